@@ -24,6 +24,7 @@ type rpcBackend struct {
 }
 
 var _ wallet.NetworkBackend = (*rpcBackend)(nil)
+var _ wallet.UnspentOutputSource = (*rpcBackend)(nil)
 
 // BackendFromRPCClient creates a wallet network backend from an RPC client.
 func BackendFromRPCClient(rpcClient *rpcclient.Client) wallet.NetworkBackend {
@@ -188,6 +189,51 @@ func (b *rpcBackend) StakeDifficulty(ctx context.Context) (vhcutil.Amount, error
 	return amount, nil
 }
 
+func (b *rpcBackend) UnspentOutput(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, int32, error) {
+	const op errors.Op = "vhcd.jsonrpc.gettxout"
+
+	r, err := b.rpcClient.GetTxOut(&outpoint.Hash, outpoint.Index, false)
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	if r == nil || r.Confirmations < 1 {
+		return nil, 0, errors.E(op, errors.NotExist, errors.Errorf("no mined unspent output %v", outpoint))
+	}
+	bestHash, err := chainhash.NewHashFromStr(r.BestBlock)
+	if err != nil {
+		return nil, 0, errors.E(op, errors.Encoding, err)
+	}
+	bestHeader, err := b.rpcClient.GetBlockHeader(bestHash)
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	amount, err := vhcutil.NewAmount(r.Value)
+	if err != nil {
+		return nil, 0, errors.E(op, errors.Encoding, err)
+	}
+	pkScript, err := hex.DecodeString(r.ScriptPubKey.Hex)
+	if err != nil {
+		return nil, 0, errors.E(op, errors.Encoding, err)
+	}
+	out := &wire.TxOut{
+		Value:    int64(amount),
+		Version:  uint16(r.Version),
+		PkScript: pkScript,
+	}
+	height := int32(bestHeader.Height) - int32(r.Confirmations) + 1
+	return out, height, nil
+}
+
+func (b *rpcBackend) MainChainBlockHash(ctx context.Context, height int32) (*chainhash.Hash, error) {
+	const op errors.Op = "vhcd.jsonrpc.getblockhash"
+
+	hash, err := b.rpcClient.GetBlockHash(int64(height))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return hash, nil
+}
+
 func (b *rpcBackend) RPCClient() *rpcclient.Client {
 	return b.rpcClient
 }
@@ -0,0 +1,248 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// TicketLottery computes the winning tickets of each block of a simulation or
+// regression test network by maintaining a local live ticket pool and
+// replicating the consensus ticket lottery.  It allows the voting code path to
+// be exercised when tests drive block generation without a vhcd server
+// delivering winningtickets notifications.
+//
+// Blocks must be connected in order beginning with the block at height 1.
+// Reorganizations are not supported.
+type TicketLottery struct {
+	params *chaincfg.Params
+
+	mu       sync.Mutex
+	height   int32
+	immature map[int32][]chainhash.Hash // purchased tickets by block height
+	live     map[chainhash.Hash]int32   // live tickets and their live height
+	winners  []chainhash.Hash
+	missed   map[chainhash.Hash]struct{}
+}
+
+// NewTicketLottery returns a TicketLottery for the simnet or regnet network
+// parameters.
+func NewTicketLottery(params *chaincfg.Params) (*TicketLottery, error) {
+	const op errors.Op = "chain.NewTicketLottery"
+	switch params.Net {
+	case wire.SimNet, wire.RegNet:
+	default:
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("ticket lottery simulation is not allowed on %s", params.Name))
+	}
+	return &TicketLottery{
+		params:   params,
+		immature: make(map[int32][]chainhash.Hash),
+		live:     make(map[chainhash.Hash]int32),
+		missed:   make(map[chainhash.Hash]struct{}),
+	}, nil
+}
+
+// Height returns the height of the last connected block.
+func (l *TicketLottery) Height() int32 {
+	l.mu.Lock()
+	height := l.height
+	l.mu.Unlock()
+	return height
+}
+
+// LiveTickets returns the number of tickets in the live ticket pool.
+func (l *TicketLottery) LiveTickets() int {
+	l.mu.Lock()
+	n := len(l.live)
+	l.mu.Unlock()
+	return n
+}
+
+// Missed returns whether a ticket was missed or expired and has not been
+// revoked.
+func (l *TicketLottery) Missed(ticket *chainhash.Hash) bool {
+	l.mu.Lock()
+	_, ok := l.missed[*ticket]
+	l.mu.Unlock()
+	return ok
+}
+
+// ConnectBlock updates the ticket pool with the tickets purchased, voted, and
+// revoked by the block and returns the winning tickets eligible to vote on it.
+// No winners are returned for blocks before the block preceding the stake
+// validation height.
+func (l *TicketLottery) ConnectBlock(block *wire.MsgBlock) ([]*chainhash.Hash, error) {
+	const op errors.Op = "chain.TicketLottery.ConnectBlock"
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	height := int32(block.Header.Height)
+	if height != l.height+1 {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("block %v at height %d does not extend height %d",
+				block.BlockHash(), height, l.height))
+	}
+
+	var purchased, voted, revoked []chainhash.Hash
+	for _, tx := range block.STransactions {
+		switch {
+		case stake.IsSStx(tx):
+			purchased = append(purchased, tx.TxHash())
+		case stake.IsSSGen(tx):
+			voted = append(voted, tx.TxIn[1].PreviousOutPoint.Hash)
+		case stake.IsSSRtx(tx):
+			revoked = append(revoked, tx.TxIn[0].PreviousOutPoint.Hash)
+		}
+	}
+
+	if int64(height) >= l.params.StakeEnabledHeight {
+		for i := range voted {
+			if !hashInSlice(&voted[i], l.winners) {
+				return nil, errors.E(op, errors.Invalid,
+					errors.Errorf("block %v votes with ticket %v which "+
+						"was not selected", block.BlockHash(), &voted[i]))
+			}
+		}
+		for i := range l.winners {
+			ticket := l.winners[i]
+			delete(l.live, ticket)
+			if !hashInSlice(&ticket, voted) {
+				l.missed[ticket] = struct{}{}
+			}
+		}
+
+		var expireHeight int32
+		if uint32(height) > l.params.TicketExpiry {
+			expireHeight = height - int32(l.params.TicketExpiry)
+		}
+		for ticket, liveHeight := range l.live {
+			if liveHeight <= expireHeight {
+				delete(l.live, ticket)
+				l.missed[ticket] = struct{}{}
+			}
+		}
+
+		for i := range revoked {
+			ticket := revoked[i]
+			if _, ok := l.missed[ticket]; !ok {
+				return nil, errors.E(op, errors.Invalid,
+					errors.Errorf("block %v revokes ticket %v which "+
+						"was not missed", block.BlockHash(), &ticket))
+			}
+			delete(l.missed, ticket)
+		}
+	}
+
+	if len(purchased) != 0 {
+		l.immature[height] = purchased
+	}
+	maturedHeight := height - int32(l.params.TicketMaturity)
+	for _, ticket := range l.immature[maturedHeight] {
+		l.live[ticket] = height
+	}
+	delete(l.immature, maturedHeight)
+
+	l.height = height
+	l.winners = nil
+	if int64(height) < l.params.StakeValidationHeight-1 {
+		return nil, nil
+	}
+
+	winners, err := l.selectWinners(&block.Header)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	l.winners = winners
+	hashes := make([]*chainhash.Hash, len(winners))
+	for i := range winners {
+		hashes[i] = &winners[i]
+	}
+	return hashes, nil
+}
+
+// selectWinners performs the ticket lottery over the live ticket pool, which
+// is ordered by ticket hash, using the serialized block header as the PRNG
+// seed.
+func (l *TicketLottery) selectWinners(header *wire.BlockHeader) ([]chainhash.Hash, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, wire.MaxBlockHeaderPayload))
+	err := header.Serialize(buf)
+	if err != nil {
+		return nil, err
+	}
+	prng := stake.NewHash256PRNGFromIV(stake.CalcHash256PRNGIV(buf.Bytes()))
+	idxs, err := stake.FindTicketIdxs(len(l.live), l.params.TicketsPerBlock, prng)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := make([]chainhash.Hash, 0, len(l.live))
+	for ticket := range l.live {
+		pool = append(pool, ticket)
+	}
+	sort.Slice(pool, func(i, j int) bool {
+		return bytes.Compare(pool[i][:], pool[j][:]) < 0
+	})
+	winners := make([]chainhash.Hash, len(idxs))
+	for i, idx := range idxs {
+		winners[i] = pool[idx]
+	}
+	return winners, nil
+}
+
+func hashInSlice(h *chainhash.Hash, list []chainhash.Hash) bool {
+	for i := range list {
+		if *h == list[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// RunTicketLottery connects each block received from blocks to a
+// TicketLottery for the wallet's network and delivers the winning tickets to
+// the wallet as if they were received in a winningtickets notification.  It
+// returns when the context is cancelled, the blocks channel is closed, or a
+// block or vote fails to process.
+func RunTicketLottery(ctx context.Context, w *wallet.Wallet, blocks <-chan *wire.MsgBlock) error {
+	const op errors.Op = "chain.RunTicketLottery"
+	l, err := NewTicketLottery(w.ChainParams())
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case block, ok := <-blocks:
+			if !ok {
+				return nil
+			}
+			winners, err := l.ConnectBlock(block)
+			if err != nil {
+				return err
+			}
+			if len(winners) == 0 {
+				continue
+			}
+			blockHash := block.BlockHash()
+			err = w.VoteOnOwnedTickets(winners, &blockHash, int32(block.Header.Height))
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/blockchain/chaingen"
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+// blockVotes returns the tickets voted by a block.
+func blockVotes(b *wire.MsgBlock) map[chainhash.Hash]struct{} {
+	votes := make(map[chainhash.Hash]struct{})
+	for _, tx := range b.STransactions {
+		if stake.IsSSGen(tx) {
+			votes[tx.TxIn[1].PreviousOutPoint.Hash] = struct{}{}
+		}
+	}
+	return votes
+}
+
+func TestTicketLotteryChaingen(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	g, err := chaingen.MakeGenerator(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := NewTicketLottery(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// connect connects the generator tip to the lottery and checks the
+	// live ticket pool against the pool size chaingen commits to in the
+	// header of the next block.
+	var winners []*chainhash.Hash
+	connect := func() {
+		t.Helper()
+		tip := g.Tip()
+		if winners != nil {
+			// Every winner selected by the lottery for the parent
+			// block must be voted by chaingen, which performs the
+			// consensus lottery.
+			votes := blockVotes(tip)
+			if len(votes) != len(winners) {
+				t.Fatalf("block %d: %d votes for %d winners",
+					tip.Header.Height, len(votes), len(winners))
+			}
+			for _, w := range winners {
+				if _, ok := votes[*w]; !ok {
+					t.Fatalf("block %d: winner %v did not vote",
+						tip.Header.Height, w)
+				}
+			}
+		}
+		if live := l.LiveTickets(); uint32(live) != tip.Header.PoolSize {
+			t.Fatalf("block %d: %d live tickets before connecting, "+
+				"header pool size %d", tip.Header.Height, live,
+				tip.Header.PoolSize)
+		}
+		winners, err = l.ConnectBlock(tip)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g.CreatePremineBlock("bp", 0)
+	connect()
+
+	// Generate enough blocks to have mature coinbase outputs, then
+	// purchase tickets in every block until the target pool size is
+	// reached, continuing past the stake validation height.
+	coinbaseMaturity := int(params.CoinbaseMaturity)
+	for i := 0; i < coinbaseMaturity; i++ {
+		g.NextBlock(fmt.Sprintf("bm%d", i), nil, nil)
+		g.SaveTipCoinbaseOuts()
+		connect()
+	}
+	targetPoolSize := int(params.TicketPoolSize) * int(params.TicketsPerBlock)
+	var purchased int
+	for i := 0; int64(g.Tip().Header.Height) < params.StakeValidationHeight+16; i++ {
+		var ticketOuts []chaingen.SpendableOut
+		if purchased < targetPoolSize {
+			ticketOuts = g.OldestCoinbaseOuts()[1:]
+			purchased += len(ticketOuts)
+		}
+		g.NextBlock(fmt.Sprintf("bs%d", i), nil, ticketOuts)
+		g.SaveTipCoinbaseOuts()
+		connect()
+	}
+	if len(winners) != int(params.TicketsPerBlock) {
+		t.Fatalf("%d winners after stake validation height", len(winners))
+	}
+
+	// Tickets which were selected but not voted are missed.
+	selected := winners
+	g.NextBlock("bmissed", nil, nil, g.ReplaceWithNVotes(params.TicketsPerBlock-2))
+	winners = nil // Not every winner votes
+	connect()
+	votes := blockVotes(g.Tip())
+	var missed int
+	for _, ticket := range selected {
+		_, voted := votes[*ticket]
+		if voted == l.Missed(ticket) {
+			t.Errorf("ticket %v voted %v, missed %v", ticket, voted, l.Missed(ticket))
+		}
+		if !voted {
+			missed++
+		}
+	}
+	if missed != 2 {
+		t.Fatalf("%d tickets missed", missed)
+	}
+	g.NextBlock("bafter", nil, nil)
+	connect()
+	if l.Height() != int32(g.Tip().Header.Height) {
+		t.Fatalf("lottery height %d, tip height %d", l.Height(), g.Tip().Header.Height)
+	}
+}
+
+func TestTicketLotteryNetworks(t *testing.T) {
+	_, err := NewTicketLottery(&chaincfg.MainNetParams)
+	if err == nil {
+		t.Fatal("ticket lottery created for mainnet")
+	}
+	_, err = NewTicketLottery(&chaincfg.RegNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// maxGapRecoveryBlocks is the maximum number of blocks connected by a catch-up
+// sync after block notifications were missed.  Larger gaps end synchronization
+// with an error, and the full startup sync is performed when synchronization
+// is restarted.
+const maxGapRecoveryBlocks = 4096
+
+// tipCheckInterval is how often the best block of the server is compared with
+// the wallet's main chain tip to detect missed notifications when no further
+// blocks are notified.
+const tipCheckInterval = 5 * time.Minute
+
+// GapRecoveryStats counts catch-up syncs performed by the RPC syncers of the
+// process after detecting missed block notifications.
+type GapRecoveryStats struct {
+	// Recoveries is the number of catch-up syncs started.
+	Recoveries uint64
+
+	// RecoveredBlocks is the number of blocks connected by catch-up syncs.
+	RecoveredBlocks uint64
+
+	// Failures is the number of catch-up syncs which failed, or which ended
+	// synchronization after exceeding the maximum number of blocks.
+	Failures uint64
+}
+
+var gapRecoveryStats struct {
+	recoveries      uint64 // atomic
+	recoveredBlocks uint64 // atomic
+	failures        uint64 // atomic
+}
+
+// GapRecoveries returns the counts of catch-up syncs performed after missed
+// block notifications since the process started.
+func GapRecoveries() GapRecoveryStats {
+	return GapRecoveryStats{
+		Recoveries:      atomic.LoadUint64(&gapRecoveryStats.recoveries),
+		RecoveredBlocks: atomic.LoadUint64(&gapRecoveryStats.recoveredBlocks),
+		Failures:        atomic.LoadUint64(&gapRecoveryStats.failures),
+	}
+}
+
+// tipLagging returns the best block of the server when it is ahead of the
+// wallet's main chain and is not a block the wallet has processed.
+func (s *RPCSyncer) tipLagging(ctx context.Context) (*chainhash.Hash, error) {
+	var hash *chainhash.Hash
+	var height int64
+	err := ctxdo(ctx, "vhcd.jsonrpc.getbestblock", func() error {
+		var err error
+		hash, height, err = s.rpcClient.GetBestBlock()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.wallet.SetBackendHeight(int32(height))
+	_, tipHeight := s.wallet.MainChainTip()
+	if height <= int64(tipHeight) {
+		return nil, nil
+	}
+	haveBlock, _, err := s.wallet.BlockInMainChain(hash)
+	if err != nil || haveBlock {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// recoverGap performs a catch-up sync after block notifications were missed,
+// connecting the blocks following the wallet's main chain tip and rescanning
+// them for wallet transactions, which were not provided by notifications.  At
+// most maxGapRecoveryBlocks are connected before an error is returned.
+func (s *RPCSyncer) recoverGap(ctx context.Context, sidechains *wallet.SidechainForest, reason string) (err error) {
+	const op errors.Op = "rpcsyncer.recoverGap"
+
+	_, tipHeight := s.wallet.MainChainTip()
+	log.Warnf("Missed block notifications (%s); catching up from block height %d",
+		reason, tipHeight)
+	atomic.AddUint64(&gapRecoveryStats.recoveries, 1)
+	defer func() {
+		if err != nil && ctx.Err() == nil {
+			atomic.AddUint64(&gapRecoveryStats.failures, 1)
+		}
+	}()
+
+	var connected int
+	for {
+		locators, err := s.wallet.BlockLocators(nil)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		nodes, err := s.getHeaderNodes(ctx, locators)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		var added int
+		for _, n := range nodes {
+			haveBlock, _, _ := s.wallet.BlockInMainChain(n.Hash)
+			if haveBlock {
+				continue
+			}
+			if sidechains.AddBlockNode(n) {
+				added++
+			}
+		}
+		if added == 0 {
+			break
+		}
+
+		bestChain, err := s.wallet.EvaluateBestChain(sidechains)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if len(bestChain) == 0 {
+			continue
+		}
+		if connected+len(bestChain) > maxGapRecoveryBlocks {
+			return errors.E(op, errors.Errorf("missed more than %d blocks",
+				maxGapRecoveryBlocks))
+		}
+		_, err = s.wallet.ValidateHeaderChainDifficulties(bestChain, 0)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		prevChain, err := s.wallet.ChainSwitch(sidechains, bestChain, nil)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if len(prevChain) != 0 {
+			log.Infof("Reorganize from %v to %v (total %d block(s) reorged)",
+				prevChain[len(prevChain)-1].Hash, bestChain[len(bestChain)-1].Hash, len(prevChain))
+			for _, n := range prevChain {
+				sidechains.AddBlockNode(n)
+			}
+		}
+		connected += len(bestChain)
+	}
+
+	// Blocks connected without their notifications leave a rescan point for
+	// the first block with unprocessed transactions.
+	rescanPoint, err := s.wallet.RescanPoint()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if rescanPoint != nil {
+		n := BackendFromRPCClient(s.rpcClient.Client)
+		err = s.wallet.Rescan(ctx, n, rescanPoint)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	atomic.AddUint64(&gapRecoveryStats.recoveredBlocks, uint64(connected))
+	tipHash, tipHeight := s.wallet.MainChainTip()
+	log.Infof("Recovered %d missed block(s), new tip block %v, height %d",
+		connected, &tipHash, tipHeight)
+	return nil
+}
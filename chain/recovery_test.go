@@ -0,0 +1,314 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/chaingen"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/gcs/blockcf"
+	vhcrpcclient "github.com/valhallacoin/vhcd/rpcclient"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/wallet"
+	_ "github.com/valhallacoin/vhcwallet/wallet/drivers/bdb"
+)
+
+// testChainServer is a consensus RPC server serving the methods used by
+// catch-up syncs from a chain of generated blocks.
+type testChainServer struct {
+	blocks     []*wire.MsgBlock
+	discovered map[chainhash.Hash][]*wire.MsgTx
+	failures   map[string]bool
+}
+
+func (s *testChainServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+		ID     interface{}       `json:"id"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var res interface{}
+	var rpcErr *vhcjson.RPCError
+	if s.failures[req.Method] {
+		rpcErr = vhcjson.NewRPCError(vhcjson.ErrRPCMisc, "test failure")
+	} else {
+		res, err = s.handle(req.Method, req.Params)
+		if err != nil {
+			rpcErr = vhcjson.NewRPCError(vhcjson.ErrRPCInvalidParameter, err.Error())
+		}
+	}
+	json.NewEncoder(w).Encode(&struct {
+		Result interface{}       `json:"result"`
+		Error  *vhcjson.RPCError `json:"error"`
+		ID     interface{}       `json:"id"`
+	}{res, rpcErr, req.ID})
+}
+
+// hashParam decodes a parameter of concatenated, non-byte-reversed hashes.
+func hashParam(param json.RawMessage) ([]chainhash.Hash, error) {
+	var s string
+	err := json.Unmarshal(param, &s)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b)%chainhash.HashSize != 0 {
+		return nil, fmt.Errorf("invalid hashes %q", s)
+	}
+	hashes := make([]chainhash.Hash, len(b)/chainhash.HashSize)
+	for i := range hashes {
+		copy(hashes[i][:], b[i*chainhash.HashSize:])
+	}
+	return hashes, nil
+}
+
+func (s *testChainServer) block(hash *chainhash.Hash) *wire.MsgBlock {
+	for _, b := range s.blocks {
+		if b.BlockHash() == *hash {
+			return b
+		}
+	}
+	return nil
+}
+
+func (s *testChainServer) handle(method string, params []json.RawMessage) (interface{}, error) {
+	switch method {
+	case "getbestblock":
+		tip := s.blocks[len(s.blocks)-1]
+		return &vhcjson.GetBestBlockResult{
+			Hash:   tip.BlockHash().String(),
+			Height: int64(tip.Header.Height),
+		}, nil
+
+	case "getheaders":
+		locators, err := hashParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		res := &vhcjson.GetHeadersResult{Headers: []string{}}
+		start := len(s.blocks)
+		for i := range locators {
+			if b := s.block(&locators[i]); b != nil {
+				start = int(b.Header.Height) + 1
+				break
+			}
+		}
+		for _, b := range s.blocks[start:] {
+			var buf bytes.Buffer
+			err := b.Header.Serialize(&buf)
+			if err != nil {
+				return nil, err
+			}
+			res.Headers = append(res.Headers, hex.EncodeToString(buf.Bytes()))
+		}
+		return res, nil
+
+	case "getcfilter":
+		var hashStr string
+		err := json.Unmarshal(params[0], &hashStr)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := chainhash.NewHashFromStr(hashStr)
+		if err != nil {
+			return nil, err
+		}
+		b := s.block(hash)
+		if b == nil {
+			return nil, fmt.Errorf("no block %v", hash)
+		}
+		f, err := blockcf.Regular(b)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(f.NBytes()), nil
+
+	case "rescan":
+		hashes, err := hashParam(params[0])
+		if err != nil {
+			return nil, err
+		}
+		res := &vhcjson.RescanResult{DiscoveredData: []vhcjson.RescannedBlock{}}
+		for i := range hashes {
+			txs := s.discovered[hashes[i]]
+			if len(txs) == 0 {
+				continue
+			}
+			d := vhcjson.RescannedBlock{Hash: hashes[i].String()}
+			for _, tx := range txs {
+				b, err := tx.Bytes()
+				if err != nil {
+					return nil, err
+				}
+				d.Transactions = append(d.Transactions, hex.EncodeToString(b))
+			}
+			res.DiscoveredData = append(res.DiscoveredData, d)
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("unhandled method %q", method)
+}
+
+// testSyncer returns a syncer for a new simnet wallet using the consensus RPC
+// server of a test chain.
+func testSyncer(t *testing.T, s *testChainServer) (syncer *RPCSyncer, teardown func()) {
+	dir, err := ioutil.TempDir("", "chain.recovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := &chaincfg.SimNetParams
+	db, err := wallet.CreateDB("bdb", filepath.Join(dir, "wallet.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	err = wallet.Create(db, pubPass, []byte("private"), nil, time.Time{}, params)
+	if err != nil {
+		db.Close()
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	w, err := wallet.Open(&wallet.Config{
+		DB:            db,
+		PubPassphrase: pubPass,
+		GapLimit:      20,
+		RelayFee:      1e-3,
+		Params:        params,
+	})
+	if err != nil {
+		db.Close()
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	w.Start()
+
+	srv := httptest.NewServer(s)
+	c, err := NewRPCClientConfig(params, &vhcrpcclient.ConnConfig{
+		Host:         strings.TrimPrefix(srv.URL, "http://"),
+		HTTPPostMode: true,
+		DisableTLS:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	teardown = func() {
+		c.Client.Shutdown()
+		srv.Close()
+		w.Stop()
+		w.WaitForShutdown()
+		db.Close()
+		os.RemoveAll(dir)
+	}
+	return NewRPCSyncer(w, c), teardown
+}
+
+func TestRecoverGap(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	g, err := chaingen.MakeGenerator(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &testChainServer{
+		blocks:     []*wire.MsgBlock{params.GenesisBlock},
+		discovered: make(map[chainhash.Hash][]*wire.MsgTx),
+		failures:   make(map[string]bool),
+	}
+	syncer, teardown := testSyncer(t, server)
+	defer teardown()
+	w := syncer.wallet
+	ctx := context.Background()
+
+	// The wallet has processed no block notifications, so every generated
+	// block is missed.
+	server.blocks = append(server.blocks, g.CreatePremineBlock("bp", 0))
+	for i := 0; i < 8; i++ {
+		server.blocks = append(server.blocks, g.NextBlock(fmt.Sprintf("b%d", i), nil, nil))
+	}
+	tip := g.Tip().BlockHash()
+	lagging, err := syncer.tipLagging(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lagging == nil || *lagging != tip {
+		t.Fatalf("lagging tip %v, expected %v", lagging, &tip)
+	}
+
+	// Transactions of missed blocks are found by rescanning them.
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0xff}, 0, wire.TxTreeRegular), 0, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	server.discovered[server.blocks[4].BlockHash()] = []*wire.MsgTx{tx}
+
+	before := GapRecoveries()
+	err = syncer.recoverGap(ctx, new(wallet.SidechainForest), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := GapRecoveries()
+	if after.Recoveries != before.Recoveries+1 || after.Failures != before.Failures ||
+		after.RecoveredBlocks != before.RecoveredBlocks+9 {
+		t.Fatalf("gap recovery stats %+v after %+v", after, before)
+	}
+	tipHash, tipHeight := w.MainChainTip()
+	if tipHash != tip || tipHeight != 9 {
+		t.Fatalf("wallet tip %v height %d after recovery", &tipHash, tipHeight)
+	}
+	lagging, err = syncer.tipLagging(ctx)
+	if err != nil || lagging != nil {
+		t.Fatalf("lagging tip %v error %v after recovery", lagging, err)
+	}
+	txHash := tx.TxHash()
+	_, confs, _, err := w.TransactionSummary(&txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confs != 6 {
+		t.Fatalf("rescanned transaction of block 4 has %d confirmations", confs)
+	}
+
+	// Failed catch-up syncs are counted.
+	server.blocks = append(server.blocks, g.NextBlock("bfail", nil, nil))
+	server.failures["getheaders"] = true
+	before = GapRecoveries()
+	err = syncer.recoverGap(ctx, new(wallet.SidechainForest), "test")
+	if err == nil {
+		t.Fatal("recovery succeeded without headers")
+	}
+	after = GapRecoveries()
+	if after.Recoveries != before.Recoveries+1 || after.Failures != before.Failures+1 ||
+		after.RecoveredBlocks != before.RecoveredBlocks {
+		t.Fatalf("gap recovery stats %+v after %+v", after, before)
+	}
+}
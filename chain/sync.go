@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
 	"github.com/valhallacoin/vhcd/gcs"
@@ -71,6 +72,7 @@ func (s *RPCSyncer) SetNotifications(ntfns *Notifications) {
 // synced checks the atomic that controls wallet syncness and if previously
 // unsynced, updates to synced and notifies the callback, if set.
 func (s *RPCSyncer) synced() {
+	s.wallet.SetSynced(true)
 	if atomic.CompareAndSwapUint32(&s.atomicWalletSynced, 0, 1) &&
 		s.notifications != nil &&
 		s.notifications.Synced != nil {
@@ -81,6 +83,7 @@ func (s *RPCSyncer) synced() {
 // unsynced checks the atomic that controls wallet syncness and if previously
 // synced, updates to unsynced and notifies the callback, if set.
 func (s *RPCSyncer) unsynced() {
+	s.wallet.SetSynced(false)
 	if atomic.CompareAndSwapUint32(&s.atomicWalletSynced, 1, 0) &&
 		s.notifications != nil &&
 		s.notifications.Synced != nil {
@@ -113,6 +116,8 @@ func (s *RPCSyncer) fetchHeadersStart() {
 }
 
 func (s *RPCSyncer) fetchHeadersProgress(fetchedHeadersCount int32, lastHeaderTime int64) {
+	// The compact filter of each block is fetched with its header.
+	s.wallet.RecordFetchedHeaders(fetchedHeadersCount, fetchedHeadersCount)
 	if s.notifications != nil && s.notifications.FetchHeadersProgress != nil {
 		s.notifications.FetchHeadersProgress(fetchedHeadersCount, lastHeaderTime)
 	}
@@ -191,7 +196,7 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 				return err
 			}
 		}
-		return s.handleNotifications(ctx)
+		return s.handleNotifications(ctx, startupSync)
 	})
 	g.Go(func() error {
 		return s.handleVoteNotifications(ctx)
@@ -203,14 +208,15 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 			return errors.E(op, err)
 		}
 
-		if s.wallet.VotingEnabled() {
-			// Request notifications for winning tickets.
-			err := s.rpcClient.NotifyWinningTickets()
-			if err != nil {
-				const op errors.Op = "vhcd.jsonrpc.notifywinningtickets"
-				return errors.E(op, err)
-			}
+		// Request notifications for winning tickets even when voting is
+		// disabled, so voting may be enabled at runtime.
+		err = s.rpcClient.NotifyWinningTickets()
+		if err != nil {
+			const op errors.Op = "vhcd.jsonrpc.notifywinningtickets"
+			return errors.E(op, err)
+		}
 
+		if s.wallet.VotingEnabled() {
 			vb := s.wallet.VoteBits()
 			log.Infof("Wallet voting enabled: vote bits = %#04x, "+
 				"extended vote bits = %x", vb.Bits, vb.ExtendedBits)
@@ -226,7 +232,11 @@ func (s *RPCSyncer) Run(ctx context.Context, startupSync bool) error {
 	return nil
 }
 
-func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
+// handleNotifications processes notifications from the server until the
+// context is cancelled or the client disconnects.  When recoverGaps is true,
+// the wallet is registered for block notifications, and missed notifications
+// are detected and recovered from with a catch-up sync.
+func (s *RPCSyncer) handleNotifications(ctx context.Context, recoverGaps bool) error {
 	// connectingBlocks keeps track of whether any blocks have been successfully
 	// attached to the main chain.  Once any blocks have attached, if a future
 	// block fails to attach, the error is fatal.  Otherwise, errors are logged.
@@ -235,12 +245,47 @@ func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
 	sidechains := new(wallet.SidechainForest)
 	var relevantTxs map[chainhash.Hash][]*wire.MsgTx
 
+	// The best block of the server is periodically compared with the main
+	// chain tip.  lagTip records the tip when the server was last found to
+	// be ahead with a block the wallet has not processed, and notifications
+	// are considered missed if the tip has not changed by the next check.
+	var tipCheck <-chan time.Time
+	if recoverGaps {
+		t := time.NewTicker(tipCheckInterval)
+		defer t.Stop()
+		tipCheck = t.C
+	}
+	var lagTip *chainhash.Hash
+
 	c := s.rpcClient.notifications()
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 
+		case <-tipCheck:
+			serverTip, err := s.tipLagging(ctx)
+			if err != nil {
+				log.Warnf("Unable to compare best block of server: %v", err)
+				continue
+			}
+			tipHash, _ := s.wallet.MainChainTip()
+			switch {
+			case serverTip == nil:
+				lagTip = nil
+			case lagTip == nil || *lagTip != tipHash:
+				lagTip = &tipHash
+			default:
+				lagTip = nil
+				err := s.recoverGap(ctx, sidechains, "server best block "+
+					serverTip.String()+" was not notified")
+				if err != nil {
+					return err
+				}
+				relevantTxs = nil
+			}
+			continue
+
 		case n, ok := <-c:
 			if !ok {
 				return errors.E(errors.NoPeers, "RPC client disconnected")
@@ -258,6 +303,7 @@ func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
 					break
 				}
 				blockHash := header.BlockHash()
+				_, tipHeight := s.wallet.MainChainTip()
 				getCfilter := s.rpcClient.GetCFilterAsync(&blockHash, wire.GCSFilterRegular)
 				var rpt *chainhash.Hash
 				rpt, err = s.wallet.RescanPoint()
@@ -318,6 +364,12 @@ func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
 					}
 
 					relevantTxs = nil
+				} else if recoverGaps && header.Height > uint32(tipHeight)+1 {
+					// The block does not extend any known chain, so
+					// the notifications of its ancestors were missed.
+					err = s.recoverGap(ctx, sidechains, "block "+
+						blockHash.String()+" does not connect to the main chain")
+					relevantTxs = nil
 				}
 
 			case blockDisconnected, reorganization:
@@ -334,8 +386,13 @@ func (s *RPCSyncer) handleNotifications(ctx context.Context) error {
 				err = s.wallet.AcceptMempoolTx(n.transaction)
 
 			case missedTickets:
+				// Missed tickets are revoked by the wallet which
+				// votes them.
+				if !s.wallet.VotingEnabled() {
+					continue
+				}
 				op = "vhcd.jsonrpc.spentandmissedtickets"
-				err = s.wallet.RevokeOwnedTickets(n.tickets)
+				err = s.wallet.ScheduleRevocations(n.tickets)
 				nonFatal = true
 
 			default:
@@ -410,12 +467,81 @@ func ctxdo(ctx context.Context, op errors.Op, f func() error) error {
 // locators.
 var hashStop chainhash.Hash
 
+// getHeaderNodes fetches the headers of main chain blocks following the
+// locators from the server, along with the compact filter of each block.
+// No nodes are returned when the server has no blocks following the locators.
+func (s *RPCSyncer) getHeaderNodes(ctx context.Context, locators []*chainhash.Hash) ([]*wallet.BlockNode, error) {
+	var headers []*wire.BlockHeader
+	err := ctxdo(ctx, "vhcd.jsonrpc.getheaders", func() error {
+		headersMsg, err := s.rpcClient.GetHeaders(locators, &hashStop)
+		if err != nil {
+			return err
+		}
+		headers = make([]*wire.BlockHeader, 0, len(headersMsg.Headers))
+		for _, h := range headersMsg.Headers {
+			header := new(wire.BlockHeader)
+			err := header.Deserialize(hex.NewDecoder(strings.NewReader(h)))
+			if err != nil {
+				return err
+			}
+			headers = append(headers, header)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*wallet.BlockNode, len(headers))
+	var g errgroup.Group
+	for i := range headers {
+		i := i
+		g.Go(func() error {
+			header := headers[i]
+			hash := header.BlockHash()
+			var filter *gcs.Filter
+			err := ctxdo(ctx, "", func() error {
+				const opf = "vhcd.jsonrpc.getcfilter(%v)"
+				var err error
+				filter, err = s.rpcClient.GetCFilter(&hash, wire.GCSFilterRegular)
+				if err != nil {
+					op := errors.Opf(opf, &hash)
+					err = errors.E(op, err)
+				}
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			nodes[i] = wallet.NewBlockNode(header, &hash, filter)
+			return nil
+		})
+	}
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
 // startupSync brings the wallet up to date with the current chain server
 // connection.  It creates a rescan request and blocks until the rescan has
 // finished.
 func (s *RPCSyncer) startupSync(ctx context.Context) error {
 	n := BackendFromRPCClient(s.rpcClient.Client)
 
+	// Record the server's best block height to report sync progress.
+	err := ctxdo(ctx, "vhcd.jsonrpc.getbestblock", func() error {
+		_, height, err := s.rpcClient.GetBestBlock()
+		if err == nil {
+			s.wallet.SetBackendHeight(int32(height))
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
 	// Fetch any missing main chain compact filters.
 	s.fetchMissingCfiltersStart()
 	progress := make(chan wallet.MissingCFilterProgress, 1)
@@ -430,7 +556,7 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 	s.fetchMissingCfiltersFinished()
 
 	// Request notifications for connected and disconnected blocks.
-	err := s.rpcClient.NotifyBlocks()
+	err = s.rpcClient.NotifyBlocks()
 	if err != nil {
 		const op errors.Op = "vhcd.jsonrpc.notifyblocks"
 		return errors.E(op, err)
@@ -448,60 +574,14 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		var headers []*wire.BlockHeader
-		err := ctxdo(ctx, "vhcd.jsonrpc.getheaders", func() error {
-			headersMsg, err := s.rpcClient.GetHeaders(locators, &hashStop)
-			if err != nil {
-				return err
-			}
-			headers = make([]*wire.BlockHeader, 0, len(headersMsg.Headers))
-			for _, h := range headersMsg.Headers {
-				header := new(wire.BlockHeader)
-				err := header.Deserialize(hex.NewDecoder(strings.NewReader(h)))
-				if err != nil {
-					return err
-				}
-				headers = append(headers, header)
-			}
-			return nil
-		})
+		nodes, err := s.getHeaderNodes(ctx, locators)
 		if err != nil {
 			return err
 		}
-		if len(headers) == 0 {
+		if len(nodes) == 0 {
 			break
 		}
 
-		nodes := make([]*wallet.BlockNode, len(headers))
-		var g errgroup.Group
-		for i := range headers {
-			i := i
-			g.Go(func() error {
-				header := headers[i]
-				hash := header.BlockHash()
-				var filter *gcs.Filter
-				err := ctxdo(ctx, "", func() error {
-					const opf = "vhcd.jsonrpc.getcfilter(%v)"
-					var err error
-					filter, err = s.rpcClient.GetCFilter(&hash, wire.GCSFilterRegular)
-					if err != nil {
-						op := errors.Opf(opf, &hash)
-						err = errors.E(op, err)
-					}
-					return err
-				})
-				if err != nil {
-					return err
-				}
-				nodes[i] = wallet.NewBlockNode(header, &hash, filter)
-				return nil
-			})
-		}
-		err = g.Wait()
-		if err != nil {
-			return err
-		}
-
 		var added int
 		for _, n := range nodes {
 			haveBlock, _, _ := s.wallet.BlockInMainChain(n.Hash)
@@ -513,7 +593,7 @@ func (s *RPCSyncer) startupSync(ctx context.Context) error {
 			}
 		}
 
-		s.fetchHeadersProgress(int32(added), headers[len(headers)-1].Timestamp.Unix())
+		s.fetchHeadersProgress(int32(added), nodes[len(nodes)-1].Header.Timestamp.Unix())
 
 		log.Infof("Fetched %d new header(s) ending at height %d from %v",
 			added, nodes[len(nodes)-1].Header.Height, s.rpcClient)
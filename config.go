@@ -7,21 +7,29 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/base64"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/decred/slog"
 	flags "github.com/jessevdk/go-flags"
+	"github.com/valhallacoin/vhcd/hdkeychain"
 	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
 	"github.com/valhallacoin/vhcwallet/errors"
 	"github.com/valhallacoin/vhcwallet/internal/cfgutil"
+	"github.com/valhallacoin/vhcwallet/internal/features"
 	"github.com/valhallacoin/vhcwallet/netparams"
+	"github.com/valhallacoin/vhcwallet/p2p"
 	"github.com/valhallacoin/vhcwallet/ticketbuyer"
 	"github.com/valhallacoin/vhcwallet/version"
 	"github.com/valhallacoin/vhcwallet/wallet"
@@ -50,6 +58,8 @@ const (
 	defaultStakePoolColdExtKey = ""
 	defaultAllowHighFees       = false
 	defaultAccountGapLimit     = wallet.DefaultAccountGapLimit
+	defaultVSPMaxFee           = 2e7 // 0.2 coin
+	defaultVoteClaimLease      = 30 * time.Second
 
 	// ticket buyer options
 	defaultMaxFee                    vhcutil.Amount = 1e6
@@ -68,6 +78,8 @@ const (
 	defaultPriceTarget                              = 0
 	defaultBalanceToMaintainAbsolute                = 0
 	defaultBalanceToMaintainRelative                = 0.3
+	defaultMaxSpend                                 = 0
+	defaultSpendWindow                              = 288 // one day of mainnet blocks
 
 	walletDbName = "wallet.db"
 )
@@ -78,6 +90,7 @@ var (
 	defaultConfigFile  = filepath.Join(defaultAppDataDir, defaultConfigFilename)
 	defaultRPCKeyFile  = filepath.Join(defaultAppDataDir, "rpc.key")
 	defaultRPCCertFile = filepath.Join(defaultAppDataDir, "rpc.cert")
+	defaultSignKeyFile = filepath.Join(defaultAppDataDir, "rpcsign.key")
 	defaultLogDir      = filepath.Join(defaultAppDataDir, defaultLogDirname)
 )
 
@@ -101,6 +114,7 @@ type config struct {
 	WalletPass          string               `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
 	PromptPass          bool                 `long:"promptpass" description:"The private wallet password is prompted for at start up, so the wallet starts unlocked without a time limit"`
 	Pass                string               `long:"pass" description:"The private wallet passphrase"`
+	KeyringPass         bool                 `long:"keyringpass" description:"Retrieve the private wallet passphrase from the OS keyring at start up, so the wallet starts unlocked without a time limit; the passphrase is prompted for and stored in the keyring if it is not found"`
 	PromptPublicPass    bool                 `long:"promptpublicpass" description:"The public wallet password is prompted for at start up"`
 	DisallowFree        bool                 `long:"disallowfree" description:"Force transactions to always include a fee"`
 	EnableTicketBuyer   bool                 `long:"enableticketbuyer" description:"Enable the automatic ticket buyer"`
@@ -109,13 +123,29 @@ type config struct {
 	PurchaseAccount     string               `long:"purchaseaccount" description:"Name of the account to buy tickets from"`
 	PoolAddress         *cfgutil.AddressFlag `long:"pooladdress" description:"The ticket pool address where ticket fees will go to"`
 	PoolFees            float64              `long:"poolfees" description:"The per-ticket fee mandated by the ticket pool as a percent (e.g. 1.00 for 1.00% fee)"`
+	VSP                 string               `long:"vsp" description:"URL of the voting service provider which tickets are purchased for (e.g. https://vsp.example.org)"`
+	VSPPubKey           string               `long:"vsppubkey" description:"Base64 encoded Ed25519 public key which signs the responses of the VSP"`
+	VSPMaxFee           *cfgutil.AmountFlag  `long:"vspmaxfee" description:"Largest fee paid to a VSP for a single ticket"`
 	GapLimit            int                  `long:"gaplimit" description:"The size of gaps between used addresses.  Used for address scanning and when generating addresses with the wrap option."`
 	StakePoolColdExtKey string               `long:"stakepoolcoldextkey" description:"Enables the wallet as a stake pool with an extended key in the format of \"xpub...:index\" to derive cold wallet addresses to send fees to"`
 	AllowHighFees       bool                 `long:"allowhighfees" description:"Force the RPC client to use the 'allowHighFees' flag when sending transactions"`
 	RelayFee            *cfgutil.AmountFlag  `long:"txfee" description:"Sets the wallet's tx fee per kb"`
 	TicketFee           *cfgutil.AmountFlag  `long:"ticketfee" description:"Sets the wallet's ticket fee per kb"`
 	AccountGapLimit     int                  `long:"accountgaplimit" description:"Number of accounts that can be created in a row without using any of them"`
+	AllowDuplicate      bool                 `long:"allowduplicatewallet" description:"Continue creating and publishing transactions after another running instance of this wallet is detected"`
+	InstanceHeartbeat   string               `long:"instanceheartbeat" description:"UDP address used to exchange heartbeats with other instances of this wallet (e.g. 239.255.42.99:9119)"`
+	UnlockCacheTimeout  time.Duration        `long:"unlocksessiontimeout" description:"Duration that the key derived from the private passphrase is cached to speed up later unlocks (0 disables caching)"`
+	RevocationDelay     time.Duration        `long:"revocationdelay" description:"Duration that automatic revocations of missed tickets are delayed, during which they may be canceled (0 revokes immediately)"`
+	MissedVoteWebhook   string               `long:"missedvotewebhook" description:"URL which missed votes are reported to with HTTP POST requests of a JSON object"`
+	VoteCoordinationDir string               `long:"votecoordinationdir" description:"Directory shared with redundant voting wallets holding the same voting keys, in which each vote is claimed by exactly one wallet"`
+	VoteClaimLease      time.Duration        `long:"voteclaimlease" description:"Duration that a vote claimed in the vote coordination directory is leased to a wallet before a redundant wallet may take it over, unless the vote was published"`
+	FullCheck           bool                 `long:"fullcheck" description:"Verify every block, transaction, credit, and account record of the wallet database when it is opened, instead of only its structure and tip"`
+	EnableFeatures      []string             `long:"enablefeature" description:"Enable an experimental or optional feature (may be repeated; see the getfeatureflags RPC)"`
+	DisableFeatures     []string             `long:"disablefeature" description:"Disable an optional feature such as spv, grpc, or notifications (may be repeated)"`
+	features            *features.Set
 	legacyTicketBuyer   bool
+	vspPubKey           []byte
+	votingXpub          *hdkeychain.ExtendedKey
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Hostname/IP and port of vhcd RPC server to connect to"`
@@ -128,8 +158,23 @@ type config struct {
 	ProxyPass        string                  `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
 
 	// SPV options
-	SPV        bool     `long:"spv" description:"Sync using simplified payment verification"`
-	SPVConnect []string `long:"spvconnect" description:"Full node addresses to SPV sync from"`
+	SPV         bool     `long:"spv" description:"Sync using simplified payment verification"`
+	SPVConnect  []string `long:"spvconnect" description:"Full node addresses to SPV sync from"`
+	SPVServices []string `long:"spvservices" description:"Service flags advertised to SPV peers: network, bloom, or cf (may be repeated)"`
+	spvServices wire.ServiceFlag
+
+	// Remote signer options
+	RemoteSigner     string   `long:"remotesigner" description:"Hostname/IP and port of a remote signing daemon which signs transactions, messages, and votes for this watching-only wallet"`
+	RemoteSignerCA   string   `long:"remotesignerca" description:"File containing CA certificates to authenticate the remote signing daemon"`
+	RemoteSignerCert string   `long:"remotesignercert" description:"File containing the TLS client certificate presented to the remote signing daemon"`
+	RemoteSignerKey  string   `long:"remotesignerkey" description:"File containing the TLS client certificate key"`
+	SignerListeners  []string `long:"signerlisten" description:"Serve the private keys of this wallet as a remote signing daemon on this interface/port (may be repeated)"`
+	SignerClientCA   string   `long:"signerclientca" description:"File containing CA certificates; remote signing clients must present a TLS client certificate signed by one of them"`
+
+	// PKCS#11 key storage options
+	PKCS11Module string `long:"pkcs11module" description:"PKCS#11 module (shared library) of the hardware security module which signs for accounts with pkcs11 key storage"`
+	PKCS11Token  string `long:"pkcs11token" description:"Label of the PKCS#11 token holding the account keys"`
+	PKCS11PIN    string `long:"pkcs11pin" default-mask:"-" description:"User PIN of the PKCS#11 token"`
 
 	// RPC server options
 	//
@@ -150,8 +195,26 @@ type config struct {
 	NoLegacyRPC            bool                    `long:"nolegacyrpc" description:"Disable the legacy JSON-RPC server"`
 	LegacyRPCMaxClients    int64                   `long:"rpcmaxclients" description:"Max number of legacy JSON-RPC clients for standard connections"`
 	LegacyRPCMaxWebsockets int64                   `long:"rpcmaxwebsockets" description:"Max number of legacy JSON-RPC websocket connections"`
+	RPCRateLimit           float64                 `long:"rpcratelimit" description:"Max legacy JSON-RPC requests per second from each client (0 disables)"`
+	RPCRateBurst           int                     `long:"rpcrateburst" description:"Number of legacy JSON-RPC requests each client may make at once before being limited to the request rate (default: one second of requests)"`
+	RPCMaxClientReqs       int                     `long:"rpcmaxclientrequests" description:"Max concurrent legacy JSON-RPC requests from each client (0 disables)"`
+	RPCRateLimitByIP       bool                    `long:"rpcratelimitbyip" description:"Apply legacy JSON-RPC client rate limits by source IP instead of by credentials or client certificate"`
+	AuditLog               string                  `long:"auditlog" description:"Record state-changing legacy JSON-RPC requests to this append-only, hash-chained log file"`
+	AuditLogKey            string                  `long:"auditlogkey" description:"File containing the secret key of the audit log hash chain (required with --auditlog; must not be stored with the log)"`
+	RPCSignMethods         []string                `long:"rpcsignmethod" description:"Sign the results of this legacy JSON-RPC method (may be repeated, e.g. getbalance and getnewaddress)"`
+	RPCSignKey             *cfgutil.ExplicitString `long:"rpcsignkey" description:"File containing the secp256k1 key used to sign legacy JSON-RPC responses (created if missing)"`
+	RPCCORSOrigins         []string                `long:"rpccorsorigin" description:"Allow cross-origin legacy JSON-RPC requests from browser clients of this origin, or * for any origin (may be repeated)"`
+	GuardPassFailures      int                     `long:"guardpassphrasefailures" description:"Lock the wallet and refuse to unlock it after this many consecutive incorrect passphrases until the anomaly guard is cleared (0 disables)"`
+	GuardSendCap           *cfgutil.AmountFlag     `long:"guardsendcap" description:"Refuse to publish transactions spending more than this amount, locking the wallet until the anomaly guard is cleared (0 disables)"`
+	GuardOrigins           []string                `long:"guardorigin" description:"Expected legacy JSON-RPC client IP address or CIDR network; requests from other addresses lock the wallet until the anomaly guard is cleared (may be repeated)"`
 	Username               string                  `short:"u" long:"username" description:"Username for legacy JSON-RPC and vhcd authentication (if vhcdusername is unset)"`
 	Password               string                  `short:"P" long:"password" default-mask:"-" description:"Password for legacy JSON-RPC and vhcd authentication (if vhcdpassword is unset)"`
+	RequireSendApproval    bool                    `long:"requiresendapproval" description:"Queue transactions created by the legacy JSON-RPC send methods until they are approved with approvesend by another client"`
+	ApproverUsername       string                  `long:"approverusername" description:"Username for legacy JSON-RPC authentication of send approvals"`
+	ApproverPassword       string                  `long:"approverpassword" default-mask:"-" description:"Password for legacy JSON-RPC authentication of send approvals"`
+	RPCUsers               []string                `long:"rpcuser" default-mask:"-" description:"Additional legacy JSON-RPC credentials in the form username:password:role, where role is readonly (default), approver, or admin (may be repeated)"`
+	ClientCAFile           string                  `long:"clientcafile" description:"File containing CA certificates; legacy JSON-RPC clients must present a TLS client certificate signed by one of them"`
+	ClientCertRoles        []string                `long:"clientcertrole" description:"Role of legacy JSON-RPC clients authenticated by a certificate with this subject common name, in the form commonname:role (may be repeated; certificates with unlisted common names are refused)"`
 
 	// IPC options
 	PipeTx            *uint `long:"pipetx" description:"File descriptor or handle of write end pipe to enable child -> parent process communication"`
@@ -173,6 +236,11 @@ type config struct {
 type ticketBuyerOptions struct {
 	BalanceToMaintainAbsolute *cfgutil.AmountFlag  `long:"balancetomaintainabsolute" description:"Amount of funds to keep in wallet when stake mining"`
 	VotingAddress             *cfgutil.AddressFlag `long:"votingaddress" description:"Purchase tickets with voting rights assigned to this address"`
+	VotingXpub                string               `long:"votingxpub" description:"Purchase tickets with voting rights assigned to addresses derived from this account extended public key of a cold voting wallet"`
+	UseVSP                    bool                 `long:"usevsp" description:"Register purchased tickets with the VSP selected by --vsp or the setvsp RPC"`
+	MaxSpend                  *cfgutil.AmountFlag  `long:"maxspend" description:"Maximum total ticket price to spend in any spendwindow blocks (0 disables)"`
+	SpendWindow               int                  `long:"spendwindow" description:"Number of blocks the maxspend budget applies to"`
+	SpreadPurchases           bool                 `long:"spreadpurchases" description:"Spread purchases across the remaining blocks of each stake difficulty interval instead of buying as many tickets as possible each block"`
 
 	// Deprecated options
 	AvgPriceMode              string              `long:"avgpricemode" description:"DEPRECATED -- The mode to use for calculating the average price if pricetarget is disabled (vwap, pool, dual)"`
@@ -320,6 +388,33 @@ func parseAndSetDebugLevels(debugLevel string) error {
 	return nil
 }
 
+// legacyRPCOnlyOption returns the first configured option which restricts
+// clients of the legacy RPC server but is not enforced by the gRPC server, or
+// the empty string when none are set.  The gRPC server does not authenticate
+// clients beyond TLS and grants every client administrative access, so it
+// must not run alongside these options.  Spending policies, per-account send
+// approval, and the anomaly guard send cap are enforced by the wallet for every
+// API.
+func legacyRPCOnlyOption(cfg *config) string {
+	switch {
+	case cfg.AuditLog != "":
+		return "--auditlog"
+	case cfg.GuardPassFailures != 0:
+		return "--guardpassphrasefailures"
+	case len(cfg.GuardOrigins) != 0:
+		return "--guardorigin"
+	case cfg.RequireSendApproval:
+		return "--requiresendapproval"
+	case cfg.ApproverUsername != "":
+		return "--approverusername"
+	case len(cfg.RPCUsers) != 0:
+		return "--rpcuser"
+	case len(cfg.ClientCertRoles) != 0:
+		return "--clientcertrole"
+	}
+	return ""
+}
+
 // loadConfig initializes and parses the config using a config file and command
 // line options.
 //
@@ -353,9 +448,11 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		PromptPublicPass:       defaultPromptPublicPass,
 		RPCKey:                 cfgutil.NewExplicitString(defaultRPCKeyFile),
 		RPCCert:                cfgutil.NewExplicitString(defaultRPCCertFile),
+		RPCSignKey:             cfgutil.NewExplicitString(defaultSignKeyFile),
 		TLSCurve:               cfgutil.NewCurveFlag(cfgutil.CurveP521),
 		LegacyRPCMaxClients:    defaultRPCMaxClients,
 		LegacyRPCMaxWebsockets: defaultRPCMaxWebsockets,
+		GuardSendCap:           cfgutil.NewAmountFlag(0),
 		EnableTicketBuyer:      defaultEnableTicketBuyer,
 		EnableVoting:           defaultEnableVoting,
 		ReuseAddresses:         defaultReuseAddresses,
@@ -367,7 +464,9 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		RelayFee:               cfgutil.NewAmountFlag(txrules.DefaultRelayFeePerKb),
 		TicketFee:              cfgutil.NewAmountFlag(txrules.DefaultRelayFeePerKb),
 		PoolAddress:            cfgutil.NewAddressFlag(nil),
+		VSPMaxFee:              cfgutil.NewAmountFlag(defaultVSPMaxFee),
 		AccountGapLimit:        defaultAccountGapLimit,
+		VoteClaimLease:         defaultVoteClaimLease,
 
 		// TODO: DEPRECATED - remove.
 		DataDir:         cfgutil.NewExplicitString(defaultAppDataDir),
@@ -380,6 +479,8 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		TBOpts: ticketBuyerOptions{
 			BalanceToMaintainAbsolute: cfgutil.NewAmountFlag(defaultBalanceToMaintainAbsolute),
 			VotingAddress:             cfgutil.NewAddressFlag(nil),
+			MaxSpend:                  cfgutil.NewAmountFlag(defaultMaxSpend),
+			SpendWindow:               defaultSpendWindow,
 
 			MaxPriceScale:             defaultMaxPriceScale,
 			AvgPriceMode:              defaultAvgPriceMode,
@@ -468,6 +569,9 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		if !cfg.RPCCert.ExplicitlySet() {
 			cfg.RPCCert.Value = filepath.Join(cfg.AppDataDir.Value, "rpc.cert")
 		}
+		if !cfg.RPCSignKey.ExplicitlySet() {
+			cfg.RPCSignKey.Value = filepath.Join(cfg.AppDataDir.Value, "rpcsign.key")
+		}
 		if !cfg.LogDir.ExplicitlySet() {
 			cfg.LogDir.Value = filepath.Join(cfg.AppDataDir.Value, defaultLogDirname)
 		}
@@ -665,6 +769,20 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Sanity check the spending budget
+	if cfg.TBOpts.MaxSpend.ToCoin() < 0 {
+		str := "%s: maxspend cannot be negative: %v"
+		err := errors.Errorf(str, funcName, cfg.TBOpts.MaxSpend)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.TBOpts.SpendWindow <= 0 {
+		str := "%s: spendwindow must be greater then zero: %v"
+		err := errors.Errorf(str, funcName, cfg.TBOpts.SpendWindow)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Sanity check ExpiryDelta
 	if cfg.TBOpts.ExpiryDelta <= 0 {
 		str := "%s: expirydelta must be greater then zero: %v"
@@ -843,6 +961,42 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
+	cfg.features, err = features.NewSet(cfg.EnableFeatures, cfg.DisableFeatures)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.SPV && !cfg.features.Enabled(features.SPV) {
+		err := errors.E("SPV sync is disabled by feature flags: disable --spv or enable the spv feature")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.RPCRateLimit < 0 || cfg.RPCRateBurst < 0 || cfg.RPCMaxClientReqs < 0 {
+		err := errors.E("RPC rate limits may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.GuardPassFailures < 0 || cfg.GuardSendCap.Amount < 0 {
+		err := errors.E("anomaly guard triggers may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if !cfg.features.Enabled(features.GRPC) {
+		cfg.NoGRPC = true
+	}
+	if opt := legacyRPCOnlyOption(&cfg); opt != "" && !cfg.NoGRPC {
+		if len(cfg.GRPCListeners) != 0 {
+			err := errors.Errorf("--grpclisten may not be used with %s: "+
+				"the gRPC server only serves administrative clients and "+
+				"does not enforce this option", opt)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		log.Warnf("gRPC server disabled: %s is only enforced by the "+
+			"legacy RPC server", opt)
+		cfg.NoGRPC = true
+	}
+
 	if cfg.SPV && cfg.EnableVoting {
 		err := errors.E("SPV voting is not possible: disable --spv or --enablevoting")
 		fmt.Fprintln(os.Stderr, err)
@@ -859,6 +1013,16 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 			return loadConfigError(err)
 		}
 	}
+	if !cfg.SPV && len(cfg.SPVServices) > 0 {
+		err := errors.E("--spvservices requires --spv")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	cfg.spvServices, err = p2p.ParseServices(cfg.SPVServices)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
 
 	// Default to localhost listen addresses if no listeners were manually
 	// specified.  When the RPC server is configured to be disabled, remove all
@@ -948,10 +1112,132 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
+	// Client certificates can not be verified without server TLS.
+	if cfg.ClientCAFile != "" && cfg.DisableServerTLS {
+		str := "%s: the --clientcafile option may not be used with " +
+			"--noservertls"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return loadConfigError(err)
+	}
+
 	// Expand environment variable and leading ~ for filepaths.
 	cfg.CAFile.Value = cleanAndExpandPath(cfg.CAFile.Value)
 	cfg.RPCCert.Value = cleanAndExpandPath(cfg.RPCCert.Value)
 	cfg.RPCKey.Value = cleanAndExpandPath(cfg.RPCKey.Value)
+	cfg.RPCSignKey.Value = cleanAndExpandPath(cfg.RPCSignKey.Value)
+	if cfg.ClientCAFile != "" {
+		cfg.ClientCAFile = cleanAndExpandPath(cfg.ClientCAFile)
+	}
+	if cfg.AuditLog != "" {
+		if cfg.AuditLogKey == "" {
+			str := "%s: the --auditlog option requires --auditlogkey"
+			err := errors.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return loadConfigError(err)
+		}
+		cfg.AuditLog = cleanAndExpandPath(cfg.AuditLog)
+		cfg.AuditLogKey = cleanAndExpandPath(cfg.AuditLogKey)
+	}
+	if cfg.VoteCoordinationDir != "" {
+		cfg.VoteCoordinationDir = cleanAndExpandPath(cfg.VoteCoordinationDir)
+	}
+
+	// The keyring passphrase unlocks the wallet opened at startup, replacing
+	// the plain-text pass setting.
+	if cfg.KeyringPass {
+		if cfg.Pass != "" || cfg.NoInitialLoad {
+			err := errors.Errorf("%s: the --keyringpass option may not be "+
+				"used with --pass or --noinitialload", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+	}
+
+	// The remote signer client and daemon are authenticated by TLS
+	// certificates in both directions.
+	if cfg.RemoteSigner != "" {
+		if cfg.RemoteSignerCA == "" || cfg.RemoteSignerCert == "" || cfg.RemoteSignerKey == "" {
+			err := errors.Errorf("%s: the --remotesigner option requires "+
+				"--remotesignerca, --remotesignercert, and --remotesignerkey",
+				funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		if len(cfg.SignerListeners) != 0 {
+			err := errors.Errorf("%s: the --remotesigner and --signerlisten "+
+				"options may not be used together", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.RemoteSignerCA = cleanAndExpandPath(cfg.RemoteSignerCA)
+		cfg.RemoteSignerCert = cleanAndExpandPath(cfg.RemoteSignerCert)
+		cfg.RemoteSignerKey = cleanAndExpandPath(cfg.RemoteSignerKey)
+	}
+	if cfg.PKCS11Module != "" {
+		if cfg.PKCS11Token == "" {
+			err := errors.Errorf("%s: the --pkcs11module option requires "+
+				"--pkcs11token", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.PKCS11Module = cleanAndExpandPath(cfg.PKCS11Module)
+	}
+	if cfg.VoteClaimLease <= 0 {
+		err := errors.Errorf("%s: --voteclaimlease must be positive", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.VSPMaxFee.Amount < 0 {
+		err := errors.Errorf("%s: --vspmaxfee may not be negative", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.VSP != "" {
+		pubKey, err := base64.StdEncoding.DecodeString(cfg.VSPPubKey)
+		if err != nil || len(pubKey) != ed25519.PublicKeySize {
+			err := errors.Errorf("%s: the --vsp option requires --vsppubkey "+
+				"set to a base64 encoded Ed25519 public key", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.vspPubKey = pubKey
+	}
+	if cfg.MissedVoteWebhook != "" {
+		u, err := url.Parse(cfg.MissedVoteWebhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			err := errors.Errorf("%s: --missedvotewebhook must be an http "+
+				"or https URL", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+	}
+	if (cfg.VSP != "" || cfg.TBOpts.UseVSP) && cfg.PoolAddress.Address != nil {
+		err := errors.Errorf("%s: VSP tickets may not be purchased with "+
+			"--pooladdress", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.TBOpts.UseVSP && cfg.TBOpts.VotingAddress.Address != nil {
+		err := errors.Errorf("%s: the --ticketbuyer.usevsp and "+
+			"--ticketbuyer.votingaddress options may not be used together",
+			funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if len(cfg.SignerListeners) != 0 {
+		if cfg.SignerClientCA == "" || cfg.DisableServerTLS || cfg.OneTimeTLSKey {
+			err := errors.Errorf("%s: the --signerlisten option requires "+
+				"--signerclientca and a persistent server TLS key "+
+				"(--noservertls and --onetimetlskey may not be used)",
+				funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.SignerClientCA = cleanAndExpandPath(cfg.SignerClientCA)
+	}
 
 	// If the vhcd username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for vhcd and
@@ -984,6 +1270,29 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
+	// Voting addresses are derived from the cold voting extended public key
+	// only when no fixed voting address is configured.
+	if cfg.TBOpts.VotingXpub != "" {
+		if votingAddress != nil || cfg.TBOpts.UseVSP {
+			err := errors.Errorf("%s: the --ticketbuyer.votingxpub option "+
+				"may not be used with --ticketbuyer.votingaddress or "+
+				"--ticketbuyer.usevsp", funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		xpub, err := hdkeychain.NewKeyFromString(cfg.TBOpts.VotingXpub)
+		if err == nil && (xpub.IsPrivate() || !xpub.IsForNet(activeNet.Params)) {
+			err = errors.New("not an extended public key for the active network")
+		}
+		if err != nil {
+			err := errors.Errorf("%s: invalid --ticketbuyer.votingxpub: %v",
+				funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.votingXpub = xpub
+	}
+
 	// Warn if user still is still using --addridxscanlen
 	if cfg.AddrIdxScanLen != defaultGapLimit && cfg.GapLimit == defaultGapLimit {
 		log.Warnf("--addridxscanlen has been DEPRECATED.  Use " +
@@ -1009,6 +1318,8 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		MaxPriceAbsolute:          int64(cfg.TBOpts.MaxPriceAbsolute.Amount),
 		MaxPriceRelative:          cfg.TBOpts.MaxPriceRelative,
 		MaxInMempool:              cfg.TBOpts.MaxInMempool,
+		MaxSpend:                  int64(cfg.TBOpts.MaxSpend.Amount),
+		SpendWindow:               cfg.TBOpts.SpendWindow,
 		PoolAddress:               cfg.PoolAddress.Address,
 		PoolFees:                  cfg.PoolFees,
 		NoSpreadTicketPurchases:   cfg.TBOpts.NoSpreadTicketPurchases,
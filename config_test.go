@@ -0,0 +1,36 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/internal/cfgutil"
+)
+
+func TestLegacyRPCOnlyOption(t *testing.T) {
+	tests := []struct {
+		name string
+		set  func(*config)
+		opt  string
+	}{
+		{"none", func(*config) {}, ""},
+		{"send cap", func(c *config) { c.GuardSendCap = cfgutil.NewAmountFlag(1e8) }, ""},
+		{"audit log", func(c *config) { c.AuditLog = "audit.log" }, "--auditlog"},
+		{"passphrase failures", func(c *config) { c.GuardPassFailures = 3 }, "--guardpassphrasefailures"},
+		{"origins", func(c *config) { c.GuardOrigins = []string{"10.0.0.0/8"} }, "--guardorigin"},
+		{"send approval", func(c *config) { c.RequireSendApproval = true }, "--requiresendapproval"},
+		{"approver", func(c *config) { c.ApproverUsername = "approver" }, "--approverusername"},
+		{"users", func(c *config) { c.RPCUsers = []string{"u:p:readonly"} }, "--rpcuser"},
+		{"cert roles", func(c *config) { c.ClientCertRoles = []string{"cn:admin"} }, "--clientcertrole"},
+	}
+	for _, test := range tests {
+		cfg := config{GuardSendCap: cfgutil.NewAmountFlag(0)}
+		test.set(&cfg)
+		if opt := legacyRPCOnlyOption(&cfg); opt != test.opt {
+			t.Errorf("%s: got option %q, want %q", test.name, opt, test.opt)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package features provides flags which enable or disable wallet subsystems
+// for a deployment.  New subsystems may be registered disabled by default and
+// enabled by operators once they are ready to use them.
+package features
+
+import (
+	"sort"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// Flag names a subsystem which may be enabled or disabled.
+type Flag string
+
+// Feature flags.
+const (
+	// SPV permits synchronizing the wallet using simplified payment
+	// verification.
+	SPV Flag = "spv"
+
+	// GRPC permits serving the gRPC API.
+	GRPC Flag = "grpc"
+
+	// Notifications permits websocket clients of the legacy JSON-RPC
+	// server to subscribe to wallet notifications.
+	Notifications Flag = "notifications"
+
+	// REST permits serving the REST gateway of the legacy JSON-RPC
+	// server.
+	REST Flag = "rest"
+)
+
+type flagInfo struct {
+	description string
+	enabled     bool // Default
+}
+
+var flags = map[Flag]flagInfo{
+	SPV:           {"Simplified payment verification sync", true},
+	GRPC:          {"gRPC API server", true},
+	Notifications: {"Legacy JSON-RPC websocket notifications", true},
+	REST:          {"REST gateway of the legacy JSON-RPC server", false},
+}
+
+// Set records whether each feature flag is enabled.  The zero value and a nil
+// Set use the default of every flag.
+type Set struct {
+	enabled map[Flag]bool
+}
+
+// NewSet creates a Set from the defaults of every flag, enabling and then
+// disabling the named flags.  Unknown flag names are errors.
+func NewSet(enable, disable []string) (*Set, error) {
+	const op errors.Op = "features.NewSet"
+	s := &Set{enabled: make(map[Flag]bool, len(flags))}
+	for f, info := range flags {
+		s.enabled[f] = info.enabled
+	}
+	for _, names := range [...]struct {
+		names   []string
+		enabled bool
+	}{{enable, true}, {disable, false}} {
+		for _, name := range names.names {
+			f := Flag(name)
+			if _, ok := flags[f]; !ok {
+				return nil, errors.E(op, errors.Invalid,
+					errors.Errorf("unknown feature flag %q", name))
+			}
+			s.enabled[f] = names.enabled
+		}
+	}
+	return s, nil
+}
+
+// Enabled returns whether the flag is enabled.
+func (s *Set) Enabled(f Flag) bool {
+	if s != nil {
+		if enabled, ok := s.enabled[f]; ok {
+			return enabled
+		}
+	}
+	return flags[f].enabled
+}
+
+// Status describes a feature flag and whether it is enabled.
+type Status struct {
+	Flag        Flag
+	Description string
+	Enabled     bool
+}
+
+// Statuses returns the status of every flag, sorted by name.
+func (s *Set) Statuses() []Status {
+	statuses := make([]Status, 0, len(flags))
+	for f, info := range flags {
+		statuses = append(statuses, Status{
+			Flag:        f,
+			Description: info.description,
+			Enabled:     s.Enabled(f),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Flag < statuses[j].Flag
+	})
+	return statuses
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package keyring stores and retrieves secrets using the keyring of the
+// operating system: the Secret Service (through the secret-tool command) on
+// Linux and other Unix systems, the Keychain (through the security command) on
+// macOS, and DPAPI-encrypted files of the user profile on Windows.
+//
+// Secrets are identified by a service name and a user name.  Failures to find
+// a secret are reported with the errors.NotExist kind.
+package keyring
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// Get retrieves the secret of a service and user from the OS keyring.
+func Get(service, user string) ([]byte, error) {
+	const op errors.Op = "keyring.Get"
+	secret, err := get(service, user)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return secret, nil
+}
+
+// Set stores the secret of a service and user in the OS keyring, replacing any
+// previous secret.
+func Set(service, user string, secret []byte) error {
+	const op errors.Op = "keyring.Set"
+	err := set(service, user, secret)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// commandError describes a failed keyring command, including its standard
+// error.  The error of running the command is kept so that its exit status
+// may be inspected with exitStatus.
+type commandError struct {
+	name   string
+	err    error
+	stderr []byte
+}
+
+func (e *commandError) Error() string {
+	if len(e.stderr) == 0 {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("%s: %v: %s", e.name, e.err, e.stderr)
+}
+
+// exitStatus returns the exit status of a keyring command which ran and
+// exited unsuccessfully.  The boolean is false for commands which failed to
+// start or did not exit normally.
+func exitStatus(err error) (int, bool) {
+	if e, ok := err.(*commandError); ok {
+		err = e.err
+	}
+	e, ok := err.(*exec.ExitError)
+	if !ok || e.ExitCode() < 0 {
+		return 0, false
+	}
+	return e.ExitCode(), true
+}
+
+// run runs a keyring command, writing stdin to its standard input and
+// returning its standard output.  The error describes the standard error of
+// failed commands.
+func run(cmd *exec.Cmd, stdin []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, &commandError{
+			name:   cmd.Args[0],
+			err:    err,
+			stderr: bytes.TrimSpace(stderr.Bytes()),
+		}
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package keyring
+
+import (
+	"bytes"
+	"os/exec"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// errSecItemNotFound is the exit status of the security command when no
+// keychain item matches the search.
+const errSecItemNotFound = 44
+
+func get(service, user string) ([]byte, error) {
+	cmd := exec.Command("security", "find-generic-password", "-s", service, "-a", user, "-w")
+	secret, err := run(cmd, nil)
+	if status, ok := exitStatus(err); ok && status == errSecItemNotFound {
+		return nil, errors.E(errors.NotExist, "secret not found in keychain")
+	}
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return bytes.TrimSuffix(secret, []byte("\n")), nil
+}
+
+func set(service, user string, secret []byte) error {
+	// The security command reads the password from the terminal when -w
+	// is the final argument; it is instead passed through the interactive
+	// mode command reader to keep it out of the process arguments.
+	var script bytes.Buffer
+	script.WriteString("add-generic-password -U -s ")
+	script.WriteString(quote(service))
+	script.WriteString(" -a ")
+	script.WriteString(quote(user))
+	script.WriteString(" -w ")
+	script.WriteString(quote(string(secret)))
+	script.WriteString("\n")
+	_, err := run(exec.Command("security", "-i"), script.Bytes())
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// quote quotes an argument of a security interactive mode command.
+func quote(s string) string {
+	var b bytes.Buffer
+	b.WriteByte('"')
+	for _, c := range []byte(s) {
+		if c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package keyring
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// fakeSecurity fails to find any keychain item the way the security command
+// does, describing the failure on its standard error.
+const fakeSecurity = `#!/bin/sh
+echo "security: SecKeychainSearchCopyNext: The specified item could not be found in the keychain." >&2
+exit 44
+`
+
+func TestSecurityNotFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = ioutil.WriteFile(filepath.Join(dir, "security"), []byte(fakeSecurity), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir)
+
+	if _, err := Get("vhcwallet", "user"); !errors.Is(errors.NotExist, err) {
+		t.Fatalf("get missing secret: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !windows
+// +build !windows
+
+package keyring
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestRunExitStatus(t *testing.T) {
+	// The exit status of failed commands is kept when they describe the
+	// failure on their standard error.
+	cmd := exec.Command("sh", "-c", "echo 'item not found' >&2; exit 44")
+	_, err := run(cmd, nil)
+	if err == nil {
+		t.Fatal("failed command succeeded")
+	}
+	if status, ok := exitStatus(err); !ok || status != 44 {
+		t.Fatalf("exit status %d %v of error %v", status, ok, err)
+	}
+	if !strings.Contains(err.Error(), "item not found") {
+		t.Fatalf("error %q does not describe standard error", err)
+	}
+
+	cmd = exec.Command("sh", "-c", "exit 1")
+	_, err = run(cmd, nil)
+	if status, ok := exitStatus(err); !ok || status != 1 {
+		t.Fatalf("exit status %d %v of error %v", status, ok, err)
+	}
+
+	// Commands which can not be started have no exit status.
+	_, err = run(exec.Command("/nonexistent/keyring-command"), nil)
+	if _, ok := exitStatus(err); ok || err == nil {
+		t.Fatalf("exit status of command which was not started: %v", err)
+	}
+}
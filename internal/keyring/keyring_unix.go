@@ -0,0 +1,39 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !darwin && !windows
+// +build !darwin,!windows
+
+package keyring
+
+import (
+	"bytes"
+	"os/exec"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func get(service, user string) ([]byte, error) {
+	cmd := exec.Command("secret-tool", "lookup", "service", service, "account", user)
+	secret, err := run(cmd, nil)
+	if _, ok := exitStatus(err); ok {
+		// secret-tool exits with status 1 and no message when no
+		// secret matches the attributes.
+		return nil, errors.E(errors.NotExist, "secret not found in keyring")
+	}
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return bytes.TrimSuffix(secret, []byte("\n")), nil
+}
+
+func set(service, user string, secret []byte) error {
+	cmd := exec.Command("secret-tool", "store", "--label="+service+" "+user,
+		"service", service, "account", user)
+	_, err := run(cmd, secret)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
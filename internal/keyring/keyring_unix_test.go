@@ -0,0 +1,56 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !darwin && !windows
+// +build !darwin,!windows
+
+package keyring
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// fakeSecretTool stores a single secret in a file of the directory formatted
+// into the script.
+const fakeSecretTool = `#!/bin/sh
+case "$1" in
+lookup) [ -f %[1]q ] || exit 1; read -r s < %[1]q; printf %%s "$s" ;;
+store) read -r s; printf %%s "$s" > %[1]q ;;
+esac
+`
+
+func TestSecretTool(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := fmt.Sprintf(fakeSecretTool, filepath.Join(dir, "secret"))
+	err = ioutil.WriteFile(filepath.Join(dir, "secret-tool"), []byte(script), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir)
+
+	if _, err := Get("vhcwallet", "user"); !errors.Is(errors.NotExist, err) {
+		t.Fatalf("get missing secret: %v", err)
+	}
+	if err := Set("vhcwallet", "user", []byte("passphrase")); err != nil {
+		t.Fatal(err)
+	}
+	secret, err := Get("vhcwallet", "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(secret) != "passphrase" {
+		t.Fatalf("got secret %q", secret)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package keyring
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+var (
+	crypt32                = syscall.NewLazyDLL("crypt32.dll")
+	kernel32               = syscall.NewLazyDLL("kernel32.dll")
+	procCryptProtectData   = crypt32.NewProc("CryptProtectData")
+	procCryptUnprotectData = crypt32.NewProc("CryptUnprotectData")
+	procLocalFree          = kernel32.NewProc("LocalFree")
+)
+
+// cryptProtectUIForbidden is the CRYPTPROTECT_UI_FORBIDDEN flag.
+const cryptProtectUIForbidden = 0x1
+
+// dataBlob is the DATA_BLOB structure.
+type dataBlob struct {
+	cbData uint32
+	pbData *byte
+}
+
+func newBlob(b []byte) *dataBlob {
+	if len(b) == 0 {
+		return &dataBlob{}
+	}
+	return &dataBlob{cbData: uint32(len(b)), pbData: &b[0]}
+}
+
+// bytes copies the blob allocated by DPAPI and frees it.
+func (b *dataBlob) bytes() []byte {
+	defer procLocalFree.Call(uintptr(unsafe.Pointer(b.pbData)))
+	out := make([]byte, b.cbData)
+	copy(out, (*[1 << 30]byte)(unsafe.Pointer(b.pbData))[:b.cbData:b.cbData])
+	return out
+}
+
+// secretPath returns the path of the DPAPI-encrypted file of a secret in the
+// roaming application data directory of the user.  Files are named by the hash
+// of the user name, which may not be a valid file name.
+func secretPath(service, user string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256([]byte(user))
+	name := hex.EncodeToString(h[:16]) + ".dpapi"
+	return filepath.Join(dir, service, "keyring", name), nil
+}
+
+func get(service, user string) ([]byte, error) {
+	path, err := secretPath(service, user)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	encrypted, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errors.E(errors.NotExist, "secret not found in keyring")
+	}
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	var out dataBlob
+	r, _, err := procCryptUnprotectData.Call(uintptr(unsafe.Pointer(newBlob(encrypted))),
+		0, 0, 0, 0, cryptProtectUIForbidden, uintptr(unsafe.Pointer(&out)))
+	if r == 0 {
+		return nil, errors.E(errors.Crypto, errors.Errorf("CryptUnprotectData: %v", err))
+	}
+	return out.bytes(), nil
+}
+
+func set(service, user string, secret []byte) error {
+	path, err := secretPath(service, user)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	var out dataBlob
+	r, _, err := procCryptProtectData.Call(uintptr(unsafe.Pointer(newBlob(secret))),
+		0, 0, 0, 0, cryptProtectUIForbidden, uintptr(unsafe.Pointer(&out)))
+	if r == 0 {
+		return errors.E(errors.Crypto, errors.Errorf("CryptProtectData: %v", err))
+	}
+	encrypted := out.bytes()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if err := ioutil.WriteFile(path, encrypted, 0600); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/valhallacoin/vhcd/hdkeychain"
@@ -338,6 +339,34 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 	}
 }
 
+// Birthday prompts the user for the date a restored wallet seed was created.
+// Blocks mined before the birthday are not rescanned for wallet transactions.
+// The zero time is returned when the user does not know the birthday.
+func Birthday(reader *bufio.Reader) (time.Time, error) {
+	for {
+		fmt.Print("Enter the date the seed was created (YYYY-MM-DD), " +
+			"or leave blank to rescan the entire block chain: ")
+		reply, err := reader.ReadString('\n')
+		if err != nil {
+			return time.Time{}, err
+		}
+		reply = strings.TrimSpace(reply)
+		if reply == "" {
+			return time.Time{}, nil
+		}
+		birthday, err := time.Parse("2006-01-02", reply)
+		if err != nil {
+			fmt.Printf("Invalid date: %v\n", err)
+			continue
+		}
+		if birthday.After(time.Now()) {
+			fmt.Println("The birthday may not be in the future")
+			continue
+		}
+		return birthday, nil
+	}
+}
+
 // Setup prompts for, from a buffered reader, the private and/or public
 // encryption passphrases to secure a wallet and a previously derived wallet
 // seed to use, if any.  privPass and pubPass will always be non-nil values
@@ -3,11 +3,13 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-//+build generate
+//go:build generate
+// +build generate
 
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -73,6 +75,18 @@ func writeUsage() {
 	writefln("var requestUsages = %q", usages)
 }
 
+func writeAPISchema(descs map[string]string) {
+	schemas, err := rpchelp.GenerateMethodSchemas(descs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	b, err := json.Marshal(schemas)
+	if err != nil {
+		log.Fatal(err)
+	}
+	writefln("var apiSchemaMethods = %q", b)
+}
+
 func main() {
 	defer outputFile.Close()
 
@@ -92,4 +106,6 @@ func main() {
 	writeLocales()
 	writefln("")
 	writeUsage()
+	writefln("")
+	writeAPISchema(rpchelp.HelpDescs[0].Descs)
 }
@@ -3,7 +3,8 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-//+build !generate
+//go:build !generate
+// +build !generate
 
 package rpchelp
 
@@ -27,6 +28,185 @@ var helpDescsEnUS = map[string]string{
 	"addmultisigaddress-nrequired": "The number of signatures required to redeem outputs paid to this address",
 	"addmultisigaddress--result0":  "The imported pay-to-script-hash address",
 
+	// ClearGuardCmd help.
+	"clearguard--synopsis":    "Clears the triggered state of the anomaly guard after its alerts are reviewed, permitting the wallet to be unlocked again.",
+	"clearguard-trustorigins": "Trust the client addresses of origin alerts, so their requests no longer trigger the guard",
+
+	// ClearUnlockSessionCmd help.
+	"clearunlocksession--synopsis": "Removes the cached key derived from the private passphrase so that the next unlock performs the full key derivation. The lock state of the wallet is not changed.",
+
+	// DecodeRawTransactionCmd help.
+	"decoderawtransaction--synopsis": "Returns a JSON object representing the provided serialized, hex-encoded transaction.\n" +
+		"The transaction is decoded by the wallet and does not require a connection to a consensus server.",
+	"decoderawtransaction-hextx": "Serialized, hex-encoded transaction",
+
+	// TxRawDecodeResult help.
+	"txrawdecoderesult-txid":     "The hash of the transaction",
+	"txrawdecoderesult-version":  "The transaction version",
+	"txrawdecoderesult-locktime": "The transaction lock time",
+	"txrawdecoderesult-expiry":   "The height at which the transaction expires and can no longer be mined",
+	"txrawdecoderesult-vin":      "The transaction inputs as JSON objects",
+	"txrawdecoderesult-vout":     "The transaction outputs as JSON objects",
+
+	// Vin help.
+	"vin-coinbase":    "The hex-encoded bytes of the signature script (coinbase txns only)",
+	"vin-stakebase":   "The hex-encoded bytes of the signature script (vote txns only)",
+	"vin-txid":        "The hash of the origin transaction (non-coinbase txns only)",
+	"vin-vout":        "The index of the output being redeemed from the origin transaction (non-coinbase txns only)",
+	"vin-tree":        "The transaction tree of the origin transaction (non-coinbase txns only)",
+	"vin-sequence":    "The script sequence number",
+	"vin-amountin":    "The amount (in VHC) of the input as committed by the transaction",
+	"vin-blockheight": "The height of the block that includes the origin transaction as committed by the transaction",
+	"vin-blockindex":  "The index of the origin transaction in its block as committed by the transaction",
+	"vin-scriptSig":   "The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)",
+
+	// ScriptSig help.
+	"scriptsig-asm": "Disassembly of the script",
+	"scriptsig-hex": "Hex-encoded bytes of the script",
+
+	// Vout help.
+	"vout-value":        "The amount in VHC",
+	"vout-n":            "The index of this transaction output",
+	"vout-version":      "The version of the public key script",
+	"vout-scriptPubKey": "The public key script used to pay coins as a JSON object",
+
+	// ScriptPubKeyResult help.
+	"scriptpubkeyresult-asm":       "Disassembly of the script",
+	"scriptpubkeyresult-hex":       "Hex-encoded bytes of the script",
+	"scriptpubkeyresult-reqSigs":   "The number of required signatures",
+	"scriptpubkeyresult-type":      "The type of the script (e.g. 'pubkeyhash')",
+	"scriptpubkeyresult-addresses": "The addresses associated with this script",
+	"scriptpubkeyresult-commitamt": "The ticket commitment value if the script is for a ticket commitment",
+
+	// DecodeScriptCmd help.
+	"decodescript--synopsis": "Returns a JSON object with information about the provided hex-encoded script.\n" +
+		"The script is decoded by the wallet and does not require a connection to a consensus server.",
+	"decodescript-hexscript": "Hex-encoded script",
+
+	// DecodeScriptResult help.
+	"decodescriptresult-asm":       "Disassembly of the script",
+	"decodescriptresult-reqSigs":   "The number of required signatures",
+	"decodescriptresult-type":      "The type of the script (e.g. 'pubkeyhash')",
+	"decodescriptresult-addresses": "The addresses associated with this script",
+	"decodescriptresult-p2sh":      "The script hash for use in pay-to-script-hash transactions (only present if the provided redeem script is not already a pay-to-script-hash script)",
+	"decodescriptresult-ismine":    "Whether the wallet owns any of the addresses or the pay-to-script-hash address of the script",
+
+	// DeletePrivKeyCmd help.
+	"deleteprivkey--synopsis": "Removes an imported private or public key from the wallet.\n" +
+		"The address of the key remains watched for transactions until the wallet is restarted.",
+	"deleteprivkey-address":     "The P2PKH address or hex encoded public key of the imported key",
+	"deleteprivkey-drophistory": "Also remove the unspent outputs paying the address from the wallet",
+	"deleteprivkey--result0":    "The number of unspent outputs removed",
+
+	// DeleteScriptCmd help.
+	"deletescript--synopsis": "Removes an imported redeem script and the record of its import from the wallet.\n" +
+		"The P2SH address of the script remains watched for transactions until the wallet is restarted.",
+	"deletescript-script":      "The P2SH address or hex encoded redeem script of the imported script",
+	"deletescript-drophistory": "Also remove the unspent outputs paying the P2SH address from the wallet",
+	"deletescript--result0":    "The number of unspent outputs removed",
+
+	// DisableVotingCmd help.
+	"disablevoting--synopsis": "Stops the wallet from voting winning tickets and revoking missed tickets until voting is enabled again.\n" +
+		"The configured enablevoting option is used when the wallet is next started.",
+
+	// EnableVotingCmd help.
+	"enablevoting--synopsis": "Starts voting winning tickets and revoking missed tickets owned by the wallet, allowing voting to fail over between wallets without a restart.\n" +
+		"The wallet must remain unlocked to vote.  The configured enablevoting option is used when the wallet is next started.",
+
+	// CreateCosignSessionCmd help.
+	"createcosignsession--synopsis": "Begins a session for an unsigned transaction spending P2SH multisig outputs recorded by the wallet.\n" +
+		"The returned session is passed to the cosigners, which add their signatures with signcosignsession.  Sessions signed by different cosigners may be combined with mergecosignsessions.  Once every input has the required signatures, any cosigner creates the signed transaction with finalizecosignsession.",
+	"createcosignsession-hextx": "The hex encoded unsigned transaction",
+
+	// CosignSessionResult help.
+	"cosignsessionresult-session":    "The JSON-encoded session passed between the cosigners",
+	"cosignsessionresult-signatures": "The number of signatures collected for each input",
+	"cosignsessionresult-required":   "The number of signatures required by each input",
+	"cosignsessionresult-complete":   "Whether every input has the required signatures",
+	"cosignsessionresult-added":      "The number of signatures added by the wallet, omitted when none were added",
+
+	// CreateOwnershipProofCmd help.
+	"createownershipproof--synopsis": "Creates a proof, signed by the private key of each address, that the wallet controls the addresses and the unspent outputs paying them as of a main chain block.\n" +
+		"The proof is verified with verifyownershipproof.",
+	"createownershipproof-addresses":   "The P2PKH addresses to prove ownership of",
+	"createownershipproof-challenge":   "A challenge chosen by the verifier which is included in the signed proof",
+	"createownershipproof-minbalance":  "The minimum total value (in VHC) of the claimed outputs; the proof is not created when the addresses hold less",
+	"createownershipproof-blockheight": "The height of the main chain block as of which outputs are claimed (default=the main chain tip)",
+
+	// OwnershipProof help.
+	"ownershipproof-challenge":   "The challenge chosen by the verifier",
+	"ownershipproof-blockhash":   "The hash of the main chain block as of which the outputs are unspent",
+	"ownershipproof-blockheight": "The height of the main chain block as of which the outputs are unspent",
+	"ownershipproof-addresses":   "The addresses and their signatures of the proof",
+	"ownershipproof-outputs":     "The unspent outputs paying the addresses",
+
+	// OwnershipProofAddress help.
+	"ownershipproofaddress-address":   "The address",
+	"ownershipproofaddress-signature": "The base64-encoded signature of the proof by the private key of the address",
+
+	// OwnershipProofOutput help.
+	"ownershipproofoutput-txid":    "The hash of the transaction of the output",
+	"ownershipproofoutput-vout":    "The output index",
+	"ownershipproofoutput-tree":    "The transaction tree of the output",
+	"ownershipproofoutput-amount":  "The value of the output in VHC",
+	"ownershipproofoutput-address": "The address paid by the output",
+
+	// CreateSplitTicketSessionCmd help.
+	"createsplitticketsession--synopsis": "Begins a session for a split ticket co-funded by several wallets at the next ticket price.\n" +
+		"The returned session is passed to each participant in turn to contribute funds with joinsplitticketsession.  Once the ticket price is fully funded, every participant signs the session with signsplitticketsession, and any participant publishes the ticket with publishsplitticketsession.\n" +
+		"Each participant's commitment receives its share of the vote reward in proportion to the amount it contributed.",
+	"createsplitticketsession-votingaddress": "The address given voting rights for the ticket; a new address of the default account is used when omitted",
+	"createsplitticketsession-expiry":        "Height at which the ticket expires; defaults to the end of the current ticket price interval",
+
+	// SplitTicketSessionResult help.
+	"splitticketsessionresult-session":     "The JSON-encoded session passed between the participants",
+	"splitticketsessionresult-ticketprice": "The price (in VHC) of the ticket",
+	"splitticketsessionresult-unfunded":    "The share of the ticket price (in VHC) not yet contributed by any participant",
+	"splitticketsessionresult-tickethash":  "The hash of the ticket, set once the session is signed",
+
+	// CreateUnsignedTicketsCmd help.
+	"createunsignedtickets--synopsis": "Performs the funding and split output construction of purchaseticket, returning the unsigned split transaction and tickets without signing or publishing them.\n" +
+		"This allows tickets to be inspected or signed externally before committing funds.  The tickets spend the outputs of the split transaction, whose hash is unchanged by signing.\n" +
+		"Addresses derived for change, voting, and ticket commitments are recorded, but the outputs spent by the split transaction are not locked.  Tickets are not registered with a VSP.",
+	"createunsignedtickets-fromaccount":   "The account to use for purchase",
+	"createunsignedtickets-spendlimit":    "Limit on the amount to spend on each ticket",
+	"createunsignedtickets-minconf":       "Minimum number of block confirmations required",
+	"createunsignedtickets-ticketaddress": "Override the ticket address to which voting rights are given",
+	"createunsignedtickets-numtickets":    "The number of tickets to create",
+	"createunsignedtickets-pooladdress":   "The address to pay stake pool fees to",
+	"createunsignedtickets-poolfees":      "The amount of fees to pay to the stake pool",
+	"createunsignedtickets-expiry":        "Height at which the tickets expire",
+	"createunsignedtickets-ticketfee":     "The transaction fee rate (VHC/kB) of the tickets",
+
+	// CreateUnsignedTicketsResult help.
+	"createunsignedticketsresult-splittx":     "The unsigned split transaction funding the tickets, hex-encoded",
+	"createunsignedticketsresult-tickets":     "The unsigned tickets, hex-encoded",
+	"createunsignedticketsresult-ticketprice": "The ticket price (in VHC) of each ticket",
+
+	// CreateWalletCmd help.
+	"createwallet--synopsis": "Creates and opens a new wallet when no wallet is loaded, such as when the server was started with the noinitialload option.\n" +
+		"The wallet is created with the insecure default public passphrase.\n" +
+		"When no seed is provided, a new random seed is generated and returned, and must be backed up to recover the wallet.",
+	"createwallet-passphrase": "The private passphrase protecting the private keys of the wallet",
+	"createwallet-seed":       "The seed of a restored wallet encoded as a hexadecimal string or mnemonic of PGP words, or unset to generate a new seed",
+	"createwallet-birthday":   "Unix time the seed was created, before which blocks are not rescanned for wallet transactions (defaults to the current time for generated seeds)",
+
+	// CreateWalletResult help.
+	"createwalletresult-seed":     "The generated seed encoded as a hexadecimal string, omitted when a seed was provided",
+	"createwalletresult-mnemonic": "The generated seed encoded as a mnemonic of PGP words, omitted when a seed was provided",
+
+	// CloseWalletCmd help.
+	"closewallet--synopsis": "Stops the loaded wallet and closes its database.\n" +
+		"Requests requiring a wallet fail until a wallet is opened with openwallet.",
+
+	// ApproveSendCmd help.
+	"approvesend--synopsis": "Approves a send queued by sendtoaddress, sendfrom, or sendmany, creating, signing, and publishing the transaction.\n" +
+		"Sends are queued for accounts requiring send approval, and for every account when the server requires send approval.\n" +
+		"A send may not be approved by the client which requested it, and when approver credentials are configured, this method must be called using them.",
+	"approvesend-id":         "The ID of the pending send",
+	"approvesend-passphrase": "The approval passphrase of the sending account, required when the account requires send approval",
+	"approvesend--result0":   "The transaction hash of the sent transaction",
+
 	// ConsolidateCmd help.
 	"consolidate--synopsis": "Consolidate n many UTXOs into a single output in the wallet.",
 	"consolidate-inputs":    "Number of UTXOs to consolidate as inputs",
@@ -34,6 +214,17 @@ var helpDescsEnUS = map[string]string{
 	"consolidate-address":   "Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.",
 	"consolidate--result0":  "Transaction hash for the consolidation transaction",
 
+	// ConsolidateAccountCmd help.
+	"consolidateaccount--synopsis": "Consolidates unspent outputs of an account into a single output.\n" +
+		"When a target size is set, only outputs of lower value are consolidated, smallest first, until the consolidated output reaches the target size.\n" +
+		"At least two outputs must be consolidated.",
+	"consolidateaccount-account":    "Account from which unspent outputs are consolidated, and which provides the output address when none is specified",
+	"consolidateaccount-maxinputs":  "Maximum number of outputs to consolidate, or 0 to be limited only by the maximum transaction size",
+	"consolidateaccount-targetsize": "Value of the consolidated output, or 0 to consolidate outputs of any value",
+	"consolidateaccount-feeperkb":   "Fee rate in coins per kB (default is the wallet's relay fee)",
+	"consolidateaccount-address":    "Address to pay (default is a new internal address of the account)",
+	"consolidateaccount--result0":   "Transaction hash of the consolidation transaction",
+
 	// CreateMultisigCmd help.
 	"createmultisig--synopsis": "Generate a multisig address and redeem script.",
 	"createmultisig-keys":      "Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address",
@@ -57,6 +248,30 @@ var helpDescsEnUS = map[string]string{
 	"generatevote-votebitsext": "The extended voteBits to set for the ticket",
 	"generatevoteresult-hex":   "The hex encoded transaction",
 
+	// FinalizeCosignSessionCmd help.
+	"finalizecosignsession--synopsis": "Creates the signed transaction of a cosigning session once every input has the required signatures, verifying each input.",
+	"finalizecosignsession-session":   "The JSON-encoded cosigning session",
+	"finalizecosignsession-publish":   "Publish the signed transaction",
+
+	// FinalizeCosignSessionResult help.
+	"finalizecosignsessionresult-hex":       "The hex encoded signed transaction",
+	"finalizecosignsessionresult-txhash":    "The hash of the signed transaction",
+	"finalizecosignsessionresult-published": "Whether the transaction was published",
+
+	// GenerateVotesCmd help.
+	"generatevotes--synopsis": "Returns vote transactions for several tickets on the same block, encoded as hexadecimal strings.\n" +
+		"Failing to create the vote of one ticket does not prevent votes from being created for the others.",
+	"generatevotes-blockhash":    "Block hash for the tickets",
+	"generatevotes-height":       "Block height for the tickets",
+	"generatevotes-tickethashes": "The hashes of the tickets",
+	"generatevotes-votebits":     "The voteBits to set for the tickets",
+	"generatevotes-votebitsext":  "The extended voteBits to set for the tickets",
+
+	// GenerateVotesResult help.
+	"generatevotesresult-tickethash": "The hash of the ticket",
+	"generatevotesresult-hex":        "The hex encoded vote transaction, omitted if the vote could not be created",
+	"generatevotesresult-error":      "The reason the vote could not be created, omitted on success",
+
 	// GetAccountCmd help.
 	"getaccount--synopsis": "DEPRECATED -- Lookup the account name that some wallet address belongs to.",
 	"getaccount-address":   "The address to query the account for",
@@ -74,9 +289,10 @@ var helpDescsEnUS = map[string]string{
 	"getaddressesbyaccount--result0":  "All addresses controlled by 'account'",
 
 	// GetBalanceCmd help.
-	"getbalance--synopsis": "Calculates and returns the balance of all accounts.",
-	"getbalance-minconf":   "Minimum number of block confirmations required before an unspent output's value is included in the balance",
-	"getbalance-account":   "DEPRECATED -- The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")",
+	"getbalance--synopsis": "Calculates and returns the balance of all accounts.\n" +
+		"Clients which selected API version 4 receive only the spendable balance, as a number.",
+	"getbalance-minconf": "Minimum number of block confirmations required before an unspent output's value is included in the balance",
+	"getbalance-account": "DEPRECATED -- The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")",
 
 	"getbalanceresult-balances":                       "Balances for all accounts.",
 	"getaccountbalanceresult-accountname":             "Name of account.",
@@ -107,6 +323,23 @@ var helpDescsEnUS = map[string]string{
 	// GetInfoCmd help.
 	"getinfo--synopsis": "Returns a JSON object containing various state info.",
 
+	// GetPeerInfoCmd help.
+	"getpeerinfo--synopsis": "Returns data about each peer the wallet synchronizes with using SPV, including the capabilities negotiated with the peer.\n" +
+		"When the wallet is synchronized using the consensus RPC server, the request is passed through to the server and its peers are returned instead.",
+
+	// GetPeerInfoResult help.
+	"getpeerinforesult-id":              "A unique identifier of the peer, increasing with each connection",
+	"getpeerinforesult-addr":            "The remote IP address and port of the peer",
+	"getpeerinforesult-services":        "Service flags advertised by the peer, encoded as in the consensus RPC server's getpeerinfo result",
+	"getpeerinforesult-servicenames":    "Names of the service flags advertised by the peer",
+	"getpeerinforesult-version":         "The protocol version advertised by the peer",
+	"getpeerinforesult-protocolversion": "The protocol version negotiated with the peer",
+	"getpeerinforesult-subver":          "The user agent of the peer",
+	"getpeerinforesult-conntime":        "Unix time the connection to the peer was established",
+	"getpeerinforesult-startingheight":  "The block height the peer advertised when connecting",
+	"getpeerinforesult-banscore":        "The ban score of the peer",
+	"getpeerinforesult-capabilities":    "Optional protocol features supported by the peer (cfilters, sendheaders, feefilter)",
+
 	// GetMasterPubkey help.
 	"getmasterpubkey--synopsis": "Requests the master pubkey from the wallet.",
 	"getmasterpubkey-account":   "The account to get the master pubkey for",
@@ -130,8 +363,78 @@ var helpDescsEnUS = map[string]string{
 	"getmultisigoutinforesult-redeemscript": "Hex of the redeeming script.",
 	"getmultisigoutinforesult-address":      "Script address.",
 
+	// GetRescanStatusCmd help.
+	"getrescanstatus--synopsis": "Reports the progress of the current or most recent rescan of the wallet.\n" +
+		"Returns null if the wallet has not been rescanned since it was opened.",
+
+	// GetRescanStatusResult help.
+	"getrescanstatusresult-rescanning":          "Whether the rescan is in progress",
+	"getrescanstatusresult-startheight":         "The height of the first block scanned",
+	"getrescanstatusresult-targetheight":        "The height of the main chain tip the rescan is scanning through",
+	"getrescanstatusresult-scannedthrough":      "The height of the last block scanned",
+	"getrescanstatusresult-percent":             "The percentage of blocks through the target height which have been scanned",
+	"getrescanstatusresult-addressesdiscovered": "The number of distinct wallet addresses paid by transactions found by the rescan",
+	"getrescanstatusresult-started":             "The Unix time the rescan started",
+	"getrescanstatusresult-error":               "The error which ended the rescan, omitted if the rescan is in progress or completed successfully",
+
+	// GetResponseSigningKeyCmd help.
+	"getresponsesigningkey--synopsis": "Returns the public key which signs the responses of selected methods and the names of those methods.\n" +
+		"The result of a signed method is replaced by an object with the keys payload, signature, and pubkey.\n" +
+		"The payload is a JSON string encoding an object with the method, id, time, and result of the request, and the signature is a DER encoded secp256k1 ECDSA signature of the SHA-256 hash of the payload.\n" +
+		"The key should be pinned by clients out of band rather than trusted from this method.",
+
+	// GetResponseSigningKeyResult help.
+	"getresponsesigningkeyresult-pubkey":  "Hex encoded compressed secp256k1 public key which signs responses",
+	"getresponsesigningkeyresult-methods": "Methods whose responses are signed",
+
+	// GetSpendingPolicyCmd help.
+	"getspendingpolicy--synopsis": "Returns the spending limits of an account and the amount sent from it during the current UTC day.",
+	"getspendingpolicy-account":   "Name of the account",
+
+	// GetSpendingPolicyResult help.
+	"getspendingpolicyresult-account":        "Name of the account.",
+	"getspendingpolicyresult-txlimit":        "Maximum amount which may be sent by a single transaction (0 when unlimited).",
+	"getspendingpolicyresult-dailylimit":     "Maximum total amount which may be sent during a UTC day (0 when unlimited).",
+	"getspendingpolicyresult-dailyspent":     "Total amount sent during the current UTC day.",
+	"getspendingpolicyresult-dailyremaining": "Amount which may still be sent during the current UTC day without exceeding the daily limit.",
+	"getspendingpolicyresult-overridable":    "Whether the limits may be exceeded after providing an override passphrase.",
+	"getspendingpolicyresult-overridden":     "Whether the limits are currently overridden.",
+
+	// GetStakingStatsCmd help.
+	"getstakingstats--synopsis": "Returns the tickets bought, votes, and revocations of the wallet mined in blocks within a time range, with the rewards earned, fees paid, and annualized return.",
+	"getstakingstats-starttime": "Unix time beginning the range (defaults to the wallet's first mined stake transaction)",
+	"getstakingstats-endtime":   "Unix time ending the range, exclusive (defaults to the current time)",
+
+	// GetStakingStatsResult help.
+	"getstakingstatsresult-starttime":        "Unix time beginning the range",
+	"getstakingstatsresult-endtime":          "Unix time ending the range",
+	"getstakingstatsresult-ticketsbought":    "Number of tickets bought by the wallet",
+	"getstakingstatsresult-ticketcost":       "Total price of the tickets bought by the wallet",
+	"getstakingstatsresult-votes":            "Number of votes",
+	"getstakingstatsresult-voterewards":      "Total stakebase rewards earned by votes",
+	"getstakingstatsresult-revocations":      "Number of revocations",
+	"getstakingstatsresult-fees":             "Total fees paid by tickets bought by the wallet, votes, and revocations",
+	"getstakingstatsresult-annualizedreturn": "Vote rewards less fees as a fraction of the ticket cost, scaled to a year",
+
+	// GetSyncStatusCmd help.
+	"getsyncstatus--synopsis": "Reports the progress of the wallet's synchronization with its network backend (vhcd or SPV peers), for displaying the progress of the initial sync.",
+
+	// GetSyncStatusResult help.
+	"getsyncstatusresult-synced":             "Whether the initial synchronization with the network backend is complete",
+	"getsyncstatusresult-tiphash":            "The hash of the wallet's main chain tip block",
+	"getsyncstatusresult-tipheight":          "The height of the wallet's main chain tip block",
+	"getsyncstatusresult-backendheight":      "The best block height known by the network backend, or 0 if not yet known",
+	"getsyncstatusresult-headersfetched":     "The number of new block headers fetched since the network backend connected",
+	"getsyncstatusresult-cfiltersfetched":    "The number of compact filters fetched since the network backend connected",
+	"getsyncstatusresult-rescanpoint":        "The hash of the first block not yet scanned for wallet transactions, omitted when every block has been scanned",
+	"getsyncstatusresult-rescanpointheight":  "The height of the rescan point block",
+	"getsyncstatusresult-rescanning":         "Whether a rescan is in progress",
+	"getsyncstatusresult-rescannedthrough":   "The last block height scanned by the current or most recent rescan",
+	"getsyncstatusresult-estimatedremaining": "Estimated seconds remaining until the wallet is synchronized, or 0 when synchronized or no estimate is available",
+
 	// GetStakeInfo help.
-	"getstakeinfo--synopsis": "Returns statistics about staking from the wallet.",
+	"getstakeinfo--synopsis": "Returns statistics about staking from the wallet.\n" +
+		"Without an RPC connection to vhcd (e.g. in SPV mode), ticket states are determined from the wallet's view of the main chain: tickets revoked before expiry are missed, tickets detected as missed votes are missed until revoked, and other mature unspent tickets are live until expiry. The allmempooltix field is zero in this mode.",
 
 	// GetStakeInfoResult help.
 	"getstakeinforesult-blockheight":      "Current block height for stake info.",
@@ -152,9 +455,27 @@ var helpDescsEnUS = map[string]string{
 	"getstakeinforesult-unspentexpired":   "Number of unspent tickets which are past expiry",
 
 	// GetTickets help.
-	"gettickets--synopsis":       "Returning the hashes of the tickets currently owned by wallet.",
+	"gettickets--synopsis": "Returning the hashes of the tickets currently owned by wallet.\n" +
+		"Use listticketstatuses to list the status and purchase height of tickets filtered by status.",
 	"gettickets-includeimmature": "If true include immature tickets in the results.",
 
+	// ListTicketStatusesCmd help.
+	"listticketstatuses--synopsis": "Lists the status and purchase height of the tickets of the wallet, newest first, optionally filtered by status.\n" +
+		"The states of tickets are determined from the wallet's view of the main chain: unrevoked tickets are reported missed when the wallet detected their missed votes, and live otherwise until they expire.",
+	"listticketstatuses-status": "Only list tickets with this status (unmined, immature, live, voted, missed, expired, or revoked), or unspent for mined tickets which are neither voted nor revoked",
+	"listticketstatuses-offset": "Number of matching tickets to skip",
+	"listticketstatuses-limit":  "Maximum number of tickets to return",
+
+	// ListTicketStatusesResult help.
+	"listticketstatusesresult-total":   "Number of tickets matching the status filter",
+	"listticketstatusesresult-tickets": "The matching tickets after applying the offset and limit",
+
+	// TicketStatusResult help.
+	"ticketstatusresult-hash":    "The hash of the ticket",
+	"ticketstatusresult-status":  "The status of the ticket",
+	"ticketstatusresult-height":  "The height of the block the ticket was purchased in, or -1 for unmined tickets",
+	"ticketstatusresult-spender": "The hash of the vote or revocation spending the ticket, if any",
+
 	// GetVoteChoices help.
 	"getvotechoices--synopsis": "Retrieve the currently configured vote choices for the latest supported stake agendas",
 
@@ -168,6 +489,75 @@ var helpDescsEnUS = map[string]string{
 	"votechoice-choiceid":          "The ID of the current choice for this agenda",
 	"votechoice-choicedescription": "A description of the current choice for this agenda",
 
+	// JoinSplitTicketSessionCmd help.
+	"joinsplitticketsession--synopsis": "Contributes funds of an account to a split ticket session, returning the updated session.\n" +
+		"The contributed outputs pay the contribution and its share of the ticket fee, and are reserved for the session for two hours.  Sessions may not be joined after any participant has signed.",
+	"joinsplitticketsession-session": "The JSON-encoded split ticket session",
+	"joinsplitticketsession-account": "The account funding the contribution",
+	"joinsplitticketsession-amount":  "The share of the ticket price (in VHC) to contribute; the unfunded remainder is contributed when omitted",
+	"joinsplitticketsession-minconf": "Minimum number of block confirmations of the contributed outputs",
+
+	// ListSplitTicketsCmd help.
+	"listsplittickets--synopsis": "Lists the split tickets co-funded by the wallet with the wallet's contribution and reward.\n" +
+		"Votes and revocations are only known to wallets recording them, such as the wallet owning the voting address.",
+
+	// SplitTicketResult help.
+	"splitticketresult-tickethash":   "The hash of the ticket",
+	"splitticketresult-account":      "The account which contributed to the ticket",
+	"splitticketresult-status":       "The status of the ticket (unpublished, unmined, live, voted, or revoked)",
+	"splitticketresult-ticketprice":  "The price (in VHC) of the ticket",
+	"splitticketresult-contribution": "The amount (in VHC) committed to the ticket by the wallet, including its share of the fee",
+	"splitticketresult-share":        "The fraction of the ticket commitments owned by the wallet",
+	"splitticketresult-returned":     "The amount (in VHC) paid to the wallet by the vote or revocation",
+	"splitticketresult-reward":       "The returned amount less the wallet's contribution",
+
+	// ListStakePoolUserTicketsCmd help.
+	"liststakepoolusertickets--synopsis": "Lists a page of the tickets of a stake pool user ordered by ticket hash, optionally filtered by status.\n" +
+		"Pages are read from indexes of the user's tickets and do not require loading every ticket of the user.",
+	"liststakepoolusertickets-user":   "The P2SH or P2PKH voting address of the user",
+	"liststakepoolusertickets-status": "Only list tickets with this status (live, voted, missed, or expired)",
+	"liststakepoolusertickets-after":  "Only list tickets with hashes greater than this ticket hash, usually the next value of the previous page",
+	"liststakepoolusertickets-limit":  "Maximum number of tickets to return",
+
+	// ListStakePoolUserTicketsResult help.
+	"liststakepooluserticketsresult-tickets": "The tickets of the page",
+	"liststakepooluserticketsresult-next":    "The hash of the last ticket of a full page, to be passed as the after parameter to request the next page",
+
+	// PublishSplitTicketSessionCmd help.
+	"publishsplitticketsession--synopsis": "Publishes the ticket of a split ticket session signed by every participant, returning the ticket hash.",
+	"publishsplitticketsession-session":   "The JSON-encoded split ticket session",
+	"publishsplitticketsession--result0":  "The hash of the published ticket",
+
+	// SignCosignSessionCmd help.
+	"signcosignsession--synopsis": "Adds signatures of a cosigning session's inputs by keys of the wallet, returning the updated session.\n" +
+		"Inputs which already have the required signatures are not signed again.",
+	"signcosignsession-session": "The JSON-encoded cosigning session",
+
+	// SignSplitTicketSessionCmd help.
+	"signsplitticketsession--synopsis": "Signs the inputs contributed by the wallet to a fully funded split ticket session and records the wallet's share of the ticket, returning the updated session.\n" +
+		"The commitment and change addresses of contributions spending outputs of the wallet must belong to the wallet.",
+	"signsplitticketsession-session": "The JSON-encoded split ticket session",
+
+	// GetVSPInfo help.
+	"getvspinfo--synopsis": "Returns info about the selected voting service provider (VSP) and the tickets registered with it",
+
+	// GetVSPInfoResult help.
+	"getvspinforesult-host":          "The URL of the VSP",
+	"getvspinforesult-pubkey":        "The base64-encoded Ed25519 public key which signs VSP responses",
+	"getvspinforesult-network":       "The network the VSP operates on",
+	"getvspinforesult-apiversions":   "The API versions supported by the VSP",
+	"getvspinforesult-feepercentage": "The percentage of the ticket value charged as the VSP fee",
+	"getvspinforesult-feexpub":       "The extended public key the VSP derives fee addresses from",
+	"getvspinforesult-vspclosed":     "Whether the VSP is closed to new tickets",
+	"getvspinforesult-tickets":       "The tickets purchased for the VSP",
+
+	// VSPTicketResult help.
+	"vspticketresult-tickethash": "The hash of the ticket",
+	"vspticketresult-status":     "The registration status of the ticket (unpaid, signed, published, or registered)",
+	"vspticketresult-feeaddress": "The address the VSP fee is paid to",
+	"vspticketresult-feeamount":  "The VSP fee of the ticket",
+	"vspticketresult-feetxhash":  "The hash of the transaction paying the VSP fee",
+
 	// GetTicketMaxPrice help.
 	"getticketmaxprice--synopsis": "Returns the max price the wallet will pay for a ticket.",
 	"getticketmaxprice--result0":  "Max price wallet will spend on a ticket.",
@@ -185,18 +575,25 @@ var helpDescsEnUS = map[string]string{
 	"infowalletresult-difficulty":      "The current target difficulty",
 	"infowalletresult-testnet":         "Whether or not server is using testnet",
 	"infowalletresult-relayfee":        "The minimum relay fee for non-free transactions in VHC/KB",
-	"infowalletresult-errors":          "Any current errors",
+	"infowalletresult-errors":          "Any current errors, including warnings when the wallet casts votes of a version older than the stake version of recent blocks or the anomaly guard is triggered",
 	"infowalletresult-paytxfee":        "The fee per kB of the serialized tx size used each time more fee is required for an authored transaction",
 	"infowalletresult-balance":         "The balance of all accounts calculated with one block confirmation",
 	"infowalletresult-walletversion":   "The version of the address manager database",
 	"infowalletresult-unlocked_until":  "Unset",
+	"infowalletresult-database":        "Storage statistics of the wallet database (omitted if unavailable)",
 	"infowalletresult-keypoolsize":     "Unset",
 	"infowalletresult-keypoololdest":   "Unset",
 
+	// DatabaseInfo help.
+	"databaseinfo-path":        "The file path of the wallet database (omitted unless called with admin credentials)",
+	"databaseinfo-size":        "The size of the wallet database file in bytes",
+	"databaseinfo-freespace":   "Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)",
+	"databaseinfo-writeerrors": "The number of failed database writes since the wallet was opened",
+
 	// GetNewAddressCmd help.
 	"getnewaddress--synopsis": "Generates and returns a new payment address.",
 	"getnewaddress-account":   "Account name the new address will belong to (default=\"default\")",
-	"getnewaddress-gappolicy": `String defining the policy to use when the BIP0044 gap limit would be violated, may be "error", "ignore", or "wrap"`,
+	"getnewaddress-gappolicy": `String defining the policy to use when the BIP0044 gap limit would be violated, may be "error", "ignore", or "wrap" (default is the account gap policy)`,
 	"getnewaddress--result0":  "The payment address",
 
 	// GetRawChangeAddressCmd help.
@@ -248,12 +645,35 @@ var helpDescsEnUS = map[string]string{
 	// GetTransactionDetailsResult help.
 	"gettransactiondetailsresult-account":           "DEPRECATED -- Unset",
 	"gettransactiondetailsresult-address":           "The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input",
-	"gettransactiondetailsresult-category":          `The kind of detail: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, or "recv" for all other received outputs`,
+	"gettransactiondetailsresult-category":          `The kind of detail: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, "transfer" for both sides of transfers between accounts of the wallet, or "recv" for all other received outputs`,
 	"gettransactiondetailsresult-amount":            "The amount of a received output",
 	"gettransactiondetailsresult-fee":               "The included fee for a sent transaction",
 	"gettransactiondetailsresult-vout":              "The transaction output index",
 	"gettransactiondetailsresult-involveswatchonly": "Unset",
 
+	// ImportMultiCmd help.
+	"importmulti--synopsis": "Imports private keys, public keys, extended public keys, and redeem scripts to the 'imported' account in a single database transaction.\n" +
+		"Public keys, extended public keys, and private keys imported with watchonly set are watching-only.\n" +
+		"The outcome of each request is returned in the same order as the requests.\n" +
+		"When rescanning, a single rescan begins from the earliest timestamp of the imported requests.",
+	"importmulti-requests": "The keys and scripts to import",
+	"importmulti-rescan":   "Rescan the blockchain from the earliest request timestamp for outputs controlled by the imported keys and scripts",
+	"importmulti--result0": "The outcome of each request",
+
+	// ImportMultiRequest help.
+	"importmultirequest-privkey":      "A WIF-encoded private key",
+	"importmultirequest-pubkey":       "A hex encoded public key, imported watching-only",
+	"importmultirequest-xpub":         "An extended public key whose external and internal branch children are imported watching-only",
+	"importmultirequest-redeemscript": "A hex encoded redeem script for a P2SH output",
+	"importmultirequest-range":        "The first and last child indexes of each branch of xpub to import (default: 0 through 19)",
+	"importmultirequest-timestamp":    "The UNIX timestamp of the earliest transaction which may pay to the imported addresses, or unset if no rescan is required for this request",
+	"importmultirequest-watchonly":    "Import only the public key of privkey",
+
+	// ImportMultiResult help.
+	"importmultiresult-success":   "Whether the request was imported, including when it was previously imported",
+	"importmultiresult-addresses": "The addresses of the imported keys or script",
+	"importmultiresult-error":     "The reason the request could not be imported, omitted on success",
+
 	// ImportPrivKeyCmd help.
 	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
@@ -281,6 +701,19 @@ var helpDescsEnUS = map[string]string{
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 
+	// ListOutpointLocksCmd help.
+	"listoutpointlocks--synopsis": "Returns the locked outpoints of every namespace, sorted by namespace and then by expiry.",
+	"listoutpointlocks-namespace": "Only include outpoints locked in this namespace (the default namespace of lockunspent is the empty string)",
+
+	// OutpointLockResult help.
+	"outpointlockresult-txid":         "The transaction hash of the locked output",
+	"outpointlockresult-vout":         "The output index of the locked output",
+	"outpointlockresult-tree":         "The tree of the transaction of the locked output",
+	"outpointlockresult-namespace":    "The namespace holding the lock",
+	"outpointlockresult-expires":      "The Unix time the lock is released, omitted for locks which do not expire",
+	"outpointlockresult-persistent":   "Whether the lock is saved across wallet restarts",
+	"outpointlockresult-expiryheight": "The main chain height the lock is released at, omitted for locks which are not released at a height",
+
 	// TransactionInput help.
 	"transactioninput-amount": "The the previous output amount",
 	"transactioninput-txid":   "The transaction hash of the referenced output",
@@ -329,7 +762,7 @@ var helpDescsEnUS = map[string]string{
 	// ListTransactionsResult help.
 	"listtransactionsresult-account":           "DEPRECATED -- Unset",
 	"listtransactionsresult-address":           "Payment address for a transaction output",
-	"listtransactionsresult-category":          `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, or "recv" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
+	"listtransactionsresult-category":          `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, "transfer" for both sides of transfers between accounts of the wallet, or "recv" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
 	"listtransactionsresult-amount":            "The value of the transaction output valued in valhallacoin",
 	"listtransactionsresult-fee":               "The total input value minus the total output value for sent transactions",
 	"listtransactionsresult-confirmations":     "The number of block confirmations of the transaction",
@@ -354,12 +787,39 @@ var helpDescsEnUS = map[string]string{
 	"listtransactions-from":             "Number of transactions to skip before results are created",
 	"listtransactions-includewatchonly": "Unused",
 
+	// ListPendingSendsCmd help.
+	"listpendingsends--synopsis": "Returns the sends queued by the wallet for approval, oldest first.",
+	"listpendingsends-account":   "Only include sends from this account",
+
+	// ListPendingSendsResult help.
+	"listpendingsendsresult-id":             "The ID of the pending send",
+	"listpendingsendsresult-account":        "The account the send is from",
+	"listpendingsendsresult-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"listpendingsendsresult-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in valhallacoin to send to each address",
+	"listpendingsendsresult-amounts--key":   "Address to pay",
+	"listpendingsendsresult-amounts--value": "Amount to send to the payment address valued in valhallacoin",
+	"listpendingsendsresult-total":          "Total amount of all outputs",
+	"listpendingsendsresult-minconf":        "Minimum number of block confirmations required for the spent outputs",
+	"listpendingsendsresult-time":           "Unix time the send was queued",
+	"listpendingsendsresult-requester":      "The client which requested the send, identified by its TLS client certificate or username",
+
 	// ListUnspentCmd help.
 	"listunspent--synopsis": "Returns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.",
 	"listunspent-minconf":   "Minimum number of block confirmations required before a transaction output is considered",
 	"listunspent-maxconf":   "Maximum number of block confirmations required before a transaction output is excluded",
 	"listunspent-addresses": "If set, limits the returned details to unspent outputs received by any of these payment addresses",
 
+	// ListUnspentPageCmd help.
+	"listunspentpage--synopsis": "Returns a page of the unlocked unspent outputs controlled by wallet keys, optionally filtered by account.\n" +
+		"Outputs are ordered by transaction hash and output index, allowing wallets with many outputs to be listed over several requests.\n" +
+		"The next page is requested by passing the \"txid:vout\" of the last output of a page as the after parameter.",
+	"listunspentpage-minconf":   "Minimum number of block confirmations required before a transaction output is considered",
+	"listunspentpage-maxconf":   "Maximum number of block confirmations required before a transaction output is excluded",
+	"listunspentpage-addresses": "If set, limits the returned details to unspent outputs received by any of these payment addresses",
+	"listunspentpage-account":   "Only list outputs controlled by this account, or \"*\" for all accounts",
+	"listunspentpage-after":     "Only list outputs after this \"txid:vout\" outpoint, or the empty string to begin with the first output",
+	"listunspentpage-limit":     "Maximum number of outputs to return",
+
 	// ListUnspentResult help.
 	"listunspentresult-txid":          "The transaction hash of the referenced output",
 	"listunspentresult-vout":          "The output index of the referenced output",
@@ -372,16 +832,36 @@ var helpDescsEnUS = map[string]string{
 	"listunspentresult-spendable":     "Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)",
 	"listunspentresult-txtype":        "The type of the transaction",
 	"listunspentresult-tree":          "The tree the transaction comes from",
+	"listunspentresult-stakeoutput":   "The kind of stake transaction output (\"ticket\", \"ticketchange\", \"vote\", or \"revocation\"), or unset for outputs of regular transactions",
+	"listunspentresult-ticketstatus":  "The status of the ticket of ticket outputs (\"live\", \"missed\", or \"expired\")",
+	"listunspentresult-stakelocked":   "Whether the output is locked by an unspent ticket and may only be spent by a vote or revocation of the ticket",
 
 	// LockUnspentCmd help.
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
-		"Locked outputs are volatile and are not saved across wallet restarts.\n" +
-		"If unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.",
+		"Locked outputs are volatile and are not saved across wallet restarts (use lockunspentnamespace to lock outputs persistently or with an expiry).\n" +
+		"Outputs are locked in the default namespace, and outputs locked in other namespaces (with lockunspentnamespace) are not unlocked.\n" +
+		"If unlock is true and no transaction outputs are specified, all outputs locked in the default namespace are marked unlocked.",
 	"lockunspent-unlock":       "True to unlock outputs, false to lock",
 	"lockunspent-transactions": "Transaction outputs to lock or unlock",
 	"lockunspent--result0":     "The boolean 'true'",
 
+	// LockUnspentNamespaceCmd help.
+	"lockunspentnamespace--synopsis": "Locks or unlocks unspent outputs in a namespace.\n" +
+		"Namespaces allow independent clients to lock outputs without unlocking each other's locks.\n" +
+		"An output may only be locked by one namespace at a time, and locking an output held by another namespace is an error.\n" +
+		"Locked outputs are not chosen for transaction inputs of authored transactions.\n" +
+		"Locks are only saved across wallet restarts when persistent is true, and relocking a persistently locked output without it makes the lock volatile.\n" +
+		"Locks may expire after a number of seconds, a number of blocks, or both, in which case they are released by whichever expires first.\n" +
+		"If unlock is true and no transaction outputs are specified, all outputs locked in the namespace are marked unlocked.",
+	"lockunspentnamespace-namespace":    "The namespace of the locks",
+	"lockunspentnamespace-unlock":       "True to unlock outputs, false to lock",
+	"lockunspentnamespace-transactions": "Transaction outputs to lock or unlock",
+	"lockunspentnamespace-ttl":          "Seconds after which locks are released automatically, or 0 to hold locks until unlocked (relocking an output replaces its ttl)",
+	"lockunspentnamespace-persistent":   "Save the locks in the wallet database so they are held after the wallet is restarted",
+	"lockunspentnamespace-blocks":       "Number of blocks attached to the main chain after which locks are released automatically, or 0 to not release locks at any height (relocking an output replaces its expiry)",
+	"lockunspentnamespace--result0":     "The boolean 'true'",
+
 	// SendFromCmd help.
 	"sendfrom--synopsis": "DEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
@@ -391,7 +871,7 @@ var helpDescsEnUS = map[string]string{
 	"sendfrom-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
 	"sendfrom-comment":     "Unused",
 	"sendfrom-commentto":   "Unused",
-	"sendfrom--result0":    "The transaction hash of the sent transaction",
+	"sendfrom--result0":    "The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval",
 
 	// SendManyCmd help.
 	"sendmany--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
@@ -403,7 +883,7 @@ var helpDescsEnUS = map[string]string{
 	"sendmany-amounts--value": "Amount to send to the payment address valued in valhallacoin",
 	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
 	"sendmany-comment":        "Unused",
-	"sendmany--result0":       "The transaction hash of the sent transaction",
+	"sendmany--result0":       "The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval",
 
 	// SendToAddressCmd help.
 	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
@@ -413,19 +893,20 @@ var helpDescsEnUS = map[string]string{
 	"sendtoaddress-amount":    "Amount to send to the payment address valued in valhallacoin",
 	"sendtoaddress-comment":   "Unused",
 	"sendtoaddress-commentto": "Unused",
-	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
+	"sendtoaddress--result0":  "The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval",
 
 	// SendToMultisigCmd help.
 	"sendtomultisig--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a multisig address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
-		"A change output is automatically included to send extra output value back to the original account.",
+		"A change output is automatically included to send extra output value back to the original account.\n" +
+		"Multisig sends can not be queued for approval and are refused when sends require approval.",
 	"sendtomultisig-minconf":     "Minimum number of block confirmations required",
 	"sendtomultisig-nrequired":   "The number of signatures required to redeem outputs paid to this address",
 	"sendtomultisig-pubkeys":     "Pubkey to send to.",
 	"sendtomultisig-fromaccount": "Unused",
 	"sendtomultisig-amount":      "Amount to send to the payment address valued in valhallacoin",
 	"sendtomultisig-comment":     "Unused",
-	"sendtomultisig--result0":    "The transaction hash of the sent transaction",
+	"sendtomultisig--result0":    "The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval",
 
 	// SetGenerate help
 	"setgenerate--synopsis":    "Enable or disable stake mining",
@@ -441,20 +922,27 @@ var helpDescsEnUS = map[string]string{
 	"settxfee-amount":    "The new fee per kB of the serialized tx size valued in valhallacoin",
 	"settxfee--result0":  "The boolean 'true'",
 
+	// SetVSP help.
+	"setvsp--synopsis": "Selects the voting service provider (VSP) tickets are purchased for, or clears the selection when host is empty",
+	"setvsp-host":      "The https URL of the VSP (http is only permitted on simnet and regnet)",
+	"setvsp-pubkey":    "The base64-encoded Ed25519 public key of the VSP (default is fetched from the VSP)",
+
 	// SetVoteChoice help.
 	"setvotechoice--synopsis": "Sets choices for defined agendas in the latest stake version supported by this software",
 	"setvotechoice-agendaid":  "The ID for the agenda to modify",
 	"setvotechoice-choiceid":  "The ID for the choice to choose",
 
 	// SignMessageCmd help.
-	"signmessage--synopsis": "Signs a message using the private key of a payment address.",
-	"signmessage-address":   "Payment address of private key used to sign the message with",
-	"signmessage-message":   "Message to sign",
-	"signmessage--result0":  "The signed message encoded as a base64 string",
+	"signmessage--synopsis": "Signs a message using the private key of a payment address.\n" +
+		"Ed25519 and secp256k1 Schnorr addresses sign with the key of their signature algorithm, and the signature includes the public key of the address.",
+	"signmessage-address":  "Payment address of private key used to sign the message with",
+	"signmessage-message":  "Message to sign",
+	"signmessage--result0": "The signed message encoded as a base64 string",
 
 	// SignRawTransactionCmd help.
 	"signrawtransaction--synopsis": "Signs transaction inputs using private keys from this wallet and request.\n" +
-		"The valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.",
+		"The valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n" +
+		"The output scripts of inputs not described by the request are looked up in the wallet's transaction history, and otherwise queried from the consensus RPC server when one is connected.",
 	"signrawtransaction-rawtx":    "Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string",
 	"signrawtransaction-inputs":   "Additional data regarding inputs that this wallet may not be tracking",
 	"signrawtransaction-privkeys": "Additional WIF-encoded private keys to use when creating signatures",
@@ -466,7 +954,9 @@ var helpDescsEnUS = map[string]string{
 	"signrawtransactionresult-errors":   "Script verification errors (if exists)",
 
 	// StartAutoBuyerCmd Help.
-	"startautobuyer--synopsis":         "Starts the wallet's ticket buyer.",
+	"startautobuyer--synopsis": "Starts a ticket buyer purchasing tickets from an account.\n" +
+		"Ticket buyers of different accounts run concurrently, each with its own balance to maintain, limits, and voting and pool addresses.\n" +
+		"Unset parameters default to the configured ticket buyer options.",
 	"startautobuyer-account":           "The account to use for purchasing tickets",
 	"startautobuyer-passphrase":        "The private passphrase of the wallet",
 	"startautobuyer-balancetomaintain": "The minimum amount of funds to never dip below when purchasing tickets",
@@ -479,7 +969,11 @@ var helpDescsEnUS = map[string]string{
 	"startautobuyer-maxperblock":       "The maximum tickets per block. Negative number indicates one ticket every n blocks",
 
 	// StopAutoBuyerCmd Help.
-	"stopautobuyer--synopsis": "Stops the wallet's ticket buyer.",
+	"stopautobuyer--synopsis": "Stops the ticket buyer of every account.",
+
+	// StopAccountAutoBuyerCmd help.
+	"stopaccountautobuyer--synopsis": "Stops the ticket buyer of a single account, leaving those of other accounts running.",
+	"stopaccountautobuyer-account":   "The account whose ticket buyer is stopped",
 
 	// SignRawTransactionError help.
 	"signrawtransactionerror-error":     "Verification or signing error related to the input",
@@ -489,18 +983,23 @@ var helpDescsEnUS = map[string]string{
 	"signrawtransactionerror-vout":      "The output index of the referenced previous output",
 
 	// SignRawTransactions help.
-	"signrawtransactions--synopsis": "Signs transaction inputs using private keys from this wallet and request for a list of transactions.\n",
-	"signrawtransactions-send":      "Set true to send the transactions after signing.",
-	"signrawtransactions-rawtxs":    "A list of transactions to sign (and optionally send).",
+	"signrawtransactions--synopsis": "Signs transaction inputs using private keys from this wallet and request for a list of transactions.\n" +
+		"Transactions are signed concurrently, and a transaction which can not be signed or sent does not prevent signing and sending the others.",
+	"signrawtransactions-send":   "Set true to send the transactions after signing.",
+	"signrawtransactions-rawtxs": "A list of transactions to sign (and optionally send).",
 
 	// SignRawTransactionsResults help.
 	"signrawtransactionsresult-results": "Returned values from the signrawtransactions command.",
 	"signedtransaction-txhash":          "The hash of the signed tx.",
 	"signedtransaction-sent":            "Tells if the transaction was sent.",
 	"signedtransaction-signingresult":   "Success or failure of signing.",
+	"signedtransaction-error":           "The reason the transaction could not be signed or sent, omitted on success.",
 
 	// SweepAccount help.
-	"sweepaccount--synopsis":             "Moves as much value as possible in a transaction from an account.\n",
+	"sweepaccount--synopsis": "Moves as much value as possible in a transaction from an account.\n" +
+		"Locked outputs, ticket outputs, and immature coinbase and stake outputs are not swept.\n" +
+		"Accounts with more outputs than fit in a single transaction are swept by several transactions spending distinct outputs.\n" +
+		"The result fields describe the first transaction and the others are listed in additionaltransactions.",
 	"sweepaccount-sourceaccount":         "The account to be swept.",
 	"sweepaccount-destinationaddress":    "The destination address to pay to.",
 	"sweepaccount-requiredconfirmations": "The minimum utxo confirmation requirement (optional).",
@@ -511,6 +1010,36 @@ var helpDescsEnUS = map[string]string{
 	"sweepaccountresult-totalpreviousoutputamount": "The total transaction input amount.",
 	"sweepaccountresult-totaloutputamount":         "The total transaction output amount.",
 	"sweepaccountresult-estimatedsignedsize":       "The estimated size of the transaction when signed.",
+	"sweepaccountresult-additionaltransactions":    "The further transactions sweeping outputs which do not fit in the first transaction, omitted when there are none.",
+
+	// SendSweepAccountCmd help.
+	"sendsweepaccount--synopsis": "Signs and publishes transactions moving as much value as possible from an account, like sweepaccount does without signing.\n" +
+		"Accounts with more outputs than fit in a single transaction are swept by several transactions.\n" +
+		"Ticket outputs and immature coinbase and stake outputs are never swept.\n" +
+		"The wallet must be unlocked.",
+	"sendsweepaccount-sourceaccount":         "The account to be swept",
+	"sendsweepaccount-destinationaddress":    "The destination address to pay to",
+	"sendsweepaccount-requiredconfirmations": "The minimum utxo confirmation requirement",
+	"sendsweepaccount-feeperkb":              "The fee rate of the transaction, valued in valhallacoin per kilobyte",
+	"sendsweepaccount-includelocked":         "Sweep outputs locked by lockunspent or any lock namespace",
+	"sendsweepaccount-dustthreshold":         "Outputs of lower value, in valhallacoin, are not swept",
+	"sendsweepaccount--result0":              "The hashes of the sweep transactions",
+
+	// SweepDustCmd help.
+	"sweepdust--synopsis": "Consolidates the dust outputs of an account into a new internal address of the account.\n" +
+		"Outputs marked with the \"dust\" UTXO policy and outputs of value below the threshold are dust.\n" +
+		"Dust outputs worth less than the fee of spending them are not swept, and no transaction is published when the swept value would not exceed the fee.\n" +
+		"The wallet must be unlocked.",
+	"sweepdust-account":   "The account to sweep dust from",
+	"sweepdust-threshold": "Outputs of lower value, in valhallacoin, are dust, or 0 for three times the fee of creating and spending an output at the fee rate",
+	"sweepdust-feeperkb":  "The fee rate of the transaction, valued in valhallacoin per kilobyte (default is the wallet's relay fee)",
+
+	// SweepDustResult help.
+	"sweepdustresult-inputs":    "The number of swept dust outputs",
+	"sweepdustresult-dustvalue": "The total value of the swept dust outputs",
+	"sweepdustresult-fee":       "The fee paid by the sweep",
+	"sweepdustresult-reclaimed": "The value of the dust reclaimed in the new output after fees",
+	"sweepdustresult-txhashes":  "The hashes of the sweep transactions, empty when sweeping was not profitable",
 
 	// ValidateAddressCmd help.
 	"validateaddress--synopsis": "Verify that an address is valid.\n" +
@@ -537,12 +1066,23 @@ var helpDescsEnUS = map[string]string{
 	"validateaddresswalletresult-sigsrequired": "The number of required signatures to redeem outputs to the multisig address",
 
 	// VerifyMessageCmd help.
-	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.",
+	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.\n" +
+		"Signatures of Ed25519 and secp256k1 Schnorr addresses must include the public key of the address as created by signmessage.",
 	"verifymessage-address":   "Address used to sign message",
 	"verifymessage-signature": "The signature to verify",
 	"verifymessage-message":   "The message to verify",
 	"verifymessage--result0":  "Whether the message was signed with the private key of 'address'",
 
+	// VerifyOwnershipProofCmd help.
+	"verifyownershipproof--synopsis": "Verifies the signatures of a proof created by createownershipproof and checks with the consensus RPC server that the proof block is in the main chain and that each claimed output exists with the claimed amount and address and was unspent as of the block.\n" +
+		"Outputs spent since the proof block invalidate the proof.",
+	"verifyownershipproof-proof":      "The ownership proof",
+	"verifyownershipproof-minbalance": "The minimum total value (in VHC) of the claimed outputs",
+
+	// VerifyOwnershipProofResult help.
+	"verifyownershipproofresult-valid":   "Whether every address signed the proof, every claimed output was unspent as of the proof block, and the claimed outputs are worth at least the minimum balance",
+	"verifyownershipproofresult-balance": "The total value (in VHC) of the claimed outputs, or zero when the proof is invalid",
+
 	// Version help
 	"version--synopsis":       "Returns application and API versions (semver) keyed by their names",
 	"version--result0--desc":  "Version objects keyed by the program or API name",
@@ -562,6 +1102,19 @@ var helpDescsEnUS = map[string]string{
 	"walletpassphrasechange-oldpassphrase": "The old wallet passphrase",
 	"walletpassphrasechange-newpassphrase": "The new wallet passphrase",
 
+	// WatchOutPointCmd help.
+	"watchoutpoint--synopsis": "Watches an outpoint not owned by the wallet.\n" +
+		"The transaction spending the outpoint is recorded by the wallet and reported by listtransactions as involving a watch-only output.",
+	"watchoutpoint-txid": "The hash of the transaction of the outpoint",
+	"watchoutpoint-vout": "The output index of the outpoint",
+	"watchoutpoint-tree": "The transaction tree of the outpoint (0=regular, 1=stake)",
+
+	// WatchScriptCmd help.
+	"watchscript--synopsis": "Watches an output script not owned by the wallet.\n" +
+		"Transactions paying the script, and transactions spending those outputs, are recorded by the wallet and reported by listtransactions as involving watch-only outputs.\n" +
+		"Past transactions are only recorded after a rescan.",
+	"watchscript-hex": "The hex encoded output script",
+
 	// CreateNewAccountCmd help.
 	"createnewaccount--synopsis": "Creates a new account.\n" +
 		"The wallet must be unlocked for this request to succeed.",
@@ -573,6 +1126,154 @@ var helpDescsEnUS = map[string]string{
 	"exportwatchingwallet-download":  "Unused",
 	"exportwatchingwallet--result0":  "The watching-only database encoded as a base64 string",
 
+	// ExportVoteChoicesCmd help.
+	"exportvotechoices--synopsis": "Returns the choices of every agenda of the supported stake version as a document which may be imported by other wallets using importvotechoices.",
+
+	// VoteChoicesDocument help.
+	"votechoicesdocument-version": "The stake version of the agendas",
+	"votechoicesdocument-choices": "The choice of each agenda",
+
+	// AgendaChoice help.
+	"agendachoice-agendaid": "The ID of the agenda",
+	"agendachoice-choiceid": "The ID of the agenda's choice",
+
+	// ImportPKCS11AccountCmd help.
+	"importpkcs11account--synopsis": "Creates an account from the account extended public key of keys kept inside the hardware security module configured by the pkcs11module option.\n" +
+		"The private keys of the account are never known by the wallet, and its inputs and messages are signed inside the module.\n" +
+		"The module must hold the private key of each derived address, found by the Hash160 of its public key, and is verified to hold the key of the first external address.\n" +
+		"Accounts with keys kept by the module are not restored from the wallet seed.",
+	"importpkcs11account-account": "Name of the new account",
+	"importpkcs11account-xpub":    "The account extended public key exported by the token",
+
+	// ImportVoteChoicesCmd help.
+	"importvotechoices--synopsis": "Applies the agenda choices of a document created by exportvotechoices.\n" +
+		"Agendas which are not included in the document are set to abstain.\n" +
+		"The document must be for the stake version supported by the wallet, and either every choice is applied or none are.",
+	"importvotechoices-document": "JSON document of the form {\"version\":n,\"choices\":[{\"agendaid\":\"id\",\"choiceid\":\"id\"},...]}",
+
+	// GetAccountReceivedCmd help.
+	"getaccountreceived--synopsis": "Returns the amounts received by addresses of an account, including spent outputs, with unmined and insufficiently confirmed credits counted separately as pending.",
+	"getaccountreceived-account":   "Account name to query received amounts for",
+	"getaccountreceived-minconf":   "Minimum number of block confirmations required before an output's value is counted as confirmed (values less than 1 are treated as 1)",
+
+	// GetAddressReceivedCmd help.
+	"getaddressreceived--synopsis": "Returns the amounts received by a single address, including spent outputs, with unmined and insufficiently confirmed credits counted separately as pending.",
+	"getaddressreceived-address":   "Payment address to query received amounts for",
+	"getaddressreceived-minconf":   "Minimum number of block confirmations required before an output's value is counted as confirmed (values less than 1 are treated as 1)",
+
+	// ReceivedResult help.
+	"receivedresult-confirmed": "The amount (in VHC) received by credits with at least minconf confirmations",
+	"receivedresult-pending":   "The amount (in VHC) received by unmined credits and credits with fewer than minconf confirmations",
+	"receivedresult-total":     "The sum of the confirmed and pending amounts",
+
+	// GetAccountStatsCmd help.
+	"getaccountstats--synopsis": "Returns the default address gap limit policy of an account and how many addresses have been returned beyond the last used address of each branch.",
+	"getaccountstats-account":   "Name of the account (default=\"default\")",
+
+	// GetAccountStatsResult help.
+	"getaccountstatsresult-account":           "Name of the account",
+	"getaccountstatsresult-accountnumber":     "Number of the account",
+	"getaccountstatsresult-gappolicy":         "Gap policy used when generating addresses without specifying a policy (\"error\", \"ignore\", or \"wrap\")",
+	"getaccountstatsresult-gaplimit":          "The unused address gap limit of the wallet",
+	"getaccountstatsresult-nextexternalindex": "Child index of the next external address that will be returned",
+	"getaccountstatsresult-nextinternalindex": "Child index of the next internal address that will be returned",
+	"getaccountstatsresult-externalgap":       "Number of external addresses returned after the last used external address",
+	"getaccountstatsresult-internalgap":       "Number of internal addresses returned after the last used internal address",
+	"getaccountstatsresult-keystorage":        "Where the private keys of the account are kept (\"local\" or \"pkcs11\")",
+
+	// GetAPISchemaCmd help.
+	"getapischema--synopsis": "Returns an OpenRPC document describing every method of the server, including the JSON schema of its parameters and result.\n" +
+		"Methods which may only be called by websocket clients are marked with the x-websocketonly extension.",
+
+	// GetAPISchemaResult help.
+	"getapischemaresult-openrpc": "Version of the OpenRPC specification the document conforms to",
+	"getapischemaresult-info":    "Title and JSON-RPC API version of the server",
+	"getapischemaresult-methods": "OpenRPC method objects of every method",
+
+	// APISchemaInfo help.
+	"apischemainfo-title":   "Title of the API",
+	"apischemainfo-version": "Semantic version of the JSON-RPC API",
+
+	// GetAuditLogCmd help.
+	"getauditlog--synopsis": "Returns the most recent records of the audit log of state-changing requests, oldest first.\n" +
+		"The hash chain of the entire log is verified before any records are returned.",
+	"getauditlog-count": "Number of most recent records to return, or 0 for every record (default=100)",
+
+	// GetAuditLogResult help.
+	"getauditlogresult-seq":      "Sequence number of the record, starting at 1",
+	"getauditlogresult-time":     "Unix time the request was received or handled",
+	"getauditlogresult-client":   "Remote address and certificate identity of the client",
+	"getauditlogresult-role":     "Role of the client's credentials",
+	"getauditlogresult-wallet":   "Name of the wallet selected by the request endpoint, omitted for the default wallet",
+	"getauditlogresult-method":   "The method of the request",
+	"getauditlogresult-params":   "JSON encoding of each request parameter, with secret parameters redacted (request records only)",
+	"getauditlogresult-request":  "Sequence number of the request record whose outcome is reported (outcome records only)",
+	"getauditlogresult-error":    "Error message if the request failed (outcome records only)",
+	"getauditlogresult-prevhash": "Hash of the previous record",
+	"getauditlogresult-hash":     "HMAC-SHA256, keyed by the audit log key, of the JSON encoding of this record with an empty hash",
+
+	// GetAutoBuyerStatusCmd help.
+	"getautobuyerstatus--synopsis": "Returns whether the ticket buyer is running, and the effective configuration and tickets purchased since it was started of each account's strategy.",
+	"getautobuyerstatus-account":   "Only report the strategy of this account",
+
+	// GetAutoBuyerStatusResult help.
+	"getautobuyerstatusresult-running":    "Whether a ticket buyer is running for any account",
+	"getautobuyerstatusresult-strategies": "The running strategies, in the order they were started",
+
+	// AutoBuyerStrategyResult help.
+	"autobuyerstrategyresult-config":          "The effective configuration of the strategy",
+	"autobuyerstrategyresult-purchased":       "The number of tickets purchased since the strategy was started",
+	"autobuyerstrategyresult-spent":           "The total ticket price paid for the purchased tickets, excluding transaction fees",
+	"autobuyerstrategyresult-lasterror":       "The most recent error which failed a purchase attempt",
+	"autobuyerstrategyresult-lasterrorheight": "The block height the most recent error occurred at",
+	"autobuyerstrategyresult-nextheight":      "The block height the strategy next evaluates purchases at",
+
+	// AutoBuyerConfig help.
+	"autobuyerconfig-account":           "The account tickets are purchased from",
+	"autobuyerconfig-balancetomaintain": "The balance (in VHC) kept in the account",
+	"autobuyerconfig-maxfee":            "The maximum ticket fee per KB (in VHC)",
+	"autobuyerconfig-maxpriceabsolute":  "The maximum ticket price (in VHC), or 0 for no limit",
+	"autobuyerconfig-maxpricerelative":  "The scaling factor of the average ticket price used as the maximum price",
+	"autobuyerconfig-maxperblock":       "The maximum number of tickets purchased per block",
+	"autobuyerconfig-maxspend":          "The maximum total ticket price (in VHC) spent in any spendwindow blocks, or 0 for no budget",
+	"autobuyerconfig-spendwindow":       "The number of blocks the maxspend budget applies to",
+	"autobuyerconfig-votingaddress":     "The address tickets are given voting rights to",
+	"autobuyerconfig-pooladdress":       "The stake pool address fees are paid to",
+	"autobuyerconfig-poolfees":          "The stake pool fee percentage",
+
+	// GetAutoConsolidationCmd help.
+	"getautoconsolidation--synopsis": "Returns the configuration and status of the automatic consolidation of the outputs of each configured account.",
+	"getautoconsolidation-account":   "Only report the automatic consolidation of this account",
+
+	// GetAutoConsolidationResult help.
+	"getautoconsolidationresult-accounts": "The automatic consolidation of each configured account, ordered by account number",
+
+	// AutoConsolidationResult help.
+	"autoconsolidationresult-account":         "The account whose outputs are consolidated",
+	"autoconsolidationresult-threshold":       "The number of spendable outputs which must be exceeded before outputs are consolidated",
+	"autoconsolidationresult-maxinputs":       "The maximum number of outputs consolidated by each transaction, or 0 for no limit",
+	"autoconsolidationresult-maxblockusage":   "The fraction of the maximum block size the latest block may use for outputs to be consolidated",
+	"autoconsolidationresult-outputs":         "The number of spendable outputs counted by the latest evaluation",
+	"autoconsolidationresult-evaluatedheight": "The block height of the latest evaluation",
+	"autoconsolidationresult-consolidations":  "The number of consolidation transactions published since automatic consolidation was configured",
+	"autoconsolidationresult-lasttx":          "The hash of the most recent consolidation transaction",
+	"autoconsolidationresult-lasttxheight":    "The block height the most recent consolidation transaction was created at",
+	"autoconsolidationresult-lasterror":       "The most recent error which failed a consolidation",
+	"autoconsolidationresult-lasterrorheight": "The block height the most recent error occurred at",
+
+	// GetBalanceAtHashCmd help.
+	"getbalanceathash--synopsis": "Calculates and returns the total balance of each account as of a main chain block by replaying all transactions mined at or before it.",
+	"getbalanceathash-blockhash": "Hash of the main chain block to calculate balances at",
+	"getbalanceathash-account":   "The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")",
+
+	// GetBalanceAtHashResult help.
+	"getbalanceathashresult-blockhash":          "Hash of the block the balances were calculated at.",
+	"getbalanceathashresult-height":             "Height of the block the balances were calculated at.",
+	"getbalanceathashresult-balances":           "Balances of each account as of the block.",
+	"getbalanceathashresult-total":              "Total balance of all reported accounts.",
+	"getaccountbalanceathashresult-accountname": "Name of account.",
+	"getaccountbalanceathashresult-total":       "Total amount of coins in the account as of the block.",
+
 	// GetBestBlockCmd help.
 	"getbestblock--synopsis": "Returns the hash and height of the newest block in the best chain that wallet has finished syncing with.",
 
@@ -580,6 +1281,57 @@ var helpDescsEnUS = map[string]string{
 	"getbestblockresult-hash":   "The hash of the block",
 	"getbestblockresult-height": "The blockchain height of the block",
 
+	// GetBuildInfoCmd help.
+	"getbuildinfo--synopsis": "Returns the version, source revision, and build environment of the running wallet and its enabled feature flags.",
+
+	// GetBuildInfoResult help.
+	"getbuildinforesult-version":         "The semantic version of the wallet",
+	"getbuildinforesult-commit":          "The source revision the wallet was built from (omitted when not recorded at build time)",
+	"getbuildinforesult-buildtags":       "The build tags the wallet was built with",
+	"getbuildinforesult-goversion":       "The Go version the wallet was built with",
+	"getbuildinforesult-platform":        "The operating system and architecture the wallet was built for",
+	"getbuildinforesult-enabledfeatures": "The names of the enabled feature flags",
+
+	// GetDBStatsCmd help.
+	"getdbstats--synopsis": "Returns the storage statistics of the wallet database and of each root bucket and the buckets nested directly within them.",
+
+	// GetDBStatsResult help.
+	"getdbstatsresult-database": "Storage statistics of the database file (omitted if the database driver does not report them)",
+	"getdbstatsresult-buckets":  "Storage statistics of each bucket, ordered by name",
+
+	// BucketStatsResult help.
+	"bucketstatsresult-name":       "Path of the bucket, with the keys of nested buckets separated by a slash and unprintable keys hex encoded",
+	"bucketstatsresult-keys":       "The number of keys in the bucket and its nested buckets, including the keys of nested buckets",
+	"bucketstatsresult-buckets":    "The number of buckets, including the bucket itself and its nested buckets",
+	"bucketstatsresult-depth":      "The number of levels of the bucket's storage tree",
+	"bucketstatsresult-inusebytes": "The number of bytes used to store the bucket",
+	"bucketstatsresult-allocbytes": "The number of bytes allocated for the storage of the bucket",
+
+	// GetFeatureFlagsCmd help.
+	"getfeatureflags--synopsis": "Returns every feature flag and whether it is enabled.\n" +
+		"Methods of disabled features return an error with code -18.\n" +
+		"Flags are enabled and disabled with the enablefeature and disablefeature options.",
+
+	// GetFeatureFlagsResult help.
+	"getfeatureflagsresult-name":        "The name of the feature flag",
+	"getfeatureflagsresult-description": "Description of the feature",
+	"getfeatureflagsresult-enabled":     "Whether the feature is enabled",
+
+	// GetGuardStatusCmd help.
+	"getguardstatus--synopsis": "Returns the state of the anomaly guard.\n" +
+		"The guard locks the wallet when a configured trigger fires, and refuses walletpassphrase requests with error code -19 until it is cleared with clearguard.",
+
+	// GetGuardStatusResult help.
+	"getguardstatusresult-triggered":          "Whether a trigger has fired since the guard was last cleared",
+	"getguardstatusresult-passphrasefailures": "The number of consecutive incorrect passphrases",
+	"getguardstatusresult-alerts":             "The alerts raised by fired triggers, oldest first",
+
+	// GuardAlertResult help.
+	"guardalertresult-time":    "The Unix time the trigger fired",
+	"guardalertresult-trigger": "The trigger which fired (passphrase, sendcap, or origin)",
+	"guardalertresult-client":  "The address of the client whose request fired the trigger",
+	"guardalertresult-detail":  "Description of the suspicious activity",
+
 	// GetUnconfirmedBalanceCmd help.
 	"getunconfirmedbalance--synopsis": "Calculates the unspent output value of all unmined transaction outputs for an account.",
 	"getunconfirmedbalance-account":   "The account to query the unconfirmed balance for (default=\"default\")",
@@ -611,18 +1363,48 @@ var helpDescsEnUS = map[string]string{
 	"redeemmultisigouts-toaddress":      "Address to look for (if not internal addresses).",
 	"redeemmultisigouts-fromscraddress": "Input script hash address.",
 
+	// RedeemMultiSigOutsBatchCmd help.
+	"redeemmultisigoutsbatch--synopsis": "Redeems the unspent outputs of a P2SH multisig address in transactions spending up to batch outputs each, signed by the wallet.\n" +
+		"Transactions which are not complete must be signed by other cosigners, for example with signrawtransaction.",
+	"redeemmultisigoutsbatch-fromscraddress": "The P2SH multisig address whose outputs are redeemed",
+	"redeemmultisigoutsbatch-toaddress":      "The address paid by each transaction; a new internal address of the default account is used for each transaction when omitted",
+	"redeemmultisigoutsbatch-number":         "The maximum number of outputs to redeem; all outputs are redeemed when omitted",
+	"redeemmultisigoutsbatch-feerate":        "The fee rate (in VHC/kB) paid for the size of the fully signed transactions; the wallet's relay fee is used when omitted",
+	"redeemmultisigoutsbatch-batch":          "The maximum number of outputs spent by each transaction",
+
+	// RedeemMultiSigOutsResult help.
+	"redeemmultisigoutsresult-results": "The redemption transaction spending each batch of outputs",
+
 	// RescanWallet help.
-	"rescanwallet--synopsis":   "Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error",
+	"rescanwallet--synopsis": "Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error.\n" +
+		"The progress of the rescan is reported by getrescanstatus and rescanprogress notifications.",
 	"rescanwallet-beginheight": "The height of the first block to begin the rescan from",
 
+	// CancelRescanCmd help.
+	"cancelrescan--synopsis": "Aborts every rescan in progress which was started by rescanwallet or by importing keys and scripts.\n" +
+		"Rescans performed while synchronizing with the network are not canceled.",
+
 	// RevokeTickets help.
-	"revoketickets--synopsis": "Requests the wallet create revovactions for any previously missed tickets.  Wallet must be unlocked.",
+	// ListWalletsCmd help.
+	"listwallets--synopsis": "Returns the default wallet and every named wallet which exists or is loaded, sorted by name.\n" +
+		"Requests are dispatched to a named wallet by the /wallet/<name> HTTP POST endpoint and the /wallet/<name>/ws websocket endpoint, and to the default wallet by all other endpoints.\n" +
+		"Named wallets are created and opened with createwallet and openwallet requests to their endpoints.",
+
+	// ListWalletsResult help.
+	"listwalletsresult-name":   "The name of the wallet, or the empty string for the default wallet",
+	"listwalletsresult-loaded": "Whether the wallet is loaded",
+
+	"revoketickets--synopsis": "Requests the wallet create revocations for any previously missed or expired tickets.  Without a consensus RPC server, missed tickets are those detected by the wallet from the votes included in each block.  Wallet must be unlocked.",
 
 	// RenameAccountCmd help.
 	"renameaccount--synopsis":  "Renames an account.",
 	"renameaccount-oldaccount": "The old account name to rename",
 	"renameaccount-newaccount": "The new name for the account",
 
+	// WalletExistsCmd help.
+	"walletexists--synopsis": "Returns whether a wallet database exists in the wallet data directory, i.e. whether openwallet may be used to open a wallet.",
+	"walletexists--result0":  "Whether the wallet database exists",
+
 	// WalletIsLockedCmd help.
 	"walletislocked--synopsis": "Returns whether or not the wallet is locked.",
 	"walletislocked--result0":  "Whether the wallet is locked",
@@ -638,6 +1420,24 @@ var helpDescsEnUS = map[string]string{
 	"walletinforesult-votebitsextended": "Extended vote bits setting",
 	"walletinforesult-voteversion":      "Version of votes that will be generated",
 	"walletinforesult-voting":           "Whether or not the wallet is currently voting tickets",
+	"walletinforesult-database":         "Storage statistics of the wallet database (omitted if unavailable)",
+	"walletinforesult-gaprecoveries":    "Catch-up syncs performed after missed block notifications from the consensus RPC server (omitted unless synchronizing with the consensus RPC server)",
+	"walletinforesult-consistencycheck": "Result of the database consistency check performed when the wallet was opened",
+
+	// ConsistencyCheckInfo help.
+	"consistencycheckinfo-mode":         "Whether the \"quick\" structural check or the \"full\" check of every record (--fullcheck) was performed",
+	"consistencycheckinfo-tiphash":      "Hash of the main chain tip block when the wallet was opened",
+	"consistencycheckinfo-tipheight":    "Height of the main chain tip block when the wallet was opened",
+	"consistencycheckinfo-blocks":       "Number of main chain blocks verified (full check only)",
+	"consistencycheckinfo-transactions": "Number of mined transaction records verified (full check only)",
+	"consistencycheckinfo-credits":      "Number of credit records verified (full check only)",
+	"consistencycheckinfo-accounts":     "Number of account records verified (full check only)",
+	"consistencycheckinfo-durationms":   "Duration of the check in milliseconds",
+
+	// GapRecoveryInfo help.
+	"gaprecoveryinfo-recoveries":      "The number of catch-up syncs started since the wallet process started",
+	"gaprecoveryinfo-recoveredblocks": "The number of blocks connected by catch-up syncs",
+	"gaprecoveryinfo-failures":        "The number of catch-up syncs which failed, including those that exceeded the maximum number of missed blocks and restarted synchronization",
 
 	// TODO Alphabetize
 
@@ -662,6 +1462,34 @@ var helpDescsEnUS = map[string]string{
 	"pooluserticket-ticket":        "The hash of the added ticket",
 	"pooluserticket-status":        "The current status of the added ticket",
 
+	// GetTicketInfoCmd help.
+	"getticketinfo--synopsis":  "Returns the status of a ticket and the vote bits and agenda choices of every vote the wallet created for it.",
+	"getticketinfo-tickethash": "Hash of the ticket",
+
+	// GetTicketInfoResult help.
+	"getticketinforesult-tickethash":      "Hash of the ticket",
+	"getticketinforesult-status":          "Current status of the ticket (\"unknown\", \"unmined\", \"immature\", \"live\", \"voted\", \"revoked\", \"missed\", or \"expired\")",
+	"getticketinforesult-blockhash":       "Hash of the block the ticket was mined in, if mined",
+	"getticketinforesult-blockheight":     "Height of the block the ticket was mined in, or -1 if unmined",
+	"getticketinforesult-spenderhash":     "Hash of the vote or revocation spending the ticket, if spent",
+	"getticketinforesult-votes":           "Records of the votes created by the wallet for the ticket",
+	"getticketinforesult-votingxpubindex": "Child index of the external branch of the --ticketbuyer.votingxpub extended public key which derived the voting address of the ticket, omitted when not derived from it",
+
+	// StakeHistoryCmd help.
+	"stakehistory--synopsis": "Returns the vote bits and agenda choices of the most recent votes created by the wallet, ordered by the height of the block voted on.",
+	"stakehistory-count":     "Number of most recent votes to return, or 0 for every vote (default=100)",
+
+	// VoteRecordResult help.
+	"voterecordresult-tickethash":  "Hash of the ticket",
+	"voterecordresult-votehash":    "Hash of the vote transaction",
+	"voterecordresult-blockhash":   "Hash of the block voted on",
+	"voterecordresult-blockheight": "Height of the block voted on",
+	"voterecordresult-time":        "Unix time the vote was created",
+	"voterecordresult-votebits":    "The vote bits cast by the vote",
+	"voterecordresult-votebitsext": "The hex encoded extended vote bits cast by the vote",
+	"voterecordresult-voteversion": "The stake version of the vote",
+	"voterecordresult-choices":     "The agenda choices of the stake version cast by the vote bits",
+
 	// ListScriptsCmd help.
 	"listscripts--synopsis": "List all scripts that have been added to wallet",
 
@@ -670,19 +1498,278 @@ var helpDescsEnUS = map[string]string{
 	"scriptinfo-redeemscript": "The redeem script",
 	"scriptinfo-address":      "The script address",
 	"scriptinfo-hash160":      "The script hash",
+	"scriptinfo-importtime":   "The Unix time the script was imported, omitted when the import was not recorded",
+	"scriptinfo-origin":       "The request which imported the script, such as the RPC method, omitted when the import was not recorded",
+	"scriptinfo-user":         "The RPC user which imported the script, omitted when unknown",
+	"scriptinfo-rescanfrom":   "The height the import rescanned the chain from, omitted when no rescan was performed",
+	"scriptinfo-unspent":      "Whether any unspent outputs of the wallet pay to the script",
 
 	// TicketsForAddressCmd help.
 	"ticketsforaddress--synopsis": "Request all the tickets for an address.",
 	"ticketsforaddress-address":   "Address to look for.",
 	"ticketsforaddress--result0":  "Tickets owned by the specified address.",
 
+	// MergeCosignSessionsCmd help.
+	"mergecosignsessions--synopsis": "Combines the signatures of copies of a cosigning session signed by different cosigners, returning the merged session.",
+	"mergecosignsessions-sessions":  "The JSON-encoded copies of the cosigning session",
+
+	// MoveFundsCmd help.
+	"movefunds--synopsis": "Authors, signs, and sends a transaction transferring an amount between two accounts of the wallet.\n" +
+		"The amount is paid to a new internal address of the destination account and the transaction is listed under the transfer category.",
+	"movefunds-fromaccount": "Account to pick unspent outputs from",
+	"movefunds-toaccount":   "Account to transfer the amount to",
+	"movefunds-amount":      "Amount to transfer valued in valhallacoin",
+	"movefunds-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"movefunds--result0":    "The transaction hash of the transfer",
+
+	// FillDepositPoolCmd help.
+	"filldepositpool--synopsis": "Reserves external addresses of an account for deposits until the given number of reserved addresses are available for assignment.\n" +
+		"Every address is derived and recorded in a single database update, and a depositaddress notification is sent for each new address.\n" +
+		"Reserved addresses are subject to the unused address gap limit so that they are found when restoring from seed, and size may not exceed the gap limit.",
+	"filldepositpool-account": "Name of the account",
+	"filldepositpool-size":    "Number of available reserved addresses to maintain",
+
+	// AssignDepositAddressCmd help.
+	"assigndepositaddress--synopsis": "Assigns the oldest available reserved deposit address of an account, recording an optional reference such as a customer identifier.",
+	"assigndepositaddress-account":   "Name of the account",
+	"assigndepositaddress-reference": "Reference to record with the assigned address",
+
+	// ListDepositAddressesCmd help.
+	"listdepositaddresses--synopsis": "Returns the reserved deposit addresses of the wallet, ordered by account and then by creation.",
+	"listdepositaddresses-account":   "Only include addresses of this account",
+	"listdepositaddresses-status":    "Only include addresses with this status (\"available\" or \"assigned\")",
+
+	// DepositAddressResult help.
+	"depositaddressresult-account":   "Name of the account the address belongs to",
+	"depositaddressresult-address":   "The reserved address",
+	"depositaddressresult-index":     "Child index of the address in the account's external branch",
+	"depositaddressresult-status":    "Assignment status of the address (\"available\" or \"assigned\")",
+	"depositaddressresult-created":   "Unix time the address was reserved",
+	"depositaddressresult-assigned":  "Unix time the address was assigned",
+	"depositaddressresult-reference": "Reference recorded when the address was assigned",
+
+	// SetAPIVersionCmd help.
+	"setapiversion--synopsis": "Selects the API version used to handle every following request of the connection, in the form major[.minor[.patch]] (websocket clients only).\n" +
+		"Results of methods which changed shape since the selected major version are returned in the shape of that version.\n" +
+		"HTTP POST clients instead select the version of a request with the X-Vhcwallet-Api-Version header.",
+	"setapiversion-version": "The requested API version, which may not be newer than the server's version or older than major version 4",
+
+	// NotifyBlocksCmd help.
+	"notifyblocks--synopsis": "Requests blockconnected and blockdisconnected notifications as blocks are processed by the wallet (websocket clients only).\n" +
+		"The subscribed transactions of each blockconnected notification are the wallet's transactions mined in the block.",
+
+	// NotifyDepositAddressesCmd help.
+	"notifydepositaddresses--synopsis": "Requests a depositaddress notification for each address reserved by filldepositpool (websocket clients only).",
+
+	// NotifyMissedVotesCmd help.
+	"notifymissedvotes--synopsis": "Requests a missedvote notification for each ticket with voting authority held by the wallet that was selected to vote on a block but whose vote was not included in the next block (websocket clients only).",
+
+	// NotifyPendingRevocationsCmd help.
+	"notifypendingrevocations--synopsis": "Requests a pendingrevocation notification for each missed ticket whose automatic revocation is delayed by the revocationdelay option (websocket clients only).\nNotifications are dropped for clients which fall behind; use listpendingrevocations for the complete list.",
+
+	// NotifyRescanProgressCmd help.
+	"notifyrescanprogress--synopsis": "Requests a rescanprogress notification with the current height, percent complete, and addresses discovered each time a range of blocks is scanned by a rescan, and when each rescan ends (websocket clients only).",
+
+	// NotifyTicketsCmd help.
+	"notifytickets--synopsis": "Requests a ticketstatus notification each time a ticket of the wallet becomes live, votes, is missed, expires, or is revoked in a block attached to the main chain (websocket clients only).\n" +
+		"Tickets are only reported missed when the wallet detects the missed vote of a ticket it was selected to vote with.",
+
+	// NotifyVoteVersionCmd help.
+	"notifyvoteversion--synopsis": "Requests a voteversion notification each time the votes cast by the wallet become outdated, or compatible again, with the stake version of recent blocks (websocket clients only).\n" +
+		"Votes are outdated after a network upgrade to a stake version newer than the wallet's vote version, and do not vote on the agendas of the newer version.",
+
+	// NotifyNewTransactionsCmd help.
+	"notifynewtransactions--synopsis": "Requests a newtx notification for each listtransactions result of transactions added to the wallet (websocket clients only).",
+	"notifynewtransactions-verbose":   "Unused",
+
+	// NotifyWinningTicketsCmd help.
+	"notifywinningtickets--synopsis": "Requests winningtickets notifications when tickets owned by the wallet are selected to vote on a block (websocket clients only).",
+
+	// StopNotifyBlocksCmd help.
+	"stopnotifyblocks--synopsis": "Cancels notifications requested with notifyblocks (websocket clients only).",
+
+	// StopNotifyDepositAddressesCmd help.
+	"stopnotifydepositaddresses--synopsis": "Cancels notifications requested with notifydepositaddresses (websocket clients only).",
+
+	// StopNotifyMissedVotesCmd help.
+	"stopnotifymissedvotes--synopsis": "Cancels notifications requested with notifymissedvotes (websocket clients only).",
+
+	// StopNotifyNewTransactionsCmd help.
+	"stopnotifynewtransactions--synopsis": "Cancels notifications requested with notifynewtransactions (websocket clients only).",
+
+	// StopNotifyPendingRevocationsCmd help.
+	"stopnotifypendingrevocations--synopsis": "Cancels notifications requested with notifypendingrevocations (websocket clients only).",
+
+	// StopNotifyRescanProgressCmd help.
+	"stopnotifyrescanprogress--synopsis": "Cancels notifications requested with notifyrescanprogress (websocket clients only).",
+
+	// StopNotifyTicketsCmd help.
+	"stopnotifytickets--synopsis": "Cancels notifications requested with notifytickets (websocket clients only).",
+
+	// StopNotifyVoteVersionCmd help.
+	"stopnotifyvoteversion--synopsis": "Cancels notifications requested with notifyvoteversion (websocket clients only).",
+
+	// StopNotifyWinningTicketsCmd help.
+	"stopnotifywinningtickets--synopsis": "Cancels notifications requested with notifywinningtickets (websocket clients only).",
+
+	// CreateMultisigAccountCmd help.
+	"createmultisigaccount--synopsis": "Creates an HD multisig account whose addresses require nrequired signatures of the keys derived from the extended public key of a wallet account and every cosigner extended public key.\n" +
+		"Each cosigner creates the account with the same keys to derive identical addresses for each branch and child index.",
+	"createmultisigaccount-name":      "The name of the multisig account",
+	"createmultisigaccount-account":   "The wallet account whose extended public key is one of the cosigner keys",
+	"createmultisigaccount-nrequired": "The number of signatures required to spend outputs of the account",
+	"createmultisigaccount-xpubs":     "The account extended public keys of the other cosigners",
+
+	// GetMultisigAccountAddressCmd help.
+	"getmultisigaccountaddress--synopsis": "Returns the P2SH address and redeem script of the next child index of a multisig account branch and watches the address for received outputs.",
+	"getmultisigaccountaddress-name":      "The name of the multisig account",
+	"getmultisigaccountaddress-internal":  "Derive the address from the internal branch instead of the external branch",
+
+	// GetMultisigAccountAddressResult help.
+	"getmultisigaccountaddressresult-address":      "The P2SH address of the child",
+	"getmultisigaccountaddressresult-redeemscript": "The hex encoded multisig redeem script",
+	"getmultisigaccountaddressresult-branch":       "The branch of the child (0=external, 1=internal)",
+	"getmultisigaccountaddressresult-index":        "The child index",
+
+	// ListMultisigAccountsCmd help.
+	"listmultisigaccounts--synopsis": "Returns every HD multisig account of the wallet.",
+
+	// MultisigAccountResult help.
+	"multisigaccountresult-name":         "The name of the multisig account",
+	"multisigaccountresult-account":      "The wallet account whose extended public key is one of the cosigner keys",
+	"multisigaccountresult-m":            "The number of signatures required to spend outputs of the account",
+	"multisigaccountresult-n":            "The number of cosigner keys",
+	"multisigaccountresult-xpubs":        "The account extended public keys of every cosigner",
+	"multisigaccountresult-nextexternal": "The next child index of the external branch",
+	"multisigaccountresult-nextinternal": "The next child index of the internal branch",
+
+	// RedeemMultisigAccountCmd help.
+	"redeemmultisigaccount--synopsis": "Redeems the unspent outputs paying addresses of a multisig account in transactions spending up to batch outputs each, signed by the wallet.\n" +
+		"Transactions which are not complete must be signed by other cosigners, for example with a cosigning session.",
+	"redeemmultisigaccount-name":      "The name of the multisig account",
+	"redeemmultisigaccount-toaddress": "The address paid by each transaction; a new internal address of the default account is used for each transaction when omitted",
+	"redeemmultisigaccount-feerate":   "The fee rate (in VHC/kB) paid for the size of the fully signed transactions; the wallet's relay fee is used when omitted",
+	"redeemmultisigaccount-batch":     "The maximum number of outputs spent by each transaction",
+
+	// ListMultisigUnspentCmd help.
+	"listmultisigunspent--synopsis": "Returns every unspent P2SH multisig output recorded by the wallet with its redeem script and confirmation data.",
+	"listmultisigunspent-address":   "Only return outputs paying this P2SH address",
+
+	// ListMultisigUnspentResult help.
+	"listmultisigunspentresult-txhash":        "The hash of the transaction creating the output",
+	"listmultisigunspentresult-vout":          "The output index",
+	"listmultisigunspentresult-tree":          "The transaction tree of the output",
+	"listmultisigunspentresult-address":       "The P2SH address paid by the output",
+	"listmultisigunspentresult-redeemscript":  "The hex encoded multisig redeem script",
+	"listmultisigunspentresult-m":             "The number of signatures required to spend the output",
+	"listmultisigunspentresult-n":             "The number of public keys of the redeem script",
+	"listmultisigunspentresult-pubkeys":       "The hex encoded public keys of the redeem script",
+	"listmultisigunspentresult-amount":        "The output amount (in VHC)",
+	"listmultisigunspentresult-blockhash":     "The hash of the block mining the output, omitted when unmined",
+	"listmultisigunspentresult-blockheight":   "The height of the block mining the output, omitted when unmined",
+	"listmultisigunspentresult-confirmations": "The number of block confirmations of the output",
+
+	// ListMissedVotesCmd help.
+	"listmissedvotes--synopsis": "Returns the tickets with voting authority held by the wallet most recently detected to have missed their votes, ordered by the height of the block they were selected to vote on.\n" +
+		"A vote is missed when it is not included in the block following the block the ticket was selected to vote on. Missed votes are only detected while the wallet is synced and are not remembered across restarts.",
+
+	// MissedVoteResult help.
+	"missedvoteresult-tickethash":  "Hash of the ticket which missed its vote",
+	"missedvoteresult-blockhash":   "Hash of the block the ticket was selected to vote on",
+	"missedvoteresult-blockheight": "Height of the block the ticket was selected to vote on",
+	"missedvoteresult-detected":    "Unix time the missed vote was detected",
+
+	// ListPendingRevocationsCmd help.
+	"listpendingrevocations--synopsis": "Returns the missed tickets whose automatic revocations are delayed by the revocationdelay option, ordered by the time they will be revoked.",
+
+	// PendingRevocationResult help.
+	"pendingrevocationresult-tickethash": "Hash of the missed ticket",
+	"pendingrevocationresult-reported":   "Unix time the ticket was reported missed",
+	"pendingrevocationresult-scheduled":  "Unix time the revocation will be created and published",
+
+	// ListRevocableTicketsCmd help.
+	"listrevocabletickets--synopsis": "Returns the unrevoked missed and expired tickets with voting authority held by the wallet, ordered by the height they were mined at, for review before calling revoketickets.\n" +
+		"Expired tickets are determined from the main chain tip and missed tickets from the missed votes detected by the wallet (see listmissedvotes).",
+
+	// RevocableTicketResult help.
+	"revocableticketresult-tickethash":  "Hash of the ticket",
+	"revocableticketresult-status":      "Status of the ticket (\"missed\" or \"expired\")",
+	"revocableticketresult-blockheight": "Height of the block the ticket was mined in",
+	"revocableticketresult-fee":         "Estimated fee of the revocation at the relay fee",
+
+	// CancelRevocationCmd help.
+	"cancelrevocation--synopsis": "Cancels the pending automatic revocation of a missed ticket, e.g. when the miss report is believed to be spurious.\n" +
+		"The cancellation is recorded in the wallet database, and the ticket is not revoked automatically again, but may still be revoked with revoketickets.",
+	"cancelrevocation-tickethash": "Hash of the missed ticket",
+
+	// OpenWalletCmd help.
+	"openwallet--synopsis": "Opens the existing wallet of the wallet data directory when no wallet is loaded.\n" +
+		"This is intended for servers started with the noinitialload option.\n" +
+		"The opened wallet is synchronized with the network until it is closed.",
+	"openwallet-publicpassphrase": "The public passphrase of the wallet, or the insecure default public passphrase if unset or empty",
+
+	// OpenWalletResult help.
+	"openwalletresult-watchingonly": "Whether the opened wallet is watching-only",
+
+	// OverrideSpendingPolicyCmd help.
+	"overridespendingpolicy--synopsis":  "Allows sends from an account to exceed the account's spending limits for a limited time.",
+	"overridespendingpolicy-account":    "Name of the account",
+	"overridespendingpolicy-passphrase": "The override passphrase of the account's spending policy",
+	"overridespendingpolicy-timeout":    "Number of seconds the override remains active",
+
+	// ReleaseOutputsCmd help.
+	"releaseoutputs--synopsis": "Releases the outputs of a reservation created by reserveoutputs before the reservation expires.",
+	"releaseoutputs-id":        "The ID of the reservation",
+
+	// ReserveOutputsCmd help.
+	"reserveoutputs--synopsis": "Selects and locks unspent outputs of an account for a transaction which is signed outside of the wallet, such as by a hardware wallet or multisig cosigners.\n" +
+		"Outputs are selected largest first until their total reaches the amount, and either every selected output is reserved or none are.\n" +
+		"Reserved outputs are not chosen for transaction inputs of authored transactions or other reservations, and are released automatically when the reservation expires.\n" +
+		"Reservations are volatile and are not saved across wallet restarts.",
+	"reserveoutputs-account": "Account to reserve unspent outputs from",
+	"reserveoutputs-amount":  "Minimum total amount of the reserved outputs, valued in valhallacoin",
+	"reserveoutputs-minconf": "Minimum number of block confirmations required for reserved outputs",
+	"reserveoutputs-ttl":     "Number of seconds after which the outputs are released automatically",
+
+	// ReserveOutputsResult help.
+	"reserveoutputsresult-id":      "The ID of the reservation, used to release the outputs with releaseoutputs",
+	"reserveoutputsresult-outputs": "The reserved outputs",
+	"reserveoutputsresult-total":   "The total amount of the reserved outputs valued in valhallacoin",
+	"reserveoutputsresult-expires": "The Unix time the reservation expires",
+
+	// ReservedOutputResult help.
+	"reservedoutputresult-txid":         "The transaction hash of the reserved output",
+	"reservedoutputresult-vout":         "The output index of the reserved output",
+	"reservedoutputresult-tree":         "The tree of the transaction of the reserved output",
+	"reservedoutputresult-amount":       "The amount of the output valued in valhallacoin",
+	"reservedoutputresult-scriptPubKey": "The output script encoded as a hexadecimal string",
+
+	// RotateAccountCmd help.
+	"rotateaccount--synopsis": "Rotates an account whose extended public key has leaked and migrates its funds to a successor account.\n" +
+		"The first request for an account creates the successor account and marks the rotated account receive-only: its previously derived addresses remain watched but no new addresses are derived.\n" +
+		"Each request sweeps up to maxinputs spendable outputs of the rotated account to a new internal address of the successor, so funds may be migrated over several transactions by repeating the request.",
+	"rotateaccount-account":    "The account to rotate",
+	"rotateaccount-newaccount": "The name of the successor account, required unless the account was already rotated",
+	"rotateaccount-maxinputs":  "Maximum number of outputs to sweep to the successor account, or 0 to not sweep",
+
+	// RotateAccountResult help.
+	"rotateaccountresult-account":          "The rotated account",
+	"rotateaccountresult-successor":        "The successor account receiving the swept funds",
+	"rotateaccountresult-rotatedtime":      "The Unix time the account was rotated",
+	"rotateaccountresult-sweeptxhash":      "The hash of the sweep transaction, if any outputs were swept",
+	"rotateaccountresult-remainingbalance": "The total balance remaining in the rotated account valued in valhallacoin",
+
+	// RejectSendCmd help.
+	"rejectsend--synopsis": "Removes a send queued by the wallet for approval without creating the transaction.",
+	"rejectsend-id":        "The ID of the pending send",
+
 	// PurchaseTicketCmd help.
-	"purchaseticket--synopsis":          "Purchase ticket using available funds.",
+	"purchaseticket--synopsis":          "Purchase ticket using available funds.  Tickets are registered with the selected VSP when no ticket or pool address is given.",
 	"purchaseticket--result0":           "Hash of the resulting ticket",
 	"purchaseticket-spendlimit":         "Limit on the amount to spend on ticket",
 	"purchaseticket-fromaccount":        "The account to use for purchase (default=\"default\")",
 	"purchaseticket-minconf":            "Minimum number of block confirmations required",
-	"purchaseticket-ticketaddress":      "Override the ticket address to which voting rights are given",
+	"purchaseticket-ticketaddress":      "Override the ticket address to which voting rights are given (defaults to the configured voting address, then an address derived from the --ticketbuyer.votingxpub extended public key, then a wallet address)",
 	"purchaseticket-numtickets":         "The number of tickets to purchase",
 	"purchaseticket-pooladdress":        "The address to pay stake pool fees to",
 	"purchaseticket-poolfees":           "The amount of fees to pay to the stake pool",
@@ -691,6 +1778,62 @@ var helpDescsEnUS = map[string]string{
 	"purchaseticket-comment":            "Unused",
 	"purchaseticket-ticketfee":          "The transaction fee rate (VHC/kB) to use (overrides fees set by the wallet config or settxfee RPC)",
 
+	// SearchWalletCmd help.
+	"searchwallet--synopsis": "Searches the wallet for transactions, addresses, accounts, and deposit address references matching part of a transaction hash, an address, an account name, or a reference.\n" +
+		"Addresses are matched case-sensitively and other records regardless of case.\n" +
+		"Transactions are returned newest first, followed by addresses, accounts, and deposit references.",
+	"searchwallet-query": "Part of a transaction hash, address, account name, or deposit reference (at least 3 characters)",
+	"searchwallet-count": "Maximum number of matches to return, or 0 for every match",
+
+	// SearchWalletResult help.
+	"searchwalletresult-kind":        "Kind of record matched (\"transaction\", \"address\", \"account\", or \"depositreference\")",
+	"searchwalletresult-txid":        "Hash of a matched transaction",
+	"searchwalletresult-blockheight": "Height of the block mining a matched transaction, or -1 if unmined",
+	"searchwalletresult-time":        "Unix time of the block mining a matched transaction, or the time it was received if unmined",
+	"searchwalletresult-address":     "Matched address, or the deposit address assigned to a matched reference",
+	"searchwalletresult-account":     "Account of the matched address, account, or deposit address",
+	"searchwalletresult-reference":   "Matched deposit address reference",
+
+	// SetAccountGapPolicyCmd help.
+	"setaccountgappolicy--synopsis": "Sets the gap policy used when generating addresses for an account without specifying a policy.",
+	"setaccountgappolicy-account":   "Name of the account",
+	"setaccountgappolicy-gappolicy": "Policy used when the unused address gap limit would be exceeded (\"error\", \"ignore\", or \"wrap\")",
+
+	// SetAutoConsolidationCmd help.
+	"setautoconsolidation--synopsis": "Configures the automatic consolidation of the outputs of an account, replacing any previous configuration of the account.\n" +
+		"As each block is attached to the main chain, the outputs of the account are consolidated to a new internal address, paying the relay fee, if the account holds more than threshold spendable outputs and the block used no more than maxblockusage of the maximum block size.\n" +
+		"Consolidations are only performed while the wallet is unlocked, and the configuration must be set again each time the wallet is loaded.",
+	"setautoconsolidation-account":       "Name of the account",
+	"setautoconsolidation-threshold":     "The number of spendable outputs which must be exceeded before outputs are consolidated, or 0 to disable automatic consolidation",
+	"setautoconsolidation-maxinputs":     "The maximum number of outputs consolidated by each transaction, or 0 to only limit inputs by the maximum transaction size",
+	"setautoconsolidation-maxblockusage": "The fraction of the maximum block size the latest block may use for outputs to be consolidated",
+
+	// SetSendApprovalCmd help.
+	"setsendapproval--synopsis":         "Requires sends from an account to be queued by the wallet and approved with approvesend using a second passphrase, or removes this requirement. Sends from such accounts made by sendtoaddress, sendfrom, and sendmany return the ID of the pending send, and other methods creating transactions from the account are refused.",
+	"setsendapproval-account":           "Name of the account",
+	"setsendapproval-passphrase":        "New approval passphrase, or an empty string to no longer require approval",
+	"setsendapproval-currentpassphrase": "The current approval passphrase, required if the account already requires approval",
+
+	// SetSpendingPolicyCmd help.
+	"setspendingpolicy--synopsis":                 "Sets the per-transaction and daily (UTC) limits of the total output amount that may be sent from an account.",
+	"setspendingpolicy-account":                   "Name of the account",
+	"setspendingpolicy-txlimit":                   "Maximum amount which may be sent by a single transaction, or 0 to disable this limit",
+	"setspendingpolicy-dailylimit":                "Maximum total amount which may be sent during a UTC day, or 0 to disable this limit",
+	"setspendingpolicy-overridepassphrase":        "New passphrase allowing the limits to be exceeded using overridespendingpolicy (unchanged if unset, removed if empty)",
+	"setspendingpolicy-currentoverridepassphrase": "The current override passphrase, required if the policy already has one",
+
+	// SetUnlockSessionTimeoutCmd help.
+	"setunlocksessiontimeout--synopsis": "Sets the duration that the key derived from the private passphrase is cached after an unlock. Unlocking again with the same passphrase before the timeout elapses skips the expensive key derivation.",
+	"setunlocksessiontimeout-timeout":   "Number of seconds the derived key is cached, or 0 to disable caching and clear any cached key",
+
+	// SetUTXOPolicyCmd help.
+	"setutxopolicy--synopsis": "Sets the spend policy of an unspent output, replacing any previous policy.\n" +
+		"Policies are saved in the wallet database and restrict which transactions created by the wallet may select the output as an input:\n" +
+		"\"donotspend\" outputs are never selected, \"reservefortickets\" outputs are only selected by ticket purchases, and \"dust\" outputs are only selected by account sweeps.",
+	"setutxopolicy-txid":     "The hash of the transaction of the output",
+	"setutxopolicy-vout":     "The output index",
+	"setutxopolicy-policies": "Policy flags of the output (\"donotspend\", \"reservefortickets\", or \"dust\"), or an empty array to remove all restrictions",
+
 	// SetTicketFeeCmd help.
 	"setticketfee--synopsis": "Modify the fee per kB of the serialized tx size used each time more fee is required for an authored stake transaction.",
 	"setticketfee-fee":       "The new fee per kB of the serialized tx size valued in valhallacoin",
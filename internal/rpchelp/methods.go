@@ -3,11 +3,15 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-//+build !generate
+//go:build !generate
+// +build !generate
 
 package rpchelp
 
-import "github.com/valhallacoin/vhcd/vhcjson"
+import (
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+)
 
 // Common return types.
 var (
@@ -28,77 +32,188 @@ var Methods = []struct {
 	{"accountsyncaddressindex", nil},
 	{"addmultisigaddress", returnsString},
 	{"addticket", nil},
+	{"approvesend", returnsString},
+	{"assigndepositaddress", []interface{}{(*types.DepositAddressResult)(nil)}},
+	{"cancelrescan", nil},
+	{"cancelrevocation", nil},
+	{"clearguard", nil},
+	{"clearunlocksession", nil},
+	{"closewallet", nil},
 	{"consolidate", returnsString},
+	{"consolidateaccount", returnsString},
+	{"createcosignsession", []interface{}{(*types.CosignSessionResult)(nil)}},
 	{"createmultisig", []interface{}{(*vhcjson.CreateMultiSigResult)(nil)}},
 	{"createnewaccount", nil},
+	{"createmultisigaccount", nil},
+	{"createownershipproof", []interface{}{(*types.OwnershipProof)(nil)}},
+	{"createsplitticketsession", []interface{}{(*types.SplitTicketSessionResult)(nil)}},
+	{"createunsignedtickets", []interface{}{(*types.CreateUnsignedTicketsResult)(nil)}},
+	{"createwallet", []interface{}{(*types.CreateWalletResult)(nil)}},
+	{"decoderawtransaction", []interface{}{(*vhcjson.TxRawDecodeResult)(nil)}},
+	{"decodescript", []interface{}{(*types.DecodeScriptResult)(nil)}},
+	{"deleteprivkey", []interface{}{(*int)(nil)}},
+	{"deletescript", []interface{}{(*int)(nil)}},
+	{"disablevoting", nil},
 	{"dumpprivkey", returnsString},
+	{"enablevoting", nil},
+	{"exportvotechoices", []interface{}{(*types.VoteChoicesDocument)(nil)}},
 	{"exportwatchingwallet", returnsString},
+	{"filldepositpool", []interface{}{(*[]types.DepositAddressResult)(nil)}},
+	{"finalizecosignsession", []interface{}{(*types.FinalizeCosignSessionResult)(nil)}},
 	{"generatevote", []interface{}{(*vhcjson.GenerateVoteResult)(nil)}},
+	{"generatevotes", []interface{}{(*[]types.GenerateVotesResult)(nil)}},
 	{"getaccountaddress", returnsString},
 	{"getaccount", returnsString},
+	{"getaccountreceived", []interface{}{(*types.ReceivedResult)(nil)}},
+	{"getaccountstats", []interface{}{(*types.GetAccountStatsResult)(nil)}},
+	{"getapischema", []interface{}{(*types.GetAPISchemaResult)(nil)}},
+	{"getauditlog", []interface{}{(*[]types.GetAuditLogResult)(nil)}},
+	{"getautobuyerstatus", []interface{}{(*types.GetAutoBuyerStatusResult)(nil)}},
+	{"getautoconsolidation", []interface{}{(*types.GetAutoConsolidationResult)(nil)}},
+	{"getaddressreceived", []interface{}{(*types.ReceivedResult)(nil)}},
 	{"getaddressesbyaccount", returnsStringArray},
 	{"getbalance", []interface{}{(*vhcjson.GetBalanceResult)(nil)}},
+	{"getbalanceathash", []interface{}{(*types.GetBalanceAtHashResult)(nil)}},
+	{"getbuildinfo", []interface{}{(*types.GetBuildInfoResult)(nil)}},
+	{"getdbstats", []interface{}{(*types.GetDBStatsResult)(nil)}},
 	{"getbestblockhash", returnsString},
+	{"getfeatureflags", []interface{}{(*[]types.GetFeatureFlagsResult)(nil)}},
+	{"getguardstatus", []interface{}{(*types.GetGuardStatusResult)(nil)}},
 	{"getbestblock", []interface{}{(*vhcjson.GetBestBlockResult)(nil)}},
 	{"getblockcount", returnsNumber},
-	{"getinfo", []interface{}{(*vhcjson.InfoWalletResult)(nil)}},
+	{"getinfo", []interface{}{(*types.InfoWalletResult)(nil)}},
 	{"getmasterpubkey", []interface{}{(*string)(nil)}},
 	{"getmultisigoutinfo", []interface{}{(*vhcjson.GetMultisigOutInfoResult)(nil)}},
 	{"getnewaddress", returnsString},
+	{"getpeerinfo", []interface{}{(*[]types.GetPeerInfoResult)(nil)}},
 	{"getrawchangeaddress", returnsString},
 	{"getreceivedbyaccount", returnsNumber},
 	{"getreceivedbyaddress", returnsNumber},
+	{"getmultisigaccountaddress", []interface{}{(*types.GetMultisigAccountAddressResult)(nil)}},
+	{"getrescanstatus", []interface{}{(*types.GetRescanStatusResult)(nil)}},
+	{"getresponsesigningkey", []interface{}{(*types.GetResponseSigningKeyResult)(nil)}},
+	{"getspendingpolicy", []interface{}{(*types.GetSpendingPolicyResult)(nil)}},
 	{"getstakeinfo", []interface{}{(*vhcjson.GetStakeInfoResult)(nil)}},
+	{"getstakingstats", []interface{}{(*types.GetStakingStatsResult)(nil)}},
+	{"getsyncstatus", []interface{}{(*types.GetSyncStatusResult)(nil)}},
 	{"getticketfee", returnsNumber},
+	{"getticketinfo", []interface{}{(*types.GetTicketInfoResult)(nil)}},
 	{"gettickets", []interface{}{(*vhcjson.GetTicketsResult)(nil)}},
 	{"gettransaction", []interface{}{(*vhcjson.GetTransactionResult)(nil)}},
 	{"getunconfirmedbalance", returnsNumber},
 	{"getvotechoices", []interface{}{(*vhcjson.GetVoteChoicesResult)(nil)}},
+	{"getvspinfo", []interface{}{(*types.GetVSPInfoResult)(nil)}},
 	{"getwalletfee", returnsNumber},
 	{"help", append(returnsString, returnsString[0])},
+	{"importmulti", []interface{}{(*[]types.ImportMultiResult)(nil)}},
+	{"importpkcs11account", nil},
 	{"importprivkey", nil},
 	{"importscript", nil},
+	{"importvotechoices", nil},
+	{"joinsplitticketsession", []interface{}{(*types.SplitTicketSessionResult)(nil)}},
 	{"keypoolrefill", nil},
 	{"listaccounts", []interface{}{(*map[string]float64)(nil)}},
 	{"listaddresstransactions", returnsLTRArray},
+	{"listdepositaddresses", []interface{}{(*[]types.DepositAddressResult)(nil)}},
 	{"listalltransactions", returnsLTRArray},
 	{"listlockunspent", []interface{}{(*[]vhcjson.TransactionInput)(nil)}},
+	{"listmissedvotes", []interface{}{(*[]types.MissedVoteResult)(nil)}},
+	{"listmultisigaccounts", []interface{}{(*[]types.MultisigAccountResult)(nil)}},
+	{"listmultisigunspent", []interface{}{(*[]types.ListMultisigUnspentResult)(nil)}},
+	{"listoutpointlocks", []interface{}{(*[]types.OutpointLockResult)(nil)}},
+	{"listpendingrevocations", []interface{}{(*[]types.PendingRevocationResult)(nil)}},
+	{"listpendingsends", []interface{}{(*[]types.ListPendingSendsResult)(nil)}},
 	{"listreceivedbyaccount", []interface{}{(*[]vhcjson.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]vhcjson.ListReceivedByAddressResult)(nil)}},
-	{"listscripts", []interface{}{(*vhcjson.ListScriptsResult)(nil)}},
+	{"listrevocabletickets", []interface{}{(*[]types.RevocableTicketResult)(nil)}},
+	{"listscripts", []interface{}{(*types.ListScriptsResult)(nil)}},
 	{"listsinceblock", []interface{}{(*vhcjson.ListSinceBlockResult)(nil)}},
+	{"listsplittickets", []interface{}{(*[]types.SplitTicketResult)(nil)}},
+	{"liststakepoolusertickets", []interface{}{(*types.ListStakePoolUserTicketsResult)(nil)}},
+	{"listticketstatuses", []interface{}{(*types.ListTicketStatusesResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
-	{"listunspent", []interface{}{(*vhcjson.ListUnspentResult)(nil)}},
+	{"listunspent", []interface{}{(*[]types.ListUnspentResult)(nil)}},
+	{"listunspentpage", []interface{}{(*[]types.ListUnspentResult)(nil)}},
+	{"listwallets", []interface{}{(*[]types.ListWalletsResult)(nil)}},
 	{"lockunspent", returnsBool},
+	{"lockunspentnamespace", returnsBool},
+	{"mergecosignsessions", []interface{}{(*types.CosignSessionResult)(nil)}},
+	{"movefunds", returnsString},
+	{"notifyblocks", nil},
+	{"notifydepositaddresses", nil},
+	{"notifymissedvotes", nil},
+	{"notifynewtransactions", nil},
+	{"notifypendingrevocations", nil},
+	{"notifyrescanprogress", nil},
+	{"notifytickets", nil},
+	{"notifyvoteversion", nil},
+	{"notifywinningtickets", nil},
+	{"openwallet", []interface{}{(*types.OpenWalletResult)(nil)}},
+	{"overridespendingpolicy", nil},
 	{"purchaseticket", returnsString},
+	{"publishsplitticketsession", returnsString},
+	{"rejectsend", nil},
 	{"redeemmultisigout", []interface{}{(*vhcjson.RedeemMultiSigOutResult)(nil)}},
 	{"redeemmultisigouts", []interface{}{(*vhcjson.RedeemMultiSigOutResult)(nil)}},
+	{"redeemmultisigaccount", []interface{}{(*vhcjson.RedeemMultiSigOutsResult)(nil)}},
+	{"redeemmultisigoutsbatch", []interface{}{(*vhcjson.RedeemMultiSigOutsResult)(nil)}},
+	{"releaseoutputs", nil},
 	{"renameaccount", nil},
 	{"rescanwallet", nil},
+	{"reserveoutputs", []interface{}{(*types.ReserveOutputsResult)(nil)}},
 	{"revoketickets", nil},
+	{"rotateaccount", []interface{}{(*types.RotateAccountResult)(nil)}},
+	{"searchwallet", []interface{}{(*[]types.SearchWalletResult)(nil)}},
 	{"sendfrom", returnsString},
 	{"sendmany", returnsString},
+	{"sendsweepaccount", returnsStringArray},
 	{"sendtoaddress", returnsString},
 	{"sendtomultisig", returnsString},
+	{"setaccountgappolicy", nil},
+	{"setapiversion", nil},
+	{"setautoconsolidation", nil},
+	{"setsendapproval", nil},
+	{"setspendingpolicy", nil},
 	{"setticketfee", returnsBool},
 	{"settxfee", returnsBool},
+	{"setunlocksessiontimeout", nil},
+	{"setutxopolicy", nil},
 	{"setvotechoice", nil},
+	{"setvsp", nil},
+	{"signcosignsession", []interface{}{(*types.CosignSessionResult)(nil)}},
 	{"signmessage", returnsString},
 	{"signrawtransaction", []interface{}{(*vhcjson.SignRawTransactionResult)(nil)}},
-	{"signrawtransactions", []interface{}{(*vhcjson.SignRawTransactionsResult)(nil)}},
+	{"signrawtransactions", []interface{}{(*types.SignRawTransactionsResult)(nil)}},
+	{"signsplitticketsession", []interface{}{(*types.SplitTicketSessionResult)(nil)}},
+	{"stakehistory", []interface{}{(*[]types.VoteRecordResult)(nil)}},
 	{"stakepooluserinfo", []interface{}{(*vhcjson.StakePoolUserInfoResult)(nil)}},
 	{"startautobuyer", nil},
+	{"stopaccountautobuyer", nil},
 	{"stopautobuyer", nil},
-	{"sweepaccount", []interface{}{(*vhcjson.SweepAccountResult)(nil)}},
+	{"stopnotifyblocks", nil},
+	{"stopnotifydepositaddresses", nil},
+	{"stopnotifymissedvotes", nil},
+	{"stopnotifynewtransactions", nil},
+	{"stopnotifypendingrevocations", nil},
+	{"stopnotifyrescanprogress", nil},
+	{"stopnotifytickets", nil},
+	{"stopnotifyvoteversion", nil},
+	{"stopnotifywinningtickets", nil},
+	{"sweepaccount", []interface{}{(*types.SweepAccountResult)(nil)}},
+	{"sweepdust", []interface{}{(*types.SweepDustResult)(nil)}},
 	{"ticketsforaddress", returnsBool},
 	{"validateaddress", []interface{}{(*vhcjson.ValidateAddressWalletResult)(nil)}},
 	{"verifymessage", returnsBool},
+	{"verifyownershipproof", []interface{}{(*types.VerifyOwnershipProofResult)(nil)}},
 	{"version", []interface{}{(*map[string]vhcjson.VersionResult)(nil)}},
-	{"walletinfo", []interface{}{(*vhcjson.WalletInfoResult)(nil)}},
+	{"walletexists", returnsBool},
+	{"walletinfo", []interface{}{(*types.WalletInfoResult)(nil)}},
 	{"walletislocked", returnsBool},
 	{"walletlock", nil},
 	{"walletpassphrasechange", nil},
 	{"walletpassphrase", nil},
+	{"watchoutpoint", nil},
+	{"watchscript", nil},
 }
 
 // HelpDescs contains the locale-specific help strings along with the locale.
@@ -0,0 +1,187 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !generate
+// +build !generate
+
+package rpchelp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+)
+
+// maxCmdParams is the maximum number of parameters of any registered command.
+// It bounds the search for the concrete type of a command.
+const maxCmdParams = 32
+
+// Schema is a JSON Schema describing a parameter or result.
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Default              json.RawMessage    `json:"default,omitempty"`
+}
+
+// ContentDescriptor is an OpenRPC content descriptor describing a method
+// parameter or result.
+type ContentDescriptor struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MethodSchema is an OpenRPC method object.
+type MethodSchema struct {
+	Name          string               `json:"name"`
+	Description   string               `json:"description,omitempty"`
+	Params        []*ContentDescriptor `json:"params"`
+	Result        *ContentDescriptor   `json:"result"`
+	WebsocketOnly bool                 `json:"x-websocketonly,omitempty"`
+}
+
+// cmdType returns the concrete type of the command registered for a method.
+// vhcjson does not expose registered types, so the command is unmarshaled from
+// null parameters, increasing the number of parameters until the count is
+// accepted.
+func cmdType(method string) (reflect.Type, error) {
+	params := make([]json.RawMessage, 0, maxCmdParams)
+	for {
+		cmd, err := vhcjson.UnmarshalCmd(&vhcjson.Request{Method: method, Params: params})
+		if err == nil {
+			return reflect.TypeOf(cmd).Elem(), nil
+		}
+		if e, ok := err.(vhcjson.Error); !ok || e.Code != vhcjson.ErrNumParams ||
+			len(params) == maxCmdParams {
+			return nil, err
+		}
+		params = append(params, json.RawMessage("null"))
+	}
+}
+
+// typeSchema returns the JSON Schema of a type.  Struct field descriptions are
+// looked up in descs using the same keys as the help generated by vhcjson.
+func typeSchema(rt reflect.Type, descs map[string]string, visiting map[reflect.Type]bool) *Schema {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	switch rt.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: typeSchema(rt.Elem(), descs, visiting)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: typeSchema(rt.Elem(), descs, visiting)}
+	case reflect.Struct:
+		s := &Schema{Type: "object"}
+		if visiting[rt] {
+			return s
+		}
+		visiting[rt] = true
+		defer delete(visiting, rt)
+		s.Properties = make(map[string]*Schema)
+		prefix := strings.ToLower(rt.Name()) + "-"
+		for i := 0; i < rt.NumField(); i++ {
+			f := rt.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name, omitempty := jsonFieldName(f)
+			if name == "-" {
+				continue
+			}
+			fs := typeSchema(f.Type, descs, visiting)
+			fs.Description = descs[prefix+name]
+			s.Properties[name] = fs
+			if !omitempty && f.Type.Kind() != reflect.Ptr {
+				s.Required = append(s.Required, name)
+			}
+		}
+		return s
+	default:
+		// Interfaces may hold any JSON value.
+		return &Schema{}
+	}
+}
+
+// jsonFieldName returns the JSON object key of a struct field and whether the
+// field is omitted when empty.
+func jsonFieldName(f reflect.StructField) (name string, omitempty bool) {
+	tag := f.Tag.Get("json")
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = strings.ToLower(f.Name)
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+// GenerateMethodSchemas returns the OpenRPC method objects of every method
+// with help, describing each parameter and result using the help descriptions
+// descs.
+func GenerateMethodSchemas(descs map[string]string) ([]*MethodSchema, error) {
+	schemas := make([]*MethodSchema, 0, len(Methods))
+	for i := range Methods {
+		m := &Methods[i]
+		rt, err := cmdType(m.Method)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %v", m.Method, err)
+		}
+		flags, err := vhcjson.MethodUsageFlags(m.Method)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %v", m.Method, err)
+		}
+		s := &MethodSchema{
+			Name:          m.Method,
+			Description:   descs[m.Method+"--synopsis"],
+			Params:        make([]*ContentDescriptor, 0, rt.NumField()),
+			WebsocketOnly: flags&vhcjson.UFWebsocketOnly != 0,
+		}
+		for j := 0; j < rt.NumField(); j++ {
+			f := rt.Field(j)
+			name := strings.ToLower(f.Name)
+			p := &ContentDescriptor{
+				Name:        name,
+				Description: descs[m.Method+"-"+name],
+				Required:    f.Type.Kind() != reflect.Ptr,
+				Schema:      typeSchema(f.Type, descs, make(map[reflect.Type]bool)),
+			}
+			if def, ok := f.Tag.Lookup("jsonrpcdefault"); ok {
+				p.Schema.Default = json.RawMessage(def)
+			}
+			s.Params = append(s.Params, p)
+		}
+		s.Result = &ContentDescriptor{
+			Name:        "result",
+			Description: descs[m.Method+"--result0"],
+			Schema:      &Schema{Type: "null"},
+		}
+		if len(m.ResultTypes) != 0 && m.ResultTypes[0] != nil {
+			s.Result.Schema = typeSchema(reflect.TypeOf(m.ResultTypes[0]), descs,
+				make(map[reflect.Type]bool))
+		}
+		schemas = append(schemas, s)
+	}
+	return schemas, nil
+}
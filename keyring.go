@@ -0,0 +1,61 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/internal/keyring"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// keyringService is the service name of private passphrases stored in the OS
+// keyring.
+const keyringService = "vhcwallet"
+
+// keyringPass unlocks the wallet with the private passphrase stored in the OS
+// keyring, which is keyed by the network directory of the wallet.  When the
+// keyring holds no passphrase, it is prompted for and stored after it unlocks
+// the wallet.
+func keyringPass(ctx context.Context, w *wallet.Wallet) ([]byte, error) {
+	user := networkDir(cfg.AppDataDir.Value, activeNet.Params)
+	passphrase, err := keyring.Get(keyringService, user)
+	switch {
+	case err == nil:
+		err = w.Unlock(passphrase, nil)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Unlocked wallet with the OS keyring passphrase")
+		return passphrase, nil
+	case !errors.Is(errors.NotExist, err):
+		return nil, err
+	}
+
+	log.Infof("No private passphrase for %s found in the OS keyring", user)
+	for {
+		passphrase, err = passPrompt(ctx, "Enter private passphrase to store in the OS keyring", false)
+		if err != nil {
+			return nil, err
+		}
+		err = w.Unlock(passphrase, nil)
+		if errors.Is(errors.Passphrase, err) {
+			fmt.Println("Incorrect password entered. Please try again.")
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	err = keyring.Set(keyringService, user, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Stored private passphrase in the OS keyring")
+	return passphrase, nil
+}
@@ -5,11 +5,14 @@
 package loader
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/hdkeychain"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcwallet/chain"
 	"github.com/valhallacoin/vhcwallet/errors"
@@ -39,8 +42,9 @@ type Loader struct {
 	db          wallet.DB
 	dbDriver    string
 
-	purchaseManager *ticketbuyer.PurchaseManager
-	ntfnClient      wallet.MainTipChangedNotificationsClient
+	// purchasers are the running ticket purchase strategies, in the order
+	// they were started.  Each strategy purchases from a different account.
+	purchasers []ticketPurchaser
 
 	stakeOptions    *StakeOptions
 	gapLimit        int
@@ -48,6 +52,29 @@ type Loader struct {
 	allowHighFees   bool
 	relayFee        float64
 
+	allowDuplicate    bool
+	instanceHeartbeat string
+
+	unlockSessionTimeout time.Duration
+	revocationDelay      time.Duration
+	vspMaxFee            vhcutil.Amount
+	voteCoordinator      wallet.VoteCoordinator
+	fullCheck            bool
+
+	// loadedCallbacks run each time the wallet is loaded, with a context
+	// that is cancelled by cancelLoaded when the wallet is unloaded.
+	loadedCallbacks []func(context.Context, *wallet.Wallet)
+	loadedCtx       context.Context
+	cancelLoaded    context.CancelFunc
+
+	// Loaders of named wallets are created by the default wallet's loader
+	// and record it as their parent.  Only the parent records the loaders
+	// of each named wallet and the callbacks run after they are loaded.
+	parent         *Loader
+	name           string
+	named          map[string]*Loader
+	namedCallbacks []func(context.Context, *Loader, *wallet.Wallet)
+
 	mu sync.Mutex
 }
 
@@ -57,6 +84,7 @@ type StakeOptions struct {
 	TicketFee           float64
 	AddressReuse        bool
 	VotingAddress       vhcutil.Address
+	VotingXpub          *hdkeychain.ExtendedKey
 	PoolAddress         vhcutil.Address
 	PoolFees            float64
 	StakePoolColdExtKey string
@@ -83,6 +111,44 @@ func (l *Loader) SetDatabaseDriver(driver string) {
 	l.dbDriver = driver
 }
 
+// SetDuplicateInstanceOptions specifies whether loaded wallets continue to
+// create and publish transactions after a duplicate instance is detected, and
+// the UDP address used to exchange heartbeats with other instances.
+func (l *Loader) SetDuplicateInstanceOptions(allow bool, heartbeatAddr string) {
+	l.allowDuplicate = allow
+	l.instanceHeartbeat = heartbeatAddr
+}
+
+// SetUnlockSessionTimeout specifies the duration that loaded wallets cache the
+// key derived from the private passphrase.
+func (l *Loader) SetUnlockSessionTimeout(timeout time.Duration) {
+	l.unlockSessionTimeout = timeout
+}
+
+// SetRevocationDelay specifies the duration that loaded wallets delay the
+// automatic revocation of missed tickets.
+func (l *Loader) SetRevocationDelay(delay time.Duration) {
+	l.revocationDelay = delay
+}
+
+// SetVSPMaxFee specifies the largest fee that loaded wallets pay a voting
+// service provider for a ticket.
+func (l *Loader) SetVSPMaxFee(fee vhcutil.Amount) {
+	l.vspMaxFee = fee
+}
+
+// SetVoteCoordinator specifies the coordinator of votes with redundant voting
+// wallets used by loaded wallets.
+func (l *Loader) SetVoteCoordinator(c wallet.VoteCoordinator) {
+	l.voteCoordinator = c
+}
+
+// SetFullCheck specifies whether loaded wallets perform a full consistency
+// check of the database when opened, rather than the quick structural check.
+func (l *Loader) SetFullCheck(full bool) {
+	l.fullCheck = full
+}
+
 // onLoaded executes each added callback and prevents loader from loading any
 // additional wallets.  Requires mutex to be locked.
 func (l *Loader) onLoaded(w *wallet.Wallet, db wallet.DB) {
@@ -93,6 +159,22 @@ func (l *Loader) onLoaded(w *wallet.Wallet, db wallet.DB) {
 	l.wallet = w
 	l.db = db
 	l.callbacks = nil // not needed anymore
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l.loadedCtx, l.cancelLoaded = ctx, cancel
+	for _, fn := range l.loadedCallbacks {
+		fn(ctx, w)
+	}
+
+	if l.parent != nil {
+		l.parent.mu.Lock()
+		l.parent.named[l.name] = l
+		callbacks := l.parent.namedCallbacks
+		l.parent.mu.Unlock()
+		for _, fn := range callbacks {
+			fn(ctx, l, w)
+		}
+	}
 }
 
 // RunAfterLoad adds a function to be executed when the loader creates or opens
@@ -110,6 +192,20 @@ func (l *Loader) RunAfterLoad(fn func(*wallet.Wallet)) {
 	}
 }
 
+// RunWhileLoaded adds a function to be executed each time the loader creates
+// or opens a wallet, including after the wallet is unloaded and loaded again.
+// The context is cancelled when the wallet is unloaded.  Functions are
+// executed in the order they are added, and must not block.
+func (l *Loader) RunWhileLoaded(fn func(ctx context.Context, w *wallet.Wallet)) {
+	l.mu.Lock()
+	l.loadedCallbacks = append(l.loadedCallbacks, fn)
+	w, ctx := l.wallet, l.loadedCtx
+	l.mu.Unlock()
+	if w != nil {
+		fn(ctx, w)
+	}
+}
+
 // CreateWatchingOnlyWallet creates a new watch-only wallet using the provided
 // extended public key and public passphrase.
 func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte) (w *wallet.Wallet, err error) {
@@ -175,20 +271,28 @@ func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte)
 	// Open the watch-only wallet.
 	so := l.stakeOptions
 	cfg := &wallet.Config{
-		DB:                  db,
-		PubPassphrase:       pubPass,
-		VotingEnabled:       so.VotingEnabled,
-		AddressReuse:        so.AddressReuse,
-		VotingAddress:       so.VotingAddress,
-		PoolAddress:         so.PoolAddress,
-		PoolFees:            so.PoolFees,
-		TicketFee:           so.TicketFee,
-		GapLimit:            l.gapLimit,
-		AccountGapLimit:     l.accountGapLimit,
-		StakePoolColdExtKey: so.StakePoolColdExtKey,
-		AllowHighFees:       l.allowHighFees,
-		RelayFee:            l.relayFee,
-		Params:              l.chainParams,
+		DB:                   db,
+		PubPassphrase:        pubPass,
+		VotingEnabled:        so.VotingEnabled,
+		AddressReuse:         so.AddressReuse,
+		VotingAddress:        so.VotingAddress,
+		VotingXpub:           so.VotingXpub,
+		PoolAddress:          so.PoolAddress,
+		PoolFees:             so.PoolFees,
+		TicketFee:            so.TicketFee,
+		GapLimit:             l.gapLimit,
+		AccountGapLimit:      l.accountGapLimit,
+		StakePoolColdExtKey:  so.StakePoolColdExtKey,
+		AllowHighFees:        l.allowHighFees,
+		AllowDuplicate:       l.allowDuplicate,
+		InstanceHeartbeat:    l.instanceHeartbeat,
+		UnlockSessionTimeout: l.unlockSessionTimeout,
+		RevocationDelay:      l.revocationDelay,
+		VSPMaxFee:            l.vspMaxFee,
+		VoteCoordinator:      l.voteCoordinator,
+		FullCheck:            l.fullCheck,
+		RelayFee:             l.relayFee,
+		Params:               l.chainParams,
 	}
 	w, err = wallet.Open(cfg)
 	if err != nil {
@@ -202,8 +306,12 @@ func (l *Loader) CreateWatchingOnlyWallet(extendedPubKey string, pubPass []byte)
 
 // CreateNewWallet creates a new wallet using the provided public and private
 // passphrases.  The seed is optional.  If non-nil, addresses are derived from
-// this seed.  If nil, a secure random seed is generated.
-func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w *wallet.Wallet, err error) {
+// this seed.  If nil, a secure random seed is generated.  The birthday is the
+// time the seed was created, or the zero time if unknown, and is used to skip
+// rescanning blocks mined before the wallet existed.
+func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte,
+	birthday time.Time) (w *wallet.Wallet, err error) {
+
 	const op errors.Op = "loader.CreateNewWallet"
 
 	defer l.mu.Unlock()
@@ -258,7 +366,7 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w
 	}
 
 	// Initialize the newly created database for the wallet before opening.
-	err = wallet.Create(db, pubPassphrase, privPassphrase, seed, l.chainParams)
+	err = wallet.Create(db, pubPassphrase, privPassphrase, seed, birthday, l.chainParams)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -266,20 +374,28 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w
 	// Open the newly-created wallet.
 	so := l.stakeOptions
 	cfg := &wallet.Config{
-		DB:                  db,
-		PubPassphrase:       pubPassphrase,
-		VotingEnabled:       so.VotingEnabled,
-		AddressReuse:        so.AddressReuse,
-		VotingAddress:       so.VotingAddress,
-		PoolAddress:         so.PoolAddress,
-		PoolFees:            so.PoolFees,
-		TicketFee:           so.TicketFee,
-		GapLimit:            l.gapLimit,
-		AccountGapLimit:     l.accountGapLimit,
-		StakePoolColdExtKey: so.StakePoolColdExtKey,
-		AllowHighFees:       l.allowHighFees,
-		RelayFee:            l.relayFee,
-		Params:              l.chainParams,
+		DB:                   db,
+		PubPassphrase:        pubPassphrase,
+		VotingEnabled:        so.VotingEnabled,
+		AddressReuse:         so.AddressReuse,
+		VotingAddress:        so.VotingAddress,
+		VotingXpub:           so.VotingXpub,
+		PoolAddress:          so.PoolAddress,
+		PoolFees:             so.PoolFees,
+		TicketFee:            so.TicketFee,
+		GapLimit:             l.gapLimit,
+		AccountGapLimit:      l.accountGapLimit,
+		StakePoolColdExtKey:  so.StakePoolColdExtKey,
+		AllowHighFees:        l.allowHighFees,
+		AllowDuplicate:       l.allowDuplicate,
+		InstanceHeartbeat:    l.instanceHeartbeat,
+		UnlockSessionTimeout: l.unlockSessionTimeout,
+		RevocationDelay:      l.revocationDelay,
+		VSPMaxFee:            l.vspMaxFee,
+		VoteCoordinator:      l.voteCoordinator,
+		FullCheck:            l.fullCheck,
+		RelayFee:             l.relayFee,
+		Params:               l.chainParams,
 	}
 	w, err = wallet.Open(cfg)
 	if err != nil {
@@ -327,20 +443,28 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte) (w *wallet.Wallet, rer
 
 	so := l.stakeOptions
 	cfg := &wallet.Config{
-		DB:                  db,
-		PubPassphrase:       pubPassphrase,
-		VotingEnabled:       so.VotingEnabled,
-		AddressReuse:        so.AddressReuse,
-		VotingAddress:       so.VotingAddress,
-		PoolAddress:         so.PoolAddress,
-		PoolFees:            so.PoolFees,
-		TicketFee:           so.TicketFee,
-		GapLimit:            l.gapLimit,
-		AccountGapLimit:     l.accountGapLimit,
-		StakePoolColdExtKey: so.StakePoolColdExtKey,
-		AllowHighFees:       l.allowHighFees,
-		RelayFee:            l.relayFee,
-		Params:              l.chainParams,
+		DB:                   db,
+		PubPassphrase:        pubPassphrase,
+		VotingEnabled:        so.VotingEnabled,
+		AddressReuse:         so.AddressReuse,
+		VotingAddress:        so.VotingAddress,
+		VotingXpub:           so.VotingXpub,
+		PoolAddress:          so.PoolAddress,
+		PoolFees:             so.PoolFees,
+		TicketFee:            so.TicketFee,
+		GapLimit:             l.gapLimit,
+		AccountGapLimit:      l.accountGapLimit,
+		StakePoolColdExtKey:  so.StakePoolColdExtKey,
+		AllowHighFees:        l.allowHighFees,
+		AllowDuplicate:       l.allowDuplicate,
+		InstanceHeartbeat:    l.instanceHeartbeat,
+		UnlockSessionTimeout: l.unlockSessionTimeout,
+		RevocationDelay:      l.revocationDelay,
+		VSPMaxFee:            l.vspMaxFee,
+		VoteCoordinator:      l.voteCoordinator,
+		FullCheck:            l.fullCheck,
+		RelayFee:             l.relayFee,
+		Params:               l.chainParams,
 	}
 	w, err = wallet.Open(cfg)
 	if err != nil {
@@ -395,6 +519,10 @@ func (l *Loader) UnloadWallet() error {
 
 	l.stopTicketPurchase()
 
+	if l.cancelLoaded != nil {
+		l.cancelLoaded()
+		l.loadedCtx, l.cancelLoaded = nil, nil
+	}
 	l.wallet.Stop()
 	l.wallet.WaitForShutdown()
 	err := l.db.Close()
@@ -423,18 +551,29 @@ func (l *Loader) NetworkBackend() (n wallet.NetworkBackend, ok bool) {
 	return n, n != nil
 }
 
-// StartTicketPurchase launches the ticketbuyer to start purchasing tickets.
+// ticketPurchaser is a running ticket purchase strategy and the notifications
+// client which drives it.
+type ticketPurchaser struct {
+	pm   *ticketbuyer.PurchaseManager
+	ntfn wallet.MainTipChangedNotificationsClient
+}
+
+// stop stops the strategy, waiting until it has finished.
+func (p *ticketPurchaser) stop() {
+	p.ntfn.Done()
+	p.pm.Stop()
+	p.pm.WaitForShutdown()
+}
+
+// StartTicketPurchase launches a ticketbuyer to start purchasing tickets from
+// the account of the config.  Several ticket buyers may run concurrently as
+// long as each purchases from a different account.
 func (l *Loader) StartTicketPurchase(passphrase []byte, ticketbuyerCfg *ticketbuyer.Config) error {
 	const op errors.Op = "loader.StartTicketPurchase"
 
 	defer l.mu.Unlock()
 	l.mu.Lock()
 
-	// Already running?
-	if l.purchaseManager != nil {
-		return errors.E(op, errors.Invalid, "ticket purchaser already started")
-	}
-
 	if l.wallet == nil {
 		return errors.E(op, errors.Invalid, "wallet must be loaded")
 	}
@@ -449,30 +588,39 @@ func (l *Loader) StartTicketPurchase(passphrase []byte, ticketbuyerCfg *ticketbu
 	if err != nil {
 		return errors.E(op, err)
 	}
+
+	// Already running for this account?
+	for i := range l.purchasers {
+		if l.purchasers[i].pm.Purchaser().Account() == p.Account() {
+			return errors.E(op, errors.Invalid, errors.Errorf("ticket "+
+				"purchaser already started for account %q", ticketbuyerCfg.AccountName))
+		}
+	}
+
 	n := w.NtfnServer.MainTipChangedNotifications()
 	pm := ticketbuyer.NewPurchaseManager(w, p, n.C, passphrase)
-	l.ntfnClient = n
-	l.purchaseManager = pm
+	l.purchasers = append(l.purchasers, ticketPurchaser{pm: pm, ntfn: n})
 	pm.Start()
 	return nil
 }
 
-// stopTicketPurchase stops the ticket purchaser, waiting until it has finished.
-// Returns false if the ticket purchaser was not running. It must be called with
-// the mutex lock held.
+// stopTicketPurchase stops every ticket purchaser, waiting until they have
+// finished.  Returns false if no ticket purchaser was running. It must be
+// called with the mutex lock held.
 func (l *Loader) stopTicketPurchase() bool {
-	if l.purchaseManager == nil {
+	if len(l.purchasers) == 0 {
 		return false
 	}
 
-	l.ntfnClient.Done()
-	l.purchaseManager.Stop()
-	l.purchaseManager.WaitForShutdown()
-	l.purchaseManager = nil
+	for i := range l.purchasers {
+		l.purchasers[i].stop()
+	}
+	l.purchasers = nil
 	return true
 }
 
-// StopTicketPurchase stops the ticket purchaser, waiting until it has finished.
+// StopTicketPurchase stops every ticket purchaser, waiting until they have
+// finished.
 func (l *Loader) StopTicketPurchase() error {
 	const op errors.Op = "loader.StopTicketPurchase"
 	defer l.mu.Unlock()
@@ -483,13 +631,46 @@ func (l *Loader) StopTicketPurchase() error {
 	return nil
 }
 
+// StopAccountTicketPurchase stops the ticket purchaser of an account, waiting
+// until it has finished.  Ticket purchasers of other accounts continue to run.
+func (l *Loader) StopAccountTicketPurchase(account uint32) error {
+	const op errors.Op = "loader.StopAccountTicketPurchase"
+	defer l.mu.Unlock()
+	l.mu.Lock()
+	for i := range l.purchasers {
+		if l.purchasers[i].pm.Purchaser().Account() != account {
+			continue
+		}
+		l.purchasers[i].stop()
+		l.purchasers = append(l.purchasers[:i], l.purchasers[i+1:]...)
+		return nil
+	}
+	return errors.E(op, errors.Invalid, errors.Errorf("ticket purchaser is "+
+		"not running for account %d", account))
+}
+
 // PurchaseManager returns the ticket purchaser instance. If ticket purchasing
-// has been disabled, it returns nil.
+// has been disabled, it returns nil.  When several ticket purchasers are
+// running, the first started is returned.
 func (l *Loader) PurchaseManager() *ticketbuyer.PurchaseManager {
 	l.mu.Lock()
-	pm := l.purchaseManager
+	defer l.mu.Unlock()
+	if len(l.purchasers) == 0 {
+		return nil
+	}
+	return l.purchasers[0].pm
+}
+
+// PurchaseManagers returns every running ticket purchaser, in the order they
+// were started.
+func (l *Loader) PurchaseManagers() []*ticketbuyer.PurchaseManager {
+	l.mu.Lock()
+	pms := make([]*ticketbuyer.PurchaseManager, len(l.purchasers))
+	for i := range l.purchasers {
+		pms[i] = l.purchasers[i].pm
+	}
 	l.mu.Unlock()
-	return pm
+	return pms
 }
 
 func fileExists(filePath string) (bool, error) {
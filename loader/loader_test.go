@@ -0,0 +1,152 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcwallet/chain"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/ticketbuyer"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+func TestRunWhileLoaded(t *testing.T) {
+	l, teardown := testLoader(t)
+	defer teardown()
+
+	var ctxs []context.Context
+	var wallets []*wallet.Wallet
+	l.RunWhileLoaded(func(ctx context.Context, w *wallet.Wallet) {
+		ctxs = append(ctxs, ctx)
+		wallets = append(wallets, w)
+	})
+
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	w, err := l.CreateNewWallet(pubPass, []byte("private"), nil, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallets) != 1 || wallets[0] != w {
+		t.Fatalf("callback ran for %d wallets after create", len(wallets))
+	}
+
+	// Functions added after the wallet is loaded run immediately with the
+	// same context.
+	var late context.Context
+	l.RunWhileLoaded(func(ctx context.Context, w *wallet.Wallet) {
+		late = ctx
+	})
+	if late != ctxs[0] {
+		t.Fatal("function added after load did not run with the load context")
+	}
+
+	// Unloading the wallet cancels the context, and the functions run again
+	// when the wallet is opened.
+	if err := l.UnloadWallet(); err != nil {
+		t.Fatal(err)
+	}
+	if ctxs[0].Err() == nil {
+		t.Fatal("context was not cancelled after unload")
+	}
+	w, err = l.OpenExistingWallet(pubPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallets) != 2 || wallets[1] != w {
+		t.Fatalf("callback ran for %d wallets after open", len(wallets))
+	}
+	if ctxs[1].Err() != nil || late == ctxs[0] {
+		t.Fatal("functions did not run with a new context after open")
+	}
+}
+
+func TestAccountTicketPurchase(t *testing.T) {
+	l, teardown := testLoader(t)
+	defer teardown()
+
+	cfg := &ticketbuyer.Config{AccountName: "default"}
+	err := l.StartTicketPurchase(nil, cfg)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("start without a wallet: got error %v", err)
+	}
+
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	w, err := l.CreateNewWallet(pubPass, []byte("private"), nil, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.StartTicketPurchase(nil, cfg)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("start without a vhcd RPC client: got error %v", err)
+	}
+
+	// The RPC client is never connected, as purchasers only use it once
+	// notified of a new main chain tip.
+	c, err := chain.NewRPCClient(&chaincfg.SimNetParams, "127.0.0.1:0", "",
+		"", nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+	l.SetNetworkBackend(chain.BackendFromRPCClient(c.Client))
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	second, err := w.NextAccount("second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.StartTicketPurchase(nil, cfg); err != nil {
+		t.Fatal(err)
+	}
+	err = l.StartTicketPurchase(nil, &ticketbuyer.Config{AccountName: "second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only one purchaser may run for each account.
+	err = l.StartTicketPurchase(nil, cfg)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("second start for default account: got error %v", err)
+	}
+
+	pms := l.PurchaseManagers()
+	if len(pms) != 2 || pms[0].Purchaser().Account() != 0 ||
+		pms[1].Purchaser().Account() != second {
+		t.Fatalf("%d purchasers running after start", len(pms))
+	}
+	if l.PurchaseManager() != pms[0] {
+		t.Fatal("purchase manager is not the first started")
+	}
+
+	// Stopping the purchaser of one account leaves the others running.
+	if err := l.StopAccountTicketPurchase(0); err != nil {
+		t.Fatal(err)
+	}
+	pms = l.PurchaseManagers()
+	if len(pms) != 1 || pms[0].Purchaser().Account() != second {
+		t.Fatalf("%d purchasers running after account stop", len(pms))
+	}
+	err = l.StopAccountTicketPurchase(0)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("second stop for default account: got error %v", err)
+	}
+
+	if err := l.StopTicketPurchase(); err != nil {
+		t.Fatal(err)
+	}
+	if l.PurchaseManager() != nil || len(l.PurchaseManagers()) != 0 {
+		t.Fatal("purchasers running after stop")
+	}
+	err = l.StopTicketPurchase()
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("stop when not running: got error %v", err)
+	}
+}
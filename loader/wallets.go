@@ -0,0 +1,206 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// walletsDirName is the directory of the default wallet's database directory
+// holding the database directory of each named wallet.
+const walletsDirName = "wallets"
+
+// walletNameRegexp matches valid names of named wallets.  Names are used as
+// directory names and in RPC endpoint paths, and are restricted to characters
+// which are safe in both.
+var walletNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ValidWalletName returns whether name is a valid name of a named wallet.
+func ValidWalletName(name string) bool {
+	return walletNameRegexp.MatchString(name)
+}
+
+// WalletInfo describes a wallet which exists or is loaded by a loader.
+type WalletInfo struct {
+	// Name is the name of the wallet.  The default wallet has the empty
+	// name.
+	Name   string
+	Loaded bool
+}
+
+// Name returns the name of the wallet managed by the loader.  The default
+// wallet has the empty name.
+func (l *Loader) Name() string {
+	return l.name
+}
+
+// Wallet returns the loader of a named wallet, which creates, opens, and
+// unloads the wallet and its services independently of the default wallet and
+// other named wallets.  Named wallets allow several wallets, such as a hot
+// wallet, a voting wallet, and a cold watching-only wallet, to be served by a
+// single process.  The database of each named wallet is saved in a directory
+// of the same name in the wallets directory of the default wallet.  The empty
+// name returns the loader of the default wallet.
+//
+// Loaders are only retained for wallets which exist, so that looking up
+// arbitrary names does not grow the set of loaders.  The loader of a wallet
+// which does not exist is retained once it creates the wallet.
+//
+// This method must be called on the loader of the default wallet.
+func (l *Loader) Wallet(name string) (*Loader, error) {
+	const op errors.Op = "loader.Wallet"
+	if l.parent != nil {
+		return nil, errors.E(op, errors.Invalid, "loader is not the default wallet's loader")
+	}
+	if name == "" {
+		return l, nil
+	}
+	if !ValidWalletName(name) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid wallet name %q", name))
+	}
+
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	if nl, ok := l.named[name]; ok {
+		return nl, nil
+	}
+	if l.named == nil {
+		l.named = make(map[string]*Loader)
+	}
+
+	// Named wallets share the options of the default wallet, with the
+	// exception of the duplicate instance heartbeat address, which may only
+	// be bound by a single wallet.
+	nl := &Loader{
+		chainParams:          l.chainParams,
+		dbDirPath:            filepath.Join(l.dbDirPath, walletsDirName, name),
+		dbDriver:             l.dbDriver,
+		stakeOptions:         l.stakeOptions,
+		gapLimit:             l.gapLimit,
+		accountGapLimit:      l.accountGapLimit,
+		allowHighFees:        l.allowHighFees,
+		relayFee:             l.relayFee,
+		allowDuplicate:       l.allowDuplicate,
+		unlockSessionTimeout: l.unlockSessionTimeout,
+		revocationDelay:      l.revocationDelay,
+		vspMaxFee:            l.vspMaxFee,
+		voteCoordinator:      l.voteCoordinator,
+		fullCheck:            l.fullCheck,
+		parent:               l,
+		name:                 name,
+	}
+	exists, err := nl.WalletExists()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if exists {
+		l.named[name] = nl
+	}
+	return nl, nil
+}
+
+// RunAfterLoadNamed adds a function to be executed each time a named wallet is
+// created or opened.  The context is cancelled when the named wallet is
+// unloaded.  Functions are executed in the order they are added, and must not
+// block.
+func (l *Loader) RunAfterLoadNamed(fn func(ctx context.Context, l *Loader, w *wallet.Wallet)) {
+	l.mu.Lock()
+	l.namedCallbacks = append(l.namedCallbacks, fn)
+	l.mu.Unlock()
+}
+
+// Wallets describes the default wallet and every named wallet which either
+// exists in the wallets directory or is loaded, sorted by name.
+//
+// This method must be called on the loader of the default wallet.
+func (l *Loader) Wallets() ([]WalletInfo, error) {
+	const op errors.Op = "loader.Wallets"
+	if l.parent != nil {
+		return nil, errors.E(op, errors.Invalid, "loader is not the default wallet's loader")
+	}
+
+	names := make(map[string]struct{})
+	dir := filepath.Join(l.dbDirPath, walletsDirName)
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.E(op, err)
+	}
+	for _, fi := range fis {
+		if !fi.IsDir() || !ValidWalletName(fi.Name()) {
+			continue
+		}
+		exists, err := fileExists(filepath.Join(dir, fi.Name(), walletDbName))
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if exists {
+			names[fi.Name()] = struct{}{}
+		}
+	}
+
+	var infos []WalletInfo
+	exists, err := l.WalletExists()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	_, loaded := l.LoadedWallet()
+	if exists || loaded {
+		infos = append(infos, WalletInfo{Loaded: loaded})
+	}
+
+	// The loaders are copied before querying whether their wallets are
+	// loaded, as named wallets lock the parent after loading.
+	l.mu.Lock()
+	named := make([]*Loader, 0, len(l.named))
+	for _, nl := range l.named {
+		named = append(named, nl)
+	}
+	l.mu.Unlock()
+	loadedNames := make(map[string]bool)
+	for _, nl := range named {
+		if _, ok := nl.LoadedWallet(); ok {
+			names[nl.name] = struct{}{}
+			loadedNames[nl.name] = true
+		}
+	}
+	for name := range names {
+		infos = append(infos, WalletInfo{Name: name, Loaded: loadedNames[name]})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
+// UnloadNamedWallets unloads every loaded named wallet.  The default wallet is
+// not unloaded.
+func (l *Loader) UnloadNamedWallets() error {
+	const op errors.Op = "loader.UnloadNamedWallets"
+
+	l.mu.Lock()
+	named := make([]*Loader, 0, len(l.named))
+	for _, nl := range l.named {
+		named = append(named, nl)
+	}
+	l.mu.Unlock()
+
+	var firstErr error
+	for _, nl := range named {
+		err := nl.UnloadWallet()
+		if err != nil && !errors.Is(errors.Invalid, err) && firstErr == nil {
+			firstErr = errors.E(op, err)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+func testLoader(t *testing.T) (l *Loader, teardown func()) {
+	dir, err := ioutil.TempDir("", "vhcwallet.loader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l = NewLoader(&chaincfg.SimNetParams, dir, &StakeOptions{}, 20, false, 1e-4, 100)
+	teardown = func() {
+		l.UnloadNamedWallets()
+		l.UnloadWallet()
+		os.RemoveAll(dir)
+	}
+	return l, teardown
+}
+
+func TestNamedWalletLoaders(t *testing.T) {
+	l, teardown := testLoader(t)
+	defer teardown()
+
+	// Loaders of wallets which do not exist are not retained.
+	nl, err := l.Wallet("hot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := l.Wallet("hot"); again == nl {
+		t.Fatal("loader of a wallet which does not exist was retained")
+	}
+	if len(l.named) != 0 {
+		t.Fatalf("%d loaders retained", len(l.named))
+	}
+	if _, err := l.Wallet("../hot"); err == nil {
+		t.Fatal("loader returned for an invalid name")
+	}
+
+	// Creating the wallet retains its loader, so later lookups return the
+	// loader of the loaded wallet.
+	_, err = nl.CreateNewWallet([]byte(wallet.InsecurePubPassphrase),
+		[]byte("private"), nil, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := l.Wallet("hot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != nl {
+		t.Fatal("loader of the created wallet was not retained")
+	}
+	infos, err := l.Wallets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 1 || infos[0].Name != "hot" || !infos[0].Loaded {
+		t.Fatalf("wallets %+v", infos)
+	}
+
+	// Loaders of existing wallets are retained before the wallet is opened.
+	if err := nl.UnloadWallet(); err != nil {
+		t.Fatal(err)
+	}
+	delete(l.named, "hot")
+	nl, err = l.Wallet("hot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := l.Wallet("hot"); again != nl {
+		t.Fatal("loader of an existing wallet was not retained")
+	}
+}
@@ -15,11 +15,13 @@ import (
 	"github.com/valhallacoin/vhcwallet/chain"
 	"github.com/valhallacoin/vhcwallet/loader"
 	"github.com/valhallacoin/vhcwallet/p2p"
+	"github.com/valhallacoin/vhcwallet/remotesigner"
 	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc"
 	"github.com/valhallacoin/vhcwallet/rpc/rpcserver"
 	"github.com/valhallacoin/vhcwallet/spv"
 	"github.com/valhallacoin/vhcwallet/ticketbuyer"
 	ticketbuyerv2 "github.com/valhallacoin/vhcwallet/ticketbuyer/v2"
+	"github.com/valhallacoin/vhcwallet/vsp"
 	"github.com/valhallacoin/vhcwallet/wallet"
 	"github.com/valhallacoin/vhcwallet/wallet/udb"
 	"github.com/decred/slog"
@@ -62,6 +64,8 @@ var (
 	grpcLog      = backendLog.Logger("GRPC")
 	legacyRPCLog = backendLog.Logger("RPCS")
 	cmgrLog      = backendLog.Logger("CMGR")
+	signLog      = backendLog.Logger("SIGN")
+	vspLog       = backendLog.Logger("VSPC")
 )
 
 // Initialize package-global logger variables.
@@ -78,6 +82,8 @@ func init() {
 	rpcserver.UseLogger(grpcLog)
 	legacyrpc.UseLogger(legacyRPCLog)
 	connmgr.UseLogger(cmgrLog)
+	remotesigner.UseLogger(signLog)
+	vsp.UseLogger(vspLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -90,6 +96,8 @@ var subsystemLoggers = map[string]slog.Logger{
 	"GRPC": grpcLog,
 	"RPCS": legacyRPCLog,
 	"CMGR": cmgrLog,
+	"SIGN": signLog,
+	"VSPC": vspLog,
 }
 
 // initLogRotator initializes the logging rotater to write logs to logFile and
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// missedVoteWebhookTimeout is the duration after which a request reporting a
+// missed vote to the webhook is abandoned.
+const missedVoteWebhookTimeout = 30 * time.Second
+
+// missedVoteAlert is the JSON object posted to the missed vote webhook.
+type missedVoteAlert struct {
+	TicketHash  string `json:"tickethash"`
+	BlockHash   string `json:"blockhash"`
+	BlockHeight int32  `json:"blockheight"`
+	Detected    int64  `json:"detected"`
+}
+
+// reportMissedVotes posts each missed vote of the wallet to the webhook URL
+// until the context is canceled.  Missed votes detected while a previous
+// report is being posted are queued, so the wallet never waits on the webhook.
+func reportMissedVotes(ctx context.Context, w *wallet.Wallet, webhook string) {
+	n := w.NtfnServer.MissedVoteNotifications()
+	defer n.Done()
+
+	queue := make(chan *wallet.MissedVote, 100)
+	go func() {
+		client := &http.Client{Timeout: missedVoteWebhookTimeout}
+		for {
+			select {
+			case m := <-queue:
+				err := postMissedVote(ctx, client, webhook, m)
+				if err != nil {
+					log.Errorf("Unable to report missed vote of ticket %v: %v",
+						&m.Ticket, err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case v := <-n.C:
+			for _, m := range v.MissedVotes {
+				select {
+				case queue <- m:
+				default:
+					log.Warnf("Dropped missed vote report of ticket %v: "+
+						"webhook is not keeping up", &m.Ticket)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// postMissedVote reports a missed vote to the webhook URL.
+func postMissedVote(ctx context.Context, client *http.Client, webhook string, m *wallet.MissedVote) error {
+	body, err := json.Marshal(&missedVoteAlert{
+		TicketHash:  m.Ticket.String(),
+		BlockHash:   m.BlockHash.String(),
+		BlockHeight: m.BlockHeight,
+		Detected:    m.Detected.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("webhook responded %v", resp.Status)
+	}
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"io"
 	"net"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -64,10 +66,12 @@ type RemotePeer struct {
 	lp         *LocalPeer
 	ua         string
 	services   wire.ServiceFlag
-	pver       uint32
+	pver       uint32 // negotiated protocol version
+	rpver      uint32 // protocol version advertised by the remote peer
 	initHeight int32
 	raddr      net.Addr
 	na         *wire.NetAddress
+	connected  time.Time
 
 	// io
 	c       net.Conn
@@ -113,6 +117,7 @@ type LocalPeer struct {
 	extaddr     net.Addr
 	amgr        *addrmgr.AddrManager
 	chainParams *chaincfg.Params
+	services    wire.ServiceFlag // advertised to remote peers
 
 	rpByID map[uint64]*RemotePeer
 	rpMu   sync.Mutex
@@ -134,8 +139,38 @@ func NewLocalPeer(params *chaincfg.Params, extaddr *net.TCPAddr, amgr *addrmgr.A
 	return lp
 }
 
+// serviceNames maps the configuration names of service flags to the flags.
+var serviceNames = map[string]wire.ServiceFlag{
+	"network": wire.SFNodeNetwork,
+	"bloom":   wire.SFNodeBloom,
+	"cf":      wire.SFNodeCF,
+}
+
+// ParseServices returns the service flags named by names, which may be any of
+// network, bloom, and cf.
+func ParseServices(names []string) (wire.ServiceFlag, error) {
+	const op errors.Op = "p2p.ParseServices"
+	var services wire.ServiceFlag
+	for _, name := range names {
+		flag, ok := serviceNames[strings.ToLower(name)]
+		if !ok {
+			return 0, errors.E(op, errors.Invalid,
+				errors.Errorf("unknown service %q", name))
+		}
+		services |= flag
+	}
+	return services, nil
+}
+
+// SetServices sets the service flags advertised to remote peers in the version
+// message.  By default, no services are advertised.  This must be called before
+// connecting to any peers.
+func (lp *LocalPeer) SetServices(services wire.ServiceFlag) {
+	lp.services = services
+}
+
 func (lp *LocalPeer) newMsgVersion(pver uint32, extaddr net.Addr, c net.Conn) (*wire.MsgVersion, error) {
-	la, err := wire.NewNetAddress(c.LocalAddr(), 0) // We provide no services
+	la, err := wire.NewNetAddress(c.LocalAddr(), lp.services)
 	if err != nil {
 		return nil, err
 	}
@@ -148,10 +183,21 @@ func (lp *LocalPeer) newMsgVersion(pver uint32, extaddr net.Addr, c net.Conn) (*
 		return nil, err
 	}
 	v := wire.NewMsgVersion(la, ra, nonce, 0)
+	v.ProtocolVersion = int32(pver)
+	v.Services = lp.services
 	v.AddUserAgent(uaName, uaVersion)
 	return v, nil
 }
 
+// requiredPver returns the minimum protocol version a remote peer must
+// negotiate to provide each of the services.
+func requiredPver(services wire.ServiceFlag) uint32 {
+	if services&wire.SFNodeCF != 0 {
+		return wire.NodeCFVersion
+	}
+	return wire.InitialProcotolVersion
+}
+
 // ConnectOutbound establishes a connection to a remote peer by their remote TCP
 // address.  The peer is serviced in the background until the context is
 // cancelled, the RemotePeer disconnects, times out, misbehaves, or the
@@ -185,16 +231,27 @@ func (lp *LocalPeer) ConnectOutbound(ctx context.Context, addr string, reqSvcs w
 		}
 	}
 
-	// Disconnect from the peer if it does not specify all required services.
-	if rp.services&reqSvcs != reqSvcs {
+	// Disconnect from the peer if it does not specify all required services,
+	// or if the negotiated protocol version is too old to provide them.
+	var reason error
+	var code wire.RejectCode
+	switch minPver := requiredPver(reqSvcs); {
+	case rp.services&reqSvcs != reqSvcs:
+		reason = errors.Errorf("missing required service flags %v", reqSvcs&^rp.services)
+		code = wire.RejectNonstandard
+	case rp.pver < minPver:
+		reason = errors.Errorf("protocol version %d is older than required version %d",
+			rp.pver, minPver)
+		code = wire.RejectObsolete
+	}
+	if reason != nil {
 		op := errors.Opf(opf, rp.raddr)
-		reason := errors.Errorf("missing required service flags %v", reqSvcs&^rp.services)
-		err := errors.E(op, reason)
+		err := errors.E(op, errors.Protocol, reason)
 		go func() {
 			if waitForAddrs != nil {
 				<-waitForAddrs
 			}
-			reject := wire.NewMsgReject(wire.CmdVersion, wire.RejectNonstandard, reason.Error())
+			reject := wire.NewMsgReject(wire.CmdVersion, code, reason.Error())
 			rp.sendMessageAck(ctx, reject)
 			rp.Disconnect(err)
 		}()
@@ -220,6 +277,60 @@ func (rp *RemotePeer) InitialHeight() int32 { return rp.initHeight }
 // Services returns the remote peer's advertised service flags.
 func (rp *RemotePeer) Services() wire.ServiceFlag { return rp.services }
 
+// ID returns the unique identifier assigned to the remote peer by the local
+// peer.  Identifiers increase with each connection.
+func (rp *RemotePeer) ID() uint64 { return rp.id }
+
+// Pver returns the protocol version negotiated with the remote peer.
+func (rp *RemotePeer) Pver() uint32 { return rp.pver }
+
+// AdvertisedPver returns the protocol version the remote peer advertised in
+// its version message.  This may be newer than the negotiated version.
+func (rp *RemotePeer) AdvertisedPver() uint32 { return rp.rpver }
+
+// ConnectedTime returns the time the handshake with the remote peer completed.
+func (rp *RemotePeer) ConnectedTime() time.Time { return rp.connected }
+
+// BanScore returns the current ban score of the remote peer.
+func (rp *RemotePeer) BanScore() uint32 { return rp.banScore.Int() }
+
+// Capabilities describes the optional protocol features a remote peer supports
+// given its advertised services and the negotiated protocol version.
+type Capabilities struct {
+	// CFilters is true when the peer serves committed filters.
+	CFilters bool
+
+	// SendHeaders is true when the peer can announce new blocks with
+	// headers messages.
+	SendHeaders bool
+
+	// FeeFilter is true when the peer honors feefilter messages.
+	FeeFilter bool
+}
+
+// Capabilities returns the optional protocol features supported by the remote
+// peer.
+func (rp *RemotePeer) Capabilities() Capabilities {
+	return Capabilities{
+		CFilters:    rp.services&wire.SFNodeCF != 0 && rp.pver >= wire.NodeCFVersion,
+		SendHeaders: rp.pver >= wire.SendHeadersVersion,
+		FeeFilter:   rp.pver >= wire.FeeFilterVersion,
+	}
+}
+
+// Peers returns every remote peer currently connected to the local peer,
+// ordered by their identifiers.
+func (lp *LocalPeer) Peers() []*RemotePeer {
+	lp.rpMu.Lock()
+	peers := make([]*RemotePeer, 0, len(lp.rpByID))
+	for _, rp := range lp.rpByID {
+		peers = append(peers, rp)
+	}
+	lp.rpMu.Unlock()
+	sort.Slice(peers, func(i, j int) bool { return peers[i].id < peers[j].id })
+	return peers
+}
+
 // InvsSent returns an LRU cache of inventory hashes sent to the remote peer.
 func (rp *RemotePeer) InvsSent() *lru.Cache { return &rp.invsSent }
 
@@ -359,11 +470,18 @@ func handshake(ctx context.Context, lp *LocalPeer, id uint64, na *wire.NetAddres
 	rp.initHeight = rversion.LastBlock
 	rp.services = rversion.Services
 	rp.ua = rversion.UserAgent
+	if rversion.ProtocolVersion < int32(wire.InitialProcotolVersion) {
+		return nil, errors.E(op, errors.Protocol,
+			errors.Errorf("invalid protocol version %d", rversion.ProtocolVersion))
+	}
+	rp.rpver = uint32(rversion.ProtocolVersion)
 
 	// Negotiate protocol down to compatible version
-	if uint32(rversion.ProtocolVersion) < rp.pver {
-		rp.pver = uint32(rversion.ProtocolVersion)
+	if rp.rpver < rp.pver {
+		rp.pver = rp.rpver
 	}
+	log.Debugf("Negotiated protocol version %d with peer %v (advertised %d, services %v)",
+		rp.pver, rp.raddr, rp.rpver, rp.services)
 
 	// Send the verack
 	err = mw.write(ctx, wire.NewMsgVerAck(), rp.pver)
@@ -386,6 +504,7 @@ func handshake(ctx context.Context, lp *LocalPeer, id uint64, na *wire.NetAddres
 	}
 	c.SetReadDeadline(time.Time{})
 
+	rp.connected = time.Now()
 	rp.out = make(chan *msgAck)
 	rp.outPrio = make(chan *msgAck)
 
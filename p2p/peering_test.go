@@ -0,0 +1,91 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+func TestParseServices(t *testing.T) {
+	tests := []struct {
+		names    []string
+		services wire.ServiceFlag
+		err      bool
+	}{
+		{nil, 0, false},
+		{[]string{"cf"}, wire.SFNodeCF, false},
+		{[]string{"network", "CF"}, wire.SFNodeNetwork | wire.SFNodeCF, false},
+		{[]string{"bloom", "bloom"}, wire.SFNodeBloom, false},
+		{[]string{"cf", "relay"}, 0, true},
+	}
+	for _, test := range tests {
+		services, err := ParseServices(test.names)
+		if (err != nil) != test.err {
+			t.Errorf("%q: unexpected error %v", test.names, err)
+			continue
+		}
+		if services != test.services {
+			t.Errorf("%q: got services %v, want %v", test.names, services, test.services)
+		}
+	}
+}
+
+func TestAdvertisedServices(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen on loopback: %v", err)
+	}
+	defer l.Close()
+	go func() {
+		c, err := l.Accept()
+		if err == nil {
+			c.Close()
+		}
+	}()
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	lp := NewLocalPeer(&chaincfg.SimNetParams, nil, nil)
+	v, err := lp.newMsgVersion(wire.ProtocolVersion, nil, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Services != 0 || v.AddrMe.Services != 0 {
+		t.Fatalf("default version message advertises services %v", v.Services)
+	}
+
+	lp.SetServices(wire.SFNodeCF)
+	v, err = lp.newMsgVersion(wire.ProtocolVersion, nil, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Services != wire.SFNodeCF || v.AddrMe.Services != wire.SFNodeCF {
+		t.Fatalf("version message advertises services %v, want %v",
+			v.Services, wire.SFNodeCF)
+	}
+}
+
+func TestPeers(t *testing.T) {
+	lp := NewLocalPeer(&chaincfg.SimNetParams, nil, nil)
+	for _, id := range []uint64{7, 2, 5} {
+		lp.rpByID[id] = &RemotePeer{id: id, lp: lp}
+	}
+	peers := lp.Peers()
+	if len(peers) != 3 {
+		t.Fatalf("got %d peers, want 3", len(peers))
+	}
+	for i, want := range []uint64{2, 5, 7} {
+		if peers[i].ID() != want {
+			t.Errorf("peer %d: got ID %d, want %d", i, peers[i].ID(), want)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build pkcs11 && cgo && !windows
+// +build pkcs11,cgo,!windows
+
+package pkcs11
+
+/*
+#cgo LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+#include <string.h>
+
+// Minimal declarations of the PKCS#11 types and functions which are used.
+typedef unsigned long ck_ulong;
+typedef ck_ulong ck_rv;
+
+typedef struct {
+	ck_ulong type;
+	void *value;
+	ck_ulong len;
+} ck_attribute;
+
+typedef struct {
+	ck_ulong mechanism;
+	void *param;
+	ck_ulong len;
+} ck_mechanism;
+
+typedef struct {
+	unsigned char major;
+	unsigned char minor;
+} ck_version;
+
+typedef struct {
+	unsigned char label[32];
+	unsigned char manufacturer_id[32];
+	unsigned char model[16];
+	unsigned char serial_number[16];
+	ck_ulong flags;
+	ck_ulong counts[10];
+	ck_version hardware_version;
+	ck_version firmware_version;
+	unsigned char utc_time[16];
+} ck_token_info;
+
+#define CKR_OK                            0x000
+#define CKR_USER_ALREADY_LOGGED_IN        0x100
+#define CKR_CRYPTOKI_ALREADY_INITIALIZED  0x191
+#define CKF_SERIAL_SESSION                0x004
+#define CKU_USER                          1
+#define CKA_CLASS                         0x000
+#define CKA_ID                            0x102
+#define CKO_PRIVATE_KEY                   3
+#define CKM_ECDSA                         0x1041
+
+typedef struct {
+	void *handle;
+	ck_rv (*initialize)(void *);
+	ck_rv (*finalize)(void *);
+	ck_rv (*get_slot_list)(unsigned char, ck_ulong *, ck_ulong *);
+	ck_rv (*get_token_info)(ck_ulong, ck_token_info *);
+	ck_rv (*open_session)(ck_ulong, ck_ulong, void *, void *, ck_ulong *);
+	ck_rv (*close_session)(ck_ulong);
+	ck_rv (*login)(ck_ulong, ck_ulong, unsigned char *, ck_ulong);
+	ck_rv (*logout)(ck_ulong);
+	ck_rv (*find_objects_init)(ck_ulong, ck_attribute *, ck_ulong);
+	ck_rv (*find_objects)(ck_ulong, ck_ulong *, ck_ulong, ck_ulong *);
+	ck_rv (*find_objects_final)(ck_ulong);
+	ck_rv (*sign_init)(ck_ulong, ck_mechanism *, ck_ulong);
+	ck_rv (*sign)(ck_ulong, unsigned char *, ck_ulong, unsigned char *, ck_ulong *);
+} ck_module;
+
+// ck_load opens the module and resolves its functions, returning the name of
+// a missing function or NULL on success.
+static const char *ck_load(ck_module *m, const char *path) {
+	m->handle = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (m->handle == NULL) {
+		return dlerror();
+	}
+#define CK_SYM(field, name) \
+	if ((*(void **)&m->field = dlsym(m->handle, name)) == NULL) return name;
+	CK_SYM(initialize, "C_Initialize")
+	CK_SYM(finalize, "C_Finalize")
+	CK_SYM(get_slot_list, "C_GetSlotList")
+	CK_SYM(get_token_info, "C_GetTokenInfo")
+	CK_SYM(open_session, "C_OpenSession")
+	CK_SYM(close_session, "C_CloseSession")
+	CK_SYM(login, "C_Login")
+	CK_SYM(logout, "C_Logout")
+	CK_SYM(find_objects_init, "C_FindObjectsInit")
+	CK_SYM(find_objects, "C_FindObjects")
+	CK_SYM(find_objects_final, "C_FindObjectsFinal")
+	CK_SYM(sign_init, "C_SignInit")
+	CK_SYM(sign, "C_Sign")
+#undef CK_SYM
+	return NULL;
+}
+
+static void ck_unload(ck_module *m) {
+	if (m->handle != NULL) {
+		dlclose(m->handle);
+	}
+}
+
+static ck_rv ck_initialize(ck_module *m) {
+	ck_rv rv = m->initialize(NULL);
+	return rv == CKR_CRYPTOKI_ALREADY_INITIALIZED ? CKR_OK : rv;
+}
+
+static ck_rv ck_finalize(ck_module *m) {
+	return m->finalize(NULL);
+}
+
+static ck_rv ck_get_slot_list(ck_module *m, ck_ulong *slots, ck_ulong *count) {
+	return m->get_slot_list(1, slots, count);
+}
+
+static ck_rv ck_token_label(ck_module *m, ck_ulong slot, unsigned char *label) {
+	ck_token_info info;
+	ck_rv rv = m->get_token_info(slot, &info);
+	if (rv == CKR_OK) {
+		memcpy(label, info.label, sizeof(info.label));
+	}
+	return rv;
+}
+
+static ck_rv ck_open_session(ck_module *m, ck_ulong slot, ck_ulong *session) {
+	return m->open_session(slot, CKF_SERIAL_SESSION, NULL, NULL, session);
+}
+
+static ck_rv ck_close_session(ck_module *m, ck_ulong session) {
+	return m->close_session(session);
+}
+
+static ck_rv ck_login(ck_module *m, ck_ulong session, unsigned char *pin, ck_ulong len) {
+	ck_rv rv = m->login(session, CKU_USER, pin, len);
+	return rv == CKR_USER_ALREADY_LOGGED_IN ? CKR_OK : rv;
+}
+
+static ck_rv ck_logout(ck_module *m, ck_ulong session) {
+	return m->logout(session);
+}
+
+// ck_find_key finds the private key object with an ID attribute, setting
+// count to the number of objects found (zero or one).
+static ck_rv ck_find_key(ck_module *m, ck_ulong session, unsigned char *id,
+	ck_ulong id_len, ck_ulong *key, ck_ulong *count) {
+
+	ck_ulong class = CKO_PRIVATE_KEY;
+	ck_attribute template[2] = {
+		{CKA_CLASS, &class, sizeof(class)},
+		{CKA_ID, id, id_len},
+	};
+	ck_rv rv = m->find_objects_init(session, template, 2);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+	rv = m->find_objects(session, key, 1, count);
+	ck_rv final_rv = m->find_objects_final(session);
+	return rv != CKR_OK ? rv : final_rv;
+}
+
+static ck_rv ck_sign(ck_module *m, ck_ulong session, ck_ulong key,
+	unsigned char *data, ck_ulong data_len, unsigned char *sig, ck_ulong *sig_len) {
+
+	ck_mechanism mech = {CKM_ECDSA, NULL, 0};
+	ck_rv rv = m->sign_init(session, &mech, key);
+	if (rv != CKR_OK) {
+		return rv;
+	}
+	return m->sign(session, data, data_len, sig, sig_len);
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+	"unsafe"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// ckError describes the return value of a failed PKCS#11 function.
+func ckError(fn string, rv C.ck_rv) error {
+	return errors.Errorf("%s: CKR 0x%x", fn, uint64(rv))
+}
+
+// Module is a session with a token of a PKCS#11 module.  It implements the
+// wallet.KeyStore interface.  PKCS#11 sessions are not safe for concurrent
+// use, and signatures are created one at a time.
+type Module struct {
+	mu      sync.Mutex
+	m       *C.ck_module
+	session C.ck_ulong
+	closed  bool
+}
+
+// Open loads the PKCS#11 module at path and logs in to the token with label
+// tokenLabel using the user PIN.
+func Open(path, tokenLabel, pin string) (*Module, error) {
+	const op errors.Op = "pkcs11.Open"
+
+	m := (*C.ck_module)(C.calloc(1, C.sizeof_ck_module))
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	if missing := C.ck_load(m, cpath); missing != nil {
+		err := errors.Errorf("load %s: %s", path, C.GoString(missing))
+		C.ck_unload(m)
+		C.free(unsafe.Pointer(m))
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	mod := &Module{m: m}
+	err := mod.open(tokenLabel, pin)
+	if err != nil {
+		C.ck_unload(m)
+		C.free(unsafe.Pointer(m))
+		return nil, errors.E(op, err)
+	}
+	return mod, nil
+}
+
+func (m *Module) open(tokenLabel, pin string) error {
+	if rv := C.ck_initialize(m.m); rv != C.CKR_OK {
+		return ckError("C_Initialize", rv)
+	}
+	slot, err := m.findToken(tokenLabel)
+	if err != nil {
+		C.ck_finalize(m.m)
+		return err
+	}
+	if rv := C.ck_open_session(m.m, slot, &m.session); rv != C.CKR_OK {
+		C.ck_finalize(m.m)
+		return ckError("C_OpenSession", rv)
+	}
+	cpin := C.CString(pin)
+	defer C.free(unsafe.Pointer(cpin))
+	rv := C.ck_login(m.m, m.session, (*C.uchar)(unsafe.Pointer(cpin)), C.ck_ulong(len(pin)))
+	if rv != C.CKR_OK {
+		C.ck_close_session(m.m, m.session)
+		C.ck_finalize(m.m)
+		return errors.E(errors.Passphrase, ckError("C_Login", rv))
+	}
+	return nil
+}
+
+// findToken returns the slot of the present token with a label.  Token labels
+// are padded with spaces.
+func (m *Module) findToken(label string) (C.ck_ulong, error) {
+	var count C.ck_ulong
+	if rv := C.ck_get_slot_list(m.m, nil, &count); rv != C.CKR_OK {
+		return 0, ckError("C_GetSlotList", rv)
+	}
+	if count == 0 {
+		return 0, errors.E(errors.NotExist, "no PKCS#11 tokens are present")
+	}
+	slots := make([]C.ck_ulong, count)
+	if rv := C.ck_get_slot_list(m.m, &slots[0], &count); rv != C.CKR_OK {
+		return 0, ckError("C_GetSlotList", rv)
+	}
+	var tokenLabel [32]byte
+	for _, slot := range slots[:count] {
+		rv := C.ck_token_label(m.m, slot, (*C.uchar)(unsafe.Pointer(&tokenLabel[0])))
+		if rv != C.CKR_OK {
+			return 0, ckError("C_GetTokenInfo", rv)
+		}
+		if string(bytes.TrimRight(tokenLabel[:], " \x00")) == label {
+			return slot, nil
+		}
+	}
+	return 0, errors.E(errors.NotExist, errors.Errorf("no PKCS#11 token with label %q", label))
+}
+
+// Sign returns the ECDSA signature of hash created inside the module with the
+// private key of the serialized compressed public key pubKey.
+func (m *Module) Sign(ctx context.Context, pubKey, hash []byte) (r, s *big.Int, err error) {
+	const op errors.Op = "pkcs11.Sign"
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	if m.closed {
+		return nil, nil, errors.E(op, errors.Invalid, "module is closed")
+	}
+
+	id := keyID(pubKey)
+	var key, count C.ck_ulong
+	rv := C.ck_find_key(m.m, m.session, (*C.uchar)(unsafe.Pointer(&id[0])),
+		C.ck_ulong(len(id)), &key, &count)
+	if rv != C.CKR_OK {
+		return nil, nil, errors.E(op, ckError("C_FindObjects", rv))
+	}
+	if count == 0 {
+		return nil, nil, errors.E(op, errors.NotExist, errors.Errorf("no private key with ID %x", id))
+	}
+
+	sig := make([]byte, 64)
+	sigLen := C.ck_ulong(len(sig))
+	rv = C.ck_sign(m.m, m.session, key, (*C.uchar)(unsafe.Pointer(&hash[0])),
+		C.ck_ulong(len(hash)), (*C.uchar)(unsafe.Pointer(&sig[0])), &sigLen)
+	if rv != C.CKR_OK {
+		return nil, nil, errors.E(op, ckError("C_Sign", rv))
+	}
+	r, s, err = parseSignature(sig[:sigLen])
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	return r, s, nil
+}
+
+// Close logs out of the token and unloads the module.
+func (m *Module) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	C.ck_logout(m.m, m.session)
+	C.ck_close_session(m.m, m.session)
+	C.ck_finalize(m.m)
+	C.ck_unload(m.m)
+	C.free(unsafe.Pointer(m.m))
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !pkcs11 || !cgo || windows
+// +build !pkcs11 !cgo windows
+
+package pkcs11
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// Module is a session with a token of a PKCS#11 module.
+type Module struct{}
+
+// Open loads the PKCS#11 module at path and logs in to the token with label
+// tokenLabel using the user PIN.
+func Open(path, tokenLabel, pin string) (*Module, error) {
+	const op errors.Op = "pkcs11.Open"
+	return nil, errors.E(op, errors.Invalid, "PKCS#11 support requires building with cgo and -tags pkcs11")
+}
+
+// Sign returns the ECDSA signature of hash created inside the module with the
+// private key of the serialized compressed public key pubKey.
+func (m *Module) Sign(ctx context.Context, pubKey, hash []byte) (r, s *big.Int, err error) {
+	const op errors.Op = "pkcs11.Sign"
+	return nil, nil, errors.E(op, errors.Invalid, "PKCS#11 support requires building with cgo and -tags pkcs11")
+}
+
+// Close logs out of the token and unloads the module.
+func (m *Module) Close() error {
+	return nil
+}
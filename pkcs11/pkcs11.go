@@ -0,0 +1,42 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package pkcs11 signs with secp256k1 private keys kept inside a hardware
+// security module accessed through a PKCS#11 module.
+//
+// The private key of a wallet public key is the private key object of the
+// token with a CKA_ID attribute equal to the Hash160 of the serialized
+// compressed public key.  Signatures are created inside the module with the
+// CKM_ECDSA mechanism, and the private keys are never revealed to the wallet.
+//
+// The PKCS#11 module is loaded at runtime and requires building with cgo and
+// the pkcs11 build tag.
+package pkcs11
+
+import (
+	"math/big"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+var _ wallet.KeyStore = (*Module)(nil)
+
+// keyID returns the CKA_ID of the private key object of a serialized
+// compressed public key.
+func keyID(pubKey []byte) []byte {
+	return vhcutil.Hash160(pubKey)
+}
+
+// parseSignature parses a CKM_ECDSA signature, which is the concatenation of
+// the big-endian r and s values, each the size of the curve order.
+func parseSignature(sig []byte) (r, s *big.Int, err error) {
+	if len(sig) != 64 {
+		return nil, nil, errors.E(errors.Encoding, errors.Errorf("ECDSA signature has length %d", len(sig)))
+	}
+	r = new(big.Int).SetBytes(sig[:32])
+	s = new(big.Int).SetBytes(sig[32:])
+	return r, s, nil
+}
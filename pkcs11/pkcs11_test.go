@@ -0,0 +1,32 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package pkcs11
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseSignature(t *testing.T) {
+	sig := make([]byte, 64)
+	sig[31] = 1
+	sig[63] = 2
+	r, s, err := parseSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Int64() != 1 || s.Int64() != 2 {
+		t.Fatalf("parsed r=%v s=%v", r, s)
+	}
+	for _, n := range []int{0, 63, 65, 72} {
+		if _, _, err := parseSignature(make([]byte, n)); err == nil {
+			t.Errorf("parsed signature of length %d", n)
+		}
+	}
+
+	if id := keyID(bytes.Repeat([]byte{2}, 33)); len(id) != 20 {
+		t.Fatalf("key ID has length %d", len(id))
+	}
+}
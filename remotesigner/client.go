@@ -0,0 +1,192 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// callTimeout is the duration requests are allowed to take when the context
+// has no deadline.
+const callTimeout = 30 * time.Second
+
+// Client delegates the private key operations of a wallet to a remote signing
+// daemon.  It implements the wallet.Signer interface.  Connections are
+// established when the first request is made, and are reestablished after
+// they fail.
+type Client struct {
+	addr      string
+	tlsConfig *tls.Config
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+var _ wallet.Signer = (*Client)(nil)
+
+// NewClient creates a client of the signing daemon at addr.  The client
+// authenticates with the TLS certificate cert, and verifies the certificate of
+// the daemon with rootCAs.
+func NewClient(addr string, cert tls.Certificate, rootCAs *x509.CertPool) *Client {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return &Client{
+		addr: addr,
+		tlsConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      rootCAs,
+			ServerName:   host,
+			MinVersion:   tls.VersionTLS12,
+		},
+	}
+}
+
+// conn returns the RPC client of an established connection, dialing the
+// daemon if no connection is established.
+func (c *Client) conn(ctx context.Context) (*rpc.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client != nil {
+		return c.client, nil
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	tlsConn := tls.Client(conn, c.tlsConfig)
+	if deadline, ok := ctx.Deadline(); ok {
+		tlsConn.SetDeadline(deadline)
+	}
+	err = tlsConn.Handshake()
+	if err != nil {
+		conn.Close()
+		return nil, errors.E(errors.IO, err)
+	}
+	tlsConn.SetDeadline(time.Time{})
+	log.Infof("Connected to remote signer %s", c.addr)
+	c.client = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(tlsConn))
+	return c.client, nil
+}
+
+// disconnect closes the connection of a failed client.
+func (c *Client) disconnect(client *rpc.Client) {
+	c.mu.Lock()
+	if c.client == client {
+		c.client.Close()
+		c.client = nil
+	}
+	c.mu.Unlock()
+}
+
+// call performs a request of the signing daemon.
+func (c *Client) call(ctx context.Context, method string, args, reply interface{}) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+	}
+	client, err := c.conn(ctx)
+	if err != nil {
+		return err
+	}
+	call := client.Go(serviceName+"."+method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-ctx.Done():
+		// The reply may never arrive, and later replies can not be
+		// matched to their requests once this request is abandoned.
+		c.disconnect(client)
+		return ctx.Err()
+	case <-call.Done:
+	}
+	switch err := call.Error.(type) {
+	case nil:
+		return nil
+	case rpc.ServerError:
+		return errors.E(errors.Errorf("remote signer: %s", string(err)))
+	default:
+		c.disconnect(client)
+		return errors.E(errors.IO, err)
+	}
+}
+
+// SignTransaction adds the signature scripts created by the signing daemon to
+// the inputs of tx whose previous output script in prevScripts is not nil.
+func (c *Client) SignTransaction(ctx context.Context, tx *wire.MsgTx, prevScripts [][]byte, hashType txscript.SigHashType) error {
+	const op errors.Op = "remotesigner.SignTransaction"
+	b, err := tx.Bytes()
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	args := &SignTransactionArgs{
+		Tx:          b,
+		PrevScripts: prevScripts,
+		HashType:    uint32(hashType),
+	}
+	var reply SignTransactionReply
+	err = c.call(ctx, "SignTransaction", args, &reply)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	var signed wire.MsgTx
+	err = signed.Deserialize(bytes.NewReader(reply.Tx))
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+
+	// Only the signature scripts may be modified by the signer, which
+	// leaves the transaction prefix and its hash unchanged.
+	if signed.TxHash() != tx.TxHash() || len(signed.TxIn) != len(tx.TxIn) {
+		return errors.E(op, errors.Protocol, "remote signer modified the transaction")
+	}
+	for i := range tx.TxIn {
+		tx.TxIn[i].SignatureScript = signed.TxIn[i].SignatureScript
+	}
+	return nil
+}
+
+// SignMessage returns the compact signature of msg created by the signing
+// daemon with the private key of addr.
+func (c *Client) SignMessage(ctx context.Context, msg string, addr vhcutil.Address) ([]byte, error) {
+	const op errors.Op = "remotesigner.SignMessage"
+	args := &SignMessageArgs{
+		Message: msg,
+		Address: addr.EncodeAddress(),
+	}
+	var reply SignMessageReply
+	err := c.call(ctx, "SignMessage", args, &reply)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return reply.Signature, nil
+}
+
+// Close closes the connection to the signing daemon, if established.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == nil {
+		return nil
+	}
+	err := c.client.Close()
+	c.client = nil
+	return err
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/elliptic"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/certgen"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+// testSigner "signs" inputs by copying the previous output script to the
+// signature script, and modifies the transaction when tamper is set.
+type testSigner struct {
+	tamper bool
+}
+
+func (s *testSigner) SignTransaction(ctx context.Context, tx *wire.MsgTx, prevScripts [][]byte, hashType txscript.SigHashType) error {
+	for i, script := range prevScripts {
+		if script != nil {
+			tx.TxIn[i].SignatureScript = script
+		}
+	}
+	if s.tamper {
+		tx.TxOut[0].Value++
+	}
+	return nil
+}
+
+func (s *testSigner) SignMessage(ctx context.Context, msg string, addr vhcutil.Address) ([]byte, error) {
+	return []byte(msg + addr.EncodeAddress()), nil
+}
+
+func testKeyPair(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	cert, key, err := certgen.NewTLSCertPair(elliptic.P256(), "remotesigner test",
+		time.Now().Add(time.Hour), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPair, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(cert)
+	return keyPair, pool
+}
+
+func TestRemoteSigner(t *testing.T) {
+	serverCert, serverPool := testKeyPair(t)
+	clientCert, clientPool := testKeyPair(t)
+	otherCert, _ := testKeyPair(t)
+
+	signer := new(testSigner)
+	s, err := NewServer(signer, serverCert, clientPool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	addr := lis.Addr().String()
+	ctx := context.Background()
+
+	c := NewClient(addr, clientCert, serverPool)
+	defer c.Close()
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 0, nil))
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{2}}, 0, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{txscript.OP_TRUE}))
+	prevScripts := [][]byte{nil, {txscript.OP_TRUE}}
+	err = c.SignTransaction(ctx, tx, prevScripts, txscript.SigHashAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.TxIn[0].SignatureScript) != 0 || !bytes.Equal(tx.TxIn[1].SignatureScript, prevScripts[1]) {
+		t.Fatalf("unexpected signature scripts %x %x", tx.TxIn[0].SignatureScript,
+			tx.TxIn[1].SignatureScript)
+	}
+
+	a, err := vhcutil.NewAddressPubKeyHash(make([]byte, 20), &chaincfg.SimNetParams,
+		vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := c.SignMessage(ctx, "msg", a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(sig) != "msg"+a.EncodeAddress() {
+		t.Fatalf("unexpected signature %q", sig)
+	}
+
+	// Transactions modified by the signer are rejected.
+	signer.tamper = true
+	err = c.SignTransaction(ctx, tx, prevScripts, txscript.SigHashAll)
+	if err == nil {
+		t.Fatal("accepted transaction modified by the signer")
+	}
+	signer.tamper = false
+
+	// Clients without a certificate signed by the client CAs are refused.
+	other := NewClient(addr, otherCert, serverPool)
+	defer other.Close()
+	_, err = other.SignMessage(ctx, "msg", a)
+	if err == nil {
+		t.Fatal("unauthenticated client signed message")
+	}
+}
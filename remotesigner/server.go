@@ -0,0 +1,173 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package remotesigner implements a protocol for delegating the private key
+// operations of a wallet to a signing daemon on a separate host, allowing the
+// private keys to be kept off of the networked host running a watching-only
+// wallet.
+//
+// Requests are JSON-RPC messages, as implemented by net/rpc/jsonrpc, sent over
+// TLS connections which are authenticated in both directions: the client
+// verifies the certificate of the signing daemon, and the daemon only accepts
+// clients presenting a certificate signed by a configured CA.
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// serviceName is the name of the net/rpc service of the signing daemon.
+const serviceName = "Signer"
+
+// SignTransactionArgs are the arguments of a Signer.SignTransaction request.
+type SignTransactionArgs struct {
+	// Tx is the serialized transaction.
+	Tx []byte
+
+	// PrevScripts are the previous output scripts of each input.  Inputs
+	// with nil scripts are not signed.
+	PrevScripts [][]byte
+
+	HashType uint32
+}
+
+// SignTransactionReply is the reply of a Signer.SignTransaction request.
+type SignTransactionReply struct {
+	// Tx is the serialized transaction with the signature scripts added by
+	// the signer.
+	Tx []byte
+}
+
+// SignMessageArgs are the arguments of a Signer.SignMessage request.
+type SignMessageArgs struct {
+	Message string
+	Address string
+}
+
+// SignMessageReply is the reply of a Signer.SignMessage request.
+type SignMessageReply struct {
+	Signature []byte
+}
+
+// service exposes a wallet.Signer as a net/rpc service.
+type service struct {
+	signer wallet.Signer
+}
+
+func (s *service) SignTransaction(args *SignTransactionArgs, reply *SignTransactionReply) error {
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(args.Tx))
+	if err != nil {
+		return err
+	}
+	log.Infof("Signing transaction %v", tx.TxHash())
+	err = s.signer.SignTransaction(context.Background(), &tx, args.PrevScripts,
+		txscript.SigHashType(args.HashType))
+	if err != nil {
+		return err
+	}
+	reply.Tx, err = tx.Bytes()
+	return err
+}
+
+func (s *service) SignMessage(args *SignMessageArgs, reply *SignMessageReply) error {
+	addr, err := vhcutil.DecodeAddress(args.Address)
+	if err != nil {
+		return err
+	}
+	log.Infof("Signing message with address %v", addr)
+	reply.Signature, err = s.signer.SignMessage(context.Background(), args.Message, addr)
+	return err
+}
+
+// Server serves the private key operations of a signer to authenticated remote
+// wallets.
+type Server struct {
+	rpc       *rpc.Server
+	tlsConfig *tls.Config
+
+	mu        sync.Mutex
+	listeners []net.Listener
+}
+
+// NewServer creates a server of the signer.  The server presents the TLS
+// certificate cert and only accepts clients presenting a certificate verified
+// by clientCAs.
+func NewServer(signer wallet.Signer, cert tls.Certificate, clientCAs *x509.CertPool) (*Server, error) {
+	const op errors.Op = "remotesigner.NewServer"
+	if clientCAs == nil {
+		return nil, errors.E(op, errors.Invalid, "client CAs are required")
+	}
+	s := &Server{
+		rpc: rpc.NewServer(),
+		tlsConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    clientCAs,
+			MinVersion:   tls.VersionTLS12,
+		},
+	}
+	err := s.rpc.RegisterName(serviceName, &service{signer: signer})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// Serve accepts connections from the listener until it is closed by Stop.
+func (s *Server) Serve(lis net.Listener) error {
+	const op errors.Op = "remotesigner.Serve"
+	s.mu.Lock()
+	s.listeners = append(s.listeners, lis)
+	s.mu.Unlock()
+
+	log.Infof("Remote signer listening on %s", lis.Addr())
+	tlsLis := tls.NewListener(lis, s.tlsConfig)
+	for {
+		conn, err := tlsLis.Accept()
+		if err != nil {
+			return errors.E(op, err)
+		}
+		go s.serveConn(conn.(*tls.Conn))
+	}
+}
+
+// serveConn serves the requests of a connection after completing the
+// handshake authenticating the client.
+func (s *Server) serveConn(conn *tls.Conn) {
+	err := conn.Handshake()
+	if err != nil {
+		log.Warnf("Rejected remote signer client %s: %v", conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+	identity := conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+	log.Infof("Remote signer client %s (%s) connected", conn.RemoteAddr(), identity)
+	s.rpc.ServeCodec(jsonrpc.NewServerCodec(conn))
+	log.Infof("Remote signer client %s (%s) disconnected", conn.RemoteAddr(), identity)
+}
+
+// Stop closes the listeners of the server.  Connected clients are not
+// disconnected.
+func (s *Server) Stop() {
+	s.mu.Lock()
+	for _, lis := range s.listeners {
+		lis.Close()
+	}
+	s.listeners = nil
+	s.mu.Unlock()
+}
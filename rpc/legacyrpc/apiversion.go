@@ -0,0 +1,133 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+)
+
+// minAPIMajor is the oldest major version of the JSON-RPC API which clients may
+// request.  Results of methods which changed shape since this version are
+// converted to the shape of the requested version.
+const minAPIMajor = 4
+
+// apiVersionHeader is the HTTP header with which POST clients request the
+// version of the API used to handle a request.  Websocket clients instead
+// select the version of every following request of the connection with the
+// setapiversion method.
+const apiVersionHeader = "X-Vhcwallet-Api-Version"
+
+// legacyResult converts the result of a method to the shape returned by older
+// major versions of the API.
+type legacyResult struct {
+	// major is the newest major version returning the shape.
+	major int
+
+	convert func(result interface{}) (interface{}, error)
+}
+
+// parseAPIVersion parses a requested API version in the form major[.minor[.patch]]
+// and returns the major version.  Versions newer than the server's API, and
+// major versions older than minAPIMajor, are rejected.
+func parseAPIVersion(s string) (int, error) {
+	const op errors.Op = "legacyrpc.parseAPIVersion"
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("invalid API version %q", s))
+	}
+	var v [3]int
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return 0, errors.E(op, errors.Invalid, errors.Errorf("invalid API version %q", s))
+		}
+		v[i] = int(n)
+	}
+	current := [3]int{jsonrpcSemverMajor, jsonrpcSemverMinor, jsonrpcSemverPatch}
+	for i := range v {
+		if v[i] != current[i] {
+			if v[i] > current[i] {
+				return 0, errors.E(op, errors.Invalid,
+					errors.Errorf("API version %s is newer than server version %s",
+						s, jsonrpcSemverString))
+			}
+			break
+		}
+	}
+	if v[0] < minAPIMajor {
+		return 0, errors.E(op, errors.Invalid,
+			errors.Errorf("API version %s is older than the oldest supported major version %d",
+				s, minAPIMajor))
+	}
+	return v[0], nil
+}
+
+// versionedHandler wraps a handler to convert results to the shape returned by
+// the major API version requested by the client, if an older version was
+// requested and the result of the method has since changed shape.
+func (s *Server) versionedHandler(ctx context.Context, req *vhcjson.Request, h lazyHandler) lazyHandler {
+	major := apiMajorFromContext(ctx)
+	if major == jsonrpcSemverMajor {
+		return h
+	}
+	var convert func(interface{}) (interface{}, error)
+	for _, l := range handlers[req.Method].legacyResults {
+		if major <= l.major {
+			convert = l.convert
+			break
+		}
+	}
+	if convert == nil {
+		return h
+	}
+	return func() (interface{}, *vhcjson.RPCError) {
+		res, jsonErr := h()
+		if jsonErr != nil {
+			return res, jsonErr
+		}
+		res, err := convert(res)
+		if err != nil {
+			return nil, convertError(err)
+		}
+		return res, nil
+	}
+}
+
+// setAPIVersion handles a setapiversion request of a websocket client by
+// returning the context used for every following request of the connection.
+func setAPIVersion(ctx context.Context, req *vhcjson.Request) (context.Context, *vhcjson.RPCError) {
+	cmd, err := vhcjson.UnmarshalCmd(req)
+	if err != nil {
+		return ctx, vhcjson.ErrRPCInvalidRequest
+	}
+	major, err := parseAPIVersion(cmd.(*types.SetAPIVersionCmd).Version)
+	if err != nil {
+		return ctx, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	log.Infof("Client %s selected API version %d", clientString(ctx), major)
+	return withAPIMajor(ctx, major), nil
+}
+
+// getBalanceV4 converts a getbalance result to the spendable balance returned
+// by major version 4 of the API.
+func getBalanceV4(result interface{}) (interface{}, error) {
+	r := result.(vhcjson.GetBalanceResult)
+	var spendable vhcutil.Amount
+	for i := range r.Balances {
+		amt, err := vhcutil.NewAmount(r.Balances[i].Spendable)
+		if err != nil {
+			return nil, err
+		}
+		spendable += amt
+	}
+	return spendable.ToCoin(), nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+)
+
+// approverMethods are the only methods which may be called using approver
+// credentials.
+var approverMethods = map[string]struct{}{
+	"approvesend":      {},
+	"listpendingsends": {},
+	"rejectsend":       {},
+}
+
+// decodePendingSendID decodes the hex ID of a pending send queued by the
+// wallet.
+func decodePendingSendID(s string) (*[16]byte, error) {
+	var id [16]byte
+	if hex.DecodedLen(len(s)) != len(id) {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "invalid pending send ID %q", s)
+	}
+	_, err := hex.Decode(id[:], []byte(s))
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	return &id, nil
+}
+
+// approveSend handles an approvesend request by creating, signing, and
+// publishing the transaction of a send queued by the wallet.  Sends may not be
+// approved by the client which requested them.
+func approveSend(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ApproveSendCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	id, err := decodePendingSendID(cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	var passphrase []byte
+	if cmd.Passphrase != nil {
+		passphrase = []byte(*cmd.Passphrase)
+	}
+	txHash, err := w.ApproveSend(id, principalFromContext(ctx), passphrase)
+	if err != nil {
+		switch {
+		case errors.Is(errors.NotExist, err):
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		case errors.Is(errors.Permission, err):
+			return nil, rpcError(vhcjson.ErrRPCInvalidRequest.Code, err)
+		case errors.Is(errors.Passphrase, err):
+			return nil, rpcErrorf(vhcjson.ErrRPCWalletPassphraseIncorrect, "incorrect approval passphrase")
+		case errors.Is(errors.Locked, err):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(errors.InsufficientBalance, err):
+			return nil, rpcError(vhcjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return nil, err
+	}
+	return txHash.String(), nil
+}
+
+// rejectSend handles a rejectsend request by removing a send queued by the
+// wallet.
+func rejectSend(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.RejectSendCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	id, err := decodePendingSendID(cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = w.RejectSend(id)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// listPendingSends handles a listpendingsends request by returning the sends
+// queued by the wallet for approval, optionally limited to a single account.
+func listPendingSends(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListPendingSendsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var account *uint32
+	if cmd.Account != nil {
+		acct, err := w.AccountNumber(*cmd.Account)
+		if err != nil {
+			if errors.Is(errors.NotExist, err) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		account = &acct
+	}
+
+	sends, err := w.PendingSends()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]types.ListPendingSendsResult, 0, len(sends))
+	for _, p := range sends {
+		if account != nil && p.Account != *account {
+			continue
+		}
+		accountName, err := w.AccountName(p.Account)
+		if err != nil {
+			return nil, err
+		}
+		amounts := make(map[string]float64, len(p.Outputs))
+		var total vhcutil.Amount
+		for _, out := range p.Outputs {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version,
+				out.PkScript, w.ChainParams())
+			if err != nil || len(addrs) != 1 {
+				return nil, rpcErrorf(vhcjson.ErrRPCInternal.Code,
+					"unable to decode output script of pending send %x", p.ID[:])
+			}
+			amt := vhcutil.Amount(out.Value)
+			amounts[addrs[0].EncodeAddress()] += amt.ToCoin()
+			total += amt
+		}
+		results = append(results, types.ListPendingSendsResult{
+			ID:        hex.EncodeToString(p.ID[:]),
+			Account:   accountName,
+			Amounts:   amounts,
+			Total:     total.ToCoin(),
+			MinConf:   p.MinConf,
+			Time:      p.Time.Unix(),
+			Requester: p.Requester,
+		})
+	}
+	return results, nil
+}
+
+// setSendApproval handles a setsendapproval request by requiring sends from an
+// account to be queued and approved using a passphrase, or by removing the
+// requirement when the passphrase is empty.
+func setSendApproval(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetSendApprovalCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	var current []byte
+	if cmd.CurrentPassphrase != nil {
+		current = []byte(*cmd.CurrentPassphrase)
+	}
+	err = w.SetSendApproval(account, current, []byte(cmd.Passphrase))
+	if err != nil {
+		if errors.Is(errors.Passphrase, err) {
+			return nil, rpcErrorf(vhcjson.ErrRPCWalletPassphraseIncorrect, "incorrect approval passphrase")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// auditedMethods are the state-changing methods which are recorded to the
+// audit log, mapped to the indexes of the parameters which are recorded.
+// Other parameters, such as passphrases and private keys, are redacted.
+var auditedMethods = map[string][]int{
+	"addmultisigaddress":        {0, 1, 2},
+	"addticket":                 {},
+	"approvesend":               {0},
+	"assigndepositaddress":      {0, 1},
+	"cancelrescan":              {},
+	"cancelrevocation":          {0},
+	"clearguard":                {0},
+	"clearunlocksession":        {},
+	"closewallet":               {},
+	"consolidate":               {0, 1, 2},
+	"consolidateaccount":        {0, 1, 2, 3, 4},
+	"createcosignsession":       {0},
+	"createmultisigaccount":     {0, 1, 2, 3},
+	"createnewaccount":          {0},
+	"createsplitticketsession":  {0, 1},
+	"createunsignedtickets":     {0, 1, 2, 3, 4, 5, 6, 7, 8},
+	"createwallet":              {2},
+	"deleteprivkey":             {0, 1},
+	"deletescript":              {0, 1},
+	"disablevoting":             {},
+	"dumpprivkey":               {0},
+	"enablevoting":              {},
+	"filldepositpool":           {0, 1},
+	"finalizecosignsession":     {0, 1},
+	"generatevotes":             {0, 1, 2, 3, 4},
+	"getmultisigaccountaddress": {0, 1},
+	"importmulti":               {0, 1},
+	"importpkcs11account":       {0, 1},
+	"importprivkey":             {1, 2, 3},
+	"importscript":              {0, 1, 2},
+	"importvotechoices":         {},
+	"joinsplitticketsession":    {0, 1, 2, 3},
+	"lockunspent":               {0, 1},
+	"lockunspentnamespace":      {0, 1, 2, 3, 4, 5},
+	"mergecosignsessions":       {0},
+	"movefunds":                 {0, 1, 2, 3},
+	"openwallet":                {},
+	"overridespendingpolicy":    {0, 2},
+	"publishsplitticketsession": {0},
+	"purchaseticket":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	"redeemmultisigaccount":     {0, 1, 2, 3},
+	"redeemmultisigout":         {0, 1, 2, 3},
+	"redeemmultisigouts":        {0, 1, 2},
+	"redeemmultisigoutsbatch":   {0, 1, 2, 3, 4},
+	"rejectsend":                {0},
+	"releaseoutputs":            {0},
+	"renameaccount":             {0, 1},
+	"reserveoutputs":            {0, 1, 2, 3},
+	"revoketickets":             {},
+	"rotateaccount":             {0, 1, 2},
+	"sendfrom":                  {0, 1, 2, 3},
+	"sendmany":                  {0, 1, 2},
+	"sendsweepaccount":          {0, 1, 2, 3, 4, 5},
+	"sendtoaddress":             {0, 1},
+	"sendtomultisig":            {0, 1, 2, 3, 4},
+	"setaccountgappolicy":       {0, 1},
+	"setautoconsolidation":      {0, 1, 2, 3},
+	"setsendapproval":           {0},
+	"setspendingpolicy":         {0, 1, 2},
+	"setticketfee":              {0},
+	"settxfee":                  {0},
+	"setunlocksessiontimeout":   {0},
+	"setutxopolicy":             {0, 1, 2},
+	"setvotechoice":             {0, 1},
+	"setvsp":                    {0, 1},
+	"signcosignsession":         {0},
+	"signsplitticketsession":    {0},
+	"startautobuyer":            {0, 2, 3, 4, 5, 6, 7, 8, 9},
+	"stop":                      {},
+	"stopaccountautobuyer":      {0},
+	"stopautobuyer":             {},
+	"sweepaccount":              {0, 1, 2, 3},
+	"sweepdust":                 {0, 1, 2},
+	"walletlock":                {},
+	"walletpassphrase":          {1},
+	"walletpassphrasechange":    {},
+	"watchoutpoint":             {0, 1, 2},
+	"watchscript":               {0},
+}
+
+// redactedParam replaces parameters which are not recorded to the audit log.
+const redactedParam = "[redacted]"
+
+// auditParamRedactors return the recorded form of parameters of methods which
+// mix secret and non-secret fields in a single parameter.
+var auditParamRedactors = map[string]func(i int, param json.RawMessage) string{
+	"importmulti": redactImportMultiParam,
+}
+
+// redactImportMultiParam redacts the private keys of the requests of an
+// importmulti request.  The public keys, scripts, and options of each request
+// are recorded.
+func redactImportMultiParam(i int, param json.RawMessage) string {
+	if i != 0 {
+		return string(param)
+	}
+	var requests []map[string]json.RawMessage
+	err := json.Unmarshal(param, &requests)
+	if err != nil {
+		return redactedParam
+	}
+	redacted, _ := json.Marshal(redactedParam)
+	for _, r := range requests {
+		if _, ok := r["privkey"]; ok {
+			r["privkey"] = redacted
+		}
+	}
+	b, err := json.Marshal(requests)
+	if err != nil {
+		return redactedParam
+	}
+	return string(b)
+}
+
+// minAuditLogKeySize is the minimum size of the secret key of an audit log.
+const minAuditLogKeySize = 16
+
+// auditRecord is a single line of the audit log.  Requests are recorded
+// before they are handled, and their outcome is recorded by a second record
+// referencing the sequence number of the request record.
+//
+// Each record commits to the hash of the previous record, and hashes are
+// keyed with a secret which is not stored with the log, so modifying or
+// removing any record other than the last breaks the hash chain and can not
+// be concealed by recomputing the hashes of the following records.
+type auditRecord struct {
+	Seq      uint64   `json:"seq"`
+	Time     int64    `json:"time"`
+	Client   string   `json:"client"`
+	Role     string   `json:"role"`
+	Wallet   string   `json:"wallet,omitempty"`
+	Method   string   `json:"method"`
+	Params   []string `json:"params,omitempty"`
+	Request  uint64   `json:"request,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	PrevHash string   `json:"prevhash"`
+	Hash     string   `json:"hash"`
+}
+
+// hash returns the HMAC-SHA256 of the record keyed by key, which is
+// calculated over the JSON encoding of the record with an empty Hash field.
+func (r *auditRecord) hash(key []byte) (string, error) {
+	c := *r
+	c.Hash = ""
+	b, err := json.Marshal(&c)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// AuditLog is an append-only log of the state-changing requests handled by
+// the server.
+type AuditLog struct {
+	mu       sync.Mutex
+	f        *os.File
+	key      []byte
+	seq      uint64
+	lastHash string
+}
+
+// OpenAuditLog opens or creates the audit log file at path.  Record hashes are
+// keyed by key, which must be kept apart from the log.  The hash chain of
+// existing records is verified, and an error is returned if the log has been
+// modified.
+func OpenAuditLog(path string, key []byte) (*AuditLog, error) {
+	const op errors.Op = "legacyrpc.OpenAuditLog"
+	if len(key) < minAuditLogKeySize {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("audit log "+
+			"key must be at least %d bytes", minAuditLogKeySize))
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	records, err := readAuditRecords(f, key)
+	if err != nil {
+		f.Close()
+		return nil, errors.E(op, errors.Errorf("%s: %v", path, err))
+	}
+	l := &AuditLog{f: f, key: append([]byte(nil), key...)}
+	if len(records) != 0 {
+		last := records[len(records)-1]
+		l.seq = last.Seq
+		l.lastHash = last.Hash
+	}
+	return l, nil
+}
+
+// readAuditRecords reads every record of the audit log from the beginning of
+// the file, verifying the hash chain.
+func readAuditRecords(f *os.File, key []byte) ([]auditRecord, error) {
+	_, err := f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	var records []auditRecord
+	var prevHash string
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, maxRequestSize)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var r auditRecord
+		err := json.Unmarshal(line, &r)
+		if err != nil {
+			return nil, errors.Errorf("record %d: %v", len(records)+1, err)
+		}
+		if r.Seq != uint64(len(records))+1 || r.PrevHash != prevHash {
+			return nil, errors.Errorf("record %d: broken hash chain", len(records)+1)
+		}
+		hash, err := r.hash(key)
+		if err != nil {
+			return nil, err
+		}
+		if !hmac.Equal([]byte(hash), []byte(r.Hash)) {
+			return nil, errors.Errorf("record %d: hash mismatch", len(records)+1)
+		}
+		prevHash = r.Hash
+		records = append(records, r)
+	}
+	return records, sc.Err()
+}
+
+// Close closes the audit log file.
+func (l *AuditLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// recordRequest appends a record of a request which is about to be handled to
+// the log, returning the sequence number of the record.
+func (l *AuditLog) recordRequest(ctx context.Context, req *vhcjson.Request, recorded []int) (uint64, error) {
+	params := make([]string, len(req.Params))
+	for i := range params {
+		params[i] = redactedParam
+	}
+	redact := auditParamRedactors[req.Method]
+	for _, i := range recorded {
+		if i >= len(req.Params) {
+			continue
+		}
+		if redact != nil {
+			params[i] = redact(i, req.Params[i])
+		} else {
+			params[i] = string(req.Params[i])
+		}
+	}
+	r := auditRecord{
+		Time:   time.Now().Unix(),
+		Client: clientString(ctx),
+		Role:   roleFromContext(ctx).String(),
+		Wallet: walletNameFromContext(ctx),
+		Method: req.Method,
+		Params: params,
+	}
+	err := l.append(&r)
+	return r.Seq, err
+}
+
+// recordResult appends a record of the outcome of the request recorded with
+// sequence number seq to the log.
+func (l *AuditLog) recordResult(ctx context.Context, req *vhcjson.Request, seq uint64, jsonErr *vhcjson.RPCError) error {
+	r := auditRecord{
+		Time:    time.Now().Unix(),
+		Client:  clientString(ctx),
+		Role:    roleFromContext(ctx).String(),
+		Wallet:  walletNameFromContext(ctx),
+		Method:  req.Method,
+		Request: seq,
+	}
+	if jsonErr != nil {
+		r.Error = jsonErr.Message
+	}
+	return l.append(&r)
+}
+
+// append assigns the next sequence number to a record, links it to the hash
+// chain, and writes it to the log file.  The record is synced to disk before
+// returning.
+func (l *AuditLog) append(r *auditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r.Seq = l.seq + 1
+	r.PrevHash = l.lastHash
+	hash, err := r.hash(l.key)
+	if err != nil {
+		return err
+	}
+	r.Hash = hash
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = l.f.Write(append(line, '\n'))
+	if err != nil {
+		return err
+	}
+	err = l.f.Sync()
+	if err != nil {
+		return err
+	}
+	l.seq = r.Seq
+	l.lastHash = r.Hash
+	return nil
+}
+
+// records reads and verifies every record of the log.
+func (l *AuditLog) records() ([]auditRecord, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return readAuditRecords(l.f, l.key)
+}
+
+// auditedHandler wraps the handler of an audited method to record the request
+// to the audit log before it is handled, and its outcome after.  Requests are
+// refused without being handled if they can not be recorded.
+func (s *Server) auditedHandler(ctx context.Context, req *vhcjson.Request, h lazyHandler) lazyHandler {
+	recorded, ok := auditedMethods[req.Method]
+	if s.auditLog == nil || !ok {
+		return h
+	}
+	return func() (interface{}, *vhcjson.RPCError) {
+		seq, err := s.auditLog.recordRequest(ctx, req, recorded)
+		if err != nil {
+			log.Errorf("Refusing %s request of client %s: cannot record "+
+				"to audit log: %v", req.Method, clientString(ctx), err)
+			return nil, errRPCAuditLog
+		}
+		res, jsonErr := h()
+		err = s.auditLog.recordResult(ctx, req, seq, jsonErr)
+		if err != nil {
+			log.Errorf("Cannot record outcome of %s request of client %s "+
+				"to audit log: %v", req.Method, clientString(ctx), err)
+		}
+		return res, jsonErr
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// Role describes the methods which may be called by clients authenticated with
+// a set of credentials.
+type Role int
+
+// Roles of RPC credentials.
+const (
+	// RoleAdmin permits calling every method.
+	RoleAdmin Role = iota
+
+	// RoleReadOnly permits calling methods which only query the wallet and
+	// do not reveal secrets or modify the wallet.
+	RoleReadOnly
+
+	// RoleApprover permits only listing, approving, and rejecting sends
+	// awaiting approval.
+	RoleApprover
+
+	// roleNone is recorded by contexts of clients which have not been
+	// authenticated, and permits calling no methods.
+	roleNone Role = -1
+)
+
+var roleNames = [...]string{
+	RoleAdmin:    "admin",
+	RoleReadOnly: "readonly",
+	RoleApprover: "approver",
+}
+
+// String returns the configuration name of the role.
+func (r Role) String() string {
+	if r < 0 || int(r) >= len(roleNames) {
+		return "unknown"
+	}
+	return roleNames[r]
+}
+
+// Credential is a username and password pair which authenticates clients with
+// the permissions of a role.
+type Credential struct {
+	Username string
+	Password string
+	Role     Role
+}
+
+// ParseCredential parses a credential in the form username:password:role.
+// Neither the username nor the password may contain a colon.  The role may be
+// omitted, in which case the read-only role is used.
+func ParseCredential(s string) (Credential, error) {
+	const op errors.Op = "legacyrpc.ParseCredential"
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return Credential{}, errors.E(op, errors.Invalid,
+			"credential must be in the form username:password:role")
+	}
+	c := Credential{Username: parts[0], Password: parts[1], Role: RoleReadOnly}
+	if c.Username == "" || c.Password == "" {
+		return Credential{}, errors.E(op, errors.Invalid,
+			"credential username and password may not be empty")
+	}
+	if len(parts) == 3 {
+		role, err := parseRole(parts[2])
+		if err != nil {
+			return Credential{}, errors.E(op, err)
+		}
+		c.Role = role
+	}
+	return c, nil
+}
+
+// ParseCertRole parses the role of clients authenticated by a TLS client
+// certificate in the form commonname:role, where commonname is the subject
+// common name of the certificate.
+func ParseCertRole(s string) (commonName string, role Role, err error) {
+	const op errors.Op = "legacyrpc.ParseCertRole"
+	i := strings.LastIndexByte(s, ':')
+	if i <= 0 {
+		return "", 0, errors.E(op, errors.Invalid,
+			"certificate role must be in the form commonname:role")
+	}
+	role, err = parseRole(s[i+1:])
+	if err != nil {
+		return "", 0, errors.E(op, err)
+	}
+	return s[:i], role, nil
+}
+
+// parseRole returns the role with a configuration name.
+func parseRole(name string) (Role, error) {
+	for r := range roleNames {
+		if roleNames[r] == name {
+			return Role(r), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown role %q", name))
+}
+
+// credential records the hash of the HTTP Basic authentication string of a
+// Credential, which is used for constant time comparisons.
+type credential struct {
+	authsha  [sha256.Size]byte
+	username string
+	role     Role
+}
+
+// readOnlyMethods are the methods which may be called using read-only
+// credentials.
+var readOnlyMethods = map[string]struct{}{
+	"accountaddressindex":          {},
+	"decoderawtransaction":         {},
+	"decodescript":                 {},
+	"exportvotechoices":            {},
+	"getaccount":                   {},
+	"getaccountreceived":           {},
+	"getaccountstats":              {},
+	"getaddressesbyaccount":        {},
+	"getaddressreceived":           {},
+	"getapischema":                 {},
+	"getautobuyerstatus":           {},
+	"getautoconsolidation":         {},
+	"getbalance":                   {},
+	"getbalanceathash":             {},
+	"getbestblock":                 {},
+	"getbestblockhash":             {},
+	"getblockcount":                {},
+	"getbuildinfo":                 {},
+	"getdbstats":                   {},
+	"getfeatureflags":              {},
+	"getguardstatus":               {},
+	"getinfo":                      {},
+	"getmultisigoutinfo":           {},
+	"getpeerinfo":                  {},
+	"getreceivedbyaccount":         {},
+	"getreceivedbyaddress":         {},
+	"getrescanstatus":              {},
+	"getspendingpolicy":            {},
+	"getstakeinfo":                 {},
+	"getstakingstats":              {},
+	"getsyncstatus":                {},
+	"getticketfee":                 {},
+	"getticketinfo":                {},
+	"gettickets":                   {},
+	"gettransaction":               {},
+	"getunconfirmedbalance":        {},
+	"getvotechoices":               {},
+	"getvspinfo":                   {},
+	"getwalletfee":                 {},
+	"help":                         {},
+	"listaccounts":                 {},
+	"listdepositaddresses":         {},
+	"listaddresstransactions":      {},
+	"listalltransactions":          {},
+	"listlockunspent":              {},
+	"listmissedvotes":              {},
+	"listmultisigaccounts":         {},
+	"listmultisigunspent":          {},
+	"listoutpointlocks":            {},
+	"listpendingrevocations":       {},
+	"listpendingsends":             {},
+	"listreceivedbyaccount":        {},
+	"listreceivedbyaddress":        {},
+	"listrevocabletickets":         {},
+	"listsinceblock":               {},
+	"listscripts":                  {},
+	"listsplittickets":             {},
+	"liststakepoolusertickets":     {},
+	"listticketstatuses":           {},
+	"listtransactions":             {},
+	"listunspent":                  {},
+	"listunspentpage":              {},
+	"listwallets":                  {},
+	"notifyblocks":                 {},
+	"notifydepositaddresses":       {},
+	"notifymissedvotes":            {},
+	"notifynewtransactions":        {},
+	"notifypendingrevocations":     {},
+	"notifyrescanprogress":         {},
+	"notifytickets":                {},
+	"notifyvoteversion":            {},
+	"notifywinningtickets":         {},
+	"searchwallet":                 {},
+	"stakehistory":                 {},
+	"stakepooluserinfo":            {},
+	"stopnotifyblocks":             {},
+	"stopnotifydepositaddresses":   {},
+	"stopnotifymissedvotes":        {},
+	"stopnotifynewtransactions":    {},
+	"stopnotifypendingrevocations": {},
+	"stopnotifyrescanprogress":     {},
+	"stopnotifytickets":            {},
+	"stopnotifyvoteversion":        {},
+	"stopnotifywinningtickets":     {},
+	"ticketsforaddress":            {},
+	"validateaddress":              {},
+	"verifymessage":                {},
+	"verifyownershipproof":         {},
+	"version":                      {},
+	"walletexists":                 {},
+	"walletinfo":                   {},
+	"walletislocked":               {},
+}
+
+// authenticate compares the hash of an HTTP Basic authentication string
+// against every configured credential, returning the role and username of the
+// matching credential.  Every credential is compared to keep the check time
+// constant.
+func (s *Server) authenticate(authsha *[sha256.Size]byte) (Role, string, error) {
+	role := RoleAdmin
+	var username string
+	matched := false
+	for i := range s.credentials {
+		c := &s.credentials[i]
+		if subtle.ConstantTimeCompare(authsha[:], c.authsha[:]) == 1 && !matched {
+			role = c.role
+			username = c.username
+			matched = true
+		}
+	}
+	if !matched {
+		return 0, "", errors.New("invalid Authorization header")
+	}
+	return role, username, nil
+}
+
+// authenticateCert returns the role and identity of a client which presented a
+// TLS client certificate verified during the handshake.  The identity describes
+// the subject and serial number of the certificate for logging.  ok is false
+// when the client did not present a verified certificate, or when no role is
+// configured for the common name of the certificate.
+func (s *Server) authenticateCert(state *tls.ConnectionState) (role Role, identity string, ok bool) {
+	if state == nil || len(state.VerifiedChains) == 0 ||
+		len(state.VerifiedChains[0]) == 0 {
+		return 0, "", false
+	}
+	leaf := state.VerifiedChains[0][0]
+	role, found := s.certRoles[leaf.Subject.CommonName]
+	if !found {
+		return 0, "", false
+	}
+	identity = fmt.Sprintf("CN=%s serial=%x", leaf.Subject.CommonName,
+		leaf.SerialNumber)
+	return role, identity, true
+}
+
+// checkRole returns an error if the method may not be called by a client
+// authenticated with the role recorded in ctx.  When approver credentials are
+// configured, queued sends may only be approved by an approver.
+func (s *Server) checkRole(ctx context.Context, method string) *vhcjson.RPCError {
+	role := roleFromContext(ctx)
+	var allowed bool
+	switch role {
+	case RoleAdmin:
+		allowed = !(s.hasApprover && method == "approvesend")
+		if !allowed {
+			return rpcErrorf(vhcjson.ErrRPCInvalidRequest.Code,
+				"sends must be approved using approver credentials")
+		}
+	case RoleReadOnly:
+		_, allowed = readOnlyMethods[method]
+	case RoleApprover:
+		_, allowed = approverMethods[method]
+	}
+	if !allowed {
+		return rpcErrorf(vhcjson.ErrRPCInvalidRequest.Code,
+			"method %s may not be called with %s credentials", method, role)
+	}
+	return nil
+}
@@ -4,6 +4,11 @@
 
 package legacyrpc
 
+import (
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcwallet/internal/features"
+)
+
 // Options contains the required options for running the legacy RPC server.
 type Options struct {
 	Username string
@@ -11,4 +16,56 @@ type Options struct {
 
 	MaxPOSTClients      int64
 	MaxWebsocketClients int64
+
+	// RequireSendApproval queues transactions created by the send methods
+	// until they are approved with the approvesend method.  When
+	// approver credentials are set, approvals must be authenticated with
+	// them, and the approver may only call the approval methods.
+	RequireSendApproval bool
+	ApproverUsername    string
+	ApproverPassword    string
+
+	// Users are additional credentials, each restricted to the methods
+	// permitted by its role.
+	Users []Credential
+
+	// ClientCertRoles maps the subject common name of TLS client
+	// certificates to the role of clients authenticated by them.  Clients
+	// which present a verified certificate may omit HTTP Basic
+	// authentication, and certificates without a listed role are given the
+	// admin role.
+	ClientCertRoles map[string]Role
+
+	// Features records the enabled feature flags.  Methods of disabled
+	// features return an error with the errRPCFeatureDisabled code.
+	Features *features.Set
+
+	// RateLimit is the number of requests per second, with bursts of up to
+	// RateLimitBurst requests, and MaxClientRequests is the number of
+	// concurrent requests permitted for each client.  Clients are
+	// identified by their credentials or certificate, or by source IP when
+	// RateLimitByIP is set.  Zero values disable the limits.
+	RateLimit         float64
+	RateLimitBurst    int
+	MaxClientRequests int
+	RateLimitByIP     bool
+
+	// AuditLog records the state-changing requests handled by the server,
+	// if set.  It is closed when the server is stopped.
+	AuditLog *AuditLog
+
+	// SigningKey signs the results of the SignedMethods, if set.  Signed
+	// results bind the result to the request method, ID, and time.
+	SigningKey    *secp256k1.PrivateKey
+	SignedMethods []string
+
+	// CORSOrigins are the origins of browser clients permitted to make
+	// cross-origin HTTP POST requests and websocket connections.  The
+	// origin "*" permits every origin.  When empty, no CORS headers are
+	// added and websocket connections are accepted from every origin.
+	CORSOrigins []string
+
+	// Guard configures the anomaly guard, which locks the wallet when
+	// suspicious requests are observed.
+	Guard GuardOptions
 }
@@ -4,7 +4,12 @@
 
 package legacyrpc
 
-import "context"
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/loader"
+)
 
 type contextKey string
 
@@ -19,3 +24,112 @@ func remoteAddr(ctx context.Context) string {
 	}
 	return v.(string)
 }
+
+func withRole(parent context.Context, role Role) context.Context {
+	return context.WithValue(parent, contextKey("role"), role)
+}
+
+// roleFromContext returns the role of the authenticated client.  Contexts
+// which do not record a role permit calling no methods.
+func roleFromContext(ctx context.Context) Role {
+	v, ok := ctx.Value(contextKey("role")).(Role)
+	if !ok {
+		return roleNone
+	}
+	return v
+}
+
+func withUsername(parent context.Context, username string) context.Context {
+	return context.WithValue(parent, contextKey("username"), username)
+}
+
+// usernameFromContext returns the username of the credential which
+// authenticated the client, or the empty string when unknown.
+func usernameFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKey("username")).(string)
+	return v
+}
+
+// principalFromContext returns the principal which sends requested by the
+// client are attributed to: the identity of its TLS client certificate, or
+// the username of its credentials.
+func principalFromContext(ctx context.Context) string {
+	identity, ok := ctx.Value(contextKey("cert-identity")).(string)
+	if ok {
+		return identity
+	}
+	return "user " + usernameFromContext(ctx)
+}
+
+func withCertIdentity(parent context.Context, identity string) context.Context {
+	return context.WithValue(parent, contextKey("cert-identity"), identity)
+}
+
+func withAPIMajor(parent context.Context, major int) context.Context {
+	return context.WithValue(parent, contextKey("api-major"), major)
+}
+
+// apiMajorFromContext returns the major API version requested by the client,
+// defaulting to the current version of the server.
+func apiMajorFromContext(ctx context.Context) int {
+	v, ok := ctx.Value(contextKey("api-major")).(int)
+	if !ok {
+		return jsonrpcSemverMajor
+	}
+	return v
+}
+
+// clientString describes the client of a request for logging, including the
+// identity of its TLS client certificate when authenticated by one.
+func clientString(ctx context.Context) string {
+	identity, ok := ctx.Value(contextKey("cert-identity")).(string)
+	if !ok {
+		return remoteAddr(ctx)
+	}
+	return remoteAddr(ctx) + " (" + identity + ")"
+}
+
+func withWalletName(parent context.Context, name string) context.Context {
+	return context.WithValue(parent, contextKey("wallet-name"), name)
+}
+
+// walletNameFromContext returns the name of the wallet selected by the endpoint
+// of a request.  The default wallet has the empty name.
+func walletNameFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKey("wallet-name")).(string)
+	return v
+}
+
+// withWalletLoader resolves the loader of the wallet selected by the endpoint
+// of a request, returning a context recording it.  Loaders of named wallets
+// are only created once the request is handled, after the client is
+// authenticated.  Failures to look up the named wallet, such as errors
+// accessing its directory, are returned as RPC errors.
+func (s *Server) withWalletLoader(parent context.Context) (context.Context, *vhcjson.RPCError) {
+	name, ok := parent.Value(contextKey("wallet-name")).(string)
+	if !ok {
+		return parent, nil
+	}
+	if _, ok := parent.Value(contextKey("wallet-loader")).(*loader.Loader); ok {
+		return parent, nil
+	}
+	l, err := s.loader.Wallet(name)
+	if err != nil {
+		return parent, rpcError(vhcjson.ErrRPCWallet, err)
+	}
+	return context.WithValue(parent, contextKey("wallet-loader"), l), nil
+}
+
+// walletLoader returns the loader of the wallet selected by the endpoint of a
+// request, defaulting to the loader of the default wallet.  The loader of
+// named wallets must have been resolved by withWalletLoader.
+func (s *Server) walletLoader(ctx context.Context) *loader.Loader {
+	if _, ok := ctx.Value(contextKey("wallet-name")).(string); !ok {
+		return s.loader
+	}
+	l, ok := ctx.Value(contextKey("wallet-loader")).(*loader.Loader)
+	if !ok {
+		panic("legacyrpc: wallet loader of request was not resolved")
+	}
+	return l
+}
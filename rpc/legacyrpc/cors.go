@@ -0,0 +1,107 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// corsMaxAge is the number of seconds browsers may cache the result of a
+// pre-flight request.
+const corsMaxAge = 600
+
+// corsPolicy describes the origins of browser clients which may make
+// cross-origin requests to the server.
+type corsPolicy struct {
+	origins   map[string]struct{}
+	anyOrigin bool
+}
+
+// newCORSPolicy returns the policy permitting cross-origin requests from the
+// origins.  The origin "*" permits every origin, but responses to requests
+// from any origin do not allow browsers to send credentials which are managed
+// by the browser.  A nil policy, which permits no cross-origin requests, is
+// returned when there are no origins.
+func newCORSPolicy(origins []string) *corsPolicy {
+	if len(origins) == 0 {
+		return nil
+	}
+	p := &corsPolicy{origins: make(map[string]struct{}, len(origins))}
+	for _, o := range origins {
+		if o == "*" {
+			p.anyOrigin = true
+			continue
+		}
+		p.origins[o] = struct{}{}
+	}
+	return p
+}
+
+// allowed returns whether requests from a browser origin are permitted.
+func (p *corsPolicy) allowed(origin string) bool {
+	if p == nil {
+		return false
+	}
+	if _, ok := p.origins[origin]; ok {
+		return true
+	}
+	return p.anyOrigin
+}
+
+// checkWebsocketOrigin returns whether a websocket upgrade request may be
+// accepted.  Every request is accepted when no policy is configured, and
+// requests without an Origin header are not made by browsers and are always
+// accepted.
+func (p *corsPolicy) checkWebsocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	return p == nil || origin == "" || p.allowed(origin)
+}
+
+// corsFn wraps an http.HandlerFunc to add the CORS response headers permitting
+// browser clients of allowed origins to read responses, and to answer
+// pre-flight requests without authentication.  Pre-flight requests from
+// origins which are not allowed fail with HTTP 403.
+func corsFn(p *corsPolicy, f http.HandlerFunc) http.HandlerFunc {
+	if p == nil {
+		return f
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		preflight := r.Method == http.MethodOptions && origin != "" &&
+			r.Header.Get("Access-Control-Request-Method") != ""
+		if origin == "" {
+			f(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Add("Vary", "Origin")
+		if !p.allowed(origin) {
+			if preflight {
+				log.Warnf("Refused cross-origin request from origin %q of client %s",
+					origin, r.RemoteAddr)
+				http.Error(w, "403 Forbidden", http.StatusForbidden)
+				return
+			}
+			f(w, r)
+			return
+		}
+		if _, ok := p.origins[origin]; ok {
+			h.Set("Access-Control-Allow-Origin", origin)
+			h.Set("Access-Control-Allow-Credentials", "true")
+		} else {
+			h.Set("Access-Control-Allow-Origin", "*")
+		}
+		if preflight {
+			h.Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+			h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type, "+apiVersionHeader)
+			h.Set("Access-Control-Max-Age", strconv.Itoa(corsMaxAge))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		f(w, r)
+	}
+}
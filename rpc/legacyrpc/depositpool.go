@@ -0,0 +1,186 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+// depositAddressResult returns the JSON result describing a reserved deposit
+// address.
+func depositAddressResult(w *wallet.Wallet, a *udb.DepositAddress) (*types.DepositAddressResult, error) {
+	accountName, err := w.AccountName(a.Account)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.DepositAddressResult{
+		Account:   accountName,
+		Address:   a.Address,
+		Index:     a.Child,
+		Status:    a.Status.String(),
+		Created:   a.Created.Unix(),
+		Reference: a.Reference,
+	}
+	if !a.Assigned.IsZero() {
+		res.Assigned = a.Assigned.Unix()
+	}
+	return res, nil
+}
+
+// fillDepositPool handles a filldepositpool request by reserving addresses of
+// an account for deposits until the requested number are available, returning
+// the newly reserved addresses.
+func fillDepositPool(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.FillDepositPoolCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	added, err := w.FillDepositPool(account, cmd.Size)
+	if err != nil {
+		if errors.Is(errors.Invalid, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	results := make([]*types.DepositAddressResult, 0, len(added))
+	for _, a := range added {
+		res, err := depositAddressResult(w, a)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// assignDepositAddress handles an assigndepositaddress request by assigning the
+// oldest available reserved address of an account to an optional reference.
+func assignDepositAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.AssignDepositAddressCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	var reference string
+	if cmd.Reference != nil {
+		reference = *cmd.Reference
+	}
+	a, err := w.AssignDepositAddress(account, reference)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcError(vhcjson.ErrRPCWalletKeypoolRanOut, err)
+		}
+		return nil, err
+	}
+	return depositAddressResult(w, a)
+}
+
+// listDepositAddresses handles a listdepositaddresses request by returning the
+// reserved deposit addresses, optionally limited to a single account and
+// assignment status.
+func listDepositAddresses(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListDepositAddressesCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var status *udb.DepositAddressStatus
+	if cmd.Status != nil {
+		var st udb.DepositAddressStatus
+		switch *cmd.Status {
+		case udb.DepositAddressAvailable.String():
+			st = udb.DepositAddressAvailable
+		case udb.DepositAddressAssigned.String():
+			st = udb.DepositAddressAssigned
+		default:
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"unknown deposit address status %q", *cmd.Status)
+		}
+		status = &st
+	}
+
+	var addrs []*udb.DepositAddress
+	if cmd.Account != nil {
+		account, err := w.AccountNumber(*cmd.Account)
+		if err != nil {
+			if errors.Is(errors.NotExist, err) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		addrs, err = w.DepositAddresses(account)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		addrs, err = w.AllDepositAddresses()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*types.DepositAddressResult, 0, len(addrs))
+	for _, a := range addrs {
+		if status != nil && a.Status != *status {
+			continue
+		}
+		res, err := depositAddressResult(w, a)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// notifyDepositAddresses sends a depositaddress notification to a websocket
+// client for each address reserved for deposits.
+func notifyDepositAddresses(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.DepositAddressNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			for _, a := range v.Addresses {
+				accountName, err := w.AccountName(a.Account)
+				if err != nil {
+					log.Warnf("Cannot notify deposit address %s: %v", a.Address, err)
+					continue
+				}
+				ntfn := types.NewDepositAddressNtfn(accountName, a.Address, a.Child)
+				if wsc.sendNotification(ctx, ntfn) != nil {
+					return
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+)
+
+// warningDeprecated is the code of warnings for requests of deprecated
+// methods.
+const warningDeprecated = "deprecated"
+
+// deprecation describes a method which is deprecated and will be removed or
+// renamed by a future version of the server.
+type deprecation struct {
+	// replacement is the method clients should call instead, if any.
+	replacement string
+
+	// hint describes how clients should migrate away from the method.
+	hint string
+}
+
+// rpcWarning is a structured warning included in the response to a request.
+type rpcWarning struct {
+	Code        string `json:"code"`
+	Method      string `json:"method"`
+	Replacement string `json:"replacement,omitempty"`
+	Message     string `json:"message"`
+}
+
+// message returns the human readable description of the deprecation of a
+// method.
+func (d *deprecation) message(method string) string {
+	msg := fmt.Sprintf("method %s is deprecated", method)
+	if d.replacement != "" {
+		msg += fmt.Sprintf("; use %s instead", d.replacement)
+	}
+	if d.hint != "" {
+		msg += ": " + d.hint
+	}
+	return msg
+}
+
+// aliases maps each alias to the method it aliases, and deprecations maps
+// each deprecated method to its deprecation.  Both are built from the
+// handlers when the package is initialized.
+var (
+	aliases      = make(map[string]string)
+	deprecations = make(map[string]*deprecation)
+)
+
+func init() {
+	for method, h := range handlers {
+		if h.aliasOf != "" {
+			// Aliases must refer to a method which is not itself
+			// an alias, so that a request is resolved to its
+			// handler with a single lookup.
+			target, ok := handlers[h.aliasOf]
+			if !ok || target.aliasOf != "" {
+				panic(fmt.Sprintf("method %s aliases invalid method %s",
+					method, h.aliasOf))
+			}
+			aliases[method] = h.aliasOf
+		}
+		if h.deprecated != nil {
+			// The replacement of a deprecated alias defaults to
+			// the method it aliases.
+			d := *h.deprecated
+			if d.replacement == "" {
+				d.replacement = h.aliasOf
+			}
+			deprecations[method] = &d
+		}
+	}
+}
+
+// resolveMethod rewrites the method of a request for an alias to the method it
+// aliases, so that the request is authorized, audited, and handled as the
+// aliased method.  Warnings are returned when the requested method or the
+// method it aliases is deprecated.
+func resolveMethod(req *vhcjson.Request) []rpcWarning {
+	var warnings []rpcWarning
+	addWarning := func(method string) {
+		d, ok := deprecations[method]
+		if !ok {
+			return
+		}
+		warnings = append(warnings, rpcWarning{
+			Code:        warningDeprecated,
+			Method:      method,
+			Replacement: d.replacement,
+			Message:     d.message(method),
+		})
+	}
+	addWarning(req.Method)
+	if target, ok := aliases[req.Method]; ok {
+		log.Debugf("RPC method %s is an alias of %s", req.Method, target)
+		req.Method = target
+		addWarning(target)
+	}
+	return warnings
+}
+
+// marshalResponse marshals a JSON-RPC response in the same manner as
+// vhcjson.MarshalResponse, additionally including any warnings in a warnings
+// member of the response object and any data recorded for the error in a data
+// member of the error object.
+func marshalResponse(rpcVersion string, id interface{}, result interface{}, rpcErr *vhcjson.RPCError, warnings []rpcWarning) ([]byte, error) {
+	data := takeErrorData(rpcErr)
+	if len(warnings) == 0 && data == nil {
+		return vhcjson.MarshalResponse(rpcVersion, id, result, rpcErr)
+	}
+	if rpcVersion != "2.0" && rpcVersion != "1.0" {
+		rpcVersion = "1.0"
+	}
+	marshalledResult, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	response, err := vhcjson.NewResponse(rpcVersion, id, marshalledResult, rpcErr)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return json.Marshal(&struct {
+			*vhcjson.Response
+			Warnings []rpcWarning `json:"warnings"`
+		}{response, warnings})
+	}
+	errObj := &struct {
+		*vhcjson.RPCError
+		Data interface{} `json:"data"`
+	}{rpcErr, data}
+	return json.Marshal(&struct {
+		*vhcjson.Response
+		Error    interface{}  `json:"error"`
+		Warnings []rpcWarning `json:"warnings,omitempty"`
+	}{response, errObj, warnings})
+}
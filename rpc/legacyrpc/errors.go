@@ -7,9 +7,12 @@ package legacyrpc
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/valhallacoin/vhcd/vhcjson"
 	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
 )
 
 func convertError(err error) *vhcjson.RPCError {
@@ -34,10 +37,55 @@ func convertError(err error) *vhcjson.RPCError {
 			code = vhcjson.ErrRPCWalletInsufficientFunds
 		}
 	}
-	return &vhcjson.RPCError{
+	return sendErrorData(&vhcjson.RPCError{
 		Code:    code,
 		Message: err.Error(),
+	}, err)
+}
+
+// errorData records structured data describing RPC errors, which is included
+// in the data member of the error object of the response.  vhcjson.RPCError
+// has no data member, so the data is keyed by the error and removed when the
+// response is marshaled.
+var errorData = struct {
+	sync.Mutex
+	m map[*vhcjson.RPCError]interface{}
+}{m: make(map[*vhcjson.RPCError]interface{})}
+
+// takeErrorData returns and forgets the data recorded for an RPC error.
+func takeErrorData(rpcErr *vhcjson.RPCError) interface{} {
+	if rpcErr == nil {
+		return nil
+	}
+	errorData.Lock()
+	data, ok := errorData.m[rpcErr]
+	if ok {
+		delete(errorData.m, rpcErr)
+	}
+	errorData.Unlock()
+	return data
+}
+
+// sendErrorData records the context of a failure to author or publish a
+// transaction described by err as the data of rpcErr.  rpcErr must not be one
+// of the shared error variables.
+func sendErrorData(rpcErr *vhcjson.RPCError, err error) *vhcjson.RPCError {
+	e, ok := wallet.FindSendError(err)
+	if !ok {
+		return rpcErr
 	}
+	data := &types.SendErrorData{
+		Stage:        string(e.Stage),
+		Inputs:       e.Inputs,
+		Required:     e.Required.ToCoin(),
+		Available:    e.Available.ToCoin(),
+		RejectReason: e.RejectReason,
+		Retryable:    e.Temporary(),
+	}
+	errorData.Lock()
+	errorData.m[rpcErr] = data
+	errorData.Unlock()
+	return rpcErr
 }
 
 func rpcError(code vhcjson.RPCErrorCode, err error) *vhcjson.RPCError {
@@ -54,8 +102,27 @@ func rpcErrorf(code vhcjson.RPCErrorCode, format string, args ...interface{}) *v
 	}
 }
 
+// errRPCFeatureDisabled is the error code returned by methods of features
+// disabled by feature flags.  It does not collide with any vhcjson wallet
+// error code.
+const errRPCFeatureDisabled vhcjson.RPCErrorCode = -18
+
+// errRPCGuardTriggered is the error code returned when a request is refused
+// by the anomaly guard.
+const errRPCGuardTriggered vhcjson.RPCErrorCode = -19
+
 // Errors variables that are defined once here to avoid duplication.
 var (
+	errRPCBusy = &vhcjson.RPCError{
+		Code:    vhcjson.ErrRPCMisc,
+		Message: "Too many requests",
+	}
+
+	errRPCAuditLog = &vhcjson.RPCError{
+		Code:    vhcjson.ErrRPCMisc,
+		Message: "request refused: cannot record request to audit log",
+	}
+
 	errUnloadedWallet = &vhcjson.RPCError{
 		Code:    vhcjson.ErrRPCWallet,
 		Message: "request requires a wallet but wallet has not loaded yet",
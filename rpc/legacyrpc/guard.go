@@ -0,0 +1,322 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// Anomaly guard triggers.
+const (
+	guardTriggerPassphrase = "passphrase"
+	guardTriggerSendCap    = "sendcap"
+	guardTriggerOrigin     = "origin"
+)
+
+// maxGuardAlerts is the number of alerts remembered by the anomaly guard.
+// Older alerts are discarded.
+const maxGuardAlerts = 100
+
+// GuardOptions configures the anomaly guard, which locks the wallet and
+// refuses to unlock it until the triggered state is cleared with the
+// clearguard method.  Zero values disable the respective triggers.
+type GuardOptions struct {
+	// MaxPassphraseFailures is the number of consecutive incorrect
+	// passphrases which trigger the guard.
+	MaxPassphraseFailures int
+
+	// SendCap is the largest total amount which may be sent by a single
+	// send request or spent by a single transaction published by the
+	// wallet.  Larger sends are refused and trigger the guard.
+	SendCap vhcutil.Amount
+
+	// Origins are the networks which clients are expected to connect from.
+	// Requests from other addresses trigger the guard.
+	Origins []*net.IPNet
+}
+
+// ParseGuardOrigin parses an expected client origin of the anomaly guard,
+// either a single IP address or a network in CIDR notation.
+func ParseGuardOrigin(s string) (*net.IPNet, error) {
+	const op errors.Op = "legacyrpc.ParseGuardOrigin"
+	if strings.IndexByte(s, '/') != -1 {
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, errors.E(op, errors.Invalid, err)
+		}
+		return n, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid IP address %q", s))
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+type guardAlert struct {
+	time    time.Time
+	trigger string
+	client  string
+	detail  string
+}
+
+// anomalyGuard records the triggers fired by suspicious requests.
+type anomalyGuard struct {
+	opts GuardOptions
+
+	mu           sync.Mutex
+	passFailures int
+	trusted      map[string]struct{}
+	alerts       []guardAlert
+}
+
+func newAnomalyGuard(opts GuardOptions) *anomalyGuard {
+	return &anomalyGuard{
+		opts:    opts,
+		trusted: make(map[string]struct{}),
+	}
+}
+
+// triggered returns whether any trigger has fired since the guard was last
+// cleared.
+func (g *anomalyGuard) triggered() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.alerts) != 0
+}
+
+// fire records an alert for a trigger.
+func (g *anomalyGuard) fire(trigger, client, detail string) {
+	log.Warnf("Anomaly guard triggered by %s (client %s): %s; locking wallet",
+		trigger, client, detail)
+	g.mu.Lock()
+	if len(g.alerts) == maxGuardAlerts {
+		g.alerts = append(g.alerts[:0], g.alerts[1:]...)
+	}
+	g.alerts = append(g.alerts, guardAlert{
+		time:    time.Now(),
+		trigger: trigger,
+		client:  client,
+		detail:  detail,
+	})
+	g.mu.Unlock()
+}
+
+// checkOrigin returns whether requests from the host are expected.  Hosts
+// outside of the configured origins are expected once they are trusted when
+// the guard is cleared.
+func (g *anomalyGuard) checkOrigin(host string) bool {
+	if len(g.opts.Origins) == 0 {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip != nil {
+		for _, n := range g.opts.Origins {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+	}
+	g.mu.Lock()
+	_, ok := g.trusted[host]
+	g.mu.Unlock()
+	return ok
+}
+
+// passphraseResult records the result of a request authenticated by the
+// private passphrase, returning whether the failure limit was reached.
+func (g *anomalyGuard) passphraseResult(ok bool) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if ok {
+		g.passFailures = 0
+		return false
+	}
+	g.passFailures++
+	max := g.opts.MaxPassphraseFailures
+	return max > 0 && g.passFailures >= max
+}
+
+// checkSend returns whether a send of the total amount is permitted by the
+// send cap.
+func (g *anomalyGuard) checkSend(total vhcutil.Amount) bool {
+	return g.opts.SendCap == 0 || total <= g.opts.SendCap
+}
+
+// errGuardTriggered describes the refusal of an unlock or request while the
+// guard is triggered.
+const errGuardTriggered = "anomaly guard is triggered; review and clear it with clearguard"
+
+var _ wallet.SpendGuard = (*anomalyGuard)(nil)
+
+// CheckUnlock implements the wallet.SpendGuard interface by refusing unlocks
+// while the guard is triggered.
+func (g *anomalyGuard) CheckUnlock() error {
+	if g.triggered() {
+		return errors.E(errors.Policy, errGuardTriggered)
+	}
+	return nil
+}
+
+// CheckSpend implements the wallet.SpendGuard interface by refusing
+// transactions spending more than the send cap and firing its trigger.  The
+// wallet locks itself after the refusal.
+func (g *anomalyGuard) CheckSpend(amount vhcutil.Amount) error {
+	if g.checkSend(amount) {
+		return nil
+	}
+	g.fire(guardTriggerSendCap, "wallet", fmt.Sprintf("transaction "+
+		"spending %v exceeds the cap of %v", amount, g.opts.SendCap))
+	return errors.E(errors.Policy, errors.Errorf("transaction spending %v "+
+		"exceeds the send cap of %v", amount, g.opts.SendCap))
+}
+
+// clear removes the triggered state, optionally trusting the origins of the
+// requests which triggered the origin trigger.
+func (g *anomalyGuard) clear(trustOrigins bool) {
+	g.mu.Lock()
+	if trustOrigins {
+		for _, a := range g.alerts {
+			if a.trigger == guardTriggerOrigin {
+				g.trusted[hostOf(a.client)] = struct{}{}
+			}
+		}
+	}
+	g.passFailures = 0
+	g.alerts = nil
+	g.mu.Unlock()
+}
+
+// status describes the state of the guard.
+func (g *anomalyGuard) status() *types.GetGuardStatusResult {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	res := &types.GetGuardStatusResult{
+		Triggered:          len(g.alerts) != 0,
+		PassphraseFailures: g.passFailures,
+		Alerts:             make([]types.GuardAlertResult, len(g.alerts)),
+	}
+	for i, a := range g.alerts {
+		res.Alerts[i] = types.GuardAlertResult{
+			Time:    a.time.Unix(),
+			Trigger: a.trigger,
+			Client:  a.client,
+			Detail:  a.detail,
+		}
+	}
+	return res
+}
+
+// warning describes the triggered state for the errors of the getinfo
+// result, or is empty when the guard has not been triggered.
+func (g *anomalyGuard) warning() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.alerts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Anomaly guard triggered by %d alerts; the wallet "+
+		"may not be unlocked until the alerts are reviewed and cleared",
+		len(g.alerts))
+}
+
+// hostOf returns the host of a remote address.
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// lockWallet locks the wallet of a request after a trigger fires.
+func (s *Server) lockWallet(ctx context.Context) {
+	if w, ok := s.walletLoader(ctx).LoadedWallet(); ok {
+		w.Lock()
+	}
+}
+
+// guardedHandler applies the anomaly guard to a request.  Requests from
+// unexpected origins are refused, and they and repeated incorrect passphrases
+// fire triggers which lock the wallet.  The wallet may not be unlocked while
+// the guard is triggered.
+func (s *Server) guardedHandler(ctx context.Context, request *vhcjson.Request, h lazyHandler) lazyHandler {
+	g := s.guard
+	return func() (interface{}, *vhcjson.RPCError) {
+		client := remoteAddr(ctx)
+		if !g.checkOrigin(hostOf(client)) {
+			g.fire(guardTriggerOrigin, client, "request "+request.Method+
+				" from an unexpected origin")
+			s.lockWallet(ctx)
+			return nil, rpcErrorf(errRPCGuardTriggered, "request from an "+
+				"unexpected origin refused by the anomaly guard")
+		}
+		if request.Method == "walletpassphrase" && g.triggered() {
+			return nil, rpcErrorf(errRPCGuardTriggered, errGuardTriggered)
+		}
+
+		res, jsonErr := h()
+		switch {
+		case jsonErr != nil && jsonErr.Code == vhcjson.ErrRPCWalletPassphraseIncorrect:
+			if g.passphraseResult(false) {
+				g.fire(guardTriggerPassphrase, client, fmt.Sprintf(
+					"%d consecutive incorrect passphrases",
+					g.opts.MaxPassphraseFailures))
+				s.lockWallet(ctx)
+			}
+		case jsonErr == nil && request.Method == "walletpassphrase":
+			g.passphraseResult(true)
+		}
+		return res, jsonErr
+	}
+}
+
+// checkSendCap refuses sends above the send cap of the anomaly guard, firing
+// its trigger and locking the wallet.
+func (s *Server) checkSendCap(ctx context.Context, amounts map[string]vhcutil.Amount) error {
+	var total vhcutil.Amount
+	for _, amt := range amounts {
+		total += amt
+	}
+	if s.guard.checkSend(total) {
+		return nil
+	}
+	s.guard.fire(guardTriggerSendCap, remoteAddr(ctx), fmt.Sprintf(
+		"send of %v exceeds the cap of %v", total, s.guard.opts.SendCap))
+	s.lockWallet(ctx)
+	return rpcErrorf(errRPCGuardTriggered, "send of %v exceeds the send "+
+		"cap of %v", total, s.guard.opts.SendCap)
+}
+
+// getGuardStatus handles a getguardstatus request by describing the triggers
+// fired by the anomaly guard.
+func getGuardStatus(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	return s.guard.status(), nil
+}
+
+// clearGuard handles a clearguard request by clearing the triggered state of
+// the anomaly guard, permitting the wallet to be unlocked again.
+func clearGuard(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ClearGuardCmd)
+	trust := cmd.TrustOrigins != nil && *cmd.TrustOrigins
+	s.guard.clear(trust)
+	log.Infof("Anomaly guard cleared by %v", clientString(ctx))
+	return nil, nil
+}
@@ -13,6 +13,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"math/big"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,19 +33,24 @@ import (
 	"github.com/valhallacoin/vhcd/wire"
 	"github.com/valhallacoin/vhcwallet/chain"
 	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/internal/features"
 	"github.com/valhallacoin/vhcwallet/internal/helpers"
+	"github.com/valhallacoin/vhcwallet/internal/zero"
 	"github.com/valhallacoin/vhcwallet/p2p"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
 	ver "github.com/valhallacoin/vhcwallet/version"
+	"github.com/valhallacoin/vhcwallet/vsp"
 	"github.com/valhallacoin/vhcwallet/wallet"
 	"github.com/valhallacoin/vhcwallet/wallet/txrules"
 	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/walletseed"
 )
 
 // API version constants
 const (
-	jsonrpcSemverString = "5.0.0"
+	jsonrpcSemverString = "5.1.0"
 	jsonrpcSemverMajor  = 5
-	jsonrpcSemverMinor  = 0
+	jsonrpcSemverMinor  = 1
 	jsonrpcSemverPatch  = 0
 )
 
@@ -61,73 +69,165 @@ func confirms(txHeight, curHeight int32) int32 {
 // the registered rpc handlers
 var handlers = map[string]handler{
 	// Reference implementation wallet methods (implemented)
-	"accountaddressindex":     {fn: accountAddressIndex},
-	"accountsyncaddressindex": {fn: accountSyncAddressIndex},
-	"addmultisigaddress":      {fn: addMultiSigAddress},
-	"addticket":               {fn: addTicket},
-	"consolidate":             {fn: consolidate},
-	"createmultisig":          {fn: createMultiSig},
-	"dumpprivkey":             {fn: dumpPrivKey},
-	"generatevote":            {fn: generateVote},
-	"getaccount":              {fn: getAccount},
-	"getaccountaddress":       {fn: getAccountAddress},
-	"getaddressesbyaccount":   {fn: getAddressesByAccount},
-	"getbalance":              {fn: getBalance},
-	"getbestblockhash":        {fn: getBestBlockHash},
-	"getblockcount":           {fn: getBlockCount},
-	"getinfo":                 {fn: getInfo},
-	"getmasterpubkey":         {fn: getMasterPubkey},
-	"getmultisigoutinfo":      {fn: getMultisigOutInfo},
-	"getnewaddress":           {fn: getNewAddress},
-	"getrawchangeaddress":     {fn: getRawChangeAddress},
-	"getreceivedbyaccount":    {fn: getReceivedByAccount},
-	"getreceivedbyaddress":    {fn: getReceivedByAddress},
-	"getstakeinfo":            {fn: getStakeInfo},
-	"getticketfee":            {fn: getTicketFee},
-	"gettickets":              {fn: getTickets},
-	"gettransaction":          {fn: getTransaction},
-	"getvotechoices":          {fn: getVoteChoices},
-	"getwalletfee":            {fn: getWalletFee},
-	"help":                    {fn: help},
-	"importprivkey":           {fn: importPrivKey},
-	"importscript":            {fn: importScript},
-	"keypoolrefill":           {fn: keypoolRefill},
-	"listaccounts":            {fn: listAccounts},
-	"listlockunspent":         {fn: listLockUnspent},
-	"listreceivedbyaccount":   {fn: listReceivedByAccount},
-	"listreceivedbyaddress":   {fn: listReceivedByAddress},
-	"listsinceblock":          {fn: listSinceBlock},
-	"listscripts":             {fn: listScripts},
-	"listtransactions":        {fn: listTransactions},
-	"listunspent":             {fn: listUnspent},
-	"lockunspent":             {fn: lockUnspent},
-	"purchaseticket":          {fn: purchaseTicket},
-	"rescanwallet":            {fn: rescanWallet},
-	"revoketickets":           {fn: revokeTickets},
-	"sendfrom":                {fn: sendFrom},
-	"sendmany":                {fn: sendMany},
-	"sendtoaddress":           {fn: sendToAddress},
-	"sendtomultisig":          {fn: sendToMultiSig},
-	"setticketfee":            {fn: setTicketFee},
-	"settxfee":                {fn: setTxFee},
-	"setvotechoice":           {fn: setVoteChoice},
-	"signmessage":             {fn: signMessage},
-	"signrawtransaction":      {fn: signRawTransaction},
-	"signrawtransactions":     {fn: signRawTransactions},
-	"startautobuyer":          {fn: startAutoBuyer},
-	"stopautobuyer":           {fn: stopAutoBuyer},
-	"sweepaccount":            {fn: sweepAccount},
-	"redeemmultisigout":       {fn: redeemMultiSigOut},
-	"redeemmultisigouts":      {fn: redeemMultiSigOuts},
-	"stakepooluserinfo":       {fn: stakePoolUserInfo},
-	"ticketsforaddress":       {fn: ticketsForAddress},
-	"validateaddress":         {fn: validateAddress},
-	"verifymessage":           {fn: verifyMessage},
-	"version":                 {fn: version},
-	"walletinfo":              {fn: walletInfo},
-	"walletlock":              {fn: walletLock},
-	"walletpassphrase":        {fn: walletPassphrase},
-	"walletpassphrasechange":  {fn: walletPassphraseChange},
+	"accountaddressindex":       {fn: accountAddressIndex},
+	"accountsyncaddressindex":   {fn: accountSyncAddressIndex},
+	"addmultisigaddress":        {fn: addMultiSigAddress},
+	"addticket":                 {fn: addTicket},
+	"approvesend":               {fn: approveSend},
+	"assigndepositaddress":      {fn: assignDepositAddress},
+	"cancelrescan":              {fn: cancelRescan},
+	"cancelrevocation":          {fn: cancelRevocation},
+	"clearguard":                {fn: clearGuard},
+	"clearunlocksession":        {fn: clearUnlockSession},
+	"closewallet":               {fn: closeWallet},
+	"consolidate":               {fn: consolidate},
+	"consolidateaccount":        {fn: consolidateAccount},
+	"createcosignsession":       {fn: createCosignSession},
+	"createmultisig":            {fn: createMultiSig},
+	"createmultisigaccount":     {fn: createMultisigAccount},
+	"createownershipproof":      {fn: createOwnershipProof},
+	"createsplitticketsession":  {fn: createSplitTicketSession},
+	"createunsignedtickets":     {fn: createUnsignedTickets},
+	"createwallet":              {fn: createWallet},
+	"decoderawtransaction":      {fn: decodeRawTransaction},
+	"decodescript":              {fn: decodeScript},
+	"deleteprivkey":             {fn: deletePrivKey},
+	"deletescript":              {fn: deleteScript},
+	"disablevoting":             {fn: disableVoting},
+	"dumpprivkey":               {fn: dumpPrivKey},
+	"enablevoting":              {fn: enableVoting},
+	"exportvotechoices":         {fn: exportVoteChoices},
+	"filldepositpool":           {fn: fillDepositPool},
+	"finalizecosignsession":     {fn: finalizeCosignSession},
+	"generatevote":              {fn: generateVote},
+	"generatevotes":             {fn: generateVotes},
+	"getaccount":                {fn: getAccount},
+	"getaccountaddress":         {fn: getAccountAddress},
+	"getaccountreceived":        {fn: getAccountReceived},
+	"getaccountstats":           {fn: getAccountStats},
+	"getauditlog":               {fn: getAuditLog},
+	"getaddressreceived":        {fn: getAddressReceived},
+	"getaddressesbyaccount":     {fn: getAddressesByAccount},
+	"getapischema":              {fn: getAPISchema},
+	"getbalance":                {fn: getBalance, legacyResults: []legacyResult{{4, getBalanceV4}}},
+	"getautobuyerstatus":        {fn: getAutoBuyerStatus},
+	"getautoconsolidation":      {fn: getAutoConsolidation},
+	"getbalanceathash":          {fn: getBalanceAtHash},
+	"getbuildinfo":              {fn: getBuildInfo},
+	"getdbstats":                {fn: getDBStats},
+	"getbestblockhash":          {fn: getBestBlockHash},
+	"getblockcount":             {fn: getBlockCount},
+	"getfeatureflags":           {fn: getFeatureFlags},
+	"getguardstatus":            {fn: getGuardStatus},
+	"getinfo":                   {fn: getInfo},
+	"getmasterpubkey":           {fn: getMasterPubkey},
+	"getmultisigoutinfo":        {fn: getMultisigOutInfo},
+	"getnewaddress":             {fn: getNewAddress},
+	"getpeerinfo":               {fn: getPeerInfo},
+	"getrawchangeaddress":       {fn: getRawChangeAddress},
+	"getreceivedbyaccount":      {fn: getReceivedByAccount},
+	"getreceivedbyaddress":      {fn: getReceivedByAddress},
+	"getmultisigaccountaddress": {fn: getMultisigAccountAddress},
+	"getrescanstatus":           {fn: getRescanStatus},
+	"getresponsesigningkey":     {fn: getResponseSigningKey},
+	"getspendingpolicy":         {fn: getSpendingPolicy},
+	"getstakeinfo":              {fn: getStakeInfo},
+	"getstakingstats":           {fn: getStakingStats},
+	"getsyncstatus":             {fn: getSyncStatus},
+	"getticketfee":              {fn: getTicketFee},
+	"getticketinfo":             {fn: getTicketInfo},
+	"gettickets":                {fn: getTickets},
+	"gettransaction":            {fn: getTransaction},
+	"getvotechoices":            {fn: getVoteChoices},
+	"getvspinfo":                {fn: getVSPInfo},
+	"getwalletfee":              {fn: getWalletFee},
+	"help":                      {fn: help},
+	"importmulti":               {fn: importMulti},
+	"importpkcs11account":       {fn: importPKCS11Account},
+	"importprivkey":             {fn: importPrivKey},
+	"importscript":              {fn: importScript},
+	"importvotechoices":         {fn: importVoteChoices},
+	"joinsplitticketsession":    {fn: joinSplitTicketSession},
+	"keypoolrefill":             {fn: keypoolRefill},
+	"listaccounts":              {fn: listAccounts},
+	"listdepositaddresses":      {fn: listDepositAddresses},
+	"listlockunspent":           {fn: listLockUnspent},
+	"listmissedvotes":           {fn: listMissedVotes},
+	"listmultisigaccounts":      {fn: listMultisigAccounts},
+	"listmultisigunspent":       {fn: listMultisigUnspent},
+	"listoutpointlocks":         {fn: listOutpointLocks},
+	"listpendingrevocations":    {fn: listPendingRevocations},
+	"listpendingsends":          {fn: listPendingSends},
+	"listreceivedbyaccount":     {fn: listReceivedByAccount},
+	"listreceivedbyaddress":     {fn: listReceivedByAddress},
+	"listrevocabletickets":      {fn: listRevocableTickets},
+	"listsinceblock":            {fn: listSinceBlock},
+	"listscripts":               {fn: listScripts},
+	"listsplittickets":          {fn: listSplitTickets},
+	"liststakepoolusertickets":  {fn: listStakePoolUserTickets},
+	"listticketstatuses":        {fn: listTicketStatuses},
+	"listtransactions":          {fn: listTransactions},
+	"listunspent":               {fn: listUnspent},
+	"listunspentpage":           {fn: listUnspentPage},
+	"listwallets":               {fn: listWallets},
+	"lockunspent":               {fn: lockUnspent},
+	"lockunspentnamespace":      {fn: lockUnspentNamespace},
+	"mergecosignsessions":       {fn: mergeCosignSessions},
+	"movefunds":                 {fn: moveFunds},
+	"openwallet":                {fn: openWallet},
+	"overridespendingpolicy":    {fn: overrideSpendingPolicy},
+	"publishsplitticketsession": {fn: publishSplitTicketSession},
+	"purchaseticket":            {fn: purchaseTicket},
+	"rejectsend":                {fn: rejectSend},
+	"releaseoutputs":            {fn: releaseOutputs},
+	"rescanwallet":              {fn: rescanWallet},
+	"reserveoutputs":            {fn: reserveOutputs},
+	"revoketickets":             {fn: revokeTickets},
+	"rotateaccount":             {fn: rotateAccount},
+	"searchwallet":              {fn: searchWallet},
+	"sendfrom":                  {fn: sendFrom},
+	"sendmany":                  {fn: sendMany},
+	"sendsweepaccount":          {fn: sendSweepAccount},
+	"sendtoaddress":             {fn: sendToAddress},
+	"sendtomultisig":            {fn: sendToMultiSig},
+	"setaccountgappolicy":       {fn: setAccountGapPolicy},
+	"setautoconsolidation":      {fn: setAutoConsolidation},
+	"setsendapproval":           {fn: setSendApproval},
+	"setspendingpolicy":         {fn: setSpendingPolicy},
+	"setticketfee":              {fn: setTicketFee},
+	"setunlocksessiontimeout":   {fn: setUnlockSessionTimeout},
+	"setutxopolicy":             {fn: setUTXOPolicy},
+	"settxfee":                  {fn: setTxFee},
+	"setvotechoice":             {fn: setVoteChoice},
+	"setvsp":                    {fn: setVSP},
+	"signcosignsession":         {fn: signCosignSession},
+	"signmessage":               {fn: signMessage},
+	"signrawtransaction":        {fn: signRawTransaction},
+	"signrawtransactions":       {fn: signRawTransactions},
+	"signsplitticketsession":    {fn: signSplitTicketSession},
+	"startautobuyer":            {fn: startAutoBuyer},
+	"stopaccountautobuyer":      {fn: stopAccountAutoBuyer},
+	"stopautobuyer":             {fn: stopAutoBuyer},
+	"sweepaccount":              {fn: sweepAccount},
+	"sweepdust":                 {fn: sweepDust},
+	"redeemmultisigout":         {fn: redeemMultiSigOut},
+	"redeemmultisigouts":        {fn: redeemMultiSigOuts},
+	"redeemmultisigaccount":     {fn: redeemMultisigAccount},
+	"redeemmultisigoutsbatch":   {fn: redeemMultiSigOutsBatch},
+	"stakehistory":              {fn: stakeHistory},
+	"stakepooluserinfo":         {fn: stakePoolUserInfo},
+	"ticketsforaddress":         {fn: ticketsForAddress},
+	"validateaddress":           {fn: validateAddress},
+	"verifymessage":             {fn: verifyMessage},
+	"verifyownershipproof":      {fn: verifyOwnershipProof},
+	"version":                   {fn: version},
+	"walletexists":              {fn: walletExists},
+	"walletinfo":                {fn: walletInfo},
+	"walletlock":                {fn: walletLock},
+	"walletpassphrase":          {fn: walletPassphrase},
+	"walletpassphrasechange":    {fn: walletPassphraseChange},
+	"watchoutpoint":             {fn: watchOutPoint},
+	"watchscript":               {fn: watchScript},
 
 	// Extensions to the reference client JSON-RPC API
 	"getbestblock":     {fn: getBestBlock},
@@ -142,6 +242,29 @@ var handlers = map[string]handler{
 	"renameaccount":           {fn: renameAccount},
 	"walletislocked":          {fn: walletIsLocked},
 
+	// Notification methods which are only available to websocket clients.
+	// Requests from websocket clients are handled by the server before
+	// handler lookup.
+	"notifyblocks":                 {fn: websocketOnly, feature: features.Notifications},
+	"notifydepositaddresses":       {fn: websocketOnly, feature: features.Notifications},
+	"notifymissedvotes":            {fn: websocketOnly, feature: features.Notifications},
+	"notifynewtransactions":        {fn: websocketOnly, feature: features.Notifications},
+	"notifypendingrevocations":     {fn: websocketOnly, feature: features.Notifications},
+	"notifyrescanprogress":         {fn: websocketOnly, feature: features.Notifications},
+	"notifytickets":                {fn: websocketOnly, feature: features.Notifications},
+	"notifyvoteversion":            {fn: websocketOnly, feature: features.Notifications},
+	"notifywinningtickets":         {fn: websocketOnly, feature: features.Notifications},
+	"setapiversion":                {fn: websocketOnly},
+	"stopnotifyblocks":             {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifydepositaddresses":   {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifymissedvotes":        {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifynewtransactions":    {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifypendingrevocations": {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifyrescanprogress":     {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifytickets":            {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifyvoteversion":        {fn: websocketOnly, feature: features.Notifications},
+	"stopnotifywinningtickets":     {fn: websocketOnly, feature: features.Notifications},
+
 	// Reference implementation methods (still unimplemented)
 	"backupwallet":         {fn: unimplemented, noHelp: true},
 	"getwalletinfo":        {fn: unimplemented, noHelp: true},
@@ -158,7 +281,7 @@ var handlers = map[string]handler{
 
 // unimplemented handles an unimplemented RPC request with the
 // appropiate error.
-func unimplemented(*Server, interface{}) (interface{}, error) {
+func unimplemented(*Server, context.Context, interface{}) (interface{}, error) {
 	return nil, &vhcjson.RPCError{
 		Code:    vhcjson.ErrRPCUnimplemented,
 		Message: "Method unimplemented",
@@ -167,7 +290,7 @@ func unimplemented(*Server, interface{}) (interface{}, error) {
 
 // unsupported handles a standard bitcoind RPC request which is
 // unsupported by vhcwallet due to design differences.
-func unsupported(*Server, interface{}) (interface{}, error) {
+func unsupported(*Server, context.Context, interface{}) (interface{}, error) {
 	return nil, &vhcjson.RPCError{
 		Code:    -1,
 		Message: "Request unsupported by vhcwallet",
@@ -183,12 +306,12 @@ type lazyHandler func() (interface{}, *vhcjson.RPCError)
 // returning a closure that will execute it with the (required) wallet and
 // (optional) consensus RPC server.  If no handlers are found and the
 // chainClient is not nil, the returned handler performs RPC passthrough.
-func lazyApplyHandler(s *Server, request *vhcjson.Request) lazyHandler {
+func lazyApplyHandler(s *Server, ctx context.Context, request *vhcjson.Request) lazyHandler {
 	handlerData, ok := handlers[request.Method]
 	if !ok {
 		return func() (interface{}, *vhcjson.RPCError) {
 			// Attempt RPC passthrough if possible
-			n, ok := s.walletLoader.NetworkBackend()
+			n, ok := s.walletLoader(ctx).NetworkBackend()
 			if !ok {
 				return nil, errRPCClientNotConnected
 			}
@@ -210,7 +333,7 @@ func lazyApplyHandler(s *Server, request *vhcjson.Request) lazyHandler {
 			return nil, vhcjson.ErrRPCInvalidRequest
 		}
 
-		resp, err := handlerData.fn(s, cmd)
+		resp, err := handlerData.fn(s, ctx, cmd)
 		if err != nil {
 			return nil, convertError(err)
 		}
@@ -247,9 +370,9 @@ func makeResponse(id, result interface{}, err error) vhcjson.Response {
 
 // accountAddressIndex returns the next address index for the passed
 // account and branch.
-func accountAddressIndex(s *Server, icmd interface{}) (interface{}, error) {
+func accountAddressIndex(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.AccountAddressIndexCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -281,9 +404,9 @@ func accountAddressIndex(s *Server, icmd interface{}) (interface{}, error) {
 // index is beyond the passed index, an error is returned. If the passed index
 // is the same as the current pool index, nothing is returned. If the syncing
 // is successful, nothing is returned.
-func accountSyncAddressIndex(s *Server, icmd interface{}) (interface{}, error) {
+func accountSyncAddressIndex(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.AccountSyncAddressIndexCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -352,14 +475,14 @@ func makeMultiSigScript(w *wallet.Wallet, keys []string, nRequired int) ([]byte,
 
 // addMultiSigAddress handles an addmultisigaddress request by adding a
 // multisig address to the given wallet.
-func addMultiSigAddress(s *Server, icmd interface{}) (interface{}, error) {
+func addMultiSigAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.AddMultisigAddressCmd)
 	// If an account is specified, ensure that is the imported account.
 	if cmd.Account != nil && *cmd.Account != udb.ImportedAddrAccountName {
 		return nil, errNotImportedAccount
 	}
 
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -378,12 +501,16 @@ func addMultiSigAddress(s *Server, icmd interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	p2shAddr, err := w.ImportP2SHRedeemScript(script)
+	p2shAddr, err := w.ImportP2SHRedeemScript(script, &udb.ScriptImport{
+		Origin:     "addmultisigaddress",
+		User:       usernameFromContext(ctx),
+		RescanFrom: -1,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	n, ok := s.walletLoader.NetworkBackend()
+	n, ok := s.walletLoader(ctx).NetworkBackend()
 	if !ok {
 		return nil, errNoNetwork
 	}
@@ -396,9 +523,9 @@ func addMultiSigAddress(s *Server, icmd interface{}) (interface{}, error) {
 }
 
 // addTicket adds a ticket to the stake manager manually.
-func addTicket(s *Server, icmd interface{}) (interface{}, error) {
+func addTicket(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.AddTicketCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -413,11 +540,297 @@ func addTicket(s *Server, icmd interface{}) (interface{}, error) {
 	return nil, err
 }
 
+// clearUnlockSession handles a clearunlocksession request by removing the
+// cached unlock session key, requiring the next unlock to derive the key from
+// the passphrase.  The lock state of the wallet is not changed.
+func clearUnlockSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	w.ClearUnlockSession()
+	return nil, nil
+}
+
+// createVinList returns the decoded inputs of a transaction.  The coinbase
+// input of coinbase transactions and the stakebase input of votes are
+// reported without previous outpoints.
+func createVinList(mtx *wire.MsgTx) []vhcjson.Vin {
+	vinList := make([]vhcjson.Vin, len(mtx.TxIn))
+	if blockchain.IsCoinBaseTx(mtx) {
+		txIn := mtx.TxIn[0]
+		vinEntry := &vinList[0]
+		vinEntry.Coinbase = hex.EncodeToString(txIn.SignatureScript)
+		vinEntry.Sequence = txIn.Sequence
+		vinEntry.AmountIn = vhcutil.Amount(txIn.ValueIn).ToCoin()
+		vinEntry.BlockHeight = txIn.BlockHeight
+		vinEntry.BlockIndex = txIn.BlockIndex
+		return vinList
+	}
+
+	isSSGen := stake.IsSSGen(mtx)
+	for i, txIn := range mtx.TxIn {
+		vinEntry := &vinList[i]
+		vinEntry.Sequence = txIn.Sequence
+		vinEntry.AmountIn = vhcutil.Amount(txIn.ValueIn).ToCoin()
+		vinEntry.BlockHeight = txIn.BlockHeight
+		vinEntry.BlockIndex = txIn.BlockIndex
+		if isSSGen && i == 0 {
+			vinEntry.Stakebase = hex.EncodeToString(txIn.SignatureScript)
+			continue
+		}
+
+		// The disassembled string will contain [error] inline if the
+		// script doesn't fully parse, so ignore the error here.
+		disbuf, _ := txscript.DisasmString(txIn.SignatureScript)
+		vinEntry.Txid = txIn.PreviousOutPoint.Hash.String()
+		vinEntry.Vout = txIn.PreviousOutPoint.Index
+		vinEntry.Tree = txIn.PreviousOutPoint.Tree
+		vinEntry.ScriptSig = &vhcjson.ScriptSig{
+			Asm: disbuf,
+			Hex: hex.EncodeToString(txIn.SignatureScript),
+		}
+	}
+	return vinList
+}
+
+// createVoutList returns the decoded outputs of a transaction.  The odd
+// outputs of tickets are decoded as ticket commitments.
+func createVoutList(mtx *wire.MsgTx, params *chaincfg.Params) []vhcjson.Vout {
+	isSStx := stake.IsSStx(mtx)
+	voutList := make([]vhcjson.Vout, 0, len(mtx.TxOut))
+	for i, v := range mtx.TxOut {
+		// The disassembled string will contain [error] inline if the
+		// script doesn't fully parse, so ignore the error here.
+		disbuf, _ := txscript.DisasmString(v.PkScript)
+
+		var vout vhcjson.Vout
+		vout.N = uint32(i)
+		vout.Value = vhcutil.Amount(v.Value).ToCoin()
+		vout.Version = v.Version
+		spk := &vout.ScriptPubKey
+		spk.Asm = disbuf
+		spk.Hex = hex.EncodeToString(v.PkScript)
+
+		var addrs []vhcutil.Address
+		if isSStx && i%2 != 0 {
+			spk.Type = "sstxcommitment"
+			addr, err := stake.AddrFromSStxPkScrCommitment(v.PkScript, params)
+			if err == nil {
+				addrs = []vhcutil.Address{addr}
+			}
+			amt, err := stake.AmountFromSStxPkScrCommitment(v.PkScript)
+			if err == nil {
+				commitAmt := amt.ToCoin()
+				spk.CommitAmt = &commitAmt
+			}
+		} else {
+			class, scriptAddrs, reqSigs, _ := txscript.ExtractPkScriptAddrs(
+				v.Version, v.PkScript, params)
+			spk.Type = class.String()
+			spk.ReqSigs = int32(reqSigs)
+			addrs = scriptAddrs
+		}
+		for _, addr := range addrs {
+			spk.Addresses = append(spk.Addresses, addr.EncodeAddress())
+		}
+
+		voutList = append(voutList, vout)
+	}
+	return voutList
+}
+
+// decodeRawTransaction handles a decoderawtransaction request by decoding a
+// serialized transaction without requiring a consensus RPC server.
+func decodeRawTransaction(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.DecodeRawTransactionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	serializedTx, err := decodeHexStr(cmd.HexTx)
+	if err != nil {
+		return nil, err
+	}
+	var mtx wire.MsgTx
+	err = mtx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDeserialization, err)
+	}
+
+	return vhcjson.TxRawDecodeResult{
+		Txid:     mtx.TxHash().String(),
+		Version:  int32(mtx.Version),
+		Locktime: mtx.LockTime,
+		Expiry:   mtx.Expiry,
+		Vin:      createVinList(&mtx),
+		Vout:     createVoutList(&mtx, w.ChainParams()),
+	}, nil
+}
+
+// decodeScript handles a decodescript request by classifying a script and
+// reporting whether the wallet owns any address involved with it, including
+// the pay-to-script-hash address of the script.
+func decodeScript(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.DecodeScriptCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	script, err := decodeHexStr(cmd.HexScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// The disassembled string will contain [error] inline if the script
+	// doesn't fully parse, so ignore the error here.
+	disbuf, _ := txscript.DisasmString(script)
+
+	// Ignore the error here since an error means the script couldn't parse
+	// and there is no additional information about it anyways.
+	class, addrs, reqSigs, _ := txscript.ExtractPkScriptAddrs(
+		txscript.DefaultScriptVersion, script, w.ChainParams())
+	involved := addrs
+
+	// Scripts which are already pay-to-script-hash can not be wrapped again.
+	var p2sh string
+	if class != txscript.ScriptHashTy {
+		p2shAddr, err := vhcutil.NewAddressScriptHash(script, w.ChainParams())
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		p2sh = p2shAddr.EncodeAddress()
+		involved = append(involved, p2shAddr)
+	}
+
+	result := &types.DecodeScriptResult{
+		Asm:     disbuf,
+		ReqSigs: int32(reqSigs),
+		Type:    class.String(),
+		P2sh:    p2sh,
+	}
+	for _, addr := range addrs {
+		result.Addresses = append(result.Addresses, addr.EncodeAddress())
+	}
+	for _, addr := range involved {
+		result.IsMine, err = w.HaveAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		if result.IsMine {
+			break
+		}
+	}
+	return result, nil
+}
+
+// deletePrivKey handles a deleteprivkey request by removing an imported key
+// from the wallet and optionally forgetting the unspent outputs paying it.
+func deletePrivKey(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.DeletePrivKeyCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	removed, err := w.DeleteImportedKey(addr, cmd.DropHistory != nil && *cmd.DropHistory)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidAddressOrKey,
+				"address %v is not known to the wallet", addr)
+		}
+		return nil, err
+	}
+	return removed, nil
+}
+
+// deleteScript handles a deletescript request by removing an imported redeem
+// script, identified by its P2SH address or hex encoding, from the wallet and
+// optionally forgetting the unspent outputs paying it.
+func deleteScript(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.DeleteScriptCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var addr vhcutil.Address
+	if script, err := hex.DecodeString(cmd.Script); err == nil && len(script) != 0 {
+		addr, err = vhcutil.NewAddressScriptHash(script, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		addr, err = decodeAddress(cmd.Script, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+	removed, err := w.DeleteImportedScript(addr, cmd.DropHistory != nil && *cmd.DropHistory)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidAddressOrKey,
+				"script for address %v is not known to the wallet", addr)
+		}
+		return nil, err
+	}
+	return removed, nil
+}
+
+// disableVoting handles a disablevoting request by stopping the wallet from
+// voting and revoking tickets until voting is enabled again.
+func disableVoting(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	w.SetVotingEnabled(false)
+	return nil, nil
+}
+
+// enableVoting handles an enablevoting request by starting to vote and revoke
+// owned tickets as winning and missed ticket notifications are received.
+func enableVoting(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Winning ticket notifications are only received from vhcd.
+	if n, ok := s.walletLoader(ctx).NetworkBackend(); ok {
+		if _, err := chain.RPCClientFromBackend(n); err != nil {
+			return nil, rpcErrorf(vhcjson.ErrRPCMisc, "voting requires vhcd RPC synchronization")
+		}
+	}
+
+	w.SetVotingEnabled(true)
+	return nil, nil
+}
+
+// closeWallet handles a closewallet request by stopping the loaded wallet and
+// closing its database.  Requests requiring a wallet fail until a wallet is
+// opened or created again.
+func closeWallet(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	err := s.walletLoader(ctx).UnloadWallet()
+	if errors.Is(errors.Invalid, err) {
+		return nil, errUnloadedWallet
+	}
+	return nil, err
+}
+
 // consolidate handles a consolidate request by returning attempting to compress
 // as many inputs as given and then returning the txHash and error.
-func consolidate(s *Server, icmd interface{}) (interface{}, error) {
+func consolidate(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ConsolidateCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -446,8 +859,6 @@ func consolidate(s *Server, icmd interface{}) (interface{}, error) {
 		}
 	}
 
-	// TODO In the future this should take the optional account and
-	// only consolidate UTXOs found within that account.
 	txHash, err := w.Consolidate(cmd.Inputs, account, changeAddr)
 	if err != nil {
 		return nil, err
@@ -456,11 +867,63 @@ func consolidate(s *Server, icmd interface{}) (interface{}, error) {
 	return txHash.String(), nil
 }
 
+// consolidateAccount handles a consolidateaccount request by consolidating
+// unspent outputs of an account, limited by the number of inputs and a target
+// output size, into a single output.  The transaction hash is returned.
+func consolidateAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ConsolidateAccountCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	if *cmd.MaxInputs < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative maxinputs")
+	}
+	if *cmd.TargetSize < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative target size")
+	}
+	opts := &wallet.ConsolidateOptions{MaxInputs: *cmd.MaxInputs}
+	opts.TargetSize, err = vhcutil.NewAmount(*cmd.TargetSize)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	if cmd.FeePerKb != nil {
+		opts.FeeRate, err = vhcutil.NewAmount(*cmd.FeePerKb)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		if opts.FeeRate <= 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "fee rate must be positive")
+		}
+	}
+	var addr vhcutil.Address
+	if cmd.Address != nil && *cmd.Address != "" {
+		addr, err = decodeAddress(*cmd.Address, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txHash, err := w.ConsolidateAccount(account, addr, opts)
+	if err != nil {
+		return nil, err
+	}
+	return txHash.String(), nil
+}
+
 // createMultiSig handles an createmultisig request by returning a
 // multisig address for the given inputs.
-func createMultiSig(s *Server, icmd interface{}) (interface{}, error) {
+func createMultiSig(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.CreateMultisigCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -481,12 +944,64 @@ func createMultiSig(s *Server, icmd interface{}) (interface{}, error) {
 	}, nil
 }
 
+// createWallet handles a createwallet request by creating and opening a new
+// wallet when no wallet is loaded.  A seed is generated and returned when none
+// is provided, and the birthday of generated seeds is the current time.
+func createWallet(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateWalletCmd)
+
+	if cmd.Passphrase == "" {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "passphrase may not be empty")
+	}
+	var birthday time.Time
+	if cmd.Birthday != nil {
+		if *cmd.Birthday <= 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "birthday must be a positive Unix time")
+		}
+		birthday = time.Unix(*cmd.Birthday, 0)
+	}
+
+	var seed []byte
+	var res types.CreateWalletResult
+	if cmd.Seed != nil && *cmd.Seed != "" {
+		var err error
+		seed, err = walletseed.DecodeUserInput(*cmd.Seed)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+	} else {
+		var err error
+		seed, err = walletseed.GenerateRandomSeed(hdkeychain.RecommendedSeedLen)
+		if err != nil {
+			return nil, err
+		}
+		res.Seed = hex.EncodeToString(seed)
+		res.Mnemonic = walletseed.EncodeMnemonic(seed)
+		if birthday.IsZero() {
+			birthday = time.Now()
+		}
+	}
+	defer zero.Bytes(seed)
+
+	passphrase := []byte(cmd.Passphrase)
+	defer zero.Bytes(passphrase)
+	_, err := s.walletLoader(ctx).CreateNewWallet([]byte(wallet.InsecurePubPassphrase),
+		passphrase, seed, birthday)
+	if err != nil {
+		if errors.Is(errors.Exist, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidRequest.Code, err)
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
 // dumpPrivKey handles a dumpprivkey request with the private key
 // for a single address, or an appropiate error if the wallet
 // is locked.
-func dumpPrivKey(s *Server, icmd interface{}) (interface{}, error) {
+func dumpPrivKey(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.DumpPrivKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -508,9 +1023,9 @@ func dumpPrivKey(s *Server, icmd interface{}) (interface{}, error) {
 
 // generateVote handles a generatevote request by constructing a signed
 // vote and returning it.
-func generateVote(s *Server, icmd interface{}) (interface{}, error) {
+func generateVote(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GenerateVoteCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -553,12 +1068,70 @@ func generateVote(s *Server, icmd interface{}) (interface{}, error) {
 	return resp, nil
 }
 
+// generateVotes handles a generatevotes request by creating votes for several
+// tickets on the same block.
+func generateVotes(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GenerateVotesCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(cmd.BlockHash)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+
+	ticketHashes := make([]*chainhash.Hash, 0, len(cmd.TicketHashes))
+	for _, h := range cmd.TicketHashes {
+		ticketHash, err := chainhash.NewHashFromStr(h)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+		}
+		ticketHashes = append(ticketHashes, ticketHash)
+	}
+
+	voteBitsExt, err := hex.DecodeString(cmd.VoteBitsExt)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+	voteBits := stake.VoteBits{
+		Bits:         cmd.VoteBits,
+		ExtendedBits: voteBitsExt,
+	}
+
+	votes, err := w.GenerateVoteTxs(blockHash, int32(cmd.Height), ticketHashes,
+		voteBits)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]types.GenerateVotesResult, 0, len(votes))
+	for _, v := range votes {
+		r := types.GenerateVotesResult{TicketHash: v.Ticket.String()}
+		if v.Err != nil {
+			r.Error = v.Err.Error()
+			res = append(res, r)
+			continue
+		}
+		var b strings.Builder
+		b.Grow(2 * v.Vote.SerializeSize())
+		err = v.Vote.Serialize(hex.NewEncoder(&b))
+		if err != nil {
+			return nil, err
+		}
+		r.Hex = b.String()
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 // getAddressesByAccount handles a getaddressesbyaccount request by returning
 // all addresses for an account, or an error if the requested account does
 // not exist.
-func getAddressesByAccount(s *Server, icmd interface{}) (interface{}, error) {
+func getAddressesByAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetAddressesByAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -605,9 +1178,9 @@ func getAddressesByAccount(s *Server, icmd interface{}) (interface{}, error) {
 // getBalance handles a getbalance request by returning the balance for an
 // account (wallet), or an error if the requested account does not
 // exist.
-func getBalance(s *Server, icmd interface{}) (interface{}, error) {
+func getBalance(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetBalanceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -724,45 +1297,206 @@ func getBalance(s *Server, icmd interface{}) (interface{}, error) {
 	return result, nil
 }
 
-// getBestBlock handles a getbestblock request by returning a JSON object
-// with the height and hash of the most recently processed block.
-func getBestBlock(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
-
-	hash, height := w.MainChainTip()
-	result := &vhcjson.GetBestBlockResult{
-		Hash:   hash.String(),
-		Height: int64(height),
-	}
-	return result, nil
+// openRPCVersion is the version of the OpenRPC specification that the document
+// returned by getapischema conforms to.
+const openRPCVersion = "1.2.6"
+
+// getAPISchema handles a getapischema request by returning an OpenRPC document
+// describing every method of the server.  The method objects are generated
+// with the help texts by internal/rpchelp.
+func getAPISchema(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	return &types.GetAPISchemaResult{
+		OpenRPC: openRPCVersion,
+		Info: types.APISchemaInfo{
+			Title:   "vhcwallet JSON-RPC API",
+			Version: jsonrpcSemverString,
+		},
+		Methods: json.RawMessage(apiSchemaMethods),
+	}, nil
 }
 
-// getBestBlockHash handles a getbestblockhash request by returning the hash
-// of the most recently processed block.
-func getBestBlockHash(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// getBalanceAtHash handles a getbalanceathash request by returning the total
+// balance of each account as of a main chain block.
+func getBalanceAtHash(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetBalanceAtHashCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	hash, _ := w.MainChainTip()
-	return hash.String(), nil
-}
+	blockHash, err := chainhash.NewHashFromStr(cmd.BlockHash)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
 
-// getBlockCount handles a getblockcount request by returning the chain height
-// of the most recently processed block.
-func getBlockCount(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	accountName := "*"
+	if cmd.Account != nil {
+		accountName = *cmd.Account
+	}
+	var account uint32
+	if accountName != "*" {
+		account, err = w.AccountNumber(accountName)
+		if err != nil {
+			if errors.Is(errors.NotExist, err) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
 	}
 
-	_, height := w.MainChainTip()
-	return height, nil
-}
+	height, balances, err := w.AccountBalancesAtBlock(blockHash)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcError(vhcjson.ErrRPCBlockNotFound, err)
+		}
+		return nil, err
+	}
+
+	accounts := make([]uint32, 0, len(balances))
+	if accountName == "*" {
+		for acct := range balances {
+			accounts = append(accounts, acct)
+		}
+		// Order by account number, which keeps the imported account last.
+		sort.Slice(accounts, func(i, j int) bool { return accounts[i] < accounts[j] })
+	} else {
+		accounts = append(accounts, account)
+	}
+
+	result := &types.GetBalanceAtHashResult{
+		BlockHash: blockHash.String(),
+		Height:    height,
+		Balances:  make([]types.GetAccountBalanceAtHashResult, 0, len(accounts)),
+	}
+	var total vhcutil.Amount
+	for _, acct := range accounts {
+		name, err := w.AccountName(acct)
+		if err != nil {
+			// Expect account lookup to succeed
+			if errors.Is(errors.NotExist, err) {
+				return nil, rpcError(vhcjson.ErrRPCInternal.Code, err)
+			}
+			return nil, err
+		}
+		total += balances[acct]
+		result.Balances = append(result.Balances, types.GetAccountBalanceAtHashResult{
+			AccountName: name,
+			Total:       balances[acct].ToCoin(),
+		})
+	}
+	result.Total = total.ToCoin()
+
+	return result, nil
+}
+
+// getBuildInfo handles a getbuildinfo request by returning the version,
+// source revision, and build environment of the running wallet, along with
+// the feature flags enabled for this server.
+func getBuildInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	tags := ver.Tags()
+	if tags == nil {
+		tags = []string{}
+	}
+	enabled := []string{}
+	for _, st := range s.features.Statuses() {
+		if st.Enabled {
+			enabled = append(enabled, string(st.Flag))
+		}
+	}
+	return &types.GetBuildInfoResult{
+		Version:         ver.String(),
+		Commit:          ver.Commit,
+		BuildTags:       tags,
+		GoVersion:       runtime.Version(),
+		Platform:        runtime.GOOS + "/" + runtime.GOARCH,
+		EnabledFeatures: enabled,
+	}, nil
+}
+
+// getDBStats handles a getdbstats request by returning the storage statistics
+// of the wallet database and each of its buckets.
+func getDBStats(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	stats, err := w.BucketStats()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.GetDBStatsResult{
+		Database: databaseInfo(ctx, w),
+		Buckets:  make([]types.BucketStatsResult, 0, len(stats)),
+	}
+	for i := range stats {
+		b := &stats[i]
+		res.Buckets = append(res.Buckets, types.BucketStatsResult{
+			Name:       b.Name,
+			Keys:       b.KeyN,
+			Buckets:    b.BucketN,
+			Depth:      b.Depth,
+			InuseBytes: b.InuseBytes,
+			AllocBytes: b.AllocBytes,
+		})
+	}
+	return res, nil
+}
+
+// getFeatureFlags handles a getfeatureflags request by returning every
+// feature flag and whether it is enabled for this server.
+func getFeatureFlags(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	statuses := s.features.Statuses()
+	res := make([]types.GetFeatureFlagsResult, 0, len(statuses))
+	for _, st := range statuses {
+		res = append(res, types.GetFeatureFlagsResult{
+			Name:        string(st.Flag),
+			Description: st.Description,
+			Enabled:     st.Enabled,
+		})
+	}
+	return res, nil
+}
+
+// getBestBlock handles a getbestblock request by returning a JSON object
+// with the height and hash of the most recently processed block.
+func getBestBlock(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, height := w.MainChainTip()
+	result := &vhcjson.GetBestBlockResult{
+		Hash:   hash.String(),
+		Height: int64(height),
+	}
+	return result, nil
+}
+
+// getBestBlockHash handles a getbestblockhash request by returning the hash
+// of the most recently processed block.
+func getBestBlockHash(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, _ := w.MainChainTip()
+	return hash.String(), nil
+}
+
+// getBlockCount handles a getblockcount request by returning the chain height
+// of the most recently processed block.
+func getBlockCount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	_, height := w.MainChainTip()
+	return height, nil
+}
 
 // difficultyRatio returns the proof-of-work difficulty as a multiple of the
 // minimum difficulty using the passed bits field from the header of a block.
@@ -775,8 +1509,8 @@ func difficultyRatio(bits uint32, params *chaincfg.Params) float64 {
 
 // getInfo handles a getinfo request by returning a structure containing
 // information about the current state of the wallet.
-func getInfo(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func getInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -796,7 +1530,7 @@ func getInfo(s *Server, icmd interface{}) (interface{}, error) {
 		spendableBalance += balance.Spendable
 	}
 
-	info := &vhcjson.InfoWalletResult{
+	info := &types.InfoWalletResult{
 		Version:         ver.Integer,
 		ProtocolVersion: int32(p2p.Pver),
 		WalletVersion:   ver.Integer,
@@ -813,9 +1547,10 @@ func getInfo(s *Server, icmd interface{}) (interface{}, error) {
 		PaytxFee:        w.RelayFee().ToCoin(),
 		RelayFee:        0,
 		Errors:          "",
+		Database:        databaseInfo(ctx, w),
 	}
 
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.walletLoader(ctx).NetworkBackend()
 	if chainClient, err := chain.RPCClientFromBackend(n); err == nil {
 		consensusInfo, err := chainClient.GetInfo()
 		if err != nil {
@@ -830,9 +1565,44 @@ func getInfo(s *Server, icmd interface{}) (interface{}, error) {
 		info.Errors = consensusInfo.Errors
 	}
 
+	// Warn of outdated votes and a triggered anomaly guard in addition to
+	// any errors of the consensus server.
+	voteVersion := w.VoteVersionStatus()
+	for _, warning := range []string{voteVersion.Warning(), s.guard.warning()} {
+		if warning == "" {
+			continue
+		}
+		if info.Errors != "" {
+			info.Errors += "; "
+		}
+		info.Errors += warning
+	}
+
 	return info, nil
 }
 
+// databaseInfo returns the storage statistics of the wallet database, or nil
+// if they can not be read.  The file path of the database is only reported to
+// clients with admin credentials.
+func databaseInfo(ctx context.Context, w *wallet.Wallet) *types.DatabaseInfo {
+	stats, err := w.DatabaseStats()
+	if err != nil {
+		log.Warnf("Cannot read wallet database statistics: %v", err)
+		return nil
+	}
+	info := &types.DatabaseInfo{
+		Size:        stats.Size,
+		WriteErrors: stats.WriteErrors,
+	}
+	if roleFromContext(ctx) == RoleAdmin {
+		info.Path = stats.Path
+	}
+	if stats.FreeSpaceKnown {
+		info.FreeSpace = &stats.FreeSpace
+	}
+	return info
+}
+
 func decodeAddress(s string, params *chaincfg.Params) (vhcutil.Address, error) {
 	// Secp256k1 pubkey as a string, handle differently.
 	if len(s) == 66 || len(s) == 130 {
@@ -863,9 +1633,9 @@ func decodeAddress(s string, params *chaincfg.Params) (vhcutil.Address, error) {
 
 // getAccount handles a getaccount request by returning the account name
 // associated with a single address.
-func getAccount(s *Server, icmd interface{}) (interface{}, error) {
+func getAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -897,9 +1667,9 @@ func getAccount(s *Server, icmd interface{}) (interface{}, error) {
 // If the most recently-requested address has been used, a new address (the
 // next chained address in the keypool) is used.  This can fail if the keypool
 // runs out (and will return vhcjson.ErrRPCWalletKeypoolRanOut if that happens).
-func getAccountAddress(s *Server, icmd interface{}) (interface{}, error) {
+func getAccountAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetAccountAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -923,11 +1693,85 @@ func getAccountAddress(s *Server, icmd interface{}) (interface{}, error) {
 	return addr.EncodeAddress(), nil
 }
 
+// getAccountStats handles a getaccountstats request by returning the default
+// address gap limit policy of an account and how many addresses of each branch
+// have been returned beyond the last used address.
+func getAccountStats(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAccountStatsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(*cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	usage, err := w.AccountGapUsage(account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetAccountStatsResult{
+		Account:           *cmd.Account,
+		AccountNumber:     account,
+		GapPolicy:         usage.Policy.String(),
+		GapLimit:          usage.GapLimit,
+		NextExternalIndex: usage.NextExternalIndex,
+		NextInternalIndex: usage.NextInternalIndex,
+		ExternalGap:       usage.ExternalGap,
+		InternalGap:       usage.InternalGap,
+		KeyStorage:        w.AccountKeyStorage(account).String(),
+	}, nil
+}
+
+// getAuditLog handles a getauditlog request by returning the most recent
+// records of the audit log after verifying the hash chain of the log.
+func getAuditLog(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAuditLogCmd)
+	if s.auditLog == nil {
+		return nil, rpcErrorf(vhcjson.ErrRPCMisc, "audit log is not enabled")
+	}
+	count := *cmd.Count
+	if count < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"count may not be negative")
+	}
+	records, err := s.auditLog.records()
+	if err != nil {
+		return nil, rpcErrorf(vhcjson.ErrRPCMisc,
+			"audit log failed verification: %v", err)
+	}
+	if count != 0 && count < len(records) {
+		records = records[len(records)-count:]
+	}
+	res := make([]types.GetAuditLogResult, 0, len(records))
+	for i := range records {
+		r := &records[i]
+		res = append(res, types.GetAuditLogResult{
+			Seq:      r.Seq,
+			Time:     r.Time,
+			Client:   r.Client,
+			Role:     r.Role,
+			Wallet:   r.Wallet,
+			Method:   r.Method,
+			Params:   r.Params,
+			Request:  r.Request,
+			Error:    r.Error,
+			PrevHash: r.PrevHash,
+			Hash:     r.Hash,
+		})
+	}
+	return res, nil
+}
+
 // getUnconfirmedBalance handles a getunconfirmedbalance extension request
 // by returning the current unconfirmed balance of an account.
-func getUnconfirmedBalance(s *Server, icmd interface{}) (interface{}, error) {
+func getUnconfirmedBalance(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetUnconfirmedBalanceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -955,11 +1799,164 @@ func getUnconfirmedBalance(s *Server, icmd interface{}) (interface{}, error) {
 	return (bals.Total - bals.Spendable).ToCoin(), nil
 }
 
+// importMulti handles an importmulti request by importing private keys, public
+// keys, extended public keys, and redeem scripts in a single database
+// transaction.  The outcome of each request is returned rather than failing the
+// entire command.  When rescanning is requested, a single rescan begins from
+// the block found using the earliest timestamp of the imported requests.
+func importMulti(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ImportMultiCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	n, ok := s.walletLoader(ctx).NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	params := w.ChainParams()
+	results := make([]types.ImportMultiResult, len(cmd.Requests))
+	reqs := make([]*wallet.ImportRequest, 0, len(cmd.Requests))
+	reqIndexes := make([]int, 0, len(cmd.Requests))
+	for i := range cmd.Requests {
+		req, err := importMultiRequest(&cmd.Requests[i], params)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if !rescan {
+			req.Timestamp = time.Time{}
+		}
+		req.User = usernameFromContext(ctx)
+		reqs = append(reqs, req)
+		reqIndexes = append(reqIndexes, i)
+	}
+
+	imported, rescanHeight, err := w.ImportMulti(reqs)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range imported {
+		result := &results[reqIndexes[i]]
+		result.Addresses = make([]string, len(r.Addresses))
+		for j, a := range r.Addresses {
+			result.Addresses[j] = a.String()
+		}
+		switch {
+		case r.Err == nil:
+			result.Success = true
+		case errors.Is(errors.Locked, r.Err):
+			result.Error = errWalletUnlockNeeded.Message
+		default:
+			result.Error = r.Err.Error()
+		}
+	}
+
+	if rescan && rescanHeight != -1 {
+		s.rescanAsync(w, n, rescanHeight)
+	}
+
+	return results, nil
+}
+
+// importMultiRequest decodes a single request of the importmulti command.
+func importMultiRequest(r *types.ImportMultiRequest, params *chaincfg.Params) (*wallet.ImportRequest, error) {
+	req := &wallet.ImportRequest{
+		WatchOnly: r.WatchOnly,
+		XpubRange: [2]uint32{0, wallet.DefaultGapLimit},
+	}
+	if r.Timestamp != nil {
+		req.Timestamp = time.Unix(*r.Timestamp, 0)
+	}
+	if r.Range != nil && r.Xpub == "" {
+		return nil, errors.New("range is only valid for extended public keys")
+	}
+
+	n := 0
+	for _, field := range []string{r.PrivKey, r.PubKey, r.Xpub, r.RedeemScript} {
+		if field != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return nil, errors.New("exactly one of privkey, pubkey, xpub, or redeemscript must be specified")
+	}
+
+	var err error
+	switch {
+	case r.PrivKey != "":
+		req.WIF, err = vhcutil.DecodeWIF(r.PrivKey)
+		if err != nil {
+			return nil, errors.Errorf("WIF decode failed: %v", err)
+		}
+		if !req.WIF.IsForNet(params) {
+			return nil, errors.Errorf("key is not intended for %s", params.Name)
+		}
+	case r.PubKey != "":
+		req.PubKey, err = hex.DecodeString(r.PubKey)
+		if err != nil {
+			return nil, errors.Errorf("invalid public key: %v", err)
+		}
+	case r.Xpub != "":
+		req.Xpub, err = hdkeychain.NewKeyFromString(r.Xpub)
+		if err != nil {
+			return nil, errors.Errorf("invalid extended public key: %v", err)
+		}
+		switch len(r.Range) {
+		case 0:
+		case 2:
+			if r.Range[0] > r.Range[1] {
+				return nil, errors.New("invalid range")
+			}
+			req.XpubRange = [2]uint32{r.Range[0], r.Range[1] + 1}
+		default:
+			return nil, errors.New("range must specify the first and last child indexes")
+		}
+	case r.RedeemScript != "":
+		req.RedeemScript, err = hex.DecodeString(r.RedeemScript)
+		if err != nil {
+			return nil, errors.Errorf("invalid redeem script: %v", err)
+		}
+	}
+	return req, nil
+}
+
+// importPKCS11Account handles an importpkcs11account request by creating an
+// account from the xpub of keys kept inside the PKCS#11 module.
+func importPKCS11Account(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ImportPKCS11AccountCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	if cmd.Account == "*" {
+		return nil, errReservedAccountName
+	}
+	xpub, err := hdkeychain.NewKeyFromString(cmd.XPub)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	_, err = w.ImportPKCS11Account(cmd.Account, xpub)
+	if err != nil {
+		if errors.Is(errors.Invalid, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
 // importPrivKey handles an importprivkey request by parsing
 // a WIF-encoded private key and adding it to an account.
-func importPrivKey(s *Server, icmd interface{}) (interface{}, error) {
+func importPrivKey(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ImportPrivKeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -972,7 +1969,7 @@ func importPrivKey(s *Server, icmd interface{}) (interface{}, error) {
 	if cmd.ScanFrom != nil {
 		scanFrom = int32(*cmd.ScanFrom)
 	}
-	n, ok := s.walletLoader.NetworkBackend()
+	n, ok := s.walletLoader(ctx).NetworkBackend()
 	if rescan && !ok {
 		return nil, errNoNetwork
 	}
@@ -1007,18 +2004,16 @@ func importPrivKey(s *Server, icmd interface{}) (interface{}, error) {
 	}
 
 	if rescan {
-		// TODO: This is not synchronized with process shutdown and
-		// will cause panics when the DB is closed mid-transaction.
-		go w.RescanFromHeight(context.Background(), n, scanFrom)
+		s.rescanAsync(w, n, scanFrom)
 	}
 
 	return nil, nil
 }
 
 // importScript imports a redeem script for a P2SH output.
-func importScript(s *Server, icmd interface{}) (interface{}, error) {
+func importScript(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ImportScriptCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1031,7 +2026,7 @@ func importScript(s *Server, icmd interface{}) (interface{}, error) {
 	if cmd.ScanFrom != nil {
 		scanFrom = int32(*cmd.ScanFrom)
 	}
-	n, ok := s.walletLoader.NetworkBackend()
+	n, ok := s.walletLoader(ctx).NetworkBackend()
 	if rescan && !ok {
 		return nil, errNoNetwork
 	}
@@ -1044,7 +2039,15 @@ func importScript(s *Server, icmd interface{}) (interface{}, error) {
 		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "empty script")
 	}
 
-	err = w.ImportScript(rs)
+	imp := &udb.ScriptImport{
+		Origin:     "importscript",
+		User:       usernameFromContext(ctx),
+		RescanFrom: -1,
+	}
+	if rescan {
+		imp.RescanFrom = scanFrom
+	}
+	err = w.ImportScript(rs, imp)
 	if err != nil {
 		switch {
 		case errors.Is(errors.Exist, err):
@@ -1058,27 +2061,93 @@ func importScript(s *Server, icmd interface{}) (interface{}, error) {
 	}
 
 	if rescan {
-		// TODO: This is not synchronized with process shutdown and
-		// will cause panics when the DB is closed mid-transaction.
-		go w.RescanFromHeight(context.Background(), n, scanFrom)
+		s.rescanAsync(w, n, scanFrom)
+	}
+
+	return nil, nil
+}
+
+// importVoteChoices handles an importvotechoices request by applying the agenda
+// choices of a document created by exportvotechoices.  Agendas of the
+// supported stake version which are not included in the document are set to
+// abstain.  Either every choice is applied or none are.
+func importVoteChoices(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ImportVoteChoicesCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var doc types.VoteChoicesDocument
+	err := json.Unmarshal([]byte(cmd.Document), &doc)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	version, agendas := wallet.CurrentAgendas(w.ChainParams())
+	if doc.Version != version {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"document is for stake version %d but the wallet supports version %d",
+			doc.Version, version)
+	}
+
+	docChoices := make(map[string]string, len(doc.Choices))
+	for _, c := range doc.Choices {
+		if _, ok := docChoices[c.AgendaID]; ok {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"duplicate choice for agenda %q", c.AgendaID)
+		}
+		docChoices[c.AgendaID] = c.ChoiceID
+	}
+	choices := make([]wallet.AgendaChoice, 0, len(agendas))
+	for i := range agendas {
+		agenda := &agendas[i].Vote
+		choiceID, ok := docChoices[agenda.Id]
+		if !ok {
+			choiceID = "abstain"
+			for j := range agenda.Choices {
+				if agenda.Choices[j].IsAbstain {
+					choiceID = agenda.Choices[j].Id
+					break
+				}
+			}
+		}
+		delete(docChoices, agenda.Id)
+		choices = append(choices, wallet.AgendaChoice{
+			AgendaID: agenda.Id,
+			ChoiceID: choiceID,
+		})
+	}
+	for agendaID := range docChoices {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"no agenda with ID %q", agendaID)
+	}
+	if len(choices) == 0 {
+		return nil, nil
 	}
 
+	_, err = w.SetAgendaChoices(choices...)
+	if err != nil {
+		if errors.Is(errors.Invalid, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
 	return nil, nil
 }
 
 // keypoolRefill handles the keypoolrefill command.  vhcwallet generates
 // deterministic addresses rather than using a keypool, so this method does
 // nothing.
-func keypoolRefill(s *Server, icmd interface{}) (interface{}, error) {
+func keypoolRefill(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	return nil, nil
 }
 
 // createNewAccount handles a createnewaccount request by creating and
 // returning a new account. If the last account has no transaction history
 // as per BIP 0044 a new account cannot be created so an error will be returned.
-func createNewAccount(s *Server, icmd interface{}) (interface{}, error) {
+func createNewAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.CreateNewAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1101,9 +2170,9 @@ func createNewAccount(s *Server, icmd interface{}) (interface{}, error) {
 
 // renameAccount handles a renameaccount request by renaming an account.
 // If the account does not exist an appropiate error will be returned.
-func renameAccount(s *Server, icmd interface{}) (interface{}, error) {
+func renameAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.RenameAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1128,9 +2197,9 @@ func renameAccount(s *Server, icmd interface{}) (interface{}, error) {
 
 // getMultisigOutInfo displays information about a given multisignature
 // output.
-func getMultisigOutInfo(s *Server, icmd interface{}) (interface{}, error) {
+func getMultisigOutInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetMultisigOutInfoCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1185,44 +2254,183 @@ func getMultisigOutInfo(s *Server, icmd interface{}) (interface{}, error) {
 	return result, nil
 }
 
-// getNewAddress handles a getnewaddress request by returning a new
-// address for an account.  If the account does not exist an appropiate
-// error is returned.
-func getNewAddress(s *Server, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*vhcjson.GetNewAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// createMultisigAccount handles a createmultisigaccount request by creating an
+// HD multisig account from the extended public key of a wallet account and the
+// extended public keys of the other cosigners.
+func createMultisigAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateMultisigAccountCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	var callOpts []wallet.NextAddressCallOption
-	if cmd.GapPolicy != nil {
-		switch *cmd.GapPolicy {
-		case "":
-		case "error":
-			callOpts = append(callOpts, wallet.WithGapPolicyError())
-		case "ignore":
-			callOpts = append(callOpts, wallet.WithGapPolicyIgnore())
-		case "wrap":
-			callOpts = append(callOpts, wallet.WithGapPolicyWrap())
-		default:
-			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "unknown gap policy %q", *cmd.GapPolicy)
-		}
-	}
-
-	acctName := "default"
-	if cmd.Account != nil {
-		acctName = *cmd.Account
-	}
-	account, err := w.AccountNumber(acctName)
+	account, err := w.AccountNumber(cmd.Account)
 	if err != nil {
 		if errors.Is(errors.NotExist, err) {
 			return nil, errAccountNotFound
 		}
 		return nil, err
 	}
+	_, err = w.CreateMultisigAccount(cmd.Name, account, cmd.NRequired, cmd.Xpubs)
+	return nil, err
+}
 
-	addr, err := w.NewExternalAddress(account, callOpts...)
+// getMultisigAccountAddress handles a getmultisigaccountaddress request by
+// returning the P2SH address of the next child of a multisig account branch.
+func getMultisigAccountAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetMultisigAccountAddressCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	branch := udb.ExternalBranch
+	if cmd.Internal != nil && *cmd.Internal {
+		branch = udb.InternalBranch
+	}
+	ma, err := w.NewMultisigAccountAddress(cmd.Name, branch)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetMultisigAccountAddressResult{
+		Address:      ma.Address.EncodeAddress(),
+		RedeemScript: hex.EncodeToString(ma.RedeemScript),
+		Branch:       ma.Branch,
+		Index:        ma.Index,
+	}, nil
+}
+
+// listMultisigAccounts handles a listmultisigaccounts request by returning
+// every HD multisig account of the wallet.
+func listMultisigAccounts(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	accts, err := w.MultisigAccounts()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]types.MultisigAccountResult, 0, len(accts))
+	for _, a := range accts {
+		name, err := w.AccountName(a.Account)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, types.MultisigAccountResult{
+			Name:         a.Name,
+			Account:      name,
+			M:            a.M,
+			N:            len(a.Xpubs),
+			Xpubs:        a.Xpubs,
+			NextExternal: a.NextExternal,
+			NextInternal: a.NextInternal,
+		})
+	}
+	return results, nil
+}
+
+// listMultisigUnspent handles a listmultisigunspent request by returning every
+// unspent P2SH multisig output recorded by the wallet, optionally limited to
+// outputs paying a single P2SH address.
+func listMultisigUnspent(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListMultisigUnspentCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var p2shAddr *vhcutil.AddressScriptHash
+	if cmd.Address != nil && *cmd.Address != "" {
+		addr, err := decodeAddress(*cmd.Address, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		p2shAddr, ok = addr.(*vhcutil.AddressScriptHash)
+		if !ok {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidAddressOrKey,
+				"address is not P2SH")
+		}
+	}
+
+	outputs, err := w.UnspentP2SHMultiSigOutputs(p2shAddr)
+	if err != nil {
+		return nil, err
+	}
+	_, tipHeight := w.MainChainTip()
+	results := make([]types.ListMultisigUnspentResult, 0, len(outputs))
+	for _, out := range outputs {
+		_, pubkeyAddrs, _, err := txscript.ExtractPkScriptAddrs(
+			txscript.DefaultScriptVersion, out.RedeemScript,
+			w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		pubkeys := make([]string, 0, len(pubkeyAddrs))
+		for _, pka := range pubkeyAddrs {
+			pubkeys = append(pubkeys, hex.EncodeToString(pka.ScriptAddress()))
+		}
+		result := types.ListMultisigUnspentResult{
+			TxHash:       out.OutPoint.Hash.String(),
+			Vout:         out.OutPoint.Index,
+			Tree:         out.OutPoint.Tree,
+			Address:      out.P2SHAddress.EncodeAddress(),
+			RedeemScript: hex.EncodeToString(out.RedeemScript),
+			M:            out.M,
+			N:            out.N,
+			Pubkeys:      pubkeys,
+			Amount:       out.OutputAmount.ToCoin(),
+		}
+		if !out.ContainingBlock.None() {
+			result.BlockHash = out.ContainingBlock.Hash.String()
+			result.BlockHeight = out.ContainingBlock.Height
+			result.Confirmations = confirms(out.ContainingBlock.Height, tipHeight)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// getNewAddress handles a getnewaddress request by returning a new
+// address for an account.  If the account does not exist an appropiate
+// error is returned.
+func getNewAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.GetNewAddressCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// The account's default gap policy is used when no policy is specified.
+	var callOpts []wallet.NextAddressCallOption
+	if cmd.GapPolicy != nil {
+		switch *cmd.GapPolicy {
+		case "":
+		case "error":
+			callOpts = append(callOpts, wallet.WithGapPolicyError())
+		case "ignore":
+			callOpts = append(callOpts, wallet.WithGapPolicyIgnore())
+		case "wrap":
+			callOpts = append(callOpts, wallet.WithGapPolicyWrap())
+		default:
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "unknown gap policy %q", *cmd.GapPolicy)
+		}
+	}
+
+	acctName := "default"
+	if cmd.Account != nil {
+		acctName = *cmd.Account
+	}
+	account, err := w.AccountNumber(acctName)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	addr, err := w.NewExternalAddress(account, callOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1234,9 +2442,9 @@ func getNewAddress(s *Server, icmd interface{}) (interface{}, error) {
 //
 // Note: bitcoind allows specifying the account as an optional parameter,
 // but ignores the parameter.
-func getRawChangeAddress(s *Server, icmd interface{}) (interface{}, error) {
+func getRawChangeAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetRawChangeAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1264,9 +2472,9 @@ func getRawChangeAddress(s *Server, icmd interface{}) (interface{}, error) {
 
 // getReceivedByAccount handles a getreceivedbyaccount request by returning
 // the total amount received by addresses of an account.
-func getReceivedByAccount(s *Server, icmd interface{}) (interface{}, error) {
+func getReceivedByAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetReceivedByAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1295,9 +2503,9 @@ func getReceivedByAccount(s *Server, icmd interface{}) (interface{}, error) {
 
 // getReceivedByAddress handles a getreceivedbyaddress request by returning
 // the total amount received by a single address.
-func getReceivedByAddress(s *Server, icmd interface{}) (interface{}, error) {
+func getReceivedByAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetReceivedByAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1317,11 +2525,65 @@ func getReceivedByAddress(s *Server, icmd interface{}) (interface{}, error) {
 	return total.ToCoin(), nil
 }
 
+// getAccountReceived handles a getaccountreceived request by returning the
+// amounts received by addresses of an account, counting unmined credits and
+// credits with fewer than minconf confirmations separately as pending.
+func getAccountReceived(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAccountReceivedCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	totals, err := w.ReceivedByAccount(account, int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+	return marshalReceivedTotals(totals), nil
+}
+
+// getAddressReceived handles a getaddressreceived request by returning the
+// amounts received by a single address, counting unmined credits and credits
+// with fewer than minconf confirmations separately as pending.
+func getAddressReceived(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAddressReceivedCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	totals, err := w.ReceivedByAddress(addr, int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+	return marshalReceivedTotals(totals), nil
+}
+
+// marshalReceivedTotals converts received totals to their JSON-RPC result.
+func marshalReceivedTotals(totals *wallet.ReceivedTotals) *types.ReceivedResult {
+	return &types.ReceivedResult{
+		Confirmed: totals.Confirmed.ToCoin(),
+		Pending:   totals.Pending.ToCoin(),
+		Total:     (totals.Confirmed + totals.Pending).ToCoin(),
+	}
+}
+
 // getMasterPubkey handles a getmasterpubkey request by returning the wallet
 // master pubkey encoded as a string.
-func getMasterPubkey(s *Server, icmd interface{}) (interface{}, error) {
+func getMasterPubkey(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetMasterPubkeyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1347,16 +2609,55 @@ func getMasterPubkey(s *Server, icmd interface{}) (interface{}, error) {
 	return masterPubKey.String(), nil
 }
 
+// getSpendingPolicy handles a getspendingpolicy request by returning the
+// spending limits of an account and the amount sent during the current day.
+func getSpendingPolicy(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetSpendingPolicyCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	policy, err := w.SpendingPolicy(account)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var dailySpent vhcutil.Amount
+	if policy.Day == udb.UTCDay(now) {
+		dailySpent = policy.DailySpent
+	}
+	remaining, _ := policy.Remaining(now)
+	return &types.GetSpendingPolicyResult{
+		Account:        cmd.Account,
+		TxLimit:        policy.TxLimit.ToCoin(),
+		DailyLimit:     policy.DailyLimit.ToCoin(),
+		DailySpent:     dailySpent.ToCoin(),
+		DailyRemaining: remaining.ToCoin(),
+		Overridable:    policy.HasOverride(),
+		Overridden:     w.SpendingPolicyOverridden(account),
+	}, nil
+}
+
 // getStakeInfo gets a large amounts of information about the stake environment
 // and a number of statistics about local staking in the wallet.
-func getStakeInfo(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func getStakeInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
 	var chainClient *rpcclient.Client
-	if n, ok := s.walletLoader.NetworkBackend(); ok {
+	if n, ok := s.walletLoader(ctx).NetworkBackend(); ok {
 		client, err := chain.RPCClientFromBackend(n)
 		if err == nil {
 			chainClient = client
@@ -1406,8 +2707,8 @@ func getStakeInfo(s *Server, icmd interface{}) (interface{}, error) {
 }
 
 // getTicketFee gets the currently set price per kb for tickets
-func getTicketFee(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func getTicketFee(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1415,16 +2716,160 @@ func getTicketFee(s *Server, icmd interface{}) (interface{}, error) {
 	return w.TicketFeeIncrement().ToCoin(), nil
 }
 
+// getStakingStats handles a getstakingstats request by returning the staking
+// activity of the wallet over a time range.
+func getStakingStats(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetStakingStatsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var start, end time.Time
+	if cmd.StartTime != nil {
+		start = time.Unix(*cmd.StartTime, 0)
+	}
+	if cmd.EndTime != nil {
+		end = time.Unix(*cmd.EndTime, 0)
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"start time must precede end time")
+	}
+
+	stats, err := w.StakingStats(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetStakingStatsResult{
+		StartTime:        stats.Start.Unix(),
+		EndTime:          stats.End.Unix(),
+		TicketsBought:    stats.TicketsBought,
+		TicketCost:       stats.TicketCost.ToCoin(),
+		Votes:            stats.Votes,
+		VoteRewards:      stats.VoteRewards.ToCoin(),
+		Revocations:      stats.Revocations,
+		Fees:             stats.Fees.ToCoin(),
+		AnnualizedReturn: stats.AnnualizedReturn(),
+	}, nil
+}
+
+// getTicketInfo handles a getticketinfo request by returning the status of a
+// ticket and the records of the votes the wallet created for it.
+func getTicketInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetTicketInfoCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	ticketHash, err := chainhash.NewHashFromStr(cmd.TicketHash)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+
+	records, err := w.VoteRecords(ticketHash)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.GetTicketInfoResult{
+		TicketHash:  ticketHash.String(),
+		Status:      restTicketStatuses[wallet.TicketStatusUnknown],
+		BlockHeight: -1,
+		Votes:       voteRecordResults(records),
+	}
+
+	// Tickets which are only tracked by the stake manager are not recorded
+	// by the transaction store, but may have been voted by the wallet.
+	summary, header, err := w.GetTicketInfo(ticketHash)
+	switch {
+	case errors.Is(errors.NotExist, err) && len(records) != 0:
+		return res, nil
+	case errors.Is(errors.NotExist, err):
+		return nil, rpcErrorf(vhcjson.ErrRPCNoTxInfo, "no information for ticket %v", ticketHash)
+	case err != nil:
+		return nil, err
+	}
+	res.Status = restTicketStatuses[summary.Status]
+	if header != nil {
+		res.BlockHash = header.BlockHash().String()
+		res.BlockHeight = int32(header.Height)
+	}
+	if summary.Spender != nil {
+		res.SpenderHash = summary.Spender.Hash.String()
+	}
+	index, err := w.ColdVotingIndex(ticketHash)
+	switch {
+	case err == nil:
+		res.VotingXpubIndex = &index
+	case !errors.Is(errors.NotExist, err):
+		return nil, err
+	}
+	return res, nil
+}
+
+// getVSPInfo handles a getvspinfo request by returning the info of the voting
+// service provider selected by the wallet and the registration status of each
+// ticket purchased for it.
+func getVSPInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	v, err := vsp.New(w)
+	if errors.Is(errors.NotExist, err) {
+		return nil, rpcErrorf(vhcjson.ErrRPCMisc, "no VSP is selected")
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := v.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tickets, err := w.VSPTickets()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.GetVSPInfoResult{
+		Host:          v.Host(),
+		PubKey:        base64.StdEncoding.EncodeToString(info.PubKey),
+		Network:       info.Network,
+		APIVersions:   info.APIVersions,
+		FeePercentage: info.FeePercentage,
+		FeeXPub:       info.FeeXPub,
+		VSPClosed:     info.VSPClosed,
+		Tickets:       make([]types.VSPTicketResult, 0, len(tickets)),
+	}
+	for _, t := range tickets {
+		if t.Host != v.Host() {
+			continue
+		}
+		r := types.VSPTicketResult{
+			TicketHash: t.TicketHash.String(),
+			Status:     t.Status.String(),
+			FeeAddress: t.FeeAddress,
+			FeeAmount:  vhcutil.Amount(t.FeeAmount).ToCoin(),
+		}
+		if t.Status != udb.VSPFeeUnpaid {
+			r.FeeTxHash = t.FeeTxHash.String()
+		}
+		res.Tickets = append(res.Tickets, r)
+	}
+	return res, nil
+}
+
 // getTickets handles a gettickets request by returning the hashes of the tickets
 // currently owned by wallet, encoded as strings.
-func getTickets(s *Server, icmd interface{}) (interface{}, error) {
+func getTickets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetTicketsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.walletLoader(ctx).NetworkBackend()
 	chainClient, err := chain.RPCClientFromBackend(n)
 	if err != nil {
 		return nil, errRPCClientNotConnected
@@ -1446,9 +2891,9 @@ func getTickets(s *Server, icmd interface{}) (interface{}, error) {
 
 // getTransaction handles a gettransaction request by returning details about
 // a single transaction saved by wallet.
-func getTransaction(s *Server, icmd interface{}) (interface{}, error) {
+func getTransaction(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.GetTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1539,8 +2984,8 @@ func getTransaction(s *Server, icmd interface{}) (interface{}, error) {
 
 // getVoteChoices handles a getvotechoices request by returning configured vote
 // preferences for each agenda of the latest supported stake version.
-func getVoteChoices(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func getVoteChoices(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1574,9 +3019,36 @@ func getVoteChoices(s *Server, icmd interface{}) (interface{}, error) {
 	return resp, nil
 }
 
+// exportVoteChoices handles an exportvotechoices request by returning the
+// choices of every agenda of the supported stake version as a document which
+// may be imported by other wallets with importvotechoices.
+func exportVoteChoices(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	version, _ := wallet.CurrentAgendas(w.ChainParams())
+	choices, _, err := w.AgendaChoices()
+	if err != nil {
+		return nil, err
+	}
+	doc := &types.VoteChoicesDocument{
+		Version: version,
+		Choices: make([]types.AgendaChoice, len(choices)),
+	}
+	for i := range choices {
+		doc.Choices[i] = types.AgendaChoice{
+			AgendaID: choices[i].AgendaID,
+			ChoiceID: choices[i].ChoiceID,
+		}
+	}
+	return doc, nil
+}
+
 // getWalletFee returns the currently set tx fee for the requested wallet
-func getWalletFee(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func getWalletFee(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1609,13 +3081,13 @@ var helpDescsMu sync.Mutex // Help may execute concurrently, so synchronize acce
 // methods, or full help for a specific method.  The chainClient is optional,
 // and this is simply a helper function for the HelpNoChainRPC and
 // HelpWithChainRPC handlers.
-func help(s *Server, icmd interface{}) (interface{}, error) {
+func help(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.HelpCmd)
 	// TODO: The "help" RPC should use a HTTP POST client when calling down to
 	// vhcd for additional help methods.  This avoids including websocket-only
 	// requests in the help, which are not callable by wallet JSON-RPC clients.
 	var chainClient *rpcclient.Client
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.walletLoader(ctx).NetworkBackend()
 	if c, err := chain.RPCClientFromBackend(n); err == nil {
 		chainClient = c
 	}
@@ -1647,8 +3119,17 @@ func help(s *Server, icmd interface{}) (interface{}, error) {
 		helpDescs = localeHelpDescs["en_US"]()
 	}
 
-	helpText, ok := helpDescs[*cmd.Command]
+	// Aliases are described by the help of the method they alias, and the
+	// help of deprecated methods begins with the deprecation notice.
+	method := *cmd.Command
+	if target, ok := aliases[method]; ok {
+		method = target
+	}
+	helpText, ok := helpDescs[method]
 	if ok {
+		if d, ok := deprecations[*cmd.Command]; ok {
+			helpText = "DEPRECATED: " + d.message(*cmd.Command) + "\n\n" + helpText
+		}
 		return helpText, nil
 	}
 
@@ -1672,9 +3153,9 @@ func help(s *Server, icmd interface{}) (interface{}, error) {
 
 // listAccounts handles a listaccounts request by returning a map of account
 // names to their balances.
-func listAccounts(s *Server, icmd interface{}) (interface{}, error) {
+func listAccounts(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListAccountsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1701,8 +3182,8 @@ func listAccounts(s *Server, icmd interface{}) (interface{}, error) {
 
 // listLockUnspent handles a listlockunspent request by returning an slice of
 // all locked outpoints.
-func listLockUnspent(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func listLockUnspent(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1710,6 +3191,54 @@ func listLockUnspent(s *Server, icmd interface{}) (interface{}, error) {
 	return w.LockedOutpoints(), nil
 }
 
+// listWallets handles a listwallets request by returning the default wallet and
+// every named wallet which exists or is loaded.
+func listWallets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	infos, err := s.loader.Wallets()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ListWalletsResult, len(infos))
+	for i := range infos {
+		res[i] = types.ListWalletsResult{
+			Name:   infos[i].Name,
+			Loaded: infos[i].Loaded,
+		}
+	}
+	return res, nil
+}
+
+// listOutpointLocks handles a listoutpointlocks request by returning the locked
+// outpoints of every namespace, or of a single namespace when one is
+// specified.
+func listOutpointLocks(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListOutpointLocksCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	res := []types.OutpointLockResult{}
+	for _, l := range w.OutpointLocks() {
+		if cmd.Namespace != nil && l.Namespace != *cmd.Namespace {
+			continue
+		}
+		r := types.OutpointLockResult{
+			Txid:         l.OutPoint.Hash.String(),
+			Vout:         l.OutPoint.Index,
+			Tree:         l.OutPoint.Tree,
+			Namespace:    l.Namespace,
+			ExpiryHeight: l.ExpiryHeight,
+			Persistent:   l.Persistent,
+		}
+		if !l.Expiry.IsZero() {
+			r.Expires = l.Expiry.Unix()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 // listReceivedByAccount handles a listreceivedbyaccount request by returning
 // a slice of objects, each one containing:
 //  "account": the receiving account;
@@ -1720,9 +3249,9 @@ func listLockUnspent(s *Server, icmd interface{}) (interface{}, error) {
 //             default: one;
 //  "includeempty": whether or not to include addresses that have no transactions -
 //                  default: false.
-func listReceivedByAccount(s *Server, icmd interface{}) (interface{}, error) {
+func listReceivedByAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListReceivedByAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1754,9 +3283,9 @@ func listReceivedByAccount(s *Server, icmd interface{}) (interface{}, error) {
 //             default: one;
 //  "includeempty": whether or not to include addresses that have no transactions -
 //                  default: false.
-func listReceivedByAddress(s *Server, icmd interface{}) (interface{}, error) {
+func listReceivedByAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListReceivedByAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1771,8 +3300,6 @@ func listReceivedByAddress(s *Server, icmd interface{}) (interface{}, error) {
 		tx []string
 	}
 
-	_, tipHeight := w.MainChainTip()
-
 	// Intermediate data for all addresses.
 	allAddrData := make(map[string]AddrData)
 	// Create an AddrData entry for each active address in the account.
@@ -1786,48 +3313,22 @@ func listReceivedByAddress(s *Server, icmd interface{}) (interface{}, error) {
 		allAddrData[address] = AddrData{}
 	}
 
-	minConf := *cmd.MinConf
-	var endHeight int32
-	if minConf == 0 {
-		endHeight = -1
-	} else {
-		endHeight = tipHeight - int32(minConf) + 1
-	}
-	err = wallet.UnstableAPI(w).RangeTransactions(0, endHeight, func(details []udb.TxDetails) (bool, error) {
-		confirmations := confirms(details[0].Block.Height, tipHeight)
-		for _, tx := range details {
-			for _, cred := range tx.Credits {
-				pkVersion := tx.MsgTx.TxOut[cred.Index].Version
-				pkScript := tx.MsgTx.TxOut[cred.Index].PkScript
-				_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkVersion,
-					pkScript, w.ChainParams())
-				if err != nil {
-					// Non standard script, skip.
-					continue
-				}
-				for _, addr := range addrs {
-					addrStr := addr.EncodeAddress()
-					addrData, ok := allAddrData[addrStr]
-					if ok {
-						addrData.amount += cred.Amount
-						// Always overwrite confirmations with newer ones.
-						addrData.confirmations = confirmations
-					} else {
-						addrData = AddrData{
-							amount:        cred.Amount,
-							confirmations: confirmations,
-						}
-					}
-					addrData.tx = append(addrData.tx, tx.Hash.String())
-					allAddrData[addrStr] = addrData
-				}
-			}
-		}
-		return false, nil
-	})
+	received, err := w.ReceivedByAddresses(int32(*cmd.MinConf))
 	if err != nil {
 		return nil, err
 	}
+	for i := range received {
+		r := &received[i]
+		txIDs := make([]string, len(r.TxHashes))
+		for j := range r.TxHashes {
+			txIDs[j] = r.TxHashes[j].String()
+		}
+		allAddrData[r.Address] = AddrData{
+			amount:        r.Amount,
+			confirmations: r.Confirmations,
+			tx:            txIDs,
+		}
+	}
 
 	// Massage address data into output format.
 	numAddresses := len(allAddrData)
@@ -1847,9 +3348,9 @@ func listReceivedByAddress(s *Server, icmd interface{}) (interface{}, error) {
 
 // listSinceBlock handles a listsinceblock request by returning an array of maps
 // with details of sent and received wallet transactions since the given block.
-func listSinceBlock(s *Server, icmd interface{}) (interface{}, error) {
+func listSinceBlock(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListSinceBlockCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1889,37 +3390,48 @@ func listSinceBlock(s *Server, icmd interface{}) (interface{}, error) {
 
 // listScripts handles a listscripts request by returning an
 // array of script details for all scripts in the wallet.
-func listScripts(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func listScripts(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	redeemScripts, err := w.FetchAllRedeemScripts()
+	infos, err := w.RedeemScriptInfos()
 	if err != nil {
 		return nil, err
 	}
-	listScriptsResultSIs := make([]vhcjson.ScriptInfo, len(redeemScripts))
-	for i, redeemScript := range redeemScripts {
-		p2shAddr, err := vhcutil.NewAddressScriptHash(redeemScript,
+	listScriptsResultSIs := make([]types.ScriptInfo, len(infos))
+	for i, info := range infos {
+		p2shAddr, err := vhcutil.NewAddressScriptHash(info.Script,
 			w.ChainParams())
 		if err != nil {
 			return nil, err
 		}
-		listScriptsResultSIs[i] = vhcjson.ScriptInfo{
+		si := types.ScriptInfo{
 			Hash160:      hex.EncodeToString(p2shAddr.Hash160()[:]),
 			Address:      p2shAddr.EncodeAddress(),
-			RedeemScript: hex.EncodeToString(redeemScript),
+			RedeemScript: hex.EncodeToString(info.Script),
+			Unspent:      info.Unspent,
+		}
+		if imp := info.Import; imp != nil {
+			si.ImportTime = imp.Time.Unix()
+			si.Origin = imp.Origin
+			si.User = imp.User
+			if imp.RescanFrom >= 0 {
+				rescanFrom := imp.RescanFrom
+				si.RescanFrom = &rescanFrom
+			}
 		}
+		listScriptsResultSIs[i] = si
 	}
-	return &vhcjson.ListScriptsResult{Scripts: listScriptsResultSIs}, nil
+	return &types.ListScriptsResult{Scripts: listScriptsResultSIs}, nil
 }
 
 // listTransactions handles a listtransactions request by returning an
 // array of maps with details of sent and recevied wallet transactions.
-func listTransactions(s *Server, icmd interface{}) (interface{}, error) {
+func listTransactions(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListTransactionsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1945,9 +3457,9 @@ func listTransactions(s *Server, icmd interface{}) (interface{}, error) {
 // transactions.  The form of the reply is identical to listtransactions,
 // but the array elements are limited to transaction details which are
 // about the addresess included in the request.
-func listAddressTransactions(s *Server, icmd interface{}) (interface{}, error) {
+func listAddressTransactions(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListAddressTransactionsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1974,9 +3486,9 @@ func listAddressTransactions(s *Server, icmd interface{}) (interface{}, error) {
 // a map with details of sent and recevied wallet transactions.  This is
 // similar to ListTransactions, except it takes only a single optional
 // argument for the account name and replies with all transactions.
-func listAllTransactions(s *Server, icmd interface{}) (interface{}, error) {
+func listAllTransactions(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListAllTransactionsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -1990,40 +3502,148 @@ func listAllTransactions(s *Server, icmd interface{}) (interface{}, error) {
 }
 
 // listUnspent handles the listunspent command.
-func listUnspent(s *Server, icmd interface{}) (interface{}, error) {
+func listUnspent(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ListUnspentCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	var addresses map[string]struct{}
-	if cmd.Addresses != nil {
-		addresses = make(map[string]struct{})
-		// confirm that all of them are good:
-		for _, as := range *cmd.Addresses {
-			a, err := decodeAddress(as, w.ChainParams())
-			if err != nil {
-				return nil, err
-			}
-			addresses[a.EncodeAddress()] = struct{}{}
-		}
+	addresses, err := decodeAddressSet(cmd.Addresses, w.ChainParams())
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := w.ListUnspent(int32(*cmd.MinConf), int32(*cmd.MaxConf), addresses)
+	result, err := w.ListUnspent(int32(*cmd.MinConf), int32(*cmd.MaxConf), addresses, nil)
 	if err != nil {
 		if errors.Is(errors.NotExist, err) {
 			return nil, errAddressNotInWallet
 		}
 		return nil, err
 	}
-	return result, nil
+	return marshalListUnspent(result), nil
+}
+
+// listUnspentPage handles the listunspentpage command.  It filters the unspent
+// outputs of listunspent by account and returns a single page of the results,
+// so wallets with many outputs need not return every output in one response.
+// Pages are ordered by transaction hash and output index, and each page
+// begins after the "txid:vout" outpoint of the last output of the previous
+// page, so only the outputs of the page are read from the wallet.
+func listUnspentPage(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListUnspentPageCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addresses, err := decodeAddressSet(cmd.Addresses, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	opts := &wallet.ListUnspentOptions{
+		Limit: *cmd.Limit,
+	}
+	if opts.Limit < 1 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"limit must be positive")
+	}
+	if *cmd.After != "" {
+		opts.After, err = parseUnspentPageCursor(*cmd.After)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if *cmd.Account != "*" {
+		account, err := w.AccountNumber(*cmd.Account)
+		if err != nil {
+			if errors.Is(errors.NotExist, err) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		opts.Account = &account
+	}
+
+	result, err := w.ListUnspent(int32(*cmd.MinConf), int32(*cmd.MaxConf), addresses, opts)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAddressNotInWallet
+		}
+		return nil, err
+	}
+	return marshalListUnspent(result), nil
+}
+
+// parseUnspentPageCursor parses the "txid:vout" outpoint after which a page of
+// listunspentpage results begins.
+func parseUnspentPageCursor(s string) (*wire.OutPoint, error) {
+	invalid := rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+		"invalid after parameter %q", s)
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, invalid
+	}
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, invalid
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, invalid
+	}
+	return wire.NewOutPoint(hash, uint32(index), wire.TxTreeRegular), nil
+}
+
+// marshalListUnspent converts the unspent outputs returned by
+// wallet.ListUnspent to their JSON-RPC results.
+func marshalListUnspent(outputs []*wallet.ListUnspentResult) []types.ListUnspentResult {
+	results := make([]types.ListUnspentResult, len(outputs))
+	for i, o := range outputs {
+		results[i] = types.ListUnspentResult{
+			TxID:          o.TxID,
+			Vout:          o.Vout,
+			Tree:          o.Tree,
+			TxType:        o.TxType,
+			Address:       o.Address,
+			Account:       o.Account,
+			ScriptPubKey:  o.ScriptPubKey,
+			RedeemScript:  o.RedeemScript,
+			Amount:        o.Amount,
+			Confirmations: o.Confirmations,
+			Spendable:     o.Spendable,
+			StakeOutput:   o.StakeOutput.String(),
+			StakeLocked:   o.StakeLocked,
+		}
+		if o.StakeOutput == wallet.StakeOutputTicket {
+			results[i].TicketStatus = restTicketStatuses[o.TicketStatus]
+		}
+	}
+	return results
+}
+
+// decodeAddressSet decodes the optional addresses parameter of the listunspent
+// methods into the set of encoded addresses, or a nil set when the parameter is
+// unset.
+func decodeAddressSet(as *[]string, params *chaincfg.Params) (map[string]struct{}, error) {
+	if as == nil {
+		return nil, nil
+	}
+	addresses := make(map[string]struct{}, len(*as))
+	for _, s := range *as {
+		a, err := decodeAddress(s, params)
+		if err != nil {
+			return nil, err
+		}
+		addresses[a.EncodeAddress()] = struct{}{}
+	}
+	return addresses, nil
 }
 
 // lockUnspent handles the lockunspent command.
-func lockUnspent(s *Server, icmd interface{}) (interface{}, error) {
+func lockUnspent(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.LockUnspentCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2048,17 +3668,173 @@ func lockUnspent(s *Server, icmd interface{}) (interface{}, error) {
 	return true, nil
 }
 
-// purchaseTicket indicates to the wallet that a ticket should be purchased
-// using all currently available funds. If the ticket could not be purchased
-// because there are not enough eligible funds, an error will be returned.
-func purchaseTicket(s *Server, icmd interface{}) (interface{}, error) {
-	// Enforce valid and positive spend limit.
-	cmd := icmd.(*vhcjson.PurchaseTicketCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// lockUnspentNamespace handles the lockunspentnamespace command.  Outpoints
+// are locked and unlocked in the namespace of the request, and locking fails
+// for outpoints locked in other namespaces.  Persistent locks are recorded in
+// the database.  Locks are applied in order, and outpoints processed before a
+// failure remain locked or unlocked.
+func lockUnspentNamespace(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.LockUnspentNamespaceCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if *cmd.TTL < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "ttl must be non-negative")
+	}
+	ttl := time.Duration(*cmd.TTL) * time.Second
+	if *cmd.Blocks < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "blocks must be non-negative")
+	}
+
+	if cmd.Unlock && len(cmd.Transactions) == 0 {
+		w.ResetLockedOutpointsNamespace(cmd.Namespace)
+		return true, nil
+	}
+	for _, input := range cmd.Transactions {
+		txHash, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+		}
+		op := wire.OutPoint{Hash: *txHash, Index: input.Vout, Tree: input.Tree}
+		switch {
+		case cmd.Unlock:
+			err = w.UnlockOutpointNamespace(op, cmd.Namespace)
+		case *cmd.Blocks != 0:
+			err = w.LockOutpointBlocks(op, cmd.Namespace, ttl, *cmd.Blocks,
+				*cmd.Persistent)
+		case *cmd.Persistent:
+			err = w.LockOutpointPersistent(op, cmd.Namespace, ttl)
+		default:
+			err = w.LockOutpointNamespace(op, cmd.Namespace, ttl)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return true, nil
+}
+
+// moveFunds handles a movefunds request by creating and publishing a
+// transaction which transfers an amount from one account of the wallet to
+// another.  The transaction is listed under the transfer category rather than
+// as a send and receive.
+func moveFunds(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.MoveFundsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	fromAccount, err := w.AccountNumber(cmd.FromAccount)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	toAccount, err := w.AccountNumber(cmd.ToAccount)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	if cmd.Amount <= 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "amount must be positive")
+	}
+	amt, err := vhcutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	txHash, err := w.MoveFunds(fromAccount, toAccount, amt, minConf)
+	if err != nil {
+		switch {
+		case errors.Is(errors.Invalid, err):
+			return nil, sendErrorData(rpcError(vhcjson.ErrRPCInvalidParameter, err), err)
+		case errors.Is(errors.Locked, err):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(errors.InsufficientBalance, err):
+			return nil, sendErrorData(rpcError(vhcjson.ErrRPCWalletInsufficientFunds, err), err)
+		}
+		return nil, err
+	}
+	return txHash.String(), nil
+}
+
+// openWallet handles an openwallet request by opening the existing wallet of
+// the loader's database directory.  The insecure default public passphrase is
+// used when none is provided.
+func openWallet(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.OpenWalletCmd)
+
+	pubPassphrase := []byte(wallet.InsecurePubPassphrase)
+	if cmd.PublicPassphrase != nil && *cmd.PublicPassphrase != "" {
+		pubPassphrase = []byte(*cmd.PublicPassphrase)
+	}
+	w, err := s.walletLoader(ctx).OpenExistingWallet(pubPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	return &types.OpenWalletResult{WatchingOnly: w.Manager.WatchingOnly()}, nil
+}
+
+// overrideSpendingPolicy handles an overridespendingpolicy request by allowing
+// sends from an account to exceed its spending limits for a number of seconds.
+func overrideSpendingPolicy(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.OverrideSpendingPolicyCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
+	if cmd.Timeout <= 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "timeout must be positive")
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	timeout := time.Second * time.Duration(cmd.Timeout)
+	err = w.OverrideSpendingPolicy(account, []byte(cmd.Passphrase), timeout)
+	if err != nil {
+		if errors.Is(errors.Passphrase, err) {
+			return nil, rpcErrorf(vhcjson.ErrRPCWalletPassphraseIncorrect, "incorrect override passphrase")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// purchaseTicketParams are the validated parameters of a ticket purchase.
+type purchaseTicketParams struct {
+	spendLimit vhcutil.Amount
+	account    uint32
+	minConf    int32
+	ticketAddr vhcutil.Address
+	numTickets int
+	poolAddr   vhcutil.Address
+	poolFee    float64
+	expiry     int32
+	ticketFee  vhcutil.Amount
+}
+
+// parsePurchaseTicketParams validates the parameters of a purchaseticket
+// request.
+func parsePurchaseTicketParams(w *wallet.Wallet, cmd *vhcjson.PurchaseTicketCmd) (*purchaseTicketParams, error) {
+	// Enforce valid and positive spend limit.
 	spendLimit, err := vhcutil.NewAmount(cmd.SpendLimit)
 	if err != nil {
 		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
@@ -2143,277 +3919,988 @@ func purchaseTicket(s *Server, icmd interface{}) (interface{}, error) {
 		}
 	}
 
-	hashes, err := w.PurchaseTickets(0, spendLimit, minConf, ticketAddr,
-		account, numTickets, poolAddr, poolFee, expiry, w.RelayFee(),
-		ticketFee)
+	return &purchaseTicketParams{
+		spendLimit: spendLimit,
+		account:    account,
+		minConf:    minConf,
+		ticketAddr: ticketAddr,
+		numTickets: numTickets,
+		poolAddr:   poolAddr,
+		poolFee:    poolFee,
+		expiry:     expiry,
+		ticketFee:  ticketFee,
+	}, nil
+}
+
+// createUnsignedTickets handles a createunsignedtickets request by performing
+// the funding and split output construction of a ticket purchase, returning the
+// split transaction and tickets without signing or publishing them.
+func createUnsignedTickets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateUnsignedTicketsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	params, err := parsePurchaseTicketParams(w, &vhcjson.PurchaseTicketCmd{
+		FromAccount:   cmd.FromAccount,
+		SpendLimit:    cmd.SpendLimit,
+		MinConf:       cmd.MinConf,
+		TicketAddress: cmd.TicketAddress,
+		NumTickets:    cmd.NumTickets,
+		PoolAddress:   cmd.PoolAddress,
+		PoolFees:      cmd.PoolFees,
+		Expiry:        cmd.Expiry,
+		TicketFee:     cmd.TicketFee,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	hashStrs := make([]string, len(hashes))
-	for i := range hashes {
-		hashStrs[i] = hashes[i].String()
+	p, err := w.PurchaseTicketsUnsigned(params.spendLimit, params.minConf,
+		params.ticketAddr, params.account, params.numTickets, params.poolAddr,
+		params.poolFee, params.expiry, w.RelayFee(), params.ticketFee)
+	if err != nil {
+		return nil, err
 	}
 
-	return hashStrs, err
-}
-
-// makeOutputs creates a slice of transaction outputs from a pair of address
-// strings to amounts.  This is used to create the outputs to include in newly
-// created transactions from a JSON object describing the output destinations
-// and amounts.
-func makeOutputs(pairs map[string]vhcutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
-	outputs := make([]*wire.TxOut, 0, len(pairs))
-	for addrStr, amt := range pairs {
-		if amt < 0 {
-			return nil, errNeedPositiveAmount
-		}
-		addr, err := decodeAddress(addrStr, chainParams)
-		if err != nil {
-			return nil, err
-		}
-
-		pkScript, err := txscript.PayToAddrScript(addr)
+	splitTx, err := p.SplitTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.CreateUnsignedTicketsResult{
+		SplitTx:     hex.EncodeToString(splitTx),
+		Tickets:     make([]string, len(p.Tickets)),
+		TicketPrice: p.TicketPrice.ToCoin(),
+	}
+	for i, ticket := range p.Tickets {
+		b, err := ticket.Bytes()
 		if err != nil {
 			return nil, err
 		}
-
-		outputs = append(outputs, wire.NewTxOut(int64(amt), pkScript))
+		res.Tickets[i] = hex.EncodeToString(b)
 	}
-	return outputs, nil
+	return res, nil
 }
 
-// sendPairs creates and sends payment transactions.
-// It returns the transaction hash in string format upon success
-// All errors are returned in vhcjson.RPCError format
-func sendPairs(w *wallet.Wallet, amounts map[string]vhcutil.Amount, account uint32, minconf int32) (string, error) {
-	outputs, err := makeOutputs(amounts, w.ChainParams())
+// decodeCosignSession decodes a cosigning session passed between the cosigners
+// of a multisig transaction.
+func decodeCosignSession(session string) (*wallet.CosignSession, error) {
+	s := new(wallet.CosignSession)
+	err := json.Unmarshal([]byte(session), s)
 	if err != nil {
-		return "", err
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
 	}
-	txSha, err := w.SendOutputs(outputs, account, minconf)
+	return s, nil
+}
+
+func cosignSessionResult(s *wallet.CosignSession, added int) (*types.CosignSessionResult, error) {
+	session, err := json.Marshal(s)
 	if err != nil {
-		if errors.Is(errors.Locked, err) {
-			return "", errWalletUnlockNeeded
-		}
-		if errors.Is(errors.InsufficientBalance, err) {
-			return "", rpcError(vhcjson.ErrRPCWalletInsufficientFunds, err)
-		}
-		return "", err
+		return nil, err
 	}
-
-	return txSha.String(), nil
+	res := &types.CosignSessionResult{
+		Session:    string(session),
+		Signatures: s.Signatures(),
+		Required:   make([]int, len(s.Inputs)),
+		Complete:   s.Complete(),
+		Added:      added,
+	}
+	for i := range s.Inputs {
+		res.Required[i] = s.Inputs[i].Required
+	}
+	return res, nil
 }
 
-// redeemMultiSigOut receives a transaction hash/idx and fetches the first output
-// index or indices with known script hashes from the transaction. It then
-// construct a transaction with a single P2PKH paying to a specified address.
-// It signs any inputs that it can, then provides the raw transaction to
-// the user to export to others to sign.
-func redeemMultiSigOut(s *Server, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*vhcjson.RedeemMultiSigOutCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// createCosignSession handles a createcosignsession request by beginning a
+// cosigning session for an unsigned transaction spending P2SH multisig outputs
+// recorded by the wallet.
+func createCosignSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateCosignSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// Convert the address to a useable format. If
-	// we have no address, create a new address in
-	// this wallet to send the output to.
-	var addr vhcutil.Address
-	var err error
-	if cmd.Address != nil {
-		addr, err = decodeAddress(*cmd.Address, w.ChainParams())
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		account := uint32(udb.DefaultAccountNum)
-		addr, err = w.NewInternalAddress(account, wallet.WithGapPolicyWrap())
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Lookup the multisignature output and get the amount
-	// along with the script for that transaction. Then,
-	// begin crafting a MsgTx.
-	hash, err := chainhash.NewHashFromStr(cmd.Hash)
+	tx := new(wire.MsgTx)
+	err := tx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexTx)))
 	if err != nil {
-		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
-	}
-	op := wire.OutPoint{
-		Hash:  *hash,
-		Index: cmd.Index,
-		Tree:  cmd.Tree,
+		return nil, rpcError(vhcjson.ErrRPCDeserialization, err)
 	}
-	p2shOutput, err := w.FetchP2SHMultiSigOutput(&op)
+	session, err := w.NewCosignSession(tx)
 	if err != nil {
 		return nil, err
 	}
-	sc := txscript.GetScriptClass(txscript.DefaultScriptVersion,
-		p2shOutput.RedeemScript)
-	if sc != txscript.MultiSigTy {
-		return nil, errors.E("P2SH redeem script is not multisig")
+	return cosignSessionResult(session, 0)
+}
+
+// signCosignSession handles a signcosignsession request by adding signatures
+// of the session inputs by keys of the wallet.
+func signCosignSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SignCosignSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
-	var msgTx wire.MsgTx
-	txIn := wire.NewTxIn(&op, int64(p2shOutput.OutputAmount), nil)
-	msgTx.AddTxIn(txIn)
 
-	pkScript, err := txscript.PayToAddrScript(addr)
+	session, err := decodeCosignSession(cmd.Session)
 	if err != nil {
 		return nil, err
 	}
-
-	err = w.PrepareRedeemMultiSigOutTxOutput(&msgTx, p2shOutput, &pkScript)
+	added, err := w.SignCosignSession(session)
 	if err != nil {
+		if errors.Is(errors.Locked, err) {
+			return nil, errWalletUnlockNeeded
+		}
 		return nil, err
 	}
+	return cosignSessionResult(session, added)
+}
+
+// mergeCosignSessions handles a mergecosignsessions request by combining the
+// signatures of copies of a cosigning session signed by different cosigners.
+func mergeCosignSessions(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.MergeCosignSessionsCmd)
+	if len(cmd.Sessions) == 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "no sessions to merge")
+	}
 
-	// Start creating the SignRawTransactionCmd.
-	outpointScript, err := txscript.PayToScriptHashScript(p2shOutput.P2SHAddress.Hash160()[:])
+	session, err := decodeCosignSession(cmd.Sessions[0])
 	if err != nil {
 		return nil, err
 	}
-	outpointScriptStr := hex.EncodeToString(outpointScript)
+	for _, other := range cmd.Sessions[1:] {
+		o, err := decodeCosignSession(other)
+		if err != nil {
+			return nil, err
+		}
+		err = session.Merge(o)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	return cosignSessionResult(session, 0)
+}
 
-	rti := vhcjson.RawTxInput{
-		Txid:         cmd.Hash,
-		Vout:         cmd.Index,
-		Tree:         cmd.Tree,
-		ScriptPubKey: outpointScriptStr,
-		RedeemScript: "",
+// finalizeCosignSession handles a finalizecosignsession request by building
+// the signed transaction of a cosigning session once every input has the
+// required signatures, optionally publishing it.
+func finalizeCosignSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.FinalizeCosignSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
-	rtis := []vhcjson.RawTxInput{rti}
 
-	var b strings.Builder
-	b.Grow(2 * msgTx.SerializeSize())
-	err = msgTx.Serialize(hex.NewEncoder(&b))
+	session, err := decodeCosignSession(cmd.Session)
 	if err != nil {
 		return nil, err
 	}
-	sigHashAll := "ALL"
+	tx, err := session.Finalize(w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	serializedTx, err := tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.FinalizeCosignSessionResult{
+		Hex:    hex.EncodeToString(serializedTx),
+		TxHash: tx.TxHash().String(),
+	}
+	if *cmd.Publish {
+		n, ok := s.walletLoader(ctx).NetworkBackend()
+		if !ok {
+			return nil, errNoNetwork
+		}
+		_, err = w.PublishTransaction(tx, serializedTx, n)
+		if err != nil {
+			return nil, err
+		}
+		res.Published = true
+	}
+	return res, nil
+}
+
+// decodeSplitTicketSession decodes a split ticket session passed between the
+// participants of a split ticket.
+func decodeSplitTicketSession(session string) (*wallet.SplitTicketSession, error) {
+	s := new(wallet.SplitTicketSession)
+	err := json.Unmarshal([]byte(session), s)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	return s, nil
+}
+
+func splitTicketSessionResult(s *wallet.SplitTicketSession, ticketHash *chainhash.Hash) (*types.SplitTicketSessionResult, error) {
+	session, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.SplitTicketSessionResult{
+		Session:     string(session),
+		TicketPrice: vhcutil.Amount(s.TicketPrice).ToCoin(),
+		Unfunded:    s.Unfunded().ToCoin(),
+	}
+	if ticketHash != nil {
+		res.TicketHash = ticketHash.String()
+	}
+	return res, nil
+}
+
+// createSplitTicketSession handles a createsplitticketsession request by
+// beginning a session for a ticket co-funded by several wallets.  The returned
+// session is passed to each participant to join and sign.
+func createSplitTicketSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateSplitTicketSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var votingAddr vhcutil.Address
+	if cmd.VotingAddress != nil && *cmd.VotingAddress != "" {
+		var err error
+		votingAddr, err = decodeAddress(*cmd.VotingAddress, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+	var expiry int32
+	if cmd.Expiry != nil {
+		if *cmd.Expiry < 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative expiry")
+		}
+		expiry = int32(*cmd.Expiry)
+	}
+
+	session, err := w.NewSplitTicketSession(votingAddr, expiry)
+	if err != nil {
+		return nil, err
+	}
+	return splitTicketSessionResult(session, nil)
+}
+
+// joinSplitTicketSession handles a joinsplitticketsession request by
+// contributing funds of an account to a split ticket session.  When the amount
+// is omitted, the remainder of the ticket price is contributed.
+func joinSplitTicketSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.JoinSplitTicketSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	session, err := decodeSplitTicketSession(cmd.Session)
+	if err != nil {
+		return nil, err
+	}
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	var amount vhcutil.Amount
+	if cmd.Amount != nil {
+		amount, err = vhcutil.NewAmount(*cmd.Amount)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		if amount <= 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"contribution must be positive")
+		}
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	err = w.JoinSplitTicketSession(session, account, amount, minConf)
+	if err != nil {
+		return nil, err
+	}
+	return splitTicketSessionResult(session, nil)
+}
+
+// signSplitTicketSession handles a signsplitticketsession request by signing
+// the inputs of a fully funded split ticket session contributed by the wallet.
+func signSplitTicketSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SignSplitTicketSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	session, err := decodeSplitTicketSession(cmd.Session)
+	if err != nil {
+		return nil, err
+	}
+	ticketHash, err := w.SignSplitTicketSession(session)
+	if err != nil {
+		return nil, err
+	}
+	return splitTicketSessionResult(session, ticketHash)
+}
+
+// publishSplitTicketSession handles a publishsplitticketsession request by
+// publishing the ticket of a split ticket session signed by every participant.
+func publishSplitTicketSession(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.PublishSplitTicketSessionCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, ok := s.walletLoader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	session, err := decodeSplitTicketSession(cmd.Session)
+	if err != nil {
+		return nil, err
+	}
+	ticketHash, err := w.PublishSplitTicketSession(session, n)
+	if err != nil {
+		return nil, err
+	}
+	return ticketHash.String(), nil
+}
+
+// listSplitTickets handles a listsplittickets request by returning the status
+// of each split ticket co-funded by the wallet, and the wallet's share of the
+// ticket and its reward.
+func listSplitTickets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tickets, err := w.SplitTickets()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.SplitTicketResult, 0, len(tickets))
+	for _, t := range tickets {
+		accountName, err := w.AccountName(t.Account)
+		if err != nil {
+			return nil, err
+		}
+		contribution := vhcutil.Amount(t.Contribution())
+		r := types.SplitTicketResult{
+			TicketHash:   t.TicketHash.String(),
+			Account:      accountName,
+			Status:       t.Status,
+			TicketPrice:  vhcutil.Amount(t.TicketPrice).ToCoin(),
+			Contribution: contribution.ToCoin(),
+		}
+		if t.TotalCommitted != 0 {
+			r.Share = float64(contribution) / float64(t.TotalCommitted)
+		}
+		if t.Returned != 0 {
+			r.Returned = t.Returned.ToCoin()
+			r.Reward = (t.Returned - contribution).ToCoin()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// purchaseTicket indicates to the wallet that a ticket should be purchased
+// using all currently available funds. If the ticket could not be purchased
+// because there are not enough eligible funds, an error will be returned.
+func purchaseTicket(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.PurchaseTicketCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	params, err := parsePurchaseTicketParams(w, cmd)
+	if err != nil {
+		return nil, err
+	}
+	// Tickets are registered with the selected VSP unless voting rights are
+	// assigned to a ticket or pool address.
+	var hashes []*chainhash.Hash
+	v, err := vsp.New(w)
+	switch {
+	case err == nil && params.ticketAddr == nil && params.poolAddr == nil:
+		hashes, err = v.PurchaseTickets(ctx, 0, params.spendLimit, params.minConf,
+			params.account, params.numTickets, params.expiry, params.ticketFee)
+		if err != nil && len(hashes) == 0 {
+			return nil, err
+		}
+		if err != nil {
+			log.Warnf("Tickets purchased for VSP %s are not registered: %v",
+				v.Host(), err)
+		}
+	case err != nil && !errors.Is(errors.NotExist, err):
+		return nil, err
+	default:
+		hashes, err = w.PurchaseTickets(0, params.spendLimit, params.minConf,
+			params.ticketAddr, params.account, params.numTickets, params.poolAddr,
+			params.poolFee, params.expiry, w.RelayFee(), params.ticketFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hashStrs := make([]string, len(hashes))
+	for i := range hashes {
+		hashStrs[i] = hashes[i].String()
+	}
+
+	return hashStrs, nil
+}
+
+// makeOutputs creates a slice of transaction outputs from a pair of address
+// strings to amounts.  This is used to create the outputs to include in newly
+// created transactions from a JSON object describing the output destinations
+// and amounts.
+func makeOutputs(pairs map[string]vhcutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
+	outputs := make([]*wire.TxOut, 0, len(pairs))
+	for addrStr, amt := range pairs {
+		if amt < 0 {
+			return nil, errNeedPositiveAmount
+		}
+		addr, err := decodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, wire.NewTxOut(int64(amt), pkScript))
+	}
+	return outputs, nil
+}
+
+// sendPairs creates and sends payment transactions, or queues the send until
+// it is approved when the account or server requires send approval.  It returns the
+// transaction hash, or the identifier of the pending send, in string format
+// upon success.  All errors are returned in vhcjson.RPCError format
+func sendPairs(s *Server, ctx context.Context, w *wallet.Wallet, amounts map[string]vhcutil.Amount, account uint32, minconf int32) (string, error) {
+	err := s.checkSendCap(ctx, amounts)
+	if err != nil {
+		return "", err
+	}
+	required, err := w.SendApprovalRequired(account)
+	if err != nil {
+		return "", err
+	}
+	if required || s.requireApproval {
+		outputs, err := makeOutputs(amounts, w.ChainParams())
+		if err != nil {
+			return "", err
+		}
+		p, err := w.QueueSend(outputs, account, minconf, principalFromContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(p.ID[:]), nil
+	}
+	return sendAmounts(w, amounts, account, minconf)
+}
+
+// sendAmounts creates and sends payment transactions.
+// It returns the transaction hash in string format upon success
+// All errors are returned in vhcjson.RPCError format
+func sendAmounts(w *wallet.Wallet, amounts map[string]vhcutil.Amount, account uint32, minconf int32) (string, error) {
+	outputs, err := makeOutputs(amounts, w.ChainParams())
+	if err != nil {
+		return "", err
+	}
+	txSha, err := w.SendOutputs(outputs, account, minconf)
+	if err != nil {
+		if errors.Is(errors.Locked, err) {
+			return "", errWalletUnlockNeeded
+		}
+		if errors.Is(errors.InsufficientBalance, err) {
+			return "", sendErrorData(rpcError(vhcjson.ErrRPCWalletInsufficientFunds, err), err)
+		}
+		return "", err
+	}
+
+	return txSha.String(), nil
+}
+
+// redeemMultiSigOut receives a transaction hash/idx and fetches the first output
+// index or indices with known script hashes from the transaction. It then
+// construct a transaction with a single P2PKH paying to a specified address.
+// It signs any inputs that it can, then provides the raw transaction to
+// the user to export to others to sign.
+func redeemMultiSigOut(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.RedeemMultiSigOutCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Lookup the multisignature output and get the amount
+	// along with the script for that transaction.
+	hash, err := chainhash.NewHashFromStr(cmd.Hash)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	op := wire.OutPoint{
+		Hash:  *hash,
+		Index: cmd.Index,
+		Tree:  cmd.Tree,
+	}
+	p2shOutput, err := w.FetchP2SHMultiSigOutput(&op)
+	if err != nil {
+		return nil, err
+	}
+	p2shOutput.OutPoint.Tree = cmd.Tree
+
+	results, err := redeemP2SHMultiSigOutputs(w, []*wallet.P2SHMultiSigOutput{p2shOutput},
+		cmd.Address, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// redeemMultisigOuts receives a script hash (in the form of a
+// script hash address), looks up all the unspent outpoints associated
+// with that address, then generates a list of partially signed
+// transactions spending to either an address specified or internal
+// addresses in this wallet.
+func redeemMultiSigOuts(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.RedeemMultiSigOutsCmd)
+	return redeemMultiSigOutsBatch(s, ctx, &types.RedeemMultiSigOutsBatchCmd{
+		FromScrAddress: cmd.FromScrAddress,
+		ToAddress:      cmd.ToAddress,
+		Number:         cmd.Number,
+		Batch:          new(int),
+	})
+}
+
+// redeemMultiSigOutsBatch handles a redeemmultisigoutsbatch request by
+// redeeming the unspent outputs of a P2SH multisig address in transactions
+// spending up to batch outputs each, paying an optional fee rate.  Each
+// transaction is signed by the wallet and returned to be signed by other
+// cosigners.
+func redeemMultiSigOutsBatch(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.RedeemMultiSigOutsBatchCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Get all the multisignature outpoints that are unspent for this
+	// address.
+	addr, err := decodeAddress(cmd.FromScrAddress, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	p2shAddr, ok := addr.(*vhcutil.AddressScriptHash)
+	if !ok {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "address is not P2SH")
+	}
+	var feeRate vhcutil.Amount
+	if cmd.FeeRate != nil {
+		feeRate, err = vhcutil.NewAmount(*cmd.FeeRate)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		if feeRate < 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative fee rate")
+		}
+	}
+	// A zero batch size redeems each output in its own transaction.
+	batch := 1
+	if cmd.Batch != nil && *cmd.Batch != 0 {
+		batch = *cmd.Batch
+	}
+	if batch < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative batch size")
+	}
+
+	outputs, err := w.UnspentP2SHMultiSigOutputs(p2shAddr)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Number != nil && *cmd.Number >= 0 && *cmd.Number < len(outputs) {
+		outputs = outputs[:*cmd.Number]
+	}
+	if len(outputs) == 0 {
+		return vhcjson.RedeemMultiSigOutsResult{Results: []vhcjson.RedeemMultiSigOutResult{}}, nil
+	}
+
+	results, err := redeemP2SHMultiSigOutputs(w, outputs, cmd.ToAddress, feeRate, batch)
+	if err != nil {
+		return nil, err
+	}
+	return vhcjson.RedeemMultiSigOutsResult{Results: results}, nil
+}
+
+// redeemMultisigAccount handles a redeemmultisigaccount request by redeeming
+// the unspent outputs paying addresses of a multisig account in transactions
+// spending up to batch outputs each.  Each transaction is signed by the wallet
+// and returned to be signed by other cosigners.
+func redeemMultisigAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.RedeemMultisigAccountCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var feeRate vhcutil.Amount
+	if cmd.FeeRate != nil {
+		var err error
+		feeRate, err = vhcutil.NewAmount(*cmd.FeeRate)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		if feeRate < 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative fee rate")
+		}
+	}
+	batch := 1
+	if cmd.Batch != nil && *cmd.Batch != 0 {
+		batch = *cmd.Batch
+	}
+	if batch < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative batch size")
+	}
+
+	outputs, err := w.UnspentMultisigAccountOutputs(cmd.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(outputs) == 0 {
+		return vhcjson.RedeemMultiSigOutsResult{Results: []vhcjson.RedeemMultiSigOutResult{}}, nil
+	}
+
+	results, err := redeemP2SHMultiSigOutputs(w, outputs, cmd.ToAddress, feeRate, batch)
+	if err != nil {
+		return nil, err
+	}
+	return vhcjson.RedeemMultiSigOutsResult{Results: results}, nil
+}
+
+// redeemP2SHMultiSigOutputs redeems P2SH multisig outputs in transactions
+// spending at most batch outputs each, paying toAddress or, when nil, a new
+// internal address of the default account for each transaction.
+func redeemP2SHMultiSigOutputs(w *wallet.Wallet, outputs []*wallet.P2SHMultiSigOutput,
+	toAddress *string, feeRate vhcutil.Amount, batch int) ([]vhcjson.RedeemMultiSigOutResult, error) {
+
+	var results []vhcjson.RedeemMultiSigOutResult
+	for len(outputs) > 0 {
+		n := batch
+		if n > len(outputs) {
+			n = len(outputs)
+		}
+		var batchOutputs []*wallet.P2SHMultiSigOutput
+		batchOutputs, outputs = outputs[:n], outputs[n:]
+
+		// Convert the address to a useable format. If
+		// we have no address, create a new address in
+		// this wallet to send the output to.
+		var addr vhcutil.Address
+		var err error
+		if toAddress != nil {
+			addr, err = decodeAddress(*toAddress, w.ChainParams())
+		} else {
+			addr, err = w.NewInternalAddress(udb.DefaultAccountNum,
+				wallet.WithGapPolicyWrap())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tx, signErrs, err := w.RedeemP2SHMultiSigOutputs(batchOutputs, addr, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		var b strings.Builder
+		b.Grow(2 * tx.SerializeSize())
+		err = tx.Serialize(hex.NewEncoder(&b))
+		if err != nil {
+			return nil, err
+		}
+		signErrors := make([]vhcjson.SignRawTransactionError, 0, len(signErrs))
+		for _, e := range signErrs {
+			input := tx.TxIn[e.InputIndex]
+			signErrors = append(signErrors, vhcjson.SignRawTransactionError{
+				TxID:      input.PreviousOutPoint.Hash.String(),
+				Vout:      input.PreviousOutPoint.Index,
+				ScriptSig: hex.EncodeToString(input.SignatureScript),
+				Sequence:  input.Sequence,
+				Error:     e.Error.Error(),
+			})
+		}
+		results = append(results, vhcjson.RedeemMultiSigOutResult{
+			Hex:      b.String(),
+			Complete: len(signErrors) == 0,
+			Errors:   signErrors,
+		})
+	}
+	return results, nil
+}
+
+// releaseOutputs handles a releaseoutputs request by releasing the outputs of
+// a reservation created by reserveoutputs.
+func releaseOutputs(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ReleaseOutputsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ReleaseOutputs(cmd.ID)
+	if errors.Is(errors.NotExist, err) {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// rescanWallet initiates a rescan of the block chain for wallet data, blocking
+// until the rescan completes or exits with an error.
+func rescanWallet(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.RescanWalletCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.walletLoader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	err := w.RescanFromHeight(ctx, n, int32(*cmd.BeginHeight), nil)
+	return nil, err
+}
+
+// reserveOutputs handles a reserveoutputs request by selecting and locking
+// unspent outputs of an account for a transaction signed outside of the
+// wallet.  The outputs are released automatically after the ttl.
+func reserveOutputs(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ReserveOutputsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	if cmd.Amount <= 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "amount must be positive")
+	}
+	amt, err := vhcutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+	if *cmd.TTL <= 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "ttl must be positive")
+	}
+	ttl := time.Duration(*cmd.TTL) * time.Second
+
+	r, err := w.ReserveOutputs(account, amt, minConf, ttl)
+	if err != nil {
+		if errors.Is(errors.InsufficientBalance, err) {
+			return nil, rpcError(vhcjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return nil, err
+	}
 
-	srtc := &vhcjson.SignRawTransactionCmd{
-		RawTx:    b.String(),
-		Inputs:   &rtis,
-		PrivKeys: &[]string{},
-		Flags:    &sigHashAll,
-	}
-
-	// Sign it and give the results to the user.
-	signedTxResult, err := signRawTransaction(s, srtc)
-	if signedTxResult == nil || err != nil {
-		return nil, err
+	res := &types.ReserveOutputsResult{
+		ID:      r.ID,
+		Outputs: make([]types.ReservedOutputResult, len(r.Outputs)),
+		Total:   r.Total.ToCoin(),
+		Expires: r.Expiry.Unix(),
+	}
+	for i := range r.Outputs {
+		o := &r.Outputs[i]
+		res.Outputs[i] = types.ReservedOutputResult{
+			Txid:         o.Hash.String(),
+			Vout:         o.Index,
+			Tree:         o.Tree,
+			Amount:       o.Amount.ToCoin(),
+			ScriptPubKey: hex.EncodeToString(o.PkScript),
+		}
 	}
-	srtTyped := signedTxResult.(vhcjson.SignRawTransactionResult)
-	return vhcjson.RedeemMultiSigOutResult(srtTyped), nil
+	return res, nil
 }
 
-// redeemMultisigOuts receives a script hash (in the form of a
-// script hash address), looks up all the unspent outpoints associated
-// with that address, then generates a list of partially signed
-// transactions spending to either an address specified or internal
-// addresses in this wallet.
-func redeemMultiSigOuts(s *Server, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*vhcjson.RedeemMultiSigOutsCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// rotateAccount handles a rotateaccount request by rotating an account whose
+// extended public key has leaked to a new successor account, and sweeping
+// outputs of the rotated account to the successor.  Requests for an account
+// which was already rotated continue migrating its funds.
+func rotateAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.RotateAccountCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// Get all the multisignature outpoints that are unspent for this
-	// address.
-	addr, err := decodeAddress(cmd.FromScrAddress, w.ChainParams())
+	account, err := w.AccountNumber(cmd.Account)
 	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
 		return nil, err
 	}
-	p2shAddr, ok := addr.(*vhcutil.AddressScriptHash)
-	if !ok {
-		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "address is not P2SH")
+	maxInputs := *cmd.MaxInputs
+	if maxInputs < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative maxinputs")
+	}
+
+	r, err := w.AccountRotation(account)
+	if errors.Is(errors.NotExist, err) {
+		if cmd.NewAccount == nil || *cmd.NewAccount == "" {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"newaccount is required to rotate account %q", cmd.Account)
+		}
+		_, err = w.RotateAccount(account, *cmd.NewAccount)
+		if err != nil {
+			switch {
+			case errors.Is(errors.Invalid, err), errors.Is(errors.Exist, err):
+				return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+			case errors.Is(errors.Locked, err):
+				return nil, errWalletUnlockNeeded
+			}
+			return nil, err
+		}
+		r, err = w.AccountRotation(account)
 	}
-	msos, err := wallet.UnstableAPI(w).UnspentMultisigCreditsForAddress(p2shAddr)
 	if err != nil {
 		return nil, err
 	}
-	max := uint32(0xffffffff)
-	if cmd.Number != nil {
-		max = uint32(*cmd.Number)
+	successorName, err := w.AccountName(r.Successor)
+	if err != nil {
+		return nil, err
 	}
 
-	itr := uint32(0)
-	rmsoResults := make([]vhcjson.RedeemMultiSigOutResult, len(msos))
-	for i, mso := range msos {
-		if itr > max {
-			break
-		}
-
-		rmsoRequest := &vhcjson.RedeemMultiSigOutCmd{
-			Hash:    mso.OutPoint.Hash.String(),
-			Index:   mso.OutPoint.Index,
-			Tree:    mso.OutPoint.Tree,
-			Address: cmd.ToAddress,
+	res := &types.RotateAccountResult{
+		Account:     cmd.Account,
+		Successor:   successorName,
+		RotatedTime: r.Time.Unix(),
+	}
+	bal, err := w.CalculateAccountBalance(account, 1)
+	if err != nil {
+		return nil, err
+	}
+	if maxInputs > 0 && bal.Spendable > 0 {
+		txHash, err := w.SweepRotatedAccount(account, maxInputs)
+		if err != nil {
+			if errors.Is(errors.Locked, err) {
+				return nil, errWalletUnlockNeeded
+			}
+			return nil, err
 		}
-		redeemResult, err := redeemMultiSigOut(s, rmsoRequest)
+		res.SweepTxHash = txHash.String()
+		bal, err = w.CalculateAccountBalance(account, 1)
 		if err != nil {
 			return nil, err
 		}
-		redeemResultTyped := redeemResult.(vhcjson.RedeemMultiSigOutResult)
-		rmsoResults[i] = redeemResultTyped
-
-		itr++
 	}
-
-	return vhcjson.RedeemMultiSigOutsResult{Results: rmsoResults}, nil
+	res.RemainingBalance = bal.Total.ToCoin()
+	return res, nil
 }
 
-// rescanWallet initiates a rescan of the block chain for wallet data, blocking
-// until the rescan completes or exits with an error.
-func rescanWallet(s *Server, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*vhcjson.RescanWalletCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// revokeTickets initiates the wallet to issue revocations for any missing
+// tickets that not yet been revoked.
+func revokeTickets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	n, ok := s.walletLoader.NetworkBackend()
-	if !ok {
-		return nil, errNoNetwork
+	// RevokeTickets uses trusted RPCs to determine which tickets were missed.
+	// RevokeMissedTickets is able to be used with other backends and revokes
+	// expired tickets and the missed tickets detected by the wallet from the
+	// votes included in each block.
+	n, _ := s.walletLoader(ctx).NetworkBackend()
+	chainClient, err := chain.RPCClientFromBackend(n)
+	if err != nil {
+		err := w.RevokeMissedTickets(context.TODO(), n)
+		return nil, err
 	}
 
-	err := w.RescanFromHeight(context.TODO(), n, int32(*cmd.BeginHeight))
+	err = w.RevokeTickets(chainClient)
 	return nil, err
 }
 
-// revokeTickets initiates the wallet to issue revocations for any missing
-// tickets that not yet been revoked.
-func revokeTickets(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+// stakeHistory handles a stakehistory request by returning the records of the
+// most recent votes created by the wallet, ordered by the height of the block
+// voted on.
+func stakeHistory(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.StakeHistoryCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	// The wallet is not locally aware of when tickets are selected to vote and
-	// when they are missed.  RevokeTickets uses trusted RPCs to determine which
-	// tickets were missed.  RevokeExpiredTickets is only able to create
-	// revocations for tickets which have reached their expiry time even if they
-	// were missed prior to expiry, but is able to be used with other backends.
-	n, _ := s.walletLoader.NetworkBackend()
-	chainClient, err := chain.RPCClientFromBackend(n)
+	count := *cmd.Count
+	if count < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"count may not be negative")
+	}
+	records, err := w.StakeHistory()
 	if err != nil {
-		err := w.RevokeExpiredTickets(context.TODO(), n)
 		return nil, err
 	}
+	if count != 0 && count < len(records) {
+		records = records[len(records)-count:]
+	}
+	return voteRecordResults(records), nil
+}
 
-	err = w.RevokeTickets(chainClient)
-	return nil, err
+// voteRecordResults converts vote records to the results of the getticketinfo
+// and stakehistory commands.
+func voteRecordResults(records []*udb.VoteRecord) []types.VoteRecordResult {
+	res := make([]types.VoteRecordResult, 0, len(records))
+	for _, r := range records {
+		choices := make([]types.AgendaChoice, 0, len(r.Choices))
+		for _, c := range r.Choices {
+			choices = append(choices, types.AgendaChoice{
+				AgendaID: c.AgendaID,
+				ChoiceID: c.ChoiceID,
+			})
+		}
+		var version uint32
+		if len(r.ExtendedBits) >= 4 {
+			version = binary.LittleEndian.Uint32(r.ExtendedBits[:4])
+		}
+		res = append(res, types.VoteRecordResult{
+			TicketHash:  r.TicketHash.String(),
+			VoteHash:    r.VoteHash.String(),
+			BlockHash:   r.BlockHash.String(),
+			BlockHeight: r.BlockHeight,
+			Time:        r.Created.Unix(),
+			VoteBits:    r.VoteBits,
+			VoteBitsExt: hex.EncodeToString(r.ExtendedBits),
+			VoteVersion: version,
+			Choices:     choices,
+		})
+	}
+	return res
 }
 
 // stakePoolUserInfo returns the ticket information for a given user from the
 // stake pool.
-func stakePoolUserInfo(s *Server, icmd interface{}) (interface{}, error) {
+func stakePoolUserInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.StakePoolUserInfoCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2431,28 +4918,7 @@ func stakePoolUserInfo(s *Server, icmd interface{}) (interface{}, error) {
 	resp.Tickets = make([]vhcjson.PoolUserTicket, 0, len(spui.Tickets))
 	resp.InvalidTickets = make([]string, 0, len(spui.InvalidTickets))
 	for _, ticket := range spui.Tickets {
-		var ticketRes vhcjson.PoolUserTicket
-
-		status := ""
-		switch ticket.Status {
-		case udb.TSImmatureOrLive:
-			status = "live"
-		case udb.TSVoted:
-			status = "voted"
-		case udb.TSMissed:
-			status = "missed"
-			if ticket.HeightSpent-ticket.HeightTicket >= w.ChainParams().TicketExpiry {
-				status = "expired"
-			}
-		}
-		ticketRes.Status = status
-
-		ticketRes.Ticket = ticket.Ticket.String()
-		ticketRes.TicketHeight = ticket.HeightTicket
-		ticketRes.SpentBy = ticket.SpentBy.String()
-		ticketRes.SpentByHeight = ticket.HeightSpent
-
-		resp.Tickets = append(resp.Tickets, ticketRes)
+		resp.Tickets = append(resp.Tickets, poolUserTicket(ticket, w.ChainParams()))
 	}
 	for _, invalid := range spui.InvalidTickets {
 		invalidTicket := invalid.String()
@@ -2463,12 +4929,108 @@ func stakePoolUserInfo(s *Server, icmd interface{}) (interface{}, error) {
 	return resp, nil
 }
 
+// poolUserTicket returns the JSON result of a stake pool user ticket.
+func poolUserTicket(ticket *udb.PoolTicket, params *chaincfg.Params) vhcjson.PoolUserTicket {
+	status := ""
+	switch ticket.Status {
+	case udb.TSImmatureOrLive:
+		status = "live"
+	case udb.TSVoted:
+		status = "voted"
+	case udb.TSMissed:
+		status = "missed"
+		if ticket.HeightSpent-ticket.HeightTicket >= params.TicketExpiry {
+			status = "expired"
+		}
+	}
+
+	return vhcjson.PoolUserTicket{
+		Status:        status,
+		Ticket:        ticket.Ticket.String(),
+		TicketHeight:  ticket.HeightTicket,
+		SpentBy:       ticket.SpentBy.String(),
+		SpentByHeight: ticket.HeightSpent,
+	}
+}
+
+// listStakePoolUserTickets returns a page of the tickets of a stake pool user
+// ordered by ticket hash, optionally filtered by status.  Tickets are read
+// from the user ticket indexes, so each page is returned without loading the
+// user's entire ticket set.
+func listStakePoolUserTickets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListStakePoolUserTicketsCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	userAddr, err := vhcutil.DecodeAddress(cmd.User)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidAddressOrKey, err)
+	}
+
+	var status *udb.TicketStatus
+	var statusName string
+	if cmd.Status != nil {
+		statusName = *cmd.Status
+		var ts udb.TicketStatus
+		switch statusName {
+		case "live":
+			ts = udb.TSImmatureOrLive
+		case "voted":
+			ts = udb.TSVoted
+		case "missed", "expired":
+			ts = udb.TSMissed
+		default:
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"unknown ticket status %q", statusName)
+		}
+		status = &ts
+	}
+
+	var after *chainhash.Hash
+	if cmd.After != nil {
+		after, err = chainhash.NewHashFromStr(*cmd.After)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+		}
+	}
+
+	limit := *cmd.Limit
+	if limit <= 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"limit must be positive")
+	}
+
+	params := w.ChainParams()
+	res := &types.ListStakePoolUserTicketsResult{
+		Tickets: make([]vhcjson.PoolUserTicket, 0, limit),
+	}
+	err = w.ForEachStakePoolUserTicket(userAddr, status, after, func(t *udb.PoolTicket) bool {
+		ticket := poolUserTicket(t, params)
+		// Missed and expired tickets share a status index.
+		if statusName != "" && ticket.Status != statusName {
+			return true
+		}
+		res.Tickets = append(res.Tickets, ticket)
+		if len(res.Tickets) == limit {
+			res.Next = ticket.Ticket
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // ticketsForAddress retrieves all ticket hashes that have the passed voting
 // address. It will only return tickets that are in the mempool or blockchain,
 // and should not return pruned tickets.
-func ticketsForAddress(s *Server, icmd interface{}) (interface{}, error) {
+func ticketsForAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.TicketsForAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2500,9 +5062,9 @@ func isNilOrEmpty(s *string) bool {
 // address.  Leftover inputs not sent to the payment address or a fee for
 // the miner are sent back to a new address in the wallet.  Upon success,
 // the TxID for the created transaction is returned.
-func sendFrom(s *Server, icmd interface{}) (interface{}, error) {
+func sendFrom(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SendFromCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2535,7 +5097,7 @@ func sendFrom(s *Server, icmd interface{}) (interface{}, error) {
 		cmd.ToAddress: amt,
 	}
 
-	return sendPairs(w, pairs, account, minConf)
+	return sendPairs(s, ctx, w, pairs, account, minConf)
 }
 
 // sendMany handles a sendmany RPC request by creating a new transaction
@@ -2543,9 +5105,9 @@ func sendFrom(s *Server, icmd interface{}) (interface{}, error) {
 // payment addresses.  Leftover inputs not sent to the payment address
 // or a fee for the miner are sent back to a new address in the wallet.
 // Upon success, the TxID for the created transaction is returned.
-func sendMany(s *Server, icmd interface{}) (interface{}, error) {
+func sendMany(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SendManyCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2577,7 +5139,7 @@ func sendMany(s *Server, icmd interface{}) (interface{}, error) {
 		pairs[k] = amt
 	}
 
-	return sendPairs(w, pairs, account, minConf)
+	return sendPairs(s, ctx, w, pairs, account, minConf)
 }
 
 // sendToAddress handles a sendtoaddress RPC request by creating a new
@@ -2585,9 +5147,9 @@ func sendMany(s *Server, icmd interface{}) (interface{}, error) {
 // payment address.  Leftover inputs not sent to the payment address or a fee
 // for the miner are sent back to a new address in the wallet.  Upon success,
 // the TxID for the created transaction is returned.
-func sendToAddress(s *Server, icmd interface{}) (interface{}, error) {
+func sendToAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SendToAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2607,99 +5169,282 @@ func sendToAddress(s *Server, icmd interface{}) (interface{}, error) {
 	if amt < 0 {
 		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative amount")
 	}
-
-	// Mock up map of address and amount pairs.
-	pairs := map[string]vhcutil.Amount{
-		cmd.Address: amt,
+
+	// Mock up map of address and amount pairs.
+	pairs := map[string]vhcutil.Amount{
+		cmd.Address: amt,
+	}
+
+	// sendtoaddress always spends from the default account, this matches bitcoind
+	return sendPairs(s, ctx, w, pairs, udb.DefaultAccountNum, 1)
+}
+
+// sendToMultiSig handles a sendtomultisig RPC request by creating a new
+// transaction spending amount many funds to an output containing a multi-
+// signature script hash. The function will fail if there isn't at least one
+// public key in the public key list that corresponds to one that is owned
+// locally.
+// Upon successfully sending the transaction to the daemon, the script hash
+// is stored in the transaction manager and the corresponding address
+// specified to be watched by the daemon.
+// The function returns a tx hash, P2SH address, and a multisig script if
+// successful.
+// Multisig sends can not be queued for approval, so they are refused when the
+// server requires every send to be approved.
+// TODO Use with non-default accounts as well
+func sendToMultiSig(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*vhcjson.SendToMultiSigCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	if s.requireApproval {
+		return nil, rpcErrorf(vhcjson.ErrRPCWallet, "sends must be queued "+
+			"for approval, which is not supported by sendtomultisig")
+	}
+
+	account := uint32(udb.DefaultAccountNum)
+	amount, err := vhcutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	nrequired := int8(*cmd.NRequired)
+	minconf := int32(*cmd.MinConf)
+	pubkeys := make([]*vhcutil.AddressSecpPubKey, len(cmd.Pubkeys))
+
+	// The address list will made up either of addreseses (pubkey hash), for
+	// which we need to look up the keys in wallet, straight pubkeys, or a
+	// mixture of the two.
+	for i, a := range cmd.Pubkeys {
+		// Try to parse as pubkey address.
+		a, err := decodeAddress(a, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+
+		switch addr := a.(type) {
+		case *vhcutil.AddressSecpPubKey:
+			pubkeys[i] = addr
+		default:
+			pubKey, err := w.PubKeyForAddress(addr)
+			if err != nil {
+				if errors.Is(errors.NotExist, err) {
+					return nil, errAddressNotInWallet
+				}
+				return nil, err
+			}
+			if vhcec.SignatureType(pubKey.GetType()) != vhcec.STEcdsaSecp256k1 {
+				return nil, errors.New("only secp256k1 " +
+					"pubkeys are currently supported")
+			}
+			pubKeyAddr, err := vhcutil.NewAddressSecpPubKey(
+				pubKey.Serialize(), w.ChainParams())
+			if err != nil {
+				return nil, err
+			}
+			pubkeys[i] = pubKeyAddr
+		}
+	}
+
+	tx, addr, script, err :=
+		w.CreateMultisigTx(account, amount, pubkeys, nrequired, minconf)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &vhcjson.SendToMultiSigResult{
+		TxHash:       tx.MsgTx.TxHash().String(),
+		Address:      addr.EncodeAddress(),
+		RedeemScript: hex.EncodeToString(script),
+	}
+
+	log.Infof("Successfully sent funds to multisignature output in "+
+		"transaction %v", tx.MsgTx.TxHash().String())
+
+	return result, nil
+}
+
+// setAccountGapPolicy handles a setaccountgappolicy request by setting the
+// gap limit policy used when generating addresses for an account without
+// specifying a gap policy.
+func setAccountGapPolicy(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetAccountGapPolicyCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var policy wallet.GapPolicy
+	switch cmd.GapPolicy {
+	case "error":
+		policy = wallet.GapPolicyError
+	case "ignore":
+		policy = wallet.GapPolicyIgnore
+	case "wrap":
+		policy = wallet.GapPolicyWrap
+	default:
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "unknown gap policy %q", cmd.GapPolicy)
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	return nil, w.SetAccountGapPolicy(account, policy)
+}
+
+// setSpendingPolicy handles a setspendingpolicy request by modifying the
+// per-transaction and daily spending limits of an account.
+func setSpendingPolicy(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetSpendingPolicyCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	txLimit, err := vhcutil.NewAmount(cmd.TxLimit)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	dailyLimit, err := vhcutil.NewAmount(cmd.DailyLimit)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	if txLimit < 0 || dailyLimit < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "spending limits may not be negative")
+	}
+
+	var currentOverride, newOverride []byte
+	if cmd.CurrentOverridePassphrase != nil {
+		currentOverride = []byte(*cmd.CurrentOverridePassphrase)
+	}
+	if cmd.OverridePassphrase != nil {
+		newOverride = []byte(*cmd.OverridePassphrase)
+	}
+	err = w.SetSpendingPolicy(account, txLimit, dailyLimit, currentOverride, newOverride)
+	if err != nil {
+		if errors.Is(errors.Passphrase, err) {
+			return nil, rpcErrorf(vhcjson.ErrRPCWalletPassphraseIncorrect, "incorrect override passphrase")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// setUnlockSessionTimeout handles a setunlocksessiontimeout request by
+// modifying the number of seconds that the key derived during an unlock is
+// cached.  A zero timeout disables caching and clears any cached key.
+func setUnlockSessionTimeout(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetUnlockSessionTimeoutCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Timeout < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "timeout may not be negative")
+	}
+
+	w.SetUnlockSessionTimeout(time.Second * time.Duration(cmd.Timeout))
+	return nil, nil
+}
+
+// setUTXOPolicy handles a setutxopolicy request by replacing the spend policy
+// flags of an unspent output.  An empty policy list removes the restrictions
+// on the output.
+func setUTXOPolicy(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetUTXOPolicyCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+	var policy udb.UTXOPolicy
+	for _, name := range cmd.Policies {
+		p, err := udb.ParseUTXOPolicy(name)
+		if err != nil {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "unknown UTXO policy %q", name)
+		}
+		policy |= p
 	}
 
-	// sendtoaddress always spends from the default account, this matches bitcoind
-	return sendPairs(w, pairs, udb.DefaultAccountNum, 1)
+	op := &wire.OutPoint{Hash: *txHash, Index: cmd.Vout}
+	err = w.SetUTXOPolicy(op, policy)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
 }
 
-// sendToMultiSig handles a sendtomultisig RPC request by creating a new
-// transaction spending amount many funds to an output containing a multi-
-// signature script hash. The function will fail if there isn't at least one
-// public key in the public key list that corresponds to one that is owned
-// locally.
-// Upon successfully sending the transaction to the daemon, the script hash
-// is stored in the transaction manager and the corresponding address
-// specified to be watched by the daemon.
-// The function returns a tx hash, P2SH address, and a multisig script if
-// successful.
-// TODO Use with non-default accounts as well
-func sendToMultiSig(s *Server, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*vhcjson.SendToMultiSigCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+// setVSP handles a setvsp request by selecting the voting service provider
+// which tickets are purchased for.  When the public key of the VSP is not
+// provided, the key reported by the VSP is trusted.  An empty host clears the
+// selected VSP.
+func setVSP(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetVSPCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account := uint32(udb.DefaultAccountNum)
-	amount, err := vhcutil.NewAmount(cmd.Amount)
-	if err != nil {
-		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	if cmd.Host == "" {
+		return nil, w.SetVSP("", nil)
 	}
-	nrequired := int8(*cmd.NRequired)
-	minconf := int32(*cmd.MinConf)
-	pubkeys := make([]*vhcutil.AddressSecpPubKey, len(cmd.Pubkeys))
 
-	// The address list will made up either of addreseses (pubkey hash), for
-	// which we need to look up the keys in wallet, straight pubkeys, or a
-	// mixture of the two.
-	for i, a := range cmd.Pubkeys {
-		// Try to parse as pubkey address.
-		a, err := decodeAddress(a, w.ChainParams())
+	var pubKey []byte
+	if cmd.PubKey != nil && *cmd.PubKey != "" {
+		var err error
+		pubKey, err = base64.StdEncoding.DecodeString(*cmd.PubKey)
 		if err != nil {
-			return nil, err
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
 		}
-
-		switch addr := a.(type) {
-		case *vhcutil.AddressSecpPubKey:
-			pubkeys[i] = addr
-		default:
-			pubKey, err := w.PubKeyForAddress(addr)
-			if err != nil {
-				if errors.Is(errors.NotExist, err) {
-					return nil, errAddressNotInWallet
-				}
-				return nil, err
-			}
-			if vhcec.SignatureType(pubKey.GetType()) != vhcec.STEcdsaSecp256k1 {
-				return nil, errors.New("only secp256k1 " +
-					"pubkeys are currently supported")
-			}
-			pubKeyAddr, err := vhcutil.NewAddressSecpPubKey(
-				pubKey.Serialize(), w.ChainParams())
-			if err != nil {
-				return nil, err
-			}
-			pubkeys[i] = pubKeyAddr
+	} else {
+		info, err := vsp.FetchInfo(ctx, cmd.Host, w.ChainParams())
+		if err != nil {
+			return nil, err
 		}
+		pubKey = info.PubKey
 	}
 
-	ctx, addr, script, err :=
-		w.CreateMultisigTx(account, amount, pubkeys, nrequired, minconf)
+	// Verify the VSP signs its info with the key and serves this network
+	// before selecting it.
+	c, err := vsp.NewClient(cmd.Host, pubKey, w.ChainParams())
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	info, err := c.Info(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	result := &vhcjson.SendToMultiSigResult{
-		TxHash:       ctx.MsgTx.TxHash().String(),
-		Address:      addr.EncodeAddress(),
-		RedeemScript: hex.EncodeToString(script),
+	if info.Network != w.ChainParams().Name {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"VSP serves network %q", info.Network)
 	}
-
-	log.Infof("Successfully sent funds to multisignature output in "+
-		"transaction %v", ctx.MsgTx.TxHash().String())
-
-	return result, nil
+	return nil, w.SetVSP(cmd.Host, pubKey)
 }
 
 // setTicketFee sets the transaction fee per kilobyte added to tickets.
-func setTicketFee(s *Server, icmd interface{}) (interface{}, error) {
+func setTicketFee(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SetTicketFeeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2720,9 +5465,9 @@ func setTicketFee(s *Server, icmd interface{}) (interface{}, error) {
 }
 
 // setTxFee sets the transaction fee per kilobyte added to transactions.
-func setTxFee(s *Server, icmd interface{}) (interface{}, error) {
+func setTxFee(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SetTxFeeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2744,9 +5489,9 @@ func setTxFee(s *Server, icmd interface{}) (interface{}, error) {
 
 // setVoteChoice handles a setvotechoice request by modifying the preferred
 // choice for a voting agenda.
-func setVoteChoice(s *Server, icmd interface{}) (interface{}, error) {
+func setVoteChoice(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SetVoteChoiceCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2760,9 +5505,9 @@ func setVoteChoice(s *Server, icmd interface{}) (interface{}, error) {
 
 // signMessage signs the given message with the private key for the given
 // address
-func signMessage(s *Server, icmd interface{}) (interface{}, error) {
+func signMessage(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SignMessageCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2788,9 +5533,9 @@ func signMessage(s *Server, icmd interface{}) (interface{}, error) {
 //
 // chainClient may be nil, in which case it was called by the NoChainRPC
 // variant.  It must be checked before all usage.
-func signRawTransaction(s *Server, icmd interface{}) (interface{}, error) {
+func signRawTransaction(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SignRawTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -2873,31 +5618,47 @@ func signRawTransaction(s *Server, icmd interface{}) (interface{}, error) {
 		}] = script
 	}
 
-	// Now we go and look for any inputs that we were not provided by
-	// querying vhcd with getrawtransaction. We queue up a bunch of async
-	// requests and will wait for replies after we have checked the rest of
-	// the arguments.
+	// Now we go and look for any inputs that we were not provided, first in
+	// the wallet's transaction store, so that inputs related to the wallet
+	// can be signed without a consensus RPC server (such as in SPV mode),
+	// and then by querying vhcd with gettxout.  We queue up a bunch of
+	// async requests and will wait for replies after we have checked the
+	// rest of the arguments.
+	var missing []wire.OutPoint
+	for i, txIn := range tx.TxIn {
+		// We don't need the first input of a stakebase tx, as it's garbage
+		// anyway.
+		if i == 0 && *cmd.Flags == "ssgen" {
+			continue
+		}
+
+		// Did we get this outpoint from the arguments?
+		if _, ok := inputs[txIn.PreviousOutPoint]; ok {
+			continue
+		}
+		missing = append(missing, txIn.PreviousOutPoint)
+	}
+	known, err := w.PrevOutScripts(missing)
+	if err != nil {
+		return nil, err
+	}
+	for outPoint, script := range known {
+		inputs[outPoint] = script
+	}
 	var requested map[wire.OutPoint]rpcclient.FutureGetTxOutResult
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.walletLoader(ctx).NetworkBackend()
 	chainClient, err := chain.RPCClientFromBackend(n)
 	if err == nil {
 		requested = make(map[wire.OutPoint]rpcclient.FutureGetTxOutResult)
-		for i, txIn := range tx.TxIn {
-			// We don't need the first input of a stakebase tx, as it's garbage
-			// anyway.
-			if i == 0 && *cmd.Flags == "ssgen" {
-				continue
-			}
-
-			// Did we get this outpoint from the arguments?
-			if _, ok := inputs[txIn.PreviousOutPoint]; ok {
+		for _, outPoint := range missing {
+			if _, ok := known[outPoint]; ok {
 				continue
 			}
 
 			// Asynchronously request the output script.
-			requested[txIn.PreviousOutPoint] = chainClient.GetTxOutAsync(
-				&txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index,
-				true)
+			outPoint := outPoint
+			requested[outPoint] = chainClient.GetTxOutAsync(&outPoint.Hash,
+				outPoint.Index, true)
 		}
 	}
 
@@ -3000,94 +5761,107 @@ func signRawTransaction(s *Server, icmd interface{}) (interface{}, error) {
 	}, nil
 }
 
-// signRawTransactions handles the signrawtransactions command.
-func signRawTransactions(s *Server, icmd interface{}) (interface{}, error) {
+// signRawTransactions handles the signrawtransactions command.  Transactions
+// are signed concurrently, and failures to sign or send a transaction are
+// reported in the result of the transaction instead of failing the request.
+func signRawTransactions(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SignRawTransactionsCmd)
 
-	// Sign each transaction sequentially and record the results.
-	// Error out if we meet some unexpected failure.
-	results := make([]vhcjson.SignRawTransactionResult, len(cmd.RawTxs))
-	for i, etx := range cmd.RawTxs {
-		flagAll := "ALL"
-		srtc := &vhcjson.SignRawTransactionCmd{
-			RawTx: etx,
-			Flags: &flagAll,
-		}
-		result, err := signRawTransaction(s, srtc)
-		if err != nil {
-			return nil, err
-		}
-
-		tResult := result.(vhcjson.SignRawTransactionResult)
-		results[i] = tResult
-	}
-
-	// If the user wants completed transactions to be automatically send,
-	// do that now. Otherwise, construct the slice and return it.
-	toReturn := make([]vhcjson.SignedTransaction, len(cmd.RawTxs))
-
+	var n wallet.NetworkBackend
 	if *cmd.Send {
-		n, ok := s.walletLoader.NetworkBackend()
+		var ok bool
+		n, ok = s.walletLoader(ctx).NetworkBackend()
 		if !ok {
 			return nil, errNoNetwork
 		}
+	}
 
-		for i, result := range results {
-			if result.Complete {
-				// Slow/mem hungry because of the deserializing.
-				msgTx := wire.NewMsgTx()
-				err := msgTx.Deserialize(hex.NewDecoder(strings.NewReader(result.Hex)))
-				if err != nil {
-					return nil, rpcError(vhcjson.ErrRPCDeserialization, err)
-				}
-				sent := false
-				hashStr := ""
-				err = n.PublishTransactions(context.TODO(), msgTx)
-				// If sendrawtransaction errors out (blockchain rule
-				// issue, etc), continue onto the next transaction.
-				if err == nil {
-					sent = true
-					hashStr = msgTx.TxHash().String()
-				}
-
-				st := vhcjson.SignedTransaction{
-					SigningResult: result,
-					Sent:          sent,
-					TxHash:        &hashStr,
+	// Spawn up to ncpu workers to sign the transactions, each recording the
+	// result or error of the transactions it signs.
+	results := make([]types.SignedTransaction, len(cmd.RawTxs))
+	nworkers := runtime.NumCPU()
+	if nworkers > len(cmd.RawTxs) {
+		nworkers = len(cmd.RawTxs)
+	}
+	c := make(chan int, nworkers)
+	var wg sync.WaitGroup
+	wg.Add(nworkers)
+	for i := 0; i < nworkers; i++ {
+		go func() {
+			for i := range c {
+				flagAll := "ALL"
+				srtc := &vhcjson.SignRawTransactionCmd{
+					RawTx: cmd.RawTxs[i],
+					Flags: &flagAll,
 				}
-				toReturn[i] = st
-			} else {
-				st := vhcjson.SignedTransaction{
-					SigningResult: result,
-					Sent:          false,
-					TxHash:        nil,
+				result, err := signRawTransaction(s, ctx, srtc)
+				if err != nil {
+					results[i].Error = err.Error()
+					continue
 				}
-				toReturn[i] = st
+				results[i].SigningResult = result.(vhcjson.SignRawTransactionResult)
 			}
+			wg.Done()
+		}()
+	}
+	for i := range cmd.RawTxs {
+		c <- i
+	}
+	close(c)
+	wg.Wait()
+
+	// If the user wants completed transactions to be automatically sent, do
+	// that now, continuing with the next transaction when one can not be
+	// sent.
+	if n == nil {
+		return &types.SignRawTransactionsResult{Results: results}, nil
+	}
+	for i := range results {
+		result := &results[i]
+		if !result.SigningResult.Complete {
+			continue
 		}
-	} else { // Just return the results.
-		for i, result := range results {
-			st := vhcjson.SignedTransaction{
-				SigningResult: result,
-				Sent:          false,
-				TxHash:        nil,
-			}
-			toReturn[i] = st
+		msgTx := wire.NewMsgTx()
+		err := msgTx.Deserialize(hex.NewDecoder(strings.NewReader(result.SigningResult.Hex)))
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		err = n.PublishTransactions(context.TODO(), msgTx)
+		if err != nil {
+			result.Error = err.Error()
+			continue
 		}
+		hashStr := msgTx.TxHash().String()
+		result.Sent = true
+		result.TxHash = &hashStr
 	}
-
-	return &vhcjson.SignRawTransactionsResult{Results: toReturn}, nil
+	return &types.SignRawTransactionsResult{Results: results}, nil
 }
 
-// startAutoBuyer handles the startautobuyer command.
-func startAutoBuyer(s *Server, icmd interface{}) (interface{}, error) {
+// startAutoBuyer handles the startautobuyer command.  Each account may run its
+// own purchase strategy concurrently with those of other accounts, with the
+// configured ticket buyer options providing the defaults of unset parameters.
+func startAutoBuyer(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.StartAutoBuyerCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	config := s.ticketbuyerConfig
+	_, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	// Copy the configured options so strategies of other accounts are not
+	// modified.
+	cfgCopy := *s.ticketbuyerConfig
+	config := &cfgCopy
+	config.AccountName = cmd.Account
 
 	if cmd.BalanceToMaintain != nil {
 		if *cmd.BalanceToMaintain < 0 {
@@ -3125,67 +5899,285 @@ func startAutoBuyer(s *Server, icmd interface{}) (interface{}, error) {
 		config.MaxPriceRelative = *cmd.MaxPriceRelative
 	}
 
-	if cmd.MaxPerBlock != nil {
-		if *cmd.MaxPerBlock < 0 {
-			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
-				"maxperblock (%v) must be non-negative", *cmd.MaxPerBlock)
+	if cmd.MaxPerBlock != nil {
+		if *cmd.MaxPerBlock < 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"maxperblock (%v) must be non-negative", *cmd.MaxPerBlock)
+		}
+
+		config.MaxPerBlock = int(*cmd.MaxPerBlock)
+	}
+
+	params := w.ChainParams()
+
+	if cmd.VotingAddress != nil {
+		var votingAddress vhcutil.Address
+		if *cmd.VotingAddress != "" {
+			votingAddress, err = decodeAddress(*cmd.VotingAddress, params)
+			if err != nil {
+				return nil, err
+			}
+
+			config.VotingAddress = votingAddress
+		}
+	}
+
+	var poolAddress vhcutil.Address
+	if cmd.PoolAddress != nil {
+		if *cmd.PoolAddress != "" {
+			poolAddress, err = decodeAddress(*cmd.PoolAddress, params)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var poolFees float64
+	if cmd.PoolFees != nil {
+		poolFees = *cmd.PoolFees
+	}
+
+	switch {
+	case poolFees == 0 && poolAddress != nil:
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "pooladdress set without poolfees")
+	case poolFees != 0 && poolAddress == nil:
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "poolfees set without pooladdress")
+	case poolFees != 0 && poolAddress != nil:
+		if !txrules.ValidPoolFeeRate(poolFees) {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "invalid poolfees %v", poolFees)
+		}
+	}
+
+	config.PoolFees = poolFees
+	config.PoolAddress = poolAddress
+
+	err = s.walletLoader(ctx).StartTicketPurchase([]byte(cmd.Passphrase), config)
+	return nil, err
+}
+
+// stopAutoBuyer handles the stopautobuyer command by stopping the ticket buyer
+// of every account.
+func stopAutoBuyer(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	err := s.walletLoader(ctx).StopTicketPurchase()
+	return nil, err
+}
+
+// stopAccountAutoBuyer handles the stopaccountautobuyer command by stopping the
+// ticket buyer of a single account.
+func stopAccountAutoBuyer(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.StopAccountAutoBuyerCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = s.walletLoader(ctx).StopAccountTicketPurchase(account)
+	return nil, err
+}
+
+// getAutoBuyerStatus handles the getautobuyerstatus command by describing
+// whether the ticket buyer is running, and the effective configuration and
+// tickets purchased since it was started of each account's strategy.
+func getAutoBuyerStatus(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAutoBuyerStatusCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	filter := false
+	var account uint32
+	if cmd.Account != nil {
+		var err error
+		account, err = w.AccountNumber(*cmd.Account)
+		if err != nil {
+			if errors.Is(errors.NotExist, err) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		filter = true
+	}
+
+	// Purchases are evaluated as each new block is attached to the main
+	// chain.
+	_, tipHeight := w.MainChainTip()
+
+	pms := s.walletLoader(ctx).PurchaseManagers()
+	res := &types.GetAutoBuyerStatusResult{
+		Running:    len(pms) != 0,
+		Strategies: make([]types.AutoBuyerStrategyResult, 0, len(pms)),
+	}
+	for _, pm := range pms {
+		p := pm.Purchaser()
+		if filter && p.Account() != account {
+			continue
+		}
+		config, err := p.Config()
+		if err != nil {
+			return nil, err
+		}
+		stats := pm.SessionStats()
+		r := types.AutoBuyerStrategyResult{
+			Config: types.AutoBuyerConfig{
+				Account:           config.AccountName,
+				BalanceToMaintain: vhcutil.Amount(config.BalanceToMaintainAbsolute).ToCoin(),
+				MaxFee:            vhcutil.Amount(config.MaxFee).ToCoin(),
+				MaxPriceAbsolute:  vhcutil.Amount(config.MaxPriceAbsolute).ToCoin(),
+				MaxPriceRelative:  config.MaxPriceRelative,
+				MaxPerBlock:       config.MaxPerBlock,
+				MaxSpend:          vhcutil.Amount(config.MaxSpend).ToCoin(),
+				SpendWindow:       config.SpendWindow,
+				PoolFees:          config.PoolFees,
+			},
+			Purchased:  stats.Purchased,
+			Spent:      stats.Spent.ToCoin(),
+			NextHeight: int64(tipHeight) + 1,
+		}
+		if config.VotingAddress != nil {
+			r.Config.VotingAddress = config.VotingAddress.EncodeAddress()
+		}
+		if config.PoolAddress != nil {
+			r.Config.PoolAddress = config.PoolAddress.EncodeAddress()
+		}
+		if stats.LastError != nil {
+			r.LastError = stats.LastError.Error()
+			r.LastErrorHeight = stats.LastErrorHeight
+		}
+		res.Strategies = append(res.Strategies, r)
+	}
+	return res, nil
+}
+
+// getSyncStatus handles the getsyncstatus command by reporting the progress of
+// the wallet's synchronization with its network backend.
+func getSyncStatus(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	status, err := w.SyncStatus()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.GetSyncStatusResult{
+		Synced:             status.Synced,
+		TipHash:            status.TipHash.String(),
+		TipHeight:          status.TipHeight,
+		BackendHeight:      status.BackendHeight,
+		HeadersFetched:     status.HeadersFetched,
+		CFiltersFetched:    status.CFiltersFetched,
+		Rescanning:         status.Rescanning,
+		RescannedThrough:   status.RescannedThrough,
+		EstimatedRemaining: int64(status.EstimatedRemaining / time.Second),
+	}
+	if status.RescanPoint != nil {
+		res.RescanPoint = status.RescanPoint.String()
+		res.RescanPointHeight = status.RescanPointHeight
+	}
+	return res, nil
+}
+
+// setAutoConsolidation handles the setautoconsolidation command by configuring
+// or disabling the automatic consolidation of an account's outputs.
+func setAutoConsolidation(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetAutoConsolidationCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	if cmd.Threshold < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative threshold")
+	}
+
+	var cfg *wallet.AutoConsolidation
+	if cmd.Threshold != 0 {
+		cfg = &wallet.AutoConsolidation{
+			Threshold:     cmd.Threshold,
+			MaxInputs:     *cmd.MaxInputs,
+			MaxBlockUsage: *cmd.MaxBlockUsage,
 		}
-
-		config.MaxPerBlock = int(*cmd.MaxPerBlock)
 	}
+	err = w.SetAutoConsolidation(account, cfg)
+	if errors.Is(errors.Invalid, err) {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
 
-	params := w.ChainParams()
-
-	var err error
-	if cmd.VotingAddress != nil {
-		var votingAddress vhcutil.Address
-		if *cmd.VotingAddress != "" {
-			votingAddress, err = decodeAddress(*cmd.VotingAddress, params)
-			if err != nil {
-				return nil, err
-			}
-
-			config.VotingAddress = votingAddress
-		}
+// getAutoConsolidation handles the getautoconsolidation command by describing
+// the configuration and status of the automatic consolidation of each
+// configured account.
+func getAutoConsolidation(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAutoConsolidationCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	var poolAddress vhcutil.Address
-	if cmd.PoolAddress != nil {
-		if *cmd.PoolAddress != "" {
-			poolAddress, err = decodeAddress(*cmd.PoolAddress, params)
-			if err != nil {
-				return nil, err
+	filter := false
+	var account uint32
+	if cmd.Account != nil {
+		var err error
+		account, err = w.AccountNumber(*cmd.Account)
+		if err != nil {
+			if errors.Is(errors.NotExist, err) {
+				return nil, errAccountNotFound
 			}
+			return nil, err
 		}
+		filter = true
 	}
 
-	var poolFees float64
-	if cmd.PoolFees != nil {
-		poolFees = *cmd.PoolFees
+	statuses := w.AutoConsolidations()
+	res := &types.GetAutoConsolidationResult{
+		Accounts: make([]types.AutoConsolidationResult, 0, len(statuses)),
 	}
-
-	switch {
-	case poolFees == 0 && poolAddress != nil:
-		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "pooladdress set without poolfees")
-	case poolFees != 0 && poolAddress == nil:
-		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "poolfees set without pooladdress")
-	case poolFees != 0 && poolAddress != nil:
-		if !txrules.ValidPoolFeeRate(poolFees) {
-			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "invalid poolfees %v", poolFees)
+	for i := range statuses {
+		st := &statuses[i]
+		if filter && st.Account != account {
+			continue
+		}
+		name, err := w.AccountName(st.Account)
+		if err != nil {
+			return nil, err
+		}
+		r := types.AutoConsolidationResult{
+			Account:         name,
+			Threshold:       st.Config.Threshold,
+			MaxInputs:       st.Config.MaxInputs,
+			MaxBlockUsage:   st.Config.MaxBlockUsage,
+			Outputs:         st.Outputs,
+			EvaluatedHeight: st.EvaluatedHeight,
+			Consolidations:  st.Consolidations,
 		}
+		if st.LastTx != nil {
+			r.LastTx = st.LastTx.String()
+			r.LastTxHeight = st.LastTxHeight
+		}
+		if st.LastError != nil {
+			r.LastError = st.LastError.Error()
+			r.LastErrorHeight = st.LastErrorHeight
+		}
+		res.Accounts = append(res.Accounts, r)
 	}
-
-	config.PoolFees = poolFees
-	config.PoolAddress = poolAddress
-
-	err = s.walletLoader.StartTicketPurchase([]byte(cmd.Passphrase), config)
-	return nil, err
-}
-
-// stopAutoBuyer handles the stopautobuyer command.
-func stopAutoBuyer(s *Server, icmd interface{}) (interface{}, error) {
-	err := s.walletLoader.StopTicketPurchase()
-	return nil, err
+	return res, nil
 }
 
 // scriptChangeSource is a ChangeSource which is used to
@@ -3223,9 +6215,9 @@ func makeScriptChangeSource(address string, version uint16) (*scriptChangeSource
 }
 
 // sweepAccount handles the sweepaccount command.
-func sweepAccount(s *Server, icmd interface{}) (interface{}, error) {
+func sweepAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.SweepAccountCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -3262,8 +6254,8 @@ func sweepAccount(s *Server, icmd interface{}) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	tx, err := w.NewUnsignedTransaction(nil, feePerKb, account,
-		requiredConfs, wallet.OutputSelectionAlgorithmAll, changeSource)
+	sweeps, err := w.NewUnsignedSweeps(account, requiredConfs, feePerKb,
+		changeSource, nil)
 	if err != nil {
 		if errors.Is(errors.InsufficientBalance, err) {
 			return nil, rpcError(vhcjson.ErrRPCWalletInsufficientFunds, err)
@@ -3271,27 +6263,160 @@ func sweepAccount(s *Server, icmd interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	var b strings.Builder
-	b.Grow(2 * tx.Tx.SerializeSize())
-	err = tx.Tx.Serialize(hex.NewEncoder(&b))
+	results := make([]vhcjson.SweepAccountResult, len(sweeps))
+	for i, tx := range sweeps {
+		var b strings.Builder
+		b.Grow(2 * tx.Tx.SerializeSize())
+		err = tx.Tx.Serialize(hex.NewEncoder(&b))
+		if err != nil {
+			return nil, err
+		}
+		results[i] = vhcjson.SweepAccountResult{
+			UnsignedTransaction:       b.String(),
+			TotalPreviousOutputAmount: tx.TotalInput.ToCoin(),
+			TotalOutputAmount:         helpers.SumOutputValues(tx.Tx.TxOut).ToCoin(),
+			EstimatedSignedSize:       uint32(tx.EstimatedSignedSerializeSize),
+		}
+	}
+
+	res := &types.SweepAccountResult{
+		UnsignedTransaction:       results[0].UnsignedTransaction,
+		TotalPreviousOutputAmount: results[0].TotalPreviousOutputAmount,
+		TotalOutputAmount:         results[0].TotalOutputAmount,
+		EstimatedSignedSize:       results[0].EstimatedSignedSize,
+		AdditionalTransactions:    results[1:],
+	}
+
+	return res, nil
+}
+
+// sendSweepAccount handles the sendsweepaccount command by signing and
+// publishing transactions moving as much value as possible from an account to
+// a destination address.  The hashes of the transactions are returned.
+func sendSweepAccount(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SendSweepAccountCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	feePerKb := w.RelayFee()
+	if cmd.FeePerKb != nil {
+		var err error
+		feePerKb, err = vhcutil.NewAmount(*cmd.FeePerKb)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	requiredConfs := int32(1)
+	if cmd.RequiredConfirmations != nil {
+		requiredConfs = int32(*cmd.RequiredConfirmations)
+		if requiredConfs < 0 {
+			return nil, errNeedPositiveAmount
+		}
+	}
+	account, err := w.AccountNumber(cmd.SourceAccount)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	dest, err := decodeAddress(cmd.DestinationAddress, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	if *cmd.DustThreshold < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative dust threshold")
+	}
+	dustThreshold, err := vhcutil.NewAmount(*cmd.DustThreshold)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	filter := &wallet.SweepFilter{
+		IncludeLocked: *cmd.IncludeLocked,
+		DustThreshold: dustThreshold,
+	}
+
+	// The swept value is bounded by the spendable balance of the account.
+	bal, err := w.CalculateAccountBalance(account, requiredConfs)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkSendCap(ctx, map[string]vhcutil.Amount{
+		cmd.DestinationAddress: bal.Spendable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes, err := w.SweepAccount(account, requiredConfs, feePerKb, dest, filter)
 	if err != nil {
 		return nil, err
 	}
+	hashStrs := make([]string, len(txHashes))
+	for i, h := range txHashes {
+		hashStrs[i] = h.String()
+	}
+	return hashStrs, nil
+}
+
+// sweepDust handles the sweepdust command by consolidating the dust outputs
+// of an account into a new address of the account when the swept value
+// exceeds the fee, and reporting the reclaimed value.
+func sweepDust(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SweepDustCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	res := &vhcjson.SweepAccountResult{
-		UnsignedTransaction:       b.String(),
-		TotalPreviousOutputAmount: tx.TotalInput.ToCoin(),
-		TotalOutputAmount:         helpers.SumOutputValues(tx.Tx.TxOut).ToCoin(),
-		EstimatedSignedSize:       uint32(tx.EstimatedSignedSerializeSize),
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	if *cmd.Threshold < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "negative dust threshold")
+	}
+	threshold, err := vhcutil.NewAmount(*cmd.Threshold)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	feePerKb := w.RelayFee()
+	if cmd.FeePerKb != nil {
+		feePerKb, err = vhcutil.NewAmount(*cmd.FeePerKb)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		if feePerKb <= 0 {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "fee rate must be positive")
+		}
 	}
 
+	sweep, err := w.SweepDust(account, threshold, feePerKb)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.SweepDustResult{
+		Inputs:    sweep.Inputs,
+		DustValue: sweep.DustValue.ToCoin(),
+		Fee:       (sweep.DustValue - sweep.Reclaimed).ToCoin(),
+		Reclaimed: sweep.Reclaimed.ToCoin(),
+		TxHashes:  make([]string, len(sweep.TxHashes)),
+	}
+	for i, h := range sweep.TxHashes {
+		res.TxHashes[i] = h.String()
+	}
 	return res, nil
 }
 
 // validateAddress handles the validateaddress command.
-func validateAddress(s *Server, icmd interface{}) (interface{}, error) {
+func validateAddress(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.ValidateAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -3386,8 +6511,10 @@ func validateAddress(s *Server, icmd interface{}) (interface{}, error) {
 }
 
 // verifyMessage handles the verifymessage command by verifying the provided
-// compact signature for the given address and message.
-func verifyMessage(s *Server, icmd interface{}) (interface{}, error) {
+// signature for the given address and message.  Secp256k1 ECDSA signatures are
+// compact signatures, while Ed25519 and secp256k1 Schnorr signatures include
+// the public key of the address.
+func verifyMessage(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.VerifyMessageCmd)
 
 	var valid bool
@@ -3402,14 +6529,13 @@ func verifyMessage(s *Server, icmd interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	// Addresses must have an associated secp256k1 private key and therefore
-	// must be P2PK or P2PKH (P2SH is not allowed).
-	switch a := addr.(type) {
+	// Addresses must have an associated private key and therefore must be
+	// P2PK or P2PKH (P2SH is not allowed).
+	switch addr.(type) {
 	case *vhcutil.AddressSecpPubKey:
+	case *vhcutil.AddressEdwardsPubKey:
+	case *vhcutil.AddressSecSchnorrPubKey:
 	case *vhcutil.AddressPubKeyHash:
-		if a.DSA(a.Net()) != vhcec.STEcdsaSecp256k1 {
-			goto WrongAddrKind
-		}
 	default:
 		goto WrongAddrKind
 	}
@@ -3420,16 +6546,159 @@ func verifyMessage(s *Server, icmd interface{}) (interface{}, error) {
 	return err == nil && valid, nil
 
 WrongAddrKind:
-	return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "address must be secp256k1 P2PK or P2PKH")
+	return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "address must be P2PK or P2PKH")
+}
+
+// createOwnershipProof handles a createownershipproof request by signing a
+// proof that the wallet controls the private keys of addresses and the unspent
+// outputs paying them as of a main chain block, defaulting to the tip.
+func createOwnershipProof(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateOwnershipProofCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addrs := make([]vhcutil.Address, 0, len(cmd.Addresses))
+	for _, a := range cmd.Addresses {
+		addr, err := decodeAddress(a, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	minBalance, err := vhcutil.NewAmount(*cmd.MinBalance)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+
+	_, height := w.MainChainTip()
+	if cmd.BlockHeight != nil {
+		if *cmd.BlockHeight < 0 || *cmd.BlockHeight > height {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"block height %d is not in the main chain", *cmd.BlockHeight)
+		}
+		height = *cmd.BlockHeight
+	}
+
+	proof, err := w.CreateOwnershipProof(*cmd.Challenge, addrs, minBalance, height)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, errAddressNotInWallet
+		}
+		return nil, err
+	}
+
+	result := &types.OwnershipProof{
+		Challenge:   proof.Challenge,
+		BlockHash:   proof.BlockHash.String(),
+		BlockHeight: proof.Height,
+		Addresses:   make([]types.OwnershipProofAddress, len(proof.Addresses)),
+		Outputs:     make([]types.OwnershipProofOutput, len(proof.Outputs)),
+	}
+	for i, addr := range proof.Addresses {
+		result.Addresses[i] = types.OwnershipProofAddress{
+			Address:   addr.EncodeAddress(),
+			Signature: base64.StdEncoding.EncodeToString(proof.Signatures[i]),
+		}
+	}
+	for i := range proof.Outputs {
+		out := &proof.Outputs[i]
+		result.Outputs[i] = types.OwnershipProofOutput{
+			TxID:    out.OutPoint.Hash.String(),
+			Vout:    out.OutPoint.Index,
+			Tree:    out.OutPoint.Tree,
+			Amount:  out.Amount.ToCoin(),
+			Address: out.Address.EncodeAddress(),
+		}
+	}
+	return result, nil
+}
+
+// verifyOwnershipProof handles a verifyownershipproof request by verifying the
+// signatures of an ownership proof, that the claimed outputs were unspent as
+// of the proof block, and that they are worth at least a minimum balance.
+func verifyOwnershipProof(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.VerifyOwnershipProofCmd)
+
+	minBalance, err := vhcutil.NewAmount(*cmd.MinBalance)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+
+	proof := &wallet.OwnershipProof{
+		Challenge:  cmd.Proof.Challenge,
+		Height:     cmd.Proof.BlockHeight,
+		Addresses:  make([]vhcutil.Address, len(cmd.Proof.Addresses)),
+		Signatures: make([][]byte, len(cmd.Proof.Addresses)),
+		Outputs:    make([]wallet.OwnershipProofOutput, len(cmd.Proof.Outputs)),
+	}
+	if err := chainhash.Decode(&proof.BlockHash, cmd.Proof.BlockHash); err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+	for i, a := range cmd.Proof.Addresses {
+		proof.Addresses[i], err = vhcutil.DecodeAddress(a.Address)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidAddressOrKey, err)
+		}
+		proof.Signatures[i], err = base64.StdEncoding.DecodeString(a.Signature)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	for i, o := range cmd.Proof.Outputs {
+		out := &proof.Outputs[i]
+		if err := chainhash.Decode(&out.OutPoint.Hash, o.TxID); err != nil {
+			return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+		}
+		out.OutPoint.Index = o.Vout
+		out.OutPoint.Tree = o.Tree
+		out.Amount, err = vhcutil.NewAmount(o.Amount)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		out.Address, err = vhcutil.DecodeAddress(o.Address)
+		if err != nil {
+			return nil, rpcError(vhcjson.ErrRPCInvalidAddressOrKey, err)
+		}
+	}
+
+	// Mirror verifymessage, which treats all errors as an invalid signature.
+	valid, err := wallet.VerifyOwnershipProof(proof)
+	if err != nil || !valid {
+		return &types.VerifyOwnershipProofResult{}, nil
+	}
+
+	// No balance is reported unless every claimed output is known to the
+	// consensus server to have been unspent as of the proof block.
+	n, ok := s.walletLoader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+	valid, err = wallet.CheckOwnershipProofOutputs(ctx, n, proof)
+	if err != nil {
+		if errors.Is(errors.Invalid, err) {
+			return nil, rpcError(vhcjson.ErrRPCClientNotConnected, err)
+		}
+		return nil, err
+	}
+	if !valid {
+		return &types.VerifyOwnershipProofResult{}, nil
+	}
+	balance := proof.Balance()
+	return &types.VerifyOwnershipProofResult{
+		Valid:   balance >= minBalance,
+		Balance: balance.ToCoin(),
+	}, nil
 }
 
 // version handles the version command by returning the RPC API versions of the
 // wallet and, optionally, the consensus RPC server as well if it is associated
 // with the server.  The chainClient is optional, and this is simply a helper
 // function for the versionWithChainRPC and versionNoChainRPC handlers.
-func version(s *Server, icmd interface{}) (interface{}, error) {
+func version(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	var resp map[string]vhcjson.VersionResult
-	n, _ := s.walletLoader.NetworkBackend()
+	n, _ := s.walletLoader(ctx).NetworkBackend()
 	chainClient, err := chain.RPCClientFromBackend(n)
 	if err == nil {
 		var err error
@@ -3450,17 +6719,24 @@ func version(s *Server, icmd interface{}) (interface{}, error) {
 	return resp, nil
 }
 
+// walletExists handles a walletexists request by returning whether a wallet
+// database exists in the loader's database directory.
+func walletExists(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	return s.walletLoader(ctx).WalletExists()
+}
+
 // walletInfo gets the current information about the wallet. If the daemon
 // is connected and fails to ping, the function will still return that the
 // daemon is disconnected.
-func walletInfo(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func walletInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
 	n, err := w.NetworkBackend()
 	connected := err == nil
+	var gapRecoveries *types.GapRecoveryInfo
 	if connected {
 		chainClient, err := chain.RPCClientFromBackend(n)
 		if err == nil {
@@ -3469,19 +6745,30 @@ func walletInfo(s *Server, icmd interface{}) (interface{}, error) {
 				log.Warnf("Ping failed on connected daemon client: %v", err)
 				connected = false
 			}
+			stats := chain.GapRecoveries()
+			gapRecoveries = &types.GapRecoveryInfo{
+				Recoveries:      stats.Recoveries,
+				RecoveredBlocks: stats.RecoveredBlocks,
+				Failures:        stats.Failures,
+			}
 		}
 	}
 
 	unlocked := !(w.Locked())
 	fi := w.RelayFee()
 	tfi := w.TicketFeeIncrement()
-	tp := s.walletLoader.PurchaseManager() != nil
+	tp := s.walletLoader(ctx).PurchaseManager() != nil
 	voteBits := w.VoteBits()
 	var voteVersion uint32
 	_ = binary.Read(bytes.NewBuffer(voteBits.ExtendedBits[0:4]), binary.LittleEndian, &voteVersion)
 	voting := w.VotingEnabled()
+	check := w.ConsistencyCheck()
+	checkMode := "quick"
+	if check.Full {
+		checkMode = "full"
+	}
 
-	return &vhcjson.WalletInfoResult{
+	return &types.WalletInfoResult{
 		DaemonConnected:  connected,
 		Unlocked:         unlocked,
 		TxFee:            fi.ToCoin(),
@@ -3491,14 +6778,26 @@ func walletInfo(s *Server, icmd interface{}) (interface{}, error) {
 		VoteBitsExtended: hex.EncodeToString(voteBits.ExtendedBits),
 		VoteVersion:      voteVersion,
 		Voting:           voting,
+		Database:         databaseInfo(ctx, w),
+		GapRecoveries:    gapRecoveries,
+		ConsistencyCheck: &types.ConsistencyCheckInfo{
+			Mode:         checkMode,
+			TipHash:      check.TipHash.String(),
+			TipHeight:    check.TipHeight,
+			Blocks:       check.Blocks,
+			Transactions: check.Transactions,
+			Credits:      check.Credits,
+			Accounts:     check.Accounts,
+			DurationMs:   int64(check.Duration / time.Millisecond),
+		},
 	}, nil
 }
 
 // walletIsLocked handles the walletislocked extension request by
 // returning the current lock state (false for unlocked, true for locked)
 // of an account.
-func walletIsLocked(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func walletIsLocked(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -3509,8 +6808,8 @@ func walletIsLocked(s *Server, icmd interface{}) (interface{}, error) {
 // walletLock handles a walletlock request by locking the all account
 // wallets, returning an error if any wallet is not encrypted (for example,
 // a watching-only wallet).
-func walletLock(s *Server, icmd interface{}) (interface{}, error) {
-	w, ok := s.walletLoader.LoadedWallet()
+func walletLock(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -3522,9 +6821,9 @@ func walletLock(s *Server, icmd interface{}) (interface{}, error) {
 // walletPassphrase responds to the walletpassphrase request by unlocking
 // the wallet.  The decryption key is saved in the wallet until timeout
 // seconds expires, after which the wallet is locked.
-func walletPassphrase(s *Server, icmd interface{}) (interface{}, error) {
+func walletPassphrase(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.WalletPassphraseCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -3545,9 +6844,9 @@ func walletPassphrase(s *Server, icmd interface{}) (interface{}, error) {
 //
 // If the old passphrase is correct and the passphrase is changed, all
 // wallets will be immediately locked.
-func walletPassphraseChange(s *Server, icmd interface{}) (interface{}, error) {
+func walletPassphraseChange(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*vhcjson.WalletPassphraseChangeCmd)
-	w, ok := s.walletLoader.LoadedWallet()
+	w, ok := s.walletLoader(ctx).LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
@@ -3563,6 +6862,60 @@ func walletPassphraseChange(s *Server, icmd interface{}) (interface{}, error) {
 	return nil, nil
 }
 
+// watchOutPoint handles a watchoutpoint request by recording the transaction
+// spending an outpoint not owned by the wallet.
+func watchOutPoint(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.WatchOutPointCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, err := chainhash.NewHashFromStr(cmd.TxID)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+	var tree int8
+	if cmd.Tree != nil {
+		tree = *cmd.Tree
+	}
+	if tree != wire.TxTreeRegular && tree != wire.TxTreeStake {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter, "invalid tree %d", tree)
+	}
+	err = w.WatchOutPoint(wire.NewOutPoint(hash, cmd.Vout, tree))
+	if err != nil && !errors.Is(errors.Exist, err) {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// watchScript handles a watchscript request by recording the transactions
+// paying an output script not owned by the wallet.
+func watchScript(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.WatchScriptCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	pkScript, err := hex.DecodeString(cmd.Hex)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+	err = w.WatchScript(pkScript)
+	if err != nil {
+		switch {
+		case errors.Is(errors.Exist, err):
+			// Watching a script twice is not an error.
+			return nil, nil
+		case errors.Is(errors.Invalid, err):
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
 // decodeHexStr decodes the hex encoding of a string, possibly prepending a
 // leading '0' character if there is an odd number of bytes in the hex string.
 // This is to prevent an error for an invalid hex string when using an odd
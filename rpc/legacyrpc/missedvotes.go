@@ -0,0 +1,54 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// listMissedVotes handles a listmissedvotes request by returning the tickets
+// most recently detected to have missed their votes.
+func listMissedVotes(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	missed := w.MissedVotes()
+	res := make([]types.MissedVoteResult, 0, len(missed))
+	for _, m := range missed {
+		res = append(res, types.MissedVoteResult{
+			TicketHash:  m.Ticket.String(),
+			BlockHash:   m.BlockHash.String(),
+			BlockHeight: m.BlockHeight,
+			Detected:    m.Detected.Unix(),
+		})
+	}
+	return res, nil
+}
+
+// notifyMissedVotes sends a missedvote notification to a websocket client for
+// each ticket detected to have missed its vote.
+func notifyMissedVotes(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.MissedVoteNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			for _, m := range v.MissedVotes {
+				ntfn := types.NewMissedVoteNtfn(m.Ticket.String(),
+					m.BlockHash.String(), m.BlockHeight)
+				if wsc.sendNotification(ctx, ntfn) != nil {
+					return
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
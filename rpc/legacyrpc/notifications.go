@@ -0,0 +1,318 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// Subscriptions of websocket clients to wallet notifications.
+const (
+	subscriptionBlocks           = "blocks"
+	subscriptionDepositAddresses = "depositaddresses"
+	subscriptionMissedVotes      = "missedvotes"
+	subscriptionNewTransactions  = "newtransactions"
+	subscriptionRescanProgress   = "rescanprogress"
+	subscriptionRevocations      = "pendingrevocations"
+	subscriptionTickets          = "tickets"
+	subscriptionVoteVersion      = "voteversion"
+	subscriptionWinningTickets   = "winningtickets"
+)
+
+// notificationMethods are the methods which modify the notification
+// subscriptions of a websocket client.
+var notificationMethods = map[string]struct{}{
+	"notifyblocks":                 {},
+	"notifydepositaddresses":       {},
+	"notifymissedvotes":            {},
+	"notifynewtransactions":        {},
+	"notifypendingrevocations":     {},
+	"notifyrescanprogress":         {},
+	"notifytickets":                {},
+	"notifyvoteversion":            {},
+	"notifywinningtickets":         {},
+	"stopnotifyblocks":             {},
+	"stopnotifydepositaddresses":   {},
+	"stopnotifymissedvotes":        {},
+	"stopnotifynewtransactions":    {},
+	"stopnotifypendingrevocations": {},
+	"stopnotifyrescanprogress":     {},
+	"stopnotifytickets":            {},
+	"stopnotifyvoteversion":        {},
+	"stopnotifywinningtickets":     {},
+}
+
+// websocketOnly handles a request for a method which is only available to
+// websocket clients.
+func websocketOnly(*Server, context.Context, interface{}) (interface{}, error) {
+	return nil, rpcErrorf(vhcjson.ErrRPCInvalidRequest.Code,
+		"Method is only available to websocket clients")
+}
+
+// subscribe starts a notification subscription of the client unless the client
+// is already subscribed.  The notify function is run in a new goroutine and
+// must return after the stop channel is closed.
+func (c *websocketClient) subscribe(subscription string, notify func(stop <-chan struct{})) {
+	c.ntfnMu.Lock()
+	defer c.ntfnMu.Unlock()
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[string]chan struct{})
+	}
+	if _, ok := c.subscriptions[subscription]; ok {
+		return
+	}
+	stop := make(chan struct{})
+	c.subscriptions[subscription] = stop
+	c.wg.Add(1)
+	go func() {
+		notify(stop)
+		c.wg.Done()
+	}()
+}
+
+// unsubscribe stops a notification subscription of the client.
+func (c *websocketClient) unsubscribe(subscription string) {
+	c.ntfnMu.Lock()
+	if stop, ok := c.subscriptions[subscription]; ok {
+		close(stop)
+		delete(c.subscriptions, subscription)
+	}
+	c.ntfnMu.Unlock()
+}
+
+// unsubscribeAll stops every notification subscription of the client.
+func (c *websocketClient) unsubscribeAll() {
+	c.ntfnMu.Lock()
+	for subscription, stop := range c.subscriptions {
+		close(stop)
+		delete(c.subscriptions, subscription)
+	}
+	c.ntfnMu.Unlock()
+}
+
+// sendNotification marshals and sends a notification to the client.
+func (c *websocketClient) sendNotification(ctx context.Context, ntfn interface{}) error {
+	mntfn, err := vhcjson.MarshalCmd("1.0", nil, ntfn)
+	if err != nil {
+		log.Errorf("Unable to marshal notification to client %s: %v",
+			remoteAddr(ctx), err)
+		return nil
+	}
+	return c.send(mntfn)
+}
+
+// websocketNotificationRequest handles a request from a websocket client to
+// start or stop receiving notifications.
+func (s *Server) websocketNotificationRequest(ctx context.Context, wsc *websocketClient, req *vhcjson.Request) *vhcjson.RPCError {
+	log.Infof("RPC method %v invoked by %v", req.Method, clientString(ctx))
+
+	_, err := vhcjson.UnmarshalCmd(req)
+	if err != nil {
+		return rpcError(vhcjson.ErrRPCInvalidParameter, err)
+	}
+	ctx, rpcErr := s.withWalletLoader(ctx)
+	if rpcErr != nil {
+		return rpcErr
+	}
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return errUnloadedWallet
+	}
+
+	switch req.Method {
+	case "notifyblocks":
+		wsc.subscribe(subscriptionBlocks, func(stop <-chan struct{}) {
+			notifyBlocks(ctx, wsc, w, stop)
+		})
+	case "stopnotifyblocks":
+		wsc.unsubscribe(subscriptionBlocks)
+	case "notifynewtransactions":
+		wsc.subscribe(subscriptionNewTransactions, func(stop <-chan struct{}) {
+			notifyNewTransactions(ctx, wsc, w, stop)
+		})
+	case "stopnotifynewtransactions":
+		wsc.unsubscribe(subscriptionNewTransactions)
+	case "notifydepositaddresses":
+		wsc.subscribe(subscriptionDepositAddresses, func(stop <-chan struct{}) {
+			notifyDepositAddresses(ctx, wsc, w, stop)
+		})
+	case "stopnotifydepositaddresses":
+		wsc.unsubscribe(subscriptionDepositAddresses)
+	case "notifymissedvotes":
+		wsc.subscribe(subscriptionMissedVotes, func(stop <-chan struct{}) {
+			notifyMissedVotes(ctx, wsc, w, stop)
+		})
+	case "stopnotifymissedvotes":
+		wsc.unsubscribe(subscriptionMissedVotes)
+	case "notifypendingrevocations":
+		wsc.subscribe(subscriptionRevocations, func(stop <-chan struct{}) {
+			notifyPendingRevocations(ctx, wsc, w, stop)
+		})
+	case "stopnotifypendingrevocations":
+		wsc.unsubscribe(subscriptionRevocations)
+	case "notifyrescanprogress":
+		wsc.subscribe(subscriptionRescanProgress, func(stop <-chan struct{}) {
+			notifyRescanProgress(ctx, wsc, w, stop)
+		})
+	case "stopnotifyrescanprogress":
+		wsc.unsubscribe(subscriptionRescanProgress)
+	case "notifytickets":
+		wsc.subscribe(subscriptionTickets, func(stop <-chan struct{}) {
+			notifyTickets(ctx, wsc, w, stop)
+		})
+	case "stopnotifytickets":
+		wsc.unsubscribe(subscriptionTickets)
+	case "notifyvoteversion":
+		wsc.subscribe(subscriptionVoteVersion, func(stop <-chan struct{}) {
+			notifyVoteVersion(ctx, wsc, w, stop)
+		})
+	case "stopnotifyvoteversion":
+		wsc.unsubscribe(subscriptionVoteVersion)
+	case "notifywinningtickets":
+		wsc.subscribe(subscriptionWinningTickets, func(stop <-chan struct{}) {
+			notifyWinningTickets(ctx, wsc, w, stop)
+		})
+	case "stopnotifywinningtickets":
+		wsc.unsubscribe(subscriptionWinningTickets)
+	}
+	return nil
+}
+
+// notifyBlocks sends blockconnected and blockdisconnected notifications to a
+// websocket client as blocks are processed by the wallet.  The subscribed
+// transactions of connected blocks are the wallet's relevant transactions.
+func notifyBlocks(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.TransactionNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			for _, hash := range v.DetachedBlocks {
+				header, err := w.BlockHeader(hash)
+				if err != nil {
+					log.Warnf("Cannot notify disconnected block %v: %v", hash, err)
+					continue
+				}
+				var buf bytes.Buffer
+				err = header.Serialize(&buf)
+				if err != nil {
+					log.Errorf("Cannot serialize block header %v: %v", hash, err)
+					continue
+				}
+				ntfn := vhcjson.NewBlockDisconnectedNtfn(hex.EncodeToString(buf.Bytes()))
+				if wsc.sendNotification(ctx, ntfn) != nil {
+					return
+				}
+			}
+			for _, b := range v.AttachedBlocks {
+				var buf bytes.Buffer
+				err := b.Header.Serialize(&buf)
+				if err != nil {
+					log.Errorf("Cannot serialize block header %v: %v",
+						b.Header.BlockHash(), err)
+					continue
+				}
+				txs := make([]string, 0, len(b.Transactions))
+				for _, tx := range b.Transactions {
+					txs = append(txs, hex.EncodeToString(tx.Transaction))
+				}
+				ntfn := vhcjson.NewBlockConnectedNtfn(hex.EncodeToString(buf.Bytes()), txs)
+				if wsc.sendNotification(ctx, ntfn) != nil {
+					return
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// notifyNewTransactions sends a newtx notification to a websocket client for
+// each listtransactions result of transactions added to the wallet, either
+// unmined or mined in an attached block.
+func notifyNewTransactions(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.TransactionNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			var hashes []*chainhash.Hash
+			for _, b := range v.AttachedBlocks {
+				for i := range b.Transactions {
+					hashes = append(hashes, b.Transactions[i].Hash)
+				}
+			}
+			for i := range v.UnminedTransactions {
+				hashes = append(hashes, v.UnminedTransactions[i].Hash)
+			}
+			for _, hash := range hashes {
+				details, err := w.ListTransactionDetails(hash)
+				if err != nil {
+					log.Warnf("Cannot notify new transaction %v: %v", hash, err)
+					continue
+				}
+				for _, d := range details {
+					ntfn := vhcjson.NewNewTxNtfn(d.Account, d)
+					if wsc.sendNotification(ctx, ntfn) != nil {
+						return
+					}
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// notifyWinningTickets sends a winningtickets notification to a websocket
+// client whenever tickets owned by the wallet are selected to vote.
+func notifyWinningTickets(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.WinningTicketsNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			tickets := make(map[string]string, len(v.Tickets))
+			for i, hash := range v.Tickets {
+				tickets[strconv.Itoa(i)] = hash.String()
+			}
+			ntfn := vhcjson.NewWinningTicketsNtfn(v.BlockHash.String(),
+				v.BlockHeight, tickets)
+			if wsc.sendNotification(ctx, ntfn) != nil {
+				return
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// notifyVoteVersion sends a voteversion notification to a websocket client
+// whenever the votes cast by the wallet become outdated or compatible with the
+// stake version of the network.
+func notifyVoteVersion(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.VoteVersionNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			ntfn := types.NewVoteVersionNtfn(v.VoteVersion,
+				v.ChainStakeVersion, v.Outdated, v.Warning())
+			if wsc.sendNotification(ctx, ntfn) != nil {
+				return
+			}
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/chain"
+	"github.com/valhallacoin/vhcwallet/p2p"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+)
+
+// peerLister is implemented by network backends which synchronize the wallet
+// with peers of the Valhalla network.
+type peerLister interface {
+	Peers() []*p2p.RemotePeer
+}
+
+// getPeerInfo handles a getpeerinfo request.  When the wallet is synchronized
+// using SPV, the peers of the wallet and their negotiated capabilities are
+// returned.  Otherwise, the request is passed through to the consensus RPC
+// server.
+func getPeerInfo(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	n, ok := s.walletLoader(ctx).NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+	if chainClient, err := chain.RPCClientFromBackend(n); err == nil {
+		resp, err := chainClient.RawRequest("getpeerinfo", nil)
+		if err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+	lister, ok := n.(peerLister)
+	if !ok {
+		return nil, rpcErrorf(vhcjson.ErrRPCClientNotConnected,
+			"network backend does not provide peer information")
+	}
+
+	peers := lister.Peers()
+	res := make([]types.GetPeerInfoResult, 0, len(peers))
+	for _, rp := range peers {
+		var capabilities []string
+		c := rp.Capabilities()
+		if c.CFilters {
+			capabilities = append(capabilities, "cfilters")
+		}
+		if c.SendHeaders {
+			capabilities = append(capabilities, "sendheaders")
+		}
+		if c.FeeFilter {
+			capabilities = append(capabilities, "feefilter")
+		}
+		res = append(res, types.GetPeerInfoResult{
+			ID:              rp.ID(),
+			Addr:            rp.RemoteAddr().String(),
+			Services:        fmt.Sprintf("%08d", uint64(rp.Services())),
+			ServiceNames:    rp.Services().String(),
+			Version:         rp.AdvertisedPver(),
+			ProtocolVersion: rp.Pver(),
+			SubVer:          rp.UA(),
+			ConnTime:        rp.ConnectedTime().Unix(),
+			StartingHeight:  rp.InitialHeight(),
+			BanScore:        rp.BanScore(),
+			Capabilities:    capabilities,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"math"
+	"net"
+	"sync"
+	"time"
+)
+
+// maxIdleLimits is the number of tracked clients above which the limits of
+// idle clients are forgotten.
+const maxIdleLimits = 1024
+
+// clientLimit records the request token bucket and active requests of a
+// single client.
+type clientLimit struct {
+	tokens float64
+	last   time.Time
+	active int
+}
+
+// rateLimiter limits the request rate and the number of concurrent requests
+// of each client.  A zero rate or maximum disables the respective limit.
+type rateLimiter struct {
+	rate      float64 // Requests per second
+	burst     float64
+	maxActive int
+
+	mu      sync.Mutex
+	clients map[string]*clientLimit
+}
+
+// newRateLimiter creates a rateLimiter.  Without a configured burst, clients
+// may burst up to one second of requests.
+func newRateLimiter(rate float64, burst, maxActive int) *rateLimiter {
+	b := float64(burst)
+	if b < 1 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+	return &rateLimiter{
+		rate:      rate,
+		burst:     b,
+		maxActive: maxActive,
+		clients:   make(map[string]*clientLimit),
+	}
+}
+
+// enabled returns whether any limit is applied.
+func (l *rateLimiter) enabled() bool {
+	return l != nil && (l.rate > 0 || l.maxActive > 0)
+}
+
+// acquire returns whether the client may begin another request at time now.
+// When true is returned, release must be called after the request completes.
+func (l *rateLimiter) acquire(client string, now time.Time) bool {
+	if !l.enabled() {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c, ok := l.clients[client]
+	if !ok {
+		if len(l.clients) >= maxIdleLimits {
+			l.forgetIdle(now)
+		}
+		c = &clientLimit{tokens: l.burst, last: now}
+		l.clients[client] = c
+	}
+	if l.rate > 0 {
+		c.tokens += now.Sub(c.last).Seconds() * l.rate
+		if c.tokens > l.burst {
+			c.tokens = l.burst
+		}
+		c.last = now
+		if c.tokens < 1 {
+			return false
+		}
+	}
+	if l.maxActive > 0 && c.active >= l.maxActive {
+		return false
+	}
+	if l.rate > 0 {
+		c.tokens--
+	}
+	c.active++
+	return true
+}
+
+// charge takes a request token from a client with an active request for each
+// additional request handled by it, such as the elements of a batch after the
+// first.  It returns false without taking a token when the bucket is empty.
+func (l *rateLimiter) charge(client string, now time.Time) bool {
+	if l == nil || l.rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c, ok := l.clients[client]
+	if !ok {
+		return false
+	}
+	c.tokens += now.Sub(c.last).Seconds() * l.rate
+	if c.tokens > l.burst {
+		c.tokens = l.burst
+	}
+	c.last = now
+	if c.tokens < 1 {
+		return false
+	}
+	c.tokens--
+	return true
+}
+
+// release ends a request of the client begun by a successful acquire.
+func (l *rateLimiter) release(client string) {
+	if !l.enabled() {
+		return
+	}
+	l.mu.Lock()
+	if c, ok := l.clients[client]; ok && c.active > 0 {
+		c.active--
+	}
+	l.mu.Unlock()
+}
+
+// forgetIdle removes clients without active requests whose token buckets have
+// refilled, as they are indistinguishable from clients never seen before.
+// The mutex must be held.
+func (l *rateLimiter) forgetIdle(now time.Time) {
+	for client, c := range l.clients {
+		if c.active != 0 {
+			continue
+		}
+		if l.rate > 0 && c.tokens+now.Sub(c.last).Seconds()*l.rate < l.burst {
+			continue
+		}
+		delete(l.clients, client)
+	}
+}
+
+// rateLimitKey returns the name which the rate limits of the client of a
+// request are tracked by.  Unless limits are applied by source IP, clients
+// authenticated by a certificate or username share limits with other
+// connections using the same certificate or credentials.
+func (s *Server) rateLimitKey(ctx context.Context) string {
+	if !s.rateLimitByIP {
+		if identity, ok := ctx.Value(contextKey("cert-identity")).(string); ok {
+			return "cert " + identity
+		}
+		if username, ok := ctx.Value(contextKey("username")).(string); ok {
+			return "user " + username
+		}
+	}
+	addr := remoteAddr(ctx)
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "ip " + addr
+	}
+	return "ip " + host
+}
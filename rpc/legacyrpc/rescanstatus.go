@@ -0,0 +1,106 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// rescanAsync starts a rescan of the wallet from a block height in a new
+// goroutine.  The rescan is aborted when the server is stopped or by the
+// cancelrescan method.
+func (s *Server) rescanAsync(w *wallet.Wallet, n wallet.NetworkBackend, height int32) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-s.quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		err := w.RescanFromHeight(ctx, n, height, nil)
+		if err != nil && !errors.Match(errors.E(context.Canceled), err) {
+			log.Errorf("Rescan from block height %d failed: %v", height, err)
+		}
+	}()
+}
+
+// cancelRescan handles a cancelrescan request by aborting the rescans started
+// by rescanwallet and by importing keys and scripts.
+func cancelRescan(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.CancelRescan()
+	if errors.Is(errors.NotExist, err) {
+		return nil, rpcError(vhcjson.ErrRPCInvalidRequest.Code, err)
+	}
+	return nil, err
+}
+
+// getRescanStatus handles a getrescanstatus request by returning the progress
+// of the current or most recent rescan, or nil if the wallet has not been
+// rescanned.
+func getRescanStatus(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	status := w.RescanStatus()
+	if status == nil {
+		return nil, nil
+	}
+	res := &types.GetRescanStatusResult{
+		Rescanning:          !status.Done,
+		StartHeight:         status.StartHeight,
+		TargetHeight:        status.TargetHeight,
+		ScannedThrough:      status.ScannedThrough,
+		Percent:             status.Percent(),
+		AddressesDiscovered: status.AddressesDiscovered,
+		Started:             status.Started.Unix(),
+	}
+	if status.Err != nil {
+		res.Error = status.Err.Error()
+	}
+	return res, nil
+}
+
+// notifyRescanProgress sends a rescanprogress notification to a websocket
+// client as each range of blocks is scanned by a rescan and when each rescan
+// ends.
+func notifyRescanProgress(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.RescanNotifications()
+	defer n.Done()
+	for {
+		select {
+		case s := <-n.C:
+			var errStr string
+			if s.Err != nil {
+				errStr = s.Err.Error()
+			}
+			ntfn := types.NewRescanProgressNtfn(s.StartHeight, s.TargetHeight,
+				s.ScannedThrough, s.Percent(), s.AddressesDiscovered, s.Done, errStr)
+			if wsc.sendNotification(ctx, ntfn) != nil {
+				return
+			}
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,441 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/internal/features"
+	"github.com/valhallacoin/vhcwallet/wallet"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+// restPathPrefix is the path prefix of every resource of the REST gateway.
+const restPathPrefix = "/v1/"
+
+// Pagination limits of REST collections.
+const (
+	restDefaultLimit = 100
+	restMaxLimit     = 1000
+)
+
+// restRoute describes a collection served by the REST gateway.  Requests are
+// authorized as calls of the legacy method, so clients may read a collection
+// only if their credentials permit calling the method.
+type restRoute struct {
+	method string
+	fn     func(s *Server, w *wallet.Wallet, query url.Values, page *restPage) error
+}
+
+var restRoutes = map[string]restRoute{
+	restPathPrefix + "accounts":     {"listaccounts", restAccounts},
+	restPathPrefix + "transactions": {"listalltransactions", restTransactions},
+	restPathPrefix + "tickets":      {"gettickets", restTickets},
+}
+
+// restPage is the response to a successful request of a collection.  Data
+// holds up to Limit items matching the request filters, beginning with the
+// item identified by the cursor parameter of the request.  When more items
+// follow, Next is the cursor parameter of the request for the next page.
+type restPage struct {
+	Data  []interface{} `json:"data"`
+	Limit int           `json:"limit"`
+	Next  string        `json:"next,omitempty"`
+
+	cursor string
+}
+
+// add appends an item to the page, returning false without appending when the
+// page is full.  The cursor of the item is recorded as the next page cursor
+// when the page is full.
+func (p *restPage) add(item interface{}, cursor string) bool {
+	if len(p.Data) == p.Limit {
+		p.Next = cursor
+		return false
+	}
+	p.Data = append(p.Data, item)
+	return true
+}
+
+// restError is the response to a failed request.  The code is the JSON-RPC
+// error code the request would fail with if made as a legacy RPC call.
+type restError struct {
+	Error struct {
+		Code    vhcjson.RPCErrorCode `json:"code"`
+		Message string               `json:"message"`
+		Data    interface{}          `json:"data,omitempty"`
+	} `json:"error"`
+}
+
+// writeRESTError writes a JSON error response with an HTTP status describing
+// the JSON-RPC error code.
+func writeRESTError(w http.ResponseWriter, rpcErr *vhcjson.RPCError) {
+	status := http.StatusInternalServerError
+	switch rpcErr.Code {
+	case vhcjson.ErrRPCInvalidParameter, vhcjson.ErrRPCInvalidParams.Code:
+		status = http.StatusBadRequest
+	case vhcjson.ErrRPCInvalidRequest.Code:
+		status = http.StatusForbidden
+	case vhcjson.ErrRPCMethodNotFound.Code:
+		status = http.StatusNotFound
+	case errRPCFeatureDisabled:
+		status = http.StatusServiceUnavailable
+	}
+	if rpcErr == errUnloadedWallet {
+		status = http.StatusServiceUnavailable
+	}
+	var resp restError
+	resp.Error.Code = rpcErr.Code
+	resp.Error.Message = rpcErr.Message
+	resp.Error.Data = takeErrorData(rpcErr)
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(&resp)
+	if err != nil {
+		log.Warnf("Failed to write REST response: %v", err)
+	}
+}
+
+// restHandler serves GET requests of the REST gateway collections.  Clients
+// are authenticated and rate limited in the same manner as HTTP POST JSON-RPC
+// clients.
+func (s *Server) restHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx, err := s.authenticateHTTP(r)
+	if err != nil {
+		log.Warnf("Failed authentication attempt from client %s",
+			r.RemoteAddr)
+		jsonAuthFail(w)
+		return
+	}
+	if !s.features.Enabled(features.REST) {
+		writeRESTError(w, rpcErrorf(errRPCFeatureDisabled,
+			"the REST gateway requires the disabled %s feature", features.REST))
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "405 Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	route, ok := restRoutes[r.URL.Path]
+	if !ok {
+		writeRESTError(w, rpcErrorf(vhcjson.ErrRPCMethodNotFound.Code,
+			"unknown resource %s", r.URL.Path))
+		return
+	}
+	if rpcErr := s.checkRole(ctx, route.method); rpcErr != nil {
+		log.Warnf("REST resource %s refused for %s credentials of client %s",
+			r.URL.Path, roleFromContext(ctx), clientString(ctx))
+		writeRESTError(w, rpcErr)
+		return
+	}
+
+	key := s.rateLimitKey(ctx)
+	if !s.limiter.acquire(key, time.Now()) {
+		log.Warnf("Rate limited request from client %s", clientString(ctx))
+		http.Error(w, "429 Too Many Requests", 429)
+		return
+	}
+	s.wg.Add(1)
+	defer func() {
+		s.wg.Done()
+		s.limiter.release(key)
+	}()
+
+	log.Infof("REST resource %s requested by %v", r.URL.Path, clientString(ctx))
+	query := r.URL.Query()
+	page, err := func() (*restPage, error) {
+		wallet, ok := s.walletLoader(ctx).LoadedWallet()
+		if !ok {
+			return nil, errUnloadedWallet
+		}
+		page, err := newRESTPage(query)
+		if err != nil {
+			return nil, err
+		}
+		err = route.fn(s, wallet, query, page)
+		if err != nil {
+			return nil, err
+		}
+		return page, nil
+	}()
+	if err != nil {
+		writeRESTError(w, convertError(err))
+		return
+	}
+	err = json.NewEncoder(w).Encode(page)
+	if err != nil {
+		log.Warnf("Failed to write REST response to client %s: %v",
+			r.RemoteAddr, err)
+	}
+}
+
+// authenticateHTTP authenticates a client by the HTTP Basic authentication of
+// the request, or if missing, by its TLS client certificate.  The returned
+// context records the remote address, identity, and role of the client.
+func (s *Server) authenticateHTTP(r *http.Request) (context.Context, error) {
+	ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
+	role, username, err := s.checkAuthHeader(r)
+	switch err {
+	case nil:
+		ctx = withUsername(ctx, username)
+	case errNoAuth:
+		var identity string
+		var ok bool
+		role, identity, ok = s.authenticateCert(r.TLS)
+		if !ok {
+			return nil, err
+		}
+		ctx = withCertIdentity(ctx, identity)
+	default:
+		return nil, err
+	}
+	return withRole(ctx, role), nil
+}
+
+// newRESTPage returns an empty page with the cursor and limit of the query
+// parameters.  Collections are read from the wallet beginning at the cursor,
+// so the cost of a request does not grow with the position of the page.
+func newRESTPage(query url.Values) (*restPage, error) {
+	limit, err := restIntParam(query, "limit", restDefaultLimit)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 1 || limit > restMaxLimit {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"limit must be between 1 and %d", restMaxLimit)
+	}
+	page := &restPage{
+		Data:   []interface{}{},
+		Limit:  limit,
+		cursor: query.Get("cursor"),
+	}
+	return page, nil
+}
+
+// restTxCursor formats the cursor of the transaction at c, skipping the first
+// skip items of the transaction.
+func restTxCursor(c *udb.TxCursor, skip int) string {
+	return fmt.Sprintf("%d:%v:%d", c.Height, &c.Hash, skip)
+}
+
+// parseRESTTxCursor parses a cursor formatted by restTxCursor.  A nil cursor
+// is returned for the empty string.
+func parseRESTTxCursor(s string) (*udb.TxCursor, int, error) {
+	if s == "" {
+		return nil, 0, nil
+	}
+	invalid := rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+		"invalid cursor parameter %q", s)
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return nil, 0, invalid
+	}
+	height, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil || height < -1 {
+		return nil, 0, invalid
+	}
+	hash, err := chainhash.NewHashFromStr(parts[1])
+	if err != nil {
+		return nil, 0, invalid
+	}
+	skip, err := strconv.Atoi(parts[2])
+	if err != nil || skip < 0 {
+		return nil, 0, invalid
+	}
+	return &udb.TxCursor{Height: int32(height), Hash: *hash}, skip, nil
+}
+
+// restIntParam returns the integer value of a query parameter, or def if the
+// parameter is not set.
+func restIntParam(query url.Values, name string, def int) (int, error) {
+	v := query.Get(name)
+	if v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"invalid %s parameter %q", name, v)
+	}
+	return i, nil
+}
+
+// restAccount describes an account in the accounts collection.
+type restAccount struct {
+	Number    uint32  `json:"number"`
+	Name      string  `json:"name"`
+	Total     float64 `json:"total"`
+	Spendable float64 `json:"spendable"`
+}
+
+// restAccounts returns the accounts of the wallet, sorted by account number.
+// The cursor is the number of the first account of the page.  The minconf
+// parameter sets the confirmations required for spendable balances, and
+// accounts with a zero total balance are omitted when the nonzero parameter is
+// true.
+func restAccounts(s *Server, w *wallet.Wallet, query url.Values, page *restPage) error {
+	minConf, err := restIntParam(query, "minconf", 1)
+	if err != nil {
+		return err
+	}
+	if minConf < 0 {
+		return rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"minconf must be non-negative")
+	}
+	nonZero := query.Get("nonzero") == "true"
+	var first uint64
+	if page.cursor != "" {
+		first, err = strconv.ParseUint(page.cursor, 10, 32)
+		if err != nil {
+			return rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"invalid cursor parameter %q", page.cursor)
+		}
+	}
+
+	res, err := w.Accounts()
+	if err != nil {
+		return err
+	}
+	bals, err := w.CalculateAccountBalances(int32(minConf))
+	if err != nil {
+		return err
+	}
+	for i := range res.Accounts {
+		a := &res.Accounts[i]
+		if uint64(a.AccountNumber) < first {
+			continue
+		}
+		if nonZero && a.TotalBalance == 0 {
+			continue
+		}
+		var spendable vhcutil.Amount
+		if b, ok := bals[a.AccountNumber]; ok {
+			spendable = b.Spendable
+		}
+		account := &restAccount{
+			Number:    a.AccountNumber,
+			Name:      a.AccountName,
+			Total:     a.TotalBalance.ToCoin(),
+			Spendable: spendable.ToCoin(),
+		}
+		if !page.add(account, strconv.FormatUint(uint64(a.AccountNumber), 10)) {
+			break
+		}
+	}
+	return nil
+}
+
+// restTransactions returns the transaction details of the wallet, newest
+// first, in the form of listtransactions results.  The account, category,
+// and txtype parameters filter the details by their fields of the same name.
+func restTransactions(s *Server, w *wallet.Wallet, query url.Values, page *restPage) error {
+	account := query.Get("account")
+	category := query.Get("category")
+	txType := query.Get("txtype")
+	cursor, skip, err := parseRESTTxCursor(page.cursor)
+	if err != nil {
+		return err
+	}
+
+	return w.RangeListTransactions(cursor, func(c *udb.TxCursor, txs []vhcjson.ListTransactionsResult) (bool, error) {
+		i := 0
+		if cursor != nil && *c == *cursor {
+			i = skip
+		}
+		for ; i < len(txs); i++ {
+			tx := &txs[i]
+			if account != "" && tx.Account != account {
+				continue
+			}
+			if category != "" && tx.Category != category {
+				continue
+			}
+			if txType != "" && (tx.TxType == nil || string(*tx.TxType) != txType) {
+				continue
+			}
+			if !page.add(tx, restTxCursor(c, i)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// restTicket describes a ticket in the tickets collection.  The block fields
+// are empty for unmined tickets, and the spender is set for voted and revoked
+// tickets.
+type restTicket struct {
+	Hash        string `json:"hash"`
+	Status      string `json:"status"`
+	BlockHash   string `json:"blockhash,omitempty"`
+	BlockHeight int32  `json:"blockheight,omitempty"`
+	Spender     string `json:"spender,omitempty"`
+}
+
+var restTicketStatuses = map[wallet.TicketStatus]string{
+	wallet.TicketStatusUnknown:  "unknown",
+	wallet.TicketStatusUnmined:  "unmined",
+	wallet.TicketStatusImmature: "immature",
+	wallet.TicketStatusLive:     "live",
+	wallet.TicketStatusVoted:    "voted",
+	wallet.TicketStatusRevoked:  "revoked",
+	wallet.TicketStatusMissed:   "missed",
+	wallet.TicketStatusExpired:  "expired",
+}
+
+// restTickets returns the tickets of the wallet, newest first.  The status
+// parameter filters tickets by their status.  Missed tickets are only
+// reported when the wallet detected the missed vote, since determining
+// whether any other ticket was missed requires querying the consensus server
+// for every ticket.
+func restTickets(s *Server, w *wallet.Wallet, query url.Values, page *restPage) error {
+	status := query.Get("status")
+	if status != "" {
+		known := false
+		for _, name := range restTicketStatuses {
+			known = known || name == status
+		}
+		if !known {
+			return rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"unknown ticket status %q", status)
+		}
+	}
+	cursor, _, err := parseRESTTxCursor(page.cursor)
+	if err != nil {
+		return err
+	}
+
+	return w.RangeTickets(cursor, func(c *udb.TxCursor, t *wallet.TicketSummary, header *wire.BlockHeader) (bool, error) {
+		name := restTicketStatuses[t.Status]
+		if status != "" && name != status {
+			return false, nil
+		}
+		ticket := &restTicket{
+			Hash:   t.Ticket.Hash.String(),
+			Status: name,
+		}
+		if header != nil {
+			ticket.BlockHash = header.BlockHash().String()
+			ticket.BlockHeight = int32(header.Height)
+		}
+		if t.Spender != nil {
+			ticket.Spender = t.Spender.Hash.String()
+		}
+		return !page.add(ticket, restTxCursor(c, 0)), nil
+	})
+}
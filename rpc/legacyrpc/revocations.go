@@ -0,0 +1,105 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// listPendingRevocations handles a listpendingrevocations request by returning
+// the missed tickets whose automatic revocations are delayed.
+func listPendingRevocations(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	pending := w.PendingRevocations()
+	res := make([]types.PendingRevocationResult, 0, len(pending))
+	for _, p := range pending {
+		res = append(res, types.PendingRevocationResult{
+			TicketHash: p.Ticket.String(),
+			Reported:   p.Reported.Unix(),
+			Scheduled:  p.Scheduled.Unix(),
+		})
+	}
+	return res, nil
+}
+
+// listRevocableTickets handles a listrevocabletickets request by returning the
+// unrevoked missed and expired tickets of the wallet with the estimated fees of
+// their revocations.
+func listRevocableTickets(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	revocable, err := w.RevocableTickets()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.RevocableTicketResult, 0, len(revocable))
+	for _, r := range revocable {
+		res = append(res, types.RevocableTicketResult{
+			TicketHash:  r.Ticket.String(),
+			Status:      restTicketStatuses[r.Status],
+			BlockHeight: r.BlockHeight,
+			Fee:         r.Fee.ToCoin(),
+		})
+	}
+	return res, nil
+}
+
+// cancelRevocation handles a cancelrevocation request by canceling the pending
+// automatic revocation of a missed ticket.
+func cancelRevocation(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CancelRevocationCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	ticketHash, err := chainhash.NewHashFromStr(cmd.TicketHash)
+	if err != nil {
+		return nil, rpcError(vhcjson.ErrRPCDecodeHexString, err)
+	}
+	err = w.CancelRevocation(ticketHash)
+	if err != nil {
+		if errors.Is(errors.NotExist, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// notifyPendingRevocations sends a pendingrevocation notification to a
+// websocket client for each missed ticket whose automatic revocation is
+// delayed.
+func notifyPendingRevocations(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.PendingRevocationNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			for _, p := range v.Revocations {
+				ntfn := types.NewPendingRevocationNtfn(p.Ticket.String(),
+					p.Scheduled.Unix())
+				if wsc.sendNotification(ctx, ntfn) != nil {
+					return
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
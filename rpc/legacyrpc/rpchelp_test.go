@@ -16,17 +16,20 @@
 package legacyrpc
 
 import (
+	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
 	"github.com/valhallacoin/vhcd/vhcjson"
 	"github.com/valhallacoin/vhcwallet/internal/rpchelp"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
 )
 
 func serverMethods() map[string]struct{} {
 	m := make(map[string]struct{})
 	for method, handlerData := range handlers {
-		if !handlerData.noHelp {
+		if !handlerData.noHelp && handlerData.aliasOf == "" {
 			m[method] = struct{}{}
 		}
 	}
@@ -108,3 +111,37 @@ func TestRPCMethodUsageGeneration(t *testing.T) {
 		needsGenerate = usages != requestUsages
 	}
 }
+
+// TestRPCMethodSchemaGeneration ensures that an OpenRPC method object can be
+// generated for every method of the RPC server and that the generated schema
+// document is valid JSON.
+func TestRPCMethodSchemaGeneration(t *testing.T) {
+	schemas, err := rpchelp.GenerateMethodSchemas(rpchelp.HelpDescs[0].Descs)
+	if err != nil {
+		t.Fatalf("Cannot generate method schemas: %v", err)
+	}
+
+	svrMethods := serverMethods()
+	for _, s := range schemas {
+		delete(svrMethods, s.Name)
+	}
+	for m := range svrMethods {
+		t.Errorf("Missing schema for method '%s'", m)
+	}
+
+	b, err := json.Marshal(schemas)
+	if err != nil {
+		t.Fatalf("Cannot marshal method schemas: %v", err)
+	}
+	if string(b) != apiSchemaMethods {
+		t.Error("Generated API schema is out of date: run 'go generate'")
+	}
+
+	res, err := getAPISchema(nil, context.Background(), &types.GetAPISchemaCmd{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := json.Marshal(res); err != nil {
+		t.Errorf("Cannot marshal getapischema result: %v", err)
+	}
+}
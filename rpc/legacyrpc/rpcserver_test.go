@@ -6,10 +6,45 @@
 package legacyrpc
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/chaingen"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/gcs/blockcf"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/chain"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/internal/features"
+	"github.com/valhallacoin/vhcwallet/loader"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/ticketbuyer"
+	"github.com/valhallacoin/vhcwallet/wallet"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
 )
 
 func TestThrottle(t *testing.T) {
@@ -52,3 +87,1729 @@ func TestThrottle(t *testing.T) {
 		t.Fatalf("status codes: want: %v, got: %v", want, got)
 	}
 }
+
+func TestCORS(t *testing.T) {
+	p := newCORSPolicy([]string{"https://wallet.example.com"})
+	h := corsFn(p, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		method, origin string
+		preflight      bool
+		status         int
+		allowOrigin    string
+	}{
+		{"POST", "", false, http.StatusOK, ""},
+		{"POST", "https://wallet.example.com", false, http.StatusOK, "https://wallet.example.com"},
+		{"POST", "https://evil.example.com", false, http.StatusOK, ""},
+		{"OPTIONS", "https://wallet.example.com", true, http.StatusNoContent, "https://wallet.example.com"},
+		{"OPTIONS", "https://evil.example.com", true, http.StatusForbidden, ""},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(test.method, "/", nil)
+		if test.origin != "" {
+			r.Header.Set("Origin", test.origin)
+		}
+		if test.preflight {
+			r.Header.Set("Access-Control-Request-Method", "POST")
+		}
+		w := httptest.NewRecorder()
+		h(w, r)
+		if w.Code != test.status {
+			t.Errorf("%s from %q: status %d, want %d", test.method, test.origin, w.Code, test.status)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != test.allowOrigin {
+			t.Errorf("%s from %q: allowed origin %q, want %q", test.method, test.origin, got, test.allowOrigin)
+		}
+	}
+
+	r := httptest.NewRequest("GET", "/ws", nil)
+	r.Header.Set("Origin", "https://evil.example.com")
+	if p.checkWebsocketOrigin(r) {
+		t.Error("websocket accepted from disallowed origin")
+	}
+	var none *corsPolicy
+	if !none.checkWebsocketOrigin(r) {
+		t.Error("websocket refused without CORS policy")
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1e9, 0)
+
+	l := newRateLimiter(2, 0, 0)
+	for i := 0; i < 2; i++ {
+		if !l.acquire("a", now) {
+			t.Fatalf("request %d within burst was limited", i)
+		}
+		l.release("a")
+	}
+	if l.acquire("a", now) {
+		t.Fatal("request exceeding burst was not limited")
+	}
+	if !l.acquire("b", now) {
+		t.Fatal("request of another client was limited")
+	}
+	if !l.acquire("a", now.Add(500*time.Millisecond)) {
+		t.Fatal("request after refill was limited")
+	}
+
+	l = newRateLimiter(0, 0, 1)
+	if !l.acquire("a", now) {
+		t.Fatal("first concurrent request was limited")
+	}
+	if l.acquire("a", now) {
+		t.Fatal("second concurrent request was not limited")
+	}
+	l.release("a")
+	if !l.acquire("a", now) {
+		t.Fatal("request after release was limited")
+	}
+
+	// Additional requests of an active client, such as the elements of a
+	// batch, are charged a token each.
+	l = newRateLimiter(1, 3, 0)
+	if !l.acquire("a", now) {
+		t.Fatal("request within burst was limited")
+	}
+	for i := 0; i < 2; i++ {
+		if !l.charge("a", now) {
+			t.Fatalf("batch element %d within burst was limited", i+1)
+		}
+	}
+	if l.charge("a", now) {
+		t.Fatal("batch element exceeding burst was not limited")
+	}
+	l.release("a")
+	if l.acquire("a", now) {
+		t.Fatal("request after charged batch was not limited")
+	}
+	if l.charge("b", now) {
+		t.Fatal("client without an active request was charged")
+	}
+
+	var disabled *rateLimiter
+	if !disabled.acquire("a", now) || !disabled.charge("a", now) {
+		t.Fatal("disabled limiter limited request")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	if _, err := OpenAuditLog(path, key[:8]); err == nil {
+		t.Fatal("opened audit log with short key")
+	}
+	l, err := OpenAuditLog(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{auditLog: l}
+	ctx := withRole(withRemoteAddr(context.Background(), "127.0.0.1:1234"), RoleAdmin)
+	ctx = withWalletName(ctx, "hot")
+	reqs := []*vhcjson.Request{
+		{Method: "walletpassphrase", Params: []json.RawMessage{[]byte(`"secret"`), []byte(`60`)}},
+		{Method: "sendtoaddress", Params: []json.RawMessage{[]byte(`"addr"`), []byte(`1.5`)}},
+	}
+	for _, req := range reqs {
+		var handled bool
+		_, jsonErr := s.auditedHandler(ctx, req, func() (interface{}, *vhcjson.RPCError) {
+			// The request is recorded before it is handled.
+			records, err := l.records()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r := records[len(records)-1]; r.Method != req.Method || r.Request != 0 {
+				t.Errorf("%s handled before its request was recorded", req.Method)
+			}
+			handled = true
+			return nil, nil
+		})()
+		if jsonErr != nil || !handled {
+			t.Fatalf("%s: handled=%v err=%v", req.Method, handled, jsonErr)
+		}
+	}
+	records, err := l.records()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+	if records[0].Wallet != "hot" || records[1].Wallet != "hot" {
+		t.Errorf("records do not name the wallet: %+v", records[:2])
+	}
+	if p := records[0].Params; p[0] != redactedParam || p[1] != "60" {
+		t.Errorf("walletpassphrase params not redacted: %q", p)
+	}
+	if r := records[1]; r.Request != 1 || r.Params != nil {
+		t.Errorf("outcome record does not reference request: %+v", r)
+	}
+	l.Close()
+
+	// The hash chain can not be verified without the key.
+	other := []byte("fedcba9876543210fedcba9876543210")
+	if _, err := OpenAuditLog(path, other); err == nil {
+		t.Fatal("opened audit log with wrong key")
+	}
+
+	// Reopening continues the hash chain.
+	l, err = OpenAuditLog(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.recordRequest(ctx, reqs[1], auditedMethods[reqs[1].Method])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests are refused when they can not be recorded.
+	l.Close()
+	s.auditLog = l
+	_, jsonErr := s.auditedHandler(ctx, reqs[1], func() (interface{}, *vhcjson.RPCError) {
+		t.Fatal("request handled without audit record")
+		return nil, nil
+	})()
+	if jsonErr != errRPCAuditLog {
+		t.Errorf("got error %v, want %v", jsonErr, errRPCAuditLog)
+	}
+
+	// Modifying a record breaks the hash chain.
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b = bytes.Replace(b, []byte(`1.5`), []byte(`9.5`), 1)
+	err = ioutil.WriteFile(path, b, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = OpenAuditLog(path, key)
+	if err == nil {
+		t.Fatal("opened modified audit log")
+	}
+}
+
+func TestPrincipalFromContext(t *testing.T) {
+	user := withUsername(context.Background(), "clerk")
+	approver := withUsername(context.Background(), "approver")
+	cert := withCertIdentity(user, "CN=clerk serial=1f")
+	if principalFromContext(user) == principalFromContext(approver) {
+		t.Fatal("clients with different usernames have the same principal")
+	}
+	if got := principalFromContext(cert); got != "CN=clerk serial=1f" {
+		t.Fatalf("principal of certificate client is %q", got)
+	}
+	if principalFromContext(cert) == principalFromContext(user) {
+		t.Fatal("certificate client has the principal of its username")
+	}
+}
+
+func TestRedactImportMultiParam(t *testing.T) {
+	param := json.RawMessage(`[{"privkey":"PmQdMn8xafwaQouk8ngs1CccRCB1ZmsqQxBaxNR4vhQi5a5QB5716","timestamp":0},{"xpub":"tpubVo","range":[0,20]}]`)
+	got := redactImportMultiParam(0, param)
+	if strings.Contains(got, "PmQd") {
+		t.Fatalf("private key recorded: %s", got)
+	}
+	want := `[{"privkey":"[redacted]","timestamp":0},{"range":[0,20],"xpub":"tpubVo"}]`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := redactImportMultiParam(1, json.RawMessage(`false`)); got != "false" {
+		t.Errorf("rescan param: got %s, want false", got)
+	}
+}
+
+func TestSignedHandler(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{
+		signingKey:    key,
+		signedMethods: map[string]struct{}{"getbalance": {}},
+	}
+	ctx := withRemoteAddr(context.Background(), "127.0.0.1:1234")
+	h := func() (interface{}, *vhcjson.RPCError) { return 1.5, nil }
+
+	res, jsonErr := s.signedHandler(ctx, &vhcjson.Request{Method: "getblockcount", ID: 1}, h)()
+	if jsonErr != nil || res != 1.5 {
+		t.Fatalf("unsigned method result changed: %v %v", res, jsonErr)
+	}
+
+	params := []json.RawMessage{[]byte(`"default"`), []byte(` { "b": 1, "a": [1.0, 2] } `)}
+	res, jsonErr = s.signedHandler(ctx, &vhcjson.Request{Method: "getbalance", Params: params, ID: 1}, h)()
+	if jsonErr != nil {
+		t.Fatal(jsonErr)
+	}
+	signed := res.(*types.SignedResult)
+	sigBytes, err := hex.DecodeString(signed.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := secp256k1.ParseDERSignature(sigBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte(signed.Payload))
+	if !sig.Verify(hash[:], key.PubKey()) {
+		t.Fatal("signature does not verify")
+	}
+	var payload signedPayload
+	err = json.Unmarshal([]byte(signed.Payload), &payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.Method != "getbalance" || string(payload.Result) != "1.5" {
+		t.Fatalf("unexpected payload %s", signed.Payload)
+	}
+	canonical := sha256.Sum256([]byte(`["default",{"a":[1.0,2],"b":1}]`))
+	if payload.ParamsHash != hex.EncodeToString(canonical[:]) {
+		t.Errorf("params hash %s does not commit to canonical params", payload.ParamsHash)
+	}
+	tampered := sha256.Sum256([]byte(strings.Replace(signed.Payload, "1.5", "9.5", 1)))
+	if sig.Verify(tampered[:], key.PubKey()) {
+		t.Fatal("signature verifies tampered payload")
+	}
+}
+
+func TestCheckRole(t *testing.T) {
+	s := &Server{hasApprover: true}
+	unauthenticated := context.Background()
+	admin := withRole(unauthenticated, RoleAdmin)
+	readOnly := withRole(unauthenticated, RoleReadOnly)
+	approver := withRole(unauthenticated, RoleApprover)
+	tests := []struct {
+		ctx     context.Context
+		method  string
+		allowed bool
+	}{
+		{admin, "sendtoaddress", true},
+		{admin, "listpendingsends", true},
+		{admin, "rejectsend", true},
+		{admin, "approvesend", false},
+		{approver, "approvesend", true},
+		{approver, "listpendingsends", true},
+		{approver, "sendtoaddress", false},
+		{approver, "dumpprivkey", false},
+		{readOnly, "getbalance", true},
+		{readOnly, "listtransactions", true},
+		{readOnly, "sendtoaddress", false},
+		{readOnly, "dumpprivkey", false},
+		{readOnly, "walletpassphrase", false},
+		{readOnly, "approvesend", false},
+		{readOnly, "stop", false},
+		{readOnly, "getrawtransaction", false},
+		{unauthenticated, "getbalance", false},
+		{unauthenticated, "sendtoaddress", false},
+	}
+	for _, test := range tests {
+		err := s.checkRole(test.ctx, test.method)
+		if (err == nil) != test.allowed {
+			t.Errorf("method %s role %v: allowed=%v, want %v",
+				test.method, roleFromContext(test.ctx), err == nil, test.allowed)
+		}
+	}
+}
+
+func TestParseCredential(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Credential
+		err  bool
+	}{
+		{"mon:secret", Credential{"mon", "secret", RoleReadOnly}, false},
+		{"mon:secret:readonly", Credential{"mon", "secret", RoleReadOnly}, false},
+		{"chk:secret:approver", Credential{"chk", "secret", RoleApprover}, false},
+		{"ops:secret:admin", Credential{"ops", "secret", RoleAdmin}, false},
+		{"ops:secret:root", Credential{}, true},
+		{"ops", Credential{}, true},
+		{":secret", Credential{}, true},
+	}
+	for _, test := range tests {
+		c, err := ParseCredential(test.s)
+		if (err != nil) != test.err {
+			t.Errorf("ParseCredential(%q): error %v, want error %v", test.s, err, test.err)
+			continue
+		}
+		if c != test.want {
+			t.Errorf("ParseCredential(%q): got %+v, want %+v", test.s, c, test.want)
+		}
+	}
+}
+
+func TestCheckFeature(t *testing.T) {
+	set, err := features.NewSet(nil, []string{string(features.Notifications)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{features: set}
+	err = s.checkFeature("notifyblocks")
+	if err == nil || err.(*vhcjson.RPCError).Code != errRPCFeatureDisabled {
+		t.Errorf("notifyblocks: got error %v, want feature disabled error", err)
+	}
+	for _, method := range []string{"getbalance", "getfeatureflags", "getrawtransaction"} {
+		if err := s.checkFeature(method); err != nil {
+			t.Errorf("%s: unexpected error %v", method, err)
+		}
+	}
+	if err := (&Server{}).checkFeature("notifyblocks"); err != nil {
+		t.Errorf("notifyblocks with default flags: unexpected error %v", err)
+	}
+}
+
+func TestAuthenticateCert(t *testing.T) {
+	s := &Server{certRoles: map[string]Role{"monitor": RoleReadOnly}}
+	state := func(cn string) *tls.ConnectionState {
+		leaf := &x509.Certificate{
+			Subject:      pkix.Name{CommonName: cn},
+			SerialNumber: big.NewInt(0x2a),
+		}
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{leaf}}}
+	}
+	tests := []struct {
+		state    *tls.ConnectionState
+		role     Role
+		identity string
+		ok       bool
+	}{
+		{nil, 0, "", false},
+		{&tls.ConnectionState{}, 0, "", false},
+		{state("monitor"), RoleReadOnly, "CN=monitor serial=2a", true},
+		{state("ops"), 0, "", false},
+	}
+	for i, test := range tests {
+		role, identity, ok := s.authenticateCert(test.state)
+		if role != test.role || identity != test.identity || ok != test.ok {
+			t.Errorf("test %d: got (%v, %q, %v), want (%v, %q, %v)", i,
+				role, identity, ok, test.role, test.identity, test.ok)
+		}
+	}
+}
+
+func TestParseCertRole(t *testing.T) {
+	tests := []struct {
+		s    string
+		cn   string
+		role Role
+		err  bool
+	}{
+		{"monitor:readonly", "monitor", RoleReadOnly, false},
+		{"host:1:approver", "host:1", RoleApprover, false},
+		{"ops:root", "", 0, true},
+		{":admin", "", 0, true},
+		{"ops", "", 0, true},
+	}
+	for _, test := range tests {
+		cn, role, err := ParseCertRole(test.s)
+		if (err != nil) != test.err {
+			t.Errorf("ParseCertRole(%q): error %v, want error %v", test.s, err, test.err)
+			continue
+		}
+		if cn != test.cn || role != test.role {
+			t.Errorf("ParseCertRole(%q): got (%q, %v), want (%q, %v)",
+				test.s, cn, role, test.cn, test.role)
+		}
+	}
+}
+
+func TestPostBatchRPC(t *testing.T) {
+	s := &Server{}
+	ctx := withRole(context.Background(), RoleReadOnly)
+	batch := []byte(` [
+		{"jsonrpc":"1.0","id":1,"method":"sendtoaddress","params":[]},
+		{"jsonrpc":"1.0","id":2,"method":"authenticate","params":[]},
+		5,
+		{"jsonrpc":"1.0","id":4,"method":"dumpprivkey","params":[]}
+	]`)
+	if !isBatchRequest(batch) {
+		t.Fatal("batch request not detected")
+	}
+	resp, stop, err := s.postBatchRPC(ctx, "test", batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stop {
+		t.Fatal("batch unexpectedly requested shutdown")
+	}
+	var responses []struct {
+		ID    interface{}
+		Error *struct{ Code int }
+	}
+	err = json.Unmarshal(resp, &responses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The authenticate request is dropped and the remaining responses
+	// remain in request order.
+	wantIDs := []interface{}{1.0, nil, 4.0}
+	if len(responses) != len(wantIDs) {
+		t.Fatalf("got %d responses, want %d: %s", len(responses), len(wantIDs), resp)
+	}
+	for i, r := range responses {
+		if r.ID != wantIDs[i] {
+			t.Errorf("response %d: got ID %v, want %v", i, r.ID, wantIDs[i])
+		}
+		if r.Error == nil {
+			t.Errorf("response %d: missing error", i)
+		}
+	}
+
+	resp, _, err = s.postBatchRPC(ctx, "test", []byte(`[]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isBatchRequest(resp) {
+		t.Fatalf("empty batch returned a batch response: %s", resp)
+	}
+
+	// Batches exceeding the maximum size are refused.
+	elems := make([]string, maxBatchRequests+1)
+	for i := range elems {
+		elems[i] = `{"jsonrpc":"1.0","id":1,"method":"help","params":[]}`
+	}
+	resp, _, err = s.postBatchRPC(ctx, "test", []byte("["+strings.Join(elems, ",")+"]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isBatchRequest(resp) {
+		t.Fatalf("oversized batch returned a batch response: %s", resp)
+	}
+
+	// Each element is charged to the rate limit of the client.
+	s.limiter = newRateLimiter(1, 2, 0)
+	ctx = withRemoteAddr(ctx, "127.0.0.1:1234")
+	key := s.rateLimitKey(ctx)
+	if !s.limiter.acquire(key, time.Now()) {
+		t.Fatal("batch request was limited")
+	}
+	batch = []byte(`[
+		{"jsonrpc":"1.0","id":1,"method":"sendtoaddress","params":[]},
+		{"jsonrpc":"1.0","id":2,"method":"sendtoaddress","params":[]},
+		{"jsonrpc":"1.0","id":3,"method":"sendtoaddress","params":[]}
+	]`)
+	resp, _, err = s.postBatchRPC(ctx, "test", batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	responses = nil
+	err = json.Unmarshal(resp, &responses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 3 {
+		t.Fatalf("got %d responses, want 3: %s", len(responses), resp)
+	}
+	for i, r := range responses {
+		busy := r.Error != nil && r.Error.Code == int(errRPCBusy.Code)
+		if busy != (i == 2) {
+			t.Errorf("response %d: busy=%v, want %v", i, busy, i == 2)
+		}
+	}
+}
+
+func TestDeprecatedAlias(t *testing.T) {
+	// Alias a method refused for read-only clients to test that the alias is
+	// authorized as the aliased method.
+	aliases["dumpprivatekey"] = "dumpprivkey"
+	deprecations["dumpprivatekey"] = &deprecation{
+		replacement: "dumpprivkey",
+		hint:        "dumpprivatekey will be removed in the next major version",
+	}
+	defer func() {
+		delete(aliases, "dumpprivatekey")
+		delete(deprecations, "dumpprivatekey")
+	}()
+
+	s := &Server{}
+	ctx := withRole(context.Background(), RoleReadOnly)
+	req := []byte(`{"jsonrpc":"1.0","id":1,"method":"dumpprivatekey","params":["x"]}`)
+	resp, _, err := s.postSingleRPC(ctx, "test", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r struct {
+		Error    *struct{ Code int }
+		Warnings []rpcWarning
+	}
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Error == nil || r.Error.Code != int(vhcjson.ErrRPCInvalidRequest.Code) {
+		t.Errorf("alias of refused method was not refused: %s", resp)
+	}
+	if len(r.Warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %s", len(r.Warnings), resp)
+	}
+	w := r.Warnings[0]
+	if w.Code != warningDeprecated || w.Method != "dumpprivatekey" || w.Replacement != "dumpprivkey" {
+		t.Errorf("unexpected warning %+v", w)
+	}
+
+	// Responses to methods which are not deprecated do not include warnings.
+	req = []byte(`{"jsonrpc":"1.0","id":2,"method":"dumpprivkey","params":["x"]}`)
+	resp, _, err = s.postSingleRPC(ctx, "test", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(resp, []byte("warnings")) {
+		t.Errorf("response to method which is not deprecated includes warnings: %s", resp)
+	}
+}
+
+func TestAPIVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		major   int
+		valid   bool
+	}{
+		{jsonrpcSemverString, jsonrpcSemverMajor, true},
+		{"4", 4, true},
+		{"4.9.9", 4, true},
+		{"5.0", 5, true},
+		{"5.1.0", 5, true},
+		{"5.2.0", 0, false},
+		{"6", 0, false},
+		{"3.0.0", 0, false},
+		{"4.x", 0, false},
+		{"4.0.0.0", 0, false},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		major, err := parseAPIVersion(test.version)
+		if test.valid != (err == nil) {
+			t.Errorf("parseAPIVersion(%q): unexpected error %v", test.version, err)
+			continue
+		}
+		if err == nil && major != test.major {
+			t.Errorf("parseAPIVersion(%q): got major %d, want %d", test.version, major, test.major)
+		}
+	}
+
+	// Results of versioned methods are converted for clients which
+	// requested an older major version.
+	balance := vhcjson.GetBalanceResult{
+		Balances: []vhcjson.GetAccountBalanceResult{
+			{AccountName: "default", Spendable: 0.1},
+			{AccountName: "imported", Spendable: 0.2},
+		},
+	}
+	h := func() (interface{}, *vhcjson.RPCError) { return balance, nil }
+	req := &vhcjson.Request{Method: "getbalance"}
+	s := &Server{}
+	res, _ := s.versionedHandler(context.Background(), req, h)()
+	if _, ok := res.(vhcjson.GetBalanceResult); !ok {
+		t.Errorf("current API version result was converted to %T", res)
+	}
+	ctx := withAPIMajor(context.Background(), 4)
+	res, jsonErr := s.versionedHandler(ctx, req, h)()
+	if jsonErr != nil {
+		t.Fatal(jsonErr)
+	}
+	if res != 0.3 {
+		t.Errorf("API version 4 result is %v, want 0.3", res)
+	}
+}
+
+func TestREST(t *testing.T) {
+	pageTests := []struct {
+		query string
+		limit int
+		err   bool
+	}{
+		{"", restDefaultLimit, false},
+		{"limit=2", 2, false},
+		{"limit=0", 0, true},
+		{"limit=1001", 0, true},
+		{"limit=x", 0, true},
+	}
+	for _, test := range pageTests {
+		query, err := url.ParseQuery(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		page, err := newRESTPage(query)
+		if (err != nil) != test.err {
+			t.Errorf("newRESTPage(%q): error %v, want error %v", test.query, err, test.err)
+			continue
+		}
+		if err == nil && page.Limit != test.limit {
+			t.Errorf("newRESTPage(%q): limit %d, want %d", test.query,
+				page.Limit, test.limit)
+		}
+	}
+
+	// Items are added until the page is full, and the cursor of the first
+	// item which did not fit is returned as the next page cursor.
+	page := &restPage{Data: []interface{}{}, Limit: 2}
+	for i := 0; i < 3; i++ {
+		added := page.add(i, strconv.Itoa(i))
+		if added != (i < 2) {
+			t.Errorf("add item %d: added %v", i, added)
+		}
+	}
+	if !reflect.DeepEqual(page.Data, []interface{}{0, 1}) || page.Next != "2" {
+		t.Errorf("page data %v, next %q", page.Data, page.Next)
+	}
+
+	cursor := &udb.TxCursor{Height: -1, Hash: chainhash.Hash{1}}
+	c, skip, err := parseRESTTxCursor(restTxCursor(cursor, 3))
+	if err != nil || *c != *cursor || skip != 3 {
+		t.Errorf("parsed cursor %v, skip %d, error %v", c, skip, err)
+	}
+	for _, s := range []string{"1", "-2:" + cursor.Hash.String() + ":0",
+		"1:x:0", "1:" + cursor.Hash.String() + ":-1"} {
+		if _, _, err := parseRESTTxCursor(s); err == nil {
+			t.Errorf("invalid cursor %q was parsed", s)
+		}
+	}
+
+	enabled, err := features.NewSet([]string{string(features.REST)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := string(httpBasicAuth("user", "pass"))
+	newServer := func(set *features.Set) *Server {
+		return &Server{
+			credentials: []credential{{
+				authsha:  sha256.Sum256([]byte(auth)),
+				username: "user",
+				role:     RoleReadOnly,
+			}},
+			features: set,
+			limiter:  newRateLimiter(0, 0, 0),
+		}
+	}
+	handlerTests := []struct {
+		features *features.Set
+		method   string
+		path     string
+		auth     string
+		status   int
+	}{
+		{enabled, "GET", "/v1/accounts", "", http.StatusUnauthorized},
+		{nil, "GET", "/v1/accounts", auth, http.StatusServiceUnavailable},
+		{enabled, "POST", "/v1/accounts", auth, http.StatusMethodNotAllowed},
+		{enabled, "GET", "/v1/unknown", auth, http.StatusNotFound},
+	}
+	for _, test := range handlerTests {
+		req := httptest.NewRequest(test.method, test.path, nil)
+		if test.auth != "" {
+			req.Header.Set("Authorization", test.auth)
+		}
+		rec := httptest.NewRecorder()
+		newServer(test.features).restHandler(rec, req)
+		if rec.Code != test.status {
+			t.Errorf("%s %s: got status %d, want %d", test.method,
+				test.path, rec.Code, test.status)
+		}
+	}
+}
+
+func TestAnomalyGuard(t *testing.T) {
+	origin, err := ParseGuardOrigin("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{
+		loader: loader.NewLoader(nil, "", nil, 0, false, 0, 0),
+		guard: newAnomalyGuard(GuardOptions{
+			MaxPassphraseFailures: 2,
+			SendCap:               vhcutil.Amount(1e8),
+			Origins:               []*net.IPNet{origin},
+		}),
+	}
+	ctx := withRemoteAddr(context.Background(), "10.1.2.3:1234")
+	passErr := func() (interface{}, *vhcjson.RPCError) {
+		return nil, rpcErrorf(vhcjson.ErrRPCWalletPassphraseIncorrect, "incorrect passphrase")
+	}
+	ok := func() (interface{}, *vhcjson.RPCError) { return nil, nil }
+	unlock := &vhcjson.Request{Method: "walletpassphrase"}
+
+	// A successful unlock resets the count of incorrect passphrases.
+	s.guardedHandler(ctx, unlock, passErr)()
+	s.guardedHandler(ctx, unlock, ok)()
+	s.guardedHandler(ctx, unlock, passErr)()
+	if s.guard.triggered() {
+		t.Fatal("guard triggered by non-consecutive incorrect passphrases")
+	}
+	s.guardedHandler(ctx, unlock, passErr)()
+	if !s.guard.triggered() {
+		t.Fatal("guard not triggered by consecutive incorrect passphrases")
+	}
+	_, jsonErr := s.guardedHandler(ctx, unlock, ok)()
+	if jsonErr == nil || jsonErr.Code != errRPCGuardTriggered {
+		t.Fatalf("unlock of triggered guard: got error %v", jsonErr)
+	}
+	s.guard.clear(false)
+
+	err = s.checkSendCap(ctx, map[string]vhcutil.Amount{"a": 6e7, "b": 6e7})
+	if err == nil || !s.guard.triggered() {
+		t.Fatal("send above cap was permitted")
+	}
+	s.guard.clear(false)
+	if err := s.checkSendCap(ctx, map[string]vhcutil.Amount{"a": 1e8}); err != nil {
+		t.Fatalf("send within cap: %v", err)
+	}
+
+	// Spends by the wallet are checked against the same cap, and unlocks are
+	// refused while the guard is triggered.
+	if err := s.guard.CheckSpend(1e8); err != nil {
+		t.Fatalf("spend within cap: %v", err)
+	}
+	if err := s.guard.CheckUnlock(); err != nil {
+		t.Fatalf("unlock of cleared guard: %v", err)
+	}
+	if err := s.guard.CheckSpend(1e8 + 1); !errors.Is(errors.Policy, err) {
+		t.Fatalf("spend above cap: got error %v", err)
+	}
+	if err := s.guard.CheckUnlock(); !errors.Is(errors.Policy, err) {
+		t.Fatalf("unlock of triggered guard: got error %v", err)
+	}
+	s.guard.clear(false)
+
+	// Requests from unexpected origins are refused and trigger the guard
+	// until the origin is trusted.
+	other := withRemoteAddr(context.Background(), "192.168.1.1:1234")
+	ran := false
+	run := func() (interface{}, *vhcjson.RPCError) { ran = true; return nil, nil }
+	_, jsonErr = s.guardedHandler(other, &vhcjson.Request{Method: "getbalance"}, run)()
+	if jsonErr == nil || jsonErr.Code != errRPCGuardTriggered || ran {
+		t.Fatalf("request from unexpected origin: got error %v, ran %v", jsonErr, ran)
+	}
+	status := s.guard.status()
+	if !status.Triggered || len(status.Alerts) != 1 || status.Alerts[0].Trigger != guardTriggerOrigin {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	s.guard.clear(true)
+	_, jsonErr = s.guardedHandler(other, &vhcjson.Request{Method: "getbalance"}, run)()
+	if jsonErr != nil || !ran || s.guard.triggered() {
+		t.Fatalf("request from trusted origin: got error %v, ran %v", jsonErr, ran)
+	}
+}
+
+func TestSendErrorData(t *testing.T) {
+	err := errors.E(errors.Op("wallet.SendOutputs"), errors.InsufficientBalance, &wallet.SendError{
+		Stage:     wallet.SendStageSelection,
+		Inputs:    2,
+		Required:  3e8,
+		Available: 1e8,
+		Err:       errors.E(errors.InsufficientBalance),
+	})
+	rpcErr := convertError(err)
+	if rpcErr.Code != vhcjson.ErrRPCWalletInsufficientFunds {
+		t.Errorf("error code %v", rpcErr.Code)
+	}
+	resp, err := marshalResponse("1.0", 1, nil, rpcErr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r struct {
+		Error *struct {
+			Code int
+			Data *types.SendErrorData
+		}
+	}
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Error == nil || r.Error.Data == nil {
+		t.Fatalf("response has no error data: %s", resp)
+	}
+	want := types.SendErrorData{
+		Stage:     "selection",
+		Inputs:    2,
+		Required:  3,
+		Available: 1,
+		Retryable: true,
+	}
+	if *r.Error.Data != want {
+		t.Errorf("error data %+v, want %+v", *r.Error.Data, want)
+	}
+	if len(errorData.m) != 0 {
+		t.Errorf("error data was not removed after marshaling")
+	}
+
+	// Errors without send context have no data.
+	resp, err = marshalResponse("1.0", 1, nil, convertError(errors.E(errors.Invalid)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(resp, []byte(`"data"`)) {
+		t.Errorf("response includes error data: %s", resp)
+	}
+}
+
+func TestNotificationMethods(t *testing.T) {
+	// Every subscription may be canceled by a websocket-only stop method.
+	for method := range notificationMethods {
+		if strings.HasPrefix(method, "stopnotify") {
+			continue
+		}
+		stop := "stop" + method
+		if _, ok := notificationMethods[stop]; !ok {
+			t.Errorf("%s has no %s method", method, stop)
+		}
+		if _, ok := handlers[stop]; !ok {
+			t.Errorf("%s is not handled", stop)
+		}
+	}
+}
+
+// testServer returns a server with a newly created wallet loaded for the
+// network params.
+func testServer(t *testing.T, params *chaincfg.Params) (s *Server, w *wallet.Wallet, teardown func()) {
+	dir, err := ioutil.TempDir("", "legacyrpc.wallet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := loader.NewLoader(params, dir, &loader.StakeOptions{}, 20, false, 1e-4, 10)
+	w, err = l.CreateNewWallet([]byte(wallet.InsecurePubPassphrase),
+		[]byte("private"), nil, time.Time{})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	s = &Server{
+		loader:    l,
+		guard:     newAnomalyGuard(GuardOptions{}),
+		activeNet: params,
+	}
+	teardown = func() {
+		l.UnloadWallet()
+		os.RemoveAll(dir)
+	}
+	return s, w, teardown
+}
+
+func TestVoteChoicesDocument(t *testing.T) {
+	// None of the networks define agendas for the current stake version,
+	// so the agendas of an older regression network version are used.
+	params := chaincfg.SimNetParams
+	version, _ := wallet.CurrentAgendas(&params)
+	agendas := chaincfg.RegNetParams.Deployments[6]
+	params.Deployments = map[uint32][]chaincfg.ConsensusDeployment{version: agendas}
+	s, w, teardown := testServer(t, &params)
+	defer teardown()
+	ctx := context.Background()
+
+	export := func() *types.VoteChoicesDocument {
+		t.Helper()
+		doc, err := exportVoteChoices(s, ctx, &types.ExportVoteChoicesCmd{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return doc.(*types.VoteChoicesDocument)
+	}
+	doc := export()
+	if doc.Version != version || len(doc.Choices) != len(agendas) {
+		t.Fatalf("exported version %d with %d choices", doc.Version, len(doc.Choices))
+	}
+	for _, c := range doc.Choices {
+		if c.ChoiceID != "abstain" {
+			t.Fatalf("default choice %q for agenda %q", c.ChoiceID, c.AgendaID)
+		}
+	}
+
+	// Documents exported by one wallet apply the same choices when imported
+	// by another.
+	agenda := &agendas[0].Vote
+	var choice string
+	for _, c := range agenda.Choices {
+		if !c.IsAbstain {
+			choice = c.Id
+			break
+		}
+	}
+	_, err := w.SetAgendaChoices(wallet.AgendaChoice{AgendaID: agenda.Id, ChoiceID: choice})
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc = export()
+	other, _, otherTeardown := testServer(t, &params)
+	defer otherTeardown()
+	importDoc := func(s *Server, doc *types.VoteChoicesDocument) error {
+		t.Helper()
+		b, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = importVoteChoices(s, ctx, &types.ImportVoteChoicesCmd{Document: string(b)})
+		return err
+	}
+	if err := importDoc(other, doc); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := exportVoteChoices(other, ctx, &types.ExportVoteChoicesCmd{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(imported, doc) {
+		t.Fatalf("imported choices %+v, exported %+v", imported, doc)
+	}
+
+	// Agendas missing from the document are set to abstain.
+	if err := importDoc(s, &types.VoteChoicesDocument{Version: version}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range export().Choices {
+		if c.ChoiceID != "abstain" {
+			t.Fatalf("choice %q for agenda %q after importing no choices",
+				c.ChoiceID, c.AgendaID)
+		}
+	}
+
+	// Invalid documents are rejected without applying any choice.
+	valid := types.AgendaChoice{AgendaID: agenda.Id, ChoiceID: choice}
+	invalidDocs := []*types.VoteChoicesDocument{
+		{Version: version + 1, Choices: []types.AgendaChoice{valid}},
+		{Version: version, Choices: []types.AgendaChoice{valid, valid}},
+		{Version: version, Choices: []types.AgendaChoice{valid, {AgendaID: "unknown", ChoiceID: "yes"}}},
+		{Version: version, Choices: []types.AgendaChoice{{AgendaID: agenda.Id, ChoiceID: "unknown"}}},
+	}
+	for i, doc := range invalidDocs {
+		err := importDoc(s, doc)
+		if e, ok := err.(*vhcjson.RPCError); !ok || e.Code != vhcjson.ErrRPCInvalidParameter {
+			t.Errorf("invalid document %d: got error %v", i, err)
+		}
+	}
+	for _, c := range export().Choices {
+		if c.ChoiceID != "abstain" {
+			t.Fatalf("choice %q for agenda %q after invalid imports",
+				c.ChoiceID, c.AgendaID)
+		}
+	}
+}
+
+func TestDatabaseInfo(t *testing.T) {
+	s, _, teardown := testServer(t, &chaincfg.SimNetParams)
+	defer teardown()
+
+	// The database path is only reported to admin clients.
+	adminCtx := withRole(context.Background(), RoleAdmin)
+	readOnlyCtx := withRole(context.Background(), RoleReadOnly)
+	res, err := walletInfo(s, adminCtx, &vhcjson.WalletInfoCmd{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := res.(*types.WalletInfoResult).Database
+	if info == nil {
+		t.Fatal("walletinfo did not report database statistics")
+	}
+	fi, err := os.Stat(info.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != fi.Size() || info.Size == 0 || info.WriteErrors != 0 {
+		t.Fatalf("database info %+v, file size %d", info, fi.Size())
+	}
+	if info.FreeSpace == nil || *info.FreeSpace == 0 {
+		t.Fatal("free space is not reported")
+	}
+	res, err = getInfo(s, readOnlyCtx, &vhcjson.GetInfoCmd{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info = res.(*types.InfoWalletResult).Database
+	if info == nil || info.Path != "" || info.Size == 0 {
+		t.Fatalf("getinfo database info %+v for read-only client", info)
+	}
+}
+
+// testNetworkBackend is a network backend other than the vhcd RPC client.
+type testNetworkBackend struct {
+	wallet.NetworkBackend
+}
+
+func TestToggleVoting(t *testing.T) {
+	s, w, teardown := testServer(t, &chaincfg.SimNetParams)
+	defer teardown()
+	ctx := context.Background()
+
+	if w.VotingEnabled() {
+		t.Fatal("voting enabled without configuration")
+	}
+	if _, err := enableVoting(s, ctx, &types.EnableVotingCmd{}); err != nil {
+		t.Fatal(err)
+	}
+	if !w.VotingEnabled() {
+		t.Fatal("voting not enabled")
+	}
+	if _, err := disableVoting(s, ctx, &types.DisableVotingCmd{}); err != nil {
+		t.Fatal(err)
+	}
+	if w.VotingEnabled() {
+		t.Fatal("voting not disabled")
+	}
+
+	// Winning tickets are not notified by other network backends.
+	s.loader.SetNetworkBackend(testNetworkBackend{})
+	_, err := enableVoting(s, ctx, &types.EnableVotingCmd{})
+	if e, ok := err.(*vhcjson.RPCError); !ok || e.Code != vhcjson.ErrRPCMisc {
+		t.Fatalf("enable voting without vhcd: got error %v", err)
+	}
+	if w.VotingEnabled() {
+		t.Fatal("voting enabled without vhcd")
+	}
+}
+
+func TestGetAutoBuyerStatus(t *testing.T) {
+	s, w, teardown := testServer(t, &chaincfg.SimNetParams)
+	defer teardown()
+	ctx := context.Background()
+
+	status := func(account *string) *types.GetAutoBuyerStatusResult {
+		t.Helper()
+		res, err := getAutoBuyerStatus(s, ctx, &types.GetAutoBuyerStatusCmd{Account: account})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.(*types.GetAutoBuyerStatusResult)
+	}
+	if res := status(nil); res.Running || len(res.Strategies) != 0 {
+		t.Fatalf("status without ticket buyers: %+v", res)
+	}
+
+	// Purchasers only use the RPC client when notified of new blocks, so it
+	// is never connected.
+	c, err := chain.NewRPCClient(&chaincfg.SimNetParams, "127.0.0.1:0", "",
+		"", nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+	s.loader.SetNetworkBackend(chain.BackendFromRPCClient(c.Client))
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NextAccount("second"); err != nil {
+		t.Fatal(err)
+	}
+	for i, account := range []string{"default", "second"} {
+		err := s.loader.StartTicketPurchase(nil, &ticketbuyer.Config{
+			AccountName: account,
+			MaxPerBlock: i + 1,
+			MaxFee:      1e6,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer s.loader.StopTicketPurchase()
+
+	_, tipHeight := w.MainChainTip()
+	res := status(nil)
+	if !res.Running || len(res.Strategies) != 2 {
+		t.Fatalf("status of two ticket buyers: %+v", res)
+	}
+	for i, r := range res.Strategies {
+		if r.Config.MaxPerBlock != i+1 || r.Config.MaxFee != 0.01 ||
+			r.Purchased != 0 || r.NextHeight != int64(tipHeight)+1 {
+			t.Errorf("status of ticket buyer %d: %+v", i, r)
+		}
+	}
+
+	// Strategies may be filtered by account.
+	second := "second"
+	res = status(&second)
+	if !res.Running || len(res.Strategies) != 1 || res.Strategies[0].Config.Account != second {
+		t.Fatalf("status of second account ticket buyer: %+v", res)
+	}
+	unknown := "unknown"
+	_, err = getAutoBuyerStatus(s, ctx, &types.GetAutoBuyerStatusCmd{Account: &unknown})
+	if err != errAccountNotFound {
+		t.Fatalf("status of unknown account: got error %v", err)
+	}
+}
+
+// testTicket returns a ticket voting and committing to an address of the
+// wallet which spends a single input of a previous transaction.
+func testTicket(t *testing.T, w *wallet.Wallet, prevHash chainhash.Hash) *wire.MsgTx {
+	t.Helper()
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := &wallet.SplitTicketSession{
+		Network:       w.ChainParams().Name,
+		TicketPrice:   1e8,
+		Expiry:        1000,
+		VotingAddress: addr.String(),
+		Contributions: []wallet.SplitTicketContribution{{
+			Amount:            1e8,
+			CommitmentAddress: addr.String(),
+			ChangeAddress:     addr.String(),
+			Inputs: []wallet.SplitTicketInput{{
+				Hash:     prevHash.String(),
+				Amount:   2e8,
+				PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+			}},
+		}},
+	}
+	tx, err := session.Ticket(w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestListTicketStatuses(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	s, w, teardown := testServer(t, params)
+	defer teardown()
+	ctx := context.Background()
+
+	ticket := func(i byte) *wire.MsgTx {
+		return testTicket(t, w, chainhash.Hash{i})
+	}
+
+	// Three tickets are mined and immature, and two remain unmined.
+	g, err := chaingen.MakeGenerator(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forest := new(wallet.SidechainForest)
+	attach := func(b *wire.MsgBlock, txs ...*wire.MsgTx) {
+		t.Helper()
+		f, err := blockcf.Regular(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := b.BlockHash()
+		forest.AddBlockNode(wallet.NewBlockNode(&b.Header, &hash, f))
+		bestChain, err := w.EvaluateBestChain(forest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.ChainSwitch(forest, bestChain, map[chainhash.Hash][]*wire.MsgTx{hash: txs})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	attach(g.CreatePremineBlock("bp", 0))
+	attach(g.NextBlock("b1", nil, nil), ticket(1), ticket(2), ticket(3))
+	for i := byte(4); i <= 5; i++ {
+		if err := w.AcceptMempoolTx(ticket(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list := func(status *string, offset, limit int) (*types.ListTicketStatusesResult, error) {
+		res, err := listTicketStatuses(s, ctx, &types.ListTicketStatusesCmd{
+			Status: status,
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.(*types.ListTicketStatusesResult), nil
+	}
+	all, err := list(nil, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.Total != 5 || len(all.Tickets) != 5 {
+		t.Fatalf("listed %d of %d tickets", len(all.Tickets), all.Total)
+	}
+	for i, r := range all.Tickets {
+		// Unmined tickets are newest.
+		status, height := "immature", int32(2)
+		if i < 2 {
+			status, height = "unmined", -1
+		}
+		if r.Status != status || r.Height != height {
+			t.Errorf("ticket %d: status %q height %d", i, r.Status, r.Height)
+		}
+	}
+
+	statusTests := []struct {
+		status  string
+		tickets []types.TicketStatusResult
+	}{
+		{"unmined", all.Tickets[:2]},
+		{"immature", all.Tickets[2:]},
+		{"unspent", all.Tickets[2:]},
+		{"live", []types.TicketStatusResult{}},
+	}
+	for _, test := range statusTests {
+		status := test.status
+		res, err := list(&status, 0, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Total != len(test.tickets) || !reflect.DeepEqual(res.Tickets, test.tickets) {
+			t.Errorf("status %q: listed %v of %d tickets", status, res.Tickets, res.Total)
+		}
+	}
+
+	// Pages are counted from the newest ticket, and the total includes
+	// tickets of every page.
+	res, err := list(nil, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 5 || !reflect.DeepEqual(res.Tickets, all.Tickets[1:3]) {
+		t.Fatalf("page listed %v of %d tickets", res.Tickets, res.Total)
+	}
+	res, err = list(nil, 5, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 5 || len(res.Tickets) != 0 {
+		t.Fatalf("page after the last ticket listed %v", res.Tickets)
+	}
+
+	bogus := "bogus"
+	for _, test := range []struct {
+		status        *string
+		offset, limit int
+	}{{&bogus, 0, 1}, {nil, -1, 1}, {nil, 0, -1}} {
+		_, err := list(test.status, test.offset, test.limit)
+		if e, ok := err.(*vhcjson.RPCError); !ok || e.Code != vhcjson.ErrRPCInvalidParameter {
+			t.Errorf("invalid parameters %+v: got error %v", test, err)
+		}
+	}
+
+	// Unspent tickets include every mined ticket which has not voted or been
+	// revoked.
+	for status, unspent := range map[wallet.TicketStatus]bool{
+		wallet.TicketStatusUnmined:  false,
+		wallet.TicketStatusImmature: true,
+		wallet.TicketStatusLive:     true,
+		wallet.TicketStatusMissed:   true,
+		wallet.TicketStatusExpired:  true,
+		wallet.TicketStatusVoted:    false,
+		wallet.TicketStatusRevoked:  false,
+	} {
+		if ticketStatusMatches(status, ticketStatusUnspent) != unspent {
+			t.Errorf("status %q matched unspent filter: %v",
+				restTicketStatuses[status], !unspent)
+		}
+	}
+}
+
+func TestDecodeRawTransaction(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	s, w, teardown := testServer(t, params)
+	defer teardown()
+	ctx := context.Background()
+
+	decode := func(tx *wire.MsgTx) vhcjson.TxRawDecodeResult {
+		t.Helper()
+		b, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := decodeRawTransaction(s, ctx, &vhcjson.DecodeRawTransactionCmd{
+			HexTx: hex.EncodeToString(b),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.(vhcjson.TxRawDecodeResult)
+	}
+
+	// Coinbase inputs are reported without previous outpoints.
+	coinbase := params.GenesisBlock.Transactions[0]
+	res := decode(coinbase)
+	if res.Txid != coinbase.TxHash().String() || len(res.Vin) != 1 ||
+		res.Vin[0].Coinbase == "" || res.Vin[0].Txid != "" || res.Vin[0].ScriptSig != nil {
+		t.Fatalf("decoded coinbase %+v", res)
+	}
+
+	// The commitments of tickets are decoded with the committed address and
+	// amount.
+	prevHash := chainhash.Hash{1}
+	ticket := testTicket(t, w, prevHash)
+	res = decode(ticket)
+	if res.Txid != ticket.TxHash().String() || res.Expiry != ticket.Expiry ||
+		len(res.Vin) != 1 || len(res.Vout) != len(ticket.TxOut) {
+		t.Fatalf("decoded ticket %+v", res)
+	}
+	vin := res.Vin[0]
+	if vin.Txid != prevHash.String() || vin.Vout != 0 || vin.ScriptSig == nil ||
+		vin.AmountIn != 2 {
+		t.Fatalf("decoded ticket input %+v", vin)
+	}
+	classes := []string{"stakesubmission", "sstxcommitment", "sstxchange"}
+	for i, vout := range res.Vout {
+		spk := vout.ScriptPubKey
+		if vout.N != uint32(i) || spk.Type != classes[i] || len(spk.Addresses) != 1 {
+			t.Fatalf("decoded ticket output %d %+v", i, vout)
+		}
+		if (spk.CommitAmt != nil) != (i == 1) {
+			t.Errorf("ticket output %d commitment amount %v", i, spk.CommitAmt)
+		}
+	}
+	if *res.Vout[1].ScriptPubKey.CommitAmt != 1 {
+		t.Errorf("commitment amount %v", *res.Vout[1].ScriptPubKey.CommitAmt)
+	}
+
+	for _, hexTx := range []string{"bogus", "00"} {
+		_, err := decodeRawTransaction(s, ctx, &vhcjson.DecodeRawTransactionCmd{HexTx: hexTx})
+		if err == nil {
+			t.Errorf("decoded invalid transaction %q", hexTx)
+		}
+	}
+	_, err := decodeRawTransaction(s, ctx, &vhcjson.DecodeRawTransactionCmd{HexTx: "00"})
+	if e, ok := err.(*vhcjson.RPCError); !ok || e.Code != vhcjson.ErrRPCDeserialization {
+		t.Errorf("decoding truncated transaction: got error %v", err)
+	}
+}
+
+func TestDecodeScript(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	s, w, teardown := testServer(t, params)
+	defer teardown()
+	ctx := context.Background()
+
+	owned, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownedScript, err := txscript.PayToAddrScript(owned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := vhcutil.NewAddressSecpPubKey(key.PubKey().SerializeCompressed(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreignScript, err := txscript.PayToAddrScript(foreign.AddressPubKeyHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Multisig scripts paying only foreign keys are owned once the script is
+	// imported, as the wallet then owns their pay-to-script-hash address.
+	multisig, err := txscript.MultiSigScript([]*vhcutil.AddressSecpPubKey{foreign}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2shAddr, err := vhcutil.NewAddressScriptHash(multisig, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2shScript, err := txscript.PayToAddrScript(p2shAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decode := func(script []byte) *types.DecodeScriptResult {
+		t.Helper()
+		res, err := decodeScript(s, ctx, &vhcjson.DecodeScriptCmd{
+			HexScript: hex.EncodeToString(script),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.(*types.DecodeScriptResult)
+	}
+	tests := []struct {
+		script []byte
+		class  string
+		isMine bool
+		p2sh   bool
+	}{
+		{ownedScript, "pubkeyhash", true, true},
+		{foreignScript, "pubkeyhash", false, true},
+		{multisig, "multisig", false, true},
+		// Scripts which are already pay-to-script-hash are not wrapped.
+		{p2shScript, "scripthash", false, false},
+	}
+	for i, test := range tests {
+		res := decode(test.script)
+		if res.Type != test.class || res.IsMine != test.isMine ||
+			(res.P2sh != "") != test.p2sh || len(res.Addresses) == 0 {
+			t.Errorf("test %d: decoded %+v", i, res)
+		}
+	}
+	if res := decode(multisig); res.P2sh != p2shAddr.EncodeAddress() || res.ReqSigs != 1 {
+		t.Fatalf("decoded multisig %+v", res)
+	}
+
+	err = w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ImportScript(multisig, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := decode(multisig); !res.IsMine {
+		t.Errorf("imported multisig script is not owned")
+	}
+	if res := decode(p2shScript); !res.IsMine {
+		t.Errorf("imported script's pay-to-script-hash script is not owned")
+	}
+
+	_, err = decodeScript(s, ctx, &vhcjson.DecodeScriptCmd{HexScript: "bogus"})
+	if err == nil {
+		t.Fatal("decoded invalid hex script")
+	}
+}
+
+// publishBackend is a network backend recording published transactions and
+// rejecting some of them.
+type publishBackend struct {
+	testNetworkBackend
+	published []chainhash.Hash
+	reject    map[chainhash.Hash]bool
+}
+
+func (b *publishBackend) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	for _, tx := range txs {
+		hash := tx.TxHash()
+		if b.reject[hash] {
+			return errors.New("rejected")
+		}
+		b.published = append(b.published, hash)
+	}
+	return nil
+}
+
+func TestSignRawTransactions(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	s, w, teardown := testServer(t, params)
+	defer teardown()
+	ctx := context.Background()
+	err := w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record a transaction paying many outputs to the wallet, and create a
+	// transaction spending each output.  More transactions are signed than
+	// there are signing workers.
+	const n = 24
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), 0, nil))
+	for i := 0; i < n; i++ {
+		addr, err := w.NewExternalAddress(0, wallet.WithGapPolicyIgnore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		funding.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	}
+	err = w.AcceptMempoolTx(funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundingHash := funding.TxHash()
+	rawTxs := make([]string, n)
+	for i := range rawTxs {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundingHash, uint32(i), wire.TxTreeRegular), 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8-1e5, funding.TxOut[i].PkScript))
+		if i == 5 {
+			// Transactions spending unknown outputs can not be
+			// signed.
+			tx.TxIn[0].PreviousOutPoint.Hash = chainhash.Hash{2}
+		}
+		b, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rawTxs[i] = hex.EncodeToString(b)
+	}
+	// Transactions which can not be decoded or signed fail without failing
+	// the request.
+	rawTxs[3] = "bogus"
+
+	signedTx := func(res *types.SignedTransaction) *wire.MsgTx {
+		t.Helper()
+		tx := wire.NewMsgTx()
+		err := tx.Deserialize(hex.NewDecoder(strings.NewReader(res.SigningResult.Hex)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	sign := func(send bool) *types.SignRawTransactionsResult {
+		t.Helper()
+		res, err := signRawTransactions(s, ctx, &vhcjson.SignRawTransactionsCmd{
+			RawTxs: rawTxs,
+			Send:   &send,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.(*types.SignRawTransactionsResult)
+	}
+
+	res := sign(false)
+	if len(res.Results) != n {
+		t.Fatalf("%d results for %d transactions", len(res.Results), n)
+	}
+	for i := range res.Results {
+		r := &res.Results[i]
+		if r.Sent || r.TxHash != nil {
+			t.Errorf("result %d sent without sending", i)
+		}
+		if i == 3 || i == 5 {
+			if r.Error == "" || r.SigningResult.Hex != "" {
+				t.Errorf("result %d of unsignable transaction: %+v", i, r)
+			}
+			continue
+		}
+		// Results are ordered by the transactions they sign.
+		tx := signedTx(r)
+		prevOut := &tx.TxIn[0].PreviousOutPoint
+		if r.Error != "" || !r.SigningResult.Complete || prevOut.Hash != fundingHash ||
+			prevOut.Index != uint32(i) || len(tx.TxIn[0].SignatureScript) == 0 {
+			t.Errorf("result %d: %+v", i, r)
+		}
+	}
+
+	// Sending requires a network backend.
+	send := true
+	_, err = signRawTransactions(s, ctx, &vhcjson.SignRawTransactionsCmd{
+		RawTxs: rawTxs,
+		Send:   &send,
+	})
+	if err != errNoNetwork {
+		t.Fatalf("send without network backend: got error %v", err)
+	}
+
+	// Transactions which fail to send are reported, and the remaining
+	// complete transactions are still sent.
+	rejected := signedTx(&res.Results[7]).TxHash()
+	backend := &publishBackend{reject: map[chainhash.Hash]bool{rejected: true}}
+	s.loader.SetNetworkBackend(backend)
+	res = sign(true)
+	if len(backend.published) != n-3 {
+		t.Fatalf("published %d transactions", len(backend.published))
+	}
+	for i := range res.Results {
+		r := &res.Results[i]
+		switch i {
+		case 3, 5:
+			if r.Sent || r.TxHash != nil {
+				t.Errorf("result %d sent without signing", i)
+			}
+		case 7:
+			if r.Sent || r.TxHash != nil || r.Error != "rejected" {
+				t.Errorf("result %d of rejected transaction: %+v", i, r)
+			}
+		default:
+			hash := signedTx(r).TxHash().String()
+			if !r.Sent || r.TxHash == nil || *r.TxHash != hash || r.Error != "" {
+				t.Errorf("result %d: %+v", i, r)
+			}
+		}
+	}
+}
+
+func TestSendToMultiSigApproval(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	s, w, teardown := testServer(t, params)
+	defer teardown()
+	ctx := context.Background()
+	err := w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &publishBackend{}
+	s.loader.SetNetworkBackend(backend)
+
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := vhcutil.NewAddressSecpPubKey(key.PubKey().SerializeCompressed(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nRequired, minConf := 1, 0
+	cmd := &vhcjson.SendToMultiSigCmd{
+		FromAccount: "default",
+		Amount:      1,
+		Pubkeys:     []string{pubkey.String()},
+		NRequired:   &nRequired,
+		MinConf:     &minConf,
+	}
+
+	// Multisig sends can not be queued, so they are refused when every send
+	// must be approved.
+	s.requireApproval = true
+	_, err = sendToMultiSig(s, ctx, cmd)
+	if e, ok := err.(*vhcjson.RPCError); !ok || e.Code != vhcjson.ErrRPCWallet {
+		t.Fatalf("multisig send requiring server approval: got error %v", err)
+	}
+
+	// Sends from accounts requiring approval are refused by the wallet.
+	s.requireApproval = false
+	err = w.SetSendApproval(0, nil, []byte("approve"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sendToMultiSig(s, ctx, cmd)
+	if !errors.Is(errors.Permission, err) {
+		t.Fatalf("multisig send requiring account approval: got error %v", err)
+	}
+	if len(backend.published) != 0 {
+		t.Fatalf("published %d transactions", len(backend.published))
+	}
+}
+
+func TestNamedWalletLookupError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "legacyrpc.wallets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	params := &chaincfg.SimNetParams
+	s := &Server{
+		loader:    loader.NewLoader(params, dir, &loader.StakeOptions{}, 20, false, 1e-4, 10),
+		guard:     newAnomalyGuard(GuardOptions{}),
+		activeNet: params,
+	}
+	ctx := withRole(withRemoteAddr(context.Background(), "127.0.0.1:1234"), RoleAdmin)
+	ctx = withWalletName(ctx, "cold")
+	req := &vhcjson.Request{Jsonrpc: "1.0", Method: "walletislocked", ID: 1}
+
+	// Failures to access the wallets directory, other than the wallet not
+	// existing, are reported to the client instead of crashing the server.
+	err = ioutil.WriteFile(filepath.Join(dir, "wallets"), nil, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, rpcErr := s.handlerClosure(ctx, req)()
+	if rpcErr == nil || rpcErr.Code != vhcjson.ErrRPCWallet || rpcErr == errUnloadedWallet {
+		t.Fatalf("request of inaccessible wallet: got error %v", rpcErr)
+	}
+	rpcErr = s.websocketNotificationRequest(ctx, nil, &vhcjson.Request{
+		Jsonrpc: "1.0", Method: "notifyblocks", ID: 1,
+	})
+	if rpcErr == nil || rpcErr.Code != vhcjson.ErrRPCWallet || rpcErr == errUnloadedWallet {
+		t.Fatalf("notification request of inaccessible wallet: got error %v", rpcErr)
+	}
+
+	// Wallets which do not exist are not loaded.
+	err = os.Remove(filepath.Join(dir, "wallets"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, rpcErr = s.handlerClosure(ctx, req)()
+	if rpcErr != errUnloadedWallet {
+		t.Fatalf("request of missing wallet: got error %v", rpcErr)
+	}
+}
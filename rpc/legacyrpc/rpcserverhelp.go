@@ -4,81 +4,192 @@ package legacyrpc
 
 func helpDescsEnUS() map[string]string {
 	return map[string]string{
-		"accountaddressindex":     "accountaddressindex \"account\" branch\n\nGet the current address index for some account branch\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n\nResult:\nn (numeric) The address index for this account branch\n",
-		"accountsyncaddressindex": "accountsyncaddressindex \"account\" branch index\n\nSynchronize an account branch to some passed address index\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n3. index   (numeric, required) The address index to synchronize to\n\nResult:\nNothing\n",
-		"addmultisigaddress":      "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
-		"addticket":               "addticket \"tickethex\"\n\nAdd a ticket to the wallet for vote and revocation creation.  Added tickets are auxiliary to transaction history and do not appear in getstakeinfo stats.\n\nArguments:\n1. tickethex (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
-		"consolidate":             "consolidate inputs (\"account\" \"address\")\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs  (numeric, required) Number of UTXOs to consolidate as inputs\n2. account (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
-		"createmultisig":          "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
-		"createnewaccount":        "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
-		"dumpprivkey":             "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
-		"exportwatchingwallet":    "exportwatchingwallet (\"account\" download=false)\n\nCreates and returns a duplicate of the wallet database without any private keys to be used as a watching-only wallet.\n\nArguments:\n1. account  (string, optional)                 Unused (must be unset or \"*\")\n2. download (boolean, optional, default=false) Unused\n\nResult:\n\"value\" (string) The watching-only database encoded as a base64 string\n",
-		"generatevote":            "generatevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\n\nReturns the vote transaction encoded as a hexadecimal string\n\nArguments:\n1. blockhash   (string, required)  Block hash for the ticket\n2. height      (numeric, required) Block height for the ticket\n3. tickethash  (string, required)  The hash of the ticket\n4. votebits    (numeric, required) The voteBits to set for the ticket\n5. votebitsext (string, required)  The extended voteBits to set for the ticket\n\nResult:\n{\n \"hex\": \"value\", (string) The hex encoded transaction\n}                \n",
-		"getaccountaddress":       "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
-		"getaccount":              "getaccount \"address\"\n\nDEPRECATED -- Lookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
-		"getaddressesbyaccount":   "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
-		"getbalance":              "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account (string, optional)             DEPRECATED -- The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                         (array of object) Balances for all accounts.\n  \"accountname\": \"value\",               (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,     (numeric)         Immature Coinbase reward coins.\n  \"immaturestakegeneration\": n.nnn,     (numeric)         Number of immature stake coins.\n  \"lockedbytickets\": n.nnn,             (numeric)         Coins locked by tickets.\n  \"spendable\": n.nnn,                   (numeric)         Spendable number of coins.\n  \"total\": n.nnn,                       (numeric)         Total amount of coins.\n  \"unconfirmed\": n.nnn,                 (numeric)         Unconfirmed number of coins.\n  \"votingauthority\": n.nnn,             (numeric)         Coins for voting authority.\n },...],                                                  \n \"blockhash\": \"value\",                  (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn, (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": n.nnn, (numeric)         Total number of immature stake coins.\n \"totallockedbytickets\": n.nnn,         (numeric)         Total number of coins locked by tickets.\n \"totalspendable\": n.nnn,               (numeric)         Total number of spendable number of coins.\n \"cumulativetotal\": n.nnn,              (numeric)         Total number of coins.\n \"totalunconfirmed\": n.nnn,             (numeric)         Total number of unconfirmed coins.\n \"totalvotingauthority\": n.nnn,         (numeric)         Total number of coins for voting authority.\n}                                       \n",
-		"getbestblockhash":        "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
-		"getbestblock":            "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
-		"getblockcount":           "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
-		"getinfo":                 "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in VHC/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
-		"getmasterpubkey":         "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
-		"getmultisigoutinfo":      "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
-		"getnewaddress":           "getnewaddress (\"account\" \"gappolicy\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account   (string, optional) Account name the new address will belong to (default=\"default\")\n2. gappolicy (string, optional) String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n\nResult:\n\"value\" (string) The payment address\n",
-		"getrawchangeaddress":     "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
-		"getreceivedbyaccount":    "getreceivedbyaccount \"account\" (minconf=1)\n\nDEPRECATED -- Returns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in valhallacoin\n",
-		"getreceivedbyaddress":    "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in valhallacoin\n",
-		"getstakeinfo":            "getstakeinfo\n\nReturns statistics about staking from the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by stake mining\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
-		"getticketfee":            "getticketfee\n\nGet the current fee per kB of the serialized tx size used for an authored stake transaction.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The current fee\n",
-		"gettickets":              "gettickets includeimmature\n\nReturning the hashes of the tickets currently owned by wallet.\n\nArguments:\n1. includeimmature (boolean, required) If true include immature tickets in the results.\n\nResult:\n{\n \"hashes\": [\"value\",...], (array of string) Hashes of the tickets owned by the wallet encoded as strings\n}                         \n",
-		"gettransaction":          "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in valhallacoin\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
-		"getunconfirmedbalance":   "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in valhallacoin.\n",
-		"getvotechoices":          "getvotechoices\n\nRetrieve the currently configured vote choices for the latest supported stake agendas\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
-		"getwalletfee":            "getwalletfee\n\nGet currently set transaction fee for the wallet\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) Current tx fee (in VHC)\n",
-		"help":                    "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
-		"importprivkey":           "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importscript":            "importscript \"hex\" (rescan=true scanfrom)\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescansfdsfd the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"keypoolrefill":           "keypoolrefill (newsize=100)\n\nDEPRECATED -- This request does nothing since no keypool is maintained.\n\nArguments:\n1. newsize (numeric, optional, default=100) Unused\n\nResult:\nNothing\n",
-		"listaccounts":            "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in valhallacoin, (object) JSON object with account names as keys and valhallacoin amounts as values\n ...\n}\n",
-		"listaddresstransactions": "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":     "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listlockunspent":         "listlockunspent\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\nNone\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
-		"listreceivedbyaccount":   "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nDEPRECATED -- Returns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in valhallacoin\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
-		"listreceivedbyaddress":   "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in valhallacoin\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
-		"listscripts":             "listscripts\n\nList all scripts that have been added to wallet\n\nArguments:\nNone\n\nResult:\n{\n \"scripts\": [{             (array of object) A list of the imported scripts\n  \"hash160\": \"value\",      (string)          The script hash\n  \"address\": \"value\",      (string)          The script address\n  \"redeemscript\": \"value\", (string)          The redeem script\n },...],                                     \n}                          \n",
-		"listsinceblock":          "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":        "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listunspent":             "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  Unset\n \"amount\": n.nnn,         (numeric) The amount of the output valued in valhallacoin\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
-		"lockunspent":             "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"purchaseticket":          "purchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\n\nPurchase ticket using available funds.\n\nArguments:\n1.  fromaccount   (string, required)             The account to use for purchase (default=\"default\")\n2.  spendlimit    (numeric, required)            Limit on the amount to spend on ticket\n3.  minconf       (numeric, optional, default=1) Minimum number of block confirmations required\n4.  ticketaddress (string, optional)             Override the ticket address to which voting rights are given\n5.  numtickets    (numeric, optional)            The number of tickets to purchase\n6.  pooladdress   (string, optional)             The address to pay stake pool fees to\n7.  poolfees      (numeric, optional)            The amount of fees to pay to the stake pool\n8.  expiry        (numeric, optional)            Height at which the purchase tickets expire\n9.  comment       (string, optional)             Unused\n10. ticketfee     (numeric, optional)            The transaction fee rate (VHC/kB) to use (overrides fees set by the wallet config or settxfee RPC)\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
-		"redeemmultisigout":       "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"redeemmultisigouts":      "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"renameaccount":           "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
-		"rescanwallet":            "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
-		"revoketickets":           "revoketickets\n\nRequests the wallet create revovactions for any previously missed tickets.  Wallet must be unlocked.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"sendfrom":                "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nDEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in valhallacoin\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendmany":                "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) DEPRECATED -- Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in valhallacoin, (object) JSON object using payment addresses as keys and output amounts valued in valhallacoin to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtoaddress":           "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in valhallacoin\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtomultisig":          "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in valhallacoin\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"setticketfee":            "setticketfee fee\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored stake transaction.\n\nArguments:\n1. fee (numeric, required) The new fee per kB of the serialized tx size valued in valhallacoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"settxfee":                "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in valhallacoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"setvotechoice":           "setvotechoice \"agendaid\" \"choiceid\"\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid (string, required) The ID for the agenda to modify\n2. choiceid (string, required) The ID for the choice to choose\n\nResult:\nNothing\n",
-		"signmessage":             "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
-		"signrawtransaction":      "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"signrawtransactions":     "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\n\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n },...],                                     \n}                          \n",
-		"stakepooluserinfo":       "stakepooluserinfo \"user\"\n\nGet user info for stakepool\n\nArguments:\n1. user (string, required) The id of the user to be looked up\n\nResult:\n{\n \"tickets\": [{             (array of object) A list of valid tickets that the user has added\n  \"status\": \"value\",       (string)          The current status of the added ticket\n  \"ticket\": \"value\",       (string)          The hash of the added ticket\n  \"ticketheight\": n,       (numeric)         The height in which the ticket was added\n  \"spentby\": \"value\",      (string)          The vote in which the ticket was spent\n  \"spentbyheight\": n,      (numeric)         The height in which the ticket was spent\n },...],                                     \n \"invalid\": [\"value\",...], (array of string) A list of invalid tickets that the user has added\n}                          \n",
-		"startautobuyer":          "startautobuyer \"account\" \"passphrase\" (balancetomaintain maxfeeperkb maxpricerelative maxpriceabsolute \"votingaddress\" \"pooladdress\" poolfees maxperblock)\n\nStarts the wallet's ticket buyer.\n\nArguments:\n1.  account           (string, required)  The account to use for purchasing tickets\n2.  passphrase        (string, required)  The private passphrase of the wallet\n3.  balancetomaintain (numeric, optional) The minimum amount of funds to never dip below when purchasing tickets\n4.  maxfeeperkb       (numeric, optional) The maximum ticket fee amount per KB\n5.  maxpricerelative  (numeric, optional) The scaling factor for setting the maximum ticket price, multiplied by the average price\n6.  maxpriceabsolute  (numeric, optional) The maximum absolute ticket price\n7.  votingaddress     (string, optional)  The address to delegate voting rights to\n8.  pooladdress       (string, optional)  The stake pool address where ticket fees will go to\n9.  poolfees          (numeric, optional) The absolute per ticket fee mandated by the stake pool as a percent\n10. maxperblock       (numeric, optional) The maximum tickets per block. Negative number indicates one ticket every n blocks\n\nResult:\nNothing\n",
-		"stopautobuyer":           "stopautobuyer\n\nStops the wallet's ticket buyer.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"sweepaccount":            "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
-		"ticketsforaddress":       "ticketsforaddress \"address\"\n\nRequest all the tickets for an address.\n\nArguments:\n1. address (string, required) Address to look for.\n\nResult:\ntrue|false (boolean) Tickets owned by the specified address.\n",
-		"validateaddress":         "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n}                            \n",
-		"verifymessage":           "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
-		"version":                 "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
-		"walletinfo":              "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false,  (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"unlocked\": true|false,         (boolean) Whether or not the wallet is unlocked\n \"txfee\": n.nnn,                 (numeric) Transaction fee per kB of the serialized tx size in coins\n \"ticketfee\": n.nnn,             (numeric) Ticket fee per kB of the serialized tx size in coins\n \"ticketpurchasing\": true|false, (boolean) Whether or not the wallet is currently purchasing tickets\n \"votebits\": n,                  (numeric) Vote bits setting\n \"votebitsextended\": \"value\",    (string)  Extended vote bits setting\n \"voteversion\": n,               (numeric) Version of votes that will be generated\n \"voting\": true|false,           (boolean) Whether or not the wallet is currently voting tickets\n}                                \n",
-		"walletislocked":          "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
-		"walletlock":              "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"walletpassphrasechange":  "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
-		"walletpassphrase":        "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks\n\nResult:\nNothing\n",
+		"accountaddressindex":          "accountaddressindex \"account\" branch\n\nGet the current address index for some account branch\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n\nResult:\nn (numeric) The address index for this account branch\n",
+		"accountsyncaddressindex":      "accountsyncaddressindex \"account\" branch index\n\nSynchronize an account branch to some passed address index\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n3. index   (numeric, required) The address index to synchronize to\n\nResult:\nNothing\n",
+		"addmultisigaddress":           "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
+		"addticket":                    "addticket \"tickethex\"\n\nAdd a ticket to the wallet for vote and revocation creation.  Added tickets are auxiliary to transaction history and do not appear in getstakeinfo stats.\n\nArguments:\n1. tickethex (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
+		"approvesend":                  "approvesend \"id\" (\"passphrase\")\n\nApproves a send queued by sendtoaddress, sendfrom, or sendmany, creating, signing, and publishing the transaction.\nSends are queued for accounts requiring send approval, and for every account when the server requires send approval.\nA send may not be approved by the client which requested it, and when approver credentials are configured, this method must be called using them.\n\nArguments:\n1. id         (string, required) The ID of the pending send\n2. passphrase (string, optional) The approval passphrase of the sending account, required when the account requires send approval\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"assigndepositaddress":         "assigndepositaddress \"account\" (\"reference\")\n\nAssigns the oldest available reserved deposit address of an account, recording an optional reference such as a customer identifier.\n\nArguments:\n1. account   (string, required) Name of the account\n2. reference (string, optional) Reference to record with the assigned address\n\nResult:\n{\n \"account\": \"value\",   (string)  Name of the account the address belongs to\n \"address\": \"value\",   (string)  The reserved address\n \"index\": n,           (numeric) Child index of the address in the account's external branch\n \"status\": \"value\",    (string)  Assignment status of the address (\"available\" or \"assigned\")\n \"created\": n,         (numeric) Unix time the address was reserved\n \"assigned\": n,        (numeric) Unix time the address was assigned\n \"reference\": \"value\", (string)  Reference recorded when the address was assigned\n}                      \n",
+		"cancelrescan":                 "cancelrescan\n\nAborts every rescan in progress which was started by rescanwallet or by importing keys and scripts.\nRescans performed while synchronizing with the network are not canceled.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"cancelrevocation":             "cancelrevocation \"tickethash\"\n\nCancels the pending automatic revocation of a missed ticket, e.g. when the miss report is believed to be spurious.\nThe cancellation is recorded in the wallet database, and the ticket is not revoked automatically again, but may still be revoked with revoketickets.\n\nArguments:\n1. tickethash (string, required) Hash of the missed ticket\n\nResult:\nNothing\n",
+		"clearguard":                   "clearguard (trustorigins=false)\n\nClears the triggered state of the anomaly guard after its alerts are reviewed, permitting the wallet to be unlocked again.\n\nArguments:\n1. trustorigins (boolean, optional, default=false) Trust the client addresses of origin alerts, so their requests no longer trigger the guard\n\nResult:\nNothing\n",
+		"clearunlocksession":           "clearunlocksession\n\nRemoves the cached key derived from the private passphrase so that the next unlock performs the full key derivation. The lock state of the wallet is not changed.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"closewallet":                  "closewallet\n\nStops the loaded wallet and closes its database.\nRequests requiring a wallet fail until a wallet is opened with openwallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"consolidate":                  "consolidate inputs (\"account\" \"address\")\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs  (numeric, required) Number of UTXOs to consolidate as inputs\n2. account (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
+		"consolidateaccount":           "consolidateaccount \"account\" (maxinputs=0 targetsize=0 feeperkb \"address\")\n\nConsolidates unspent outputs of an account into a single output.\nWhen a target size is set, only outputs of lower value are consolidated, smallest first, until the consolidated output reaches the target size.\nAt least two outputs must be consolidated.\n\nArguments:\n1. account    (string, required)             Account from which unspent outputs are consolidated, and which provides the output address when none is specified\n2. maxinputs  (numeric, optional, default=0) Maximum number of outputs to consolidate, or 0 to be limited only by the maximum transaction size\n3. targetsize (numeric, optional, default=0) Value of the consolidated output, or 0 to consolidate outputs of any value\n4. feeperkb   (numeric, optional)            Fee rate in coins per kB (default is the wallet's relay fee)\n5. address    (string, optional)             Address to pay (default is a new internal address of the account)\n\nResult:\n\"value\" (string) Transaction hash of the consolidation transaction\n",
+		"createcosignsession":          "createcosignsession \"hextx\"\n\nBegins a session for an unsigned transaction spending P2SH multisig outputs recorded by the wallet.\nThe returned session is passed to the cosigners, which add their signatures with signcosignsession.  Sessions signed by different cosigners may be combined with mergecosignsessions.  Once every input has the required signatures, any cosigner creates the signed transaction with finalizecosignsession.\n\nArguments:\n1. hextx (string, required) The hex encoded unsigned transaction\n\nResult:\n{\n \"session\": \"value\",     (string)           The JSON-encoded session passed between the cosigners\n \"signatures\": [n,...],  (array of numeric) The number of signatures collected for each input\n \"required\": [n,...],    (array of numeric) The number of signatures required by each input\n \"complete\": true|false, (boolean)          Whether every input has the required signatures\n \"added\": n,             (numeric)          The number of signatures added by the wallet, omitted when none were added\n}                        \n",
+		"createmultisig":               "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
+		"createnewaccount":             "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
+		"createmultisigaccount":        "createmultisigaccount \"name\" \"account\" nrequired [\"xpub\",...]\n\nCreates an HD multisig account whose addresses require nrequired signatures of the keys derived from the extended public key of a wallet account and every cosigner extended public key.\nEach cosigner creates the account with the same keys to derive identical addresses for each branch and child index.\n\nArguments:\n1. name      (string, required)          The name of the multisig account\n2. account   (string, required)          The wallet account whose extended public key is one of the cosigner keys\n3. nrequired (numeric, required)         The number of signatures required to spend outputs of the account\n4. xpubs     (array of string, required) The account extended public keys of the other cosigners\n\nResult:\nNothing\n",
+		"createownershipproof":         "createownershipproof [\"address\",...] (challenge=\"\" minbalance=0 blockheight)\n\nCreates a proof, signed by the private key of each address, that the wallet controls the addresses and the unspent outputs paying them as of a main chain block.\nThe proof is verified with verifyownershipproof.\n\nArguments:\n1. addresses   (array of string, required)    The P2PKH addresses to prove ownership of\n2. challenge   (string, optional, default=\"\") A challenge chosen by the verifier which is included in the signed proof\n3. minbalance  (numeric, optional, default=0) The minimum total value (in VHC) of the claimed outputs; the proof is not created when the addresses hold less\n4. blockheight (numeric, optional)            The height of the main chain block as of which outputs are claimed (default=the main chain tip)\n\nResult:\n{\n \"challenge\": \"value\",  (string)          The challenge chosen by the verifier\n \"blockhash\": \"value\",  (string)          The hash of the main chain block as of which the outputs are unspent\n \"blockheight\": n,      (numeric)         The height of the main chain block as of which the outputs are unspent\n \"addresses\": [{        (array of object) The addresses and their signatures of the proof\n  \"address\": \"value\",   (string)          The address\n  \"signature\": \"value\", (string)          The base64-encoded signature of the proof by the private key of the address\n },...],                                  \n \"outputs\": [{          (array of object) The unspent outputs paying the addresses\n  \"txid\": \"value\",      (string)          The hash of the transaction of the output\n  \"vout\": n,            (numeric)         The output index\n  \"tree\": n,            (numeric)         The transaction tree of the output\n  \"amount\": n.nnn,      (numeric)         The value of the output in VHC\n  \"address\": \"value\",   (string)          The address paid by the output\n },...],                                  \n}                       \n",
+		"createsplitticketsession":     "createsplitticketsession (\"votingaddress\" expiry)\n\nBegins a session for a split ticket co-funded by several wallets at the next ticket price.\nThe returned session is passed to each participant in turn to contribute funds with joinsplitticketsession.  Once the ticket price is fully funded, every participant signs the session with signsplitticketsession, and any participant publishes the ticket with publishsplitticketsession.\nEach participant's commitment receives its share of the vote reward in proportion to the amount it contributed.\n\nArguments:\n1. votingaddress (string, optional)  The address given voting rights for the ticket; a new address of the default account is used when omitted\n2. expiry        (numeric, optional) Height at which the ticket expires; defaults to the end of the current ticket price interval\n\nResult:\n{\n \"session\": \"value\",    (string)  The JSON-encoded session passed between the participants\n \"ticketprice\": n.nnn,  (numeric) The price (in VHC) of the ticket\n \"unfunded\": n.nnn,     (numeric) The share of the ticket price (in VHC) not yet contributed by any participant\n \"tickethash\": \"value\", (string)  The hash of the ticket, set once the session is signed\n}                       \n",
+		"createunsignedtickets":        "createunsignedtickets \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry ticketfee)\n\nPerforms the funding and split output construction of purchaseticket, returning the unsigned split transaction and tickets without signing or publishing them.\nThis allows tickets to be inspected or signed externally before committing funds.  The tickets spend the outputs of the split transaction, whose hash is unchanged by signing.\nAddresses derived for change, voting, and ticket commitments are recorded, but the outputs spent by the split transaction are not locked.  Tickets are not registered with a VSP.\n\nArguments:\n1. fromaccount   (string, required)             The account to use for purchase\n2. spendlimit    (numeric, required)            Limit on the amount to spend on each ticket\n3. minconf       (numeric, optional, default=1) Minimum number of block confirmations required\n4. ticketaddress (string, optional)             Override the ticket address to which voting rights are given\n5. numtickets    (numeric, optional)            The number of tickets to create\n6. pooladdress   (string, optional)             The address to pay stake pool fees to\n7. poolfees      (numeric, optional)            The amount of fees to pay to the stake pool\n8. expiry        (numeric, optional)            Height at which the tickets expire\n9. ticketfee     (numeric, optional)            The transaction fee rate (VHC/kB) of the tickets\n\nResult:\n{\n \"splittx\": \"value\",       (string)          The unsigned split transaction funding the tickets, hex-encoded\n \"tickets\": [\"value\",...], (array of string) The unsigned tickets, hex-encoded\n \"ticketprice\": n.nnn,     (numeric)         The ticket price (in VHC) of each ticket\n}                          \n",
+		"createwallet":                 "createwallet \"passphrase\" (\"seed\" birthday)\n\nCreates and opens a new wallet when no wallet is loaded, such as when the server was started with the noinitialload option.\nThe wallet is created with the insecure default public passphrase.\nWhen no seed is provided, a new random seed is generated and returned, and must be backed up to recover the wallet.\n\nArguments:\n1. passphrase (string, required)  The private passphrase protecting the private keys of the wallet\n2. seed       (string, optional)  The seed of a restored wallet encoded as a hexadecimal string or mnemonic of PGP words, or unset to generate a new seed\n3. birthday   (numeric, optional) Unix time the seed was created, before which blocks are not rescanned for wallet transactions (defaults to the current time for generated seeds)\n\nResult:\n{\n \"seed\": \"value\",     (string) The generated seed encoded as a hexadecimal string, omitted when a seed was provided\n \"mnemonic\": \"value\", (string) The generated seed encoded as a mnemonic of PGP words, omitted when a seed was provided\n}                     \n",
+		"decoderawtransaction":         "decoderawtransaction \"hextx\"\n\nReturns a JSON object representing the provided serialized, hex-encoded transaction.\nThe transaction is decoded by the wallet and does not require a connection to a consensus server.\n\nArguments:\n1. hextx (string, required) Serialized, hex-encoded transaction\n\nResult:\n{\n \"txid\": \"value\",              (string)          The hash of the transaction\n \"version\": n,                 (numeric)         The transaction version\n \"locktime\": n,                (numeric)         The transaction lock time\n \"expiry\": n,                  (numeric)         The height at which the transaction expires and can no longer be mined\n \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n  \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n  \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n  \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n  \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n  \"tree\": n,                   (numeric)         The transaction tree of the origin transaction (non-coinbase txns only)\n  \"sequence\": n,               (numeric)         The script sequence number\n  \"amountin\": n.nnn,           (numeric)         The amount (in VHC) of the input as committed by the transaction\n  \"blockheight\": n,            (numeric)         The height of the block that includes the origin transaction as committed by the transaction\n  \"blockindex\": n,             (numeric)         The index of the origin transaction in its block as committed by the transaction\n  \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n   \"asm\": \"value\",             (string)          Disassembly of the script\n   \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n  },                                             \n },...],                                         \n \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n  \"value\": n.nnn,              (numeric)         The amount in VHC\n  \"n\": n,                      (numeric)         The index of this transaction output\n  \"version\": n,                (numeric)         The version of the public key script\n  \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n   \"asm\": \"value\",             (string)          Disassembly of the script\n   \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   \"reqSigs\": n,               (numeric)         The number of required signatures\n   \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n   \"addresses\": [\"value\",...], (array of string) The addresses associated with this script\n   \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a ticket commitment\n  },                                             \n },...],                                         \n}                              \n",
+		"decodescript":                 "decodescript \"hexscript\"\n\nReturns a JSON object with information about the provided hex-encoded script.\nThe script is decoded by the wallet and does not require a connection to a consensus server.\n\nArguments:\n1. hexscript (string, required) Hex-encoded script\n\nResult:\n{\n \"asm\": \"value\",             (string)          Disassembly of the script\n \"reqSigs\": n,               (numeric)         The number of required signatures\n \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n \"addresses\": [\"value\",...], (array of string) The addresses associated with this script\n \"p2sh\": \"value\",            (string)          The script hash for use in pay-to-script-hash transactions (only present if the provided redeem script is not already a pay-to-script-hash script)\n \"ismine\": true|false,       (boolean)         Whether the wallet owns any of the addresses or the pay-to-script-hash address of the script\n}                            \n",
+		"deleteprivkey":                "deleteprivkey \"address\" (drophistory=false)\n\nRemoves an imported private or public key from the wallet.\nThe address of the key remains watched for transactions until the wallet is restarted.\n\nArguments:\n1. address     (string, required)                 The P2PKH address or hex encoded public key of the imported key\n2. drophistory (boolean, optional, default=false) Also remove the unspent outputs paying the address from the wallet\n\nResult:\nn (numeric) The number of unspent outputs removed\n",
+		"deletescript":                 "deletescript \"script\" (drophistory=false)\n\nRemoves an imported redeem script and the record of its import from the wallet.\nThe P2SH address of the script remains watched for transactions until the wallet is restarted.\n\nArguments:\n1. script      (string, required)                 The P2SH address or hex encoded redeem script of the imported script\n2. drophistory (boolean, optional, default=false) Also remove the unspent outputs paying the P2SH address from the wallet\n\nResult:\nn (numeric) The number of unspent outputs removed\n",
+		"disablevoting":                "disablevoting\n\nStops the wallet from voting winning tickets and revoking missed tickets until voting is enabled again.\nThe configured enablevoting option is used when the wallet is next started.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"dumpprivkey":                  "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
+		"enablevoting":                 "enablevoting\n\nStarts voting winning tickets and revoking missed tickets owned by the wallet, allowing voting to fail over between wallets without a restart.\nThe wallet must remain unlocked to vote.  The configured enablevoting option is used when the wallet is next started.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"exportvotechoices":            "exportvotechoices\n\nReturns the choices of every agenda of the supported stake version as a document which may be imported by other wallets using importvotechoices.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,         (numeric)         The stake version of the agendas\n \"choices\": [{         (array of object) The choice of each agenda\n  \"agendaid\": \"value\", (string)          The ID of the agenda\n  \"choiceid\": \"value\", (string)          The ID of the agenda's choice\n },...],                                 \n}                      \n",
+		"exportwatchingwallet":         "exportwatchingwallet (\"account\" download=false)\n\nCreates and returns a duplicate of the wallet database without any private keys to be used as a watching-only wallet.\n\nArguments:\n1. account  (string, optional)                 Unused (must be unset or \"*\")\n2. download (boolean, optional, default=false) Unused\n\nResult:\n\"value\" (string) The watching-only database encoded as a base64 string\n",
+		"filldepositpool":              "filldepositpool \"account\" size\n\nReserves external addresses of an account for deposits until the given number of reserved addresses are available for assignment.\nEvery address is derived and recorded in a single database update, and a depositaddress notification is sent for each new address.\nReserved addresses are subject to the unused address gap limit so that they are found when restoring from seed, and size may not exceed the gap limit.\n\nArguments:\n1. account (string, required)  Name of the account\n2. size    (numeric, required) Number of available reserved addresses to maintain\n\nResult:\n[{\n \"account\": \"value\",   (string)  Name of the account the address belongs to\n \"address\": \"value\",   (string)  The reserved address\n \"index\": n,           (numeric) Child index of the address in the account's external branch\n \"status\": \"value\",    (string)  Assignment status of the address (\"available\" or \"assigned\")\n \"created\": n,         (numeric) Unix time the address was reserved\n \"assigned\": n,        (numeric) Unix time the address was assigned\n \"reference\": \"value\", (string)  Reference recorded when the address was assigned\n},...]\n",
+		"finalizecosignsession":        "finalizecosignsession \"session\" (publish=false)\n\nCreates the signed transaction of a cosigning session once every input has the required signatures, verifying each input.\n\nArguments:\n1. session (string, required)                 The JSON-encoded cosigning session\n2. publish (boolean, optional, default=false) Publish the signed transaction\n\nResult:\n{\n \"hex\": \"value\",          (string)  The hex encoded signed transaction\n \"txhash\": \"value\",       (string)  The hash of the signed transaction\n \"published\": true|false, (boolean) Whether the transaction was published\n}                         \n",
+		"generatevote":                 "generatevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\n\nReturns the vote transaction encoded as a hexadecimal string\n\nArguments:\n1. blockhash   (string, required)  Block hash for the ticket\n2. height      (numeric, required) Block height for the ticket\n3. tickethash  (string, required)  The hash of the ticket\n4. votebits    (numeric, required) The voteBits to set for the ticket\n5. votebitsext (string, required)  The extended voteBits to set for the ticket\n\nResult:\n{\n \"hex\": \"value\", (string) The hex encoded transaction\n}                \n",
+		"generatevotes":                "generatevotes \"blockhash\" height [\"tickethash\",...] votebits \"votebitsext\"\n\nReturns vote transactions for several tickets on the same block, encoded as hexadecimal strings.\nFailing to create the vote of one ticket does not prevent votes from being created for the others.\n\nArguments:\n1. blockhash    (string, required)          Block hash for the tickets\n2. height       (numeric, required)         Block height for the tickets\n3. tickethashes (array of string, required) The hashes of the tickets\n4. votebits     (numeric, required)         The voteBits to set for the tickets\n5. votebitsext  (string, required)          The extended voteBits to set for the tickets\n\nResult:\n[{\n \"tickethash\": \"value\", (string) The hash of the ticket\n \"hex\": \"value\",        (string) The hex encoded vote transaction, omitted if the vote could not be created\n \"error\": \"value\",      (string) The reason the vote could not be created, omitted on success\n},...]\n",
+		"getaccountaddress":            "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
+		"getaccount":                   "getaccount \"address\"\n\nDEPRECATED -- Lookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
+		"getaccountreceived":           "getaccountreceived \"account\" (minconf=1)\n\nReturns the amounts received by addresses of an account, including spent outputs, with unmined and insufficiently confirmed credits counted separately as pending.\n\nArguments:\n1. account (string, required)             Account name to query received amounts for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is counted as confirmed (values less than 1 are treated as 1)\n\nResult:\n{\n \"confirmed\": n.nnn, (numeric) The amount (in VHC) received by credits with at least minconf confirmations\n \"pending\": n.nnn,   (numeric) The amount (in VHC) received by unmined credits and credits with fewer than minconf confirmations\n \"total\": n.nnn,     (numeric) The sum of the confirmed and pending amounts\n}                    \n",
+		"getaccountstats":              "getaccountstats (account=\"default\")\n\nReturns the default address gap limit policy of an account and how many addresses have been returned beyond the last used address of each branch.\n\nArguments:\n1. account (string, optional, default=\"default\") Name of the account (default=\"default\")\n\nResult:\n{\n \"account\": \"value\",     (string)  Name of the account\n \"accountnumber\": n,     (numeric) Number of the account\n \"gappolicy\": \"value\",   (string)  Gap policy used when generating addresses without specifying a policy (\"error\", \"ignore\", or \"wrap\")\n \"gaplimit\": n,          (numeric) The unused address gap limit of the wallet\n \"nextexternalindex\": n, (numeric) Child index of the next external address that will be returned\n \"nextinternalindex\": n, (numeric) Child index of the next internal address that will be returned\n \"externalgap\": n,       (numeric) Number of external addresses returned after the last used external address\n \"internalgap\": n,       (numeric) Number of internal addresses returned after the last used internal address\n \"keystorage\": \"value\",  (string)  Where the private keys of the account are kept (\"local\" or \"pkcs11\")\n}                        \n",
+		"getapischema":                 "getapischema\n\nReturns an OpenRPC document describing every method of the server, including the JSON schema of its parameters and result.\nMethods which may only be called by websocket clients are marked with the x-websocketonly extension.\n\nArguments:\nNone\n\nResult:\n{\n \"openrpc\": \"value\",  (string) Version of the OpenRPC specification the document conforms to\n \"info\": {            (object) Title and JSON-RPC API version of the server\n  \"title\": \"value\",   (string) Title of the API\n  \"version\": \"value\", (string) Semantic version of the JSON-RPC API\n },                            \n \"methods\": unknown,  (value)  OpenRPC method objects of every method\n}                     \n",
+		"getauditlog":                  "getauditlog (count=100)\n\nReturns the most recent records of the audit log of state-changing requests, oldest first.\nThe hash chain of the entire log is verified before any records are returned.\n\nArguments:\n1. count (numeric, optional, default=100) Number of most recent records to return, or 0 for every record (default=100)\n\nResult:\n[{\n \"seq\": n,                (numeric)         Sequence number of the record, starting at 1\n \"time\": n,               (numeric)         Unix time the request was received or handled\n \"client\": \"value\",       (string)          Remote address and certificate identity of the client\n \"role\": \"value\",         (string)          Role of the client's credentials\n \"wallet\": \"value\",       (string)          Name of the wallet selected by the request endpoint, omitted for the default wallet\n \"method\": \"value\",       (string)          The method of the request\n \"params\": [\"value\",...], (array of string) JSON encoding of each request parameter, with secret parameters redacted (request records only)\n \"request\": n,            (numeric)         Sequence number of the request record whose outcome is reported (outcome records only)\n \"error\": \"value\",        (string)          Error message if the request failed (outcome records only)\n \"prevhash\": \"value\",     (string)          Hash of the previous record\n \"hash\": \"value\",         (string)          HMAC-SHA256, keyed by the audit log key, of the JSON encoding of this record with an empty hash\n},...]\n",
+		"getautobuyerstatus":           "getautobuyerstatus (\"account\")\n\nReturns whether the ticket buyer is running, and the effective configuration and tickets purchased since it was started of each account's strategy.\n\nArguments:\n1. account (string, optional) Only report the strategy of this account\n\nResult:\n{\n \"running\": true|false,        (boolean)         Whether a ticket buyer is running for any account\n \"strategies\": [{              (array of object) The running strategies, in the order they were started\n  \"config\": {                  (object)          The effective configuration of the strategy\n   \"account\": \"value\",         (string)          The account tickets are purchased from\n   \"balancetomaintain\": n.nnn, (numeric)         The balance (in VHC) kept in the account\n   \"maxfee\": n.nnn,            (numeric)         The maximum ticket fee per KB (in VHC)\n   \"maxpriceabsolute\": n.nnn,  (numeric)         The maximum ticket price (in VHC), or 0 for no limit\n   \"maxpricerelative\": n.nnn,  (numeric)         The scaling factor of the average ticket price used as the maximum price\n   \"maxperblock\": n,           (numeric)         The maximum number of tickets purchased per block\n   \"maxspend\": n.nnn,          (numeric)         The maximum total ticket price (in VHC) spent in any spendwindow blocks, or 0 for no budget\n   \"spendwindow\": n,           (numeric)         The number of blocks the maxspend budget applies to\n   \"votingaddress\": \"value\",   (string)          The address tickets are given voting rights to\n   \"pooladdress\": \"value\",     (string)          The stake pool address fees are paid to\n   \"poolfees\": n.nnn,          (numeric)         The stake pool fee percentage\n  },                                             \n  \"purchased\": n,              (numeric)         The number of tickets purchased since the strategy was started\n  \"spent\": n.nnn,              (numeric)         The total ticket price paid for the purchased tickets, excluding transaction fees\n  \"lasterror\": \"value\",        (string)          The most recent error which failed a purchase attempt\n  \"lasterrorheight\": n,        (numeric)         The block height the most recent error occurred at\n  \"nextheight\": n,             (numeric)         The block height the strategy next evaluates purchases at\n },...],                                         \n}                              \n",
+		"getautoconsolidation":         "getautoconsolidation (\"account\")\n\nReturns the configuration and status of the automatic consolidation of the outputs of each configured account.\n\nArguments:\n1. account (string, optional) Only report the automatic consolidation of this account\n\nResult:\n{\n \"accounts\": [{           (array of object) The automatic consolidation of each configured account, ordered by account number\n  \"account\": \"value\",     (string)          The account whose outputs are consolidated\n  \"threshold\": n,         (numeric)         The number of spendable outputs which must be exceeded before outputs are consolidated\n  \"maxinputs\": n,         (numeric)         The maximum number of outputs consolidated by each transaction, or 0 for no limit\n  \"maxblockusage\": n.nnn, (numeric)         The fraction of the maximum block size the latest block may use for outputs to be consolidated\n  \"outputs\": n,           (numeric)         The number of spendable outputs counted by the latest evaluation\n  \"evaluatedheight\": n,   (numeric)         The block height of the latest evaluation\n  \"consolidations\": n,    (numeric)         The number of consolidation transactions published since automatic consolidation was configured\n  \"lasttx\": \"value\",      (string)          The hash of the most recent consolidation transaction\n  \"lasttxheight\": n,      (numeric)         The block height the most recent consolidation transaction was created at\n  \"lasterror\": \"value\",   (string)          The most recent error which failed a consolidation\n  \"lasterrorheight\": n,   (numeric)         The block height the most recent error occurred at\n },...],                                    \n}                         \n",
+		"getaddressreceived":           "getaddressreceived \"address\" (minconf=1)\n\nReturns the amounts received by a single address, including spent outputs, with unmined and insufficiently confirmed credits counted separately as pending.\n\nArguments:\n1. address (string, required)             Payment address to query received amounts for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is counted as confirmed (values less than 1 are treated as 1)\n\nResult:\n{\n \"confirmed\": n.nnn, (numeric) The amount (in VHC) received by credits with at least minconf confirmations\n \"pending\": n.nnn,   (numeric) The amount (in VHC) received by unmined credits and credits with fewer than minconf confirmations\n \"total\": n.nnn,     (numeric) The sum of the confirmed and pending amounts\n}                    \n",
+		"getaddressesbyaccount":        "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
+		"getbalance":                   "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\nClients which selected API version 4 receive only the spendable balance, as a number.\n\nArguments:\n1. account (string, optional)             DEPRECATED -- The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                         (array of object) Balances for all accounts.\n  \"accountname\": \"value\",               (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,     (numeric)         Immature Coinbase reward coins.\n  \"immaturestakegeneration\": n.nnn,     (numeric)         Number of immature stake coins.\n  \"lockedbytickets\": n.nnn,             (numeric)         Coins locked by tickets.\n  \"spendable\": n.nnn,                   (numeric)         Spendable number of coins.\n  \"total\": n.nnn,                       (numeric)         Total amount of coins.\n  \"unconfirmed\": n.nnn,                 (numeric)         Unconfirmed number of coins.\n  \"votingauthority\": n.nnn,             (numeric)         Coins for voting authority.\n },...],                                                  \n \"blockhash\": \"value\",                  (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn, (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": n.nnn, (numeric)         Total number of immature stake coins.\n \"totallockedbytickets\": n.nnn,         (numeric)         Total number of coins locked by tickets.\n \"totalspendable\": n.nnn,               (numeric)         Total number of spendable number of coins.\n \"cumulativetotal\": n.nnn,              (numeric)         Total number of coins.\n \"totalunconfirmed\": n.nnn,             (numeric)         Total number of unconfirmed coins.\n \"totalvotingauthority\": n.nnn,         (numeric)         Total number of coins for voting authority.\n}                                       \n",
+		"getbalanceathash":             "getbalanceathash \"blockhash\" (\"account\")\n\nCalculates and returns the total balance of each account as of a main chain block by replaying all transactions mined at or before it.\n\nArguments:\n1. blockhash (string, required) Hash of the main chain block to calculate balances at\n2. account   (string, optional) The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n\nResult:\n{\n \"blockhash\": \"value\",    (string)          Hash of the block the balances were calculated at.\n \"height\": n,             (numeric)         Height of the block the balances were calculated at.\n \"balances\": [{           (array of object) Balances of each account as of the block.\n  \"accountname\": \"value\", (string)          Name of account.\n  \"total\": n.nnn,         (numeric)         Total amount of coins in the account as of the block.\n },...],                                    \n \"total\": n.nnn,          (numeric)         Total balance of all reported accounts.\n}                         \n",
+		"getbuildinfo":                 "getbuildinfo\n\nReturns the version, source revision, and build environment of the running wallet and its enabled feature flags.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": \"value\",               (string)          The semantic version of the wallet\n \"commit\": \"value\",                (string)          The source revision the wallet was built from (omitted when not recorded at build time)\n \"buildtags\": [\"value\",...],       (array of string) The build tags the wallet was built with\n \"goversion\": \"value\",             (string)          The Go version the wallet was built with\n \"platform\": \"value\",              (string)          The operating system and architecture the wallet was built for\n \"enabledfeatures\": [\"value\",...], (array of string) The names of the enabled feature flags\n}                                  \n",
+		"getdbstats":                   "getdbstats\n\nReturns the storage statistics of the wallet database and of each root bucket and the buckets nested directly within them.\n\nArguments:\nNone\n\nResult:\n{\n \"database\": {      (object)          Storage statistics of the database file (omitted if the database driver does not report them)\n  \"path\": \"value\",  (string)          The file path of the wallet database (omitted unless called with admin credentials)\n  \"size\": n,        (numeric)         The size of the wallet database file in bytes\n  \"freespace\": n,   (numeric)         Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)\n  \"writeerrors\": n, (numeric)         The number of failed database writes since the wallet was opened\n },                                   \n \"buckets\": [{      (array of object) Storage statistics of each bucket, ordered by name\n  \"name\": \"value\",  (string)          Path of the bucket, with the keys of nested buckets separated by a slash and unprintable keys hex encoded\n  \"keys\": n,        (numeric)         The number of keys in the bucket and its nested buckets, including the keys of nested buckets\n  \"buckets\": n,     (numeric)         The number of buckets, including the bucket itself and its nested buckets\n  \"depth\": n,       (numeric)         The number of levels of the bucket's storage tree\n  \"inusebytes\": n,  (numeric)         The number of bytes used to store the bucket\n  \"allocbytes\": n,  (numeric)         The number of bytes allocated for the storage of the bucket\n },...],                              \n}                   \n",
+		"getbestblockhash":             "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
+		"getfeatureflags":              "getfeatureflags\n\nReturns every feature flag and whether it is enabled.\nMethods of disabled features return an error with code -18.\nFlags are enabled and disabled with the enablefeature and disablefeature options.\n\nArguments:\nNone\n\nResult:\n[{\n \"name\": \"value\",        (string)  The name of the feature flag\n \"description\": \"value\", (string)  Description of the feature\n \"enabled\": true|false,  (boolean) Whether the feature is enabled\n},...]\n",
+		"getguardstatus":               "getguardstatus\n\nReturns the state of the anomaly guard.\nThe guard locks the wallet when a configured trigger fires, and refuses walletpassphrase requests with error code -19 until it is cleared with clearguard.\n\nArguments:\nNone\n\nResult:\n{\n \"triggered\": true|false, (boolean)         Whether a trigger has fired since the guard was last cleared\n \"passphrasefailures\": n, (numeric)         The number of consecutive incorrect passphrases\n \"alerts\": [{             (array of object) The alerts raised by fired triggers, oldest first\n  \"time\": n,              (numeric)         The Unix time the trigger fired\n  \"trigger\": \"value\",     (string)          The trigger which fired (passphrase, sendcap, or origin)\n  \"client\": \"value\",      (string)          The address of the client whose request fired the trigger\n  \"detail\": \"value\",      (string)          Description of the suspicious activity\n },...],                                    \n}                         \n",
+		"getbestblock":                 "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
+		"getblockcount":                "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
+		"getinfo":                      "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in VHC/KB\n \"errors\": \"value\",     (string)  Any current errors, including warnings when the wallet casts votes of a version older than the stake version of recent blocks or the anomaly guard is triggered\n \"database\": {          (object)  Storage statistics of the wallet database (omitted if unavailable)\n  \"path\": \"value\",      (string)  The file path of the wallet database (omitted unless called with admin credentials)\n  \"size\": n,            (numeric) The size of the wallet database file in bytes\n  \"freespace\": n,       (numeric) Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)\n  \"writeerrors\": n,     (numeric) The number of failed database writes since the wallet was opened\n },                               \n}                       \n",
+		"getmasterpubkey":              "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
+		"getmultisigoutinfo":           "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
+		"getnewaddress":                "getnewaddress (\"account\" \"gappolicy\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account   (string, optional) Account name the new address will belong to (default=\"default\")\n2. gappolicy (string, optional) String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\" (default is the account gap policy)\n\nResult:\n\"value\" (string) The payment address\n",
+		"getpeerinfo":                  "getpeerinfo\n\nReturns data about each peer the wallet synchronizes with using SPV, including the capabilities negotiated with the peer.\nWhen the wallet is synchronized using the consensus RPC server, the request is passed through to the server and its peers are returned instead.\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": n,                       (numeric)         A unique identifier of the peer, increasing with each connection\n \"addr\": \"value\",               (string)          The remote IP address and port of the peer\n \"services\": \"value\",           (string)          Service flags advertised by the peer, encoded as in the consensus RPC server's getpeerinfo result\n \"servicenames\": \"value\",       (string)          Names of the service flags advertised by the peer\n \"version\": n,                  (numeric)         The protocol version advertised by the peer\n \"protocolversion\": n,          (numeric)         The protocol version negotiated with the peer\n \"subver\": \"value\",             (string)          The user agent of the peer\n \"conntime\": n,                 (numeric)         Unix time the connection to the peer was established\n \"startingheight\": n,           (numeric)         The block height the peer advertised when connecting\n \"banscore\": n,                 (numeric)         The ban score of the peer\n \"capabilities\": [\"value\",...], (array of string) Optional protocol features supported by the peer (cfilters, sendheaders, feefilter)\n},...]\n",
+		"getrawchangeaddress":          "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
+		"getreceivedbyaccount":         "getreceivedbyaccount \"account\" (minconf=1)\n\nDEPRECATED -- Returns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in valhallacoin\n",
+		"getreceivedbyaddress":         "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in valhallacoin\n",
+		"getmultisigaccountaddress":    "getmultisigaccountaddress \"name\" (internal=false)\n\nReturns the P2SH address and redeem script of the next child index of a multisig account branch and watches the address for received outputs.\n\nArguments:\n1. name     (string, required)                 The name of the multisig account\n2. internal (boolean, optional, default=false) Derive the address from the internal branch instead of the external branch\n\nResult:\n{\n \"address\": \"value\",      (string)  The P2SH address of the child\n \"redeemscript\": \"value\", (string)  The hex encoded multisig redeem script\n \"branch\": n,             (numeric) The branch of the child (0=external, 1=internal)\n \"index\": n,              (numeric) The child index\n}                         \n",
+		"getrescanstatus":              "getrescanstatus\n\nReports the progress of the current or most recent rescan of the wallet.\nReturns null if the wallet has not been rescanned since it was opened.\n\nArguments:\nNone\n\nResult:\n{\n \"rescanning\": true|false, (boolean) Whether the rescan is in progress\n \"startheight\": n,         (numeric) The height of the first block scanned\n \"targetheight\": n,        (numeric) The height of the main chain tip the rescan is scanning through\n \"scannedthrough\": n,      (numeric) The height of the last block scanned\n \"percent\": n.nnn,         (numeric) The percentage of blocks through the target height which have been scanned\n \"addressesdiscovered\": n, (numeric) The number of distinct wallet addresses paid by transactions found by the rescan\n \"started\": n,             (numeric) The Unix time the rescan started\n \"error\": \"value\",         (string)  The error which ended the rescan, omitted if the rescan is in progress or completed successfully\n}                          \n",
+		"getresponsesigningkey":        "getresponsesigningkey\n\nReturns the public key which signs the responses of selected methods and the names of those methods.\nThe result of a signed method is replaced by an object with the keys payload, signature, and pubkey.\nThe payload is a JSON string encoding an object with the method, id, time, and result of the request, and the signature is a DER encoded secp256k1 ECDSA signature of the SHA-256 hash of the payload.\nThe key should be pinned by clients out of band rather than trusted from this method.\n\nArguments:\nNone\n\nResult:\n{\n \"pubkey\": \"value\",        (string)          Hex encoded compressed secp256k1 public key which signs responses\n \"methods\": [\"value\",...], (array of string) Methods whose responses are signed\n}                          \n",
+		"getspendingpolicy":            "getspendingpolicy \"account\"\n\nReturns the spending limits of an account and the amount sent from it during the current UTC day.\n\nArguments:\n1. account (string, required) Name of the account\n\nResult:\n{\n \"account\": \"value\",        (string)  Name of the account.\n \"txlimit\": n.nnn,          (numeric) Maximum amount which may be sent by a single transaction (0 when unlimited).\n \"dailylimit\": n.nnn,       (numeric) Maximum total amount which may be sent during a UTC day (0 when unlimited).\n \"dailyspent\": n.nnn,       (numeric) Total amount sent during the current UTC day.\n \"dailyremaining\": n.nnn,   (numeric) Amount which may still be sent during the current UTC day without exceeding the daily limit.\n \"overridable\": true|false, (boolean) Whether the limits may be exceeded after providing an override passphrase.\n \"overridden\": true|false,  (boolean) Whether the limits are currently overridden.\n}                           \n",
+		"getstakeinfo":                 "getstakeinfo\n\nReturns statistics about staking from the wallet.\nWithout an RPC connection to vhcd (e.g. in SPV mode), ticket states are determined from the wallet's view of the main chain: tickets revoked before expiry are missed, tickets detected as missed votes are missed until revoked, and other mature unspent tickets are live until expiry. The allmempooltix field is zero in this mode.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by stake mining\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
+		"getstakingstats":              "getstakingstats (starttime endtime)\n\nReturns the tickets bought, votes, and revocations of the wallet mined in blocks within a time range, with the rewards earned, fees paid, and annualized return.\n\nArguments:\n1. starttime (numeric, optional) Unix time beginning the range (defaults to the wallet's first mined stake transaction)\n2. endtime   (numeric, optional) Unix time ending the range, exclusive (defaults to the current time)\n\nResult:\n{\n \"starttime\": n,            (numeric) Unix time beginning the range\n \"endtime\": n,              (numeric) Unix time ending the range\n \"ticketsbought\": n,        (numeric) Number of tickets bought by the wallet\n \"ticketcost\": n.nnn,       (numeric) Total price of the tickets bought by the wallet\n \"votes\": n,                (numeric) Number of votes\n \"voterewards\": n.nnn,      (numeric) Total stakebase rewards earned by votes\n \"revocations\": n,          (numeric) Number of revocations\n \"fees\": n.nnn,             (numeric) Total fees paid by tickets bought by the wallet, votes, and revocations\n \"annualizedreturn\": n.nnn, (numeric) Vote rewards less fees as a fraction of the ticket cost, scaled to a year\n}                           \n",
+		"getsyncstatus":                "getsyncstatus\n\nReports the progress of the wallet's synchronization with its network backend (vhcd or SPV peers), for displaying the progress of the initial sync.\n\nArguments:\nNone\n\nResult:\n{\n \"synced\": true|false,     (boolean) Whether the initial synchronization with the network backend is complete\n \"tiphash\": \"value\",       (string)  The hash of the wallet's main chain tip block\n \"tipheight\": n,           (numeric) The height of the wallet's main chain tip block\n \"backendheight\": n,       (numeric) The best block height known by the network backend, or 0 if not yet known\n \"headersfetched\": n,      (numeric) The number of new block headers fetched since the network backend connected\n \"cfiltersfetched\": n,     (numeric) The number of compact filters fetched since the network backend connected\n \"rescanpoint\": \"value\",   (string)  The hash of the first block not yet scanned for wallet transactions, omitted when every block has been scanned\n \"rescanpointheight\": n,   (numeric) The height of the rescan point block\n \"rescanning\": true|false, (boolean) Whether a rescan is in progress\n \"rescannedthrough\": n,    (numeric) The last block height scanned by the current or most recent rescan\n \"estimatedremaining\": n,  (numeric) Estimated seconds remaining until the wallet is synchronized, or 0 when synchronized or no estimate is available\n}                          \n",
+		"getticketfee":                 "getticketfee\n\nGet the current fee per kB of the serialized tx size used for an authored stake transaction.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The current fee\n",
+		"getticketinfo":                "getticketinfo \"tickethash\"\n\nReturns the status of a ticket and the vote bits and agenda choices of every vote the wallet created for it.\n\nArguments:\n1. tickethash (string, required) Hash of the ticket\n\nResult:\n{\n \"tickethash\": \"value\",   (string)          Hash of the ticket\n \"status\": \"value\",       (string)          Current status of the ticket (\"unknown\", \"unmined\", \"immature\", \"live\", \"voted\", \"revoked\", \"missed\", or \"expired\")\n \"blockhash\": \"value\",    (string)          Hash of the block the ticket was mined in, if mined\n \"blockheight\": n,        (numeric)         Height of the block the ticket was mined in, or -1 if unmined\n \"spenderhash\": \"value\",  (string)          Hash of the vote or revocation spending the ticket, if spent\n \"votes\": [{              (array of object) Records of the votes created by the wallet for the ticket\n  \"tickethash\": \"value\",  (string)          Hash of the ticket\n  \"votehash\": \"value\",    (string)          Hash of the vote transaction\n  \"blockhash\": \"value\",   (string)          Hash of the block voted on\n  \"blockheight\": n,       (numeric)         Height of the block voted on\n  \"time\": n,              (numeric)         Unix time the vote was created\n  \"votebits\": n,          (numeric)         The vote bits cast by the vote\n  \"votebitsext\": \"value\", (string)          The hex encoded extended vote bits cast by the vote\n  \"voteversion\": n,       (numeric)         The stake version of the vote\n  \"choices\": [{           (array of object) The agenda choices of the stake version cast by the vote bits\n   \"agendaid\": \"value\",   (string)          The ID of the agenda\n   \"choiceid\": \"value\",   (string)          The ID of the agenda's choice\n  },...],                                   \n },...],                                    \n \"votingxpubindex\": n,    (numeric)         Child index of the external branch of the --ticketbuyer.votingxpub extended public key which derived the voting address of the ticket, omitted when not derived from it\n}                         \n",
+		"gettickets":                   "gettickets includeimmature\n\nReturning the hashes of the tickets currently owned by wallet.\nUse listticketstatuses to list the status and purchase height of tickets filtered by status.\n\nArguments:\n1. includeimmature (boolean, required) If true include immature tickets in the results.\n\nResult:\n{\n \"hashes\": [\"value\",...], (array of string) Hashes of the tickets owned by the wallet encoded as strings\n}                         \n",
+		"gettransaction":               "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in valhallacoin\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"transfer\" for both sides of transfers between accounts of the wallet, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
+		"getunconfirmedbalance":        "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in valhallacoin.\n",
+		"getvotechoices":               "getvotechoices\n\nRetrieve the currently configured vote choices for the latest supported stake agendas\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
+		"getvspinfo":                   "getvspinfo\n\nReturns info about the selected voting service provider (VSP) and the tickets registered with it\n\nArguments:\nNone\n\nResult:\n{\n \"host\": \"value\",         (string)           The URL of the VSP\n \"pubkey\": \"value\",       (string)           The base64-encoded Ed25519 public key which signs VSP responses\n \"network\": \"value\",      (string)           The network the VSP operates on\n \"apiversions\": [n,...],  (array of numeric) The API versions supported by the VSP\n \"feepercentage\": n.nnn,  (numeric)          The percentage of the ticket value charged as the VSP fee\n \"feexpub\": \"value\",      (string)           The extended public key the VSP derives fee addresses from\n \"vspclosed\": true|false, (boolean)          Whether the VSP is closed to new tickets\n \"tickets\": [{            (array of object)  The tickets purchased for the VSP\n  \"tickethash\": \"value\",  (string)           The hash of the ticket\n  \"status\": \"value\",      (string)           The registration status of the ticket (unpaid, signed, published, or registered)\n  \"feeaddress\": \"value\",  (string)           The address the VSP fee is paid to\n  \"feeamount\": n.nnn,     (numeric)          The VSP fee of the ticket\n  \"feetxhash\": \"value\",   (string)           The hash of the transaction paying the VSP fee\n },...],                                     \n}                         \n",
+		"getwalletfee":                 "getwalletfee\n\nGet currently set transaction fee for the wallet\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) Current tx fee (in VHC)\n",
+		"help":                         "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
+		"importmulti":                  "importmulti [{\"privkey\":\"value\",\"pubkey\":\"value\",\"xpub\":\"value\",\"redeemscript\":\"value\",\"range\":[range,...],\"timestamp\":timestamp,\"watchonly\":watchonly},...] (rescan=true)\n\nImports private keys, public keys, extended public keys, and redeem scripts to the 'imported' account in a single database transaction.\nPublic keys, extended public keys, and private keys imported with watchonly set are watching-only.\nThe outcome of each request is returned in the same order as the requests.\nWhen rescanning, a single rescan begins from the earliest timestamp of the imported requests.\n\nArguments:\n1. requests (array of object, required) The keys and scripts to import\n[{\n \"privkey\": \"value\",      (string)           A WIF-encoded private key\n \"pubkey\": \"value\",       (string)           A hex encoded public key, imported watching-only\n \"xpub\": \"value\",         (string)           An extended public key whose external and internal branch children are imported watching-only\n \"redeemscript\": \"value\", (string)           A hex encoded redeem script for a P2SH output\n \"range\": [n,...],        (array of numeric) The first and last child indexes of each branch of xpub to import (default: 0 through 19)\n \"timestamp\": n,          (numeric)          The UNIX timestamp of the earliest transaction which may pay to the imported addresses, or unset if no rescan is required for this request\n \"watchonly\": true|false, (boolean)          Import only the public key of privkey\n},...]\n2. rescan (boolean, optional, default=true) Rescan the blockchain from the earliest request timestamp for outputs controlled by the imported keys and scripts\n\nResult:\n[{\n \"success\": true|false,      (boolean)         Whether the request was imported, including when it was previously imported\n \"addresses\": [\"value\",...], (array of string) The addresses of the imported keys or script\n \"error\": \"value\",           (string)          The reason the request could not be imported, omitted on success\n},...]\n",
+		"importpkcs11account":          "importpkcs11account \"account\" \"xpub\"\n\nCreates an account from the account extended public key of keys kept inside the hardware security module configured by the pkcs11module option.\nThe private keys of the account are never known by the wallet, and its inputs and messages are signed inside the module.\nThe module must hold the private key of each derived address, found by the Hash160 of its public key, and is verified to hold the key of the first external address.\nAccounts with keys kept by the module are not restored from the wallet seed.\n\nArguments:\n1. account (string, required) Name of the new account\n2. xpub    (string, required) The account extended public key exported by the token\n\nResult:\nNothing\n",
+		"importprivkey":                "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
+		"importscript":                 "importscript \"hex\" (rescan=true scanfrom)\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescansfdsfd the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
+		"importvotechoices":            "importvotechoices \"document\"\n\nApplies the agenda choices of a document created by exportvotechoices.\nAgendas which are not included in the document are set to abstain.\nThe document must be for the stake version supported by the wallet, and either every choice is applied or none are.\n\nArguments:\n1. document (string, required) JSON document of the form {\"version\":n,\"choices\":[{\"agendaid\":\"id\",\"choiceid\":\"id\"},...]}\n\nResult:\nNothing\n",
+		"joinsplitticketsession":       "joinsplitticketsession \"session\" \"account\" (amount minconf=1)\n\nContributes funds of an account to a split ticket session, returning the updated session.\nThe contributed outputs pay the contribution and its share of the ticket fee, and are reserved for the session for two hours.  Sessions may not be joined after any participant has signed.\n\nArguments:\n1. session (string, required)             The JSON-encoded split ticket session\n2. account (string, required)             The account funding the contribution\n3. amount  (numeric, optional)            The share of the ticket price (in VHC) to contribute; the unfunded remainder is contributed when omitted\n4. minconf (numeric, optional, default=1) Minimum number of block confirmations of the contributed outputs\n\nResult:\n{\n \"session\": \"value\",    (string)  The JSON-encoded session passed between the participants\n \"ticketprice\": n.nnn,  (numeric) The price (in VHC) of the ticket\n \"unfunded\": n.nnn,     (numeric) The share of the ticket price (in VHC) not yet contributed by any participant\n \"tickethash\": \"value\", (string)  The hash of the ticket, set once the session is signed\n}                       \n",
+		"keypoolrefill":                "keypoolrefill (newsize=100)\n\nDEPRECATED -- This request does nothing since no keypool is maintained.\n\nArguments:\n1. newsize (numeric, optional, default=100) Unused\n\nResult:\nNothing\n",
+		"listaccounts":                 "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in valhallacoin, (object) JSON object with account names as keys and valhallacoin amounts as values\n ...\n}\n",
+		"listaddresstransactions":      "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"transfer\" for both sides of transfers between accounts of the wallet, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listdepositaddresses":         "listdepositaddresses (\"account\" \"status\")\n\nReturns the reserved deposit addresses of the wallet, ordered by account and then by creation.\n\nArguments:\n1. account (string, optional) Only include addresses of this account\n2. status  (string, optional) Only include addresses with this status (\"available\" or \"assigned\")\n\nResult:\n[{\n \"account\": \"value\",   (string)  Name of the account the address belongs to\n \"address\": \"value\",   (string)  The reserved address\n \"index\": n,           (numeric) Child index of the address in the account's external branch\n \"status\": \"value\",    (string)  Assignment status of the address (\"available\" or \"assigned\")\n \"created\": n,         (numeric) Unix time the address was reserved\n \"assigned\": n,        (numeric) Unix time the address was assigned\n \"reference\": \"value\", (string)  Reference recorded when the address was assigned\n},...]\n",
+		"listalltransactions":          "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"transfer\" for both sides of transfers between accounts of the wallet, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listlockunspent":              "listlockunspent\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\nNone\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
+		"listmissedvotes":              "listmissedvotes\n\nReturns the tickets with voting authority held by the wallet most recently detected to have missed their votes, ordered by the height of the block they were selected to vote on.\nA vote is missed when it is not included in the block following the block the ticket was selected to vote on. Missed votes are only detected while the wallet is synced and are not remembered across restarts.\n\nArguments:\nNone\n\nResult:\n[{\n \"tickethash\": \"value\", (string)  Hash of the ticket which missed its vote\n \"blockhash\": \"value\",  (string)  Hash of the block the ticket was selected to vote on\n \"blockheight\": n,      (numeric) Height of the block the ticket was selected to vote on\n \"detected\": n,         (numeric) Unix time the missed vote was detected\n},...]\n",
+		"listmultisigaccounts":         "listmultisigaccounts\n\nReturns every HD multisig account of the wallet.\n\nArguments:\nNone\n\nResult:\n[{\n \"name\": \"value\",        (string)          The name of the multisig account\n \"account\": \"value\",     (string)          The wallet account whose extended public key is one of the cosigner keys\n \"m\": n,                 (numeric)         The number of signatures required to spend outputs of the account\n \"n\": n,                 (numeric)         The number of cosigner keys\n \"xpubs\": [\"value\",...], (array of string) The account extended public keys of every cosigner\n \"nextexternal\": n,      (numeric)         The next child index of the external branch\n \"nextinternal\": n,      (numeric)         The next child index of the internal branch\n},...]\n",
+		"listmultisigunspent":          "listmultisigunspent (\"address\")\n\nReturns every unspent P2SH multisig output recorded by the wallet with its redeem script and confirmation data.\n\nArguments:\n1. address (string, optional) Only return outputs paying this P2SH address\n\nResult:\n[{\n \"txhash\": \"value\",        (string)          The hash of the transaction creating the output\n \"vout\": n,                (numeric)         The output index\n \"tree\": n,                (numeric)         The transaction tree of the output\n \"address\": \"value\",       (string)          The P2SH address paid by the output\n \"redeemscript\": \"value\",  (string)          The hex encoded multisig redeem script\n \"m\": n,                   (numeric)         The number of signatures required to spend the output\n \"n\": n,                   (numeric)         The number of public keys of the redeem script\n \"pubkeys\": [\"value\",...], (array of string) The hex encoded public keys of the redeem script\n \"amount\": n.nnn,          (numeric)         The output amount (in VHC)\n \"blockhash\": \"value\",     (string)          The hash of the block mining the output, omitted when unmined\n \"blockheight\": n,         (numeric)         The height of the block mining the output, omitted when unmined\n \"confirmations\": n,       (numeric)         The number of block confirmations of the output\n},...]\n",
+		"listoutpointlocks":            "listoutpointlocks (\"namespace\")\n\nReturns the locked outpoints of every namespace, sorted by namespace and then by expiry.\n\nArguments:\n1. namespace (string, optional) Only include outpoints locked in this namespace (the default namespace of lockunspent is the empty string)\n\nResult:\n[{\n \"txid\": \"value\",          (string)  The transaction hash of the locked output\n \"vout\": n,                (numeric) The output index of the locked output\n \"tree\": n,                (numeric) The tree of the transaction of the locked output\n \"namespace\": \"value\",     (string)  The namespace holding the lock\n \"expires\": n,             (numeric) The Unix time the lock is released, omitted for locks which do not expire\n \"expiryheight\": n,        (numeric) The main chain height the lock is released at, omitted for locks which are not released at a height\n \"persistent\": true|false, (boolean) Whether the lock is saved across wallet restarts\n},...]\n",
+		"listpendingrevocations":       "listpendingrevocations\n\nReturns the missed tickets whose automatic revocations are delayed by the revocationdelay option, ordered by the time they will be revoked.\n\nArguments:\nNone\n\nResult:\n[{\n \"tickethash\": \"value\", (string)  Hash of the missed ticket\n \"reported\": n,         (numeric) Unix time the ticket was reported missed\n \"scheduled\": n,        (numeric) Unix time the revocation will be created and published\n},...]\n",
+		"listpendingsends":             "listpendingsends (\"account\")\n\nReturns the sends queued by the wallet for approval, oldest first.\n\nArguments:\n1. account (string, optional) Only include sends from this account\n\nResult:\n[{\n \"id\": \"value\",      (string) The ID of the pending send\n \"account\": \"value\", (string) The account the send is from\n \"amounts\": {        (object) Pairs of payment addresses and the output amount to pay each\n  \"Address to pay\": Amount to send to the payment address valued in valhallacoin, (object) JSON object using payment addresses as keys and output amounts valued in valhallacoin to send to each address\n  ...\n }\n \"total\": n.nnn,       (numeric) Total amount of all outputs\n \"minconf\": n,         (numeric) Minimum number of block confirmations required for the spent outputs\n \"time\": n,            (numeric) Unix time the send was queued\n \"requester\": \"value\", (string)  The client which requested the send, identified by its TLS client certificate or username\n},...]\n",
+		"listreceivedbyaccount":        "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nDEPRECATED -- Returns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in valhallacoin\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
+		"listreceivedbyaddress":        "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in valhallacoin\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
+		"listrevocabletickets":         "listrevocabletickets\n\nReturns the unrevoked missed and expired tickets with voting authority held by the wallet, ordered by the height they were mined at, for review before calling revoketickets.\nExpired tickets are determined from the main chain tip and missed tickets from the missed votes detected by the wallet (see listmissedvotes).\n\nArguments:\nNone\n\nResult:\n[{\n \"tickethash\": \"value\", (string)  Hash of the ticket\n \"status\": \"value\",     (string)  Status of the ticket (\"missed\" or \"expired\")\n \"blockheight\": n,      (numeric) Height of the block the ticket was mined in\n \"fee\": n.nnn,          (numeric) Estimated fee of the revocation at the relay fee\n},...]\n",
+		"listscripts":                  "listscripts\n\nList all scripts that have been added to wallet\n\nArguments:\nNone\n\nResult:\n{\n \"scripts\": [{             (array of object) A list of the imported scripts\n  \"hash160\": \"value\",      (string)          The script hash\n  \"address\": \"value\",      (string)          The script address\n  \"redeemscript\": \"value\", (string)          The redeem script\n  \"importtime\": n,         (numeric)         The Unix time the script was imported, omitted when the import was not recorded\n  \"origin\": \"value\",       (string)          The request which imported the script, such as the RPC method, omitted when the import was not recorded\n  \"user\": \"value\",         (string)          The RPC user which imported the script, omitted when unknown\n  \"rescanfrom\": n,         (numeric)         The height the import rescanned the chain from, omitted when no rescan was performed\n  \"unspent\": true|false,   (boolean)         Whether any unspent outputs of the wallet pay to the script\n },...],                                     \n}                          \n",
+		"listsinceblock":               "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"transfer\" for both sides of transfers between accounts of the wallet, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listsplittickets":             "listsplittickets\n\nLists the split tickets co-funded by the wallet with the wallet's contribution and reward.\nVotes and revocations are only known to wallets recording them, such as the wallet owning the voting address.\n\nArguments:\nNone\n\nResult:\n[{\n \"tickethash\": \"value\", (string)  The hash of the ticket\n \"account\": \"value\",    (string)  The account which contributed to the ticket\n \"status\": \"value\",     (string)  The status of the ticket (unpublished, unmined, live, voted, or revoked)\n \"ticketprice\": n.nnn,  (numeric) The price (in VHC) of the ticket\n \"contribution\": n.nnn, (numeric) The amount (in VHC) committed to the ticket by the wallet, including its share of the fee\n \"share\": n.nnn,        (numeric) The fraction of the ticket commitments owned by the wallet\n \"returned\": n.nnn,     (numeric) The amount (in VHC) paid to the wallet by the vote or revocation\n \"reward\": n.nnn,       (numeric) The returned amount less the wallet's contribution\n},...]\n",
+		"liststakepoolusertickets":     "liststakepoolusertickets \"user\" (\"status\" \"after\" limit=100)\n\nLists a page of the tickets of a stake pool user ordered by ticket hash, optionally filtered by status.\nPages are read from indexes of the user's tickets and do not require loading every ticket of the user.\n\nArguments:\n1. user   (string, required)               The P2SH or P2PKH voting address of the user\n2. status (string, optional)               Only list tickets with this status (live, voted, missed, or expired)\n3. after  (string, optional)               Only list tickets with hashes greater than this ticket hash, usually the next value of the previous page\n4. limit  (numeric, optional, default=100) Maximum number of tickets to return\n\nResult:\n{\n \"tickets\": [{        (array of object) The tickets of the page\n  \"status\": \"value\",  (string)          The current status of the added ticket\n  \"ticket\": \"value\",  (string)          The hash of the added ticket\n  \"ticketheight\": n,  (numeric)         The height in which the ticket was added\n  \"spentby\": \"value\", (string)          The vote in which the ticket was spent\n  \"spentbyheight\": n, (numeric)         The height in which the ticket was spent\n },...],                                \n \"next\": \"value\",     (string)          The hash of the last ticket of a full page, to be passed as the after parameter to request the next page\n}                     \n",
+		"listticketstatuses":           "listticketstatuses (\"status\" offset=0 limit=100)\n\nLists the status and purchase height of the tickets of the wallet, newest first, optionally filtered by status.\nThe states of tickets are determined from the wallet's view of the main chain: unrevoked tickets are reported missed when the wallet detected their missed votes, and live otherwise until they expire.\n\nArguments:\n1. status (string, optional)               Only list tickets with this status (unmined, immature, live, voted, missed, expired, or revoked), or unspent for mined tickets which are neither voted nor revoked\n2. offset (numeric, optional, default=0)   Number of matching tickets to skip\n3. limit  (numeric, optional, default=100) Maximum number of tickets to return\n\nResult:\n{\n \"total\": n,          (numeric)         Number of tickets matching the status filter\n \"tickets\": [{        (array of object) The matching tickets after applying the offset and limit\n  \"hash\": \"value\",    (string)          The hash of the ticket\n  \"status\": \"value\",  (string)          The status of the ticket\n  \"height\": n,        (numeric)         The height of the block the ticket was purchased in, or -1 for unmined tickets\n  \"spender\": \"value\", (string)          The hash of the vote or revocation spending the ticket, if any\n },...],                                \n}                     \n",
+		"listtransactions":             "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in valhallacoin\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"transfer\" for both sides of transfers between accounts of the wallet, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listunspent":                  "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n[{\n \"txid\": \"value\",           (string)  The transaction hash of the referenced output\n \"vout\": n,                 (numeric) The output index of the referenced output\n \"tree\": n,                 (numeric) The tree the transaction comes from\n \"txtype\": n,               (numeric) The type of the transaction\n \"address\": \"value\",        (string)  The payment address that received the output\n \"account\": \"value\",        (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\",   (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\",   (string)  Unset\n \"amount\": n.nnn,           (numeric) The amount of the output valued in valhallacoin\n \"confirmations\": n,        (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false,   (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n \"stakeoutput\": \"value\",    (string)  The kind of stake transaction output (\"ticket\", \"ticketchange\", \"vote\", or \"revocation\"), or unset for outputs of regular transactions\n \"ticketstatus\": \"value\",   (string)  The status of the ticket of ticket outputs (\"live\", \"missed\", or \"expired\")\n \"stakelocked\": true|false, (boolean) Whether the output is locked by an unspent ticket and may only be spent by a vote or revocation of the ticket\n},...]\n",
+		"listunspentpage":              "listunspentpage (minconf=1 maxconf=9999999 [\"address\",...] account=\"*\" after=\"\" limit=100)\n\nReturns a page of the unlocked unspent outputs controlled by wallet keys, optionally filtered by account.\nOutputs are ordered by transaction hash and output index, allowing wallets with many outputs to be listed over several requests.\nThe next page is requested by passing the \"txid:vout\" of the last output of a page as the after parameter.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n4. account   (string, optional, default=\"*\")      Only list outputs controlled by this account, or \"*\" for all accounts\n5. after     (string, optional, default=\"\")       Only list outputs after this \"txid:vout\" outpoint, or the empty string to begin with the first output\n6. limit     (numeric, optional, default=100)     Maximum number of outputs to return\n\nResult:\n[{\n \"txid\": \"value\",           (string)  The transaction hash of the referenced output\n \"vout\": n,                 (numeric) The output index of the referenced output\n \"tree\": n,                 (numeric) The tree the transaction comes from\n \"txtype\": n,               (numeric) The type of the transaction\n \"address\": \"value\",        (string)  The payment address that received the output\n \"account\": \"value\",        (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\",   (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\",   (string)  Unset\n \"amount\": n.nnn,           (numeric) The amount of the output valued in valhallacoin\n \"confirmations\": n,        (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false,   (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n \"stakeoutput\": \"value\",    (string)  The kind of stake transaction output (\"ticket\", \"ticketchange\", \"vote\", or \"revocation\"), or unset for outputs of regular transactions\n \"ticketstatus\": \"value\",   (string)  The status of the ticket of ticket outputs (\"live\", \"missed\", or \"expired\")\n \"stakelocked\": true|false, (boolean) Whether the output is locked by an unspent ticket and may only be spent by a vote or revocation of the ticket\n},...]\n",
+		"listwallets":                  "listwallets\n\nReturns the default wallet and every named wallet which exists or is loaded, sorted by name.\nRequests are dispatched to a named wallet by the /wallet/<name> HTTP POST endpoint and the /wallet/<name>/ws websocket endpoint, and to the default wallet by all other endpoints.\nNamed wallets are created and opened with createwallet and openwallet requests to their endpoints.\n\nArguments:\nNone\n\nResult:\n[{\n \"name\": \"value\",      (string)  The name of the wallet, or the empty string for the default wallet\n \"loaded\": true|false, (boolean) Whether the wallet is loaded\n},...]\n",
+		"lockunspent":                  "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts (use lockunspentnamespace to lock outputs persistently or with an expiry).\nOutputs are locked in the default namespace, and outputs locked in other namespaces (with lockunspentnamespace) are not unlocked.\nIf unlock is true and no transaction outputs are specified, all outputs locked in the default namespace are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"lockunspentnamespace":         "lockunspentnamespace \"namespace\" unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] (ttl=0 persistent=false blocks=0)\n\nLocks or unlocks unspent outputs in a namespace.\nNamespaces allow independent clients to lock outputs without unlocking each other's locks.\nAn output may only be locked by one namespace at a time, and locking an output held by another namespace is an error.\nLocked outputs are not chosen for transaction inputs of authored transactions.\nLocks are only saved across wallet restarts when persistent is true, and relocking a persistently locked output without it makes the lock volatile.\nLocks may expire after a number of seconds, a number of blocks, or both, in which case they are released by whichever expires first.\nIf unlock is true and no transaction outputs are specified, all outputs locked in the namespace are marked unlocked.\n\nArguments:\n1. namespace    (string, required)          The namespace of the locks\n2. unlock       (boolean, required)         True to unlock outputs, false to lock\n3. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n4. ttl        (numeric, optional, default=0)     Seconds after which locks are released automatically, or 0 to hold locks until unlocked (relocking an output replaces its ttl)\n5. persistent (boolean, optional, default=false) Save the locks in the wallet database so they are held after the wallet is restarted\n6. blocks     (numeric, optional, default=0)     Number of blocks attached to the main chain after which locks are released automatically, or 0 to not release locks at any height (relocking an output replaces its expiry)\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"mergecosignsessions":          "mergecosignsessions [\"session\",...]\n\nCombines the signatures of copies of a cosigning session signed by different cosigners, returning the merged session.\n\nArguments:\n1. sessions (array of string, required) The JSON-encoded copies of the cosigning session\n\nResult:\n{\n \"session\": \"value\",     (string)           The JSON-encoded session passed between the cosigners\n \"signatures\": [n,...],  (array of numeric) The number of signatures collected for each input\n \"required\": [n,...],    (array of numeric) The number of signatures required by each input\n \"complete\": true|false, (boolean)          Whether every input has the required signatures\n \"added\": n,             (numeric)          The number of signatures added by the wallet, omitted when none were added\n}                        \n",
+		"movefunds":                    "movefunds \"fromaccount\" \"toaccount\" amount (minconf=1)\n\nAuthors, signs, and sends a transaction transferring an amount between two accounts of the wallet.\nThe amount is paid to a new internal address of the destination account and the transaction is listed under the transfer category.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaccount   (string, required)             Account to transfer the amount to\n3. amount      (numeric, required)            Amount to transfer valued in valhallacoin\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n\nResult:\n\"value\" (string) The transaction hash of the transfer\n",
+		"notifyblocks":                 "notifyblocks\n\nRequests blockconnected and blockdisconnected notifications as blocks are processed by the wallet (websocket clients only).\nThe subscribed transactions of each blockconnected notification are the wallet's transactions mined in the block.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifydepositaddresses":       "notifydepositaddresses\n\nRequests a depositaddress notification for each address reserved by filldepositpool (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifymissedvotes":            "notifymissedvotes\n\nRequests a missedvote notification for each ticket with voting authority held by the wallet that was selected to vote on a block but whose vote was not included in the next block (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifynewtransactions":        "notifynewtransactions (verbose=false)\n\nRequests a newtx notification for each listtransactions result of transactions added to the wallet (websocket clients only).\n\nArguments:\n1. verbose (boolean, optional, default=false) Unused\n\nResult:\nNothing\n",
+		"notifypendingrevocations":     "notifypendingrevocations\n\nRequests a pendingrevocation notification for each missed ticket whose automatic revocation is delayed by the revocationdelay option (websocket clients only).\nNotifications are dropped for clients which fall behind; use listpendingrevocations for the complete list.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifyrescanprogress":         "notifyrescanprogress\n\nRequests a rescanprogress notification with the current height, percent complete, and addresses discovered each time a range of blocks is scanned by a rescan, and when each rescan ends (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifytickets":                "notifytickets\n\nRequests a ticketstatus notification each time a ticket of the wallet becomes live, votes, is missed, expires, or is revoked in a block attached to the main chain (websocket clients only).\nTickets are only reported missed when the wallet detects the missed vote of a ticket it was selected to vote with.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifyvoteversion":            "notifyvoteversion\n\nRequests a voteversion notification each time the votes cast by the wallet become outdated, or compatible again, with the stake version of recent blocks (websocket clients only).\nVotes are outdated after a network upgrade to a stake version newer than the wallet's vote version, and do not vote on the agendas of the newer version.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"notifywinningtickets":         "notifywinningtickets\n\nRequests winningtickets notifications when tickets owned by the wallet are selected to vote on a block (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"openwallet":                   "openwallet (\"publicpassphrase\")\n\nOpens the existing wallet of the wallet data directory when no wallet is loaded.\nThis is intended for servers started with the noinitialload option.\nThe opened wallet is synchronized with the network until it is closed.\n\nArguments:\n1. publicpassphrase (string, optional) The public passphrase of the wallet, or the insecure default public passphrase if unset or empty\n\nResult:\n{\n \"watchingonly\": true|false, (boolean) Whether the opened wallet is watching-only\n}                            \n",
+		"overridespendingpolicy":       "overridespendingpolicy \"account\" \"passphrase\" timeout\n\nAllows sends from an account to exceed the account's spending limits for a limited time.\n\nArguments:\n1. account    (string, required)  Name of the account\n2. passphrase (string, required)  The override passphrase of the account's spending policy\n3. timeout    (numeric, required) Number of seconds the override remains active\n\nResult:\nNothing\n",
+		"purchaseticket":               "purchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\n\nPurchase ticket using available funds.  Tickets are registered with the selected VSP when no ticket or pool address is given.\n\nArguments:\n1.  fromaccount   (string, required)             The account to use for purchase (default=\"default\")\n2.  spendlimit    (numeric, required)            Limit on the amount to spend on ticket\n3.  minconf       (numeric, optional, default=1) Minimum number of block confirmations required\n4.  ticketaddress (string, optional)             Override the ticket address to which voting rights are given (defaults to the configured voting address, then an address derived from the --ticketbuyer.votingxpub extended public key, then a wallet address)\n5.  numtickets    (numeric, optional)            The number of tickets to purchase\n6.  pooladdress   (string, optional)             The address to pay stake pool fees to\n7.  poolfees      (numeric, optional)            The amount of fees to pay to the stake pool\n8.  expiry        (numeric, optional)            Height at which the purchase tickets expire\n9.  comment       (string, optional)             Unused\n10. ticketfee     (numeric, optional)            The transaction fee rate (VHC/kB) to use (overrides fees set by the wallet config or settxfee RPC)\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
+		"publishsplitticketsession":    "publishsplitticketsession \"session\"\n\nPublishes the ticket of a split ticket session signed by every participant, returning the ticket hash.\n\nArguments:\n1. session (string, required) The JSON-encoded split ticket session\n\nResult:\n\"value\" (string) The hash of the published ticket\n",
+		"rejectsend":                   "rejectsend \"id\"\n\nRemoves a send queued by the wallet for approval without creating the transaction.\n\nArguments:\n1. id (string, required) The ID of the pending send\n\nResult:\nNothing\n",
+		"redeemmultisigout":            "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"redeemmultisigouts":           "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"redeemmultisigaccount":        "redeemmultisigaccount \"name\" (\"toaddress\" feerate batch=20)\n\nRedeems the unspent outputs paying addresses of a multisig account in transactions spending up to batch outputs each, signed by the wallet.\nTransactions which are not complete must be signed by other cosigners, for example with a cosigning session.\n\nArguments:\n1. name      (string, required)              The name of the multisig account\n2. toaddress (string, optional)              The address paid by each transaction; a new internal address of the default account is used for each transaction when omitted\n3. feerate   (numeric, optional)             The fee rate (in VHC/kB) paid for the size of the fully signed transactions; the wallet's relay fee is used when omitted\n4. batch     (numeric, optional, default=20) The maximum number of outputs spent by each transaction\n\nResult:\n{\n \"results\": [{            (array of object) The redemption transaction spending each batch of outputs\n  \"hex\": \"value\",         (string)          Resulting hash.\n  \"complete\": true|false, (boolean)         Shows if opperation was completed.\n  \"errors\": [{            (array of object) Any errors generated.\n   \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n   \"vout\": n,             (numeric)         The output index of the referenced previous output\n   \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n   \"sequence\": n,         (numeric)         Script sequence number\n   \"error\": \"value\",      (string)          Verification or signing error related to the input\n  },...],                                   \n },...],                                    \n}                         \n",
+		"redeemmultisigoutsbatch":      "redeemmultisigoutsbatch \"fromscraddress\" (\"toaddress\" number feerate batch=20)\n\nRedeems the unspent outputs of a P2SH multisig address in transactions spending up to batch outputs each, signed by the wallet.\nTransactions which are not complete must be signed by other cosigners, for example with signrawtransaction.\n\nArguments:\n1. fromscraddress (string, required)              The P2SH multisig address whose outputs are redeemed\n2. toaddress      (string, optional)              The address paid by each transaction; a new internal address of the default account is used for each transaction when omitted\n3. number         (numeric, optional)             The maximum number of outputs to redeem; all outputs are redeemed when omitted\n4. feerate        (numeric, optional)             The fee rate (in VHC/kB) paid for the size of the fully signed transactions; the wallet's relay fee is used when omitted\n5. batch          (numeric, optional, default=20) The maximum number of outputs spent by each transaction\n\nResult:\n{\n \"results\": [{            (array of object) The redemption transaction spending each batch of outputs\n  \"hex\": \"value\",         (string)          Resulting hash.\n  \"complete\": true|false, (boolean)         Shows if opperation was completed.\n  \"errors\": [{            (array of object) Any errors generated.\n   \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n   \"vout\": n,             (numeric)         The output index of the referenced previous output\n   \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n   \"sequence\": n,         (numeric)         Script sequence number\n   \"error\": \"value\",      (string)          Verification or signing error related to the input\n  },...],                                   \n },...],                                    \n}                         \n",
+		"releaseoutputs":               "releaseoutputs \"id\"\n\nReleases the outputs of a reservation created by reserveoutputs before the reservation expires.\n\nArguments:\n1. id (string, required) The ID of the reservation\n\nResult:\nNothing\n",
+		"renameaccount":                "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
+		"rescanwallet":                 "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error.\nThe progress of the rescan is reported by getrescanstatus and rescanprogress notifications.\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
+		"reserveoutputs":               "reserveoutputs \"account\" amount (minconf=1 ttl=300)\n\nSelects and locks unspent outputs of an account for a transaction which is signed outside of the wallet, such as by a hardware wallet or multisig cosigners.\nOutputs are selected largest first until their total reaches the amount, and either every selected output is reserved or none are.\nReserved outputs are not chosen for transaction inputs of authored transactions or other reservations, and are released automatically when the reservation expires.\nReservations are volatile and are not saved across wallet restarts.\n\nArguments:\n1. account (string, required)               Account to reserve unspent outputs from\n2. amount  (numeric, required)              Minimum total amount of the reserved outputs, valued in valhallacoin\n3. minconf (numeric, optional, default=1)   Minimum number of block confirmations required for reserved outputs\n4. ttl     (numeric, optional, default=300) Number of seconds after which the outputs are released automatically\n\nResult:\n{\n \"id\": \"value\",            (string)          The ID of the reservation, used to release the outputs with releaseoutputs\n \"outputs\": [{             (array of object) The reserved outputs\n  \"txid\": \"value\",         (string)          The transaction hash of the reserved output\n  \"vout\": n,               (numeric)         The output index of the reserved output\n  \"tree\": n,               (numeric)         The tree of the transaction of the reserved output\n  \"amount\": n.nnn,         (numeric)         The amount of the output valued in valhallacoin\n  \"scriptPubKey\": \"value\", (string)          The output script encoded as a hexadecimal string\n },...],                                     \n \"total\": n.nnn,           (numeric)         The total amount of the reserved outputs valued in valhallacoin\n \"expires\": n,             (numeric)         The Unix time the reservation expires\n}                          \n",
+		"revoketickets":                "revoketickets\n\nRequests the wallet create revocations for any previously missed or expired tickets.  Without a consensus RPC server, missed tickets are those detected by the wallet from the votes included in each block.  Wallet must be unlocked.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"rotateaccount":                "rotateaccount \"account\" (\"newaccount\" maxinputs=20)\n\nRotates an account whose extended public key has leaked and migrates its funds to a successor account.\nThe first request for an account creates the successor account and marks the rotated account receive-only: its previously derived addresses remain watched but no new addresses are derived.\nEach request sweeps up to maxinputs spendable outputs of the rotated account to a new internal address of the successor, so funds may be migrated over several transactions by repeating the request.\n\nArguments:\n1. account    (string, required)              The account to rotate\n2. newaccount (string, optional)              The name of the successor account, required unless the account was already rotated\n3. maxinputs  (numeric, optional, default=20) Maximum number of outputs to sweep to the successor account, or 0 to not sweep\n\nResult:\n{\n \"account\": \"value\",        (string)  The rotated account\n \"successor\": \"value\",      (string)  The successor account receiving the swept funds\n \"rotatedtime\": n,          (numeric) The Unix time the account was rotated\n \"sweeptxhash\": \"value\",    (string)  The hash of the sweep transaction, if any outputs were swept\n \"remainingbalance\": n.nnn, (numeric) The total balance remaining in the rotated account valued in valhallacoin\n}                           \n",
+		"searchwallet":                 "searchwallet \"query\" (count=100)\n\nSearches the wallet for transactions, addresses, accounts, and deposit address references matching part of a transaction hash, an address, an account name, or a reference.\nAddresses are matched case-sensitively and other records regardless of case.\nTransactions are returned newest first, followed by addresses, accounts, and deposit references.\n\nArguments:\n1. query (string, required)               Part of a transaction hash, address, account name, or deposit reference (at least 3 characters)\n2. count (numeric, optional, default=100) Maximum number of matches to return, or 0 for every match\n\nResult:\n[{\n \"kind\": \"value\",      (string)  Kind of record matched (\"transaction\", \"address\", \"account\", or \"depositreference\")\n \"txid\": \"value\",      (string)  Hash of a matched transaction\n \"blockheight\": n,     (numeric) Height of the block mining a matched transaction, or -1 if unmined\n \"time\": n,            (numeric) Unix time of the block mining a matched transaction, or the time it was received if unmined\n \"address\": \"value\",   (string)  Matched address, or the deposit address assigned to a matched reference\n \"account\": \"value\",   (string)  Account of the matched address, account, or deposit address\n \"reference\": \"value\", (string)  Matched deposit address reference\n},...]\n",
+		"sendfrom":                     "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nDEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in valhallacoin\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\n",
+		"sendmany":                     "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) DEPRECATED -- Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in valhallacoin, (object) JSON object using payment addresses as keys and output amounts valued in valhallacoin to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\n",
+		"sendsweepaccount":             "sendsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb includelocked=false dustthreshold=0)\n\nSigns and publishes transactions moving as much value as possible from an account, like sweepaccount does without signing.\nAccounts with more outputs than fit in a single transaction are swept by several transactions.\nTicket outputs and immature coinbase and stake outputs are never swept.\nThe wallet must be unlocked.\n\nArguments:\n1. sourceaccount         (string, required)                 The account to be swept\n2. destinationaddress    (string, required)                 The destination address to pay to\n3. requiredconfirmations (numeric, optional)                The minimum utxo confirmation requirement\n4. feeperkb              (numeric, optional)                The fee rate of the transaction, valued in valhallacoin per kilobyte\n5. includelocked         (boolean, optional, default=false) Sweep outputs locked by lockunspent or any lock namespace\n6. dustthreshold         (numeric, optional, default=0)     Outputs of lower value, in valhallacoin, are not swept\n\nResult:\n[\"value\",...] (array of string) The hashes of the sweep transactions\n",
+		"sendtoaddress":                "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in valhallacoin\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\n",
+		"sendtomultisig":               "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\nMultisig sends can not be queued for approval and are refused when sends require approval.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in valhallacoin\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\n",
+		"setaccountgappolicy":          "setaccountgappolicy \"account\" \"gappolicy\"\n\nSets the gap policy used when generating addresses for an account without specifying a policy.\n\nArguments:\n1. account   (string, required) Name of the account\n2. gappolicy (string, required) Policy used when the unused address gap limit would be exceeded (\"error\", \"ignore\", or \"wrap\")\n\nResult:\nNothing\n",
+		"setapiversion":                "setapiversion \"version\"\n\nSelects the API version used to handle every following request of the connection, in the form major[.minor[.patch]] (websocket clients only).\nResults of methods which changed shape since the selected major version are returned in the shape of that version.\nHTTP POST clients instead select the version of a request with the X-Vhcwallet-Api-Version header.\n\nArguments:\n1. version (string, required) The requested API version, which may not be newer than the server's version or older than major version 4\n\nResult:\nNothing\n",
+		"setautoconsolidation":         "setautoconsolidation \"account\" threshold (maxinputs=0 maxblockusage=0.5)\n\nConfigures the automatic consolidation of the outputs of an account, replacing any previous configuration of the account.\nAs each block is attached to the main chain, the outputs of the account are consolidated to a new internal address, paying the relay fee, if the account holds more than threshold spendable outputs and the block used no more than maxblockusage of the maximum block size.\nConsolidations are only performed while the wallet is unlocked, and the configuration must be set again each time the wallet is loaded.\n\nArguments:\n1. account       (string, required)               Name of the account\n2. threshold     (numeric, required)              The number of spendable outputs which must be exceeded before outputs are consolidated, or 0 to disable automatic consolidation\n3. maxinputs     (numeric, optional, default=0)   The maximum number of outputs consolidated by each transaction, or 0 to only limit inputs by the maximum transaction size\n4. maxblockusage (numeric, optional, default=0.5) The fraction of the maximum block size the latest block may use for outputs to be consolidated\n\nResult:\nNothing\n",
+		"setsendapproval":              "setsendapproval \"account\" \"passphrase\" (\"currentpassphrase\")\n\nRequires sends from an account to be queued by the wallet and approved with approvesend using a second passphrase, or removes this requirement. Sends from such accounts made by sendtoaddress, sendfrom, and sendmany return the ID of the pending send, and other methods creating transactions from the account are refused.\n\nArguments:\n1. account           (string, required) Name of the account\n2. passphrase        (string, required) New approval passphrase, or an empty string to no longer require approval\n3. currentpassphrase (string, optional) The current approval passphrase, required if the account already requires approval\n\nResult:\nNothing\n",
+		"setspendingpolicy":            "setspendingpolicy \"account\" txlimit dailylimit (\"overridepassphrase\" \"currentoverridepassphrase\")\n\nSets the per-transaction and daily (UTC) limits of the total output amount that may be sent from an account.\n\nArguments:\n1. account                   (string, required)  Name of the account\n2. txlimit                   (numeric, required) Maximum amount which may be sent by a single transaction, or 0 to disable this limit\n3. dailylimit                (numeric, required) Maximum total amount which may be sent during a UTC day, or 0 to disable this limit\n4. overridepassphrase        (string, optional)  New passphrase allowing the limits to be exceeded using overridespendingpolicy (unchanged if unset, removed if empty)\n5. currentoverridepassphrase (string, optional)  The current override passphrase, required if the policy already has one\n\nResult:\nNothing\n",
+		"setticketfee":                 "setticketfee fee\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored stake transaction.\n\nArguments:\n1. fee (numeric, required) The new fee per kB of the serialized tx size valued in valhallacoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"settxfee":                     "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in valhallacoin\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"setunlocksessiontimeout":      "setunlocksessiontimeout timeout\n\nSets the duration that the key derived from the private passphrase is cached after an unlock. Unlocking again with the same passphrase before the timeout elapses skips the expensive key derivation.\n\nArguments:\n1. timeout (numeric, required) Number of seconds the derived key is cached, or 0 to disable caching and clear any cached key\n\nResult:\nNothing\n",
+		"setutxopolicy":                "setutxopolicy \"txid\" vout [\"policy\",...]\n\nSets the spend policy of an unspent output, replacing any previous policy.\nPolicies are saved in the wallet database and restrict which transactions created by the wallet may select the output as an input:\n\"donotspend\" outputs are never selected, \"reservefortickets\" outputs are only selected by ticket purchases, and \"dust\" outputs are only selected by account sweeps.\n\nArguments:\n1. txid     (string, required)          The hash of the transaction of the output\n2. vout     (numeric, required)         The output index\n3. policies (array of string, required) Policy flags of the output (\"donotspend\", \"reservefortickets\", or \"dust\"), or an empty array to remove all restrictions\n\nResult:\nNothing\n",
+		"setvotechoice":                "setvotechoice \"agendaid\" \"choiceid\"\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid (string, required) The ID for the agenda to modify\n2. choiceid (string, required) The ID for the choice to choose\n\nResult:\nNothing\n",
+		"setvsp":                       "setvsp \"host\" (\"pubkey\")\n\nSelects the voting service provider (VSP) tickets are purchased for, or clears the selection when host is empty\n\nArguments:\n1. host   (string, required) The https URL of the VSP (http is only permitted on simnet and regnet)\n2. pubkey (string, optional) The base64-encoded Ed25519 public key of the VSP (default is fetched from the VSP)\n\nResult:\nNothing\n",
+		"signcosignsession":            "signcosignsession \"session\"\n\nAdds signatures of a cosigning session's inputs by keys of the wallet, returning the updated session.\nInputs which already have the required signatures are not signed again.\n\nArguments:\n1. session (string, required) The JSON-encoded cosigning session\n\nResult:\n{\n \"session\": \"value\",     (string)           The JSON-encoded session passed between the cosigners\n \"signatures\": [n,...],  (array of numeric) The number of signatures collected for each input\n \"required\": [n,...],    (array of numeric) The number of signatures required by each input\n \"complete\": true|false, (boolean)          Whether every input has the required signatures\n \"added\": n,             (numeric)          The number of signatures added by the wallet, omitted when none were added\n}                        \n",
+		"signmessage":                  "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\nEd25519 and secp256k1 Schnorr addresses sign with the key of their signature algorithm, and the signature includes the public key of the address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
+		"signrawtransaction":           "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\nThe output scripts of inputs not described by the request are looked up in the wallet's transaction history, and otherwise queried from the consensus RPC server when one is connected.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"signrawtransactions":          "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\nTransactions are signed concurrently, and a transaction which can not be signed or sent does not prevent signing and sending the others.\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n  \"error\": \"value\",        (string)          The reason the transaction could not be signed or sent, omitted on success.\n },...],                                     \n}                          \n",
+		"signsplitticketsession":       "signsplitticketsession \"session\"\n\nSigns the inputs contributed by the wallet to a fully funded split ticket session and records the wallet's share of the ticket, returning the updated session.\nThe commitment and change addresses of contributions spending outputs of the wallet must belong to the wallet.\n\nArguments:\n1. session (string, required) The JSON-encoded split ticket session\n\nResult:\n{\n \"session\": \"value\",    (string)  The JSON-encoded session passed between the participants\n \"ticketprice\": n.nnn,  (numeric) The price (in VHC) of the ticket\n \"unfunded\": n.nnn,     (numeric) The share of the ticket price (in VHC) not yet contributed by any participant\n \"tickethash\": \"value\", (string)  The hash of the ticket, set once the session is signed\n}                       \n",
+		"stakehistory":                 "stakehistory (count=100)\n\nReturns the vote bits and agenda choices of the most recent votes created by the wallet, ordered by the height of the block voted on.\n\nArguments:\n1. count (numeric, optional, default=100) Number of most recent votes to return, or 0 for every vote (default=100)\n\nResult:\n[{\n \"tickethash\": \"value\",  (string)          Hash of the ticket\n \"votehash\": \"value\",    (string)          Hash of the vote transaction\n \"blockhash\": \"value\",   (string)          Hash of the block voted on\n \"blockheight\": n,       (numeric)         Height of the block voted on\n \"time\": n,              (numeric)         Unix time the vote was created\n \"votebits\": n,          (numeric)         The vote bits cast by the vote\n \"votebitsext\": \"value\", (string)          The hex encoded extended vote bits cast by the vote\n \"voteversion\": n,       (numeric)         The stake version of the vote\n \"choices\": [{           (array of object) The agenda choices of the stake version cast by the vote bits\n  \"agendaid\": \"value\",   (string)          The ID of the agenda\n  \"choiceid\": \"value\",   (string)          The ID of the agenda's choice\n },...],                                   \n},...]\n",
+		"stakepooluserinfo":            "stakepooluserinfo \"user\"\n\nGet user info for stakepool\n\nArguments:\n1. user (string, required) The id of the user to be looked up\n\nResult:\n{\n \"tickets\": [{             (array of object) A list of valid tickets that the user has added\n  \"status\": \"value\",       (string)          The current status of the added ticket\n  \"ticket\": \"value\",       (string)          The hash of the added ticket\n  \"ticketheight\": n,       (numeric)         The height in which the ticket was added\n  \"spentby\": \"value\",      (string)          The vote in which the ticket was spent\n  \"spentbyheight\": n,      (numeric)         The height in which the ticket was spent\n },...],                                     \n \"invalid\": [\"value\",...], (array of string) A list of invalid tickets that the user has added\n}                          \n",
+		"startautobuyer":               "startautobuyer \"account\" \"passphrase\" (balancetomaintain maxfeeperkb maxpricerelative maxpriceabsolute \"votingaddress\" \"pooladdress\" poolfees maxperblock)\n\nStarts a ticket buyer purchasing tickets from an account.\nTicket buyers of different accounts run concurrently, each with its own balance to maintain, limits, and voting and pool addresses.\nUnset parameters default to the configured ticket buyer options.\n\nArguments:\n1.  account           (string, required)  The account to use for purchasing tickets\n2.  passphrase        (string, required)  The private passphrase of the wallet\n3.  balancetomaintain (numeric, optional) The minimum amount of funds to never dip below when purchasing tickets\n4.  maxfeeperkb       (numeric, optional) The maximum ticket fee amount per KB\n5.  maxpricerelative  (numeric, optional) The scaling factor for setting the maximum ticket price, multiplied by the average price\n6.  maxpriceabsolute  (numeric, optional) The maximum absolute ticket price\n7.  votingaddress     (string, optional)  The address to delegate voting rights to\n8.  pooladdress       (string, optional)  The stake pool address where ticket fees will go to\n9.  poolfees          (numeric, optional) The absolute per ticket fee mandated by the stake pool as a percent\n10. maxperblock       (numeric, optional) The maximum tickets per block. Negative number indicates one ticket every n blocks\n\nResult:\nNothing\n",
+		"stopaccountautobuyer":         "stopaccountautobuyer \"account\"\n\nStops the ticket buyer of a single account, leaving those of other accounts running.\n\nArguments:\n1. account (string, required) The account whose ticket buyer is stopped\n\nResult:\nNothing\n",
+		"stopautobuyer":                "stopautobuyer\n\nStops the ticket buyer of every account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifyblocks":             "stopnotifyblocks\n\nCancels notifications requested with notifyblocks (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifydepositaddresses":   "stopnotifydepositaddresses\n\nCancels notifications requested with notifydepositaddresses (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifymissedvotes":        "stopnotifymissedvotes\n\nCancels notifications requested with notifymissedvotes (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifynewtransactions":    "stopnotifynewtransactions\n\nCancels notifications requested with notifynewtransactions (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifypendingrevocations": "stopnotifypendingrevocations\n\nCancels notifications requested with notifypendingrevocations (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifyrescanprogress":     "stopnotifyrescanprogress\n\nCancels notifications requested with notifyrescanprogress (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifytickets":            "stopnotifytickets\n\nCancels notifications requested with notifytickets (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifyvoteversion":        "stopnotifyvoteversion\n\nCancels notifications requested with notifyvoteversion (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"stopnotifywinningtickets":     "stopnotifywinningtickets\n\nCancels notifications requested with notifywinningtickets (websocket clients only).\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"sweepaccount":                 "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\nLocked outputs, ticket outputs, and immature coinbase and stake outputs are not swept.\nAccounts with more outputs than fit in a single transaction are swept by several transactions spending distinct outputs.\nThe result fields describe the first transaction and the others are listed in additionaltransactions.\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",      (string)          The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn,  (numeric)         The total transaction input amount.\n \"totaloutputamount\": n.nnn,          (numeric)         The total transaction output amount.\n \"estimatedsignedsize\": n,            (numeric)         The estimated size of the transaction when signed.\n \"additionaltransactions\": [{         (array of object) The further transactions sweeping outputs which do not fit in the first transaction, omitted when there are none.\n  \"unsignedtransaction\": \"value\",     (string)          The hex encoded string of the unsigned transaction.\n  \"totalpreviousoutputamount\": n.nnn, (numeric)         The total transaction input amount.\n  \"totaloutputamount\": n.nnn,         (numeric)         The total transaction output amount.\n  \"estimatedsignedsize\": n,           (numeric)         The estimated size of the transaction when signed.\n },...],                                                \n}                                     \n",
+		"sweepdust":                    "sweepdust \"account\" (threshold=0 feeperkb)\n\nConsolidates the dust outputs of an account into a new internal address of the account.\nOutputs marked with the \"dust\" UTXO policy and outputs of value below the threshold are dust.\nDust outputs worth less than the fee of spending them are not swept, and no transaction is published when the swept value would not exceed the fee.\nThe wallet must be unlocked.\n\nArguments:\n1. account   (string, required)             The account to sweep dust from\n2. threshold (numeric, optional, default=0) Outputs of lower value, in valhallacoin, are dust, or 0 for three times the fee of creating and spending an output at the fee rate\n3. feeperkb  (numeric, optional)            The fee rate of the transaction, valued in valhallacoin per kilobyte (default is the wallet's relay fee)\n\nResult:\n{\n \"inputs\": n,               (numeric)         The number of swept dust outputs\n \"dustvalue\": n.nnn,        (numeric)         The total value of the swept dust outputs\n \"fee\": n.nnn,              (numeric)         The fee paid by the sweep\n \"reclaimed\": n.nnn,        (numeric)         The value of the dust reclaimed in the new output after fees\n \"txhashes\": [\"value\",...], (array of string) The hashes of the sweep transactions, empty when sweeping was not profitable\n}                           \n",
+		"ticketsforaddress":            "ticketsforaddress \"address\"\n\nRequest all the tickets for an address.\n\nArguments:\n1. address (string, required) Address to look for.\n\nResult:\ntrue|false (boolean) Tickets owned by the specified address.\n",
+		"validateaddress":              "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n}                            \n",
+		"verifymessage":                "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\nSignatures of Ed25519 and secp256k1 Schnorr addresses must include the public key of the address as created by signmessage.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
+		"verifyownershipproof":         "verifyownershipproof {\"challenge\":\"value\",\"blockhash\":\"value\",\"blockheight\":n,\"addresses\":[{\"address\":\"value\",\"signature\":\"value\"},...],\"outputs\":[{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"amount\":n.nnn,\"address\":\"value\"},...]} (minbalance=0)\n\nVerifies the signatures of a proof created by createownershipproof and checks with the consensus RPC server that the proof block is in the main chain and that each claimed output exists with the claimed amount and address and was unspent as of the block.\nOutputs spent since the proof block invalidate the proof.\n\nArguments:\n1. proof (object, required) The ownership proof\n{\n \"challenge\": \"value\",  (string)          The challenge chosen by the verifier\n \"blockhash\": \"value\",  (string)          The hash of the main chain block as of which the outputs are unspent\n \"blockheight\": n,      (numeric)         The height of the main chain block as of which the outputs are unspent\n \"addresses\": [{        (array of object) The addresses and their signatures of the proof\n  \"address\": \"value\",   (string)          The address\n  \"signature\": \"value\", (string)          The base64-encoded signature of the proof by the private key of the address\n },...],                                  \n \"outputs\": [{          (array of object) The unspent outputs paying the addresses\n  \"txid\": \"value\",      (string)          The hash of the transaction of the output\n  \"vout\": n,            (numeric)         The output index\n  \"tree\": n,            (numeric)         The transaction tree of the output\n  \"amount\": n.nnn,      (numeric)         The value of the output in VHC\n  \"address\": \"value\",   (string)          The address paid by the output\n },...],                                  \n}                       \n2. minbalance (numeric, optional, default=0) The minimum total value (in VHC) of the claimed outputs\n\nResult:\n{\n \"valid\": true|false, (boolean) Whether every address signed the proof, every claimed output was unspent as of the proof block, and the claimed outputs are worth at least the minimum balance\n \"balance\": n.nnn,    (numeric) The total value (in VHC) of the claimed outputs, or zero when the proof is invalid\n}                     \n",
+		"version":                      "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
+		"walletexists":                 "walletexists\n\nReturns whether a wallet database exists in the wallet data directory, i.e. whether openwallet may be used to open a wallet.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet database exists\n",
+		"walletinfo":                   "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false,  (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"unlocked\": true|false,         (boolean) Whether or not the wallet is unlocked\n \"txfee\": n.nnn,                 (numeric) Transaction fee per kB of the serialized tx size in coins\n \"ticketfee\": n.nnn,             (numeric) Ticket fee per kB of the serialized tx size in coins\n \"ticketpurchasing\": true|false, (boolean) Whether or not the wallet is currently purchasing tickets\n \"votebits\": n,                  (numeric) Vote bits setting\n \"votebitsextended\": \"value\",    (string)  Extended vote bits setting\n \"voteversion\": n,               (numeric) Version of votes that will be generated\n \"voting\": true|false,           (boolean) Whether or not the wallet is currently voting tickets\n \"database\": {                   (object)  Storage statistics of the wallet database (omitted if unavailable)\n  \"path\": \"value\",               (string)  The file path of the wallet database (omitted unless called with admin credentials)\n  \"size\": n,                     (numeric) The size of the wallet database file in bytes\n  \"freespace\": n,                (numeric) Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)\n  \"writeerrors\": n,              (numeric) The number of failed database writes since the wallet was opened\n },                                        \n \"gaprecoveries\": {              (object)  Catch-up syncs performed after missed block notifications from the consensus RPC server (omitted unless synchronizing with the consensus RPC server)\n  \"recoveries\": n,               (numeric) The number of catch-up syncs started since the wallet process started\n  \"recoveredblocks\": n,          (numeric) The number of blocks connected by catch-up syncs\n  \"failures\": n,                 (numeric) The number of catch-up syncs which failed, including those that exceeded the maximum number of missed blocks and restarted synchronization\n },                                        \n \"consistencycheck\": {           (object)  Result of the database consistency check performed when the wallet was opened\n  \"mode\": \"value\",               (string)  Whether the \"quick\" structural check or the \"full\" check of every record (--fullcheck) was performed\n  \"tiphash\": \"value\",            (string)  Hash of the main chain tip block when the wallet was opened\n  \"tipheight\": n,                (numeric) Height of the main chain tip block when the wallet was opened\n  \"blocks\": n,                   (numeric) Number of main chain blocks verified (full check only)\n  \"transactions\": n,             (numeric) Number of mined transaction records verified (full check only)\n  \"credits\": n,                  (numeric) Number of credit records verified (full check only)\n  \"accounts\": n,                 (numeric) Number of account records verified (full check only)\n  \"durationms\": n,               (numeric) Duration of the check in milliseconds\n },                                        \n}                                \n",
+		"walletislocked":               "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
+		"walletlock":                   "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"walletpassphrasechange":       "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
+		"walletpassphrase":             "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks\n\nResult:\nNothing\n",
+		"watchoutpoint":                "watchoutpoint \"txid\" vout (tree=0)\n\nWatches an outpoint not owned by the wallet.\nThe transaction spending the outpoint is recorded by the wallet and reported by listtransactions as involving a watch-only output.\n\nArguments:\n1. txid (string, required)             The hash of the transaction of the outpoint\n2. vout (numeric, required)            The output index of the outpoint\n3. tree (numeric, optional, default=0) The transaction tree of the outpoint (0=regular, 1=stake)\n\nResult:\nNothing\n",
+		"watchscript":                  "watchscript \"hex\"\n\nWatches an output script not owned by the wallet.\nTransactions paying the script, and transactions spending those outputs, are recorded by the wallet and reported by listtransactions as involving watch-only outputs.\nPast transactions are only recorded after a rescan.\n\nArguments:\n1. hex (string, required) The hex encoded output script\n\nResult:\nNothing\n",
 	}
 }
 
@@ -86,4 +197,6 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "accountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddticket \"tickethex\"\nconsolidate inputs (\"account\" \"address\")\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ndumpprivkey \"address\"\nexportwatchingwallet (\"account\" download=false)\ngeneratevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\ngetaccountaddress \"account\"\ngetaccount \"address\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbestblockhash\ngetbestblock\ngetblockcount\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetstakeinfo\ngetticketfee\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngetunconfirmedbalance (\"account\")\ngetvotechoices\ngetwalletfee\nhelp (\"command\")\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nkeypoolrefill (newsize=100)\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistlockunspent\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistscripts\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\npurchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nrevoketickets\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsetticketfee fee\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\"\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nstakepooluserinfo \"user\"\nstartautobuyer \"account\" \"passphrase\" (balancetomaintain maxfeeperkb maxpricerelative maxpriceabsolute \"votingaddress\" \"pooladdress\" poolfees maxperblock)\nstopautobuyer\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nticketsforaddress \"address\"\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpassphrase \"passphrase\" timeout"
+var requestUsages = "accountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddticket \"tickethex\"\napprovesend \"id\" (\"passphrase\")\nassigndepositaddress \"account\" (\"reference\")\ncancelrescan\ncancelrevocation \"tickethash\"\nclearguard (trustorigins=false)\nclearunlocksession\nclosewallet\nconsolidate inputs (\"account\" \"address\")\nconsolidateaccount \"account\" (maxinputs=0 targetsize=0 feeperkb \"address\")\ncreatecosignsession \"hextx\"\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ncreatemultisigaccount \"name\" \"account\" nrequired [\"xpub\",...]\ncreateownershipproof [\"address\",...] (challenge=\"\" minbalance=0 blockheight)\ncreatesplitticketsession (\"votingaddress\" expiry)\ncreateunsignedtickets \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry ticketfee)\ncreatewallet \"passphrase\" (\"seed\" birthday)\ndecoderawtransaction \"hextx\"\ndecodescript \"hexscript\"\ndeleteprivkey \"address\" (drophistory=false)\ndeletescript \"script\" (drophistory=false)\ndisablevoting\ndumpprivkey \"address\"\nenablevoting\nexportvotechoices\nexportwatchingwallet (\"account\" download=false)\nfilldepositpool \"account\" size\nfinalizecosignsession \"session\" (publish=false)\ngeneratevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\ngeneratevotes \"blockhash\" height [\"tickethash\",...] votebits \"votebitsext\"\ngetaccountaddress \"account\"\ngetaccount \"address\"\ngetaccountreceived \"account\" (minconf=1)\ngetaccountstats (account=\"default\")\ngetapischema\ngetauditlog (count=100)\ngetautobuyerstatus (\"account\")\ngetautoconsolidation (\"account\")\ngetaddressreceived \"address\" (minconf=1)\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbalanceathash \"blockhash\" (\"account\")\ngetbuildinfo\ngetdbstats\ngetbestblockhash\ngetfeatureflags\ngetguardstatus\ngetbestblock\ngetblockcount\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetmultisigaccountaddress \"name\" (internal=false)\ngetrescanstatus\ngetresponsesigningkey\ngetspendingpolicy \"account\"\ngetstakeinfo\ngetstakingstats (starttime endtime)\ngetsyncstatus\ngetticketfee\ngetticketinfo \"tickethash\"\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngetunconfirmedbalance (\"account\")\ngetvotechoices\ngetvspinfo\ngetwalletfee\nhelp (\"command\")\nimportmulti [{\"privkey\":\"value\",\"pubkey\":\"value\",\"xpub\":\"value\",\"redeemscript\":\"value\",\"range\":[range,...],\"timestamp\":timestamp,\"watchonly\":watchonly},...] (rescan=true)\nimportpkcs11account \"account\" \"xpub\"\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportvotechoices \"document\"\njoinsplitticketsession \"session\" \"account\" (amount minconf=1)\nkeypoolrefill (newsize=100)\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistdepositaddresses (\"account\" \"status\")\nlistalltransactions (\"account\")\nlistlockunspent\nlistmissedvotes\nlistmultisigaccounts\nlistmultisigunspent (\"address\")\nlistoutpointlocks (\"namespace\")\nlistpendingrevocations\nlistpendingsends (\"account\")\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistrevocabletickets\nlistscripts\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlistsplittickets\nliststakepoolusertickets \"user\" (\"status\" \"after\" limit=100)\nlistticketstatuses (\"status\" offset=0 limit=100)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlistunspentpage (minconf=1 maxconf=9999999 [\"address\",...] account=\"*\" after=\"\" limit=100)\nlistwallets\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nlockunspentnamespace \"namespace\" unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] (ttl=0 persistent=false blocks=0)\nmergecosignsessions [\"session\",...]\nmovefunds \"fromaccount\" \"toaccount\" amount (minconf=1)\nnotifyblocks\nnotifydepositaddresses\nnotifymissedvotes\nnotifynewtransactions (verbose=false)\nnotifypendingrevocations\nnotifyrescanprogress\nnotifytickets\nnotifyvoteversion\nnotifywinningtickets\nopenwallet (\"publicpassphrase\")\noverridespendingpolicy \"account\" \"passphrase\" timeout\npurchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\npublishsplitticketsession \"session\"\nrejectsend \"id\"\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nredeemmultisigaccount \"name\" (\"toaddress\" feerate batch=20)\nredeemmultisigoutsbatch \"fromscraddress\" (\"toaddress\" number feerate batch=20)\nreleaseoutputs \"id\"\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nreserveoutputs \"account\" amount (minconf=1 ttl=300)\nrevoketickets\nrotateaccount \"account\" (\"newaccount\" maxinputs=20)\nsearchwallet \"query\" (count=100)\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\nsendsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb includelocked=false dustthreshold=0)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsetaccountgappolicy \"account\" \"gappolicy\"\nsetapiversion \"version\"\nsetautoconsolidation \"account\" threshold (maxinputs=0 maxblockusage=0.5)\nsetsendapproval \"account\" \"passphrase\" (\"currentpassphrase\")\nsetspendingpolicy \"account\" txlimit dailylimit (\"overridepassphrase\" \"currentoverridepassphrase\")\nsetticketfee fee\nsettxfee amount\nsetunlocksessiontimeout timeout\nsetutxopolicy \"txid\" vout [\"policy\",...]\nsetvotechoice \"agendaid\" \"choiceid\"\nsetvsp \"host\" (\"pubkey\")\nsigncosignsession \"session\"\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nsignsplitticketsession \"session\"\nstakehistory (count=100)\nstakepooluserinfo \"user\"\nstartautobuyer \"account\" \"passphrase\" (balancetomaintain maxfeeperkb maxpricerelative maxpriceabsolute \"votingaddress\" \"pooladdress\" poolfees maxperblock)\nstopaccountautobuyer \"account\"\nstopautobuyer\nstopnotifyblocks\nstopnotifydepositaddresses\nstopnotifymissedvotes\nstopnotifynewtransactions\nstopnotifypendingrevocations\nstopnotifyrescanprogress\nstopnotifytickets\nstopnotifyvoteversion\nstopnotifywinningtickets\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nsweepdust \"account\" (threshold=0 feeperkb)\nticketsforaddress \"address\"\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nverifyownershipproof {\"challenge\":\"value\",\"blockhash\":\"value\",\"blockheight\":n,\"addresses\":[{\"address\":\"value\",\"signature\":\"value\"},...],\"outputs\":[{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"amount\":n.nnn,\"address\":\"value\"},...]} (minbalance=0)\nversion\nwalletexists\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpassphrase \"passphrase\" timeout\nwatchoutpoint \"txid\" vout (tree=0)\nwatchscript \"hex\""
+
+var apiSchemaMethods = "[{\"name\":\"accountaddressindex\",\"description\":\"Get the current address index for some account branch\",\"params\":[{\"name\":\"account\",\"description\":\"String for the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"branch\",\"description\":\"Number for the branch (0=external, 1=internal)\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"description\":\"The address index for this account branch\",\"schema\":{\"type\":\"integer\"}}},{\"name\":\"accountsyncaddressindex\",\"description\":\"Synchronize an account branch to some passed address index\",\"params\":[{\"name\":\"account\",\"description\":\"String for the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"branch\",\"description\":\"Number for the branch (0=external, 1=internal)\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"index\",\"description\":\"The address index to synchronize to\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"addmultisigaddress\",\"description\":\"Generates and imports a multisig address and redeeming script to the 'imported' account.\",\"params\":[{\"name\":\"nrequired\",\"description\":\"The number of signatures required to redeem outputs paid to this address\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"keys\",\"description\":\"Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"account\",\"description\":\"DEPRECATED -- Unused (all imported addresses belong to the imported account)\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The imported pay-to-script-hash address\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"addticket\",\"description\":\"Add a ticket to the wallet for vote and revocation creation.  Added tickets are auxiliary to transaction history and do not appear in getstakeinfo stats.\",\"params\":[{\"name\":\"tickethex\",\"description\":\"Hex-encoded serialized transaction\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"approvesend\",\"description\":\"Approves a send queued by sendtoaddress, sendfrom, or sendmany, creating, signing, and publishing the transaction.\\nSends are queued for accounts requiring send approval, and for every account when the server requires send approval.\\nA send may not be approved by the client which requested it, and when approver credentials are configured, this method must be called using them.\",\"params\":[{\"name\":\"id\",\"description\":\"The ID of the pending send\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"passphrase\",\"description\":\"The approval passphrase of the sending account, required when the account requires send approval\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The transaction hash of the sent transaction\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"assigndepositaddress\",\"description\":\"Assigns the oldest available reserved deposit address of an account, recording an optional reference such as a customer identifier.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"reference\",\"description\":\"Reference to record with the assigned address\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"Name of the account the address belongs to\"},\"address\":{\"type\":\"string\",\"description\":\"The reserved address\"},\"assigned\":{\"type\":\"integer\",\"description\":\"Unix time the address was assigned\"},\"created\":{\"type\":\"integer\",\"description\":\"Unix time the address was reserved\"},\"index\":{\"type\":\"integer\",\"description\":\"Child index of the address in the account's external branch\"},\"reference\":{\"type\":\"string\",\"description\":\"Reference recorded when the address was assigned\"},\"status\":{\"type\":\"string\",\"description\":\"Assignment status of the address (\\\"available\\\" or \\\"assigned\\\")\"}},\"required\":[\"account\",\"address\",\"index\",\"status\",\"created\"]}}},{\"name\":\"cancelrescan\",\"description\":\"Aborts every rescan in progress which was started by rescanwallet or by importing keys and scripts.\\nRescans performed while synchronizing with the network are not canceled.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"cancelrevocation\",\"description\":\"Cancels the pending automatic revocation of a missed ticket, e.g. when the miss report is believed to be spurious.\\nThe cancellation is recorded in the wallet database, and the ticket is not revoked automatically again, but may still be revoked with revoketickets.\",\"params\":[{\"name\":\"tickethash\",\"description\":\"Hash of the missed ticket\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"clearguard\",\"description\":\"Clears the triggered state of the anomaly guard after its alerts are reviewed, permitting the wallet to be unlocked again.\",\"params\":[{\"name\":\"trustorigins\",\"description\":\"Trust the client addresses of origin alerts, so their requests no longer trigger the guard\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"clearunlocksession\",\"description\":\"Removes the cached key derived from the private passphrase so that the next unlock performs the full key derivation. The lock state of the wallet is not changed.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"closewallet\",\"description\":\"Stops the loaded wallet and closes its database.\\nRequests requiring a wallet fail until a wallet is opened with openwallet.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"consolidate\",\"description\":\"Consolidate n many UTXOs into a single output in the wallet.\",\"params\":[{\"name\":\"inputs\",\"description\":\"Number of UTXOs to consolidate as inputs\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"account\",\"description\":\"Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\",\"schema\":{\"type\":\"string\"}},{\"name\":\"address\",\"description\":\"Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"Transaction hash for the consolidation transaction\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"consolidateaccount\",\"description\":\"Consolidates unspent outputs of an account into a single output.\\nWhen a target size is set, only outputs of lower value are consolidated, smallest first, until the consolidated output reaches the target size.\\nAt least two outputs must be consolidated.\",\"params\":[{\"name\":\"account\",\"description\":\"Account from which unspent outputs are consolidated, and which provides the output address when none is specified\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"maxinputs\",\"description\":\"Maximum number of outputs to consolidate, or 0 to be limited only by the maximum transaction size\",\"schema\":{\"type\":\"integer\",\"default\":0}},{\"name\":\"targetsize\",\"description\":\"Value of the consolidated output, or 0 to consolidate outputs of any value\",\"schema\":{\"type\":\"number\",\"default\":0}},{\"name\":\"feeperkb\",\"description\":\"Fee rate in coins per kB (default is the wallet's relay fee)\",\"schema\":{\"type\":\"number\"}},{\"name\":\"address\",\"description\":\"Address to pay (default is a new internal address of the account)\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"Transaction hash of the consolidation transaction\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"createcosignsession\",\"description\":\"Begins a session for an unsigned transaction spending P2SH multisig outputs recorded by the wallet.\\nThe returned session is passed to the cosigners, which add their signatures with signcosignsession.  Sessions signed by different cosigners may be combined with mergecosignsessions.  Once every input has the required signatures, any cosigner creates the signed transaction with finalizecosignsession.\",\"params\":[{\"name\":\"hextx\",\"description\":\"The hex encoded unsigned transaction\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"added\":{\"type\":\"integer\",\"description\":\"The number of signatures added by the wallet, omitted when none were added\"},\"complete\":{\"type\":\"boolean\",\"description\":\"Whether every input has the required signatures\"},\"required\":{\"type\":\"array\",\"description\":\"The number of signatures required by each input\",\"items\":{\"type\":\"integer\"}},\"session\":{\"type\":\"string\",\"description\":\"The JSON-encoded session passed between the cosigners\"},\"signatures\":{\"type\":\"array\",\"description\":\"The number of signatures collected for each input\",\"items\":{\"type\":\"integer\"}}},\"required\":[\"session\",\"signatures\",\"required\",\"complete\"]}}},{\"name\":\"createmultisig\",\"description\":\"Generate a multisig address and redeem script.\",\"params\":[{\"name\":\"nrequired\",\"description\":\"The number of signatures required to redeem outputs paid to this address\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"keys\",\"description\":\"Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The generated pay-to-script-hash address\"},\"redeemScript\":{\"type\":\"string\",\"description\":\"The script required to redeem outputs paid to the multisig address\"}},\"required\":[\"address\",\"redeemScript\"]}}},{\"name\":\"createnewaccount\",\"description\":\"Creates a new account.\\nThe wallet must be unlocked for this request to succeed.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the new account\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"createmultisigaccount\",\"description\":\"Creates an HD multisig account whose addresses require nrequired signatures of the keys derived from the extended public key of a wallet account and every cosigner extended public key.\\nEach cosigner creates the account with the same keys to derive identical addresses for each branch and child index.\",\"params\":[{\"name\":\"name\",\"description\":\"The name of the multisig account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"account\",\"description\":\"The wallet account whose extended public key is one of the cosigner keys\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"nrequired\",\"description\":\"The number of signatures required to spend outputs of the account\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"xpubs\",\"description\":\"The account extended public keys of the other cosigners\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"createownershipproof\",\"description\":\"Creates a proof, signed by the private key of each address, that the wallet controls the addresses and the unspent outputs paying them as of a main chain block.\\nThe proof is verified with verifyownershipproof.\",\"params\":[{\"name\":\"addresses\",\"description\":\"The P2PKH addresses to prove ownership of\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"challenge\",\"description\":\"A challenge chosen by the verifier which is included in the signed proof\",\"schema\":{\"type\":\"string\",\"default\":\"\"}},{\"name\":\"minbalance\",\"description\":\"The minimum total value (in VHC) of the claimed outputs; the proof is not created when the addresses hold less\",\"schema\":{\"type\":\"number\",\"default\":0}},{\"name\":\"blockheight\",\"description\":\"The height of the main chain block as of which outputs are claimed (default=the main chain tip)\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"addresses\":{\"type\":\"array\",\"description\":\"The addresses and their signatures of the proof\",\"items\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The address\"},\"signature\":{\"type\":\"string\",\"description\":\"The base64-encoded signature of the proof by the private key of the address\"}},\"required\":[\"address\",\"signature\"]}},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the main chain block as of which the outputs are unspent\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"The height of the main chain block as of which the outputs are unspent\"},\"challenge\":{\"type\":\"string\",\"description\":\"The challenge chosen by the verifier\"},\"outputs\":{\"type\":\"array\",\"description\":\"The unspent outputs paying the addresses\",\"items\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The address paid by the output\"},\"amount\":{\"type\":\"number\",\"description\":\"The value of the output in VHC\"},\"tree\":{\"type\":\"integer\",\"description\":\"The transaction tree of the output\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction of the output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"amount\",\"address\"]}}},\"required\":[\"challenge\",\"blockhash\",\"blockheight\",\"addresses\",\"outputs\"]}}},{\"name\":\"createsplitticketsession\",\"description\":\"Begins a session for a split ticket co-funded by several wallets at the next ticket price.\\nThe returned session is passed to each participant in turn to contribute funds with joinsplitticketsession.  Once the ticket price is fully funded, every participant signs the session with signsplitticketsession, and any participant publishes the ticket with publishsplitticketsession.\\nEach participant's commitment receives its share of the vote reward in proportion to the amount it contributed.\",\"params\":[{\"name\":\"votingaddress\",\"description\":\"The address given voting rights for the ticket; a new address of the default account is used when omitted\",\"schema\":{\"type\":\"string\"}},{\"name\":\"expiry\",\"description\":\"Height at which the ticket expires; defaults to the end of the current ticket price interval\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"session\":{\"type\":\"string\",\"description\":\"The JSON-encoded session passed between the participants\"},\"tickethash\":{\"type\":\"string\",\"description\":\"The hash of the ticket, set once the session is signed\"},\"ticketprice\":{\"type\":\"number\",\"description\":\"The price (in VHC) of the ticket\"},\"unfunded\":{\"type\":\"number\",\"description\":\"The share of the ticket price (in VHC) not yet contributed by any participant\"}},\"required\":[\"session\",\"ticketprice\",\"unfunded\"]}}},{\"name\":\"createunsignedtickets\",\"description\":\"Performs the funding and split output construction of purchaseticket, returning the unsigned split transaction and tickets without signing or publishing them.\\nThis allows tickets to be inspected or signed externally before committing funds.  The tickets spend the outputs of the split transaction, whose hash is unchanged by signing.\\nAddresses derived for change, voting, and ticket commitments are recorded, but the outputs spent by the split transaction are not locked.  Tickets are not registered with a VSP.\",\"params\":[{\"name\":\"fromaccount\",\"description\":\"The account to use for purchase\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"spendlimit\",\"description\":\"Limit on the amount to spend on each ticket\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"ticketaddress\",\"description\":\"Override the ticket address to which voting rights are given\",\"schema\":{\"type\":\"string\"}},{\"name\":\"numtickets\",\"description\":\"The number of tickets to create\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"pooladdress\",\"description\":\"The address to pay stake pool fees to\",\"schema\":{\"type\":\"string\"}},{\"name\":\"poolfees\",\"description\":\"The amount of fees to pay to the stake pool\",\"schema\":{\"type\":\"number\"}},{\"name\":\"expiry\",\"description\":\"Height at which the tickets expire\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"ticketfee\",\"description\":\"The transaction fee rate (VHC/kB) of the tickets\",\"schema\":{\"type\":\"number\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"splittx\":{\"type\":\"string\",\"description\":\"The unsigned split transaction funding the tickets, hex-encoded\"},\"ticketprice\":{\"type\":\"number\",\"description\":\"The ticket price (in VHC) of each ticket\"},\"tickets\":{\"type\":\"array\",\"description\":\"The unsigned tickets, hex-encoded\",\"items\":{\"type\":\"string\"}}},\"required\":[\"splittx\",\"tickets\",\"ticketprice\"]}}},{\"name\":\"createwallet\",\"description\":\"Creates and opens a new wallet when no wallet is loaded, such as when the server was started with the noinitialload option.\\nThe wallet is created with the insecure default public passphrase.\\nWhen no seed is provided, a new random seed is generated and returned, and must be backed up to recover the wallet.\",\"params\":[{\"name\":\"passphrase\",\"description\":\"The private passphrase protecting the private keys of the wallet\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"seed\",\"description\":\"The seed of a restored wallet encoded as a hexadecimal string or mnemonic of PGP words, or unset to generate a new seed\",\"schema\":{\"type\":\"string\"}},{\"name\":\"birthday\",\"description\":\"Unix time the seed was created, before which blocks are not rescanned for wallet transactions (defaults to the current time for generated seeds)\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"mnemonic\":{\"type\":\"string\",\"description\":\"The generated seed encoded as a mnemonic of PGP words, omitted when a seed was provided\"},\"seed\":{\"type\":\"string\",\"description\":\"The generated seed encoded as a hexadecimal string, omitted when a seed was provided\"}}}}},{\"name\":\"decoderawtransaction\",\"description\":\"Returns a JSON object representing the provided serialized, hex-encoded transaction.\\nThe transaction is decoded by the wallet and does not require a connection to a consensus server.\",\"params\":[{\"name\":\"hextx\",\"description\":\"Serialized, hex-encoded transaction\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"expiry\":{\"type\":\"integer\",\"description\":\"The height at which the transaction expires and can no longer be mined\"},\"locktime\":{\"type\":\"integer\",\"description\":\"The transaction lock time\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction\"},\"version\":{\"type\":\"integer\",\"description\":\"The transaction version\"},\"vin\":{\"type\":\"array\",\"description\":\"The transaction inputs as JSON objects\",\"items\":{\"type\":\"object\",\"properties\":{\"amountin\":{\"type\":\"number\",\"description\":\"The amount (in VHC) of the input as committed by the transaction\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"The height of the block that includes the origin transaction as committed by the transaction\"},\"blockindex\":{\"type\":\"integer\",\"description\":\"The index of the origin transaction in its block as committed by the transaction\"},\"coinbase\":{\"type\":\"string\",\"description\":\"The hex-encoded bytes of the signature script (coinbase txns only)\"},\"scriptSig\":{\"type\":\"object\",\"description\":\"The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\",\"properties\":{\"asm\":{\"type\":\"string\",\"description\":\"Disassembly of the script\"},\"hex\":{\"type\":\"string\",\"description\":\"Hex-encoded bytes of the script\"}},\"required\":[\"asm\",\"hex\"]},\"sequence\":{\"type\":\"integer\",\"description\":\"The script sequence number\"},\"stakebase\":{\"type\":\"string\",\"description\":\"The hex-encoded bytes of the signature script (vote txns only)\"},\"tree\":{\"type\":\"integer\",\"description\":\"The transaction tree of the origin transaction (non-coinbase txns only)\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the origin transaction (non-coinbase txns only)\"},\"vout\":{\"type\":\"integer\",\"description\":\"The index of the output being redeemed from the origin transaction (non-coinbase txns only)\"}},\"required\":[\"coinbase\",\"stakebase\",\"txid\",\"vout\",\"tree\",\"sequence\",\"amountin\",\"blockheight\",\"blockindex\"]}},\"vout\":{\"type\":\"array\",\"description\":\"The transaction outputs as JSON objects\",\"items\":{\"type\":\"object\",\"properties\":{\"n\":{\"type\":\"integer\",\"description\":\"The index of this transaction output\"},\"scriptPubKey\":{\"type\":\"object\",\"description\":\"The public key script used to pay coins as a JSON object\",\"properties\":{\"addresses\":{\"type\":\"array\",\"description\":\"The addresses associated with this script\",\"items\":{\"type\":\"string\"}},\"asm\":{\"type\":\"string\",\"description\":\"Disassembly of the script\"},\"commitamt\":{\"type\":\"number\",\"description\":\"The ticket commitment value if the script is for a ticket commitment\"},\"hex\":{\"type\":\"string\",\"description\":\"Hex-encoded bytes of the script\"},\"reqSigs\":{\"type\":\"integer\",\"description\":\"The number of required signatures\"},\"type\":{\"type\":\"string\",\"description\":\"The type of the script (e.g. 'pubkeyhash')\"}},\"required\":[\"asm\",\"type\"]},\"value\":{\"type\":\"number\",\"description\":\"The amount in VHC\"},\"version\":{\"type\":\"integer\",\"description\":\"The version of the public key script\"}},\"required\":[\"value\",\"n\",\"version\",\"scriptPubKey\"]}}},\"required\":[\"txid\",\"version\",\"locktime\",\"expiry\",\"vin\",\"vout\"]}}},{\"name\":\"decodescript\",\"description\":\"Returns a JSON object with information about the provided hex-encoded script.\\nThe script is decoded by the wallet and does not require a connection to a consensus server.\",\"params\":[{\"name\":\"hexscript\",\"description\":\"Hex-encoded script\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"addresses\":{\"type\":\"array\",\"description\":\"The addresses associated with this script\",\"items\":{\"type\":\"string\"}},\"asm\":{\"type\":\"string\",\"description\":\"Disassembly of the script\"},\"ismine\":{\"type\":\"boolean\",\"description\":\"Whether the wallet owns any of the addresses or the pay-to-script-hash address of the script\"},\"p2sh\":{\"type\":\"string\",\"description\":\"The script hash for use in pay-to-script-hash transactions (only present if the provided redeem script is not already a pay-to-script-hash script)\"},\"reqSigs\":{\"type\":\"integer\",\"description\":\"The number of required signatures\"},\"type\":{\"type\":\"string\",\"description\":\"The type of the script (e.g. 'pubkeyhash')\"}},\"required\":[\"asm\",\"type\",\"ismine\"]}}},{\"name\":\"deleteprivkey\",\"description\":\"Removes an imported private or public key from the wallet.\\nThe address of the key remains watched for transactions until the wallet is restarted.\",\"params\":[{\"name\":\"address\",\"description\":\"The P2PKH address or hex encoded public key of the imported key\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"drophistory\",\"description\":\"Also remove the unspent outputs paying the address from the wallet\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"description\":\"The number of unspent outputs removed\",\"schema\":{\"type\":\"integer\"}}},{\"name\":\"deletescript\",\"description\":\"Removes an imported redeem script and the record of its import from the wallet.\\nThe P2SH address of the script remains watched for transactions until the wallet is restarted.\",\"params\":[{\"name\":\"script\",\"description\":\"The P2SH address or hex encoded redeem script of the imported script\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"drophistory\",\"description\":\"Also remove the unspent outputs paying the P2SH address from the wallet\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"description\":\"The number of unspent outputs removed\",\"schema\":{\"type\":\"integer\"}}},{\"name\":\"disablevoting\",\"description\":\"Stops the wallet from voting winning tickets and revoking missed tickets until voting is enabled again.\\nThe configured enablevoting option is used when the wallet is next started.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"dumpprivkey\",\"description\":\"Returns the private key in WIF encoding that controls some wallet address.\",\"params\":[{\"name\":\"address\",\"description\":\"The address to return a private key for\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The WIF-encoded private key\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"enablevoting\",\"description\":\"Starts voting winning tickets and revoking missed tickets owned by the wallet, allowing voting to fail over between wallets without a restart.\\nThe wallet must remain unlocked to vote.  The configured enablevoting option is used when the wallet is next started.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"exportvotechoices\",\"description\":\"Returns the choices of every agenda of the supported stake version as a document which may be imported by other wallets using importvotechoices.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"choices\":{\"type\":\"array\",\"description\":\"The choice of each agenda\",\"items\":{\"type\":\"object\",\"properties\":{\"agendaid\":{\"type\":\"string\",\"description\":\"The ID of the agenda\"},\"choiceid\":{\"type\":\"string\",\"description\":\"The ID of the agenda's choice\"}},\"required\":[\"agendaid\",\"choiceid\"]}},\"version\":{\"type\":\"integer\",\"description\":\"The stake version of the agendas\"}},\"required\":[\"version\",\"choices\"]}}},{\"name\":\"exportwatchingwallet\",\"description\":\"Creates and returns a duplicate of the wallet database without any private keys to be used as a watching-only wallet.\",\"params\":[{\"name\":\"account\",\"description\":\"Unused (must be unset or \\\"*\\\")\",\"schema\":{\"type\":\"string\"}},{\"name\":\"download\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"description\":\"The watching-only database encoded as a base64 string\",\"schema\":{\"type\":\"string\"}},\"x-websocketonly\":true},{\"name\":\"filldepositpool\",\"description\":\"Reserves external addresses of an account for deposits until the given number of reserved addresses are available for assignment.\\nEvery address is derived and recorded in a single database update, and a depositaddress notification is sent for each new address.\\nReserved addresses are subject to the unused address gap limit so that they are found when restoring from seed, and size may not exceed the gap limit.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"size\",\"description\":\"Number of available reserved addresses to maintain\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"Name of the account the address belongs to\"},\"address\":{\"type\":\"string\",\"description\":\"The reserved address\"},\"assigned\":{\"type\":\"integer\",\"description\":\"Unix time the address was assigned\"},\"created\":{\"type\":\"integer\",\"description\":\"Unix time the address was reserved\"},\"index\":{\"type\":\"integer\",\"description\":\"Child index of the address in the account's external branch\"},\"reference\":{\"type\":\"string\",\"description\":\"Reference recorded when the address was assigned\"},\"status\":{\"type\":\"string\",\"description\":\"Assignment status of the address (\\\"available\\\" or \\\"assigned\\\")\"}},\"required\":[\"account\",\"address\",\"index\",\"status\",\"created\"]}}}},{\"name\":\"finalizecosignsession\",\"description\":\"Creates the signed transaction of a cosigning session once every input has the required signatures, verifying each input.\",\"params\":[{\"name\":\"session\",\"description\":\"The JSON-encoded cosigning session\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"publish\",\"description\":\"Publish the signed transaction\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"hex\":{\"type\":\"string\",\"description\":\"The hex encoded signed transaction\"},\"published\":{\"type\":\"boolean\",\"description\":\"Whether the transaction was published\"},\"txhash\":{\"type\":\"string\",\"description\":\"The hash of the signed transaction\"}},\"required\":[\"hex\",\"txhash\",\"published\"]}}},{\"name\":\"generatevote\",\"description\":\"Returns the vote transaction encoded as a hexadecimal string\",\"params\":[{\"name\":\"blockhash\",\"description\":\"Block hash for the ticket\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"height\",\"description\":\"Block height for the ticket\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"tickethash\",\"description\":\"The hash of the ticket\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"votebits\",\"description\":\"The voteBits to set for the ticket\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"votebitsext\",\"description\":\"The extended voteBits to set for the ticket\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"hex\":{\"type\":\"string\",\"description\":\"The hex encoded transaction\"}},\"required\":[\"hex\"]}}},{\"name\":\"generatevotes\",\"description\":\"Returns vote transactions for several tickets on the same block, encoded as hexadecimal strings.\\nFailing to create the vote of one ticket does not prevent votes from being created for the others.\",\"params\":[{\"name\":\"blockhash\",\"description\":\"Block hash for the tickets\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"height\",\"description\":\"Block height for the tickets\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"tickethashes\",\"description\":\"The hashes of the tickets\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"votebits\",\"description\":\"The voteBits to set for the tickets\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"votebitsext\",\"description\":\"The extended voteBits to set for the tickets\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"The reason the vote could not be created, omitted on success\"},\"hex\":{\"type\":\"string\",\"description\":\"The hex encoded vote transaction, omitted if the vote could not be created\"},\"tickethash\":{\"type\":\"string\",\"description\":\"The hash of the ticket\"}},\"required\":[\"tickethash\"]}}}},{\"name\":\"getaccountaddress\",\"description\":\"DEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\",\"params\":[{\"name\":\"account\",\"description\":\"The account of the returned address\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The unused address for 'account'\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"getaccount\",\"description\":\"DEPRECATED -- Lookup the account name that some wallet address belongs to.\",\"params\":[{\"name\":\"address\",\"description\":\"The address to query the account for\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The name of the account that 'address' belongs to\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"getaccountreceived\",\"description\":\"Returns the amounts received by addresses of an account, including spent outputs, with unmined and insufficiently confirmed credits counted separately as pending.\",\"params\":[{\"name\":\"account\",\"description\":\"Account name to query received amounts for\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before an output's value is counted as confirmed (values less than 1 are treated as 1)\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"confirmed\":{\"type\":\"number\",\"description\":\"The amount (in VHC) received by credits with at least minconf confirmations\"},\"pending\":{\"type\":\"number\",\"description\":\"The amount (in VHC) received by unmined credits and credits with fewer than minconf confirmations\"},\"total\":{\"type\":\"number\",\"description\":\"The sum of the confirmed and pending amounts\"}},\"required\":[\"confirmed\",\"pending\",\"total\"]}}},{\"name\":\"getaccountstats\",\"description\":\"Returns the default address gap limit policy of an account and how many addresses have been returned beyond the last used address of each branch.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account (default=\\\"default\\\")\",\"schema\":{\"type\":\"string\",\"default\":\"default\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"Name of the account\"},\"accountnumber\":{\"type\":\"integer\",\"description\":\"Number of the account\"},\"externalgap\":{\"type\":\"integer\",\"description\":\"Number of external addresses returned after the last used external address\"},\"gaplimit\":{\"type\":\"integer\",\"description\":\"The unused address gap limit of the wallet\"},\"gappolicy\":{\"type\":\"string\",\"description\":\"Gap policy used when generating addresses without specifying a policy (\\\"error\\\", \\\"ignore\\\", or \\\"wrap\\\")\"},\"internalgap\":{\"type\":\"integer\",\"description\":\"Number of internal addresses returned after the last used internal address\"},\"keystorage\":{\"type\":\"string\",\"description\":\"Where the private keys of the account are kept (\\\"local\\\" or \\\"pkcs11\\\")\"},\"nextexternalindex\":{\"type\":\"integer\",\"description\":\"Child index of the next external address that will be returned\"},\"nextinternalindex\":{\"type\":\"integer\",\"description\":\"Child index of the next internal address that will be returned\"}},\"required\":[\"account\",\"accountnumber\",\"gappolicy\",\"gaplimit\",\"nextexternalindex\",\"nextinternalindex\",\"externalgap\",\"internalgap\",\"keystorage\"]}}},{\"name\":\"getapischema\",\"description\":\"Returns an OpenRPC document describing every method of the server, including the JSON schema of its parameters and result.\\nMethods which may only be called by websocket clients are marked with the x-websocketonly extension.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"info\":{\"type\":\"object\",\"description\":\"Title and JSON-RPC API version of the server\",\"properties\":{\"title\":{\"type\":\"string\",\"description\":\"Title of the API\"},\"version\":{\"type\":\"string\",\"description\":\"Semantic version of the JSON-RPC API\"}},\"required\":[\"title\",\"version\"]},\"methods\":{\"description\":\"OpenRPC method objects of every method\"},\"openrpc\":{\"type\":\"string\",\"description\":\"Version of the OpenRPC specification the document conforms to\"}},\"required\":[\"openrpc\",\"info\",\"methods\"]}}},{\"name\":\"getauditlog\",\"description\":\"Returns the most recent records of the audit log of state-changing requests, oldest first.\\nThe hash chain of the entire log is verified before any records are returned.\",\"params\":[{\"name\":\"count\",\"description\":\"Number of most recent records to return, or 0 for every record (default=100)\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"client\":{\"type\":\"string\",\"description\":\"Remote address and certificate identity of the client\"},\"error\":{\"type\":\"string\",\"description\":\"Error message if the request failed (outcome records only)\"},\"hash\":{\"type\":\"string\",\"description\":\"HMAC-SHA256, keyed by the audit log key, of the JSON encoding of this record with an empty hash\"},\"method\":{\"type\":\"string\",\"description\":\"The method of the request\"},\"params\":{\"type\":\"array\",\"description\":\"JSON encoding of each request parameter, with secret parameters redacted (request records only)\",\"items\":{\"type\":\"string\"}},\"prevhash\":{\"type\":\"string\",\"description\":\"Hash of the previous record\"},\"request\":{\"type\":\"integer\",\"description\":\"Sequence number of the request record whose outcome is reported (outcome records only)\"},\"role\":{\"type\":\"string\",\"description\":\"Role of the client's credentials\"},\"seq\":{\"type\":\"integer\",\"description\":\"Sequence number of the record, starting at 1\"},\"time\":{\"type\":\"integer\",\"description\":\"Unix time the request was received or handled\"},\"wallet\":{\"type\":\"string\",\"description\":\"Name of the wallet selected by the request endpoint, omitted for the default wallet\"}},\"required\":[\"seq\",\"time\",\"client\",\"role\",\"method\",\"prevhash\",\"hash\"]}}}},{\"name\":\"getautobuyerstatus\",\"description\":\"Returns whether the ticket buyer is running, and the effective configuration and tickets purchased since it was started of each account's strategy.\",\"params\":[{\"name\":\"account\",\"description\":\"Only report the strategy of this account\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"running\":{\"type\":\"boolean\",\"description\":\"Whether a ticket buyer is running for any account\"},\"strategies\":{\"type\":\"array\",\"description\":\"The running strategies, in the order they were started\",\"items\":{\"type\":\"object\",\"properties\":{\"config\":{\"type\":\"object\",\"description\":\"The effective configuration of the strategy\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account tickets are purchased from\"},\"balancetomaintain\":{\"type\":\"number\",\"description\":\"The balance (in VHC) kept in the account\"},\"maxfee\":{\"type\":\"number\",\"description\":\"The maximum ticket fee per KB (in VHC)\"},\"maxperblock\":{\"type\":\"integer\",\"description\":\"The maximum number of tickets purchased per block\"},\"maxpriceabsolute\":{\"type\":\"number\",\"description\":\"The maximum ticket price (in VHC), or 0 for no limit\"},\"maxpricerelative\":{\"type\":\"number\",\"description\":\"The scaling factor of the average ticket price used as the maximum price\"},\"maxspend\":{\"type\":\"number\",\"description\":\"The maximum total ticket price (in VHC) spent in any spendwindow blocks, or 0 for no budget\"},\"pooladdress\":{\"type\":\"string\",\"description\":\"The stake pool address fees are paid to\"},\"poolfees\":{\"type\":\"number\",\"description\":\"The stake pool fee percentage\"},\"spendwindow\":{\"type\":\"integer\",\"description\":\"The number of blocks the maxspend budget applies to\"},\"votingaddress\":{\"type\":\"string\",\"description\":\"The address tickets are given voting rights to\"}},\"required\":[\"account\",\"balancetomaintain\",\"maxfee\",\"maxpriceabsolute\",\"maxpricerelative\",\"maxperblock\",\"maxspend\",\"spendwindow\"]},\"lasterror\":{\"type\":\"string\",\"description\":\"The most recent error which failed a purchase attempt\"},\"lasterrorheight\":{\"type\":\"integer\",\"description\":\"The block height the most recent error occurred at\"},\"nextheight\":{\"type\":\"integer\",\"description\":\"The block height the strategy next evaluates purchases at\"},\"purchased\":{\"type\":\"integer\",\"description\":\"The number of tickets purchased since the strategy was started\"},\"spent\":{\"type\":\"number\",\"description\":\"The total ticket price paid for the purchased tickets, excluding transaction fees\"}},\"required\":[\"config\",\"purchased\",\"spent\",\"nextheight\"]}}},\"required\":[\"running\",\"strategies\"]}}},{\"name\":\"getautoconsolidation\",\"description\":\"Returns the configuration and status of the automatic consolidation of the outputs of each configured account.\",\"params\":[{\"name\":\"account\",\"description\":\"Only report the automatic consolidation of this account\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"accounts\":{\"type\":\"array\",\"description\":\"The automatic consolidation of each configured account, ordered by account number\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account whose outputs are consolidated\"},\"consolidations\":{\"type\":\"integer\",\"description\":\"The number of consolidation transactions published since automatic consolidation was configured\"},\"evaluatedheight\":{\"type\":\"integer\",\"description\":\"The block height of the latest evaluation\"},\"lasterror\":{\"type\":\"string\",\"description\":\"The most recent error which failed a consolidation\"},\"lasterrorheight\":{\"type\":\"integer\",\"description\":\"The block height the most recent error occurred at\"},\"lasttx\":{\"type\":\"string\",\"description\":\"The hash of the most recent consolidation transaction\"},\"lasttxheight\":{\"type\":\"integer\",\"description\":\"The block height the most recent consolidation transaction was created at\"},\"maxblockusage\":{\"type\":\"number\",\"description\":\"The fraction of the maximum block size the latest block may use for outputs to be consolidated\"},\"maxinputs\":{\"type\":\"integer\",\"description\":\"The maximum number of outputs consolidated by each transaction, or 0 for no limit\"},\"outputs\":{\"type\":\"integer\",\"description\":\"The number of spendable outputs counted by the latest evaluation\"},\"threshold\":{\"type\":\"integer\",\"description\":\"The number of spendable outputs which must be exceeded before outputs are consolidated\"}},\"required\":[\"account\",\"threshold\",\"maxinputs\",\"maxblockusage\",\"outputs\",\"consolidations\"]}}},\"required\":[\"accounts\"]}}},{\"name\":\"getaddressreceived\",\"description\":\"Returns the amounts received by a single address, including spent outputs, with unmined and insufficiently confirmed credits counted separately as pending.\",\"params\":[{\"name\":\"address\",\"description\":\"Payment address to query received amounts for\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before an output's value is counted as confirmed (values less than 1 are treated as 1)\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"confirmed\":{\"type\":\"number\",\"description\":\"The amount (in VHC) received by credits with at least minconf confirmations\"},\"pending\":{\"type\":\"number\",\"description\":\"The amount (in VHC) received by unmined credits and credits with fewer than minconf confirmations\"},\"total\":{\"type\":\"number\",\"description\":\"The sum of the confirmed and pending amounts\"}},\"required\":[\"confirmed\",\"pending\",\"total\"]}}},{\"name\":\"getaddressesbyaccount\",\"description\":\"DEPRECATED -- Returns all addresses strings controlled by a single account.\",\"params\":[{\"name\":\"account\",\"description\":\"Account name to fetch addresses for\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"All addresses controlled by 'account'\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}},{\"name\":\"getbalance\",\"description\":\"Calculates and returns the balance of all accounts.\\nClients which selected API version 4 receive only the spendable balance, as a number.\",\"params\":[{\"name\":\"account\",\"description\":\"DEPRECATED -- The account name to query the balance for, or \\\"*\\\" to consider all accounts (default=\\\"*\\\")\",\"schema\":{\"type\":\"string\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before an unspent output's value is included in the balance\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"balances\":{\"type\":\"array\",\"description\":\"Balances for all accounts.\",\"items\":{\"type\":\"object\",\"properties\":{\"accountname\":{\"type\":\"string\",\"description\":\"Name of account.\"},\"immaturecoinbaserewards\":{\"type\":\"number\",\"description\":\"Immature Coinbase reward coins.\"},\"immaturestakegeneration\":{\"type\":\"number\",\"description\":\"Number of immature stake coins.\"},\"lockedbytickets\":{\"type\":\"number\",\"description\":\"Coins locked by tickets.\"},\"spendable\":{\"type\":\"number\",\"description\":\"Spendable number of coins.\"},\"total\":{\"type\":\"number\",\"description\":\"Total amount of coins.\"},\"unconfirmed\":{\"type\":\"number\",\"description\":\"Unconfirmed number of coins.\"},\"votingauthority\":{\"type\":\"number\",\"description\":\"Coins for voting authority.\"}},\"required\":[\"accountname\",\"immaturecoinbaserewards\",\"immaturestakegeneration\",\"lockedbytickets\",\"spendable\",\"total\",\"unconfirmed\",\"votingauthority\"]}},\"blockhash\":{\"type\":\"string\",\"description\":\"Block hash.\"},\"cumulativetotal\":{\"type\":\"number\",\"description\":\"Total number of coins.\"},\"totalimmaturecoinbaserewards\":{\"type\":\"number\",\"description\":\"Total number of immature coinbase reward coins.\"},\"totalimmaturestakegeneration\":{\"type\":\"number\",\"description\":\"Total number of immature stake coins.\"},\"totallockedbytickets\":{\"type\":\"number\",\"description\":\"Total number of coins locked by tickets.\"},\"totalspendable\":{\"type\":\"number\",\"description\":\"Total number of spendable number of coins.\"},\"totalunconfirmed\":{\"type\":\"number\",\"description\":\"Total number of unconfirmed coins.\"},\"totalvotingauthority\":{\"type\":\"number\",\"description\":\"Total number of coins for voting authority.\"}},\"required\":[\"balances\",\"blockhash\"]}}},{\"name\":\"getbalanceathash\",\"description\":\"Calculates and returns the total balance of each account as of a main chain block by replaying all transactions mined at or before it.\",\"params\":[{\"name\":\"blockhash\",\"description\":\"Hash of the main chain block to calculate balances at\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"account\",\"description\":\"The account name to query the balance for, or \\\"*\\\" to consider all accounts (default=\\\"*\\\")\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"balances\":{\"type\":\"array\",\"description\":\"Balances of each account as of the block.\",\"items\":{\"type\":\"object\",\"properties\":{\"accountname\":{\"type\":\"string\",\"description\":\"Name of account.\"},\"total\":{\"type\":\"number\",\"description\":\"Total amount of coins in the account as of the block.\"}},\"required\":[\"accountname\",\"total\"]}},\"blockhash\":{\"type\":\"string\",\"description\":\"Hash of the block the balances were calculated at.\"},\"height\":{\"type\":\"integer\",\"description\":\"Height of the block the balances were calculated at.\"},\"total\":{\"type\":\"number\",\"description\":\"Total balance of all reported accounts.\"}},\"required\":[\"blockhash\",\"height\",\"balances\",\"total\"]}}},{\"name\":\"getbuildinfo\",\"description\":\"Returns the version, source revision, and build environment of the running wallet and its enabled feature flags.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"buildtags\":{\"type\":\"array\",\"description\":\"The build tags the wallet was built with\",\"items\":{\"type\":\"string\"}},\"commit\":{\"type\":\"string\",\"description\":\"The source revision the wallet was built from (omitted when not recorded at build time)\"},\"enabledfeatures\":{\"type\":\"array\",\"description\":\"The names of the enabled feature flags\",\"items\":{\"type\":\"string\"}},\"goversion\":{\"type\":\"string\",\"description\":\"The Go version the wallet was built with\"},\"platform\":{\"type\":\"string\",\"description\":\"The operating system and architecture the wallet was built for\"},\"version\":{\"type\":\"string\",\"description\":\"The semantic version of the wallet\"}},\"required\":[\"version\",\"buildtags\",\"goversion\",\"platform\",\"enabledfeatures\"]}}},{\"name\":\"getdbstats\",\"description\":\"Returns the storage statistics of the wallet database and of each root bucket and the buckets nested directly within them.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"buckets\":{\"type\":\"array\",\"description\":\"Storage statistics of each bucket, ordered by name\",\"items\":{\"type\":\"object\",\"properties\":{\"allocbytes\":{\"type\":\"integer\",\"description\":\"The number of bytes allocated for the storage of the bucket\"},\"buckets\":{\"type\":\"integer\",\"description\":\"The number of buckets, including the bucket itself and its nested buckets\"},\"depth\":{\"type\":\"integer\",\"description\":\"The number of levels of the bucket's storage tree\"},\"inusebytes\":{\"type\":\"integer\",\"description\":\"The number of bytes used to store the bucket\"},\"keys\":{\"type\":\"integer\",\"description\":\"The number of keys in the bucket and its nested buckets, including the keys of nested buckets\"},\"name\":{\"type\":\"string\",\"description\":\"Path of the bucket, with the keys of nested buckets separated by a slash and unprintable keys hex encoded\"}},\"required\":[\"name\",\"keys\",\"buckets\",\"depth\",\"inusebytes\",\"allocbytes\"]}},\"database\":{\"type\":\"object\",\"description\":\"Storage statistics of the database file (omitted if the database driver does not report them)\",\"properties\":{\"freespace\":{\"type\":\"integer\",\"description\":\"Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)\"},\"path\":{\"type\":\"string\",\"description\":\"The file path of the wallet database (omitted unless called with admin credentials)\"},\"size\":{\"type\":\"integer\",\"description\":\"The size of the wallet database file in bytes\"},\"writeerrors\":{\"type\":\"integer\",\"description\":\"The number of failed database writes since the wallet was opened\"}},\"required\":[\"size\",\"writeerrors\"]}},\"required\":[\"buckets\"]}}},{\"name\":\"getbestblockhash\",\"description\":\"Returns the hash of the newest block in the best chain that wallet has finished syncing with.\",\"params\":[],\"result\":{\"name\":\"result\",\"description\":\"The hash of the most recent synced-to block\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"getfeatureflags\",\"description\":\"Returns every feature flag and whether it is enabled.\\nMethods of disabled features return an error with code -18.\\nFlags are enabled and disabled with the enablefeature and disablefeature options.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"description\":{\"type\":\"string\",\"description\":\"Description of the feature\"},\"enabled\":{\"type\":\"boolean\",\"description\":\"Whether the feature is enabled\"},\"name\":{\"type\":\"string\",\"description\":\"The name of the feature flag\"}},\"required\":[\"name\",\"description\",\"enabled\"]}}}},{\"name\":\"getguardstatus\",\"description\":\"Returns the state of the anomaly guard.\\nThe guard locks the wallet when a configured trigger fires, and refuses walletpassphrase requests with error code -19 until it is cleared with clearguard.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"alerts\":{\"type\":\"array\",\"description\":\"The alerts raised by fired triggers, oldest first\",\"items\":{\"type\":\"object\",\"properties\":{\"client\":{\"type\":\"string\",\"description\":\"The address of the client whose request fired the trigger\"},\"detail\":{\"type\":\"string\",\"description\":\"Description of the suspicious activity\"},\"time\":{\"type\":\"integer\",\"description\":\"The Unix time the trigger fired\"},\"trigger\":{\"type\":\"string\",\"description\":\"The trigger which fired (passphrase, sendcap, or origin)\"}},\"required\":[\"time\",\"trigger\",\"client\",\"detail\"]}},\"passphrasefailures\":{\"type\":\"integer\",\"description\":\"The number of consecutive incorrect passphrases\"},\"triggered\":{\"type\":\"boolean\",\"description\":\"Whether a trigger has fired since the guard was last cleared\"}},\"required\":[\"triggered\",\"passphrasefailures\",\"alerts\"]}}},{\"name\":\"getbestblock\",\"description\":\"Returns the hash and height of the newest block in the best chain that wallet has finished syncing with.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"hash\":{\"type\":\"string\",\"description\":\"The hash of the block\"},\"height\":{\"type\":\"integer\",\"description\":\"The blockchain height of the block\"}},\"required\":[\"hash\",\"height\"]}}},{\"name\":\"getblockcount\",\"description\":\"Returns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\",\"params\":[],\"result\":{\"name\":\"result\",\"description\":\"The blockchain height of the most recent synced-to block\",\"schema\":{\"type\":\"number\"}}},{\"name\":\"getinfo\",\"description\":\"Returns a JSON object containing various state info.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"balance\":{\"type\":\"number\",\"description\":\"The balance of all accounts calculated with one block confirmation\"},\"blocks\":{\"type\":\"integer\",\"description\":\"The number of blocks processed\"},\"connections\":{\"type\":\"integer\",\"description\":\"The number of connected peers\"},\"database\":{\"type\":\"object\",\"description\":\"Storage statistics of the wallet database (omitted if unavailable)\",\"properties\":{\"freespace\":{\"type\":\"integer\",\"description\":\"Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)\"},\"path\":{\"type\":\"string\",\"description\":\"The file path of the wallet database (omitted unless called with admin credentials)\"},\"size\":{\"type\":\"integer\",\"description\":\"The size of the wallet database file in bytes\"},\"writeerrors\":{\"type\":\"integer\",\"description\":\"The number of failed database writes since the wallet was opened\"}},\"required\":[\"size\",\"writeerrors\"]},\"difficulty\":{\"type\":\"number\",\"description\":\"The current target difficulty\"},\"errors\":{\"type\":\"string\",\"description\":\"Any current errors, including warnings when the wallet casts votes of a version older than the stake version of recent blocks or the anomaly guard is triggered\"},\"keypoololdest\":{\"type\":\"integer\",\"description\":\"Unset\"},\"keypoolsize\":{\"type\":\"integer\",\"description\":\"Unset\"},\"paytxfee\":{\"type\":\"number\",\"description\":\"The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\"},\"protocolversion\":{\"type\":\"integer\",\"description\":\"The latest supported protocol version\"},\"proxy\":{\"type\":\"string\",\"description\":\"The proxy used by the server\"},\"relayfee\":{\"type\":\"number\",\"description\":\"The minimum relay fee for non-free transactions in VHC/KB\"},\"testnet\":{\"type\":\"boolean\",\"description\":\"Whether or not server is using testnet\"},\"timeoffset\":{\"type\":\"integer\",\"description\":\"The time offset\"},\"unlocked_until\":{\"type\":\"integer\",\"description\":\"Unset\"},\"version\":{\"type\":\"integer\",\"description\":\"The version of the server\"},\"walletversion\":{\"type\":\"integer\",\"description\":\"The version of the address manager database\"}},\"required\":[\"version\",\"protocolversion\",\"walletversion\",\"balance\",\"blocks\",\"timeoffset\",\"connections\",\"proxy\",\"difficulty\",\"testnet\",\"keypoololdest\",\"keypoolsize\",\"unlocked_until\",\"paytxfee\",\"relayfee\",\"errors\"]}}},{\"name\":\"getmasterpubkey\",\"description\":\"Requests the master pubkey from the wallet.\",\"params\":[{\"name\":\"account\",\"description\":\"The account to get the master pubkey for\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The master pubkey for the wallet\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"getmultisigoutinfo\",\"description\":\"Returns information about a multisignature output.\",\"params\":[{\"name\":\"hash\",\"description\":\"Input hash to check.\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"index\",\"description\":\"Index of input.\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"Script address.\"},\"amount\":{\"type\":\"number\",\"description\":\"Amount of coins contained.\"},\"blockhash\":{\"type\":\"string\",\"description\":\"Hash of the containing block.\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the containing block.\"},\"m\":{\"type\":\"integer\",\"description\":\"m (in m-of-n)\"},\"n\":{\"type\":\"integer\",\"description\":\"n (in m-of-n)\"},\"pubkeys\":{\"type\":\"array\",\"description\":\"Associated pubkeys.\",\"items\":{\"type\":\"string\"}},\"redeemscript\":{\"type\":\"string\",\"description\":\"Hex of the redeeming script.\"},\"spent\":{\"type\":\"boolean\",\"description\":\"If it has been spent.\"},\"spentby\":{\"type\":\"string\",\"description\":\"Hash of spending tx.\"},\"spentbyindex\":{\"type\":\"integer\",\"description\":\"Index of spending tx.\"},\"txhash\":{\"type\":\"string\",\"description\":\"txhash\"}},\"required\":[\"address\",\"redeemscript\",\"m\",\"n\",\"pubkeys\",\"txhash\",\"blockheight\",\"blockhash\",\"spent\",\"spentby\",\"spentbyindex\",\"amount\"]}}},{\"name\":\"getnewaddress\",\"description\":\"Generates and returns a new payment address.\",\"params\":[{\"name\":\"account\",\"description\":\"Account name the new address will belong to (default=\\\"default\\\")\",\"schema\":{\"type\":\"string\"}},{\"name\":\"gappolicy\",\"description\":\"String defining the policy to use when the BIP0044 gap limit would be violated, may be \\\"error\\\", \\\"ignore\\\", or \\\"wrap\\\" (default is the account gap policy)\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The payment address\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"getpeerinfo\",\"description\":\"Returns data about each peer the wallet synchronizes with using SPV, including the capabilities negotiated with the peer.\\nWhen the wallet is synchronized using the consensus RPC server, the request is passed through to the server and its peers are returned instead.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"addr\":{\"type\":\"string\",\"description\":\"The remote IP address and port of the peer\"},\"banscore\":{\"type\":\"integer\",\"description\":\"The ban score of the peer\"},\"capabilities\":{\"type\":\"array\",\"description\":\"Optional protocol features supported by the peer (cfilters, sendheaders, feefilter)\",\"items\":{\"type\":\"string\"}},\"conntime\":{\"type\":\"integer\",\"description\":\"Unix time the connection to the peer was established\"},\"id\":{\"type\":\"integer\",\"description\":\"A unique identifier of the peer, increasing with each connection\"},\"protocolversion\":{\"type\":\"integer\",\"description\":\"The protocol version negotiated with the peer\"},\"servicenames\":{\"type\":\"string\",\"description\":\"Names of the service flags advertised by the peer\"},\"services\":{\"type\":\"string\",\"description\":\"Service flags advertised by the peer, encoded as in the consensus RPC server's getpeerinfo result\"},\"startingheight\":{\"type\":\"integer\",\"description\":\"The block height the peer advertised when connecting\"},\"subver\":{\"type\":\"string\",\"description\":\"The user agent of the peer\"},\"version\":{\"type\":\"integer\",\"description\":\"The protocol version advertised by the peer\"}},\"required\":[\"id\",\"addr\",\"services\",\"servicenames\",\"version\",\"protocolversion\",\"subver\",\"conntime\",\"startingheight\",\"banscore\",\"capabilities\"]}}}},{\"name\":\"getrawchangeaddress\",\"description\":\"Generates and returns a new internal payment address for use as a change address in raw transactions.\",\"params\":[{\"name\":\"account\",\"description\":\"Account name the new internal address will belong to (default=\\\"default\\\")\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The internal payment address\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"getreceivedbyaccount\",\"description\":\"DEPRECATED -- Returns the total amount received by addresses of some account, including spent outputs.\",\"params\":[{\"name\":\"account\",\"description\":\"Account name to query total received amount for\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before an output's value is included in the total\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"description\":\"The total received amount valued in valhallacoin\",\"schema\":{\"type\":\"number\"}}},{\"name\":\"getreceivedbyaddress\",\"description\":\"Returns the total amount received by a single address, including spent outputs.\",\"params\":[{\"name\":\"address\",\"description\":\"Payment address which received outputs to include in total\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before an output's value is included in the total\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"description\":\"The total received amount valued in valhallacoin\",\"schema\":{\"type\":\"number\"}}},{\"name\":\"getmultisigaccountaddress\",\"description\":\"Returns the P2SH address and redeem script of the next child index of a multisig account branch and watches the address for received outputs.\",\"params\":[{\"name\":\"name\",\"description\":\"The name of the multisig account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"internal\",\"description\":\"Derive the address from the internal branch instead of the external branch\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The P2SH address of the child\"},\"branch\":{\"type\":\"integer\",\"description\":\"The branch of the child (0=external, 1=internal)\"},\"index\":{\"type\":\"integer\",\"description\":\"The child index\"},\"redeemscript\":{\"type\":\"string\",\"description\":\"The hex encoded multisig redeem script\"}},\"required\":[\"address\",\"redeemscript\",\"branch\",\"index\"]}}},{\"name\":\"getrescanstatus\",\"description\":\"Reports the progress of the current or most recent rescan of the wallet.\\nReturns null if the wallet has not been rescanned since it was opened.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"addressesdiscovered\":{\"type\":\"integer\",\"description\":\"The number of distinct wallet addresses paid by transactions found by the rescan\"},\"error\":{\"type\":\"string\",\"description\":\"The error which ended the rescan, omitted if the rescan is in progress or completed successfully\"},\"percent\":{\"type\":\"number\",\"description\":\"The percentage of blocks through the target height which have been scanned\"},\"rescanning\":{\"type\":\"boolean\",\"description\":\"Whether the rescan is in progress\"},\"scannedthrough\":{\"type\":\"integer\",\"description\":\"The height of the last block scanned\"},\"started\":{\"type\":\"integer\",\"description\":\"The Unix time the rescan started\"},\"startheight\":{\"type\":\"integer\",\"description\":\"The height of the first block scanned\"},\"targetheight\":{\"type\":\"integer\",\"description\":\"The height of the main chain tip the rescan is scanning through\"}},\"required\":[\"rescanning\",\"startheight\",\"targetheight\",\"scannedthrough\",\"percent\",\"addressesdiscovered\",\"started\"]}}},{\"name\":\"getresponsesigningkey\",\"description\":\"Returns the public key which signs the responses of selected methods and the names of those methods.\\nThe result of a signed method is replaced by an object with the keys payload, signature, and pubkey.\\nThe payload is a JSON string encoding an object with the method, id, time, and result of the request, and the signature is a DER encoded secp256k1 ECDSA signature of the SHA-256 hash of the payload.\\nThe key should be pinned by clients out of band rather than trusted from this method.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"methods\":{\"type\":\"array\",\"description\":\"Methods whose responses are signed\",\"items\":{\"type\":\"string\"}},\"pubkey\":{\"type\":\"string\",\"description\":\"Hex encoded compressed secp256k1 public key which signs responses\"}},\"required\":[\"pubkey\",\"methods\"]}}},{\"name\":\"getspendingpolicy\",\"description\":\"Returns the spending limits of an account and the amount sent from it during the current UTC day.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"Name of the account.\"},\"dailylimit\":{\"type\":\"number\",\"description\":\"Maximum total amount which may be sent during a UTC day (0 when unlimited).\"},\"dailyremaining\":{\"type\":\"number\",\"description\":\"Amount which may still be sent during the current UTC day without exceeding the daily limit.\"},\"dailyspent\":{\"type\":\"number\",\"description\":\"Total amount sent during the current UTC day.\"},\"overridable\":{\"type\":\"boolean\",\"description\":\"Whether the limits may be exceeded after providing an override passphrase.\"},\"overridden\":{\"type\":\"boolean\",\"description\":\"Whether the limits are currently overridden.\"},\"txlimit\":{\"type\":\"number\",\"description\":\"Maximum amount which may be sent by a single transaction (0 when unlimited).\"}},\"required\":[\"account\",\"txlimit\",\"dailylimit\",\"dailyspent\",\"dailyremaining\",\"overridable\",\"overridden\"]}}},{\"name\":\"getstakeinfo\",\"description\":\"Returns statistics about staking from the wallet.\\nWithout an RPC connection to vhcd (e.g. in SPV mode), ticket states are determined from the wallet's view of the main chain: tickets revoked before expiry are missed, tickets detected as missed votes are missed until revoked, and other mature unspent tickets are live until expiry. The allmempooltix field is zero in this mode.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"allmempooltix\":{\"type\":\"integer\",\"description\":\"Number of tickets currently in the mempool\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Current block height for stake info.\"},\"difficulty\":{\"type\":\"number\",\"description\":\"Current stake difficulty.\"},\"expired\":{\"type\":\"integer\",\"description\":\"Number of tickets that have expired\"},\"immature\":{\"type\":\"integer\",\"description\":\"Number of tickets from this wallet that are in the blockchain but which are not yet mature\"},\"live\":{\"type\":\"integer\",\"description\":\"Number of mature, active tickets owned by this wallet\"},\"missed\":{\"type\":\"integer\",\"description\":\"Number of missed tickets (failure to vote, not including expired)\"},\"ownmempooltix\":{\"type\":\"integer\",\"description\":\"Number of tickets submitted by this wallet currently in mempool\"},\"poolsize\":{\"type\":\"integer\",\"description\":\"Number of live tickets in the ticket pool.\"},\"proportionlive\":{\"type\":\"number\",\"description\":\"(Live / PoolSize)\"},\"proportionmissed\":{\"type\":\"number\",\"description\":\"(Missed / (Missed + Voted))\"},\"revoked\":{\"type\":\"integer\",\"description\":\"Number of missed tickets that were missed and then revoked\"},\"totalsubsidy\":{\"type\":\"number\",\"description\":\"Total amount of coins earned by stake mining\"},\"unspent\":{\"type\":\"integer\",\"description\":\"Number of unspent tickets\"},\"unspentexpired\":{\"type\":\"integer\",\"description\":\"Number of unspent tickets which are past expiry\"},\"voted\":{\"type\":\"integer\",\"description\":\"Number of votes cast by this wallet\"}},\"required\":[\"blockheight\",\"difficulty\",\"totalsubsidy\",\"ownmempooltix\",\"immature\",\"unspent\",\"voted\",\"revoked\",\"unspentexpired\"]}}},{\"name\":\"getstakingstats\",\"description\":\"Returns the tickets bought, votes, and revocations of the wallet mined in blocks within a time range, with the rewards earned, fees paid, and annualized return.\",\"params\":[{\"name\":\"starttime\",\"description\":\"Unix time beginning the range (defaults to the wallet's first mined stake transaction)\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"endtime\",\"description\":\"Unix time ending the range, exclusive (defaults to the current time)\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"annualizedreturn\":{\"type\":\"number\",\"description\":\"Vote rewards less fees as a fraction of the ticket cost, scaled to a year\"},\"endtime\":{\"type\":\"integer\",\"description\":\"Unix time ending the range\"},\"fees\":{\"type\":\"number\",\"description\":\"Total fees paid by tickets bought by the wallet, votes, and revocations\"},\"revocations\":{\"type\":\"integer\",\"description\":\"Number of revocations\"},\"starttime\":{\"type\":\"integer\",\"description\":\"Unix time beginning the range\"},\"ticketcost\":{\"type\":\"number\",\"description\":\"Total price of the tickets bought by the wallet\"},\"ticketsbought\":{\"type\":\"integer\",\"description\":\"Number of tickets bought by the wallet\"},\"voterewards\":{\"type\":\"number\",\"description\":\"Total stakebase rewards earned by votes\"},\"votes\":{\"type\":\"integer\",\"description\":\"Number of votes\"}},\"required\":[\"starttime\",\"endtime\",\"ticketsbought\",\"ticketcost\",\"votes\",\"voterewards\",\"revocations\",\"fees\",\"annualizedreturn\"]}}},{\"name\":\"getsyncstatus\",\"description\":\"Reports the progress of the wallet's synchronization with its network backend (vhcd or SPV peers), for displaying the progress of the initial sync.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"backendheight\":{\"type\":\"integer\",\"description\":\"The best block height known by the network backend, or 0 if not yet known\"},\"cfiltersfetched\":{\"type\":\"integer\",\"description\":\"The number of compact filters fetched since the network backend connected\"},\"estimatedremaining\":{\"type\":\"integer\",\"description\":\"Estimated seconds remaining until the wallet is synchronized, or 0 when synchronized or no estimate is available\"},\"headersfetched\":{\"type\":\"integer\",\"description\":\"The number of new block headers fetched since the network backend connected\"},\"rescannedthrough\":{\"type\":\"integer\",\"description\":\"The last block height scanned by the current or most recent rescan\"},\"rescanning\":{\"type\":\"boolean\",\"description\":\"Whether a rescan is in progress\"},\"rescanpoint\":{\"type\":\"string\",\"description\":\"The hash of the first block not yet scanned for wallet transactions, omitted when every block has been scanned\"},\"rescanpointheight\":{\"type\":\"integer\",\"description\":\"The height of the rescan point block\"},\"synced\":{\"type\":\"boolean\",\"description\":\"Whether the initial synchronization with the network backend is complete\"},\"tiphash\":{\"type\":\"string\",\"description\":\"The hash of the wallet's main chain tip block\"},\"tipheight\":{\"type\":\"integer\",\"description\":\"The height of the wallet's main chain tip block\"}},\"required\":[\"synced\",\"tiphash\",\"tipheight\",\"backendheight\",\"headersfetched\",\"cfiltersfetched\",\"rescanning\",\"estimatedremaining\"]}}},{\"name\":\"getticketfee\",\"description\":\"Get the current fee per kB of the serialized tx size used for an authored stake transaction.\",\"params\":[],\"result\":{\"name\":\"result\",\"description\":\"The current fee\",\"schema\":{\"type\":\"number\"}}},{\"name\":\"getticketinfo\",\"description\":\"Returns the status of a ticket and the vote bits and agenda choices of every vote the wallet created for it.\",\"params\":[{\"name\":\"tickethash\",\"description\":\"Hash of the ticket\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"blockhash\":{\"type\":\"string\",\"description\":\"Hash of the block the ticket was mined in, if mined\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the block the ticket was mined in, or -1 if unmined\"},\"spenderhash\":{\"type\":\"string\",\"description\":\"Hash of the vote or revocation spending the ticket, if spent\"},\"status\":{\"type\":\"string\",\"description\":\"Current status of the ticket (\\\"unknown\\\", \\\"unmined\\\", \\\"immature\\\", \\\"live\\\", \\\"voted\\\", \\\"revoked\\\", \\\"missed\\\", or \\\"expired\\\")\"},\"tickethash\":{\"type\":\"string\",\"description\":\"Hash of the ticket\"},\"votes\":{\"type\":\"array\",\"description\":\"Records of the votes created by the wallet for the ticket\",\"items\":{\"type\":\"object\",\"properties\":{\"blockhash\":{\"type\":\"string\",\"description\":\"Hash of the block voted on\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the block voted on\"},\"choices\":{\"type\":\"array\",\"description\":\"The agenda choices of the stake version cast by the vote bits\",\"items\":{\"type\":\"object\",\"properties\":{\"agendaid\":{\"type\":\"string\",\"description\":\"The ID of the agenda\"},\"choiceid\":{\"type\":\"string\",\"description\":\"The ID of the agenda's choice\"}},\"required\":[\"agendaid\",\"choiceid\"]}},\"tickethash\":{\"type\":\"string\",\"description\":\"Hash of the ticket\"},\"time\":{\"type\":\"integer\",\"description\":\"Unix time the vote was created\"},\"votebits\":{\"type\":\"integer\",\"description\":\"The vote bits cast by the vote\"},\"votebitsext\":{\"type\":\"string\",\"description\":\"The hex encoded extended vote bits cast by the vote\"},\"votehash\":{\"type\":\"string\",\"description\":\"Hash of the vote transaction\"},\"voteversion\":{\"type\":\"integer\",\"description\":\"The stake version of the vote\"}},\"required\":[\"tickethash\",\"votehash\",\"blockhash\",\"blockheight\",\"time\",\"votebits\",\"votebitsext\",\"voteversion\",\"choices\"]}},\"votingxpubindex\":{\"type\":\"integer\",\"description\":\"Child index of the external branch of the --ticketbuyer.votingxpub extended public key which derived the voting address of the ticket, omitted when not derived from it\"}},\"required\":[\"tickethash\",\"status\",\"blockheight\",\"votes\"]}}},{\"name\":\"gettickets\",\"description\":\"Returning the hashes of the tickets currently owned by wallet.\\nUse listticketstatuses to list the status and purchase height of tickets filtered by status.\",\"params\":[{\"name\":\"includeimmature\",\"description\":\"If true include immature tickets in the results.\",\"required\":true,\"schema\":{\"type\":\"boolean\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"hashes\":{\"type\":\"array\",\"description\":\"Hashes of the tickets owned by the wallet encoded as strings\",\"items\":{\"type\":\"string\"}}},\"required\":[\"hashes\"]}}},{\"name\":\"gettransaction\",\"description\":\"Returns a JSON object with details regarding a transaction relevant to this wallet.\",\"params\":[{\"name\":\"txid\",\"description\":\"Hash of the transaction to query\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"includewatchonly\",\"description\":\"Also consider transactions involving watched addresses\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"amount\":{\"type\":\"number\",\"description\":\"The total amount this transaction credits to the wallet, valued in valhallacoin\"},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the block this transaction is mined in, or the empty string if unmined\"},\"blockindex\":{\"type\":\"integer\",\"description\":\"Unset\"},\"blocktime\":{\"type\":\"integer\",\"description\":\"The Unix time of the block header this transaction is mined in, or 0 if unmined\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"details\":{\"type\":\"array\",\"description\":\"Additional details for each recorded wallet credit and debit\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"DEPRECATED -- Unset\"},\"address\":{\"type\":\"string\",\"description\":\"The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\"},\"amount\":{\"type\":\"number\",\"description\":\"The amount of a received output\"},\"category\":{\"type\":\"string\",\"description\":\"The kind of detail: \\\"send\\\" for sent transactions, \\\"immature\\\" for immature coinbase outputs, \\\"generate\\\" for mature coinbase outputs, \\\"transfer\\\" for both sides of transfers between accounts of the wallet, or \\\"recv\\\" for all other received outputs\"},\"fee\":{\"type\":\"number\",\"description\":\"The included fee for a sent transaction\"},\"involveswatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"vout\":{\"type\":\"integer\",\"description\":\"The transaction output index\"}},\"required\":[\"account\",\"amount\",\"category\",\"vout\"]}},\"fee\":{\"type\":\"number\",\"description\":\"The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\"},\"hex\":{\"type\":\"string\",\"description\":\"The transaction encoded as a hexadecimal string\"},\"ticketstatus\":{\"type\":\"string\",\"description\":\"Status of ticket (if transaction is a ticket)\"},\"time\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"timereceived\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash\"},\"type\":{\"type\":\"string\",\"description\":\"The type of transaction (regular, ticket, vote, or revocation)\"},\"walletconflicts\":{\"type\":\"array\",\"description\":\"Unset\",\"items\":{\"type\":\"string\"}}},\"required\":[\"amount\",\"confirmations\",\"blockhash\",\"blockindex\",\"blocktime\",\"txid\",\"walletconflicts\",\"time\",\"timereceived\",\"details\",\"hex\",\"type\"]}}},{\"name\":\"getunconfirmedbalance\",\"description\":\"Calculates the unspent output value of all unmined transaction outputs for an account.\",\"params\":[{\"name\":\"account\",\"description\":\"The account to query the unconfirmed balance for (default=\\\"default\\\")\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"Total amount of all unmined unspent outputs of the account valued in valhallacoin.\",\"schema\":{\"type\":\"number\"}},\"x-websocketonly\":true},{\"name\":\"getvotechoices\",\"description\":\"Retrieve the currently configured vote choices for the latest supported stake agendas\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"choices\":{\"type\":\"array\",\"description\":\"The currently configured agenda vote choices, including abstaining votes\",\"items\":{\"type\":\"object\",\"properties\":{\"agendadescription\":{\"type\":\"string\",\"description\":\"A description of the agenda the choice concerns\"},\"agendaid\":{\"type\":\"string\",\"description\":\"The ID for the agenda the choice concerns\"},\"choicedescription\":{\"type\":\"string\",\"description\":\"A description of the current choice for this agenda\"},\"choiceid\":{\"type\":\"string\",\"description\":\"The ID of the current choice for this agenda\"}},\"required\":[\"agendaid\",\"agendadescription\",\"choiceid\",\"choicedescription\"]}},\"version\":{\"type\":\"integer\",\"description\":\"The latest stake version supported by the software and the version of the included agendas\"}},\"required\":[\"version\",\"choices\"]}}},{\"name\":\"getvspinfo\",\"description\":\"Returns info about the selected voting service provider (VSP) and the tickets registered with it\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"apiversions\":{\"type\":\"array\",\"description\":\"The API versions supported by the VSP\",\"items\":{\"type\":\"integer\"}},\"feepercentage\":{\"type\":\"number\",\"description\":\"The percentage of the ticket value charged as the VSP fee\"},\"feexpub\":{\"type\":\"string\",\"description\":\"The extended public key the VSP derives fee addresses from\"},\"host\":{\"type\":\"string\",\"description\":\"The URL of the VSP\"},\"network\":{\"type\":\"string\",\"description\":\"The network the VSP operates on\"},\"pubkey\":{\"type\":\"string\",\"description\":\"The base64-encoded Ed25519 public key which signs VSP responses\"},\"tickets\":{\"type\":\"array\",\"description\":\"The tickets purchased for the VSP\",\"items\":{\"type\":\"object\",\"properties\":{\"feeaddress\":{\"type\":\"string\",\"description\":\"The address the VSP fee is paid to\"},\"feeamount\":{\"type\":\"number\",\"description\":\"The VSP fee of the ticket\"},\"feetxhash\":{\"type\":\"string\",\"description\":\"The hash of the transaction paying the VSP fee\"},\"status\":{\"type\":\"string\",\"description\":\"The registration status of the ticket (unpaid, signed, published, or registered)\"},\"tickethash\":{\"type\":\"string\",\"description\":\"The hash of the ticket\"}},\"required\":[\"tickethash\",\"status\"]}},\"vspclosed\":{\"type\":\"boolean\",\"description\":\"Whether the VSP is closed to new tickets\"}},\"required\":[\"host\",\"pubkey\",\"network\",\"apiversions\",\"feepercentage\",\"feexpub\",\"vspclosed\",\"tickets\"]}}},{\"name\":\"getwalletfee\",\"description\":\"Get currently set transaction fee for the wallet\",\"params\":[],\"result\":{\"name\":\"result\",\"description\":\"Current tx fee (in VHC)\",\"schema\":{\"type\":\"number\"}}},{\"name\":\"help\",\"description\":\"Returns a list of all commands or help for a specified command.\",\"params\":[{\"name\":\"command\",\"description\":\"The command to retrieve help for\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"List of commands\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"importmulti\",\"description\":\"Imports private keys, public keys, extended public keys, and redeem scripts to the 'imported' account in a single database transaction.\\nPublic keys, extended public keys, and private keys imported with watchonly set are watching-only.\\nThe outcome of each request is returned in the same order as the requests.\\nWhen rescanning, a single rescan begins from the earliest timestamp of the imported requests.\",\"params\":[{\"name\":\"requests\",\"description\":\"The keys and scripts to import\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"privkey\":{\"type\":\"string\",\"description\":\"A WIF-encoded private key\"},\"pubkey\":{\"type\":\"string\",\"description\":\"A hex encoded public key, imported watching-only\"},\"range\":{\"type\":\"array\",\"description\":\"The first and last child indexes of each branch of xpub to import (default: 0 through 19)\",\"items\":{\"type\":\"integer\"}},\"redeemscript\":{\"type\":\"string\",\"description\":\"A hex encoded redeem script for a P2SH output\"},\"timestamp\":{\"type\":\"integer\",\"description\":\"The UNIX timestamp of the earliest transaction which may pay to the imported addresses, or unset if no rescan is required for this request\"},\"watchonly\":{\"type\":\"boolean\",\"description\":\"Import only the public key of privkey\"},\"xpub\":{\"type\":\"string\",\"description\":\"An extended public key whose external and internal branch children are imported watching-only\"}}}}},{\"name\":\"rescan\",\"description\":\"Rescan the blockchain from the earliest request timestamp for outputs controlled by the imported keys and scripts\",\"schema\":{\"type\":\"boolean\",\"default\":true}}],\"result\":{\"name\":\"result\",\"description\":\"The outcome of each request\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"addresses\":{\"type\":\"array\",\"description\":\"The addresses of the imported keys or script\",\"items\":{\"type\":\"string\"}},\"error\":{\"type\":\"string\",\"description\":\"The reason the request could not be imported, omitted on success\"},\"success\":{\"type\":\"boolean\",\"description\":\"Whether the request was imported, including when it was previously imported\"}},\"required\":[\"success\",\"addresses\"]}}}},{\"name\":\"importpkcs11account\",\"description\":\"Creates an account from the account extended public key of keys kept inside the hardware security module configured by the pkcs11module option.\\nThe private keys of the account are never known by the wallet, and its inputs and messages are signed inside the module.\\nThe module must hold the private key of each derived address, found by the Hash160 of its public key, and is verified to hold the key of the first external address.\\nAccounts with keys kept by the module are not restored from the wallet seed.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the new account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"xpub\",\"description\":\"The account extended public key exported by the token\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"importprivkey\",\"description\":\"Imports a WIF-encoded private key to the 'imported' account.\",\"params\":[{\"name\":\"privkey\",\"description\":\"The WIF-encoded private key\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"label\",\"description\":\"Unused (must be unset or 'imported')\",\"schema\":{\"type\":\"string\"}},{\"name\":\"rescan\",\"description\":\"Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\",\"schema\":{\"type\":\"boolean\",\"default\":true}},{\"name\":\"scanfrom\",\"description\":\"Block number for where to start rescan from\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"importscript\",\"description\":\"Import a redeem script.\",\"params\":[{\"name\":\"hex\",\"description\":\"Hex encoded script to import\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"rescan\",\"description\":\"Rescansfdsfd the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\",\"schema\":{\"type\":\"boolean\",\"default\":true}},{\"name\":\"scanfrom\",\"description\":\"Block number for where to start rescan from\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"importvotechoices\",\"description\":\"Applies the agenda choices of a document created by exportvotechoices.\\nAgendas which are not included in the document are set to abstain.\\nThe document must be for the stake version supported by the wallet, and either every choice is applied or none are.\",\"params\":[{\"name\":\"document\",\"description\":\"JSON document of the form {\\\"version\\\":n,\\\"choices\\\":[{\\\"agendaid\\\":\\\"id\\\",\\\"choiceid\\\":\\\"id\\\"},...]}\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"joinsplitticketsession\",\"description\":\"Contributes funds of an account to a split ticket session, returning the updated session.\\nThe contributed outputs pay the contribution and its share of the ticket fee, and are reserved for the session for two hours.  Sessions may not be joined after any participant has signed.\",\"params\":[{\"name\":\"session\",\"description\":\"The JSON-encoded split ticket session\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"account\",\"description\":\"The account funding the contribution\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amount\",\"description\":\"The share of the ticket price (in VHC) to contribute; the unfunded remainder is contributed when omitted\",\"schema\":{\"type\":\"number\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations of the contributed outputs\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"session\":{\"type\":\"string\",\"description\":\"The JSON-encoded session passed between the participants\"},\"tickethash\":{\"type\":\"string\",\"description\":\"The hash of the ticket, set once the session is signed\"},\"ticketprice\":{\"type\":\"number\",\"description\":\"The price (in VHC) of the ticket\"},\"unfunded\":{\"type\":\"number\",\"description\":\"The share of the ticket price (in VHC) not yet contributed by any participant\"}},\"required\":[\"session\",\"ticketprice\",\"unfunded\"]}}},{\"name\":\"keypoolrefill\",\"description\":\"DEPRECATED -- This request does nothing since no keypool is maintained.\",\"params\":[{\"name\":\"newsize\",\"description\":\"Unused\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"listaccounts\",\"description\":\"DEPRECATED -- Returns a JSON object of all accounts and their balances.\",\"params\":[{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before an unspent output's value is included in the balance\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"number\"}}}},{\"name\":\"listaddresstransactions\",\"description\":\"Returns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\",\"params\":[{\"name\":\"addresses\",\"description\":\"Addresses to filter transaction results by\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"account\",\"description\":\"Unused (must be unset or \\\"*\\\")\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"DEPRECATED -- Unset\"},\"address\":{\"type\":\"string\",\"description\":\"Payment address for a transaction output\"},\"amount\":{\"type\":\"number\",\"description\":\"The value of the transaction output valued in valhallacoin\"},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the block this transaction is mined in, or the empty string if unmined\"},\"blockindex\":{\"type\":\"integer\",\"description\":\"Unset\"},\"blocktime\":{\"type\":\"integer\",\"description\":\"The Unix time of the block header this transaction is mined in, or 0 if unmined\"},\"category\":{\"type\":\"string\",\"description\":\"The kind of transaction: \\\"send\\\" for sent transactions, \\\"immature\\\" for immature coinbase outputs, \\\"generate\\\" for mature coinbase outputs, \\\"transfer\\\" for both sides of transfers between accounts of the wallet, or \\\"recv\\\" for all other received outputs.  Note: A single output may be included multiple times under different categories\"},\"comment\":{\"type\":\"string\",\"description\":\"Unset\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"fee\":{\"type\":\"number\",\"description\":\"The total input value minus the total output value for sent transactions\"},\"generated\":{\"type\":\"boolean\",\"description\":\"Whether the transaction output is a coinbase output\"},\"involveswatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"otheraccount\":{\"type\":\"string\",\"description\":\"Unset\"},\"time\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"timereceived\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction\"},\"txtype\":{\"type\":\"string\",\"description\":\"The type of tx (regular tx, stake tx)\"},\"vout\":{\"type\":\"integer\",\"description\":\"The transaction output index\"},\"walletconflicts\":{\"type\":\"array\",\"description\":\"Unset\",\"items\":{\"type\":\"string\"}}},\"required\":[\"account\",\"amount\",\"category\",\"confirmations\",\"time\",\"timereceived\",\"txid\",\"vout\",\"walletconflicts\"]}}},\"x-websocketonly\":true},{\"name\":\"listdepositaddresses\",\"description\":\"Returns the reserved deposit addresses of the wallet, ordered by account and then by creation.\",\"params\":[{\"name\":\"account\",\"description\":\"Only include addresses of this account\",\"schema\":{\"type\":\"string\"}},{\"name\":\"status\",\"description\":\"Only include addresses with this status (\\\"available\\\" or \\\"assigned\\\")\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"Name of the account the address belongs to\"},\"address\":{\"type\":\"string\",\"description\":\"The reserved address\"},\"assigned\":{\"type\":\"integer\",\"description\":\"Unix time the address was assigned\"},\"created\":{\"type\":\"integer\",\"description\":\"Unix time the address was reserved\"},\"index\":{\"type\":\"integer\",\"description\":\"Child index of the address in the account's external branch\"},\"reference\":{\"type\":\"string\",\"description\":\"Reference recorded when the address was assigned\"},\"status\":{\"type\":\"string\",\"description\":\"Assignment status of the address (\\\"available\\\" or \\\"assigned\\\")\"}},\"required\":[\"account\",\"address\",\"index\",\"status\",\"created\"]}}}},{\"name\":\"listalltransactions\",\"description\":\"Returns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\",\"params\":[{\"name\":\"account\",\"description\":\"Unused (must be unset or \\\"*\\\")\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"DEPRECATED -- Unset\"},\"address\":{\"type\":\"string\",\"description\":\"Payment address for a transaction output\"},\"amount\":{\"type\":\"number\",\"description\":\"The value of the transaction output valued in valhallacoin\"},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the block this transaction is mined in, or the empty string if unmined\"},\"blockindex\":{\"type\":\"integer\",\"description\":\"Unset\"},\"blocktime\":{\"type\":\"integer\",\"description\":\"The Unix time of the block header this transaction is mined in, or 0 if unmined\"},\"category\":{\"type\":\"string\",\"description\":\"The kind of transaction: \\\"send\\\" for sent transactions, \\\"immature\\\" for immature coinbase outputs, \\\"generate\\\" for mature coinbase outputs, \\\"transfer\\\" for both sides of transfers between accounts of the wallet, or \\\"recv\\\" for all other received outputs.  Note: A single output may be included multiple times under different categories\"},\"comment\":{\"type\":\"string\",\"description\":\"Unset\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"fee\":{\"type\":\"number\",\"description\":\"The total input value minus the total output value for sent transactions\"},\"generated\":{\"type\":\"boolean\",\"description\":\"Whether the transaction output is a coinbase output\"},\"involveswatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"otheraccount\":{\"type\":\"string\",\"description\":\"Unset\"},\"time\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"timereceived\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction\"},\"txtype\":{\"type\":\"string\",\"description\":\"The type of tx (regular tx, stake tx)\"},\"vout\":{\"type\":\"integer\",\"description\":\"The transaction output index\"},\"walletconflicts\":{\"type\":\"array\",\"description\":\"Unset\",\"items\":{\"type\":\"string\"}}},\"required\":[\"account\",\"amount\",\"category\",\"confirmations\",\"time\",\"timereceived\",\"txid\",\"vout\",\"walletconflicts\"]}}},\"x-websocketonly\":true},{\"name\":\"listlockunspent\",\"description\":\"Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"amount\":{\"type\":\"number\",\"description\":\"The the previous output amount\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree to generate transaction for\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced output\"}},\"required\":[\"txid\",\"vout\",\"tree\"]}}}},{\"name\":\"listmissedvotes\",\"description\":\"Returns the tickets with voting authority held by the wallet most recently detected to have missed their votes, ordered by the height of the block they were selected to vote on.\\nA vote is missed when it is not included in the block following the block the ticket was selected to vote on. Missed votes are only detected while the wallet is synced and are not remembered across restarts.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"blockhash\":{\"type\":\"string\",\"description\":\"Hash of the block the ticket was selected to vote on\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the block the ticket was selected to vote on\"},\"detected\":{\"type\":\"integer\",\"description\":\"Unix time the missed vote was detected\"},\"tickethash\":{\"type\":\"string\",\"description\":\"Hash of the ticket which missed its vote\"}},\"required\":[\"tickethash\",\"blockhash\",\"blockheight\",\"detected\"]}}}},{\"name\":\"listmultisigaccounts\",\"description\":\"Returns every HD multisig account of the wallet.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The wallet account whose extended public key is one of the cosigner keys\"},\"m\":{\"type\":\"integer\",\"description\":\"The number of signatures required to spend outputs of the account\"},\"n\":{\"type\":\"integer\",\"description\":\"The number of cosigner keys\"},\"name\":{\"type\":\"string\",\"description\":\"The name of the multisig account\"},\"nextexternal\":{\"type\":\"integer\",\"description\":\"The next child index of the external branch\"},\"nextinternal\":{\"type\":\"integer\",\"description\":\"The next child index of the internal branch\"},\"xpubs\":{\"type\":\"array\",\"description\":\"The account extended public keys of every cosigner\",\"items\":{\"type\":\"string\"}}},\"required\":[\"name\",\"account\",\"m\",\"n\",\"xpubs\",\"nextexternal\",\"nextinternal\"]}}}},{\"name\":\"listmultisigunspent\",\"description\":\"Returns every unspent P2SH multisig output recorded by the wallet with its redeem script and confirmation data.\",\"params\":[{\"name\":\"address\",\"description\":\"Only return outputs paying this P2SH address\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The P2SH address paid by the output\"},\"amount\":{\"type\":\"number\",\"description\":\"The output amount (in VHC)\"},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the block mining the output, omitted when unmined\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"The height of the block mining the output, omitted when unmined\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the output\"},\"m\":{\"type\":\"integer\",\"description\":\"The number of signatures required to spend the output\"},\"n\":{\"type\":\"integer\",\"description\":\"The number of public keys of the redeem script\"},\"pubkeys\":{\"type\":\"array\",\"description\":\"The hex encoded public keys of the redeem script\",\"items\":{\"type\":\"string\"}},\"redeemscript\":{\"type\":\"string\",\"description\":\"The hex encoded multisig redeem script\"},\"tree\":{\"type\":\"integer\",\"description\":\"The transaction tree of the output\"},\"txhash\":{\"type\":\"string\",\"description\":\"The hash of the transaction creating the output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index\"}},\"required\":[\"txhash\",\"vout\",\"tree\",\"address\",\"redeemscript\",\"m\",\"n\",\"pubkeys\",\"amount\",\"confirmations\"]}}}},{\"name\":\"listoutpointlocks\",\"description\":\"Returns the locked outpoints of every namespace, sorted by namespace and then by expiry.\",\"params\":[{\"name\":\"namespace\",\"description\":\"Only include outpoints locked in this namespace (the default namespace of lockunspent is the empty string)\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"expires\":{\"type\":\"integer\",\"description\":\"The Unix time the lock is released, omitted for locks which do not expire\"},\"expiryheight\":{\"type\":\"integer\",\"description\":\"The main chain height the lock is released at, omitted for locks which are not released at a height\"},\"namespace\":{\"type\":\"string\",\"description\":\"The namespace holding the lock\"},\"persistent\":{\"type\":\"boolean\",\"description\":\"Whether the lock is saved across wallet restarts\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree of the transaction of the locked output\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the locked output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the locked output\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"namespace\",\"persistent\"]}}}},{\"name\":\"listpendingrevocations\",\"description\":\"Returns the missed tickets whose automatic revocations are delayed by the revocationdelay option, ordered by the time they will be revoked.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"reported\":{\"type\":\"integer\",\"description\":\"Unix time the ticket was reported missed\"},\"scheduled\":{\"type\":\"integer\",\"description\":\"Unix time the revocation will be created and published\"},\"tickethash\":{\"type\":\"string\",\"description\":\"Hash of the missed ticket\"}},\"required\":[\"tickethash\",\"reported\",\"scheduled\"]}}}},{\"name\":\"listpendingsends\",\"description\":\"Returns the sends queued by the wallet for approval, oldest first.\",\"params\":[{\"name\":\"account\",\"description\":\"Only include sends from this account\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account the send is from\"},\"amounts\":{\"type\":\"object\",\"description\":\"Pairs of payment addresses and the output amount to pay each\",\"additionalProperties\":{\"type\":\"number\"}},\"id\":{\"type\":\"string\",\"description\":\"The ID of the pending send\"},\"minconf\":{\"type\":\"integer\",\"description\":\"Minimum number of block confirmations required for the spent outputs\"},\"requester\":{\"type\":\"string\",\"description\":\"The client which requested the send, identified by its TLS client certificate or username\"},\"time\":{\"type\":\"integer\",\"description\":\"Unix time the send was queued\"},\"total\":{\"type\":\"number\",\"description\":\"Total amount of all outputs\"}},\"required\":[\"id\",\"account\",\"amounts\",\"total\",\"minconf\",\"time\",\"requester\"]}}}},{\"name\":\"listreceivedbyaccount\",\"description\":\"DEPRECATED -- Returns a JSON array of objects listing all accounts and the total amount received by each account.\",\"params\":[{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction is considered\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"includeempty\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}},{\"name\":\"includewatchonly\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The name of the account\"},\"amount\":{\"type\":\"number\",\"description\":\"Total amount received by payment addresses of the account valued in valhallacoin\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"Number of block confirmations of the most recent transaction relevant to the account\"}},\"required\":[\"account\",\"amount\",\"confirmations\"]}}}},{\"name\":\"listreceivedbyaddress\",\"description\":\"Returns a JSON array of objects listing wallet payment addresses and their total received amounts.\",\"params\":[{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction is considered\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"includeempty\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}},{\"name\":\"includewatchonly\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"DEPRECATED -- Unset\"},\"address\":{\"type\":\"string\",\"description\":\"The payment address\"},\"amount\":{\"type\":\"number\",\"description\":\"Total amount received by the payment address valued in valhallacoin\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"Number of block confirmations of the most recent transaction relevant to the address\"},\"involvesWatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"txids\":{\"type\":\"array\",\"description\":\"Transaction hashes of all transactions involving this address\",\"items\":{\"type\":\"string\"}}},\"required\":[\"account\",\"address\",\"amount\",\"confirmations\"]}}}},{\"name\":\"listrevocabletickets\",\"description\":\"Returns the unrevoked missed and expired tickets with voting authority held by the wallet, ordered by the height they were mined at, for review before calling revoketickets.\\nExpired tickets are determined from the main chain tip and missed tickets from the missed votes detected by the wallet (see listmissedvotes).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the block the ticket was mined in\"},\"fee\":{\"type\":\"number\",\"description\":\"Estimated fee of the revocation at the relay fee\"},\"status\":{\"type\":\"string\",\"description\":\"Status of the ticket (\\\"missed\\\" or \\\"expired\\\")\"},\"tickethash\":{\"type\":\"string\",\"description\":\"Hash of the ticket\"}},\"required\":[\"tickethash\",\"status\",\"blockheight\",\"fee\"]}}}},{\"name\":\"listscripts\",\"description\":\"List all scripts that have been added to wallet\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"scripts\":{\"type\":\"array\",\"description\":\"A list of the imported scripts\",\"items\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The script address\"},\"hash160\":{\"type\":\"string\",\"description\":\"The script hash\"},\"importtime\":{\"type\":\"integer\",\"description\":\"The Unix time the script was imported, omitted when the import was not recorded\"},\"origin\":{\"type\":\"string\",\"description\":\"The request which imported the script, such as the RPC method, omitted when the import was not recorded\"},\"redeemscript\":{\"type\":\"string\",\"description\":\"The redeem script\"},\"rescanfrom\":{\"type\":\"integer\",\"description\":\"The height the import rescanned the chain from, omitted when no rescan was performed\"},\"unspent\":{\"type\":\"boolean\",\"description\":\"Whether any unspent outputs of the wallet pay to the script\"},\"user\":{\"type\":\"string\",\"description\":\"The RPC user which imported the script, omitted when unknown\"}},\"required\":[\"hash160\",\"address\",\"redeemscript\",\"unspent\"]}}},\"required\":[\"scripts\"]}}},{\"name\":\"listsinceblock\",\"description\":\"Returns a JSON array of objects listing details of all wallet transactions after some block.\",\"params\":[{\"name\":\"blockhash\",\"description\":\"Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\",\"schema\":{\"type\":\"string\"}},{\"name\":\"targetconfirmations\",\"description\":\"Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"includewatchonly\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"description\":\"Lists all transactions, including unmined transactions, since the specified block\",\"schema\":{\"type\":\"object\",\"properties\":{\"lastblock\":{\"type\":\"string\",\"description\":\"Hash of the latest-synced block to be used in later calls to listsinceblock\"},\"transactions\":{\"type\":\"array\",\"description\":\"JSON array of objects containing verbose details of the each transaction\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"DEPRECATED -- Unset\"},\"address\":{\"type\":\"string\",\"description\":\"Payment address for a transaction output\"},\"amount\":{\"type\":\"number\",\"description\":\"The value of the transaction output valued in valhallacoin\"},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the block this transaction is mined in, or the empty string if unmined\"},\"blockindex\":{\"type\":\"integer\",\"description\":\"Unset\"},\"blocktime\":{\"type\":\"integer\",\"description\":\"The Unix time of the block header this transaction is mined in, or 0 if unmined\"},\"category\":{\"type\":\"string\",\"description\":\"The kind of transaction: \\\"send\\\" for sent transactions, \\\"immature\\\" for immature coinbase outputs, \\\"generate\\\" for mature coinbase outputs, \\\"transfer\\\" for both sides of transfers between accounts of the wallet, or \\\"recv\\\" for all other received outputs.  Note: A single output may be included multiple times under different categories\"},\"comment\":{\"type\":\"string\",\"description\":\"Unset\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"fee\":{\"type\":\"number\",\"description\":\"The total input value minus the total output value for sent transactions\"},\"generated\":{\"type\":\"boolean\",\"description\":\"Whether the transaction output is a coinbase output\"},\"involveswatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"otheraccount\":{\"type\":\"string\",\"description\":\"Unset\"},\"time\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"timereceived\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction\"},\"txtype\":{\"type\":\"string\",\"description\":\"The type of tx (regular tx, stake tx)\"},\"vout\":{\"type\":\"integer\",\"description\":\"The transaction output index\"},\"walletconflicts\":{\"type\":\"array\",\"description\":\"Unset\",\"items\":{\"type\":\"string\"}}},\"required\":[\"account\",\"amount\",\"category\",\"confirmations\",\"time\",\"timereceived\",\"txid\",\"vout\",\"walletconflicts\"]}}},\"required\":[\"transactions\",\"lastblock\"]}}},{\"name\":\"listsplittickets\",\"description\":\"Lists the split tickets co-funded by the wallet with the wallet's contribution and reward.\\nVotes and revocations are only known to wallets recording them, such as the wallet owning the voting address.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account which contributed to the ticket\"},\"contribution\":{\"type\":\"number\",\"description\":\"The amount (in VHC) committed to the ticket by the wallet, including its share of the fee\"},\"returned\":{\"type\":\"number\",\"description\":\"The amount (in VHC) paid to the wallet by the vote or revocation\"},\"reward\":{\"type\":\"number\",\"description\":\"The returned amount less the wallet's contribution\"},\"share\":{\"type\":\"number\",\"description\":\"The fraction of the ticket commitments owned by the wallet\"},\"status\":{\"type\":\"string\",\"description\":\"The status of the ticket (unpublished, unmined, live, voted, or revoked)\"},\"tickethash\":{\"type\":\"string\",\"description\":\"The hash of the ticket\"},\"ticketprice\":{\"type\":\"number\",\"description\":\"The price (in VHC) of the ticket\"}},\"required\":[\"tickethash\",\"account\",\"status\",\"ticketprice\",\"contribution\",\"share\"]}}}},{\"name\":\"liststakepoolusertickets\",\"description\":\"Lists a page of the tickets of a stake pool user ordered by ticket hash, optionally filtered by status.\\nPages are read from indexes of the user's tickets and do not require loading every ticket of the user.\",\"params\":[{\"name\":\"user\",\"description\":\"The P2SH or P2PKH voting address of the user\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"status\",\"description\":\"Only list tickets with this status (live, voted, missed, or expired)\",\"schema\":{\"type\":\"string\"}},{\"name\":\"after\",\"description\":\"Only list tickets with hashes greater than this ticket hash, usually the next value of the previous page\",\"schema\":{\"type\":\"string\"}},{\"name\":\"limit\",\"description\":\"Maximum number of tickets to return\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"next\":{\"type\":\"string\",\"description\":\"The hash of the last ticket of a full page, to be passed as the after parameter to request the next page\"},\"tickets\":{\"type\":\"array\",\"description\":\"The tickets of the page\",\"items\":{\"type\":\"object\",\"properties\":{\"spentby\":{\"type\":\"string\",\"description\":\"The vote in which the ticket was spent\"},\"spentbyheight\":{\"type\":\"integer\",\"description\":\"The height in which the ticket was spent\"},\"status\":{\"type\":\"string\",\"description\":\"The current status of the added ticket\"},\"ticket\":{\"type\":\"string\",\"description\":\"The hash of the added ticket\"},\"ticketheight\":{\"type\":\"integer\",\"description\":\"The height in which the ticket was added\"}},\"required\":[\"status\",\"ticket\",\"ticketheight\",\"spentby\",\"spentbyheight\"]}}},\"required\":[\"tickets\"]}}},{\"name\":\"listticketstatuses\",\"description\":\"Lists the status and purchase height of the tickets of the wallet, newest first, optionally filtered by status.\\nThe states of tickets are determined from the wallet's view of the main chain: unrevoked tickets are reported missed when the wallet detected their missed votes, and live otherwise until they expire.\",\"params\":[{\"name\":\"status\",\"description\":\"Only list tickets with this status (unmined, immature, live, voted, missed, expired, or revoked), or unspent for mined tickets which are neither voted nor revoked\",\"schema\":{\"type\":\"string\"}},{\"name\":\"offset\",\"description\":\"Number of matching tickets to skip\",\"schema\":{\"type\":\"integer\",\"default\":0}},{\"name\":\"limit\",\"description\":\"Maximum number of tickets to return\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"tickets\":{\"type\":\"array\",\"description\":\"The matching tickets after applying the offset and limit\",\"items\":{\"type\":\"object\",\"properties\":{\"hash\":{\"type\":\"string\",\"description\":\"The hash of the ticket\"},\"height\":{\"type\":\"integer\",\"description\":\"The height of the block the ticket was purchased in, or -1 for unmined tickets\"},\"spender\":{\"type\":\"string\",\"description\":\"The hash of the vote or revocation spending the ticket, if any\"},\"status\":{\"type\":\"string\",\"description\":\"The status of the ticket\"}},\"required\":[\"hash\",\"status\",\"height\"]}},\"total\":{\"type\":\"integer\",\"description\":\"Number of tickets matching the status filter\"}},\"required\":[\"total\",\"tickets\"]}}},{\"name\":\"listtransactions\",\"description\":\"Returns a JSON array of objects containing verbose details for wallet transactions.\",\"params\":[{\"name\":\"account\",\"description\":\"DEPRECATED -- Unused (must be unset or \\\"*\\\")\",\"schema\":{\"type\":\"string\"}},{\"name\":\"count\",\"description\":\"Maximum number of transactions to create results from\",\"schema\":{\"type\":\"integer\",\"default\":10}},{\"name\":\"from\",\"description\":\"Number of transactions to skip before results are created\",\"schema\":{\"type\":\"integer\",\"default\":0}},{\"name\":\"includewatchonly\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"DEPRECATED -- Unset\"},\"address\":{\"type\":\"string\",\"description\":\"Payment address for a transaction output\"},\"amount\":{\"type\":\"number\",\"description\":\"The value of the transaction output valued in valhallacoin\"},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the block this transaction is mined in, or the empty string if unmined\"},\"blockindex\":{\"type\":\"integer\",\"description\":\"Unset\"},\"blocktime\":{\"type\":\"integer\",\"description\":\"The Unix time of the block header this transaction is mined in, or 0 if unmined\"},\"category\":{\"type\":\"string\",\"description\":\"The kind of transaction: \\\"send\\\" for sent transactions, \\\"immature\\\" for immature coinbase outputs, \\\"generate\\\" for mature coinbase outputs, \\\"transfer\\\" for both sides of transfers between accounts of the wallet, or \\\"recv\\\" for all other received outputs.  Note: A single output may be included multiple times under different categories\"},\"comment\":{\"type\":\"string\",\"description\":\"Unset\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"fee\":{\"type\":\"number\",\"description\":\"The total input value minus the total output value for sent transactions\"},\"generated\":{\"type\":\"boolean\",\"description\":\"Whether the transaction output is a coinbase output\"},\"involveswatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"otheraccount\":{\"type\":\"string\",\"description\":\"Unset\"},\"time\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"timereceived\":{\"type\":\"integer\",\"description\":\"The earliest Unix time this transaction was known to exist\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction\"},\"txtype\":{\"type\":\"string\",\"description\":\"The type of tx (regular tx, stake tx)\"},\"vout\":{\"type\":\"integer\",\"description\":\"The transaction output index\"},\"walletconflicts\":{\"type\":\"array\",\"description\":\"Unset\",\"items\":{\"type\":\"string\"}}},\"required\":[\"account\",\"amount\",\"category\",\"confirmations\",\"time\",\"timereceived\",\"txid\",\"vout\",\"walletconflicts\"]}}}},{\"name\":\"listunspent\",\"description\":\"Returns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\",\"params\":[{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction output is considered\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"maxconf\",\"description\":\"Maximum number of block confirmations required before a transaction output is excluded\",\"schema\":{\"type\":\"integer\",\"default\":9999999}},{\"name\":\"addresses\",\"description\":\"If set, limits the returned details to unspent outputs received by any of these payment addresses\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account associated with the receiving payment address\"},\"address\":{\"type\":\"string\",\"description\":\"The payment address that received the output\"},\"amount\":{\"type\":\"number\",\"description\":\"The amount of the output valued in valhallacoin\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"redeemScript\":{\"type\":\"string\",\"description\":\"Unset\"},\"scriptPubKey\":{\"type\":\"string\",\"description\":\"The output script encoded as a hexadecimal string\"},\"spendable\":{\"type\":\"boolean\",\"description\":\"Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\"},\"stakelocked\":{\"type\":\"boolean\",\"description\":\"Whether the output is locked by an unspent ticket and may only be spent by a vote or revocation of the ticket\"},\"stakeoutput\":{\"type\":\"string\",\"description\":\"The kind of stake transaction output (\\\"ticket\\\", \\\"ticketchange\\\", \\\"vote\\\", or \\\"revocation\\\"), or unset for outputs of regular transactions\"},\"ticketstatus\":{\"type\":\"string\",\"description\":\"The status of the ticket of ticket outputs (\\\"live\\\", \\\"missed\\\", or \\\"expired\\\")\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree the transaction comes from\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced output\"},\"txtype\":{\"type\":\"integer\",\"description\":\"The type of the transaction\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced output\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"txtype\",\"address\",\"account\",\"scriptPubKey\",\"amount\",\"confirmations\",\"spendable\",\"stakelocked\"]}}}},{\"name\":\"listunspentpage\",\"description\":\"Returns a page of the unlocked unspent outputs controlled by wallet keys, optionally filtered by account.\\nOutputs are ordered by transaction hash and output index, allowing wallets with many outputs to be listed over several requests.\\nThe next page is requested by passing the \\\"txid:vout\\\" of the last output of a page as the after parameter.\",\"params\":[{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction output is considered\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"maxconf\",\"description\":\"Maximum number of block confirmations required before a transaction output is excluded\",\"schema\":{\"type\":\"integer\",\"default\":9999999}},{\"name\":\"addresses\",\"description\":\"If set, limits the returned details to unspent outputs received by any of these payment addresses\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"account\",\"description\":\"Only list outputs controlled by this account, or \\\"*\\\" for all accounts\",\"schema\":{\"type\":\"string\",\"default\":\"*\"}},{\"name\":\"after\",\"description\":\"Only list outputs after this \\\"txid:vout\\\" outpoint, or the empty string to begin with the first output\",\"schema\":{\"type\":\"string\",\"default\":\"\"}},{\"name\":\"limit\",\"description\":\"Maximum number of outputs to return\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account associated with the receiving payment address\"},\"address\":{\"type\":\"string\",\"description\":\"The payment address that received the output\"},\"amount\":{\"type\":\"number\",\"description\":\"The amount of the output valued in valhallacoin\"},\"confirmations\":{\"type\":\"integer\",\"description\":\"The number of block confirmations of the transaction\"},\"redeemScript\":{\"type\":\"string\",\"description\":\"Unset\"},\"scriptPubKey\":{\"type\":\"string\",\"description\":\"The output script encoded as a hexadecimal string\"},\"spendable\":{\"type\":\"boolean\",\"description\":\"Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\"},\"stakelocked\":{\"type\":\"boolean\",\"description\":\"Whether the output is locked by an unspent ticket and may only be spent by a vote or revocation of the ticket\"},\"stakeoutput\":{\"type\":\"string\",\"description\":\"The kind of stake transaction output (\\\"ticket\\\", \\\"ticketchange\\\", \\\"vote\\\", or \\\"revocation\\\"), or unset for outputs of regular transactions\"},\"ticketstatus\":{\"type\":\"string\",\"description\":\"The status of the ticket of ticket outputs (\\\"live\\\", \\\"missed\\\", or \\\"expired\\\")\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree the transaction comes from\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced output\"},\"txtype\":{\"type\":\"integer\",\"description\":\"The type of the transaction\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced output\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"txtype\",\"address\",\"account\",\"scriptPubKey\",\"amount\",\"confirmations\",\"spendable\",\"stakelocked\"]}}}},{\"name\":\"listwallets\",\"description\":\"Returns the default wallet and every named wallet which exists or is loaded, sorted by name.\\nRequests are dispatched to a named wallet by the /wallet/\\u003cname\\u003e HTTP POST endpoint and the /wallet/\\u003cname\\u003e/ws websocket endpoint, and to the default wallet by all other endpoints.\\nNamed wallets are created and opened with createwallet and openwallet requests to their endpoints.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"loaded\":{\"type\":\"boolean\",\"description\":\"Whether the wallet is loaded\"},\"name\":{\"type\":\"string\",\"description\":\"The name of the wallet, or the empty string for the default wallet\"}},\"required\":[\"name\",\"loaded\"]}}}},{\"name\":\"lockunspent\",\"description\":\"Locks or unlocks an unspent output.\\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\\nLocked outputs are volatile and are not saved across wallet restarts (use lockunspentnamespace to lock outputs persistently or with an expiry).\\nOutputs are locked in the default namespace, and outputs locked in other namespaces (with lockunspentnamespace) are not unlocked.\\nIf unlock is true and no transaction outputs are specified, all outputs locked in the default namespace are marked unlocked.\",\"params\":[{\"name\":\"unlock\",\"description\":\"True to unlock outputs, false to lock\",\"required\":true,\"schema\":{\"type\":\"boolean\"}},{\"name\":\"transactions\",\"description\":\"Transaction outputs to lock or unlock\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"amount\":{\"type\":\"number\",\"description\":\"The the previous output amount\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree to generate transaction for\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced output\"}},\"required\":[\"txid\",\"vout\",\"tree\"]}}}],\"result\":{\"name\":\"result\",\"description\":\"The boolean 'true'\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"lockunspentnamespace\",\"description\":\"Locks or unlocks unspent outputs in a namespace.\\nNamespaces allow independent clients to lock outputs without unlocking each other's locks.\\nAn output may only be locked by one namespace at a time, and locking an output held by another namespace is an error.\\nLocked outputs are not chosen for transaction inputs of authored transactions.\\nLocks are only saved across wallet restarts when persistent is true, and relocking a persistently locked output without it makes the lock volatile.\\nLocks may expire after a number of seconds, a number of blocks, or both, in which case they are released by whichever expires first.\\nIf unlock is true and no transaction outputs are specified, all outputs locked in the namespace are marked unlocked.\",\"params\":[{\"name\":\"namespace\",\"description\":\"The namespace of the locks\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"unlock\",\"description\":\"True to unlock outputs, false to lock\",\"required\":true,\"schema\":{\"type\":\"boolean\"}},{\"name\":\"transactions\",\"description\":\"Transaction outputs to lock or unlock\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"amount\":{\"type\":\"number\",\"description\":\"The the previous output amount\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree to generate transaction for\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced output\"}},\"required\":[\"txid\",\"vout\",\"tree\"]}}},{\"name\":\"ttl\",\"description\":\"Seconds after which locks are released automatically, or 0 to hold locks until unlocked (relocking an output replaces its ttl)\",\"schema\":{\"type\":\"integer\",\"default\":0}},{\"name\":\"persistent\",\"description\":\"Save the locks in the wallet database so they are held after the wallet is restarted\",\"schema\":{\"type\":\"boolean\",\"default\":false}},{\"name\":\"blocks\",\"description\":\"Number of blocks attached to the main chain after which locks are released automatically, or 0 to not release locks at any height (relocking an output replaces its expiry)\",\"schema\":{\"type\":\"integer\",\"default\":0}}],\"result\":{\"name\":\"result\",\"description\":\"The boolean 'true'\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"mergecosignsessions\",\"description\":\"Combines the signatures of copies of a cosigning session signed by different cosigners, returning the merged session.\",\"params\":[{\"name\":\"sessions\",\"description\":\"The JSON-encoded copies of the cosigning session\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"added\":{\"type\":\"integer\",\"description\":\"The number of signatures added by the wallet, omitted when none were added\"},\"complete\":{\"type\":\"boolean\",\"description\":\"Whether every input has the required signatures\"},\"required\":{\"type\":\"array\",\"description\":\"The number of signatures required by each input\",\"items\":{\"type\":\"integer\"}},\"session\":{\"type\":\"string\",\"description\":\"The JSON-encoded session passed between the cosigners\"},\"signatures\":{\"type\":\"array\",\"description\":\"The number of signatures collected for each input\",\"items\":{\"type\":\"integer\"}}},\"required\":[\"session\",\"signatures\",\"required\",\"complete\"]}}},{\"name\":\"movefunds\",\"description\":\"Authors, signs, and sends a transaction transferring an amount between two accounts of the wallet.\\nThe amount is paid to a new internal address of the destination account and the transaction is listed under the transfer category.\",\"params\":[{\"name\":\"fromaccount\",\"description\":\"Account to pick unspent outputs from\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"toaccount\",\"description\":\"Account to transfer the amount to\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amount\",\"description\":\"Amount to transfer valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction output is eligible to be spent\",\"schema\":{\"type\":\"integer\",\"default\":1}}],\"result\":{\"name\":\"result\",\"description\":\"The transaction hash of the transfer\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"notifyblocks\",\"description\":\"Requests blockconnected and blockdisconnected notifications as blocks are processed by the wallet (websocket clients only).\\nThe subscribed transactions of each blockconnected notification are the wallet's transactions mined in the block.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifydepositaddresses\",\"description\":\"Requests a depositaddress notification for each address reserved by filldepositpool (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifymissedvotes\",\"description\":\"Requests a missedvote notification for each ticket with voting authority held by the wallet that was selected to vote on a block but whose vote was not included in the next block (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifynewtransactions\",\"description\":\"Requests a newtx notification for each listtransactions result of transactions added to the wallet (websocket clients only).\",\"params\":[{\"name\":\"verbose\",\"description\":\"Unused\",\"schema\":{\"type\":\"boolean\",\"default\":false}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifypendingrevocations\",\"description\":\"Requests a pendingrevocation notification for each missed ticket whose automatic revocation is delayed by the revocationdelay option (websocket clients only).\\nNotifications are dropped for clients which fall behind; use listpendingrevocations for the complete list.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifyrescanprogress\",\"description\":\"Requests a rescanprogress notification with the current height, percent complete, and addresses discovered each time a range of blocks is scanned by a rescan, and when each rescan ends (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifytickets\",\"description\":\"Requests a ticketstatus notification each time a ticket of the wallet becomes live, votes, is missed, expires, or is revoked in a block attached to the main chain (websocket clients only).\\nTickets are only reported missed when the wallet detects the missed vote of a ticket it was selected to vote with.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifyvoteversion\",\"description\":\"Requests a voteversion notification each time the votes cast by the wallet become outdated, or compatible again, with the stake version of recent blocks (websocket clients only).\\nVotes are outdated after a network upgrade to a stake version newer than the wallet's vote version, and do not vote on the agendas of the newer version.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"notifywinningtickets\",\"description\":\"Requests winningtickets notifications when tickets owned by the wallet are selected to vote on a block (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"openwallet\",\"description\":\"Opens the existing wallet of the wallet data directory when no wallet is loaded.\\nThis is intended for servers started with the noinitialload option.\\nThe opened wallet is synchronized with the network until it is closed.\",\"params\":[{\"name\":\"publicpassphrase\",\"description\":\"The public passphrase of the wallet, or the insecure default public passphrase if unset or empty\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"watchingonly\":{\"type\":\"boolean\",\"description\":\"Whether the opened wallet is watching-only\"}},\"required\":[\"watchingonly\"]}}},{\"name\":\"overridespendingpolicy\",\"description\":\"Allows sends from an account to exceed the account's spending limits for a limited time.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"passphrase\",\"description\":\"The override passphrase of the account's spending policy\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"timeout\",\"description\":\"Number of seconds the override remains active\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"purchaseticket\",\"description\":\"Purchase ticket using available funds.  Tickets are registered with the selected VSP when no ticket or pool address is given.\",\"params\":[{\"name\":\"fromaccount\",\"description\":\"The account to use for purchase (default=\\\"default\\\")\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"spendlimit\",\"description\":\"Limit on the amount to spend on ticket\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"ticketaddress\",\"description\":\"Override the ticket address to which voting rights are given (defaults to the configured voting address, then an address derived from the --ticketbuyer.votingxpub extended public key, then a wallet address)\",\"schema\":{\"type\":\"string\"}},{\"name\":\"numtickets\",\"description\":\"The number of tickets to purchase\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"pooladdress\",\"description\":\"The address to pay stake pool fees to\",\"schema\":{\"type\":\"string\"}},{\"name\":\"poolfees\",\"description\":\"The amount of fees to pay to the stake pool\",\"schema\":{\"type\":\"number\"}},{\"name\":\"expiry\",\"description\":\"Height at which the purchase tickets expire\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"comment\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}},{\"name\":\"ticketfee\",\"description\":\"The transaction fee rate (VHC/kB) to use (overrides fees set by the wallet config or settxfee RPC)\",\"schema\":{\"type\":\"number\"}}],\"result\":{\"name\":\"result\",\"description\":\"Hash of the resulting ticket\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"publishsplitticketsession\",\"description\":\"Publishes the ticket of a split ticket session signed by every participant, returning the ticket hash.\",\"params\":[{\"name\":\"session\",\"description\":\"The JSON-encoded split ticket session\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The hash of the published ticket\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"rejectsend\",\"description\":\"Removes a send queued by the wallet for approval without creating the transaction.\",\"params\":[{\"name\":\"id\",\"description\":\"The ID of the pending send\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"redeemmultisigout\",\"description\":\"Takes the input and constructs a P2PKH paying to the specified address.\",\"params\":[{\"name\":\"hash\",\"description\":\"Hash of the input transaction\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"index\",\"description\":\"Idx of the input transaction\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"tree\",\"description\":\"Tree the transaction is on.\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"address\",\"description\":\"Address to pay to.\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"complete\":{\"type\":\"boolean\",\"description\":\"Shows if opperation was completed.\"},\"errors\":{\"type\":\"array\",\"description\":\"Any errors generated.\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"Verification or signing error related to the input\"},\"scriptSig\":{\"type\":\"string\",\"description\":\"The hex-encoded signature script\"},\"sequence\":{\"type\":\"integer\",\"description\":\"Script sequence number\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced previous output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced previous output\"}},\"required\":[\"txid\",\"vout\",\"scriptSig\",\"sequence\",\"error\"]}},\"hex\":{\"type\":\"string\",\"description\":\"Resulting hash.\"}},\"required\":[\"hex\",\"complete\"]}}},{\"name\":\"redeemmultisigouts\",\"description\":\"Takes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\",\"params\":[{\"name\":\"fromscraddress\",\"description\":\"Input script hash address.\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"toaddress\",\"description\":\"Address to look for (if not internal addresses).\",\"schema\":{\"type\":\"string\"}},{\"name\":\"number\",\"description\":\"Number of outpoints found.\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"complete\":{\"type\":\"boolean\",\"description\":\"Shows if opperation was completed.\"},\"errors\":{\"type\":\"array\",\"description\":\"Any errors generated.\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"Verification or signing error related to the input\"},\"scriptSig\":{\"type\":\"string\",\"description\":\"The hex-encoded signature script\"},\"sequence\":{\"type\":\"integer\",\"description\":\"Script sequence number\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced previous output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced previous output\"}},\"required\":[\"txid\",\"vout\",\"scriptSig\",\"sequence\",\"error\"]}},\"hex\":{\"type\":\"string\",\"description\":\"Resulting hash.\"}},\"required\":[\"hex\",\"complete\"]}}},{\"name\":\"redeemmultisigaccount\",\"description\":\"Redeems the unspent outputs paying addresses of a multisig account in transactions spending up to batch outputs each, signed by the wallet.\\nTransactions which are not complete must be signed by other cosigners, for example with a cosigning session.\",\"params\":[{\"name\":\"name\",\"description\":\"The name of the multisig account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"toaddress\",\"description\":\"The address paid by each transaction; a new internal address of the default account is used for each transaction when omitted\",\"schema\":{\"type\":\"string\"}},{\"name\":\"feerate\",\"description\":\"The fee rate (in VHC/kB) paid for the size of the fully signed transactions; the wallet's relay fee is used when omitted\",\"schema\":{\"type\":\"number\"}},{\"name\":\"batch\",\"description\":\"The maximum number of outputs spent by each transaction\",\"schema\":{\"type\":\"integer\",\"default\":20}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"results\":{\"type\":\"array\",\"description\":\"The redemption transaction spending each batch of outputs\",\"items\":{\"type\":\"object\",\"properties\":{\"complete\":{\"type\":\"boolean\",\"description\":\"Shows if opperation was completed.\"},\"errors\":{\"type\":\"array\",\"description\":\"Any errors generated.\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"Verification or signing error related to the input\"},\"scriptSig\":{\"type\":\"string\",\"description\":\"The hex-encoded signature script\"},\"sequence\":{\"type\":\"integer\",\"description\":\"Script sequence number\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced previous output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced previous output\"}},\"required\":[\"txid\",\"vout\",\"scriptSig\",\"sequence\",\"error\"]}},\"hex\":{\"type\":\"string\",\"description\":\"Resulting hash.\"}},\"required\":[\"hex\",\"complete\"]}}},\"required\":[\"results\"]}}},{\"name\":\"redeemmultisigoutsbatch\",\"description\":\"Redeems the unspent outputs of a P2SH multisig address in transactions spending up to batch outputs each, signed by the wallet.\\nTransactions which are not complete must be signed by other cosigners, for example with signrawtransaction.\",\"params\":[{\"name\":\"fromscraddress\",\"description\":\"The P2SH multisig address whose outputs are redeemed\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"toaddress\",\"description\":\"The address paid by each transaction; a new internal address of the default account is used for each transaction when omitted\",\"schema\":{\"type\":\"string\"}},{\"name\":\"number\",\"description\":\"The maximum number of outputs to redeem; all outputs are redeemed when omitted\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"feerate\",\"description\":\"The fee rate (in VHC/kB) paid for the size of the fully signed transactions; the wallet's relay fee is used when omitted\",\"schema\":{\"type\":\"number\"}},{\"name\":\"batch\",\"description\":\"The maximum number of outputs spent by each transaction\",\"schema\":{\"type\":\"integer\",\"default\":20}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"results\":{\"type\":\"array\",\"description\":\"The redemption transaction spending each batch of outputs\",\"items\":{\"type\":\"object\",\"properties\":{\"complete\":{\"type\":\"boolean\",\"description\":\"Shows if opperation was completed.\"},\"errors\":{\"type\":\"array\",\"description\":\"Any errors generated.\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"Verification or signing error related to the input\"},\"scriptSig\":{\"type\":\"string\",\"description\":\"The hex-encoded signature script\"},\"sequence\":{\"type\":\"integer\",\"description\":\"Script sequence number\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced previous output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced previous output\"}},\"required\":[\"txid\",\"vout\",\"scriptSig\",\"sequence\",\"error\"]}},\"hex\":{\"type\":\"string\",\"description\":\"Resulting hash.\"}},\"required\":[\"hex\",\"complete\"]}}},\"required\":[\"results\"]}}},{\"name\":\"releaseoutputs\",\"description\":\"Releases the outputs of a reservation created by reserveoutputs before the reservation expires.\",\"params\":[{\"name\":\"id\",\"description\":\"The ID of the reservation\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"renameaccount\",\"description\":\"Renames an account.\",\"params\":[{\"name\":\"oldaccount\",\"description\":\"The old account name to rename\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"newaccount\",\"description\":\"The new name for the account\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"rescanwallet\",\"description\":\"Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error.\\nThe progress of the rescan is reported by getrescanstatus and rescanprogress notifications.\",\"params\":[{\"name\":\"beginheight\",\"description\":\"The height of the first block to begin the rescan from\",\"schema\":{\"type\":\"integer\",\"default\":0}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"reserveoutputs\",\"description\":\"Selects and locks unspent outputs of an account for a transaction which is signed outside of the wallet, such as by a hardware wallet or multisig cosigners.\\nOutputs are selected largest first until their total reaches the amount, and either every selected output is reserved or none are.\\nReserved outputs are not chosen for transaction inputs of authored transactions or other reservations, and are released automatically when the reservation expires.\\nReservations are volatile and are not saved across wallet restarts.\",\"params\":[{\"name\":\"account\",\"description\":\"Account to reserve unspent outputs from\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amount\",\"description\":\"Minimum total amount of the reserved outputs, valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required for reserved outputs\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"ttl\",\"description\":\"Number of seconds after which the outputs are released automatically\",\"schema\":{\"type\":\"integer\",\"default\":300}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"expires\":{\"type\":\"integer\",\"description\":\"The Unix time the reservation expires\"},\"id\":{\"type\":\"string\",\"description\":\"The ID of the reservation, used to release the outputs with releaseoutputs\"},\"outputs\":{\"type\":\"array\",\"description\":\"The reserved outputs\",\"items\":{\"type\":\"object\",\"properties\":{\"amount\":{\"type\":\"number\",\"description\":\"The amount of the output valued in valhallacoin\"},\"scriptPubKey\":{\"type\":\"string\",\"description\":\"The output script encoded as a hexadecimal string\"},\"tree\":{\"type\":\"integer\",\"description\":\"The tree of the transaction of the reserved output\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the reserved output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the reserved output\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"amount\",\"scriptPubKey\"]}},\"total\":{\"type\":\"number\",\"description\":\"The total amount of the reserved outputs valued in valhallacoin\"}},\"required\":[\"id\",\"outputs\",\"total\",\"expires\"]}}},{\"name\":\"revoketickets\",\"description\":\"Requests the wallet create revocations for any previously missed or expired tickets.  Without a consensus RPC server, missed tickets are those detected by the wallet from the votes included in each block.  Wallet must be unlocked.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"rotateaccount\",\"description\":\"Rotates an account whose extended public key has leaked and migrates its funds to a successor account.\\nThe first request for an account creates the successor account and marks the rotated account receive-only: its previously derived addresses remain watched but no new addresses are derived.\\nEach request sweeps up to maxinputs spendable outputs of the rotated account to a new internal address of the successor, so funds may be migrated over several transactions by repeating the request.\",\"params\":[{\"name\":\"account\",\"description\":\"The account to rotate\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"newaccount\",\"description\":\"The name of the successor account, required unless the account was already rotated\",\"schema\":{\"type\":\"string\"}},{\"name\":\"maxinputs\",\"description\":\"Maximum number of outputs to sweep to the successor account, or 0 to not sweep\",\"schema\":{\"type\":\"integer\",\"default\":20}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The rotated account\"},\"remainingbalance\":{\"type\":\"number\",\"description\":\"The total balance remaining in the rotated account valued in valhallacoin\"},\"rotatedtime\":{\"type\":\"integer\",\"description\":\"The Unix time the account was rotated\"},\"successor\":{\"type\":\"string\",\"description\":\"The successor account receiving the swept funds\"},\"sweeptxhash\":{\"type\":\"string\",\"description\":\"The hash of the sweep transaction, if any outputs were swept\"}},\"required\":[\"account\",\"successor\",\"rotatedtime\",\"remainingbalance\"]}}},{\"name\":\"searchwallet\",\"description\":\"Searches the wallet for transactions, addresses, accounts, and deposit address references matching part of a transaction hash, an address, an account name, or a reference.\\nAddresses are matched case-sensitively and other records regardless of case.\\nTransactions are returned newest first, followed by addresses, accounts, and deposit references.\",\"params\":[{\"name\":\"query\",\"description\":\"Part of a transaction hash, address, account name, or deposit reference (at least 3 characters)\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"count\",\"description\":\"Maximum number of matches to return, or 0 for every match\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"Account of the matched address, account, or deposit address\"},\"address\":{\"type\":\"string\",\"description\":\"Matched address, or the deposit address assigned to a matched reference\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the block mining a matched transaction, or -1 if unmined\"},\"kind\":{\"type\":\"string\",\"description\":\"Kind of record matched (\\\"transaction\\\", \\\"address\\\", \\\"account\\\", or \\\"depositreference\\\")\"},\"reference\":{\"type\":\"string\",\"description\":\"Matched deposit address reference\"},\"time\":{\"type\":\"integer\",\"description\":\"Unix time of the block mining a matched transaction, or the time it was received if unmined\"},\"txid\":{\"type\":\"string\",\"description\":\"Hash of a matched transaction\"}},\"required\":[\"kind\"]}}}},{\"name\":\"sendfrom\",\"description\":\"DEPRECATED -- Authors, signs, and sends a transaction that outputs some amount to a payment address.\\nA change output is automatically included to send extra output value back to the original account.\",\"params\":[{\"name\":\"fromaccount\",\"description\":\"Account to pick unspent outputs from\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"toaddress\",\"description\":\"Address to pay\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amount\",\"description\":\"Amount to send to the payment address valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction output is eligible to be spent\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"comment\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}},{\"name\":\"commentto\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"sendmany\",\"description\":\"Authors, signs, and sends a transaction that outputs to many payment addresses.\\nA change output is automatically included to send extra output value back to the original account.\",\"params\":[{\"name\":\"fromaccount\",\"description\":\"DEPRECATED -- Account to pick unspent outputs from\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amounts\",\"description\":\"Pairs of payment addresses and the output amount to pay each\",\"required\":true,\"schema\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"number\"}}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required before a transaction output is eligible to be spent\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"comment\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"sendsweepaccount\",\"description\":\"Signs and publishes transactions moving as much value as possible from an account, like sweepaccount does without signing.\\nAccounts with more outputs than fit in a single transaction are swept by several transactions.\\nTicket outputs and immature coinbase and stake outputs are never swept.\\nThe wallet must be unlocked.\",\"params\":[{\"name\":\"sourceaccount\",\"description\":\"The account to be swept\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"destinationaddress\",\"description\":\"The destination address to pay to\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"requiredconfirmations\",\"description\":\"The minimum utxo confirmation requirement\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"feeperkb\",\"description\":\"The fee rate of the transaction, valued in valhallacoin per kilobyte\",\"schema\":{\"type\":\"number\"}},{\"name\":\"includelocked\",\"description\":\"Sweep outputs locked by lockunspent or any lock namespace\",\"schema\":{\"type\":\"boolean\",\"default\":false}},{\"name\":\"dustthreshold\",\"description\":\"Outputs of lower value, in valhallacoin, are not swept\",\"schema\":{\"type\":\"number\",\"default\":0}}],\"result\":{\"name\":\"result\",\"description\":\"The hashes of the sweep transactions\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}},{\"name\":\"sendtoaddress\",\"description\":\"Authors, signs, and sends a transaction that outputs some amount to a payment address.\\nUnlike sendfrom, outputs are always chosen from the default account.\\nA change output is automatically included to send extra output value back to the original account.\",\"params\":[{\"name\":\"address\",\"description\":\"Address to pay\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amount\",\"description\":\"Amount to send to the payment address valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"comment\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}},{\"name\":\"commentto\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"sendtomultisig\",\"description\":\"Authors, signs, and sends a transaction that outputs some amount to a multisig address.\\nUnlike sendfrom, outputs are always chosen from the default account.\\nA change output is automatically included to send extra output value back to the original account.\\nMultisig sends can not be queued for approval and are refused when sends require approval.\",\"params\":[{\"name\":\"fromaccount\",\"description\":\"Unused\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"amount\",\"description\":\"Amount to send to the payment address valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"pubkeys\",\"description\":\"Pubkey to send to.\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"nrequired\",\"description\":\"The number of signatures required to redeem outputs paid to this address\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"minconf\",\"description\":\"Minimum number of block confirmations required\",\"schema\":{\"type\":\"integer\",\"default\":1}},{\"name\":\"comment\",\"description\":\"Unused\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The transaction hash of the sent transaction, or the ID of the pending send when the account or server requires send approval\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"setaccountgappolicy\",\"description\":\"Sets the gap policy used when generating addresses for an account without specifying a policy.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"gappolicy\",\"description\":\"Policy used when the unused address gap limit would be exceeded (\\\"error\\\", \\\"ignore\\\", or \\\"wrap\\\")\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setapiversion\",\"description\":\"Selects the API version used to handle every following request of the connection, in the form major[.minor[.patch]] (websocket clients only).\\nResults of methods which changed shape since the selected major version are returned in the shape of that version.\\nHTTP POST clients instead select the version of a request with the X-Vhcwallet-Api-Version header.\",\"params\":[{\"name\":\"version\",\"description\":\"The requested API version, which may not be newer than the server's version or older than major version 4\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"setautoconsolidation\",\"description\":\"Configures the automatic consolidation of the outputs of an account, replacing any previous configuration of the account.\\nAs each block is attached to the main chain, the outputs of the account are consolidated to a new internal address, paying the relay fee, if the account holds more than threshold spendable outputs and the block used no more than maxblockusage of the maximum block size.\\nConsolidations are only performed while the wallet is unlocked, and the configuration must be set again each time the wallet is loaded.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"threshold\",\"description\":\"The number of spendable outputs which must be exceeded before outputs are consolidated, or 0 to disable automatic consolidation\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"maxinputs\",\"description\":\"The maximum number of outputs consolidated by each transaction, or 0 to only limit inputs by the maximum transaction size\",\"schema\":{\"type\":\"integer\",\"default\":0}},{\"name\":\"maxblockusage\",\"description\":\"The fraction of the maximum block size the latest block may use for outputs to be consolidated\",\"schema\":{\"type\":\"number\",\"default\":0.5}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setsendapproval\",\"description\":\"Requires sends from an account to be queued by the wallet and approved with approvesend using a second passphrase, or removes this requirement. Sends from such accounts made by sendtoaddress, sendfrom, and sendmany return the ID of the pending send, and other methods creating transactions from the account are refused.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"passphrase\",\"description\":\"New approval passphrase, or an empty string to no longer require approval\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"currentpassphrase\",\"description\":\"The current approval passphrase, required if the account already requires approval\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setspendingpolicy\",\"description\":\"Sets the per-transaction and daily (UTC) limits of the total output amount that may be sent from an account.\",\"params\":[{\"name\":\"account\",\"description\":\"Name of the account\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"txlimit\",\"description\":\"Maximum amount which may be sent by a single transaction, or 0 to disable this limit\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"dailylimit\",\"description\":\"Maximum total amount which may be sent during a UTC day, or 0 to disable this limit\",\"required\":true,\"schema\":{\"type\":\"number\"}},{\"name\":\"overridepassphrase\",\"description\":\"New passphrase allowing the limits to be exceeded using overridespendingpolicy (unchanged if unset, removed if empty)\",\"schema\":{\"type\":\"string\"}},{\"name\":\"currentoverridepassphrase\",\"description\":\"The current override passphrase, required if the policy already has one\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setticketfee\",\"description\":\"Modify the fee per kB of the serialized tx size used each time more fee is required for an authored stake transaction.\",\"params\":[{\"name\":\"fee\",\"description\":\"The new fee per kB of the serialized tx size valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}}],\"result\":{\"name\":\"result\",\"description\":\"The boolean 'true'\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"settxfee\",\"description\":\"Modify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\",\"params\":[{\"name\":\"amount\",\"description\":\"The new fee per kB of the serialized tx size valued in valhallacoin\",\"required\":true,\"schema\":{\"type\":\"number\"}}],\"result\":{\"name\":\"result\",\"description\":\"The boolean 'true'\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"setunlocksessiontimeout\",\"description\":\"Sets the duration that the key derived from the private passphrase is cached after an unlock. Unlocking again with the same passphrase before the timeout elapses skips the expensive key derivation.\",\"params\":[{\"name\":\"timeout\",\"description\":\"Number of seconds the derived key is cached, or 0 to disable caching and clear any cached key\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setutxopolicy\",\"description\":\"Sets the spend policy of an unspent output, replacing any previous policy.\\nPolicies are saved in the wallet database and restrict which transactions created by the wallet may select the output as an input:\\n\\\"donotspend\\\" outputs are never selected, \\\"reservefortickets\\\" outputs are only selected by ticket purchases, and \\\"dust\\\" outputs are only selected by account sweeps.\",\"params\":[{\"name\":\"txid\",\"description\":\"The hash of the transaction of the output\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"vout\",\"description\":\"The output index\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"policies\",\"description\":\"Policy flags of the output (\\\"donotspend\\\", \\\"reservefortickets\\\", or \\\"dust\\\"), or an empty array to remove all restrictions\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setvotechoice\",\"description\":\"Sets choices for defined agendas in the latest stake version supported by this software\",\"params\":[{\"name\":\"agendaid\",\"description\":\"The ID for the agenda to modify\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"choiceid\",\"description\":\"The ID for the choice to choose\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"setvsp\",\"description\":\"Selects the voting service provider (VSP) tickets are purchased for, or clears the selection when host is empty\",\"params\":[{\"name\":\"host\",\"description\":\"The https URL of the VSP (http is only permitted on simnet and regnet)\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"pubkey\",\"description\":\"The base64-encoded Ed25519 public key of the VSP (default is fetched from the VSP)\",\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"signcosignsession\",\"description\":\"Adds signatures of a cosigning session's inputs by keys of the wallet, returning the updated session.\\nInputs which already have the required signatures are not signed again.\",\"params\":[{\"name\":\"session\",\"description\":\"The JSON-encoded cosigning session\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"added\":{\"type\":\"integer\",\"description\":\"The number of signatures added by the wallet, omitted when none were added\"},\"complete\":{\"type\":\"boolean\",\"description\":\"Whether every input has the required signatures\"},\"required\":{\"type\":\"array\",\"description\":\"The number of signatures required by each input\",\"items\":{\"type\":\"integer\"}},\"session\":{\"type\":\"string\",\"description\":\"The JSON-encoded session passed between the cosigners\"},\"signatures\":{\"type\":\"array\",\"description\":\"The number of signatures collected for each input\",\"items\":{\"type\":\"integer\"}}},\"required\":[\"session\",\"signatures\",\"required\",\"complete\"]}}},{\"name\":\"signmessage\",\"description\":\"Signs a message using the private key of a payment address.\\nEd25519 and secp256k1 Schnorr addresses sign with the key of their signature algorithm, and the signature includes the public key of the address.\",\"params\":[{\"name\":\"address\",\"description\":\"Payment address of private key used to sign the message with\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"message\",\"description\":\"Message to sign\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"The signed message encoded as a base64 string\",\"schema\":{\"type\":\"string\"}}},{\"name\":\"signrawtransaction\",\"description\":\"Signs transaction inputs using private keys from this wallet and request.\\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\\nThe output scripts of inputs not described by the request are looked up in the wallet's transaction history, and otherwise queried from the consensus RPC server when one is connected.\",\"params\":[{\"name\":\"rawtx\",\"description\":\"Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"inputs\",\"description\":\"Additional data regarding inputs that this wallet may not be tracking\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"redeemScript\":{\"type\":\"string\"},\"scriptPubKey\":{\"type\":\"string\"},\"tree\":{\"type\":\"integer\"},\"txid\":{\"type\":\"string\"},\"vout\":{\"type\":\"integer\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"scriptPubKey\",\"redeemScript\"]}}},{\"name\":\"privkeys\",\"description\":\"Additional WIF-encoded private keys to use when creating signatures\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"flags\",\"description\":\"Sighash flags\",\"schema\":{\"type\":\"string\",\"default\":\"ALL\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"complete\":{\"type\":\"boolean\",\"description\":\"Whether all input signatures have been created\"},\"errors\":{\"type\":\"array\",\"description\":\"Script verification errors (if exists)\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"Verification or signing error related to the input\"},\"scriptSig\":{\"type\":\"string\",\"description\":\"The hex-encoded signature script\"},\"sequence\":{\"type\":\"integer\",\"description\":\"Script sequence number\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced previous output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced previous output\"}},\"required\":[\"txid\",\"vout\",\"scriptSig\",\"sequence\",\"error\"]}},\"hex\":{\"type\":\"string\",\"description\":\"The resulting transaction encoded as a hexadecimal string\"}},\"required\":[\"hex\",\"complete\"]}}},{\"name\":\"signrawtransactions\",\"description\":\"Signs transaction inputs using private keys from this wallet and request for a list of transactions.\\nTransactions are signed concurrently, and a transaction which can not be signed or sent does not prevent signing and sending the others.\",\"params\":[{\"name\":\"rawtxs\",\"description\":\"A list of transactions to sign (and optionally send).\",\"required\":true,\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},{\"name\":\"send\",\"description\":\"Set true to send the transactions after signing.\",\"schema\":{\"type\":\"boolean\",\"default\":true}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"results\":{\"type\":\"array\",\"description\":\"Returned values from the signrawtransactions command.\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"The reason the transaction could not be signed or sent, omitted on success.\"},\"sent\":{\"type\":\"boolean\",\"description\":\"Tells if the transaction was sent.\"},\"signingresult\":{\"type\":\"object\",\"description\":\"Success or failure of signing.\",\"properties\":{\"complete\":{\"type\":\"boolean\",\"description\":\"Whether all input signatures have been created\"},\"errors\":{\"type\":\"array\",\"description\":\"Script verification errors (if exists)\",\"items\":{\"type\":\"object\",\"properties\":{\"error\":{\"type\":\"string\",\"description\":\"Verification or signing error related to the input\"},\"scriptSig\":{\"type\":\"string\",\"description\":\"The hex-encoded signature script\"},\"sequence\":{\"type\":\"integer\",\"description\":\"Script sequence number\"},\"txid\":{\"type\":\"string\",\"description\":\"The transaction hash of the referenced previous output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index of the referenced previous output\"}},\"required\":[\"txid\",\"vout\",\"scriptSig\",\"sequence\",\"error\"]}},\"hex\":{\"type\":\"string\",\"description\":\"The resulting transaction encoded as a hexadecimal string\"}},\"required\":[\"hex\",\"complete\"]},\"txhash\":{\"type\":\"string\",\"description\":\"The hash of the signed tx.\"}},\"required\":[\"signingresult\",\"sent\"]}}},\"required\":[\"results\"]}}},{\"name\":\"signsplitticketsession\",\"description\":\"Signs the inputs contributed by the wallet to a fully funded split ticket session and records the wallet's share of the ticket, returning the updated session.\\nThe commitment and change addresses of contributions spending outputs of the wallet must belong to the wallet.\",\"params\":[{\"name\":\"session\",\"description\":\"The JSON-encoded split ticket session\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"session\":{\"type\":\"string\",\"description\":\"The JSON-encoded session passed between the participants\"},\"tickethash\":{\"type\":\"string\",\"description\":\"The hash of the ticket, set once the session is signed\"},\"ticketprice\":{\"type\":\"number\",\"description\":\"The price (in VHC) of the ticket\"},\"unfunded\":{\"type\":\"number\",\"description\":\"The share of the ticket price (in VHC) not yet contributed by any participant\"}},\"required\":[\"session\",\"ticketprice\",\"unfunded\"]}}},{\"name\":\"stakehistory\",\"description\":\"Returns the vote bits and agenda choices of the most recent votes created by the wallet, ordered by the height of the block voted on.\",\"params\":[{\"name\":\"count\",\"description\":\"Number of most recent votes to return, or 0 for every vote (default=100)\",\"schema\":{\"type\":\"integer\",\"default\":100}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"object\",\"properties\":{\"blockhash\":{\"type\":\"string\",\"description\":\"Hash of the block voted on\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"Height of the block voted on\"},\"choices\":{\"type\":\"array\",\"description\":\"The agenda choices of the stake version cast by the vote bits\",\"items\":{\"type\":\"object\",\"properties\":{\"agendaid\":{\"type\":\"string\",\"description\":\"The ID of the agenda\"},\"choiceid\":{\"type\":\"string\",\"description\":\"The ID of the agenda's choice\"}},\"required\":[\"agendaid\",\"choiceid\"]}},\"tickethash\":{\"type\":\"string\",\"description\":\"Hash of the ticket\"},\"time\":{\"type\":\"integer\",\"description\":\"Unix time the vote was created\"},\"votebits\":{\"type\":\"integer\",\"description\":\"The vote bits cast by the vote\"},\"votebitsext\":{\"type\":\"string\",\"description\":\"The hex encoded extended vote bits cast by the vote\"},\"votehash\":{\"type\":\"string\",\"description\":\"Hash of the vote transaction\"},\"voteversion\":{\"type\":\"integer\",\"description\":\"The stake version of the vote\"}},\"required\":[\"tickethash\",\"votehash\",\"blockhash\",\"blockheight\",\"time\",\"votebits\",\"votebitsext\",\"voteversion\",\"choices\"]}}}},{\"name\":\"stakepooluserinfo\",\"description\":\"Get user info for stakepool\",\"params\":[{\"name\":\"user\",\"description\":\"The id of the user to be looked up\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"invalid\":{\"type\":\"array\",\"description\":\"A list of invalid tickets that the user has added\",\"items\":{\"type\":\"string\"}},\"tickets\":{\"type\":\"array\",\"description\":\"A list of valid tickets that the user has added\",\"items\":{\"type\":\"object\",\"properties\":{\"spentby\":{\"type\":\"string\",\"description\":\"The vote in which the ticket was spent\"},\"spentbyheight\":{\"type\":\"integer\",\"description\":\"The height in which the ticket was spent\"},\"status\":{\"type\":\"string\",\"description\":\"The current status of the added ticket\"},\"ticket\":{\"type\":\"string\",\"description\":\"The hash of the added ticket\"},\"ticketheight\":{\"type\":\"integer\",\"description\":\"The height in which the ticket was added\"}},\"required\":[\"status\",\"ticket\",\"ticketheight\",\"spentby\",\"spentbyheight\"]}}},\"required\":[\"tickets\",\"invalid\"]}}},{\"name\":\"startautobuyer\",\"description\":\"Starts a ticket buyer purchasing tickets from an account.\\nTicket buyers of different accounts run concurrently, each with its own balance to maintain, limits, and voting and pool addresses.\\nUnset parameters default to the configured ticket buyer options.\",\"params\":[{\"name\":\"account\",\"description\":\"The account to use for purchasing tickets\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"passphrase\",\"description\":\"The private passphrase of the wallet\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"balancetomaintain\",\"description\":\"The minimum amount of funds to never dip below when purchasing tickets\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"maxfeeperkb\",\"description\":\"The maximum ticket fee amount per KB\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"maxpricerelative\",\"description\":\"The scaling factor for setting the maximum ticket price, multiplied by the average price\",\"schema\":{\"type\":\"number\"}},{\"name\":\"maxpriceabsolute\",\"description\":\"The maximum absolute ticket price\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"votingaddress\",\"description\":\"The address to delegate voting rights to\",\"schema\":{\"type\":\"string\"}},{\"name\":\"pooladdress\",\"description\":\"The stake pool address where ticket fees will go to\",\"schema\":{\"type\":\"string\"}},{\"name\":\"poolfees\",\"description\":\"The absolute per ticket fee mandated by the stake pool as a percent\",\"schema\":{\"type\":\"number\"}},{\"name\":\"maxperblock\",\"description\":\"The maximum tickets per block. Negative number indicates one ticket every n blocks\",\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"stopaccountautobuyer\",\"description\":\"Stops the ticket buyer of a single account, leaving those of other accounts running.\",\"params\":[{\"name\":\"account\",\"description\":\"The account whose ticket buyer is stopped\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"stopautobuyer\",\"description\":\"Stops the ticket buyer of every account.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"stopnotifyblocks\",\"description\":\"Cancels notifications requested with notifyblocks (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifydepositaddresses\",\"description\":\"Cancels notifications requested with notifydepositaddresses (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifymissedvotes\",\"description\":\"Cancels notifications requested with notifymissedvotes (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifynewtransactions\",\"description\":\"Cancels notifications requested with notifynewtransactions (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifypendingrevocations\",\"description\":\"Cancels notifications requested with notifypendingrevocations (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifyrescanprogress\",\"description\":\"Cancels notifications requested with notifyrescanprogress (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifytickets\",\"description\":\"Cancels notifications requested with notifytickets (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifyvoteversion\",\"description\":\"Cancels notifications requested with notifyvoteversion (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"stopnotifywinningtickets\",\"description\":\"Cancels notifications requested with notifywinningtickets (websocket clients only).\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}},\"x-websocketonly\":true},{\"name\":\"sweepaccount\",\"description\":\"Moves as much value as possible in a transaction from an account.\\nLocked outputs, ticket outputs, and immature coinbase and stake outputs are not swept.\\nAccounts with more outputs than fit in a single transaction are swept by several transactions spending distinct outputs.\\nThe result fields describe the first transaction and the others are listed in additionaltransactions.\",\"params\":[{\"name\":\"sourceaccount\",\"description\":\"The account to be swept.\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"destinationaddress\",\"description\":\"The destination address to pay to.\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"requiredconfirmations\",\"description\":\"The minimum utxo confirmation requirement (optional).\",\"schema\":{\"type\":\"integer\"}},{\"name\":\"feeperkb\",\"description\":\"The minimum relay fee policy (optional).\",\"schema\":{\"type\":\"number\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"additionaltransactions\":{\"type\":\"array\",\"description\":\"The further transactions sweeping outputs which do not fit in the first transaction, omitted when there are none.\",\"items\":{\"type\":\"object\",\"properties\":{\"estimatedsignedsize\":{\"type\":\"integer\",\"description\":\"The estimated size of the transaction when signed.\"},\"totaloutputamount\":{\"type\":\"number\",\"description\":\"The total transaction output amount.\"},\"totalpreviousoutputamount\":{\"type\":\"number\",\"description\":\"The total transaction input amount.\"},\"unsignedtransaction\":{\"type\":\"string\",\"description\":\"The hex encoded string of the unsigned transaction.\"}},\"required\":[\"unsignedtransaction\",\"totalpreviousoutputamount\",\"totaloutputamount\",\"estimatedsignedsize\"]}},\"estimatedsignedsize\":{\"type\":\"integer\",\"description\":\"The estimated size of the transaction when signed.\"},\"totaloutputamount\":{\"type\":\"number\",\"description\":\"The total transaction output amount.\"},\"totalpreviousoutputamount\":{\"type\":\"number\",\"description\":\"The total transaction input amount.\"},\"unsignedtransaction\":{\"type\":\"string\",\"description\":\"The hex encoded string of the unsigned transaction.\"}},\"required\":[\"unsignedtransaction\",\"totalpreviousoutputamount\",\"totaloutputamount\",\"estimatedsignedsize\"]}}},{\"name\":\"sweepdust\",\"description\":\"Consolidates the dust outputs of an account into a new internal address of the account.\\nOutputs marked with the \\\"dust\\\" UTXO policy and outputs of value below the threshold are dust.\\nDust outputs worth less than the fee of spending them are not swept, and no transaction is published when the swept value would not exceed the fee.\\nThe wallet must be unlocked.\",\"params\":[{\"name\":\"account\",\"description\":\"The account to sweep dust from\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"threshold\",\"description\":\"Outputs of lower value, in valhallacoin, are dust, or 0 for three times the fee of creating and spending an output at the fee rate\",\"schema\":{\"type\":\"number\",\"default\":0}},{\"name\":\"feeperkb\",\"description\":\"The fee rate of the transaction, valued in valhallacoin per kilobyte (default is the wallet's relay fee)\",\"schema\":{\"type\":\"number\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"dustvalue\":{\"type\":\"number\",\"description\":\"The total value of the swept dust outputs\"},\"fee\":{\"type\":\"number\",\"description\":\"The fee paid by the sweep\"},\"inputs\":{\"type\":\"integer\",\"description\":\"The number of swept dust outputs\"},\"reclaimed\":{\"type\":\"number\",\"description\":\"The value of the dust reclaimed in the new output after fees\"},\"txhashes\":{\"type\":\"array\",\"description\":\"The hashes of the sweep transactions, empty when sweeping was not profitable\",\"items\":{\"type\":\"string\"}}},\"required\":[\"inputs\",\"dustvalue\",\"fee\",\"reclaimed\",\"txhashes\"]}}},{\"name\":\"ticketsforaddress\",\"description\":\"Request all the tickets for an address.\",\"params\":[{\"name\":\"address\",\"description\":\"Address to look for.\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"Tickets owned by the specified address.\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"validateaddress\",\"description\":\"Verify that an address is valid.\\nExtra details are returned if the address is controlled by this wallet.\\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\",\"params\":[{\"name\":\"address\",\"description\":\"Address to validate\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"account\":{\"type\":\"string\",\"description\":\"The account this payment address belongs to (only when isvalid is true)\"},\"address\":{\"type\":\"string\",\"description\":\"The payment address (only when isvalid is true)\"},\"addresses\":{\"type\":\"array\",\"description\":\"All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\",\"items\":{\"type\":\"string\"}},\"hex\":{\"type\":\"string\",\"description\":\"The redeem script \"},\"iscompressed\":{\"type\":\"boolean\",\"description\":\"Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\"},\"ismine\":{\"type\":\"boolean\",\"description\":\"Whether this address is controlled by the wallet (only when isvalid is true)\"},\"isscript\":{\"type\":\"boolean\",\"description\":\"Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\"},\"isvalid\":{\"type\":\"boolean\",\"description\":\"Whether or not the address is valid\"},\"iswatchonly\":{\"type\":\"boolean\",\"description\":\"Unset\"},\"pubkey\":{\"type\":\"string\",\"description\":\"The associated public key of the payment address, if any (only when isvalid is true)\"},\"pubkeyaddr\":{\"type\":\"string\",\"description\":\"The pubkey for this payment address (only when isvalid is true)\"},\"script\":{\"type\":\"string\",\"description\":\"The class of redeem script for a multisig address\"},\"sigsrequired\":{\"type\":\"integer\",\"description\":\"The number of required signatures to redeem outputs to the multisig address\"}},\"required\":[\"isvalid\"]}}},{\"name\":\"verifymessage\",\"description\":\"Verify a message was signed with the associated private key of some address.\\nSignatures of Ed25519 and secp256k1 Schnorr addresses must include the public key of the address as created by signmessage.\",\"params\":[{\"name\":\"address\",\"description\":\"Address used to sign message\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"signature\",\"description\":\"The signature to verify\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"message\",\"description\":\"The message to verify\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"description\":\"Whether the message was signed with the private key of 'address'\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"verifyownershipproof\",\"description\":\"Verifies the signatures of a proof created by createownershipproof and checks with the consensus RPC server that the proof block is in the main chain and that each claimed output exists with the claimed amount and address and was unspent as of the block.\\nOutputs spent since the proof block invalidate the proof.\",\"params\":[{\"name\":\"proof\",\"description\":\"The ownership proof\",\"required\":true,\"schema\":{\"type\":\"object\",\"properties\":{\"addresses\":{\"type\":\"array\",\"description\":\"The addresses and their signatures of the proof\",\"items\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The address\"},\"signature\":{\"type\":\"string\",\"description\":\"The base64-encoded signature of the proof by the private key of the address\"}},\"required\":[\"address\",\"signature\"]}},\"blockhash\":{\"type\":\"string\",\"description\":\"The hash of the main chain block as of which the outputs are unspent\"},\"blockheight\":{\"type\":\"integer\",\"description\":\"The height of the main chain block as of which the outputs are unspent\"},\"challenge\":{\"type\":\"string\",\"description\":\"The challenge chosen by the verifier\"},\"outputs\":{\"type\":\"array\",\"description\":\"The unspent outputs paying the addresses\",\"items\":{\"type\":\"object\",\"properties\":{\"address\":{\"type\":\"string\",\"description\":\"The address paid by the output\"},\"amount\":{\"type\":\"number\",\"description\":\"The value of the output in VHC\"},\"tree\":{\"type\":\"integer\",\"description\":\"The transaction tree of the output\"},\"txid\":{\"type\":\"string\",\"description\":\"The hash of the transaction of the output\"},\"vout\":{\"type\":\"integer\",\"description\":\"The output index\"}},\"required\":[\"txid\",\"vout\",\"tree\",\"amount\",\"address\"]}}},\"required\":[\"challenge\",\"blockhash\",\"blockheight\",\"addresses\",\"outputs\"]}},{\"name\":\"minbalance\",\"description\":\"The minimum total value (in VHC) of the claimed outputs\",\"schema\":{\"type\":\"number\",\"default\":0}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"balance\":{\"type\":\"number\",\"description\":\"The total value (in VHC) of the claimed outputs, or zero when the proof is invalid\"},\"valid\":{\"type\":\"boolean\",\"description\":\"Whether every address signed the proof, every claimed output was unspent as of the proof block, and the claimed outputs are worth at least the minimum balance\"}},\"required\":[\"valid\",\"balance\"]}}},{\"name\":\"version\",\"description\":\"Returns application and API versions (semver) keyed by their names\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"object\",\"properties\":{\"buildmetadata\":{\"type\":\"string\"},\"major\":{\"type\":\"integer\"},\"minor\":{\"type\":\"integer\"},\"patch\":{\"type\":\"integer\"},\"prerelease\":{\"type\":\"string\"},\"versionstring\":{\"type\":\"string\"}},\"required\":[\"versionstring\",\"major\",\"minor\",\"patch\",\"prerelease\",\"buildmetadata\"]}}}},{\"name\":\"walletexists\",\"description\":\"Returns whether a wallet database exists in the wallet data directory, i.e. whether openwallet may be used to open a wallet.\",\"params\":[],\"result\":{\"name\":\"result\",\"description\":\"Whether the wallet database exists\",\"schema\":{\"type\":\"boolean\"}}},{\"name\":\"walletinfo\",\"description\":\"Returns global information about the wallet\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"object\",\"properties\":{\"consistencycheck\":{\"type\":\"object\",\"description\":\"Result of the database consistency check performed when the wallet was opened\",\"properties\":{\"accounts\":{\"type\":\"integer\",\"description\":\"Number of account records verified (full check only)\"},\"blocks\":{\"type\":\"integer\",\"description\":\"Number of main chain blocks verified (full check only)\"},\"credits\":{\"type\":\"integer\",\"description\":\"Number of credit records verified (full check only)\"},\"durationms\":{\"type\":\"integer\",\"description\":\"Duration of the check in milliseconds\"},\"mode\":{\"type\":\"string\",\"description\":\"Whether the \\\"quick\\\" structural check or the \\\"full\\\" check of every record (--fullcheck) was performed\"},\"tiphash\":{\"type\":\"string\",\"description\":\"Hash of the main chain tip block when the wallet was opened\"},\"tipheight\":{\"type\":\"integer\",\"description\":\"Height of the main chain tip block when the wallet was opened\"},\"transactions\":{\"type\":\"integer\",\"description\":\"Number of mined transaction records verified (full check only)\"}},\"required\":[\"mode\",\"tiphash\",\"tipheight\",\"durationms\"]},\"daemonconnected\":{\"type\":\"boolean\",\"description\":\"Whether or not the wallet is currently connected to the daemon RPC\"},\"database\":{\"type\":\"object\",\"description\":\"Storage statistics of the wallet database (omitted if unavailable)\",\"properties\":{\"freespace\":{\"type\":\"integer\",\"description\":\"Bytes available on the volume containing the wallet database (omitted if unsupported on this platform)\"},\"path\":{\"type\":\"string\",\"description\":\"The file path of the wallet database (omitted unless called with admin credentials)\"},\"size\":{\"type\":\"integer\",\"description\":\"The size of the wallet database file in bytes\"},\"writeerrors\":{\"type\":\"integer\",\"description\":\"The number of failed database writes since the wallet was opened\"}},\"required\":[\"size\",\"writeerrors\"]},\"gaprecoveries\":{\"type\":\"object\",\"description\":\"Catch-up syncs performed after missed block notifications from the consensus RPC server (omitted unless synchronizing with the consensus RPC server)\",\"properties\":{\"failures\":{\"type\":\"integer\",\"description\":\"The number of catch-up syncs which failed, including those that exceeded the maximum number of missed blocks and restarted synchronization\"},\"recoveredblocks\":{\"type\":\"integer\",\"description\":\"The number of blocks connected by catch-up syncs\"},\"recoveries\":{\"type\":\"integer\",\"description\":\"The number of catch-up syncs started since the wallet process started\"}},\"required\":[\"recoveries\",\"recoveredblocks\",\"failures\"]},\"ticketfee\":{\"type\":\"number\",\"description\":\"Ticket fee per kB of the serialized tx size in coins\"},\"ticketpurchasing\":{\"type\":\"boolean\",\"description\":\"Whether or not the wallet is currently purchasing tickets\"},\"txfee\":{\"type\":\"number\",\"description\":\"Transaction fee per kB of the serialized tx size in coins\"},\"unlocked\":{\"type\":\"boolean\",\"description\":\"Whether or not the wallet is unlocked\"},\"votebits\":{\"type\":\"integer\",\"description\":\"Vote bits setting\"},\"votebitsextended\":{\"type\":\"string\",\"description\":\"Extended vote bits setting\"},\"voteversion\":{\"type\":\"integer\",\"description\":\"Version of votes that will be generated\"},\"voting\":{\"type\":\"boolean\",\"description\":\"Whether or not the wallet is currently voting tickets\"}},\"required\":[\"daemonconnected\",\"unlocked\",\"txfee\",\"ticketfee\",\"ticketpurchasing\",\"votebits\",\"votebitsextended\",\"voteversion\",\"voting\"]}}},{\"name\":\"walletislocked\",\"description\":\"Returns whether or not the wallet is locked.\",\"params\":[],\"result\":{\"name\":\"result\",\"description\":\"Whether the wallet is locked\",\"schema\":{\"type\":\"boolean\"}},\"x-websocketonly\":true},{\"name\":\"walletlock\",\"description\":\"Lock the wallet.\",\"params\":[],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"walletpassphrasechange\",\"description\":\"Change the wallet passphrase.\",\"params\":[{\"name\":\"oldpassphrase\",\"description\":\"The old wallet passphrase\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"newpassphrase\",\"description\":\"The new wallet passphrase\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"walletpassphrase\",\"description\":\"Unlock the wallet.\",\"params\":[{\"name\":\"passphrase\",\"description\":\"The wallet passphrase\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"timeout\",\"description\":\"The number of seconds to wait before the wallet automatically locks\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"watchoutpoint\",\"description\":\"Watches an outpoint not owned by the wallet.\\nThe transaction spending the outpoint is recorded by the wallet and reported by listtransactions as involving a watch-only output.\",\"params\":[{\"name\":\"txid\",\"description\":\"The hash of the transaction of the outpoint\",\"required\":true,\"schema\":{\"type\":\"string\"}},{\"name\":\"vout\",\"description\":\"The output index of the outpoint\",\"required\":true,\"schema\":{\"type\":\"integer\"}},{\"name\":\"tree\",\"description\":\"The transaction tree of the outpoint (0=regular, 1=stake)\",\"schema\":{\"type\":\"integer\",\"default\":0}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}},{\"name\":\"watchscript\",\"description\":\"Watches an output script not owned by the wallet.\\nTransactions paying the script, and transactions spending those outputs, are recorded by the wallet and reported by listtransactions as involving watch-only outputs.\\nPast transactions are only recorded after a rescan.\",\"params\":[{\"name\":\"hex\",\"description\":\"The hex encoded output script\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"result\":{\"name\":\"result\",\"schema\":{\"type\":\"null\"}}}]"
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// searchWallet handles a searchwallet request by returning the wallet records
+// matching part of a transaction hash, address, account name, or deposit
+// address reference.
+func searchWallet(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SearchWalletCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	count := *cmd.Count
+	if count < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"count may not be negative")
+	}
+	matches, err := w.Search(cmd.Query, count)
+	if err != nil {
+		if errors.Is(errors.Invalid, err) {
+			return nil, rpcError(vhcjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+
+	res := make([]types.SearchWalletResult, 0, len(matches))
+	for _, m := range matches {
+		r := types.SearchWalletResult{
+			Kind:      m.Kind.String(),
+			Address:   m.Address,
+			Reference: m.Reference,
+		}
+		if m.Kind == wallet.SearchMatchTransaction {
+			r.TxID = m.TxHash.String()
+			r.BlockHeight = m.Height
+			r.Time = m.Time.Unix()
+		} else {
+			r.Account, err = w.AccountName(m.Account)
+			if err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
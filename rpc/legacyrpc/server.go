@@ -6,27 +6,35 @@
 package legacyrpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
-	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
 	"github.com/valhallacoin/vhcd/vhcjson"
 	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/internal/features"
 	"github.com/valhallacoin/vhcwallet/loader"
 	"github.com/valhallacoin/vhcwallet/ticketbuyer"
+	"github.com/valhallacoin/vhcwallet/wallet"
 	"github.com/gorilla/websocket"
 )
 
+// walletPathPrefix is the path prefix of the HTTP POST and websocket endpoints
+// of named wallets.
+const walletPathPrefix = "/wallet/"
+
 type websocketClient struct {
 	conn          *websocket.Conn
 	authenticated bool
@@ -34,6 +42,9 @@ type websocketClient struct {
 	responses     chan []byte
 	quit          chan struct{} // closed on disconnect
 	wg            sync.WaitGroup
+
+	ntfnMu        sync.Mutex
+	subscriptions map[string]chan struct{} // closed to unsubscribe
 }
 
 func newWebsocketClient(c *websocket.Conn, authenticated bool) *websocketClient {
@@ -59,13 +70,25 @@ func (c *websocketClient) send(b []byte) error {
 // config, shutdown, etc.)
 type Server struct {
 	httpServer        http.Server
-	walletLoader      *loader.Loader
+	loader            *loader.Loader
 	ticketbuyerConfig *ticketbuyer.Config
 	handlerMu         sync.Mutex
 	listeners         []net.Listener
-	authsha           [sha256.Size]byte
+	credentials       []credential
+	hasApprover       bool
+	certRoles         map[string]Role
+	features          *features.Set
+	limiter           *rateLimiter
+	rateLimitByIP     bool
+	auditLog          *AuditLog
+	signingKey        *secp256k1.PrivateKey
+	signedMethods     map[string]struct{}
+	cors              *corsPolicy
+	guard             *anomalyGuard
 	upgrader          websocket.Upgrader
 
+	requireApproval bool
+
 	maxPostClients      int64 // Max concurrent HTTP POST clients.
 	maxWebsocketClients int64 // Max concurrent websocket clients.
 
@@ -81,8 +104,38 @@ type Server struct {
 }
 
 type handler struct {
-	fn     func(*Server, interface{}) (interface{}, error)
+	fn     func(*Server, context.Context, interface{}) (interface{}, error)
 	noHelp bool
+
+	// feature is the feature flag which must be enabled for the method to
+	// be called, if any.
+	feature features.Flag
+
+	// aliasOf is the method handling requests for this method when it is
+	// an alias, such as the previous name of a renamed method.  Aliases do
+	// not have their own help.
+	aliasOf string
+
+	// deprecated describes the deprecation of the method, if any.
+	// Responses to requests of deprecated methods include a warning with
+	// the migration hint.
+	deprecated *deprecation
+
+	// legacyResults convert results to the shapes returned by older major
+	// API versions for clients which request them, ordered from the oldest
+	// version.
+	legacyResults []legacyResult
+}
+
+// checkFeature returns an error if the method requires a feature which is
+// disabled by the feature flags of the server.
+func (s *Server) checkFeature(method string) *vhcjson.RPCError {
+	h, ok := handlers[method]
+	if !ok || h.feature == "" || s.features.Enabled(h.feature) {
+		return nil
+	}
+	return rpcErrorf(errRPCFeatureDisabled,
+		"method %s requires the disabled %s feature", method, h.feature)
 }
 
 // jsonAuthFail sends a message back to the client if the http auth is rejected.
@@ -96,6 +149,7 @@ func jsonAuthFail(w http.ResponseWriter) {
 func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.Loader, ticketBuyerConfig *ticketbuyer.Config, listeners []net.Listener) *Server {
 	serveMux := http.NewServeMux()
 	const rpcAuthTimeoutSeconds = 10
+	cors := newCORSPolicy(opts.CORSOrigins)
 	server := &Server{
 		httpServer: http.Server{
 			Handler: serveMux,
@@ -104,49 +158,128 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 			// handshake within the allowed timeframe.
 			ReadTimeout: time.Second * rpcAuthTimeoutSeconds,
 		},
-		walletLoader:        walletLoader,
+		loader:              walletLoader,
 		maxPostClients:      opts.MaxPOSTClients,
 		maxWebsocketClients: opts.MaxWebsocketClients,
 		listeners:           listeners,
 		ticketbuyerConfig:   ticketBuyerConfig,
-		// A hash of the HTTP basic auth string is used for a constant
-		// time comparison.
-		authsha: sha256.Sum256(httpBasicAuth(opts.Username, opts.Password)),
+		requireApproval:     opts.RequireSendApproval,
+		certRoles:           opts.ClientCertRoles,
+		features:            opts.Features,
+		limiter:             newRateLimiter(opts.RateLimit, opts.RateLimitBurst, opts.MaxClientRequests),
+		rateLimitByIP:       opts.RateLimitByIP,
+		auditLog:            opts.AuditLog,
+		signingKey:          opts.SigningKey,
+		signedMethods:       make(map[string]struct{}),
+		cors:                cors,
+		guard:               newAnomalyGuard(opts.Guard),
 		upgrader: websocket.Upgrader{
-			// Allow all origins.
-			CheckOrigin: func(r *http.Request) bool { return true },
+			// Allow all origins unless a CORS policy is configured.
+			CheckOrigin: cors.checkWebsocketOrigin,
 		},
 		quit:                make(chan struct{}),
 		requestShutdownChan: make(chan struct{}, 1),
 		activeNet:           activeNet,
 	}
 
-	serveMux.Handle("/", throttledFn(opts.MaxPOSTClients,
+	// A hash of the HTTP basic auth string of each credential is used for
+	// a constant time comparison.  The admin credentials may be unset when
+	// clients are authenticated by TLS client certificates.
+	creds := make([]Credential, 0, len(opts.Users)+2)
+	if opts.Username != "" && opts.Password != "" {
+		creds = append(creds, Credential{opts.Username, opts.Password, RoleAdmin})
+	}
+	if opts.ApproverUsername != "" && opts.ApproverPassword != "" {
+		creds = append(creds, Credential{opts.ApproverUsername,
+			opts.ApproverPassword, RoleApprover})
+	}
+	creds = append(creds, opts.Users...)
+	for _, c := range creds {
+		server.credentials = append(server.credentials, credential{
+			authsha:  sha256.Sum256(httpBasicAuth(c.Username, c.Password)),
+			username: c.Username,
+			role:     c.Role,
+		})
+		if c.Role == RoleApprover {
+			server.hasApprover = true
+		}
+	}
+
+	// The anomaly guard is enforced by each wallet, so that unlocks and
+	// spends requested by other APIs are refused while it is triggered.
+	walletLoader.RunAfterLoad(func(w *wallet.Wallet) {
+		w.SetSpendGuard(server.guard)
+	})
+	walletLoader.RunAfterLoadNamed(func(ctx context.Context, l *loader.Loader, w *wallet.Wallet) {
+		w.SetSpendGuard(server.guard)
+	})
+
+	for _, m := range opts.SignedMethods {
+		if _, ok := handlers[m]; !ok {
+			log.Warnf("Responses of unknown method %s can not be signed", m)
+			continue
+		}
+		server.signedMethods[m] = struct{}{}
+	}
+
+	postHandler := throttledFn(opts.MaxPOSTClients, corsFn(cors,
 		func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Connection", "close")
 			w.Header().Set("Content-Type", "application/json")
 			r.Close = true
 
-			if err := server.checkAuthHeader(r); err != nil {
+			ctx, err := server.authenticateHTTP(r)
+			if err != nil {
 				log.Warnf("Failed authentication attempt from client %s",
 					r.RemoteAddr)
 				jsonAuthFail(w)
 				return
 			}
+
+			if v := r.Header.Get(apiVersionHeader); v != "" {
+				major, err := parseAPIVersion(v)
+				if err != nil {
+					http.Error(w, "400 Bad Request: "+err.Error(),
+						http.StatusBadRequest)
+					return
+				}
+				ctx = withAPIMajor(ctx, major)
+			}
+
+			key := server.rateLimitKey(ctx)
+			if !server.limiter.acquire(key, time.Now()) {
+				log.Warnf("Rate limited request from client %s",
+					clientString(ctx))
+				http.Error(w, "429 Too Many Requests", 429)
+				return
+			}
 			server.wg.Add(1)
-			server.postClientRPC(w, r)
+			server.postClientRPC(ctx, w, r)
 			server.wg.Done()
+			server.limiter.release(key)
 		}))
+	serveMux.Handle("/", postHandler)
+
+	serveMux.Handle(restPathPrefix, throttledFn(opts.MaxPOSTClients,
+		corsFn(cors, server.restHandler)))
 
-	serveMux.Handle("/ws", throttledFn(opts.MaxWebsocketClients,
+	websocketHandler := throttledFn(opts.MaxWebsocketClients,
 		func(w http.ResponseWriter, r *http.Request) {
 			ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
 			authenticated := false
-			switch server.checkAuthHeader(r) {
+			role, username, err := server.checkAuthHeader(r)
+			switch err {
 			case nil:
 				authenticated = true
+				ctx = withRole(withUsername(ctx, username), role)
 			case errNoAuth:
-				// nothing
+				// Clients without a verified certificate must
+				// authenticate with the authenticate method.
+				role, identity, ok := server.authenticateCert(r.TLS)
+				if ok {
+					authenticated = true
+					ctx = withRole(withCertIdentity(ctx, identity), role)
+				}
 			default:
 				// If auth was supplied but incorrect, rather than simply
 				// being missing, immediately terminate the connection.
@@ -164,7 +297,24 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 			}
 			wsc := newWebsocketClient(conn, authenticated)
 			server.websocketClientRPC(ctx, wsc)
-		}))
+		})
+	serveMux.Handle("/ws", websocketHandler)
+
+	// Requests of named wallets are served by the /wallet/<name> and
+	// /wallet/<name>/ws endpoints.
+	serveMux.HandleFunc(walletPathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, walletPathPrefix)
+		h := postHandler
+		if strings.HasSuffix(name, "/ws") {
+			name = strings.TrimSuffix(name, "/ws")
+			h = websocketHandler
+		}
+		if !loader.ValidWalletName(name) {
+			http.NotFound(w, r)
+			return
+		}
+		h.ServeHTTP(w, r.WithContext(withWalletName(r.Context(), name)))
+	})
 
 	for _, lis := range listeners {
 		server.serve(lis)
@@ -231,6 +381,13 @@ func (s *Server) Stop() {
 
 	// Wait for all remaining goroutines to exit.
 	s.wg.Wait()
+
+	if s.auditLog != nil {
+		err := s.auditLog.Close()
+		if err != nil {
+			log.Errorf("Cannot close audit log: %v", err)
+		}
+	}
 }
 
 // handlerClosure creates a closure function for handling requests of the given
@@ -241,8 +398,25 @@ func (s *Server) Stop() {
 // method.  Each of these must be checked beforehand (the method is already
 // known) and handled accordingly.
 func (s *Server) handlerClosure(ctx context.Context, request *vhcjson.Request) lazyHandler {
-	log.Infof("RPC method %v invoked by %v", request.Method, remoteAddr(ctx))
-	return lazyApplyHandler(s, request)
+	log.Infof("RPC method %v invoked by %v", request.Method, clientString(ctx))
+	ctx, rpcErr := s.withWalletLoader(ctx)
+	if rpcErr != nil {
+		log.Errorf("Cannot find wallet of %s request of client %s: %v",
+			request.Method, clientString(ctx), rpcErr)
+		return s.auditedHandler(ctx, request, func() (interface{}, *vhcjson.RPCError) {
+			return nil, rpcErr
+		})
+	}
+	h := s.guardedHandler(ctx, request, lazyApplyHandler(s, ctx, request))
+	h = s.versionedHandler(ctx, request, h)
+	h = s.signedHandler(ctx, request, h)
+	return s.auditedHandler(ctx, request, h)
+}
+
+// stopHandler is the handler of stop requests.  The server is stopped by the
+// caller after the response is written.
+func stopHandler() (interface{}, *vhcjson.RPCError) {
+	return "vhcwallet stopping", nil
 }
 
 // errNoAuth represents an error where authentication could not succeed
@@ -250,21 +424,18 @@ func (s *Server) handlerClosure(ctx context.Context, request *vhcjson.Request) l
 var errNoAuth = errors.E("missing Authorization header")
 
 // checkAuthHeader checks the HTTP Basic authentication supplied by a client
-// in the HTTP request r.
+// in the HTTP request r, returning the role and username of the matching
+// credentials.
 //
 // The authentication comparison is time constant.
-func (s *Server) checkAuthHeader(r *http.Request) error {
+func (s *Server) checkAuthHeader(r *http.Request) (Role, string, error) {
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) == 0 {
-		return errNoAuth
+		return 0, "", errNoAuth
 	}
 
 	authsha := sha256.Sum256([]byte(authhdr[0]))
-	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp != 1 {
-		return errors.New("invalid Authorization header")
-	}
-	return nil
+	return s.authenticate(&authsha)
 }
 
 // throttledFn wraps an http.HandlerFunc with throttling of concurrent active
@@ -305,21 +476,23 @@ func idPointer(id interface{}) (p *interface{}) {
 
 // invalidAuth checks whether a websocket request is a valid (parsable)
 // authenticate request and checks the supplied username and passphrase
-// against the server auth.
-func (s *Server) invalidAuth(req *vhcjson.Request) bool {
+// against the server auth.  It also returns the role and username of the
+// matching credentials.
+func (s *Server) invalidAuth(req *vhcjson.Request) (invalid bool, role Role, username string) {
 	cmd, err := vhcjson.UnmarshalCmd(req)
 	if err != nil {
-		return false
+		return false, 0, ""
 	}
 	authCmd, ok := cmd.(*vhcjson.AuthenticateCmd)
 	if !ok {
-		return false
+		return false, 0, ""
 	}
 	// Check credentials.
 	login := authCmd.Username + ":" + authCmd.Passphrase
 	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 	authSha := sha256.Sum256([]byte(auth))
-	return subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) != 1
+	role, username, err = s.authenticate(&authSha)
+	return err != nil, role, username
 }
 
 func (s *Server) websocketClientRead(ctx context.Context, wsc *websocketClient) {
@@ -380,16 +553,18 @@ out:
 			if req.Method == "authenticate" {
 				log.Infof("RPC method authenticate invoked by %s",
 					remoteAddr(ctx))
-				switch {
-				case wsc.authenticated:
+				if wsc.authenticated {
 					log.Warnf("Multiple authentication attempts from %s",
 						remoteAddr(ctx))
 					break out
-				case s.invalidAuth(&req):
+				}
+				invalid, role, username := s.invalidAuth(&req)
+				if invalid {
 					log.Warnf("Failed authentication attempt from %s",
 						remoteAddr(ctx))
 					break out
 				}
+				ctx = withRole(withUsername(ctx, username), role)
 				wsc.authenticated = true
 				resp := makeResponse(req.ID, nil, nil)
 				// Expected to never fail.
@@ -409,12 +584,68 @@ out:
 				break out
 			}
 
+			warnings := resolveMethod(&req)
+
+			if jsonErr := s.checkRole(ctx, req.Method); jsonErr != nil {
+				log.Warnf("RPC method %s refused for %s credentials of client %s",
+					req.Method, roleFromContext(ctx), clientString(ctx))
+				mresp, err := marshalResponse(req.Jsonrpc, req.ID, nil, jsonErr, warnings)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+				continue
+			}
+
+			if jsonErr := s.checkFeature(req.Method); jsonErr != nil {
+				mresp, err := marshalResponse(req.Jsonrpc, req.ID, nil, jsonErr, warnings)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+				continue
+			}
+
+			if _, ok := notificationMethods[req.Method]; ok {
+				jsonErr := s.websocketNotificationRequest(ctx, wsc, &req)
+				mresp, err := marshalResponse(req.Jsonrpc, req.ID, nil, jsonErr, warnings)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+				continue
+			}
+
 			switch req.Method {
+			case "setapiversion":
+				var jsonErr *vhcjson.RPCError
+				ctx, jsonErr = setAPIVersion(ctx, &req)
+				mresp, err := marshalResponse(req.Jsonrpc, req.ID, nil, jsonErr, warnings)
+				// Expected to never fail.
+				if err != nil {
+					panic(err)
+				}
+				err = wsc.send(mresp)
+				if err != nil {
+					break out
+				}
+
 			case "stop":
-				log.Infof("RPC method stop invoked by %s", remoteAddr(ctx))
-				resp := makeResponse(req.ID,
-					"vhcwallet stopping.", nil)
-				mresp, err := json.Marshal(resp)
+				log.Infof("RPC method stop invoked by %s", clientString(ctx))
+				res, jsonErr := s.auditedHandler(ctx, &req, stopHandler)()
+				mresp, err := marshalResponse(req.Jsonrpc, req.ID, res, jsonErr, warnings)
 				// Expected to never fail.
 				if err != nil {
 					panic(err)
@@ -423,16 +654,35 @@ out:
 				if err != nil {
 					break out
 				}
+				if jsonErr != nil {
+					continue
+				}
 				s.requestProcessShutdown()
 				break out
 
 			default:
+				key := s.rateLimitKey(ctx)
+				if !s.limiter.acquire(key, time.Now()) {
+					log.Warnf("Rate limited request from client %s",
+						clientString(ctx))
+					mresp, err := marshalResponse(req.Jsonrpc, req.ID, nil, errRPCBusy, warnings)
+					// Expected to never fail.
+					if err != nil {
+						panic(err)
+					}
+					err = wsc.send(mresp)
+					if err != nil {
+						break out
+					}
+					continue
+				}
 				req := req // Copy for the closure
 				f := s.handlerClosure(ctx, &req)
 				wsc.wg.Add(1)
 				go func() {
+					defer s.limiter.release(key)
 					resp, jsonErr := f()
-					mresp, err := vhcjson.MarshalResponse(req.Jsonrpc, req.ID, resp, jsonErr)
+					mresp, err := marshalResponse(req.Jsonrpc, req.ID, resp, jsonErr, warnings)
 					if err != nil {
 						log.Errorf("Unable to marshal response to client %s: %v",
 							remoteAddr(ctx), err)
@@ -448,7 +698,9 @@ out:
 		}
 	}
 
-	// allow client to disconnect after all handler goroutines are done
+	// allow client to disconnect after all handler and notification
+	// goroutines are done
+	wsc.unsubscribeAll()
 	wsc.wg.Wait()
 	close(wsc.responses)
 	s.wg.Done()
@@ -489,7 +741,7 @@ out:
 // websocketClientRPC starts the goroutines to serve JSON-RPC requests over a
 // websocket connection for a single client.
 func (s *Server) websocketClientRPC(ctx context.Context, wsc *websocketClient) {
-	log.Infof("New websocket client %s", remoteAddr(ctx))
+	log.Infof("New websocket client %s", clientString(ctx))
 
 	// Clear the read deadline set before the websocket hijacked
 	// the connection.
@@ -514,10 +766,10 @@ func (s *Server) websocketClientRPC(ctx context.Context, wsc *websocketClient) {
 // that may be read from a client.  This is currently limited to 4MB.
 const maxRequestSize = 1024 * 1024 * 4
 
-// postClientRPC processes and replies to a JSON-RPC client request.
-func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
-	ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
-
+// postClientRPC processes and replies to a JSON-RPC client request or a batch
+// of requests.  The context records the remote address and role of the
+// authenticated client.
+func (s *Server) postClientRPC(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	body := http.MaxBytesReader(w, r.Body, maxRequestSize)
 	rpcRequest, err := ioutil.ReadAll(body)
 	if err != nil {
@@ -528,6 +780,45 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var mresp []byte
+	var stop bool
+	if isBatchRequest(rpcRequest) {
+		mresp, stop, err = s.postBatchRPC(ctx, r.RemoteAddr, rpcRequest)
+	} else {
+		mresp, stop, err = s.postSingleRPC(ctx, r.RemoteAddr, rpcRequest)
+	}
+	if err != nil {
+		log.Errorf("Unable to marshal response to client %s: %v",
+			r.RemoteAddr, err)
+		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	if mresp == nil {
+		return
+	}
+	_, err = w.Write(mresp)
+	if err != nil {
+		log.Warnf("Failed to write response to client %s: %v",
+			r.RemoteAddr, err)
+	}
+
+	if stop {
+		s.requestProcessShutdown()
+	}
+}
+
+// isBatchRequest returns whether the body of a HTTP POST request is a JSON
+// array of requests.
+func isBatchRequest(rpcRequest []byte) bool {
+	trimmed := bytes.TrimLeft(rpcRequest, " \t\r\n")
+	return len(trimmed) != 0 && trimmed[0] == '['
+}
+
+// postSingleRPC processes a single JSON-RPC request from a HTTP POST client and
+// returns the marshaled response.  A nil response is returned for requests
+// which are dropped without a reply.  The stop return indicates the client
+// requested a shutdown, which must be performed after the response is written.
+func (s *Server) postSingleRPC(ctx context.Context, remoteAddr string, rpcRequest []byte) (mresp []byte, stop bool, err error) {
 	// First check whether wallet has a handler for this request's method.
 	// If unfound, the request is sent to the chain server for further
 	// processing.  While checking the methods, disallow authenticate
@@ -535,58 +826,113 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	var req vhcjson.Request
 	err = json.Unmarshal(rpcRequest, &req)
 	if err != nil {
-		resp, err := vhcjson.MarshalResponse(req.Jsonrpc, req.ID, nil, vhcjson.ErrRPCInvalidRequest)
-		if err != nil {
-			log.Errorf("Unable to marshal response to client %s: %v",
-				r.RemoteAddr, err)
-			http.Error(w, "500 Internal Server Error",
-				http.StatusInternalServerError)
-			return
-		}
-		_, err = w.Write(resp)
-		if err != nil {
-			log.Warnf("Cannot write invalid request request to "+
-				"client %s: %v", r.RemoteAddr, err)
-		}
-		return
+		mresp, err = vhcjson.MarshalResponse(req.Jsonrpc, req.ID, nil, vhcjson.ErrRPCInvalidRequest)
+		return mresp, false, err
 	}
 
 	// Create the response and error from the request.  Two special cases
-	// are handled for the authenticate and stop request methods.
+	// are handled for the authenticate and stop request methods.  Methods
+	// not permitted by the role of the client's credentials are refused.
 	var res interface{}
-	var jsonErr *vhcjson.RPCError
-	var stop bool
-	switch req.Method {
-	case "authenticate":
+	warnings := resolveMethod(&req)
+	jsonErr := s.checkRole(ctx, req.Method)
+	if jsonErr == nil {
+		jsonErr = s.checkFeature(req.Method)
+	}
+	switch {
+	case req.Method == "authenticate":
 		log.Warnf("Invalid RPC method authenticate invoked by HTTP POST client %s",
-			r.RemoteAddr)
+			remoteAddr)
 		// Drop it.
-		return
-	case "stop":
-		log.Infof("RPC method stop invoked by %s", r.RemoteAddr)
-		stop = true
-		res = "vhcwallet stopping"
+		return nil, false, nil
+	case jsonErr != nil:
+		log.Warnf("RPC method %s refused for %s credentials of client %s",
+			req.Method, roleFromContext(ctx), clientString(ctx))
+	case req.Method == "stop":
+		log.Infof("RPC method stop invoked by %s", clientString(ctx))
+		res, jsonErr = s.auditedHandler(ctx, &req, stopHandler)()
+		stop = jsonErr == nil
 	default:
 		res, jsonErr = s.handlerClosure(ctx, &req)()
 	}
 
-	// Marshal and send.
-	mresp, err := vhcjson.MarshalResponse(req.Jsonrpc, req.ID, res, jsonErr)
-	if err != nil {
-		log.Errorf("Unable to marshal response to client %s: %v",
-			r.RemoteAddr, err)
-		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
-		return
+	mresp, err = marshalResponse(req.Jsonrpc, req.ID, res, jsonErr, warnings)
+	return mresp, stop, err
+}
+
+// maxBatchWorkers specifies the maximum number of requests of a single batch
+// which are handled concurrently.
+const maxBatchWorkers = 8
+
+// maxBatchRequests specifies the maximum number of requests of a single batch.
+const maxBatchRequests = 100
+
+// postBatchRPC processes a JSON array of requests from a HTTP POST client.  The
+// requests are handled concurrently by a bounded number of workers, and the
+// responses are marshaled as a JSON array in the order of the requests.
+//
+// Each request of the batch is charged to the rate limit of the client.  The
+// first request is charged when the HTTP request is accepted, and requests
+// exceeding the remaining rate limit are refused with a busy error.
+func (s *Server) postBatchRPC(ctx context.Context, remoteAddr string, rpcRequest []byte) (mresp []byte, stop bool, err error) {
+	var reqs []json.RawMessage
+	err = json.Unmarshal(rpcRequest, &reqs)
+	if err != nil || len(reqs) == 0 {
+		mresp, err = vhcjson.MarshalResponse("", nil, nil, vhcjson.ErrRPCInvalidRequest)
+		return mresp, false, err
 	}
-	_, err = w.Write(mresp)
-	if err != nil {
-		log.Warnf("Failed to write response to client %s: %v",
-			r.RemoteAddr, err)
+	if len(reqs) > maxBatchRequests {
+		log.Warnf("Batch of %d requests from client %s exceeds the maximum "+
+			"of %d", len(reqs), clientString(ctx), maxBatchRequests)
+		mresp, err = vhcjson.MarshalResponse("", nil, nil, rpcErrorf(
+			vhcjson.ErrRPCInvalidRequest.Code, "batch may not exceed %d requests",
+			maxBatchRequests))
+		return mresp, false, err
 	}
 
-	if stop {
-		s.requestProcessShutdown()
+	resps := make([][]byte, len(reqs))
+	stops := make([]bool, len(reqs))
+	errs := make([]error, len(reqs))
+	sem := make(chan struct{}, maxBatchWorkers)
+	var wg sync.WaitGroup
+	key := s.rateLimitKey(ctx)
+	for i := range reqs {
+		if i != 0 && !s.limiter.charge(key, time.Now()) {
+			log.Warnf("Rate limited batch request from client %s",
+				clientString(ctx))
+			var req vhcjson.Request
+			_ = json.Unmarshal(reqs[i], &req)
+			resps[i], errs[i] = marshalResponse(req.Jsonrpc, req.ID, nil, errRPCBusy, nil)
+			continue
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			resps[i], stops[i], errs[i] = s.postSingleRPC(ctx, remoteAddr, reqs[i])
+			<-sem
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, resp := range resps {
+		if errs[i] != nil {
+			return nil, false, errs[i]
+		}
+		stop = stop || stops[i]
+		// Dropped requests are omitted from the batch response.
+		if resp == nil {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(resp)
 	}
+	buf.WriteByte(']')
+	return buf.Bytes(), stop, nil
 }
 
 func (s *Server) requestProcessShutdown() {
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+)
+
+// signedPayload is the JSON object which is signed for a signed response.  It
+// binds the result to the method, parameters, and ID of the request and the
+// time it was handled.
+type signedPayload struct {
+	Method     string          `json:"method"`
+	ParamsHash string          `json:"paramshash"`
+	ID         interface{}     `json:"id"`
+	Time       int64           `json:"time"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// paramsHash returns the hex encoded SHA-256 hash of the canonical encoding of
+// the parameters of a request.  The canonical encoding is the JSON array of
+// the parameters without insignificant whitespace and with the keys of every
+// object sorted, so clients may compute the hash from their own encoding of
+// the request.
+func paramsHash(params []json.RawMessage) (string, error) {
+	canonical := make([]interface{}, len(params))
+	for i, p := range params {
+		dec := json.NewDecoder(bytes.NewReader(p))
+		dec.UseNumber()
+		err := dec.Decode(&canonical[i])
+		if err != nil {
+			return "", err
+		}
+	}
+	b, err := json.Marshal(canonical)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// signResult signs the result of a request with the response signing key of
+// the server.  The signature is a DER encoded secp256k1 ECDSA signature of the
+// SHA-256 hash of the JSON payload, which is returned as a string so clients
+// verify the exact signed bytes before decoding them.
+func (s *Server) signResult(req *vhcjson.Request, result interface{}) (*types.SignedResult, error) {
+	rawResult, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	ph, err := paramsHash(req.Params)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(&signedPayload{
+		Method:     req.Method,
+		ParamsHash: ph,
+		ID:         req.ID,
+		Time:       time.Now().Unix(),
+		Result:     rawResult,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(payload)
+	sig, err := s.signingKey.Sign(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignedResult{
+		Payload:   string(payload),
+		Signature: hex.EncodeToString(sig.Serialize()),
+		PubKey:    hex.EncodeToString(s.signingKey.PubKey().SerializeCompressed()),
+	}, nil
+}
+
+// signedHandler wraps the handler of a request to replace successful results
+// with signed results when responses of the method are signed.
+func (s *Server) signedHandler(ctx context.Context, req *vhcjson.Request, h lazyHandler) lazyHandler {
+	if s.signingKey == nil {
+		return h
+	}
+	if _, ok := s.signedMethods[req.Method]; !ok {
+		return h
+	}
+	return func() (interface{}, *vhcjson.RPCError) {
+		res, jsonErr := h()
+		if jsonErr != nil {
+			return res, jsonErr
+		}
+		signed, err := s.signResult(req, res)
+		if err != nil {
+			log.Errorf("Cannot sign %s response to client %s: %v",
+				req.Method, clientString(ctx), err)
+			return nil, rpcErrorf(vhcjson.ErrRPCInternal.Code,
+				"cannot sign response")
+		}
+		return signed, nil
+	}
+}
+
+// getResponseSigningKey handles a getresponsesigningkey request by returning
+// the public key used to sign responses and the methods which are signed.
+func getResponseSigningKey(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	if s.signingKey == nil {
+		return nil, rpcErrorf(vhcjson.ErrRPCMisc, "response signing is not enabled")
+	}
+	methods := make([]string, 0, len(s.signedMethods))
+	for m := range s.signedMethods {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return &types.GetResponseSigningKeyResult{
+		PubKey:  hex.EncodeToString(s.signingKey.PubKey().SerializeCompressed()),
+		Methods: methods,
+	}, nil
+}
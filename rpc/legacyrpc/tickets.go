@@ -0,0 +1,133 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc/types"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// ticketStatusUnspent is the listticketstatuses status filter matching mined
+// tickets which are not yet voted or revoked.
+const ticketStatusUnspent = "unspent"
+
+// listTicketStatuses handles a listticketstatuses request by returning the
+// status and purchase height of the wallet's tickets, newest first, optionally
+// filtered by status.  Unrevoked tickets are reported as missed when the wallet
+// detected their missed votes, and as live otherwise until they expire.
+func listTicketStatuses(s *Server, ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListTicketStatusesCmd)
+	w, ok := s.walletLoader(ctx).LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var status string
+	if cmd.Status != nil {
+		status = *cmd.Status
+		known := status == ticketStatusUnspent
+		for _, name := range restTicketStatuses {
+			known = known || name == status
+		}
+		if !known {
+			return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+				"unknown ticket status %q", status)
+		}
+	}
+	offset, limit := *cmd.Offset, *cmd.Limit
+	if offset < 0 || limit < 0 {
+		return nil, rpcErrorf(vhcjson.ErrRPCInvalidParameter,
+			"offset and limit may not be negative")
+	}
+
+	missed := make(map[chainhash.Hash]struct{})
+	for _, m := range w.MissedVotes() {
+		missed[m.Ticket] = struct{}{}
+	}
+
+	var tickets []types.TicketStatusResult
+	err := w.GetTickets(func(summaries []*wallet.TicketSummary, header *wire.BlockHeader) (bool, error) {
+		for _, t := range summaries {
+			ticketStatus := t.Status
+			if _, ok := missed[*t.Ticket.Hash]; ok && ticketStatus == wallet.TicketStatusLive {
+				ticketStatus = wallet.TicketStatusMissed
+			}
+			if !ticketStatusMatches(ticketStatus, status) {
+				continue
+			}
+			ticket := types.TicketStatusResult{
+				Hash:   t.Ticket.Hash.String(),
+				Status: restTicketStatuses[ticketStatus],
+				Height: -1,
+			}
+			if header != nil {
+				ticket.Height = int32(header.Height)
+			}
+			if t.Spender != nil {
+				ticket.Spender = t.Spender.Hash.String()
+			}
+			tickets = append(tickets, ticket)
+		}
+		return false, nil
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.ListTicketStatusesResult{
+		Total:   len(tickets),
+		Tickets: []types.TicketStatusResult{},
+	}
+	for i := len(tickets) - 1 - offset; i >= 0 && len(res.Tickets) < limit; i-- {
+		res.Tickets = append(res.Tickets, tickets[i])
+	}
+	return res, nil
+}
+
+// ticketStatusMatches returns whether a ticket status is matched by a
+// listticketstatuses status filter.  The empty filter matches every status.
+func ticketStatusMatches(ticketStatus wallet.TicketStatus, filter string) bool {
+	switch filter {
+	case "":
+		return true
+	case ticketStatusUnspent:
+		switch ticketStatus {
+		case wallet.TicketStatusImmature, wallet.TicketStatusLive,
+			wallet.TicketStatusMissed, wallet.TicketStatusExpired:
+			return true
+		}
+		return false
+	default:
+		return restTicketStatuses[ticketStatus] == filter
+	}
+}
+
+// notifyTickets sends a ticketstatus notification to a websocket client for
+// each ticket of the wallet which becomes live, votes, is missed, expires, or
+// is revoked in a block attached to the main chain.
+func notifyTickets(ctx context.Context, wsc *websocketClient, w *wallet.Wallet, stop <-chan struct{}) {
+	n := w.NtfnServer.TicketNotifications()
+	defer n.Done()
+	for {
+		select {
+		case v := <-n.C:
+			for _, c := range v.Changes {
+				ntfn := types.NewTicketStatusNtfn(c.Ticket.String(),
+					restTicketStatuses[c.Status], c.BlockHash.String(),
+					c.BlockHeight)
+				if wsc.sendNotification(ctx, ntfn) != nil {
+					return
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
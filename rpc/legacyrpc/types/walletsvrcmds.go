@@ -0,0 +1,1574 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package types defines the JSON-RPC commands and results which are
+// implemented by the vhcwallet JSON-RPC server but are not provided by the
+// vhcjson package.  Importing this package registers each command with
+// vhcjson so they may be marshaled, unmarshaled, and described by the help
+// system in the same manner as the vhcjson commands.
+package types
+
+import "github.com/valhallacoin/vhcd/vhcjson"
+
+// ApproveSendCmd defines the approvesend JSON-RPC command.
+type ApproveSendCmd struct {
+	ID         string
+	Passphrase *string
+}
+
+// NewApproveSendCmd returns a new instance which can be used to issue an
+// approvesend JSON-RPC command.
+func NewApproveSendCmd(id string, passphrase *string) *ApproveSendCmd {
+	return &ApproveSendCmd{
+		ID:         id,
+		Passphrase: passphrase,
+	}
+}
+
+// AssignDepositAddressCmd defines the assigndepositaddress JSON-RPC command.
+type AssignDepositAddressCmd struct {
+	Account   string
+	Reference *string
+}
+
+// NewAssignDepositAddressCmd returns a new instance which can be used to issue
+// an assigndepositaddress JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewAssignDepositAddressCmd(account string, reference *string) *AssignDepositAddressCmd {
+	return &AssignDepositAddressCmd{
+		Account:   account,
+		Reference: reference,
+	}
+}
+
+// CancelRescanCmd defines the cancelrescan JSON-RPC command.
+type CancelRescanCmd struct{}
+
+// NewCancelRescanCmd returns a new instance which can be used to issue a
+// cancelrescan JSON-RPC command.
+func NewCancelRescanCmd() *CancelRescanCmd {
+	return &CancelRescanCmd{}
+}
+
+// CancelRevocationCmd defines the cancelrevocation JSON-RPC command.
+type CancelRevocationCmd struct {
+	TicketHash string
+}
+
+// NewCancelRevocationCmd returns a new instance which can be used to issue a
+// cancelrevocation JSON-RPC command.
+func NewCancelRevocationCmd(ticketHash string) *CancelRevocationCmd {
+	return &CancelRevocationCmd{
+		TicketHash: ticketHash,
+	}
+}
+
+// ClearGuardCmd defines the clearguard JSON-RPC command.
+type ClearGuardCmd struct {
+	TrustOrigins *bool `jsonrpcdefault:"false"`
+}
+
+// NewClearGuardCmd returns a new instance which can be used to issue a
+// clearguard JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewClearGuardCmd(trustOrigins *bool) *ClearGuardCmd {
+	return &ClearGuardCmd{
+		TrustOrigins: trustOrigins,
+	}
+}
+
+// ClearUnlockSessionCmd defines the clearunlocksession JSON-RPC command.
+type ClearUnlockSessionCmd struct{}
+
+// NewClearUnlockSessionCmd returns a new instance which can be used to issue a
+// clearunlocksession JSON-RPC command.
+func NewClearUnlockSessionCmd() *ClearUnlockSessionCmd {
+	return &ClearUnlockSessionCmd{}
+}
+
+// CloseWalletCmd defines the closewallet JSON-RPC command.
+type CloseWalletCmd struct{}
+
+// NewCloseWalletCmd returns a new instance which can be used to issue a
+// closewallet JSON-RPC command.
+func NewCloseWalletCmd() *CloseWalletCmd {
+	return &CloseWalletCmd{}
+}
+
+// ConsolidateAccountCmd defines the consolidateaccount JSON-RPC command.
+type ConsolidateAccountCmd struct {
+	Account    string
+	MaxInputs  *int     `jsonrpcdefault:"0"`
+	TargetSize *float64 `jsonrpcdefault:"0"`
+	FeePerKb   *float64
+	Address    *string
+}
+
+// NewConsolidateAccountCmd returns a new instance which can be used to issue a
+// consolidateaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewConsolidateAccountCmd(account string, maxInputs *int, targetSize, feePerKb *float64,
+	address *string) *ConsolidateAccountCmd {
+
+	return &ConsolidateAccountCmd{
+		Account:    account,
+		MaxInputs:  maxInputs,
+		TargetSize: targetSize,
+		FeePerKb:   feePerKb,
+		Address:    address,
+	}
+}
+
+// CreateCosignSessionCmd defines the createcosignsession JSON-RPC command.
+type CreateCosignSessionCmd struct {
+	HexTx string
+}
+
+// NewCreateCosignSessionCmd returns a new instance which can be used to issue a
+// createcosignsession JSON-RPC command.
+func NewCreateCosignSessionCmd(hexTx string) *CreateCosignSessionCmd {
+	return &CreateCosignSessionCmd{
+		HexTx: hexTx,
+	}
+}
+
+// CreateMultisigAccountCmd defines the createmultisigaccount JSON-RPC command.
+type CreateMultisigAccountCmd struct {
+	Name      string
+	Account   string
+	NRequired int
+	Xpubs     []string
+}
+
+// NewCreateMultisigAccountCmd returns a new instance which can be used to issue
+// a createmultisigaccount JSON-RPC command.
+func NewCreateMultisigAccountCmd(name, account string, nRequired int, xpubs []string) *CreateMultisigAccountCmd {
+	return &CreateMultisigAccountCmd{
+		Name:      name,
+		Account:   account,
+		NRequired: nRequired,
+		Xpubs:     xpubs,
+	}
+}
+
+// CreateOwnershipProofCmd defines the createownershipproof JSON-RPC command.
+type CreateOwnershipProofCmd struct {
+	Addresses   []string
+	Challenge   *string  `jsonrpcdefault:"\"\""`
+	MinBalance  *float64 `jsonrpcdefault:"0"`
+	BlockHeight *int32
+}
+
+// NewCreateOwnershipProofCmd returns a new instance which can be used to issue
+// a createownershipproof JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateOwnershipProofCmd(addresses []string, challenge *string, minBalance *float64, blockHeight *int32) *CreateOwnershipProofCmd {
+	return &CreateOwnershipProofCmd{
+		Addresses:   addresses,
+		Challenge:   challenge,
+		MinBalance:  minBalance,
+		BlockHeight: blockHeight,
+	}
+}
+
+// OwnershipProofAddress describes an address of an ownership proof and its
+// signature of the proof.
+type OwnershipProofAddress struct {
+	Address   string `json:"address"`
+	Signature string `json:"signature"`
+}
+
+// OwnershipProofOutput describes an unspent output claimed by an ownership
+// proof.
+type OwnershipProofOutput struct {
+	TxID    string  `json:"txid"`
+	Vout    uint32  `json:"vout"`
+	Tree    int8    `json:"tree"`
+	Amount  float64 `json:"amount"`
+	Address string  `json:"address"`
+}
+
+// OwnershipProof describes the ownership proof created by the
+// createownershipproof JSON-RPC command and verified by the
+// verifyownershipproof JSON-RPC command.
+type OwnershipProof struct {
+	Challenge   string                  `json:"challenge"`
+	BlockHash   string                  `json:"blockhash"`
+	BlockHeight int32                   `json:"blockheight"`
+	Addresses   []OwnershipProofAddress `json:"addresses"`
+	Outputs     []OwnershipProofOutput  `json:"outputs"`
+}
+
+// CreateSplitTicketSessionCmd defines the createsplitticketsession JSON-RPC
+// command.
+type CreateSplitTicketSessionCmd struct {
+	VotingAddress *string
+	Expiry        *int
+}
+
+// NewCreateSplitTicketSessionCmd returns a new instance which can be used to
+// issue a createsplitticketsession JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateSplitTicketSessionCmd(votingAddress *string, expiry *int) *CreateSplitTicketSessionCmd {
+	return &CreateSplitTicketSessionCmd{
+		VotingAddress: votingAddress,
+		Expiry:        expiry,
+	}
+}
+
+// CreateUnsignedTicketsCmd defines the createunsignedtickets JSON-RPC command.
+type CreateUnsignedTicketsCmd struct {
+	FromAccount   string
+	SpendLimit    float64 // In Coins
+	MinConf       *int    `jsonrpcdefault:"1"`
+	TicketAddress *string
+	NumTickets    *int
+	PoolAddress   *string
+	PoolFees      *float64
+	Expiry        *int
+	TicketFee     *float64
+}
+
+// NewCreateUnsignedTicketsCmd returns a new instance which can be used to issue
+// a createunsignedtickets JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateUnsignedTicketsCmd(fromAccount string, spendLimit float64, minConf *int,
+	ticketAddress *string, numTickets *int, poolAddress *string, poolFees *float64,
+	expiry *int, ticketFee *float64) *CreateUnsignedTicketsCmd {
+
+	return &CreateUnsignedTicketsCmd{
+		FromAccount:   fromAccount,
+		SpendLimit:    spendLimit,
+		MinConf:       minConf,
+		TicketAddress: ticketAddress,
+		NumTickets:    numTickets,
+		PoolAddress:   poolAddress,
+		PoolFees:      poolFees,
+		Expiry:        expiry,
+		TicketFee:     ticketFee,
+	}
+}
+
+// CreateWalletCmd defines the createwallet JSON-RPC command.
+type CreateWalletCmd struct {
+	Passphrase string
+	Seed       *string
+	Birthday   *int64
+}
+
+// NewCreateWalletCmd returns a new instance which can be used to issue a
+// createwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateWalletCmd(passphrase string, seed *string, birthday *int64) *CreateWalletCmd {
+	return &CreateWalletCmd{
+		Passphrase: passphrase,
+		Seed:       seed,
+		Birthday:   birthday,
+	}
+}
+
+// DeletePrivKeyCmd defines the deleteprivkey JSON-RPC command.
+type DeletePrivKeyCmd struct {
+	Address     string
+	DropHistory *bool `jsonrpcdefault:"false"`
+}
+
+// NewDeletePrivKeyCmd returns a new instance which can be used to issue a
+// deleteprivkey JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewDeletePrivKeyCmd(address string, dropHistory *bool) *DeletePrivKeyCmd {
+	return &DeletePrivKeyCmd{
+		Address:     address,
+		DropHistory: dropHistory,
+	}
+}
+
+// DeleteScriptCmd defines the deletescript JSON-RPC command.
+type DeleteScriptCmd struct {
+	Script      string
+	DropHistory *bool `jsonrpcdefault:"false"`
+}
+
+// NewDeleteScriptCmd returns a new instance which can be used to issue a
+// deletescript JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewDeleteScriptCmd(script string, dropHistory *bool) *DeleteScriptCmd {
+	return &DeleteScriptCmd{
+		Script:      script,
+		DropHistory: dropHistory,
+	}
+}
+
+// DisableVotingCmd defines the disablevoting JSON-RPC command.
+type DisableVotingCmd struct{}
+
+// NewDisableVotingCmd returns a new instance which can be used to issue a
+// disablevoting JSON-RPC command.
+func NewDisableVotingCmd() *DisableVotingCmd {
+	return &DisableVotingCmd{}
+}
+
+// EnableVotingCmd defines the enablevoting JSON-RPC command.
+type EnableVotingCmd struct{}
+
+// NewEnableVotingCmd returns a new instance which can be used to issue an
+// enablevoting JSON-RPC command.
+func NewEnableVotingCmd() *EnableVotingCmd {
+	return &EnableVotingCmd{}
+}
+
+// ExportVoteChoicesCmd defines the exportvotechoices JSON-RPC command.
+type ExportVoteChoicesCmd struct{}
+
+// NewExportVoteChoicesCmd returns a new instance which can be used to issue an
+// exportvotechoices JSON-RPC command.
+func NewExportVoteChoicesCmd() *ExportVoteChoicesCmd {
+	return &ExportVoteChoicesCmd{}
+}
+
+// FillDepositPoolCmd defines the filldepositpool JSON-RPC command.
+type FillDepositPoolCmd struct {
+	Account string
+	Size    int
+}
+
+// NewFillDepositPoolCmd returns a new instance which can be used to issue a
+// filldepositpool JSON-RPC command.
+func NewFillDepositPoolCmd(account string, size int) *FillDepositPoolCmd {
+	return &FillDepositPoolCmd{
+		Account: account,
+		Size:    size,
+	}
+}
+
+// FinalizeCosignSessionCmd defines the finalizecosignsession JSON-RPC command.
+type FinalizeCosignSessionCmd struct {
+	Session string
+	Publish *bool `jsonrpcdefault:"false"`
+}
+
+// NewFinalizeCosignSessionCmd returns a new instance which can be used to issue
+// a finalizecosignsession JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewFinalizeCosignSessionCmd(session string, publish *bool) *FinalizeCosignSessionCmd {
+	return &FinalizeCosignSessionCmd{
+		Session: session,
+		Publish: publish,
+	}
+}
+
+// GenerateVotesCmd defines the generatevotes JSON-RPC command.
+type GenerateVotesCmd struct {
+	BlockHash    string
+	Height       int64
+	TicketHashes []string
+	VoteBits     uint16
+	VoteBitsExt  string
+}
+
+// NewGenerateVotesCmd returns a new instance which can be used to issue a
+// generatevotes JSON-RPC command.
+func NewGenerateVotesCmd(blockHash string, height int64, ticketHashes []string,
+	voteBits uint16, voteBitsExt string) *GenerateVotesCmd {
+	return &GenerateVotesCmd{
+		BlockHash:    blockHash,
+		Height:       height,
+		TicketHashes: ticketHashes,
+		VoteBits:     voteBits,
+		VoteBitsExt:  voteBitsExt,
+	}
+}
+
+// GetAccountReceivedCmd defines the getaccountreceived JSON-RPC command.
+type GetAccountReceivedCmd struct {
+	Account string
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// NewGetAccountReceivedCmd returns a new instance which can be used to issue a
+// getaccountreceived JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAccountReceivedCmd(account string, minConf *int) *GetAccountReceivedCmd {
+	return &GetAccountReceivedCmd{
+		Account: account,
+		MinConf: minConf,
+	}
+}
+
+// GetAccountStatsCmd defines the getaccountstats JSON-RPC command.
+type GetAccountStatsCmd struct {
+	Account *string `jsonrpcdefault:"\"default\""`
+}
+
+// NewGetAccountStatsCmd returns a new instance which can be used to issue a
+// getaccountstats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAccountStatsCmd(account *string) *GetAccountStatsCmd {
+	return &GetAccountStatsCmd{
+		Account: account,
+	}
+}
+
+// GetBuildInfoCmd defines the getbuildinfo JSON-RPC command.
+type GetBuildInfoCmd struct{}
+
+// NewGetBuildInfoCmd returns a new instance which can be used to issue a
+// getbuildinfo JSON-RPC command.
+func NewGetBuildInfoCmd() *GetBuildInfoCmd {
+	return &GetBuildInfoCmd{}
+}
+
+// GetDBStatsCmd defines the getdbstats JSON-RPC command.
+type GetDBStatsCmd struct{}
+
+// NewGetDBStatsCmd returns a new instance which can be used to issue a
+// getdbstats JSON-RPC command.
+func NewGetDBStatsCmd() *GetDBStatsCmd {
+	return &GetDBStatsCmd{}
+}
+
+// GetFeatureFlagsCmd defines the getfeatureflags JSON-RPC command.
+type GetFeatureFlagsCmd struct{}
+
+// NewGetFeatureFlagsCmd returns a new instance which can be used to issue a
+// getfeatureflags JSON-RPC command.
+func NewGetFeatureFlagsCmd() *GetFeatureFlagsCmd {
+	return &GetFeatureFlagsCmd{}
+}
+
+// GetGuardStatusCmd defines the getguardstatus JSON-RPC command.
+type GetGuardStatusCmd struct{}
+
+// NewGetGuardStatusCmd returns a new instance which can be used to issue a
+// getguardstatus JSON-RPC command.
+func NewGetGuardStatusCmd() *GetGuardStatusCmd {
+	return &GetGuardStatusCmd{}
+}
+
+// GetAuditLogCmd defines the getauditlog JSON-RPC command.
+type GetAuditLogCmd struct {
+	Count *int `jsonrpcdefault:"100"`
+}
+
+// NewGetAuditLogCmd returns a new instance which can be used to issue a
+// getauditlog JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAuditLogCmd(count *int) *GetAuditLogCmd {
+	return &GetAuditLogCmd{
+		Count: count,
+	}
+}
+
+// GetAutoBuyerStatusCmd defines the getautobuyerstatus JSON-RPC command.
+type GetAutoBuyerStatusCmd struct {
+	Account *string
+}
+
+// NewGetAutoBuyerStatusCmd returns a new instance which can be used to issue a
+// getautobuyerstatus JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAutoBuyerStatusCmd(account *string) *GetAutoBuyerStatusCmd {
+	return &GetAutoBuyerStatusCmd{
+		Account: account,
+	}
+}
+
+// GetAutoConsolidationCmd defines the getautoconsolidation JSON-RPC command.
+type GetAutoConsolidationCmd struct {
+	Account *string
+}
+
+// NewGetAutoConsolidationCmd returns a new instance which can be used to issue
+// a getautoconsolidation JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAutoConsolidationCmd(account *string) *GetAutoConsolidationCmd {
+	return &GetAutoConsolidationCmd{
+		Account: account,
+	}
+}
+
+// GetAddressReceivedCmd defines the getaddressreceived JSON-RPC command.
+type GetAddressReceivedCmd struct {
+	Address string
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// NewGetAddressReceivedCmd returns a new instance which can be used to issue a
+// getaddressreceived JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAddressReceivedCmd(address string, minConf *int) *GetAddressReceivedCmd {
+	return &GetAddressReceivedCmd{
+		Address: address,
+		MinConf: minConf,
+	}
+}
+
+// GetAPISchemaCmd defines the getapischema JSON-RPC command.
+type GetAPISchemaCmd struct{}
+
+// NewGetAPISchemaCmd returns a new instance which can be used to issue a
+// getapischema JSON-RPC command.
+func NewGetAPISchemaCmd() *GetAPISchemaCmd {
+	return &GetAPISchemaCmd{}
+}
+
+// GetBalanceAtHashCmd defines the getbalanceathash JSON-RPC command.
+type GetBalanceAtHashCmd struct {
+	BlockHash string
+	Account   *string
+}
+
+// NewGetBalanceAtHashCmd returns a new instance which can be used to issue a
+// getbalanceathash JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetBalanceAtHashCmd(blockHash string, account *string) *GetBalanceAtHashCmd {
+	return &GetBalanceAtHashCmd{
+		BlockHash: blockHash,
+		Account:   account,
+	}
+}
+
+// GetMultisigAccountAddressCmd defines the getmultisigaccountaddress JSON-RPC
+// command.
+type GetMultisigAccountAddressCmd struct {
+	Name     string
+	Internal *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetMultisigAccountAddressCmd returns a new instance which can be used to
+// issue a getmultisigaccountaddress JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMultisigAccountAddressCmd(name string, internal *bool) *GetMultisigAccountAddressCmd {
+	return &GetMultisigAccountAddressCmd{
+		Name:     name,
+		Internal: internal,
+	}
+}
+
+// GetRescanStatusCmd defines the getrescanstatus JSON-RPC command.
+type GetRescanStatusCmd struct{}
+
+// NewGetRescanStatusCmd returns a new instance which can be used to issue a
+// getrescanstatus JSON-RPC command.
+func NewGetRescanStatusCmd() *GetRescanStatusCmd {
+	return &GetRescanStatusCmd{}
+}
+
+// GetResponseSigningKeyCmd defines the getresponsesigningkey JSON-RPC command.
+type GetResponseSigningKeyCmd struct{}
+
+// NewGetResponseSigningKeyCmd returns a new instance which can be used to
+// issue a getresponsesigningkey JSON-RPC command.
+func NewGetResponseSigningKeyCmd() *GetResponseSigningKeyCmd {
+	return &GetResponseSigningKeyCmd{}
+}
+
+// GetSpendingPolicyCmd defines the getspendingpolicy JSON-RPC command.
+type GetSpendingPolicyCmd struct {
+	Account string
+}
+
+// NewGetSpendingPolicyCmd returns a new instance which can be used to issue a
+// getspendingpolicy JSON-RPC command.
+func NewGetSpendingPolicyCmd(account string) *GetSpendingPolicyCmd {
+	return &GetSpendingPolicyCmd{
+		Account: account,
+	}
+}
+
+// GetStakingStatsCmd defines the getstakingstats JSON-RPC command.
+type GetStakingStatsCmd struct {
+	StartTime *int64
+	EndTime   *int64
+}
+
+// NewGetStakingStatsCmd returns a new instance which can be used to issue a
+// getstakingstats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetStakingStatsCmd(startTime, endTime *int64) *GetStakingStatsCmd {
+	return &GetStakingStatsCmd{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+// GetSyncStatusCmd defines the getsyncstatus JSON-RPC command.
+type GetSyncStatusCmd struct{}
+
+// NewGetSyncStatusCmd returns a new instance which can be used to issue a
+// getsyncstatus JSON-RPC command.
+func NewGetSyncStatusCmd() *GetSyncStatusCmd {
+	return &GetSyncStatusCmd{}
+}
+
+// GetTicketInfoCmd defines the getticketinfo JSON-RPC command.
+type GetTicketInfoCmd struct {
+	TicketHash string
+}
+
+// NewGetTicketInfoCmd returns a new instance which can be used to issue a
+// getticketinfo JSON-RPC command.
+func NewGetTicketInfoCmd(ticketHash string) *GetTicketInfoCmd {
+	return &GetTicketInfoCmd{
+		TicketHash: ticketHash,
+	}
+}
+
+// GetVSPInfoCmd defines the getvspinfo JSON-RPC command.
+type GetVSPInfoCmd struct{}
+
+// NewGetVSPInfoCmd returns a new instance which can be used to issue a
+// getvspinfo JSON-RPC command.
+func NewGetVSPInfoCmd() *GetVSPInfoCmd {
+	return &GetVSPInfoCmd{}
+}
+
+// ImportMultiRequest describes a single key or script imported by the
+// importmulti JSON-RPC command.  Exactly one of PrivKey, PubKey, Xpub, and
+// RedeemScript must be set.
+type ImportMultiRequest struct {
+	PrivKey      string   `json:"privkey,omitempty"`
+	PubKey       string   `json:"pubkey,omitempty"`
+	Xpub         string   `json:"xpub,omitempty"`
+	RedeemScript string   `json:"redeemscript,omitempty"`
+	Range        []uint32 `json:"range,omitempty"`
+	Timestamp    *int64   `json:"timestamp,omitempty"`
+	WatchOnly    bool     `json:"watchonly,omitempty"`
+}
+
+// ImportMultiCmd defines the importmulti JSON-RPC command.
+type ImportMultiCmd struct {
+	Requests []ImportMultiRequest
+	Rescan   *bool `jsonrpcdefault:"true"`
+}
+
+// NewImportMultiCmd returns a new instance which can be used to issue an
+// importmulti JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewImportMultiCmd(requests []ImportMultiRequest, rescan *bool) *ImportMultiCmd {
+	return &ImportMultiCmd{
+		Requests: requests,
+		Rescan:   rescan,
+	}
+}
+
+// ImportPKCS11AccountCmd defines the importpkcs11account JSON-RPC command.
+type ImportPKCS11AccountCmd struct {
+	Account string
+	XPub    string
+}
+
+// NewImportPKCS11AccountCmd returns a new instance which can be used to issue
+// an importpkcs11account JSON-RPC command.
+func NewImportPKCS11AccountCmd(account, xpub string) *ImportPKCS11AccountCmd {
+	return &ImportPKCS11AccountCmd{
+		Account: account,
+		XPub:    xpub,
+	}
+}
+
+// ImportVoteChoicesCmd defines the importvotechoices JSON-RPC command.
+type ImportVoteChoicesCmd struct {
+	Document string
+}
+
+// NewImportVoteChoicesCmd returns a new instance which can be used to issue an
+// importvotechoices JSON-RPC command.
+func NewImportVoteChoicesCmd(document string) *ImportVoteChoicesCmd {
+	return &ImportVoteChoicesCmd{
+		Document: document,
+	}
+}
+
+// JoinSplitTicketSessionCmd defines the joinsplitticketsession JSON-RPC
+// command.
+type JoinSplitTicketSessionCmd struct {
+	Session string
+	Account string
+	Amount  *float64
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// NewJoinSplitTicketSessionCmd returns a new instance which can be used to
+// issue a joinsplitticketsession JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewJoinSplitTicketSessionCmd(session, account string, amount *float64, minConf *int) *JoinSplitTicketSessionCmd {
+	return &JoinSplitTicketSessionCmd{
+		Session: session,
+		Account: account,
+		Amount:  amount,
+		MinConf: minConf,
+	}
+}
+
+// ListDepositAddressesCmd defines the listdepositaddresses JSON-RPC command.
+type ListDepositAddressesCmd struct {
+	Account *string
+	Status  *string
+}
+
+// NewListDepositAddressesCmd returns a new instance which can be used to issue
+// a listdepositaddresses JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListDepositAddressesCmd(account, status *string) *ListDepositAddressesCmd {
+	return &ListDepositAddressesCmd{
+		Account: account,
+		Status:  status,
+	}
+}
+
+// ListMultisigAccountsCmd defines the listmultisigaccounts JSON-RPC command.
+type ListMultisigAccountsCmd struct{}
+
+// NewListMultisigAccountsCmd returns a new instance which can be used to issue
+// a listmultisigaccounts JSON-RPC command.
+func NewListMultisigAccountsCmd() *ListMultisigAccountsCmd {
+	return &ListMultisigAccountsCmd{}
+}
+
+// ListMultisigUnspentCmd defines the listmultisigunspent JSON-RPC command.
+type ListMultisigUnspentCmd struct {
+	Address *string
+}
+
+// NewListMultisigUnspentCmd returns a new instance which can be used to issue
+// a listmultisigunspent JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListMultisigUnspentCmd(address *string) *ListMultisigUnspentCmd {
+	return &ListMultisigUnspentCmd{
+		Address: address,
+	}
+}
+
+// ListMissedVotesCmd defines the listmissedvotes JSON-RPC command.
+type ListMissedVotesCmd struct{}
+
+// NewListMissedVotesCmd returns a new instance which can be used to issue a
+// listmissedvotes JSON-RPC command.
+func NewListMissedVotesCmd() *ListMissedVotesCmd {
+	return &ListMissedVotesCmd{}
+}
+
+// ListOutpointLocksCmd defines the listoutpointlocks JSON-RPC command.
+type ListOutpointLocksCmd struct {
+	Namespace *string
+}
+
+// NewListOutpointLocksCmd returns a new instance which can be used to issue a
+// listoutpointlocks JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListOutpointLocksCmd(namespace *string) *ListOutpointLocksCmd {
+	return &ListOutpointLocksCmd{
+		Namespace: namespace,
+	}
+}
+
+// ListPendingRevocationsCmd defines the listpendingrevocations JSON-RPC
+// command.
+type ListPendingRevocationsCmd struct{}
+
+// NewListPendingRevocationsCmd returns a new instance which can be used to
+// issue a listpendingrevocations JSON-RPC command.
+func NewListPendingRevocationsCmd() *ListPendingRevocationsCmd {
+	return &ListPendingRevocationsCmd{}
+}
+
+// ListPendingSendsCmd defines the listpendingsends JSON-RPC command.
+type ListPendingSendsCmd struct {
+	Account *string
+}
+
+// NewListPendingSendsCmd returns a new instance which can be used to issue a
+// listpendingsends JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListPendingSendsCmd(account *string) *ListPendingSendsCmd {
+	return &ListPendingSendsCmd{
+		Account: account,
+	}
+}
+
+// ListRevocableTicketsCmd defines the listrevocabletickets JSON-RPC command.
+type ListRevocableTicketsCmd struct{}
+
+// NewListRevocableTicketsCmd returns a new instance which can be used to issue
+// a listrevocabletickets JSON-RPC command.
+func NewListRevocableTicketsCmd() *ListRevocableTicketsCmd {
+	return &ListRevocableTicketsCmd{}
+}
+
+// ListSplitTicketsCmd defines the listsplittickets JSON-RPC command.
+type ListSplitTicketsCmd struct{}
+
+// NewListSplitTicketsCmd returns a new instance which can be used to issue a
+// listsplittickets JSON-RPC command.
+func NewListSplitTicketsCmd() *ListSplitTicketsCmd {
+	return &ListSplitTicketsCmd{}
+}
+
+// ListStakePoolUserTicketsCmd defines the liststakepoolusertickets JSON-RPC
+// command.
+type ListStakePoolUserTicketsCmd struct {
+	User   string
+	Status *string
+	After  *string
+	Limit  *int `jsonrpcdefault:"100"`
+}
+
+// NewListStakePoolUserTicketsCmd returns a new instance which can be used to
+// issue a liststakepoolusertickets JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListStakePoolUserTicketsCmd(user string, status, after *string, limit *int) *ListStakePoolUserTicketsCmd {
+	return &ListStakePoolUserTicketsCmd{
+		User:   user,
+		Status: status,
+		After:  after,
+		Limit:  limit,
+	}
+}
+
+// ListTicketStatusesCmd defines the listticketstatuses JSON-RPC command.
+type ListTicketStatusesCmd struct {
+	Status *string
+	Offset *int `jsonrpcdefault:"0"`
+	Limit  *int `jsonrpcdefault:"100"`
+}
+
+// NewListTicketStatusesCmd returns a new instance which can be used to issue a
+// listticketstatuses JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListTicketStatusesCmd(status *string, offset, limit *int) *ListTicketStatusesCmd {
+	return &ListTicketStatusesCmd{
+		Status: status,
+		Offset: offset,
+		Limit:  limit,
+	}
+}
+
+// ListUnspentPageCmd defines the listunspentpage JSON-RPC command.
+type ListUnspentPageCmd struct {
+	MinConf   *int `jsonrpcdefault:"1"`
+	MaxConf   *int `jsonrpcdefault:"9999999"`
+	Addresses *[]string
+	Account   *string `jsonrpcdefault:"\"*\""`
+	After     *string `jsonrpcdefault:"\"\""`
+	Limit     *int    `jsonrpcdefault:"100"`
+}
+
+// NewListUnspentPageCmd returns a new instance which can be used to issue a
+// listunspentpage JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListUnspentPageCmd(minConf, maxConf *int, addresses *[]string, account *string,
+	after *string, limit *int) *ListUnspentPageCmd {
+
+	return &ListUnspentPageCmd{
+		MinConf:   minConf,
+		MaxConf:   maxConf,
+		Addresses: addresses,
+		Account:   account,
+		After:     after,
+		Limit:     limit,
+	}
+}
+
+// ListWalletsCmd defines the listwallets JSON-RPC command.
+type ListWalletsCmd struct{}
+
+// NewListWalletsCmd returns a new instance which can be used to issue a
+// listwallets JSON-RPC command.
+func NewListWalletsCmd() *ListWalletsCmd {
+	return &ListWalletsCmd{}
+}
+
+// LockUnspentNamespaceCmd defines the lockunspentnamespace JSON-RPC command.
+type LockUnspentNamespaceCmd struct {
+	Namespace    string
+	Unlock       bool
+	Transactions []vhcjson.TransactionInput
+	TTL          *int64 `jsonrpcdefault:"0"`
+	Persistent   *bool  `jsonrpcdefault:"false"`
+	Blocks       *int32 `jsonrpcdefault:"0"`
+}
+
+// NewLockUnspentNamespaceCmd returns a new instance which can be used to issue
+// a lockunspentnamespace JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewLockUnspentNamespaceCmd(namespace string, unlock bool, transactions []vhcjson.TransactionInput,
+	ttl *int64, persistent *bool, blocks *int32) *LockUnspentNamespaceCmd {
+
+	return &LockUnspentNamespaceCmd{
+		Namespace:    namespace,
+		Unlock:       unlock,
+		Transactions: transactions,
+		TTL:          ttl,
+		Persistent:   persistent,
+		Blocks:       blocks,
+	}
+}
+
+// MergeCosignSessionsCmd defines the mergecosignsessions JSON-RPC command.
+type MergeCosignSessionsCmd struct {
+	Sessions []string
+}
+
+// NewMergeCosignSessionsCmd returns a new instance which can be used to issue a
+// mergecosignsessions JSON-RPC command.
+func NewMergeCosignSessionsCmd(sessions []string) *MergeCosignSessionsCmd {
+	return &MergeCosignSessionsCmd{
+		Sessions: sessions,
+	}
+}
+
+// MoveFundsCmd defines the movefunds JSON-RPC command.
+type MoveFundsCmd struct {
+	FromAccount string
+	ToAccount   string
+	Amount      float64
+	MinConf     *int `jsonrpcdefault:"1"`
+}
+
+// NewMoveFundsCmd returns a new instance which can be used to issue a movefunds
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewMoveFundsCmd(fromAccount, toAccount string, amount float64, minConf *int) *MoveFundsCmd {
+	return &MoveFundsCmd{
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+		Amount:      amount,
+		MinConf:     minConf,
+	}
+}
+
+// OpenWalletCmd defines the openwallet JSON-RPC command.
+type OpenWalletCmd struct {
+	PublicPassphrase *string
+}
+
+// NewOpenWalletCmd returns a new instance which can be used to issue an
+// openwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewOpenWalletCmd(publicPassphrase *string) *OpenWalletCmd {
+	return &OpenWalletCmd{
+		PublicPassphrase: publicPassphrase,
+	}
+}
+
+// OverrideSpendingPolicyCmd defines the overridespendingpolicy JSON-RPC
+// command.
+type OverrideSpendingPolicyCmd struct {
+	Account    string
+	Passphrase string
+	Timeout    int64
+}
+
+// NewOverrideSpendingPolicyCmd returns a new instance which can be used to
+// issue an overridespendingpolicy JSON-RPC command.
+func NewOverrideSpendingPolicyCmd(account, passphrase string, timeout int64) *OverrideSpendingPolicyCmd {
+	return &OverrideSpendingPolicyCmd{
+		Account:    account,
+		Passphrase: passphrase,
+		Timeout:    timeout,
+	}
+}
+
+// PublishSplitTicketSessionCmd defines the publishsplitticketsession JSON-RPC
+// command.
+type PublishSplitTicketSessionCmd struct {
+	Session string
+}
+
+// NewPublishSplitTicketSessionCmd returns a new instance which can be used to
+// issue a publishsplitticketsession JSON-RPC command.
+func NewPublishSplitTicketSessionCmd(session string) *PublishSplitTicketSessionCmd {
+	return &PublishSplitTicketSessionCmd{
+		Session: session,
+	}
+}
+
+// RedeemMultisigAccountCmd defines the redeemmultisigaccount JSON-RPC command.
+type RedeemMultisigAccountCmd struct {
+	Name      string
+	ToAddress *string
+	FeeRate   *float64
+	Batch     *int `jsonrpcdefault:"20"`
+}
+
+// NewRedeemMultisigAccountCmd returns a new instance which can be used to issue
+// a redeemmultisigaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewRedeemMultisigAccountCmd(name string, to *string, feeRate *float64, batch *int) *RedeemMultisigAccountCmd {
+	return &RedeemMultisigAccountCmd{
+		Name:      name,
+		ToAddress: to,
+		FeeRate:   feeRate,
+		Batch:     batch,
+	}
+}
+
+// RedeemMultiSigOutsBatchCmd defines the redeemmultisigoutsbatch JSON-RPC
+// command.
+type RedeemMultiSigOutsBatchCmd struct {
+	FromScrAddress string
+	ToAddress      *string
+	Number         *int
+	FeeRate        *float64
+	Batch          *int `jsonrpcdefault:"20"`
+}
+
+// NewRedeemMultiSigOutsBatchCmd returns a new instance which can be used to
+// issue a redeemmultisigoutsbatch JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewRedeemMultiSigOutsBatchCmd(from string, to *string, number *int, feeRate *float64, batch *int) *RedeemMultiSigOutsBatchCmd {
+	return &RedeemMultiSigOutsBatchCmd{
+		FromScrAddress: from,
+		ToAddress:      to,
+		Number:         number,
+		FeeRate:        feeRate,
+		Batch:          batch,
+	}
+}
+
+// RejectSendCmd defines the rejectsend JSON-RPC command.
+type RejectSendCmd struct {
+	ID string
+}
+
+// NewRejectSendCmd returns a new instance which can be used to issue a
+// rejectsend JSON-RPC command.
+func NewRejectSendCmd(id string) *RejectSendCmd {
+	return &RejectSendCmd{
+		ID: id,
+	}
+}
+
+// ReleaseOutputsCmd defines the releaseoutputs JSON-RPC command.
+type ReleaseOutputsCmd struct {
+	ID string
+}
+
+// NewReleaseOutputsCmd returns a new instance which can be used to issue a
+// releaseoutputs JSON-RPC command.
+func NewReleaseOutputsCmd(id string) *ReleaseOutputsCmd {
+	return &ReleaseOutputsCmd{
+		ID: id,
+	}
+}
+
+// ReserveOutputsCmd defines the reserveoutputs JSON-RPC command.
+type ReserveOutputsCmd struct {
+	Account string
+	Amount  float64
+	MinConf *int   `jsonrpcdefault:"1"`
+	TTL     *int64 `jsonrpcdefault:"300"`
+}
+
+// NewReserveOutputsCmd returns a new instance which can be used to issue a
+// reserveoutputs JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewReserveOutputsCmd(account string, amount float64, minConf *int, ttl *int64) *ReserveOutputsCmd {
+	return &ReserveOutputsCmd{
+		Account: account,
+		Amount:  amount,
+		MinConf: minConf,
+		TTL:     ttl,
+	}
+}
+
+// RotateAccountCmd defines the rotateaccount JSON-RPC command.
+type RotateAccountCmd struct {
+	Account    string
+	NewAccount *string
+	MaxInputs  *int `jsonrpcdefault:"20"`
+}
+
+// NewRotateAccountCmd returns a new instance which can be used to issue a
+// rotateaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewRotateAccountCmd(account string, newAccount *string, maxInputs *int) *RotateAccountCmd {
+	return &RotateAccountCmd{
+		Account:    account,
+		NewAccount: newAccount,
+		MaxInputs:  maxInputs,
+	}
+}
+
+// SearchWalletCmd defines the searchwallet JSON-RPC command.
+type SearchWalletCmd struct {
+	Query string
+	Count *int `jsonrpcdefault:"100"`
+}
+
+// NewSearchWalletCmd returns a new instance which can be used to issue a
+// searchwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSearchWalletCmd(query string, count *int) *SearchWalletCmd {
+	return &SearchWalletCmd{
+		Query: query,
+		Count: count,
+	}
+}
+
+// SendSweepAccountCmd defines the sendsweepaccount JSON-RPC command.
+type SendSweepAccountCmd struct {
+	SourceAccount         string
+	DestinationAddress    string
+	RequiredConfirmations *uint32
+	FeePerKb              *float64
+	IncludeLocked         *bool    `jsonrpcdefault:"false"`
+	DustThreshold         *float64 `jsonrpcdefault:"0"`
+}
+
+// NewSendSweepAccountCmd returns a new instance which can be used to issue a
+// sendsweepaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendSweepAccountCmd(sourceAccount, destinationAddress string, requiredConfs *uint32,
+	feePerKb *float64, includeLocked *bool, dustThreshold *float64) *SendSweepAccountCmd {
+
+	return &SendSweepAccountCmd{
+		SourceAccount:         sourceAccount,
+		DestinationAddress:    destinationAddress,
+		RequiredConfirmations: requiredConfs,
+		FeePerKb:              feePerKb,
+		IncludeLocked:         includeLocked,
+		DustThreshold:         dustThreshold,
+	}
+}
+
+// SetAccountGapPolicyCmd defines the setaccountgappolicy JSON-RPC command.
+type SetAccountGapPolicyCmd struct {
+	Account   string
+	GapPolicy string
+}
+
+// NewSetAccountGapPolicyCmd returns a new instance which can be used to issue a
+// setaccountgappolicy JSON-RPC command.
+func NewSetAccountGapPolicyCmd(account, gapPolicy string) *SetAccountGapPolicyCmd {
+	return &SetAccountGapPolicyCmd{
+		Account:   account,
+		GapPolicy: gapPolicy,
+	}
+}
+
+// SetAutoConsolidationCmd defines the setautoconsolidation JSON-RPC command.
+type SetAutoConsolidationCmd struct {
+	Account       string
+	Threshold     int
+	MaxInputs     *int     `jsonrpcdefault:"0"`
+	MaxBlockUsage *float64 `jsonrpcdefault:"0.5"`
+}
+
+// NewSetAutoConsolidationCmd returns a new instance which can be used to issue
+// a setautoconsolidation JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetAutoConsolidationCmd(account string, threshold int, maxInputs *int,
+	maxBlockUsage *float64) *SetAutoConsolidationCmd {
+
+	return &SetAutoConsolidationCmd{
+		Account:       account,
+		Threshold:     threshold,
+		MaxInputs:     maxInputs,
+		MaxBlockUsage: maxBlockUsage,
+	}
+}
+
+// SetSendApprovalCmd defines the setsendapproval JSON-RPC command.
+type SetSendApprovalCmd struct {
+	Account           string
+	Passphrase        string
+	CurrentPassphrase *string
+}
+
+// NewSetSendApprovalCmd returns a new instance which can be used to issue a
+// setsendapproval JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetSendApprovalCmd(account, passphrase string, currentPassphrase *string) *SetSendApprovalCmd {
+	return &SetSendApprovalCmd{
+		Account:           account,
+		Passphrase:        passphrase,
+		CurrentPassphrase: currentPassphrase,
+	}
+}
+
+// SetSpendingPolicyCmd defines the setspendingpolicy JSON-RPC command.
+type SetSpendingPolicyCmd struct {
+	Account                   string
+	TxLimit                   float64
+	DailyLimit                float64
+	OverridePassphrase        *string
+	CurrentOverridePassphrase *string
+}
+
+// NewSetSpendingPolicyCmd returns a new instance which can be used to issue a
+// setspendingpolicy JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetSpendingPolicyCmd(account string, txLimit, dailyLimit float64,
+	overridePassphrase, currentOverridePassphrase *string) *SetSpendingPolicyCmd {
+
+	return &SetSpendingPolicyCmd{
+		Account:                   account,
+		TxLimit:                   txLimit,
+		DailyLimit:                dailyLimit,
+		OverridePassphrase:        overridePassphrase,
+		CurrentOverridePassphrase: currentOverridePassphrase,
+	}
+}
+
+// SetUnlockSessionTimeoutCmd defines the setunlocksessiontimeout JSON-RPC
+// command.
+type SetUnlockSessionTimeoutCmd struct {
+	Timeout int64
+}
+
+// NewSetUnlockSessionTimeoutCmd returns a new instance which can be used to
+// issue a setunlocksessiontimeout JSON-RPC command.
+func NewSetUnlockSessionTimeoutCmd(timeout int64) *SetUnlockSessionTimeoutCmd {
+	return &SetUnlockSessionTimeoutCmd{
+		Timeout: timeout,
+	}
+}
+
+// SetUTXOPolicyCmd defines the setutxopolicy JSON-RPC command.
+type SetUTXOPolicyCmd struct {
+	Txid     string
+	Vout     uint32
+	Policies []string
+}
+
+// NewSetUTXOPolicyCmd returns a new instance which can be used to issue a
+// setutxopolicy JSON-RPC command.
+func NewSetUTXOPolicyCmd(txid string, vout uint32, policies []string) *SetUTXOPolicyCmd {
+	return &SetUTXOPolicyCmd{
+		Txid:     txid,
+		Vout:     vout,
+		Policies: policies,
+	}
+}
+
+// SetVSPCmd defines the setvsp JSON-RPC command.
+type SetVSPCmd struct {
+	Host   string
+	PubKey *string
+}
+
+// NewSetVSPCmd returns a new instance which can be used to issue a setvsp
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetVSPCmd(host string, pubKey *string) *SetVSPCmd {
+	return &SetVSPCmd{
+		Host:   host,
+		PubKey: pubKey,
+	}
+}
+
+// SignCosignSessionCmd defines the signcosignsession JSON-RPC command.
+type SignCosignSessionCmd struct {
+	Session string
+}
+
+// NewSignCosignSessionCmd returns a new instance which can be used to issue a
+// signcosignsession JSON-RPC command.
+func NewSignCosignSessionCmd(session string) *SignCosignSessionCmd {
+	return &SignCosignSessionCmd{
+		Session: session,
+	}
+}
+
+// SignSplitTicketSessionCmd defines the signsplitticketsession JSON-RPC
+// command.
+type SignSplitTicketSessionCmd struct {
+	Session string
+}
+
+// NewSignSplitTicketSessionCmd returns a new instance which can be used to
+// issue a signsplitticketsession JSON-RPC command.
+func NewSignSplitTicketSessionCmd(session string) *SignSplitTicketSessionCmd {
+	return &SignSplitTicketSessionCmd{
+		Session: session,
+	}
+}
+
+// StakeHistoryCmd defines the stakehistory JSON-RPC command.
+type StakeHistoryCmd struct {
+	Count *int `jsonrpcdefault:"100"`
+}
+
+// NewStakeHistoryCmd returns a new instance which can be used to issue a
+// stakehistory JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewStakeHistoryCmd(count *int) *StakeHistoryCmd {
+	return &StakeHistoryCmd{
+		Count: count,
+	}
+}
+
+// StopAccountAutoBuyerCmd defines the stopaccountautobuyer JSON-RPC command.
+type StopAccountAutoBuyerCmd struct {
+	Account string
+}
+
+// NewStopAccountAutoBuyerCmd returns a new instance which can be used to issue
+// a stopaccountautobuyer JSON-RPC command.
+func NewStopAccountAutoBuyerCmd(account string) *StopAccountAutoBuyerCmd {
+	return &StopAccountAutoBuyerCmd{
+		Account: account,
+	}
+}
+
+// SweepDustCmd defines the sweepdust JSON-RPC command.
+type SweepDustCmd struct {
+	Account   string
+	Threshold *float64 `jsonrpcdefault:"0"`
+	FeePerKb  *float64
+}
+
+// NewSweepDustCmd returns a new instance which can be used to issue a sweepdust
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSweepDustCmd(account string, threshold, feePerKb *float64) *SweepDustCmd {
+	return &SweepDustCmd{
+		Account:   account,
+		Threshold: threshold,
+		FeePerKb:  feePerKb,
+	}
+}
+
+// VerifyOwnershipProofCmd defines the verifyownershipproof JSON-RPC command.
+type VerifyOwnershipProofCmd struct {
+	Proof      OwnershipProof
+	MinBalance *float64 `jsonrpcdefault:"0"`
+}
+
+// NewVerifyOwnershipProofCmd returns a new instance which can be used to issue
+// a verifyownershipproof JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewVerifyOwnershipProofCmd(proof OwnershipProof, minBalance *float64) *VerifyOwnershipProofCmd {
+	return &VerifyOwnershipProofCmd{
+		Proof:      proof,
+		MinBalance: minBalance,
+	}
+}
+
+// WalletExistsCmd defines the walletexists JSON-RPC command.
+type WalletExistsCmd struct{}
+
+// NewWalletExistsCmd returns a new instance which can be used to issue a
+// walletexists JSON-RPC command.
+func NewWalletExistsCmd() *WalletExistsCmd {
+	return &WalletExistsCmd{}
+}
+
+// WatchOutPointCmd defines the watchoutpoint JSON-RPC command.
+type WatchOutPointCmd struct {
+	TxID string
+	Vout uint32
+	Tree *int8 `jsonrpcdefault:"0"`
+}
+
+// NewWatchOutPointCmd returns a new instance which can be used to issue a
+// watchoutpoint JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewWatchOutPointCmd(txID string, vout uint32, tree *int8) *WatchOutPointCmd {
+	return &WatchOutPointCmd{
+		TxID: txID,
+		Vout: vout,
+		Tree: tree,
+	}
+}
+
+// WatchScriptCmd defines the watchscript JSON-RPC command.
+type WatchScriptCmd struct {
+	Hex string
+}
+
+// NewWatchScriptCmd returns a new instance which can be used to issue a
+// watchscript JSON-RPC command.
+func NewWatchScriptCmd(hexScript string) *WatchScriptCmd {
+	return &WatchScriptCmd{
+		Hex: hexScript,
+	}
+}
+
+func init() {
+	// The commands in this file are only usable with a wallet server.
+	flags := vhcjson.UFWalletOnly
+
+	vhcjson.MustRegisterCmd("approvesend", (*ApproveSendCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("assigndepositaddress", (*AssignDepositAddressCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("cancelrescan", (*CancelRescanCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("cancelrevocation", (*CancelRevocationCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("clearguard", (*ClearGuardCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("clearunlocksession", (*ClearUnlockSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("closewallet", (*CloseWalletCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("consolidateaccount", (*ConsolidateAccountCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("createcosignsession", (*CreateCosignSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("createmultisigaccount", (*CreateMultisigAccountCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("createownershipproof", (*CreateOwnershipProofCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("createsplitticketsession", (*CreateSplitTicketSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("createunsignedtickets", (*CreateUnsignedTicketsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("createwallet", (*CreateWalletCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("deleteprivkey", (*DeletePrivKeyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("deletescript", (*DeleteScriptCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("disablevoting", (*DisableVotingCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("enablevoting", (*EnableVotingCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("exportvotechoices", (*ExportVoteChoicesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("filldepositpool", (*FillDepositPoolCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("finalizecosignsession", (*FinalizeCosignSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("generatevotes", (*GenerateVotesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getaccountreceived", (*GetAccountReceivedCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getaccountstats", (*GetAccountStatsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getaddressreceived", (*GetAddressReceivedCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getapischema", (*GetAPISchemaCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getauditlog", (*GetAuditLogCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getautobuyerstatus", (*GetAutoBuyerStatusCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getautoconsolidation", (*GetAutoConsolidationCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getbalanceathash", (*GetBalanceAtHashCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getbuildinfo", (*GetBuildInfoCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getdbstats", (*GetDBStatsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getfeatureflags", (*GetFeatureFlagsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getguardstatus", (*GetGuardStatusCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getmultisigaccountaddress", (*GetMultisigAccountAddressCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getrescanstatus", (*GetRescanStatusCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getresponsesigningkey", (*GetResponseSigningKeyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getspendingpolicy", (*GetSpendingPolicyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getstakingstats", (*GetStakingStatsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getsyncstatus", (*GetSyncStatusCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getticketinfo", (*GetTicketInfoCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("getvspinfo", (*GetVSPInfoCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("importmulti", (*ImportMultiCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("importpkcs11account", (*ImportPKCS11AccountCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("importvotechoices", (*ImportVoteChoicesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("joinsplitticketsession", (*JoinSplitTicketSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listdepositaddresses", (*ListDepositAddressesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listmissedvotes", (*ListMissedVotesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listmultisigaccounts", (*ListMultisigAccountsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listmultisigunspent", (*ListMultisigUnspentCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listoutpointlocks", (*ListOutpointLocksCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listpendingrevocations", (*ListPendingRevocationsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listpendingsends", (*ListPendingSendsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listrevocabletickets", (*ListRevocableTicketsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listsplittickets", (*ListSplitTicketsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("liststakepoolusertickets", (*ListStakePoolUserTicketsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listticketstatuses", (*ListTicketStatusesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listunspentpage", (*ListUnspentPageCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("listwallets", (*ListWalletsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("lockunspentnamespace", (*LockUnspentNamespaceCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("mergecosignsessions", (*MergeCosignSessionsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("movefunds", (*MoveFundsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("openwallet", (*OpenWalletCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("overridespendingpolicy", (*OverrideSpendingPolicyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("publishsplitticketsession", (*PublishSplitTicketSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("redeemmultisigaccount", (*RedeemMultisigAccountCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("redeemmultisigoutsbatch", (*RedeemMultiSigOutsBatchCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("rejectsend", (*RejectSendCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("releaseoutputs", (*ReleaseOutputsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("reserveoutputs", (*ReserveOutputsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("rotateaccount", (*RotateAccountCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("searchwallet", (*SearchWalletCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("sendsweepaccount", (*SendSweepAccountCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setaccountgappolicy", (*SetAccountGapPolicyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setautoconsolidation", (*SetAutoConsolidationCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setsendapproval", (*SetSendApprovalCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setspendingpolicy", (*SetSpendingPolicyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setunlocksessiontimeout", (*SetUnlockSessionTimeoutCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setutxopolicy", (*SetUTXOPolicyCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setvsp", (*SetVSPCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("signcosignsession", (*SignCosignSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("signsplitticketsession", (*SignSplitTicketSessionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stakehistory", (*StakeHistoryCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopaccountautobuyer", (*StopAccountAutoBuyerCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("sweepdust", (*SweepDustCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("verifyownershipproof", (*VerifyOwnershipProofCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("walletexists", (*WalletExistsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("watchoutpoint", (*WatchOutPointCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("watchscript", (*WatchScriptCmd)(nil), flags)
+}
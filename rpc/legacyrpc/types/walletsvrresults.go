@@ -0,0 +1,756 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package types
+
+import "github.com/valhallacoin/vhcd/vhcjson"
+
+// ReceivedResult models the data returned by the getaddressreceived and
+// getaccountreceived commands.
+type ReceivedResult struct {
+	Confirmed float64 `json:"confirmed"`
+	Pending   float64 `json:"pending"`
+	Total     float64 `json:"total"`
+}
+
+// GetAccountStatsResult models the data returned from the getaccountstats
+// command.
+type GetAccountStatsResult struct {
+	Account           string `json:"account"`
+	AccountNumber     uint32 `json:"accountnumber"`
+	GapPolicy         string `json:"gappolicy"`
+	GapLimit          uint32 `json:"gaplimit"`
+	NextExternalIndex uint32 `json:"nextexternalindex"`
+	NextInternalIndex uint32 `json:"nextinternalindex"`
+	ExternalGap       uint32 `json:"externalgap"`
+	InternalGap       uint32 `json:"internalgap"`
+	KeyStorage        string `json:"keystorage"`
+}
+
+// DecodeScriptResult models the data returned from the decodescript command.
+type DecodeScriptResult struct {
+	Asm       string   `json:"asm"`
+	ReqSigs   int32    `json:"reqSigs,omitempty"`
+	Type      string   `json:"type"`
+	Addresses []string `json:"addresses,omitempty"`
+	P2sh      string   `json:"p2sh,omitempty"`
+	IsMine    bool     `json:"ismine"`
+}
+
+// DepositAddressResult models the data of an address reserved for deposits
+// returned by the filldepositpool, assigndepositaddress, and
+// listdepositaddresses commands.
+type DepositAddressResult struct {
+	Account   string `json:"account"`
+	Address   string `json:"address"`
+	Index     uint32 `json:"index"`
+	Status    string `json:"status"`
+	Created   int64  `json:"created"`
+	Assigned  int64  `json:"assigned,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// GenerateVotesResult models the vote created for a ticket, or the error
+// creating it, returned by the generatevotes command.
+type GenerateVotesResult struct {
+	TicketHash string `json:"tickethash"`
+	Hex        string `json:"hex,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// GetAutoConsolidationResult models the data returned by the getautoconsolidation
+// command.
+type GetAutoConsolidationResult struct {
+	Accounts []AutoConsolidationResult `json:"accounts"`
+}
+
+// AutoConsolidationResult models the automatic consolidation of the outputs of
+// a single account returned by the getautoconsolidation command.
+type AutoConsolidationResult struct {
+	Account         string  `json:"account"`
+	Threshold       int     `json:"threshold"`
+	MaxInputs       int     `json:"maxinputs"`
+	MaxBlockUsage   float64 `json:"maxblockusage"`
+	Outputs         int     `json:"outputs"`
+	EvaluatedHeight int32   `json:"evaluatedheight,omitempty"`
+	Consolidations  int     `json:"consolidations"`
+	LastTx          string  `json:"lasttx,omitempty"`
+	LastTxHeight    int32   `json:"lasttxheight,omitempty"`
+	LastError       string  `json:"lasterror,omitempty"`
+	LastErrorHeight int32   `json:"lasterrorheight,omitempty"`
+}
+
+// GetBalanceAtHashResult models the data returned from the getbalanceathash
+// command.
+type GetBalanceAtHashResult struct {
+	BlockHash string                          `json:"blockhash"`
+	Height    int32                           `json:"height"`
+	Balances  []GetAccountBalanceAtHashResult `json:"balances"`
+	Total     float64                         `json:"total"`
+}
+
+// GetAccountBalanceAtHashResult models the balance of a single account as of
+// the block queried by the getbalanceathash command.
+type GetAccountBalanceAtHashResult struct {
+	AccountName string  `json:"accountname"`
+	Total       float64 `json:"total"`
+}
+
+// GetSpendingPolicyResult models the data returned from the getspendingpolicy
+// command.
+type GetSpendingPolicyResult struct {
+	Account        string  `json:"account"`
+	TxLimit        float64 `json:"txlimit"`
+	DailyLimit     float64 `json:"dailylimit"`
+	DailySpent     float64 `json:"dailyspent"`
+	DailyRemaining float64 `json:"dailyremaining"`
+	Overridable    bool    `json:"overridable"`
+	Overridden     bool    `json:"overridden"`
+}
+
+// GetStakingStatsResult models the data returned from the getstakingstats
+// command.
+type GetStakingStatsResult struct {
+	StartTime        int64   `json:"starttime"`
+	EndTime          int64   `json:"endtime"`
+	TicketsBought    int     `json:"ticketsbought"`
+	TicketCost       float64 `json:"ticketcost"`
+	Votes            int     `json:"votes"`
+	VoteRewards      float64 `json:"voterewards"`
+	Revocations      int     `json:"revocations"`
+	Fees             float64 `json:"fees"`
+	AnnualizedReturn float64 `json:"annualizedreturn"`
+}
+
+// GetRescanStatusResult models the data returned from the getrescanstatus
+// command.
+type GetRescanStatusResult struct {
+	Rescanning          bool    `json:"rescanning"`
+	StartHeight         int32   `json:"startheight"`
+	TargetHeight        int32   `json:"targetheight"`
+	ScannedThrough      int32   `json:"scannedthrough"`
+	Percent             float64 `json:"percent"`
+	AddressesDiscovered int     `json:"addressesdiscovered"`
+	Started             int64   `json:"started"`
+	Error               string  `json:"error,omitempty"`
+}
+
+// GetSyncStatusResult models the data returned from the getsyncstatus command.
+type GetSyncStatusResult struct {
+	Synced             bool   `json:"synced"`
+	TipHash            string `json:"tiphash"`
+	TipHeight          int32  `json:"tipheight"`
+	BackendHeight      int32  `json:"backendheight"`
+	HeadersFetched     int32  `json:"headersfetched"`
+	CFiltersFetched    int32  `json:"cfiltersfetched"`
+	RescanPoint        string `json:"rescanpoint,omitempty"`
+	RescanPointHeight  int32  `json:"rescanpointheight,omitempty"`
+	Rescanning         bool   `json:"rescanning"`
+	RescannedThrough   int32  `json:"rescannedthrough,omitempty"`
+	EstimatedRemaining int64  `json:"estimatedremaining"`
+}
+
+// GetPeerInfoResult models the data of a peer the wallet synchronizes with
+// using SPV, returned by the getpeerinfo command.
+type GetPeerInfoResult struct {
+	ID              uint64   `json:"id"`
+	Addr            string   `json:"addr"`
+	Services        string   `json:"services"`
+	ServiceNames    string   `json:"servicenames"`
+	Version         uint32   `json:"version"`
+	ProtocolVersion uint32   `json:"protocolversion"`
+	SubVer          string   `json:"subver"`
+	ConnTime        int64    `json:"conntime"`
+	StartingHeight  int32    `json:"startingheight"`
+	BanScore        uint32   `json:"banscore"`
+	Capabilities    []string `json:"capabilities"`
+}
+
+// ImportMultiResult models the outcome of a single request of the importmulti
+// command.
+type ImportMultiResult struct {
+	Success   bool     `json:"success"`
+	Addresses []string `json:"addresses"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// ListStakePoolUserTicketsResult models the data returned by the
+// liststakepoolusertickets command.
+type ListStakePoolUserTicketsResult struct {
+	Tickets []vhcjson.PoolUserTicket `json:"tickets"`
+	Next    string                   `json:"next,omitempty"`
+}
+
+// ListTicketStatusesResult models the data returned by the listticketstatuses
+// command.
+type ListTicketStatusesResult struct {
+	Total   int                  `json:"total"`
+	Tickets []TicketStatusResult `json:"tickets"`
+}
+
+// TicketStatusResult models the status of a ticket returned by the
+// listticketstatuses command.
+type TicketStatusResult struct {
+	Hash    string `json:"hash"`
+	Status  string `json:"status"`
+	Height  int32  `json:"height"`
+	Spender string `json:"spender,omitempty"`
+}
+
+// ScriptInfo models a redeem script returned by the listscripts command,
+// including the metadata recorded when the script was imported.
+type ScriptInfo struct {
+	Hash160      string `json:"hash160"`
+	Address      string `json:"address"`
+	RedeemScript string `json:"redeemscript"`
+	ImportTime   int64  `json:"importtime,omitempty"`
+	Origin       string `json:"origin,omitempty"`
+	User         string `json:"user,omitempty"`
+	RescanFrom   *int32 `json:"rescanfrom,omitempty"`
+	Unspent      bool   `json:"unspent"`
+}
+
+// ListScriptsResult models the data returned by the listscripts command.
+type ListScriptsResult struct {
+	Scripts []ScriptInfo `json:"scripts"`
+}
+
+// MultisigAccountResult models a multisig account returned by the
+// listmultisigaccounts command.
+type MultisigAccountResult struct {
+	Name         string   `json:"name"`
+	Account      string   `json:"account"`
+	M            uint8    `json:"m"`
+	N            int      `json:"n"`
+	Xpubs        []string `json:"xpubs"`
+	NextExternal uint32   `json:"nextexternal"`
+	NextInternal uint32   `json:"nextinternal"`
+}
+
+// GetMultisigAccountAddressResult models the data returned by the
+// getmultisigaccountaddress command.
+type GetMultisigAccountAddressResult struct {
+	Address      string `json:"address"`
+	RedeemScript string `json:"redeemscript"`
+	Branch       uint32 `json:"branch"`
+	Index        uint32 `json:"index"`
+}
+
+// ListMultisigUnspentResult models an unspent P2SH multisig output returned by
+// the listmultisigunspent command.
+type ListMultisigUnspentResult struct {
+	TxHash        string   `json:"txhash"`
+	Vout          uint32   `json:"vout"`
+	Tree          int8     `json:"tree"`
+	Address       string   `json:"address"`
+	RedeemScript  string   `json:"redeemscript"`
+	M             uint8    `json:"m"`
+	N             uint8    `json:"n"`
+	Pubkeys       []string `json:"pubkeys"`
+	Amount        float64  `json:"amount"`
+	BlockHash     string   `json:"blockhash,omitempty"`
+	BlockHeight   int32    `json:"blockheight,omitempty"`
+	Confirmations int32    `json:"confirmations"`
+}
+
+// MissedVoteResult models the data of a ticket whose vote was missed, returned
+// by the listmissedvotes command.
+type MissedVoteResult struct {
+	TicketHash  string `json:"tickethash"`
+	BlockHash   string `json:"blockhash"`
+	BlockHeight int32  `json:"blockheight"`
+	Detected    int64  `json:"detected"`
+}
+
+// PendingRevocationResult models the data of a missed ticket whose automatic
+// revocation is pending, returned by the listpendingrevocations command.
+type PendingRevocationResult struct {
+	TicketHash string `json:"tickethash"`
+	Reported   int64  `json:"reported"`
+	Scheduled  int64  `json:"scheduled"`
+}
+
+// RevocableTicketResult models the data of a missed or expired ticket which
+// may be revoked, returned by the listrevocabletickets command.
+type RevocableTicketResult struct {
+	TicketHash  string  `json:"tickethash"`
+	Status      string  `json:"status"`
+	BlockHeight int32   `json:"blockheight"`
+	Fee         float64 `json:"fee"`
+}
+
+// SearchWalletResult models a wallet record matched by the searchwallet
+// command.
+type SearchWalletResult struct {
+	Kind        string `json:"kind"`
+	TxID        string `json:"txid,omitempty"`
+	BlockHeight int32  `json:"blockheight,omitempty"`
+	Time        int64  `json:"time,omitempty"`
+	Address     string `json:"address,omitempty"`
+	Account     string `json:"account,omitempty"`
+	Reference   string `json:"reference,omitempty"`
+}
+
+// CosignSessionResult models the data returned by the createcosignsession,
+// signcosignsession, and mergecosignsessions commands.
+type CosignSessionResult struct {
+	Session    string `json:"session"`
+	Signatures []int  `json:"signatures"`
+	Required   []int  `json:"required"`
+	Complete   bool   `json:"complete"`
+	Added      int    `json:"added,omitempty"`
+}
+
+// FinalizeCosignSessionResult models the data returned by the
+// finalizecosignsession command.
+type FinalizeCosignSessionResult struct {
+	Hex       string `json:"hex"`
+	TxHash    string `json:"txhash"`
+	Published bool   `json:"published"`
+}
+
+// SplitTicketSessionResult models the data returned by the
+// createsplitticketsession, joinsplitticketsession, and signsplitticketsession
+// commands.
+type SplitTicketSessionResult struct {
+	Session     string  `json:"session"`
+	TicketPrice float64 `json:"ticketprice"`
+	Unfunded    float64 `json:"unfunded"`
+	TicketHash  string  `json:"tickethash,omitempty"`
+}
+
+// SplitTicketResult models a split ticket co-funded by the wallet returned by
+// the listsplittickets command.
+type SplitTicketResult struct {
+	TicketHash   string  `json:"tickethash"`
+	Account      string  `json:"account"`
+	Status       string  `json:"status"`
+	TicketPrice  float64 `json:"ticketprice"`
+	Contribution float64 `json:"contribution"`
+	Share        float64 `json:"share"`
+	Returned     float64 `json:"returned,omitempty"`
+	Reward       float64 `json:"reward,omitempty"`
+}
+
+// CreateUnsignedTicketsResult models the data returned from the
+// createunsignedtickets command.
+type CreateUnsignedTicketsResult struct {
+	SplitTx     string   `json:"splittx"`
+	Tickets     []string `json:"tickets"`
+	TicketPrice float64  `json:"ticketprice"`
+}
+
+// CreateWalletResult models the data returned from the createwallet command.
+// The seed is only returned when it was generated by the wallet.
+type CreateWalletResult struct {
+	Seed     string `json:"seed,omitempty"`
+	Mnemonic string `json:"mnemonic,omitempty"`
+}
+
+// OpenWalletResult models the data returned from the openwallet command.
+type OpenWalletResult struct {
+	WatchingOnly bool `json:"watchingonly"`
+}
+
+// OutpointLockResult models a locked outpoint returned by the
+// listoutpointlocks command.  Expires is the Unix time the lock is released,
+// or omitted for locks which do not expire.  ExpiryHeight is the main chain
+// height the lock is released at, or omitted for locks which are not released
+// at a height.
+type OutpointLockResult struct {
+	Txid         string `json:"txid"`
+	Vout         uint32 `json:"vout"`
+	Tree         int8   `json:"tree"`
+	Namespace    string `json:"namespace"`
+	Expires      int64  `json:"expires,omitempty"`
+	ExpiryHeight int32  `json:"expiryheight,omitempty"`
+	Persistent   bool   `json:"persistent"`
+}
+
+// ReserveOutputsResult models the data returned from the reserveoutputs
+// command.
+type ReserveOutputsResult struct {
+	ID      string                 `json:"id"`
+	Outputs []ReservedOutputResult `json:"outputs"`
+	Total   float64                `json:"total"`
+	Expires int64                  `json:"expires"`
+}
+
+// ReservedOutputResult models an output of a ReserveOutputsResult.
+type ReservedOutputResult struct {
+	Txid         string  `json:"txid"`
+	Vout         uint32  `json:"vout"`
+	Tree         int8    `json:"tree"`
+	Amount       float64 `json:"amount"`
+	ScriptPubKey string  `json:"scriptPubKey"`
+}
+
+// RotateAccountResult models the data returned from the rotateaccount
+// command.
+type RotateAccountResult struct {
+	Account          string  `json:"account"`
+	Successor        string  `json:"successor"`
+	RotatedTime      int64   `json:"rotatedtime"`
+	SweepTxHash      string  `json:"sweeptxhash,omitempty"`
+	RemainingBalance float64 `json:"remainingbalance"`
+}
+
+// ListPendingSendsResult models the data of a single send queued by the wallet
+// for approval returned from the listpendingsends command.
+type ListPendingSendsResult struct {
+	ID        string             `json:"id"`
+	Account   string             `json:"account"`
+	Amounts   map[string]float64 `json:"amounts"`
+	Total     float64            `json:"total"`
+	MinConf   int32              `json:"minconf"`
+	Time      int64              `json:"time"`
+	Requester string             `json:"requester"`
+}
+
+// ListUnspentResult models the unspent outputs returned by the listunspent and
+// listunspentpage commands.  It extends vhcjson.ListUnspentResult with the
+// classification of outputs of stake transactions.
+type ListUnspentResult struct {
+	TxID          string  `json:"txid"`
+	Vout          uint32  `json:"vout"`
+	Tree          int8    `json:"tree"`
+	TxType        int     `json:"txtype"`
+	Address       string  `json:"address"`
+	Account       string  `json:"account"`
+	ScriptPubKey  string  `json:"scriptPubKey"`
+	RedeemScript  string  `json:"redeemScript,omitempty"`
+	Amount        float64 `json:"amount"`
+	Confirmations int64   `json:"confirmations"`
+	Spendable     bool    `json:"spendable"`
+	StakeOutput   string  `json:"stakeoutput,omitempty"`
+	TicketStatus  string  `json:"ticketstatus,omitempty"`
+	StakeLocked   bool    `json:"stakelocked"`
+}
+
+// ListWalletsResult models a wallet returned by the listwallets command.
+type ListWalletsResult struct {
+	Name   string `json:"name"`
+	Loaded bool   `json:"loaded"`
+}
+
+// GetAuditLogResult models a record of the audit log returned by the
+// getauditlog command.
+type GetAuditLogResult struct {
+	Seq      uint64   `json:"seq"`
+	Time     int64    `json:"time"`
+	Client   string   `json:"client"`
+	Role     string   `json:"role"`
+	Wallet   string   `json:"wallet,omitempty"`
+	Method   string   `json:"method"`
+	Params   []string `json:"params,omitempty"`
+	Request  uint64   `json:"request,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	PrevHash string   `json:"prevhash"`
+	Hash     string   `json:"hash"`
+}
+
+// GetAutoBuyerStatusResult models the data returned by the getautobuyerstatus
+// command.
+type GetAutoBuyerStatusResult struct {
+	Running    bool                      `json:"running"`
+	Strategies []AutoBuyerStrategyResult `json:"strategies"`
+}
+
+// AutoBuyerStrategyResult models the status of the ticket buyer of a single
+// account returned by the getautobuyerstatus command.
+type AutoBuyerStrategyResult struct {
+	Config          AutoBuyerConfig `json:"config"`
+	Purchased       int             `json:"purchased"`
+	Spent           float64         `json:"spent"`
+	LastError       string          `json:"lasterror,omitempty"`
+	LastErrorHeight int64           `json:"lasterrorheight,omitempty"`
+	NextHeight      int64           `json:"nextheight"`
+}
+
+// AutoBuyerConfig models the effective configuration of a running ticket
+// buyer strategy returned by the getautobuyerstatus command.
+type AutoBuyerConfig struct {
+	Account           string  `json:"account"`
+	BalanceToMaintain float64 `json:"balancetomaintain"`
+	MaxFee            float64 `json:"maxfee"`
+	MaxPriceAbsolute  float64 `json:"maxpriceabsolute"`
+	MaxPriceRelative  float64 `json:"maxpricerelative"`
+	MaxPerBlock       int     `json:"maxperblock"`
+	MaxSpend          float64 `json:"maxspend"`
+	SpendWindow       int     `json:"spendwindow"`
+	VotingAddress     string  `json:"votingaddress,omitempty"`
+	PoolAddress       string  `json:"pooladdress,omitempty"`
+	PoolFees          float64 `json:"poolfees,omitempty"`
+}
+
+// GetBuildInfoResult models the data returned from the getbuildinfo command.
+type GetBuildInfoResult struct {
+	Version         string   `json:"version"`
+	Commit          string   `json:"commit,omitempty"`
+	BuildTags       []string `json:"buildtags"`
+	GoVersion       string   `json:"goversion"`
+	Platform        string   `json:"platform"`
+	EnabledFeatures []string `json:"enabledfeatures"`
+}
+
+// GetFeatureFlagsResult models a feature flag returned by the getfeatureflags
+// command.
+type GetFeatureFlagsResult struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// GetGuardStatusResult models the data returned by the getguardstatus command.
+type GetGuardStatusResult struct {
+	Triggered          bool               `json:"triggered"`
+	PassphraseFailures int                `json:"passphrasefailures"`
+	Alerts             []GuardAlertResult `json:"alerts"`
+}
+
+// GuardAlertResult models a trigger fired by the anomaly guard returned by the
+// getguardstatus command.
+type GuardAlertResult struct {
+	Time    int64  `json:"time"`
+	Trigger string `json:"trigger"`
+	Client  string `json:"client"`
+	Detail  string `json:"detail"`
+}
+
+// DatabaseInfo models the storage statistics of the wallet database returned
+// by the getinfo and walletinfo commands.
+type DatabaseInfo struct {
+	Path        string  `json:"path,omitempty"`
+	Size        int64   `json:"size"`
+	FreeSpace   *uint64 `json:"freespace,omitempty"`
+	WriteErrors uint64  `json:"writeerrors"`
+}
+
+// GetDBStatsResult models the data returned by the getdbstats command.
+type GetDBStatsResult struct {
+	Database *DatabaseInfo       `json:"database,omitempty"`
+	Buckets  []BucketStatsResult `json:"buckets"`
+}
+
+// BucketStatsResult models the storage statistics of a bucket of the wallet
+// database returned by the getdbstats command.
+type BucketStatsResult struct {
+	Name       string `json:"name"`
+	Keys       int    `json:"keys"`
+	Buckets    int    `json:"buckets"`
+	Depth      int    `json:"depth"`
+	InuseBytes int    `json:"inusebytes"`
+	AllocBytes int    `json:"allocbytes"`
+}
+
+// InfoWalletResult models the data returned by the getinfo command.  It
+// extends the vhcjson result with the storage statistics of the wallet
+// database.
+type InfoWalletResult struct {
+	Version         int32         `json:"version"`
+	ProtocolVersion int32         `json:"protocolversion"`
+	WalletVersion   int32         `json:"walletversion"`
+	Balance         float64       `json:"balance"`
+	Blocks          int32         `json:"blocks"`
+	TimeOffset      int64         `json:"timeoffset"`
+	Connections     int32         `json:"connections"`
+	Proxy           string        `json:"proxy"`
+	Difficulty      float64       `json:"difficulty"`
+	TestNet         bool          `json:"testnet"`
+	KeypoolOldest   int64         `json:"keypoololdest"`
+	KeypoolSize     int32         `json:"keypoolsize"`
+	UnlockedUntil   int64         `json:"unlocked_until"`
+	PaytxFee        float64       `json:"paytxfee"`
+	RelayFee        float64       `json:"relayfee"`
+	Errors          string        `json:"errors"`
+	Database        *DatabaseInfo `json:"database,omitempty"`
+}
+
+// WalletInfoResult models the data returned by the walletinfo command.  It
+// extends the vhcjson result with the storage statistics of the wallet
+// database.
+type WalletInfoResult struct {
+	DaemonConnected  bool                  `json:"daemonconnected"`
+	Unlocked         bool                  `json:"unlocked"`
+	TxFee            float64               `json:"txfee"`
+	TicketFee        float64               `json:"ticketfee"`
+	TicketPurchasing bool                  `json:"ticketpurchasing"`
+	VoteBits         uint16                `json:"votebits"`
+	VoteBitsExtended string                `json:"votebitsextended"`
+	VoteVersion      uint32                `json:"voteversion"`
+	Voting           bool                  `json:"voting"`
+	Database         *DatabaseInfo         `json:"database,omitempty"`
+	GapRecoveries    *GapRecoveryInfo      `json:"gaprecoveries,omitempty"`
+	ConsistencyCheck *ConsistencyCheckInfo `json:"consistencycheck"`
+}
+
+// ConsistencyCheckInfo models the result of the database consistency check
+// performed when the wallet was opened, returned by the walletinfo command.
+type ConsistencyCheckInfo struct {
+	Mode         string `json:"mode"`
+	TipHash      string `json:"tiphash"`
+	TipHeight    int32  `json:"tipheight"`
+	Blocks       int    `json:"blocks,omitempty"`
+	Transactions int    `json:"transactions,omitempty"`
+	Credits      int    `json:"credits,omitempty"`
+	Accounts     int    `json:"accounts,omitempty"`
+	DurationMs   int64  `json:"durationms"`
+}
+
+// GapRecoveryInfo models the counts of catch-up syncs performed after block
+// notifications from the consensus RPC server were missed, returned by the
+// walletinfo command.
+type GapRecoveryInfo struct {
+	Recoveries      uint64 `json:"recoveries"`
+	RecoveredBlocks uint64 `json:"recoveredblocks"`
+	Failures        uint64 `json:"failures"`
+}
+
+// APISchemaInfo describes the API documented by an OpenRPC schema.
+type APISchemaInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// GetAPISchemaResult models the OpenRPC document returned by the getapischema
+// command.  Methods holds the JSON array of OpenRPC method objects.
+type GetAPISchemaResult struct {
+	OpenRPC string        `json:"openrpc"`
+	Info    APISchemaInfo `json:"info"`
+	Methods interface{}   `json:"methods"`
+}
+
+// GetResponseSigningKeyResult models the data returned by the
+// getresponsesigningkey command.
+type GetResponseSigningKeyResult struct {
+	PubKey  string   `json:"pubkey"`
+	Methods []string `json:"methods"`
+}
+
+// SignedResult models the result of a method whose responses are signed.  The
+// payload is the JSON encoding of an object with the method, the SHA-256 hash
+// of the canonical encoding of the params, the id, time, and result of the
+// request.  The signature is a hex encoded DER secp256k1 ECDSA
+// signature of the SHA-256 hash of the payload.
+type SignedResult struct {
+	Payload   string `json:"payload"`
+	Signature string `json:"signature"`
+	PubKey    string `json:"pubkey"`
+}
+
+// VoteChoicesDocument models the agenda choices of a wallet returned by the
+// exportvotechoices command and accepted by the importvotechoices command.
+type VoteChoicesDocument struct {
+	Version uint32         `json:"version"`
+	Choices []AgendaChoice `json:"choices"`
+}
+
+// AgendaChoice models the choice of a single agenda in a VoteChoicesDocument.
+type AgendaChoice struct {
+	AgendaID string `json:"agendaid"`
+	ChoiceID string `json:"choiceid"`
+}
+
+// GetTicketInfoResult models the data returned by the getticketinfo command.
+type GetTicketInfoResult struct {
+	TicketHash  string             `json:"tickethash"`
+	Status      string             `json:"status"`
+	BlockHash   string             `json:"blockhash,omitempty"`
+	BlockHeight int32              `json:"blockheight"`
+	SpenderHash string             `json:"spenderhash,omitempty"`
+	Votes       []VoteRecordResult `json:"votes"`
+
+	VotingXpubIndex *uint32 `json:"votingxpubindex,omitempty"`
+}
+
+// GetVSPInfoResult models the data returned by the getvspinfo command.
+type GetVSPInfoResult struct {
+	Host          string            `json:"host"`
+	PubKey        string            `json:"pubkey"`
+	Network       string            `json:"network"`
+	APIVersions   []int             `json:"apiversions"`
+	FeePercentage float64           `json:"feepercentage"`
+	FeeXPub       string            `json:"feexpub"`
+	VSPClosed     bool              `json:"vspclosed"`
+	Tickets       []VSPTicketResult `json:"tickets"`
+}
+
+// VSPTicketResult models the registration of a ticket with a VSP returned by
+// the getvspinfo command.
+type VSPTicketResult struct {
+	TicketHash string  `json:"tickethash"`
+	Status     string  `json:"status"`
+	FeeAddress string  `json:"feeaddress,omitempty"`
+	FeeAmount  float64 `json:"feeamount,omitempty"`
+	FeeTxHash  string  `json:"feetxhash,omitempty"`
+}
+
+// VoteRecordResult models the record of a vote created by the wallet returned
+// by the getticketinfo and stakehistory commands.
+type VoteRecordResult struct {
+	TicketHash  string         `json:"tickethash"`
+	VoteHash    string         `json:"votehash"`
+	BlockHash   string         `json:"blockhash"`
+	BlockHeight int32          `json:"blockheight"`
+	Time        int64          `json:"time"`
+	VoteBits    uint16         `json:"votebits"`
+	VoteBitsExt string         `json:"votebitsext"`
+	VoteVersion uint32         `json:"voteversion"`
+	Choices     []AgendaChoice `json:"choices"`
+}
+
+// SignedTransaction models the result of signing, and optionally sending, a
+// single transaction of the signrawtransactions command.  Error describes why
+// the transaction could not be signed or sent.
+type SignedTransaction struct {
+	SigningResult vhcjson.SignRawTransactionResult `json:"signingresult"`
+	Sent          bool                             `json:"sent"`
+	TxHash        *string                          `json:"txhash,omitempty"`
+	Error         string                           `json:"error,omitempty"`
+}
+
+// SignRawTransactionsResult models the data returned from the
+// signrawtransactions command.
+type SignRawTransactionsResult struct {
+	Results []SignedTransaction `json:"results"`
+}
+
+// SweepAccountResult models the data returned from the sweepaccount command.
+// It extends the sweepaccount result of vhcjson, which describes the first
+// sweep transaction, with the additional transactions required to sweep
+// accounts with more outputs than fit in a single transaction.
+type SweepAccountResult struct {
+	UnsignedTransaction       string                       `json:"unsignedtransaction"`
+	TotalPreviousOutputAmount float64                      `json:"totalpreviousoutputamount"`
+	TotalOutputAmount         float64                      `json:"totaloutputamount"`
+	EstimatedSignedSize       uint32                       `json:"estimatedsignedsize"`
+	AdditionalTransactions    []vhcjson.SweepAccountResult `json:"additionaltransactions,omitempty"`
+}
+
+// SweepDustResult models the data returned from the sweepdust command.
+type SweepDustResult struct {
+	Inputs    int      `json:"inputs"`
+	DustValue float64  `json:"dustvalue"`
+	Fee       float64  `json:"fee"`
+	Reclaimed float64  `json:"reclaimed"`
+	TxHashes  []string `json:"txhashes"`
+}
+
+// SendErrorData models the data member of the error object of methods which
+// fail to author or publish a transaction.
+type SendErrorData struct {
+	Stage        string  `json:"stage"`
+	Inputs       int     `json:"inputs"`
+	Required     float64 `json:"required"`
+	Available    float64 `json:"available"`
+	RejectReason string  `json:"rejectreason,omitempty"`
+	Retryable    bool    `json:"retryable"`
+}
+
+// VerifyOwnershipProofResult models the data returned from the
+// verifyownershipproof command.
+type VerifyOwnershipProofResult struct {
+	Valid   bool    `json:"valid"`
+	Balance float64 `json:"balance"`
+}
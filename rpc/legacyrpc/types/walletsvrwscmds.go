@@ -0,0 +1,317 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package types
+
+import "github.com/valhallacoin/vhcd/vhcjson"
+
+// DepositAddressNtfnMethod is the method of notifications sent to websocket
+// clients when an address is reserved for deposits.
+const DepositAddressNtfnMethod = "depositaddress"
+
+// MissedVoteNtfnMethod is the method of notifications sent to websocket
+// clients when a ticket selected to vote is detected to have missed its vote.
+const MissedVoteNtfnMethod = "missedvote"
+
+// PendingRevocationNtfnMethod is the method of notifications sent to websocket
+// clients when the automatic revocation of a missed ticket is delayed.
+const PendingRevocationNtfnMethod = "pendingrevocation"
+
+// RescanProgressNtfnMethod is the method of notifications sent to websocket
+// clients as a rescan of the wallet progresses and when it ends.
+const RescanProgressNtfnMethod = "rescanprogress"
+
+// TicketStatusNtfnMethod is the method of notifications sent to websocket
+// clients when a ticket of the wallet becomes live, votes, is missed, expires,
+// or is revoked.
+const TicketStatusNtfnMethod = "ticketstatus"
+
+// VoteVersionNtfnMethod is the method of notifications sent to websocket
+// clients when the votes cast by the wallet become outdated or compatible with
+// the stake version of the network.
+const VoteVersionNtfnMethod = "voteversion"
+
+// NotifyDepositAddressesCmd defines the notifydepositaddresses JSON-RPC
+// command.
+type NotifyDepositAddressesCmd struct{}
+
+// NewNotifyDepositAddressesCmd returns a new instance which can be used to
+// issue a notifydepositaddresses JSON-RPC command.
+func NewNotifyDepositAddressesCmd() *NotifyDepositAddressesCmd {
+	return &NotifyDepositAddressesCmd{}
+}
+
+// StopNotifyDepositAddressesCmd defines the stopnotifydepositaddresses JSON-RPC
+// command.
+type StopNotifyDepositAddressesCmd struct{}
+
+// NewStopNotifyDepositAddressesCmd returns a new instance which can be used to
+// issue a stopnotifydepositaddresses JSON-RPC command.
+func NewStopNotifyDepositAddressesCmd() *StopNotifyDepositAddressesCmd {
+	return &StopNotifyDepositAddressesCmd{}
+}
+
+// NotifyMissedVotesCmd defines the notifymissedvotes JSON-RPC command.
+type NotifyMissedVotesCmd struct{}
+
+// NewNotifyMissedVotesCmd returns a new instance which can be used to issue a
+// notifymissedvotes JSON-RPC command.
+func NewNotifyMissedVotesCmd() *NotifyMissedVotesCmd {
+	return &NotifyMissedVotesCmd{}
+}
+
+// StopNotifyMissedVotesCmd defines the stopnotifymissedvotes JSON-RPC command.
+type StopNotifyMissedVotesCmd struct{}
+
+// NewStopNotifyMissedVotesCmd returns a new instance which can be used to
+// issue a stopnotifymissedvotes JSON-RPC command.
+func NewStopNotifyMissedVotesCmd() *StopNotifyMissedVotesCmd {
+	return &StopNotifyMissedVotesCmd{}
+}
+
+// SetAPIVersionCmd defines the setapiversion JSON-RPC command.
+type SetAPIVersionCmd struct {
+	Version string
+}
+
+// NewSetAPIVersionCmd returns a new instance which can be used to issue a
+// setapiversion JSON-RPC command.
+func NewSetAPIVersionCmd(version string) *SetAPIVersionCmd {
+	return &SetAPIVersionCmd{
+		Version: version,
+	}
+}
+
+// NotifyPendingRevocationsCmd defines the notifypendingrevocations JSON-RPC
+// command.
+type NotifyPendingRevocationsCmd struct{}
+
+// NewNotifyPendingRevocationsCmd returns a new instance which can be used to
+// issue a notifypendingrevocations JSON-RPC command.
+func NewNotifyPendingRevocationsCmd() *NotifyPendingRevocationsCmd {
+	return &NotifyPendingRevocationsCmd{}
+}
+
+// StopNotifyPendingRevocationsCmd defines the stopnotifypendingrevocations
+// JSON-RPC command.
+type StopNotifyPendingRevocationsCmd struct{}
+
+// NewStopNotifyPendingRevocationsCmd returns a new instance which can be used
+// to issue a stopnotifypendingrevocations JSON-RPC command.
+func NewStopNotifyPendingRevocationsCmd() *StopNotifyPendingRevocationsCmd {
+	return &StopNotifyPendingRevocationsCmd{}
+}
+
+// NotifyRescanProgressCmd defines the notifyrescanprogress JSON-RPC command.
+type NotifyRescanProgressCmd struct{}
+
+// NewNotifyRescanProgressCmd returns a new instance which can be used to issue
+// a notifyrescanprogress JSON-RPC command.
+func NewNotifyRescanProgressCmd() *NotifyRescanProgressCmd {
+	return &NotifyRescanProgressCmd{}
+}
+
+// StopNotifyRescanProgressCmd defines the stopnotifyrescanprogress JSON-RPC
+// command.
+type StopNotifyRescanProgressCmd struct{}
+
+// NewStopNotifyRescanProgressCmd returns a new instance which can be used to
+// issue a stopnotifyrescanprogress JSON-RPC command.
+func NewStopNotifyRescanProgressCmd() *StopNotifyRescanProgressCmd {
+	return &StopNotifyRescanProgressCmd{}
+}
+
+// NotifyTicketsCmd defines the notifytickets JSON-RPC command.
+type NotifyTicketsCmd struct{}
+
+// NewNotifyTicketsCmd returns a new instance which can be used to issue a
+// notifytickets JSON-RPC command.
+func NewNotifyTicketsCmd() *NotifyTicketsCmd {
+	return &NotifyTicketsCmd{}
+}
+
+// StopNotifyTicketsCmd defines the stopnotifytickets JSON-RPC command.
+type StopNotifyTicketsCmd struct{}
+
+// NewStopNotifyTicketsCmd returns a new instance which can be used to issue a
+// stopnotifytickets JSON-RPC command.
+func NewStopNotifyTicketsCmd() *StopNotifyTicketsCmd {
+	return &StopNotifyTicketsCmd{}
+}
+
+// NotifyVoteVersionCmd defines the notifyvoteversion JSON-RPC command.
+type NotifyVoteVersionCmd struct{}
+
+// NewNotifyVoteVersionCmd returns a new instance which can be used to issue a
+// notifyvoteversion JSON-RPC command.
+func NewNotifyVoteVersionCmd() *NotifyVoteVersionCmd {
+	return &NotifyVoteVersionCmd{}
+}
+
+// StopNotifyVoteVersionCmd defines the stopnotifyvoteversion JSON-RPC command.
+type StopNotifyVoteVersionCmd struct{}
+
+// NewStopNotifyVoteVersionCmd returns a new instance which can be used to
+// issue a stopnotifyvoteversion JSON-RPC command.
+func NewStopNotifyVoteVersionCmd() *StopNotifyVoteVersionCmd {
+	return &StopNotifyVoteVersionCmd{}
+}
+
+// StopNotifyWinningTicketsCmd defines the stopnotifywinningtickets JSON-RPC
+// command.
+type StopNotifyWinningTicketsCmd struct{}
+
+// NewStopNotifyWinningTicketsCmd returns a new instance which can be used to
+// issue a stopnotifywinningtickets JSON-RPC command.
+func NewStopNotifyWinningTicketsCmd() *StopNotifyWinningTicketsCmd {
+	return &StopNotifyWinningTicketsCmd{}
+}
+
+// DepositAddressNtfn defines the depositaddress JSON-RPC notification.
+type DepositAddressNtfn struct {
+	Account string
+	Address string
+	Index   uint32
+}
+
+// NewDepositAddressNtfn returns a new instance which can be used to issue a
+// depositaddress JSON-RPC notification.
+func NewDepositAddressNtfn(account, address string, index uint32) *DepositAddressNtfn {
+	return &DepositAddressNtfn{
+		Account: account,
+		Address: address,
+		Index:   index,
+	}
+}
+
+// MissedVoteNtfn defines the missedvote JSON-RPC notification.
+type MissedVoteNtfn struct {
+	TicketHash  string
+	BlockHash   string
+	BlockHeight int32
+}
+
+// NewMissedVoteNtfn returns a new instance which can be used to issue a
+// missedvote JSON-RPC notification.
+func NewMissedVoteNtfn(ticketHash, blockHash string, blockHeight int32) *MissedVoteNtfn {
+	return &MissedVoteNtfn{
+		TicketHash:  ticketHash,
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+	}
+}
+
+// PendingRevocationNtfn defines the pendingrevocation JSON-RPC notification.
+type PendingRevocationNtfn struct {
+	TicketHash string
+	Scheduled  int64
+}
+
+// NewPendingRevocationNtfn returns a new instance which can be used to issue a
+// pendingrevocation JSON-RPC notification.
+func NewPendingRevocationNtfn(ticketHash string, scheduled int64) *PendingRevocationNtfn {
+	return &PendingRevocationNtfn{
+		TicketHash: ticketHash,
+		Scheduled:  scheduled,
+	}
+}
+
+// RescanProgressNtfn defines the rescanprogress JSON-RPC notification.
+type RescanProgressNtfn struct {
+	StartHeight         int32
+	TargetHeight        int32
+	ScannedThrough      int32
+	Percent             float64
+	AddressesDiscovered int
+	Done                bool
+	Error               string
+}
+
+// NewRescanProgressNtfn returns a new instance which can be used to issue a
+// rescanprogress JSON-RPC notification.
+func NewRescanProgressNtfn(startHeight, targetHeight, scannedThrough int32, percent float64,
+	addressesDiscovered int, done bool, err string) *RescanProgressNtfn {
+
+	return &RescanProgressNtfn{
+		StartHeight:         startHeight,
+		TargetHeight:        targetHeight,
+		ScannedThrough:      scannedThrough,
+		Percent:             percent,
+		AddressesDiscovered: addressesDiscovered,
+		Done:                done,
+		Error:               err,
+	}
+}
+
+// TicketStatusNtfn defines the ticketstatus JSON-RPC notification.
+type TicketStatusNtfn struct {
+	TicketHash  string
+	Status      string
+	BlockHash   string
+	BlockHeight int32
+}
+
+// NewTicketStatusNtfn returns a new instance which can be used to issue a
+// ticketstatus JSON-RPC notification.
+func NewTicketStatusNtfn(ticketHash, status, blockHash string, blockHeight int32) *TicketStatusNtfn {
+	return &TicketStatusNtfn{
+		TicketHash:  ticketHash,
+		Status:      status,
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+	}
+}
+
+// VoteVersionNtfn defines the voteversion JSON-RPC notification.
+type VoteVersionNtfn struct {
+	VoteVersion       uint32
+	ChainStakeVersion uint32
+	Outdated          bool
+	Warning           string
+}
+
+// NewVoteVersionNtfn returns a new instance which can be used to issue a
+// voteversion JSON-RPC notification.
+func NewVoteVersionNtfn(voteVersion, chainStakeVersion uint32, outdated bool, warning string) *VoteVersionNtfn {
+	return &VoteVersionNtfn{
+		VoteVersion:       voteVersion,
+		ChainStakeVersion: chainStakeVersion,
+		Outdated:          outdated,
+		Warning:           warning,
+	}
+}
+
+func init() {
+	// The commands and notifications in this file are only usable by
+	// websocket clients of a wallet server.
+	flags := vhcjson.UFWalletOnly | vhcjson.UFWebsocketOnly
+
+	vhcjson.MustRegisterCmd("notifydepositaddresses", (*NotifyDepositAddressesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("notifymissedvotes", (*NotifyMissedVotesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("notifypendingrevocations", (*NotifyPendingRevocationsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("notifyrescanprogress", (*NotifyRescanProgressCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("notifytickets", (*NotifyTicketsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("notifyvoteversion", (*NotifyVoteVersionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("setapiversion", (*SetAPIVersionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifydepositaddresses", (*StopNotifyDepositAddressesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifymissedvotes", (*StopNotifyMissedVotesCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifypendingrevocations", (*StopNotifyPendingRevocationsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifyrescanprogress", (*StopNotifyRescanProgressCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifytickets", (*StopNotifyTicketsCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifyvoteversion", (*StopNotifyVoteVersionCmd)(nil), flags)
+	vhcjson.MustRegisterCmd("stopnotifywinningtickets", (*StopNotifyWinningTicketsCmd)(nil), flags)
+
+	vhcjson.MustRegisterCmd(DepositAddressNtfnMethod, (*DepositAddressNtfn)(nil),
+		flags|vhcjson.UFNotification)
+	vhcjson.MustRegisterCmd(MissedVoteNtfnMethod, (*MissedVoteNtfn)(nil),
+		flags|vhcjson.UFNotification)
+	vhcjson.MustRegisterCmd(PendingRevocationNtfnMethod, (*PendingRevocationNtfn)(nil),
+		flags|vhcjson.UFNotification)
+	vhcjson.MustRegisterCmd(RescanProgressNtfnMethod, (*RescanProgressNtfn)(nil),
+		flags|vhcjson.UFNotification)
+	vhcjson.MustRegisterCmd(TicketStatusNtfnMethod, (*TicketStatusNtfn)(nil),
+		flags|vhcjson.UFNotification)
+	vhcjson.MustRegisterCmd(VoteVersionNtfnMethod, (*VoteVersionNtfn)(nil),
+		flags|vhcjson.UFNotification)
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -62,9 +63,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "5.6.0"
+	semverString = "5.9.0"
 	semverMajor  = 5
-	semverMinor  = 6
+	semverMinor  = 9
 	semverPatch  = 0
 )
 
@@ -556,7 +557,7 @@ func (s *walletServer) ImportPrivateKey(ctx context.Context, req *pb.ImportPriva
 	}
 
 	if req.Rescan {
-		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom)
+		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom, nil)
 	}
 
 	return &pb.ImportPrivateKeyResponse{}, nil
@@ -617,13 +618,20 @@ func (s *walletServer) ImportScript(ctx context.Context,
 		return nil, err
 	}
 
-	err = s.wallet.ImportScript(req.Script)
+	imp := &udb.ScriptImport{
+		Origin:     "WalletService.ImportScript",
+		RescanFrom: -1,
+	}
+	if req.Rescan {
+		imp.RescanFrom = req.ScanFrom
+	}
+	err = s.wallet.ImportScript(req.Script, imp)
 	if err != nil {
 		return nil, translateError(err)
 	}
 
 	if req.Rescan {
-		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom)
+		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom, nil)
 	}
 
 	p2sh, err := vhcutil.NewAddressScriptHash(req.Script, s.wallet.ChainParams())
@@ -644,9 +652,13 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		return nil, translateError(err)
 	}
 
+	return marshalBalances(&bals), nil
+}
+
+func marshalBalances(bals *udb.Balances) *pb.BalanceResponse {
 	// TODO: Spendable currently includes multisig outputs that may not
 	// actually be spendable without additional keys.
-	resp := &pb.BalanceResponse{
+	return &pb.BalanceResponse{
 		Total:                   int64(bals.Total),
 		Spendable:               int64(bals.Spendable),
 		ImmatureReward:          int64(bals.ImmatureCoinbaseRewards),
@@ -655,7 +667,6 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		VotingAuthority:         int64(bals.VotingAuthority),
 		Unconfirmed:             int64(bals.Unconfirmed),
 	}
-	return resp, nil
 }
 
 func (s *walletServer) TicketPrice(ctx context.Context, req *pb.TicketPriceRequest) (*pb.TicketPriceResponse, error) {
@@ -813,6 +824,103 @@ func (s *walletServer) SweepAccount(ctx context.Context, req *pb.SweepAccountReq
 	return res, nil
 }
 
+func (s *walletServer) LockUnspent(ctx context.Context, req *pb.LockUnspentRequest) (*pb.LockUnspentResponse, error) {
+	if req.Unlock && len(req.Outpoints) == 0 {
+		s.wallet.ResetLockedOutpoints()
+		return &pb.LockUnspentResponse{}, nil
+	}
+
+	ops := make([]wire.OutPoint, 0, len(req.Outpoints))
+	for _, o := range req.Outpoints {
+		hash, err := chainhash.NewHash(o.TransactionHash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "transaction hash has invalid length")
+		}
+		if o.Tree != int32(wire.TxTreeRegular) && o.Tree != int32(wire.TxTreeStake) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid transaction tree %d", o.Tree)
+		}
+		ops = append(ops, wire.OutPoint{Hash: *hash, Index: o.OutputIndex, Tree: int8(o.Tree)})
+	}
+	for _, op := range ops {
+		if req.Unlock {
+			s.wallet.UnlockOutpoint(op)
+		} else {
+			s.wallet.LockOutpoint(op)
+		}
+	}
+	return &pb.LockUnspentResponse{}, nil
+}
+
+func (s *walletServer) LockedOutputs(ctx context.Context, req *pb.LockedOutputsRequest) (*pb.LockedOutputsResponse, error) {
+	locked := s.wallet.LockedOutpoints()
+	outpoints := make([]*pb.OutPoint, 0, len(locked))
+	for i := range locked {
+		hash, err := chainhash.NewHashFromStr(locked[i].Txid)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		outpoints = append(outpoints, &pb.OutPoint{
+			TransactionHash: hash[:],
+			OutputIndex:     locked[i].Vout,
+			Tree:            int32(locked[i].Tree),
+		})
+	}
+	return &pb.LockedOutputsResponse{Outpoints: outpoints}, nil
+}
+
+func (s *walletServer) Fees(ctx context.Context, req *pb.FeesRequest) (*pb.FeesResponse, error) {
+	return &pb.FeesResponse{
+		TxFeePerKb:     int64(s.wallet.RelayFee()),
+		TicketFeePerKb: int64(s.wallet.TicketFeeIncrement()),
+	}, nil
+}
+
+func (s *walletServer) SetTxFee(ctx context.Context, req *pb.SetTxFeeRequest) (*pb.SetTxFeeResponse, error) {
+	if req.FeePerKb < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "fee per kb cannot be negative")
+	}
+	s.wallet.SetRelayFee(vhcutil.Amount(req.FeePerKb))
+	return &pb.SetTxFeeResponse{}, nil
+}
+
+func (s *walletServer) SetTicketFee(ctx context.Context, req *pb.SetTicketFeeRequest) (*pb.SetTicketFeeResponse, error) {
+	if req.FeePerKb < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "fee per kb cannot be negative")
+	}
+	s.wallet.SetTicketFeeIncrement(vhcutil.Amount(req.FeePerKb))
+	return &pb.SetTicketFeeResponse{}, nil
+}
+
+func (s *walletServer) AccountAddresses(ctx context.Context, req *pb.AccountAddressesRequest) (*pb.AccountAddressesResponse, error) {
+	endExt, endInt, err := s.wallet.BIP0044BranchNextIndexes(req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	encode := func(branch, end uint32) ([]string, error) {
+		addrs, err := s.wallet.AccountBranchAddressRange(req.Account, branch, 0, end)
+		if err != nil {
+			return nil, err
+		}
+		strs := make([]string, len(addrs))
+		for i, a := range addrs {
+			strs[i] = a.EncodeAddress()
+		}
+		return strs, nil
+	}
+	ext, err := encode(udb.ExternalBranch, endExt)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	internal, err := encode(udb.InternalBranch, endInt)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.AccountAddressesResponse{
+		ExternalAddresses: ext,
+		InternalAddresses: internal,
+	}, nil
+}
+
 func (s *walletServer) BlockInfo(ctx context.Context, req *pb.BlockInfoRequest) (*pb.BlockInfoResponse, error) {
 	var blockID *wallet.BlockIdentifier
 	switch {
@@ -1636,14 +1744,13 @@ func (s *walletServer) RevokeTickets(ctx context.Context, req *pb.RevokeTicketsR
 		return nil, translateError(err)
 	}
 
-	// The wallet is not locally aware of when tickets are selected to vote and
-	// when they are missed.  RevokeTickets uses trusted RPCs to determine which
-	// tickets were missed.  RevokeExpiredTickets is only able to create
-	// revocations for tickets which have reached their expiry time even if they
-	// were missed prior to expiry, but is able to be used with other backends.
+	// RevokeTickets uses trusted RPCs to determine which tickets were missed.
+	// RevokeMissedTickets is able to be used with other backends and revokes
+	// expired tickets and the missed tickets detected by the wallet from the
+	// votes included in each block.
 	chainClient, err := chain.RPCClientFromBackend(n)
 	if err != nil {
-		err := s.wallet.RevokeExpiredTickets(ctx, n)
+		err := s.wallet.RevokeMissedTickets(ctx, n)
 		if err != nil {
 			return nil, translateError(err)
 		}
@@ -2078,6 +2185,197 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	}
 }
 
+func (s *walletServer) BalanceNotifications(req *pb.BalanceNotificationsRequest,
+	svr pb.WalletService_BalanceNotificationsServer) error {
+
+	if req.RequiredConfirmations < 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"required_confirmations may not be negative")
+	}
+
+	// Register for transaction notifications before calculating the initial
+	// balances so no changes are missed.
+	n := s.wallet.NtfnServer.TransactionNotifications()
+	defer n.Done()
+
+	// Balances are recalculated after every notification, since attached
+	// blocks change the spendable and immature balances of accounts even
+	// when no relevant transactions were mined.  Only accounts with changed
+	// balances are sent.
+	last := make(map[uint32]udb.Balances)
+	sendChanged := func() error {
+		bals, err := s.wallet.CalculateAccountBalances(req.RequiredConfirmations)
+		if err != nil {
+			return translateError(err)
+		}
+		var resp pb.BalanceNotificationsResponse
+		for account, b := range bals {
+			if prev, ok := last[account]; ok && prev == *b {
+				continue
+			}
+			last[account] = *b
+			resp.Balances = append(resp.Balances, &pb.BalanceNotificationsResponse_AccountBalance{
+				AccountNumber: account,
+				Balance:       marshalBalances(b),
+			})
+		}
+		if len(resp.Balances) == 0 {
+			return nil
+		}
+		sort.Slice(resp.Balances, func(i, j int) bool {
+			return resp.Balances[i].AccountNumber < resp.Balances[j].AccountNumber
+		})
+		err = svr.Send(&resp)
+		if err != nil {
+			return translateError(err)
+		}
+		return nil
+	}
+
+	err := sendChanged()
+	if err != nil {
+		return err
+	}
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case <-n.C:
+			err := sendChanged()
+			if err != nil {
+				return err
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
+// ticketStatusFinal returns whether a ticket with the status may only change
+// status after a wallet transaction spending it is added or removed.
+func ticketStatusFinal(s wallet.TicketStatus) bool {
+	switch s {
+	case wallet.TicketStatusUnmined, wallet.TicketStatusImmature,
+		wallet.TicketStatusLive:
+		return false
+	}
+	return true
+}
+
+// notifiedTickets returns the hashes of the tickets purchased, voted, or
+// revoked by the transactions of a notification.
+func notifiedTickets(n *wallet.TransactionNotifications) []chainhash.Hash {
+	var hashes []chainhash.Hash
+	add := func(txs []wallet.TransactionSummary) {
+		for i := range txs {
+			tx := &txs[i]
+			var ticketInput int
+			switch tx.Type {
+			case wallet.TransactionTypeTicketPurchase:
+				hashes = append(hashes, *tx.Hash)
+				continue
+			case wallet.TransactionTypeVote:
+				ticketInput = 1
+			case wallet.TransactionTypeRevocation:
+				ticketInput = 0
+			default:
+				continue
+			}
+			var mtx wire.MsgTx
+			err := mtx.Deserialize(bytes.NewReader(tx.Transaction))
+			if err != nil || len(mtx.TxIn) <= ticketInput {
+				continue
+			}
+			hashes = append(hashes, mtx.TxIn[ticketInput].PreviousOutPoint.Hash)
+		}
+	}
+	for i := range n.AttachedBlocks {
+		add(n.AttachedBlocks[i].Transactions)
+	}
+	add(n.UnminedTransactions)
+	return hashes
+}
+
+func (s *walletServer) TicketNotifications(req *pb.TicketNotificationsRequest,
+	svr pb.WalletService_TicketNotificationsServer) error {
+
+	// Status changes caused by attached blocks are determined by the wallet
+	// itself, and wallet transactions report purchased, voted, and revoked
+	// tickets.  Only the tickets of each notification are looked up, and no
+	// requests are made to the consensus server.
+	txNtfns := s.wallet.NtfnServer.TransactionNotifications()
+	defer txNtfns.Done()
+	ticketNtfns := s.wallet.NtfnServer.TicketNotifications()
+	defer ticketNtfns.Done()
+
+	// Record the last status sent for tickets which may still change status
+	// without a wallet transaction spending them.
+	statuses := make(map[chainhash.Hash]wallet.TicketStatus)
+	sendTickets := func(hashes []chainhash.Hash) error {
+		var resp pb.TicketNotificationsResponse
+		seen := make(map[chainhash.Hash]struct{})
+		for i := range hashes {
+			hash := &hashes[i]
+			if _, ok := seen[*hash]; ok {
+				continue
+			}
+			seen[*hash] = struct{}{}
+			ticket, header, err := s.wallet.GetTicketInfo(hash)
+			if errors.Is(errors.NotExist, err) {
+				// Unmined tickets are removed when double spent.
+				delete(statuses, *hash)
+				continue
+			}
+			if err != nil {
+				return translateError(err)
+			}
+			if prev, ok := statuses[*hash]; ok && prev == ticket.Status {
+				continue
+			}
+			if ticketStatusFinal(ticket.Status) {
+				delete(statuses, *hash)
+			} else {
+				statuses[*hash] = ticket.Status
+			}
+			resp.Tickets = append(resp.Tickets, &pb.GetTicketsResponse{
+				Ticket: marshalTicketDetails(ticket),
+				Block:  marshalGetTicketBlockDetails(header),
+			})
+		}
+		if len(resp.Tickets) == 0 {
+			return nil
+		}
+		err := svr.Send(&resp)
+		if err != nil {
+			return translateError(err)
+		}
+		return nil
+	}
+
+	ctxDone := svr.Context().Done()
+	for {
+		var err error
+		select {
+		case v := <-txNtfns.C:
+			err = sendTickets(notifiedTickets(v))
+
+		case v := <-ticketNtfns.C:
+			hashes := make([]chainhash.Hash, len(v.Changes))
+			for i, c := range v.Changes {
+				hashes[i] = c.Ticket
+			}
+			err = sendTickets(hashes)
+
+		case <-ctxDone:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (s *walletServer) ConfirmationNotifications(svr pb.WalletService_ConfirmationNotificationsServer) error {
 	c := s.wallet.NtfnServer.ConfirmationNotifications(svr.Context())
 	errOut := make(chan error, 2)
@@ -2322,6 +2620,8 @@ func (t *ticketbuyerServer) StartAutoBuyer(ctx context.Context, req *pb.StartAut
 		MaxPriceAbsolute:          req.MaxPriceAbsolute,
 		MaxPriceRelative:          req.MaxPriceRelative,
 		MaxInMempool:              t.ticketbuyerCfg.MaxInMempool,
+		MaxSpend:                  t.ticketbuyerCfg.MaxSpend,
+		SpendWindow:               t.ticketbuyerCfg.SpendWindow,
 		PoolAddress:               poolAddress,
 		PoolFees:                  poolFees,
 		NoSpreadTicketPurchases:   t.ticketbuyerCfg.NoSpreadTicketPurchases,
@@ -2366,12 +2666,25 @@ func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletReq
 		pubPassphrase = []byte(wallet.InsecurePubPassphrase)
 	}
 
-	// Seed is required.
-	if len(req.Seed) == 0 {
+	// Exactly one of the seed and seed mnemonic is required.
+	seed := req.Seed
+	switch {
+	case len(req.Seed) != 0 && req.SeedMnemonic != "":
+		return nil, status.Errorf(codes.InvalidArgument, "seed and seed_mnemonic are mutually exclusive")
+	case req.SeedMnemonic != "":
+		var err error
+		seed, err = walletseed.DecodeUserInput(req.SeedMnemonic)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		defer zero.Bytes(seed)
+	case len(req.Seed) == 0:
 		return nil, status.Errorf(codes.InvalidArgument, "seed is a required parameter")
 	}
 
-	_, err := s.loader.CreateNewWallet(pubPassphrase, req.PrivatePassphrase, req.Seed)
+	// The request does not describe the birthday of the seed, so blocks are
+	// rescanned from the genesis block.
+	_, err := s.loader.CreateNewWallet(pubPassphrase, req.PrivatePassphrase, seed, time.Time{})
 	if err != nil {
 		return nil, translateError(err)
 	}
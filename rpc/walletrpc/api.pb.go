@@ -5065,6 +5065,7 @@ type CreateWalletRequest struct {
 	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
 	PrivatePassphrase    []byte   `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
 	Seed                 []byte   `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	SeedMnemonic         string   `protobuf:"bytes,4,opt,name=seed_mnemonic,json=seedMnemonic,proto3" json:"seed_mnemonic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5115,6 +5116,13 @@ func (m *CreateWalletRequest) GetSeed() []byte {
 	return nil
 }
 
+func (m *CreateWalletRequest) GetSeedMnemonic() string {
+	if m != nil {
+		return m.SeedMnemonic
+	}
+	return ""
+}
+
 type CreateWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -9183,239 +9191,945 @@ func (m *SweepAccountResponse) GetEstimatedSignedSize() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
-	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
-	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
-	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
-	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
-	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
-	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
-	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
-	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
-	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
-	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
-	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
-	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
-	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
-	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
-	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
-	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
-	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
-	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
-	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
-	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
-	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
-	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
-	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
-	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
-	proto.RegisterType((*ImportScriptRequest)(nil), "walletrpc.ImportScriptRequest")
-	proto.RegisterType((*ImportScriptResponse)(nil), "walletrpc.ImportScriptResponse")
-	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
-	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
-	proto.RegisterType((*GetTransactionRequest)(nil), "walletrpc.GetTransactionRequest")
-	proto.RegisterType((*GetTransactionResponse)(nil), "walletrpc.GetTransactionResponse")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
-	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
-	proto.RegisterType((*GetTicketRequest)(nil), "walletrpc.GetTicketRequest")
-	proto.RegisterType((*GetTicketsRequest)(nil), "walletrpc.GetTicketsRequest")
-	proto.RegisterType((*GetTicketsResponse)(nil), "walletrpc.GetTicketsResponse")
-	proto.RegisterType((*GetTicketsResponse_TicketDetails)(nil), "walletrpc.GetTicketsResponse.TicketDetails")
-	proto.RegisterType((*GetTicketsResponse_BlockDetails)(nil), "walletrpc.GetTicketsResponse.BlockDetails")
-	proto.RegisterType((*TicketPriceRequest)(nil), "walletrpc.TicketPriceRequest")
-	proto.RegisterType((*TicketPriceResponse)(nil), "walletrpc.TicketPriceResponse")
-	proto.RegisterType((*StakeInfoRequest)(nil), "walletrpc.StakeInfoRequest")
-	proto.RegisterType((*StakeInfoResponse)(nil), "walletrpc.StakeInfoResponse")
-	proto.RegisterType((*BlockInfoRequest)(nil), "walletrpc.BlockInfoRequest")
-	proto.RegisterType((*BlockInfoResponse)(nil), "walletrpc.BlockInfoResponse")
-	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
-	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
-	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
-	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
-	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
-	proto.RegisterType((*UnspentOutputsRequest)(nil), "walletrpc.UnspentOutputsRequest")
-	proto.RegisterType((*UnspentOutputResponse)(nil), "walletrpc.UnspentOutputResponse")
-	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
-	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
-	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
-	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
-	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
-	proto.RegisterType((*SignTransactionRequest_AdditionalScript)(nil), "walletrpc.SignTransactionRequest.AdditionalScript")
-	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
-	proto.RegisterType((*SignTransactionsRequest)(nil), "walletrpc.SignTransactionsRequest")
-	proto.RegisterType((*SignTransactionsRequest_AdditionalScript)(nil), "walletrpc.SignTransactionsRequest.AdditionalScript")
-	proto.RegisterType((*SignTransactionsRequest_UnsignedTransaction)(nil), "walletrpc.SignTransactionsRequest.UnsignedTransaction")
-	proto.RegisterType((*SignTransactionsResponse)(nil), "walletrpc.SignTransactionsResponse")
-	proto.RegisterType((*SignTransactionsResponse_SignedTransaction)(nil), "walletrpc.SignTransactionsResponse.SignedTransaction")
-	proto.RegisterType((*CreateSignatureRequest)(nil), "walletrpc.CreateSignatureRequest")
-	proto.RegisterType((*CreateSignatureResponse)(nil), "walletrpc.CreateSignatureResponse")
-	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
-	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
-	proto.RegisterType((*PublishUnminedTransactionsRequest)(nil), "walletrpc.PublishUnminedTransactionsRequest")
-	proto.RegisterType((*PublishUnminedTransactionsResponse)(nil), "walletrpc.PublishUnminedTransactionsResponse")
-	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
-	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
-	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
-	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
-	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
-	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
-	proto.RegisterType((*SignMessagesRequest)(nil), "walletrpc.SignMessagesRequest")
-	proto.RegisterType((*SignMessagesRequest_Message)(nil), "walletrpc.SignMessagesRequest.Message")
-	proto.RegisterType((*SignMessagesResponse)(nil), "walletrpc.SignMessagesResponse")
-	proto.RegisterType((*SignMessagesResponse_SignReply)(nil), "walletrpc.SignMessagesResponse.SignReply")
-	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
-	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
-	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
-	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
-	proto.RegisterType((*ConfirmationNotificationsRequest)(nil), "walletrpc.ConfirmationNotificationsRequest")
-	proto.RegisterType((*ConfirmationNotificationsResponse)(nil), "walletrpc.ConfirmationNotificationsResponse")
-	proto.RegisterType((*ConfirmationNotificationsResponse_TransactionConfirmations)(nil), "walletrpc.ConfirmationNotificationsResponse.TransactionConfirmations")
-	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
-	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
-	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
-	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
-	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
-	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
-	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
-	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
-	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
-	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
-	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
-	proto.RegisterType((*DiscoverAddressesRequest)(nil), "walletrpc.DiscoverAddressesRequest")
-	proto.RegisterType((*DiscoverAddressesResponse)(nil), "walletrpc.DiscoverAddressesResponse")
-	proto.RegisterType((*FetchMissingCFiltersRequest)(nil), "walletrpc.FetchMissingCFiltersRequest")
-	proto.RegisterType((*FetchMissingCFiltersResponse)(nil), "walletrpc.FetchMissingCFiltersResponse")
-	proto.RegisterType((*SubscribeToBlockNotificationsRequest)(nil), "walletrpc.SubscribeToBlockNotificationsRequest")
-	proto.RegisterType((*SubscribeToBlockNotificationsResponse)(nil), "walletrpc.SubscribeToBlockNotificationsResponse")
-	proto.RegisterType((*FetchHeadersRequest)(nil), "walletrpc.FetchHeadersRequest")
-	proto.RegisterType((*FetchHeadersResponse)(nil), "walletrpc.FetchHeadersResponse")
-	proto.RegisterType((*FetchHeadersNotification)(nil), "walletrpc.FetchHeadersNotification")
-	proto.RegisterType((*FetchMissingCFiltersNotification)(nil), "walletrpc.FetchMissingCFiltersNotification")
-	proto.RegisterType((*RescanProgressNotification)(nil), "walletrpc.RescanProgressNotification")
-	proto.RegisterType((*PeerNotification)(nil), "walletrpc.PeerNotification")
-	proto.RegisterType((*RpcSyncRequest)(nil), "walletrpc.RpcSyncRequest")
-	proto.RegisterType((*RpcSyncResponse)(nil), "walletrpc.RpcSyncResponse")
-	proto.RegisterType((*SpvSyncRequest)(nil), "walletrpc.SpvSyncRequest")
-	proto.RegisterType((*SpvSyncResponse)(nil), "walletrpc.SpvSyncResponse")
-	proto.RegisterType((*RescanPointRequest)(nil), "walletrpc.RescanPointRequest")
-	proto.RegisterType((*RescanPointResponse)(nil), "walletrpc.RescanPointResponse")
-	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "walletrpc.GenerateRandomSeedRequest")
-	proto.RegisterType((*GenerateRandomSeedResponse)(nil), "walletrpc.GenerateRandomSeedResponse")
-	proto.RegisterType((*DecodeSeedRequest)(nil), "walletrpc.DecodeSeedRequest")
-	proto.RegisterType((*DecodeSeedResponse)(nil), "walletrpc.DecodeSeedResponse")
-	proto.RegisterType((*RunTicketBuyerRequest)(nil), "walletrpc.RunTicketBuyerRequest")
-	proto.RegisterType((*RunTicketBuyerResponse)(nil), "walletrpc.RunTicketBuyerResponse")
-	proto.RegisterType((*StartAutoBuyerRequest)(nil), "walletrpc.StartAutoBuyerRequest")
-	proto.RegisterType((*StartAutoBuyerResponse)(nil), "walletrpc.StartAutoBuyerResponse")
-	proto.RegisterType((*StopAutoBuyerRequest)(nil), "walletrpc.StopAutoBuyerRequest")
-	proto.RegisterType((*StopAutoBuyerResponse)(nil), "walletrpc.StopAutoBuyerResponse")
-	proto.RegisterType((*TicketBuyerConfigRequest)(nil), "walletrpc.TicketBuyerConfigRequest")
-	proto.RegisterType((*TicketBuyerConfigResponse)(nil), "walletrpc.TicketBuyerConfigResponse")
-	proto.RegisterType((*SetAccountRequest)(nil), "walletrpc.SetAccountRequest")
-	proto.RegisterType((*SetAccountResponse)(nil), "walletrpc.SetAccountResponse")
-	proto.RegisterType((*SetBalanceToMaintainRequest)(nil), "walletrpc.SetBalanceToMaintainRequest")
-	proto.RegisterType((*SetBalanceToMaintainResponse)(nil), "walletrpc.SetBalanceToMaintainResponse")
-	proto.RegisterType((*SetMaxFeeRequest)(nil), "walletrpc.SetMaxFeeRequest")
-	proto.RegisterType((*SetMaxFeeResponse)(nil), "walletrpc.SetMaxFeeResponse")
-	proto.RegisterType((*SetMaxPriceRelativeRequest)(nil), "walletrpc.SetMaxPriceRelativeRequest")
-	proto.RegisterType((*SetMaxPriceRelativeResponse)(nil), "walletrpc.SetMaxPriceRelativeResponse")
-	proto.RegisterType((*SetMaxPriceAbsoluteRequest)(nil), "walletrpc.SetMaxPriceAbsoluteRequest")
-	proto.RegisterType((*SetMaxPriceAbsoluteResponse)(nil), "walletrpc.SetMaxPriceAbsoluteResponse")
-	proto.RegisterType((*SetVotingAddressRequest)(nil), "walletrpc.SetVotingAddressRequest")
-	proto.RegisterType((*SetVotingAddressResponse)(nil), "walletrpc.SetVotingAddressResponse")
-	proto.RegisterType((*SetPoolAddressRequest)(nil), "walletrpc.SetPoolAddressRequest")
-	proto.RegisterType((*SetPoolAddressResponse)(nil), "walletrpc.SetPoolAddressResponse")
-	proto.RegisterType((*SetPoolFeesRequest)(nil), "walletrpc.SetPoolFeesRequest")
-	proto.RegisterType((*SetPoolFeesResponse)(nil), "walletrpc.SetPoolFeesResponse")
-	proto.RegisterType((*SetMaxPerBlockRequest)(nil), "walletrpc.SetMaxPerBlockRequest")
-	proto.RegisterType((*SetMaxPerBlockResponse)(nil), "walletrpc.SetMaxPerBlockResponse")
-	proto.RegisterType((*AgendasRequest)(nil), "walletrpc.AgendasRequest")
-	proto.RegisterType((*AgendasResponse)(nil), "walletrpc.AgendasResponse")
-	proto.RegisterType((*AgendasResponse_Agenda)(nil), "walletrpc.AgendasResponse.Agenda")
-	proto.RegisterType((*AgendasResponse_Choice)(nil), "walletrpc.AgendasResponse.Choice")
-	proto.RegisterType((*VoteChoicesRequest)(nil), "walletrpc.VoteChoicesRequest")
-	proto.RegisterType((*VoteChoicesResponse)(nil), "walletrpc.VoteChoicesResponse")
-	proto.RegisterType((*VoteChoicesResponse_Choice)(nil), "walletrpc.VoteChoicesResponse.Choice")
-	proto.RegisterType((*SetVoteChoicesRequest)(nil), "walletrpc.SetVoteChoicesRequest")
-	proto.RegisterType((*SetVoteChoicesRequest_Choice)(nil), "walletrpc.SetVoteChoicesRequest.Choice")
-	proto.RegisterType((*SetVoteChoicesResponse)(nil), "walletrpc.SetVoteChoicesResponse")
-	proto.RegisterType((*VerifyMessageRequest)(nil), "walletrpc.VerifyMessageRequest")
-	proto.RegisterType((*VerifyMessageResponse)(nil), "walletrpc.VerifyMessageResponse")
-	proto.RegisterType((*DecodedTransaction)(nil), "walletrpc.DecodedTransaction")
-	proto.RegisterType((*DecodedTransaction_Input)(nil), "walletrpc.DecodedTransaction.Input")
-	proto.RegisterType((*DecodedTransaction_Output)(nil), "walletrpc.DecodedTransaction.Output")
-	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "walletrpc.DecodeRawTransactionRequest")
-	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "walletrpc.DecodeRawTransactionResponse")
-	proto.RegisterType((*ValidateAddressRequest)(nil), "walletrpc.ValidateAddressRequest")
-	proto.RegisterType((*ValidateAddressResponse)(nil), "walletrpc.ValidateAddressResponse")
-	proto.RegisterType((*CommittedTicketsRequest)(nil), "walletrpc.CommittedTicketsRequest")
-	proto.RegisterType((*GetAccountExtendedPubKeyRequest)(nil), "walletrpc.GetAccountExtendedPubKeyRequest")
-	proto.RegisterType((*GetAccountExtendedPubKeyResponse)(nil), "walletrpc.GetAccountExtendedPubKeyResponse")
-	proto.RegisterType((*CommittedTicketsResponse)(nil), "walletrpc.CommittedTicketsResponse")
-	proto.RegisterType((*CommittedTicketsResponse_TicketAddress)(nil), "walletrpc.CommittedTicketsResponse.TicketAddress")
-	proto.RegisterType((*BestBlockRequest)(nil), "walletrpc.BestBlockRequest")
-	proto.RegisterType((*BestBlockResponse)(nil), "walletrpc.BestBlockResponse")
-	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
-	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
-	proto.RegisterEnum("walletrpc.SyncNotificationType", SyncNotificationType_name, SyncNotificationType_value)
-	proto.RegisterEnum("walletrpc.TransactionDetails_TransactionType", TransactionDetails_TransactionType_name, TransactionDetails_TransactionType_value)
-	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
-	proto.RegisterEnum("walletrpc.NextAddressRequest_GapPolicy", NextAddressRequest_GapPolicy_name, NextAddressRequest_GapPolicy_value)
-	proto.RegisterEnum("walletrpc.GetTicketsResponse_TicketDetails_TicketStatus", GetTicketsResponse_TicketDetails_TicketStatus_name, GetTicketsResponse_TicketDetails_TicketStatus_value)
-	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
-	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm", ConstructTransactionRequest_OutputSelectionAlgorithm_name, ConstructTransactionRequest_OutputSelectionAlgorithm_value)
-	proto.RegisterEnum("walletrpc.CreateSignatureRequest_SigHashType", CreateSignatureRequest_SigHashType_name, CreateSignatureRequest_SigHashType_value)
-	proto.RegisterEnum("walletrpc.DecodedTransaction_Input_TreeType", DecodedTransaction_Input_TreeType_name, DecodedTransaction_Input_TreeType_value)
-	proto.RegisterEnum("walletrpc.DecodedTransaction_Output_ScriptClass", DecodedTransaction_Output_ScriptClass_name, DecodedTransaction_Output_ScriptClass_value)
-	proto.RegisterEnum("walletrpc.ValidateAddressResponse_ScriptType", ValidateAddressResponse_ScriptType_name, ValidateAddressResponse_ScriptType_value)
+type OutPoint struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Tree                 int32    `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *OutPoint) Reset()         { *m = OutPoint{} }
+func (m *OutPoint) String() string { return proto.CompactTextString(m) }
+func (*OutPoint) ProtoMessage()    {}
+func (*OutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{155}
+}
+func (m *OutPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutPoint.Unmarshal(m, b)
+}
+func (m *OutPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutPoint.Marshal(b, m, deterministic)
+}
+func (dst *OutPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutPoint.Merge(dst, src)
+}
+func (m *OutPoint) XXX_Size() int {
+	return xxx_messageInfo_OutPoint.Size(m)
+}
+func (m *OutPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutPoint.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_OutPoint proto.InternalMessageInfo
 
-// VersionServiceClient is the client API for VersionService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type VersionServiceClient interface {
-	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+func (m *OutPoint) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
 }
 
-type versionServiceClient struct {
-	cc *grpc.ClientConn
+func (m *OutPoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
 }
 
-func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
-	return &versionServiceClient{cc}
+func (m *OutPoint) GetTree() int32 {
+	if m != nil {
+		return m.Tree
+	}
+	return 0
 }
 
-func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LockUnspentRequest struct {
+	Unlock               bool        `protobuf:"varint,1,opt,name=unlock,proto3" json:"unlock,omitempty"`
+	Outpoints            []*OutPoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-// VersionServiceServer is the server API for VersionService service.
-type VersionServiceServer interface {
-	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+func (m *LockUnspentRequest) Reset()         { *m = LockUnspentRequest{} }
+func (m *LockUnspentRequest) String() string { return proto.CompactTextString(m) }
+func (*LockUnspentRequest) ProtoMessage()    {}
+func (*LockUnspentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{156}
+}
+func (m *LockUnspentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockUnspentRequest.Unmarshal(m, b)
+}
+func (m *LockUnspentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockUnspentRequest.Marshal(b, m, deterministic)
+}
+func (dst *LockUnspentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockUnspentRequest.Merge(dst, src)
+}
+func (m *LockUnspentRequest) XXX_Size() int {
+	return xxx_messageInfo_LockUnspentRequest.Size(m)
+}
+func (m *LockUnspentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockUnspentRequest.DiscardUnknown(m)
 }
 
-func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
-	s.RegisterService(&_VersionService_serviceDesc, srv)
+var xxx_messageInfo_LockUnspentRequest proto.InternalMessageInfo
+
+func (m *LockUnspentRequest) GetUnlock() bool {
+	if m != nil {
+		return m.Unlock
+	}
+	return false
 }
 
-func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func (m *LockUnspentRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+type LockUnspentResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockUnspentResponse) Reset()         { *m = LockUnspentResponse{} }
+func (m *LockUnspentResponse) String() string { return proto.CompactTextString(m) }
+func (*LockUnspentResponse) ProtoMessage()    {}
+func (*LockUnspentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{157}
+}
+func (m *LockUnspentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockUnspentResponse.Unmarshal(m, b)
+}
+func (m *LockUnspentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockUnspentResponse.Marshal(b, m, deterministic)
+}
+func (dst *LockUnspentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockUnspentResponse.Merge(dst, src)
+}
+func (m *LockUnspentResponse) XXX_Size() int {
+	return xxx_messageInfo_LockUnspentResponse.Size(m)
+}
+func (m *LockUnspentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockUnspentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockUnspentResponse proto.InternalMessageInfo
+
+type LockedOutputsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockedOutputsRequest) Reset()         { *m = LockedOutputsRequest{} }
+func (m *LockedOutputsRequest) String() string { return proto.CompactTextString(m) }
+func (*LockedOutputsRequest) ProtoMessage()    {}
+func (*LockedOutputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{158}
+}
+func (m *LockedOutputsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockedOutputsRequest.Unmarshal(m, b)
+}
+func (m *LockedOutputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockedOutputsRequest.Marshal(b, m, deterministic)
+}
+func (dst *LockedOutputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedOutputsRequest.Merge(dst, src)
+}
+func (m *LockedOutputsRequest) XXX_Size() int {
+	return xxx_messageInfo_LockedOutputsRequest.Size(m)
+}
+func (m *LockedOutputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedOutputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedOutputsRequest proto.InternalMessageInfo
+
+type LockedOutputsResponse struct {
+	Outpoints            []*OutPoint `protobuf:"bytes,1,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LockedOutputsResponse) Reset()         { *m = LockedOutputsResponse{} }
+func (m *LockedOutputsResponse) String() string { return proto.CompactTextString(m) }
+func (*LockedOutputsResponse) ProtoMessage()    {}
+func (*LockedOutputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{159}
+}
+func (m *LockedOutputsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockedOutputsResponse.Unmarshal(m, b)
+}
+func (m *LockedOutputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockedOutputsResponse.Marshal(b, m, deterministic)
+}
+func (dst *LockedOutputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedOutputsResponse.Merge(dst, src)
+}
+func (m *LockedOutputsResponse) XXX_Size() int {
+	return xxx_messageInfo_LockedOutputsResponse.Size(m)
+}
+func (m *LockedOutputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedOutputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedOutputsResponse proto.InternalMessageInfo
+
+func (m *LockedOutputsResponse) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+type FeesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeesRequest) Reset()         { *m = FeesRequest{} }
+func (m *FeesRequest) String() string { return proto.CompactTextString(m) }
+func (*FeesRequest) ProtoMessage()    {}
+func (*FeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{160}
+}
+func (m *FeesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeesRequest.Unmarshal(m, b)
+}
+func (m *FeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeesRequest.Marshal(b, m, deterministic)
+}
+func (dst *FeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeesRequest.Merge(dst, src)
+}
+func (m *FeesRequest) XXX_Size() int {
+	return xxx_messageInfo_FeesRequest.Size(m)
+}
+func (m *FeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeesRequest proto.InternalMessageInfo
+
+type FeesResponse struct {
+	TxFeePerKb           int64    `protobuf:"varint,1,opt,name=tx_fee_per_kb,json=txFeePerKb,proto3" json:"tx_fee_per_kb,omitempty"`
+	TicketFeePerKb       int64    `protobuf:"varint,2,opt,name=ticket_fee_per_kb,json=ticketFeePerKb,proto3" json:"ticket_fee_per_kb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeesResponse) Reset()         { *m = FeesResponse{} }
+func (m *FeesResponse) String() string { return proto.CompactTextString(m) }
+func (*FeesResponse) ProtoMessage()    {}
+func (*FeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{161}
+}
+func (m *FeesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeesResponse.Unmarshal(m, b)
+}
+func (m *FeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeesResponse.Marshal(b, m, deterministic)
+}
+func (dst *FeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeesResponse.Merge(dst, src)
+}
+func (m *FeesResponse) XXX_Size() int {
+	return xxx_messageInfo_FeesResponse.Size(m)
+}
+func (m *FeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeesResponse proto.InternalMessageInfo
+
+func (m *FeesResponse) GetTxFeePerKb() int64 {
+	if m != nil {
+		return m.TxFeePerKb
+	}
+	return 0
+}
+
+func (m *FeesResponse) GetTicketFeePerKb() int64 {
+	if m != nil {
+		return m.TicketFeePerKb
+	}
+	return 0
+}
+
+type SetTxFeeRequest struct {
+	FeePerKb             int64    `protobuf:"varint,1,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTxFeeRequest) Reset()         { *m = SetTxFeeRequest{} }
+func (m *SetTxFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTxFeeRequest) ProtoMessage()    {}
+func (*SetTxFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{162}
+}
+func (m *SetTxFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTxFeeRequest.Unmarshal(m, b)
+}
+func (m *SetTxFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTxFeeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetTxFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTxFeeRequest.Merge(dst, src)
+}
+func (m *SetTxFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTxFeeRequest.Size(m)
+}
+func (m *SetTxFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTxFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTxFeeRequest proto.InternalMessageInfo
+
+func (m *SetTxFeeRequest) GetFeePerKb() int64 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
+}
+
+type SetTxFeeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTxFeeResponse) Reset()         { *m = SetTxFeeResponse{} }
+func (m *SetTxFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTxFeeResponse) ProtoMessage()    {}
+func (*SetTxFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{163}
+}
+func (m *SetTxFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTxFeeResponse.Unmarshal(m, b)
+}
+func (m *SetTxFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTxFeeResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetTxFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTxFeeResponse.Merge(dst, src)
+}
+func (m *SetTxFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_SetTxFeeResponse.Size(m)
+}
+func (m *SetTxFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTxFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTxFeeResponse proto.InternalMessageInfo
+
+type SetTicketFeeRequest struct {
+	FeePerKb             int64    `protobuf:"varint,1,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTicketFeeRequest) Reset()         { *m = SetTicketFeeRequest{} }
+func (m *SetTicketFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTicketFeeRequest) ProtoMessage()    {}
+func (*SetTicketFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{164}
+}
+func (m *SetTicketFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTicketFeeRequest.Unmarshal(m, b)
+}
+func (m *SetTicketFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTicketFeeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetTicketFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTicketFeeRequest.Merge(dst, src)
+}
+func (m *SetTicketFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTicketFeeRequest.Size(m)
+}
+func (m *SetTicketFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTicketFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTicketFeeRequest proto.InternalMessageInfo
+
+func (m *SetTicketFeeRequest) GetFeePerKb() int64 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
+}
+
+type SetTicketFeeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTicketFeeResponse) Reset()         { *m = SetTicketFeeResponse{} }
+func (m *SetTicketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTicketFeeResponse) ProtoMessage()    {}
+func (*SetTicketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{165}
+}
+func (m *SetTicketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTicketFeeResponse.Unmarshal(m, b)
+}
+func (m *SetTicketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTicketFeeResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetTicketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTicketFeeResponse.Merge(dst, src)
+}
+func (m *SetTicketFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_SetTicketFeeResponse.Size(m)
+}
+func (m *SetTicketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTicketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTicketFeeResponse proto.InternalMessageInfo
+
+type AccountAddressesRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountAddressesRequest) Reset()         { *m = AccountAddressesRequest{} }
+func (m *AccountAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountAddressesRequest) ProtoMessage()    {}
+func (*AccountAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{166}
+}
+func (m *AccountAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountAddressesRequest.Unmarshal(m, b)
+}
+func (m *AccountAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountAddressesRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccountAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAddressesRequest.Merge(dst, src)
+}
+func (m *AccountAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountAddressesRequest.Size(m)
+}
+func (m *AccountAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAddressesRequest proto.InternalMessageInfo
+
+func (m *AccountAddressesRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type AccountAddressesResponse struct {
+	ExternalAddresses    []string `protobuf:"bytes,1,rep,name=external_addresses,json=externalAddresses,proto3" json:"external_addresses,omitempty"`
+	InternalAddresses    []string `protobuf:"bytes,2,rep,name=internal_addresses,json=internalAddresses,proto3" json:"internal_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountAddressesResponse) Reset()         { *m = AccountAddressesResponse{} }
+func (m *AccountAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountAddressesResponse) ProtoMessage()    {}
+func (*AccountAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{167}
+}
+func (m *AccountAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountAddressesResponse.Unmarshal(m, b)
+}
+func (m *AccountAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountAddressesResponse.Marshal(b, m, deterministic)
+}
+func (dst *AccountAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAddressesResponse.Merge(dst, src)
+}
+func (m *AccountAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountAddressesResponse.Size(m)
+}
+func (m *AccountAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAddressesResponse proto.InternalMessageInfo
+
+func (m *AccountAddressesResponse) GetExternalAddresses() []string {
+	if m != nil {
+		return m.ExternalAddresses
+	}
+	return nil
+}
+
+func (m *AccountAddressesResponse) GetInternalAddresses() []string {
+	if m != nil {
+		return m.InternalAddresses
+	}
+	return nil
+}
+
+type BalanceNotificationsRequest struct {
+	RequiredConfirmations int32    `protobuf:"varint,1,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *BalanceNotificationsRequest) Reset()         { *m = BalanceNotificationsRequest{} }
+func (m *BalanceNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceNotificationsRequest) ProtoMessage()    {}
+func (*BalanceNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{168}
+}
+func (m *BalanceNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceNotificationsRequest.Unmarshal(m, b)
+}
+func (m *BalanceNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *BalanceNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceNotificationsRequest.Merge(dst, src)
+}
+func (m *BalanceNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_BalanceNotificationsRequest.Size(m)
+}
+func (m *BalanceNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceNotificationsRequest proto.InternalMessageInfo
+
+func (m *BalanceNotificationsRequest) GetRequiredConfirmations() int32 {
+	if m != nil {
+		return m.RequiredConfirmations
+	}
+	return 0
+}
+
+type BalanceNotificationsResponse struct {
+	Balances             []*BalanceNotificationsResponse_AccountBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *BalanceNotificationsResponse) Reset()         { *m = BalanceNotificationsResponse{} }
+func (m *BalanceNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceNotificationsResponse) ProtoMessage()    {}
+func (*BalanceNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{169}
+}
+func (m *BalanceNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceNotificationsResponse.Unmarshal(m, b)
+}
+func (m *BalanceNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *BalanceNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceNotificationsResponse.Merge(dst, src)
+}
+func (m *BalanceNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_BalanceNotificationsResponse.Size(m)
+}
+func (m *BalanceNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceNotificationsResponse proto.InternalMessageInfo
+
+func (m *BalanceNotificationsResponse) GetBalances() []*BalanceNotificationsResponse_AccountBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+type BalanceNotificationsResponse_AccountBalance struct {
+	AccountNumber        uint32           `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Balance              *BalanceResponse `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BalanceNotificationsResponse_AccountBalance) Reset() {
+	*m = BalanceNotificationsResponse_AccountBalance{}
+}
+func (m *BalanceNotificationsResponse_AccountBalance) String() string {
+	return proto.CompactTextString(m)
+}
+func (*BalanceNotificationsResponse_AccountBalance) ProtoMessage() {}
+func (*BalanceNotificationsResponse_AccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{169, 0}
+}
+func (m *BalanceNotificationsResponse_AccountBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceNotificationsResponse_AccountBalance.Unmarshal(m, b)
+}
+func (m *BalanceNotificationsResponse_AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceNotificationsResponse_AccountBalance.Marshal(b, m, deterministic)
+}
+func (dst *BalanceNotificationsResponse_AccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceNotificationsResponse_AccountBalance.Merge(dst, src)
+}
+func (m *BalanceNotificationsResponse_AccountBalance) XXX_Size() int {
+	return xxx_messageInfo_BalanceNotificationsResponse_AccountBalance.Size(m)
+}
+func (m *BalanceNotificationsResponse_AccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceNotificationsResponse_AccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceNotificationsResponse_AccountBalance proto.InternalMessageInfo
+
+func (m *BalanceNotificationsResponse_AccountBalance) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *BalanceNotificationsResponse_AccountBalance) GetBalance() *BalanceResponse {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+type TicketNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketNotificationsRequest) Reset()         { *m = TicketNotificationsRequest{} }
+func (m *TicketNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*TicketNotificationsRequest) ProtoMessage()    {}
+func (*TicketNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{170}
+}
+func (m *TicketNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketNotificationsRequest.Unmarshal(m, b)
+}
+func (m *TicketNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *TicketNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketNotificationsRequest.Merge(dst, src)
+}
+func (m *TicketNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_TicketNotificationsRequest.Size(m)
+}
+func (m *TicketNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketNotificationsRequest proto.InternalMessageInfo
+
+type TicketNotificationsResponse struct {
+	Tickets              []*GetTicketsResponse `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TicketNotificationsResponse) Reset()         { *m = TicketNotificationsResponse{} }
+func (m *TicketNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*TicketNotificationsResponse) ProtoMessage()    {}
+func (*TicketNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_bfc3eaa1a00f06bc, []int{171}
+}
+func (m *TicketNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketNotificationsResponse.Unmarshal(m, b)
+}
+func (m *TicketNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *TicketNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketNotificationsResponse.Merge(dst, src)
+}
+func (m *TicketNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_TicketNotificationsResponse.Size(m)
+}
+func (m *TicketNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketNotificationsResponse proto.InternalMessageInfo
+
+func (m *TicketNotificationsResponse) GetTickets() []*GetTicketsResponse {
+	if m != nil {
+		return m.Tickets
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
+	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
+	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
+	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
+	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
+	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
+	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
+	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
+	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
+	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
+	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
+	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
+	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
+	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
+	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
+	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
+	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
+	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
+	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
+	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
+	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
+	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
+	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
+	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
+	proto.RegisterType((*ImportScriptRequest)(nil), "walletrpc.ImportScriptRequest")
+	proto.RegisterType((*ImportScriptResponse)(nil), "walletrpc.ImportScriptResponse")
+	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
+	proto.RegisterType((*GetTransactionRequest)(nil), "walletrpc.GetTransactionRequest")
+	proto.RegisterType((*GetTransactionResponse)(nil), "walletrpc.GetTransactionResponse")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
+	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
+	proto.RegisterType((*GetTicketRequest)(nil), "walletrpc.GetTicketRequest")
+	proto.RegisterType((*GetTicketsRequest)(nil), "walletrpc.GetTicketsRequest")
+	proto.RegisterType((*GetTicketsResponse)(nil), "walletrpc.GetTicketsResponse")
+	proto.RegisterType((*GetTicketsResponse_TicketDetails)(nil), "walletrpc.GetTicketsResponse.TicketDetails")
+	proto.RegisterType((*GetTicketsResponse_BlockDetails)(nil), "walletrpc.GetTicketsResponse.BlockDetails")
+	proto.RegisterType((*TicketPriceRequest)(nil), "walletrpc.TicketPriceRequest")
+	proto.RegisterType((*TicketPriceResponse)(nil), "walletrpc.TicketPriceResponse")
+	proto.RegisterType((*StakeInfoRequest)(nil), "walletrpc.StakeInfoRequest")
+	proto.RegisterType((*StakeInfoResponse)(nil), "walletrpc.StakeInfoResponse")
+	proto.RegisterType((*BlockInfoRequest)(nil), "walletrpc.BlockInfoRequest")
+	proto.RegisterType((*BlockInfoResponse)(nil), "walletrpc.BlockInfoResponse")
+	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
+	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
+	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
+	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
+	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
+	proto.RegisterType((*UnspentOutputsRequest)(nil), "walletrpc.UnspentOutputsRequest")
+	proto.RegisterType((*UnspentOutputResponse)(nil), "walletrpc.UnspentOutputResponse")
+	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
+	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
+	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
+	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
+	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
+	proto.RegisterType((*SignTransactionRequest_AdditionalScript)(nil), "walletrpc.SignTransactionRequest.AdditionalScript")
+	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
+	proto.RegisterType((*SignTransactionsRequest)(nil), "walletrpc.SignTransactionsRequest")
+	proto.RegisterType((*SignTransactionsRequest_AdditionalScript)(nil), "walletrpc.SignTransactionsRequest.AdditionalScript")
+	proto.RegisterType((*SignTransactionsRequest_UnsignedTransaction)(nil), "walletrpc.SignTransactionsRequest.UnsignedTransaction")
+	proto.RegisterType((*SignTransactionsResponse)(nil), "walletrpc.SignTransactionsResponse")
+	proto.RegisterType((*SignTransactionsResponse_SignedTransaction)(nil), "walletrpc.SignTransactionsResponse.SignedTransaction")
+	proto.RegisterType((*CreateSignatureRequest)(nil), "walletrpc.CreateSignatureRequest")
+	proto.RegisterType((*CreateSignatureResponse)(nil), "walletrpc.CreateSignatureResponse")
+	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
+	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
+	proto.RegisterType((*PublishUnminedTransactionsRequest)(nil), "walletrpc.PublishUnminedTransactionsRequest")
+	proto.RegisterType((*PublishUnminedTransactionsResponse)(nil), "walletrpc.PublishUnminedTransactionsResponse")
+	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
+	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
+	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
+	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
+	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
+	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
+	proto.RegisterType((*SignMessagesRequest)(nil), "walletrpc.SignMessagesRequest")
+	proto.RegisterType((*SignMessagesRequest_Message)(nil), "walletrpc.SignMessagesRequest.Message")
+	proto.RegisterType((*SignMessagesResponse)(nil), "walletrpc.SignMessagesResponse")
+	proto.RegisterType((*SignMessagesResponse_SignReply)(nil), "walletrpc.SignMessagesResponse.SignReply")
+	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
+	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
+	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
+	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
+	proto.RegisterType((*ConfirmationNotificationsRequest)(nil), "walletrpc.ConfirmationNotificationsRequest")
+	proto.RegisterType((*ConfirmationNotificationsResponse)(nil), "walletrpc.ConfirmationNotificationsResponse")
+	proto.RegisterType((*ConfirmationNotificationsResponse_TransactionConfirmations)(nil), "walletrpc.ConfirmationNotificationsResponse.TransactionConfirmations")
+	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
+	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
+	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
+	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
+	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
+	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
+	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
+	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
+	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
+	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
+	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
+	proto.RegisterType((*DiscoverAddressesRequest)(nil), "walletrpc.DiscoverAddressesRequest")
+	proto.RegisterType((*DiscoverAddressesResponse)(nil), "walletrpc.DiscoverAddressesResponse")
+	proto.RegisterType((*FetchMissingCFiltersRequest)(nil), "walletrpc.FetchMissingCFiltersRequest")
+	proto.RegisterType((*FetchMissingCFiltersResponse)(nil), "walletrpc.FetchMissingCFiltersResponse")
+	proto.RegisterType((*SubscribeToBlockNotificationsRequest)(nil), "walletrpc.SubscribeToBlockNotificationsRequest")
+	proto.RegisterType((*SubscribeToBlockNotificationsResponse)(nil), "walletrpc.SubscribeToBlockNotificationsResponse")
+	proto.RegisterType((*FetchHeadersRequest)(nil), "walletrpc.FetchHeadersRequest")
+	proto.RegisterType((*FetchHeadersResponse)(nil), "walletrpc.FetchHeadersResponse")
+	proto.RegisterType((*FetchHeadersNotification)(nil), "walletrpc.FetchHeadersNotification")
+	proto.RegisterType((*FetchMissingCFiltersNotification)(nil), "walletrpc.FetchMissingCFiltersNotification")
+	proto.RegisterType((*RescanProgressNotification)(nil), "walletrpc.RescanProgressNotification")
+	proto.RegisterType((*PeerNotification)(nil), "walletrpc.PeerNotification")
+	proto.RegisterType((*RpcSyncRequest)(nil), "walletrpc.RpcSyncRequest")
+	proto.RegisterType((*RpcSyncResponse)(nil), "walletrpc.RpcSyncResponse")
+	proto.RegisterType((*SpvSyncRequest)(nil), "walletrpc.SpvSyncRequest")
+	proto.RegisterType((*SpvSyncResponse)(nil), "walletrpc.SpvSyncResponse")
+	proto.RegisterType((*RescanPointRequest)(nil), "walletrpc.RescanPointRequest")
+	proto.RegisterType((*RescanPointResponse)(nil), "walletrpc.RescanPointResponse")
+	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "walletrpc.GenerateRandomSeedRequest")
+	proto.RegisterType((*GenerateRandomSeedResponse)(nil), "walletrpc.GenerateRandomSeedResponse")
+	proto.RegisterType((*DecodeSeedRequest)(nil), "walletrpc.DecodeSeedRequest")
+	proto.RegisterType((*DecodeSeedResponse)(nil), "walletrpc.DecodeSeedResponse")
+	proto.RegisterType((*RunTicketBuyerRequest)(nil), "walletrpc.RunTicketBuyerRequest")
+	proto.RegisterType((*RunTicketBuyerResponse)(nil), "walletrpc.RunTicketBuyerResponse")
+	proto.RegisterType((*StartAutoBuyerRequest)(nil), "walletrpc.StartAutoBuyerRequest")
+	proto.RegisterType((*StartAutoBuyerResponse)(nil), "walletrpc.StartAutoBuyerResponse")
+	proto.RegisterType((*StopAutoBuyerRequest)(nil), "walletrpc.StopAutoBuyerRequest")
+	proto.RegisterType((*StopAutoBuyerResponse)(nil), "walletrpc.StopAutoBuyerResponse")
+	proto.RegisterType((*TicketBuyerConfigRequest)(nil), "walletrpc.TicketBuyerConfigRequest")
+	proto.RegisterType((*TicketBuyerConfigResponse)(nil), "walletrpc.TicketBuyerConfigResponse")
+	proto.RegisterType((*SetAccountRequest)(nil), "walletrpc.SetAccountRequest")
+	proto.RegisterType((*SetAccountResponse)(nil), "walletrpc.SetAccountResponse")
+	proto.RegisterType((*SetBalanceToMaintainRequest)(nil), "walletrpc.SetBalanceToMaintainRequest")
+	proto.RegisterType((*SetBalanceToMaintainResponse)(nil), "walletrpc.SetBalanceToMaintainResponse")
+	proto.RegisterType((*SetMaxFeeRequest)(nil), "walletrpc.SetMaxFeeRequest")
+	proto.RegisterType((*SetMaxFeeResponse)(nil), "walletrpc.SetMaxFeeResponse")
+	proto.RegisterType((*SetMaxPriceRelativeRequest)(nil), "walletrpc.SetMaxPriceRelativeRequest")
+	proto.RegisterType((*SetMaxPriceRelativeResponse)(nil), "walletrpc.SetMaxPriceRelativeResponse")
+	proto.RegisterType((*SetMaxPriceAbsoluteRequest)(nil), "walletrpc.SetMaxPriceAbsoluteRequest")
+	proto.RegisterType((*SetMaxPriceAbsoluteResponse)(nil), "walletrpc.SetMaxPriceAbsoluteResponse")
+	proto.RegisterType((*SetVotingAddressRequest)(nil), "walletrpc.SetVotingAddressRequest")
+	proto.RegisterType((*SetVotingAddressResponse)(nil), "walletrpc.SetVotingAddressResponse")
+	proto.RegisterType((*SetPoolAddressRequest)(nil), "walletrpc.SetPoolAddressRequest")
+	proto.RegisterType((*SetPoolAddressResponse)(nil), "walletrpc.SetPoolAddressResponse")
+	proto.RegisterType((*SetPoolFeesRequest)(nil), "walletrpc.SetPoolFeesRequest")
+	proto.RegisterType((*SetPoolFeesResponse)(nil), "walletrpc.SetPoolFeesResponse")
+	proto.RegisterType((*SetMaxPerBlockRequest)(nil), "walletrpc.SetMaxPerBlockRequest")
+	proto.RegisterType((*SetMaxPerBlockResponse)(nil), "walletrpc.SetMaxPerBlockResponse")
+	proto.RegisterType((*AgendasRequest)(nil), "walletrpc.AgendasRequest")
+	proto.RegisterType((*AgendasResponse)(nil), "walletrpc.AgendasResponse")
+	proto.RegisterType((*AgendasResponse_Agenda)(nil), "walletrpc.AgendasResponse.Agenda")
+	proto.RegisterType((*AgendasResponse_Choice)(nil), "walletrpc.AgendasResponse.Choice")
+	proto.RegisterType((*VoteChoicesRequest)(nil), "walletrpc.VoteChoicesRequest")
+	proto.RegisterType((*VoteChoicesResponse)(nil), "walletrpc.VoteChoicesResponse")
+	proto.RegisterType((*VoteChoicesResponse_Choice)(nil), "walletrpc.VoteChoicesResponse.Choice")
+	proto.RegisterType((*SetVoteChoicesRequest)(nil), "walletrpc.SetVoteChoicesRequest")
+	proto.RegisterType((*SetVoteChoicesRequest_Choice)(nil), "walletrpc.SetVoteChoicesRequest.Choice")
+	proto.RegisterType((*SetVoteChoicesResponse)(nil), "walletrpc.SetVoteChoicesResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "walletrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "walletrpc.VerifyMessageResponse")
+	proto.RegisterType((*DecodedTransaction)(nil), "walletrpc.DecodedTransaction")
+	proto.RegisterType((*DecodedTransaction_Input)(nil), "walletrpc.DecodedTransaction.Input")
+	proto.RegisterType((*DecodedTransaction_Output)(nil), "walletrpc.DecodedTransaction.Output")
+	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "walletrpc.DecodeRawTransactionRequest")
+	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "walletrpc.DecodeRawTransactionResponse")
+	proto.RegisterType((*ValidateAddressRequest)(nil), "walletrpc.ValidateAddressRequest")
+	proto.RegisterType((*ValidateAddressResponse)(nil), "walletrpc.ValidateAddressResponse")
+	proto.RegisterType((*CommittedTicketsRequest)(nil), "walletrpc.CommittedTicketsRequest")
+	proto.RegisterType((*GetAccountExtendedPubKeyRequest)(nil), "walletrpc.GetAccountExtendedPubKeyRequest")
+	proto.RegisterType((*GetAccountExtendedPubKeyResponse)(nil), "walletrpc.GetAccountExtendedPubKeyResponse")
+	proto.RegisterType((*CommittedTicketsResponse)(nil), "walletrpc.CommittedTicketsResponse")
+	proto.RegisterType((*CommittedTicketsResponse_TicketAddress)(nil), "walletrpc.CommittedTicketsResponse.TicketAddress")
+	proto.RegisterType((*BestBlockRequest)(nil), "walletrpc.BestBlockRequest")
+	proto.RegisterType((*BestBlockResponse)(nil), "walletrpc.BestBlockResponse")
+	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
+	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
+	proto.RegisterType((*OutPoint)(nil), "walletrpc.OutPoint")
+	proto.RegisterType((*LockUnspentRequest)(nil), "walletrpc.LockUnspentRequest")
+	proto.RegisterType((*LockUnspentResponse)(nil), "walletrpc.LockUnspentResponse")
+	proto.RegisterType((*LockedOutputsRequest)(nil), "walletrpc.LockedOutputsRequest")
+	proto.RegisterType((*LockedOutputsResponse)(nil), "walletrpc.LockedOutputsResponse")
+	proto.RegisterType((*FeesRequest)(nil), "walletrpc.FeesRequest")
+	proto.RegisterType((*FeesResponse)(nil), "walletrpc.FeesResponse")
+	proto.RegisterType((*SetTxFeeRequest)(nil), "walletrpc.SetTxFeeRequest")
+	proto.RegisterType((*SetTxFeeResponse)(nil), "walletrpc.SetTxFeeResponse")
+	proto.RegisterType((*SetTicketFeeRequest)(nil), "walletrpc.SetTicketFeeRequest")
+	proto.RegisterType((*SetTicketFeeResponse)(nil), "walletrpc.SetTicketFeeResponse")
+	proto.RegisterType((*AccountAddressesRequest)(nil), "walletrpc.AccountAddressesRequest")
+	proto.RegisterType((*AccountAddressesResponse)(nil), "walletrpc.AccountAddressesResponse")
+	proto.RegisterType((*BalanceNotificationsRequest)(nil), "walletrpc.BalanceNotificationsRequest")
+	proto.RegisterType((*BalanceNotificationsResponse)(nil), "walletrpc.BalanceNotificationsResponse")
+	proto.RegisterType((*BalanceNotificationsResponse_AccountBalance)(nil), "walletrpc.BalanceNotificationsResponse.AccountBalance")
+	proto.RegisterType((*TicketNotificationsRequest)(nil), "walletrpc.TicketNotificationsRequest")
+	proto.RegisterType((*TicketNotificationsResponse)(nil), "walletrpc.TicketNotificationsResponse")
+	proto.RegisterEnum("walletrpc.SyncNotificationType", SyncNotificationType_name, SyncNotificationType_value)
+	proto.RegisterEnum("walletrpc.TransactionDetails_TransactionType", TransactionDetails_TransactionType_name, TransactionDetails_TransactionType_value)
+	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
+	proto.RegisterEnum("walletrpc.NextAddressRequest_GapPolicy", NextAddressRequest_GapPolicy_name, NextAddressRequest_GapPolicy_value)
+	proto.RegisterEnum("walletrpc.GetTicketsResponse_TicketDetails_TicketStatus", GetTicketsResponse_TicketDetails_TicketStatus_name, GetTicketsResponse_TicketDetails_TicketStatus_value)
+	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
+	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm", ConstructTransactionRequest_OutputSelectionAlgorithm_name, ConstructTransactionRequest_OutputSelectionAlgorithm_value)
+	proto.RegisterEnum("walletrpc.CreateSignatureRequest_SigHashType", CreateSignatureRequest_SigHashType_name, CreateSignatureRequest_SigHashType_value)
+	proto.RegisterEnum("walletrpc.DecodedTransaction_Input_TreeType", DecodedTransaction_Input_TreeType_name, DecodedTransaction_Input_TreeType_value)
+	proto.RegisterEnum("walletrpc.DecodedTransaction_Output_ScriptClass", DecodedTransaction_Output_ScriptClass_name, DecodedTransaction_Output_ScriptClass_value)
+	proto.RegisterEnum("walletrpc.ValidateAddressResponse_ScriptType", ValidateAddressResponse_ScriptType_name, ValidateAddressResponse_ScriptType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// VersionServiceClient is the client API for VersionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VersionServiceClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type versionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
+	return &versionServiceClient{cc}
+}
+
+func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VersionServiceServer is the server API for VersionService service.
+type VersionServiceServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+}
+
+func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
+	s.RegisterService(&_VersionService_serviceDesc, srv)
+}
+
+func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -9493,6 +10207,14 @@ type WalletServiceClient interface {
 	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
 	CommittedTickets(ctx context.Context, in *CommittedTicketsRequest, opts ...grpc.CallOption) (*CommittedTicketsResponse, error)
 	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
+	LockUnspent(ctx context.Context, in *LockUnspentRequest, opts ...grpc.CallOption) (*LockUnspentResponse, error)
+	LockedOutputs(ctx context.Context, in *LockedOutputsRequest, opts ...grpc.CallOption) (*LockedOutputsResponse, error)
+	Fees(ctx context.Context, in *FeesRequest, opts ...grpc.CallOption) (*FeesResponse, error)
+	SetTxFee(ctx context.Context, in *SetTxFeeRequest, opts ...grpc.CallOption) (*SetTxFeeResponse, error)
+	SetTicketFee(ctx context.Context, in *SetTicketFeeRequest, opts ...grpc.CallOption) (*SetTicketFeeResponse, error)
+	AccountAddresses(ctx context.Context, in *AccountAddressesRequest, opts ...grpc.CallOption) (*AccountAddressesResponse, error)
+	BalanceNotifications(ctx context.Context, in *BalanceNotificationsRequest, opts ...grpc.CallOption) (WalletService_BalanceNotificationsClient, error)
+	TicketNotifications(ctx context.Context, in *TicketNotificationsRequest, opts ...grpc.CallOption) (WalletService_TicketNotificationsClient, error)
 }
 
 type walletServiceClient struct {
@@ -10023,6 +10745,124 @@ func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccount
 	return out, nil
 }
 
+func (c *walletServiceClient) LockUnspent(ctx context.Context, in *LockUnspentRequest, opts ...grpc.CallOption) (*LockUnspentResponse, error) {
+	out := new(LockUnspentResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LockUnspent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LockedOutputs(ctx context.Context, in *LockedOutputsRequest, opts ...grpc.CallOption) (*LockedOutputsResponse, error) {
+	out := new(LockedOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LockedOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Fees(ctx context.Context, in *FeesRequest, opts ...grpc.CallOption) (*FeesResponse, error) {
+	out := new(FeesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Fees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetTxFee(ctx context.Context, in *SetTxFeeRequest, opts ...grpc.CallOption) (*SetTxFeeResponse, error) {
+	out := new(SetTxFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SetTxFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetTicketFee(ctx context.Context, in *SetTicketFeeRequest, opts ...grpc.CallOption) (*SetTicketFeeResponse, error) {
+	out := new(SetTicketFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SetTicketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AccountAddresses(ctx context.Context, in *AccountAddressesRequest, opts ...grpc.CallOption) (*AccountAddressesResponse, error) {
+	out := new(AccountAddressesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AccountAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BalanceNotifications(ctx context.Context, in *BalanceNotificationsRequest, opts ...grpc.CallOption) (WalletService_BalanceNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[7], "/walletrpc.WalletService/BalanceNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceBalanceNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_BalanceNotificationsClient interface {
+	Recv() (*BalanceNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceBalanceNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceBalanceNotificationsClient) Recv() (*BalanceNotificationsResponse, error) {
+	m := new(BalanceNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) TicketNotifications(ctx context.Context, in *TicketNotificationsRequest, opts ...grpc.CallOption) (WalletService_TicketNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[8], "/walletrpc.WalletService/TicketNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceTicketNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_TicketNotificationsClient interface {
+	Recv() (*TicketNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceTicketNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceTicketNotificationsClient) Recv() (*TicketNotificationsResponse, error) {
+	m := new(TicketNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 type WalletServiceServer interface {
 	// Queries
@@ -10068,6 +10908,14 @@ type WalletServiceServer interface {
 	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
 	CommittedTickets(context.Context, *CommittedTicketsRequest) (*CommittedTicketsResponse, error)
 	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
+	LockUnspent(context.Context, *LockUnspentRequest) (*LockUnspentResponse, error)
+	LockedOutputs(context.Context, *LockedOutputsRequest) (*LockedOutputsResponse, error)
+	Fees(context.Context, *FeesRequest) (*FeesResponse, error)
+	SetTxFee(context.Context, *SetTxFeeRequest) (*SetTxFeeResponse, error)
+	SetTicketFee(context.Context, *SetTicketFeeRequest) (*SetTicketFeeResponse, error)
+	AccountAddresses(context.Context, *AccountAddressesRequest) (*AccountAddressesResponse, error)
+	BalanceNotifications(*BalanceNotificationsRequest, WalletService_BalanceNotificationsServer) error
+	TicketNotifications(*TicketNotificationsRequest, WalletService_TicketNotificationsServer) error
 }
 
 func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
@@ -10820,6 +11668,156 @@ func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_LockUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).LockUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/LockUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).LockUnspent(ctx, req.(*LockUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_LockedOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockedOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).LockedOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/LockedOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).LockedOutputs(ctx, req.(*LockedOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Fees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Fees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Fees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Fees(ctx, req.(*FeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetTxFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTxFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetTxFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SetTxFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetTxFee(ctx, req.(*SetTxFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetTicketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTicketFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetTicketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SetTicketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetTicketFee(ctx, req.(*SetTicketFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AccountAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AccountAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AccountAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AccountAddresses(ctx, req.(*AccountAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_BalanceNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BalanceNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).BalanceNotifications(m, &walletServiceBalanceNotificationsServer{stream})
+}
+
+type WalletService_BalanceNotificationsServer interface {
+	Send(*BalanceNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceBalanceNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceBalanceNotificationsServer) Send(m *BalanceNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_TicketNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TicketNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).TicketNotifications(m, &walletServiceTicketNotificationsServer{stream})
+}
+
+type WalletService_TicketNotificationsServer interface {
+	Send(*TicketNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceTicketNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceTicketNotificationsServer) Send(m *TicketNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _WalletService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletService",
 	HandlerType: (*WalletServiceServer)(nil),
@@ -10956,6 +11954,30 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SweepAccount",
 			Handler:    _WalletService_SweepAccount_Handler,
 		},
+		{
+			MethodName: "LockUnspent",
+			Handler:    _WalletService_LockUnspent_Handler,
+		},
+		{
+			MethodName: "LockedOutputs",
+			Handler:    _WalletService_LockedOutputs_Handler,
+		},
+		{
+			MethodName: "Fees",
+			Handler:    _WalletService_Fees_Handler,
+		},
+		{
+			MethodName: "SetTxFee",
+			Handler:    _WalletService_SetTxFee_Handler,
+		},
+		{
+			MethodName: "SetTicketFee",
+			Handler:    _WalletService_SetTicketFee_Handler,
+		},
+		{
+			MethodName: "AccountAddresses",
+			Handler:    _WalletService_AccountAddresses_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -10994,6 +12016,16 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _WalletService_UnspentOutputs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BalanceNotifications",
+			Handler:       _WalletService_BalanceNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TicketNotifications",
+			Handler:       _WalletService_TicketNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
@@ -12420,530 +13452,550 @@ var _DecodeMessageService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api.proto", fileDescriptor_api_bfc3eaa1a00f06bc) }
 
 var fileDescriptor_api_bfc3eaa1a00f06bc = []byte{
-	// 8391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0x59,
-	0x96, 0xd0, 0x84, 0xd3, 0x8f, 0xcc, 0xe3, 0xcc, 0x74, 0xe4, 0xf5, 0x2b, 0x2b, 0x5d, 0xd5, 0x55,
-	0x15, 0xdd, 0xd5, 0x5d, 0xd3, 0x0f, 0x77, 0x8d, 0xbb, 0x67, 0xa6, 0x77, 0xa6, 0xa7, 0xbb, 0xb3,
-	0xec, 0xac, 0xaa, 0x9c, 0xb2, 0xd3, 0xb9, 0x91, 0x59, 0xd5, 0xd5, 0xd3, 0xb0, 0xa1, 0x70, 0xe6,
-	0xb5, 0x1d, 0x5b, 0x99, 0x11, 0x39, 0x11, 0x91, 0x76, 0x79, 0x00, 0x31, 0x1a, 0x04, 0x7f, 0x2b,
-	0x1e, 0x12, 0x1f, 0x3c, 0x76, 0x85, 0x04, 0x02, 0x24, 0xc4, 0x02, 0x02, 0xa1, 0x15, 0x83, 0x10,
-	0x8b, 0xf8, 0x41, 0x2b, 0x84, 0x96, 0x1f, 0x3e, 0xf8, 0x43, 0xe2, 0x0b, 0x89, 0x95, 0xf8, 0xe5,
-	0x03, 0x74, 0x5f, 0x11, 0xf7, 0xc6, 0x23, 0x6d, 0xf7, 0xf4, 0x48, 0xcc, 0x88, 0xfa, 0xa9, 0x8c,
-	0x73, 0xcf, 0x3d, 0xf7, 0x75, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xce, 0x31, 0x94, 0xec, 0x89, 0xb3,
-	0x3d, 0xf1, 0xbd, 0xd0, 0x43, 0xa5, 0x73, 0x7b, 0x34, 0xc2, 0xa1, 0x3f, 0x19, 0x18, 0x3a, 0x54,
-	0x9f, 0x63, 0x3f, 0x70, 0x3c, 0xd7, 0xc4, 0x3f, 0x9e, 0xe2, 0x20, 0x34, 0xfe, 0x50, 0x83, 0x95,
-	0x08, 0x14, 0x4c, 0x3c, 0x37, 0xc0, 0xe8, 0x1e, 0x54, 0xcf, 0x18, 0xc8, 0x0a, 0x42, 0xdf, 0x71,
-	0x4f, 0xea, 0xda, 0x1d, 0xed, 0x7e, 0xc9, 0xac, 0x70, 0x68, 0x8f, 0x02, 0xd1, 0x1a, 0x2c, 0x8c,
-	0xed, 0xdf, 0xf6, 0xfc, 0xfa, 0xdc, 0x1d, 0xed, 0x7e, 0xc5, 0x64, 0x1f, 0x14, 0xea, 0xb8, 0x9e,
-	0x5f, 0x2f, 0x70, 0x28, 0xf9, 0x20, 0xd0, 0x89, 0x1d, 0x0e, 0x4e, 0xeb, 0xf3, 0x0c, 0x4a, 0x3f,
-	0xd0, 0x6b, 0x00, 0x13, 0x1f, 0xfb, 0x78, 0x84, 0xed, 0x00, 0xd7, 0x17, 0x68, 0x23, 0x12, 0x84,
-	0x74, 0xe4, 0x68, 0xea, 0x8c, 0x86, 0xd6, 0x18, 0x87, 0xf6, 0xd0, 0x0e, 0xed, 0xfa, 0x22, 0xeb,
-	0x08, 0x85, 0x1e, 0x70, 0xa0, 0xf1, 0x9f, 0x16, 0x00, 0xf5, 0x7d, 0xdb, 0x0d, 0xec, 0x41, 0xe8,
-	0x78, 0xee, 0x1e, 0x0e, 0x6d, 0x67, 0x14, 0x20, 0x04, 0xf3, 0xa7, 0x76, 0x70, 0x4a, 0x3b, 0x5f,
-	0x36, 0xe9, 0x6f, 0x74, 0x07, 0x96, 0xc3, 0x18, 0x93, 0xf6, 0xbc, 0x6c, 0xca, 0x20, 0xf4, 0x7d,
-	0x58, 0x1c, 0xe2, 0x23, 0x27, 0x0c, 0xea, 0x85, 0x3b, 0x85, 0xfb, 0xcb, 0x3b, 0xaf, 0x6f, 0x47,
-	0xd3, 0xb7, 0x9d, 0x6e, 0x64, 0xbb, 0xed, 0x4e, 0xa6, 0xa1, 0xc9, 0xab, 0xa0, 0x4f, 0x60, 0x69,
-	0xe0, 0xe3, 0x21, 0xa9, 0x3d, 0x4f, 0x6b, 0xbf, 0x31, 0xbb, 0xf6, 0xe1, 0x34, 0x24, 0xd5, 0x45,
-	0x25, 0xa4, 0x43, 0xe1, 0x18, 0xb3, 0x99, 0x28, 0x98, 0xe4, 0x27, 0xba, 0x09, 0xa5, 0xd0, 0x19,
-	0xe3, 0x20, 0xb4, 0xc7, 0x13, 0x3a, 0xfa, 0x82, 0x19, 0x03, 0xd0, 0x0b, 0xd0, 0xa5, 0xbe, 0x5b,
-	0xe1, 0xc5, 0x04, 0xd7, 0x97, 0xee, 0x68, 0xf7, 0xab, 0x3b, 0xef, 0xcd, 0x6e, 0x58, 0x02, 0xf5,
-	0x2f, 0x26, 0xd8, 0x5c, 0x09, 0x55, 0x40, 0xe3, 0xc7, 0xb0, 0x40, 0x87, 0x46, 0x56, 0xce, 0x71,
-	0x87, 0xf8, 0x15, 0x9d, 0xc6, 0x8a, 0xc9, 0x3e, 0xd0, 0x37, 0x41, 0x9f, 0xf8, 0xf8, 0xcc, 0xf1,
-	0xa6, 0x81, 0x65, 0x0f, 0x06, 0xde, 0xd4, 0x0d, 0x39, 0x1b, 0xac, 0x08, 0x78, 0x93, 0x81, 0xd1,
-	0x5b, 0xb0, 0x12, 0xa3, 0x8e, 0x29, 0x66, 0x81, 0x8e, 0xa3, 0x1a, 0x61, 0x52, 0x68, 0xe3, 0x1f,
-	0x69, 0xb0, 0xc8, 0x26, 0x24, 0xa7, 0xd1, 0x3a, 0x2c, 0xa9, 0x6d, 0x89, 0x4f, 0xd4, 0x80, 0xa2,
-	0xe3, 0x86, 0xd8, 0x77, 0xed, 0x11, 0x25, 0x5e, 0x34, 0xa3, 0x6f, 0xb4, 0x01, 0x8b, 0xbc, 0xd9,
-	0x79, 0xda, 0x2c, 0xff, 0xa2, 0xd4, 0x86, 0x43, 0x1f, 0x07, 0x01, 0xe7, 0x3c, 0xf1, 0x89, 0x5e,
-	0x87, 0x8a, 0x47, 0xfb, 0x61, 0x05, 0x03, 0xdf, 0x99, 0x84, 0x74, 0xde, 0xcb, 0x66, 0x99, 0x01,
-	0x7b, 0x14, 0x66, 0x7c, 0x09, 0x2b, 0x89, 0x49, 0x44, 0xcb, 0xb0, 0x64, 0xb6, 0x1e, 0x3f, 0xdb,
-	0x6f, 0x9a, 0xfa, 0x37, 0x50, 0x19, 0x8a, 0xbb, 0x87, 0xed, 0xce, 0xc3, 0x66, 0xaf, 0xa5, 0xcf,
-	0xa3, 0x55, 0x58, 0xe9, 0xb7, 0x77, 0x9f, 0xb6, 0xfa, 0x56, 0xf7, 0x99, 0xb9, 0xfb, 0x84, 0x00,
-	0x35, 0x54, 0x84, 0xf9, 0xe7, 0x87, 0xfd, 0x96, 0x3e, 0x87, 0xaa, 0x00, 0x66, 0xeb, 0xf9, 0xe1,
-	0x6e, 0xb3, 0xdf, 0x3e, 0xec, 0xe8, 0x05, 0xe3, 0xdf, 0x6b, 0x50, 0x7e, 0x38, 0xf2, 0x06, 0x2f,
-	0x67, 0xf1, 0xf2, 0x06, 0x2c, 0x9e, 0x62, 0xe7, 0xe4, 0x94, 0xcd, 0xc6, 0x82, 0xc9, 0xbf, 0x54,
-	0x96, 0x29, 0x24, 0x59, 0xe6, 0x2d, 0x58, 0xb1, 0x27, 0x13, 0xdf, 0x3b, 0xc3, 0x81, 0x35, 0xb1,
-	0x7d, 0xec, 0x86, 0x74, 0xf8, 0x45, 0xb3, 0x2a, 0xc0, 0x5d, 0x0a, 0x45, 0x4d, 0x28, 0x4b, 0x4c,
-	0x21, 0x18, 0xfa, 0xd6, 0x4c, 0xbe, 0x32, 0x95, 0x2a, 0xc6, 0x21, 0x54, 0x39, 0x17, 0x3c, 0xb4,
-	0x47, 0xb6, 0x3b, 0xc0, 0xf2, 0x12, 0x6a, 0xea, 0x12, 0xbe, 0x0e, 0x95, 0xd0, 0x0b, 0xed, 0x91,
-	0x75, 0xc4, 0x50, 0xe9, 0xa0, 0x0a, 0x66, 0x99, 0x02, 0x79, 0x75, 0xa3, 0x02, 0xcb, 0x5d, 0xc7,
-	0x3d, 0x11, 0xc2, 0xab, 0x0a, 0x65, 0xf6, 0xc9, 0x04, 0x17, 0x11, 0x6f, 0x1d, 0x1c, 0x9e, 0x7b,
-	0xfe, 0x4b, 0x81, 0xf1, 0x11, 0xac, 0x44, 0x90, 0x58, 0xba, 0x91, 0xfe, 0x9d, 0x61, 0xcb, 0x65,
-	0x25, 0xbc, 0x27, 0x15, 0x06, 0xe5, 0xe8, 0xc6, 0x6f, 0xc0, 0x1a, 0xef, 0x7b, 0x67, 0x3a, 0x3e,
-	0xc2, 0x3e, 0xa7, 0x88, 0xee, 0x42, 0x99, 0x77, 0xd9, 0x72, 0xed, 0x31, 0xe6, 0xa2, 0x71, 0x99,
-	0xc3, 0x3a, 0xf6, 0x18, 0x1b, 0x9f, 0xc0, 0x7a, 0xa2, 0xaa, 0xdc, 0x34, 0xaf, 0x4b, 0x4b, 0xe2,
-	0xa6, 0x25, 0x74, 0xa3, 0x06, 0x2b, 0xbc, 0x7e, 0x20, 0xc6, 0xf1, 0x07, 0x05, 0xd0, 0x63, 0x18,
-	0x27, 0xf7, 0x29, 0x14, 0x79, 0xc5, 0xa0, 0xae, 0xa5, 0x84, 0x55, 0x12, 0x5d, 0x00, 0xcc, 0xa8,
-	0x12, 0x7a, 0x17, 0xd0, 0x60, 0xea, 0x93, 0xd5, 0xb6, 0x8e, 0x08, 0xb7, 0x59, 0x94, 0xc7, 0x98,
-	0x50, 0xd4, 0x79, 0x09, 0x65, 0xc3, 0x27, 0x84, 0xdf, 0x1e, 0xc0, 0x5a, 0x02, 0x9b, 0x71, 0x5f,
-	0x81, 0x72, 0x1f, 0x52, 0xf0, 0x69, 0x49, 0xe3, 0x67, 0x73, 0xb0, 0x24, 0xc4, 0xc0, 0xd5, 0xc6,
-	0x9e, 0x9a, 0xde, 0xb9, 0xd4, 0xf4, 0xa6, 0x39, 0xa5, 0x90, 0xe6, 0x14, 0x32, 0x34, 0xfc, 0x8a,
-	0x49, 0x00, 0xeb, 0x25, 0xbe, 0xb0, 0x06, 0x91, 0x04, 0xa8, 0x98, 0xba, 0x28, 0x79, 0x8a, 0x2f,
-	0x76, 0x69, 0xe7, 0xde, 0x05, 0x24, 0xe4, 0x85, 0x84, 0xbd, 0xc0, 0xb0, 0x45, 0x89, 0x82, 0x3d,
-	0x9e, 0x78, 0x7e, 0x88, 0x87, 0x12, 0xf6, 0x22, 0xc7, 0xe6, 0x25, 0x02, 0xdb, 0x78, 0x01, 0x6b,
-	0x26, 0x26, 0x63, 0x11, 0xf3, 0xcf, 0x19, 0xe9, 0x8a, 0x13, 0x72, 0x03, 0x8a, 0x2e, 0x3e, 0x97,
-	0x27, 0x63, 0xc9, 0xc5, 0xe7, 0x94, 0xcf, 0x36, 0x61, 0x3d, 0x41, 0x99, 0xef, 0x83, 0xdf, 0x84,
-	0x8a, 0x89, 0x83, 0x81, 0xed, 0x4a, 0x4c, 0x7b, 0x84, 0x4f, 0x1c, 0x57, 0x2c, 0x99, 0x46, 0x97,
-	0x6c, 0x99, 0xc2, 0xd8, 0x5a, 0xa1, 0x5b, 0x00, 0x1c, 0x25, 0xe6, 0x81, 0x12, 0x43, 0xb0, 0x83,
-	0x53, 0xe3, 0x07, 0x50, 0x15, 0x24, 0x39, 0xf7, 0xbd, 0x03, 0x35, 0x9f, 0x42, 0x5c, 0x3c, 0xb4,
-	0xc2, 0x53, 0xdf, 0x9b, 0x9e, 0x9c, 0x72, 0xc2, 0x7a, 0x54, 0xd0, 0x67, 0x70, 0xe3, 0x73, 0x40,
-	0x1d, 0xfc, 0x2a, 0x4c, 0x4c, 0x01, 0x39, 0xff, 0xed, 0x20, 0x98, 0x9c, 0xfa, 0xe4, 0xfc, 0x67,
-	0xb2, 0x4d, 0x82, 0x5c, 0x81, 0x19, 0x8c, 0x8f, 0x61, 0x55, 0x21, 0x7c, 0xbd, 0x9d, 0xf6, 0x1f,
-	0xe7, 0x78, 0xbf, 0x98, 0xe4, 0x17, 0xfd, 0xca, 0x97, 0x52, 0xdf, 0x81, 0xf9, 0x97, 0x8e, 0x3b,
-	0xa4, 0x3d, 0xa9, 0xee, 0x18, 0xd2, 0x76, 0x4b, 0x93, 0xd9, 0x7e, 0xea, 0xb8, 0x43, 0x93, 0xe2,
-	0xa3, 0x47, 0x00, 0x27, 0xf6, 0xc4, 0x9a, 0x78, 0x23, 0x67, 0x70, 0x41, 0x19, 0xb6, 0xba, 0xf3,
-	0xd6, 0xec, 0xda, 0x8f, 0xed, 0x49, 0x97, 0xa2, 0x9b, 0xa5, 0x13, 0xf1, 0xd3, 0xd8, 0x81, 0x79,
-	0x42, 0x15, 0xad, 0x81, 0xfe, 0xb0, 0xdd, 0x7d, 0xf0, 0xe0, 0xc3, 0x0f, 0xad, 0xd6, 0x8b, 0x7e,
-	0xcb, 0xec, 0x34, 0xf7, 0xf5, 0x6f, 0xc8, 0xd0, 0x76, 0x87, 0x43, 0x35, 0xc3, 0x81, 0x52, 0x44,
-	0x0b, 0x35, 0x60, 0xe3, 0x71, 0xb3, 0x6b, 0x75, 0x0f, 0xf7, 0xdb, 0xbb, 0x5f, 0x58, 0xcf, 0x3a,
-	0xbd, 0x6e, 0x6b, 0xb7, 0xfd, 0xa8, 0xdd, 0xda, 0x63, 0xd5, 0xa5, 0xb2, 0x96, 0x69, 0x1e, 0x9a,
-	0xba, 0x86, 0xd6, 0xa1, 0x26, 0x41, 0xdb, 0x8f, 0x3b, 0x87, 0x26, 0x39, 0xb2, 0x56, 0x61, 0x45,
-	0x02, 0x7f, 0x6e, 0x36, 0xbb, 0x7a, 0xc1, 0xe8, 0xf0, 0xd5, 0x10, 0x23, 0xe1, 0xab, 0x21, 0x1d,
-	0xb5, 0x9a, 0x7a, 0xd4, 0xde, 0x02, 0x98, 0x4c, 0x8f, 0x46, 0xce, 0x80, 0x6c, 0x24, 0xbe, 0xbe,
-	0x25, 0x06, 0x79, 0x8a, 0x2f, 0x8c, 0x7f, 0xaa, 0xc1, 0x66, 0x9b, 0x6e, 0xa8, 0xae, 0xef, 0x9c,
-	0xd9, 0x21, 0x7e, 0x8a, 0x2f, 0xae, 0xca, 0x3c, 0xf9, 0xda, 0xc2, 0x9b, 0x44, 0x23, 0xa1, 0xe4,
-	0xe8, 0xf6, 0x3d, 0x77, 0x8e, 0xe9, 0x8a, 0x94, 0xcc, 0xca, 0x24, 0x6a, 0xe5, 0x73, 0xe7, 0x98,
-	0x1c, 0xb0, 0x8c, 0x91, 0xa9, 0xdc, 0x28, 0x9a, 0xfc, 0x0b, 0x6d, 0x41, 0x89, 0xfc, 0x6f, 0x1d,
-	0xfb, 0xde, 0x98, 0x0a, 0x89, 0x05, 0xb3, 0x48, 0x00, 0x8f, 0x7c, 0x6f, 0x6c, 0x34, 0xa0, 0x9e,
-	0xee, 0x31, 0xdf, 0x97, 0xff, 0x4c, 0x83, 0x55, 0x56, 0xc8, 0x94, 0x88, 0xab, 0x0e, 0x65, 0x03,
-	0x16, 0xb9, 0x26, 0xc2, 0xf6, 0x25, 0xff, 0x92, 0x3a, 0x58, 0xc8, 0xef, 0xe0, 0xbc, 0xda, 0x41,
-	0xf4, 0x1e, 0x20, 0x1f, 0xff, 0x78, 0xea, 0xf8, 0xd8, 0xf2, 0xf1, 0x10, 0xe3, 0xb1, 0x7d, 0x34,
-	0xc2, 0x5c, 0x07, 0xa8, 0xf1, 0x12, 0x33, 0x2a, 0x30, 0xbe, 0x80, 0x35, 0xb5, 0xcb, 0x7c, 0x4d,
-	0xef, 0x42, 0x79, 0xb2, 0x13, 0x9c, 0x5a, 0xea, 0xc2, 0x2e, 0x13, 0x18, 0x5f, 0x7e, 0x32, 0x2c,
-	0xa9, 0x85, 0x39, 0xda, 0x82, 0x04, 0x31, 0x5c, 0xa8, 0x72, 0x71, 0x7d, 0x4d, 0x99, 0xf8, 0x6d,
-	0xd8, 0xe0, 0x1d, 0x1d, 0x5a, 0x03, 0xcf, 0x3d, 0x76, 0xfc, 0xb1, 0xcd, 0x94, 0x14, 0xa6, 0x09,
-	0xad, 0x8b, 0xd2, 0x5d, 0xb9, 0xd0, 0xf8, 0xbb, 0x73, 0xb0, 0x12, 0x35, 0xc8, 0x87, 0xb1, 0x06,
-	0x0b, 0xf4, 0xdc, 0xa0, 0x0d, 0x15, 0x4c, 0xf6, 0x41, 0x54, 0xa8, 0x60, 0x82, 0xdd, 0x61, 0xd4,
-	0xf1, 0x82, 0x19, 0x03, 0x88, 0x0a, 0xe5, 0x8c, 0xc7, 0x76, 0x38, 0xa5, 0x53, 0x78, 0x6e, 0xfb,
-	0x43, 0xa1, 0xd1, 0x0a, 0xb0, 0x49, 0xa1, 0xe8, 0x7b, 0x70, 0x23, 0x42, 0x0c, 0x42, 0xfb, 0x25,
-	0xb6, 0x4e, 0xb0, 0x8b, 0x7d, 0xda, 0x1d, 0xae, 0x8d, 0x6e, 0x0a, 0x84, 0x1e, 0x29, 0x7f, 0x1c,
-	0x15, 0xa3, 0xb7, 0xa1, 0x46, 0x4e, 0x52, 0x3c, 0xb4, 0x8e, 0x2e, 0xac, 0xd0, 0x19, 0xbc, 0xc4,
-	0x61, 0xc0, 0x2f, 0x06, 0x2b, 0xac, 0xe0, 0xe1, 0x45, 0x9f, 0x81, 0x89, 0x36, 0x7e, 0xe6, 0x85,
-	0x8e, 0x7b, 0x62, 0xd9, 0xd3, 0xf0, 0xd4, 0xf3, 0x9d, 0xf0, 0x82, 0xdf, 0x15, 0x56, 0x18, 0xbc,
-	0x29, 0xc0, 0xe4, 0x02, 0x34, 0x75, 0xf9, 0x9c, 0xe1, 0x21, 0xbd, 0x2c, 0x14, 0x4c, 0x19, 0x64,
-	0x3c, 0x84, 0xf5, 0xc7, 0x38, 0x94, 0x74, 0x3b, 0xb1, 0x38, 0xdf, 0x54, 0x2f, 0x1b, 0x92, 0x3e,
-	0x2a, 0xdf, 0x1e, 0xe8, 0x69, 0xf1, 0x7b, 0x1a, 0x6c, 0x24, 0x89, 0x44, 0x4a, 0x8b, 0x72, 0x03,
-	0x23, 0x04, 0x2e, 0xd5, 0x2a, 0x95, 0x0b, 0xda, 0x1b, 0x50, 0xc9, 0x5a, 0x73, 0x15, 0x48, 0x8f,
-	0xb3, 0x58, 0xa5, 0x29, 0xf0, 0xe3, 0x4c, 0xe8, 0x32, 0xc6, 0x7f, 0x9e, 0x4b, 0x76, 0x30, 0x12,
-	0xfe, 0xdb, 0xb0, 0x1a, 0x84, 0xb6, 0x4f, 0xa7, 0x53, 0x22, 0xc1, 0x46, 0x5a, 0x13, 0x45, 0xb1,
-	0x5a, 0xb4, 0x03, 0xeb, 0x49, 0xfc, 0x58, 0x2b, 0xaf, 0x99, 0xab, 0x6a, 0x0d, 0x76, 0xd8, 0xbe,
-	0x0d, 0x35, 0xec, 0x0e, 0x13, 0x2d, 0xb0, 0x4e, 0xae, 0xb0, 0x82, 0x98, 0xfe, 0x36, 0xac, 0xaa,
-	0xb8, 0x8c, 0x3a, 0xdb, 0xd6, 0x35, 0x19, 0x9b, 0xd1, 0xfe, 0x04, 0xb6, 0xc6, 0x8e, 0xeb, 0x8c,
-	0xa7, 0x63, 0xcb, 0xc7, 0x03, 0xa2, 0xad, 0x29, 0x6a, 0x3c, 0x93, 0x57, 0x37, 0x38, 0x8a, 0x49,
-	0x31, 0xe4, 0x69, 0x40, 0x1f, 0x41, 0x3d, 0xb4, 0xfd, 0x13, 0xac, 0xd4, 0x93, 0x74, 0x9c, 0x05,
-	0x73, 0x83, 0x95, 0x4b, 0xb5, 0x98, 0xa6, 0xf3, 0xcf, 0x35, 0xd8, 0x4c, 0x4d, 0x2a, 0x5f, 0xf6,
-	0x47, 0x80, 0xc6, 0x0e, 0xd5, 0x14, 0xe4, 0xce, 0xb0, 0xd5, 0xdf, 0x94, 0x56, 0x5f, 0xbe, 0xf5,
-	0x98, 0x35, 0x5a, 0x45, 0xe9, 0x5d, 0x17, 0xd6, 0xa6, 0x6e, 0x06, 0xa5, 0xb9, 0xab, 0xdc, 0x4e,
-	0x56, 0x79, 0x55, 0x99, 0xa2, 0xf1, 0x01, 0xe8, 0xa4, 0xd3, 0x74, 0x2b, 0x09, 0x1e, 0xb8, 0x0d,
-	0xcb, 0x6c, 0xcb, 0xc9, 0x6b, 0x0f, 0x0c, 0x44, 0xf9, 0xe7, 0x2f, 0xcc, 0x41, 0x2d, 0xaa, 0xf5,
-	0x6b, 0xc3, 0x3a, 0xdb, 0xb0, 0x2a, 0x96, 0x9e, 0x8d, 0x3e, 0xd6, 0x83, 0x17, 0xcc, 0x1a, 0x5f,
-	0x75, 0x5a, 0xc2, 0x16, 0xfc, 0x3f, 0xcc, 0x03, 0x92, 0x67, 0x81, 0xaf, 0xf5, 0x2e, 0x2c, 0xb2,
-	0xfa, 0x7c, 0x7d, 0xdf, 0x91, 0x56, 0x25, 0x8d, 0xbe, 0xcd, 0xbe, 0xc5, 0x1a, 0xf1, 0xaa, 0xe8,
-	0x33, 0x58, 0xa0, 0x9d, 0xa6, 0x73, 0xb1, 0xbc, 0xf3, 0xf6, 0x6c, 0x1a, 0x0a, 0xdb, 0xb0, 0x8a,
-	0x8d, 0x3f, 0x9e, 0x83, 0x8a, 0x42, 0x1b, 0x7d, 0x3b, 0xd1, 0xb1, 0x4b, 0xd8, 0x45, 0x74, 0xe5,
-	0xbb, 0xb0, 0x44, 0x85, 0x3f, 0xf6, 0x79, 0x67, 0x2e, 0xa9, 0x27, 0xb0, 0xd1, 0x9f, 0x86, 0x0a,
-	0x9f, 0xc8, 0x20, 0xb4, 0xc3, 0x69, 0xc0, 0x15, 0xbf, 0x8f, 0xae, 0x31, 0x1f, 0xfc, 0xab, 0x47,
-	0xeb, 0x9b, 0xe5, 0x50, 0xfa, 0x32, 0x7e, 0x0c, 0x65, 0xb9, 0x14, 0x2d, 0xc3, 0xd2, 0xb3, 0xce,
-	0xd3, 0xce, 0xe1, 0xe7, 0x1d, 0xfd, 0x1b, 0xec, 0xe3, 0xa0, 0xdd, 0x69, 0xed, 0xe9, 0x1a, 0x2a,
-	0x43, 0xb1, 0x7d, 0x70, 0xd0, 0xec, 0x3f, 0xa3, 0xaa, 0x5b, 0x11, 0xe6, 0xf7, 0xdb, 0xcf, 0x5b,
-	0x7a, 0x01, 0x95, 0x60, 0xe1, 0xf9, 0x61, 0xbf, 0xb5, 0xa7, 0xcf, 0x23, 0x80, 0xc5, 0x83, 0x76,
-	0xaf, 0xd7, 0xda, 0xd3, 0x17, 0x48, 0xdd, 0xd6, 0x8b, 0x6e, 0xdb, 0x6c, 0xed, 0xe9, 0x8b, 0xcc,
-	0xaa, 0xf1, 0xfc, 0xf0, 0x69, 0x6b, 0x4f, 0x5f, 0x6a, 0xbc, 0xf8, 0x65, 0xd9, 0x25, 0x8c, 0x35,
-	0x40, 0x6c, 0x30, 0x5d, 0xdf, 0x89, 0x14, 0x02, 0xa3, 0x0b, 0xab, 0x0a, 0x34, 0x56, 0x3e, 0xf8,
-	0xc4, 0x4e, 0x08, 0x9c, 0x1f, 0xde, 0x7c, 0xcf, 0x52, 0xd4, 0xbc, 0x5e, 0x18, 0x08, 0x74, 0x7a,
-	0xd4, 0xb6, 0xdd, 0x63, 0x4f, 0xb4, 0xf2, 0xc7, 0x73, 0x50, 0x93, 0x80, 0xbc, 0x91, 0x2d, 0x28,
-	0x4d, 0x3c, 0x6f, 0x64, 0x05, 0xce, 0x4f, 0x30, 0xd7, 0x43, 0x8a, 0x04, 0xd0, 0x73, 0x7e, 0x82,
-	0x89, 0x0e, 0x69, 0x8f, 0x46, 0xd6, 0x18, 0x8f, 0x29, 0x4e, 0xe8, 0xbc, 0xe2, 0x5a, 0x66, 0xc5,
-	0x1e, 0x8d, 0x0e, 0x18, 0xb4, 0xef, 0xbc, 0x22, 0x78, 0xde, 0xb9, 0xab, 0xe0, 0x31, 0xc3, 0x68,
-	0xc5, 0x3b, 0x77, 0x25, 0xbc, 0x06, 0x14, 0x85, 0x26, 0xc0, 0x6f, 0xa9, 0xd1, 0x37, 0x99, 0xe4,
-	0x91, 0x73, 0x86, 0xf9, 0x7d, 0x94, 0xfe, 0x26, 0x7a, 0xcb, 0x99, 0x17, 0xe2, 0x21, 0xbf, 0x76,
-	0xb2, 0x0f, 0x32, 0xe8, 0xb1, 0x13, 0x04, 0xfc, 0x60, 0xaf, 0x98, 0xfc, 0x8b, 0xe8, 0xc2, 0x3e,
-	0x3e, 0xf3, 0x5e, 0xe2, 0x61, 0xbd, 0xc8, 0x74, 0x61, 0xfe, 0x49, 0x4a, 0xf0, 0xab, 0x09, 0xd1,
-	0x95, 0xea, 0x25, 0x56, 0xc2, 0x3f, 0xe3, 0x6b, 0x76, 0x30, 0x3d, 0x0a, 0x9c, 0xe1, 0x45, 0x1d,
-	0xa4, 0x6b, 0x76, 0x8f, 0xc1, 0x48, 0xf5, 0xa9, 0x4b, 0xd8, 0x3d, 0xac, 0x2f, 0xb3, 0xea, 0xfc,
-	0xd3, 0xe8, 0x83, 0x4e, 0x39, 0x45, 0x9a, 0xe7, 0xc4, 0xa1, 0xac, 0x25, 0x0e, 0x65, 0x7a, 0x4b,
-	0x4d, 0x4a, 0x41, 0x72, 0x4b, 0x8d, 0x25, 0x94, 0xf1, 0xd7, 0xe6, 0xa0, 0x26, 0x91, 0xe5, 0x2b,
-	0xf5, 0x0b, 0xd3, 0x4d, 0x2b, 0x15, 0x85, 0x2c, 0xa5, 0x42, 0xe1, 0xe0, 0xf9, 0xa4, 0x65, 0x4d,
-	0x6a, 0xc6, 0x26, 0xb2, 0x62, 0x81, 0x19, 0x97, 0x79, 0x33, 0x04, 0x44, 0xee, 0xcc, 0x4c, 0x0f,
-	0x74, 0xdc, 0x33, 0x7b, 0xe4, 0x0c, 0x6d, 0xb1, 0x82, 0x45, 0x53, 0x0f, 0x18, 0x03, 0x46, 0xf0,
-	0x2c, 0x4b, 0xdd, 0x52, 0x96, 0xa5, 0xce, 0xf8, 0x43, 0x0d, 0x36, 0x77, 0x4f, 0x6d, 0xf7, 0x04,
-	0x77, 0xa3, 0x3b, 0x83, 0x98, 0xf2, 0x8f, 0xa0, 0x40, 0x6e, 0x56, 0x1a, 0x15, 0x3c, 0x6f, 0x4a,
-	0x82, 0x27, 0xa7, 0xc2, 0x36, 0xb9, 0xaf, 0x90, 0x2a, 0x44, 0x17, 0xf7, 0x46, 0x43, 0x4b, 0xba,
-	0x98, 0xb0, 0xcb, 0x47, 0xc5, 0x1b, 0x0d, 0xe3, 0x6a, 0x04, 0xcd, 0xc5, 0xe7, 0x32, 0x1a, 0x3b,
-	0x8c, 0x2a, 0x2e, 0x3e, 0x8f, 0xd1, 0x8c, 0xd7, 0xa0, 0xf0, 0x14, 0x5f, 0x10, 0x61, 0xd2, 0x35,
-	0xdb, 0xcf, 0x9b, 0xfd, 0x96, 0xfe, 0x0d, 0x22, 0x72, 0xba, 0xcf, 0x1e, 0xee, 0xb7, 0x77, 0x75,
-	0x8d, 0x5c, 0x9b, 0xd2, 0x3d, 0xe2, 0xd7, 0xa6, 0x9f, 0xce, 0xc1, 0xc6, 0xa3, 0xa9, 0x3b, 0xcc,
-	0xd0, 0x49, 0x67, 0xdb, 0x13, 0xd9, 0x59, 0xc6, 0xad, 0xbf, 0xc2, 0x9e, 0x48, 0x81, 0xcc, 0xe4,
-	0x3c, 0xe3, 0x22, 0x51, 0x98, 0x71, 0x91, 0x40, 0x1f, 0x43, 0xc3, 0x71, 0x07, 0xa3, 0xe9, 0x10,
-	0x5b, 0x91, 0x7e, 0x3f, 0xf0, 0x1c, 0xf7, 0xc8, 0x0e, 0x70, 0xc0, 0x2f, 0x8b, 0x75, 0x8e, 0xd1,
-	0xe6, 0x08, 0xbb, 0xa2, 0x9c, 0x9c, 0xfa, 0xa2, 0xf6, 0x80, 0x0e, 0x59, 0x98, 0x99, 0xd9, 0x1d,
-	0x6c, 0x95, 0x17, 0xb2, 0xe9, 0xe0, 0xd6, 0xe6, 0x7f, 0x59, 0x80, 0xcd, 0xd4, 0x14, 0x70, 0xee,
-	0xff, 0x53, 0xa0, 0x07, 0x78, 0x84, 0x07, 0x21, 0x1e, 0x5a, 0xcc, 0x44, 0x2d, 0xcc, 0x81, 0xdf,
-	0x92, 0xd6, 0x3b, 0xa7, 0xf6, 0x76, 0x97, 0x1b, 0xe1, 0xf9, 0x53, 0xc4, 0x8a, 0x20, 0xc5, 0xbe,
-	0x03, 0x2a, 0x6a, 0xa9, 0x18, 0x50, 0xa6, 0x71, 0x99, 0xc2, 0xf8, 0x2c, 0xde, 0x07, 0x9d, 0x0f,
-	0x64, 0xf2, 0x52, 0x8c, 0x85, 0x31, 0x41, 0x95, 0xc1, 0xbb, 0x2f, 0xd9, 0x30, 0x1a, 0x7f, 0xa2,
-	0x41, 0x55, 0x6d, 0xf0, 0x1a, 0xb7, 0x0a, 0xd2, 0x15, 0x6e, 0x97, 0x67, 0x8f, 0x03, 0x4c, 0xe0,
-	0x2e, 0x33, 0x58, 0x9b, 0x3e, 0x11, 0xc4, 0xc6, 0xfe, 0x82, 0x62, 0xec, 0x27, 0xb2, 0x3c, 0xea,
-	0xdb, 0x3c, 0x25, 0x5f, 0x9c, 0xf0, 0x5e, 0x11, 0xba, 0x44, 0x53, 0x76, 0xce, 0xb0, 0x45, 0x76,
-	0x33, 0xbf, 0x65, 0x2d, 0x73, 0x58, 0xdf, 0x61, 0x36, 0x47, 0x72, 0x99, 0x8e, 0x56, 0x99, 0x6f,
-	0xda, 0x32, 0x01, 0x8a, 0x95, 0x25, 0x72, 0x3a, 0xf4, 0x31, 0x7b, 0x81, 0x59, 0x30, 0xe9, 0x6f,
-	0xe3, 0x8f, 0x34, 0x58, 0x7f, 0xc6, 0x44, 0x22, 0x9f, 0xd1, 0x5f, 0x61, 0xd6, 0x35, 0xfe, 0xfa,
-	0x5c, 0x62, 0x34, 0x11, 0x13, 0xfe, 0x7a, 0x2f, 0x23, 0x39, 0x61, 0x58, 0x17, 0xac, 0x60, 0x3a,
-	0xa6, 0x67, 0x68, 0xc1, 0x2c, 0x31, 0x48, 0x6f, 0x3a, 0x36, 0x7e, 0xba, 0x08, 0x5b, 0xbb, 0x9e,
-	0x1b, 0x84, 0xfe, 0x74, 0x90, 0x75, 0x75, 0xbe, 0x07, 0xd5, 0xc0, 0x9b, 0xfa, 0x03, 0x6c, 0xa9,
-	0x4b, 0x5e, 0x61, 0x50, 0x61, 0x23, 0xff, 0x6a, 0x76, 0x0d, 0x74, 0x13, 0xe0, 0x18, 0x63, 0x6b,
-	0x82, 0x7d, 0xeb, 0xe5, 0x11, 0x5f, 0xfe, 0xe2, 0x31, 0xc6, 0x5d, 0xec, 0x3f, 0x3d, 0x42, 0x7f,
-	0x0e, 0x1a, 0xe2, 0x35, 0x8b, 0x6e, 0x6d, 0xb2, 0x3c, 0xf6, 0xe8, 0xc4, 0xf3, 0x9d, 0xf0, 0x94,
-	0x59, 0x87, 0xaa, 0x3b, 0x9f, 0xca, 0x07, 0x43, 0xfe, 0x38, 0xf8, 0x7b, 0x65, 0x4f, 0xd0, 0x69,
-	0x0a, 0x32, 0x66, 0xdd, 0xcb, 0x29, 0x41, 0x5f, 0x02, 0x72, 0xc9, 0xfd, 0x91, 0x09, 0x08, 0x21,
-	0x9f, 0x16, 0xa8, 0x7c, 0x7a, 0xef, 0x5a, 0xcd, 0x9a, 0xba, 0xeb, 0xb9, 0x4c, 0x2a, 0x0a, 0xe1,
-	0x74, 0x02, 0x88, 0x13, 0x1e, 0xe2, 0x20, 0x74, 0x5c, 0x66, 0x59, 0x59, 0xa4, 0x4a, 0xfa, 0x47,
-	0xd7, 0x22, 0xbe, 0x17, 0xd7, 0x37, 0x6b, 0x8c, 0xa6, 0x04, 0x6a, 0x8c, 0xa0, 0x96, 0xc2, 0x9b,
-	0x61, 0xd6, 0xcc, 0x33, 0xd8, 0x11, 0x3e, 0xa0, 0xbf, 0x2c, 0xfe, 0x94, 0x2e, 0x94, 0x41, 0x06,
-	0xe5, 0x0f, 0xf1, 0x8d, 0x3f, 0x1b, 0x3d, 0x84, 0xfe, 0x08, 0x96, 0xe5, 0x91, 0x69, 0xbf, 0xe0,
-	0xc8, 0x64, 0x62, 0xd2, 0x26, 0x9b, 0x93, 0x37, 0x99, 0xf1, 0x21, 0xd4, 0xf3, 0xd6, 0x19, 0xad,
-	0xc0, 0xb2, 0x6a, 0x33, 0x5e, 0x82, 0x42, 0x73, 0x7f, 0x5f, 0xd7, 0x8c, 0xbf, 0x31, 0x07, 0x37,
-	0xb3, 0x3b, 0xc3, 0x25, 0xc4, 0xb7, 0xc8, 0xcd, 0x3d, 0x70, 0x4e, 0x12, 0x57, 0x77, 0x2e, 0x25,
-	0x56, 0x45, 0x99, 0x54, 0x15, 0x7d, 0x0a, 0x37, 0xd9, 0xd9, 0x13, 0x3d, 0x20, 0x73, 0x4e, 0x56,
-	0xfa, 0x7d, 0x83, 0xe2, 0xa8, 0xc7, 0x0a, 0x17, 0x92, 0xe4, 0x42, 0x4b, 0x09, 0xa8, 0xf5, 0x98,
-	0x50, 0xa9, 0xd1, 0x22, 0x05, 0x7f, 0x07, 0xd6, 0xc9, 0x04, 0x8d, 0x89, 0xfe, 0x65, 0xf1, 0xbe,
-	0x52, 0xf5, 0x9f, 0xa9, 0xe4, 0xab, 0x51, 0x61, 0x8f, 0x96, 0xd1, 0x9b, 0xc0, 0x5d, 0x28, 0x73,
-	0x1e, 0x64, 0xe2, 0x8c, 0xdd, 0x96, 0x97, 0x19, 0x8c, 0x8a, 0x33, 0xe3, 0x7f, 0xcf, 0xc1, 0x06,
-	0xa9, 0x91, 0x21, 0x19, 0x2e, 0x33, 0xfd, 0x7e, 0x1b, 0x36, 0x02, 0xec, 0x3b, 0xf6, 0xc8, 0xf9,
-	0x49, 0x62, 0xde, 0x18, 0x67, 0xad, 0xc7, 0xa5, 0xf2, 0xcc, 0xd9, 0x80, 0xec, 0xe1, 0xd0, 0x21,
-	0xbf, 0x89, 0x06, 0x4f, 0xb9, 0x4b, 0x3c, 0xe1, 0xee, 0x48, 0xec, 0x93, 0xdd, 0xab, 0xed, 0x66,
-	0x54, 0x97, 0x5b, 0x7d, 0x6b, 0x76, 0x02, 0x12, 0x34, 0xfe, 0xaa, 0x06, 0x7a, 0x12, 0xef, 0x6b,
-	0x3e, 0x06, 0x84, 0x24, 0x2e, 0x48, 0x92, 0x78, 0xd6, 0x11, 0xf0, 0xc3, 0xf9, 0x62, 0x41, 0x9f,
-	0x37, 0x2b, 0x8e, 0x1b, 0x91, 0xc5, 0xe4, 0x9a, 0xbc, 0x99, 0x1a, 0x26, 0xe7, 0xc9, 0x3b, 0x69,
-	0x63, 0x64, 0xc2, 0x1d, 0xe4, 0x43, 0xd8, 0x88, 0xb8, 0x56, 0x21, 0x4b, 0x2d, 0x4e, 0x15, 0x33,
-	0xe2, 0x69, 0xea, 0x2d, 0xd1, 0xe6, 0x4d, 0xfe, 0xd7, 0x42, 0xaa, 0xcd, 0xe0, 0xaa, 0x2b, 0xfe,
-	0xa3, 0xc4, 0xbb, 0x3b, 0xb3, 0x6c, 0x7d, 0x27, 0x7f, 0xd1, 0xa2, 0x17, 0xa3, 0x67, 0xe9, 0x2d,
-	0xa4, 0x3e, 0xc8, 0xa3, 0xa3, 0x4c, 0xb6, 0x60, 0x8e, 0x2e, 0x1f, 0x5c, 0xa1, 0x85, 0x5f, 0x51,
-	0xbe, 0x68, 0xec, 0xc3, 0x6a, 0xc6, 0xe4, 0xcc, 0xd8, 0x5c, 0xda, 0x8c, 0xcd, 0x65, 0xfc, 0x37,
-	0x0d, 0xea, 0xe9, 0x19, 0xe2, 0x2c, 0xf5, 0x45, 0x62, 0xf9, 0x98, 0x26, 0xfe, 0xed, 0x99, 0x93,
-	0xcb, 0x55, 0xf1, 0xde, 0xec, 0xd5, 0x6b, 0xbc, 0x84, 0x5a, 0x0a, 0xe5, 0x97, 0xc6, 0xc2, 0xff,
-	0xb8, 0x00, 0x1b, 0xbb, 0x3e, 0xb6, 0x43, 0x4c, 0xda, 0xe4, 0xaf, 0x1a, 0x57, 0x7f, 0x79, 0xe3,
-	0xe7, 0xe2, 0x9c, 0x7a, 0x2e, 0xe6, 0x4f, 0x78, 0x61, 0x96, 0x34, 0xbb, 0x0d, 0xcb, 0x52, 0xc7,
-	0xb9, 0x30, 0x06, 0x27, 0xea, 0x2e, 0xfa, 0x21, 0x94, 0x08, 0x4b, 0x31, 0x07, 0xa8, 0x85, 0x94,
-	0x03, 0x54, 0xf6, 0x38, 0xc8, 0x7c, 0x13, 0x8e, 0xa3, 0x0e, 0x50, 0xc5, 0x53, 0xfe, 0x0b, 0xbd,
-	0x0b, 0x28, 0x3a, 0x6e, 0x62, 0x8e, 0x62, 0x2e, 0x40, 0x91, 0xd3, 0x93, 0xb8, 0xd1, 0x18, 0x7f,
-	0x49, 0x83, 0x65, 0x89, 0x0e, 0x39, 0x20, 0x7b, 0xed, 0xc7, 0x4f, 0x9a, 0xbd, 0x27, 0xd6, 0xe1,
-	0x3e, 0x39, 0x20, 0x25, 0x00, 0x3d, 0x28, 0x91, 0x0e, 0x65, 0x01, 0xe8, 0x1c, 0x76, 0x5a, 0xfa,
-	0x1c, 0x42, 0x50, 0x15, 0x90, 0x5e, 0xbb, 0xf3, 0x78, 0xbf, 0xa5, 0x17, 0xd0, 0x1a, 0xe8, 0x52,
-	0xb5, 0xe7, 0xcd, 0xfd, 0x67, 0x2d, 0x7d, 0x1e, 0xdd, 0x80, 0xb5, 0x08, 0xda, 0xf9, 0xe2, 0xb0,
-	0xd3, 0xda, 0x6d, 0x76, 0xba, 0xcd, 0x2f, 0xf4, 0x9f, 0x6a, 0xc6, 0x73, 0xd8, 0x4c, 0x0d, 0x93,
-	0xb3, 0xe4, 0x4d, 0x28, 0x05, 0x02, 0x28, 0xac, 0x23, 0x11, 0x20, 0xe3, 0x09, 0xb6, 0x2c, 0x3f,
-	0xc1, 0xfe, 0x10, 0x6e, 0x74, 0xc9, 0x47, 0x70, 0x9a, 0x71, 0x7a, 0xbd, 0x07, 0x28, 0xf7, 0x44,
-	0xaf, 0xa5, 0xf6, 0x9b, 0xf1, 0x18, 0x1a, 0x59, 0xb4, 0xae, 0x7d, 0x85, 0x30, 0x5e, 0x87, 0xbb,
-	0x9c, 0xd0, 0xb3, 0xb4, 0x45, 0x5f, 0x58, 0xf5, 0xde, 0x00, 0x63, 0x16, 0x92, 0x30, 0x2e, 0x14,
-	0x60, 0xa3, 0x3b, 0xf5, 0x07, 0xa7, 0x76, 0x80, 0x13, 0xe6, 0xfc, 0xaf, 0xfe, 0xc2, 0x7c, 0x1b,
-	0x96, 0xa9, 0x0d, 0xd8, 0x1a, 0x39, 0x63, 0x47, 0xe8, 0x1b, 0x40, 0x41, 0xfb, 0x04, 0x32, 0x43,
-	0xd3, 0x67, 0xcc, 0x9d, 0xa3, 0xe9, 0xdf, 0x83, 0x2a, 0xb7, 0x7b, 0xaa, 0xae, 0x6b, 0xdc, 0xcc,
-	0x2c, 0x1e, 0x5e, 0x6f, 0xc3, 0xb2, 0x3b, 0x1d, 0x47, 0xaf, 0x86, 0xcc, 0x44, 0x08, 0xee, 0x74,
-	0x2c, 0x1e, 0x0c, 0xef, 0x42, 0x99, 0x9a, 0x23, 0x05, 0x95, 0x25, 0xfe, 0x78, 0xeb, 0x79, 0x23,
-	0x41, 0x43, 0x58, 0x3f, 0x8f, 0x31, 0x0e, 0xe8, 0x85, 0x47, 0x63, 0xd6, 0xcf, 0x47, 0x18, 0x53,
-	0xfd, 0x96, 0x9a, 0x09, 0x2f, 0xb8, 0xd1, 0x90, 0x7f, 0xa1, 0x75, 0x58, 0x0c, 0x5f, 0x91, 0x2a,
-	0xdc, 0x58, 0xb8, 0x10, 0xbe, 0x7a, 0xc4, 0x6e, 0x4f, 0xbc, 0xdb, 0xa4, 0x68, 0x59, 0x18, 0xce,
-	0x08, 0xe4, 0x11, 0xc6, 0xc6, 0x27, 0xb0, 0x99, 0x5a, 0x01, 0xce, 0x13, 0xaf, 0x47, 0x16, 0x74,
-	0xc2, 0x0e, 0x98, 0x89, 0xd3, 0xb2, 0xb0, 0x83, 0x3f, 0xa1, 0x30, 0xe3, 0x3b, 0xb0, 0x66, 0x52,
-	0x73, 0xe6, 0xf5, 0xd6, 0x8f, 0xf9, 0xcf, 0x28, 0xf5, 0x38, 0x4f, 0xbc, 0x06, 0x37, 0xf7, 0x3d,
-	0x7b, 0xd8, 0xa4, 0x0e, 0x61, 0x7b, 0x76, 0x68, 0x3f, 0x72, 0x46, 0x21, 0xf6, 0x23, 0xce, 0xba,
-	0x0d, 0xb7, 0x72, 0xca, 0x39, 0x81, 0x53, 0x40, 0x64, 0x1b, 0x1e, 0xe0, 0x20, 0xb0, 0x4f, 0xb0,
-	0x7c, 0xe3, 0xcf, 0xbe, 0x2f, 0xd4, 0x61, 0x69, 0xcc, 0x70, 0x85, 0xc4, 0xe4, 0x9f, 0x89, 0x31,
-	0x14, 0x52, 0x63, 0xf8, 0x00, 0x56, 0x95, 0x96, 0xae, 0xb2, 0xe5, 0x8d, 0x3f, 0xd0, 0x94, 0x5a,
-	0x57, 0x66, 0xf8, 0x87, 0x50, 0xe4, 0xfd, 0x12, 0x6a, 0xc9, 0x9b, 0x89, 0x73, 0x2d, 0x41, 0x71,
-	0x5b, 0xf4, 0x2b, 0xaa, 0xd7, 0xf8, 0x01, 0x2c, 0x71, 0xe0, 0x57, 0x99, 0x0f, 0xe3, 0x6f, 0x6b,
-	0xb0, 0xa6, 0x36, 0x14, 0x3d, 0x3a, 0x2d, 0xf9, 0x78, 0x32, 0x72, 0xb0, 0x38, 0x72, 0xbf, 0x99,
-	0xdb, 0x35, 0xe9, 0xb8, 0x35, 0xf1, 0x64, 0x74, 0x61, 0x8a, 0x9a, 0x8d, 0x4f, 0xa1, 0x14, 0x41,
-	0x2f, 0x11, 0x9b, 0x6b, 0xb0, 0x80, 0x7d, 0x9f, 0x7b, 0x3f, 0x97, 0x4c, 0xf6, 0x61, 0xdc, 0x85,
-	0xdb, 0x92, 0x94, 0xe9, 0x78, 0xa1, 0x73, 0xec, 0x0c, 0x6c, 0x45, 0x2c, 0xfd, 0xee, 0x1c, 0xdc,
-	0xc9, 0xc7, 0xe1, 0xa3, 0xf9, 0x0c, 0x56, 0xec, 0x30, 0xb4, 0x07, 0xa7, 0x78, 0xc8, 0xde, 0xee,
-	0xc4, 0xa8, 0x72, 0xdf, 0x4a, 0xab, 0x02, 0x9f, 0x42, 0x03, 0xf4, 0x16, 0xac, 0x0c, 0xb1, 0x4a,
-	0x61, 0x8e, 0xee, 0x9d, 0xaa, 0x00, 0x73, 0xc4, 0xbc, 0x17, 0xd5, 0xc2, 0x57, 0x7d, 0x51, 0x45,
-	0x1f, 0x43, 0x23, 0x83, 0xa2, 0xd8, 0xc1, 0xf3, 0xb4, 0x17, 0xf5, 0x74, 0x45, 0xbe, 0x9b, 0x6f,
-	0xc1, 0x96, 0xf0, 0x9e, 0xcc, 0x9a, 0xbe, 0xff, 0xa5, 0xc1, 0xcd, 0xec, 0xf2, 0x6b, 0xb9, 0x7e,
-	0x5d, 0xc5, 0xd1, 0x30, 0xdb, 0x87, 0xb0, 0x70, 0x2d, 0x1f, 0xc2, 0xf9, 0x6b, 0xf9, 0x10, 0x2e,
-	0xe4, 0xf8, 0x10, 0xfe, 0x16, 0xdc, 0x91, 0x0f, 0x82, 0xac, 0x89, 0x21, 0x02, 0x3b, 0x7c, 0xa5,
-	0x8a, 0xc9, 0x62, 0xf8, 0x8a, 0x4d, 0x2a, 0x91, 0xc0, 0x41, 0xe8, 0x4d, 0x2c, 0xfb, 0x38, 0xe4,
-	0xaf, 0x98, 0x0b, 0x66, 0x89, 0x40, 0x9a, 0x04, 0x60, 0xfc, 0xfe, 0x1c, 0xdc, 0x9d, 0xd1, 0x00,
-	0x9f, 0xd9, 0x97, 0xc9, 0x47, 0x12, 0xc6, 0x92, 0x2d, 0xd5, 0x1c, 0x31, 0x9b, 0xc8, 0xb6, 0xe2,
-	0x35, 0x20, 0x11, 0x4b, 0xbc, 0xb5, 0x34, 0xfe, 0x96, 0x06, 0xf5, 0x3c, 0x5c, 0xb4, 0x09, 0x4b,
-	0x7c, 0xac, 0x7c, 0x63, 0x2e, 0xb2, 0x91, 0x7e, 0x2d, 0xce, 0x21, 0xa9, 0xf7, 0xa2, 0xf9, 0xf4,
-	0x3b, 0xd4, 0x5f, 0xd4, 0x60, 0x95, 0xa9, 0x5b, 0x9f, 0xd3, 0xb1, 0x8b, 0x45, 0x78, 0x07, 0x6a,
-	0x5c, 0x99, 0x4a, 0x09, 0x52, 0x9d, 0x15, 0x48, 0x4f, 0x27, 0xef, 0x11, 0x4d, 0x93, 0xf9, 0xa1,
-	0xa5, 0x5e, 0x59, 0x6a, 0xbc, 0x44, 0x42, 0x47, 0x30, 0x1f, 0x60, 0x3c, 0xe4, 0xfd, 0xa5, 0xbf,
-	0x8d, 0x0d, 0x58, 0x53, 0xbb, 0xc1, 0x0f, 0xa0, 0x57, 0x70, 0x5b, 0xc0, 0xc3, 0xc1, 0xa9, 0xe3,
-	0x9e, 0x1c, 0xba, 0xa3, 0x0b, 0xb5, 0xab, 0xf7, 0x81, 0xf2, 0xb0, 0x3b, 0xc4, 0x43, 0x6b, 0x32,
-	0x3d, 0xb2, 0xc4, 0x33, 0x51, 0xc9, 0xac, 0x0a, 0x78, 0x77, 0x7a, 0xf4, 0x14, 0x5f, 0x64, 0x0f,
-	0x6a, 0x2e, 0x7b, 0x50, 0x86, 0x01, 0x77, 0xf2, 0x5b, 0xe6, 0xbd, 0xfb, 0x0c, 0x6a, 0x87, 0x13,
-	0xec, 0x7e, 0xf5, 0xa9, 0x33, 0x7e, 0x03, 0x90, 0x4c, 0x21, 0xd6, 0x16, 0xce, 0x79, 0xab, 0x96,
-	0xe7, 0x8e, 0xd8, 0x78, 0x8a, 0x66, 0xf9, 0x5c, 0xea, 0x8a, 0xb1, 0x06, 0x68, 0x77, 0xe4, 0x05,
-	0xea, 0xc2, 0x19, 0xeb, 0xb0, 0xaa, 0x40, 0x79, 0x4f, 0xd7, 0x61, 0x95, 0x41, 0x5a, 0xaf, 0x9c,
-	0x20, 0x76, 0xc7, 0xde, 0x86, 0x35, 0x15, 0xcc, 0x3b, 0x40, 0xf5, 0x22, 0x02, 0xe1, 0x2d, 0xf3,
-	0x2f, 0xe3, 0x77, 0xc9, 0x8d, 0x31, 0xb4, 0xfd, 0x70, 0x97, 0xa0, 0xb9, 0xc1, 0x34, 0x30, 0x27,
-	0x03, 0x31, 0xf0, 0xb7, 0x60, 0x85, 0x7b, 0xa2, 0x27, 0x9c, 0xe9, 0xaa, 0x1c, 0x2c, 0x54, 0xb2,
-	0x06, 0x14, 0xa7, 0x01, 0x11, 0x23, 0x91, 0xb8, 0x8a, 0xbe, 0x49, 0x19, 0x99, 0xb6, 0x73, 0xcf,
-	0x17, 0x0c, 0x12, 0x7d, 0x93, 0x2b, 0xe2, 0x00, 0xfb, 0x7c, 0x33, 0x62, 0x7e, 0x39, 0x96, 0x41,
-	0xc6, 0x16, 0xdc, 0xc8, 0xe8, 0x1e, 0x9f, 0x83, 0xbf, 0xaf, 0x41, 0x7d, 0xcf, 0x09, 0x06, 0xde,
-	0x19, 0xf6, 0x79, 0x57, 0x62, 0x95, 0xe1, 0x1d, 0xa8, 0x0d, 0x79, 0x99, 0x25, 0x39, 0xa3, 0xd3,
-	0x17, 0x4d, 0x51, 0x20, 0x3c, 0xd1, 0xaf, 0xcb, 0xf0, 0x39, 0xee, 0x34, 0x85, 0x1c, 0x77, 0x1a,
-	0x32, 0x8a, 0x8c, 0x7e, 0xf2, 0x51, 0xdc, 0x82, 0xad, 0x47, 0x38, 0x1c, 0x9c, 0x1e, 0x38, 0x41,
-	0xe0, 0xb8, 0x27, 0xbb, 0x09, 0x95, 0xee, 0x35, 0xb8, 0x99, 0x5d, 0xcc, 0xab, 0xbf, 0x09, 0x6f,
-	0xf4, 0xa6, 0x47, 0xe4, 0x32, 0x78, 0x84, 0xfb, 0x1e, 0x6d, 0x33, 0xf3, 0x78, 0x7a, 0x0b, 0xee,
-	0x5d, 0x82, 0x17, 0x73, 0x16, 0x6d, 0x90, 0xbd, 0x0c, 0x47, 0xf5, 0xff, 0xe1, 0x1c, 0xac, 0xa9,
-	0x70, 0xce, 0x5a, 0x3b, 0xb0, 0x7e, 0x4c, 0xe0, 0x78, 0xc8, 0xdf, 0x97, 0x03, 0x4b, 0x7e, 0x49,
-	0x58, 0xe5, 0x85, 0xbc, 0x1a, 0x3b, 0x64, 0xde, 0x87, 0xb5, 0x63, 0xc7, 0x0f, 0x42, 0xcb, 0xc5,
-	0xe7, 0x69, 0x0f, 0xff, 0x1a, 0x2d, 0xeb, 0xe0, 0xf3, 0xd8, 0x61, 0xe8, 0x03, 0xd8, 0x48, 0x55,
-	0x90, 0x9d, 0xfc, 0x57, 0xd5, 0x2a, 0xec, 0xed, 0xfc, 0x23, 0xb8, 0x31, 0xb6, 0x1d, 0x6a, 0xe2,
-	0x77, 0x5c, 0x2b, 0x74, 0x26, 0x72, 0x53, 0x8c, 0xd9, 0xd6, 0x09, 0xc2, 0x2e, 0x29, 0xef, 0x3b,
-	0x93, 0xb8, 0xb9, 0x8f, 0x61, 0x2b, 0xbb, 0x26, 0x6b, 0x93, 0x59, 0x52, 0x37, 0xd3, 0x75, 0x99,
-	0x0c, 0x7e, 0x05, 0x75, 0x79, 0xa6, 0xe4, 0x69, 0x9e, 0x3d, 0x5b, 0x0b, 0xd9, 0xb3, 0x75, 0x1f,
-	0xf4, 0x91, 0x1d, 0x84, 0xbc, 0x02, 0x7b, 0x43, 0x62, 0x16, 0xe6, 0x2a, 0x81, 0x33, 0xdc, 0xbe,
-	0x33, 0xc6, 0xc6, 0xdf, 0xd3, 0xe0, 0x4e, 0x16, 0xb7, 0x28, 0x5d, 0x68, 0xc2, 0x2d, 0xd1, 0x85,
-	0xc1, 0x31, 0x2b, 0xb7, 0x28, 0xcf, 0xaa, 0x4e, 0xf8, 0x0d, 0x8e, 0xb4, 0xcb, 0x71, 0xe8, 0x3e,
-	0xe4, 0x33, 0xfb, 0x03, 0xd8, 0x4a, 0x91, 0x20, 0xb7, 0x4a, 0xc5, 0x8f, 0xa1, 0x9e, 0x20, 0xd0,
-	0x72, 0x87, 0x7c, 0x82, 0xda, 0xd0, 0x60, 0x3e, 0xfb, 0x5d, 0xdf, 0x3b, 0x21, 0xdb, 0x41, 0xe9,
-	0xdf, 0xb5, 0xfc, 0xf7, 0x9f, 0x82, 0xde, 0xc5, 0xd8, 0x57, 0x08, 0xdc, 0x02, 0x98, 0x60, 0xec,
-	0x2b, 0x13, 0x5b, 0x22, 0x90, 0xdd, 0x64, 0x7c, 0x95, 0x6a, 0x05, 0x32, 0xfe, 0x44, 0x83, 0xaa,
-	0x39, 0x19, 0xf4, 0x2e, 0xdc, 0xff, 0x87, 0x64, 0x60, 0xb6, 0x24, 0x5b, 0xb8, 0x96, 0x24, 0x5b,
-	0xcc, 0x91, 0x64, 0xc6, 0xbf, 0x2e, 0xc0, 0x4a, 0x34, 0xe2, 0xf8, 0xac, 0x08, 0x2e, 0xdc, 0x01,
-	0x1e, 0x8a, 0xb3, 0x82, 0x7d, 0xa1, 0x7d, 0xa8, 0xb9, 0xd2, 0x34, 0x33, 0x9b, 0x16, 0x8b, 0x37,
-	0xb8, 0x2d, 0x5f, 0x69, 0x2e, 0xdc, 0x81, 0xbc, 0x1c, 0xd4, 0x8a, 0xa5, 0xbb, 0x09, 0x08, 0x7a,
-	0x02, 0x15, 0xca, 0x1f, 0x62, 0x1b, 0xd0, 0x89, 0x51, 0x03, 0x85, 0xf2, 0x36, 0x91, 0x59, 0x3e,
-	0x96, 0x4a, 0x90, 0x0d, 0x1b, 0x8c, 0xd2, 0x98, 0x31, 0x7d, 0xc4, 0x92, 0x74, 0x32, 0x55, 0x2f,
-	0xbf, 0xcb, 0x36, 0x87, 0xb9, 0x76, 0x2c, 0x63, 0x70, 0x42, 0xa8, 0x03, 0x2b, 0x8c, 0xf3, 0xac,
-	0x09, 0xe7, 0x58, 0xba, 0x00, 0xcb, 0x3b, 0xf7, 0x24, 0xda, 0xf9, 0x2c, 0x6d, 0x56, 0x7d, 0xa5,
-	0x0c, 0x3d, 0x02, 0x9d, 0x72, 0xa8, 0xe3, 0x1e, 0x7b, 0x5c, 0xf9, 0xe3, 0x8f, 0x83, 0x5b, 0x12,
-	0xc1, 0x24, 0x63, 0x9b, 0x2b, 0xa4, 0x52, 0x3b, 0xae, 0x63, 0xfc, 0x8e, 0x06, 0xd5, 0xde, 0xe4,
-	0x4c, 0x66, 0xd8, 0x5f, 0xe6, 0xb9, 0x47, 0xad, 0x47, 0x67, 0xd6, 0xc0, 0x73, 0x5d, 0x3c, 0x08,
-	0xe9, 0x45, 0xac, 0x64, 0x42, 0x30, 0x39, 0xdb, 0x65, 0x10, 0xca, 0x4e, 0x51, 0x7f, 0xfe, 0x3f,
-	0x3b, 0xfd, 0xaa, 0xb1, 0xd3, 0x1a, 0x20, 0xde, 0xaa, 0xe7, 0x44, 0xc1, 0x50, 0x46, 0x13, 0x56,
-	0x15, 0x28, 0x5f, 0xd7, 0xb7, 0x85, 0x98, 0xb6, 0x26, 0x04, 0xae, 0x98, 0x45, 0xfd, 0x18, 0x9f,
-	0x2a, 0x40, 0x1f, 0xc3, 0x0d, 0x1e, 0x41, 0x80, 0x4d, 0xdb, 0x1d, 0x7a, 0xe3, 0x1e, 0xc6, 0x43,
-	0xc9, 0xa7, 0x99, 0x5c, 0x19, 0xac, 0x11, 0x76, 0x4f, 0xc2, 0x53, 0xae, 0x36, 0x00, 0x01, 0xed,
-	0x53, 0x88, 0xf1, 0x67, 0xa0, 0x91, 0x55, 0x3b, 0xf6, 0xb1, 0xa3, 0xd5, 0x8f, 0x2e, 0x42, 0x1c,
-	0x44, 0xe6, 0x10, 0x8c, 0x87, 0x0f, 0x09, 0x00, 0xdd, 0x80, 0x22, 0x2d, 0x3e, 0xe5, 0x2f, 0x36,
-	0x25, 0x73, 0x89, 0x7c, 0x3f, 0xc1, 0xaf, 0x88, 0x56, 0x4e, 0x8b, 0xc6, 0x2e, 0x1e, 0x7b, 0xae,
-	0x33, 0xe0, 0xc1, 0x36, 0x65, 0x02, 0x3c, 0xe0, 0x30, 0x63, 0x07, 0x6a, 0x7b, 0x78, 0xe0, 0x0d,
-	0xb1, 0xdc, 0xe5, 0x5b, 0x00, 0x44, 0xb8, 0xb3, 0x57, 0x0b, 0x7e, 0x20, 0x94, 0x08, 0x84, 0xbe,
-	0x54, 0x18, 0xdf, 0x05, 0x24, 0xd7, 0x89, 0x7d, 0x43, 0x87, 0x14, 0x3a, 0xb4, 0xe8, 0x75, 0x89,
-	0xbf, 0x88, 0x70, 0x18, 0x41, 0x35, 0xfe, 0xf2, 0x1c, 0xac, 0x9b, 0x53, 0x97, 0x99, 0xfd, 0x1e,
-	0x4e, 0x2f, 0xe2, 0xe8, 0xce, 0xaf, 0x6e, 0xf2, 0xbd, 0x07, 0x55, 0x11, 0x83, 0x31, 0x90, 0x0d,
-	0x05, 0x15, 0x1e, 0x81, 0xc1, 0xd1, 0xb6, 0x61, 0x95, 0x87, 0x2d, 0x5a, 0xa1, 0x67, 0x11, 0xd5,
-	0x26, 0xb4, 0x1d, 0x11, 0x0c, 0x52, 0xe3, 0x45, 0x7d, 0xef, 0x80, 0x17, 0xc8, 0x64, 0x55, 0x8b,
-	0x2f, 0x27, 0xcb, 0x8f, 0xc5, 0xa4, 0x41, 0x77, 0xf1, 0x12, 0x83, 0xee, 0x92, 0x6a, 0xd0, 0x35,
-	0xea, 0xb0, 0x91, 0x9c, 0x10, 0xae, 0xa7, 0xfe, 0x4e, 0x01, 0xd6, 0xa9, 0x4e, 0xd2, 0x9c, 0x86,
-	0xde, 0xd7, 0x34, 0x57, 0x39, 0x93, 0x50, 0xc8, 0x9b, 0x84, 0xd7, 0xa1, 0x3a, 0xb6, 0xa9, 0x5d,
-	0x59, 0x38, 0xb9, 0xb0, 0xf9, 0x5a, 0x1e, 0xdb, 0xaf, 0x1e, 0x09, 0x3f, 0x97, 0x77, 0x01, 0x11,
-	0x24, 0xea, 0x10, 0x6c, 0xf9, 0x78, 0x64, 0x87, 0xc2, 0x67, 0x56, 0x33, 0xf5, 0xb1, 0xfd, 0x8a,
-	0x7b, 0x10, 0x33, 0xb8, 0x8a, 0x6d, 0x1f, 0x05, 0xde, 0x68, 0x1a, 0x62, 0x1e, 0x34, 0x13, 0x61,
-	0x37, 0x39, 0x3c, 0x63, 0x15, 0x96, 0xae, 0xb2, 0x0a, 0xc5, 0x4b, 0x56, 0xa1, 0x94, 0x30, 0xab,
-	0x1b, 0x50, 0xa1, 0x9d, 0xc2, 0x3e, 0x53, 0x84, 0xb9, 0x15, 0x9d, 0x0c, 0xb3, 0x8b, 0x7d, 0xaa,
-	0xfb, 0x92, 0x95, 0x4a, 0x2e, 0x07, 0x5f, 0xa9, 0x0d, 0x58, 0xeb, 0x85, 0xde, 0x24, 0xb9, 0x4e,
-	0xc6, 0x26, 0x59, 0x40, 0x05, 0xce, 0x2b, 0x34, 0xa0, 0x2e, 0xad, 0x38, 0xb5, 0xb0, 0x44, 0xa1,
-	0xd5, 0x7f, 0x65, 0x11, 0x6e, 0x64, 0x14, 0x4a, 0x01, 0x7d, 0xd9, 0xbe, 0x6b, 0x6f, 0x40, 0xd5,
-	0x3e, 0x3b, 0xe1, 0xf3, 0x3a, 0xf6, 0x86, 0x42, 0x4b, 0x2b, 0xdb, 0x67, 0x27, 0x74, 0x4e, 0x0f,
-	0xbc, 0x21, 0xbd, 0xd9, 0x45, 0x58, 0xcf, 0x3f, 0x6f, 0x76, 0xad, 0x21, 0x1e, 0x85, 0xb6, 0x60,
-	0x00, 0x81, 0x4a, 0x4a, 0xf6, 0x48, 0xc1, 0xb5, 0x77, 0x8d, 0x01, 0x15, 0x66, 0xf3, 0x24, 0xe8,
-	0xf6, 0xd9, 0x89, 0xf0, 0x05, 0x63, 0xc0, 0xbe, 0xd7, 0x3c, 0x3b, 0x41, 0xdf, 0x82, 0xf5, 0xa1,
-	0xe7, 0x86, 0xd6, 0xb9, 0xed, 0x84, 0xd6, 0xb1, 0xe7, 0x2b, 0xcf, 0x25, 0x45, 0x13, 0x91, 0xc2,
-	0xcf, 0x6d, 0x27, 0x7c, 0xe4, 0xf9, 0xd2, 0xb3, 0x09, 0x7b, 0xe8, 0xe0, 0xfd, 0xe5, 0xd1, 0x53,
-	0x0c, 0xc6, 0x7a, 0x7a, 0x8b, 0xf9, 0x62, 0x31, 0xbf, 0x2e, 0xce, 0x00, 0xa5, 0x63, 0x8c, 0x7b,
-	0x14, 0x40, 0xd8, 0x8e, 0x14, 0x73, 0xf7, 0xbe, 0x60, 0x60, 0x8f, 0x1c, 0xf7, 0x84, 0xf3, 0x81,
-	0x7e, 0x8c, 0x71, 0x9f, 0x16, 0xf4, 0x18, 0x1c, 0x6d, 0xc2, 0xd2, 0xd8, 0x71, 0xa5, 0xf7, 0x94,
-	0xc5, 0xb1, 0xe3, 0x3e, 0xc2, 0x98, 0x16, 0xb0, 0x0d, 0x51, 0x2f, 0xf3, 0x02, 0xba, 0x13, 0xd2,
-	0x1c, 0x54, 0x49, 0x71, 0x50, 0x0e, 0xeb, 0x57, 0x73, 0x58, 0x3f, 0x7b, 0x5b, 0xad, 0xe4, 0x6c,
-	0xab, 0x37, 0xd8, 0x4e, 0x75, 0x22, 0x8f, 0xf7, 0x7a, 0x8d, 0xf9, 0x2e, 0x8e, 0xed, 0x57, 0x6d,
-	0xe1, 0xef, 0x9e, 0xda, 0x27, 0xe8, 0x92, 0x7d, 0xb2, 0x9a, 0xd8, 0x27, 0xdf, 0x81, 0xcd, 0x60,
-	0xe2, 0x63, 0x7b, 0x28, 0xe2, 0x54, 0x26, 0xfc, 0xf9, 0x28, 0xa8, 0xaf, 0xd1, 0xc5, 0x5b, 0x67,
-	0xc5, 0x3c, 0x74, 0x40, 0x14, 0x66, 0x6c, 0xe3, 0xf5, 0xac, 0x6d, 0x1c, 0xbf, 0x62, 0x6d, 0x48,
-	0xaf, 0x58, 0xc6, 0x7b, 0x50, 0xeb, 0xe1, 0x64, 0x08, 0x73, 0xee, 0x4e, 0x20, 0xa7, 0xbc, 0x8c,
-	0xce, 0xf7, 0xdc, 0x01, 0x6c, 0xf5, 0xb0, 0x48, 0x87, 0x10, 0x73, 0xac, 0x14, 0x41, 0x94, 0xc5,
-	0xe8, 0x5a, 0x0e, 0xa3, 0x1b, 0xaf, 0xc1, 0xcd, 0x6c, 0x72, 0xbc, 0xb9, 0xef, 0x82, 0xde, 0xc3,
-	0xe1, 0x01, 0x65, 0x0e, 0xd1, 0x46, 0x5a, 0x9a, 0x6a, 0x29, 0x69, 0x6a, 0xac, 0xd2, 0xc1, 0x8a,
-	0x8a, 0x9c, 0xda, 0x0f, 0xa1, 0xc1, 0x80, 0xca, 0xa2, 0x0b, 0xba, 0xd9, 0x9c, 0xa2, 0x65, 0x73,
-	0x8a, 0x71, 0x8b, 0x4e, 0x44, 0x9a, 0x56, 0x66, 0x53, 0x82, 0x1b, 0x33, 0x9b, 0x8a, 0x58, 0x58,
-	0xcb, 0x66, 0xe1, 0x44, 0x53, 0x31, 0xad, 0xc8, 0x1a, 0xb9, 0xd9, 0xc3, 0xe1, 0x73, 0x99, 0x05,
-	0x24, 0xbf, 0xcd, 0x04, 0xc3, 0x68, 0x19, 0x0c, 0x43, 0x04, 0x69, 0x9a, 0x02, 0xa7, 0xfe, 0x3d,
-	0x58, 0xef, 0xe1, 0xb0, 0x1b, 0xb3, 0xb6, 0x14, 0x93, 0xaf, 0x6c, 0x02, 0x2d, 0xb5, 0x09, 0xa8,
-	0xac, 0x4f, 0xd4, 0xe5, 0x54, 0xbf, 0x45, 0x99, 0xab, 0xcb, 0x37, 0x84, 0xf4, 0x04, 0x10, 0x6f,
-	0x1a, 0x2d, 0x71, 0xc4, 0xaf, 0xc3, 0xaa, 0x52, 0x85, 0x53, 0xfa, 0x3e, 0xed, 0xdf, 0x41, 0x2c,
-	0x1f, 0x04, 0xb1, 0x94, 0x28, 0xd1, 0xb2, 0x0f, 0xa3, 0x44, 0xe5, 0x38, 0x15, 0x47, 0xf3, 0x04,
-	0xbb, 0x43, 0x3b, 0xb2, 0x6c, 0xfd, 0xbc, 0x00, 0x2b, 0x11, 0x28, 0x3e, 0x47, 0x84, 0x23, 0x24,
-	0xdf, 0x3d, 0xfc, 0x13, 0x7d, 0x1f, 0x96, 0x6c, 0x86, 0xcc, 0x5f, 0x1a, 0xef, 0xca, 0xa9, 0x2d,
-	0x54, 0x32, 0xfc, 0xdb, 0x14, 0x35, 0x1a, 0x7f, 0xa4, 0xc1, 0x22, 0x83, 0xa1, 0x2a, 0xcc, 0x39,
-	0x43, 0x3e, 0xb7, 0x73, 0x0e, 0xb5, 0x03, 0x0c, 0x31, 0xf3, 0xe9, 0x10, 0x4e, 0x74, 0x25, 0x53,
-	0x06, 0x21, 0x04, 0xf3, 0x63, 0x3b, 0x78, 0xc9, 0xd5, 0x37, 0xfa, 0x9b, 0xf4, 0x66, 0x70, 0xea,
-	0x39, 0x03, 0x2c, 0x7c, 0xe8, 0x66, 0xf5, 0x66, 0x97, 0x62, 0x9a, 0xa2, 0x06, 0x7b, 0x7b, 0xb1,
-	0xfd, 0x50, 0xf6, 0x4a, 0x2e, 0x51, 0x08, 0xf5, 0x49, 0xbe, 0x0d, 0xec, 0x00, 0xe1, 0x5e, 0xcb,
-	0x4c, 0x05, 0x01, 0x06, 0x22, 0x08, 0x8d, 0x9f, 0x69, 0xb0, 0xc8, 0x68, 0x7e, 0xb5, 0xd1, 0xf0,
-	0x64, 0x46, 0x74, 0x34, 0x34, 0x4b, 0xd1, 0x2d, 0x00, 0x27, 0x20, 0xdb, 0x26, 0x3a, 0x44, 0x8b,
-	0x66, 0xc9, 0x09, 0x9a, 0x0c, 0x80, 0x56, 0x61, 0xc1, 0x09, 0x2c, 0xd7, 0xe3, 0xc6, 0x8f, 0x79,
-	0x27, 0xe8, 0x78, 0x44, 0x9a, 0x3d, 0xf7, 0x42, 0xcc, 0xfa, 0x11, 0xad, 0xe9, 0x3f, 0x99, 0x83,
-	0x55, 0x05, 0x7c, 0xe9, 0xba, 0x7e, 0x1a, 0xcf, 0x24, 0x5b, 0x57, 0xf9, 0x2e, 0x96, 0x41, 0x2a,
-	0x35, 0x9b, 0x0d, 0x28, 0x9e, 0x79, 0x21, 0x96, 0x06, 0x15, 0x7d, 0x37, 0xfe, 0x4e, 0x3c, 0x53,
-	0x5b, 0x50, 0x62, 0xdc, 0x60, 0x45, 0x13, 0x56, 0x64, 0x80, 0xf6, 0x90, 0xdc, 0xc7, 0x79, 0x61,
-	0x7a, 0xf6, 0x6a, 0xac, 0x64, 0x4f, 0x9a, 0xc3, 0x2d, 0x28, 0xb1, 0xd6, 0x09, 0x2d, 0x76, 0x79,
-	0x29, 0x32, 0x00, 0xa3, 0xc5, 0x0b, 0x65, 0x5a, 0xf3, 0x8c, 0x16, 0x2b, 0x91, 0x68, 0x19, 0xbf,
-	0xa7, 0xd1, 0xfd, 0x96, 0x9e, 0x4b, 0xd4, 0x8c, 0x67, 0x86, 0xbd, 0xab, 0xc9, 0xf9, 0x21, 0x32,
-	0xab, 0x24, 0xe7, 0xa6, 0xf1, 0xf0, 0x6a, 0xc3, 0x57, 0xc6, 0x33, 0xa7, 0x8e, 0xc7, 0xf8, 0x90,
-	0x6e, 0xe9, 0xac, 0x45, 0x95, 0x67, 0x5e, 0x53, 0x67, 0xde, 0x38, 0x85, 0xb5, 0xe7, 0xd8, 0x77,
-	0x8e, 0x2f, 0xbe, 0x06, 0x97, 0x07, 0xe5, 0xdd, 0xbd, 0x90, 0xf4, 0x5d, 0x78, 0x0f, 0xd6, 0x13,
-	0x2d, 0xc5, 0x91, 0xfc, 0x34, 0x76, 0x8a, 0xdb, 0x3f, 0xd8, 0x87, 0xf1, 0xd3, 0x65, 0x71, 0x49,
-	0x54, 0x3c, 0xca, 0xae, 0xe1, 0x8f, 0x28, 0xf1, 0x32, 0x33, 0xb8, 0x46, 0xbc, 0xbc, 0x05, 0x25,
-	0x6a, 0xae, 0xa6, 0xfb, 0x96, 0xf3, 0x22, 0x01, 0xd0, 0x6d, 0x1d, 0xbb, 0xc8, 0xcc, 0x2b, 0x2e,
-	0x32, 0x59, 0x29, 0xbb, 0x16, 0xbe, 0x8e, 0x94, 0x5d, 0xe8, 0xfb, 0xb0, 0x48, 0x2f, 0xca, 0x44,
-	0x83, 0x4d, 0x26, 0x03, 0x4a, 0x4f, 0x81, 0xc8, 0x5c, 0xc6, 0xaa, 0xa0, 0x4f, 0x60, 0x49, 0xf8,
-	0xe6, 0x2f, 0xa5, 0x32, 0x97, 0x65, 0xd4, 0x16, 0x99, 0xcb, 0x78, 0xa5, 0xc6, 0x7f, 0x29, 0x88,
-	0x84, 0x61, 0xdf, 0x83, 0x1b, 0x91, 0xff, 0x5c, 0xce, 0x1c, 0x6f, 0x0a, 0x84, 0xc4, 0xeb, 0x3f,
-	0xfa, 0x18, 0x1a, 0x99, 0x75, 0x65, 0x4f, 0xd0, 0x7a, 0x46, 0x65, 0xe6, 0x05, 0xf8, 0x99, 0xe4,
-	0x16, 0x5a, 0xdd, 0x79, 0xf7, 0x0a, 0xc3, 0xdf, 0xee, 0xfb, 0x18, 0xd3, 0xd9, 0x64, 0x4e, 0xa4,
-	0x0d, 0x28, 0x06, 0x84, 0x73, 0xdd, 0x41, 0x14, 0x85, 0x29, 0xbe, 0xe9, 0x96, 0x62, 0x21, 0x20,
-	0x8e, 0xcb, 0xa5, 0x78, 0x91, 0x01, 0xda, 0x6e, 0xea, 0xc9, 0x98, 0xb9, 0x5c, 0x29, 0x21, 0x86,
-	0xb7, 0x81, 0x7d, 0xf2, 0xc1, 0xb0, 0x00, 0x4d, 0xf6, 0x0e, 0xdd, 0x16, 0x39, 0xd5, 0x22, 0x36,
-	0x17, 0x6e, 0x87, 0x45, 0xc6, 0x93, 0x11, 0x9c, 0xbb, 0xd3, 0x3e, 0x80, 0xb5, 0x24, 0xaa, 0x65,
-	0x07, 0x63, 0x7a, 0x91, 0x28, 0x99, 0x28, 0x81, 0xde, 0x0c, 0xc6, 0xc6, 0x47, 0x50, 0x14, 0x63,
-	0x55, 0xf3, 0x94, 0xad, 0xc5, 0x41, 0xc3, 0xff, 0x47, 0xfc, 0xd3, 0x50, 0x09, 0x16, 0x7a, 0xfd,
-	0xe6, 0xd3, 0x96, 0xae, 0x35, 0xfe, 0xcd, 0xbc, 0x9c, 0x96, 0xed, 0xcc, 0x1e, 0x4d, 0x85, 0xa6,
-	0xc5, 0x3e, 0xe2, 0x64, 0x6d, 0x73, 0x89, 0x64, 0x6d, 0x72, 0x8c, 0x83, 0xb4, 0x6d, 0xe2, 0xe0,
-	0x88, 0x79, 0x25, 0x38, 0x82, 0x9c, 0x93, 0xf1, 0x50, 0x98, 0x99, 0xa3, 0x14, 0x88, 0x11, 0xa0,
-	0xf7, 0x61, 0x35, 0x72, 0x99, 0x8b, 0x06, 0x18, 0xf0, 0x94, 0x04, 0x22, 0xa5, 0xc9, 0x30, 0xf2,
-	0x7e, 0x0c, 0x50, 0x0f, 0xca, 0x9c, 0xde, 0x60, 0x64, 0xf3, 0x2b, 0x7b, 0x75, 0xe7, 0xc1, 0x55,
-	0xf8, 0x7a, 0x9b, 0x4d, 0xdc, 0x2e, 0xa9, 0x67, 0x2e, 0x07, 0xf1, 0x07, 0x11, 0x4e, 0xb6, 0x78,
-	0x5b, 0xac, 0x17, 0xa9, 0x65, 0x36, 0x06, 0xa0, 0x77, 0xa0, 0x36, 0xf0, 0xc6, 0x63, 0x27, 0x1c,
-	0x63, 0x37, 0x8a, 0x36, 0x28, 0x31, 0xb5, 0x34, 0x2e, 0x60, 0xc1, 0x06, 0xc6, 0x7f, 0xd7, 0x60,
-	0x59, 0x6a, 0x07, 0xe9, 0x50, 0xee, 0x1c, 0x76, 0xac, 0x5e, 0xbf, 0xd9, 0xd9, 0x6b, 0x9a, 0x7b,
-	0x2c, 0x86, 0xbb, 0xfb, 0xec, 0xa1, 0xf5, 0xb4, 0xf5, 0x05, 0xf3, 0x1b, 0xe5, 0x1f, 0xd6, 0x93,
-	0x66, 0xef, 0x89, 0x3e, 0x47, 0x5d, 0x4b, 0x77, 0xcd, 0x76, 0xb7, 0xcf, 0x00, 0x05, 0x54, 0x81,
-	0xd2, 0xc1, 0xb3, 0xfd, 0x7e, 0xdb, 0xea, 0xb5, 0x1f, 0xeb, 0xf3, 0xe4, 0xb3, 0xf3, 0x6c, 0x7f,
-	0xdf, 0xda, 0x6b, 0xf6, 0x9b, 0xfa, 0x02, 0x75, 0x29, 0x25, 0x6b, 0x6a, 0xf5, 0x9e, 0x3d, 0x3c,
-	0x68, 0xf7, 0x7a, 0xed, 0xc3, 0x8e, 0xbe, 0x48, 0x90, 0x18, 0xf4, 0x71, 0xab, 0xa3, 0x2f, 0xc5,
-	0x48, 0x52, 0x3e, 0xba, 0xa2, 0x52, 0xd5, 0xda, 0x7d, 0xd2, 0xec, 0x3c, 0x6e, 0xe9, 0x25, 0xd2,
-	0xbe, 0xe8, 0x51, 0x73, 0xbf, 0xaf, 0x03, 0x41, 0x93, 0xbb, 0x48, 0xa1, 0xcb, 0x46, 0x1f, 0xb6,
-	0xd8, 0x44, 0x9b, 0xf6, 0x79, 0x86, 0x0f, 0xe9, 0x57, 0x74, 0xc2, 0xb6, 0xe0, 0x66, 0x36, 0xd5,
-	0xab, 0xe6, 0x19, 0x49, 0x2f, 0xbe, 0xe2, 0x36, 0x6d, 0xec, 0xc0, 0xc6, 0x73, 0x1e, 0x8b, 0x9b,
-	0x91, 0x1e, 0x2a, 0xf3, 0x50, 0x33, 0x7e, 0xbe, 0x00, 0x9b, 0xa9, 0x4a, 0xbc, 0x43, 0x37, 0xa0,
-	0xe8, 0x04, 0x96, 0x7c, 0x44, 0x2d, 0x39, 0x01, 0x45, 0x26, 0xd7, 0x79, 0x27, 0xb0, 0xc6, 0x8e,
-	0x2b, 0xb2, 0xe4, 0x2c, 0x3a, 0xc1, 0x81, 0xe3, 0x66, 0xf9, 0x32, 0x15, 0xb2, 0x7c, 0x99, 0xee,
-	0x40, 0x99, 0x7b, 0x70, 0xd0, 0xdb, 0x04, 0xd7, 0x3e, 0x60, 0x42, 0xdd, 0x37, 0x48, 0x3f, 0x48,
-	0x0b, 0xc2, 0xc7, 0x83, 0x85, 0x25, 0x2f, 0xb2, 0x42, 0x22, 0xd5, 0x9c, 0x40, 0x76, 0x72, 0x2e,
-	0x9a, 0x45, 0x27, 0xe0, 0x62, 0xe6, 0x4d, 0x58, 0x89, 0x3c, 0xa0, 0x29, 0x65, 0x76, 0x38, 0x94,
-	0xcc, 0x8a, 0xf0, 0xac, 0x27, 0xc4, 0x03, 0xd4, 0x01, 0xbe, 0x47, 0xd8, 0x71, 0x56, 0x4c, 0x1d,
-	0x67, 0x39, 0x73, 0xc2, 0xb7, 0x19, 0x15, 0xc0, 0x7c, 0xef, 0x53, 0x01, 0xf5, 0x0e, 0xa0, 0x89,
-	0x7d, 0x41, 0x6d, 0x37, 0xc3, 0xa1, 0x2f, 0x7a, 0x57, 0x62, 0xb2, 0x70, 0x62, 0x5f, 0xf4, 0x3d,
-	0x42, 0x88, 0x77, 0xf2, 0x75, 0xa8, 0x04, 0xce, 0x49, 0x60, 0x09, 0x09, 0x40, 0x4d, 0x25, 0x15,
-	0xb3, 0x4c, 0x80, 0x26, 0x87, 0x51, 0x0f, 0xf2, 0xc0, 0x8a, 0x72, 0x44, 0x2e, 0xb3, 0x5c, 0x44,
-	0x4e, 0xd0, 0x16, 0x59, 0x22, 0x23, 0x21, 0x56, 0x96, 0x84, 0x98, 0xf1, 0x3f, 0x34, 0x80, 0xb8,
-	0x8f, 0xa8, 0x06, 0x95, 0x8e, 0xe7, 0xf6, 0x42, 0xdb, 0x1d, 0xda, 0xfe, 0xb0, 0x7f, 0xc1, 0xd2,
-	0x3c, 0x32, 0x2f, 0x99, 0xfe, 0x05, 0xdf, 0xa3, 0xf4, 0x8b, 0xf9, 0x83, 0xeb, 0x73, 0xd4, 0xdb,
-	0x9b, 0x12, 0xe0, 0x90, 0x02, 0xaa, 0x02, 0x1c, 0x4c, 0x47, 0xa1, 0xd3, 0x73, 0x4e, 0xfa, 0x17,
-	0xfa, 0x3c, 0xf9, 0xee, 0x4c, 0x47, 0xa3, 0x3d, 0x3b, 0xb4, 0xfb, 0x17, 0xfa, 0x02, 0x5a, 0xe7,
-	0xb9, 0x08, 0x7a, 0xd3, 0x23, 0xfa, 0x34, 0x42, 0x4e, 0x77, 0x7d, 0x91, 0xa0, 0x89, 0x14, 0x41,
-	0xfd, 0x0b, 0x7d, 0x29, 0x42, 0x33, 0xf1, 0x99, 0x27, 0x1e, 0x68, 0xf8, 0x4e, 0xe5, 0xb5, 0x59,
-	0xa8, 0x5c, 0xff, 0x82, 0xef, 0xd4, 0xe9, 0xd1, 0x4b, 0x7c, 0xd1, 0x1c, 0x85, 0xfd, 0x0b, 0x1d,
-	0xd0, 0x2a, 0xac, 0x30, 0x00, 0xe9, 0x16, 0x03, 0x2e, 0x1b, 0x1f, 0xc0, 0xe6, 0x2e, 0x15, 0x52,
-	0x21, 0x1e, 0x26, 0x5c, 0x69, 0xeb, 0xb0, 0x24, 0xcc, 0x63, 0xcc, 0xb5, 0x4c, 0x7c, 0x1a, 0x4f,
-	0xe0, 0xf6, 0xe3, 0xc8, 0xcc, 0xd1, 0x52, 0x1c, 0x87, 0xae, 0x97, 0xd5, 0xc9, 0xe8, 0xc1, 0x9d,
-	0x7c, 0x4a, 0x7c, 0x13, 0xbd, 0x0f, 0x6b, 0xf6, 0x60, 0x60, 0xe5, 0x38, 0x2e, 0xd5, 0xec, 0xc1,
-	0x40, 0xad, 0x68, 0xfc, 0x2b, 0x0d, 0xea, 0xe9, 0x41, 0x71, 0x6a, 0x5f, 0xc2, 0x8a, 0xe2, 0x38,
-	0x8d, 0xb3, 0x02, 0xa7, 0xf3, 0x6a, 0xf3, 0xcc, 0x1c, 0x82, 0xa5, 0x93, 0x94, 0x1a, 0x4d, 0x91,
-	0x7d, 0xa4, 0x19, 0x07, 0x05, 0x4a, 0xd9, 0x47, 0xca, 0x51, 0x7a, 0x91, 0xfc, 0x87, 0x72, 0x04,
-	0xfa, 0x43, 0x1c, 0x84, 0xf2, 0xb5, 0xdc, 0xf8, 0x14, 0x6a, 0x12, 0x2c, 0x7e, 0xfc, 0x93, 0x9c,
-	0x0a, 0x2a, 0x51, 0xca, 0x0d, 0x91, 0x9e, 0x63, 0x2e, 0x4e, 0xcf, 0x61, 0xfc, 0x3b, 0x0d, 0x56,
-	0x7b, 0xe7, 0x18, 0x4f, 0xd2, 0xf9, 0x08, 0x33, 0x62, 0x54, 0x4b, 0xc9, 0x18, 0xd5, 0xf7, 0x61,
-	0x55, 0x0a, 0x22, 0xb4, 0xd4, 0x9e, 0x23, 0xa9, 0xa8, 0x19, 0xc7, 0x7c, 0xcc, 0x08, 0x54, 0xae,
-	0x5c, 0x2d, 0xa8, 0x75, 0x9e, 0x19, 0x33, 0x44, 0x50, 0xab, 0xf1, 0x3f, 0x35, 0x58, 0x53, 0x07,
-	0xf1, 0xeb, 0x1d, 0x65, 0xf8, 0xf6, 0x3f, 0x28, 0xc0, 0x5a, 0xd6, 0x1b, 0x2d, 0x02, 0x58, 0xec,
-	0x7d, 0xd1, 0xd9, 0xa5, 0xc1, 0x98, 0x65, 0x28, 0x3e, 0xeb, 0xf0, 0x2f, 0x0d, 0x21, 0xa8, 0x76,
-	0x5b, 0x2d, 0xd3, 0xda, 0x3d, 0xec, 0x74, 0x5a, 0xbb, 0xfd, 0xd6, 0x9e, 0x3e, 0x47, 0xa4, 0x06,
-	0x85, 0xed, 0xb5, 0x7b, 0x31, 0xb8, 0x80, 0xde, 0x80, 0x3b, 0x8f, 0x5a, 0xfd, 0xdd, 0x27, 0xad,
-	0x3d, 0x8b, 0x6a, 0x06, 0x9d, 0xc7, 0xd6, 0xee, 0xa3, 0xf6, 0x7e, 0xbf, 0x65, 0xf6, 0x88, 0x3e,
-	0x62, 0xb2, 0x14, 0x31, 0xf7, 0xe0, 0x6e, 0x2e, 0x56, 0xd7, 0x3c, 0x7c, 0x6c, 0xb6, 0x7a, 0x3d,
-	0x7d, 0x61, 0x26, 0xda, 0xa3, 0x76, 0xa7, 0xdd, 0x7b, 0x42, 0xf3, 0xca, 0x6c, 0xc1, 0xa6, 0x40,
-	0x7b, 0xd2, 0x6a, 0xee, 0xc9, 0x4d, 0x2d, 0xa1, 0x9b, 0x50, 0x4f, 0x16, 0x46, 0x2d, 0x14, 0xb3,
-	0x4a, 0x23, 0xc2, 0x25, 0xf4, 0x1a, 0x34, 0xe8, 0xf0, 0x9e, 0xb7, 0x4c, 0xab, 0xb9, 0xb7, 0x47,
-	0xea, 0xb4, 0x62, 0xda, 0x80, 0x6e, 0xc3, 0x56, 0x46, 0x79, 0x44, 0x60, 0x99, 0x4c, 0x9c, 0xd9,
-	0xea, 0xed, 0x36, 0x3b, 0x51, 0xa5, 0x32, 0x11, 0x98, 0x1c, 0x16, 0xf5, 0xa3, 0x22, 0x01, 0xa3,
-	0xda, 0xd5, 0x1d, 0x33, 0xca, 0xb1, 0xdd, 0xc3, 0xfe, 0x19, 0xb9, 0x8c, 0x7f, 0x06, 0x4b, 0x1c,
-	0x82, 0x6e, 0xc8, 0x87, 0xa2, 0x92, 0x89, 0xbb, 0xd1, 0xc8, 0x2a, 0x62, 0x5c, 0xbd, 0xf3, 0xfb,
-	0x5b, 0x50, 0x61, 0x0e, 0x87, 0x82, 0xe6, 0x77, 0x61, 0xbe, 0xeb, 0xb8, 0x27, 0x68, 0x43, 0x7e,
-	0x79, 0x8e, 0x53, 0xe3, 0x36, 0x36, 0x53, 0xf0, 0xc8, 0xb3, 0x7c, 0x89, 0xa7, 0xb8, 0x55, 0x3a,
-	0xa3, 0xe6, 0xcd, 0x55, 0x3a, 0x93, 0x4c, 0xa0, 0x6b, 0x42, 0x45, 0x49, 0x6f, 0x8b, 0x6e, 0xa7,
-	0xb3, 0xce, 0x2a, 0x39, 0x73, 0x1b, 0x77, 0xf2, 0x11, 0x22, 0xef, 0xfd, 0x62, 0xe4, 0x2d, 0xd1,
-	0xc8, 0x4c, 0x62, 0xcb, 0x28, 0x6d, 0xcd, 0x48, 0x70, 0x4b, 0x86, 0x26, 0xd2, 0xbf, 0xca, 0x43,
-	0x53, 0x73, 0x0c, 0x2a, 0x43, 0x4b, 0x66, 0x03, 0x0c, 0xa0, 0x9e, 0x77, 0x04, 0xa1, 0x44, 0x06,
-	0xaa, 0x59, 0x27, 0x5e, 0xe3, 0x9d, 0x2b, 0xe1, 0xf2, 0x46, 0x9f, 0x41, 0x55, 0xcd, 0x9a, 0x86,
-	0xee, 0x24, 0x12, 0x44, 0xa5, 0x94, 0xe6, 0xc6, 0xdd, 0x19, 0x18, 0x9c, 0xec, 0x8f, 0x60, 0x25,
-	0x91, 0x8c, 0x0d, 0xe5, 0xd7, 0x8a, 0x26, 0xd8, 0x98, 0x85, 0xc2, 0x28, 0x3f, 0xd0, 0xd0, 0x63,
-	0x28, 0x45, 0x89, 0xab, 0xd0, 0x56, 0x56, 0x3a, 0x2b, 0x41, 0xef, 0xd6, 0xcc, 0x5c, 0x57, 0xe8,
-	0x29, 0x40, 0x0c, 0x45, 0x37, 0x73, 0x90, 0xaf, 0x42, 0xea, 0x81, 0x86, 0xf6, 0x61, 0x59, 0x4a,
-	0x16, 0x85, 0x94, 0xd0, 0x85, 0x54, 0x6a, 0xa9, 0xc6, 0x6b, 0x79, 0xc5, 0x51, 0xc6, 0xba, 0x52,
-	0x94, 0x13, 0x4a, 0x19, 0x63, 0x32, 0x7d, 0x54, 0xe3, 0x66, 0x76, 0x61, 0x4c, 0x27, 0xca, 0x58,
-	0xa4, 0xd0, 0x49, 0xa6, 0x47, 0x52, 0xe8, 0xa4, 0x93, 0x1c, 0x11, 0x3a, 0xe2, 0xe0, 0x57, 0xe9,
-	0x24, 0x54, 0x04, 0x95, 0x4e, 0x4a, 0x57, 0x98, 0x2a, 0x7e, 0xf5, 0x8a, 0x73, 0xaa, 0xc2, 0xe3,
-	0x97, 0xc4, 0xb1, 0x28, 0x3c, 0x7e, 0x59, 0x3c, 0xcb, 0x03, 0x0d, 0x39, 0x71, 0x3e, 0x6d, 0xa5,
-	0xc9, 0x37, 0x33, 0x64, 0x43, 0x56, 0x73, 0x6f, 0x5d, 0x8a, 0x17, 0x35, 0xf5, 0x13, 0xb8, 0x91,
-	0x1b, 0x87, 0x80, 0xde, 0xb9, 0x5a, 0xb4, 0x02, 0x6b, 0xf4, 0xdd, 0xeb, 0x84, 0x36, 0xdc, 0xd7,
-	0x1e, 0x68, 0xe8, 0x4b, 0xd0, 0x93, 0x79, 0x8c, 0x90, 0x71, 0x79, 0xda, 0xa5, 0xc6, 0xeb, 0x33,
-	0x71, 0x62, 0xc9, 0xab, 0x24, 0x7c, 0x56, 0x24, 0x6f, 0x56, 0x92, 0x69, 0x45, 0xf2, 0x66, 0xe6,
-	0x8a, 0x46, 0x9f, 0xc2, 0x22, 0x73, 0x3b, 0x42, 0xf5, 0x94, 0x57, 0x94, 0xa0, 0x72, 0x23, 0xa3,
-	0x44, 0xde, 0x75, 0x52, 0x06, 0x66, 0x65, 0xd7, 0xa5, 0x53, 0x3e, 0x2b, 0xbb, 0x2e, 0x2b, 0x71,
-	0xb3, 0xa0, 0x26, 0xf2, 0x03, 0xcf, 0xcc, 0x91, 0x9c, 0xa6, 0x96, 0xb8, 0x73, 0x7f, 0x09, 0x7a,
-	0x32, 0x19, 0xaf, 0xb2, 0x1a, 0x39, 0xb9, 0x85, 0x95, 0xd5, 0xc8, 0xcb, 0xe6, 0x8b, 0x0e, 0xa1,
-	0x2c, 0x67, 0xc6, 0x45, 0xaf, 0xa5, 0x2a, 0x29, 0x59, 0x7e, 0x1b, 0xb7, 0x73, 0xcb, 0x39, 0xc1,
-	0x17, 0xb0, 0x92, 0xc8, 0xd2, 0xa4, 0x48, 0xec, 0xec, 0x14, 0x58, 0x8a, 0xc4, 0xce, 0x4b, 0x11,
-	0xf5, 0x1c, 0xaa, 0x6a, 0x12, 0x22, 0xe5, 0x88, 0xc9, 0xcc, 0x4f, 0xd4, 0xc8, 0xc5, 0x90, 0xd6,
-	0xfe, 0x04, 0xd6, 0xb2, 0x72, 0x7e, 0x28, 0x9b, 0x7a, 0x46, 0x86, 0x12, 0x65, 0x53, 0xcf, 0x4c,
-	0x1e, 0xf2, 0x02, 0x56, 0x12, 0x61, 0xf3, 0xca, 0xd4, 0x64, 0xa7, 0xb1, 0x50, 0xa6, 0x26, 0x2f,
-	0x05, 0xc4, 0x97, 0xa0, 0x27, 0x03, 0xf2, 0x91, 0x71, 0x79, 0x2a, 0x04, 0x85, 0x45, 0x72, 0x93,
-	0x01, 0xbc, 0x80, 0x95, 0x44, 0x50, 0xb6, 0xd2, 0xed, 0xec, 0xb8, 0x74, 0xa5, 0xdb, 0x79, 0x31,
-	0xdd, 0x36, 0xa0, 0x74, 0x28, 0x35, 0x92, 0x8d, 0xf6, 0xb9, 0x51, 0xdb, 0x8d, 0x7b, 0x97, 0x60,
-	0xf1, 0x26, 0x2e, 0xa2, 0x68, 0xed, 0x8c, 0xf8, 0x69, 0xf4, 0x6e, 0x9a, 0x48, 0x7e, 0x2c, 0x76,
-	0xe3, 0xbd, 0x2b, 0x62, 0xc7, 0xf3, 0x96, 0x88, 0x08, 0x56, 0xe6, 0x2d, 0x3b, 0x5e, 0x5b, 0x99,
-	0xb7, 0xbc, 0x80, 0x62, 0x2a, 0x42, 0xa5, 0x98, 0xdf, 0x84, 0x08, 0x4d, 0x47, 0x11, 0x27, 0x44,
-	0x68, 0x46, 0xb8, 0x30, 0xfa, 0x6d, 0x58, 0xcf, 0x0c, 0x07, 0x46, 0x32, 0x7b, 0xcf, 0x0a, 0x28,
-	0x6e, 0xdc, 0xbf, 0x1c, 0x31, 0x96, 0x8f, 0x52, 0x30, 0xab, 0x22, 0x1f, 0xd3, 0x11, 0xc7, 0x8a,
-	0x7c, 0xcc, 0x0a, 0x13, 0x3e, 0x84, 0xb2, 0x1c, 0x1a, 0x8b, 0x5e, 0x9b, 0x1d, 0xce, 0xab, 0x88,
-	0xb0, 0xcc, 0x28, 0xdc, 0x17, 0xb0, 0x92, 0xb0, 0xf5, 0x29, 0x0b, 0x97, 0x6d, 0x50, 0x55, 0x16,
-	0x2e, 0xcf, 0x7c, 0x4a, 0x0e, 0xd6, 0x84, 0x25, 0x46, 0x3d, 0x58, 0xb3, 0x2d, 0x57, 0xea, 0xc1,
-	0x9a, 0x67, 0x08, 0x22, 0xf3, 0x20, 0x59, 0x13, 0xd4, 0x79, 0x48, 0xdb, 0x4a, 0xd4, 0x79, 0xc8,
-	0x30, 0x43, 0xec, 0xfc, 0xcd, 0x92, 0x08, 0x1c, 0x23, 0xcb, 0x89, 0x7d, 0x71, 0x6d, 0x3b, 0x84,
-	0xb2, 0x1c, 0x38, 0xa6, 0x34, 0x94, 0x11, 0x68, 0xa6, 0x34, 0x94, 0x19, 0x71, 0x76, 0x08, 0x65,
-	0x39, 0x00, 0x50, 0x21, 0x98, 0x11, 0xa0, 0xa8, 0x10, 0xcc, 0x8a, 0x1c, 0x24, 0x57, 0xa0, 0xbc,
-	0xf8, 0x3d, 0x45, 0x3d, 0xbc, 0x24, 0xbc, 0x50, 0x51, 0x0f, 0x2f, 0x0b, 0x08, 0x44, 0x6d, 0x80,
-	0x38, 0x9c, 0x4f, 0xb9, 0x06, 0xa4, 0xe2, 0x04, 0x95, 0x6b, 0x40, 0x46, 0x0c, 0xe0, 0x3e, 0x2c,
-	0x4b, 0x81, 0x7c, 0xca, 0x06, 0x49, 0x87, 0xfd, 0x29, 0x1b, 0x24, 0x23, 0xfe, 0x0f, 0xfd, 0x16,
-	0xb5, 0xb2, 0xaa, 0x81, 0x71, 0xe8, 0x75, 0x55, 0xdf, 0xcf, 0x8c, 0xea, 0x6b, 0xbc, 0x31, 0x1b,
-	0x29, 0xa6, 0x9f, 0x0a, 0x59, 0x53, 0xe8, 0xe7, 0x05, 0xde, 0x29, 0xf4, 0x73, 0xa3, 0xde, 0xd0,
-	0xcf, 0x34, 0xb8, 0x35, 0x33, 0x1e, 0x0d, 0xbd, 0x2f, 0xf7, 0xf3, 0x0a, 0x11, 0x6e, 0x8d, 0x07,
-	0x57, 0xaf, 0x10, 0xf3, 0xa8, 0x1c, 0x14, 0xa0, 0xf0, 0x68, 0x46, 0x0c, 0x9c, 0xc2, 0xa3, 0x99,
-	0xb1, 0x70, 0x27, 0x3c, 0x46, 0x2e, 0x11, 0x12, 0xa0, 0xa8, 0x1d, 0x33, 0x82, 0xfd, 0x14, 0xb5,
-	0x63, 0x56, 0xd4, 0x1f, 0x7a, 0x08, 0x4b, 0x3c, 0xce, 0x42, 0xb1, 0x28, 0xa8, 0xb1, 0x20, 0x8a,
-	0x45, 0x21, 0x11, 0x96, 0xf1, 0x40, 0x23, 0x34, 0x78, 0xe8, 0x8f, 0x42, 0x43, 0x0d, 0x80, 0x52,
-	0x68, 0x24, 0x22, 0x85, 0x98, 0x8e, 0x2d, 0xc5, 0x06, 0x28, 0x4c, 0x9d, 0x8e, 0x24, 0x50, 0x98,
-	0x3a, 0x23, 0xa4, 0x60, 0xc7, 0x83, 0x35, 0xc9, 0xb5, 0xf7, 0xf9, 0x8e, 0x10, 0x4e, 0x9f, 0x43,
-	0x55, 0x75, 0x02, 0x57, 0xb4, 0xc4, 0x4c, 0x87, 0x79, 0xc5, 0x10, 0x91, 0xed, 0x41, 0xfe, 0x40,
-	0xdb, 0xf9, 0xb7, 0x45, 0x91, 0xdc, 0x99, 0x96, 0x88, 0xf6, 0x9e, 0x41, 0x55, 0x75, 0x65, 0x56,
-	0xda, 0xcb, 0x74, 0x3a, 0x57, 0xda, 0xcb, 0xf6, 0x83, 0x26, 0x47, 0xbc, 0xe2, 0xef, 0xac, 0x1c,
-	0xf1, 0x59, 0x1e, 0xd2, 0x8d, 0x3b, 0xf9, 0x08, 0xf1, 0x3e, 0x4d, 0x79, 0x43, 0x2b, 0xfb, 0x34,
-	0xcf, 0x91, 0x5a, 0xd9, 0xa7, 0xf9, 0x0e, 0xd5, 0x6d, 0x80, 0xd8, 0x59, 0x54, 0x11, 0x80, 0x29,
-	0x97, 0x53, 0x45, 0x00, 0xa6, 0x3d, 0x4c, 0xc9, 0xe6, 0xc8, 0x72, 0x09, 0x55, 0x36, 0xc7, 0x0c,
-	0x17, 0xd4, 0xc6, 0x5b, 0x97, 0xe2, 0x49, 0x06, 0x12, 0xe1, 0x22, 0xaa, 0x1a, 0x48, 0x12, 0x1e,
-	0xa7, 0x8d, 0x9b, 0xd9, 0x85, 0x9c, 0xce, 0x90, 0x3a, 0x26, 0x26, 0x3d, 0x41, 0xd1, 0xbd, 0x54,
-	0xa5, 0x2c, 0xaf, 0xd3, 0xc6, 0x9b, 0x97, 0xa1, 0x65, 0xb6, 0x12, 0x7b, 0xf6, 0x67, 0x57, 0x4f,
-	0x38, 0x9c, 0xe6, 0xb5, 0x92, 0xf4, 0x25, 0xa5, 0xb7, 0x89, 0x84, 0x27, 0xa8, 0x7a, 0x9b, 0xc8,
-	0x76, 0x34, 0x55, 0x6f, 0x13, 0x39, 0xae, 0xa4, 0x74, 0xbf, 0x28, 0xee, 0xa0, 0xea, 0x7e, 0xc9,
-	0xf2, 0x32, 0x55, 0xf7, 0x4b, 0xa6, 0x2f, 0x29, 0x55, 0x29, 0x63, 0xc7, 0x50, 0x74, 0x2b, 0x5d,
-	0x43, 0xf2, 0x31, 0x55, 0x55, 0xca, 0xb4, 0x3f, 0x29, 0xef, 0xa4, 0xe4, 0x12, 0x9a, 0xec, 0x64,
-	0xda, 0xd5, 0x34, 0xd9, 0xc9, 0x0c, 0x7f, 0xd2, 0x9d, 0x9f, 0x6b, 0xa4, 0x97, 0x78, 0x28, 0x64,
-	0x87, 0x0d, 0x28, 0x1d, 0xac, 0xa4, 0xdc, 0x7f, 0x72, 0x23, 0xa1, 0x94, 0xfb, 0xcf, 0x8c, 0x88,
-	0xa7, 0x36, 0x40, 0x1c, 0x5e, 0xa4, 0xec, 0xc9, 0x54, 0xa4, 0x52, 0xe3, 0x56, 0x4e, 0x29, 0xef,
-	0xfd, 0x6f, 0x42, 0x85, 0x79, 0x89, 0x4a, 0x4f, 0x02, 0xdc, 0x6d, 0x54, 0x39, 0x14, 0x54, 0x97,
-	0x59, 0xe5, 0x50, 0x48, 0x78, 0x99, 0xee, 0xfc, 0x0b, 0x0d, 0x2a, 0x8c, 0x4d, 0x04, 0xcd, 0x7d,
-	0x58, 0x96, 0xdc, 0xf6, 0x94, 0x75, 0x4c, 0xfb, 0x0e, 0x2a, 0xeb, 0x98, 0xe5, 0xed, 0xc7, 0xd6,
-	0x51, 0x26, 0x78, 0xe7, 0x32, 0x7f, 0xc4, 0xe4, 0x3a, 0x66, 0x90, 0xdd, 0x99, 0x40, 0x83, 0x5f,
-	0x1a, 0xa8, 0x17, 0x1f, 0xd7, 0x15, 0xc4, 0x10, 0x4c, 0xa8, 0x28, 0xce, 0x7d, 0x8a, 0xe8, 0xce,
-	0x72, 0x30, 0x54, 0x44, 0x77, 0xa6, 0x5f, 0xe0, 0xce, 0x9f, 0x87, 0x35, 0xb6, 0x22, 0xbc, 0x40,
-	0xb4, 0x75, 0x22, 0xe0, 0xaa, 0x03, 0x89, 0x22, 0x27, 0x67, 0xf8, 0xad, 0x28, 0x72, 0x72, 0x96,
-	0x27, 0xca, 0xd1, 0x22, 0xfd, 0x33, 0xac, 0x1f, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0xf1, 0xc6, 0x06, 0x93, 0x75, 0x00, 0x00,
+	// 8712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x23, 0x49,
+	0x92, 0xd8, 0x92, 0xd4, 0x83, 0x0c, 0x89, 0x14, 0x59, 0x7a, 0xb1, 0x4b, 0xdd, 0x2d, 0x75, 0x75,
+	0xf7, 0xf4, 0xec, 0xce, 0xb4, 0xa6, 0x47, 0x33, 0xbb, 0x33, 0xb7, 0xaf, 0x59, 0xb6, 0xc4, 0xee,
+	0xe6, 0xb4, 0x9a, 0xd2, 0x15, 0xd9, 0x3d, 0x33, 0xbb, 0xe7, 0x2b, 0x94, 0xc8, 0x94, 0x54, 0xd7,
+	0x64, 0x91, 0x5b, 0x55, 0x54, 0x4b, 0x6b, 0x1b, 0x5e, 0xac, 0x01, 0xff, 0x1d, 0xfc, 0x00, 0xfc,
+	0xe1, 0xc7, 0x19, 0x06, 0x6c, 0xd8, 0x86, 0x0d, 0xbf, 0x60, 0xc3, 0x38, 0x78, 0x0d, 0xc3, 0x36,
+	0xfc, 0x63, 0x1c, 0x8c, 0xc3, 0xf9, 0xc7, 0x1f, 0xfe, 0xb2, 0x01, 0x7f, 0x19, 0xb0, 0x01, 0xff,
+	0xfa, 0xc3, 0x46, 0x66, 0x46, 0x56, 0x65, 0xd6, 0x83, 0x92, 0x66, 0x67, 0x01, 0xef, 0xe2, 0xfa,
+	0xa7, 0x59, 0x11, 0x91, 0x91, 0xaf, 0xc8, 0xc8, 0xcc, 0xc8, 0x88, 0x10, 0x94, 0xec, 0xb1, 0xb3,
+	0x3d, 0xf6, 0x46, 0xc1, 0x48, 0x2b, 0xbd, 0xb1, 0x07, 0x03, 0x12, 0x78, 0xe3, 0x9e, 0x51, 0x85,
+	0xca, 0x2b, 0xe2, 0xf9, 0xce, 0xc8, 0x35, 0xc9, 0x8f, 0x27, 0xc4, 0x0f, 0x8c, 0x7f, 0x9b, 0x83,
+	0xa5, 0x10, 0xe4, 0x8f, 0x47, 0xae, 0x4f, 0xb4, 0xfb, 0x50, 0x39, 0xe3, 0x20, 0xcb, 0x0f, 0x3c,
+	0xc7, 0x3d, 0xa9, 0xe7, 0xb6, 0x72, 0x6f, 0x97, 0xcc, 0x32, 0x42, 0x3b, 0x0c, 0xa8, 0xad, 0xc0,
+	0xec, 0xd0, 0xfe, 0x9d, 0x91, 0x57, 0xcf, 0x6f, 0xe5, 0xde, 0x2e, 0x9b, 0xfc, 0x83, 0x41, 0x1d,
+	0x77, 0xe4, 0xd5, 0x0b, 0x08, 0x75, 0x5c, 0x0e, 0x1d, 0xdb, 0x41, 0xef, 0xb4, 0x3e, 0xc3, 0xa1,
+	0xec, 0x43, 0xbb, 0x0d, 0x30, 0xf6, 0x88, 0x47, 0x06, 0xc4, 0xf6, 0x49, 0x7d, 0x96, 0x55, 0x22,
+	0x41, 0x68, 0x43, 0x8e, 0x26, 0xce, 0xa0, 0x6f, 0x0d, 0x49, 0x60, 0xf7, 0xed, 0xc0, 0xae, 0xcf,
+	0xf1, 0x86, 0x30, 0xe8, 0x0b, 0x04, 0x1a, 0x7f, 0x38, 0x0b, 0x5a, 0xd7, 0xb3, 0x5d, 0xdf, 0xee,
+	0x05, 0xce, 0xc8, 0xdd, 0x23, 0x81, 0xed, 0x0c, 0x7c, 0x4d, 0x83, 0x99, 0x53, 0xdb, 0x3f, 0x65,
+	0x8d, 0x5f, 0x34, 0xd9, 0x6f, 0x6d, 0x0b, 0x16, 0x82, 0x88, 0x92, 0xb5, 0x7c, 0xd1, 0x94, 0x41,
+	0xda, 0x77, 0x60, 0xae, 0x4f, 0x8e, 0x9c, 0xc0, 0xaf, 0x17, 0xb6, 0x0a, 0x6f, 0x2f, 0xec, 0xdc,
+	0xdd, 0x0e, 0x87, 0x6f, 0x3b, 0x59, 0xc9, 0x76, 0xcb, 0x1d, 0x4f, 0x02, 0x13, 0x8b, 0x68, 0xdf,
+	0x87, 0xf9, 0x9e, 0x47, 0xfa, 0xb4, 0xf4, 0x0c, 0x2b, 0x7d, 0x6f, 0x7a, 0xe9, 0x83, 0x49, 0x40,
+	0x8b, 0x8b, 0x42, 0x5a, 0x15, 0x0a, 0xc7, 0x84, 0x8f, 0x44, 0xc1, 0xa4, 0x3f, 0xb5, 0x9b, 0x50,
+	0x0a, 0x9c, 0x21, 0xf1, 0x03, 0x7b, 0x38, 0x66, 0xbd, 0x2f, 0x98, 0x11, 0x40, 0xfb, 0x1c, 0xaa,
+	0x52, 0xdb, 0xad, 0xe0, 0x62, 0x4c, 0xea, 0xf3, 0x5b, 0xb9, 0xb7, 0x2b, 0x3b, 0x0f, 0xa7, 0x57,
+	0x2c, 0x81, 0xba, 0x17, 0x63, 0x62, 0x2e, 0x05, 0x2a, 0x40, 0xff, 0x31, 0xcc, 0xb2, 0xae, 0xd1,
+	0x99, 0x73, 0xdc, 0x3e, 0x39, 0x67, 0xc3, 0x58, 0x36, 0xf9, 0x87, 0xf6, 0x75, 0xa8, 0x8e, 0x3d,
+	0x72, 0xe6, 0x8c, 0x26, 0xbe, 0x65, 0xf7, 0x7a, 0xa3, 0x89, 0x1b, 0xa0, 0x18, 0x2c, 0x09, 0x78,
+	0x83, 0x83, 0xb5, 0x07, 0xb0, 0x14, 0x91, 0x0e, 0x19, 0x65, 0x81, 0xf5, 0xa3, 0x12, 0x52, 0x32,
+	0xa8, 0xfe, 0x0f, 0x72, 0x30, 0xc7, 0x07, 0x24, 0xa3, 0xd2, 0x3a, 0xcc, 0xab, 0x75, 0x89, 0x4f,
+	0x4d, 0x87, 0xa2, 0xe3, 0x06, 0xc4, 0x73, 0xed, 0x01, 0x63, 0x5e, 0x34, 0xc3, 0x6f, 0x6d, 0x0d,
+	0xe6, 0xb0, 0xda, 0x19, 0x56, 0x2d, 0x7e, 0x31, 0x6e, 0xfd, 0xbe, 0x47, 0x7c, 0x1f, 0x25, 0x4f,
+	0x7c, 0x6a, 0x77, 0xa1, 0x3c, 0x62, 0xed, 0xb0, 0xfc, 0x9e, 0xe7, 0x8c, 0x03, 0x36, 0xee, 0x8b,
+	0xe6, 0x22, 0x07, 0x76, 0x18, 0xcc, 0xf8, 0x11, 0x2c, 0xc5, 0x06, 0x51, 0x5b, 0x80, 0x79, 0xb3,
+	0xf9, 0xf4, 0xe5, 0x7e, 0xc3, 0xac, 0x7e, 0x4d, 0x5b, 0x84, 0xe2, 0xee, 0x41, 0xab, 0xfd, 0xb8,
+	0xd1, 0x69, 0x56, 0x67, 0xb4, 0x65, 0x58, 0xea, 0xb6, 0x76, 0x9f, 0x37, 0xbb, 0xd6, 0xe1, 0x4b,
+	0x73, 0xf7, 0x19, 0x05, 0xe6, 0xb4, 0x22, 0xcc, 0xbc, 0x3a, 0xe8, 0x36, 0xab, 0x79, 0xad, 0x02,
+	0x60, 0x36, 0x5f, 0x1d, 0xec, 0x36, 0xba, 0xad, 0x83, 0x76, 0xb5, 0x60, 0xfc, 0xfb, 0x1c, 0x2c,
+	0x3e, 0x1e, 0x8c, 0x7a, 0xaf, 0xa7, 0xc9, 0xf2, 0x1a, 0xcc, 0x9d, 0x12, 0xe7, 0xe4, 0x94, 0x8f,
+	0xc6, 0xac, 0x89, 0x5f, 0xaa, 0xc8, 0x14, 0xe2, 0x22, 0xf3, 0x00, 0x96, 0xec, 0xf1, 0xd8, 0x1b,
+	0x9d, 0x11, 0xdf, 0x1a, 0xdb, 0x1e, 0x71, 0x03, 0xd6, 0xfd, 0xa2, 0x59, 0x11, 0xe0, 0x43, 0x06,
+	0xd5, 0x1a, 0xb0, 0x28, 0x09, 0x85, 0x10, 0xe8, 0x5b, 0x53, 0xe5, 0xca, 0x54, 0x8a, 0x18, 0x07,
+	0x50, 0x41, 0x29, 0x78, 0x6c, 0x0f, 0x6c, 0xb7, 0x47, 0xe4, 0x29, 0xcc, 0xa9, 0x53, 0x78, 0x17,
+	0xca, 0xc1, 0x28, 0xb0, 0x07, 0xd6, 0x11, 0x27, 0x65, 0x9d, 0x2a, 0x98, 0x8b, 0x0c, 0x88, 0xc5,
+	0x8d, 0x32, 0x2c, 0x1c, 0x3a, 0xee, 0x89, 0x50, 0x5e, 0x15, 0x58, 0xe4, 0x9f, 0x5c, 0x71, 0x51,
+	0xf5, 0xd6, 0x26, 0xc1, 0x9b, 0x91, 0xf7, 0x5a, 0x50, 0x7c, 0x0c, 0x4b, 0x21, 0x24, 0xd2, 0x6e,
+	0xb4, 0x7d, 0x67, 0xc4, 0x72, 0x39, 0x06, 0x5b, 0x52, 0xe6, 0x50, 0x24, 0x37, 0x7e, 0x03, 0x56,
+	0xb0, 0xed, 0xed, 0xc9, 0xf0, 0x88, 0x78, 0xc8, 0x51, 0xbb, 0x03, 0x8b, 0xd8, 0x64, 0xcb, 0xb5,
+	0x87, 0x04, 0x55, 0xe3, 0x02, 0xc2, 0xda, 0xf6, 0x90, 0x18, 0xdf, 0x87, 0xd5, 0x58, 0x51, 0xb9,
+	0x6a, 0x2c, 0xcb, 0x30, 0x51, 0xd5, 0x12, 0xb9, 0x51, 0x83, 0x25, 0x2c, 0xef, 0x8b, 0x7e, 0xfc,
+	0x7e, 0x01, 0xaa, 0x11, 0x0c, 0xd9, 0x7d, 0x02, 0x45, 0x2c, 0xe8, 0xd7, 0x73, 0x09, 0x65, 0x15,
+	0x27, 0x17, 0x00, 0x33, 0x2c, 0xa4, 0xbd, 0x0b, 0x5a, 0x6f, 0xe2, 0xd1, 0xd9, 0xb6, 0x8e, 0xa8,
+	0xb4, 0x59, 0x4c, 0xc6, 0xb8, 0x52, 0xac, 0x22, 0x86, 0x89, 0xe1, 0x33, 0x2a, 0x6f, 0x8f, 0x60,
+	0x25, 0x46, 0xcd, 0xa5, 0xaf, 0xc0, 0xa4, 0x4f, 0x53, 0xe8, 0x19, 0x46, 0xff, 0x59, 0x1e, 0xe6,
+	0x85, 0x1a, 0xb8, 0x5a, 0xdf, 0x13, 0xc3, 0x9b, 0x4f, 0x0c, 0x6f, 0x52, 0x52, 0x0a, 0x49, 0x49,
+	0xa1, 0x5d, 0x23, 0xe7, 0x5c, 0x03, 0x58, 0xaf, 0xc9, 0x85, 0xd5, 0x0b, 0x35, 0x40, 0xd9, 0xac,
+	0x0a, 0xcc, 0x73, 0x72, 0xb1, 0xcb, 0x1a, 0xf7, 0x2e, 0x68, 0x8e, 0x9b, 0xa0, 0x9e, 0xe5, 0xd4,
+	0x8e, 0x9b, 0x42, 0x3d, 0x1c, 0x8f, 0xbc, 0x80, 0xf4, 0x25, 0xea, 0x39, 0xa4, 0x46, 0x8c, 0xa0,
+	0x36, 0x3e, 0x87, 0x15, 0x93, 0xd0, 0xbe, 0x88, 0xf1, 0x47, 0x41, 0xba, 0xe2, 0x80, 0xdc, 0x80,
+	0xa2, 0x4b, 0xde, 0xc8, 0x83, 0x31, 0xef, 0x92, 0x37, 0x4c, 0xce, 0xd6, 0x61, 0x35, 0xc6, 0x19,
+	0xd7, 0xc1, 0x6f, 0x42, 0xd9, 0x24, 0x7e, 0xcf, 0x76, 0x25, 0xa1, 0x3d, 0x22, 0x27, 0x8e, 0x2b,
+	0xa6, 0x2c, 0xc7, 0xa6, 0x6c, 0x81, 0xc1, 0xf8, 0x5c, 0x69, 0xb7, 0x00, 0x90, 0x24, 0x92, 0x81,
+	0x12, 0x27, 0xb0, 0xfd, 0x53, 0xe3, 0x7b, 0x50, 0x11, 0x2c, 0x51, 0xfa, 0xde, 0x81, 0x9a, 0xc7,
+	0x20, 0x2e, 0xe9, 0x5b, 0xc1, 0xa9, 0x37, 0x9a, 0x9c, 0x9c, 0x22, 0xe3, 0x6a, 0x88, 0xe8, 0x72,
+	0xb8, 0xf1, 0x19, 0x68, 0x6d, 0x72, 0x1e, 0xc4, 0x86, 0x80, 0xee, 0xff, 0xb6, 0xef, 0x8f, 0x4f,
+	0x3d, 0xdb, 0x27, 0xa8, 0xdb, 0x24, 0xc8, 0x15, 0x84, 0xc1, 0xf8, 0x2e, 0x2c, 0x2b, 0x8c, 0xaf,
+	0xb7, 0xd2, 0xfe, 0x63, 0x1e, 0xdb, 0xc5, 0x35, 0xbf, 0x68, 0x57, 0xb6, 0x96, 0xfa, 0x16, 0xcc,
+	0xbc, 0x76, 0xdc, 0x3e, 0x6b, 0x49, 0x65, 0xc7, 0x90, 0x96, 0x5b, 0x92, 0xcd, 0xf6, 0x73, 0xc7,
+	0xed, 0x9b, 0x8c, 0x5e, 0x7b, 0x02, 0x70, 0x62, 0x8f, 0xad, 0xf1, 0x68, 0xe0, 0xf4, 0x2e, 0x98,
+	0xc0, 0x56, 0x76, 0x1e, 0x4c, 0x2f, 0xfd, 0xd4, 0x1e, 0x1f, 0x32, 0x72, 0xb3, 0x74, 0x22, 0x7e,
+	0x1a, 0x3b, 0x30, 0x43, 0xb9, 0x6a, 0x2b, 0x50, 0x7d, 0xdc, 0x3a, 0x7c, 0xf4, 0xe8, 0xc3, 0x0f,
+	0xad, 0xe6, 0xe7, 0xdd, 0xa6, 0xd9, 0x6e, 0xec, 0x57, 0xbf, 0x26, 0x43, 0x5b, 0x6d, 0x84, 0xe6,
+	0x0c, 0x07, 0x4a, 0x21, 0x2f, 0x4d, 0x87, 0xb5, 0xa7, 0x8d, 0x43, 0xeb, 0xf0, 0x60, 0xbf, 0xb5,
+	0xfb, 0x85, 0xf5, 0xb2, 0xdd, 0x39, 0x6c, 0xee, 0xb6, 0x9e, 0xb4, 0x9a, 0x7b, 0xbc, 0xb8, 0x84,
+	0x6b, 0x9a, 0xe6, 0x81, 0x59, 0xcd, 0x69, 0xab, 0x50, 0x93, 0xa0, 0xad, 0xa7, 0xed, 0x03, 0x93,
+	0x6e, 0x59, 0xcb, 0xb0, 0x24, 0x81, 0x3f, 0x33, 0x1b, 0x87, 0xd5, 0x82, 0xd1, 0x86, 0x65, 0xa5,
+	0x27, 0x38, 0x1b, 0xd2, 0x56, 0x9b, 0x53, 0xb7, 0xda, 0x5b, 0x00, 0xe3, 0xc9, 0xd1, 0xc0, 0xe9,
+	0xd1, 0x85, 0x84, 0xf3, 0x5b, 0xe2, 0x90, 0xe7, 0xe4, 0xc2, 0xf8, 0x27, 0x39, 0x58, 0x6f, 0xb1,
+	0x05, 0x75, 0xe8, 0x39, 0x67, 0x76, 0x40, 0x9e, 0x93, 0x8b, 0xab, 0x0a, 0x4f, 0xf6, 0x69, 0xe1,
+	0x2d, 0x7a, 0x22, 0x61, 0xec, 0xd8, 0xf2, 0x7d, 0xe3, 0x1c, 0xb3, 0x19, 0x29, 0x99, 0xe5, 0x71,
+	0x58, 0xcb, 0x67, 0xce, 0x31, 0xdd, 0x60, 0xb9, 0x20, 0x33, 0xbd, 0x51, 0x34, 0xf1, 0x4b, 0xdb,
+	0x80, 0x12, 0xfd, 0xdf, 0x3a, 0xf6, 0x46, 0x43, 0xa6, 0x24, 0x66, 0xcd, 0x22, 0x05, 0x3c, 0xf1,
+	0x46, 0x43, 0x43, 0x87, 0x7a, 0xb2, 0xc5, 0xb8, 0x2e, 0xff, 0x69, 0x0e, 0x96, 0x39, 0x92, 0x1f,
+	0x22, 0xae, 0xda, 0x95, 0x35, 0x98, 0xc3, 0x93, 0x08, 0x5f, 0x97, 0xf8, 0x25, 0x35, 0xb0, 0x90,
+	0xdd, 0xc0, 0x19, 0xb5, 0x81, 0xda, 0x43, 0xd0, 0x3c, 0xf2, 0xe3, 0x89, 0xe3, 0x11, 0xcb, 0x23,
+	0x7d, 0x42, 0x86, 0xf6, 0xd1, 0x80, 0xe0, 0x19, 0xa0, 0x86, 0x18, 0x33, 0x44, 0x18, 0x5f, 0xc0,
+	0x8a, 0xda, 0x64, 0x9c, 0xd3, 0x3b, 0xb0, 0x38, 0xde, 0xf1, 0x4f, 0x2d, 0x75, 0x62, 0x17, 0x28,
+	0x0c, 0xa7, 0x9f, 0x76, 0x4b, 0xaa, 0x21, 0xcf, 0x6a, 0x90, 0x20, 0x86, 0x0b, 0x15, 0x54, 0xd7,
+	0xd7, 0xd4, 0x89, 0xdf, 0x84, 0x35, 0x6c, 0x68, 0xdf, 0xea, 0x8d, 0xdc, 0x63, 0xc7, 0x1b, 0xda,
+	0xfc, 0x90, 0xc2, 0x4f, 0x42, 0xab, 0x02, 0xbb, 0x2b, 0x23, 0x8d, 0xbf, 0x95, 0x87, 0xa5, 0xb0,
+	0x42, 0xec, 0xc6, 0x0a, 0xcc, 0xb2, 0x7d, 0x83, 0x55, 0x54, 0x30, 0xf9, 0x07, 0x3d, 0x42, 0xf9,
+	0x63, 0xe2, 0xf6, 0xc3, 0x86, 0x17, 0xcc, 0x08, 0x40, 0x8f, 0x50, 0xce, 0x70, 0x68, 0x07, 0x13,
+	0x36, 0x84, 0x6f, 0x6c, 0xaf, 0x2f, 0x4e, 0xb4, 0x02, 0x6c, 0x32, 0xa8, 0xf6, 0x6d, 0xb8, 0x11,
+	0x12, 0xfa, 0x81, 0xfd, 0x9a, 0x58, 0x27, 0xc4, 0x25, 0x1e, 0x6b, 0x0e, 0x9e, 0x46, 0xd7, 0x05,
+	0x41, 0x87, 0xe2, 0x9f, 0x86, 0x68, 0xed, 0x1b, 0x50, 0xa3, 0x3b, 0x29, 0xe9, 0x5b, 0x47, 0x17,
+	0x56, 0xe0, 0xf4, 0x5e, 0x93, 0xc0, 0xc7, 0x8b, 0xc1, 0x12, 0x47, 0x3c, 0xbe, 0xe8, 0x72, 0x30,
+	0x3d, 0x8d, 0x9f, 0x8d, 0x02, 0xc7, 0x3d, 0xb1, 0xec, 0x49, 0x70, 0x3a, 0xf2, 0x9c, 0xe0, 0x02,
+	0xef, 0x0a, 0x4b, 0x1c, 0xde, 0x10, 0x60, 0x7a, 0x01, 0x9a, 0xb8, 0x38, 0x66, 0xa4, 0xcf, 0x2e,
+	0x0b, 0x05, 0x53, 0x06, 0x19, 0x8f, 0x61, 0xf5, 0x29, 0x09, 0xa4, 0xb3, 0x9d, 0x98, 0x9c, 0xaf,
+	0xab, 0x97, 0x0d, 0xe9, 0x3c, 0x2a, 0xdf, 0x1e, 0xd8, 0x6e, 0xf1, 0x37, 0x72, 0xb0, 0x16, 0x67,
+	0x12, 0x1e, 0x5a, 0x94, 0x1b, 0x18, 0x65, 0x70, 0xe9, 0xa9, 0x52, 0x2e, 0xa1, 0xdd, 0x83, 0x72,
+	0xda, 0x9c, 0xab, 0x40, 0xb6, 0x9d, 0x45, 0x47, 0x9a, 0x02, 0x6e, 0x67, 0xe2, 0x2c, 0x63, 0xfc,
+	0xa7, 0x7c, 0xbc, 0x81, 0xa1, 0xf2, 0xdf, 0x86, 0x65, 0x3f, 0xb0, 0x3d, 0x36, 0x9c, 0x12, 0x0b,
+	0xde, 0xd3, 0x9a, 0x40, 0x45, 0xc7, 0xa2, 0x1d, 0x58, 0x8d, 0xd3, 0x47, 0xa7, 0xf2, 0x9a, 0xb9,
+	0xac, 0x96, 0x60, 0x28, 0x3a, 0xb9, 0xc4, 0xed, 0xc7, 0x6a, 0xe0, 0x8d, 0x5c, 0xe2, 0x88, 0x88,
+	0xff, 0x36, 0x2c, 0xab, 0xb4, 0x9c, 0x3b, 0x5f, 0xd6, 0x35, 0x99, 0x9a, 0xf3, 0xfe, 0x3e, 0x6c,
+	0x0c, 0x1d, 0xd7, 0x19, 0x4e, 0x86, 0x96, 0x47, 0x7a, 0xf4, 0xb4, 0xa6, 0x1c, 0xe3, 0xb9, 0xbe,
+	0xba, 0x81, 0x24, 0x26, 0xa3, 0x90, 0x87, 0x41, 0xfb, 0x18, 0xea, 0x81, 0xed, 0x9d, 0x10, 0xa5,
+	0x9c, 0x74, 0xc6, 0x99, 0x35, 0xd7, 0x38, 0x5e, 0x2a, 0xc5, 0x4f, 0x3a, 0xff, 0x2c, 0x07, 0xeb,
+	0x89, 0x41, 0xc5, 0x69, 0x7f, 0x02, 0xda, 0xd0, 0x61, 0x27, 0x05, 0xb9, 0x31, 0x7c, 0xf6, 0xd7,
+	0xa5, 0xd9, 0x97, 0x6f, 0x3d, 0x66, 0x8d, 0x15, 0x51, 0x5a, 0x77, 0x08, 0x2b, 0x13, 0x37, 0x85,
+	0x53, 0xfe, 0x2a, 0xb7, 0x93, 0x65, 0x2c, 0x2a, 0x73, 0x34, 0x3e, 0x80, 0x2a, 0x6d, 0x34, 0x5b,
+	0x4a, 0x42, 0x06, 0x36, 0x61, 0x81, 0x2f, 0x39, 0x79, 0xee, 0x81, 0x83, 0x98, 0xfc, 0xfc, 0xd9,
+	0x3c, 0xd4, 0xc2, 0x52, 0xbf, 0x36, 0xa2, 0xb3, 0x0d, 0xcb, 0x62, 0xea, 0x79, 0xef, 0xa3, 0x73,
+	0xf0, 0xac, 0x59, 0xc3, 0x59, 0x67, 0x18, 0x3e, 0xe1, 0xff, 0x61, 0x06, 0x34, 0x79, 0x14, 0x70,
+	0xae, 0x77, 0x61, 0x8e, 0x97, 0xc7, 0xf9, 0x7d, 0x47, 0x9a, 0x95, 0x24, 0xf9, 0x36, 0xff, 0x16,
+	0x73, 0x84, 0x45, 0xb5, 0x1f, 0xc0, 0x2c, 0x6b, 0x34, 0x1b, 0x8b, 0x85, 0x9d, 0x6f, 0x4c, 0xe7,
+	0xa1, 0x88, 0x0d, 0x2f, 0xa8, 0xff, 0x51, 0x1e, 0xca, 0x0a, 0x6f, 0xed, 0x9b, 0xb1, 0x86, 0x5d,
+	0x22, 0x2e, 0xa2, 0x29, 0x1f, 0xc1, 0x3c, 0x53, 0xfe, 0xc4, 0xab, 0xe7, 0xaf, 0x52, 0x4e, 0x50,
+	0x6b, 0x7f, 0x02, 0xca, 0x38, 0x90, 0x7e, 0x60, 0x07, 0x13, 0x1f, 0x0f, 0x7e, 0x1f, 0x5f, 0x63,
+	0x3c, 0xf0, 0xab, 0xc3, 0xca, 0x9b, 0x8b, 0x81, 0xf4, 0x65, 0xfc, 0x18, 0x16, 0x65, 0x2c, 0xb5,
+	0x3f, 0xbc, 0x6c, 0x3f, 0x6f, 0x1f, 0x7c, 0xd6, 0xae, 0x7e, 0x8d, 0x7f, 0xbc, 0x68, 0xb5, 0x9b,
+	0x7b, 0xd5, 0x1c, 0x35, 0x46, 0xb4, 0x5e, 0xbc, 0x68, 0x74, 0x5f, 0xb2, 0xa3, 0x5b, 0x11, 0x66,
+	0xf6, 0x5b, 0xaf, 0x9a, 0xd5, 0x82, 0x56, 0x82, 0x59, 0x6a, 0x81, 0xd8, 0xab, 0xce, 0x68, 0x00,
+	0x73, 0x2f, 0x5a, 0x9d, 0x4e, 0x73, 0xaf, 0x3a, 0x4b, 0xcb, 0x36, 0x3f, 0x3f, 0x6c, 0x99, 0xcd,
+	0xbd, 0xea, 0x1c, 0xb7, 0x6a, 0xbc, 0x3a, 0x78, 0xde, 0xdc, 0xab, 0xce, 0xeb, 0x9f, 0xff, 0xb2,
+	0xec, 0x12, 0xc6, 0x0a, 0x68, 0xbc, 0x33, 0x87, 0x9e, 0x13, 0x1e, 0x08, 0x8c, 0x43, 0x58, 0x56,
+	0xa0, 0xd1, 0xe1, 0x03, 0x07, 0x76, 0x4c, 0xe1, 0xb8, 0x79, 0x2f, 0x04, 0x11, 0x69, 0x56, 0x2b,
+	0x0c, 0x0d, 0xaa, 0x6c, 0xab, 0x6d, 0xb9, 0xc7, 0x23, 0x51, 0xcb, 0x1f, 0xe5, 0xa1, 0x26, 0x01,
+	0xb1, 0x92, 0x0d, 0x28, 0x8d, 0x47, 0xa3, 0x81, 0xe5, 0x3b, 0x3f, 0x21, 0x78, 0x0e, 0x29, 0x52,
+	0x40, 0xc7, 0xf9, 0x09, 0xa1, 0x67, 0x48, 0x7b, 0x30, 0xb0, 0x86, 0x64, 0xc8, 0x68, 0x02, 0xe7,
+	0x1c, 0x4f, 0x99, 0x65, 0x7b, 0x30, 0x78, 0xc1, 0xa1, 0x5d, 0xe7, 0x9c, 0xd2, 0x8d, 0xde, 0xb8,
+	0x0a, 0x1d, 0x37, 0x8c, 0x96, 0x47, 0x6f, 0x5c, 0x89, 0x8e, 0x5a, 0xb0, 0xf0, 0x24, 0x80, 0xb7,
+	0xd4, 0xf0, 0x9b, 0x0e, 0xf2, 0xc0, 0x39, 0x23, 0x78, 0x1f, 0x65, 0xbf, 0xe9, 0xb9, 0xe5, 0x6c,
+	0x14, 0x90, 0x3e, 0x5e, 0x3b, 0xf9, 0x07, 0xed, 0xf4, 0xd0, 0xf1, 0x7d, 0xdc, 0xd8, 0xcb, 0x26,
+	0x7e, 0xd1, 0xb3, 0xb0, 0x47, 0xce, 0x46, 0xaf, 0x49, 0xbf, 0x5e, 0xe4, 0x67, 0x61, 0xfc, 0xa4,
+	0x18, 0x72, 0x3e, 0xa6, 0x67, 0xa5, 0x7a, 0x89, 0x63, 0xf0, 0x33, 0xba, 0x66, 0xfb, 0x93, 0x23,
+	0xdf, 0xe9, 0x5f, 0xd4, 0x41, 0xba, 0x66, 0x77, 0x38, 0x8c, 0x16, 0x9f, 0xb8, 0x54, 0xdc, 0x83,
+	0xfa, 0x02, 0x2f, 0x8e, 0x9f, 0x46, 0x17, 0xaa, 0x4c, 0x52, 0xa4, 0x71, 0x8e, 0x6d, 0xca, 0xb9,
+	0xd8, 0xa6, 0xcc, 0x6e, 0xa9, 0x71, 0x2d, 0x48, 0x6f, 0xa9, 0x91, 0x86, 0x32, 0xfe, 0x52, 0x1e,
+	0x6a, 0x12, 0x5b, 0x9c, 0xa9, 0x5f, 0x98, 0x6f, 0xf2, 0x50, 0x51, 0x48, 0x3b, 0x54, 0x28, 0x12,
+	0x3c, 0x13, 0xb7, 0xac, 0x49, 0xd5, 0xd8, 0x54, 0x57, 0xcc, 0x72, 0xe3, 0x32, 0x56, 0x43, 0x41,
+	0xf4, 0xce, 0xcc, 0xcf, 0x81, 0x8e, 0x7b, 0x66, 0x0f, 0x9c, 0xbe, 0x2d, 0x66, 0xb0, 0x68, 0x56,
+	0x7d, 0x2e, 0x80, 0x21, 0x3c, 0xcd, 0x52, 0x37, 0x9f, 0x66, 0xa9, 0xa3, 0x36, 0xfc, 0xf5, 0xdd,
+	0x53, 0xdb, 0x3d, 0x21, 0x87, 0xe1, 0x9d, 0x41, 0x0c, 0xf9, 0xc7, 0x50, 0xa0, 0x37, 0xab, 0x1c,
+	0x53, 0x3c, 0x6f, 0x49, 0x8a, 0x27, 0xa3, 0xc0, 0x36, 0xbd, 0xaf, 0xd0, 0x22, 0xf4, 0x2c, 0x3e,
+	0x1a, 0xf4, 0x2d, 0xe9, 0x62, 0xc2, 0x2f, 0x1f, 0xe5, 0xd1, 0xa0, 0x1f, 0x15, 0xa3, 0x64, 0xd4,
+	0x3e, 0x21, 0x91, 0xf1, 0xcd, 0xa8, 0xec, 0x92, 0x37, 0x11, 0x99, 0x71, 0x1b, 0x0a, 0xcf, 0xc9,
+	0x05, 0x55, 0x26, 0x87, 0x66, 0xeb, 0x55, 0xa3, 0xdb, 0xac, 0x7e, 0x8d, 0xaa, 0x9c, 0xc3, 0x97,
+	0x8f, 0xf7, 0x5b, 0xbb, 0xd5, 0x1c, 0xbd, 0x36, 0x25, 0x5b, 0x84, 0xd7, 0xa6, 0x9f, 0xe6, 0x61,
+	0xed, 0xc9, 0xc4, 0xed, 0xa7, 0x9c, 0x49, 0xa7, 0xdb, 0x13, 0xf9, 0x5e, 0x86, 0xd6, 0x5f, 0x61,
+	0x4f, 0x64, 0x40, 0x6e, 0x72, 0x9e, 0x72, 0x91, 0x28, 0x4c, 0xb9, 0x48, 0x68, 0xdf, 0x05, 0xdd,
+	0x71, 0x7b, 0x83, 0x49, 0x9f, 0x58, 0xe1, 0xf9, 0xbe, 0x37, 0x72, 0xdc, 0x23, 0xdb, 0x27, 0x3e,
+	0x5e, 0x16, 0xeb, 0x48, 0xd1, 0x42, 0x82, 0x5d, 0x81, 0xa7, 0xbb, 0xbe, 0x28, 0xdd, 0x63, 0x5d,
+	0x16, 0x66, 0x66, 0x7e, 0x07, 0x5b, 0x46, 0x24, 0x1f, 0x0e, 0xb4, 0x36, 0xff, 0x8b, 0x02, 0xac,
+	0x27, 0x86, 0x00, 0xa5, 0xff, 0xb7, 0xa0, 0xea, 0x93, 0x01, 0xe9, 0x51, 0x73, 0x14, 0x37, 0x51,
+	0x0b, 0x73, 0xe0, 0xfb, 0xd2, 0x7c, 0x67, 0x94, 0xde, 0x3e, 0x44, 0x23, 0x3c, 0x3e, 0x45, 0x2c,
+	0x09, 0x56, 0xfc, 0xdb, 0x67, 0xaa, 0x96, 0xa9, 0x01, 0x65, 0x18, 0x17, 0x18, 0x0c, 0x47, 0xf1,
+	0x6d, 0xa8, 0x62, 0x47, 0xc6, 0xaf, 0x45, 0x5f, 0xb8, 0x10, 0x54, 0x38, 0xfc, 0xf0, 0x35, 0xef,
+	0x86, 0xfe, 0xbf, 0x72, 0x50, 0x51, 0x2b, 0xbc, 0xc6, 0xad, 0x82, 0x36, 0x05, 0xed, 0xf2, 0xfc,
+	0x71, 0x80, 0x2b, 0xdc, 0x05, 0x0e, 0x6b, 0x51, 0x90, 0x64, 0xec, 0x2f, 0x28, 0xc6, 0x7e, 0xaa,
+	0xcb, 0xc3, 0xb6, 0xcd, 0x30, 0xf6, 0xc5, 0x31, 0xb6, 0x8a, 0xf2, 0xf5, 0x48, 0x8f, 0x50, 0x93,
+	0x30, 0x5d, 0xcd, 0x78, 0xcb, 0x5a, 0x40, 0x58, 0xd7, 0xe1, 0x36, 0x47, 0x7a, 0x99, 0x0e, 0x67,
+	0x19, 0x17, 0xed, 0x22, 0x05, 0x8a, 0x99, 0xa5, 0x7a, 0x3a, 0xf0, 0x08, 0x7f, 0x81, 0x99, 0x35,
+	0xd9, 0x6f, 0xe3, 0x0f, 0x72, 0xb0, 0xfa, 0x92, 0xab, 0x44, 0x1c, 0xd1, 0x5f, 0x61, 0xd1, 0x35,
+	0xfe, 0x72, 0x3e, 0xd6, 0x9b, 0x50, 0x08, 0x7f, 0xbd, 0xa7, 0x91, 0xee, 0x30, 0xbc, 0x09, 0x96,
+	0x3f, 0x19, 0xb2, 0x3d, 0xb4, 0x60, 0x96, 0x38, 0xa4, 0x33, 0x19, 0x1a, 0x3f, 0x9d, 0x83, 0x8d,
+	0xdd, 0x91, 0xeb, 0x07, 0xde, 0xa4, 0x97, 0x76, 0x75, 0xbe, 0x0f, 0x15, 0x7f, 0x34, 0xf1, 0x7a,
+	0xc4, 0x52, 0xa7, 0xbc, 0xcc, 0xa1, 0xc2, 0x46, 0xfe, 0xe5, 0xec, 0x1a, 0xda, 0x4d, 0x80, 0x63,
+	0x42, 0xac, 0x31, 0xf1, 0xac, 0xd7, 0x47, 0x38, 0xfd, 0xc5, 0x63, 0x42, 0x0e, 0x89, 0xf7, 0xfc,
+	0x48, 0xfb, 0xd3, 0xa0, 0xe3, 0x70, 0xf3, 0xa5, 0x4d, 0xa7, 0xc7, 0x1e, 0x9c, 0x50, 0x73, 0xc0,
+	0x29, 0xb7, 0x0e, 0x55, 0x76, 0x3e, 0x91, 0x37, 0x86, 0xec, 0x7e, 0xe0, 0x7b, 0x65, 0x47, 0xf0,
+	0x69, 0x08, 0x36, 0x66, 0x7d, 0x94, 0x81, 0xd1, 0x7e, 0x04, 0x9a, 0x4b, 0xef, 0x8f, 0x5c, 0x41,
+	0x08, 0xfd, 0x34, 0xcb, 0xf4, 0xd3, 0xc3, 0x6b, 0x55, 0x6b, 0x56, 0xdd, 0x91, 0xcb, 0xb5, 0xa2,
+	0x50, 0x4e, 0x27, 0xa0, 0x21, 0xe3, 0x3e, 0xf1, 0x03, 0xc7, 0xe5, 0x96, 0x95, 0x39, 0x76, 0x48,
+	0xff, 0xf8, 0x5a, 0xcc, 0xf7, 0xa2, 0xf2, 0x66, 0x8d, 0xf3, 0x94, 0x40, 0xfa, 0x00, 0x6a, 0x09,
+	0xba, 0x29, 0x66, 0xcd, 0x2c, 0x83, 0x1d, 0x95, 0x03, 0xf6, 0xcb, 0xc2, 0xa7, 0x74, 0x71, 0x18,
+	0xe4, 0x50, 0x7c, 0x88, 0xd7, 0xff, 0x54, 0xf8, 0x10, 0xfa, 0x43, 0x58, 0x90, 0x7b, 0x96, 0xfb,
+	0x05, 0x7b, 0x26, 0x33, 0x93, 0x16, 0x59, 0x5e, 0x5e, 0x64, 0xc6, 0x87, 0x50, 0xcf, 0x9a, 0x67,
+	0x6d, 0x09, 0x16, 0x54, 0x9b, 0xf1, 0x3c, 0x14, 0x1a, 0xfb, 0xd4, 0xca, 0xfc, 0x57, 0xf2, 0x70,
+	0x33, 0xbd, 0x31, 0xa8, 0x21, 0xde, 0xa7, 0x37, 0x77, 0xdf, 0x39, 0x89, 0x5d, 0xdd, 0x51, 0x4b,
+	0x2c, 0x0b, 0x9c, 0x54, 0x54, 0xfb, 0x04, 0x6e, 0xf2, 0xbd, 0x27, 0x7c, 0x40, 0x46, 0x49, 0x56,
+	0xda, 0x7d, 0x83, 0xd1, 0xa8, 0xdb, 0x0a, 0x2a, 0x49, 0x7a, 0xa1, 0x65, 0x0c, 0xd4, 0x72, 0x5c,
+	0xa9, 0xd4, 0x18, 0x4a, 0xa1, 0xdf, 0x81, 0x55, 0x3a, 0x40, 0x43, 0x9b, 0xee, 0xa5, 0xd8, 0x56,
+	0x76, 0xfc, 0xe7, 0x47, 0xf2, 0xe5, 0x10, 0xd9, 0x61, 0x38, 0x76, 0x13, 0xb8, 0x03, 0x8b, 0x28,
+	0x83, 0x5c, 0x9d, 0xf1, 0xdb, 0xf2, 0x02, 0x87, 0x31, 0x75, 0x66, 0xfc, 0x9f, 0x3c, 0xac, 0xd1,
+	0x12, 0x29, 0x9a, 0xe1, 0x32, 0xd3, 0xef, 0x37, 0x61, 0xcd, 0x27, 0x9e, 0x63, 0x0f, 0x9c, 0x9f,
+	0xc4, 0xc6, 0x8d, 0x4b, 0xd6, 0x6a, 0x84, 0x95, 0x47, 0xce, 0x06, 0xcd, 0xee, 0xf7, 0x1d, 0xfa,
+	0x9b, 0x9e, 0xe0, 0x99, 0x74, 0x89, 0x27, 0xdc, 0x1d, 0x49, 0x7c, 0xd2, 0x5b, 0xb5, 0xdd, 0x08,
+	0xcb, 0xa2, 0xd5, 0xb7, 0x66, 0xc7, 0x20, 0xbe, 0xfe, 0x17, 0x73, 0x50, 0x8d, 0xd3, 0x7d, 0xc5,
+	0xdb, 0x80, 0xd0, 0xc4, 0x05, 0x49, 0x13, 0x4f, 0xdb, 0x02, 0x3e, 0x9d, 0x29, 0x16, 0xaa, 0x33,
+	0x66, 0xd9, 0x71, 0x43, 0xb6, 0x84, 0x5e, 0x93, 0xd7, 0x13, 0xdd, 0x44, 0x99, 0xdc, 0x4a, 0x1a,
+	0x23, 0x63, 0xee, 0x20, 0x1f, 0xc2, 0x5a, 0x28, 0xb5, 0x0a, 0x5b, 0x66, 0x71, 0x2a, 0x9b, 0xa1,
+	0x4c, 0xb7, 0x5c, 0xd1, 0x6c, 0xe2, 0x1b, 0xff, 0xa5, 0x90, 0xa8, 0xd3, 0xbf, 0xea, 0x8c, 0xff,
+	0x30, 0xf6, 0xee, 0xce, 0x2d, 0x5b, 0xdf, 0xca, 0x9e, 0x34, 0xc1, 0x79, 0xfb, 0x65, 0x72, 0x09,
+	0xa9, 0x0f, 0xf2, 0xda, 0x51, 0xaa, 0x58, 0x70, 0x47, 0x97, 0x0f, 0xae, 0x50, 0xc3, 0xaf, 0xa8,
+	0x5c, 0xe8, 0xfb, 0xb0, 0x9c, 0x32, 0x38, 0x53, 0x16, 0x57, 0x6e, 0xca, 0xe2, 0x32, 0xfe, 0x5b,
+	0x0e, 0xea, 0xc9, 0x11, 0x42, 0x91, 0xfa, 0x22, 0x36, 0x7d, 0xfc, 0x24, 0xfe, 0xcd, 0xa9, 0x83,
+	0xcb, 0x8b, 0x6e, 0x77, 0xa6, 0xcf, 0x9e, 0xfe, 0x1a, 0x6a, 0x09, 0x92, 0x5f, 0x9a, 0x08, 0xff,
+	0xc3, 0x02, 0xac, 0xed, 0x7a, 0xc4, 0x0e, 0x08, 0xad, 0x13, 0x5f, 0x35, 0xae, 0xfe, 0xf2, 0x86,
+	0xfb, 0x62, 0x5e, 0xdd, 0x17, 0xb3, 0x07, 0xbc, 0x30, 0x4d, 0x9b, 0x6d, 0xc2, 0x82, 0xd4, 0x70,
+	0x54, 0xc6, 0xe0, 0x84, 0xcd, 0xd5, 0x3e, 0x85, 0x12, 0x15, 0x29, 0xee, 0x00, 0x35, 0x9b, 0x70,
+	0x80, 0x4a, 0xef, 0x07, 0x1d, 0x6f, 0x2a, 0x71, 0xcc, 0x01, 0xaa, 0x78, 0x8a, 0xbf, 0xe8, 0xeb,
+	0x7e, 0xb8, 0xdd, 0x44, 0x12, 0xc5, 0x5d, 0x80, 0x42, 0xa7, 0x27, 0x71, 0xa3, 0x31, 0xfe, 0x5c,
+	0x0e, 0x16, 0x24, 0x3e, 0x74, 0x83, 0xec, 0xb4, 0x9e, 0x3e, 0x6b, 0x74, 0x9e, 0x59, 0x07, 0xfb,
+	0x74, 0x83, 0x94, 0x00, 0x6c, 0xa3, 0xd4, 0xaa, 0xb0, 0x28, 0x00, 0xed, 0x83, 0x36, 0xb5, 0xc7,
+	0x69, 0x50, 0x11, 0x90, 0x4e, 0xab, 0xfd, 0x74, 0x9f, 0x5a, 0xe6, 0x56, 0xa0, 0x2a, 0x15, 0x7b,
+	0xd5, 0xd8, 0x7f, 0x49, 0xdd, 0x88, 0x6e, 0xc0, 0x4a, 0x08, 0x6d, 0x7f, 0x71, 0xd0, 0x6e, 0xee,
+	0x36, 0xda, 0x87, 0x8d, 0x2f, 0xaa, 0x3f, 0xcd, 0x19, 0xaf, 0x60, 0x3d, 0xd1, 0x4d, 0x14, 0x49,
+	0xfa, 0x9a, 0x25, 0x80, 0xc2, 0x3a, 0x12, 0x02, 0x52, 0x9e, 0x60, 0x17, 0xe5, 0x27, 0xd8, 0x4f,
+	0xe1, 0xc6, 0x21, 0xfd, 0xf0, 0x4f, 0x53, 0x76, 0xaf, 0x87, 0xa0, 0x65, 0xee, 0xe8, 0xb5, 0xc4,
+	0x7a, 0x33, 0x9e, 0x82, 0x9e, 0xc6, 0xeb, 0xda, 0x57, 0x08, 0xe3, 0x2e, 0xdc, 0x41, 0x46, 0x2f,
+	0x93, 0x16, 0x7d, 0x61, 0xd5, 0xbb, 0x07, 0xc6, 0x34, 0x22, 0x61, 0x5c, 0x28, 0xc0, 0xda, 0xe1,
+	0xc4, 0xeb, 0x9d, 0xda, 0x3e, 0x89, 0x99, 0xf3, 0xbf, 0xfc, 0x0b, 0xf3, 0x26, 0x2c, 0x30, 0x1b,
+	0xb0, 0x35, 0x70, 0x86, 0x8e, 0x38, 0x6f, 0x00, 0x03, 0xed, 0x53, 0xc8, 0x94, 0x93, 0x3e, 0x17,
+	0xee, 0x8c, 0x93, 0xfe, 0x7d, 0xa8, 0xa0, 0xdd, 0x53, 0x75, 0x5d, 0x43, 0x33, 0xb3, 0x78, 0x78,
+	0xdd, 0x84, 0x05, 0x77, 0x32, 0x0c, 0x5f, 0x0d, 0xb9, 0x89, 0x10, 0xdc, 0xc9, 0x10, 0x3b, 0xc8,
+	0x1e, 0x6f, 0xa9, 0x39, 0x52, 0x70, 0x99, 0xc7, 0xc7, 0xdb, 0xd1, 0x68, 0x20, 0x78, 0x08, 0xeb,
+	0xe7, 0x31, 0x21, 0x3e, 0xbb, 0xf0, 0xe4, 0xb8, 0xf5, 0xf3, 0x09, 0x21, 0xec, 0x7c, 0xcb, 0xcc,
+	0x84, 0x17, 0x68, 0x34, 0xc4, 0x2f, 0x6d, 0x15, 0xe6, 0x82, 0x73, 0x5a, 0x04, 0x8d, 0x85, 0xb3,
+	0xc1, 0xf9, 0x13, 0x7e, 0x7b, 0xc2, 0x66, 0x53, 0xd4, 0x82, 0x30, 0x9c, 0x51, 0xc8, 0x13, 0x42,
+	0xfd, 0xa5, 0xd6, 0x13, 0x33, 0x80, 0x32, 0x71, 0x37, 0xb4, 0xa0, 0x53, 0x71, 0x20, 0x5c, 0x9d,
+	0x2e, 0x0a, 0x3b, 0xf8, 0x33, 0x06, 0x33, 0xbe, 0x45, 0x3d, 0x6c, 0xa8, 0x39, 0xf3, 0x7a, 0xf3,
+	0xc7, 0xfd, 0x67, 0x94, 0x72, 0x28, 0x13, 0xb7, 0xe1, 0xe6, 0xfe, 0xc8, 0xee, 0x37, 0x98, 0x43,
+	0xd8, 0x9e, 0x1d, 0xd8, 0x4f, 0x9c, 0x41, 0x40, 0xbc, 0x50, 0xb2, 0x36, 0xe1, 0x56, 0x06, 0x1e,
+	0x19, 0x9c, 0x82, 0x46, 0x97, 0xe1, 0x0b, 0xe2, 0xfb, 0xf6, 0x09, 0x91, 0x6f, 0xfc, 0xe9, 0xf7,
+	0x85, 0x3a, 0xcc, 0x0f, 0x39, 0xad, 0xd0, 0x98, 0xf8, 0x19, 0xeb, 0x43, 0x21, 0xd1, 0x87, 0x0f,
+	0x60, 0x59, 0xa9, 0xe9, 0x2a, 0x4b, 0xde, 0xf8, 0xfd, 0x9c, 0x52, 0xea, 0xca, 0x02, 0xff, 0x18,
+	0x8a, 0xd8, 0x2e, 0x71, 0x2c, 0x79, 0x2b, 0xb6, 0xaf, 0xc5, 0x38, 0x6e, 0x8b, 0x76, 0x85, 0xe5,
+	0xf4, 0xef, 0xc1, 0x3c, 0x02, 0xbf, 0xcc, 0x78, 0x18, 0x7f, 0x3d, 0x07, 0x2b, 0x6a, 0x45, 0xe1,
+	0xa3, 0xd3, 0xbc, 0x47, 0xc6, 0x03, 0x87, 0x88, 0x2d, 0xf7, 0xeb, 0x99, 0x4d, 0x93, 0xb6, 0x5b,
+	0x93, 0x8c, 0x07, 0x17, 0xa6, 0x28, 0xa9, 0x7f, 0x02, 0xa5, 0x10, 0x7a, 0x89, 0xda, 0x5c, 0x81,
+	0x59, 0xe2, 0x79, 0xe8, 0xfd, 0x5c, 0x32, 0xf9, 0x87, 0x71, 0x07, 0x36, 0x25, 0x2d, 0xd3, 0x1e,
+	0x05, 0xce, 0xb1, 0xd3, 0xb3, 0x15, 0xb5, 0xf4, 0x7b, 0x79, 0xd8, 0xca, 0xa6, 0xc1, 0xde, 0xfc,
+	0x00, 0x96, 0xec, 0x20, 0xb0, 0x7b, 0xa7, 0xf4, 0xfd, 0x9f, 0x1a, 0x90, 0x45, 0xaf, 0x32, 0xdf,
+	0x4a, 0x2b, 0x82, 0x9e, 0x41, 0x7d, 0x6a, 0x3d, 0xee, 0x13, 0x95, 0x43, 0x9e, 0xad, 0x9d, 0x4a,
+	0x9f, 0x28, 0x84, 0x59, 0x2f, 0xaa, 0x85, 0x2f, 0xfb, 0xa2, 0x4a, 0x6d, 0x4c, 0x29, 0x1c, 0xc5,
+	0x0a, 0x9e, 0x61, 0xad, 0xa8, 0x27, 0x0b, 0xe2, 0x6a, 0xbe, 0x05, 0x1b, 0xc2, 0x7b, 0x32, 0x6d,
+	0xf8, 0xfe, 0x77, 0x0e, 0x6e, 0xa6, 0xe3, 0xaf, 0xe5, 0xfa, 0x75, 0x15, 0x47, 0xc3, 0x74, 0x1f,
+	0xc2, 0xc2, 0xb5, 0x7c, 0x08, 0x67, 0xae, 0xe5, 0x43, 0x38, 0x9b, 0xe1, 0x43, 0xf8, 0xdb, 0xb0,
+	0x25, 0x6f, 0x04, 0x69, 0x03, 0x43, 0x15, 0x76, 0x70, 0xae, 0xaa, 0xc9, 0x62, 0x70, 0xce, 0x07,
+	0x95, 0x6a, 0x60, 0x3f, 0x18, 0x8d, 0x2d, 0xfb, 0x38, 0xc0, 0x57, 0xcc, 0x59, 0xb3, 0x44, 0x21,
+	0x0d, 0x0a, 0x30, 0xfe, 0x51, 0x1e, 0xee, 0x4c, 0xa9, 0x00, 0x47, 0xf6, 0x75, 0xfc, 0x91, 0x84,
+	0x8b, 0x64, 0x53, 0x35, 0x47, 0x4c, 0x67, 0xb2, 0xad, 0x78, 0x0d, 0x48, 0xcc, 0x62, 0x6f, 0x2d,
+	0xfa, 0x5f, 0xcb, 0x41, 0x3d, 0x8b, 0x56, 0x5b, 0x87, 0x79, 0xec, 0x2b, 0x2e, 0xcc, 0x39, 0xde,
+	0xd3, 0xaf, 0xc4, 0x39, 0x24, 0xf1, 0x5e, 0x34, 0x93, 0x7c, 0x87, 0xfa, 0xfb, 0x39, 0x58, 0xe6,
+	0xc7, 0xad, 0xcf, 0x58, 0xdf, 0xc5, 0x24, 0xbc, 0x03, 0x35, 0x3c, 0x4c, 0x25, 0x14, 0x69, 0x95,
+	0x23, 0xa4, 0xa7, 0x93, 0x87, 0xf4, 0xa4, 0xc9, 0xfd, 0xd0, 0x12, 0xaf, 0x2c, 0x35, 0xc4, 0x48,
+	0xe4, 0x1a, 0xcc, 0xf8, 0x84, 0xf4, 0xb1, 0xbd, 0xec, 0x37, 0xdd, 0x1f, 0xe9, 0xff, 0xd6, 0xd0,
+	0x25, 0xc3, 0x91, 0xeb, 0xf4, 0x58, 0x5b, 0x4b, 0xe6, 0x22, 0x05, 0xbe, 0x40, 0x98, 0xb1, 0x06,
+	0x2b, 0x6a, 0x5b, 0x71, 0x97, 0x3a, 0x87, 0x4d, 0x01, 0x0f, 0x7a, 0xa7, 0x8e, 0x7b, 0x72, 0xe0,
+	0x0e, 0x2e, 0xd4, 0xfe, 0xbc, 0x0d, 0x4c, 0xd0, 0xdd, 0x3e, 0xe9, 0x5b, 0xe3, 0xc9, 0x91, 0x25,
+	0xde, 0x92, 0x4a, 0x66, 0x45, 0xc0, 0x0f, 0x27, 0x47, 0xf4, 0x65, 0x27, 0xb5, 0xe7, 0xf9, 0xf4,
+	0x9e, 0x1b, 0x06, 0x6c, 0x65, 0xd7, 0x8c, 0xad, 0xfb, 0x01, 0xd4, 0x0e, 0xc6, 0xc4, 0xfd, 0xf2,
+	0xe3, 0x6b, 0xfc, 0x06, 0x68, 0x32, 0x87, 0xe8, 0x48, 0xf1, 0x06, 0x6b, 0xb5, 0x46, 0xee, 0x80,
+	0xf7, 0xa7, 0x68, 0x2e, 0xbe, 0x91, 0x9a, 0x42, 0x5f, 0xa3, 0x77, 0x07, 0x23, 0x5f, 0x9d, 0x5d,
+	0x63, 0x15, 0x96, 0x15, 0x28, 0xb6, 0x74, 0x15, 0x96, 0x39, 0xa4, 0x79, 0xee, 0xf8, 0x91, 0xcf,
+	0xf6, 0x36, 0xac, 0xa8, 0x60, 0x6c, 0x00, 0x3b, 0x3c, 0x51, 0x08, 0xd6, 0x8c, 0x5f, 0xc6, 0xef,
+	0xd1, 0x6b, 0x65, 0x60, 0x7b, 0x01, 0x35, 0xa3, 0x11, 0xd7, 0x9f, 0xf8, 0xe6, 0xb8, 0x27, 0x3a,
+	0xfe, 0x00, 0x96, 0xd0, 0x5d, 0x3d, 0xe6, 0x71, 0x57, 0x41, 0xb0, 0x38, 0xb7, 0xe9, 0x50, 0x9c,
+	0xf8, 0xc4, 0x93, 0x74, 0x5a, 0xf8, 0x4d, 0x71, 0x74, 0xd8, 0xde, 0x8c, 0x3c, 0x21, 0x45, 0xe1,
+	0x37, 0xbd, 0x47, 0xf6, 0x88, 0x87, 0x2b, 0x96, 0xe0, 0x0d, 0x5a, 0x06, 0x19, 0x1b, 0x70, 0x23,
+	0xa5, 0x79, 0x38, 0x06, 0x7f, 0x27, 0x07, 0xf5, 0x3d, 0xc7, 0xef, 0x8d, 0xce, 0x88, 0x87, 0x4d,
+	0x89, 0xce, 0x15, 0xef, 0x40, 0xad, 0x8f, 0x38, 0x4b, 0xf2, 0x58, 0x67, 0xcf, 0x9e, 0x02, 0x21,
+	0xdc, 0xd5, 0xaf, 0xbb, 0x2a, 0x32, 0x7c, 0x6e, 0x0a, 0x19, 0x3e, 0x37, 0xb4, 0x17, 0x29, 0xed,
+	0xc4, 0x5e, 0xdc, 0x82, 0x8d, 0x27, 0x24, 0xe8, 0x9d, 0xbe, 0x70, 0x7c, 0xdf, 0x71, 0x4f, 0x76,
+	0x63, 0xe7, 0xbe, 0xdb, 0x70, 0x33, 0x1d, 0x8d, 0xc5, 0xdf, 0x82, 0x7b, 0xf4, 0x61, 0xbc, 0xe7,
+	0x39, 0x47, 0xa4, 0x3b, 0x62, 0x75, 0xa6, 0xee, 0x61, 0x0f, 0xe0, 0xfe, 0x25, 0x74, 0x91, 0x64,
+	0xb1, 0x0a, 0xf9, 0xf3, 0x71, 0x58, 0xfe, 0xef, 0xe5, 0x61, 0x45, 0x85, 0xa3, 0x68, 0xed, 0xc0,
+	0xea, 0x31, 0x85, 0x93, 0x3e, 0x3e, 0x42, 0xfb, 0x96, 0xfc, 0xdc, 0xb0, 0x8c, 0x48, 0x2c, 0xc6,
+	0x77, 0xa2, 0xf7, 0x60, 0xe5, 0xd8, 0xf1, 0xfc, 0xc0, 0xa2, 0xcf, 0xb8, 0x89, 0x30, 0x80, 0x1a,
+	0xc3, 0xb5, 0xc9, 0x9b, 0x70, 0x04, 0xb5, 0x0f, 0x60, 0x2d, 0x51, 0x40, 0x8e, 0x04, 0x58, 0x56,
+	0x8b, 0x30, 0x94, 0xf6, 0x31, 0xdc, 0x18, 0xda, 0x0e, 0x7b, 0x07, 0x70, 0x5c, 0x2b, 0x70, 0xc6,
+	0x72, 0x55, 0x5c, 0xd8, 0x56, 0x29, 0xc1, 0x2e, 0xc5, 0x77, 0x9d, 0x71, 0x54, 0xdd, 0x77, 0x61,
+	0x23, 0xbd, 0x24, 0xaf, 0x93, 0x9b, 0x5b, 0xd7, 0x93, 0x65, 0xb9, 0xa2, 0x3e, 0x87, 0xba, 0x3c,
+	0x52, 0xf2, 0x30, 0x4f, 0x1f, 0xad, 0xd9, 0xf4, 0xd1, 0x7a, 0x1b, 0xaa, 0x03, 0xdb, 0x0f, 0xb0,
+	0x00, 0x7f, 0x68, 0xe2, 0x66, 0xe8, 0x0a, 0x85, 0x73, 0x5a, 0xfa, 0xd6, 0x64, 0xfc, 0xed, 0x1c,
+	0x6c, 0xa5, 0x49, 0x8b, 0xd2, 0x84, 0x06, 0xdc, 0x12, 0x4d, 0xe8, 0x1d, 0x73, 0xbc, 0xc5, 0x64,
+	0x56, 0xf5, 0xd4, 0xd7, 0x91, 0x68, 0x17, 0x69, 0xd8, 0x3a, 0xc4, 0x91, 0xfd, 0x1e, 0x6c, 0x24,
+	0x58, 0xd0, 0xab, 0xa7, 0xe2, 0xec, 0x50, 0x8f, 0x31, 0x68, 0xba, 0x7d, 0x1c, 0xa0, 0x16, 0xe8,
+	0xdc, 0xb1, 0xff, 0xd0, 0x1b, 0x9d, 0xd0, 0xe5, 0xa0, 0xb4, 0xef, 0x5a, 0x4e, 0xfe, 0xcf, 0xa1,
+	0x7a, 0x48, 0x88, 0xa7, 0x30, 0xa0, 0xd6, 0x05, 0x42, 0x3c, 0x65, 0x60, 0x4b, 0x14, 0xb2, 0x1b,
+	0x0f, 0xc2, 0x52, 0x4d, 0x45, 0x06, 0x7d, 0x2a, 0x36, 0xc7, 0xbd, 0xce, 0x85, 0xfb, 0xff, 0x91,
+	0x0e, 0x4c, 0xd7, 0x64, 0xb3, 0xd7, 0xd2, 0x64, 0x73, 0x19, 0x9a, 0xcc, 0xf8, 0x57, 0x05, 0x58,
+	0x0a, 0x7b, 0x1c, 0xed, 0x15, 0xfe, 0x85, 0xdb, 0x23, 0x7d, 0xb1, 0x57, 0xf0, 0x2f, 0x6d, 0x1f,
+	0x6a, 0xae, 0x34, 0xcc, 0xdc, 0xf0, 0xc5, 0x83, 0x12, 0x36, 0xe5, 0x7b, 0xcf, 0x85, 0xdb, 0x93,
+	0xa7, 0x83, 0x99, 0xba, 0xaa, 0x6e, 0x0c, 0xa2, 0x3d, 0x83, 0x32, 0x93, 0x0f, 0xb1, 0x0c, 0xd8,
+	0xc0, 0xa8, 0xd1, 0x44, 0x59, 0x8b, 0xc8, 0x5c, 0x3c, 0x96, 0x30, 0x9a, 0x0d, 0x6b, 0x9c, 0xd3,
+	0x90, 0x0b, 0x7d, 0x28, 0x92, 0xf5, 0x99, 0x84, 0x2b, 0xe0, 0x65, 0x8b, 0xc3, 0x5c, 0x39, 0x96,
+	0x29, 0x90, 0x91, 0xd6, 0x86, 0x25, 0x2e, 0x79, 0xd6, 0x18, 0x25, 0x96, 0x4d, 0xc0, 0xc2, 0xce,
+	0x7d, 0x89, 0x77, 0xb6, 0x48, 0x9b, 0x15, 0x4f, 0xc1, 0x69, 0x4f, 0xa0, 0xca, 0x24, 0xd4, 0x71,
+	0x8f, 0x47, 0x78, 0x42, 0xc4, 0x17, 0xc4, 0x0d, 0x89, 0x61, 0x5c, 0xb0, 0xcd, 0x25, 0x5a, 0xa8,
+	0x15, 0x95, 0x31, 0x7e, 0x37, 0x07, 0x95, 0xce, 0xf8, 0x4c, 0x16, 0xd8, 0x5f, 0xe6, 0xbe, 0xc7,
+	0x4c, 0x4c, 0x67, 0xd4, 0x78, 0xe4, 0x92, 0x5e, 0xc0, 0x6e, 0x6b, 0x25, 0x6a, 0x62, 0x3a, 0xdb,
+	0xe5, 0x10, 0x26, 0x4e, 0x61, 0x7b, 0xfe, 0x58, 0x9c, 0x7e, 0xd5, 0xc4, 0x69, 0x05, 0x34, 0xac,
+	0x75, 0xe4, 0x84, 0x11, 0x53, 0x46, 0x03, 0x96, 0x15, 0x28, 0xce, 0xeb, 0x37, 0x84, 0x9a, 0xb6,
+	0xc6, 0x14, 0xae, 0xd8, 0x4e, 0xbd, 0x88, 0x9e, 0x1d, 0x80, 0xbe, 0x0b, 0x37, 0x30, 0xcc, 0x80,
+	0x98, 0xb6, 0xdb, 0x1f, 0x0d, 0x3b, 0x84, 0xf4, 0x25, 0xc7, 0x67, 0x76, 0x9f, 0x18, 0x10, 0xf7,
+	0x24, 0x38, 0xc5, 0x63, 0x03, 0x50, 0xd0, 0x3e, 0x83, 0x18, 0x7f, 0x12, 0xf4, 0xb4, 0xd2, 0x91,
+	0x23, 0x1e, 0x2b, 0x7e, 0x74, 0x11, 0x10, 0x3f, 0xb4, 0x99, 0x10, 0x1a, 0xab, 0x10, 0x10, 0x9f,
+	0xc6, 0xb2, 0x31, 0xf4, 0x29, 0x3e, 0xeb, 0x94, 0xcc, 0x79, 0xfa, 0xfd, 0x8c, 0x9c, 0x27, 0x2f,
+	0x32, 0x85, 0x94, 0x8b, 0xcc, 0x0e, 0xd4, 0xf6, 0x48, 0x6f, 0xd4, 0x27, 0x72, 0x93, 0x6f, 0x01,
+	0x50, 0xe5, 0xce, 0x9f, 0x36, 0x70, 0x43, 0x28, 0x51, 0x08, 0x7b, 0xce, 0x30, 0x3e, 0x02, 0x4d,
+	0x2e, 0x13, 0x39, 0x90, 0xf6, 0x19, 0xb4, 0x6f, 0xb1, 0x3b, 0x15, 0x3e, 0x9b, 0x20, 0x8c, 0x92,
+	0x1a, 0x7f, 0x3e, 0x0f, 0xab, 0xe6, 0xc4, 0xe5, 0xb6, 0xc1, 0xc7, 0x93, 0x0b, 0xe2, 0x5d, 0xd5,
+	0x4c, 0x96, 0x6d, 0x17, 0xa6, 0x91, 0xf5, 0x18, 0xa8, 0xd1, 0x93, 0xad, 0x09, 0x65, 0x0e, 0x15,
+	0x7e, 0x20, 0xdb, 0xb0, 0x8c, 0xb1, 0x8d, 0x56, 0x30, 0xb2, 0xe8, 0xd1, 0x26, 0xb0, 0x1d, 0x11,
+	0x31, 0x52, 0x43, 0x54, 0x77, 0xf4, 0x02, 0x11, 0x32, 0x5b, 0xd5, 0x2c, 0x8c, 0x6c, 0x39, 0x30,
+	0x61, 0xf5, 0x9d, 0xbb, 0xc4, 0xea, 0x3b, 0xaf, 0x5a, 0x7d, 0x8d, 0x3a, 0xac, 0xc5, 0x07, 0x04,
+	0xcf, 0xa9, 0xbf, 0x5b, 0x80, 0x55, 0x76, 0x26, 0x69, 0x4c, 0x82, 0xd1, 0x57, 0x34, 0x56, 0x19,
+	0x83, 0x50, 0xc8, 0x1a, 0x84, 0xbb, 0x50, 0x19, 0xda, 0xe7, 0x96, 0xe4, 0x09, 0xc3, 0xc7, 0x6b,
+	0x61, 0x68, 0x9f, 0x3f, 0x11, 0xce, 0x30, 0xef, 0x82, 0x46, 0x89, 0x98, 0xd7, 0xb0, 0xe5, 0x91,
+	0x81, 0x1d, 0x08, 0xc7, 0xda, 0x9c, 0x59, 0x1d, 0xda, 0xe7, 0xe8, 0x66, 0xcc, 0xe1, 0x2a, 0xb5,
+	0x7d, 0xe4, 0x8f, 0x06, 0x93, 0x80, 0x60, 0x64, 0x4d, 0x48, 0xdd, 0x40, 0x78, 0xca, 0x2c, 0xcc,
+	0x5f, 0x65, 0x16, 0x8a, 0x97, 0xcc, 0x42, 0x29, 0x66, 0x7b, 0x37, 0xa0, 0xcc, 0x1a, 0x45, 0x3c,
+	0x7e, 0x10, 0xae, 0x43, 0xd8, 0xcd, 0x43, 0xe2, 0xb1, 0xb3, 0x2f, 0x9d, 0xa9, 0xf8, 0x74, 0xe0,
+	0x4c, 0xad, 0xc1, 0x4a, 0x87, 0x9a, 0x7d, 0x62, 0xf3, 0x44, 0x6d, 0xe1, 0x31, 0x38, 0x16, 0xd0,
+	0xa1, 0x2e, 0xcd, 0x38, 0x33, 0xc3, 0x84, 0xf1, 0xd7, 0x7f, 0x61, 0x0e, 0x6e, 0xa4, 0x20, 0xa5,
+	0xa8, 0xbf, 0x74, 0x07, 0xb7, 0x7b, 0x50, 0xb1, 0xcf, 0x4e, 0x70, 0x5c, 0x87, 0xa3, 0xbe, 0x38,
+	0xa5, 0x2d, 0xda, 0x67, 0x27, 0x6c, 0x4c, 0x5f, 0x8c, 0xfa, 0xec, 0x66, 0x17, 0x52, 0xbd, 0xfa,
+	0xac, 0x71, 0x68, 0xf5, 0xc9, 0x20, 0xb0, 0x85, 0x00, 0x08, 0x52, 0x8a, 0xd9, 0xa3, 0x88, 0x6b,
+	0xaf, 0x1a, 0x03, 0xca, 0x6c, 0x00, 0x7d, 0x4a, 0x6e, 0x9f, 0x9d, 0x08, 0x87, 0x31, 0x0e, 0xec,
+	0x8e, 0x1a, 0x67, 0x27, 0xda, 0xfb, 0xb0, 0xda, 0x1f, 0xb9, 0x81, 0xf5, 0xc6, 0x76, 0x02, 0xeb,
+	0x78, 0xe4, 0x29, 0x6f, 0x2a, 0x45, 0x53, 0xa3, 0xc8, 0xcf, 0x6c, 0x27, 0x78, 0x32, 0xf2, 0xa4,
+	0xb7, 0x15, 0xfe, 0x1a, 0x82, 0xed, 0xc5, 0x10, 0x2b, 0x0e, 0xe3, 0x2d, 0xbd, 0xc5, 0x1d, 0xb6,
+	0xb8, 0xf3, 0x17, 0x0a, 0x40, 0xe9, 0x98, 0x90, 0x0e, 0x03, 0x50, 0xb1, 0xa3, 0x68, 0xf4, 0x01,
+	0xf4, 0x7b, 0xf6, 0x80, 0xe6, 0xe0, 0xe0, 0x72, 0x50, 0x3d, 0x26, 0xa4, 0xcb, 0x10, 0x1d, 0x0e,
+	0xa7, 0xb6, 0xb0, 0xa1, 0xe3, 0x4a, 0x8f, 0x2e, 0x73, 0x43, 0xc7, 0xa5, 0xaf, 0x2e, 0x14, 0xc1,
+	0x17, 0x44, 0x7d, 0x11, 0x11, 0x6c, 0x25, 0x24, 0x25, 0xa8, 0x9c, 0x90, 0xa0, 0x0c, 0xd1, 0xaf,
+	0x64, 0x88, 0x7e, 0xfa, 0xb2, 0x5a, 0xca, 0x58, 0x56, 0xf7, 0xf8, 0x4a, 0x75, 0x42, 0xb7, 0xf8,
+	0x7a, 0x8d, 0x3b, 0x38, 0x0e, 0xed, 0xf3, 0x96, 0x70, 0x8a, 0x4f, 0xac, 0x13, 0xed, 0x92, 0x75,
+	0xb2, 0x1c, 0x5b, 0x27, 0xdf, 0x82, 0x75, 0x7f, 0xec, 0x11, 0xbb, 0x2f, 0x82, 0x59, 0xc6, 0xf8,
+	0xc6, 0xe4, 0xd7, 0x57, 0xd8, 0xe4, 0xad, 0x72, 0x34, 0xc6, 0x17, 0x08, 0x64, 0xca, 0x32, 0x5e,
+	0x4d, 0x5b, 0xc6, 0xd1, 0x53, 0xd7, 0x9a, 0xf4, 0xd4, 0x65, 0x3c, 0x84, 0x5a, 0x87, 0xc4, 0xe3,
+	0x9c, 0x33, 0x57, 0x02, 0xdd, 0xe5, 0x65, 0x72, 0x5c, 0x73, 0x2f, 0x60, 0xa3, 0x43, 0x82, 0xc7,
+	0x71, 0x89, 0x95, 0xc2, 0x8c, 0xd2, 0x04, 0x3d, 0x97, 0x21, 0xe8, 0xd4, 0x6c, 0x91, 0xce, 0x0e,
+	0xab, 0xfb, 0x08, 0xaa, 0x1d, 0x12, 0xbc, 0x60, 0xc2, 0x21, 0xea, 0x48, 0x6a, 0xd3, 0x5c, 0x42,
+	0x9b, 0x1a, 0xcb, 0x50, 0x93, 0x0a, 0x22, 0xb7, 0x4f, 0x41, 0xe7, 0x40, 0x65, 0xd2, 0x05, 0xdf,
+	0x74, 0x49, 0xc9, 0xa5, 0x4b, 0x0a, 0xb5, 0xc7, 0xa4, 0xf2, 0x4a, 0xad, 0x4a, 0x48, 0x63, 0x6a,
+	0x55, 0xa1, 0x08, 0xe7, 0xd2, 0x45, 0x38, 0x56, 0x55, 0xc4, 0x2b, 0xb4, 0x46, 0xae, 0x77, 0x48,
+	0xf0, 0x4a, 0x16, 0x01, 0xc9, 0xb9, 0x33, 0x26, 0x30, 0xb9, 0x14, 0x81, 0xa1, 0x8a, 0x34, 0xc9,
+	0x01, 0xb9, 0x7f, 0x1b, 0x56, 0x3b, 0x24, 0x38, 0x8c, 0x44, 0x5b, 0x0a, 0xdc, 0x57, 0x16, 0x41,
+	0x2e, 0xb1, 0x08, 0x98, 0xae, 0x8f, 0x95, 0x45, 0xae, 0xef, 0x83, 0x86, 0x18, 0xba, 0x20, 0xa4,
+	0x77, 0x82, 0x68, 0xd1, 0xe4, 0x62, 0x5b, 0xfc, 0x2a, 0x2c, 0x2b, 0x45, 0x90, 0xd3, 0x77, 0x60,
+	0x15, 0x07, 0x07, 0xf5, 0x83, 0x60, 0x96, 0x50, 0x25, 0xb9, 0xf4, 0xcd, 0x28, 0x56, 0x38, 0xca,
+	0xd7, 0xd1, 0x38, 0x21, 0x6e, 0xdf, 0x0e, 0x2d, 0x5b, 0x3f, 0x2f, 0xc0, 0x52, 0x08, 0x8a, 0xf6,
+	0x11, 0xe1, 0x2d, 0x89, 0xab, 0x07, 0x3f, 0xb5, 0xef, 0xc0, 0xbc, 0xcd, 0x89, 0xf1, 0x39, 0xf2,
+	0x8e, 0x9c, 0xff, 0x42, 0x65, 0x83, 0xdf, 0xa6, 0x28, 0xa1, 0xff, 0x41, 0x0e, 0xe6, 0x38, 0x4c,
+	0xab, 0x40, 0xde, 0xe9, 0xe3, 0xd8, 0xe6, 0x1d, 0x66, 0x07, 0xe8, 0x13, 0xee, 0xf8, 0x21, 0x3c,
+	0xed, 0x4a, 0xa6, 0x0c, 0xa2, 0xb6, 0xf8, 0xa1, 0xed, 0xbf, 0xc6, 0xe3, 0x1b, 0xfb, 0x4d, 0x5b,
+	0xd3, 0x3b, 0x1d, 0x39, 0x3d, 0x22, 0x1c, 0xed, 0xa6, 0xb5, 0x66, 0x97, 0x51, 0x9a, 0xa2, 0x04,
+	0x7f, 0xa0, 0xa1, 0x76, 0x1f, 0xc9, 0x75, 0xb9, 0xc4, 0x20, 0xcc, 0x71, 0x79, 0x13, 0xf8, 0x06,
+	0x82, 0xae, 0xcd, 0xfc, 0x08, 0x02, 0x1c, 0x44, 0x09, 0xf4, 0x9f, 0xe5, 0x60, 0x8e, 0xf3, 0xfc,
+	0x72, 0xbd, 0xc1, 0x8c, 0x47, 0xac, 0x37, 0xf4, 0x37, 0x6d, 0x90, 0xe3, 0xd3, 0x65, 0x13, 0x6e,
+	0xa2, 0x45, 0xb3, 0xe4, 0xf8, 0x0d, 0x0e, 0xd0, 0x96, 0x61, 0xd6, 0xf1, 0x2d, 0x77, 0x84, 0xc6,
+	0x8f, 0x19, 0xc7, 0x6f, 0x8f, 0xa8, 0x36, 0x7b, 0x35, 0x0a, 0x08, 0x6f, 0x47, 0x38, 0xa7, 0xff,
+	0x38, 0x0f, 0xcb, 0x0a, 0xf8, 0xd2, 0x79, 0xfd, 0x24, 0x1a, 0x49, 0x3e, 0xaf, 0xf2, 0x5d, 0x2c,
+	0x85, 0x55, 0x62, 0x34, 0x75, 0x28, 0xd2, 0x80, 0x28, 0xa9, 0x53, 0xe1, 0xb7, 0xfe, 0x37, 0xa3,
+	0x91, 0xda, 0x80, 0x12, 0x97, 0x06, 0x2b, 0x1c, 0xb0, 0x22, 0x07, 0xb4, 0xfa, 0xf4, 0x3e, 0x8e,
+	0xc8, 0xe4, 0xe8, 0xd5, 0x38, 0x66, 0x2f, 0x42, 0x50, 0x5e, 0xbc, 0x76, 0xca, 0x8b, 0x5f, 0x5e,
+	0x8a, 0x1c, 0xc0, 0x79, 0x21, 0x52, 0xe6, 0xc5, 0xdf, 0x6a, 0x6a, 0x1c, 0x23, 0xf1, 0xa2, 0xe1,
+	0xd3, 0xab, 0x5c, 0x57, 0xc4, 0xc6, 0x52, 0x6b, 0x44, 0x23, 0xc3, 0x1f, 0xdf, 0xe4, 0x24, 0x12,
+	0xa9, 0x45, 0xe2, 0x63, 0xa3, 0x3f, 0xbe, 0x5a, 0xf7, 0x95, 0xfe, 0xe4, 0xd5, 0xfe, 0x18, 0x1f,
+	0xc2, 0x5a, 0xbc, 0x32, 0x9c, 0x54, 0x79, 0xe4, 0x73, 0xea, 0xc8, 0x1b, 0xa7, 0xb0, 0xf2, 0x8a,
+	0x78, 0xce, 0xf1, 0xc5, 0x57, 0xe0, 0x17, 0xa1, 0x3c, 0xce, 0x17, 0xe2, 0x0e, 0x0e, 0x0f, 0x61,
+	0x35, 0x56, 0x53, 0x14, 0xee, 0xcf, 0x02, 0xac, 0xd0, 0xfe, 0xc1, 0x3f, 0x8c, 0x9f, 0x2e, 0x88,
+	0x4b, 0xa2, 0xe2, 0x76, 0x76, 0x0d, 0xa7, 0x45, 0x49, 0x96, 0xb9, 0xc1, 0x55, 0x7c, 0xd2, 0x71,
+	0x64, 0xe6, 0x6a, 0xb6, 0x6e, 0x51, 0x16, 0x29, 0x80, 0x2d, 0xeb, 0xc8, 0x8f, 0x66, 0x46, 0xf1,
+	0xa3, 0x49, 0xcb, 0xeb, 0x35, 0xfb, 0x55, 0xe4, 0xf5, 0xa2, 0xe9, 0xcd, 0xd8, 0x45, 0x99, 0x9e,
+	0x60, 0xe3, 0x19, 0x83, 0x92, 0x43, 0x20, 0xd2, 0x9b, 0xf1, 0x22, 0x34, 0xbd, 0x99, 0x70, 0xe0,
+	0x9f, 0x4f, 0xa4, 0x37, 0x4b, 0x29, 0x2d, 0xd2, 0x9b, 0x61, 0x21, 0xfd, 0x3f, 0x17, 0x44, 0x56,
+	0xb1, 0x6f, 0xc3, 0x8d, 0xd0, 0xc9, 0x2e, 0x63, 0x8c, 0xd7, 0x05, 0x41, 0xcc, 0x45, 0x80, 0xba,
+	0x17, 0xa4, 0x96, 0x95, 0xdd, 0x45, 0xeb, 0x29, 0x85, 0xb9, 0xab, 0xe0, 0x0f, 0x24, 0xdf, 0xd1,
+	0xca, 0xce, 0xbb, 0x57, 0xe8, 0xfe, 0x76, 0xd7, 0x23, 0x84, 0x8d, 0x26, 0x2b, 0x49, 0x45, 0xdc,
+	0xa7, 0x92, 0xeb, 0xf6, 0xc2, 0x50, 0x4d, 0xf1, 0xcd, 0x96, 0x14, 0x8f, 0x13, 0x71, 0x5c, 0xd4,
+	0xe2, 0x45, 0x0e, 0x68, 0xb9, 0x89, 0x77, 0x65, 0xee, 0x97, 0xa5, 0xc4, 0x21, 0x6e, 0x02, 0xff,
+	0xc4, 0xce, 0xf0, 0x28, 0x4e, 0xfe, 0x58, 0xdd, 0x12, 0x89, 0xd7, 0x42, 0x31, 0x17, 0xbe, 0x89,
+	0x45, 0x2e, 0x93, 0x21, 0x1c, 0x7d, 0x6e, 0x1f, 0xc1, 0x4a, 0x9c, 0xd4, 0xb2, 0xfd, 0x21, 0xbb,
+	0x48, 0x94, 0x4c, 0x2d, 0x46, 0xde, 0xf0, 0x87, 0xc6, 0xc7, 0x50, 0x14, 0x7d, 0x55, 0x93, 0x99,
+	0xad, 0x44, 0x91, 0xc5, 0xff, 0x57, 0xfc, 0xcb, 0xd1, 0xe8, 0xe1, 0x4e, 0xb7, 0xf1, 0xbc, 0x59,
+	0xcd, 0xe9, 0xff, 0x7a, 0x46, 0xce, 0xdd, 0x76, 0x66, 0x0f, 0x26, 0xe2, 0xa4, 0xc5, 0x3f, 0xa2,
+	0x8c, 0x6e, 0xf9, 0x58, 0x46, 0x37, 0x39, 0x10, 0x42, 0x5a, 0x36, 0x51, 0x04, 0xc5, 0x8c, 0x12,
+	0x41, 0x41, 0xf7, 0xc9, 0xa8, 0x2b, 0xdc, 0xcc, 0x51, 0xf2, 0x45, 0x0f, 0xb4, 0xf7, 0x60, 0x39,
+	0xf4, 0xab, 0x0b, 0x3b, 0xe8, 0x63, 0xde, 0x02, 0x91, 0xf7, 0xa4, 0x1f, 0xba, 0x48, 0xfa, 0x5a,
+	0x07, 0x16, 0x91, 0x5f, 0x6f, 0x60, 0xe3, 0x95, 0xbd, 0xb2, 0xf3, 0xe8, 0x2a, 0x72, 0xbd, 0xcd,
+	0x07, 0x6e, 0x97, 0x96, 0x33, 0x17, 0xfc, 0xe8, 0x83, 0x2a, 0x27, 0x5b, 0xbc, 0x2d, 0xd6, 0x8b,
+	0xcc, 0x32, 0x1b, 0x01, 0xa8, 0x55, 0xb8, 0x37, 0x1a, 0x0e, 0x9d, 0x60, 0x48, 0xdc, 0x30, 0x24,
+	0xa1, 0xc4, 0x8f, 0xa5, 0x11, 0x82, 0x47, 0x24, 0x18, 0xff, 0x9d, 0xfa, 0x97, 0x4a, 0xac, 0xab,
+	0xb0, 0xd8, 0x3e, 0x68, 0x5b, 0x9d, 0x6e, 0xa3, 0xbd, 0xd7, 0x30, 0xf7, 0x78, 0xa0, 0xf7, 0xe1,
+	0xcb, 0xc7, 0xd6, 0xf3, 0xe6, 0x17, 0xdc, 0xb9, 0x14, 0x3f, 0x2c, 0xea, 0x25, 0x5a, 0xcd, 0x33,
+	0xff, 0xd3, 0x5d, 0xb3, 0x75, 0xd8, 0xe5, 0x80, 0x82, 0x56, 0x86, 0xd2, 0x8b, 0x97, 0xfb, 0xdd,
+	0x96, 0xd5, 0x69, 0x3d, 0xad, 0xce, 0xd0, 0xcf, 0xf6, 0xcb, 0xfd, 0x7d, 0x6b, 0xaf, 0xd1, 0x6d,
+	0x54, 0x67, 0x99, 0xdf, 0x29, 0x9d, 0x53, 0xab, 0xf3, 0xf2, 0x31, 0x8d, 0x07, 0xa7, 0xf9, 0xe8,
+	0xe6, 0x28, 0x11, 0x87, 0x3e, 0x6d, 0xb6, 0xab, 0xf3, 0x11, 0x91, 0x94, 0xb4, 0xae, 0xa8, 0x14,
+	0xb5, 0x76, 0x9f, 0x35, 0xda, 0x4f, 0x9b, 0xd5, 0x12, 0xad, 0x5f, 0xb4, 0xa8, 0xb1, 0xdf, 0xad,
+	0x02, 0x25, 0x93, 0x9b, 0xc8, 0xa0, 0x0b, 0x46, 0x17, 0x36, 0xf8, 0x40, 0x9b, 0xf6, 0x9b, 0x14,
+	0x47, 0xd3, 0x2f, 0xe9, 0xa9, 0x6d, 0xc1, 0xcd, 0x74, 0xae, 0x57, 0x4d, 0x46, 0x92, 0x9c, 0x7c,
+	0xc5, 0xb7, 0xda, 0xd8, 0x81, 0xb5, 0x57, 0x18, 0xb0, 0x9b, 0x92, 0x43, 0x2a, 0x75, 0x53, 0x33,
+	0x7e, 0x3e, 0x0b, 0xeb, 0x89, 0x42, 0xd8, 0xa0, 0x1b, 0x50, 0x74, 0x7c, 0x4b, 0xde, 0xa2, 0xe6,
+	0x1d, 0x9f, 0x11, 0xd3, 0xeb, 0xbc, 0xe3, 0x5b, 0xd4, 0x69, 0x0a, 0x53, 0xe9, 0xcc, 0x39, 0xfe,
+	0x0b, 0xc7, 0x4d, 0x73, 0x78, 0x2a, 0xa4, 0x39, 0x3c, 0x6d, 0xc1, 0x22, 0x7a, 0x70, 0xb0, 0xdb,
+	0x04, 0x9e, 0x3e, 0xa8, 0xef, 0xef, 0x73, 0x72, 0x41, 0xdb, 0x41, 0x6b, 0x40, 0x0a, 0x8c, 0x5d,
+	0x9e, 0xe3, 0x48, 0xaa, 0xd5, 0x1c, 0x5f, 0xf6, 0x84, 0xa6, 0xf9, 0x15, 0x7d, 0x54, 0x33, 0x34,
+	0x9b, 0xd2, 0xeb, 0x50, 0xbf, 0xf4, 0xfb, 0x1e, 0xdf, 0x1c, 0x68, 0x36, 0x25, 0x74, 0x92, 0xa6,
+	0xcc, 0xa9, 0xa1, 0x1d, 0xd7, 0x08, 0xdf, 0xce, 0x8a, 0x89, 0xed, 0x2c, 0x63, 0x4c, 0x70, 0x99,
+	0x31, 0x05, 0x0c, 0x7e, 0xf8, 0x5b, 0x7b, 0x07, 0xb4, 0xb1, 0x7d, 0xc1, 0x6c, 0x37, 0xfd, 0xbe,
+	0x27, 0x5a, 0x57, 0xe2, 0xba, 0x70, 0x6c, 0x5f, 0x74, 0x47, 0x94, 0x11, 0x36, 0x92, 0x9a, 0x97,
+	0x9d, 0x13, 0xdf, 0x12, 0x1a, 0x80, 0x99, 0x4a, 0xca, 0xe6, 0x22, 0x05, 0x9a, 0x08, 0x63, 0x6e,
+	0xe6, 0xbe, 0x15, 0x26, 0x92, 0x5c, 0x60, 0x1d, 0x05, 0xc7, 0x6f, 0x21, 0x24, 0x52, 0x62, 0x8b,
+	0x92, 0x12, 0x33, 0xfe, 0x47, 0x0e, 0x20, 0x6a, 0xa3, 0x56, 0x83, 0x72, 0x9b, 0xe6, 0x48, 0xb5,
+	0xdd, 0xbe, 0xed, 0xf5, 0xbb, 0x17, 0x3c, 0x17, 0x24, 0xf7, 0x92, 0xe9, 0x5e, 0xe0, 0x1a, 0x65,
+	0x5f, 0xdc, 0x69, 0xbc, 0x9a, 0xa7, 0x10, 0xce, 0x00, 0x21, 0x05, 0x9a, 0x10, 0xf2, 0xc5, 0x64,
+	0x10, 0x38, 0x1d, 0xe7, 0xa4, 0x7b, 0x51, 0x9d, 0xa1, 0xdf, 0xed, 0xc9, 0x60, 0x40, 0x7d, 0x4d,
+	0xbb, 0x17, 0xd5, 0x59, 0x6d, 0x15, 0x13, 0x16, 0x74, 0x26, 0x47, 0xec, 0x69, 0x84, 0xee, 0xee,
+	0xd5, 0x39, 0x4a, 0x26, 0xf2, 0x08, 0x75, 0x2f, 0xaa, 0xf3, 0x21, 0x19, 0x75, 0x73, 0x15, 0x0f,
+	0x34, 0xb8, 0x52, 0xb1, 0x34, 0x8f, 0xa7, 0xeb, 0x5e, 0xe0, 0x4a, 0x9d, 0x1c, 0xbd, 0x26, 0x17,
+	0x8d, 0x41, 0xd0, 0xbd, 0xa8, 0x02, 0x4d, 0xf1, 0xc5, 0x01, 0xb4, 0x59, 0x1c, 0xb8, 0x60, 0x7c,
+	0x00, 0xeb, 0xbb, 0x4c, 0x49, 0x05, 0xa4, 0x1f, 0xf3, 0xb7, 0xad, 0xc3, 0xbc, 0x30, 0x8f, 0x71,
+	0xff, 0x33, 0xf1, 0x69, 0x3c, 0x83, 0xcd, 0xa7, 0xa1, 0x99, 0xa3, 0xa9, 0x38, 0x0e, 0x5d, 0x2f,
+	0xf5, 0x93, 0xd1, 0x81, 0xad, 0x6c, 0x4e, 0xb8, 0x88, 0xde, 0x83, 0x15, 0xbb, 0xd7, 0xb3, 0x32,
+	0x1c, 0x97, 0x6a, 0x76, 0xaf, 0xa7, 0x16, 0x34, 0xfe, 0x65, 0x0e, 0xea, 0xc9, 0x4e, 0x21, 0xb7,
+	0x1f, 0xc1, 0x92, 0xe2, 0x5d, 0x4d, 0xd2, 0xa2, 0xab, 0xb3, 0x4a, 0x6f, 0x77, 0xe5, 0xa2, 0x66,
+	0x9c, 0x93, 0xde, 0x10, 0x29, 0x4a, 0x1a, 0x51, 0xe4, 0xa0, 0x94, 0xa2, 0x64, 0x31, 0xcc, 0x41,
+	0x92, 0xfd, 0x50, 0xae, 0x41, 0xf5, 0x31, 0xf1, 0x03, 0xf9, 0x5a, 0x6e, 0x7c, 0x02, 0x35, 0x09,
+	0x16, 0x3d, 0xfe, 0x49, 0x4e, 0x05, 0xe5, 0x30, 0x2f, 0x87, 0xc8, 0xe1, 0x91, 0x8f, 0x72, 0x78,
+	0x18, 0xff, 0x8e, 0x7a, 0x08, 0xbf, 0x21, 0x64, 0x9c, 0x4c, 0x5a, 0x98, 0x12, 0xc8, 0x5a, 0x8a,
+	0x07, 0xb2, 0xbe, 0x07, 0xcb, 0x52, 0xa4, 0xa1, 0xa5, 0xb6, 0x5c, 0x93, 0x50, 0x8d, 0x28, 0x30,
+	0x64, 0x4a, 0x34, 0x73, 0xf9, 0x6a, 0x91, 0xaf, 0x33, 0xdc, 0x98, 0x21, 0x22, 0x5f, 0x8d, 0xff,
+	0x49, 0x7d, 0x85, 0x95, 0x4e, 0xfc, 0x7a, 0x87, 0x22, 0x1a, 0x03, 0x28, 0x1e, 0x4c, 0x02, 0xf6,
+	0xd4, 0xf7, 0xcb, 0x8f, 0xb8, 0x32, 0x2c, 0xd0, 0xf6, 0x47, 0xbd, 0xd7, 0x18, 0x0f, 0x2e, 0x24,
+	0x64, 0x0d, 0xe6, 0x26, 0x6e, 0x68, 0x0a, 0x2a, 0x9a, 0xf8, 0xa5, 0xbd, 0x0f, 0x25, 0xca, 0x90,
+	0xb6, 0x4d, 0xdc, 0xea, 0x97, 0xa5, 0x05, 0x24, 0xda, 0x6d, 0x46, 0x54, 0xd4, 0x18, 0xa5, 0x54,
+	0x10, 0x3d, 0x61, 0xec, 0xb3, 0x04, 0x68, 0x6a, 0x40, 0xbd, 0xf1, 0x29, 0xac, 0xc6, 0xe0, 0xe1,
+	0x74, 0x4b, 0x55, 0xe7, 0xae, 0x54, 0x75, 0x19, 0x16, 0x24, 0x9b, 0x99, 0xf1, 0x5b, 0xb0, 0x28,
+	0xdb, 0xc3, 0xb4, 0x3b, 0x50, 0x0e, 0x52, 0x8c, 0xa3, 0x10, 0x44, 0x2f, 0x4d, 0x5f, 0x87, 0x5a,
+	0x14, 0xf3, 0x20, 0xc8, 0xd0, 0x53, 0x28, 0x0c, 0x7d, 0xe0, 0x72, 0xfa, 0x1e, 0x2c, 0x75, 0x48,
+	0xd0, 0x95, 0xcd, 0xaf, 0xaa, 0x60, 0x73, 0xee, 0x91, 0x60, 0x6b, 0x50, 0x8d, 0x0a, 0xe0, 0xa8,
+	0x7c, 0xc0, 0x2c, 0x77, 0x5d, 0xc1, 0xf9, 0x6a, 0x8c, 0xe8, 0x6b, 0x90, 0x52, 0x28, 0x64, 0xb6,
+	0x8e, 0x6b, 0x26, 0xe1, 0xcb, 0x97, 0x6d, 0xcb, 0x3e, 0x87, 0x7a, 0xb2, 0x10, 0x0e, 0xd8, 0x43,
+	0xc9, 0x95, 0xda, 0x56, 0xf4, 0x68, 0xc9, 0xac, 0x09, 0x4c, 0x58, 0x8c, 0x92, 0x3b, 0x6e, 0x82,
+	0x3c, 0xcf, 0xc9, 0x1d, 0x37, 0x46, 0x4e, 0x0f, 0x8f, 0x68, 0xdd, 0x4e, 0xf5, 0x8c, 0xce, 0x56,
+	0x2e, 0xb9, 0x69, 0xe9, 0x02, 0xff, 0x6b, 0x0e, 0x6e, 0xa6, 0xb3, 0xc5, 0x4e, 0x99, 0x50, 0x44,
+	0x63, 0xbb, 0x10, 0x2b, 0x39, 0x4a, 0x73, 0x5a, 0xd1, 0x6d, 0x35, 0x2d, 0xb2, 0x19, 0xf2, 0xd1,
+	0x87, 0x89, 0x94, 0xc9, 0x57, 0xf4, 0x67, 0xff, 0x10, 0xe6, 0xe5, 0xcc, 0xc9, 0x0b, 0x3b, 0x7a,
+	0xb2, 0x2d, 0xa2, 0x7a, 0x53, 0x90, 0x1a, 0x37, 0x41, 0xe7, 0xb3, 0x9f, 0xea, 0xa7, 0xf8, 0x0a,
+	0x36, 0x52, 0xb1, 0xd8, 0xff, 0x8f, 0xd4, 0xfd, 0x5e, 0x3d, 0x39, 0x27, 0x13, 0x5b, 0x85, 0xc7,
+	0x81, 0x6f, 0xfc, 0xdd, 0x02, 0xac, 0xa4, 0xf9, 0x92, 0xd0, 0x8c, 0x30, 0x9d, 0x2f, 0xda, 0xbb,
+	0x2c, 0xb2, 0x7c, 0x11, 0x8a, 0x2f, 0xdb, 0xf8, 0x95, 0xa3, 0x31, 0x72, 0x87, 0xcd, 0xa6, 0x69,
+	0xed, 0x1e, 0xb4, 0xdb, 0xcd, 0x5d, 0x9a, 0xb2, 0x2a, 0x4f, 0x4f, 0x37, 0x0c, 0xb6, 0xd7, 0xea,
+	0x44, 0xe0, 0x82, 0x76, 0x0f, 0xb6, 0x9e, 0x34, 0xbb, 0xbb, 0xcf, 0x9a, 0x7b, 0x16, 0xbb, 0xc1,
+	0xb4, 0x9f, 0x5a, 0xbb, 0x4f, 0x5a, 0xfb, 0xdd, 0xa6, 0xd9, 0xa1, 0xf7, 0x26, 0x93, 0xe7, 0xbb,
+	0xba, 0x0f, 0x77, 0x32, 0xa9, 0x0e, 0xcd, 0x83, 0xa7, 0x66, 0xb3, 0xd3, 0xa9, 0xce, 0x4e, 0x25,
+	0x7b, 0xd2, 0x6a, 0xb7, 0x3a, 0xcf, 0x58, 0x92, 0xac, 0x0d, 0x58, 0x17, 0x64, 0xcf, 0x9a, 0x8d,
+	0x3d, 0xb9, 0xaa, 0x79, 0xed, 0x26, 0xd4, 0xe3, 0xc8, 0xb0, 0x86, 0x62, 0x1a, 0x36, 0x64, 0x5c,
+	0xd2, 0x6e, 0x83, 0xce, 0xba, 0xf7, 0xaa, 0x69, 0x5a, 0x8d, 0xbd, 0x3d, 0x5a, 0xa6, 0x19, 0xf1,
+	0x06, 0x6d, 0x13, 0x36, 0x52, 0xf0, 0x21, 0x83, 0x05, 0x3a, 0x70, 0x66, 0xb3, 0xb3, 0xdb, 0x68,
+	0x87, 0x85, 0x16, 0xe9, 0xc1, 0x0e, 0x61, 0x61, 0x3b, 0xca, 0x12, 0x30, 0x2c, 0x5d, 0xd9, 0x31,
+	0xc3, 0x3f, 0x18, 0xd0, 0x21, 0xde, 0x99, 0xd3, 0xa3, 0x91, 0x29, 0xf3, 0x08, 0xd1, 0x6e, 0xc8,
+	0x87, 0x77, 0xe5, 0xcf, 0x0a, 0xe8, 0x7a, 0x1a, 0x8a, 0x8b, 0xc1, 0xce, 0x1f, 0x6e, 0x41, 0x99,
+	0x3b, 0x46, 0x0b, 0x9e, 0x1f, 0xc1, 0x0c, 0xcd, 0xe3, 0xad, 0xad, 0x49, 0xa5, 0xa4, 0x3c, 0xdf,
+	0xfa, 0x7a, 0x02, 0x1e, 0x86, 0xc9, 0xcc, 0x63, 0xbe, 0x6e, 0xa5, 0x31, 0x6a, 0x12, 0x70, 0x5d,
+	0x4f, 0x43, 0x85, 0x6b, 0xb8, 0xac, 0xe4, 0xea, 0xd6, 0x36, 0x93, 0x29, 0xb4, 0x95, 0x04, 0xe0,
+	0xfa, 0x56, 0x36, 0x41, 0x18, 0x8a, 0x54, 0x44, 0x84, 0xaf, 0xe9, 0xa9, 0x19, 0xb9, 0x39, 0xa7,
+	0x8d, 0x29, 0xd9, 0xba, 0x69, 0xd7, 0x84, 0x06, 0xb8, 0x91, 0xb6, 0x92, 0x93, 0x5d, 0x8b, 0x2d,
+	0x72, 0xcd, 0x87, 0x7a, 0xd6, 0x51, 0x59, 0x8b, 0xa5, 0xd3, 0x9b, 0x76, 0x32, 0xd7, 0xdf, 0xb9,
+	0x12, 0x2d, 0x56, 0xfa, 0x12, 0x2a, 0x6a, 0x0a, 0x48, 0x6d, 0x2b, 0xa6, 0x14, 0x12, 0x97, 0x7b,
+	0xfd, 0xce, 0x14, 0x0a, 0x64, 0xfb, 0x43, 0x58, 0x52, 0x31, 0xbe, 0x96, 0x5d, 0x2a, 0x1c, 0x60,
+	0x63, 0x1a, 0x09, 0xe7, 0xfc, 0x28, 0xa7, 0x3d, 0x85, 0x52, 0xa8, 0xac, 0xb4, 0x8d, 0x34, 0x15,
+	0x26, 0xf8, 0x4d, 0xd7, 0x6f, 0xda, 0x73, 0x80, 0x08, 0xaa, 0xdd, 0xcc, 0x20, 0xbe, 0x0a, 0xab,
+	0x47, 0x39, 0x6d, 0x1f, 0x16, 0xa4, 0xcc, 0x77, 0x9a, 0x4c, 0x9f, 0xcc, 0x93, 0xa7, 0xdf, 0xce,
+	0x42, 0x87, 0xe9, 0x37, 0x4b, 0x61, 0x82, 0x3b, 0xa5, 0x8f, 0xf1, 0x5c, 0x78, 0xfa, 0xcd, 0x74,
+	0x64, 0xc4, 0x27, 0x4c, 0xbf, 0xa6, 0xf0, 0x89, 0xe7, 0x7a, 0xd3, 0x6f, 0xa6, 0x23, 0x25, 0x3e,
+	0xe2, 0x82, 0xa2, 0xf2, 0x89, 0x5d, 0x65, 0xf4, 0x9b, 0xe9, 0x48, 0xe4, 0x33, 0x51, 0x82, 0x84,
+	0x94, 0x6d, 0x4a, 0x91, 0xf1, 0x4b, 0x82, 0xf2, 0xf4, 0x77, 0xae, 0x44, 0x1b, 0x4e, 0x8e, 0x13,
+	0xfd, 0x71, 0x00, 0xa5, 0xca, 0xb7, 0x52, 0x74, 0x43, 0x5a, 0x75, 0x0f, 0x2e, 0xa5, 0x0b, 0xab,
+	0xfa, 0x09, 0xdc, 0xc8, 0x0c, 0xaa, 0xd2, 0xde, 0xb9, 0x5a, 0xe8, 0x15, 0xaf, 0xf4, 0xdd, 0xeb,
+	0xc4, 0x69, 0xbd, 0x9d, 0x7b, 0x94, 0xd3, 0x7e, 0x04, 0xd5, 0x78, 0x52, 0x36, 0xcd, 0xb8, 0x3c,
+	0x87, 0x9c, 0x7e, 0x77, 0x2a, 0x4d, 0xa4, 0x79, 0x95, 0xec, 0xf5, 0x8a, 0xe6, 0x4d, 0xcb, 0x98,
+	0xaf, 0x6f, 0x65, 0x13, 0x84, 0xf6, 0xbc, 0x39, 0xee, 0x1e, 0xa9, 0xd5, 0x13, 0xde, 0x9b, 0x82,
+	0xcb, 0x8d, 0x14, 0x8c, 0xbc, 0xea, 0xa4, 0x74, 0xf2, 0xca, 0xaa, 0x4b, 0xe6, 0xaf, 0xd7, 0x6f,
+	0x67, 0xa1, 0xb1, 0x39, 0x82, 0x9b, 0x48, 0x76, 0x3e, 0x35, 0xe1, 0xbb, 0x7e, 0x3b, 0x0b, 0x1d,
+	0x1a, 0x22, 0xaa, 0xf1, 0xcc, 0xe2, 0xca, 0x6c, 0x64, 0x24, 0x4a, 0xd7, 0xef, 0x4e, 0xa5, 0x41,
+	0xe6, 0x07, 0xb0, 0x28, 0xa7, 0xf9, 0xd6, 0x6e, 0x27, 0x0a, 0x29, 0x29, 0xcb, 0xf5, 0xcd, 0x4c,
+	0x3c, 0x32, 0xfc, 0x1c, 0x96, 0x62, 0x29, 0xe7, 0x14, 0x8d, 0x9d, 0x9e, 0xcf, 0x4f, 0x37, 0xa6,
+	0x91, 0x20, 0xe7, 0x57, 0x50, 0x51, 0x33, 0xaa, 0x29, 0x5b, 0x4c, 0x6a, 0xb2, 0x35, 0x3d, 0x93,
+	0x42, 0x9a, 0xfb, 0x13, 0x58, 0x49, 0x4b, 0x60, 0xa4, 0x2c, 0xea, 0x29, 0xe9, 0x96, 0xf4, 0x07,
+	0x97, 0xd2, 0x45, 0x43, 0x13, 0xcb, 0x01, 0xa2, 0x0c, 0x4d, 0x7a, 0x4e, 0x1e, 0xdd, 0x98, 0x46,
+	0x12, 0x89, 0x48, 0x0c, 0xe5, 0x6b, 0xc6, 0xe5, 0x79, 0x5d, 0xf4, 0xbb, 0x53, 0x69, 0xa2, 0x66,
+	0xc7, 0x32, 0x4c, 0x28, 0xcd, 0x4e, 0x4f, 0xb2, 0xa1, 0x1b, 0xd3, 0x48, 0x90, 0xb3, 0x0d, 0x5a,
+	0x32, 0x2f, 0x84, 0x26, 0x3f, 0x2e, 0x66, 0xa6, 0xa0, 0xd0, 0xef, 0x5f, 0x42, 0x85, 0x55, 0x5c,
+	0x80, 0x9e, 0x9d, 0x0c, 0x42, 0x7b, 0x37, 0xc9, 0x24, 0x3b, 0xb1, 0x84, 0xfe, 0xf0, 0x8a, 0xd4,
+	0xd1, 0xb8, 0xc5, 0xd2, 0x1b, 0x28, 0xe3, 0x96, 0x9e, 0x7c, 0x42, 0x37, 0xa6, 0x91, 0xc8, 0x2a,
+	0x54, 0x4a, 0x60, 0x10, 0x53, 0xa1, 0xc9, 0x94, 0x08, 0xfa, 0x56, 0x36, 0x01, 0xf2, 0xfc, 0x1d,
+	0x58, 0x4d, 0xcd, 0x6d, 0xa0, 0xc9, 0xe2, 0x3d, 0x2d, 0x3b, 0x82, 0xfe, 0xf6, 0xe5, 0x84, 0x91,
+	0x7e, 0x94, 0x22, 0xf3, 0x15, 0xfd, 0x98, 0x4c, 0x9f, 0xa0, 0xdf, 0xce, 0x42, 0x47, 0x2a, 0x4c,
+	0x02, 0xfb, 0xda, 0xed, 0xe9, 0xb9, 0x09, 0xf4, 0xcd, 0x4c, 0x7c, 0x34, 0x71, 0xb1, 0x37, 0x09,
+	0x65, 0xe2, 0xd2, 0x1f, 0x7e, 0x74, 0x63, 0x1a, 0x49, 0xb4, 0x4e, 0xe3, 0x16, 0x63, 0x75, 0x63,
+	0x4d, 0xb7, 0xb0, 0xeb, 0x77, 0xa7, 0xd2, 0x48, 0xe3, 0x20, 0x59, 0x3d, 0xd5, 0x71, 0x48, 0xda,
+	0x74, 0xf5, 0xcd, 0x4c, 0x7c, 0x34, 0x4d, 0x92, 0x1d, 0x4e, 0x99, 0xa6, 0xa4, 0x01, 0x50, 0xbf,
+	0x9d, 0x85, 0x8e, 0x84, 0x56, 0x31, 0xd3, 0x29, 0x42, 0x9b, 0x66, 0xd8, 0xd3, 0xb7, 0xb2, 0x09,
+	0x42, 0x4b, 0xc4, 0x0c, 0xcf, 0x4b, 0x22, 0x6f, 0x1f, 0x91, 0xfd, 0x4e, 0x5f, 0x4f, 0xc0, 0xa3,
+	0xab, 0x9a, 0xb0, 0xa4, 0x29, 0x57, 0xb5, 0x98, 0x3d, 0x4e, 0xdf, 0x48, 0xc5, 0x49, 0x03, 0x2e,
+	0x59, 0xd1, 0xd4, 0x01, 0x4f, 0xda, 0xe4, 0xf4, 0xcd, 0x4c, 0x7c, 0x24, 0x1e, 0x71, 0x4b, 0x9a,
+	0x22, 0x1e, 0x19, 0xb6, 0x39, 0xfd, 0xee, 0x54, 0x1a, 0x64, 0xee, 0xc0, 0x4a, 0x9a, 0x69, 0x4a,
+	0xd9, 0xe6, 0xa6, 0x58, 0xd3, 0xf4, 0x07, 0x97, 0xd2, 0x85, 0x3b, 0xea, 0xb1, 0xc8, 0xde, 0xad,
+	0xd6, 0x74, 0x3f, 0x71, 0x59, 0x49, 0xad, 0xe8, 0xad, 0xcb, 0xc8, 0x44, 0x3d, 0x3b, 0x7f, 0xb5,
+	0x24, 0x22, 0xb0, 0xa9, 0xbe, 0x21, 0x9e, 0xb0, 0x2b, 0x1c, 0xc0, 0xa2, 0x1c, 0x81, 0xad, 0x4c,
+	0x4c, 0x4a, 0xc4, 0xb6, 0xbe, 0x99, 0x89, 0x8f, 0x66, 0x5a, 0x8e, 0xa4, 0x57, 0x18, 0xa6, 0xa4,
+	0x03, 0xd0, 0x37, 0x33, 0xf1, 0xd1, 0x1d, 0x3d, 0x2b, 0x10, 0x5e, 0xb9, 0xbf, 0x5c, 0x12, 0xa7,
+	0xaf, 0xbf, 0x73, 0x25, 0x5a, 0xac, 0xb4, 0x05, 0x10, 0xc5, 0xc5, 0x2b, 0xf7, 0xd4, 0x44, 0xc0,
+	0xbd, 0x7e, 0x2b, 0x03, 0x1b, 0xa9, 0x06, 0x29, 0x22, 0x5e, 0x51, 0x0d, 0xc9, 0xf8, 0x79, 0xfd,
+	0x76, 0x16, 0x1a, 0xb9, 0xfd, 0x36, 0xd4, 0x12, 0x11, 0xe6, 0xda, 0x5d, 0xf5, 0x42, 0x9a, 0x1a,
+	0x1e, 0xaf, 0xdf, 0x9b, 0x4e, 0x14, 0xf1, 0x4f, 0xc4, 0x7e, 0x2b, 0xfc, 0xb3, 0x22, 0xd8, 0xf5,
+	0x7b, 0xd3, 0x89, 0x90, 0xff, 0xcf, 0x72, 0x70, 0x6b, 0x6a, 0x60, 0xb7, 0xf6, 0x9e, 0xdc, 0xce,
+	0x2b, 0x84, 0x8a, 0xeb, 0x8f, 0xae, 0x5e, 0x20, 0x92, 0x51, 0x39, 0xba, 0x4e, 0x91, 0xd1, 0x94,
+	0x60, 0x72, 0x7d, 0x33, 0x13, 0x8f, 0x0c, 0x4f, 0x30, 0xd8, 0x3c, 0x16, 0x5b, 0xa7, 0x28, 0x8c,
+	0x29, 0x51, 0xf3, 0xfa, 0x83, 0x4b, 0xe9, 0xb0, 0xa2, 0xc7, 0x30, 0x8f, 0x01, 0x8b, 0x8a, 0xc9,
+	0x4b, 0x0d, 0xaa, 0xd4, 0xf5, 0x34, 0x54, 0xa8, 0x72, 0x1e, 0xc3, 0x3c, 0xc6, 0xd0, 0x2a, 0x3c,
+	0xd4, 0x48, 0x62, 0x5d, 0x4f, 0x43, 0xc9, 0x97, 0x40, 0x29, 0xc8, 0x4e, 0x11, 0xea, 0x64, 0x48,
+	0x9e, 0x7e, 0x3b, 0x0b, 0x8d, 0xe6, 0xce, 0x11, 0xac, 0x48, 0x31, 0x32, 0xaf, 0x76, 0x84, 0x72,
+	0xfa, 0x0c, 0x2a, 0x6a, 0x34, 0x95, 0x72, 0x8d, 0x49, 0x8d, 0x3c, 0xd3, 0xef, 0x4c, 0xa1, 0x08,
+	0xb5, 0xe1, 0xbf, 0x29, 0x82, 0x26, 0x61, 0x44, 0x7d, 0x2f, 0xa1, 0xa2, 0xc6, 0x04, 0x29, 0xf5,
+	0xa5, 0x46, 0x6f, 0xe9, 0x77, 0xa6, 0x50, 0x44, 0xdb, 0xb9, 0x12, 0x38, 0xa4, 0x6c, 0xe7, 0x69,
+	0xa1, 0x46, 0xfa, 0x56, 0x36, 0x41, 0xb4, 0x4e, 0x13, 0x61, 0x45, 0xca, 0x3a, 0xcd, 0x8a, 0x48,
+	0xd2, 0xef, 0x4d, 0x27, 0x8a, 0x14, 0x60, 0x14, 0x75, 0xa1, 0x28, 0xc0, 0x44, 0xec, 0x86, 0x7e,
+	0x2b, 0x03, 0x1b, 0x2d, 0x8e, 0xb4, 0xd8, 0x0a, 0x65, 0x71, 0x4c, 0x89, 0xe5, 0xd0, 0x1f, 0x5c,
+	0x4a, 0x27, 0x59, 0xf0, 0x44, 0xac, 0x85, 0x16, 0x3b, 0x8e, 0x28, 0xa1, 0x1b, 0xfa, 0xcd, 0x74,
+	0x24, 0xf2, 0xe9, 0xc3, 0x32, 0x7a, 0xe3, 0x2b, 0x31, 0x39, 0xf7, 0x13, 0x85, 0xd2, 0xc2, 0x37,
+	0xf4, 0xb7, 0x2e, 0x23, 0x4b, 0xad, 0x25, 0x0a, 0x91, 0x4b, 0x2f, 0x1e, 0x8b, 0xdc, 0xd0, 0xdf,
+	0xba, 0x8c, 0x4c, 0xba, 0xee, 0xc6, 0x42, 0x2a, 0xd4, 0xeb, 0x6e, 0x7a, 0xc4, 0x86, 0x7e, 0x77,
+	0x2a, 0x4d, 0x64, 0xc9, 0x56, 0xe3, 0x2a, 0xd4, 0xf5, 0x92, 0x16, 0xae, 0xa1, 0xdf, 0x99, 0x42,
+	0x21, 0xdd, 0x79, 0xa2, 0x08, 0x0b, 0xed, 0x56, 0xb2, 0x84, 0x7c, 0x70, 0xbd, 0x9d, 0x85, 0x56,
+	0x1a, 0x29, 0xc5, 0x56, 0xc4, 0x1b, 0x99, 0x8c, 0xd9, 0xd0, 0xef, 0x4c, 0xa1, 0x40, 0x9d, 0xf5,
+	0x73, 0xea, 0x75, 0x48, 0x48, 0x5f, 0xe8, 0x0e, 0x9b, 0xfe, 0x9d, 0x9f, 0x78, 0xd4, 0xaf, 0x72,
+	0x41, 0xcf, 0x0c, 0x29, 0xd6, 0xef, 0x5f, 0x42, 0x15, 0xad, 0xc9, 0x28, 0x4e, 0x57, 0x59, 0x93,
+	0x89, 0x90, 0x5f, 0xfd, 0x56, 0x06, 0x16, 0x5b, 0xff, 0x9b, 0x50, 0xe6, 0xe1, 0x16, 0xd2, 0x9b,
+	0x15, 0x07, 0xf8, 0xca, 0xa6, 0xa0, 0xc6, 0x9e, 0xe8, 0x7a, 0x1a, 0x0a, 0x59, 0xfe, 0xf3, 0x1c,
+	0x94, 0xb9, 0x98, 0x08, 0x9e, 0xfb, 0xb0, 0x20, 0xf9, 0xbf, 0x2b, 0xf3, 0x98, 0x74, 0xc2, 0xd7,
+	0x6f, 0x67, 0xa1, 0x95, 0x79, 0x94, 0x19, 0x6e, 0x5d, 0xe6, 0xd8, 0xaf, 0xdf, 0x99, 0x42, 0x81,
+	0xcd, 0x1e, 0x83, 0x8e, 0xb7, 0x5a, 0xe6, 0x0e, 0x8f, 0x67, 0x05, 0xd1, 0x05, 0x13, 0xca, 0x8a,
+	0x97, 0xbc, 0xa2, 0xba, 0xd3, 0x3c, 0xf5, 0xf5, 0xad, 0x6c, 0x02, 0xac, 0xf1, 0xcf, 0xc0, 0x0a,
+	0x9f, 0x11, 0x44, 0x88, 0xba, 0x4e, 0x60, 0x25, 0xcd, 0x13, 0x53, 0xd1, 0x93, 0x53, 0x1c, 0x40,
+	0xf5, 0x07, 0x97, 0xd2, 0xf1, 0x06, 0x1c, 0xcd, 0xb1, 0x3f, 0x7a, 0xfe, 0xc1, 0xff, 0x1b, 0x00,
+	0xe1, 0x08, 0x55, 0x45, 0x01, 0x7d, 0x00, 0x00,
 }
@@ -6,8 +6,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"io/ioutil"
 	"net"
 	"os"
@@ -17,6 +20,7 @@ import (
 	"time"
 
 	"github.com/valhallacoin/vhcd/certgen"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
 	"github.com/valhallacoin/vhcwallet/errors"
 	"github.com/valhallacoin/vhcwallet/loader"
 	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc"
@@ -54,6 +58,47 @@ func openRPCKeyPair() (tls.Certificate, error) {
 	}
 }
 
+// loadSigningKey reads the hex encoded secp256k1 key used to sign legacy RPC
+// responses, generating and writing a new key if the file does not exist.
+func loadSigningKey(path string) (*secp256k1.PrivateKey, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		log.Infof("Generating RPC response signing key %s", path)
+		key, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		err = ioutil.WriteFile(path, []byte(hex.EncodeToString(key.Serialize())+"\n"), 0600)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	secret, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil || len(secret) != secp256k1.PrivKeyBytesLen {
+		return nil, errors.Errorf("%s: invalid signing key", path)
+	}
+	key, _ := secp256k1.PrivKeyFromBytes(secret)
+	return key, nil
+}
+
+// loadClientCAs reads the PEM encoded CA certificates used to verify the TLS
+// client certificates of legacy RPC clients.
+func loadClientCAs(path string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
 // generateRPCKeyPair generates a new RPC TLS keypair and writes the cert and
 // possibly also the key in PEM format to the paths specified by the config.  If
 // successful, the new keypair is returned.
@@ -135,8 +180,18 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 			MinVersion:   tls.VersionTLS12,
 			NextProtos:   []string{"h2"}, // HTTP/2 over TLS
 		}
+		legacyTLSConfig := tlsConfig
+		if cfg.ClientCAFile != "" {
+			clientCAs, err := loadClientCAs(cfg.ClientCAFile)
+			if err != nil {
+				return nil, nil, err
+			}
+			legacyTLSConfig = tlsConfig.Clone()
+			legacyTLSConfig.ClientCAs = clientCAs
+			legacyTLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
 		legacyListen = func(net string, laddr string) (net.Listener, error) {
-			return tls.Listen(net, laddr, tlsConfig)
+			return tls.Listen(net, laddr, legacyTLSConfig)
 		}
 
 		if len(cfg.GRPCListeners) != 0 {
@@ -170,19 +225,81 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *legacyrpc.Serv
 		}
 	}
 
-	if cfg.Username == "" || cfg.Password == "" {
-		log.Info("Legacy RPC server disabled (requires username and password)")
+	if (cfg.Username == "" || cfg.Password == "") && cfg.ClientCAFile == "" {
+		log.Info("Legacy RPC server disabled (requires username and password or client CA file)")
 	} else if len(cfg.LegacyRPCListeners) != 0 {
 		listeners := makeListeners(cfg.LegacyRPCListeners, legacyListen)
 		if len(listeners) == 0 {
 			err := errors.New("failed to create listeners for legacy RPC server")
 			return nil, nil, err
 		}
+		users := make([]legacyrpc.Credential, 0, len(cfg.RPCUsers))
+		for _, u := range cfg.RPCUsers {
+			c, err := legacyrpc.ParseCredential(u)
+			if err != nil {
+				return nil, nil, err
+			}
+			users = append(users, c)
+		}
+		certRoles := make(map[string]legacyrpc.Role, len(cfg.ClientCertRoles))
+		for _, cr := range cfg.ClientCertRoles {
+			commonName, role, err := legacyrpc.ParseCertRole(cr)
+			if err != nil {
+				return nil, nil, err
+			}
+			certRoles[commonName] = role
+		}
+		guardOrigins := make([]*net.IPNet, 0, len(cfg.GuardOrigins))
+		for _, o := range cfg.GuardOrigins {
+			n, err := legacyrpc.ParseGuardOrigin(o)
+			if err != nil {
+				return nil, nil, err
+			}
+			guardOrigins = append(guardOrigins, n)
+		}
+		var auditLog *legacyrpc.AuditLog
+		if cfg.AuditLog != "" {
+			key, err := ioutil.ReadFile(cfg.AuditLogKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			auditLog, err = legacyrpc.OpenAuditLog(cfg.AuditLog,
+				bytes.TrimSpace(key))
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		var signingKey *secp256k1.PrivateKey
+		if len(cfg.RPCSignMethods) != 0 {
+			signingKey, err = loadSigningKey(cfg.RPCSignKey.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 		opts := legacyrpc.Options{
 			Username:            cfg.Username,
 			Password:            cfg.Password,
 			MaxPOSTClients:      cfg.LegacyRPCMaxClients,
 			MaxWebsocketClients: cfg.LegacyRPCMaxWebsockets,
+			RequireSendApproval: cfg.RequireSendApproval,
+			ApproverUsername:    cfg.ApproverUsername,
+			ApproverPassword:    cfg.ApproverPassword,
+			Users:               users,
+			ClientCertRoles:     certRoles,
+			Features:            cfg.features,
+			RateLimit:           cfg.RPCRateLimit,
+			RateLimitBurst:      cfg.RPCRateBurst,
+			MaxClientRequests:   cfg.RPCMaxClientReqs,
+			RateLimitByIP:       cfg.RPCRateLimitByIP,
+			AuditLog:            auditLog,
+			SigningKey:          signingKey,
+			SignedMethods:       cfg.RPCSignMethods,
+			CORSOrigins:         cfg.RPCCORSOrigins,
+			Guard: legacyrpc.GuardOptions{
+				MaxPassphraseFailures: cfg.GuardPassFailures,
+				SendCap:               cfg.GuardSendCap.Amount,
+				Origins:               guardOrigins,
+			},
 		}
 		legacyServer = legacyrpc.NewServer(&opts, activeNet.Params, walletLoader, &cfg.tbCfg, listeners)
 		for _, lis := range listeners {
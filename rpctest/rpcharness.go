@@ -18,6 +18,7 @@ import (
 
 	"github.com/valhallacoin/vhcd/chaincfg"
 	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/chain"
 	"github.com/valhallacoin/vhcwallet/errors"
 
 	"github.com/valhallacoin/vhcd/vhcutil"
@@ -468,6 +469,38 @@ func (h *Harness) GenerateBlock(startHeight uint32) ([]*chainhash.Hash, error) {
 	return blockHashes, nil
 }
 
+// TicketLottery connects every main chain block of the node to a new
+// chain.TicketLottery, which replicates the consensus ticket lottery without
+// relying on winningtickets notifications from vhcd.  The lottery is returned
+// along with the winning tickets eligible to vote on the tip block.  An error
+// is returned if any block votes with a ticket the lottery did not select.
+func (h *Harness) TicketLottery() (*chain.TicketLottery, []*chainhash.Hash, error) {
+	l, err := chain.NewTicketLottery(h.ActiveNet)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, tipHeight, err := h.Node.GetBestBlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	var winners []*chainhash.Hash
+	for height := int64(1); height <= tipHeight; height++ {
+		hash, err := h.Node.GetBlockHash(height)
+		if err != nil {
+			return nil, nil, err
+		}
+		block, err := h.Node.GetBlock(hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		winners, err = l.ConnectBlock(block)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return l, winners, nil
+}
+
 func init() {
 	// Create the testInstances map once the package has been imported.
 	testInstances = make(map[string]*Harness)
@@ -45,6 +45,7 @@ var rpcTestCases = []rpcTestCase{
 	testPurchaseTickets,
 	testGetStakeInfo,
 	testWalletInfo,
+	testTicketLottery,
 }
 
 // Not all tests need their own harness. Indicate here which get a dedicaed
@@ -67,6 +68,7 @@ var needOwnHarness = map[string]bool{
 	"testGetTickets":       false,
 	"testGetStakeInfo":     true,
 	"testWalletInfo":       false,
+	"testTicketLottery":    true,
 }
 
 // Get function name from module name
@@ -2141,6 +2143,66 @@ func testWalletInfo(r *Harness, t *testing.T) {
 ///////////////////////////////////////////////////////////////////////////////
 // Helper functions
 
+// testTicketLottery checks that the ticket lottery simulation selects the
+// tickets voted by the wallet on blocks generated by vhcd.
+func testTicketLottery(r *Harness, t *testing.T) {
+	// Purchase enough tickets that the chain does not stall once voting
+	// begins.
+	minConf := 1
+	noSplitTransactions := false
+	for i := 0; i < 5; i++ {
+		priceLimit, err := vhcutil.NewAmount(2 * mustGetStakeDiffNext(r, t))
+		if err != nil {
+			t.Fatal("Invalid Amount.", err)
+		}
+		numTickets := int(chaincfg.SimNetParams.MaxFreshStakePerBlock)
+		_, err = r.WalletRPC.PurchaseTicket("default", priceLimit,
+			&minConf, nil, &numTickets, nil, nil, nil, &noSplitTransactions, nil)
+		if err != nil {
+			t.Fatal("Failed to purchase tickets:", err)
+		}
+		newBestBlock(r, t)
+	}
+
+	// Advance past the stake validation height so that blocks include
+	// votes for the winning tickets.
+	votingHeight := uint32(chaincfg.SimNetParams.StakeValidationHeight)
+	advanceToHeight(r, t, votingHeight+2)
+	time.Sleep(250 * time.Millisecond)
+
+	l, winners, err := r.TicketLottery()
+	if err != nil {
+		t.Fatal("Ticket lottery failed:", err)
+	}
+	if len(winners) != int(chaincfg.SimNetParams.TicketsPerBlock) {
+		t.Fatalf("Ticket lottery selected %d winners, expected %d",
+			len(winners), chaincfg.SimNetParams.TicketsPerBlock)
+	}
+	height, block, _ := getBestBlock(r, t)
+	if l.Height() != int32(height) {
+		t.Fatalf("Ticket lottery height %d, expected %d", l.Height(), height)
+	}
+
+	// The next block must include the votes of the selected tickets and
+	// commit to the simulated live ticket pool size.
+	_, block, _ = newBestBlock(r, t)
+	if uint32(l.LiveTickets()) != block.MsgBlock().Header.PoolSize {
+		t.Fatalf("Ticket lottery has %d live tickets, block pool size %d",
+			l.LiveTickets(), block.MsgBlock().Header.PoolSize)
+	}
+	votes := make(map[chainhash.Hash]struct{})
+	for _, stx := range block.MsgBlock().STransactions {
+		if stake.IsSSGen(stx) {
+			votes[stx.TxIn[1].PreviousOutPoint.Hash] = struct{}{}
+		}
+	}
+	for _, ticket := range winners {
+		if _, ok := votes[*ticket]; !ok {
+			t.Fatalf("Winning ticket %v did not vote", ticket)
+		}
+	}
+}
+
 func mustGetStakeInfo(wcl *vhcrpcclient.Client, t *testing.T) *vhcjson.GetStakeInfoResult {
 	stakeinfo, err := wcl.GetStakeInfo()
 	if err != nil {
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/loader"
+	"github.com/valhallacoin/vhcwallet/remotesigner"
+	"github.com/valhallacoin/vhcwallet/wallet"
+)
+
+// newRemoteSigner creates the client of the remote signing daemon configured
+// by the remotesigner options.
+func newRemoteSigner() (*remotesigner.Client, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.RemoteSignerCert, cfg.RemoteSignerKey)
+	if err != nil {
+		return nil, err
+	}
+	pem, err := ioutil.ReadFile(cfg.RemoteSignerCA)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(pem) {
+		return nil, errors.Errorf("no certificates found in %s", cfg.RemoteSignerCA)
+	}
+	return remotesigner.NewClient(cfg.RemoteSigner, cert, rootCAs), nil
+}
+
+// loadedWalletSigner signs with the private keys of the wallet loaded by a
+// loader.
+type loadedWalletSigner struct {
+	loader *loader.Loader
+}
+
+func (s loadedWalletSigner) signer() (wallet.Signer, error) {
+	w, ok := s.loader.LoadedWallet()
+	if !ok {
+		return nil, errors.E(errors.Invalid, "wallet is not loaded")
+	}
+	return w.LocalSigner(), nil
+}
+
+func (s loadedWalletSigner) SignTransaction(ctx context.Context, tx *wire.MsgTx, prevScripts [][]byte, hashType txscript.SigHashType) error {
+	signer, err := s.signer()
+	if err != nil {
+		return err
+	}
+	return signer.SignTransaction(ctx, tx, prevScripts, hashType)
+}
+
+func (s loadedWalletSigner) SignMessage(ctx context.Context, msg string, addr vhcutil.Address) ([]byte, error) {
+	signer, err := s.signer()
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignMessage(ctx, msg, addr)
+}
+
+// startSignerServer serves the private keys of the loaded wallet to remote
+// watching-only wallets on the signerlisten addresses.  The server presents
+// the RPC server certificate.
+func startSignerServer(walletLoader *loader.Loader) (*remotesigner.Server, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.RPCCert.Value, cfg.RPCKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	clientCAs, err := loadClientCAs(cfg.SignerClientCA)
+	if err != nil {
+		return nil, err
+	}
+	server, err := remotesigner.NewServer(loadedWalletSigner{walletLoader},
+		cert, clientCAs)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range cfg.SignerListeners {
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			server.Stop()
+			return nil, err
+		}
+		go func() {
+			err := server.Serve(lis)
+			log.Tracef("Finished serving remote signer clients: %v", err)
+		}()
+	}
+	return server, nil
+}
@@ -123,6 +123,7 @@ func (s *Syncer) SetNotifications(ntfns *Notifications) {
 // synced checks the atomic that controls wallet syncness and if previously
 // unsynced, updates to synced and notifies the callback, if set.
 func (s *Syncer) synced() {
+	s.wallet.SetSynced(true)
 	if atomic.CompareAndSwapUint32(&s.atomicWalletSynced, 0, 1) &&
 		s.notifications != nil &&
 		s.notifications.Synced != nil {
@@ -133,6 +134,7 @@ func (s *Syncer) synced() {
 // unsynced checks the atomic that controls wallet syncness and if previously
 // synced, updates to unsynced and notifies the callback, if set.
 func (s *Syncer) unsynced() {
+	s.wallet.SetSynced(false)
 	if atomic.CompareAndSwapUint32(&s.atomicWalletSynced, 1, 0) &&
 		s.notifications != nil &&
 		s.notifications.Synced != nil {
@@ -179,6 +181,8 @@ func (s *Syncer) fetchHeadersStart() {
 }
 
 func (s *Syncer) fetchHeadersProgress(fetchedHeadersCount int32, lastHeaderTime int64) {
+	// The compact filter of each block is fetched with its header.
+	s.wallet.RecordFetchedHeaders(fetchedHeadersCount, fetchedHeadersCount)
 	if s.notifications != nil && s.notifications.FetchHeadersProgress != nil {
 		s.notifications.FetchHeadersProgress(fetchedHeadersCount, lastHeaderTime)
 	}
@@ -465,6 +469,28 @@ func (s *Syncer) forRemotes(f func(rp *p2p.RemotePeer) error) error {
 	return nil
 }
 
+// Peers returns the remote peers the syncer is currently synchronizing with,
+// ordered by their identifiers.  Peers of the local peer which are still
+// connecting or which were disconnected for lacking required services are not
+// included.
+func (s *Syncer) Peers() []*p2p.RemotePeer {
+	s.remotesMu.Lock()
+	syncing := make(map[*p2p.RemotePeer]struct{}, len(s.remotes))
+	for _, rp := range s.remotes {
+		syncing[rp] = struct{}{}
+	}
+	s.remotesMu.Unlock()
+
+	all := s.lp.Peers()
+	peers := all[:0]
+	for _, rp := range all {
+		if _, ok := syncing[rp]; ok {
+			peers = append(peers, rp)
+		}
+	}
+	return peers
+}
+
 func (s *Syncer) pickRemote(pick func(*p2p.RemotePeer) bool) (*p2p.RemotePeer, error) {
 	defer s.remotesMu.Unlock()
 	s.remotesMu.Lock()
@@ -1124,6 +1150,7 @@ func (s *Syncer) startupSync(ctx context.Context, rp *p2p.RemotePeer) error {
 	if rp.InitialHeight() < tipHeight-6 {
 		return errors.E("peer is not synced")
 	}
+	s.wallet.SetBackendHeight(rp.InitialHeight())
 	s.fetchMissingCfiltersStart()
 	progress := make(chan wallet.MissingCFilterProgress, 1)
 	go s.wallet.FetchMissingCFiltersWithProgress(ctx, rp, progress)
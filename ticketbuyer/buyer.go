@@ -73,6 +73,8 @@ type Config struct {
 	MaxPriceAbsolute          int64
 	MaxPriceRelative          float64
 	MaxInMempool              int
+	MaxSpend                  int64
+	SpendWindow               int
 	PoolAddress               vhcutil.Address
 	PoolFees                  float64
 	NoSpreadTicketPurchases   bool
@@ -141,6 +143,8 @@ func (t *TicketPurchaser) Config() (*Config, error) {
 		MaxPriceAbsolute:          int64(t.maxPriceAbsolute),
 		MaxPriceRelative:          t.maxPriceRelative,
 		MaxInMempool:              t.cfg.MaxInMempool,
+		MaxSpend:                  t.cfg.MaxSpend,
+		SpendWindow:               t.cfg.SpendWindow,
 		PoolAddress:               t.cfg.PoolAddress,
 		PoolFees:                  t.poolFees,
 		NoSpreadTicketPurchases:   t.cfg.NoSpreadTicketPurchases,
@@ -817,10 +821,29 @@ func (t *TicketPurchaser) Purchase(height int64) (*PurchaseStats, error) {
 		}
 	}
 
+	// Do not exceed the spending budget of the account.
+	if t.cfg.MaxSpend > 0 {
+		remaining, err := t.wallet.TicketBudgetRemaining(account,
+			vhcutil.Amount(t.cfg.MaxSpend), int32(t.cfg.SpendWindow))
+		if err != nil {
+			return ps, err
+		}
+		if n := int(remaining / nextStakeDiff); toBuyForBlock > n {
+			toBuyForBlock = n
+			log.Infof("Limiting to %d purchases so that the spending budget "+
+				"of %v per %d blocks is not exceeded", toBuyForBlock,
+				vhcutil.Amount(t.cfg.MaxSpend), t.cfg.SpendWindow)
+		}
+		if toBuyForBlock <= 0 {
+			return ps, nil
+		}
+	}
+
 	// If an address wasn't passed, create an internal address in
-	// the wallet for the ticket address.
+	// the wallet for the ticket address, unless the wallet derives
+	// voting addresses from a cold voting extended public key.
 	votingAddress := t.VotingAddress()
-	if votingAddress == nil {
+	if votingAddress == nil && t.wallet.VotingXpub() == nil {
 		votingAddress, err = t.wallet.NewInternalAddress(account, wallet.WithGapPolicyWrap())
 		if err != nil {
 			return ps, err
@@ -853,6 +876,13 @@ func (t *TicketPurchaser) Purchase(height int64) (*PurchaseStats, error) {
 			feeToUse.ToCoin())
 	}
 	ps.Purchased = len(hashes)
+	if t.cfg.SpendWindow > 0 && len(hashes) > 0 {
+		err := t.wallet.RecordTicketSpend(account,
+			nextStakeDiff*vhcutil.Amount(len(hashes)), int32(t.cfg.SpendWindow))
+		if err != nil {
+			log.Errorf("Failed to record ticket spending: %v", err)
+		}
+	}
 	if purchaseErr != nil {
 		log.Errorf("One or more tickets could not be purchased: %v", purchaseErr)
 	}
@@ -8,9 +8,28 @@ import (
 	"sync"
 	"time"
 
+	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcwallet/wallet"
 )
 
+// SessionStats describes the ticket purchases made since a purchase manager
+// was started.
+type SessionStats struct {
+	// Purchased is the number of tickets purchased and Spent is the total
+	// ticket price paid for them, excluding transaction fees.
+	Purchased int
+	Spent     vhcutil.Amount
+
+	// LastHeight is the height of the most recent block a purchase was
+	// attempted for, or zero if none has been attempted.
+	LastHeight int64
+
+	// LastError is the most recent error which failed a purchase attempt,
+	// and LastErrorHeight is the height of the block it occurred at.
+	LastError       error
+	LastErrorHeight int64
+}
+
 // PurchaseManager is the main handler of websocket notifications to
 // pass to the purchaser and internal quit notifications.
 type PurchaseManager struct {
@@ -21,6 +40,9 @@ type PurchaseManager struct {
 	wg         sync.WaitGroup
 	quitMtx    sync.Mutex
 	quit       chan struct{}
+
+	statsMtx sync.Mutex
+	stats    SessionStats
 }
 
 // NewPurchaseManager creates a new PurchaseManager.
@@ -39,10 +61,12 @@ func NewPurchaseManager(w *wallet.Wallet, purchaser *TicketPurchaser,
 func (p *PurchaseManager) purchase(height int64) {
 	err := p.w.Unlock(p.passphrase, nil)
 	if err != nil {
+		p.recordPurchase(height, nil, err)
 		log.Errorf("Failed to purchase tickets this round: %v", err)
 		return
 	}
 	purchaseInfo, err := p.purchaser.Purchase(height)
+	p.recordPurchase(height, purchaseInfo, err)
 	if err != nil {
 		log.Errorf("Failed to purchase tickets this round: %v", err)
 		return
@@ -53,6 +77,31 @@ func (p *PurchaseManager) purchase(height int64) {
 	log.Debugf("Purchased %v tickets this round", purchaseInfo.Purchased)
 }
 
+// recordPurchase adds the outcome of a purchase attempt to the session
+// statistics.
+func (p *PurchaseManager) recordPurchase(height int64, ps *PurchaseStats, err error) {
+	p.statsMtx.Lock()
+	p.stats.LastHeight = height
+	if ps != nil {
+		p.stats.Purchased += ps.Purchased
+		p.stats.Spent += ps.TicketPrice * vhcutil.Amount(ps.Purchased)
+	}
+	if err != nil {
+		p.stats.LastError = err
+		p.stats.LastErrorHeight = height
+	}
+	p.statsMtx.Unlock()
+}
+
+// SessionStats returns the statistics of the tickets purchased since the
+// purchase manager was started.
+func (p *PurchaseManager) SessionStats() SessionStats {
+	p.statsMtx.Lock()
+	stats := p.stats
+	p.statsMtx.Unlock()
+	return stats
+}
+
 // Purchaser returns the ticket buyer instance associated with the purchase
 // manager.
 func (p *PurchaseManager) Purchaser() *TicketPurchaser {
@@ -0,0 +1,38 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestSessionStats(t *testing.T) {
+	p := NewPurchaseManager(nil, nil, nil, nil)
+	if stats := p.SessionStats(); stats != (SessionStats{}) {
+		t.Fatalf("stats of new session: %+v", stats)
+	}
+
+	p.recordPurchase(10, &PurchaseStats{Purchased: 2, TicketPrice: 3e8}, nil)
+	p.recordPurchase(11, &PurchaseStats{Purchased: 0, TicketPrice: 3e8}, nil)
+	failure := errors.E(errors.InsufficientBalance)
+	p.recordPurchase(12, nil, failure)
+	p.recordPurchase(13, &PurchaseStats{Purchased: 1, TicketPrice: 4e8}, nil)
+
+	// Errors remain reported after later successful purchases.
+	stats := p.SessionStats()
+	expected := SessionStats{
+		Purchased:       3,
+		Spent:           vhcutil.Amount(10e8),
+		LastHeight:      13,
+		LastError:       failure,
+		LastErrorHeight: 12,
+	}
+	if stats != expected {
+		t.Fatalf("session stats %+v, expected %+v", stats, expected)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/vsp"
 	"github.com/valhallacoin/vhcwallet/wallet"
 )
 
@@ -35,6 +36,19 @@ type Config struct {
 
 	// Stakepool fee percentage (between 0-100)
 	PoolFees float64
+
+	// Register tickets with the VSP selected by the wallet; overrides
+	// VotingAccount, VotingAddr, PoolFeeAddr, and PoolFees
+	UseVSP bool
+
+	// Maximum ticket price spent from the account in any SpendWindow
+	// blocks; zero disables the budget
+	MaxSpend    vhcutil.Amount
+	SpendWindow int32
+
+	// Spread purchases across the remaining blocks of the stake difficulty
+	// interval rather than buying as many tickets as possible each block
+	SpreadPurchases bool
 }
 
 // TB is an automated ticket buyer, buying as many tickets as possible given an
@@ -45,6 +59,11 @@ type TB struct {
 
 	cfg Config
 	mu  sync.Mutex
+
+	// Fraction of a ticket carried between blocks of the interval when
+	// spreading purchases; protected by mu
+	spreadInterval int32
+	spreadFraction float64
 }
 
 // New returns a new TB to buy tickets from a wallet using the default config.
@@ -163,8 +182,26 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 	votingAddr := tb.cfg.VotingAddr
 	poolFeeAddr := tb.cfg.PoolFeeAddr
 	poolFees := tb.cfg.PoolFees
+	useVSP := tb.cfg.UseVSP
+	spread := tb.cfg.SpreadPurchases
+	maxSpend := tb.cfg.MaxSpend
+	spendWindow := tb.cfg.SpendWindow
 	tb.mu.Unlock()
 
+	// Retry the registration of VSP tickets each block, even when no more
+	// tickets are purchased.
+	var v *vsp.VSP
+	if useVSP {
+		v, err = vsp.New(w)
+		if err != nil {
+			return err
+		}
+		err = v.ProcessTickets(ctx)
+		if err != nil {
+			log.Errorf("VSP ticket registration failed: %v", err)
+		}
+	}
+
 	// Determine how many tickets to buy
 	bal, err := w.CalculateAccountBalance(account, minconf)
 	if err != nil {
@@ -185,24 +222,79 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 		log.Debugf("Skipping purchase: low available balance")
 		return nil
 	}
+	if spread {
+		// Tickets purchased at this height may be mined in the blocks
+		// before expiry, with the earliest two blocks from now.
+		remaining := expiry - 2 - height
+		buy = tb.spread(expiry, buy, remaining)
+		if buy == 0 {
+			log.Debugf("Skipping purchase: spreading purchases over the "+
+				"remaining %d blocks of the interval", remaining)
+			return nil
+		}
+	}
 	if max := int(w.ChainParams().MaxFreshStakePerBlock); buy > max {
 		buy = max
 	}
+	if maxSpend > 0 {
+		remaining, err := w.TicketBudgetRemaining(account, maxSpend, spendWindow)
+		if err != nil {
+			return err
+		}
+		if n := int(remaining / sdiff); buy > n {
+			buy = n
+		}
+		if buy == 0 {
+			log.Debugf("Skipping purchase: spending budget of %v per %d "+
+				"blocks is exhausted", maxSpend, spendWindow)
+			return nil
+		}
+	}
+	recordSpend := func(n int) {
+		if spendWindow < 1 || n == 0 {
+			return
+		}
+		err := w.RecordTicketSpend(account, sdiff*vhcutil.Amount(n), spendWindow)
+		if err != nil {
+			log.Errorf("Failed to record ticket spending: %v", err)
+		}
+	}
+
+	feeRate := w.RelayFee()
+	if v != nil {
+		tix, err := v.PurchaseTickets(ctx, maintain, -1, minconf, account, buy,
+			expiry, feeRate)
+		for _, hash := range tix {
+			log.Infof("Purchased ticket %v for VSP %s at stake difficulty %v",
+				hash, v.Host(), sdiff)
+		}
+		recordSpend(len(tix))
+		if err != nil {
+			if errors.Is(errors.Passphrase, err) {
+				return err
+			}
+			log.Errorf("One or more VSP tickets could not be purchased "+
+				"or registered: %v", err)
+		}
+		return nil
+	}
 
-	// Derive a voting address from voting account when address is unset.
-	if votingAddr == nil {
+	// Derive a voting address from voting account when address is unset,
+	// unless the wallet derives voting addresses from a cold voting extended
+	// public key.
+	if votingAddr == nil && w.VotingXpub() == nil {
 		votingAddr, err = w.NewInternalAddress(votingAccount, wallet.WithGapPolicyWrap())
 		if err != nil {
 			return err
 		}
 	}
 
-	feeRate := w.RelayFee()
 	tix, err := w.PurchaseTickets(maintain, -1, minconf, votingAddr, account,
 		buy, poolFeeAddr, poolFees, expiry, feeRate, feeRate)
 	for _, hash := range tix {
 		log.Infof("Purchased ticket %v at stake difficulty %v", hash, sdiff)
 	}
+	recordSpend(len(tix))
 	if err != nil {
 		// Invalid passphrase errors must be returned so Run exits.
 		if errors.Is(errors.Passphrase, err) {
@@ -213,6 +305,26 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *chainhash.Hash) e
 	return nil
 }
 
+// spread returns the number of tickets to buy in the current block when buy
+// tickets are spread over the remaining blocks of the interval ending at
+// expiry.  Fractions of a ticket are carried to later blocks of the interval,
+// so a ticket is bought every few blocks when fewer tickets than blocks remain.
+func (tb *TB) spread(expiry int32, buy int, remaining int32) int {
+	if remaining <= 1 {
+		return buy
+	}
+	defer tb.mu.Unlock()
+	tb.mu.Lock()
+	if tb.spreadInterval != expiry {
+		tb.spreadInterval = expiry
+		tb.spreadFraction = 0
+	}
+	tb.spreadFraction += float64(buy) / float64(remaining)
+	n := int(tb.spreadFraction)
+	tb.spreadFraction -= float64(n)
+	return n
+}
+
 // AccessConfig runs f with the current config passed as a parameter.  The
 // config is protected by a mutex and this function is safe for concurrent
 // access to read or modify the config.  It is unsafe to leak a pointer to the
@@ -0,0 +1,42 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import "testing"
+
+func TestSpread(t *testing.T) {
+	tb := new(TB)
+
+	// Three affordable tickets are bought one at a time across the nine
+	// blocks remaining in the interval, with the balance of each purchase
+	// no longer affordable in later blocks.
+	var bought, blocks int
+	for remaining := int32(9); remaining >= 1; remaining-- {
+		n := tb.spread(144, 3-bought, remaining)
+		if n > 1 {
+			t.Fatalf("bought %d tickets with %d blocks remaining", n, remaining)
+		}
+		if n != 0 {
+			blocks++
+		}
+		bought += n
+	}
+	if bought != 3 || blocks != 3 {
+		t.Fatalf("bought %d tickets in %d blocks", bought, blocks)
+	}
+
+	// Every affordable ticket is bought in the last block of the interval.
+	if n := tb.spread(144, 5, 1); n != 5 {
+		t.Fatalf("bought %d of 5 tickets in the last block", n)
+	}
+
+	// Fractions of tickets are not carried to the next interval.
+	if n := tb.spread(144, 2, 3); n != 0 {
+		t.Fatalf("bought %d tickets", n)
+	}
+	if n := tb.spread(288, 1, 3); n != 0 {
+		t.Fatalf("bought %d tickets after fraction was carried to the next interval", n)
+	}
+}
@@ -8,6 +8,7 @@ package version
 import (
 	"bytes"
 	"fmt"
+	"runtime/debug"
 	"strings"
 )
 
@@ -36,6 +37,52 @@ var PreRelease = "pre"
 // version alphabet.
 var BuildMetadata = "dev"
 
+// Commit is the revision of the source tree the application was built from.
+// It is set at link time (e.g.
+// `-ldflags "-X github.com/valhallacoin/vhcwallet/version.Commit=$(git rev-parse HEAD)"`)
+// and is empty for builds which did not record it.
+var Commit = ""
+
+// BuildTags is a comma-separated list of the build tags the application was
+// built with.  It may be set at link time, and otherwise defaults to the tags
+// recorded by the Go toolchain in the build information of the binary.  It is
+// reported for identification only.
+var BuildTags = ""
+
+func init() {
+	if BuildTags != "" {
+		return
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		BuildTags = buildInfoTags(info.Settings)
+	}
+}
+
+// buildInfoTags returns the value of the -tags build setting, or the empty
+// string when the binary was built without tags.
+func buildInfoTags(settings []debug.BuildSetting) string {
+	for _, s := range settings {
+		if s.Key == "-tags" {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// Tags returns the build tags recorded by BuildTags.  Empty tags and tags
+// containing characters outside of the semantic version alphabet are omitted.
+func Tags() []string {
+	var tags []string
+	for _, tag := range strings.Split(BuildTags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || normalizeVerString(tag) != tag {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
 // String returns the application version as a properly formed string per the
 // semantic versioning 2.0.0 spec (http://semver.org/).
 func String() string {
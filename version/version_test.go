@@ -0,0 +1,45 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package version
+
+import (
+	"reflect"
+	"runtime/debug"
+	"testing"
+)
+
+func TestBuildInfoTags(t *testing.T) {
+	settings := []debug.BuildSetting{
+		{Key: "-compiler", Value: "gc"},
+		{Key: "-tags", Value: "pkcs11,netgo"},
+		{Key: "CGO_ENABLED", Value: "1"},
+	}
+	if tags := buildInfoTags(settings); tags != "pkcs11,netgo" {
+		t.Errorf("got tags %q, want %q", tags, "pkcs11,netgo")
+	}
+	if tags := buildInfoTags(settings[:1]); tags != "" {
+		t.Errorf("got tags %q for build without tags", tags)
+	}
+}
+
+func TestTags(t *testing.T) {
+	defer func(tags string) { BuildTags = tags }(BuildTags)
+
+	tests := []struct {
+		buildTags string
+		want      []string
+	}{
+		{"", nil},
+		{"pkcs11", []string{"pkcs11"}},
+		{"pkcs11, netgo,,", []string{"pkcs11", "netgo"}},
+		{"pkcs11,bad tag!", []string{"pkcs11"}},
+	}
+	for _, test := range tests {
+		BuildTags = test.buildTags
+		if tags := Tags(); !reflect.DeepEqual(tags, test.want) {
+			t.Errorf("BuildTags %q: got %q, want %q", test.buildTags, tags, test.want)
+		}
+	}
+}
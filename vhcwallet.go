@@ -28,6 +28,7 @@ import (
 	"github.com/valhallacoin/vhcwallet/internal/zero"
 	ldr "github.com/valhallacoin/vhcwallet/loader"
 	"github.com/valhallacoin/vhcwallet/p2p"
+	"github.com/valhallacoin/vhcwallet/pkcs11"
 	"github.com/valhallacoin/vhcwallet/rpc/legacyrpc"
 	"github.com/valhallacoin/vhcwallet/rpc/rpcserver"
 	"github.com/valhallacoin/vhcwallet/spv"
@@ -89,6 +90,9 @@ func run(ctx context.Context) error {
 	// Show version at startup.
 	log.Infof("Version %s (Go version %s %s/%s)", version.String(), runtime.Version(),
 		runtime.GOOS, runtime.GOARCH)
+	if version.Commit != "" {
+		log.Infof("Built from commit %s", version.Commit)
+	}
 
 	// Read IPC messages from the read end of a pipe created and passed by the
 	// parent process, if any.  When this pipe is closed, shutdown is
@@ -153,6 +157,7 @@ func run(ctx context.Context) error {
 		VotingEnabled:       cfg.EnableVoting,
 		AddressReuse:        cfg.ReuseAddresses,
 		VotingAddress:       cfg.TBOpts.VotingAddress.Address,
+		VotingXpub:          cfg.votingXpub,
 		PoolAddress:         cfg.PoolAddress.Address,
 		PoolFees:            cfg.PoolFees,
 		StakePoolColdExtKey: cfg.StakePoolColdExtKey,
@@ -160,6 +165,24 @@ func run(ctx context.Context) error {
 	}
 	loader := ldr.NewLoader(activeNet.Params, dbDir, stakeOptions,
 		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(), cfg.AccountGapLimit)
+	loader.SetDuplicateInstanceOptions(cfg.AllowDuplicate, cfg.InstanceHeartbeat)
+	loader.SetUnlockSessionTimeout(cfg.UnlockCacheTimeout)
+	loader.SetRevocationDelay(cfg.RevocationDelay)
+	loader.SetVSPMaxFee(cfg.VSPMaxFee.Amount)
+	loader.SetFullCheck(cfg.FullCheck)
+	if cfg.VoteCoordinationDir != "" {
+		host, _ := os.Hostname()
+		instance := fmt.Sprintf("%s:%d", host, os.Getpid())
+		c, err := wallet.NewFileVoteCoordinator(cfg.VoteCoordinationDir, instance,
+			cfg.VoteClaimLease)
+		if err != nil {
+			log.Errorf("Unable to coordinate votes: %v", err)
+			return err
+		}
+		log.Infof("Coordinating votes with redundant voting wallets in %s",
+			cfg.VoteCoordinationDir)
+		loader.SetVoteCoordinator(c)
+	}
 
 	// Stop any services started by the loader after the shutdown procedure is
 	// initialized and this function returns.
@@ -172,6 +195,69 @@ func run(ctx context.Context) error {
 		}
 	}()
 
+	// Delegate signing to the remote signing daemon, if configured, once
+	// the watching-only wallet is loaded.
+	if cfg.RemoteSigner != "" {
+		signer, err := newRemoteSigner()
+		if err != nil {
+			log.Errorf("Unable to create remote signer client: %v", err)
+			return err
+		}
+		defer signer.Close()
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			log.Infof("Signing with remote signer %s", cfg.RemoteSigner)
+			w.SetSigner(signer)
+		})
+	}
+
+	// Sign for accounts with PKCS#11 key storage inside the hardware
+	// security module, if configured.
+	if cfg.PKCS11Module != "" {
+		module, err := pkcs11.Open(cfg.PKCS11Module, cfg.PKCS11Token, cfg.PKCS11PIN)
+		if err != nil {
+			log.Errorf("Unable to open PKCS#11 module: %v", err)
+			return err
+		}
+		defer module.Close()
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			log.Infof("Signing with PKCS#11 token %q", cfg.PKCS11Token)
+			w.SetKeyStore(module)
+		})
+	}
+
+	// Select the voting service provider which tickets are purchased for,
+	// if configured.
+	if cfg.VSP != "" {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			err := w.SetVSP(cfg.VSP, cfg.vspPubKey)
+			if err != nil {
+				log.Errorf("Unable to select VSP %s: %v", cfg.VSP, err)
+				return
+			}
+			log.Infof("Purchasing tickets for VSP %s", cfg.VSP)
+		})
+	}
+
+	// Report missed votes to the webhook, if configured.
+	if cfg.MissedVoteWebhook != "" {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			log.Infof("Reporting missed votes to %s", cfg.MissedVoteWebhook)
+			go reportMissedVotes(ctx, w, cfg.MissedVoteWebhook)
+		})
+	}
+
+	// Named wallets are only loaded over RPC, and are synchronized with the
+	// network independently of the default wallet until they are unloaded.
+	loader.RunAfterLoadNamed(func(ctx context.Context, l *ldr.Loader, w *wallet.Wallet) {
+		go namedWalletSyncLoop(ctx, l, w)
+	})
+	defer func() {
+		err := loader.UnloadNamedWallets()
+		if err != nil {
+			log.Errorf("Failed to close named wallets: %v", err)
+		}
+	}()
+
 	// Open the wallet when --noinitialload was not set.
 	passphrase := []byte{}
 	if !cfg.NoInitialLoad {
@@ -229,6 +315,13 @@ func run(ctx context.Context) error {
 				log.Errorf("Incorrect passphrase in pass config setting.")
 				return err
 			}
+		} else if cfg.KeyringPass {
+			passphrase, err = keyringPass(ctx, w)
+			if err != nil {
+				log.Errorf("Unable to unlock wallet with the OS keyring "+
+					"passphrase: %v", err)
+				return err
+			}
 		} else {
 			passphrase = startPromptPass(ctx, w)
 		}
@@ -248,6 +341,10 @@ func run(ctx context.Context) error {
 				c.VotingAddr = cfg.TBOpts.VotingAddress.Address
 				c.PoolFeeAddr = cfg.PoolAddress.Address
 				c.PoolFees = cfg.PoolFees
+				c.UseVSP = cfg.TBOpts.UseVSP
+				c.MaxSpend = cfg.TBOpts.MaxSpend.Amount
+				c.SpendWindow = int32(cfg.TBOpts.SpendWindow)
+				c.SpreadPurchases = cfg.TBOpts.SpreadPurchases
 			})
 			log.Infof("Starting ticket buyer")
 			tbdone := make(chan struct{})
@@ -278,6 +375,14 @@ func run(ctx context.Context) error {
 		log.Errorf("Unable to create RPC servers: %v", err)
 		return err
 	}
+	if len(cfg.SignerListeners) != 0 {
+		signerServer, err := startSignerServer(loader)
+		if err != nil {
+			log.Errorf("Unable to start remote signer server: %v", err)
+			return err
+		}
+		defer signerServer.Stop()
+	}
 	if gRPCServer != nil {
 		// Start wallet and voting gRPC services after a wallet is loaded.
 		loader.RunAfterLoad(func(w *wallet.Wallet) {
@@ -307,22 +412,29 @@ func run(ctx context.Context) error {
 	// that can be ignored when the ticket buyer was never started.
 	defer loader.StopTicketPurchase()
 
-	// When not running with --noinitialload, it is the main package's
-	// responsibility to synchronize the wallet with the network through SPV or
-	// the trusted vhcd server.  This blocks until cancelled.
-	if !cfg.NoInitialLoad {
-		if done(ctx) {
-			return ctx.Err()
-		}
-
+	// It is the main package's responsibility to synchronize the default
+	// wallet with the network through SPV or the trusted vhcd server each
+	// time it is loaded, including when it is created or opened over RPC
+	// with --noinitialload, until it is unloaded.
+	if done(ctx) {
+		return ctx.Err()
+	}
+	loader.RunWhileLoaded(func(wctx context.Context, w *wallet.Wallet) {
+		// Synchronization also stops when shutdown is signaled.
+		wctx, cancel := context.WithCancel(wctx)
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-wctx.Done():
+			}
+			cancel()
+		}()
 		if cfg.SPV {
-			loader.RunAfterLoad(func(w *wallet.Wallet) {
-				spvLoop(ctx, w, loader)
-			})
+			go spvLoop(wctx, w, loader)
 		} else {
-			rpcClientConnectLoop(ctx, passphrase, jsonRPCServer, loader)
+			go rpcClientConnectLoop(wctx, passphrase, jsonRPCServer, w, loader)
 		}
-	}
+	})
 
 	// Wait until shutdown is signaled before returning and running deferred
 	// shutdown tasks.
@@ -415,6 +527,7 @@ func spvLoop(ctx context.Context, w *wallet.Wallet, loader *ldr.Loader) {
 	amgrDir := filepath.Join(cfg.AppDataDir.Value, w.ChainParams().Name)
 	amgr := addrmgr.New(amgrDir, net.LookupIP) // TODO: be mindful of tor
 	lp := p2p.NewLocalPeer(w.ChainParams(), addr, amgr)
+	lp.SetServices(cfg.spvServices)
 	syncer := spv.NewSyncer(w, lp)
 	if len(cfg.SPVConnect) > 0 {
 		syncer.SetPersistantPeers(cfg.SPVConnect)
@@ -430,9 +543,9 @@ func spvLoop(ctx context.Context, w *wallet.Wallet, loader *ldr.Loader) {
 	}
 }
 
-// rpcClientConnectLoop loops forever, attempting to create a connection to the
-// consensus RPC server.  If this connection succeeds, the RPC client is used as
-// the loaded wallet's network backend and used to keep the wallet synchronized
+// rpcClientConnectLoop loops until cancelled, attempting to create a connection
+// to the consensus RPC server.  If this connection succeeds, the RPC client is
+// used as the wallet's network backend and used to keep the wallet synchronized
 // to the network.  If/when the RPC connection is lost, the wallet is
 // disassociated from the client and a new connection is attempmted.
 //
@@ -440,13 +553,8 @@ func spvLoop(ctx context.Context, w *wallet.Wallet, loader *ldr.Loader) {
 // associated with the server for RPC passthrough and to enable additional
 // methods.
 //
-// This function panics if the wallet has not already been loaded.
-func rpcClientConnectLoop(ctx context.Context, passphrase []byte, jsonRPCServer *legacyrpc.Server, loader *ldr.Loader) {
-	w, ok := loader.LoadedWallet()
-	if !ok {
-		panic("rpcClientConnectLoop: called without loaded wallet")
-	}
-
+// The loop returns when the context is cancelled after the wallet is unloaded.
+func rpcClientConnectLoop(ctx context.Context, passphrase []byte, jsonRPCServer *legacyrpc.Server, w *wallet.Wallet, loader *ldr.Loader) {
 	certs := readCAFile()
 
 	for {
@@ -487,6 +595,43 @@ func rpcClientConnectLoop(ctx context.Context, passphrase []byte, jsonRPCServer
 	}
 }
 
+// namedWalletSyncLoop synchronizes a named wallet with the network through SPV
+// or the trusted vhcd server until the context is cancelled when the wallet is
+// unloaded.  Each named wallet uses its own SPV syncer or vhcd RPC connection.
+func namedWalletSyncLoop(ctx context.Context, l *ldr.Loader, w *wallet.Wallet) {
+	if cfg.SPV {
+		spvLoop(ctx, w, l)
+		return
+	}
+
+	certs := readCAFile()
+	for {
+		chainClient, err := startChainRPC(ctx, certs)
+		if err != nil {
+			log.Errorf("Error connecting wallet %q to RPC server: %v",
+				l.Name(), err)
+			return
+		}
+
+		n := chain.BackendFromRPCClient(chainClient.Client)
+		w.SetNetworkBackend(n)
+		l.SetNetworkBackend(n)
+
+		syncer := chain.NewRPCSyncer(w, chainClient)
+		err = syncer.Run(ctx, true)
+		if errors.Match(errors.E(context.Canceled), err) {
+			return
+		}
+		if err != nil {
+			syncLog.Errorf("Wallet %q synchronization stopped: %v",
+				l.Name(), err)
+		}
+
+		w.SetNetworkBackend(nil)
+		l.SetNetworkBackend(nil)
+	}
+}
+
 func readCAFile() []byte {
 	// Read certificate file if TLS is not disabled.
 	var certs []byte
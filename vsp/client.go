@@ -0,0 +1,295 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package vsp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// requestTimeout is the duration requests are allowed to take when the
+// context has no deadline.
+const requestTimeout = 30 * time.Second
+
+// maxResponseSize limits the size of response bodies read from a VSP.
+const maxResponseSize = 1 << 20
+
+// Headers carrying the signatures of requests and responses.
+const (
+	clientSignatureHeader = "VSP-Client-Signature"
+	serverSignatureHeader = "VSP-Server-Signature"
+)
+
+// Info describes a voting service provider and the fees it charges.
+type Info struct {
+	APIVersions   []int   `json:"apiversions"`
+	Timestamp     int64   `json:"timestamp"`
+	PubKey        []byte  `json:"pubkey"`
+	FeePercentage float64 `json:"feepercentage"`
+	FeeXPub       string  `json:"feexpub"`
+	VSPClosed     bool    `json:"vspclosed"`
+	Network       string  `json:"network"`
+}
+
+// FeeAddressRequest requests the fee address and fee amount of a ticket.
+type FeeAddressRequest struct {
+	Timestamp  int64  `json:"timestamp"`
+	TicketHash string `json:"tickethash"`
+	TicketHex  string `json:"tickethex"`
+}
+
+// FeeAddressResponse describes the fee which must be paid to register a
+// ticket.  The fee address is derived from the fee xpub of the VSP at the fee
+// address index.
+type FeeAddressResponse struct {
+	Timestamp       int64           `json:"timestamp"`
+	FeeAddress      string          `json:"feeaddress"`
+	FeeAddressIndex uint32          `json:"feeaddressindex"`
+	FeeAmount       int64           `json:"feeamount"`
+	Expiration      int64           `json:"expiration"`
+	Request         json.RawMessage `json:"request"`
+}
+
+// PayFeeRequest provides the fee transaction and voting key of a ticket.
+type PayFeeRequest struct {
+	Timestamp   int64             `json:"timestamp"`
+	TicketHash  string            `json:"tickethash"`
+	FeeTx       string            `json:"feetx"`
+	VotingKey   string            `json:"votingkey"`
+	VoteChoices map[string]string `json:"votechoices"`
+}
+
+// PayFeeResponse acknowledges the registration of a ticket.
+type PayFeeResponse struct {
+	Timestamp int64           `json:"timestamp"`
+	Request   json.RawMessage `json:"request"`
+}
+
+// TicketStatusRequest requests the registration status of a ticket.
+type TicketStatusRequest struct {
+	Timestamp  int64  `json:"timestamp"`
+	TicketHash string `json:"tickethash"`
+}
+
+// TicketStatusResponse describes the registration status of a ticket.
+type TicketStatusResponse struct {
+	Timestamp       int64             `json:"timestamp"`
+	TicketConfirmed bool              `json:"ticketconfirmed"`
+	FeeTxStatus     string            `json:"feetxstatus"`
+	FeeTxHash       string            `json:"feetxhash"`
+	VoteChoices     map[string]string `json:"votechoices"`
+	Request         json.RawMessage   `json:"request"`
+}
+
+// apiError is the body of an unsuccessful response.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Client performs requests of the HTTP API of a voting service provider.
+// Responses are verified to be signed by the Ed25519 key of the VSP.
+type Client struct {
+	url    *url.URL
+	pubKey ed25519.PublicKey
+	http   *http.Client
+}
+
+// NewClient creates a client of the VSP at host, which must be an https URL,
+// or an http URL on the simulation and regression test networks.  Responses
+// must be signed by pubKey.
+func NewClient(host string, pubKey []byte, params *chaincfg.Params) (*Client, error) {
+	const op errors.Op = "vsp.NewClient"
+	if len(pubKey) != ed25519.PublicKeySize {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("VSP public key has length %d", len(pubKey)))
+	}
+	c, err := newClient(host, ed25519.PublicKey(pubKey), params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// newClient creates a client of the VSP at host.  Response signatures are not
+// verified when pubKey is nil.  Voting keys are sent to the VSP, so http URLs
+// are only permitted on networks without value.
+func newClient(host string, pubKey ed25519.PublicKey, params *chaincfg.Params) (*Client, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.E(errors.Invalid, errors.Errorf("VSP host %q is not an http or https URL", host))
+	}
+	if u.Scheme == "http" && params.Net != wire.SimNet && params.Net != wire.RegNet {
+		return nil, errors.E(errors.Invalid, errors.Errorf("VSP host %q must be an "+
+			"https URL on %s", host, params.Name))
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return &Client{
+		url:    u,
+		pubKey: pubKey,
+		http:   new(http.Client),
+	}, nil
+}
+
+// FetchInfo returns the unverified info of the VSP at host.  It is used to
+// discover the public key of a VSP before it is selected.
+func FetchInfo(ctx context.Context, host string, params *chaincfg.Params) (*Info, error) {
+	const op errors.Op = "vsp.FetchInfo"
+	c, err := newClient(host, nil, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	info := new(Info)
+	err = c.do(ctx, http.MethodGet, "vspinfo", nil, nil, info)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return info, nil
+}
+
+// do performs a request of an API method.  A request body is sent with the
+// client signature sig.  The response is verified to be signed by the VSP
+// unless the client has no public key, and is decoded into resp.
+func (c *Client) do(ctx context.Context, method, path string, body, sig []byte, resp interface{}) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+	}
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	u := *c.url
+	u.Path += "/api/v1/" + path
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return errors.E(errors.Invalid, err)
+	}
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if sig != nil {
+		req.Header.Set(clientSignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	}
+	reply, err := c.http.Do(req)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	defer reply.Body.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(reply.Body, maxResponseSize))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if reply.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(b, &apiErr) == nil && apiErr.Message != "" {
+			return errors.E(errors.Protocol, errors.Errorf("VSP responded %d: %s",
+				reply.StatusCode, apiErr.Message))
+		}
+		return errors.E(errors.Protocol, errors.Errorf("VSP responded %s", reply.Status))
+	}
+
+	if c.pubKey != nil {
+		serverSig, err := base64.StdEncoding.DecodeString(reply.Header.Get(serverSignatureHeader))
+		if err != nil {
+			return errors.E(errors.Protocol, errors.Errorf("VSP response signature: %v", err))
+		}
+		if !ed25519.Verify(c.pubKey, b, serverSig) {
+			return errors.E(errors.Protocol, "VSP response has an invalid signature")
+		}
+	}
+	err = json.Unmarshal(b, resp)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	return nil
+}
+
+// post performs a signed request of an API method, verifying the VSP echoed
+// the request in the response.
+func (c *Client) post(ctx context.Context, path string, req interface{}, sign func([]byte) ([]byte, error),
+	resp interface{}, echoed func() json.RawMessage) error {
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	sig, err := sign(body)
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodPost, path, body, sig, resp)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(echoed(), body) {
+		return errors.E(errors.Protocol, "VSP response does not match the request")
+	}
+	return nil
+}
+
+// Info returns the info of the VSP.
+func (c *Client) Info(ctx context.Context) (*Info, error) {
+	const op errors.Op = "vsp.Info"
+	info := new(Info)
+	err := c.do(ctx, http.MethodGet, "vspinfo", nil, nil, info)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return info, nil
+}
+
+// FeeAddress requests the fee address and amount of a ticket.  The request is
+// signed by sign using the commitment address of the ticket.
+func (c *Client) FeeAddress(ctx context.Context, req *FeeAddressRequest, sign func([]byte) ([]byte, error)) (*FeeAddressResponse, error) {
+	const op errors.Op = "vsp.FeeAddress"
+	resp := new(FeeAddressResponse)
+	err := c.post(ctx, "feeaddress", req, sign, resp, func() json.RawMessage { return resp.Request })
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return resp, nil
+}
+
+// PayFee provides the fee transaction and voting key of a ticket.  The
+// request is signed by sign using the commitment address of the ticket.
+func (c *Client) PayFee(ctx context.Context, req *PayFeeRequest, sign func([]byte) ([]byte, error)) (*PayFeeResponse, error) {
+	const op errors.Op = "vsp.PayFee"
+	resp := new(PayFeeResponse)
+	err := c.post(ctx, "payfee", req, sign, resp, func() json.RawMessage { return resp.Request })
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return resp, nil
+}
+
+// TicketStatus requests the registration status of a ticket.  The request is
+// signed by sign using the commitment address of the ticket.
+func (c *Client) TicketStatus(ctx context.Context, req *TicketStatusRequest, sign func([]byte) ([]byte, error)) (*TicketStatusResponse, error) {
+	const op errors.Op = "vsp.TicketStatus"
+	resp := new(TicketStatusResponse)
+	err := c.post(ctx, "ticketstatus", req, sign, resp, func() json.RawMessage { return resp.Request })
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package vsp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestClientSignatures(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientSig := []byte("client signature")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp interface{}
+		switch r.URL.Path {
+		case "/api/v1/vspinfo":
+			resp = &Info{PubKey: pub, Network: "simnet"}
+		case "/api/v1/ticketstatus":
+			sig, _ := base64.StdEncoding.DecodeString(r.Header.Get(clientSignatureHeader))
+			if !bytes.Equal(sig, clientSig) {
+				http.Error(w, "bad client signature", http.StatusBadRequest)
+				return
+			}
+			req, _ := ioutil.ReadAll(r.Body)
+			resp = &TicketStatusResponse{FeeTxStatus: "confirmed", Request: req}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		b, _ := json.Marshal(resp)
+		w.Header().Set(serverSignatureHeader, base64.StdEncoding.EncodeToString(ed25519.Sign(priv, b)))
+		w.Write(b)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c, err := NewClient(srv.URL, pub, &chaincfg.SimNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(info.PubKey, pub) || info.Network != "simnet" {
+		t.Errorf("info: %+v", info)
+	}
+	sign := func([]byte) ([]byte, error) { return clientSig, nil }
+	status, err := c.TicketStatus(ctx, &TicketStatusRequest{TicketHash: "00"}, sign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.FeeTxStatus != "confirmed" {
+		t.Errorf("ticket status: %+v", status)
+	}
+
+	// Responses signed by another key are rejected.
+	c, err = NewClient(srv.URL, otherPub, &chaincfg.SimNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Info(ctx); !errors.Is(errors.Protocol, err) {
+		t.Errorf("info signed by another key: %v", err)
+	}
+
+	// API errors are reported.
+	_, err = c.TicketStatus(ctx, &TicketStatusRequest{}, func([]byte) ([]byte, error) { return nil, nil })
+	if !errors.Is(errors.Protocol, err) {
+		t.Errorf("unsigned request: %v", err)
+	}
+}
+
+func TestClientScheme(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		host   string
+		params *chaincfg.Params
+		ok     bool
+	}{
+		{"https://vsp.example.org", &chaincfg.MainNetParams, true},
+		{"http://vsp.example.org", &chaincfg.MainNetParams, false},
+		{"http://127.0.0.1:3000", &chaincfg.SimNetParams, true},
+		{"http://127.0.0.1:3000", &chaincfg.RegNetParams, true},
+		{"ftp://vsp.example.org", &chaincfg.SimNetParams, false},
+	}
+	for _, test := range tests {
+		_, err := NewClient(test.host, pub, test.params)
+		if test.ok && err != nil {
+			t.Errorf("%s on %s: %v", test.host, test.params.Name, err)
+		}
+		if !test.ok && !errors.Is(errors.Invalid, err) {
+			t.Errorf("%s on %s: got error %v", test.host, test.params.Name, err)
+		}
+	}
+}
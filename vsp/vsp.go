@@ -0,0 +1,394 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package vsp
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+// minconf is the number of confirmations required of the outputs spent by
+// fee transactions.
+const minconf = 1
+
+// VSP registers the tickets of a wallet with the voting service provider
+// selected by the wallet.  Tickets are purchased with voting rights assigned to
+// addresses of the wallet, and are registered by paying the fee requested by
+// the VSP and providing the VSP the private key of the voting address.
+type VSP struct {
+	wallet *wallet.Wallet
+	params *chaincfg.Params
+	host   string
+	client *Client
+
+	// mu serializes ticket registration to prevent paying a fee twice.
+	mu sync.Mutex
+}
+
+// New returns a VSP for the voting service provider selected by the wallet.
+// An errors.NotExist error is returned when no VSP is selected.
+func New(w *wallet.Wallet) (*VSP, error) {
+	const op errors.Op = "vsp.New"
+	host, pubKey, err := w.VSP()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	c, err := NewClient(host, pubKey, w.ChainParams())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &VSP{
+		wallet: w,
+		params: w.ChainParams(),
+		host:   host,
+		client: c,
+	}, nil
+}
+
+// Host returns the URL of the voting service provider.
+func (v *VSP) Host() string {
+	return v.host
+}
+
+// Info returns the info of the voting service provider, verifying it serves
+// the network of the wallet.
+func (v *VSP) Info(ctx context.Context) (*Info, error) {
+	const op errors.Op = "vsp.Info"
+	info, err := v.client.Info(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if info.Network != v.params.Name {
+		return nil, errors.E(op, errors.Protocol,
+			errors.Errorf("VSP serves network %q", info.Network))
+	}
+	return info, nil
+}
+
+// PurchaseTickets purchases tickets from account with voting rights assigned
+// to addresses of the account, and registers them with the VSP paying the
+// fees from the same account.  The minBalance, spendLimit, minConf, expiry,
+// and ticketFee parameters have the same meaning as those of
+// wallet.PurchaseTickets.  The registration of previously purchased tickets
+// which are not yet registered is retried as well.  The hashes of all
+// purchased tickets are returned, including those which could not yet be
+// registered.
+func (v *VSP) PurchaseTickets(ctx context.Context, minBalance, spendLimit vhcutil.Amount, minConf int32,
+	account uint32, numTickets int, expiry int32, ticketFee vhcutil.Amount) ([]*chainhash.Hash, error) {
+
+	const op errors.Op = "vsp.PurchaseTickets"
+	w := v.wallet
+	if w.PoolAddress() != nil || w.TicketAddress() != nil {
+		return nil, errors.E(op, errors.Invalid, "VSP tickets may not be "+
+			"purchased with a configured pool or ticket address")
+	}
+	info, err := v.Info(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if info.VSPClosed {
+		return nil, errors.E(op, errors.Invalid, "VSP is not accepting new tickets")
+	}
+
+	hashes, err := w.PurchaseTickets(minBalance, spendLimit, minConf, nil, account,
+		numTickets, nil, 0, expiry, w.RelayFee(), ticketFee)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, hash := range hashes {
+		err := w.PutVSPTicket(&udb.VSPTicket{
+			TicketHash: *hash,
+			Host:       v.host,
+			Status:     udb.VSPFeeUnpaid,
+			FeeAccount: account,
+		})
+		if err != nil {
+			return hashes, errors.E(op, err)
+		}
+	}
+	err = v.ProcessTickets(ctx)
+	if err != nil {
+		return hashes, errors.E(op, err)
+	}
+	return hashes, nil
+}
+
+// ProcessTickets retries the registration of each ticket purchased for the
+// VSP which is not yet registered.  Tickets registered with other VSPs are
+// ignored.
+func (v *VSP) ProcessTickets(ctx context.Context) error {
+	const op errors.Op = "vsp.ProcessTickets"
+	tickets, err := v.wallet.VSPTickets()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	for _, t := range tickets {
+		if t.Host != v.host || t.Status == udb.VSPRegistered {
+			continue
+		}
+		err := v.ProcessTicket(ctx, &t.TicketHash)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+// ProcessTicket advances the registration of a ticket purchased for the VSP.
+// The fee address of an unpaid ticket is requested and verified to be derived
+// from the fee xpub of the VSP, and the fee is paid to it.  A fee transaction
+// which was recorded but may not have been published is published again.  The
+// fee transaction and voting key of a ticket whose fee was published are then
+// provided to the VSP.
+func (v *VSP) ProcessTicket(ctx context.Context, ticketHash *chainhash.Hash) error {
+	const op errors.Op = "vsp.ProcessTicket"
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	w := v.wallet
+	t, err := w.VSPTicket(ticketHash)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if t.Host != v.host {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("ticket %v is managed by VSP %s", ticketHash, t.Host))
+	}
+	if t.Status == udb.VSPRegistered {
+		return nil
+	}
+
+	ticket, err := v.tx(ticketHash)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !stake.IsSStx(ticket) {
+		return errors.E(op, errors.Invalid, errors.Errorf("%v is not a ticket", ticketHash))
+	}
+	commitmentAddr, err := stake.AddrFromSStxPkScrCommitment(ticket.TxOut[1].PkScript, v.params)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	sign := func(body []byte) ([]byte, error) {
+		return w.SignMessage(string(body), commitmentAddr)
+	}
+
+	if t.Status == udb.VSPFeeUnpaid {
+		if t.FeeAddress == "" {
+			err = v.requestFeeAddress(ctx, t, ticket, sign)
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
+		err = v.payFee(t)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	feeTx, err := v.tx(&t.FeeTxHash)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if t.Status == udb.VSPFeeSigned {
+		err = v.publishFee(ctx, t, feeTx)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	err = v.provideVotingKey(ctx, t, ticket, feeTx, sign)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// tx returns a transaction recorded by the wallet.
+func (v *VSP) tx(hash *chainhash.Hash) (*wire.MsgTx, error) {
+	txs, _, err := v.wallet.GetTransactionsByHashes([]*chainhash.Hash{hash})
+	if err != nil {
+		return nil, err
+	}
+	return txs[0], nil
+}
+
+// requestFeeAddress requests the fee address and fee amount of a ticket and
+// records them after verifying the fee address is derived from the fee xpub
+// of the VSP, and the fee exceeds neither the fee percentage of the VSP nor
+// the maximum fee of the wallet.
+func (v *VSP) requestFeeAddress(ctx context.Context, t *udb.VSPTicket, ticket *wire.MsgTx,
+	sign func([]byte) ([]byte, error)) error {
+
+	info, err := v.Info(ctx)
+	if err != nil {
+		return err
+	}
+	ticketBytes, err := ticket.Bytes()
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	resp, err := v.client.FeeAddress(ctx, &FeeAddressRequest{
+		Timestamp:  time.Now().Unix(),
+		TicketHash: t.TicketHash.String(),
+		TicketHex:  hex.EncodeToString(ticketBytes),
+	}, sign)
+	if err != nil {
+		return err
+	}
+	err = verifyFeeAddress(info.FeeXPub, resp.FeeAddressIndex, resp.FeeAddress, v.params)
+	if err != nil {
+		return err
+	}
+	maxFee := vhcutil.Amount(ticket.TxOut[0].Value).MulF64(info.FeePercentage / 100)
+	if resp.FeeAmount <= 0 || vhcutil.Amount(resp.FeeAmount) > maxFee {
+		return errors.E(errors.Protocol, errors.Errorf("VSP requested fee %v "+
+			"exceeding %v%% of the ticket price", vhcutil.Amount(resp.FeeAmount),
+			info.FeePercentage))
+	}
+	if vhcutil.Amount(resp.FeeAmount) > v.wallet.VSPMaxFee() {
+		return errors.E(errors.Policy, errors.Errorf("VSP requested fee %v "+
+			"exceeding the maximum of %v", vhcutil.Amount(resp.FeeAmount),
+			v.wallet.VSPMaxFee()))
+	}
+
+	t.FeeAddress = resp.FeeAddress
+	t.FeeAddressIndex = resp.FeeAddressIndex
+	t.FeeAmount = resp.FeeAmount
+	err = v.wallet.PutVSPTicket(t)
+	if err != nil {
+		return err
+	}
+	log.Infof("Ticket %v fee address %s fee %v", &t.TicketHash, t.FeeAddress,
+		vhcutil.Amount(t.FeeAmount))
+	return nil
+}
+
+// verifyFeeAddress verifies the fee address at index is derived from the fee
+// xpub of a VSP.
+func verifyFeeAddress(xpub string, index uint32, feeAddress string, params *chaincfg.Params) error {
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return errors.E(errors.Protocol, errors.Errorf("VSP fee xpub: %v", err))
+	}
+	if key.IsPrivate() || !key.IsForNet(params) {
+		return errors.E(errors.Protocol, "VSP fee xpub is not a public key for the network")
+	}
+	if index >= hdkeychain.HardenedKeyStart {
+		return errors.E(errors.Protocol, errors.Errorf("VSP fee address index %d is hardened", index))
+	}
+	child, err := key.Child(index)
+	if err != nil {
+		return errors.E(errors.Protocol, err)
+	}
+	addr, err := child.Address(params)
+	if err != nil {
+		return errors.E(errors.Protocol, err)
+	}
+	if addr.EncodeAddress() != feeAddress {
+		return errors.E(errors.Protocol, errors.Errorf("VSP fee address %s is not "+
+			"derived from the fee xpub at index %d", feeAddress, index))
+	}
+	return nil
+}
+
+// payFee pays the fee of a ticket.  The fee transaction is recorded with the
+// ticket before it is published, so a payment interrupted before it is
+// published is published again by publishFee rather than paid twice.
+func (v *VSP) payFee(t *udb.VSPTicket) error {
+	hash, err := v.wallet.PayVSPFee(t, minconf)
+	if err != nil {
+		return err
+	}
+	return v.feePublished(t, hash)
+}
+
+// publishFee publishes the recorded fee transaction of a ticket again.
+func (v *VSP) publishFee(ctx context.Context, t *udb.VSPTicket, feeTx *wire.MsgTx) error {
+	n, err := v.wallet.NetworkBackend()
+	if err != nil {
+		return err
+	}
+	err = n.PublishTransactions(ctx, feeTx)
+	if err != nil {
+		return err
+	}
+	return v.feePublished(t, &t.FeeTxHash)
+}
+
+// feePublished records the fee transaction of a ticket as published.
+func (v *VSP) feePublished(t *udb.VSPTicket, hash *chainhash.Hash) error {
+	t.Status = udb.VSPFeePublished
+	err := v.wallet.PutVSPTicket(t)
+	if err != nil {
+		return err
+	}
+	log.Infof("Paid fee of ticket %v in transaction %v", &t.TicketHash, hash)
+	return nil
+}
+
+// provideVotingKey provides the fee transaction, voting key, and agenda
+// choices of a ticket to the VSP, recording the ticket as registered when the
+// VSP accepts them.
+func (v *VSP) provideVotingKey(ctx context.Context, t *udb.VSPTicket, ticket, feeTx *wire.MsgTx,
+	sign func([]byte) ([]byte, error)) error {
+
+	w := v.wallet
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(ticket.TxOut[0].Version,
+		ticket.TxOut[0].PkScript, v.params)
+	if err != nil {
+		return err
+	}
+	if len(addrs) != 1 {
+		return errors.E(errors.Invalid, errors.Errorf("ticket %v has no voting address", &t.TicketHash))
+	}
+	votingKey, err := w.DumpWIFPrivateKey(addrs[0])
+	if err != nil {
+		return err
+	}
+	choices, _, err := w.AgendaChoices()
+	if err != nil {
+		return err
+	}
+	voteChoices := make(map[string]string, len(choices))
+	for _, c := range choices {
+		voteChoices[c.AgendaID] = c.ChoiceID
+	}
+	feeTxBytes, err := feeTx.Bytes()
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+
+	_, err = v.client.PayFee(ctx, &PayFeeRequest{
+		Timestamp:   time.Now().Unix(),
+		TicketHash:  t.TicketHash.String(),
+		FeeTx:       hex.EncodeToString(feeTxBytes),
+		VotingKey:   votingKey,
+		VoteChoices: voteChoices,
+	}, sign)
+	if err != nil {
+		return err
+	}
+	t.Status = udb.VSPRegistered
+	err = w.PutVSPTicket(t)
+	if err != nil {
+		return err
+	}
+	log.Infof("Registered ticket %v with VSP %s", &t.TicketHash, v.host)
+	return nil
+}
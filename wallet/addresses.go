@@ -24,25 +24,41 @@ const DefaultGapLimit = 20
 // created in a row without using any of them
 const DefaultAccountGapLimit = 10
 
-// gapPolicy defines the policy to use when the BIP0044 address gap limit is
+// GapPolicy defines the policy to use when the BIP0044 address gap limit is
 // exceeded.
-type gapPolicy int
+type GapPolicy uint8
 
+// Gap limit policies.  See the WithGapPolicy call options for details of each
+// policy.
 const (
-	gapPolicyError gapPolicy = iota
-	gapPolicyIgnore
-	gapPolicyWrap
+	GapPolicyError GapPolicy = iota
+	GapPolicyIgnore
+	GapPolicyWrap
 )
 
+// String returns the name of the gap policy.
+func (p GapPolicy) String() string {
+	switch p {
+	case GapPolicyError:
+		return "error"
+	case GapPolicyIgnore:
+		return "ignore"
+	case GapPolicyWrap:
+		return "wrap"
+	default:
+		return "unknown"
+	}
+}
+
 type nextAddressCallOptions struct {
-	policy gapPolicy
+	policy GapPolicy
 }
 
 // NextAddressCallOption defines a call option for the NextAddress family of
 // wallet methods.
 type NextAddressCallOption func(*nextAddressCallOptions)
 
-func withGapPolicy(policy gapPolicy) NextAddressCallOption {
+func withGapPolicy(policy GapPolicy) NextAddressCallOption {
 	return func(o *nextAddressCallOptions) {
 		o.policy = policy
 	}
@@ -54,7 +70,7 @@ func withGapPolicy(policy gapPolicy) NextAddressCallOption {
 // specify whether to ignore the gap limit or wrap around to a previously
 // returned address.
 func WithGapPolicyError() NextAddressCallOption {
-	return withGapPolicy(gapPolicyError)
+	return withGapPolicy(GapPolicyError)
 }
 
 // WithGapPolicyIgnore configures the NextAddress family of methods to ignore
@@ -66,7 +82,7 @@ func WithGapPolicyError() NextAddressCallOption {
 // This is a good policy to use when addresses must never be reused, but be
 // aware of the issues noted above.
 func WithGapPolicyIgnore() NextAddressCallOption {
-	return withGapPolicy(gapPolicyIgnore)
+	return withGapPolicy(GapPolicyIgnore)
 }
 
 // WithGapPolicyWrap configures the NextAddress family of methods to wrap around
@@ -76,7 +92,7 @@ func WithGapPolicyIgnore() NextAddressCallOption {
 // This is a good policy to use for most individual users' wallets where funds
 // are segmented by accounts and not the addresses that control each output.
 func WithGapPolicyWrap() NextAddressCallOption {
-	return withGapPolicy(gapPolicyWrap)
+	return withGapPolicy(GapPolicyWrap)
 }
 
 type addressBuffer struct {
@@ -165,15 +181,24 @@ func (w *Wallet) deferPersistReturnedChild(updates *[]func(walletdb.ReadWriteTx)
 func (w *Wallet) nextAddress(op errors.Op, persist persistReturnedChildFunc, account, branch uint32,
 	callOpts ...NextAddressCallOption) (vhcutil.Address, error) {
 
-	var opts nextAddressCallOptions // TODO: zero values for now, add to wallet config later.
+	gapLimit := uint32(w.gapLimit)
+
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+
+	// The account's default gap policy is used unless overridden by the
+	// call options.
+	opts := nextAddressCallOptions{policy: w.gapPolicies[account]}
 	for _, c := range callOpts {
 		c(&opts)
 	}
 
-	gapLimit := uint32(w.gapLimit)
+	// Rotated accounts are receive-only.
+	if r, ok := w.rotations[account]; ok {
+		return nil, errors.E(op, errors.Policy, errors.Errorf("account %d was "+
+			"rotated to account %d and no longer derives addresses", account, r.Successor))
+	}
 
-	defer w.addressBuffersMu.Unlock()
-	w.addressBuffersMu.Lock()
 	ad, ok := w.addressBuffers[account]
 	if !ok {
 		return nil, errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
@@ -192,11 +217,11 @@ func (w *Wallet) nextAddress(op errors.Op, persist persistReturnedChildFunc, acc
 	for {
 		if alb.cursor >= gapLimit {
 			switch opts.policy {
-			case gapPolicyError:
+			case GapPolicyError:
 				return nil, errors.E(op, errors.Policy,
 					"generating next address violates the unused address gap limit policy")
 
-			case gapPolicyIgnore:
+			case GapPolicyIgnore:
 				// Addresses beyond the last used child + gap limit are not
 				// already watched, so this must be done now if the wallet is
 				// connected to a consensus RPC server.  Watch addresses in
@@ -219,7 +244,7 @@ func (w *Wallet) nextAddress(op errors.Op, persist persistReturnedChildFunc, acc
 					return nil, err
 				}
 
-			case gapPolicyWrap:
+			case GapPolicyWrap:
 				alb.cursor = 0
 			}
 		}
@@ -460,6 +485,85 @@ func (w *Wallet) BIP0044BranchNextIndexes(account uint32) (extChild, intChild ui
 	return extChild, intChild, nil
 }
 
+// AccountGapPolicy returns the default gap limit policy used when generating
+// addresses for an account without specifying a gap policy call option.
+func (w *Wallet) AccountGapPolicy(account uint32) GapPolicy {
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+	return w.gapPolicies[account]
+}
+
+// SetAccountGapPolicy sets the default gap limit policy used when generating
+// addresses for an account without specifying a gap policy call option.
+func (w *Wallet) SetAccountGapPolicy(account uint32, policy GapPolicy) error {
+	const op errors.Op = "wallet.SetAccountGapPolicy"
+	switch policy {
+	case GapPolicyError, GapPolicyIgnore, GapPolicyWrap:
+	default:
+		return errors.E(op, errors.Invalid, errors.Errorf("unknown gap policy %d", policy))
+	}
+
+	w.addressBuffersMu.Lock()
+	_, ok := w.addressBuffers[account]
+	w.addressBuffersMu.Unlock()
+	if !ok {
+		return errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
+	}
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		if policy == GapPolicyError {
+			return w.Manager.DeleteAccountGapPolicy(ns, account)
+		}
+		return w.Manager.PutAccountGapPolicy(ns, account, uint8(policy))
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	w.addressBuffersMu.Lock()
+	if policy == GapPolicyError {
+		delete(w.gapPolicies, account)
+	} else {
+		w.gapPolicies[account] = policy
+	}
+	w.addressBuffersMu.Unlock()
+	return nil
+}
+
+// AccountGapUsage describes the address gap limit policy of an account and how
+// many addresses of each branch have been returned beyond the last used
+// address.
+type AccountGapUsage struct {
+	Policy            GapPolicy
+	GapLimit          uint32
+	NextExternalIndex uint32
+	NextInternalIndex uint32
+	ExternalGap       uint32
+	InternalGap       uint32
+}
+
+// AccountGapUsage returns the gap limit policy and current gap usage of an
+// account.
+func (w *Wallet) AccountGapUsage(account uint32) (*AccountGapUsage, error) {
+	const op errors.Op = "wallet.AccountGapUsage"
+
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+
+	acctData, ok := w.addressBuffers[account]
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("account %v", account))
+	}
+	ext, in := &acctData.albExternal, &acctData.albInternal
+	return &AccountGapUsage{
+		Policy:            w.gapPolicies[account],
+		GapLimit:          uint32(w.gapLimit),
+		NextExternalIndex: ext.lastUsed + 1 + ext.cursor,
+		NextInternalIndex: in.lastUsed + 1 + in.cursor,
+		ExternalGap:       ext.cursor,
+		InternalGap:       in.cursor,
+	}, nil
+}
+
 // ExtendWatchedAddresses derives and watches additional addresses for an
 // account branch they have not yet been derived.  This does not modify the next
 // generated address for the branch.
@@ -11,6 +11,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
 	"github.com/valhallacoin/vhcd/vhcutil"
@@ -145,7 +146,7 @@ func setupWallet(t *testing.T, cfg *Config) (*Wallet, walletdb.DB, func()) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = Create(opaqueDB{db}, pubPassphrase, privPassphrase, seed, cfg.Params)
+	err = Create(opaqueDB{db}, pubPassphrase, privPassphrase, seed, time.Time{}, cfg.Params)
 	if err != nil {
 		db.Close()
 		os.Remove(f.Name())
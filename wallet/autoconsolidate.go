@@ -0,0 +1,201 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// DefaultMaxBlockUsage is the default block usage, as a fraction of the
+// maximum block size, at or below which automatic consolidations are
+// performed.
+const DefaultMaxBlockUsage = 0.5
+
+// AutoConsolidation configures the automatic consolidation of the unspent
+// outputs of an account.  Outputs are consolidated as blocks are attached to
+// the main chain while the account holds more than Threshold spendable outputs
+// and the attached block indicates a low-fee period, that is, the block used no
+// more than MaxBlockUsage of the maximum block size.  Consolidations pay the
+// relay fee of the wallet and are only performed while the wallet is unlocked.
+type AutoConsolidation struct {
+	// Threshold is the number of spendable outputs of the account which
+	// must be exceeded before outputs are consolidated.
+	Threshold int
+
+	// MaxInputs limits the number of outputs consolidated by each
+	// transaction.  Zero only limits the inputs by the maximum transaction
+	// size.
+	MaxInputs int
+
+	// MaxBlockUsage is the fraction of the maximum block size that the
+	// latest attached block may use for outputs to be consolidated.
+	MaxBlockUsage float64
+}
+
+// AutoConsolidationStatus describes the automatic consolidation of an
+// account's unspent outputs.
+type AutoConsolidationStatus struct {
+	Account uint32
+	Config  AutoConsolidation
+
+	// Outputs is the number of spendable outputs of the account counted
+	// by the latest evaluation, and EvaluatedHeight is the main chain
+	// height of that evaluation.  EvaluatedHeight is zero if the account
+	// has not yet been evaluated.
+	Outputs         int
+	EvaluatedHeight int32
+
+	// Consolidations is the number of consolidation transactions
+	// published since the automatic consolidation was configured, and
+	// LastTx is the most recent of them.
+	Consolidations int
+	LastTx         *chainhash.Hash
+	LastTxHeight   int32
+
+	LastError       error
+	LastErrorHeight int32
+}
+
+type autoConsolidation struct {
+	status  AutoConsolidationStatus
+	running bool
+}
+
+// SetAutoConsolidation configures the automatic consolidation of an account's
+// unspent outputs, replacing any previous configuration of the account.  A nil
+// configuration disables automatic consolidation of the account.  The
+// configuration is not persisted and must be set again each time the wallet is
+// loaded.
+func (w *Wallet) SetAutoConsolidation(account uint32, cfg *AutoConsolidation) error {
+	const op errors.Op = "wallet.SetAutoConsolidation"
+	if cfg != nil {
+		switch {
+		case cfg.Threshold < 1:
+			return errors.E(op, errors.Invalid, "threshold must be positive")
+		case cfg.MaxInputs < 0:
+			return errors.E(op, errors.Invalid, "negative maximum inputs")
+		case cfg.MaxInputs == 1:
+			return errors.E(op, errors.Invalid, "consolidations require at least two inputs")
+		case cfg.MaxBlockUsage <= 0 || cfg.MaxBlockUsage > 1:
+			return errors.E(op, errors.Invalid, "maximum block usage must be in the range (0, 1]")
+		}
+	}
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.Manager.AccountName(ns, account)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	w.autoConsolidationsMu.Lock()
+	defer w.autoConsolidationsMu.Unlock()
+	if cfg == nil {
+		delete(w.autoConsolidations, account)
+		return nil
+	}
+	w.autoConsolidations[account] = &autoConsolidation{
+		status: AutoConsolidationStatus{
+			Account: account,
+			Config:  *cfg,
+		},
+	}
+	return nil
+}
+
+// AutoConsolidations returns the status of the automatic consolidation of
+// every configured account, ordered by account number.
+func (w *Wallet) AutoConsolidations() []AutoConsolidationStatus {
+	w.autoConsolidationsMu.Lock()
+	statuses := make([]AutoConsolidationStatus, 0, len(w.autoConsolidations))
+	for _, c := range w.autoConsolidations {
+		statuses = append(statuses, c.status)
+	}
+	w.autoConsolidationsMu.Unlock()
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Account < statuses[j].Account
+	})
+	return statuses
+}
+
+// lowFeeBlock returns whether a block used no more than maxUsage of the
+// maximum block size, indicating that transactions paying the relay fee are
+// mined promptly.
+func (w *Wallet) lowFeeBlock(header *wire.BlockHeader, maxUsage float64) bool {
+	maxSize := w.chainParams.MaximumBlockSizes[0]
+	return float64(header.Size) <= maxUsage*float64(maxSize)
+}
+
+// scheduleAutoConsolidations evaluates the automatic consolidation of each
+// configured account after the main chain tip changed to tip.  Accounts are
+// evaluated in the background, and an account is not evaluated again until
+// its previous evaluation completes.
+func (w *Wallet) scheduleAutoConsolidations(tip *wire.BlockHeader) {
+	w.autoConsolidationsMu.Lock()
+	defer w.autoConsolidationsMu.Unlock()
+	for account, c := range w.autoConsolidations {
+		if c.running || !w.lowFeeBlock(tip, c.status.Config.MaxBlockUsage) {
+			continue
+		}
+		c.running = true
+		go w.autoConsolidate(account, c, int32(tip.Height))
+	}
+}
+
+// autoConsolidate consolidates the outputs of an account if it holds more than
+// the configured threshold of spendable outputs.
+func (w *Wallet) autoConsolidate(account uint32, c *autoConsolidation, height int32) {
+	w.autoConsolidationsMu.Lock()
+	cfg := c.status.Config
+	w.autoConsolidationsMu.Unlock()
+
+	var outputs int
+	var txHash *chainhash.Hash
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		eligible, err := w.findEligibleOutputs(dbtx, account, 1, tipHeight)
+		outputs = len(eligible)
+		return err
+	})
+	if err == nil && outputs > cfg.Threshold {
+		if w.Locked() {
+			err = errors.E(errors.Locked, "wallet must be unlocked to consolidate outputs")
+		} else {
+			txHash, err = w.ConsolidateAccount(account, nil,
+				&ConsolidateOptions{MaxInputs: cfg.MaxInputs})
+		}
+	}
+
+	w.autoConsolidationsMu.Lock()
+	defer w.autoConsolidationsMu.Unlock()
+	c.running = false
+	s := &c.status
+	s.Outputs = outputs
+	s.EvaluatedHeight = height
+	if err != nil {
+		s.LastError = err
+		s.LastErrorHeight = height
+		if errors.Is(errors.Locked, err) {
+			log.Debugf("Skipped consolidating outputs of account %d: %v", account, err)
+		} else {
+			log.Errorf("Failed to consolidate outputs of account %d: %v", account, err)
+		}
+		return
+	}
+	if txHash != nil {
+		s.Consolidations++
+		s.LastTx = txHash
+		s.LastTxHeight = height
+		log.Infof("Consolidated outputs of account %d in transaction %v",
+			account, txHash)
+	}
+}
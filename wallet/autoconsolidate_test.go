@@ -0,0 +1,77 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestAutoConsolidation(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 1e8, 1e8, 1e8, 1e8)
+
+	err := w.SetAutoConsolidation(0, &AutoConsolidation{Threshold: 0, MaxBlockUsage: 0.5})
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("expected Invalid error, got %v", err)
+	}
+	err = w.SetAutoConsolidation(1<<20, &AutoConsolidation{Threshold: 3, MaxBlockUsage: 0.5})
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("expected NotExist error, got %v", err)
+	}
+	err = w.SetAutoConsolidation(0, &AutoConsolidation{Threshold: 3, MaxInputs: 4, MaxBlockUsage: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Outputs are not consolidated after full blocks.
+	maxSize := uint32(w.chainParams.MaximumBlockSizes[0])
+	w.scheduleAutoConsolidations(&wire.BlockHeader{Height: 1, Size: maxSize})
+	time.Sleep(100 * time.Millisecond)
+	if s := w.AutoConsolidations()[0]; s.EvaluatedHeight != 0 {
+		t.Fatalf("account evaluated after a full block: %+v", s)
+	}
+
+	w.scheduleAutoConsolidations(&wire.BlockHeader{Height: 2, Size: maxSize / 4})
+	var s AutoConsolidationStatus
+	for i := 0; i < 50; i++ {
+		s = w.AutoConsolidations()[0]
+		if s.EvaluatedHeight != 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if s.LastError != nil {
+		t.Fatal(s.LastError)
+	}
+	if s.Outputs != 5 || s.Consolidations != 1 || s.LastTx == nil || s.LastTxHeight != 2 {
+		t.Fatalf("unexpected status %+v", s)
+	}
+	txs, _, err := w.GetTransactionsByHashes([]*chainhash.Hash{s.LastTx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(txs[0].TxIn); n != 4 {
+		t.Fatalf("consolidated %d outputs", n)
+	}
+
+	if err := w.SetAutoConsolidation(0, nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(w.AutoConsolidations()); n != 0 {
+		t.Fatalf("%d accounts remain configured", n)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// birthdayMargin is subtracted from the wallet birthday when skipping blocks
+// which are too old to contain wallet transactions, allowing for inaccurate
+// birthdays and block timestamps.
+const birthdayMargin = 48 * time.Hour
+
+// Birthday returns the time the wallet seed was created, or the zero time if
+// the birthday is unknown.
+func (w *Wallet) Birthday() (time.Time, error) {
+	const op errors.Op = "wallet.Birthday"
+	var birthday time.Time
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		birthday, err = udb.FetchBirthday(dbtx)
+		return err
+	})
+	if err != nil {
+		return time.Time{}, errors.E(op, err)
+	}
+	return birthday, nil
+}
+
+// SetBirthday records the time the wallet seed was created.  Blocks mined
+// before the birthday are not rescanned for wallet transactions, which speeds
+// up the initial synchronization of wallets restored from a seed.
+func (w *Wallet) SetBirthday(birthday time.Time) error {
+	const op errors.Op = "wallet.SetBirthday"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutBirthday(dbtx, birthday)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	log.Infof("Set wallet birthday to %v", birthday.Format(time.RFC3339))
+	return nil
+}
+
+// birthdayRescanPoint returns the first main chain block at or after the
+// rescan point with a timestamp after the wallet birthday (less the birthday
+// margin), or nil if every block through the tip was mined before it.  The
+// rescan point is returned unchanged when the birthday is unknown.
+func (w *Wallet) birthdayRescanPoint(dbtx walletdb.ReadTx, rescanPoint *chainhash.Hash, height int32) (*chainhash.Hash, error) {
+	birthday, err := udb.FetchBirthday(dbtx)
+	if err != nil || birthday.IsZero() {
+		return rescanPoint, err
+	}
+	birthday = birthday.Add(-birthdayMargin)
+
+	ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.TxStore.MainChainTip(ns)
+	var searchErr error
+	timestamp := func(height int32) time.Time {
+		if searchErr != nil {
+			return time.Time{}
+		}
+		hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, height)
+		if err != nil {
+			searchErr = err
+			return time.Time{}
+		}
+		h, err := w.TxStore.GetBlockHeader(dbtx, &hash)
+		if err != nil {
+			searchErr = err
+			return time.Time{}
+		}
+		return h.Timestamp
+	}
+	if !timestamp(height).Before(birthday) {
+		return rescanPoint, searchErr
+	}
+	n := int(tipHeight - height + 1)
+	i := sort.Search(n, func(i int) bool {
+		return !timestamp(height + int32(i)).Before(birthday)
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if i == n {
+		return nil, nil
+	}
+	hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, height+int32(i))
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Skipping rescan of blocks %d-%d mined before the wallet birthday",
+		height, height+int32(i)-1)
+	return &hash, nil
+}
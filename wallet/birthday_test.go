@@ -0,0 +1,67 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestCreateBirthday(t *testing.T) {
+	// Wallets with generated seeds are born when created.
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	birthday, err := w.Birthday()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(birthday) > time.Minute {
+		t.Errorf("generated seed has birthday %v", birthday)
+	}
+
+	seed := bytes.Repeat([]byte{1}, 32)
+	restored := time.Unix(1546300800, 0)
+	tests := []struct {
+		birthday time.Time
+	}{
+		{time.Time{}},
+		{restored},
+	}
+	for i, test := range tests {
+		f, err := ioutil.TempFile("", "vhcwallet.testdb")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		db, err := walletdb.Create("bdb", f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = Create(opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"),
+			seed, test.birthday, &chaincfg.SimNetParams)
+		if err == nil {
+			err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+				birthday, err = udb.FetchBirthday(dbtx)
+				return err
+			})
+		}
+		db.Close()
+		os.Remove(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !birthday.Equal(test.birthday) {
+			t.Errorf("test %d: birthday %v, want %v", i, birthday, test.birthday)
+		}
+	}
+}
@@ -134,11 +134,6 @@ func (w *Wallet) ChainSwitch(forest *SidechainForest, chain []*BlockNode, releva
 		}
 
 		for _, n := range chain {
-			if voteVersion(w.chainParams) < n.Header.StakeVersion {
-				log.Warnf("Old vote version detected (v%v), please update your "+
-					"wallet to the latest version.", voteVersion(w.chainParams))
-			}
-
 			watch, err := w.extendMainChain(op, dbtx, n.Header, n.Filter, relevantTxs[*n.Hash])
 			if err != nil {
 				return err
@@ -203,6 +198,28 @@ func (w *Wallet) ChainSwitch(forest *SidechainForest, chain []*BlockNode, releva
 	forest.PruneTree(chain[0].Hash)
 	forest.Prune(int32(chain[len(chain)-1].Header.Height), w.chainParams)
 
+	w.releaseOutpointLocksAtHeight(chainTipChanges.NewHeight)
+
+	err = w.checkVoteVersion()
+	if err != nil {
+		log.Errorf("Failed to check vote version: %v", err)
+	}
+
+	attached := make([]*wire.BlockHeader, len(chain))
+	for i, n := range chain {
+		attached[i] = n.Header
+	}
+	missed, err := w.checkMissedVotes(attached)
+	if err != nil {
+		log.Errorf("Failed to check for missed votes: %v", err)
+	}
+	w.scheduleMissedRevocations(missed)
+	err = w.checkTicketStatusChanges(chain, missed)
+	if err != nil {
+		log.Errorf("Failed to check for ticket status changes: %v", err)
+	}
+	w.scheduleAutoConsolidations(chain[len(chain)-1].Header)
+
 	w.NtfnServer.notifyMainChainTipChanged(chainTipChanges)
 	w.NtfnServer.sendAttachedBlockNotification()
 
@@ -377,6 +394,7 @@ func (w *Wallet) processTransactionRecord(dbtx walletdb.ReadWriteTx, rec *udb.Tx
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	w.invalidateStakeInfo()
 
 	// Handle incoming SStx; store them in the stake manager if we own
 	// the OP_SSTX tagged out, except if we're operating as a stake pool
@@ -713,6 +731,14 @@ func (w *Wallet) processTransactionRecord(dbtx walletdb.ReadWriteTx, rec *udb.Tx
 		}
 	}
 
+	// Watch outputs paying watched scripts so that their spends are
+	// recorded as well.
+	watched, err := w.TxStore.WatchTxOutputs(txmgrNs, rec)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	watchOutPoints = append(watchOutPoints, watched...)
+
 	// Send notification of mined or unmined transaction to any interested
 	// clients.
 	//
@@ -755,18 +781,45 @@ func selectOwnedTickets(w *Wallet, dbtx walletdb.ReadTx, tickets []*chainhash.Ha
 // tickets in the winningTicketHashes slice if wallet voting is enabled.  The
 // vote is only valid when voting on the block described by the passed block
 // hash and height.  When a network backend is associated with the wallet,
-// relevant commitment outputs are loaded as watched data.
+// relevant commitment outputs are loaded as watched data.  Winning tickets
+// notifications are sent after voting so that clients never delay votes.
 func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, blockHash *chainhash.Hash, blockHeight int32) error {
 	const op errors.Op = "wallet.VoteOnOwnedTickets"
 
-	if !w.votingEnabled || blockHeight < int32(w.chainParams.StakeValidationHeight)-1 {
+	defer w.NtfnServer.notifyWinningTickets(blockHash, blockHeight, winningTicketHashes)
+
+	err := w.recordSelectedTickets(winningTicketHashes, blockHash, blockHeight)
+	if err != nil {
+		log.Errorf("Failed to record tickets selected to vote: %v", err)
+	}
+
+	if !w.VotingEnabled() || blockHeight < int32(w.chainParams.StakeValidationHeight)-1 {
 		return nil
 	}
 
-	n, err := w.NetworkBackend()
+	err = w.voteOnOwnedTickets(winningTicketHashes, blockHash, blockHeight)
 	if err != nil {
 		return errors.E(op, err)
 	}
+	return nil
+}
+
+// voteOnOwnedTickets creates and publishes vote transactions for the owned
+// tickets of the winningTicketHashes slice.
+func (w *Wallet) voteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, blockHash *chainhash.Hash, blockHeight int32) error {
+	// Redundant voting wallets are expected to run as duplicate instances
+	// and avoid double votes by claiming each vote.
+	if w.voteCoordinator == nil {
+		err := w.checkDuplicateInstance()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return err
+	}
 
 	// TODO The behavior of this is not quite right if tons of blocks
 	// are coming in quickly, because the transaction store will end up
@@ -810,17 +863,23 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 				continue
 			}
 
+			if !w.claimVote(blockHash, blockHeight, ticketHash) {
+				continue
+			}
+
 			vote, err := createUnsignedVote(ticketHash, ticketPurchase,
 				blockHeight, blockHash, voteBits, w.subsidyCache, w.chainParams)
 			if err != nil {
 				log.Errorf("Failed to create vote transaction for ticket "+
 					"hash %v: %v", ticketHash, err)
+				w.releaseVote(blockHash, ticketHash)
 				continue
 			}
 			err = w.signVote(addrmgrNs, ticketPurchase, vote)
 			if err != nil {
 				log.Errorf("Failed to sign vote for ticket hash %v: %v",
 					ticketHash, err)
+				w.releaseVote(blockHash, ticketHash)
 				continue
 			}
 			votes[i] = vote
@@ -828,14 +887,18 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 		return nil
 	})
 	if err != nil {
-		log.Errorf("View failed: %v", errors.E(op, err))
+		log.Errorf("View failed: %v", err)
 	}
 
-	// Remove nil votes without preserving order.
+	// Remove nil votes and their tickets without preserving order.
+	ticketHashes = ticketHashes[:len(votes)]
 	for i := 0; i < len(votes); {
 		if votes[i] == nil {
-			votes[i], votes[len(votes)-1] = votes[len(votes)-1], votes[i]
-			votes = votes[:len(votes)-1]
+			last := len(votes) - 1
+			votes[i], votes[last] = votes[last], votes[i]
+			ticketHashes[i], ticketHashes[last] = ticketHashes[last], ticketHashes[i]
+			votes = votes[:last]
+			ticketHashes = ticketHashes[:last]
 			continue
 		}
 		i++
@@ -847,10 +910,15 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 		if err != nil {
 			log.Errorf("Failed to create transaction record for vote %v: %v",
 				ticketHashes[i], err)
+			w.releaseVote(blockHash, ticketHashes[i])
 			continue
 		}
 		voteRecords = append(voteRecords, rec)
 	}
+	// voteTicket returns the ticket hash of a vote record.
+	voteTicket := func(rec *udb.TxRecord) *chainhash.Hash {
+		return &rec.MsgTx.TxIn[1].PreviousOutPoint.Hash
+	}
 	var watchOutPoints []wire.OutPoint
 	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
 		for i := range voteRecords {
@@ -859,10 +927,17 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 				return err
 			}
 			watchOutPoints = append(watchOutPoints, watch...)
+			err = w.recordVote(dbtx, &voteRecords[i].MsgTx, voteRecords[i].Received)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	})
 	if err != nil {
+		for i := range voteRecords {
+			w.releaseVote(blockHash, voteTicket(voteRecords[i]))
+		}
 		return err
 	}
 
@@ -872,8 +947,9 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 	for i := range voteRecords {
 		log.Infof("Voting on block %v (height %v) using ticket %v "+
 			"(vote hash: %v bits: %v)", blockHash, blockHeight,
-			ticketHashes[i], &voteRecords[i].Hash, voteBits.Bits)
+			voteTicket(voteRecords[i]), &voteRecords[i].Hash, voteBits.Bits)
 	}
+	published := err == nil
 	if err != nil {
 		// Unwrap to access the underlying RPC error code.
 		for {
@@ -886,6 +962,15 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 		rpcErr, ok := err.(*vhcjson.RPCError)
 		if !ok || rpcErr.Code != vhcjson.ErrRPCDuplicateTx {
 			log.Errorf("Failed to send one or more votes: %v", err)
+		} else {
+			published = true
+		}
+	}
+	for i := range voteRecords {
+		if published {
+			w.confirmVote(blockHash, voteTicket(voteRecords[i]))
+		} else {
+			w.releaseVote(blockHash, voteTicket(voteRecords[i]))
 		}
 	}
 
@@ -906,6 +991,11 @@ func (w *Wallet) VoteOnOwnedTickets(winningTicketHashes []*chainhash.Hash, block
 func (w *Wallet) RevokeOwnedTickets(missedTicketHashes []*chainhash.Hash) error {
 	const op errors.Op = "wallet.RevokeOwnedTickets"
 
+	err := w.checkDuplicateInstance()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
 	n, err := w.NetworkBackend()
 	if err != nil {
 		return errors.E(op, err)
@@ -973,11 +1063,14 @@ func (w *Wallet) RevokeOwnedTickets(missedTicketHashes []*chainhash.Hash) error
 		log.Errorf("View failed: %v", errors.E(op, err))
 	}
 
-	// Remove nil revocations without preserving order.
+	// Remove nil revocations without preserving order.  Ticket hashes are
+	// swapped alongside to remain at the indexes of their revocations.
 	for i := 0; i < len(revocations); {
 		if revocations[i] == nil {
-			revocations[i], revocations[len(revocations)-1] = revocations[len(revocations)-1], revocations[i]
-			revocations = revocations[:len(revocations)-1]
+			last := len(revocations) - 1
+			revocations[i], revocations[last] = revocations[last], revocations[i]
+			ticketHashes[i], ticketHashes[last] = ticketHashes[last], ticketHashes[i]
+			revocations = revocations[:last]
 			continue
 		}
 		i++
@@ -992,8 +1085,17 @@ func (w *Wallet) RevokeOwnedTickets(missedTicketHashes []*chainhash.Hash) error
 		}
 		revocationHash := &txRec.Hash
 		var watchOutPoints []wire.OutPoint
+		var spent bool
 		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			// Missed tickets may be reported both by the network
+			// backend and by the wallet's own missed vote detection.
+			// Skip tickets revoked since the revocation was created.
 			var err error
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			spent, err = w.ticketSpent(txmgrNs, ticketHashes[i])
+			if err != nil || spent {
+				return err
+			}
 			watchOutPoints, err = w.processTransactionRecord(dbtx, txRec, nil, nil)
 			return err
 		})
@@ -1002,6 +1104,10 @@ func (w *Wallet) RevokeOwnedTickets(missedTicketHashes []*chainhash.Hash) error
 				revocationHash, ticketHashes[i], err)
 			continue
 		}
+		if spent {
+			log.Debugf("Ticket %v is already spent", ticketHashes[i])
+			continue
+		}
 		err = n.PublishTransactions(context.TODO(), revocation)
 		if err != nil {
 			log.Errorf("Failed to send revocation %v for ticket hash %v: %v",
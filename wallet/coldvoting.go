@@ -0,0 +1,93 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// coldVotingKey is an account extended public key of a cold voting wallet from
+// which the voting addresses of purchased tickets are derived.
+type coldVotingKey struct {
+	xpub   *hdkeychain.ExtendedKey
+	branch *hdkeychain.ExtendedKey // External branch of xpub
+	id     chainhash.Hash
+}
+
+func newColdVotingKey(xpub *hdkeychain.ExtendedKey) (*coldVotingKey, error) {
+	if xpub.IsPrivate() {
+		return nil, errors.E(errors.Invalid, "voting extended key must be public")
+	}
+	branch, err := xpub.Child(udb.ExternalBranch)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	return &coldVotingKey{
+		xpub:   xpub,
+		branch: branch,
+		id:     chainhash.HashH([]byte(xpub.String())),
+	}, nil
+}
+
+// VotingXpub returns the extended public key of the cold voting wallet which
+// ticket voting addresses are derived from, or nil if voting addresses are not
+// derived from an external key.
+func (w *Wallet) VotingXpub() *hdkeychain.ExtendedKey {
+	if w.coldVoting == nil {
+		return nil
+	}
+	return w.coldVoting.xpub
+}
+
+// nextColdVotingAddress derives the voting address of a ticket from the next
+// unused child of the external branch of the voting extended public key.
+func (w *Wallet) nextColdVotingAddress(dbtx walletdb.ReadWriteTx) (vhcutil.Address, uint32, error) {
+	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+	for {
+		index, err := w.TxStore.NextColdVotingIndex(txmgrNs, &w.coldVoting.id)
+		if err != nil {
+			return nil, 0, err
+		}
+		child, err := w.coldVoting.branch.Child(index)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		addr, err := child.Address(w.chainParams)
+		if err != nil {
+			return nil, 0, err
+		}
+		return addr, index, nil
+	}
+}
+
+// ColdVotingIndex returns the child index of the external branch of the voting
+// extended public key which derived the voting address of a ticket purchased
+// by the wallet.  Tickets whose voting addresses were not derived from the
+// voting extended public key return an errors.NotExist error.
+func (w *Wallet) ColdVotingIndex(ticketHash *chainhash.Hash) (uint32, error) {
+	const op errors.Op = "wallet.ColdVotingIndex"
+	var index uint32
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		t, err := w.TxStore.ColdVotingTicket(txmgrNs, ticketHash)
+		if err != nil {
+			return err
+		}
+		index = t.Index
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return index, nil
+}
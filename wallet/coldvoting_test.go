@@ -0,0 +1,74 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestColdVotingAddresses(t *testing.T) {
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), &chaincfg.SimNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newColdVotingKey(master); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("private voting key: expected Invalid error, got %v", err)
+	}
+	xpub, err := master.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	branch, err := xpub.Child(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := basicWalletConfig
+	cfg.VotingXpub = xpub
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if w.VotingXpub() != xpub {
+		t.Fatal("wallet does not use configured voting xpub")
+	}
+	for i := uint32(0); i < 3; i++ {
+		err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			addr, index, err := w.nextColdVotingAddress(dbtx)
+			if err != nil {
+				return err
+			}
+			if index != i {
+				t.Errorf("derived index %d, want %d", index, i)
+			}
+			child, err := branch.Child(i)
+			if err != nil {
+				return err
+			}
+			want, err := child.Address(w.chainParams)
+			if err != nil {
+				return err
+			}
+			if addr.String() != want.String() {
+				t.Errorf("voting address %d is %v, want %v", i, addr, want)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = w.ColdVotingIndex(&chainhash.Hash{})
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("index of unknown ticket: expected NotExist error, got %v", err)
+	}
+}
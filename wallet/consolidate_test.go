@@ -0,0 +1,79 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcutil"
+)
+
+func TestConsolidateAccount(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 3e8, 1e8, 10e8, 2e8)
+	account, err := w.NextAccount("consolidate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, account, 4e6, 5e6, 6e6)
+
+	consolidated := func(hash *chainhash.Hash) []vhcutil.Amount {
+		t.Helper()
+		txs, _, err := w.GetTransactionsByHashes([]*chainhash.Hash{hash})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(txs[0].TxOut) != 1 {
+			t.Fatalf("consolidation has %d outputs", len(txs[0].TxOut))
+		}
+		var amounts []vhcutil.Amount
+		for _, in := range txs[0].TxIn {
+			amounts = append(amounts, vhcutil.Amount(in.ValueIn))
+		}
+		return amounts
+	}
+
+	// Only outputs below the target are consolidated, smallest first, until
+	// the output reaches the target size.
+	hash, err := w.ConsolidateAccount(0, nil, &ConsolidateOptions{TargetSize: 2.5e8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in := consolidated(hash); len(in) != 2 || in[0] != 1e8 || in[1] != 2e8 {
+		t.Fatalf("consolidated %v", in)
+	}
+	_, err = w.ConsolidateAccount(0, nil, &ConsolidateOptions{TargetSize: 2.5e8})
+	if err == nil {
+		t.Fatal("consolidated outputs above the target size")
+	}
+
+	// Inputs are selected from the requested account only, and are limited
+	// by the maximum number of inputs.
+	hash, err = w.ConsolidateAccount(account, nil, &ConsolidateOptions{
+		MaxInputs: 2,
+		FeeRate:   2 * w.RelayFee(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := consolidated(hash)
+	if len(in) != 2 {
+		t.Fatalf("consolidated %d inputs", len(in))
+	}
+	for _, amount := range in {
+		if amount > 6e6 {
+			t.Fatalf("consolidated output %v of another account", amount)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// CosignSession describes a transaction spending P2SH multisig outputs which
+// is signed by several wallets.  The session is created by a wallet recording
+// the spent outputs and passed between the cosigners, each of which adds its
+// signatures.  Sessions signed by different cosigners may be merged, and once
+// every input has the required number of signatures any cosigner finalizes the
+// session to produce the signed transaction.
+type CosignSession struct {
+	ID      string        `json:"id"`
+	Network string        `json:"network"`
+	Tx      string        `json:"tx"`
+	Inputs  []CosignInput `json:"inputs"`
+}
+
+// CosignInput describes an input of a cosigned transaction.  Signatures are
+// partial signatures of the input by the keys of the multisig redeem script.
+type CosignInput struct {
+	RedeemScript string            `json:"redeemscript"`
+	Required     int               `json:"required"`
+	Signatures   []CosignSignature `json:"signatures,omitempty"`
+}
+
+// CosignSignature is the signature of a cosigned transaction input by one of
+// the public keys of the input's redeem script.  Both fields are hex encoded.
+type CosignSignature struct {
+	PubKey    string `json:"pubkey"`
+	Signature string `json:"signature"`
+}
+
+// tx decodes the unsigned transaction of the session, checking that it is
+// for the network described by params and that every input is described.
+func (s *CosignSession) tx(params *chaincfg.Params) (*wire.MsgTx, error) {
+	if s.Network != params.Name {
+		return nil, errors.E(errors.Invalid,
+			errors.Errorf("cosigning session is for network %q", s.Network))
+	}
+	b, err := hex.DecodeString(s.Tx)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	tx := new(wire.MsgTx)
+	err = tx.Deserialize(bytes.NewReader(b))
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	if len(tx.TxIn) != len(s.Inputs) {
+		return nil, errors.E(errors.Invalid,
+			errors.Errorf("cosigning session describes %d of %d inputs",
+				len(s.Inputs), len(tx.TxIn)))
+	}
+	return tx, nil
+}
+
+// multisig decodes the redeem script of an input, returning the script and
+// the public keys which may sign it.
+func (in *CosignInput) multisig(params *chaincfg.Params) ([]byte, []vhcutil.Address, error) {
+	script, err := hex.DecodeString(in.RedeemScript)
+	if err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	class, pubKeys, required, err := txscript.ExtractPkScriptAddrs(
+		txscript.DefaultScriptVersion, script, params)
+	if err != nil || class != txscript.MultiSigTy {
+		return nil, nil, errors.E(errors.Invalid, "redeem script is not multisig")
+	}
+	if required != in.Required {
+		return nil, nil, errors.E(errors.Invalid,
+			errors.Errorf("redeem script requires %d signatures, session "+
+				"describes %d", required, in.Required))
+	}
+	return script, pubKeys, nil
+}
+
+func (in *CosignInput) signed(pubKey string) bool {
+	for i := range in.Signatures {
+		if in.Signatures[i].PubKey == pubKey {
+			return true
+		}
+	}
+	return false
+}
+
+// Signatures returns the number of signatures collected for each input of the
+// session, limited to the number of required signatures.
+func (s *CosignSession) Signatures() []int {
+	n := make([]int, len(s.Inputs))
+	for i := range s.Inputs {
+		n[i] = len(s.Inputs[i].Signatures)
+		if n[i] > s.Inputs[i].Required {
+			n[i] = s.Inputs[i].Required
+		}
+	}
+	return n
+}
+
+// Complete returns whether every input of the session has the required number
+// of signatures.
+func (s *CosignSession) Complete() bool {
+	for i := range s.Inputs {
+		if len(s.Inputs[i].Signatures) < s.Inputs[i].Required {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge adds the signatures of another copy of the session, such as one signed
+// by a different cosigner, to s.
+func (s *CosignSession) Merge(other *CosignSession) error {
+	if other.ID != s.ID || other.Network != s.Network || other.Tx != s.Tx ||
+		len(other.Inputs) != len(s.Inputs) {
+		return errors.E(errors.Invalid, "cosigning sessions differ")
+	}
+	for i := range s.Inputs {
+		in, otherIn := &s.Inputs[i], &other.Inputs[i]
+		if otherIn.RedeemScript != in.RedeemScript || otherIn.Required != in.Required {
+			return errors.E(errors.Invalid,
+				errors.Errorf("cosigning sessions differ at input %d", i))
+		}
+		for _, sig := range otherIn.Signatures {
+			if !in.signed(sig.PubKey) {
+				in.Signatures = append(in.Signatures, sig)
+			}
+		}
+	}
+	return nil
+}
+
+// Finalize builds the signed transaction of a complete session.  Signatures are
+// ordered by the public keys of each redeem script, and every input is verified
+// before the transaction is returned.
+func (s *CosignSession) Finalize(params *chaincfg.Params) (*wire.MsgTx, error) {
+	const op errors.Op = "wallet.CosignSession.Finalize"
+
+	tx, err := s.tx(params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for i := range s.Inputs {
+		in := &s.Inputs[i]
+		script, pubKeys, err := in.multisig(params)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(in.Signatures) < in.Required {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("input %d has %d of %d required signatures",
+					i, len(in.Signatures), in.Required))
+		}
+
+		b := txscript.NewScriptBuilder()
+		signed := 0
+		for _, pk := range pubKeys {
+			pubKey := hex.EncodeToString(pk.ScriptAddress())
+			for _, sig := range in.Signatures {
+				if sig.PubKey != pubKey {
+					continue
+				}
+				sigBytes, err := hex.DecodeString(sig.Signature)
+				if err != nil {
+					return nil, errors.E(op, errors.Encoding, err)
+				}
+				b.AddData(sigBytes)
+				signed++
+				break
+			}
+			if signed == in.Required {
+				break
+			}
+		}
+		if signed < in.Required {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("input %d has signatures of keys outside "+
+					"the redeem script", i))
+		}
+		b.AddData(script)
+		tx.TxIn[i].SignatureScript, err = b.Script()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	for i := range s.Inputs {
+		script, _ := hex.DecodeString(s.Inputs[i].RedeemScript)
+		pkScript, err := txscript.PayToScriptHashScript(vhcutil.Hash160(script))
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		vm, err := txscript.NewEngine(pkScript, tx, i, sanityVerifyFlags,
+			txscript.DefaultScriptVersion, nil)
+		if err == nil {
+			err = vm.Execute()
+		}
+		if err != nil {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("input %d signatures are invalid: %v", i, err))
+		}
+	}
+	return tx, nil
+}
+
+// NewCosignSession begins a cosigning session for an unsigned transaction.
+// Every input of the transaction must spend a P2SH multisig output recorded by
+// the wallet.  The input amounts are set from the spent outputs and any
+// existing signature scripts are removed.
+func (w *Wallet) NewCosignSession(tx *wire.MsgTx) (*CosignSession, error) {
+	const op errors.Op = "wallet.NewCosignSession"
+
+	if len(tx.TxIn) == 0 {
+		return nil, errors.E(op, errors.Invalid, "transaction has no inputs")
+	}
+	tx = tx.Copy()
+	inputs := make([]CosignInput, len(tx.TxIn))
+	for i, in := range tx.TxIn {
+		out, err := w.FetchP2SHMultiSigOutput(&in.PreviousOutPoint)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if out.Redeemer != nil {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("output %v is already spent", &in.PreviousOutPoint))
+		}
+		in.ValueIn = int64(out.OutputAmount)
+		in.SignatureScript = nil
+		inputs[i] = CosignInput{
+			RedeemScript: hex.EncodeToString(out.RedeemScript),
+			Required:     int(out.M),
+		}
+	}
+	b, err := tx.Bytes()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var id [8]byte
+	_, err = rand.Read(id[:])
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &CosignSession{
+		ID:      hex.EncodeToString(id[:]),
+		Network: w.chainParams.Name,
+		Tx:      hex.EncodeToString(b),
+		Inputs:  inputs,
+	}, nil
+}
+
+// SignCosignSession adds signatures of every input of a cosigning session by
+// the keys of the redeem scripts which belong to the wallet, returning the
+// number of signatures added.  Inputs with the required number of signatures
+// are not signed again.  Sessions with no inputs signable by the wallet return
+// an errors.NotExist error.
+func (w *Wallet) SignCosignSession(s *CosignSession) (int, error) {
+	const op errors.Op = "wallet.SignCosignSession"
+
+	tx, err := s.tx(w.chainParams)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	var owned, added int
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for i := range s.Inputs {
+			in := &s.Inputs[i]
+			script, pubKeys, err := in.multisig(w.chainParams)
+			if err != nil {
+				return errors.Errorf("input %d: %v", i, err)
+			}
+			for _, pk := range pubKeys {
+				pk, ok := pk.(*vhcutil.AddressSecpPubKey)
+				if !ok {
+					continue
+				}
+				key, done, err := w.Manager.PrivateKey(addrmgrNs, pk.AddressPubKeyHash())
+				if errors.Is(errors.NotExist, err) || errors.Is(errors.WatchingOnly, err) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				owned++
+				pubKey := hex.EncodeToString(pk.ScriptAddress())
+				if in.signed(pubKey) || len(in.Signatures) >= in.Required {
+					done()
+					continue
+				}
+				sig, err := txscript.RawTxInSignature(tx, i, script,
+					txscript.SigHashAll, key)
+				done()
+				if err != nil {
+					return err
+				}
+				in.Signatures = append(in.Signatures, CosignSignature{
+					PubKey:    pubKey,
+					Signature: hex.EncodeToString(sig),
+				})
+				added++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if owned == 0 {
+		return 0, errors.E(op, errors.NotExist, "wallet holds no keys of the cosigning session")
+	}
+	return added, nil
+}
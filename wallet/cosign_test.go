@@ -0,0 +1,140 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+func TestCosignSession(t *testing.T) {
+	cfg1, cfg2 := basicWalletConfig, basicWalletConfig
+	w1, teardown1 := testWallet(t, &cfg1)
+	defer teardown1()
+	w2, teardown2 := testWallet(t, &cfg2)
+	defer teardown2()
+
+	pubKey := func(w *Wallet) *vhcutil.AddressSecpPubKey {
+		addr, err := w.NewExternalAddress(udb.DefaultAccountNum)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := w.AddressInfo(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		serialized := info.(udb.ManagedPubKeyAddress).PubKey().Serialize()
+		pk, err := vhcutil.NewAddressSecpPubKey(serialized, w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pk
+	}
+	pk1, pk2 := pubKey(w1), pubKey(w2)
+	for _, w := range []*Wallet{w1, w2} {
+		err := w.Unlock([]byte("private"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	newSession := func(required int, pubKeys ...*vhcutil.AddressSecpPubKey) *CosignSession {
+		script, err := txscript.MultiSigScript(pubKeys, required)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := wire.NewMsgTx()
+		prevOut := wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular)
+		tx.AddTxIn(wire.NewTxIn(prevOut, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8-1e5, []byte{txscript.OP_TRUE}))
+		b, err := tx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &CosignSession{
+			ID:      "test",
+			Network: w1.chainParams.Name,
+			Tx:      hex.EncodeToString(b),
+			Inputs: []CosignInput{{
+				RedeemScript: hex.EncodeToString(script),
+				Required:     required,
+			}},
+		}
+	}
+	copySession := func(s *CosignSession) *CosignSession {
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := new(CosignSession)
+		err = json.Unmarshal(b, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	sign := func(w *Wallet, s *CosignSession, want int) {
+		added, err := w.SignCosignSession(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if added != want {
+			t.Errorf("added %d signatures, want %d", added, want)
+		}
+	}
+
+	s1 := newSession(2, pk1, pk2)
+	s2 := copySession(s1)
+	if _, err := s1.Finalize(w1.chainParams); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("finalize unsigned session: expected Invalid error, got %v", err)
+	}
+
+	// Each cosigner signs its own copy of the session, which are then
+	// merged and finalized.
+	sign(w1, s1, 1)
+	sign(w1, s1, 0)
+	sign(w2, s2, 1)
+	if s1.Complete() {
+		t.Fatal("session signed by one of two cosigners is complete")
+	}
+	err := s1.Merge(s2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s1.Complete() {
+		t.Fatal("merged session is not complete")
+	}
+	if _, err := s1.Finalize(w1.chainParams); err != nil {
+		t.Fatalf("finalize: %v", err)
+	}
+
+	// Signing in turn produces the same result.
+	s3 := newSession(2, pk2, pk1)
+	sign(w2, s3, 1)
+	sign(w1, s3, 1)
+	if _, err := s3.Finalize(w2.chainParams); err != nil {
+		t.Fatalf("finalize: %v", err)
+	}
+
+	other := copySession(s2)
+	other.ID = "other"
+	if err := s1.Merge(other); !errors.Is(errors.Invalid, err) {
+		t.Errorf("merge different session: expected Invalid error, got %v", err)
+	}
+
+	// Wallets holding no keys of the redeem scripts can not sign.
+	_, err = w1.SignCosignSession(newSession(1, pk2))
+	if !errors.Is(errors.NotExist, err) {
+		t.Errorf("sign without keys: expected NotExist error, got %v", err)
+	}
+}
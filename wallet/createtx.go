@@ -8,6 +8,7 @@ package wallet
 import (
 	"context"
 	"encoding/binary"
+	"sort"
 	"time"
 
 	"github.com/valhallacoin/vhcd/blockchain"
@@ -88,6 +89,15 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb vhc
 	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource) (*txauthor.AuthoredTx, error) {
 
 	const op errors.Op = "wallet.NewUnsignedTransaction"
+	return w.newUnsignedTransaction(op, outputs, relayFeePerKb, account, minConf,
+		algo, changeSource, sendExcludedUTXOPolicies)
+}
+
+// newUnsignedTransaction implements NewUnsignedTransaction, excluding outputs
+// with any of the UTXO policy flags of exclude from input selection.
+func (w *Wallet) newUnsignedTransaction(op errors.Op, outputs []*wire.TxOut, relayFeePerKb vhcutil.Amount, account uint32,
+	minConf int32, algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource,
+	exclude udb.UTXOPolicy) (*txauthor.AuthoredTx, error) {
 
 	var authoredTx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
@@ -96,6 +106,10 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb vhc
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
 
+		if w.TxStore.SendApprovalRequired(txmgrNs, account) {
+			return errApprovalRequired(account)
+		}
+
 		if account != udb.ImportedAddrAccount {
 			lastAcct, err := w.Manager.LastAccount(addrmgrNs)
 			if err != nil {
@@ -107,7 +121,7 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb vhc
 		}
 
 		sourceImpl := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
-			minConf, tipHeight)
+			minConf, tipHeight, exclude)
 		var inputSource txauthor.InputSource
 		switch algo {
 		case OutputSelectionAlgorithmDefault:
@@ -207,6 +221,7 @@ func (w *Wallet) insertIntoTxMgr(ns walletdb.ReadWriteBucket, msgTx *wire.MsgTx)
 	if err != nil {
 		return nil, err
 	}
+	w.invalidateStakeInfo()
 	return rec, nil
 }
 
@@ -277,11 +292,16 @@ func (w *Wallet) checkHighFees(totalInput vhcutil.Amount, tx *wire.MsgTx) error
 	return nil
 }
 
+// txRecorder records additional details of a created transaction in the same
+// database transaction which records the transaction, before it is published.
+type txRecorder func(txmgrNs walletdb.ReadWriteBucket, txHash *chainhash.Hash) error
+
 // txToOutputs creates a transaction, selecting previous outputs from an account
 // with no less than minconf confirmations, and creates a signed transaction
-// that pays to each of the outputs.
+// that pays to each of the outputs.  A non-nil record is called to record
+// additional details of the transaction.
 func (w *Wallet) txToOutputs(op errors.Op, outputs []*wire.TxOut, account uint32,
-	minconf int32, randomizeChangeIdx bool) (*txauthor.AuthoredTx, error) {
+	minconf int32, randomizeChangeIdx bool, record txRecorder) (*txauthor.AuthoredTx, error) {
 
 	n, err := w.NetworkBackend()
 	if err != nil {
@@ -289,7 +309,7 @@ func (w *Wallet) txToOutputs(op errors.Op, outputs []*wire.TxOut, account uint32
 	}
 
 	return w.txToOutputsInternal(op, outputs, account, minconf, n,
-		randomizeChangeIdx, w.RelayFee())
+		randomizeChangeIdx, w.RelayFee(), sendExcludedUTXOPolicies, record)
 }
 
 // txToOutputsInternal creates a signed transaction which includes each output
@@ -298,14 +318,22 @@ func (w *Wallet) txToOutputs(op errors.Op, outputs []*wire.TxOut, account uint32
 // return change to the wallet.  An appropriate fee is included based on the
 // wallet's current relay fee.  The wallet must be unlocked to create the
 // transaction.  The address pool passed must be locked and engaged in an
-// address pool batch call.
+// address pool batch call.  The total output value is checked against and
+// recorded by the account's spending policy.  Outputs with any of the UTXO
+// policy flags of exclude are not selected as inputs.
+// When record is non-nil, it is called to record additional details of the
+// transaction together with the transaction record.
 //
 // Valhalla: This func also sends the transaction, and if successful, inserts it
 // into the database, rather than delegating this work to the caller as
 // btcwallet does.
 func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, account uint32, minconf int32,
-	n NetworkBackend, randomizeChangeIdx bool, txFee vhcutil.Amount) (*txauthor.AuthoredTx, error) {
+	n NetworkBackend, randomizeChangeIdx bool, txFee vhcutil.Amount,
+	exclude udb.UTXOPolicy, record txRecorder) (*txauthor.AuthoredTx, error) {
 
+	remote := w.remote()
+	var sel inputSelection
+	stage := SendStageSelection
 	var atx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
 	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
@@ -315,7 +343,7 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 		// Create the unsigned transaction.
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
 		inputSource := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
-			minconf, tipHeight)
+			minconf, tipHeight, exclude)
 		changeSource := &p2PKHChangeSource{
 			persist: w.deferPersistReturnedChild(&changeSourceUpdates),
 			account: account,
@@ -323,10 +351,11 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 		}
 		var err error
 		atx, err = txauthor.NewUnsignedTransaction(outputs, txFee,
-			inputSource.SelectInputs, changeSource)
+			sel.wrap(inputSource.SelectInputs), changeSource)
 		if err != nil {
 			return err
 		}
+		stage = SendStageSigning
 
 		// Randomize change position, if change exists, before signing.  This
 		// doesn't affect the serialize size, so the change amount will still be
@@ -335,7 +364,16 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 			atx.RandomizeChangePosition()
 		}
 
-		// Sign the transaction
+		// Sign the transaction, unless it is signed by the remote
+		// signer after the view.  Accounts whose keys are kept by the
+		// key store are signed by it.
+		if remote != nil {
+			return nil
+		}
+		if w.AccountKeyStorage(account) != KeyStorageLocal {
+			return w.signKeyStoreAll(addrmgrNs, atx.Tx, atx.PrevScripts,
+				txscript.SigHashAll)
+		}
 		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
 		err = atx.AddAllInputScripts(secrets)
 		for _, done := range secrets.doneFuncs {
@@ -344,13 +382,20 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 		return err
 	})
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, sendError(op, stage, &sel, err)
+	}
+	if remote != nil {
+		err = remote.SignTransaction(context.Background(), atx.Tx, atx.PrevScripts,
+			txscript.SigHashAll)
+		if err != nil {
+			return nil, sendError(op, SendStageSigning, &sel, err)
+		}
 	}
 
 	// Ensure valid signatures were created.
 	err = validateMsgTx(op, atx.Tx, atx.PrevScripts)
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, sendError(op, SendStageSigning, &sel, err)
 	}
 
 	// Warn when spending UTXOs controlled by imported keys created change for
@@ -363,19 +408,34 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 
 	err = w.checkHighFees(atx.TotalInput, atx.Tx)
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, sendError(op, SendStagePolicy, &sel, err)
+	}
+	var sent vhcutil.Amount
+	for _, output := range outputs {
+		sent += vhcutil.Amount(output.Value)
+	}
+	err = w.checkSpendGuard(sent)
+	if err != nil {
+		return nil, sendError(op, SendStagePolicy, &sel, err)
 	}
 
 	rec, err := udb.NewTxRecordFromMsgTx(atx.Tx, time.Now())
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, sendError(op, SendStageRecording, &sel, err)
 	}
 
 	// To avoid a race between publishing a transaction and potentially opening
 	// a database view during PublishTransaction, the update must be committed
 	// before publishing the transaction to the network.
 	var watch []wire.OutPoint
+	stage = SendStageRecording
 	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		err := w.recordPolicySpend(dbtx, account, sent)
+		if err != nil {
+			stage = SendStagePolicy
+			return err
+		}
+
 		for _, up := range changeSourceUpdates {
 			err := up(dbtx)
 			if err != nil {
@@ -385,16 +445,18 @@ func (w *Wallet) txToOutputsInternal(op errors.Op, outputs []*wire.TxOut, accoun
 
 		// TODO: this can be improved by not using the same codepath as notified
 		// relevant transactions, since this does a lot of extra work.
-		var err error
 		watch, err = w.processTransactionRecord(dbtx, rec, nil, nil)
-		return err
+		if err != nil || record == nil {
+			return err
+		}
+		return record(dbtx.ReadWriteBucket(wtxmgrNamespaceKey), &rec.Hash)
 	})
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, sendError(op, stage, &sel, err)
 	}
 	err = n.PublishTransactions(context.TODO(), atx.Tx)
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, sendError(op, SendStagePublish, &sel, err)
 	}
 
 	// Watch for future relevant transactions.
@@ -446,6 +508,10 @@ func (w *Wallet) txToMultisigInternal(op errors.Op, dbtx walletdb.ReadWriteTx, a
 		return nil, nil, nil, err
 	}
 
+	if w.TxStore.SendApprovalRequired(txmgrNs, account) {
+		return txToMultisigError(errors.E(op, errApprovalRequired(account)))
+	}
+
 	n, err := w.NetworkBackend()
 	if err != nil {
 		return txToMultisigError(err)
@@ -556,6 +622,14 @@ func (w *Wallet) txToMultisigInternal(op errors.Op, dbtx walletdb.ReadWriteTx, a
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
+	err = w.checkSpendGuard(amount)
+	if err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
+	err = w.recordPolicySpend(dbtx, account, amount)
+	if err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
 
 	err = n.PublishTransactions(context.TODO(), msgtx)
 	if err != nil {
@@ -614,13 +688,16 @@ func creditScripts(credits []udb.Credit) [][]byte {
 	return scripts
 }
 
-// compressWallet compresses all the utxos in a wallet into a single change
-// address. For use when it becomes dusty.
-func (w *Wallet) compressWallet(op errors.Op, maxNumIns int, account uint32, changeAddr vhcutil.Address) (*chainhash.Hash, error) {
+// compressWallet compresses the utxos of an account selected by opts into a
+// single change address. For use when it becomes dusty.  At least minNumIns
+// outputs must be eligible to be spent.
+func (w *Wallet) compressWallet(op errors.Op, minNumIns int, opts *ConsolidateOptions, account uint32,
+	changeAddr vhcutil.Address) (*chainhash.Hash, error) {
+
 	var hash *chainhash.Hash
 	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
 		var err error
-		hash, err = w.compressWalletInternal(op, dbtx, maxNumIns, account, changeAddr)
+		hash, err = w.compressWalletInternal(op, dbtx, minNumIns, opts, account, changeAddr)
 		return err
 	})
 	if err != nil {
@@ -629,8 +706,8 @@ func (w *Wallet) compressWallet(op errors.Op, maxNumIns int, account uint32, cha
 	return hash, nil
 }
 
-func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx, maxNumIns int, account uint32,
-	changeAddr vhcutil.Address) (*chainhash.Hash, error) {
+func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx, minNumIns int, opts *ConsolidateOptions,
+	account uint32, changeAddr vhcutil.Address) (*chainhash.Hash, error) {
 
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
@@ -649,9 +726,32 @@ func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx,
 		return nil, errors.E(op, err)
 	}
 
-	if len(eligible) <= 1 {
+	// Outputs already of the target size are not consolidated, and smaller
+	// outputs are consolidated first.
+	if opts.TargetSize > 0 {
+		small := eligible[:0]
+		for _, e := range eligible {
+			if e.Amount < opts.TargetSize {
+				small = append(small, e)
+			}
+		}
+		eligible = small
+		sort.SliceStable(eligible, func(i, j int) bool {
+			return eligible[i].Amount < eligible[j].Amount
+		})
+	}
+
+	if len(eligible) < minNumIns {
 		return nil, errors.E(op, "too few outputs to consolidate")
 	}
+	maxNumIns := opts.MaxInputs
+	if maxNumIns <= 0 {
+		maxNumIns = len(eligible)
+	}
+	feeRate := opts.FeeRate
+	if feeRate == 0 {
+		feeRate = w.RelayFee()
+	}
 
 	// Check if output address is default, and generate a new adress if needed
 	if changeAddr == nil {
@@ -680,8 +780,11 @@ func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx,
 		if count >= maxNumIns {
 			break
 		}
-		// Add the size of a wire.OutPoint
-		if msgtx.SerializeSize() > maximumTxSize {
+		// Stop before the signed transaction would exceed the maximum
+		// size.
+		szEst := txsizes.EstimateSerializeSize(
+			append(scriptSizes, txsizes.RedeemP2PKHSigScriptSize), msgtx.TxOut, 0)
+		if szEst > maximumTxSize {
 			break
 		}
 
@@ -691,14 +794,28 @@ func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx,
 		forSigning = append(forSigning, e)
 		scriptSizes = append(scriptSizes, txsizes.RedeemP2PKHSigScriptSize)
 		count++
+
+		// Stop once the consolidated output reaches the target size.
+		fee := txrules.FeeForSerializeSize(feeRate, szEst)
+		if opts.TargetSize > 0 && totalAdded-fee >= opts.TargetSize {
+			break
+		}
+	}
+	if count < minNumIns {
+		return nil, errors.E(op, "too few outputs to consolidate")
 	}
 
 	// Get an initial fee estimate based on the number of selected inputs
 	// and added outputs, with no change.
 	szEst := txsizes.EstimateSerializeSize(scriptSizes, msgtx.TxOut, 0)
-	feeEst := txrules.FeeForSerializeSize(w.RelayFee(), szEst)
+	feeEst := txrules.FeeForSerializeSize(feeRate, szEst)
+	value := totalAdded - feeEst
+	if value <= 0 || txrules.IsDustAmount(value, len(pkScript), feeRate) {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"consolidated value does not exceed the fee")
+	}
 
-	msgtx.TxOut[0].Value = int64(totalAdded - feeEst)
+	msgtx.TxOut[0].Value = int64(value)
 
 	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
 	if err != nil {
@@ -713,6 +830,10 @@ func (w *Wallet) compressWalletInternal(op errors.Op, dbtx walletdb.ReadWriteTx,
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	err = w.checkSpendGuard(value)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
 
 	err = n.PublishTransactions(context.TODO(), msgtx)
 	if err != nil {
@@ -1071,10 +1192,24 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 	if txFeeIncrement == 0 {
 		txFeeIncrement = w.RelayFee()
 	}
-	splitTx, err := w.txToOutputsInternal(op, splitOuts, account, req.minConf,
-		n, false, txFeeIncrement)
-	if err != nil {
-		return nil, err
+	var splitTx *txauthor.AuthoredTx
+	if req.unsigned != nil {
+		splitTx, err = w.newUnsignedTransaction(op, splitOuts, txFeeIncrement,
+			account, req.minConf, OutputSelectionAlgorithmDefault, nil,
+			ticketExcludedUTXOPolicies)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		// Change is appended after the split outputs, which tickets
+		// reference by their position in the transaction.
+		req.unsigned.SplitTx = splitTx.Tx
+		req.unsigned.TicketPrice = ticketPrice
+	} else {
+		splitTx, err = w.txToOutputsInternal(op, splitOuts, account, req.minConf,
+			n, false, txFeeIncrement, ticketExcludedUTXOPolicies, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// After tickets are created and published, watch for future
@@ -1146,18 +1281,28 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 		// to delegate voting to, just use an address from
 		// this wallet. Check the passed address from the
 		// request first, then check the ticket address
-		// stored from the configuation. Finally, generate
-		// an address.
+		// stored from the configuation, then derive an
+		// address from the cold voting extended public key.
+		// Finally, generate an address.
 		var addrVote, addrSubsidy vhcutil.Address
+		var coldVotingIndex uint32
+		coldVoting := false
 		err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
 			addrVote = req.ticketAddr
 			if addrVote == nil {
 				addrVote = w.ticketAddress
-				if addrVote == nil {
-					addrVote, err = addrFunc(op, w.persistReturnedChild(dbtx), req.account)
-					if err != nil {
-						return err
-					}
+			}
+			if addrVote == nil && w.coldVoting != nil {
+				addrVote, coldVotingIndex, err = w.nextColdVotingAddress(dbtx)
+				if err != nil {
+					return err
+				}
+				coldVoting = true
+			}
+			if addrVote == nil {
+				addrVote, err = addrFunc(op, w.persistReturnedChild(dbtx), req.account)
+				if err != nil {
+					return err
 				}
 			}
 
@@ -1174,6 +1319,22 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 		if err != nil {
 			return ticketHashes, err
 		}
+
+		// Record the child index which derived a cold voting address so
+		// the ticket can be matched to the key of the cold wallet.
+		if coldVoting {
+			err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+				txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+				return w.TxStore.PutColdVotingTicket(txmgrNs, &udb.ColdVotingTicket{
+					TicketHash: ticket.TxHash(),
+					XpubID:     w.coldVoting.id,
+					Index:      coldVotingIndex,
+				})
+			})
+			if err != nil {
+				return ticketHashes, errors.E(op, err)
+			}
+		}
 		var forSigning []udb.Credit
 		if eopPool != nil {
 			eopPoolCredit := udb.Credit{
@@ -1199,6 +1360,11 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 		// Set the expiry.
 		ticket.Expiry = uint32(req.expiry)
 
+		if req.unsigned != nil {
+			req.unsigned.Tickets = append(req.unsigned.Tickets, ticket)
+			continue
+		}
+
 		err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 			ns := tx.ReadBucket(waddrmgrNamespaceKey)
 			return w.signP2PKHMsgTx(ticket, forSigning, ns)
@@ -1215,6 +1381,10 @@ func (w *Wallet) purchaseTickets(op errors.Op, req purchaseTicketRequest) ([]*ch
 		if err != nil {
 			return ticketHashes, errors.E(op, err)
 		}
+		err = w.checkSpendGuard(vhcutil.Amount(ticket.TxOut[0].Value))
+		if err != nil {
+			return ticketHashes, errors.E(op, err)
+		}
 
 		rec, err := udb.NewTxRecordFromMsgTx(ticket, time.Now())
 		if err != nil {
@@ -1270,10 +1440,15 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 			continue
 		}
 
-		// Locked unspent outputs are skipped.
+		// Locked unspent outputs are skipped, as are outputs reserved
+		// for tickets or which must not be spent.
 		if w.LockedOutpoint(output.OutPoint) {
 			continue
 		}
+		policy := w.TxStore.UTXOPolicy(txmgrNs, &output.OutPoint)
+		if policy&(udb.UTXOPolicyDoNotSpend|udb.UTXOPolicyReserveForTickets) != 0 {
+			continue
+		}
 
 		// Filter out unspendable outputs, that is, remove those that
 		// (at this time) are not P2PKH outputs.  Other inputs must be
@@ -1362,10 +1537,15 @@ func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32,
 	for i := range unspent {
 		output := unspent[i]
 
-		// Locked unspent outputs are skipped.
+		// Locked unspent outputs are skipped, as are outputs excluded
+		// from sends by their UTXO policy.
 		if w.LockedOutpoint(output.OutPoint) {
 			continue
 		}
+		policy := w.TxStore.UTXOPolicy(txmgrNs, &output.OutPoint)
+		if policy&(udb.UTXOPolicyDoNotSpend|sendExcludedUTXOPolicies) != 0 {
+			continue
+		}
 
 		// Filter out unspendable outputs, that is, remove those that
 		// (at this time) are not P2PKH outputs.  Other inputs must be
@@ -1442,7 +1622,19 @@ func (w *Wallet) signP2PKHMsgTx(msgtx *wire.MsgTx, prevOutputs []udb.Credit, add
 
 // signVoteOrRevocation signs a vote or revocation, specified by the isVote
 // argument.  This signs the transaction by modifying tx's input scripts.
+//
+// Votes and revocations are signed by the remote signer of the wallet, if set,
+// or by the key store when the ticket's voting address belongs to an account
+// whose keys are kept by it.
 func (w *Wallet) signVoteOrRevocation(addrmgrNs walletdb.ReadBucket, ticketPurchase, tx *wire.MsgTx, isVote bool) error {
+	if r := w.remote(); r != nil {
+		return w.signVoteOrRevocationRemote(r, ticketPurchase, tx, isVote)
+	}
+	signed, err := w.signVoteOrRevocationKeyStore(addrmgrNs, ticketPurchase, tx, isVote)
+	if err != nil || signed {
+		return err
+	}
+
 	// Create a slice of functions to run after the retreived secrets are no
 	// longer needed.
 	doneFuncs := make([]func(), 0, len(tx.TxIn))
@@ -1496,6 +1688,54 @@ func (w *Wallet) signVoteOrRevocation(addrmgrNs walletdb.ReadBucket, ticketPurch
 	return nil
 }
 
+// signVoteOrRevocationRemote signs a vote or revocation with a remote signer,
+// validating the signature of the ticket input.
+func (w *Wallet) signVoteOrRevocationRemote(r Signer, ticketPurchase, tx *wire.MsgTx, isVote bool) error {
+	inputToSign := 0
+	if isVote {
+		inputToSign = 1
+	}
+	prevScripts := make([][]byte, len(tx.TxIn))
+	redeemTicketScript := ticketPurchase.TxOut[0].PkScript
+	prevScripts[inputToSign] = redeemTicketScript
+	err := r.SignTransaction(context.Background(), tx, prevScripts, txscript.SigHashAll)
+	if err != nil {
+		return err
+	}
+	if isVote {
+		tx.TxIn[0].SignatureScript = w.chainParams.StakeBaseSigScript
+	}
+	vm, err := txscript.NewEngine(redeemTicketScript, tx, inputToSign,
+		sanityVerifyFlags, txscript.DefaultScriptVersion, nil)
+	if err == nil {
+		err = vm.Execute()
+	}
+	if err != nil {
+		return errors.E(errors.ScriptFailure, err)
+	}
+	return nil
+}
+
+// signVoteOrRevocationKeyStore signs a vote or revocation with the key store
+// when the private key of the ticket's voting address is kept by it, returning
+// whether the transaction was signed.
+func (w *Wallet) signVoteOrRevocationKeyStore(addrmgrNs walletdb.ReadBucket, ticketPurchase, tx *wire.MsgTx, isVote bool) (bool, error) {
+	inputToSign := 0
+	if isVote {
+		inputToSign = 1
+	}
+	prevScripts := make([][]byte, len(tx.TxIn))
+	prevScripts[inputToSign] = ticketPurchase.TxOut[0].PkScript
+	signed, err := w.signKeyStore(addrmgrNs, tx, prevScripts, txscript.SigHashAll)
+	if err != nil || !signed[inputToSign] {
+		return false, err
+	}
+	if isVote {
+		tx.TxIn[0].SignatureScript = w.chainParams.StakeBaseSigScript
+	}
+	return true, nil
+}
+
 // signVote signs a vote transaction.  This modifies the input scripts pointed
 // to by the vote transaction.
 func (w *Wallet) signVote(addrmgrNs walletdb.ReadBucket, ticketPurchase, vote *wire.MsgTx) error {
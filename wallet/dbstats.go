@@ -0,0 +1,143 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// DatabaseStats describes the storage of the wallet database.
+type DatabaseStats struct {
+	// Path is the file path of the database.
+	Path string
+
+	// Size is the size of the database file in bytes.
+	Size int64
+
+	// FreeSpace is the number of bytes available to the wallet process on
+	// the volume containing the database.  It is only set when
+	// FreeSpaceKnown is true, as free space can not be queried on every
+	// platform.
+	FreeSpace      uint64
+	FreeSpaceKnown bool
+
+	// WriteErrors is the number of database write transactions which
+	// failed since the database was opened.
+	WriteErrors uint64
+}
+
+// DatabaseStats returns statistics describing the storage of the wallet
+// database, which may be used to warn operators before the volume containing
+// the database is exhausted.
+func (w *Wallet) DatabaseStats() (*DatabaseStats, error) {
+	const op errors.Op = "wallet.DatabaseStats"
+	db, ok := w.db.(walletdb.StatsDB)
+	if !ok {
+		return nil, errors.E(op, errors.Invalid, "database driver does not report statistics")
+	}
+	dbStats := db.Stats()
+	fi, err := os.Stat(dbStats.Path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	stats := &DatabaseStats{
+		Path:        dbStats.Path,
+		Size:        fi.Size(),
+		WriteErrors: dbStats.WriteErrors,
+	}
+	free, err := availableSpace(filepath.Dir(dbStats.Path))
+	if err == nil {
+		stats.FreeSpace = free
+		stats.FreeSpaceKnown = true
+	}
+	return stats, nil
+}
+
+// BucketStats describes the storage of a bucket of the wallet database.
+type BucketStats struct {
+	// Name is the path of the bucket, with the keys of the root bucket and
+	// any nested bucket separated by a slash.  Keys which are not printable
+	// are hex encoded.
+	Name string
+
+	walletdb.BucketStats
+}
+
+// bucketName returns the printable name of a bucket key.
+func bucketName(key []byte) string {
+	for _, c := range key {
+		if c < 0x21 || c > 0x7e || c == '/' {
+			return hex.EncodeToString(key)
+		}
+	}
+	return string(key)
+}
+
+// BucketStats returns storage statistics of every root bucket of the wallet
+// database and the buckets nested directly within them, ordered by name.
+func (w *Wallet) BucketStats() ([]BucketStats, error) {
+	const op errors.Op = "wallet.BucketStats"
+	var stats []BucketStats
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		return dbtx.ForEachBucket(func(key []byte) error {
+			root := dbtx.ReadBucket(key)
+			rootName := bucketName(key)
+			stats = append(stats, BucketStats{
+				Name:        rootName,
+				BucketStats: root.Stats(),
+			})
+			return root.ForEach(func(k, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				nested := root.NestedReadBucket(k)
+				stats = append(stats, BucketStats{
+					Name:        rootName + "/" + bucketName(k),
+					BucketStats: nested.Stats(),
+				})
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
+
+// ConsistencyCheck describes the database consistency check performed when
+// the wallet was opened.
+type ConsistencyCheck struct {
+	udb.CheckStats
+
+	// Duration is the time taken by the check.
+	Duration time.Duration
+}
+
+func (c *ConsistencyCheck) log() {
+	if !c.Full {
+		log.Infof("Quick database check passed (tip %v, height %d) in %v",
+			&c.TipHash, c.TipHeight, c.Duration.Round(time.Millisecond))
+		return
+	}
+	log.Infof("Full database check passed (%d blocks, %d transactions, "+
+		"%d credits, %d accounts) in %v", c.Blocks, c.Transactions,
+		c.Credits, c.Accounts, c.Duration.Round(time.Millisecond))
+}
+
+// ConsistencyCheck returns the result of the database consistency check
+// performed when the wallet was opened.
+func (w *Wallet) ConsistencyCheck() ConsistencyCheck {
+	return w.consistencyCheck
+}
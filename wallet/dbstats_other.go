@@ -0,0 +1,16 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !darwin && !dragonfly && !freebsd && !linux
+// +build !darwin,!dragonfly,!freebsd,!linux
+
+package wallet
+
+import "github.com/valhallacoin/vhcwallet/errors"
+
+// availableSpace returns the number of bytes available to unprivileged users
+// on the volume containing path.  It is not implemented on this platform.
+func availableSpace(path string) (uint64, error) {
+	return 0, errors.E(errors.Invalid, "free space is not available on this platform")
+}
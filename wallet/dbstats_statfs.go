@@ -0,0 +1,21 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build darwin || dragonfly || freebsd || linux
+// +build darwin dragonfly freebsd linux
+
+package wallet
+
+import "syscall"
+
+// availableSpace returns the number of bytes available to unprivileged users
+// on the volume containing path.
+func availableSpace(path string) (uint64, error) {
+	var st syscall.Statfs_t
+	err := syscall.Statfs(path, &st)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(st.Bavail) * uint64(st.Bsize), nil
+}
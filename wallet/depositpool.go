@@ -0,0 +1,201 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// FillDepositPool reserves external addresses of an account for deposits until
+// the account has size addresses which have not yet been assigned, and returns
+// the newly reserved addresses in the order they were derived.  Deposit
+// address notifications are sent for each new address.
+//
+// Reserved addresses must be found when restoring the wallet from seed, so
+// they are derived with the error gap policy and size may not exceed the gap
+// limit of the wallet.  Addresses within the gap limit are already watched
+// for deposits.  All addresses are derived before any are recorded, and are
+// recorded under a single database update.
+func (w *Wallet) FillDepositPool(account uint32, size int) ([]*udb.DepositAddress, error) {
+	const op errors.Op = "wallet.FillDepositPool"
+	if size < 0 || size > w.gapLimit {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("deposit pool size must be between 0 and the gap limit %d", w.gapLimit))
+	}
+	if account == udb.ImportedAddrAccount {
+		return nil, errors.E(op, errors.Invalid, "addresses can not be reserved for the imported account")
+	}
+
+	available := 0
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		reserved, err := w.Manager.DepositAddresses(ns, account)
+		if err != nil {
+			return err
+		}
+		for _, a := range reserved {
+			if a.Status == udb.DepositAddressAvailable {
+				available++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if available >= size {
+		return nil, nil
+	}
+	need := size - available
+
+	// Ensure every address fits within the gap limit before deriving any, as
+	// derivation advances the cursor of the branch.
+	w.addressBuffersMu.Lock()
+	ad, ok := w.addressBuffers[account]
+	var cursor uint32
+	if ok {
+		cursor = ad.albExternal.cursor
+	}
+	w.addressBuffersMu.Unlock()
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
+	}
+	if int(cursor)+need > w.gapLimit {
+		return nil, errors.E(op, errors.Policy, errors.Errorf("reserving %d "+
+			"addresses violates the unused address gap limit policy "+
+			"(%d unused addresses already returned)", need, cursor))
+	}
+
+	now := time.Now()
+	added := make([]*udb.DepositAddress, 0, need)
+	var updates []func(walletdb.ReadWriteTx) error
+	for i := 0; i < need; i++ {
+		var child uint32
+		persist := w.deferPersistReturnedChild(&updates)
+		persistChild := func(account, branch, c uint32) error {
+			child = c
+			return persist(account, branch, c)
+		}
+		addr, err := w.nextAddress(op, persistChild, account, udb.ExternalBranch,
+			WithGapPolicyError())
+		if err != nil {
+			w.restoreDepositCursor(account, cursor, len(added))
+			return nil, err
+		}
+		added = append(added, &udb.DepositAddress{
+			Account: account,
+			Child:   child,
+			Address: addr.EncodeAddress(),
+			Status:  udb.DepositAddressAvailable,
+			Created: now,
+		})
+	}
+
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for _, up := range updates {
+			err := up(dbtx)
+			if err != nil {
+				return err
+			}
+		}
+		for _, a := range added {
+			err := w.Manager.PutDepositAddress(ns, a)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		w.restoreDepositCursor(account, cursor, len(added))
+		return nil, errors.E(op, err)
+	}
+
+	w.NtfnServer.notifyDepositAddresses(added)
+	return added, nil
+}
+
+// restoreDepositCursor rewinds the external branch cursor of an account after
+// n derived deposit addresses could not be recorded, unless other addresses
+// were derived concurrently.
+func (w *Wallet) restoreDepositCursor(account, cursor uint32, n int) {
+	w.addressBuffersMu.Lock()
+	defer w.addressBuffersMu.Unlock()
+	ad, ok := w.addressBuffers[account]
+	if ok && ad.albExternal.cursor == cursor+uint32(n) {
+		ad.albExternal.cursor = cursor
+	}
+}
+
+// AssignDepositAddress assigns the oldest available reserved deposit address of
+// an account to a reference, such as a customer identifier, and returns it.
+// An error with kind errors.NotExist is returned if the account has no
+// available reserved addresses.
+func (w *Wallet) AssignDepositAddress(account uint32, reference string) (*udb.DepositAddress, error) {
+	const op errors.Op = "wallet.AssignDepositAddress"
+	var assigned *udb.DepositAddress
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		reserved, err := w.Manager.DepositAddresses(ns, account)
+		if err != nil {
+			return err
+		}
+		for _, a := range reserved {
+			if a.Status != udb.DepositAddressAvailable {
+				continue
+			}
+			a.Status = udb.DepositAddressAssigned
+			a.Assigned = time.Now()
+			a.Reference = reference
+			assigned = a
+			return w.Manager.PutDepositAddress(ns, a)
+		}
+		return errors.E(errors.NotExist, errors.Errorf("no available deposit "+
+			"addresses are reserved for account %d", account))
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return assigned, nil
+}
+
+// DepositAddresses returns the reserved deposit addresses of an account in the
+// order they were created.
+func (w *Wallet) DepositAddresses(account uint32) ([]*udb.DepositAddress, error) {
+	const op errors.Op = "wallet.DepositAddresses"
+	var addrs []*udb.DepositAddress
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		addrs, err = w.Manager.DepositAddresses(ns, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return addrs, nil
+}
+
+// AllDepositAddresses returns the reserved deposit addresses of every account,
+// ordered by account and then by creation.
+func (w *Wallet) AllDepositAddresses() ([]*udb.DepositAddress, error) {
+	const op errors.Op = "wallet.AllDepositAddresses"
+	var addrs []*udb.DepositAddress
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		addrs, err = w.Manager.AllDepositAddresses(ns)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return addrs, nil
+}
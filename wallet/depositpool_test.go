@@ -0,0 +1,95 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+func TestFillDepositPool(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	nextChild := func() uint32 {
+		t.Helper()
+		addr, err := w.NewExternalAddress(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := w.AddressInfo(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return info.(udb.ManagedPubKeyAddress).Index()
+	}
+
+	// Pools may not exceed the gap limit.
+	_, err := w.FillDepositPool(0, cfg.GapLimit+1)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("pool larger than gap limit: got error %v", err)
+	}
+
+	added, err := w.FillDepositPool(0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 5 {
+		t.Fatalf("reserved %d addresses, expected 5", len(added))
+	}
+	for i, a := range added {
+		if a.Child != uint32(i) || a.Status != udb.DepositAddressAvailable {
+			t.Fatalf("unexpected reserved address %d: %+v", i, a)
+		}
+	}
+
+	// Full pools are not refilled until an address is assigned.
+	added, err = w.FillDepositPool(0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 0 {
+		t.Fatalf("full pool reserved %d more addresses", len(added))
+	}
+	assigned, err := w.AssignDepositAddress(0, "customer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assigned.Child != 0 || assigned.Reference != "customer" {
+		t.Fatalf("unexpected assigned address %+v", assigned)
+	}
+	added, err = w.FillDepositPool(0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 1 || added[0].Child != 5 {
+		t.Fatalf("unexpected refill %+v", added)
+	}
+
+	// Reserved addresses are not returned by the next address methods.
+	if child := nextChild(); child != 6 {
+		t.Fatalf("next address has child %d, expected 6", child)
+	}
+
+	// Filling the pool beyond the gap limit reserves no addresses and does
+	// not skip any children.
+	_, err = w.FillDepositPool(0, cfg.GapLimit)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("filling beyond gap limit: got error %v", err)
+	}
+	addrs, err := w.DepositAddresses(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 6 {
+		t.Fatalf("%d addresses reserved after failed fill, expected 6", len(addrs))
+	}
+	if child := nextChild(); child != 7 {
+		t.Fatalf("next address has child %d after failed fill, expected 7", child)
+	}
+}
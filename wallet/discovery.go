@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
 	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcd/gcs/blockcf"
@@ -102,6 +103,94 @@ type scriptPath struct {
 	account, branch, index uint32
 }
 
+// discoveryProbes is the number of gap limit segments of each account branch
+// which are searched for address usage by each query of the backend.  Probing
+// several segments at once divides the remaining range of each search into
+// more parts than a binary search, reducing the number of sequential passes
+// over every block filter or round trips to the vhcd address index.
+const discoveryProbes = 4
+
+// probeSegments returns the segments in the range [lo, hi] which are searched
+// for address usage by a single query.  Up to n segments evenly dividing the
+// range are returned, or every segment when the range contains no more than n
+// segments.  With n=1, this is the midpoint of a binary search.
+func probeSegments(lo, hi, n uint32) []uint32 {
+	if hi-lo < n {
+		probes := make([]uint32, 0, hi-lo+1)
+		for seg := lo; seg <= hi; seg++ {
+			probes = append(probes, seg)
+		}
+		return probes
+	}
+	probes := make([]uint32, n)
+	span := uint64(hi - lo)
+	for i := range probes {
+		probes[i] = lo + uint32(span*uint64(i+1)/uint64(n+1))
+	}
+	return probes
+}
+
+// narrowSegments narrows the range [lo, hi] of segments which may contain the
+// last used address of a branch after the probed segments were searched.  The
+// range is narrowed to the segments following the last probed segment with
+// usage, or preceding the first probed segment when no usage was found.  hi
+// may underflow and is set to lo-1 when the search is finished.
+func narrowSegments(lo, hi *uint32, probes []uint32, lastUsed, gapLimit uint32) {
+	if lastUsed != ^uint32(0) {
+		used := lastUsed / gapLimit
+		for i, seg := range probes {
+			if seg != used {
+				continue
+			}
+			*lo = seg + 1
+			if i+1 < len(probes) {
+				*hi = probes[i+1] - 1
+			}
+			return
+		}
+	}
+	*hi = probes[0] - 1
+}
+
+// branchSearch describes the search of a single account branch during an
+// iteration of address discovery.
+type branchSearch struct {
+	key          *hd.ExtendedKey
+	acct, branch uint32
+	lo, hi       *uint32
+	lastUsed     *uint32
+	probes       []uint32
+	scripts      [][]byte
+	paths        []scriptPath
+}
+
+// derive derives the output scripts of the addresses in the probed segments.
+func (s *branchSearch) derive(gapLimit uint32, params *chaincfg.Params) error {
+	s.scripts = s.scripts[:0]
+	s.paths = s.paths[:0]
+	for _, seg := range s.probes {
+		begin := seg * gapLimit
+		addrs, err := deriveChildAddresses(s.key, begin, gapLimit, params)
+		if err != nil {
+			return err
+		}
+		for i, addr := range addrs {
+			scr, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				log.Errorf("PayToAddrScript(%v): %v", addr, err)
+				continue
+			}
+			s.scripts = append(s.scripts, scr)
+			s.paths = append(s.paths, scriptPath{
+				account: s.acct,
+				branch:  s.branch,
+				index:   begin + uint32(i),
+			})
+		}
+	}
+	return nil
+}
+
 type addrFinder struct {
 	w           *Wallet
 	gaplimit    uint32
@@ -179,50 +268,60 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 		return err
 	}
 
+	searches := make([]*branchSearch, 0, 2*len(a.usage))
+	for i := range a.usage {
+		u := &a.usage[i]
+		searches = append(searches, &branchSearch{
+			key:      u.extkey,
+			acct:     uint32(i),
+			branch:   0,
+			lo:       &u.extlo,
+			hi:       &u.exthi,
+			lastUsed: &u.extLastUsed,
+		}, &branchSearch{
+			key:      u.intkey,
+			acct:     uint32(i),
+			branch:   1,
+			lo:       &u.intlo,
+			hi:       &u.inthi,
+			lastUsed: &u.intLastUsed,
+		})
+	}
+
 	for {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
 
-		// Derive one bsearch iteration of filter data for all branches.
-		// Map address scripts to their HD path.
+		// Derive the addresses of the probed segments of every unfinished
+		// branch concurrently, and batch the scripts of all branches into
+		// a single pass over the block filters.  Map address scripts to
+		// their HD path.
+		var g errgroup.Group
+		for _, s := range searches {
+			s := s
+			s.probes = nil
+			if *s.lo > *s.hi || *s.hi >= a.segments { // Terminating condition
+				continue
+			}
+			s.probes = probeSegments(*s.lo, *s.hi, discoveryProbes)
+			g.Go(func() error {
+				return s.derive(a.gaplimit, a.w.chainParams)
+			})
+		}
+		err := g.Wait()
+		if err != nil {
+			return err
+		}
 		var data [][]byte
 		scrPaths := make(map[string]scriptPath)
-		addBranch := func(branchPub *hd.ExtendedKey, acct, branch, lo, hi uint32) error {
-			if lo > hi || hi >= a.segments { // Terminating condition
-				return nil
-			}
-			mid := (hi + lo) / 2
-			begin := mid * a.gaplimit
-			addrs, err := deriveChildAddresses(branchPub, begin, a.gaplimit, a.w.chainParams)
-			if err != nil {
-				return err
+		for _, s := range searches {
+			if len(s.probes) == 0 {
+				continue
 			}
-			for i, addr := range addrs {
-				scr, err := txscript.PayToAddrScript(addr)
-				if err != nil {
-					log.Errorf("PayToAddrScript(%v): %v", addr, err)
-					continue
-				}
+			for i, scr := range s.scripts {
 				data = append(data, scr)
-				scrPaths[string(scr)] = scriptPath{
-					account: acct,
-					branch:  branch,
-					index:   mid*a.gaplimit + uint32(i),
-				}
-			}
-			return nil
-		}
-		for i := range a.usage {
-			acct := uint32(i)
-			u := &a.usage[i]
-			err = addBranch(u.extkey, acct, 0, u.extlo, u.exthi)
-			if err != nil {
-				return err
-			}
-			err = addBranch(u.intkey, acct, 1, u.intlo, u.inthi)
-			if err != nil {
-				return err
+				scrPaths[string(scr)] = s.paths[i]
 			}
 		}
 
@@ -231,7 +330,7 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 		}
 
 		// Record committed scripts of matching filters.
-		err := a.filter(ctx, fs, data, p)
+		err = a.filter(ctx, fs, data, p)
 		if err != nil {
 			return err
 		}
@@ -269,30 +368,10 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, p Peer) er
 		}
 		wg.Wait()
 
-		// Update hi/lo segments for next bisect iteration
-		for i := range a.usage {
-			u := &a.usage[i]
-			if u.extlo <= u.exthi {
-				mid := (u.exthi + u.extlo) / 2
-				// When the last used index is in this segment's index half open
-				// range [begin,end) then an address was found in this segment.
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
-				if u.extLastUsed >= begin && u.extLastUsed < end {
-					u.extlo = mid + 1
-				} else {
-					u.exthi = mid - 1
-				}
-			}
-			if u.intlo <= u.inthi {
-				mid := (u.inthi + u.intlo) / 2
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
-				if u.intLastUsed >= begin && u.intLastUsed < end {
-					u.intlo = mid + 1
-				} else {
-					u.inthi = mid - 1
-				}
+		// Update hi/lo segments for the next search iteration.
+		for _, s := range searches {
+			if len(s.probes) != 0 {
+				narrowSegments(s.lo, s.hi, s.probes, *s.lastUsed, a.gaplimit)
 			}
 		}
 	}
@@ -421,57 +500,67 @@ func (w *Wallet) findLastUsedAccount(ctx context.Context, p Peer, blockCache blo
 		addrScripts  = make([][]byte, 0, acctGapLimit*gapLimit*2*2)
 	)
 
+	// Blocks mined before the wallet birthday can not contain transactions
+	// of any account and their filters are not matched.
+	var startBlock *chainhash.Hash
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		startBlock, err = w.birthdayRescanPoint(dbtx, w.chainParams.GenesisHash, 0)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if startBlock == nil {
+		return 0, nil
+	}
+
 	lastUsedInRange := func(begin, end uint32) (uint32, error) { // [begin,end)
 		addrScripts = addrScripts[:0]
 		addrScriptAccts := make(map[string]uint32)
 		if end >= hd.HardenedKeyStart {
 			end = hd.HardenedKeyStart - 1
 		}
+		// Account keys are derived from the hardened coin type key, while the
+		// addresses of every account branch are derived concurrently.
+		searches := make([]*branchSearch, 0, 2*(end-begin))
 		for acct := begin; acct < end; acct++ {
 			xpriv, err := coinTypeXpriv.Child(hd.HardenedKeyStart + acct)
 			if err != nil {
 				return 0, err
 			}
 			xpub, err := xpriv.Neuter()
-			if err != nil {
-				xpriv.Zero()
-				return 0, err
-			}
-			extKey, intKey, err := deriveBranches(xpub)
-			if err != nil {
-				xpriv.Zero()
-				return 0, err
-			}
-			addrs, err := deriveChildAddresses(extKey, 0, gapLimit, w.chainParams)
 			xpriv.Zero()
 			if err != nil {
 				return 0, err
 			}
-			for _, a := range addrs {
-				script, err := txscript.PayToAddrScript(a)
-				if err != nil {
-					log.Warnf("Failed to create output script for address %v: %v", a, err)
-					continue
-				}
-				addrScriptAccts[string(script)] = acct
-				addrScripts = append(addrScripts, script)
-			}
-			addrs, err = deriveChildAddresses(intKey, 0, gapLimit, w.chainParams)
+			extKey, intKey, err := deriveBranches(xpub)
 			if err != nil {
 				return 0, err
 			}
-			for _, a := range addrs {
-				script, err := txscript.PayToAddrScript(a)
-				if err != nil {
-					log.Warnf("Failed to create output script for address %v: %v", a, err)
-					continue
-				}
-				addrScriptAccts[string(script)] = acct
+			searches = append(searches,
+				&branchSearch{key: extKey, acct: acct, branch: 0, probes: []uint32{0}},
+				&branchSearch{key: intKey, acct: acct, branch: 1, probes: []uint32{0}})
+		}
+		var g errgroup.Group
+		for _, s := range searches {
+			s := s
+			g.Go(func() error {
+				return s.derive(gapLimit, w.chainParams)
+			})
+		}
+		err := g.Wait()
+		if err != nil {
+			return 0, err
+		}
+		for _, s := range searches {
+			for _, script := range s.scripts {
+				addrScriptAccts[string(script)] = s.acct
 				addrScripts = append(addrScripts, script)
 			}
 		}
 
-		searchBlocks, err := w.filterBlocks(ctx, w.chainParams.GenesisHash, addrScripts)
+		searchBlocks, err := w.filterBlocks(ctx, startBlock, addrScripts)
 		if err != nil {
 			return 0, err
 		}
@@ -674,12 +763,21 @@ func (f *existsAddrIndexFinder) findLastUsedAddress(xpub *hd.ExtendedKey) (uint3
 		segments        = hd.HardenedKeyStart / scanLen
 		lo, hi   uint32 = 0, segments - 1
 	)
-Bsearch:
-	for lo <= hi {
-		mid := (hi + lo) / 2
-		addrs, err := deriveChildAddresses(xpub, mid*scanLen, scanLen, f.wallet.chainParams)
-		if err != nil {
-			return 0, err
+	for lo <= hi && hi < segments {
+		// Query the usage of the addresses of every probed segment with a
+		// single request.
+		probes := probeSegments(lo, hi, discoveryProbes)
+		var addrs []vhcutil.Address
+		var indexes []uint32
+		for _, seg := range probes {
+			segAddrs, err := deriveChildAddresses(xpub, seg*scanLen, scanLen, f.wallet.chainParams)
+			if err != nil {
+				return 0, err
+			}
+			for i := range segAddrs {
+				indexes = append(indexes, seg*scanLen+uint32(i))
+			}
+			addrs = append(addrs, segAddrs...)
 		}
 		existsBits, err := f.addressesUsed(addrs)
 		if err != nil {
@@ -687,15 +785,11 @@ Bsearch:
 		}
 		for i := len(addrs) - 1; i >= 0; i-- {
 			if existsBits.Get(i) {
-				lastUsed = mid*scanLen + uint32(i)
-				lo = mid + 1
-				continue Bsearch
+				lastUsed = indexes[i]
+				break
 			}
 		}
-		if mid == 0 {
-			break
-		}
-		hi = mid - 1
+		narrowSegments(&lo, &hi, probes, lastUsed, scanLen)
 	}
 	return lastUsed, nil
 }
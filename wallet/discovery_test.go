@@ -0,0 +1,74 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	hd "github.com/valhallacoin/vhcd/hdkeychain"
+)
+
+func TestProbeSegments(t *testing.T) {
+	tests := []struct {
+		lo, hi, n uint32
+		probes    []uint32
+	}{
+		{0, 10, 1, []uint32{5}},
+		{3, 4, 1, []uint32{3}},
+		{0, 2, 4, []uint32{0, 1, 2}},
+		{0, 4, 4, []uint32{0, 1, 2, 3}},
+		{0, 99, 4, []uint32{19, 39, 59, 79}},
+	}
+	for i, test := range tests {
+		probes := probeSegments(test.lo, test.hi, test.n)
+		if len(probes) != len(test.probes) {
+			t.Errorf("test %d: probes %v, want %v", i, probes, test.probes)
+			continue
+		}
+		for j := range probes {
+			if probes[j] != test.probes[j] {
+				t.Errorf("test %d: probes %v, want %v", i, probes, test.probes)
+				break
+			}
+		}
+	}
+}
+
+// TestSegmentSearch simulates the search for the last used address of a
+// branch where every segment through the segment of the last used address
+// contains usage.
+func TestSegmentSearch(t *testing.T) {
+	const gapLimit = 20
+	const segments = hd.HardenedKeyStart / gapLimit
+	for _, n := range []uint32{1, discoveryProbes} {
+		for _, want := range []uint32{^uint32(0), 0, 19, 20, 1000, 123456, segments*gapLimit - 1} {
+			lastUsed := ^uint32(0)
+			var lo, hi uint32 = 0, segments - 1
+			var queries int
+			for lo <= hi && hi < segments {
+				probes := probeSegments(lo, hi, n)
+				for _, seg := range probes {
+					if want != ^uint32(0) && seg <= want/gapLimit {
+						lastUsed = seg*gapLimit + gapLimit - 1
+						if seg == want/gapLimit {
+							lastUsed = want
+						}
+					}
+				}
+				narrowSegments(&lo, &hi, probes, lastUsed, gapLimit)
+				queries++
+				if queries > 64 {
+					t.Fatalf("n=%d want=%d: search did not terminate", n, want)
+				}
+			}
+			if lastUsed != want {
+				t.Errorf("n=%d: found last used %d, want %d", n, lastUsed, want)
+			}
+			if n == discoveryProbes && queries > 14 {
+				t.Errorf("n=%d want=%d: %d queries", n, want, queries)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/vhcutil"
+)
+
+// SpendGuard is consulted before the wallet is unlocked and before it
+// publishes transactions spending its funds, regardless of the RPC server or
+// API which requested the operation.
+type SpendGuard interface {
+	// CheckUnlock returns an error when the wallet may not be unlocked.
+	CheckUnlock() error
+
+	// CheckSpend returns an error when the wallet may not publish a
+	// transaction spending amount.  The wallet is locked after a refused
+	// spend.
+	CheckSpend(amount vhcutil.Amount) error
+}
+
+// SetSpendGuard sets the guard consulted before the wallet is unlocked and
+// before it publishes transactions.  A nil guard removes the checks.
+func (w *Wallet) SetSpendGuard(g SpendGuard) {
+	w.spendGuardMu.Lock()
+	w.spendGuard = g
+	w.spendGuardMu.Unlock()
+}
+
+// SpendGuard returns the guard of the wallet, or nil when none is set.
+func (w *Wallet) SpendGuard() SpendGuard {
+	w.spendGuardMu.Lock()
+	defer w.spendGuardMu.Unlock()
+	return w.spendGuard
+}
+
+// checkSpendGuard checks that a transaction spending amount may be published.
+// The wallet is locked when the spend is refused.  Callers may hold an unlock
+// of the wallet, so the lock is requested without waiting for its release.
+func (w *Wallet) checkSpendGuard(amount vhcutil.Amount) error {
+	g := w.SpendGuard()
+	if g == nil {
+		return nil
+	}
+	err := g.CheckSpend(amount)
+	if err != nil {
+		go w.Lock()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// capGuard refuses spends above a cap, and refuses unlocks after a refused
+// spend.
+type capGuard struct {
+	cap     vhcutil.Amount
+	refused bool
+}
+
+func (g *capGuard) CheckUnlock() error {
+	if g.refused {
+		return errors.E(errors.Policy, "guard triggered")
+	}
+	return nil
+}
+
+func (g *capGuard) CheckSpend(amount vhcutil.Amount) error {
+	if amount > g.cap {
+		g.refused = true
+		return errors.E(errors.Policy, "spend above cap")
+	}
+	return nil
+}
+
+func TestSpendGuard(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	n := &transferCheckNetwork{w: w}
+	w.SetNetworkBackend(n)
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 5e8, 5e8)
+	account, err := w.NextAccount("savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &capGuard{cap: 1e8}
+	w.SetSpendGuard(g)
+
+	if _, err := w.MoveFunds(0, account, 1e8, 1); err != nil {
+		t.Fatalf("transfer within cap: %v", err)
+	}
+	_, err = w.MoveFunds(0, account, 2e8, 1)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("transfer above cap: got error %v", err)
+	}
+	if len(n.published) != 1 {
+		t.Fatalf("published %d transactions", len(n.published))
+	}
+
+	// The wallet is locked after the refused spend, and may not be unlocked
+	// while the guard refuses unlocks.
+	deadline := time.Now().Add(5 * time.Second)
+	for !w.Locked() {
+		if time.Now().After(deadline) {
+			t.Fatal("wallet was not locked after a refused spend")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	err = w.Unlock([]byte("private"), nil)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("unlock of triggered guard: got error %v", err)
+	}
+	g.refused = false
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// ImportRequest describes a single item imported by ImportMulti.  Exactly one
+// of WIF, PubKey, Xpub, and RedeemScript must be set.
+type ImportRequest struct {
+	WIF          *vhcutil.WIF
+	PubKey       []byte // Serialized secp256k1 public key
+	Xpub         *hdkeychain.ExtendedKey
+	RedeemScript []byte
+
+	// XpubRange is the half-open range of child indexes of the external and
+	// internal branches of Xpub which are imported.
+	XpubRange [2]uint32
+
+	// Timestamp is the earliest time transactions may pay to the imported
+	// addresses.  The zero time indicates the keys are new and no blocks
+	// must be rescanned.
+	Timestamp time.Time
+
+	// WatchOnly imports only the public key of a WIF.  Public keys and
+	// extended public keys are always imported watching-only.
+	WatchOnly bool
+
+	// User is recorded as the user which imported a RedeemScript.
+	User string
+}
+
+// ImportResult describes the outcome of a single ImportRequest.  Err is nil
+// when the request was imported, and Addresses lists the addresses of the
+// request, including those which were already imported.
+type ImportResult struct {
+	Addresses []vhcutil.Address
+	Err       error
+}
+
+// ImportMulti imports private keys, public keys, extended public keys, and
+// redeem scripts in a single database transaction.  Each request is imported
+// independently and its outcome is described by the result at the same index.
+// Items which were already imported are not errors.
+//
+// The returned height is the first main chain block which must be rescanned
+// for transactions paying to the imported addresses, found from the earliest
+// timestamp of the successful requests, or -1 when no rescan is required.
+func (w *Wallet) ImportMulti(reqs []*ImportRequest) ([]*ImportResult, int32, error) {
+	const op errors.Op = "wallet.ImportMulti"
+
+	results := make([]*ImportResult, len(reqs))
+	var watch []vhcutil.Address
+	var earliest time.Time
+	var rescan bool
+	rescanHeight := int32(-1)
+	var props *udb.AccountProperties
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		for i, req := range reqs {
+			addrs, err := w.importRequest(addrmgrNs, txmgrNs, req)
+			results[i] = &ImportResult{Addresses: addrs, Err: err}
+			if err != nil {
+				continue
+			}
+			watch = append(watch, addrs...)
+			if req.Timestamp.IsZero() {
+				continue
+			}
+			if !rescan || req.Timestamp.Before(earliest) {
+				earliest = req.Timestamp
+			}
+			rescan = true
+		}
+		if len(watch) == 0 {
+			return nil
+		}
+
+		var err error
+		props, err = w.Manager.AccountProperties(addrmgrNs, udb.ImportedAddrAccount)
+		if err != nil {
+			return err
+		}
+		if rescan {
+			rescanHeight, err = w.rescanHeightForTime(dbtx, earliest)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Record the import of each redeem script.
+		now := time.Now()
+		for i, req := range reqs {
+			if req.RedeemScript == nil || results[i].Err != nil {
+				continue
+			}
+			imp := &udb.ScriptImport{
+				Time:       now,
+				Origin:     "importmulti",
+				User:       req.User,
+				RescanFrom: -1,
+			}
+			if !req.Timestamp.IsZero() {
+				imp.RescanFrom = rescanHeight
+			}
+			err := w.TxStore.PutScriptImport(txmgrNs, req.RedeemScript, imp)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	if len(watch) == 0 {
+		return results, -1, nil
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		err := n.LoadTxFilter(context.TODO(), false, watch, nil)
+		if err != nil {
+			return nil, 0, errors.E(op, err)
+		}
+	}
+	log.Infof("Imported %d addresses", len(watch))
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return results, rescanHeight, nil
+}
+
+// importRequest imports a single ImportMulti request and returns its
+// addresses.
+func (w *Wallet) importRequest(addrmgrNs, txmgrNs walletdb.ReadWriteBucket, req *ImportRequest) ([]vhcutil.Address, error) {
+	n := 0
+	for _, set := range []bool{req.WIF != nil, req.PubKey != nil, req.Xpub != nil, req.RedeemScript != nil} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return nil, errors.E(errors.Invalid, "import request must specify exactly one key or script")
+	}
+
+	exists := func(err error) bool { return errors.Is(errors.Exist, err) }
+
+	switch {
+	case req.WIF != nil && !req.WatchOnly:
+		a, err := w.Manager.ImportPrivateKey(addrmgrNs, req.WIF)
+		if exists(err) {
+			return w.wifAddress(req.WIF)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []vhcutil.Address{a.Address()}, nil
+
+	case req.WIF != nil:
+		return w.importPubKey(addrmgrNs, req.WIF.SerializePubKey())
+
+	case req.PubKey != nil:
+		return w.importPubKey(addrmgrNs, req.PubKey)
+
+	case req.Xpub != nil:
+		if req.Xpub.IsPrivate() {
+			return nil, errors.E(errors.Invalid, "extended key must be public")
+		}
+		if !req.Xpub.IsForNet(w.chainParams) {
+			return nil, errors.E(errors.Invalid, "extended key is for the wrong network")
+		}
+		start, end := req.XpubRange[0], req.XpubRange[1]
+		if start >= end || end > hdkeychain.HardenedKeyStart {
+			return nil, errors.E(errors.Invalid, "invalid extended key child range")
+		}
+		var addrs []vhcutil.Address
+		for _, branch := range []uint32{udb.ExternalBranch, udb.InternalBranch} {
+			branchKey, err := req.Xpub.Child(branch)
+			if err != nil {
+				return nil, err
+			}
+			for i := start; i < end; i++ {
+				child, err := branchKey.Child(i)
+				if err == hdkeychain.ErrInvalidChild {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				pubKey, err := child.ECPubKey()
+				if err != nil {
+					return nil, err
+				}
+				a, err := w.importPubKey(addrmgrNs, pubKey.SerializeCompressed())
+				if err != nil {
+					return nil, err
+				}
+				addrs = append(addrs, a...)
+			}
+		}
+		return addrs, nil
+
+	default:
+		err := w.TxStore.InsertTxScript(txmgrNs, req.RedeemScript)
+		if err != nil {
+			return nil, err
+		}
+		a, err := w.Manager.ImportScript(addrmgrNs, req.RedeemScript)
+		if exists(err) {
+			addr, err := vhcutil.NewAddressScriptHash(req.RedeemScript, w.chainParams)
+			if err != nil {
+				return nil, err
+			}
+			return []vhcutil.Address{addr}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []vhcutil.Address{a.Address()}, nil
+	}
+}
+
+// importPubKey imports a watching-only public key, returning its P2PKH
+// address even if it was already imported.
+func (w *Wallet) importPubKey(addrmgrNs walletdb.ReadWriteBucket, pubKey []byte) ([]vhcutil.Address, error) {
+	a, err := w.Manager.ImportPublicKey(addrmgrNs, pubKey)
+	if errors.Is(errors.Exist, err) {
+		addr, err := vhcutil.NewAddressSecpPubKey(pubKey, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		return []vhcutil.Address{addr.AddressPubKeyHash()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []vhcutil.Address{a.Address()}, nil
+}
+
+func (w *Wallet) wifAddress(wif *vhcutil.WIF) ([]vhcutil.Address, error) {
+	addr, err := vhcutil.NewAddressSecpPubKey(wif.SerializePubKey(), w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	return []vhcutil.Address{addr.AddressPubKeyHash()}, nil
+}
+
+// rescanHeightForTime returns the height of the first main chain block with a
+// timestamp after t, less the birthday margin to allow for inaccurate
+// timestamps.  The tip height plus one is returned when every block was mined
+// before then.
+func (w *Wallet) rescanHeightForTime(dbtx walletdb.ReadTx, t time.Time) (int32, error) {
+	t = t.Add(-birthdayMargin)
+	ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.TxStore.MainChainTip(ns)
+	var searchErr error
+	i := sort.Search(int(tipHeight)+1, func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, int32(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		h, err := w.TxStore.GetBlockHeader(dbtx, &hash)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return !h.Timestamp.Before(t)
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+	return int32(i), nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestImportMulti(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{2}, 32), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := master.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif := func(i uint32) *vhcutil.WIF {
+		child, err := master.Child(hdkeychain.HardenedKeyStart + i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKey, err := child.ECPrivKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		wif, err := vhcutil.NewWIF(privKey, w.chainParams, vhcec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return wif
+	}
+	spendable, watched := wif(0), wif(1)
+
+	reqs := []*ImportRequest{
+		{WIF: spendable},
+		{WIF: watched, WatchOnly: true},
+		{PubKey: wif(2).SerializePubKey()},
+		{Xpub: xpub, XpubRange: [2]uint32{0, 2}},
+		{RedeemScript: []byte{0x51}},
+		{},
+		{Xpub: master, XpubRange: [2]uint32{0, 2}},
+	}
+	wantAddrs := []int{1, 1, 1, 4, 1}
+
+	// Private keys can not be imported while the wallet is locked, but
+	// watching-only imports succeed.
+	results, rescanHeight, err := w.ImportMulti(reqs[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(errors.Locked, results[0].Err) {
+		t.Errorf("locked private key import: expected Locked error, got %v", results[0].Err)
+	}
+	if results[1].Err != nil {
+		t.Errorf("locked watching-only import: %v", results[1].Err)
+	}
+	if rescanHeight != -1 {
+		t.Errorf("rescan height %d without timestamps, want -1", rescanHeight)
+	}
+
+	err = w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Importing every request twice checks that existing keys and scripts
+	// are not errors.
+	for i := 0; i < 2; i++ {
+		results, _, err = w.ImportMulti(reqs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j, want := range wantAddrs {
+			r := results[j]
+			if r.Err != nil {
+				t.Errorf("request %d: %v", j, r.Err)
+				continue
+			}
+			if len(r.Addresses) != want {
+				t.Errorf("request %d: imported %d addresses, want %d", j, len(r.Addresses), want)
+			}
+			for _, a := range r.Addresses {
+				if ok, err := w.HaveAddress(a); !ok || err != nil {
+					t.Errorf("request %d: address %v not found (err %v)", j, a, err)
+				}
+			}
+		}
+		for j := len(wantAddrs); j < len(reqs); j++ {
+			if !errors.Is(errors.Invalid, results[j].Err) {
+				t.Errorf("request %d: expected Invalid error, got %v", j, results[j].Err)
+			}
+		}
+	}
+
+	_, err = w.DumpWIFPrivateKey(results[0].Addresses[0])
+	if err != nil {
+		t.Errorf("dump imported private key: %v", err)
+	}
+	for _, j := range []int{1, 2} {
+		_, err = w.DumpWIFPrivateKey(results[j].Addresses[0])
+		if !errors.Is(errors.WatchingOnly, err) {
+			t.Errorf("request %d: expected WatchingOnly error, got %v", j, err)
+		}
+	}
+
+	// The rescan begins from the earliest timestamp.  Only the genesis block
+	// is in the main chain, so a timestamp before it rescans from the
+	// genesis block and later timestamps require no blocks to be rescanned.
+	genesis := w.chainParams.GenesisBlock.Header.Timestamp
+	tests := []struct {
+		timestamps []time.Time
+		height     int32
+	}{
+		{[]time.Time{time.Now()}, 1},
+		{[]time.Time{time.Now(), genesis.Add(-time.Hour)}, 0},
+		{[]time.Time{genesis.Add(birthdayMargin + time.Second)}, 1},
+	}
+	for i, test := range tests {
+		var reqs []*ImportRequest
+		for _, ts := range test.timestamps {
+			reqs = append(reqs, &ImportRequest{PubKey: wif(3).SerializePubKey(), Timestamp: ts})
+		}
+		_, height, err := w.ImportMulti(reqs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if height != test.height {
+			t.Errorf("test %d: rescan height %d, want %d", i, height, test.height)
+		}
+	}
+}
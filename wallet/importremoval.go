@@ -0,0 +1,94 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// DeleteImportedKey removes an imported private or public key from the wallet.
+// When dropHistory is true, the unspent outputs paying the key's address are
+// removed from the transaction store as well.  The number of removed outputs
+// is returned.
+//
+// The address remains watched by the network backend until the wallet is
+// restarted.
+func (w *Wallet) DeleteImportedKey(addr vhcutil.Address, dropHistory bool) (int, error) {
+	const op errors.Op = "wallet.DeleteImportedKey"
+	n, err := w.deleteImported(addr, false, dropHistory)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return n, nil
+}
+
+// DeleteImportedScript removes an imported redeem script with a P2SH address
+// from the wallet, including any record of its import.  When dropHistory is
+// true, the unspent outputs paying the P2SH address are removed from the
+// transaction store as well.  The number of removed outputs is returned.
+//
+// The address remains watched by the network backend until the wallet is
+// restarted.
+func (w *Wallet) DeleteImportedScript(addr vhcutil.Address, dropHistory bool) (int, error) {
+	const op errors.Op = "wallet.DeleteImportedScript"
+	if _, ok := addr.(*vhcutil.AddressScriptHash); !ok {
+		return 0, errors.E(op, errors.Invalid, "address must be P2SH")
+	}
+	n, err := w.deleteImported(addr, true, dropHistory)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return n, nil
+}
+
+func (w *Wallet) deleteImported(addr vhcutil.Address, script, dropHistory bool) (int, error) {
+	var removed int
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		ma, err := w.Manager.Address(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		if !ma.Imported() {
+			return errors.E(errors.Invalid, errors.Errorf("address %v was not imported", addr))
+		}
+		_, isScript := ma.(udb.ManagedScriptAddress)
+		switch {
+		case script && !isScript:
+			return errors.E(errors.Invalid, errors.Errorf("address %v is not a script address", addr))
+		case !script && isScript:
+			return errors.E(errors.Invalid, errors.Errorf("address %v is a script address", addr))
+		}
+
+		err = w.Manager.RemoveImportedAddress(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		hash160 := ma.Address().ScriptAddress()
+		if script {
+			err = w.TxStore.DeleteTxScript(txmgrNs, hash160)
+			if err != nil && !errors.Is(errors.NotExist, err) {
+				return err
+			}
+		}
+		if dropHistory {
+			removed, err = w.TxStore.RemoveAddressCredits(txmgrNs, hash160)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	log.Infof("Deleted imported address %v", addr)
+	return removed, nil
+}
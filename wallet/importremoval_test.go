@@ -0,0 +1,151 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestDeleteImported(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priv, pub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32))
+	wif, err := vhcutil.NewWIF(priv, w.chainParams, vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.ImportPrivateKey(wif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyAddr, err := vhcutil.NewAddressPubKeyHash(vhcutil.Hash160(pub.SerializeCompressed()),
+		w.chainParams, vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := vhcutil.NewAddressSecpPubKey(pub.SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, err := txscript.MultiSigScript([]*vhcutil.AddressSecpPubKey{other}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptAddr, err := w.ImportP2SHRedeemScript(script, &udb.ScriptImport{Origin: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record an unmined output paying the imported key.
+	pkScript, err := txscript.PayToAddrScript(keyAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), 2e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.InsertMemPoolTx(ns, rec)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.AddCredit(ns, rec, nil, 0, false, udb.ImportedAddrAccount)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Chain addresses and mismatched address kinds can not be deleted.
+	chainAddr, err := w.NewExternalAddress(udb.DefaultAccountNum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.DeleteImportedKey(chainAddr, false)
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("chain address: expected Invalid error, got %v", err)
+	}
+	_, err = w.DeleteImportedKey(scriptAddr, false)
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("script address as key: expected Invalid error, got %v", err)
+	}
+	_, err = w.DeleteImportedScript(keyAddr, false)
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("key address as script: expected Invalid error, got %v", err)
+	}
+
+	removed, err := w.DeleteImportedKey(keyAddr, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 {
+		t.Errorf("removed %d outputs, want 1", removed)
+	}
+	removed, err = w.DeleteImportedScript(scriptAddr, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 0 {
+		t.Errorf("removed %d outputs without dropping history, want 0", removed)
+	}
+
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, addr := range []vhcutil.Address{keyAddr, scriptAddr} {
+			_, err := w.Manager.Address(addrmgrNs, addr)
+			if !errors.Is(errors.NotExist, err) {
+				t.Errorf("%v: expected NotExist error, got %v", addr, err)
+			}
+		}
+		_, err := w.TxStore.GetTxScript(txmgrNs, scriptAddr.ScriptAddress())
+		if !errors.Is(errors.NotExist, err) {
+			t.Errorf("script: expected NotExist error, got %v", err)
+		}
+		_, err = w.TxStore.ScriptImport(txmgrNs, scriptAddr.ScriptAddress())
+		if !errors.Is(errors.NotExist, err) {
+			t.Errorf("script import: expected NotExist error, got %v", err)
+		}
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		if len(unspent) != 0 {
+			t.Errorf("%d unspent outputs remain, want 0", len(unspent))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = w.DeleteImportedKey(keyAddr, false)
+	if !errors.Is(errors.NotExist, err) {
+		t.Errorf("deleted key: expected NotExist error, got %v", err)
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+const (
+	// instanceLeaseInterval is the duration between renewals of the
+	// instance lease and network heartbeats.
+	instanceLeaseInterval = 30 * time.Second
+
+	// instanceLeaseTimeout is the duration after the last renewal that an
+	// instance lease held by another process is considered active.
+	instanceLeaseTimeout = 4 * instanceLeaseInterval
+)
+
+// instanceHeartbeatMagic prefixes every network heartbeat message.
+var instanceHeartbeatMagic = [4]byte{'v', 'h', 'h', 'b'}
+
+// instanceHeartbeatSize is the size of a heartbeat message: the magic, the
+// wallet fingerprint, and the instance ID.
+const instanceHeartbeatSize = 4 + chainhash.HashSize + 16
+
+// DuplicateInstance returns whether another running instance of this wallet
+// has been detected, along with a description of the detected instance.
+func (w *Wallet) DuplicateInstance() (bool, string) {
+	w.duplicateMu.Lock()
+	reason := w.duplicateReason
+	w.duplicateMu.Unlock()
+	return reason != "", reason
+}
+
+func (w *Wallet) setDuplicateInstance(reason string) {
+	w.duplicateMu.Lock()
+	detected := w.duplicateReason != ""
+	if !detected {
+		w.duplicateReason = reason
+	}
+	w.duplicateMu.Unlock()
+	if detected {
+		return
+	}
+	if w.allowDuplicate {
+		log.Warnf("Duplicate wallet instance detected (%s); mutating "+
+			"operations remain enabled due to configuration", reason)
+		return
+	}
+	log.Errorf("Duplicate wallet instance detected (%s); refusing to create "+
+		"or publish transactions to avoid double spends and double votes",
+		reason)
+}
+
+// checkDuplicateInstance returns an error if another running instance of this
+// wallet was detected and operations which may create conflicting transactions
+// must be refused.
+func (w *Wallet) checkDuplicateInstance() error {
+	if w.allowDuplicate {
+		return nil
+	}
+	if detected, reason := w.DuplicateInstance(); detected {
+		return errors.E(errors.Invalid, errors.Errorf("duplicate wallet "+
+			"instance detected (%s)", reason))
+	}
+	return nil
+}
+
+// holdMutatingUnlock is the same as holdUnlock but additionally errors if the
+// wallet must refuse mutating operations due to a detected duplicate instance.
+func (w *Wallet) holdMutatingUnlock() (heldUnlock, error) {
+	if err := w.checkDuplicateInstance(); err != nil {
+		return nil, err
+	}
+	return w.holdUnlock()
+}
+
+// leaseActive returns whether a lease recorded by another instance must be
+// considered held by a running process.  Leases recorded on this host are only
+// active while the recording process is running, which allows a wallet to be
+// restarted after a crash without waiting for its previous lease to time out.
+func leaseActive(lease *udb.InstanceLease, host string, pid uint32) bool {
+	if time.Since(lease.Heartbeat) >= instanceLeaseTimeout {
+		return false
+	}
+	if host == "" || lease.Host != host {
+		return true
+	}
+	return lease.PID != pid && processRunning(lease.PID)
+}
+
+// acquireInstanceLease records the lease of this process in the database,
+// first checking whether a lease of another process was recently renewed.
+func (w *Wallet) acquireInstanceLease() error {
+	_, err := rand.Read(w.instanceID[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	host, _ := os.Hostname()
+	pid := uint32(os.Getpid())
+
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		prev, err := udb.FetchInstanceLease(dbtx)
+		if err != nil {
+			return err
+		}
+		if prev != nil && leaseActive(prev, host, pid) {
+			w.setDuplicateInstance(fmt.Sprintf("database lease held by "+
+				"process %d on host %q was renewed at %v", prev.PID,
+				prev.Host, prev.Heartbeat))
+		}
+		return udb.PutInstanceLease(dbtx, &udb.InstanceLease{
+			ID:        w.instanceID,
+			PID:       pid,
+			Host:      host,
+			Heartbeat: time.Now(),
+		})
+	})
+}
+
+// renewInstanceLease updates the heartbeat time of the lease held by this
+// process.  If the recorded lease is held by another instance, the duplicate
+// is reported.
+func (w *Wallet) renewInstanceLease() error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		lease, err := udb.FetchInstanceLease(dbtx)
+		if err != nil {
+			return err
+		}
+		if lease == nil {
+			return nil
+		}
+		if lease.ID != w.instanceID {
+			w.setDuplicateInstance(fmt.Sprintf("database lease taken by "+
+				"process %d on host %q", lease.PID, lease.Host))
+			return nil
+		}
+		lease.Heartbeat = time.Now()
+		return udb.PutInstanceLease(dbtx, lease)
+	})
+}
+
+// releaseInstanceLease removes the lease held by this process.
+func (w *Wallet) releaseInstanceLease() error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		lease, err := udb.FetchInstanceLease(dbtx)
+		if err != nil || lease == nil || lease.ID != w.instanceID {
+			return err
+		}
+		return udb.DeleteInstanceLease(dbtx)
+	})
+}
+
+// instanceFingerprint returns an identifier of the wallet seed used in network
+// heartbeats.  The fingerprint does not reveal the account public key.
+func (w *Wallet) instanceFingerprint() ([]byte, error) {
+	xpub, err := w.MasterPubKey(0)
+	if err != nil {
+		return nil, err
+	}
+	return chainhash.HashB([]byte("vhcwallet instance " + xpub.String())), nil
+}
+
+// instanceMonitor periodically renews the instance lease and, when configured,
+// exchanges network heartbeats with other instances.  The lease is released
+// when the wallet is stopped.
+func (w *Wallet) instanceMonitor() {
+	defer w.wg.Done()
+	quit := w.quitChan()
+
+	var conn *net.UDPConn
+	var heartbeatAddr *net.UDPAddr
+	var heartbeat []byte
+	if w.heartbeatAddr != "" {
+		var err error
+		conn, heartbeatAddr, err = listenHeartbeats(w.heartbeatAddr)
+		if err != nil {
+			log.Errorf("Unable to exchange instance heartbeats: %v", err)
+		}
+		fingerprint, err := w.instanceFingerprint()
+		if err != nil {
+			log.Errorf("Unable to exchange instance heartbeats: %v", err)
+		}
+		if conn != nil && fingerprint != nil {
+			heartbeat = make([]byte, 0, instanceHeartbeatSize)
+			heartbeat = append(heartbeat, instanceHeartbeatMagic[:]...)
+			heartbeat = append(heartbeat, fingerprint...)
+			heartbeat = append(heartbeat, w.instanceID[:]...)
+			go w.readHeartbeats(conn, fingerprint)
+		} else if conn != nil {
+			conn.Close()
+			conn = nil
+		}
+	}
+
+	sendHeartbeat := func() {
+		if conn == nil {
+			return
+		}
+		_, err := conn.WriteToUDP(heartbeat, heartbeatAddr)
+		if err != nil {
+			log.Debugf("Failed to send instance heartbeat: %v", err)
+		}
+	}
+	sendHeartbeat()
+
+	t := time.NewTicker(instanceLeaseInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			err := w.renewInstanceLease()
+			if err != nil {
+				log.Errorf("Failed to renew instance lease: %v", err)
+			}
+			sendHeartbeat()
+		case <-quit:
+			if conn != nil {
+				conn.Close()
+			}
+			err := w.releaseInstanceLease()
+			if err != nil {
+				log.Errorf("Failed to release instance lease: %v", err)
+			}
+			return
+		}
+	}
+}
+
+// listenHeartbeats creates the UDP connection used to send and receive
+// heartbeats.  Multicast addresses are joined, while for all other addresses
+// heartbeats are received on the same port as the address.
+func listenHeartbeats(addr string) (*net.UDPConn, *net.UDPAddr, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	var conn *net.UDPConn
+	if udpAddr.IP.IsMulticast() {
+		conn, err = net.ListenMulticastUDP("udp", nil, udpAddr)
+	} else {
+		conn, err = net.ListenUDP("udp", &net.UDPAddr{Port: udpAddr.Port})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, udpAddr, nil
+}
+
+// readHeartbeats reads heartbeats from conn until it is closed, reporting a
+// duplicate instance when a heartbeat with the same wallet fingerprint but a
+// different instance ID is received.
+func (w *Wallet) readHeartbeats(conn *net.UDPConn, fingerprint []byte) {
+	buf := make([]byte, instanceHeartbeatSize)
+	for {
+		n, from, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		if n != instanceHeartbeatSize || !bytes.Equal(buf[:4], instanceHeartbeatMagic[:]) {
+			continue
+		}
+		if !bytes.Equal(buf[4:4+chainhash.HashSize], fingerprint) {
+			continue
+		}
+		if bytes.Equal(buf[4+chainhash.HashSize:], w.instanceID[:]) {
+			continue
+		}
+		w.setDuplicateInstance(fmt.Sprintf("heartbeat received from %v", from))
+	}
+}
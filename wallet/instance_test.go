@@ -0,0 +1,100 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestAcquireInstanceLease(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		t.Skip("host name unavailable")
+	}
+
+	// Find the PID of a process which is no longer running.
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	exitedPID := uint32(cmd.ProcessState.Pid())
+
+	tests := []struct {
+		name      string
+		lease     udb.InstanceLease
+		duplicate bool
+	}{
+		{
+			name:  "stale lease of exited process on this host",
+			lease: udb.InstanceLease{PID: exitedPID, Host: host},
+		},
+		{
+			name:  "stale lease of this process",
+			lease: udb.InstanceLease{PID: uint32(os.Getpid()), Host: host},
+		},
+		{
+			name:      "lease of running process on this host",
+			lease:     udb.InstanceLease{PID: uint32(os.Getppid()), Host: host},
+			duplicate: true,
+		},
+		{
+			name:      "lease of process on another host",
+			lease:     udb.InstanceLease{PID: exitedPID, Host: host + "-other"},
+			duplicate: true,
+		},
+		{
+			name: "expired lease of process on another host",
+			lease: udb.InstanceLease{PID: exitedPID, Host: host + "-other",
+				Heartbeat: time.Now().Add(-instanceLeaseTimeout)},
+		},
+	}
+	for _, test := range tests {
+		w.duplicateReason = ""
+		lease := test.lease
+		lease.ID = [16]byte{1}
+		if lease.Heartbeat.IsZero() {
+			lease.Heartbeat = time.Now()
+		}
+		err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.PutInstanceLease(dbtx, &lease)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = w.acquireInstanceLease()
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if duplicate, reason := w.DuplicateInstance(); duplicate != test.duplicate {
+			t.Errorf("%s: duplicate instance %v (%q), expected %v",
+				test.name, duplicate, reason, test.duplicate)
+		}
+
+		// The lease is always recorded for this process.
+		var held *udb.InstanceLease
+		err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			held, err = udb.FetchInstanceLease(dbtx)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if held == nil || held.ID != w.instanceID || held.PID != uint32(os.Getpid()) ||
+			held.Host != host {
+			t.Errorf("%s: recorded lease %+v", test.name, held)
+		}
+	}
+}
@@ -6,8 +6,10 @@
 package bdb
 
 import (
+	"bytes"
 	"io"
 	"os"
+	"sync/atomic"
 
 	"github.com/boltdb/bolt"
 	"github.com/valhallacoin/vhcwallet/errors"
@@ -40,6 +42,7 @@ func convertErr(err error) error {
 // provides a root bucket against which all read and writes occur.
 type transaction struct {
 	boltTx *bolt.Tx
+	db     *db
 }
 
 func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
@@ -54,6 +57,12 @@ func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
 	return (*bucket)(boltBucket)
 }
 
+func (tx *transaction) ForEachBucket(f func(key []byte) error) error {
+	return convertErr(tx.boltTx.ForEach(func(k []byte, _ *bolt.Bucket) error {
+		return f(k)
+	}))
+}
+
 func (tx *transaction) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
 	boltBucket, err := tx.boltTx.CreateBucket(key)
 	if err != nil {
@@ -75,7 +84,11 @@ func (tx *transaction) DeleteTopLevelBucket(key []byte) error {
 //
 // This function is part of the walletdb.Tx interface implementation.
 func (tx *transaction) Commit() error {
-	return convertErr(tx.boltTx.Commit())
+	err := tx.boltTx.Commit()
+	if err != nil {
+		atomic.AddUint64(&tx.db.writeErrors, 1)
+	}
+	return convertErr(err)
 }
 
 // Rollback undoes all changes that have been made to the root bucket and all of
@@ -196,6 +209,41 @@ func (b *bucket) ReadWriteCursor() walletdb.ReadWriteCursor {
 	return (*cursor)((*bolt.Bucket)(b).Cursor())
 }
 
+// ReadPrefixCursor returns a new cursor over the bucket's key/value pairs and
+// nested buckets with keys beginning with prefix.
+//
+// This function is part of the walletdb.ReadBucket interface implementation.
+func (b *bucket) ReadPrefixCursor(prefix []byte) walletdb.ReadCursor {
+	return b.ReadWritePrefixCursor(prefix)
+}
+
+// ReadWritePrefixCursor returns a new cursor over the bucket's key/value pairs
+// and nested buckets with keys beginning with prefix.
+//
+// This function is part of the walletdb.ReadWriteBucket interface
+// implementation.
+func (b *bucket) ReadWritePrefixCursor(prefix []byte) walletdb.ReadWriteCursor {
+	return &prefixCursor{
+		cursor: (*bolt.Bucket)(b).Cursor(),
+		prefix: append([]byte(nil), prefix...),
+	}
+}
+
+// Stats returns statistics describing the storage of the bucket and all of its
+// nested buckets.
+//
+// This function is part of the walletdb.ReadBucket interface implementation.
+func (b *bucket) Stats() walletdb.BucketStats {
+	s := (*bolt.Bucket)(b).Stats()
+	return walletdb.BucketStats{
+		KeyN:       s.KeyN,
+		BucketN:    s.BucketN,
+		Depth:      s.Depth,
+		InuseBytes: s.BranchInuse + s.LeafInuse + s.InlineBucketInuse,
+		AllocBytes: s.BranchAlloc + s.LeafAlloc,
+	}
+}
+
 // cursor represents a cursor over key/value pairs and nested buckets of a
 // bucket.
 //
@@ -254,20 +302,111 @@ func (c *cursor) Seek(seek []byte) (key, value []byte) {
 // This function is part of the walletdb.Cursor interface implementation.
 func (c *cursor) Close() {}
 
+// prefixCursor is a cursor over the key/value pairs and nested buckets of a
+// bucket with keys beginning with a prefix.
+type prefixCursor struct {
+	cursor *bolt.Cursor
+	prefix []byte
+}
+
+// bound returns the pair if the key begins with the cursor prefix, or a nil
+// pair otherwise.
+func (c *prefixCursor) bound(key, value []byte) ([]byte, []byte) {
+	if key == nil || !bytes.HasPrefix(key, c.prefix) {
+		return nil, nil
+	}
+	return key, value
+}
+
+// Delete removes the current key/value pair the cursor is at without
+// invalidating the cursor.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) Delete() error {
+	return convertErr(c.cursor.Delete())
+}
+
+// First positions the cursor at the first key/value pair with the prefix and
+// returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) First() (key, value []byte) {
+	return c.bound(c.cursor.Seek(c.prefix))
+}
+
+// Last positions the cursor at the last key/value pair with the prefix and
+// returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) Last() (key, value []byte) {
+	// Seek to the first key following every key with the prefix, which is
+	// the prefix with its last byte incremented after removing any
+	// trailing 0xff bytes.
+	end := append([]byte(nil), c.prefix...)
+	for len(end) > 0 && end[len(end)-1] == 0xff {
+		end = end[:len(end)-1]
+	}
+	if len(end) == 0 {
+		return c.bound(c.cursor.Last())
+	}
+	end[len(end)-1]++
+	if k, _ := c.cursor.Seek(end); k == nil {
+		return c.bound(c.cursor.Last())
+	}
+	return c.bound(c.cursor.Prev())
+}
+
+// Next moves the cursor one key/value pair forward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) Next() (key, value []byte) {
+	return c.bound(c.cursor.Next())
+}
+
+// Prev moves the cursor one key/value pair backward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) Prev() (key, value []byte) {
+	return c.bound(c.cursor.Prev())
+}
+
+// Seek positions the cursor at the passed seek key, or the first key with the
+// prefix if seek sorts before it.  If the key does not exist, the cursor is
+// moved to the next key after seek.  Returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) Seek(seek []byte) (key, value []byte) {
+	if bytes.Compare(seek, c.prefix) < 0 {
+		seek = c.prefix
+	}
+	return c.bound(c.cursor.Seek(seek))
+}
+
+// Closes the cursor
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *prefixCursor) Close() {}
+
 // db represents a collection of namespaces which are persisted and implements
 // the walletdb.Db interface.  All database access is performed through
 // transactions which are obtained through the specific Namespace.
-type db bolt.DB
+type db struct {
+	writeErrors uint64 // atomic; first for 64-bit alignment
+	boltDB      *bolt.DB
+}
 
-// Enforce db implements the walletdb.Db interface.
-var _ walletdb.DB = (*db)(nil)
+// Enforce db implements the walletdb.Db and walletdb.StatsDB interfaces.
+var _ walletdb.StatsDB = (*db)(nil)
 
 func (db *db) beginTx(writable bool) (*transaction, error) {
-	boltTx, err := (*bolt.DB)(db).Begin(writable)
+	boltTx, err := db.boltDB.Begin(writable)
 	if err != nil {
+		if writable {
+			atomic.AddUint64(&db.writeErrors, 1)
+		}
 		return nil, convertErr(err)
 	}
-	return &transaction{boltTx: boltTx}, nil
+	return &transaction{boltTx: boltTx, db: db}, nil
 }
 
 func (db *db) BeginReadTx() (walletdb.ReadTx, error) {
@@ -283,7 +422,7 @@ func (db *db) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
 //
 // This function is part of the walletdb.Db interface implementation.
 func (db *db) Copy(w io.Writer) error {
-	return convertErr((*bolt.DB)(db).View(func(tx *bolt.Tx) error {
+	return convertErr(db.boltDB.View(func(tx *bolt.Tx) error {
 		return tx.Copy(w)
 	}))
 }
@@ -292,7 +431,18 @@ func (db *db) Copy(w io.Writer) error {
 //
 // This function is part of the walletdb.Db interface implementation.
 func (db *db) Close() error {
-	return convertErr((*bolt.DB)(db).Close())
+	return convertErr(db.boltDB.Close())
+}
+
+// Stats returns the file path of the database and the number of failed write
+// transactions.
+//
+// This function is part of the walletdb.StatsDB interface implementation.
+func (db *db) Stats() walletdb.Stats {
+	return walletdb.Stats{
+		Path:        db.boltDB.Path(),
+		WriteErrors: atomic.LoadUint64(&db.writeErrors),
+	}
 }
 
 // filesExists reports whether the named file or directory exists.
@@ -312,5 +462,8 @@ func openDB(dbPath string, create bool) (walletdb.DB, error) {
 	}
 
 	boltDB, err := bolt.Open(dbPath, 0600, nil)
-	return (*db)(boltDB), convertErr(err)
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	return &db{boltDB: boltDB}, nil
 }
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestPrefixCursor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := openDB(filepath.Join(dir, "prefix.db"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	keys := []string{"a", "b\x00", "b\x01", "b\xff", "b\xff\xff", "c"}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket([]byte("test"))
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := b.Put([]byte(k), []byte(k)); err != nil {
+				return err
+			}
+		}
+		_, err = b.CreateBucket([]byte("nested"))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collect := func(c walletdb.ReadCursor, reverse bool) []string {
+		defer c.Close()
+		var got []string
+		first, next := c.First, c.Next
+		if reverse {
+			first, next = c.Last, c.Prev
+		}
+		for k, _ := first(); k != nil; k, _ = next() {
+			got = append(got, string(k))
+		}
+		return got
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"", append(keys[:len(keys):len(keys)], "nested")},
+		{"b", keys[1:5]},
+		{"b\xff", keys[3:5]},
+		{"d", nil},
+		{"\xff", nil},
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket([]byte("test"))
+		for _, test := range tests {
+			got := collect(b.ReadPrefixCursor([]byte(test.prefix)), false)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("prefix %q: got %q, want %q", test.prefix, got, test.want)
+			}
+			got = collect(b.ReadPrefixCursor([]byte(test.prefix)), true)
+			for i, j := 0, len(got)-1; i < j; i, j = i+1, j-1 {
+				got[i], got[j] = got[j], got[i]
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("prefix %q reversed: got %q, want %q", test.prefix, got, test.want)
+			}
+		}
+
+		c := b.ReadPrefixCursor([]byte("b"))
+		defer c.Close()
+		if k, _ := c.Seek([]byte("a")); string(k) != "b\x00" {
+			t.Errorf("seek before prefix: got %q", k)
+		}
+		if k, _ := c.Seek([]byte("b\x02")); string(k) != "b\xff" {
+			t.Errorf("seek within prefix: got %q", k)
+		}
+		if k, _ := c.Seek([]byte("c")); k != nil {
+			t.Errorf("seek after prefix: got %q", k)
+		}
+
+		stats := b.Stats()
+		if stats.KeyN != len(keys)+1 || stats.BucketN != 2 {
+			t.Errorf("unexpected bucket stats %+v", stats)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stats.db")
+	db, err := openDB(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	stats := db.(walletdb.StatsDB).Stats()
+	if stats.Path != path || stats.WriteErrors != 0 {
+		t.Fatalf("stats of new database: %+v", stats)
+	}
+
+	// Failed read transactions are not write errors, but every failed write
+	// transaction is counted.
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.BeginReadTx(); err == nil {
+		t.Fatal("read transaction began on closed database")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := db.BeginReadWriteTx(); err == nil {
+			t.Fatal("write transaction began on closed database")
+		}
+	}
+	stats = db.(walletdb.StatsDB).Stats()
+	if stats.WriteErrors != 2 {
+		t.Fatalf("%d write errors, expected 2", stats.WriteErrors)
+	}
+}
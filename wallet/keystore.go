@@ -0,0 +1,296 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// KeyStore creates signatures with private keys which are kept outside of the
+// address manager, such as inside a hardware security module.  The private keys
+// are never revealed to the wallet.
+type KeyStore interface {
+	// Sign returns the secp256k1 ECDSA signature of hash created with the
+	// private key of the serialized compressed public key pubKey.
+	Sign(ctx context.Context, pubKey, hash []byte) (r, s *big.Int, err error)
+}
+
+// KeyStorage describes where the private keys of an account are kept.
+type KeyStorage uint8
+
+// Key storages of accounts.
+const (
+	// KeyStorageLocal keeps private keys encrypted by the address manager.
+	KeyStorageLocal KeyStorage = iota
+
+	// KeyStoragePKCS11 keeps private keys inside a PKCS#11 module, which
+	// is accessed through the key store set by SetKeyStore.  The address
+	// manager only records the public keys of the account.
+	KeyStoragePKCS11
+)
+
+// String returns the name of the key storage.
+func (s KeyStorage) String() string {
+	switch s {
+	case KeyStorageLocal:
+		return "local"
+	case KeyStoragePKCS11:
+		return "pkcs11"
+	default:
+		return "unknown"
+	}
+}
+
+// SetKeyStore sets the key store which signs for accounts whose keys are not
+// kept by the address manager.
+func (w *Wallet) SetKeyStore(ks KeyStore) {
+	w.signerMu.Lock()
+	w.keyStore = ks
+	w.signerMu.Unlock()
+}
+
+// ImportPKCS11Account creates an account from the account extended public key
+// of keys kept inside a PKCS#11 module, such as an xpub exported by the token.
+// The private keys of the account are never known by the address manager, and
+// inputs and messages are signed by the wallet's key store, which must hold the
+// private key of the first external address of the account.
+func (w *Wallet) ImportPKCS11Account(name string, xpub *hdkeychain.ExtendedKey) (uint32, error) {
+	const op errors.Op = "wallet.ImportPKCS11Account"
+	w.signerMu.Lock()
+	ks := w.keyStore
+	w.signerMu.Unlock()
+	if ks == nil {
+		return 0, errors.E(op, errors.Invalid, "no PKCS#11 key store is configured")
+	}
+	if xpub.IsPrivate() {
+		return 0, errors.E(op, errors.Invalid, "extended key is private")
+	}
+
+	// Verify the key store holds the keys derived from the xpub by signing
+	// with the key of the first external address.
+	branch, err := xpub.Child(udb.ExternalBranch)
+	if err != nil {
+		return 0, errors.E(op, errors.Invalid, err)
+	}
+	child, err := branch.Child(0)
+	if err != nil {
+		return 0, errors.E(op, errors.Invalid, err)
+	}
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return 0, errors.E(op, errors.Invalid, err)
+	}
+	_, err = keyStoreSign(ks, pubKey.SerializeCompressed(),
+		messageHash("vhcwallet PKCS#11 account "+name))
+	if err != nil {
+		return 0, errors.E(op, errors.Errorf("key store does not hold the "+
+			"keys of the xpub: %v", err))
+	}
+
+	var a *newAccount
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		account, err := w.Manager.ImportXpubAccount(ns, name, xpub)
+		if err != nil {
+			return err
+		}
+		err = w.Manager.PutAccountKeyStorage(ns, account, uint8(KeyStoragePKCS11))
+		if err != nil {
+			return err
+		}
+		a, err = w.prepareNewAccount(dbtx, account)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	w.signerMu.Lock()
+	w.keyStorages[a.account] = KeyStoragePKCS11
+	w.signerMu.Unlock()
+	err = w.loadNewAccount(a)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return a.account, nil
+}
+
+// AccountKeyStorage returns where the private keys of an account are kept.
+func (w *Wallet) AccountKeyStorage(account uint32) KeyStorage {
+	w.signerMu.Lock()
+	s := w.keyStorages[account]
+	w.signerMu.Unlock()
+	return s
+}
+
+// keyStoreKey returns the key store and serialized compressed public key of
+// addr when the address belongs to an account whose private keys are kept by
+// the key store.  A nil key store is returned for addresses of accounts with
+// local keys.
+func (w *Wallet) keyStoreKey(addrmgrNs walletdb.ReadBucket, addr vhcutil.Address) (KeyStore, []byte, error) {
+	w.signerMu.Lock()
+	ks, haveExternal := w.keyStore, len(w.keyStorages) != 0
+	w.signerMu.Unlock()
+	if !haveExternal {
+		return nil, nil, nil
+	}
+
+	ma, err := w.Manager.Address(addrmgrNs, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	pka, ok := ma.(udb.ManagedPubKeyAddress)
+	if !ok || w.AccountKeyStorage(ma.Account()) == KeyStorageLocal {
+		return nil, nil, nil
+	}
+	if ks == nil {
+		return nil, nil, errors.E(errors.Invalid, errors.Errorf("keys of account %d "+
+			"are kept by a key store which is not configured", ma.Account()))
+	}
+	return ks, pka.PubKey().SerializeCompressed(), nil
+}
+
+// keyStoreSign signs hash with the key store, verifying the signature was
+// created by the private key of pubKey.
+func keyStoreSign(ks KeyStore, pubKey, hash []byte) (*secp256k1.Signature, error) {
+	r, s, err := ks.Sign(context.Background(), pubKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	sig := secp256k1.NewSignature(r, s)
+	if !sig.Verify(hash, pk) {
+		return nil, errors.E(errors.Crypto, "key store returned an invalid signature")
+	}
+	return sig, nil
+}
+
+// signKeyStore signs each input of tx whose previous output script in
+// prevScripts pays to a secp256k1 pubkey hash address of an account whose
+// keys are kept by the key store.  It returns whether each input was signed.
+func (w *Wallet) signKeyStore(addrmgrNs walletdb.ReadBucket, tx *wire.MsgTx, prevScripts [][]byte,
+	hashType txscript.SigHashType) ([]bool, error) {
+
+	signed := make([]bool, len(tx.TxIn))
+	for i, script := range prevScripts {
+		if script == nil {
+			continue
+		}
+		if (hashType&txscript.SigHashSingle) == txscript.SigHashSingle &&
+			i >= len(tx.TxOut) {
+			continue
+		}
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(txscript.DefaultScriptVersion,
+			script, w.chainParams)
+		if err != nil || len(addrs) != 1 {
+			continue
+		}
+		addr, ok := addrs[0].(*vhcutil.AddressPubKeyHash)
+		if !ok || addr.DSA(w.chainParams) != vhcec.STEcdsaSecp256k1 {
+			continue
+		}
+		ks, pubKey, err := w.keyStoreKey(addrmgrNs, addr)
+		if errors.Is(errors.NotExist, err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ks == nil {
+			continue
+		}
+		hash, err := txscript.CalcSignatureHash(script, hashType, tx, i, nil)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := keyStoreSign(ks, pubKey, hash)
+		if err != nil {
+			return nil, errors.E(errors.Errorf("input %d: %v", i, err))
+		}
+		sigScript, err := txscript.NewScriptBuilder().
+			AddData(append(sig.Serialize(), byte(hashType))).
+			AddData(pubKey).
+			Script()
+		if err != nil {
+			return nil, err
+		}
+		tx.TxIn[i].SignatureScript = sigScript
+		signed[i] = true
+	}
+	return signed, nil
+}
+
+// signKeyStoreAll signs each input of tx with the key store, returning an error
+// when any input is left unsigned.  It is used to sign transactions spending
+// only outputs of an account whose keys are kept by the key store.
+func (w *Wallet) signKeyStoreAll(addrmgrNs walletdb.ReadBucket, tx *wire.MsgTx, prevScripts [][]byte,
+	hashType txscript.SigHashType) error {
+
+	signed, err := w.signKeyStore(addrmgrNs, tx, prevScripts, hashType)
+	if err != nil {
+		return err
+	}
+	for i, ok := range signed {
+		if !ok {
+			return errors.E(errors.Invalid, errors.Errorf("input %d was "+
+				"not signed by the key store", i))
+		}
+	}
+	return nil
+}
+
+// signMessageKeyStore returns the compact signature of msg created by the key
+// store with the private key of addr.  A nil signature is returned when the
+// private key of addr is not kept by the key store.  Key stores only create
+// secp256k1 ECDSA signatures.
+func (w *Wallet) signMessageKeyStore(msg string, addr vhcutil.Address) ([]byte, error) {
+	if addr.DSA(w.chainParams) != vhcec.STEcdsaSecp256k1 {
+		return nil, nil
+	}
+	var ks KeyStore
+	var pubKey []byte
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		ks, pubKey, err = w.keyStoreKey(dbtx.ReadBucket(waddrmgrNamespaceKey), addr)
+		return err
+	})
+	if err != nil || ks == nil {
+		return nil, err
+	}
+	hash := messageHash(msg)
+	sig, err := keyStoreSign(ks, pubKey, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key store does not report the recovery code of the signature,
+	// which is found by recovering the public key with each code.
+	compact := make([]byte, 65)
+	rb, sb := sig.GetR().Bytes(), sig.GetS().Bytes()
+	copy(compact[33-len(rb):33], rb)
+	copy(compact[65-len(sb):], sb)
+	for i := byte(0); i < 4; i++ {
+		compact[0] = 27 + 4 + i // compressed
+		pk, _, err := secp256k1.RecoverCompact(compact, hash)
+		if err == nil && bytes.Equal(pk.SerializeCompressed(), pubKey) {
+			return compact, nil
+		}
+	}
+	return nil, errors.E(errors.Crypto, "unable to find recovery code of signature")
+}
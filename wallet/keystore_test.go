@@ -0,0 +1,161 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// memKeyStore signs with private keys held in memory, keyed by the serialized
+// compressed public key.
+type memKeyStore struct {
+	keys map[string]*secp256k1.PrivateKey
+}
+
+func (ks *memKeyStore) add(t *testing.T, xpriv *hdkeychain.ExtendedKey, branch, index uint32) {
+	t.Helper()
+	b, err := xpriv.Child(branch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := b.Child(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv, err := c.ECPrivKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks.keys[string(priv.PubKey().SerializeCompressed())] = priv
+}
+
+func (ks *memKeyStore) Sign(ctx context.Context, pubKey, hash []byte) (r, s *big.Int, err error) {
+	priv, ok := ks.keys[string(pubKey)]
+	if !ok {
+		return nil, nil, errors.E(errors.NotExist, "unknown key")
+	}
+	sig, err := priv.Sign(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig.GetR(), sig.GetS(), nil
+}
+
+func TestKeyStore(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	// The account keys are kept by the key store.
+	seed := bytes.Repeat([]byte{0x5a}, hdkeychain.RecommendedSeedLen)
+	xpriv, err := hdkeychain.NewMaster(seed, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := xpriv.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Accounts may not be imported without a key store holding the keys of
+	// the xpub.
+	if _, err := w.ImportPKCS11Account("hsm", xpub); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("import without a key store: %v", err)
+	}
+	ks := &memKeyStore{keys: make(map[string]*secp256k1.PrivateKey)}
+	w.SetKeyStore(ks)
+	if _, err := w.ImportPKCS11Account("hsm", xpub); err == nil {
+		t.Fatal("imported xpub whose keys are not held by the key store")
+	}
+	if _, err := w.ImportPKCS11Account("hsm", xpriv); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("import of a private extended key: %v", err)
+	}
+	ks.add(t, xpriv, udb.ExternalBranch, 0)
+	account, err := w.ImportPKCS11Account("hsm", xpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := w.AccountKeyStorage(account); s != KeyStoragePKCS11 {
+		t.Fatalf("account key storage is %v", s)
+	}
+
+	addr, err := w.NewExternalAddress(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The address manager holds no private keys of the account, even when
+	// unlocked.
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.DumpWIFPrivateKey(addr); !errors.Is(errors.WatchingOnly, err) {
+		t.Fatalf("dumped private key of key store account: %v", err)
+	}
+	w.Lock()
+	if !w.Locked() {
+		t.Fatal("wallet is unlocked")
+	}
+
+	// The locked wallet signs messages and transactions with the key store.
+	sig, err := w.SignMessage("msg", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyMessage("msg", addr, sig); !ok || err != nil {
+		t.Fatalf("invalid key store signature: %v", err)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	prevOut := wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular)
+	tx.AddTxIn(wire.NewTxIn(prevOut, 1e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+	sigErrs, err := w.SignTransaction(tx, txscript.SigHashAll,
+		map[wire.OutPoint][]byte{*prevOut: pkScript}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sigErrs) != 0 {
+		t.Fatalf("signature errors: %v", sigErrs[0].Error)
+	}
+
+	// Transactions of key store accounts are not signed when any input is
+	// left unsigned by the key store.
+	other, err := vhcutil.NewAddressPubKeyHash(make([]byte, 20), w.chainParams,
+		vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherScript, err := txscript.PayToAddrScript(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 0, wire.TxTreeRegular), 1e8, nil))
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		return w.signKeyStoreAll(dbtx.ReadBucket(waddrmgrNamespaceKey), tx,
+			[][]byte{pkScript, otherScript}, txscript.SigHashAll)
+	})
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("signing with an unsigned input: %v", err)
+	}
+}
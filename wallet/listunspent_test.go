@@ -0,0 +1,243 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+func TestListUnspentOptions(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.NextAccount("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 2e8, 3e8)
+	fundAccount(t, w, account, 4e8, 5e8)
+
+	all, err := w.ListUnspent(1, 9999999, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 5 {
+		t.Fatalf("listed %d outputs", len(all))
+	}
+
+	var defaultOutputs, otherOutputs []int
+	for i, r := range all {
+		if r.Account == "other" {
+			otherOutputs = append(otherOutputs, i)
+		} else {
+			defaultOutputs = append(defaultOutputs, i)
+		}
+	}
+	if len(defaultOutputs) != 3 || len(otherOutputs) != 2 {
+		t.Fatalf("listed %d default and %d other account outputs",
+			len(defaultOutputs), len(otherOutputs))
+	}
+
+	// Unmined outputs are paged together with mined outputs.
+	addr, err := w.NewExternalAddress(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unmined := wire.NewMsgTx()
+	unmined.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0xfe}, 0, wire.TxTreeRegular), 0, nil))
+	unmined.AddTxOut(wire.NewTxOut(6e8, pkScript))
+	unmined.AddTxOut(wire.NewTxOut(7e8, pkScript))
+	if err := w.AcceptMempoolTx(unmined); err != nil {
+		t.Fatal(err)
+	}
+	all, err = w.ListUnspent(0, 9999999, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 7 {
+		t.Fatalf("listed %d outputs", len(all))
+	}
+
+	// Pages are listed in order of transaction hash and output index, each
+	// beginning after the last output of the previous page.
+	page := func(opts *ListUnspentOptions) [][2]interface{} {
+		t.Helper()
+		var listed [][2]interface{}
+		for {
+			results, err := w.ListUnspent(0, 9999999, nil, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) > opts.Limit {
+				t.Fatalf("listed %d outputs for limit %d", len(results), opts.Limit)
+			}
+			for _, r := range results {
+				listed = append(listed, [2]interface{}{r.TxID, r.Vout})
+			}
+			if len(results) < opts.Limit {
+				return listed
+			}
+			last := results[len(results)-1]
+			hash, err := chainhash.NewHashFromStr(last.TxID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opts.After = wire.NewOutPoint(hash, last.Vout, last.Tree)
+		}
+	}
+	less := func(a, b [2]interface{}) bool {
+		ha, _ := chainhash.NewHashFromStr(a[0].(string))
+		hb, _ := chainhash.NewHashFromStr(b[0].(string))
+		if c := bytes.Compare(ha[:], hb[:]); c != 0 {
+			return c < 0
+		}
+		return a[1].(uint32) < b[1].(uint32)
+	}
+	accountOutputs := func(name string) [][2]interface{} {
+		var outputs [][2]interface{}
+		for _, r := range all {
+			if name == "" || r.Account == name {
+				outputs = append(outputs, [2]interface{}{r.TxID, r.Vout})
+			}
+		}
+		sort.Slice(outputs, func(i, j int) bool { return less(outputs[i], outputs[j]) })
+		return outputs
+	}
+
+	defaultAccount := uint32(0)
+	tests := []struct {
+		opts    ListUnspentOptions
+		outputs [][2]interface{}
+	}{
+		{ListUnspentOptions{Limit: 1}, accountOutputs("")},
+		{ListUnspentOptions{Limit: 2}, accountOutputs("")},
+		{ListUnspentOptions{Limit: 100}, accountOutputs("")},
+		{ListUnspentOptions{Account: &account, Limit: 2}, accountOutputs("other")},
+		{ListUnspentOptions{Account: &defaultAccount, Limit: 2}, accountOutputs("default")},
+	}
+	for i, test := range tests {
+		listed := page(&test.opts)
+		if !reflect.DeepEqual(listed, test.outputs) {
+			t.Errorf("test %d: listed %v, expected %v", i, listed, test.outputs)
+		}
+	}
+
+	// Listing after the last output returns no outputs.
+	outputs := accountOutputs("")
+	last := outputs[len(outputs)-1]
+	hash, err := chainhash.NewHashFromStr(last[0].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := w.ListUnspent(0, 9999999, nil, &ListUnspentOptions{
+		After: wire.NewOutPoint(hash, last[1].(uint32), 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("listed %d outputs after the last output", len(results))
+	}
+}
+
+func TestListUnspentStakeOutputs(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+	attach := func(b *gblock, relevantTxs map[chainhash.Hash][]*wire.MsgTx) {
+		t.Helper()
+		mustAddBlockNode(t, forest, b.BlockNode)
+		bestChain := tw.evaluateBestChain(forest, 1, b.Hash)
+		_, err := w.ChainSwitch(forest, bestChain, relevantTxs)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	votingAddr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &SplitTicketSession{
+		Network:       cfg.Params.Name,
+		TicketPrice:   1e8,
+		Expiry:        1000,
+		VotingAddress: votingAddr.String(),
+		Contributions: []SplitTicketContribution{{
+			Amount:            1e8,
+			CommitmentAddress: splitTicketTestAddr(t, 2),
+			ChangeAddress:     splitTicketTestAddr(t, 3),
+			Inputs: []SplitTicketInput{{
+				Hash:     chainhash.Hash{1}.String(),
+				Amount:   2e8,
+				PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+			}},
+		}},
+	}
+	ticket, err := s.Ticket(cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticketHash := ticket.TxHash()
+
+	attach(tg.createPremineBlock("premine"), nil)
+	b := tg.nextBlock("ticket", nil, nil, func(b *wire.MsgBlock) {
+		b.STransactions = append(b.STransactions, ticket)
+	})
+	attach(b, map[chainhash.Hash][]*wire.MsgTx{*b.Hash: {ticket}})
+	for i := 0; i <= int(cfg.Params.TicketMaturity); i++ {
+		b = tg.nextBlock(fmt.Sprintf("b%d", i), nil, nil)
+		attach(b, nil)
+	}
+
+	ticketOutput := func() *ListUnspentResult {
+		t.Helper()
+		results, err := w.ListUnspent(0, 9999999, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range results {
+			if r.TxID == ticketHash.String() && r.Vout == 0 {
+				return r
+			}
+		}
+		t.Fatal("ticket output is not listed")
+		return nil
+	}
+	r := ticketOutput()
+	if r.StakeOutput != StakeOutputTicket || r.TicketStatus != TicketStatusLive || !r.StakeLocked {
+		t.Fatalf("unexpected live ticket output %+v", r)
+	}
+
+	w.missedVotesMu.Lock()
+	w.missedVotes = append(w.missedVotes, MissedVote{Ticket: ticketHash})
+	w.missedVotesMu.Unlock()
+	r = ticketOutput()
+	if r.TicketStatus != TicketStatusMissed || !r.StakeLocked {
+		t.Fatalf("unexpected missed ticket output %+v", r)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// maxMissedVotes is the number of missed votes remembered by the wallet.
+// Older missed votes are discarded.
+const maxMissedVotes = 1000
+
+// selectedTicketsExpiry is the number of blocks after which tickets selected to
+// vote on a block which was never extended by the main chain are forgotten.
+const selectedTicketsExpiry = 16
+
+// MissedVote describes a ticket with voting authority held by the wallet which
+// was selected to vote on a block, but whose vote was not included in the
+// next block of the main chain.
+type MissedVote struct {
+	Ticket      chainhash.Hash
+	BlockHash   chainhash.Hash
+	BlockHeight int32
+	Detected    time.Time
+}
+
+// selectedTickets records the tickets of the wallet selected to vote on a
+// block.
+type selectedTickets struct {
+	height  int32
+	tickets []chainhash.Hash
+}
+
+// recordSelectedTickets remembers the winning tickets which the wallet has
+// voting authority for, so that missed votes are detected once the next block
+// is attached to the main chain.
+func (w *Wallet) recordSelectedTickets(winningTicketHashes []*chainhash.Hash, blockHash *chainhash.Hash, blockHeight int32) error {
+	if blockHeight < int32(w.chainParams.StakeValidationHeight)-1 {
+		return nil
+	}
+
+	var selected []chainhash.Hash
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, ticketHash := range selectOwnedTickets(w, dbtx, winningTicketHashes) {
+			ticketPurchase, err := w.TxStore.Tx(txmgrNs, ticketHash)
+			if err != nil && errors.Is(errors.NotExist, err) {
+				ticketPurchase, err = w.StakeMgr.TicketPurchase(dbtx, ticketHash)
+			}
+			if err != nil {
+				return err
+			}
+			owned, err := w.hasVotingAuthority(addrmgrNs, ticketPurchase)
+			if err != nil {
+				return err
+			}
+			if owned {
+				selected = append(selected, *ticketHash)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(selected) == 0 {
+		return nil
+	}
+
+	w.missedVotesMu.Lock()
+	w.selectedTickets[*blockHash] = &selectedTickets{
+		height:  blockHeight,
+		tickets: selected,
+	}
+	w.missedVotesMu.Unlock()
+	return nil
+}
+
+// checkMissedVotes reports and returns the selected tickets whose votes were
+// not included in the blocks attached to the main chain.  A vote is included
+// when the ticket is spent by a mined vote, or when the block includes a vote
+// from every selected ticket.
+func (w *Wallet) checkMissedVotes(attached []*wire.BlockHeader) ([]*MissedVote, error) {
+	type check struct {
+		blockHash chainhash.Hash
+		selected  *selectedTickets
+	}
+	var checks []check
+	w.missedVotesMu.Lock()
+	for _, header := range attached {
+		s, ok := w.selectedTickets[header.PrevBlock]
+		if !ok {
+			continue
+		}
+		delete(w.selectedTickets, header.PrevBlock)
+		// Every selected ticket voted when the block includes the
+		// maximum number of votes, even if the wallet has not
+		// recorded the votes of its tickets.
+		if header.Voters >= w.chainParams.TicketsPerBlock {
+			continue
+		}
+		checks = append(checks, check{header.PrevBlock, s})
+	}
+	if len(attached) != 0 {
+		// Forget tickets selected to vote on blocks which were never
+		// extended by the main chain.
+		tipHeight := int32(attached[len(attached)-1].Height)
+		for hash, s := range w.selectedTickets {
+			if s.height+selectedTicketsExpiry < tipHeight {
+				delete(w.selectedTickets, hash)
+			}
+		}
+	}
+	w.missedVotesMu.Unlock()
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	var missed []*MissedVote
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, c := range checks {
+			for i := range c.selected.tickets {
+				ticketHash := &c.selected.tickets[i]
+				voted := false
+				details, err := w.TxStore.TxDetails(txmgrNs, ticketHash)
+				switch {
+				case err == nil:
+					ticket, err := w.TxStore.TicketDetails(txmgrNs, details)
+					if err != nil {
+						return err
+					}
+					voted = ticket != nil && ticket.Spender != nil &&
+						ticket.Spender.Block.Height != -1 &&
+						stake.IsSSGen(&ticket.Spender.MsgTx)
+				case errors.Is(errors.NotExist, err):
+					// Tickets only tracked by the stake manager
+					// are not checked.
+					continue
+				default:
+					return err
+				}
+				if voted {
+					continue
+				}
+				missed = append(missed, &MissedVote{
+					Ticket:      *ticketHash,
+					BlockHash:   c.blockHash,
+					BlockHeight: c.selected.height,
+					Detected:    now,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(missed) == 0 {
+		return nil, nil
+	}
+
+	w.missedVotesMu.Lock()
+	for _, m := range missed {
+		if len(w.missedVotes) == maxMissedVotes {
+			w.missedVotes = append(w.missedVotes[:0], w.missedVotes[1:]...)
+		}
+		w.missedVotes = append(w.missedVotes, *m)
+	}
+	w.missedVotesMu.Unlock()
+	w.invalidateStakeInfo()
+
+	for _, m := range missed {
+		log.Warnf("Missed vote of ticket %v selected to vote on block %v "+
+			"(height %v)", &m.Ticket, &m.BlockHash, m.BlockHeight)
+	}
+	w.NtfnServer.notifyMissedVotes(missed)
+	return missed, nil
+}
+
+// MissedVotes returns the votes most recently missed by the wallet, ordered by
+// the height of the block the tickets were selected to vote on.  Votes are
+// only detected as missed while the wallet is synchronized.
+func (w *Wallet) MissedVotes() []*MissedVote {
+	w.missedVotesMu.Lock()
+	missed := make([]*MissedVote, len(w.missedVotes))
+	for i := range w.missedVotes {
+		m := w.missedVotes[i]
+		missed[i] = &m
+	}
+	w.missedVotesMu.Unlock()
+	return missed
+}
+
+// missedTicket returns whether the vote of a ticket is remembered as missed.
+func (w *Wallet) missedTicket(ticket *chainhash.Hash) bool {
+	w.missedVotesMu.Lock()
+	defer w.missedVotesMu.Unlock()
+	for i := range w.missedVotes {
+		if w.missedVotes[i].Ticket == *ticket {
+			return true
+		}
+	}
+	return false
+}
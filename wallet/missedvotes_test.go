@@ -0,0 +1,130 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestCheckMissedVotes(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	// Record an unvoted ticket in the transaction store.
+	s := &SplitTicketSession{
+		Network:       cfg.Params.Name,
+		TicketPrice:   1e8,
+		Expiry:        1000,
+		VotingAddress: splitTicketTestAddr(t, 1),
+		Contributions: []SplitTicketContribution{{
+			Amount:            1e8,
+			CommitmentAddress: splitTicketTestAddr(t, 2),
+			ChangeAddress:     splitTicketTestAddr(t, 3),
+			Inputs: []SplitTicketInput{{
+				Hash:     chainhash.Hash{1}.String(),
+				Amount:   2e8,
+				PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+			}},
+		}},
+	}
+	ticket, err := s.Ticket(cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := udb.NewTxRecordFromMsgTx(ticket, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.TxStore.InsertMemPoolTx(dbtx.ReadWriteBucket(wtxmgrNamespaceKey), rec)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	selectedBlock := chainhash.Hash{2}
+	staleBlock := chainhash.Hash{3}
+	w.selectedTickets[selectedBlock] = &selectedTickets{
+		height:  100,
+		tickets: []chainhash.Hash{rec.Hash},
+	}
+	w.selectedTickets[staleBlock] = &selectedTickets{
+		height:  50,
+		tickets: []chainhash.Hash{rec.Hash},
+	}
+
+	n := w.NtfnServer.MissedVoteNotifications()
+	defer n.Done()
+	notified := make(chan *MissedVoteNotification, 1)
+	go func() { notified <- <-n.C }()
+
+	// Attaching a block which does not include the vote reports the vote as
+	// missed and forgets stale selections.
+	reported, err := w.checkMissedVotes([]*wire.BlockHeader{{
+		PrevBlock: selectedBlock,
+		Height:    101,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reported) != 1 {
+		t.Fatalf("reported %d missed votes", len(reported))
+	}
+	missed := w.MissedVotes()
+	if len(missed) != 1 || missed[0].Ticket != rec.Hash ||
+		missed[0].BlockHash != selectedBlock || missed[0].BlockHeight != 100 {
+		t.Fatalf("missed votes: %+v", missed)
+	}
+	select {
+	case ntfn := <-notified:
+		if len(ntfn.MissedVotes) != 1 || ntfn.MissedVotes[0].Ticket != rec.Hash {
+			t.Errorf("missed vote notification: %+v", ntfn.MissedVotes)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no missed vote notification")
+	}
+	if len(w.selectedTickets) != 0 {
+		t.Errorf("%d selections remain after check", len(w.selectedTickets))
+	}
+
+	// Selections are only checked once.
+	_, err = w.checkMissedVotes([]*wire.BlockHeader{{
+		PrevBlock: selectedBlock,
+		Height:    101,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(w.MissedVotes()); n != 1 {
+		t.Fatalf("%d missed votes after second check", n)
+	}
+
+	// Votes are not missed when the next block includes a vote from every
+	// selected ticket.
+	w.selectedTickets[selectedBlock] = &selectedTickets{
+		height:  100,
+		tickets: []chainhash.Hash{rec.Hash},
+	}
+	reported, err = w.checkMissedVotes([]*wire.BlockHeader{{
+		PrevBlock: selectedBlock,
+		Height:    101,
+		Voters:    cfg.Params.TicketsPerBlock,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reported) != 0 {
+		t.Fatalf("reported %d missed votes of block with every vote", len(reported))
+	}
+}
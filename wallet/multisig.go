@@ -5,6 +5,9 @@
 package wallet
 
 import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
 	"github.com/valhallacoin/vhcd/vhcec"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcd/txscript"
@@ -83,8 +86,24 @@ func (w *Wallet) MakeSecp256k1MultiSigScript(secp256k1Addrs []vhcutil.Address, n
 	return script, nil
 }
 
-// ImportP2SHRedeemScript adds a P2SH redeem script to the wallet.
-func (w *Wallet) ImportP2SHRedeemScript(script []byte) (*vhcutil.AddressScriptHash, error) {
+// insertImportedScript saves an imported redeem script to the transaction
+// store and, when imp is not nil, records its import.
+func (w *Wallet) insertImportedScript(txmgrNs walletdb.ReadWriteBucket, script []byte, imp *udb.ScriptImport) error {
+	err := w.TxStore.InsertTxScript(txmgrNs, script)
+	if err != nil || imp == nil {
+		return err
+	}
+	if imp.Time.IsZero() {
+		imp2 := *imp
+		imp2.Time = time.Now()
+		imp = &imp2
+	}
+	return w.TxStore.PutScriptImport(txmgrNs, script, imp)
+}
+
+// ImportP2SHRedeemScript adds a P2SH redeem script to the wallet.  When imp
+// is not nil, it is recorded as the import of the script.
+func (w *Wallet) ImportP2SHRedeemScript(script []byte, imp *udb.ScriptImport) (*vhcutil.AddressScriptHash, error) {
 	const op errors.Op = "wallet.ImportP2SHRedeemScript"
 
 	var p2shAddr *vhcutil.AddressScriptHash
@@ -92,7 +111,7 @@ func (w *Wallet) ImportP2SHRedeemScript(script []byte) (*vhcutil.AddressScriptHa
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
 
-		err := w.TxStore.InsertTxScript(txmgrNs, script)
+		err := w.insertImportedScript(txmgrNs, script, imp)
 		if err != nil {
 			return err
 		}
@@ -176,6 +195,46 @@ func (w *Wallet) FetchP2SHMultiSigOutput(outPoint *wire.OutPoint) (*P2SHMultiSig
 	return &multiSigOutput, nil
 }
 
+// UnspentP2SHMultiSigOutputs returns every unspent P2SH multi-signature
+// output recorded by the wallet.  When p2shAddr is not nil, only outputs
+// paying to the address are returned.
+func (w *Wallet) UnspentP2SHMultiSigOutputs(p2shAddr *vhcutil.AddressScriptHash) ([]*P2SHMultiSigOutput, error) {
+	const op errors.Op = "wallet.UnspentP2SHMultiSigOutputs"
+
+	var outputs []*P2SHMultiSigOutput
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachUnspentMultisigOut(txmgrNs, func(mso *udb.MultisigOut, redeemScript []byte) error {
+			if p2shAddr != nil && mso.ScriptHash != *p2shAddr.Hash160() {
+				return nil
+			}
+			addr, err := vhcutil.NewAddressScriptHashFromHash(
+				mso.ScriptHash[:], w.chainParams)
+			if err != nil {
+				return err
+			}
+			block := BlockIdentity{Hash: mso.BlockHash, Height: int32(mso.BlockHeight)}
+			if mso.BlockHash == (chainhash.Hash{}) {
+				block = BlockIdentity{Height: -1}
+			}
+			outputs = append(outputs, &P2SHMultiSigOutput{
+				OutPoint:        *mso.OutPoint,
+				OutputAmount:    mso.Amount,
+				ContainingBlock: block,
+				P2SHAddress:     addr,
+				RedeemScript:    redeemScript,
+				M:               mso.M,
+				N:               mso.N,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return outputs, nil
+}
+
 // FetchAllRedeemScripts returns all P2SH redeem scripts saved by the wallet.
 func (w *Wallet) FetchAllRedeemScripts() ([][]byte, error) {
 	const op errors.Op = "wallet.FetchAllRedeemScripts"
@@ -192,6 +251,173 @@ func (w *Wallet) FetchAllRedeemScripts() ([][]byte, error) {
 	return redeemScripts, nil
 }
 
+// RedeemScriptInfo describes a P2SH redeem script saved by the wallet.  Import
+// is nil when the import of the script was not recorded, and Unspent reports
+// whether any unspent output of the wallet pays to the script.
+type RedeemScriptInfo struct {
+	Script  []byte
+	Import  *udb.ScriptImport
+	Unspent bool
+}
+
+// RedeemScriptInfos returns every P2SH redeem script saved by the wallet with
+// its import record and whether unspent outputs currently pay to it.
+func (w *Wallet) RedeemScriptInfos() ([]*RedeemScriptInfo, error) {
+	const op errors.Op = "wallet.RedeemScriptInfos"
+
+	var infos []*RedeemScriptInfo
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		paid := make(map[[20]byte]struct{})
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		for _, c := range unspent {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+				txscript.DefaultScriptVersion, c.PkScript, w.chainParams)
+			if err != nil {
+				continue
+			}
+			for _, a := range addrs {
+				if a, ok := a.(*vhcutil.AddressScriptHash); ok {
+					paid[*a.Hash160()] = struct{}{}
+				}
+			}
+		}
+		err = w.TxStore.ForEachUnspentMultisigOut(txmgrNs, func(mso *udb.MultisigOut, _ []byte) error {
+			paid[mso.ScriptHash] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, script := range w.TxStore.StoredTxScripts(txmgrNs) {
+			var k [20]byte
+			copy(k[:], vhcutil.Hash160(script))
+			info := &RedeemScriptInfo{Script: script}
+			_, info.Unspent = paid[k]
+			info.Import, err = w.TxStore.ScriptImport(txmgrNs, k[:])
+			if err != nil && !errors.Is(errors.NotExist, err) {
+				return err
+			}
+			infos = append(infos, info)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return infos, nil
+}
+
+// redeemP2SHMultiSigScriptSize returns the worst case serialize size of a
+// signature script redeeming a P2SH output with an m-of-n multisig redeem
+// script.  Each signature is pushed with OP_DATA_73, followed by the push of
+// the redeem script.
+func redeemP2SHMultiSigScriptSize(redeemScript []byte, m int) int {
+	push := 1
+	switch l := len(redeemScript); {
+	case l > 0xff:
+		push = 3
+	case l >= txscript.OP_PUSHDATA1:
+		push = 2
+	}
+	return m*(1+73) + push + len(redeemScript)
+}
+
+// RedeemP2SHMultiSigOutputs creates a transaction spending every P2SH
+// multi-signature output to a single output paying addr, and signs the inputs
+// with keys of the wallet.  The fee is paid at feeRate (per kB) for the worst
+// case size of the fully signed transaction, and a zero feeRate uses the
+// wallet's relay fee.  Inputs which require signatures of other cosigners are
+// described by the returned signature errors.
+func (w *Wallet) RedeemP2SHMultiSigOutputs(outputs []*P2SHMultiSigOutput, addr vhcutil.Address,
+	feeRate vhcutil.Amount) (*wire.MsgTx, []SignatureError, error) {
+
+	const op errors.Op = "wallet.RedeemP2SHMultiSigOutputs"
+
+	if len(outputs) == 0 {
+		return nil, nil, errors.E(op, errors.Invalid, "no outputs to redeem")
+	}
+	if feeRate == 0 {
+		feeRate = w.RelayFee()
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, nil, errors.E(op, errors.Invalid, err)
+	}
+
+	tx := wire.NewMsgTx()
+	scriptSizes := make([]int, 0, len(outputs))
+	prevScripts := make(map[wire.OutPoint][]byte, len(outputs))
+	var total vhcutil.Amount
+	for _, out := range outputs {
+		if txscript.GetScriptClass(txscript.DefaultScriptVersion,
+			out.RedeemScript) != txscript.MultiSigTy {
+			return nil, nil, errors.E(op, errors.Invalid,
+				errors.Errorf("redeem script of output %v is not multisig", &out.OutPoint))
+		}
+		tx.AddTxIn(wire.NewTxIn(&out.OutPoint, int64(out.OutputAmount), nil))
+		scriptSizes = append(scriptSizes,
+			redeemP2SHMultiSigScriptSize(out.RedeemScript, int(out.M)))
+		if out.OutPoint.Tree == wire.TxTreeRegular {
+			prevScript, err := txscript.PayToScriptHashScript(out.P2SHAddress.Hash160()[:])
+			if err != nil {
+				return nil, nil, errors.E(op, err)
+			}
+			prevScripts[out.OutPoint] = prevScript
+		}
+		total += out.OutputAmount
+	}
+
+	txOut := wire.NewTxOut(0, pkScript)
+	size := txsizes.EstimateSerializeSize(scriptSizes, []*wire.TxOut{txOut}, 0)
+	fee := txrules.FeeForSerializeSize(feeRate, size)
+	if total-fee <= 0 || txrules.IsDustAmount(total-fee, len(pkScript), w.RelayFee()) {
+		return nil, nil, errors.E(op, errors.InsufficientBalance,
+			errors.Errorf("estimated fee %v leaves dust of redeemed value %v", fee, total))
+	}
+	txOut.Value = int64(total - fee)
+	tx.AddTxOut(txOut)
+
+	signErrs, err := w.SignTransaction(tx, txscript.SigHashAll, prevScripts, nil, nil)
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	// Inputs lacking signatures of other cosigners are not signature
+	// errors of SignTransaction, so every other input is verified.
+	failed := make(map[uint32]struct{}, len(signErrs))
+	for _, e := range signErrs {
+		failed[e.InputIndex] = struct{}{}
+	}
+	for i, out := range outputs {
+		if _, ok := failed[uint32(i)]; ok {
+			continue
+		}
+		prevScript := prevScripts[out.OutPoint]
+		if prevScript == nil {
+			continue
+		}
+		vm, err := txscript.NewEngine(prevScript, tx, i, sanityVerifyFlags,
+			txscript.DefaultScriptVersion, nil)
+		if err == nil {
+			err = vm.Execute()
+		}
+		if err != nil {
+			signErrs = append(signErrs, SignatureError{
+				InputIndex: uint32(i),
+				Error: errors.E(op, errors.Errorf("input requires %d "+
+					"signatures: %v", out.M, err)),
+			})
+		}
+	}
+	return tx, signErrs, nil
+}
+
 // PrepareRedeemMultiSigOutTxOutput estimates the tx value for a MultiSigOutTx
 // output and adds it to msgTx.
 func (w *Wallet) PrepareRedeemMultiSigOutTxOutput(msgTx *wire.MsgTx, p2shOutput *P2SHMultiSigOutput, pkScript *[]byte) error {
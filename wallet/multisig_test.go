@@ -0,0 +1,240 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/txsizes"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestRedeemP2SHMultiSigOutputs(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(udb.DefaultAccountNum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherPub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32))
+	other, err := vhcutil.NewAddressSecpPubKey(otherPub.SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := func(m int, amounts ...vhcutil.Amount) []*P2SHMultiSigOutput {
+		script, err := w.MakeSecp256k1MultiSigScript([]vhcutil.Address{addr, other}, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2shAddr, err := w.ImportP2SHRedeemScript(script, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var outs []*P2SHMultiSigOutput
+		for i, amount := range amounts {
+			outs = append(outs, &P2SHMultiSigOutput{
+				OutPoint:     *wire.NewOutPoint(&chainhash.Hash{byte(m)}, uint32(i), wire.TxTreeRegular),
+				OutputAmount: amount,
+				P2SHAddress:  p2shAddr,
+				RedeemScript: script,
+				M:            uint8(m),
+				N:            2,
+			})
+		}
+		return outs
+	}
+
+	// Outputs of a 1-of-2 script are fully signed by the wallet and
+	// redeemed in a single transaction paying the requested fee rate.
+	outs := outputs(1, 1e8, 2e8, 3e8)
+	feeRate := vhcutil.Amount(1e5)
+	tx, signErrs, err := w.RedeemP2SHMultiSigOutputs(outs, addr, feeRate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signErrs) != 0 {
+		t.Fatalf("1-of-2 redemption has signature errors: %v", signErrs[0].Error)
+	}
+	if len(tx.TxIn) != 3 || len(tx.TxOut) != 1 {
+		t.Fatalf("redemption has %d inputs and %d outputs", len(tx.TxIn), len(tx.TxOut))
+	}
+	sizes := make([]int, len(outs))
+	for i, out := range outs {
+		sizes[i] = redeemP2SHMultiSigScriptSize(out.RedeemScript, 1)
+	}
+	estimated := txsizes.EstimateSerializeSize(sizes, tx.TxOut, 0)
+	if size := tx.SerializeSize(); size > estimated {
+		t.Errorf("signed size %d exceeds estimated size %d", size, estimated)
+	}
+	wantFee := txrules.FeeForSerializeSize(feeRate, estimated)
+	if fee := 6e8 - vhcutil.Amount(tx.TxOut[0].Value); fee != wantFee {
+		t.Errorf("redemption pays fee %v, want %v", fee, wantFee)
+	}
+
+	// Outputs of a 2-of-2 script require the signature of the other key.
+	_, signErrs, err = w.RedeemP2SHMultiSigOutputs(outputs(2, 1e8), addr, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signErrs) != 1 {
+		t.Errorf("2-of-2 redemption has %d signature errors, want 1", len(signErrs))
+	}
+
+	// Redeeming outputs worth less than the fee is an error.
+	_, _, err = w.RedeemP2SHMultiSigOutputs(outputs(1, 1000), addr, feeRate)
+	if !errors.Is(errors.InsufficientBalance, err) {
+		t.Errorf("redeem dust: expected InsufficientBalance error, got %v", err)
+	}
+}
+
+func TestRedeemP2SHMultiSigScriptSize(t *testing.T) {
+	for _, n := range []int{1, 3, 15} {
+		pubKeys := make([]*vhcutil.AddressSecpPubKey, n)
+		for i := range pubKeys {
+			_, pub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{byte(i + 1)}, 32))
+			pk, err := vhcutil.NewAddressSecpPubKey(pub.SerializeCompressed(), basicWalletConfig.Params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pubKeys[i] = pk
+		}
+		script, err := txscript.MultiSigScript(pubKeys, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := txscript.NewScriptBuilder()
+		for i := 0; i < n; i++ {
+			b.AddData(make([]byte, 73))
+		}
+		b.AddData(script)
+		sigScript, err := b.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size := redeemP2SHMultiSigScriptSize(script, n); size != len(sigScript) {
+			t.Errorf("%d-of-%d: estimated size %d, want %d", n, n, size, len(sigScript))
+		}
+	}
+}
+
+func TestRedeemScriptInfos(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	var pubKeys []*vhcutil.AddressSecpPubKey
+	for i := byte(1); i <= 2; i++ {
+		_, pub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{i}, 32))
+		pk, err := vhcutil.NewAddressSecpPubKey(pub.SerializeCompressed(), w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, pk)
+	}
+	paid, err := txscript.MultiSigScript(pubKeys, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unpaid, err := txscript.MultiSigScript(pubKeys, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Unlock([]byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported := time.Unix(1500000000, 0)
+	_, err = w.ImportP2SHRedeemScript(paid, &udb.ScriptImport{
+		Time:       imported,
+		Origin:     "addmultisigaddress",
+		User:       "alice",
+		RescanFrom: 100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.ImportP2SHRedeemScript(unpaid, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An unmined output pays to the first script.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 0, nil))
+	pkScript, err := txscript.PayToScriptHashScript(vhcutil.Hash160(paid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.InsertMemPoolTx(txmgrNs, rec)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.AddMultisigOut(txmgrNs, rec, nil, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	infos, err := w.RedeemScriptInfos()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 2 {
+		t.Fatalf("found %d scripts, want 2", len(infos))
+	}
+	for _, info := range infos {
+		switch {
+		case bytes.Equal(info.Script, paid):
+			if !info.Unspent {
+				t.Error("paid script has no unspent outputs")
+			}
+			want := udb.ScriptImport{
+				Time:       imported,
+				Origin:     "addmultisigaddress",
+				User:       "alice",
+				RescanFrom: 100,
+			}
+			if info.Import == nil || !info.Import.Time.Equal(want.Time) ||
+				info.Import.Origin != want.Origin || info.Import.User != want.User ||
+				info.Import.RescanFrom != want.RescanFrom {
+				t.Errorf("paid script import %+v, want %+v", info.Import, want)
+			}
+		case bytes.Equal(info.Script, unpaid):
+			if info.Unspent {
+				t.Error("unpaid script has unspent outputs")
+			}
+			if info.Import != nil {
+				t.Errorf("unrecorded import returned %+v", info.Import)
+			}
+		default:
+			t.Errorf("unexpected script %x", info.Script)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// MultisigAccountAddress describes a P2SH address of a multisig account and
+// the multisig redeem script it pays.
+type MultisigAccountAddress struct {
+	Address      *vhcutil.AddressScriptHash
+	RedeemScript []byte
+	Branch       uint32
+	Index        uint32
+}
+
+// parseMultisigXpubs decodes the cosigner extended public keys of a multisig
+// account.
+func parseMultisigXpubs(xpubs []string, params *chaincfg.Params) ([]*hdkeychain.ExtendedKey, error) {
+	keys := make([]*hdkeychain.ExtendedKey, 0, len(xpubs))
+	for _, s := range xpubs {
+		xpub, err := hdkeychain.NewKeyFromString(s)
+		if err != nil {
+			return nil, errors.E(errors.Encoding, err)
+		}
+		if xpub.IsPrivate() {
+			return nil, errors.E(errors.Invalid, "cosigner extended key must be public")
+		}
+		if !xpub.IsForNet(params) {
+			return nil, errors.E(errors.Invalid, "cosigner extended key is for another network")
+		}
+		keys = append(keys, xpub)
+	}
+	return keys, nil
+}
+
+// multisigRedeemScript derives the redeem script of a branch child of a
+// multisig account.  The child public keys of every cosigner are sorted so
+// that all cosigners derive identical scripts regardless of the order they
+// recorded the extended keys.  Children which are invalid for any cosigner
+// return hdkeychain.ErrInvalidChild.
+func multisigRedeemScript(xpubs []*hdkeychain.ExtendedKey, m int, branch, child uint32,
+	params *chaincfg.Params) ([]byte, error) {
+
+	pubKeys := make([][]byte, 0, len(xpubs))
+	for _, xpub := range xpubs {
+		branchKey, err := xpub.Child(branch)
+		if err != nil {
+			return nil, err
+		}
+		childKey, err := branchKey.Child(child)
+		if err != nil {
+			return nil, err
+		}
+		pk, err := childKey.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pk.SerializeCompressed())
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i], pubKeys[j]) < 0
+	})
+	addrs := make([]*vhcutil.AddressSecpPubKey, 0, len(pubKeys))
+	for _, pk := range pubKeys {
+		addr, err := vhcutil.NewAddressSecpPubKey(pk, params)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	script, err := txscript.MultiSigScript(addrs, m)
+	if err != nil {
+		return nil, errors.E(errors.Op("txscript.MultiSigScript"), err)
+	}
+	return script, nil
+}
+
+// CreateMultisigAccount creates an M-of-N multisig account named name whose
+// addresses require m signatures of keys derived from the extended public key
+// of the wallet account and every cosigner extended public key.
+func (w *Wallet) CreateMultisigAccount(name string, account uint32, m int, cosignerXpubs []string) (*udb.MultisigAccount, error) {
+	const op errors.Op = "wallet.CreateMultisigAccount"
+
+	if _, err := parseMultisigXpubs(cosignerXpubs, w.chainParams); err != nil {
+		return nil, errors.E(op, err)
+	}
+	var a *udb.MultisigAccount
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		acctXpub, err := w.Manager.AccountExtendedPubKey(dbtx, account)
+		if err != nil {
+			return err
+		}
+		xpubs := make([]string, 0, len(cosignerXpubs)+1)
+		xpubs = append(xpubs, acctXpub.String())
+		seen := map[string]struct{}{acctXpub.String(): {}}
+		for _, s := range cosignerXpubs {
+			if _, ok := seen[s]; ok {
+				return errors.E(errors.Invalid, "duplicate cosigner extended key")
+			}
+			seen[s] = struct{}{}
+			xpubs = append(xpubs, s)
+		}
+		sort.Strings(xpubs)
+		if m < 1 || m > len(xpubs) || len(xpubs) > txscript.MaxPubKeysPerMultiSig {
+			return errors.E(errors.Invalid, errors.Errorf("invalid %d-of-%d multisig", m, len(xpubs)))
+		}
+
+		a = &udb.MultisigAccount{
+			Name:    name,
+			Account: account,
+			M:       uint8(m),
+			Xpubs:   xpubs,
+		}
+		return w.Manager.PutMultisigAccount(addrmgrNs, a)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return a, nil
+}
+
+// MultisigAccounts returns every multisig account of the wallet.
+func (w *Wallet) MultisigAccounts() ([]*udb.MultisigAccount, error) {
+	const op errors.Op = "wallet.MultisigAccounts"
+	var accts []*udb.MultisigAccount
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		accts, err = w.Manager.MultisigAccounts(addrmgrNs)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return accts, nil
+}
+
+// MultisigAccountAddress derives the P2SH address of a branch child of a
+// multisig account without recording it as returned.
+func (w *Wallet) MultisigAccountAddress(name string, branch, child uint32) (*MultisigAccountAddress, error) {
+	const op errors.Op = "wallet.MultisigAccountAddress"
+	var a *udb.MultisigAccount
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		a, err = w.Manager.MultisigAccount(addrmgrNs, name)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	xpubs, err := parseMultisigXpubs(a.Xpubs, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	script, err := multisigRedeemScript(xpubs, int(a.M), branch, child, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	addr, err := vhcutil.NewAddressScriptHash(script, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &MultisigAccountAddress{
+		Address:      addr,
+		RedeemScript: script,
+		Branch:       branch,
+		Index:        child,
+	}, nil
+}
+
+// NewMultisigAccountAddress returns the P2SH address of the next unused child
+// of a multisig account branch.  The redeem script is saved so outputs paying
+// the address are recorded as multisig outputs, the wallet account is synced
+// to the child so the wallet's key can sign for it, and the address is watched
+// by the network backend.
+func (w *Wallet) NewMultisigAccountAddress(name string, branch uint32) (*MultisigAccountAddress, error) {
+	const op errors.Op = "wallet.NewMultisigAccountAddress"
+
+	var ma *MultisigAccountAddress
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		a, err := w.Manager.MultisigAccount(addrmgrNs, name)
+		if err != nil {
+			return err
+		}
+		xpubs, err := parseMultisigXpubs(a.Xpubs, w.chainParams)
+		if err != nil {
+			return err
+		}
+		for {
+			index, err := w.Manager.NextMultisigAccountIndex(addrmgrNs, name, branch)
+			if err != nil {
+				return err
+			}
+			script, err := multisigRedeemScript(xpubs, int(a.M), branch, index, w.chainParams)
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			err = w.TxStore.InsertTxScript(txmgrNs, script)
+			if err != nil {
+				return err
+			}
+			err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, a.Account, index, branch)
+			if err != nil {
+				return err
+			}
+			addr, err := vhcutil.NewAddressScriptHash(script, w.chainParams)
+			if err != nil {
+				return err
+			}
+			ma = &MultisigAccountAddress{
+				Address:      addr,
+				RedeemScript: script,
+				Branch:       branch,
+				Index:        index,
+			}
+			return nil
+		}
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		err := n.LoadTxFilter(context.TODO(), false, []vhcutil.Address{ma.Address}, nil)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	return ma, nil
+}
+
+// multisigAccountAddrs returns the P2SH addresses of every returned child of
+// the branches of a multisig account.
+func multisigAccountAddrs(a *udb.MultisigAccount, params *chaincfg.Params) ([]*vhcutil.AddressScriptHash, error) {
+	xpubs, err := parseMultisigXpubs(a.Xpubs, params)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]*vhcutil.AddressScriptHash, 0, a.NextExternal+a.NextInternal)
+	branches := [...]struct{ branch, n uint32 }{
+		{udb.ExternalBranch, a.NextExternal},
+		{udb.InternalBranch, a.NextInternal},
+	}
+	for _, b := range branches {
+		for child := uint32(0); child < b.n; child++ {
+			script, err := multisigRedeemScript(xpubs, int(a.M), b.branch, child, params)
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			addr, err := vhcutil.NewAddressScriptHash(script, params)
+			if err != nil {
+				return nil, err
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs, nil
+}
+
+// UnspentMultisigAccountOutputs returns the unspent outputs paying the
+// returned addresses of a multisig account.  The outputs may be redeemed
+// cooperatively with the other cosigners using RedeemP2SHMultiSigOutputs.
+func (w *Wallet) UnspentMultisigAccountOutputs(name string) ([]*P2SHMultiSigOutput, error) {
+	const op errors.Op = "wallet.UnspentMultisigAccountOutputs"
+	var a *udb.MultisigAccount
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		a, err = w.Manager.MultisigAccount(addrmgrNs, name)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	addrs, err := multisigAccountAddrs(a, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	owned := make(map[[20]byte]struct{}, len(addrs))
+	for _, addr := range addrs {
+		owned[*addr.Hash160()] = struct{}{}
+	}
+	outputs, err := w.UnspentP2SHMultiSigOutputs(nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	filtered := outputs[:0]
+	for _, out := range outputs {
+		if _, ok := owned[*out.P2SHAddress.Hash160()]; ok {
+			filtered = append(filtered, out)
+		}
+	}
+	return filtered, nil
+}
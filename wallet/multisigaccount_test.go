@@ -0,0 +1,155 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/hdkeychain"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestMultisigAccount(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	var cosigners []string
+	for i := byte(1); i <= 2; i++ {
+		master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{i}, 32), w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xpub, err := master.Neuter()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cosigners = append(cosigners, xpub.String())
+	}
+
+	_, err := w.CreateMultisigAccount("invalid", 0, 4, cosigners)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("4-of-3 account: expected Invalid error, got %v", err)
+	}
+	_, err = w.CreateMultisigAccount("duplicate", 0, 2, []string{cosigners[0], cosigners[0]})
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("duplicate cosigners: expected Invalid error, got %v", err)
+	}
+	a, err := w.CreateMultisigAccount("shared", 0, 2, cosigners)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Xpubs) != 3 {
+		t.Fatalf("account has %d cosigner keys, want 3", len(a.Xpubs))
+	}
+	_, err = w.CreateMultisigAccount("shared", 0, 2, cosigners)
+	if !errors.Is(errors.Exist, err) {
+		t.Fatalf("duplicate name: expected Exist error, got %v", err)
+	}
+
+	// Cosigners derive identical scripts regardless of the order of the
+	// extended keys.
+	xpubs, err := parseMultisigXpubs(a.Xpubs, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reversed := []*hdkeychain.ExtendedKey{xpubs[2], xpubs[1], xpubs[0]}
+
+	for i := uint32(0); i < 3; i++ {
+		ma, err := w.NewMultisigAccountAddress("shared", udb.ExternalBranch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ma.Index != i {
+			t.Errorf("derived index %d, want %d", ma.Index, i)
+		}
+		want, err := multisigRedeemScript(reversed, 2, udb.ExternalBranch, i, w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(ma.RedeemScript, want) {
+			t.Errorf("redeem script %d differs from cosigner script", i)
+		}
+		class := txscript.GetScriptClass(txscript.DefaultScriptVersion, ma.RedeemScript)
+		if class != txscript.MultiSigTy {
+			t.Errorf("redeem script %d has class %v", i, class)
+		}
+		demand, err := w.MultisigAccountAddress("shared", udb.ExternalBranch, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if demand.Address.String() != ma.Address.String() {
+			t.Errorf("address %d derived on demand is %v, want %v", i, demand.Address, ma.Address)
+		}
+	}
+
+	// Returned addresses have their scripts saved and the wallet key of
+	// each script is known to the address manager.
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		a, err := w.Manager.MultisigAccount(addrmgrNs, "shared")
+		if err != nil {
+			return err
+		}
+		if a.NextExternal != 3 || a.NextInternal != 0 {
+			t.Errorf("next indexes %d/%d, want 3/0", a.NextExternal, a.NextInternal)
+		}
+		addrs, err := multisigAccountAddrs(a, w.chainParams)
+		if err != nil {
+			return err
+		}
+		if len(addrs) != 3 {
+			t.Errorf("%d active addresses, want 3", len(addrs))
+		}
+		for _, addr := range addrs {
+			script, err := w.TxStore.GetTxScript(txmgrNs, addr.ScriptAddress())
+			if err != nil {
+				return err
+			}
+			_, keys, _, err := txscript.ExtractPkScriptAddrs(
+				txscript.DefaultScriptVersion, script, w.chainParams)
+			if err != nil {
+				return err
+			}
+			var owned int
+			for _, k := range keys {
+				if _, err := w.Manager.Address(addrmgrNs, k); err == nil {
+					owned++
+				}
+			}
+			if owned != 1 {
+				t.Errorf("script of %v has %d wallet keys, want 1", addr, owned)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accts, err := w.MultisigAccounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accts) != 1 || accts[0].Name != "shared" || accts[0].M != 2 {
+		t.Fatalf("unexpected multisig accounts %v", accts)
+	}
+	outputs, err := w.UnspentMultisigAccountOutputs("shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 0 {
+		t.Fatalf("%d unspent outputs, want 0", len(outputs))
+	}
+	_, err = w.NewMultisigAccountAddress("unknown", udb.ExternalBranch)
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("unknown account: expected NotExist error, got %v", err)
+	}
+}
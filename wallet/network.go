@@ -40,6 +40,18 @@ type NetworkBackend interface {
 	StakeDifficulty(ctx context.Context) (vhcutil.Amount, error)
 }
 
+// UnspentOutputSource is implemented by network backends which can look up
+// outputs of the main chain unspent transaction output set.
+type UnspentOutputSource interface {
+	// UnspentOutput returns an unspent output and the height of the main
+	// chain block which mined it.  An error with the NotExist kind is
+	// returned when the output does not exist, is spent, or is unmined.
+	UnspentOutput(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, int32, error)
+
+	// MainChainBlockHash returns the hash of the main chain block at height.
+	MainChainBlockHash(ctx context.Context, height int32) (*chainhash.Hash, error)
+}
+
 // NetworkBackend returns the currently associated network backend of the
 // wallet, or an error if the no backend is currently set.
 func (w *Wallet) NetworkBackend() (NetworkBackend, error) {
@@ -55,9 +67,13 @@ func (w *Wallet) NetworkBackend() (NetworkBackend, error) {
 }
 
 // SetNetworkBackend sets the network backend used by various functions of the
-// wallet.
+// wallet.  Changing the backend clears the recorded synchronization progress.
 func (w *Wallet) SetNetworkBackend(n NetworkBackend) {
 	w.networkBackendMu.Lock()
+	changed := w.networkBackend != n
 	w.networkBackend = n
 	w.networkBackendMu.Unlock()
+	if changed {
+		w.resetSyncProgress()
+	}
 }
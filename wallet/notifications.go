@@ -41,12 +41,19 @@ type NotificationServer struct {
 	transactions []chan *TransactionNotifications
 	// Coalesce transaction notifications since wallet previously did not add
 	// mined txs together.  Now it does and this can be rewritten.
-	currentTxNtfn     *TransactionNotifications
-	accountClients    []chan *AccountNotification
-	tipChangedClients []chan *MainTipChangedNotification
-	confClients       []*ConfirmationNotificationsClient
-	mu                sync.Mutex // Only protects registered clients
-	wallet            *Wallet    // smells like hacks
+	currentTxNtfn      *TransactionNotifications
+	accountClients     []chan *AccountNotification
+	tipChangedClients  []chan *MainTipChangedNotification
+	confClients        []*ConfirmationNotificationsClient
+	winningClients     []chan *WinningTicketsNotification
+	depositClients     []chan *DepositAddressNotification
+	revocationClients  []chan *PendingRevocationNotification
+	voteVersionClients []chan *VoteVersionStatus
+	missedVoteClients  []chan *MissedVoteNotification
+	ticketClients      []chan *TicketNotification
+	rescanClients      []chan *RescanStatus
+	mu                 sync.Mutex // Only protects registered clients
+	wallet             *Wallet    // smells like hacks
 }
 
 func newNotificationServer(wallet *Wallet) *NotificationServer {
@@ -220,6 +227,9 @@ func makeTicketSummary(chainClient *vhcrpcclient.Client, dbtx walletdb.ReadTx, w
 			// Check if ticket age is over TicketExpiry limit and therefore expired
 		} else if ticketExpired(w.chainParams, details.Ticket.Height(), tipHeight) {
 			ticketStatus = TicketStatusExpired
+			// Check if the vote was detected as missed by the wallet
+		} else if w.missedTicket(&details.Ticket.Hash) {
+			ticketStatus = TicketStatusMissed
 		}
 	}
 	return &TicketSummary{
@@ -675,6 +685,420 @@ func (c *AccountNotificationsClient) Done() {
 	}()
 }
 
+// WinningTicketsNotification describes the tickets owned by the wallet which
+// were selected to vote on a block.
+type WinningTicketsNotification struct {
+	BlockHash   *chainhash.Hash
+	BlockHeight int32
+	Tickets     []*chainhash.Hash
+}
+
+// WinningTicketsNotificationsClient receives WinningTicketsNotifications over
+// the channel C.
+type WinningTicketsNotificationsClient struct {
+	C      chan *WinningTicketsNotification
+	server *NotificationServer
+}
+
+// winningTicketsNotificationsBuffer is the number of winning tickets
+// notifications buffered for each client.
+const winningTicketsNotificationsBuffer = 16
+
+// WinningTicketsNotifications returns a client for receiving
+// WinningTicketsNotification over a channel.  The channel is buffered, and
+// notifications are dropped rather than delaying votes when a client falls
+// behind.  When finished, the client's Done method should be called to
+// disassociate the client from the server.
+func (s *NotificationServer) WinningTicketsNotifications() WinningTicketsNotificationsClient {
+	c := make(chan *WinningTicketsNotification, winningTicketsNotificationsBuffer)
+	s.mu.Lock()
+	s.winningClients = append(s.winningClients, c)
+	s.mu.Unlock()
+	return WinningTicketsNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *WinningTicketsNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.winningClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.winningClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyWinningTickets notifies clients of the tickets owned by the wallet
+// which are included in the winning tickets of a block.  No notification is
+// sent when none of the winning tickets are owned.
+func (s *NotificationServer) notifyWinningTickets(blockHash *chainhash.Hash, blockHeight int32, winningTickets []*chainhash.Hash) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.winningClients) == 0 {
+		return
+	}
+
+	w := s.wallet
+	var owned []*chainhash.Hash
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		owned = selectOwnedTickets(w, dbtx, winningTickets)
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to construct winning tickets notification: %v", err)
+		return
+	}
+	if len(owned) == 0 {
+		return
+	}
+
+	n := &WinningTicketsNotification{
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+		Tickets:     owned,
+	}
+	for _, c := range s.winningClients {
+		select {
+		case c <- n:
+		default:
+			log.Warnf("Dropped winning tickets notification for a client " +
+				"which is not receiving notifications")
+		}
+	}
+}
+
+// DepositAddressNotification describes external addresses newly reserved for
+// deposits to accounts.
+type DepositAddressNotification struct {
+	Addresses []*udb.DepositAddress
+}
+
+// DepositAddressNotificationsClient receives DepositAddressNotifications over
+// the channel C.
+type DepositAddressNotificationsClient struct {
+	C      chan *DepositAddressNotification
+	server *NotificationServer
+}
+
+// DepositAddressNotifications returns a client for receiving
+// DepositAddressNotification over a channel.  The channel is unbuffered.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) DepositAddressNotifications() DepositAddressNotificationsClient {
+	c := make(chan *DepositAddressNotification)
+	s.mu.Lock()
+	s.depositClients = append(s.depositClients, c)
+	s.mu.Unlock()
+	return DepositAddressNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *DepositAddressNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.depositClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.depositClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyDepositAddresses notifies clients of newly reserved deposit addresses.
+func (s *NotificationServer) notifyDepositAddresses(addrs []*udb.DepositAddress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := &DepositAddressNotification{Addresses: addrs}
+	for _, c := range s.depositClients {
+		c <- n
+	}
+}
+
+// PendingRevocationNotification describes missed tickets whose automatic
+// revocations are pending.
+type PendingRevocationNotification struct {
+	Revocations []*PendingRevocation
+}
+
+// PendingRevocationNotificationsClient receives PendingRevocationNotifications
+// over the channel C.
+type PendingRevocationNotificationsClient struct {
+	C      chan *PendingRevocationNotification
+	server *NotificationServer
+}
+
+// pendingRevocationNotificationsBuffer is the number of pending revocation
+// notifications buffered for each client.
+const pendingRevocationNotificationsBuffer = 16
+
+// PendingRevocationNotifications returns a client for receiving
+// PendingRevocationNotification over a channel.  The channel is buffered, and
+// notifications are dropped rather than delaying revocations when a client
+// falls behind.  Wallet.PendingRevocations returns every pending revocation
+// for clients which must not miss any.  When finished, the client's Done
+// method should be called to disassociate the client from the server.
+func (s *NotificationServer) PendingRevocationNotifications() PendingRevocationNotificationsClient {
+	c := make(chan *PendingRevocationNotification, pendingRevocationNotificationsBuffer)
+	s.mu.Lock()
+	s.revocationClients = append(s.revocationClients, c)
+	s.mu.Unlock()
+	return PendingRevocationNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *PendingRevocationNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.revocationClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.revocationClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyPendingRevocations notifies clients of newly delayed revocations of
+// missed tickets.
+func (s *NotificationServer) notifyPendingRevocations(revocations []*PendingRevocation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := &PendingRevocationNotification{Revocations: revocations}
+	for _, c := range s.revocationClients {
+		select {
+		case c <- n:
+		default:
+			log.Warnf("Dropped pending revocation notification for a client " +
+				"which is not receiving notifications")
+		}
+	}
+}
+
+// VoteVersionNotificationsClient receives a VoteVersionStatus over the channel
+// C each time the votes cast by the wallet become outdated or compatible with
+// the stake version of the network.
+type VoteVersionNotificationsClient struct {
+	C      chan *VoteVersionStatus
+	server *NotificationServer
+}
+
+// VoteVersionNotifications returns a client for receiving vote version status
+// changes over a channel.  The channel is unbuffered.  When finished, the
+// client's Done method should be called to disassociate the client from the
+// server.
+func (s *NotificationServer) VoteVersionNotifications() VoteVersionNotificationsClient {
+	c := make(chan *VoteVersionStatus)
+	s.mu.Lock()
+	s.voteVersionClients = append(s.voteVersionClients, c)
+	s.mu.Unlock()
+	return VoteVersionNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *VoteVersionNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.voteVersionClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.voteVersionClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyVoteVersion notifies clients of a change to the compatibility of the
+// wallet's votes with the stake version of the network.
+func (s *NotificationServer) notifyVoteVersion(status *VoteVersionStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.voteVersionClients {
+		c <- status
+	}
+}
+
+// MissedVoteNotification describes tickets selected to vote whose votes were
+// not included in the next block.
+type MissedVoteNotification struct {
+	MissedVotes []*MissedVote
+}
+
+// MissedVoteNotificationsClient receives MissedVoteNotifications over the
+// channel C.
+type MissedVoteNotificationsClient struct {
+	C      chan *MissedVoteNotification
+	server *NotificationServer
+}
+
+// MissedVoteNotifications returns a client for receiving
+// MissedVoteNotification over a channel.  The channel is unbuffered.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) MissedVoteNotifications() MissedVoteNotificationsClient {
+	c := make(chan *MissedVoteNotification)
+	s.mu.Lock()
+	s.missedVoteClients = append(s.missedVoteClients, c)
+	s.mu.Unlock()
+	return MissedVoteNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *MissedVoteNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.missedVoteClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.missedVoteClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyMissedVotes notifies clients of votes detected as missed.
+func (s *NotificationServer) notifyMissedVotes(missed []*MissedVote) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := &MissedVoteNotification{MissedVotes: missed}
+	for _, c := range s.missedVoteClients {
+		c <- n
+	}
+}
+
+// TicketNotification describes changes to the status of the wallet's tickets
+// caused by blocks attached to the main chain, sorted by increasing block
+// heights.
+type TicketNotification struct {
+	Changes []*TicketStatusChange
+}
+
+// TicketNotificationsClient receives TicketNotifications over the channel C.
+type TicketNotificationsClient struct {
+	C      chan *TicketNotification
+	server *NotificationServer
+}
+
+// TicketNotifications returns a client for receiving TicketNotification over
+// a channel.  The channel is unbuffered.  When finished, the client's Done
+// method should be called to disassociate the client from the server.
+func (s *NotificationServer) TicketNotifications() TicketNotificationsClient {
+	c := make(chan *TicketNotification)
+	s.mu.Lock()
+	s.ticketClients = append(s.ticketClients, c)
+	s.mu.Unlock()
+	return TicketNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *TicketNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.ticketClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.ticketClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyTickets notifies clients of changes to the status of tickets.
+func (s *NotificationServer) notifyTickets(changes []*TicketStatusChange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := &TicketNotification{Changes: changes}
+	for _, c := range s.ticketClients {
+		c <- n
+	}
+}
+
 // MainTipChangedNotification describes processed changes to the main chain tip
 // block.  Attached and detached blocks are sorted by increasing heights.
 //
@@ -987,3 +1411,57 @@ func (c *ConfirmationNotificationsClient) process(tipHeight int32) {
 	case <-c.ctx.Done():
 	}
 }
+
+// RescanNotificationsClient receives the status of rescans over the channel C.
+type RescanNotificationsClient struct {
+	C      chan *RescanStatus
+	server *NotificationServer
+}
+
+// RescanNotifications returns a client for receiving the status of rescans
+// over a channel as each range of blocks is scanned and when each rescan
+// ends.  The channel is unbuffered.  When finished, the client's Done method
+// should be called to disassociate the client from the server.
+func (s *NotificationServer) RescanNotifications() RescanNotificationsClient {
+	c := make(chan *RescanStatus)
+	s.mu.Lock()
+	s.rescanClients = append(s.rescanClients, c)
+	s.mu.Unlock()
+	return RescanNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *RescanNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.rescanClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.rescanClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// notifyRescanProgress notifies clients of the status of a rescan.
+func (s *NotificationServer) notifyRescanProgress(status *RescanStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.rescanClients {
+		c <- status
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+)
+
+func TestWinningTicketsNotifications(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	ticket := testOwnedTicket(t, w)
+	ticketHash := ticket.TxHash()
+	err := w.AcceptMempoolTx(ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := w.NtfnServer.WinningTicketsNotifications()
+	defer n.Done()
+
+	// Only owned winning tickets are notified.
+	blockHash := chainhash.Hash{1}
+	unowned := chainhash.Hash{2}
+	winners := []*chainhash.Hash{&unowned, &ticketHash}
+	err = w.VoteOnOwnedTickets(winners, &blockHash, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case v := <-n.C:
+		if *v.BlockHash != blockHash || v.BlockHeight != 100 ||
+			len(v.Tickets) != 1 || *v.Tickets[0] != ticketHash {
+			t.Fatalf("unexpected notification %+v", v)
+		}
+	default:
+		t.Fatal("no winning tickets notification")
+	}
+
+	// Voting does not wait for clients which are not receiving.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i <= winningTicketsNotificationsBuffer; i++ {
+			w.VoteOnOwnedTickets(winners, &blockHash, 100)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("voting blocked on winning tickets client")
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// DefaultLockNamespace is the namespace of outpoints locked by LockOutpoint.
+const DefaultLockNamespace = ""
+
+// outpointLock records the namespace holding the lock of an outpoint, the
+// time and main chain height the lock expires at, and whether the lock is
+// recorded in the database.  A zero expiry or expiry height never expires.
+type outpointLock struct {
+	namespace    string
+	expiry       time.Time
+	expiryHeight int32
+	persistent   bool
+}
+
+func (l *outpointLock) expired(now time.Time) bool {
+	return !l.expiry.IsZero() && !now.Before(l.expiry)
+}
+
+// OutpointLock describes a locked outpoint.  Expiry is the zero time for locks
+// which do not expire at a time, and ExpiryHeight is zero for locks which are
+// not released when the main chain reaches a height.  Persistent locks are
+// saved across wallet restarts.
+type OutpointLock struct {
+	OutPoint     wire.OutPoint
+	Namespace    string
+	Expiry       time.Time
+	ExpiryHeight int32
+	Persistent   bool
+}
+
+// pruneOutpointLocks removes expired locks.  The outpoint locks mutex must be
+// held.
+func (w *Wallet) pruneOutpointLocks(now time.Time) {
+	for op, l := range w.lockedOutpoints {
+		if l.expired(now) {
+			log.Debugf("Lock of outpoint %v in namespace %q expired", &op,
+				l.namespace)
+			delete(w.lockedOutpoints, op)
+		}
+	}
+}
+
+// LockedOutpoint returns whether an outpoint has been marked as locked, in any
+// namespace, and should not be used as an input for created transactions.
+func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
+	w.lockedOutpointsMu.Lock()
+	l, locked := w.lockedOutpoints[op]
+	locked = locked && !l.expired(time.Now())
+	w.lockedOutpointsMu.Unlock()
+	return locked
+}
+
+// LockOutpoint marks an outpoint as locked in the default namespace, that is,
+// it should not be used as an input for newly created transactions.  Outpoints
+// already locked in another namespace remain held by that namespace.
+func (w *Wallet) LockOutpoint(op wire.OutPoint) {
+	err := w.LockOutpointNamespace(op, DefaultLockNamespace, 0)
+	if err != nil {
+		log.Debugf("Outpoint %v not locked in default namespace: %v", &op, err)
+	}
+}
+
+// UnlockOutpoint marks an outpoint locked in the default namespace as
+// unlocked, that is, it may be used as an input for newly created
+// transactions.  Locks held by other namespaces are not removed.
+func (w *Wallet) UnlockOutpoint(op wire.OutPoint) {
+	err := w.UnlockOutpointNamespace(op, DefaultLockNamespace)
+	if err != nil {
+		log.Debugf("Outpoint %v not unlocked in default namespace: %v", &op, err)
+	}
+}
+
+// ResetLockedOutpoints unlocks every outpoint locked in the default namespace
+// so they may be used as inputs for new transactions.
+func (w *Wallet) ResetLockedOutpoints() {
+	w.ResetLockedOutpointsNamespace(DefaultLockNamespace)
+}
+
+// LockedOutpoints returns a slice of currently locked outpoints of every
+// namespace.  This is intended to be used by marshaling the result as a JSON
+// array for listlockunspent RPC results.
+func (w *Wallet) LockedOutpoints() []vhcjson.TransactionInput {
+	locks := w.OutpointLocks()
+	locked := make([]vhcjson.TransactionInput, len(locks))
+	for i := range locks {
+		op := &locks[i].OutPoint
+		locked[i] = vhcjson.TransactionInput{
+			Txid: op.Hash.String(),
+			Vout: op.Index,
+			Tree: op.Tree,
+		}
+	}
+	return locked
+}
+
+// LockOutpointNamespace locks an outpoint in a namespace, preventing its use as
+// an input for newly created transactions.  Namespaces allow independent
+// clients to lock outpoints without unlocking each other's locks.  A positive
+// ttl releases the lock automatically after that duration, while a zero ttl
+// locks the outpoint until it is unlocked.  Locking an outpoint already held by
+// the namespace replaces the expiry of the lock.  An error with code
+// errors.Exist is returned if another namespace holds the lock.  The lock is
+// not saved across wallet restarts.
+func (w *Wallet) LockOutpointNamespace(outPoint wire.OutPoint, namespace string, ttl time.Duration) error {
+	const op errors.Op = "wallet.LockOutpointNamespace"
+	err := w.lockOutpoint(outPoint, namespace, ttl, 0, false)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// LockOutpointPersistent locks an outpoint in a namespace like
+// LockOutpointNamespace, and records the lock in the database so the outpoint
+// remains locked after the wallet is restarted.
+func (w *Wallet) LockOutpointPersistent(outPoint wire.OutPoint, namespace string, ttl time.Duration) error {
+	const op errors.Op = "wallet.LockOutpointPersistent"
+	err := w.lockOutpoint(outPoint, namespace, ttl, 0, true)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// LockOutpointBlocks locks an outpoint in a namespace like
+// LockOutpointNamespace, or like LockOutpointPersistent if persistent is true,
+// and additionally releases the lock once blocks more blocks are attached to
+// the main chain.  The lock is released by whichever of the ttl and blocks
+// expires first, and a zero blocks does not release the lock at any height.
+func (w *Wallet) LockOutpointBlocks(outPoint wire.OutPoint, namespace string, ttl time.Duration,
+	blocks int32, persistent bool) error {
+
+	const op errors.Op = "wallet.LockOutpointBlocks"
+	err := w.lockOutpoint(outPoint, namespace, ttl, blocks, persistent)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (w *Wallet) lockOutpoint(outPoint wire.OutPoint, namespace string, ttl time.Duration,
+	blocks int32, persistent bool) error {
+
+	if ttl < 0 {
+		return errors.E(errors.Invalid, "negative lock ttl")
+	}
+	if blocks < 0 {
+		return errors.E(errors.Invalid, "negative lock block count")
+	}
+	var expiryHeight int32
+	if blocks != 0 {
+		_, tipHeight := w.MainChainTip()
+		expiryHeight = tipHeight + blocks
+	}
+
+	now := time.Now()
+	w.lockedOutpointsMu.Lock()
+	prev, ok := w.lockedOutpoints[outPoint]
+	if ok && !prev.expired(now) && prev.namespace != namespace {
+		w.lockedOutpointsMu.Unlock()
+		return errors.E(errors.Exist,
+			errors.Errorf("outpoint %v is locked in namespace %q", &outPoint,
+				prev.namespace))
+	}
+	l := outpointLock{
+		namespace:    namespace,
+		expiryHeight: expiryHeight,
+		persistent:   persistent,
+	}
+	if ttl != 0 {
+		l.expiry = now.Add(ttl)
+	}
+	w.lockedOutpoints[outPoint] = l
+	w.lockedOutpointsMu.Unlock()
+
+	if !persistent && !prev.persistent {
+		return nil
+	}
+
+	// The database is updated without holding the outpoint locks mutex, as
+	// transaction creation checks locks during database transactions.
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if !persistent {
+			return w.TxStore.DeleteOutpointLock(txmgrNs, &outPoint)
+		}
+		return w.TxStore.PutOutpointLock(txmgrNs, &udb.OutpointLock{
+			OutPoint:     outPoint,
+			Namespace:    namespace,
+			Expiry:       l.expiry,
+			ExpiryHeight: l.expiryHeight,
+		})
+	})
+	if err != nil {
+		w.lockedOutpointsMu.Lock()
+		if ok {
+			w.lockedOutpoints[outPoint] = prev
+		} else {
+			delete(w.lockedOutpoints, outPoint)
+		}
+		w.lockedOutpointsMu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// lockOutpointsNamespace locks every outpoint in a namespace until the expiry,
+// or locks none of them if any is locked in another namespace.  The locks are
+// not saved across wallet restarts.
+func (w *Wallet) lockOutpointsNamespace(outPoints []wire.OutPoint, namespace string, expiry time.Time) error {
+	now := time.Now()
+	w.lockedOutpointsMu.Lock()
+	var unpersist []wire.OutPoint
+	for i := range outPoints {
+		l, ok := w.lockedOutpoints[outPoints[i]]
+		if ok && !l.expired(now) && l.namespace != namespace {
+			w.lockedOutpointsMu.Unlock()
+			return errors.E(errors.Exist, errors.Errorf("outpoint %v is "+
+				"locked in namespace %q", &outPoints[i], l.namespace))
+		}
+		if ok && l.persistent {
+			unpersist = append(unpersist, outPoints[i])
+		}
+	}
+	for i := range outPoints {
+		w.lockedOutpoints[outPoints[i]] = outpointLock{
+			namespace: namespace,
+			expiry:    expiry,
+		}
+	}
+	w.lockedOutpointsMu.Unlock()
+
+	w.deleteOutpointLocks(unpersist)
+	return nil
+}
+
+// deleteOutpointLocks removes the persistent locks of outpoints from the
+// database.  Failures are logged, as the locks have already been released.
+func (w *Wallet) deleteOutpointLocks(outPoints []wire.OutPoint) {
+	if len(outPoints) == 0 {
+		return
+	}
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for i := range outPoints {
+			err := w.TxStore.DeleteOutpointLock(txmgrNs, &outPoints[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to remove persistent outpoint locks: %v", err)
+	}
+}
+
+// UnlockOutpointNamespace unlocks an outpoint locked in a namespace.  An error
+// with code errors.NotExist is returned if the namespace does not hold a lock
+// of the outpoint.
+func (w *Wallet) UnlockOutpointNamespace(outPoint wire.OutPoint, namespace string) error {
+	const op errors.Op = "wallet.UnlockOutpointNamespace"
+
+	w.lockedOutpointsMu.Lock()
+	l, ok := w.lockedOutpoints[outPoint]
+	if !ok || l.expired(time.Now()) || l.namespace != namespace {
+		w.lockedOutpointsMu.Unlock()
+		return errors.E(op, errors.NotExist,
+			errors.Errorf("outpoint %v is not locked in namespace %q",
+				&outPoint, namespace))
+	}
+	delete(w.lockedOutpoints, outPoint)
+	w.lockedOutpointsMu.Unlock()
+
+	if l.persistent {
+		err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			return w.TxStore.DeleteOutpointLock(txmgrNs, &outPoint)
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+// ResetLockedOutpointsNamespace unlocks every outpoint locked in a namespace.
+func (w *Wallet) ResetLockedOutpointsNamespace(namespace string) {
+	w.unlockNamespace(namespace)
+}
+
+// unlockNamespace unlocks every outpoint locked in a namespace, returning the
+// number of unexpired locks which were removed.
+func (w *Wallet) unlockNamespace(namespace string) int {
+	now := time.Now()
+	w.lockedOutpointsMu.Lock()
+	n := 0
+	var unpersist []wire.OutPoint
+	for op, l := range w.lockedOutpoints {
+		if l.namespace == namespace {
+			if !l.expired(now) {
+				n++
+			}
+			if l.persistent {
+				unpersist = append(unpersist, op)
+			}
+			delete(w.lockedOutpoints, op)
+		}
+	}
+	w.lockedOutpointsMu.Unlock()
+
+	w.deleteOutpointLocks(unpersist)
+	return n
+}
+
+// releaseOutpointLocksAtHeight releases every lock which expires at or below a
+// main chain height.  It is called after blocks are attached to the main chain.
+func (w *Wallet) releaseOutpointLocksAtHeight(height int32) {
+	w.lockedOutpointsMu.Lock()
+	var unpersist []wire.OutPoint
+	for op, l := range w.lockedOutpoints {
+		if l.expiryHeight == 0 || height < l.expiryHeight {
+			continue
+		}
+		log.Debugf("Lock of outpoint %v in namespace %q released at "+
+			"height %d", &op, l.namespace, height)
+		if l.persistent {
+			unpersist = append(unpersist, op)
+		}
+		delete(w.lockedOutpoints, op)
+	}
+	w.lockedOutpointsMu.Unlock()
+
+	w.deleteOutpointLocks(unpersist)
+}
+
+// loadOutpointLocks restores the persistent outpoint locks recorded in the
+// database, removing locks which expired while the wallet was not running.
+func (w *Wallet) loadOutpointLocks() error {
+	var locks []udb.OutpointLock
+	var tipHeight int32
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight = w.TxStore.MainChainTip(txmgrNs)
+		var err error
+		locks, err = w.TxStore.OutpointLocks(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var expired []wire.OutPoint
+	w.lockedOutpointsMu.Lock()
+	for i := range locks {
+		l := outpointLock{
+			namespace:    locks[i].Namespace,
+			expiry:       locks[i].Expiry,
+			expiryHeight: locks[i].ExpiryHeight,
+			persistent:   true,
+		}
+		if l.expired(now) || (l.expiryHeight != 0 && tipHeight >= l.expiryHeight) {
+			expired = append(expired, locks[i].OutPoint)
+			continue
+		}
+		w.lockedOutpoints[locks[i].OutPoint] = l
+	}
+	w.lockedOutpointsMu.Unlock()
+
+	w.deleteOutpointLocks(expired)
+	return nil
+}
+
+// OutpointLocks returns the unexpired locks of every namespace, sorted by
+// namespace and then by expiry.
+func (w *Wallet) OutpointLocks() []OutpointLock {
+	w.lockedOutpointsMu.Lock()
+	w.pruneOutpointLocks(time.Now())
+	locks := make([]OutpointLock, 0, len(w.lockedOutpoints))
+	for op, l := range w.lockedOutpoints {
+		locks = append(locks, OutpointLock{
+			OutPoint:     op,
+			Namespace:    l.namespace,
+			Expiry:       l.expiry,
+			ExpiryHeight: l.expiryHeight,
+			Persistent:   l.persistent,
+		})
+	}
+	w.lockedOutpointsMu.Unlock()
+
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].Namespace != locks[j].Namespace {
+			return locks[i].Namespace < locks[j].Namespace
+		}
+		return locks[i].Expiry.Before(locks[j].Expiry)
+	})
+	return locks
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestOutpointLockNamespaces(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	payout := wire.OutPoint{Hash: chainhash.Hash{1}}
+	consolidation := wire.OutPoint{Hash: chainhash.Hash{2}}
+	global := wire.OutPoint{Hash: chainhash.Hash{3}}
+
+	if err := w.LockOutpointNamespace(payout, "payout", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.LockOutpointNamespace(consolidation, "consolidation", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	w.LockOutpoint(global)
+
+	// Namespaces may not lock or unlock each other's outpoints.
+	err := w.LockOutpointNamespace(payout, "consolidation", 0)
+	if !errors.Is(errors.Exist, err) {
+		t.Errorf("locked outpoint held by another namespace: %v", err)
+	}
+	err = w.UnlockOutpointNamespace(payout, "consolidation")
+	if !errors.Is(errors.NotExist, err) {
+		t.Errorf("unlocked outpoint held by another namespace: %v", err)
+	}
+
+	// Resetting the default namespace only unlocks its own outpoints.
+	w.ResetLockedOutpoints()
+	if w.LockedOutpoint(global) {
+		t.Error("default namespace outpoint locked after reset")
+	}
+	if !w.LockedOutpoint(payout) || !w.LockedOutpoint(consolidation) {
+		t.Error("namespaced outpoints unlocked by default namespace reset")
+	}
+
+	// Expired locks are released and may be taken by other namespaces.
+	w.lockedOutpointsMu.Lock()
+	l := w.lockedOutpoints[consolidation]
+	l.expiry = time.Now().Add(-time.Second)
+	w.lockedOutpoints[consolidation] = l
+	w.lockedOutpointsMu.Unlock()
+	if w.LockedOutpoint(consolidation) {
+		t.Error("expired lock reported as locked")
+	}
+	locks := w.OutpointLocks()
+	if len(locks) != 1 || locks[0].OutPoint != payout || locks[0].Namespace != "payout" {
+		t.Errorf("unexpected locks %+v", locks)
+	}
+	if err := w.LockOutpointNamespace(consolidation, "payout", 0); err != nil {
+		t.Errorf("lock of expired outpoint: %v", err)
+	}
+
+	if err := w.UnlockOutpointNamespace(payout, "payout"); err != nil {
+		t.Fatal(err)
+	}
+	if w.LockedOutpoint(payout) {
+		t.Error("outpoint locked after unlock")
+	}
+}
+
+func TestLockOutpointsNamespaceAtomic(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	held := wire.OutPoint{Hash: chainhash.Hash{1}}
+	free := wire.OutPoint{Hash: chainhash.Hash{2}}
+	w.LockOutpoint(held)
+
+	ns := reservationNamespacePrefix + "test"
+	err := w.lockOutpointsNamespace([]wire.OutPoint{free, held}, ns,
+		time.Now().Add(time.Hour))
+	if !errors.Is(errors.Exist, err) {
+		t.Fatalf("locked outpoints held by another namespace: %v", err)
+	}
+	if w.LockedOutpoint(free) {
+		t.Fatal("outpoint locked by failed all-or-nothing lock")
+	}
+
+	err = w.lockOutpointsNamespace([]wire.OutPoint{free}, ns, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ReleaseOutputs("test"); err != nil {
+		t.Fatal(err)
+	}
+	if w.LockedOutpoint(free) {
+		t.Fatal("reserved outpoint locked after release")
+	}
+	if err := w.ReleaseOutputs("test"); !errors.Is(errors.NotExist, err) {
+		t.Fatalf("released unknown reservation: %v", err)
+	}
+}
+
+func TestPersistentOutpointLocks(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	persistent := wire.OutPoint{Hash: chainhash.Hash{1}}
+	volatile := wire.OutPoint{Hash: chainhash.Hash{2}}
+	relocked := wire.OutPoint{Hash: chainhash.Hash{3}}
+	unlocked := wire.OutPoint{Hash: chainhash.Hash{4}}
+	for _, op := range []wire.OutPoint{persistent, relocked, unlocked} {
+		if err := w.LockOutpointPersistent(op, "payout", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.LockOutpointNamespace(volatile, "payout", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.LockOutpointNamespace(relocked, "payout", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.UnlockOutpointNamespace(unlocked, "payout"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the persistent lock is restored after a restart.
+	w.lockedOutpointsMu.Lock()
+	w.lockedOutpoints = make(map[wire.OutPoint]outpointLock)
+	w.lockedOutpointsMu.Unlock()
+	if err := w.loadOutpointLocks(); err != nil {
+		t.Fatal(err)
+	}
+	locks := w.OutpointLocks()
+	if len(locks) != 1 || locks[0].OutPoint != persistent ||
+		locks[0].Namespace != "payout" || !locks[0].Persistent {
+		t.Fatalf("unexpected locks after restart %+v", locks)
+	}
+
+	// Resetting the namespace removes its persistent locks.
+	w.ResetLockedOutpointsNamespace("payout")
+	if err := w.loadOutpointLocks(); err != nil {
+		t.Fatal(err)
+	}
+	if w.LockedOutpoint(persistent) {
+		t.Fatal("persistent lock restored after namespace reset")
+	}
+}
+
+func TestOutpointLockExpiryHeight(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	_, tipHeight := w.MainChainTip()
+	short := wire.OutPoint{Hash: chainhash.Hash{1}}
+	long := wire.OutPoint{Hash: chainhash.Hash{2}}
+	if err := w.LockOutpointBlocks(short, "invoice", 0, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.LockOutpointBlocks(long, "invoice", time.Hour, 2, true); err != nil {
+		t.Fatal(err)
+	}
+	err := w.LockOutpointBlocks(long, "invoice", 0, -1, true)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("expected Invalid error, got %v", err)
+	}
+
+	w.releaseOutpointLocksAtHeight(tipHeight + 1)
+	if w.LockedOutpoint(short) {
+		t.Fatal("lock not released at its expiry height")
+	}
+	if !w.LockedOutpoint(long) {
+		t.Fatal("lock released before its expiry height")
+	}
+
+	// The expiry height of persistent locks is restored after a restart.
+	w.lockedOutpointsMu.Lock()
+	w.lockedOutpoints = make(map[wire.OutPoint]outpointLock)
+	w.lockedOutpointsMu.Unlock()
+	if err := w.loadOutpointLocks(); err != nil {
+		t.Fatal(err)
+	}
+	locks := w.OutpointLocks()
+	if len(locks) != 1 || locks[0].OutPoint != long ||
+		locks[0].ExpiryHeight != tipHeight+2 || locks[0].Expiry.IsZero() {
+		t.Fatalf("unexpected locks after restart %+v", locks)
+	}
+
+	// Released persistent locks are removed from the database.
+	w.releaseOutpointLocksAtHeight(tipHeight + 2)
+	if err := w.loadOutpointLocks(); err != nil {
+		t.Fatal(err)
+	}
+	if w.LockedOutpoint(long) {
+		t.Fatal("persistent lock restored after release at its expiry height")
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// OwnershipProofOutput is an unspent output paying an address of an ownership
+// proof.
+type OwnershipProofOutput struct {
+	OutPoint wire.OutPoint
+	Amount   vhcutil.Amount
+	Address  vhcutil.Address
+}
+
+// OwnershipProof is a statement, signed by the key of each of its addresses,
+// that the signer controls the addresses and the unspent outputs paying them
+// as of a main chain block.  Proofs are created in response to a challenge
+// chosen by the verifier, which prevents the reuse of old proofs.  Verifiers
+// must check that the claimed outputs exist and are unspent in the block
+// chain using CheckOwnershipProofOutputs.
+type OwnershipProof struct {
+	Challenge  string
+	BlockHash  chainhash.Hash
+	Height     int32
+	Addresses  []vhcutil.Address
+	Signatures [][]byte
+	Outputs    []OwnershipProofOutput
+}
+
+// Balance returns the total value of the outputs claimed by the proof.
+func (p *OwnershipProof) Balance() vhcutil.Amount {
+	var balance vhcutil.Amount
+	for i := range p.Outputs {
+		balance += p.Outputs[i].Amount
+	}
+	return balance
+}
+
+// repeatsOutput returns whether the proof claims any output more than once,
+// which would count its value towards the balance of the proof repeatedly.
+// Outputs are compared by transaction hash and index only, as the tree of an
+// outpoint does not identify a different output.
+func (p *OwnershipProof) repeatsOutput() bool {
+	seen := make(map[wire.OutPoint]struct{}, len(p.Outputs))
+	for i := range p.Outputs {
+		op := p.Outputs[i].OutPoint
+		op.Tree = 0
+		if _, ok := seen[op]; ok {
+			return true
+		}
+		seen[op] = struct{}{}
+	}
+	return false
+}
+
+// statement returns the message signed by each address of the proof.
+func (p *OwnershipProof) statement() string {
+	var b strings.Builder
+	b.WriteString("Valhalla ownership proof\n")
+	fmt.Fprintf(&b, "Challenge: %s\n", p.Challenge)
+	fmt.Fprintf(&b, "Block: %v %d\n", &p.BlockHash, p.Height)
+	for _, addr := range p.Addresses {
+		fmt.Fprintf(&b, "Address: %s\n", addr.EncodeAddress())
+	}
+	for i := range p.Outputs {
+		out := &p.Outputs[i]
+		fmt.Fprintf(&b, "Output: %v %d %d %s\n", &out.OutPoint,
+			out.OutPoint.Tree, int64(out.Amount), out.Address.EncodeAddress())
+	}
+	return b.String()
+}
+
+// CreateOwnershipProof creates a proof that the wallet controls the private
+// keys of addrs, claiming every unspent output paying these addresses which
+// was mined at or before the main chain block at height.  Proving ownership as
+// of a block below the tip allows verifiers to check the proof against a
+// block which is unlikely to be reorganized.  An error with the
+// InsufficientBalance kind is returned when the claimed outputs are worth less
+// than minBalance.
+func (w *Wallet) CreateOwnershipProof(challenge string, addrs []vhcutil.Address, minBalance vhcutil.Amount, height int32) (*OwnershipProof, error) {
+	const op errors.Op = "wallet.CreateOwnershipProof"
+	if len(addrs) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no addresses to prove ownership of")
+	}
+	if height < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative block height")
+	}
+	proof := &OwnershipProof{
+		Challenge: challenge,
+		Height:    height,
+		Addresses: make([]vhcutil.Address, 0, len(addrs)),
+	}
+	proven := make(map[string]vhcutil.Address, len(addrs))
+	for _, addr := range addrs {
+		encoded := addr.EncodeAddress()
+		if _, ok := proven[encoded]; ok {
+			continue
+		}
+		proven[encoded] = addr
+		proof.Addresses = append(proof.Addresses, addr)
+	}
+
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		proof.BlockHash, err = w.TxStore.GetMainChainBlockHashForHeight(txmgrNs, height)
+		if err != nil {
+			return err
+		}
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		for _, c := range unspent {
+			if c.Height < 0 || c.Height > proof.Height {
+				continue
+			}
+			_, scriptAddrs, _, err := txscript.ExtractPkScriptAddrs(
+				txscript.DefaultScriptVersion, c.PkScript, w.chainParams)
+			if err != nil || len(scriptAddrs) != 1 {
+				continue
+			}
+			addr, ok := proven[scriptAddrs[0].EncodeAddress()]
+			if !ok {
+				continue
+			}
+			proof.Outputs = append(proof.Outputs, OwnershipProofOutput{
+				OutPoint: c.OutPoint,
+				Amount:   c.Amount,
+				Address:  addr,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(proof.Outputs, func(i, j int) bool {
+		a, b := &proof.Outputs[i].OutPoint, &proof.Outputs[j].OutPoint
+		if c := bytes.Compare(a.Hash[:], b.Hash[:]); c != 0 {
+			return c < 0
+		}
+		return a.Index < b.Index
+	})
+	if balance := proof.Balance(); balance < minBalance {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			errors.Errorf("addresses hold %v, less than the minimum balance %v",
+				balance, minBalance))
+	}
+
+	statement := proof.statement()
+	proof.Signatures = make([][]byte, len(proof.Addresses))
+	for i, addr := range proof.Addresses {
+		proof.Signatures[i], err = w.SignMessage(statement, addr)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return proof, nil
+}
+
+// VerifyOwnershipProof verifies that every address of an ownership proof
+// signed the proof, and that every claimed output pays one of the addresses
+// and is claimed only once.  Whether the claimed outputs exist and are unspent
+// is checked by CheckOwnershipProofOutputs.
+func VerifyOwnershipProof(proof *OwnershipProof) (bool, error) {
+	const op errors.Op = "wallet.VerifyOwnershipProof"
+	if len(proof.Addresses) == 0 || len(proof.Signatures) != len(proof.Addresses) {
+		return false, nil
+	}
+	if proof.repeatsOutput() {
+		return false, nil
+	}
+	addrs := make(map[string]struct{}, len(proof.Addresses))
+	for _, addr := range proof.Addresses {
+		addrs[addr.EncodeAddress()] = struct{}{}
+	}
+	for i := range proof.Outputs {
+		if _, ok := addrs[proof.Outputs[i].Address.EncodeAddress()]; !ok {
+			return false, nil
+		}
+	}
+
+	statement := proof.statement()
+	for i, addr := range proof.Addresses {
+		valid, err := VerifyMessage(statement, addr, proof.Signatures[i])
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+		if !valid {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// CheckOwnershipProofOutputs checks with the network backend n that the block
+// of an ownership proof is in the main chain and that every claimed output
+// exists, pays the claimed amount to the claimed address, and was unspent as
+// of the block.  An output which is unspent now and was mined at or before the
+// block was unspent as of the block; outputs spent since the block fail the
+// check, as do proofs claiming an output more than once.  The backend must
+// implement UnspentOutputSource.
+func CheckOwnershipProofOutputs(ctx context.Context, n NetworkBackend, proof *OwnershipProof) (bool, error) {
+	const op errors.Op = "wallet.CheckOwnershipProofOutputs"
+	s, ok := n.(UnspentOutputSource)
+	if !ok {
+		return false, errors.E(op, errors.Invalid,
+			"network backend can not look up unspent outputs")
+	}
+
+	blockHash, err := s.MainChainBlockHash(ctx, proof.Height)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	if *blockHash != proof.BlockHash || proof.repeatsOutput() {
+		return false, nil
+	}
+	for i := range proof.Outputs {
+		claimed := &proof.Outputs[i]
+		out, height, err := s.UnspentOutput(ctx, &claimed.OutPoint)
+		if errors.Is(errors.NotExist, err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+		if height > proof.Height || out.Value != int64(claimed.Amount) {
+			return false, nil
+		}
+		pkScript, err := txscript.PayToAddrScript(claimed.Address)
+		if err != nil || !bytes.Equal(out.PkScript, pkScript) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestOwnershipProof(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record a transaction paying the address mined in the genesis block,
+	// which is the main chain tip, and an unmined one.
+	genesis := &w.chainParams.GenesisBlock.Header
+	blockMeta := &udb.BlockMeta{
+		Block: udb.Block{Hash: genesis.BlockHash()},
+		Time:  genesis.Timestamp,
+	}
+	for i, header := range []*wire.BlockHeader{genesis, nil} {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{byte(i)}, 0, wire.TxTreeRegular), 2e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+		rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			meta := blockMeta
+			if header == nil {
+				meta = nil
+			}
+			_, err := w.processTransactionRecord(dbtx, rec, header, meta)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = w.CreateOwnershipProof("challenge", []vhcutil.Address{addr}, 2e8, 0)
+	if !errors.Is(errors.InsufficientBalance, err) {
+		t.Fatalf("expected InsufficientBalance error, got %v", err)
+	}
+	_, err = w.CreateOwnershipProof("challenge", []vhcutil.Address{addr}, 0, 1)
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("expected NotExist error for a height above the tip, got %v", err)
+	}
+	proof, err := w.CreateOwnershipProof("challenge", []vhcutil.Address{addr, addr}, 1e8, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Addresses) != 1 || len(proof.Outputs) != 1 || proof.Balance() != 1e8 {
+		t.Fatalf("unexpected proof %+v", proof)
+	}
+	if ok, err := VerifyOwnershipProof(proof); !ok || err != nil {
+		t.Fatalf("invalid proof: %v", err)
+	}
+
+	// Proofs do not verify for another challenge or with other outputs.
+	proof.Challenge = "other challenge"
+	if ok, _ := VerifyOwnershipProof(proof); ok {
+		t.Fatal("proof verified for another challenge")
+	}
+	proof.Challenge = "challenge"
+	proof.Outputs[0].Amount = 2e8
+	if ok, _ := VerifyOwnershipProof(proof); ok {
+		t.Fatal("proof verified with another output amount")
+	}
+
+	// Proofs claiming an output repeatedly do not verify, even when signed,
+	// as the repeated output would inflate the proven balance.
+	proof.Outputs[0].Amount = 1e8
+	repeated := proof.Outputs[0]
+	repeated.OutPoint.Tree = wire.TxTreeStake
+	proof.Outputs = append(proof.Outputs, proof.Outputs[0], repeated)
+	proof.Signatures[0], err = w.SignMessage(proof.statement(), addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyOwnershipProof(proof); ok || err != nil {
+		t.Fatalf("proof with repeated outputs verified with balance %v: %v",
+			proof.Balance(), err)
+	}
+}
+
+// utxoNetwork is a network backend with a fixed main chain and unspent output
+// set.
+type utxoNetwork struct {
+	mockNetwork
+	blocks  []chainhash.Hash
+	unspent map[wire.OutPoint]utxoEntry
+}
+
+type utxoEntry struct {
+	out    *wire.TxOut
+	height int32
+}
+
+func (n *utxoNetwork) UnspentOutput(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, int32, error) {
+	e, ok := n.unspent[*outpoint]
+	if !ok {
+		return nil, 0, errors.E(errors.NotExist, "no unspent output")
+	}
+	return e.out, e.height, nil
+}
+
+func (n *utxoNetwork) MainChainBlockHash(ctx context.Context, height int32) (*chainhash.Hash, error) {
+	if height < 0 || int(height) >= len(n.blocks) {
+		return nil, errors.E(errors.NotExist, "no block")
+	}
+	return &n.blocks[height], nil
+}
+
+func TestCheckOwnershipProofOutputs(t *testing.T) {
+	addr, err := vhcutil.NewAddressPubKeyHash(make([]byte, 20),
+		basicWalletConfig.Params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outPoint := wire.OutPoint{Hash: chainhash.Hash{1}}
+	newProof := func() *OwnershipProof {
+		return &OwnershipProof{
+			BlockHash: chainhash.Hash{0xb1},
+			Height:    1,
+			Addresses: []vhcutil.Address{addr},
+			Outputs: []OwnershipProofOutput{
+				{OutPoint: outPoint, Amount: 1e8, Address: addr},
+			},
+		}
+	}
+	newNetwork := func() *utxoNetwork {
+		return &utxoNetwork{
+			blocks: []chainhash.Hash{{0xb0}, {0xb1}, {0xb2}},
+			unspent: map[wire.OutPoint]utxoEntry{
+				outPoint: {wire.NewTxOut(1e8, pkScript), 1},
+			},
+		}
+	}
+	ctx := context.Background()
+
+	if ok, err := CheckOwnershipProofOutputs(ctx, newNetwork(), newProof()); !ok || err != nil {
+		t.Fatalf("valid outputs failed the check: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*utxoNetwork, *OwnershipProof)
+	}{
+		{"spent output", func(n *utxoNetwork, p *OwnershipProof) {
+			delete(n.unspent, outPoint)
+		}},
+		{"output mined after the block", func(n *utxoNetwork, p *OwnershipProof) {
+			n.unspent[outPoint] = utxoEntry{n.unspent[outPoint].out, 2}
+		}},
+		{"other amount", func(n *utxoNetwork, p *OwnershipProof) {
+			p.Outputs[0].Amount = 2e8
+		}},
+		{"other script", func(n *utxoNetwork, p *OwnershipProof) {
+			n.unspent[outPoint] = utxoEntry{wire.NewTxOut(1e8, []byte{txscript.OP_TRUE}), 1}
+		}},
+		{"block not in the main chain", func(n *utxoNetwork, p *OwnershipProof) {
+			p.BlockHash = chainhash.Hash{0xff}
+		}},
+		{"repeated output", func(n *utxoNetwork, p *OwnershipProof) {
+			p.Outputs = append(p.Outputs, p.Outputs[0])
+		}},
+	}
+	for _, test := range tests {
+		n, proof := newNetwork(), newProof()
+		test.modify(n, proof)
+		ok, err := CheckOwnershipProofOutputs(ctx, n, proof)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+		if ok {
+			t.Errorf("%s: outputs passed the check", test.name)
+		}
+	}
+
+	// Backends which can not look up unspent outputs fail the check.
+	_, err = CheckOwnershipProofOutputs(ctx, mockNetwork{}, newProof())
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("expected Invalid error without an output source, got %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !windows
+// +build !windows
+
+package wallet
+
+import "syscall"
+
+// processRunning returns whether a process with the PID is running on this
+// host.
+func processRunning(pid uint32) bool {
+	err := syscall.Kill(int(pid), 0)
+	return err == nil || err == syscall.EPERM
+}
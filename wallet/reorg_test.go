@@ -49,8 +49,8 @@ func (tg *tg) createPremineBlock(name string) *gblock {
 	return &gblock{premine, n}
 }
 
-func (tg *tg) nextBlock(blockName string, spend *chaingen.SpendableOut, ticketSpends []chaingen.SpendableOut) *gblock {
-	b := tg.NextBlock(blockName, spend, ticketSpends)
+func (tg *tg) nextBlock(blockName string, spend *chaingen.SpendableOut, ticketSpends []chaingen.SpendableOut, mungers ...func(*wire.MsgBlock)) *gblock {
+	b := tg.NextBlock(blockName, spend, ticketSpends, mungers...)
 	f, err := blockcf.Regular(b)
 	if err != nil {
 		tg.Fatal(err)
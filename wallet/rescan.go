@@ -202,8 +202,14 @@ func (w *Wallet) SaveRescanned(hash *chainhash.Hash, txs []*wire.MsgTx) error {
 // startHash and height up through the recorded main chain tip block.  The
 // progress channel, if non-nil, is sent non-error progress notifications with
 // the heights the rescan has completed through, starting with the start height.
+// The progress of the rescan is recorded as the wallet's rescan status and
+// reported to the progress function, if non-nil.
 func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
-	startHash *chainhash.Hash, height int32, p chan<- RescanProgress) error {
+	startHash *chainhash.Hash, height int32, p chan<- RescanProgress,
+	progress RescanProgressFunc) (err error) {
+
+	t := w.startRescanTracker(height, progress)
+	defer func() { t.done(err) }()
 
 	blockHashStorage := make([]chainhash.Hash, maxBlocksPerRescan)
 	rescanFrom := *startHash
@@ -211,6 +217,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 	for {
 		select {
 		case <-ctx.Done():
+			log.Infof("Rescan canceled")
 			return ctx.Err()
 		default:
 		}
@@ -240,7 +247,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 			}
 		}
 		log.Infof("Rescanning block range [%v, %v]...", height, through)
-		err = n.Rescan(ctx, rescanBlocks, w)
+		err = n.Rescan(ctx, rescanBlocks, t)
 		if err != nil {
 			return err
 		}
@@ -250,6 +257,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		if err != nil {
 			return err
 		}
+		t.scanned(through)
 		if p != nil {
 			p <- RescanProgress{ScannedThrough: through}
 		}
@@ -281,7 +289,7 @@ func (w *Wallet) Rescan(ctx context.Context, n NetworkBackend, startHash *chainh
 		return errors.E(op, err)
 	}
 
-	err = w.rescan(ctx, n, startHash, startHeight, nil)
+	err = w.rescan(ctx, n, startHash, startHeight, nil, nil)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -289,10 +297,18 @@ func (w *Wallet) Rescan(ctx context.Context, n NetworkBackend, startHash *chainh
 }
 
 // RescanFromHeight is an alternative to Rescan that takes a block height
-// instead of a hash.  See Rescan for more details.
-func (w *Wallet) RescanFromHeight(ctx context.Context, n NetworkBackend, startHeight int32) error {
+// instead of a hash.  The progress function, if non-nil, is called after each
+// range of blocks is scanned and when the rescan ends.  Unlike rescans
+// performed by network syncers, the rescan may be aborted by CancelRescan.
+// See Rescan for more details.
+func (w *Wallet) RescanFromHeight(ctx context.Context, n NetworkBackend, startHeight int32,
+	progress RescanProgressFunc) error {
+
 	const op errors.Op = "wallet.RescanFromHeight"
 
+	ctx, cancel := w.cancelableRescanContext(ctx)
+	defer cancel()
+
 	var startHash chainhash.Hash
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
@@ -305,13 +321,52 @@ func (w *Wallet) RescanFromHeight(ctx context.Context, n NetworkBackend, startHe
 		return errors.E(op, err)
 	}
 
-	err = w.rescan(ctx, n, &startHash, startHeight, nil)
+	err = w.rescan(ctx, n, &startHash, startHeight, nil, progress)
 	if err != nil {
 		return errors.E(op, err)
 	}
 	return nil
 }
 
+// cancelableRescanContext returns a context for a rescan which is canceled by
+// CancelRescan.  The returned cancel function must be called when the rescan
+// ends.
+func (w *Wallet) cancelableRescanContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	w.rescanStatusMu.Lock()
+	if w.rescanCancels == nil {
+		w.rescanCancels = make(map[uint64]context.CancelFunc)
+	}
+	id := w.rescanCancelID
+	w.rescanCancelID++
+	w.rescanCancels[id] = cancel
+	w.rescanStatusMu.Unlock()
+	return ctx, func() {
+		w.rescanStatusMu.Lock()
+		delete(w.rescanCancels, id)
+		w.rescanStatusMu.Unlock()
+		cancel()
+	}
+}
+
+// CancelRescan aborts every rescan started by RescanFromHeight which is still
+// in progress, including rescans started after importing keys and scripts.
+// Aborted rescans return an error matching context.Canceled.  Returns an
+// error with the NotExist kind if no rescan can be canceled.
+func (w *Wallet) CancelRescan() error {
+	const op errors.Op = "wallet.CancelRescan"
+
+	w.rescanStatusMu.Lock()
+	defer w.rescanStatusMu.Unlock()
+	if len(w.rescanCancels) == 0 {
+		return errors.E(op, errors.NotExist, "no rescan in progress")
+	}
+	for _, cancel := range w.rescanCancels {
+		cancel()
+	}
+	return nil
+}
+
 // RescanProgress records the height the rescan has completed through and any
 // errors during processing of the rescan.
 type RescanProgress struct {
@@ -343,7 +398,7 @@ func (w *Wallet) RescanProgressFromHeight(ctx context.Context, n NetworkBackend,
 		return
 	}
 
-	err = w.rescan(ctx, n, &startHash, startHeight, p)
+	err = w.rescan(ctx, n, &startHash, startHeight, p, nil)
 	if err != nil {
 		p <- RescanProgress{Err: errors.E(op, err)}
 	}
@@ -401,5 +456,5 @@ func (w *Wallet) rescanPoint(dbtx walletdb.ReadTx) (*chainhash.Hash, error) {
 		log.Info(err)
 		return nil, err
 	}
-	return &rescanPoint, nil
+	return w.birthdayRescanPoint(dbtx, &rescanPoint, int32(h.Height)+1)
 }
@@ -0,0 +1,159 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// RescanStatus describes the progress of a rescan.
+type RescanStatus struct {
+	// StartHeight is the first block height scanned and TargetHeight is
+	// the height of the main chain tip the rescan is scanning through.
+	StartHeight  int32
+	TargetHeight int32
+
+	// ScannedThrough is the last block height scanned.  It is one less
+	// than StartHeight before any blocks are scanned.
+	ScannedThrough int32
+
+	// AddressesDiscovered counts the distinct wallet addresses paid by
+	// transactions found by the rescan.
+	AddressesDiscovered int
+
+	Started time.Time
+
+	// Done is set once the rescan ends, with Err recording the error which
+	// ended it, if any.
+	Done bool
+	Err  error
+}
+
+// Percent returns the percentage of the blocks through the target height
+// which have been scanned.
+func (s *RescanStatus) Percent() float64 {
+	total := s.TargetHeight - s.StartHeight + 1
+	if total <= 0 {
+		return 100
+	}
+	scanned := s.ScannedThrough - s.StartHeight + 1
+	switch {
+	case scanned <= 0:
+		return 0
+	case scanned >= total:
+		return 100
+	}
+	return 100 * float64(scanned) / float64(total)
+}
+
+// RescanProgressFunc is called with the status of a rescan after each range of
+// blocks is scanned and once more when the rescan ends.
+type RescanProgressFunc func(status *RescanStatus)
+
+// RescanStatus returns the status of the most recently started rescan, or nil
+// if the wallet has not been rescanned since it was loaded.
+func (w *Wallet) RescanStatus() *RescanStatus {
+	w.rescanStatusMu.Lock()
+	defer w.rescanStatusMu.Unlock()
+	if w.rescanStatus == nil {
+		return nil
+	}
+	s := *w.rescanStatus
+	return &s
+}
+
+// rescanTracker records the progress of a single rescan, reporting it to the
+// wallet, its notification clients, and an optional progress callback.
+type rescanTracker struct {
+	w        *Wallet
+	status   *RescanStatus
+	addrs    map[string]struct{}
+	progress RescanProgressFunc
+}
+
+func (w *Wallet) startRescanTracker(startHeight int32, progress RescanProgressFunc) *rescanTracker {
+	_, tipHeight := w.MainChainTip()
+	t := &rescanTracker{
+		w: w,
+		status: &RescanStatus{
+			StartHeight:    startHeight,
+			TargetHeight:   tipHeight,
+			ScannedThrough: startHeight - 1,
+			Started:        time.Now(),
+		},
+		addrs:    make(map[string]struct{}),
+		progress: progress,
+	}
+	w.rescanStatusMu.Lock()
+	w.rescanStatus = t.status
+	w.rescanStatusMu.Unlock()
+	return t
+}
+
+// update modifies the tracked status and reports the result.
+func (t *rescanTracker) update(f func(s *RescanStatus)) {
+	t.w.rescanStatusMu.Lock()
+	f(t.status)
+	s := *t.status
+	t.w.rescanStatusMu.Unlock()
+
+	t.w.NtfnServer.notifyRescanProgress(&s)
+	if t.progress != nil {
+		t.progress(&s)
+	}
+}
+
+func (t *rescanTracker) scanned(through int32) {
+	_, tipHeight := t.w.MainChainTip()
+	t.update(func(s *RescanStatus) {
+		s.ScannedThrough = through
+		s.TargetHeight = tipHeight
+		s.AddressesDiscovered = len(t.addrs)
+	})
+}
+
+func (t *rescanTracker) done(err error) {
+	t.update(func(s *RescanStatus) {
+		s.Done = true
+		s.Err = err
+		s.AddressesDiscovered = len(t.addrs)
+	})
+}
+
+// SaveRescanned records transactions from a rescanned block and counts the
+// wallet addresses they pay.
+func (t *rescanTracker) SaveRescanned(hash *chainhash.Hash, txs []*wire.MsgTx) error {
+	err := t.w.SaveRescanned(hash, txs)
+	if err != nil {
+		return err
+	}
+	return walletdb.View(t.w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, tx := range txs {
+			for _, out := range tx.TxOut {
+				_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version,
+					out.PkScript, t.w.chainParams)
+				if err != nil {
+					continue
+				}
+				for _, a := range addrs {
+					k := a.EncodeAddress()
+					if _, ok := t.addrs[k]; ok {
+						continue
+					}
+					if _, err := t.w.Manager.Address(addrmgrNs, a); err == nil {
+						t.addrs[k] = struct{}{}
+					}
+				}
+			}
+		}
+		return nil
+	})
+}
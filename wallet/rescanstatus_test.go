@@ -0,0 +1,91 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestRescanStatusPercent(t *testing.T) {
+	tests := []struct {
+		start, target, through int32
+		percent                float64
+	}{
+		{start: 1, target: 100, through: 0, percent: 0},
+		{start: 1, target: 100, through: 50, percent: 50},
+		{start: 1, target: 100, through: 100, percent: 100},
+		{start: 101, target: 100, through: 100, percent: 100},
+	}
+	for i, test := range tests {
+		s := &RescanStatus{
+			StartHeight:    test.start,
+			TargetHeight:   test.target,
+			ScannedThrough: test.through,
+		}
+		if p := s.Percent(); p != test.percent {
+			t.Errorf("test %d: percent %v, expected %v", i, p, test.percent)
+		}
+	}
+}
+
+func TestRescanTracker(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if s := w.RescanStatus(); s != nil {
+		t.Fatalf("unexpected status %+v before rescan", s)
+	}
+
+	var reported []RescanStatus
+	rt := w.startRescanTracker(0, func(s *RescanStatus) {
+		reported = append(reported, *s)
+	})
+	rt.scanned(0)
+	s := w.RescanStatus()
+	if s == nil || s.Done || s.ScannedThrough != 0 {
+		t.Fatalf("unexpected status %+v", s)
+	}
+	rescanErr := errors.E(errors.Invalid, "rescan failed")
+	rt.done(rescanErr)
+	s = w.RescanStatus()
+	if !s.Done || s.Err != rescanErr {
+		t.Fatalf("unexpected status %+v after rescan", s)
+	}
+	if len(reported) != 2 || reported[0].Done || !reported[1].Done {
+		t.Fatalf("unexpected reported progress %+v", reported)
+	}
+}
+
+func TestCancelRescan(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.CancelRescan()
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("expected NotExist error canceling without a rescan, got %v", err)
+	}
+
+	ctx, done := w.cancelableRescanContext(context.Background())
+	err = w.CancelRescan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatal("rescan context was not canceled")
+	}
+	done()
+
+	err = w.CancelRescan()
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("expected NotExist error after rescan ended, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// reservationNamespacePrefix prefixes the outpoint lock namespace of each
+// output reservation.
+const reservationNamespacePrefix = "reservation:"
+
+// reserveAttempts is the number of times output selection is repeated when
+// selected outputs are concurrently locked by another namespace.
+const reserveAttempts = 3
+
+// OutputReservation describes unspent outputs reserved by ReserveOutputs.  The
+// outputs are locked in a namespace of the reservation ID until they are
+// released or the reservation expires.
+type OutputReservation struct {
+	ID      string
+	Account uint32
+	Outputs []udb.Credit
+	Total   vhcutil.Amount
+	Expiry  time.Time
+}
+
+// ReserveOutputs selects unspent outputs of an account with at least minConf
+// confirmations totaling at least amount, and locks them for the duration of
+// the ttl so they are not spent by transactions created by the wallet or
+// reserved again.  This supports transactions which are signed externally,
+// such as by hardware wallets or multisig cosigners, where the outputs must
+// remain unspent until the signed transaction is published.  Outputs are
+// selected largest first, and are released early with ReleaseOutputs.
+//
+// Selecting and locking the outputs is atomic with respect to other
+// reservations and outpoint locks: either every selected output is reserved or
+// none are.
+func (w *Wallet) ReserveOutputs(account uint32, amount vhcutil.Amount, minConf int32, ttl time.Duration) (*OutputReservation, error) {
+	const op errors.Op = "wallet.ReserveOutputs"
+	if amount <= 0 {
+		return nil, errors.E(op, errors.Invalid, "reserved amount must be positive")
+	}
+	if ttl <= 0 {
+		return nil, errors.E(op, errors.Invalid, "reservation ttl must be positive")
+	}
+
+	var id [16]byte
+	_, err := rand.Read(id[:])
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	r := &OutputReservation{
+		ID:      hex.EncodeToString(id[:]),
+		Account: account,
+	}
+
+	for attempt := 1; ; attempt++ {
+		var eligible []udb.Credit
+		err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			if account != udb.ImportedAddrAccount {
+				lastAcct, err := w.Manager.LastAccount(addrmgrNs)
+				if err != nil {
+					return err
+				}
+				if account > lastAcct {
+					return errors.E(errors.NotExist, "account not found")
+				}
+			}
+			_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+			var err error
+			eligible, err = w.findEligibleOutputs(dbtx, account, minConf, tipHeight)
+			return err
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+
+		sort.Slice(eligible, func(i, j int) bool {
+			return eligible[i].Amount > eligible[j].Amount
+		})
+		r.Outputs = r.Outputs[:0]
+		r.Total = 0
+		for i := 0; i < len(eligible) && r.Total < amount; i++ {
+			r.Outputs = append(r.Outputs, eligible[i])
+			r.Total += eligible[i].Amount
+		}
+		if r.Total < amount {
+			return nil, errors.E(op, errors.InsufficientBalance,
+				errors.Errorf("account %d has %v of unlocked spendable "+
+					"outputs, %v requested", account, r.Total, amount))
+		}
+
+		outPoints := make([]wire.OutPoint, len(r.Outputs))
+		for i := range r.Outputs {
+			outPoints[i] = r.Outputs[i].OutPoint
+		}
+		r.Expiry = time.Now().Add(ttl)
+		err = w.lockOutpointsNamespace(outPoints,
+			reservationNamespacePrefix+r.ID, r.Expiry)
+		if err == nil {
+			break
+		}
+		if attempt == reserveAttempts {
+			return nil, errors.E(op, err)
+		}
+		log.Debugf("Retrying output reservation: %v", err)
+	}
+
+	log.Infof("Reserved %d outputs totaling %v from account %d until %v "+
+		"(reservation %s)", len(r.Outputs), r.Total, account,
+		r.Expiry.Format(time.RFC3339), r.ID)
+	return r, nil
+}
+
+// ReleaseOutputs releases the outputs of a reservation before it expires.  An
+// error with code errors.NotExist is returned if the reservation is unknown or
+// has already expired or been released.
+func (w *Wallet) ReleaseOutputs(id string) error {
+	const op errors.Op = "wallet.ReleaseOutputs"
+	if w.unlockNamespace(reservationNamespacePrefix+id) == 0 {
+		return errors.E(op, errors.NotExist,
+			errors.Errorf("no active reservation %q", id))
+	}
+	log.Infof("Released outputs of reservation %s", id)
+	return nil
+}
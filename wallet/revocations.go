@@ -0,0 +1,423 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// PendingRevocation describes a missed ticket owned by the wallet whose
+// automatic revocation is delayed to allow an operator to cancel it.
+type PendingRevocation struct {
+	Ticket    chainhash.Hash
+	Reported  time.Time
+	Scheduled time.Time
+}
+
+// pendingRevocation is a PendingRevocation with the timer that revokes the
+// ticket once the delay has elapsed.
+type pendingRevocation struct {
+	PendingRevocation
+	timer *time.Timer
+}
+
+// revocationRetryInterval is the duration to wait before retrying a delayed
+// revocation which could not be created or published.
+const revocationRetryInterval = time.Minute
+
+// RevocationDelay returns the duration that automatic revocations of missed
+// tickets are delayed.  Tickets are revoked immediately when it is not
+// positive.
+func (w *Wallet) RevocationDelay() time.Duration {
+	w.revocationsMu.Lock()
+	delay := w.revocationDelay
+	w.revocationsMu.Unlock()
+	return delay
+}
+
+// ScheduleRevocations revokes owned tickets from missedTicketHashes after the
+// revocation delay of the wallet.  Each delayed ticket is recorded in the
+// wallet database, so the revocation remains pending across restarts, and is
+// reported to PendingRevocationNotifications clients.  The revocation is not
+// created or published if it is canceled with CancelRevocation before the
+// delay elapses.  Tickets are revoked immediately by RevokeOwnedTickets when
+// the delay is not positive.
+func (w *Wallet) ScheduleRevocations(missedTicketHashes []*chainhash.Hash) error {
+	const op errors.Op = "wallet.ScheduleRevocations"
+
+	delay := w.RevocationDelay()
+	if delay <= 0 {
+		return w.RevokeOwnedTickets(missedTicketHashes)
+	}
+
+	now := time.Now()
+	var added []*PendingRevocation
+	w.revocationsMu.Lock()
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, hash := range selectOwnedTickets(w, dbtx, missedTicketHashes) {
+			// Tickets with pending or canceled revocations are not
+			// scheduled again.
+			_, err := w.TxStore.PendingRevocation(txmgrNs, hash)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(errors.NotExist, err) {
+				return err
+			}
+			r := &udb.PendingRevocation{
+				Ticket:    *hash,
+				Reported:  now,
+				Scheduled: now.Add(delay),
+			}
+			err = w.TxStore.PutPendingRevocation(txmgrNs, r)
+			if err != nil {
+				return err
+			}
+			added = append(added, &PendingRevocation{
+				Ticket:    r.Ticket,
+				Reported:  r.Reported,
+				Scheduled: r.Scheduled,
+			})
+		}
+		return nil
+	})
+	if err == nil {
+		for _, p := range added {
+			w.startRevocationTimer(p, delay)
+		}
+	}
+	w.revocationsMu.Unlock()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for _, p := range added {
+		log.Infof("Revocation of missed ticket %v is pending until %v",
+			&p.Ticket, p.Scheduled.Format(time.RFC3339))
+	}
+	if len(added) != 0 {
+		w.NtfnServer.notifyPendingRevocations(added)
+	}
+	return nil
+}
+
+// startRevocationTimer tracks a pending revocation and revokes the ticket after
+// delay.  The revocations mutex must be held.
+func (w *Wallet) startRevocationTimer(p *PendingRevocation, delay time.Duration) {
+	ticket := p.Ticket
+	w.pendingRevocations[ticket] = &pendingRevocation{
+		PendingRevocation: *p,
+		timer:             time.AfterFunc(delay, func() { w.revokePending(&ticket) }),
+	}
+}
+
+// loadPendingRevocations starts the timers of the pending revocations recorded
+// by the wallet database.  Revocations whose delay elapsed while the wallet was
+// not running are revoked immediately.  Records of canceled revocations are
+// removed once the ticket is spent or no longer recorded by the wallet.
+func (w *Wallet) loadPendingRevocations() error {
+	var pending []*PendingRevocation
+	var prune []*chainhash.Hash
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		records, err := w.TxStore.PendingRevocations(txmgrNs)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if !r.Canceled {
+				pending = append(pending, &PendingRevocation{
+					Ticket:    r.Ticket,
+					Reported:  r.Reported,
+					Scheduled: r.Scheduled,
+				})
+				continue
+			}
+			spent, err := w.ticketSpent(txmgrNs, &r.Ticket)
+			if err != nil {
+				return err
+			}
+			if spent || !w.TxStore.ExistsTx(txmgrNs, &r.Ticket) {
+				prune = append(prune, &r.Ticket)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	w.revocationsMu.Lock()
+	for _, p := range pending {
+		w.startRevocationTimer(p, p.Scheduled.Sub(now))
+	}
+	w.revocationsMu.Unlock()
+
+	if len(prune) == 0 {
+		return nil
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, ticket := range prune {
+			err := w.TxStore.DeletePendingRevocation(txmgrNs, ticket)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// revokePending revokes a ticket after its revocation delay has elapsed,
+// unless the revocation was canceled or the wallet is shutting down.  The
+// revocation is retried later when it can not be created or published, such as
+// before the wallet is synced.
+func (w *Wallet) revokePending(ticket *chainhash.Hash) {
+	w.revocationsMu.Lock()
+	p, ok := w.pendingRevocations[*ticket]
+	w.revocationsMu.Unlock()
+	if !ok {
+		return
+	}
+
+	select {
+	case <-w.quitChan():
+		return
+	default:
+	}
+
+	// Tickets spent by a vote or another revocation no longer need to be
+	// revoked.
+	var spent bool
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		spent, err = w.ticketSpent(dbtx.ReadBucket(wtxmgrNamespaceKey), ticket)
+		return err
+	})
+	if err == nil && !spent {
+		err = w.RevokeOwnedTickets([]*chainhash.Hash{ticket})
+	}
+	if err != nil {
+		log.Errorf("Failed to revoke missed ticket %v (retrying in %v): %v",
+			ticket, revocationRetryInterval, err)
+		w.revocationsMu.Lock()
+		if w.pendingRevocations[*ticket] == p {
+			p.timer = time.AfterFunc(revocationRetryInterval, func() { w.revokePending(ticket) })
+		}
+		w.revocationsMu.Unlock()
+		return
+	}
+
+	w.revocationsMu.Lock()
+	if w.pendingRevocations[*ticket] == p {
+		delete(w.pendingRevocations, *ticket)
+	}
+	w.revocationsMu.Unlock()
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.DeletePendingRevocation(txmgrNs, ticket)
+	})
+	if err != nil {
+		log.Errorf("Failed to remove pending revocation of ticket %v: %v", ticket, err)
+	}
+}
+
+// PendingRevocations returns the missed tickets whose automatic revocations
+// are pending, ordered by the time they will be revoked.
+func (w *Wallet) PendingRevocations() []*PendingRevocation {
+	w.revocationsMu.Lock()
+	pending := make([]*PendingRevocation, 0, len(w.pendingRevocations))
+	for _, p := range w.pendingRevocations {
+		pr := p.PendingRevocation
+		pending = append(pending, &pr)
+	}
+	w.revocationsMu.Unlock()
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Scheduled.Before(pending[j].Scheduled)
+	})
+	return pending
+}
+
+// CancelRevocation cancels the pending automatic revocation of a missed
+// ticket.  The cancellation is recorded in the wallet database, and canceled
+// tickets are not revoked automatically again, but may still be revoked with
+// RevokeTickets or RevokeExpiredTickets.  An error with code NotExist is
+// returned when no revocation of the ticket is pending.
+func (w *Wallet) CancelRevocation(ticket *chainhash.Hash) error {
+	const op errors.Op = "wallet.CancelRevocation"
+
+	w.revocationsMu.Lock()
+	defer w.revocationsMu.Unlock()
+	p, ok := w.pendingRevocations[*ticket]
+	if !ok {
+		return errors.E(op, errors.NotExist, errors.Errorf("no pending revocation of ticket %v", ticket))
+	}
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.PutPendingRevocation(txmgrNs, &udb.PendingRevocation{
+			Ticket:    p.Ticket,
+			Reported:  p.Reported,
+			Scheduled: p.Scheduled,
+			Canceled:  true,
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	p.timer.Stop()
+	delete(w.pendingRevocations, *ticket)
+	log.Infof("Canceled pending revocation of missed ticket %v", ticket)
+	return nil
+}
+
+// RevokeMissedTickets revokes the tickets returned by RevocableTickets.  It is
+// similar to RevokeTickets but is able to be used with any Peer implementation,
+// as missed tickets are determined from the missed votes detected by the
+// wallet instead of querying the consensus RPC server.  Unlike
+// RevokeExpiredTickets, missed tickets are revoked before they expire.
+func (w *Wallet) RevokeMissedTickets(ctx context.Context, p Peer) error {
+	const opf = "wallet.RevokeMissedTickets(%v)"
+
+	revocable, err := w.RevocableTickets()
+	if err != nil {
+		return errors.E(errors.Opf(opf, p), err)
+	}
+	tickets := make([]chainhash.Hash, len(revocable))
+	for i, r := range revocable {
+		tickets[i] = r.Ticket
+	}
+	err = w.revokeTicketsWithPeer(ctx, p, tickets)
+	if err != nil {
+		return errors.E(errors.Opf(opf, p), err)
+	}
+	return nil
+}
+
+// scheduleMissedRevocations schedules the revocations of tickets detected as
+// missed by checkMissedVotes.  This does not depend on the network backend
+// reporting missed tickets, so missed tickets are revoked promptly when
+// synchronizing with SPV.  Tickets which are also reported by the backend are
+// only revoked once.
+func (w *Wallet) scheduleMissedRevocations(missed []*MissedVote) {
+	if len(missed) == 0 || !w.VotingEnabled() {
+		return
+	}
+	tickets := make([]*chainhash.Hash, len(missed))
+	for i, m := range missed {
+		tickets[i] = &m.Ticket
+	}
+	go func() {
+		err := w.ScheduleRevocations(tickets)
+		if err != nil {
+			log.Errorf("Failed to revoke missed tickets: %v", err)
+		}
+	}()
+}
+
+// ticketSpent returns whether a ticket recorded by the transaction store is
+// spent by a mined or unmined vote or revocation.
+func (w *Wallet) ticketSpent(txmgrNs walletdb.ReadBucket, ticketHash *chainhash.Hash) (bool, error) {
+	details, err := w.TxStore.TxDetails(txmgrNs, ticketHash)
+	if errors.Is(errors.NotExist, err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	ticket, err := w.TxStore.TicketDetails(txmgrNs, details)
+	if err != nil {
+		return false, err
+	}
+	return ticket != nil && ticket.Spender != nil, nil
+}
+
+// RevocableTicket describes a mined ticket with voting authority held by the
+// wallet which was missed or has expired but has not been revoked.
+type RevocableTicket struct {
+	Ticket      chainhash.Hash
+	Status      TicketStatus // TicketStatusMissed or TicketStatusExpired
+	BlockHeight int32
+	Fee         vhcutil.Amount // Estimated revocation fee at the relay fee
+}
+
+// RevocableTickets returns the unrevoked tickets owned by the wallet which are
+// eligible for revocation, ordered by the height of the blocks they were mined
+// in.  Expired tickets are determined from the main chain tip and missed
+// tickets from the missed votes detected by the wallet, so the results do not
+// depend on the network backend.
+func (w *Wallet) RevocableTickets() ([]*RevocableTicket, error) {
+	const op errors.Op = "wallet.RevocableTickets"
+
+	missed := make(map[chainhash.Hash]struct{})
+	for _, m := range w.MissedVotes() {
+		missed[m.Ticket] = struct{}{}
+	}
+
+	feePerKb := w.RelayFee()
+	var revocable []*RevocableTicket
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		it := w.TxStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			if it.SpenderHash != (chainhash.Hash{}) || it.Block.Height == -1 {
+				continue
+			}
+			var status TicketStatus
+			_, isMissed := missed[it.TxRecord.Hash]
+			switch {
+			case ticketExpired(w.chainParams, it.Block.Height, tipHeight):
+				status = TicketStatusExpired
+			case isMissed:
+				status = TicketStatusMissed
+			default:
+				continue
+			}
+
+			owned, err := w.hasVotingAuthority(addrmgrNs, &it.MsgTx)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				continue
+			}
+
+			revocation, err := createUnsignedRevocation(&it.TxRecord.Hash,
+				&it.MsgTx, feePerKb)
+			if err != nil {
+				return err
+			}
+			revocable = append(revocable, &RevocableTicket{
+				Ticket:      it.TxRecord.Hash,
+				Status:      status,
+				BlockHeight: it.Block.Height,
+				Fee:         txFee(revocation),
+			})
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	sort.SliceStable(revocable, func(i, j int) bool {
+		return revocable[i].BlockHeight < revocable[j].BlockHeight
+	})
+	return revocable, nil
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestScheduleRevocations(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.RevocationDelay = time.Hour
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	ticket := testOwnedTicket(t, w)
+	ticketHash := ticket.TxHash()
+	err := w.AcceptMempoolTx(ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ntfns := w.NtfnServer.PendingRevocationNotifications()
+	defer ntfns.Done()
+
+	// Tickets not owned by the wallet are never delayed, and owned tickets
+	// are only scheduled once.
+	unowned := chainhash.Hash{1}
+	for i := 0; i < 2; i++ {
+		err = w.ScheduleRevocations([]*chainhash.Hash{&unowned, &ticketHash})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending := w.PendingRevocations()
+	if len(pending) != 1 || pending[0].Ticket != ticketHash {
+		t.Fatalf("unexpected pending revocations %+v", pending)
+	}
+	n := <-ntfns.C
+	if len(n.Revocations) != 1 || n.Revocations[0].Ticket != ticketHash {
+		t.Fatalf("unexpected notification %+v", n.Revocations)
+	}
+
+	// Notifying does not wait for clients which are not receiving.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i <= pendingRevocationNotificationsBuffer; i++ {
+			w.NtfnServer.notifyPendingRevocations(pending)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("notifying pending revocations blocked on client")
+	}
+
+	// Pending revocations are restored when the wallet is opened again.
+	w.Stop()
+	w.WaitForShutdown()
+	w, err = Open(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Start()
+	defer func() {
+		w.Stop()
+		w.WaitForShutdown()
+	}()
+	pending = w.PendingRevocations()
+	if len(pending) != 1 || pending[0].Ticket != ticketHash {
+		t.Fatalf("pending revocations %+v after reopening wallet", pending)
+	}
+
+	// Canceled revocations are not scheduled again.
+	err = w.CancelRevocation(&ticketHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.CancelRevocation(&ticketHash)
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("canceling twice: expected NotExist, got %v", err)
+	}
+	err = w.ScheduleRevocations([]*chainhash.Hash{&ticketHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(w.PendingRevocations()); n != 0 {
+		t.Fatalf("canceled revocation was scheduled again (%d pending)", n)
+	}
+}
+
+func TestPendingRevocationTimer(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.RevocationDelay = 10 * time.Millisecond
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	ticket := testOwnedTicket(t, w)
+	ticketHash := ticket.TxHash()
+	err := w.AcceptMempoolTx(ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ScheduleRevocations([]*chainhash.Hash{&ticketHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The ticket is revoked once the delay elapses, and the pending
+	// revocation is removed.
+	deadline := time.Now().Add(10 * time.Second)
+	for len(w.PendingRevocations()) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("pending revocation was not revoked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		spent, err := w.ticketSpent(txmgrNs, &ticketHash)
+		if err != nil {
+			return err
+		}
+		if !spent {
+			t.Error("ticket was not revoked")
+		}
+		_, err = w.TxStore.PendingRevocation(txmgrNs, &ticketHash)
+		if !errors.Is(errors.NotExist, err) {
+			t.Errorf("revoked ticket is still recorded as pending: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRevocableTickets(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.RevocationDelay = time.Hour
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+	attach := func(b *gblock, relevantTxs map[chainhash.Hash][]*wire.MsgTx) {
+		t.Helper()
+		mustAddBlockNode(t, forest, b.BlockNode)
+		bestChain := tw.evaluateBestChain(forest, 1, b.Hash)
+		_, err := w.ChainSwitch(forest, bestChain, relevantTxs)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ticket := testOwnedTicket(t, w)
+	ticketHash := ticket.TxHash()
+
+	attach(tg.createPremineBlock("premine"), nil)
+	b := tg.nextBlock("ticket", nil, nil, func(b *wire.MsgBlock) {
+		b.STransactions = append(b.STransactions, ticket)
+	})
+	attach(b, map[chainhash.Hash][]*wire.MsgTx{*b.Hash: {ticket}})
+	for i := 0; i <= int(cfg.Params.TicketMaturity); i++ {
+		b = tg.nextBlock(fmt.Sprintf("b%d", i), nil, nil)
+		attach(b, nil)
+	}
+
+	// Live tickets may not be revoked.
+	revocable, err := w.RevocableTickets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revocable) != 0 {
+		t.Fatalf("live ticket is revocable: %+v", revocable[0])
+	}
+
+	w.selectedTickets[*b.Hash] = &selectedTickets{
+		height:  int32(b.MsgBlock.Header.Height),
+		tickets: []chainhash.Hash{ticketHash},
+	}
+	attach(tg.nextBlock("missed", nil, nil), nil)
+
+	revocable, err = w.RevocableTickets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revocable) != 1 {
+		t.Fatalf("expected 1 revocable ticket, got %d", len(revocable))
+	}
+	r := revocable[0]
+	if r.Ticket != ticketHash || r.Status != TicketStatusMissed || r.BlockHeight != 2 {
+		t.Fatalf("unexpected revocable ticket %+v", r)
+	}
+	if r.Fee <= 0 {
+		t.Fatalf("revocation fee estimate %v is not positive", r.Fee)
+	}
+}
+
+// testOwnedTicket returns a ticket whose voting address is owned by the wallet.
+func testOwnedTicket(t *testing.T, w *Wallet) *wire.MsgTx {
+	t.Helper()
+	votingAddr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &SplitTicketSession{
+		Network:       w.chainParams.Name,
+		TicketPrice:   1e8,
+		Expiry:        1000,
+		VotingAddress: votingAddr.String(),
+		Contributions: []SplitTicketContribution{{
+			Amount:            1e8,
+			CommitmentAddress: splitTicketTestAddr(t, 2),
+			ChangeAddress:     splitTicketTestAddr(t, 3),
+			Inputs: []SplitTicketInput{{
+				Hash:     chainhash.Hash{1}.String(),
+				Amount:   2e8,
+				PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+			}},
+		}},
+	}
+	ticket, err := s.Ticket(w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ticket
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// RotateAccount retires an account whose extended public key has leaked by
+// creating a successor account named successorName and marking the account
+// receive-only.  Previously derived addresses of the rotated account remain
+// watched, but no new addresses are derived for it.  Funds are migrated to the
+// successor with SweepRotatedAccount.  The successor account number is
+// returned.
+func (w *Wallet) RotateAccount(account uint32, successorName string) (uint32, error) {
+	const op errors.Op = "wallet.RotateAccount"
+	if account == udb.ImportedAddrAccount {
+		return 0, errors.E(op, errors.Invalid, "the imported account may not be rotated")
+	}
+
+	w.addressBuffersMu.Lock()
+	_, ok := w.addressBuffers[account]
+	r, rotated := w.rotations[account]
+	w.addressBuffersMu.Unlock()
+	if !ok {
+		return 0, errors.E(op, errors.NotExist, errors.Errorf("account %d", account))
+	}
+	if rotated {
+		return 0, errors.E(op, errors.Exist, errors.Errorf("account %d was "+
+			"already rotated to account %d", account, r.Successor))
+	}
+
+	// The successor account and the rotation are recorded by a single
+	// transaction so a failure can not leave a successor without a rotated
+	// account.
+	var successor *newAccount
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		rotations, err := w.Manager.AccountRotations(ns)
+		if err != nil {
+			return err
+		}
+		if r, ok := rotations[account]; ok {
+			return errors.E(errors.Exist, errors.Errorf("account %d was "+
+				"already rotated to account %d", account, r.Successor))
+		}
+		successor, err = w.nextAccount(dbtx, successorName)
+		if err != nil {
+			return err
+		}
+		r = &udb.AccountRotation{
+			Successor: successor.account,
+			Time:      time.Now(),
+		}
+		return w.Manager.PutAccountRotation(ns, account, r)
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	w.addressBuffersMu.Lock()
+	w.rotations[account] = r
+	w.addressBuffersMu.Unlock()
+
+	err = w.loadNewAccount(successor)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	log.Infof("Rotated account %d to successor account %d (%q)", account,
+		successor.account, successorName)
+	return successor.account, nil
+}
+
+// AccountRotation returns the rotation of an account.  An errors.NotExist
+// error is returned if the account has not been rotated.
+func (w *Wallet) AccountRotation(account uint32) (*udb.AccountRotation, error) {
+	const op errors.Op = "wallet.AccountRotation"
+	defer w.addressBuffersMu.Unlock()
+	w.addressBuffersMu.Lock()
+	r, ok := w.rotations[account]
+	if !ok {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("account %d has not been rotated", account))
+	}
+	rc := *r
+	return &rc, nil
+}
+
+// SweepRotatedAccount migrates funds of a rotated account to a new internal
+// address of its successor account, spending at most maxInputs outputs.
+// Limiting the inputs allows funds to be migrated over several transactions
+// as they confirm.  The transaction is recorded as an internal transfer.
+func (w *Wallet) SweepRotatedAccount(account uint32, maxInputs int) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.SweepRotatedAccount"
+	if maxInputs < 1 {
+		return nil, errors.E(op, errors.Invalid, "at least one input must be swept")
+	}
+	r, err := w.AccountRotation(account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	required, err := w.SendApprovalRequired(account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if required {
+		return nil, errors.E(op, errApprovalRequired(account))
+	}
+
+	addr, err := w.NewInternalAddress(r.Successor, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	req := consolidateRequest{
+		minInputs: 1,
+		opts:      ConsolidateOptions{MaxInputs: maxInputs},
+		account:   account,
+		address:   addr,
+		resp:      make(chan consolidateResponse),
+	}
+	w.consolidateRequests <- req
+	resp := <-req.resp
+	if resp.err != nil {
+		return nil, errors.E(op, resp.err)
+	}
+
+	hash := resp.txHash
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.PutInternalTransfer(txmgrNs, hash, &udb.InternalTransfer{
+			FromAccount: account,
+			ToAccount:   r.Successor,
+		})
+	})
+	if err != nil {
+		// The transaction has already been published, so only log the
+		// failure to categorize it.
+		log.Errorf("Failed to record transaction %v as an internal transfer: %v",
+			hash, err)
+	}
+	log.Infof("Swept rotated account %d to account %d in transaction %v",
+		account, r.Successor, hash)
+	return hash, nil
+}
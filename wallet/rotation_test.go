@@ -0,0 +1,78 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestRotateAccount(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dbRotations := func() map[uint32]*udb.AccountRotation {
+		t.Helper()
+		var rotations map[uint32]*udb.AccountRotation
+		err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			var err error
+			rotations, err = w.Manager.AccountRotations(ns)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rotations
+	}
+
+	leaked, err := w.NextAccount("leaked")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A successor which can not be created must not leave the account
+	// recorded as rotated.
+	_, err = w.RotateAccount(leaked, "leaked")
+	if err == nil {
+		t.Fatal("rotated to a successor with a duplicate name")
+	}
+	if len(dbRotations()) != 0 {
+		t.Fatal("failed rotation was recorded")
+	}
+	if _, err := w.AccountRotation(leaked); !errors.Is(errors.NotExist, err) {
+		t.Fatalf("failed rotation is visible: %v", err)
+	}
+
+	successor, err := w.RotateAccount(leaked, "successor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := w.AccountNumber("successor"); err != nil || n != successor {
+		t.Fatalf("successor account %d, lookup %d: %v", successor, n, err)
+	}
+	if r := dbRotations()[leaked]; r == nil || r.Successor != successor {
+		t.Fatalf("recorded rotation %+v", r)
+	}
+	if r, err := w.AccountRotation(leaked); err != nil || r.Successor != successor {
+		t.Fatalf("rotation %+v: %v", r, err)
+	}
+
+	_, err = w.RotateAccount(leaked, "another")
+	if !errors.Is(errors.Exist, err) {
+		t.Fatalf("rotated twice: %v", err)
+	}
+	if _, err := w.AccountNumber("another"); err == nil {
+		t.Fatal("second rotation created a successor")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"strings"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// MinSearchQueryLen is the minimum length of a search query.  Shorter queries
+// would match most records of a wallet.
+const MinSearchQueryLen = 3
+
+// SearchMatchKind describes the kind of wallet record matched by a search.
+type SearchMatchKind uint8
+
+// Kinds of search matches.
+const (
+	SearchMatchTransaction SearchMatchKind = iota
+	SearchMatchAddress
+	SearchMatchAccount
+	SearchMatchDepositReference
+)
+
+// String returns the name of the kind of record.
+func (k SearchMatchKind) String() string {
+	switch k {
+	case SearchMatchTransaction:
+		return "transaction"
+	case SearchMatchAddress:
+		return "address"
+	case SearchMatchAccount:
+		return "account"
+	case SearchMatchDepositReference:
+		return "depositreference"
+	default:
+		return "unknown"
+	}
+}
+
+// SearchMatch describes a wallet record matched by a search.  TxHash, Height
+// and Time are only set for transaction matches, Reference is only set for
+// deposit reference matches, and Address is set for address and deposit
+// reference matches.  Height is -1 for unmined transactions.
+type SearchMatch struct {
+	Kind      SearchMatchKind
+	TxHash    *chainhash.Hash
+	Height    int32
+	Time      time.Time
+	Address   string
+	Account   uint32
+	Reference string
+}
+
+// Search returns up to limit wallet records matching query, which may be part
+// of a transaction hash, an address, an account name, or the reference of an
+// assigned deposit address.  Addresses are matched case-sensitively, and other
+// records regardless of case.  Transactions are searched from the newest,
+// followed by addresses, accounts, and deposit references.  A limit of zero or
+// less returns every match.
+func (w *Wallet) Search(query string, limit int) ([]*SearchMatch, error) {
+	const op errors.Op = "wallet.Search"
+
+	if len(query) < MinSearchQueryLen {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("search query must be at least %d characters", MinSearchQueryLen))
+	}
+	lowerQuery := strings.ToLower(query)
+
+	var matches []*SearchMatch
+	full := func() bool { return limit > 0 && len(matches) >= limit }
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			for i := len(details) - 1; i >= 0; i-- {
+				d := &details[i]
+				if !strings.Contains(d.Hash.String(), lowerQuery) {
+					continue
+				}
+				hash := d.Hash
+				m := &SearchMatch{
+					Kind:   SearchMatchTransaction,
+					TxHash: &hash,
+					Height: d.Height(),
+					Time:   d.Received,
+				}
+				if m.Height != -1 {
+					m.Time = d.Block.Time
+				}
+				matches = append(matches, m)
+				if full() {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		err := w.TxStore.RangeTransactions(txmgrNs, -1, 0, rangeFn)
+		if err != nil || full() {
+			return err
+		}
+
+		var accounts []uint32
+		err = w.Manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			accounts = append(accounts, account)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		errFull := errors.New("full")
+		for _, account := range accounts {
+			err := w.Manager.ForEachAccountAddress(addrmgrNs, account, func(maddr udb.ManagedAddress) error {
+				addr := maddr.Address().String()
+				if !strings.Contains(addr, query) {
+					return nil
+				}
+				matches = append(matches, &SearchMatch{
+					Kind:    SearchMatchAddress,
+					Address: addr,
+					Account: account,
+				})
+				if full() {
+					return errFull
+				}
+				return nil
+			})
+			if err == errFull {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, account := range accounts {
+			name, err := w.Manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(strings.ToLower(name), lowerQuery) {
+				continue
+			}
+			matches = append(matches, &SearchMatch{
+				Kind:    SearchMatchAccount,
+				Account: account,
+			})
+			if full() {
+				return nil
+			}
+		}
+
+		deposits, err := w.Manager.AllDepositAddresses(addrmgrNs)
+		if err != nil {
+			return err
+		}
+		for _, a := range deposits {
+			if a.Reference == "" || !strings.Contains(strings.ToLower(a.Reference), lowerQuery) {
+				continue
+			}
+			matches = append(matches, &SearchMatch{
+				Kind:      SearchMatchDepositReference,
+				Address:   a.Address,
+				Account:   a.Account,
+				Reference: a.Reference,
+			})
+			if full() {
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return matches, nil
+}
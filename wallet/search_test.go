@@ -0,0 +1,54 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestSearch(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = w.Search("ab", 0)
+	if !errors.Is(errors.Invalid, err) {
+		t.Errorf("short query: expected Invalid, got %v", err)
+	}
+
+	query := addr.String()[5:15]
+	matches, err := w.Search(query, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, m := range matches {
+		if m.Kind != SearchMatchAddress || !strings.Contains(m.Address, query) {
+			t.Errorf("unexpected match %+v for query %q", m, query)
+		}
+		if m.Address == addr.String() && m.Account == 0 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("address %v not found by query %q", addr, query)
+	}
+
+	matches, err = w.Search("DEFAULT", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].Kind != SearchMatchAccount || matches[0].Account != 0 {
+		t.Errorf("default account not found by case-insensitive query: %+v", matches)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"crypto/rand"
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// errApprovalRequired describes sends which were refused because the account
+// requires sends to be queued and approved.
+func errApprovalRequired(account uint32) error {
+	return errors.E(errors.Permission, errors.Errorf("sends from account %d "+
+		"must be queued for approval", account))
+}
+
+// SendApprovalRequired returns whether sends from an account must be queued
+// with QueueSend and approved with ApproveSend.
+func (w *Wallet) SendApprovalRequired(account uint32) (bool, error) {
+	const op errors.Op = "wallet.SendApprovalRequired"
+	var required bool
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		required = w.TxStore.SendApprovalRequired(txmgrNs, account)
+		return nil
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return required, nil
+}
+
+// SetSendApproval sets the passphrase required to approve sends from an
+// account.  An empty newPassphrase removes the approval requirement.  If the
+// account already requires approval, currentPassphrase must match the current
+// approval passphrase.
+func (w *Wallet) SetSendApproval(account uint32, currentPassphrase, newPassphrase []byte) error {
+	const op errors.Op = "wallet.SetSendApproval"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		// Ensure the account exists.
+		_, err := w.Manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+
+		if w.TxStore.SendApprovalRequired(txmgrNs, account) {
+			ok, err := w.TxStore.CheckSendApprovalPassphrase(txmgrNs, account, currentPassphrase)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.E(errors.Passphrase, "incorrect approval passphrase")
+			}
+		}
+		return w.TxStore.PutSendApprovalPassphrase(txmgrNs, account, newPassphrase)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// QueueSend records a send of outputs from an account which must be approved,
+// either because the account requires send approval or because the caller
+// requires every send to be approved.  The requester identifies the principal
+// which requested the send, which may not approve it.  The transaction is not
+// created until the send is approved with ApproveSend.
+func (w *Wallet) QueueSend(outputs []*wire.TxOut, account uint32, minconf int32, requester string) (*udb.PendingSend, error) {
+	const op errors.Op = "wallet.QueueSend"
+	relayFee := w.RelayFee()
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, relayFee)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	p := &udb.PendingSend{
+		Account:   account,
+		MinConf:   minconf,
+		Time:      time.Now(),
+		Requester: requester,
+		Outputs:   outputs,
+	}
+	_, err := rand.Read(p.ID[:])
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		// Ensure the account exists.
+		_, err := w.Manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.PutPendingSend(txmgrNs, p)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	log.Infof("Queued send %x from account %d pending approval", p.ID[:], account)
+	return p, nil
+}
+
+// PendingSends returns all sends awaiting approval, oldest first.
+func (w *Wallet) PendingSends() ([]*udb.PendingSend, error) {
+	const op errors.Op = "wallet.PendingSends"
+	var sends []*udb.PendingSend
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		sends, err = w.TxStore.PendingSends(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.SliceStable(sends, func(i, j int) bool {
+		return sends[i].Time.Before(sends[j].Time)
+	})
+	return sends, nil
+}
+
+// ApproveSend creates, signs, and publishes the transaction of a pending send.
+// The approver identifies the approving principal, which must differ from the
+// requester of the send.  When the sending account requires send approval,
+// its approval passphrase must be provided.  If the transaction can not be
+// created, the send remains queued.
+func (w *Wallet) ApproveSend(id *[16]byte, approver string, passphrase []byte) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.ApproveSend"
+
+	// The approval is checked in a read-only transaction, as deriving the
+	// key of the approval passphrase is slow.
+	var p *udb.PendingSend
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		p, err = w.TxStore.PendingSend(txmgrNs, id)
+		if err != nil {
+			return err
+		}
+		if approver == p.Requester {
+			return errors.E(errors.Permission, "sends may not be approved "+
+				"by their requester")
+		}
+		if !w.TxStore.SendApprovalRequired(txmgrNs, p.Account) {
+			return nil
+		}
+		ok, err := w.TxStore.CheckSendApprovalPassphrase(txmgrNs, p.Account, passphrase)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.E(errors.Passphrase, "incorrect approval passphrase")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// The pending send is removed before the transaction is created so that
+	// concurrent approvals can not create multiple transactions.
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		_, err := w.TxStore.PendingSend(txmgrNs, id)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.DeletePendingSend(txmgrNs, id)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	req := createTxRequest{
+		account: p.Account,
+		outputs: p.Outputs,
+		minconf: p.MinConf,
+		resp:    make(chan createTxResponse),
+	}
+	w.createTxRequests <- req
+	resp := <-req.resp
+	if resp.err != nil {
+		requeueErr := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			return w.TxStore.PutPendingSend(txmgrNs, p)
+		})
+		if requeueErr != nil {
+			log.Errorf("Failed to requeue pending send %x: %v", id[:], requeueErr)
+		}
+		return nil, errors.E(op, resp.err)
+	}
+
+	hash := resp.tx.Tx.TxHash()
+	log.Infof("Approved send %x as transaction %v", id[:], &hash)
+	return &hash, nil
+}
+
+// RejectSend removes a send awaiting approval without creating its
+// transaction.
+func (w *Wallet) RejectSend(id *[16]byte) error {
+	const op errors.Op = "wallet.RejectSend"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		_, err := w.TxStore.PendingSend(txmgrNs, id)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.DeletePendingSend(txmgrNs, id)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	log.Infof("Rejected send %x", id[:])
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestApproveSend(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 5e8)
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := []*wire.TxOut{wire.NewTxOut(1e8, pkScript)}
+
+	// Sends may be queued for accounts which do not require approval, such
+	// as when every send must be approved.
+	p, err := w.QueueSend(outputs, 0, 1, "clerk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sends, err := w.PendingSends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sends) != 1 || sends[0].ID != p.ID || sends[0].Requester != "clerk" {
+		t.Fatalf("pending sends %+v", sends)
+	}
+
+	// Sends may not be approved by their requester.
+	_, err = w.ApproveSend(&p.ID, "clerk", nil)
+	if !errors.Is(errors.Permission, err) {
+		t.Fatalf("approval by requester: got error %v", err)
+	}
+
+	// Accounts requiring approval require the approval passphrase.
+	err = w.SetSendApproval(0, nil, []byte("approve"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.ApproveSend(&p.ID, "manager", []byte("wrong"))
+	if !errors.Is(errors.Passphrase, err) {
+		t.Fatalf("approval with incorrect passphrase: got error %v", err)
+	}
+	if _, err := w.ApproveSend(&p.ID, "manager", []byte("approve")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Approved sends are removed from the queue.
+	sends, err = w.PendingSends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sends) != 0 {
+		t.Fatalf("%d sends pending after approval", len(sends))
+	}
+	_, err = w.ApproveSend(&p.ID, "manager", []byte("approve"))
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("second approval: got error %v", err)
+	}
+}
+
+func TestSendApprovalMultisig(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 5e8)
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := vhcutil.NewAddressSecpPubKey(key.PubKey().SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkeys := []*vhcutil.AddressSecpPubKey{pubkey}
+
+	err = w.SetSendApproval(0, nil, []byte("approve"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = w.CreateMultisigTx(0, 1e8, pubkeys, 1, 0)
+	if !errors.Is(errors.Permission, err) {
+		t.Fatalf("multisig send requiring approval: got error %v", err)
+	}
+
+	err = w.SetSendApproval(0, []byte("approve"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = w.CreateMultisigTx(0, 1e8, pubkeys, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/txauthor"
+)
+
+// SendStage describes a stage of authoring and publishing a transaction.
+type SendStage string
+
+// Stages of authoring and publishing a transaction.
+const (
+	SendStageSelection SendStage = "selection"
+	SendStageSigning   SendStage = "signing"
+	SendStagePolicy    SendStage = "policy"
+	SendStageRecording SendStage = "recording"
+	SendStagePublish   SendStage = "publish"
+)
+
+// SendError describes the context of a failure to author or publish a
+// transaction.  It is nested in the *errors.Error returned by methods sending
+// transactions, and may be found with FindSendError.
+type SendError struct {
+	// Stage is the stage which failed.
+	Stage SendStage
+
+	// Inputs is the number of inputs selected for the transaction.
+	Inputs int
+
+	// Required is the total input value required to pay the outputs and
+	// the fee, and Available is the total value of the selected inputs.
+	Required  vhcutil.Amount
+	Available vhcutil.Amount
+
+	// RejectReason is the reason the network rejected the transaction when
+	// publishing fails.
+	RejectReason string
+
+	Err error
+}
+
+// Error returns the message of the failure.
+func (e *SendError) Error() string {
+	return e.Err.Error()
+}
+
+// Kind returns the kind of the error, or errors.Other if it was not
+// classified.
+func (e *SendError) Kind() errors.Kind {
+	return errorKind(e.Err)
+}
+
+// Temporary returns whether the send may succeed if retried later without
+// changing the request, such as after outputs confirm, the wallet is
+// unlocked, or the network becomes reachable.
+func (e *SendError) Temporary() bool {
+	switch e.Kind() {
+	case errors.InsufficientBalance, errors.Locked, errors.NoPeers, errors.IO:
+		return true
+	}
+	return false
+}
+
+// FindSendError returns the SendError nested in err.
+func FindSendError(err error) (*SendError, bool) {
+	for {
+		switch e := err.(type) {
+		case *SendError:
+			return e, true
+		case *errors.Error:
+			err = e.Err
+		default:
+			return nil, false
+		}
+	}
+}
+
+// errorKind returns the first kind other than errors.Other of err and its
+// nested errors.
+func errorKind(err error) errors.Kind {
+	for {
+		e, ok := err.(*errors.Error)
+		if !ok {
+			return errors.Other
+		}
+		if e.Kind != errors.Other {
+			return e.Kind
+		}
+		err = e.Err
+	}
+}
+
+// inputSelection records the inputs most recently selected for a
+// transaction.
+type inputSelection struct {
+	inputs    int
+	required  vhcutil.Amount
+	available vhcutil.Amount
+}
+
+// wrap returns an input source recording the selections of source.
+func (s *inputSelection) wrap(source txauthor.InputSource) txauthor.InputSource {
+	return func(target vhcutil.Amount) (*txauthor.InputDetail, error) {
+		detail, err := source(target)
+		s.required = target
+		if detail != nil {
+			s.inputs = len(detail.Inputs)
+			s.available = detail.Amount
+		}
+		return detail, err
+	}
+}
+
+// sendError returns err with the context of the failed stage and input
+// selection.  The kind of err is promoted so that errors.Is continues to
+// match it.
+func sendError(op errors.Op, stage SendStage, sel *inputSelection, err error) error {
+	e := &SendError{
+		Stage:     stage,
+		Inputs:    sel.inputs,
+		Required:  sel.required,
+		Available: sel.available,
+		Err:       err,
+	}
+	if stage == SendStagePublish {
+		// Report the message of the peer or consensus server rather than
+		// the operations wrapping it.
+		reason := err
+		for {
+			werr, ok := reason.(*errors.Error)
+			if !ok || werr.Err == nil {
+				break
+			}
+			reason = werr.Err
+		}
+		e.RejectReason = reason.Error()
+	}
+	return errors.E(op, e.Kind(), e)
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
 	"github.com/valhallacoin/vhcd/vhcutil"
@@ -36,7 +37,7 @@ func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
 		db.Close()
 		os.Remove(f.Name())
 	}
-	err = Create(opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, cfg.Params)
+	err = Create(opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, time.Time{}, cfg.Params)
 	if err != nil {
 		rm()
 		t.Fatal(err)
@@ -0,0 +1,256 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainec"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// Signer creates signatures with the private keys of wallet addresses.  By
+// default, wallets sign with the keys of their own address manager.  Wallets
+// configured with a remote signer by SetSigner delegate transaction signing,
+// message signing, and the signing of votes and revocations to it, allowing
+// the private keys to be kept by a separate hardened host while the wallet
+// itself is watching-only.
+type Signer interface {
+	// SignTransaction adds signature scripts to each input of tx whose
+	// previous output script in prevScripts is not nil.  Inputs which can
+	// not be signed by the signer are left unmodified.
+	SignTransaction(ctx context.Context, tx *wire.MsgTx, prevScripts [][]byte, hashType txscript.SigHashType) error
+
+	// SignMessage returns the compact signature of msg created with the
+	// private key of addr.
+	SignMessage(ctx context.Context, msg string, addr vhcutil.Address) ([]byte, error)
+}
+
+// SetSigner sets the remote signer which private key operations are delegated
+// to.  A nil signer restores signing with the keys of the wallet.
+func (w *Wallet) SetSigner(s Signer) {
+	w.signerMu.Lock()
+	w.remoteSigner = s
+	w.signerMu.Unlock()
+}
+
+// remote returns the remote signer of the wallet, or nil when the wallet
+// signs with its own keys.
+func (w *Wallet) remote() Signer {
+	w.signerMu.Lock()
+	s := w.remoteSigner
+	w.signerMu.Unlock()
+	return s
+}
+
+// LocalSigner returns a Signer which signs with the private keys of the
+// wallet's address manager, regardless of any remote signer set by SetSigner.
+// It is used to serve the wallet's keys to remote watching-only wallets.  The
+// wallet must be unlocked to sign.
+func (w *Wallet) LocalSigner() Signer {
+	return localSigner{w}
+}
+
+type localSigner struct {
+	w *Wallet
+}
+
+func (s localSigner) SignTransaction(ctx context.Context, tx *wire.MsgTx, prevScripts [][]byte, hashType txscript.SigHashType) error {
+	const op errors.Op = "wallet.SignTransaction"
+	if len(prevScripts) != len(tx.TxIn) {
+		return errors.E(op, errors.Invalid, "previous output script count does not match input count")
+	}
+	w := s.w
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: dbtx.ReadBucket(waddrmgrNamespaceKey)}
+		defer func() {
+			for _, done := range secrets.doneFuncs {
+				done()
+			}
+		}()
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		keyStoreSigned, err := w.signKeyStore(addrmgrNs, tx, prevScripts, hashType)
+		if err != nil {
+			return err
+		}
+		for i, prevScript := range prevScripts {
+			if prevScript == nil || keyStoreSigned[i] {
+				continue
+			}
+			ecType := vhcec.STEcdsaSecp256k1
+			class := txscript.GetScriptClass(txscript.DefaultScriptVersion, prevScript)
+			if class == txscript.PubkeyAltTy || class == txscript.PubkeyHashAltTy {
+				var err error
+				ecType, err = txscript.ExtractPkScriptAltSigType(prevScript)
+				if err != nil {
+					return errors.E(errors.Invalid, "unknown checksigalt signature suite specified")
+				}
+			}
+			script, err := txscript.SignTxOutput(w.chainParams, tx, i,
+				prevScript, hashType, txscript.KeyClosure(secrets.GetKey),
+				txscript.ScriptClosure(secrets.GetScript),
+				tx.TxIn[i].SignatureScript, ecType)
+			if err != nil {
+				log.Debugf("Unable to sign input %d: %v", i, err)
+				continue
+			}
+			tx.TxIn[i].SignatureScript = script
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (s localSigner) SignMessage(ctx context.Context, msg string, addr vhcutil.Address) ([]byte, error) {
+	sig, err := s.w.signMessageKeyStore(msg, addr)
+	if err != nil || sig != nil {
+		return sig, err
+	}
+	return s.w.signMessage(msg, addr)
+}
+
+// messageHash returns the hash of a message signed by SignMessage.
+func messageHash(msg string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Valhalla Signed Message:\n")
+	wire.WriteVarString(&buf, 0, msg)
+	return chainhash.HashB(buf.Bytes())
+}
+
+// signMessage signs a message with the private key of addr held by the
+// address manager.
+func (w *Wallet) signMessage(msg string, addr vhcutil.Address) ([]byte, error) {
+	const op errors.Op = "wallet.SignMessage"
+	if addr.DSA(w.chainParams) != vhcec.STEcdsaSecp256k1 {
+		sig, err := w.signMessageAlt(msg, addr)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		return sig, nil
+	}
+	var privKey chainec.PrivateKey
+	var done func()
+	defer func() {
+		if done != nil {
+			done()
+		}
+	}()
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	pkCast, ok := privKey.(*secp256k1.PrivateKey)
+	if !ok {
+		return nil, errors.E(op, "unable to create secp256k1.PrivateKey from chainec.PrivateKey")
+	}
+	sig, err := secp256k1.SignCompact(pkCast, messageHash(msg), true)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return sig, nil
+}
+
+// messageSigDSA returns the signature algorithm of message signatures created
+// with the Ed25519 and secp256k1 Schnorr keys of addresses.
+func messageSigDSA(sigType vhcec.SignatureType) (chainec.DSA, bool) {
+	switch sigType {
+	case vhcec.STEd25519:
+		return chainec.Edwards, true
+	case vhcec.STSchnorrSecp256k1:
+		return chainec.SecSchnorr, true
+	default:
+		return nil, false
+	}
+}
+
+// signMessageAlt signs a message with the Ed25519 or secp256k1 Schnorr private
+// key of addr held by the address manager.  The public key can not be
+// recovered from these signatures, so the signature is serialized as the
+// signature type, followed by the compressed public key and the 64 byte
+// signature.
+func (w *Wallet) signMessageAlt(msg string, addr vhcutil.Address) ([]byte, error) {
+	sigType := addr.DSA(w.chainParams)
+	dsa, ok := messageSigDSA(sigType)
+	if !ok {
+		return nil, errors.E(errors.Invalid, errors.Errorf("address %v can not sign messages", addr))
+	}
+
+	var privKey chainec.PrivateKey
+	var done func()
+	defer func() {
+		if done != nil {
+			done()
+		}
+	}()
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Schnorr signatures are created with secp256k1 keys.
+	keyType := vhcec.SignatureType(privKey.GetType())
+	if (sigType == vhcec.STEd25519) != (keyType == vhcec.STEd25519) {
+		return nil, errors.E(errors.Invalid, errors.Errorf("address %v is not "+
+			"associated with a key of its signature algorithm", addr))
+	}
+
+	r, sigS, err := dsa.Sign(privKey, messageHash(msg))
+	if err != nil {
+		return nil, errors.E(errors.Crypto, err)
+	}
+	pubKey := dsa.NewPublicKey(privKey.Public()).SerializeCompressed()
+	sig := make([]byte, 0, 1+len(pubKey)+64)
+	sig = append(sig, byte(sigType))
+	sig = append(sig, pubKey...)
+	sig = append(sig, dsa.NewSignature(r, sigS).Serialize()...)
+	return sig, nil
+}
+
+// verifyMessageAlt verifies a message signature created by signMessageAlt with
+// the key of addr.
+func verifyMessageAlt(msg string, addr vhcutil.Address, dsa chainec.DSA, sig []byte) (bool, error) {
+	pubKeyLen := dsa.PubKeyBytesLenCompressed()
+	if len(sig) != 1+pubKeyLen+64 {
+		return false, errors.E(errors.Encoding, errors.Errorf("signature "+
+			"length %d is invalid", len(sig)))
+	}
+	if vhcec.SignatureType(sig[0]) != addr.DSA(addr.Net()) {
+		return false, nil
+	}
+	pubKeyBytes := sig[1 : 1+pubKeyLen]
+	if !bytes.Equal(vhcutil.Hash160(pubKeyBytes), addr.Hash160()[:]) {
+		return false, nil
+	}
+	pubKey, err := dsa.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return false, errors.E(errors.Encoding, err)
+	}
+	s, err := dsa.ParseSignature(sig[1+pubKeyLen:])
+	if err != nil {
+		return false, errors.E(errors.Encoding, err)
+	}
+	return dsa.Verify(pubKey, messageHash(msg), s.GetR(), s.GetS()), nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainec"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcutil"
+)
+
+// forgingSigner signs messages with the keys of another wallet.
+type forgingSigner struct {
+	Signer
+	addr vhcutil.Address
+}
+
+func (s forgingSigner) SignMessage(ctx context.Context, msg string, addr vhcutil.Address) ([]byte, error) {
+	return s.Signer.SignMessage(ctx, msg, s.addr)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	signerCfg := basicWalletConfig
+	signer, teardown := testWallet(t, &signerCfg)
+	defer teardown()
+
+	if err := signer.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAddr, err := signer.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The locked wallet signs with the keys of the remote signer.
+	w.SetSigner(signer.LocalSigner())
+	if _, err := w.SignMessage("msg", addr); err == nil {
+		t.Fatal("signed message with a key unknown to the signer")
+	}
+	sig, err := w.SignMessage("msg", otherAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyMessage("msg", otherAddr, sig); !ok || err != nil {
+		t.Fatalf("invalid remote signature: %v", err)
+	}
+
+	// Signatures by keys of other addresses are rejected.
+	w.SetSigner(forgingSigner{signer.LocalSigner(), otherAddr})
+	if _, err := w.SignMessage("msg", addr); err == nil {
+		t.Fatal("accepted signature of another address")
+	}
+
+	w.SetSigner(nil)
+	if _, err := w.SignMessage("msg", otherAddr); err == nil {
+		t.Fatal("signed message without remote signer")
+	}
+}
+
+func TestSignMessageAlt(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	edPrivKey, _ := chainec.Edwards.PrivKeyFromScalar(bytes.Repeat([]byte{1}, 32))
+	wif, err := vhcutil.NewWIF(edPrivKey, w.chainParams, vhcec.STEd25519)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edAddrStr, err := w.ImportPrivateKey(wif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edAddr, err := vhcutil.DecodeAddress(edAddrStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schnorrAddr, err := vhcutil.NewAddressPubKeyHash(addr.Hash160()[:],
+		w.chainParams, vhcec.STSchnorrSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		addr vhcutil.Address
+		dsa  chainec.DSA
+	}{
+		{edAddr, chainec.Edwards},
+		{schnorrAddr, chainec.SecSchnorr},
+	}
+	for _, test := range tests {
+		sig, err := w.SignMessage("msg", test.addr)
+		if err != nil {
+			t.Fatalf("%v: %v", test.addr, err)
+		}
+		if vhcec.SignatureType(sig[0]) != test.addr.DSA(w.chainParams) {
+			t.Fatalf("%v: signature type %d", test.addr, sig[0])
+		}
+
+		// Check the signature independently of VerifyMessage.
+		pubKeyLen := test.dsa.PubKeyBytesLenCompressed()
+		pubKey, err := test.dsa.ParsePubKey(sig[1 : 1+pubKeyLen])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(vhcutil.Hash160(pubKey.SerializeCompressed()), test.addr.Hash160()[:]) {
+			t.Fatalf("%v: signature public key does not match address", test.addr)
+		}
+		s, err := test.dsa.ParseSignature(sig[1+pubKeyLen:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !test.dsa.Verify(pubKey, messageHash("msg"), s.GetR(), s.GetS()) {
+			t.Fatalf("%v: invalid signature", test.addr)
+		}
+
+		if ok, err := VerifyMessage("msg", test.addr, sig); !ok || err != nil {
+			t.Fatalf("%v: VerifyMessage rejected signature: %v", test.addr, err)
+		}
+		if ok, _ := VerifyMessage("other msg", test.addr, sig); ok {
+			t.Fatalf("%v: signature verified for another message", test.addr)
+		}
+	}
+
+	// Signatures do not verify for addresses of other keys or algorithms.
+	edSig, err := w.SignMessage("msg", edAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := VerifyMessage("msg", schnorrAddr, edSig); ok {
+		t.Fatal("Ed25519 signature verified for Schnorr address")
+	}
+	if ok, _ := VerifyMessage("msg", addr, edSig); ok {
+		t.Fatal("Ed25519 signature verified for ECDSA address")
+	}
+	schnorrSig, err := w.SignMessage("msg", schnorrAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSchnorrAddr, err := vhcutil.NewAddressPubKeyHash(edAddr.Hash160()[:],
+		w.chainParams, vhcec.STSchnorrSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := VerifyMessage("msg", otherSchnorrAddr, schnorrSig); ok {
+		t.Fatal("Schnorr signature verified for address of another key")
+	}
+
+	// Secp256k1 keys do not sign for Ed25519 addresses.
+	edAddrOfKey, err := vhcutil.NewAddressPubKeyHash(addr.Hash160()[:],
+		w.chainParams, vhcec.STEd25519)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.SignMessage("msg", edAddrOfKey); err == nil {
+		t.Fatal("signed Ed25519 message with a secp256k1 key")
+	}
+}
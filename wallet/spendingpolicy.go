@@ -0,0 +1,155 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// SpendingPolicy returns the spending policy of an account.
+func (w *Wallet) SpendingPolicy(account uint32) (*udb.SpendingPolicy, error) {
+	const op errors.Op = "wallet.SpendingPolicy"
+	var policy *udb.SpendingPolicy
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		policy, err = w.TxStore.SpendingPolicy(txmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return policy, nil
+}
+
+// SetSpendingPolicy modifies the per-transaction and daily send limits of an
+// account.  Zero limits disable the respective check.  If the current policy
+// may be overridden, currentOverride must match its override passphrase.  A
+// nil newOverride keeps the current override passphrase, while an empty
+// newOverride removes it.
+func (w *Wallet) SetSpendingPolicy(account uint32, txLimit, dailyLimit vhcutil.Amount,
+	currentOverride, newOverride []byte) error {
+
+	const op errors.Op = "wallet.SetSpendingPolicy"
+	if txLimit < 0 || dailyLimit < 0 {
+		return errors.E(op, errors.Invalid, "spending limits may not be negative")
+	}
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		// Ensure the account exists.
+		_, err := w.Manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+
+		policy, err := w.TxStore.SpendingPolicy(txmgrNs, account)
+		if err != nil {
+			return err
+		}
+		if policy.HasOverride() {
+			ok, err := policy.CheckOverridePassphrase(currentOverride)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.E(errors.Passphrase, "incorrect override passphrase")
+			}
+		}
+		policy.TxLimit = txLimit
+		policy.DailyLimit = dailyLimit
+		if newOverride != nil {
+			err = policy.SetOverridePassphrase(newOverride)
+			if err != nil {
+				return err
+			}
+		}
+		return w.TxStore.PutSpendingPolicy(txmgrNs, account, policy)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	// Any override granted with the previous passphrase no longer applies.
+	w.policyOverridesMu.Lock()
+	delete(w.policyOverrides, account)
+	w.policyOverridesMu.Unlock()
+
+	return nil
+}
+
+// OverrideSpendingPolicy allows sends from an account to exceed its spending
+// limits until the timeout elapses.  The override passphrase of the account's
+// policy must be provided.
+func (w *Wallet) OverrideSpendingPolicy(account uint32, passphrase []byte, timeout time.Duration) error {
+	const op errors.Op = "wallet.OverrideSpendingPolicy"
+	policy, err := w.SpendingPolicy(account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !policy.HasOverride() {
+		return errors.E(op, errors.Invalid, "spending policy may not be overridden")
+	}
+	ok, err := policy.CheckOverridePassphrase(passphrase)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !ok {
+		return errors.E(op, errors.Passphrase, "incorrect override passphrase")
+	}
+
+	w.policyOverridesMu.Lock()
+	w.policyOverrides[account] = time.Now().Add(timeout)
+	w.policyOverridesMu.Unlock()
+	return nil
+}
+
+// SpendingPolicyOverridden returns whether the spending policy of an account
+// is currently overridden.
+func (w *Wallet) SpendingPolicyOverridden(account uint32) bool {
+	w.policyOverridesMu.Lock()
+	defer w.policyOverridesMu.Unlock()
+	expiry, ok := w.policyOverrides[account]
+	if !ok {
+		return false
+	}
+	if time.Now().After(expiry) {
+		delete(w.policyOverrides, account)
+		return false
+	}
+	return true
+}
+
+// recordPolicySpend checks that sending amount from account is allowed by the
+// account's spending policy and adds it to the policy's daily counter.  Sends
+// exceeding the policy are allowed only while the policy is overridden.
+func (w *Wallet) recordPolicySpend(dbtx walletdb.ReadWriteTx, account uint32, amount vhcutil.Amount) error {
+	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+	policy, err := w.TxStore.SpendingPolicy(txmgrNs, account)
+	if err != nil {
+		return err
+	}
+	if policy.TxLimit == 0 && policy.DailyLimit == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	err = policy.Check(amount, now)
+	if err != nil {
+		if !w.SpendingPolicyOverridden(account) {
+			return err
+		}
+		log.Infof("Spending policy of account %d overridden to send %v",
+			account, amount)
+	}
+	policy.Record(amount, now)
+	return w.TxStore.PutSpendingPolicy(txmgrNs, account, policy)
+}
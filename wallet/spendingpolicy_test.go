@@ -0,0 +1,101 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestSpendingPolicyTicketPurchase(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 10e8)
+
+	// Allow a single ticket purchase per day.
+	ticketPrice, err := w.NextStakeDifficulty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.SetSpendingPolicy(0, 0, ticketPrice*3/2, nil, []byte("override"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.PurchaseTickets(0, -1, 0, nil, 0, 1, nil, 0, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first purchase is counted against the daily limit, which the
+	// second purchase would exceed.
+	_, err = w.PurchaseTickets(0, -1, 0, nil, 0, 1, nil, 0, 0, 0, 0)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("purchase exceeding daily limit: got error %v", err)
+	}
+
+	err = w.OverrideSpendingPolicy(0, []byte("wrong"), time.Minute)
+	if !errors.Is(errors.Passphrase, err) {
+		t.Fatalf("override with incorrect passphrase: got error %v", err)
+	}
+	err = w.OverrideSpendingPolicy(0, []byte("override"), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.PurchaseTickets(0, -1, 0, nil, 0, 1, nil, 0, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("purchase with overridden policy: %v", err)
+	}
+}
+
+func TestSpendingPolicyMultisig(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 10e8)
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := vhcutil.NewAddressSecpPubKey(key.PubKey().SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkeys := []*vhcutil.AddressSecpPubKey{pubkey}
+
+	err = w.SetSpendingPolicy(0, 2e8, 3e8, nil, []byte("override"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = w.CreateMultisigTx(0, 3e8, pubkeys, 1, 0)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("multisig send exceeding transaction limit: got error %v", err)
+	}
+	_, _, _, err = w.CreateMultisigTx(0, 2e8, pubkeys, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first send is counted against the daily limit, which the second
+	// send would exceed.
+	_, _, _, err = w.CreateMultisigTx(0, 2e8, pubkeys, 1, 0)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("multisig send exceeding daily limit: got error %v", err)
+	}
+}
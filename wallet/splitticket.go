@@ -0,0 +1,635 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/txsizes"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+const (
+	// splitTicketLockNamespace is the namespace of outpoints reserved for
+	// split ticket sessions joined by the wallet.
+	splitTicketLockNamespace = "splitticket"
+
+	// splitTicketLockTTL is how long outpoints contributed to a split ticket
+	// session remain reserved when the ticket is never published.
+	splitTicketLockTTL = 2 * time.Hour
+)
+
+// Serialize sizes used to divide the fee of a split ticket between its
+// participants.  The base size covers the transaction header, worst case
+// input and output counts, and the stake submission output.  Each contributed
+// input adds its own size and the sizes of its commitment and change outputs.
+var (
+	splitTicketBaseSize = 12 + 2*3 + 3 +
+		txsizes.EstimateOutputSize(txsizes.P2PKHPkScriptSize+1)
+	splitTicketInputSize = txsizes.RedeemP2PKHInputSize +
+		txsizes.EstimateOutputSize(txsizes.TicketCommitmentScriptSize) +
+		txsizes.EstimateOutputSize(txsizes.P2PKHPkScriptSize+1)
+)
+
+// SplitTicketSession describes a ticket co-funded by several wallets.  The
+// session is passed between the participants, each of which joins it with a
+// contribution to the ticket price, signs its own inputs once the price is
+// fully funded, and finally any participant publishes the ticket.
+//
+// Every participant builds the identical ticket from the session.  Each
+// contributed input is paired with a commitment output returning vote or
+// revocation rewards to the contributor in proportion to the amount committed
+// by the input, and a change output.
+type SplitTicketSession struct {
+	ID            string                    `json:"id"`
+	Network       string                    `json:"network"`
+	TicketPrice   int64                     `json:"ticketprice"`
+	FeeRate       int64                     `json:"feerate"`
+	Expiry        int32                     `json:"expiry"`
+	VotingAddress string                    `json:"votingaddress"`
+	Contributions []SplitTicketContribution `json:"contributions"`
+}
+
+// SplitTicketContribution describes the funds contributed to a split ticket
+// session by a participant.  Amount is the contributed share of the ticket
+// price; the participant's inputs additionally pay its share of the fee.
+type SplitTicketContribution struct {
+	Amount            int64              `json:"amount"`
+	CommitmentAddress string             `json:"commitmentaddress"`
+	ChangeAddress     string             `json:"changeaddress"`
+	Inputs            []SplitTicketInput `json:"inputs"`
+}
+
+// SplitTicketInput describes an output spent by a split ticket.  SigScript is
+// the hex encoded signature script, which is empty until the owner of the
+// output signs the session.
+type SplitTicketInput struct {
+	Hash      string `json:"hash"`
+	Index     uint32 `json:"index"`
+	Tree      int8   `json:"tree"`
+	Amount    int64  `json:"amount"`
+	PkScript  string `json:"pkscript"`
+	SigScript string `json:"sigscript,omitempty"`
+}
+
+func (in *SplitTicketInput) outPoint() (wire.OutPoint, error) {
+	hash, err := chainhash.NewHashFromStr(in.Hash)
+	if err != nil {
+		return wire.OutPoint{}, errors.E(errors.Encoding, err)
+	}
+	return wire.OutPoint{Hash: *hash, Index: in.Index, Tree: in.Tree}, nil
+}
+
+// funded returns the total share of the ticket price contributed to the
+// session.
+func (s *SplitTicketSession) funded() int64 {
+	var sum int64
+	for i := range s.Contributions {
+		sum += s.Contributions[i].Amount
+	}
+	return sum
+}
+
+// Unfunded returns the share of the ticket price which has not been
+// contributed by any participant.
+func (s *SplitTicketSession) Unfunded() vhcutil.Amount {
+	return vhcutil.Amount(s.TicketPrice - s.funded())
+}
+
+func (s *SplitTicketSession) inputs() int {
+	var n int
+	for i := range s.Contributions {
+		n += len(s.Contributions[i].Inputs)
+	}
+	return n
+}
+
+func (s *SplitTicketSession) signed() bool {
+	for i := range s.Contributions {
+		for j := range s.Contributions[i].Inputs {
+			if s.Contributions[i].Inputs[j].SigScript != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contributionFee returns the fee paid by a contribution of amount spending
+// n inputs.  Contributions pay for the size of their own inputs and outputs
+// and for a share of the base transaction size proportional to amount.
+func (s *SplitTicketSession) contributionFee(amount int64, n int) int64 {
+	feeRate := vhcutil.Amount(s.FeeRate)
+	own := txrules.FeeForSerializeSize(feeRate, n*splitTicketInputSize)
+	base := int64(txrules.FeeForSerializeSize(feeRate, splitTicketBaseSize))
+	return int64(own) + (base*amount+s.TicketPrice-1)/s.TicketPrice
+}
+
+// ticket builds the ticket described by a fully funded session, including any
+// signature scripts already added to the session.  The amount committed by
+// each ticket input is also returned.
+func (s *SplitTicketSession) ticket(params *chaincfg.Params) (*wire.MsgTx, []int64, error) {
+	if s.Network != params.Name {
+		return nil, nil, errors.E(errors.Invalid,
+			errors.Errorf("split ticket session is for network %q", s.Network))
+	}
+	if s.TicketPrice <= 0 || s.FeeRate < 0 {
+		return nil, nil, errors.E(errors.Invalid, "invalid split ticket session price or fee rate")
+	}
+	if s.funded() != s.TicketPrice {
+		return nil, nil, errors.E(errors.Invalid, "split ticket session is not fully funded")
+	}
+	if n := s.inputs(); n > stake.MaxInputsPerSStx {
+		return nil, nil, errors.E(errors.Invalid,
+			errors.Errorf("split ticket spends %d inputs (max %d)", n, stake.MaxInputsPerSStx))
+	}
+
+	votingAddr, err := vhcutil.DecodeAddress(s.VotingAddress)
+	if err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	pkScript, err := txscript.PayToSStx(votingAddr)
+	if err != nil {
+		return nil, nil, errors.E(errors.Invalid,
+			errors.Errorf("voting address %v", votingAddr))
+	}
+
+	tx := wire.NewMsgTx()
+	tx.Expiry = uint32(s.Expiry)
+	tx.AddTxOut(wire.NewTxOut(s.TicketPrice, pkScript))
+
+	feeRate := vhcutil.Amount(s.FeeRate)
+	limits := uint16(defaultTicketFeeLimits)
+	var committed []int64
+	for i := range s.Contributions {
+		c := &s.Contributions[i]
+		if c.Amount <= 0 || len(c.Inputs) == 0 {
+			return nil, nil, errors.E(errors.Invalid, "empty split ticket contribution")
+		}
+		commitmentAddr, err := vhcutil.DecodeAddress(c.CommitmentAddress)
+		if err != nil {
+			return nil, nil, errors.E(errors.Encoding, err)
+		}
+		changeAddr, err := vhcutil.DecodeAddress(c.ChangeAddress)
+		if err != nil {
+			return nil, nil, errors.E(errors.Encoding, err)
+		}
+		changeScript, err := txscript.PayToSStxChange(changeAddr)
+		if err != nil {
+			return nil, nil, errors.E(errors.Invalid,
+				errors.Errorf("change address %v", changeAddr))
+		}
+
+		// Inputs are committed in order until the contribution and its
+		// fee are covered; the remainder of each input is returned as
+		// change unless it is dust.
+		need := c.Amount + s.contributionFee(c.Amount, len(c.Inputs))
+		for j := range c.Inputs {
+			in := &c.Inputs[j]
+			op, err := in.outPoint()
+			if err != nil {
+				return nil, nil, err
+			}
+			if in.Amount <= 0 {
+				return nil, nil, errors.E(errors.Invalid,
+					errors.Errorf("input %v has no value", &op))
+			}
+			txIn := wire.NewTxIn(&op, in.Amount, nil)
+			if in.SigScript != "" {
+				txIn.SignatureScript, err = hex.DecodeString(in.SigScript)
+				if err != nil {
+					return nil, nil, errors.E(errors.Encoding, err)
+				}
+			}
+			tx.AddTxIn(txIn)
+
+			commit := in.Amount
+			if commit > need {
+				commit = need
+			}
+			change := in.Amount - commit
+			if txrules.IsDustAmount(vhcutil.Amount(change), len(changeScript), feeRate) {
+				commit, change = in.Amount, 0
+			}
+			need -= commit
+			if need < 0 {
+				need = 0
+			}
+
+			pkScript, err := txscript.GenerateSStxAddrPush(commitmentAddr,
+				vhcutil.Amount(commit), limits)
+			if err != nil {
+				return nil, nil, errors.E(errors.Invalid,
+					errors.Errorf("commitment address %v", commitmentAddr))
+			}
+			tx.AddTxOut(wire.NewTxOut(0, pkScript))
+			tx.AddTxOut(wire.NewTxOut(change, changeScript))
+			committed = append(committed, commit)
+		}
+		if need > 0 {
+			return nil, nil, errors.E(errors.Invalid,
+				errors.Errorf("contribution inputs are short %v",
+					vhcutil.Amount(need)))
+		}
+	}
+
+	err = stake.CheckSStx(tx)
+	if err != nil {
+		return nil, nil, errors.E(errors.Invalid, err)
+	}
+	return tx, committed, nil
+}
+
+// Ticket returns the ticket described by a fully funded split ticket session,
+// including any signature scripts already added by the participants.
+func (s *SplitTicketSession) Ticket(params *chaincfg.Params) (*wire.MsgTx, error) {
+	tx, _, err := s.ticket(params)
+	return tx, err
+}
+
+// NewSplitTicketSession begins a split ticket session for a ticket voting with
+// votingAddr which expires at the expiry height.  The session buys a ticket at
+// the next ticket price and pays fees at the wallet's ticket fee rate.  When
+// votingAddr is nil, votes are cast by a new address of the wallet's default
+// account.  A zero expiry expires the ticket at the end of the current ticket
+// price interval.
+func (w *Wallet) NewSplitTicketSession(votingAddr vhcutil.Address, expiry int32) (*SplitTicketSession, error) {
+	const op errors.Op = "wallet.NewSplitTicketSession"
+
+	price, err := w.NextStakeDifficulty()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if votingAddr == nil {
+		votingAddr, err = w.NewChangeAddress(udb.DefaultAccountNum)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	_, height := w.MainChainTip()
+	if expiry == 0 {
+		intervalSize := int32(w.chainParams.StakeDiffWindowSize)
+		expiry = (height/intervalSize + 1) * intervalSize
+		if height+1 == expiry {
+			expiry += intervalSize
+		}
+	}
+	if expiry <= height+1 {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("expiry %d is not after the next block", expiry))
+	}
+
+	var id [8]byte
+	_, err = rand.Read(id[:])
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &SplitTicketSession{
+		ID:            hex.EncodeToString(id[:]),
+		Network:       w.chainParams.Name,
+		TicketPrice:   int64(price),
+		FeeRate:       int64(w.TicketFeeIncrement()),
+		Expiry:        expiry,
+		VotingAddress: votingAddr.EncodeAddress(),
+	}, nil
+}
+
+// JoinSplitTicketSession adds a contribution of amount to a split ticket
+// session, funded by outputs of account with at least minConf confirmations.
+// A zero amount contributes the remainder of the ticket price.  The spent
+// outputs are reserved for the session and may not be used by other
+// transactions until the reservation expires.  Sessions may not be joined
+// after any participant has signed.
+func (w *Wallet) JoinSplitTicketSession(s *SplitTicketSession, account uint32,
+	amount vhcutil.Amount, minConf int32) error {
+
+	const op errors.Op = "wallet.JoinSplitTicketSession"
+
+	if s.Network != w.chainParams.Name {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("split ticket session is for network %q", s.Network))
+	}
+	if s.signed() {
+		return errors.E(op, errors.Invalid, "split ticket session is already signed")
+	}
+	unfunded := s.Unfunded()
+	if amount == 0 {
+		amount = unfunded
+	}
+	if amount <= 0 || amount > unfunded {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("contribution %v exceeds unfunded ticket price %v",
+				amount, unfunded))
+	}
+
+	var c SplitTicketContribution
+	var ops []wire.OutPoint
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		eligible, err := w.findEligibleOutputs(dbtx, account, minConf, tipHeight)
+		if err != nil {
+			return err
+		}
+		var total int64
+		for i := range eligible {
+			if total >= int64(amount)+s.contributionFee(int64(amount), len(ops)) {
+				break
+			}
+			out := &eligible[i]
+			c.Inputs = append(c.Inputs, SplitTicketInput{
+				Hash:     out.Hash.String(),
+				Index:    out.Index,
+				Tree:     out.Tree,
+				Amount:   int64(out.Amount),
+				PkScript: hex.EncodeToString(out.PkScript),
+			})
+			ops = append(ops, out.OutPoint)
+			total += int64(out.Amount)
+		}
+		if total < int64(amount)+s.contributionFee(int64(amount), len(ops)) {
+			return errors.E(errors.InsufficientBalance)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if s.inputs()+len(c.Inputs) > stake.MaxInputsPerSStx {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("split ticket may spend at most %d inputs", stake.MaxInputsPerSStx))
+	}
+
+	commitmentAddr, err := w.NewChangeAddress(account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	changeAddr, err := w.NewChangeAddress(account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	c.Amount = int64(amount)
+	c.CommitmentAddress = commitmentAddr.EncodeAddress()
+	c.ChangeAddress = changeAddr.EncodeAddress()
+
+	err = w.lockOutpointsNamespace(ops, splitTicketLockNamespace,
+		time.Now().Add(splitTicketLockTTL))
+	if err != nil {
+		return errors.E(op, err)
+	}
+	s.Contributions = append(s.Contributions, c)
+	return nil
+}
+
+// SignSplitTicketSession signs every input of a fully funded split ticket
+// session that is controlled by the wallet, adding the signature scripts to
+// the session, and records the wallet's contribution to the ticket.  The
+// commitment and change addresses of contributions spending the wallet's
+// outputs must belong to the wallet, so that the ticket returns the funds of
+// the wallet to itself.
+func (w *Wallet) SignSplitTicketSession(s *SplitTicketSession) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.SignSplitTicketSession"
+
+	tx, committed, err := s.ticket(w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Find the inputs spending outputs of the wallet, verifying that the
+	// session describes them accurately.
+	prevScripts := make(map[wire.OutPoint][]byte, len(tx.TxIn))
+	owned := make([]bool, len(tx.TxIn))
+	var contributions []int
+	idx := 0
+	for i := range s.Contributions {
+		c := &s.Contributions[i]
+		ownedInputs := 0
+		for j := range c.Inputs {
+			in := &c.Inputs[j]
+			pkScript, err := hex.DecodeString(in.PkScript)
+			if err != nil {
+				return nil, errors.E(op, errors.Encoding, err)
+			}
+			prevOut := &tx.TxIn[idx].PreviousOutPoint
+			prevScripts[*prevOut] = pkScript
+			out, err := w.FetchOutput(prevOut)
+			if err == nil {
+				_, addrs, _, _ := txscript.ExtractPkScriptAddrs(out.Version,
+					out.PkScript, w.chainParams)
+				if len(addrs) == 1 {
+					owned[idx], err = w.HaveAddress(addrs[0])
+					if err != nil {
+						return nil, errors.E(op, err)
+					}
+				}
+				if owned[idx] && (out.Value != in.Amount ||
+					hex.EncodeToString(out.PkScript) != in.PkScript) {
+					return nil, errors.E(op, errors.Invalid,
+						errors.Errorf("session misdescribes output %v", prevOut))
+				}
+			} else if !errors.Is(errors.NotExist, err) {
+				return nil, errors.E(op, err)
+			}
+			if owned[idx] {
+				ownedInputs++
+			}
+			idx++
+		}
+		if ownedInputs == 0 {
+			continue
+		}
+		for _, a := range []string{c.CommitmentAddress, c.ChangeAddress} {
+			addr, err := vhcutil.DecodeAddress(a)
+			if err != nil {
+				return nil, errors.E(op, errors.Encoding, err)
+			}
+			have, err := w.HaveAddress(addr)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			if !have {
+				return nil, errors.E(op, errors.Invalid,
+					errors.Errorf("contribution spending wallet outputs "+
+						"pays address %v of another wallet", addr))
+			}
+		}
+		contributions = append(contributions, i)
+	}
+	if len(contributions) == 0 {
+		return nil, errors.E(op, errors.NotExist, "wallet contributes no inputs to the split ticket")
+	}
+
+	signErrs, err := w.SignTransaction(tx, txscript.SigHashAll, prevScripts, nil, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, e := range signErrs {
+		if owned[e.InputIndex] {
+			return nil, errors.E(op, e.Error)
+		}
+	}
+
+	ticketHash := tx.TxHash()
+	rec := &udb.SplitTicket{
+		TicketHash:  ticketHash,
+		TicketPrice: s.TicketPrice,
+	}
+	for _, v := range committed {
+		rec.TotalCommitted += v
+	}
+	idx = 0
+	for i := range s.Contributions {
+		c := &s.Contributions[i]
+		for j := range c.Inputs {
+			if owned[idx] {
+				c.Inputs[j].SigScript = hex.EncodeToString(tx.TxIn[idx].SignatureScript)
+				rec.Commitments = append(rec.Commitments, udb.SplitTicketCommitment{
+					Index:  uint32(idx),
+					Amount: committed[idx],
+				})
+			}
+			idx++
+		}
+	}
+
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		addr, err := vhcutil.DecodeAddress(s.Contributions[contributions[0]].CommitmentAddress)
+		if err != nil {
+			return errors.E(errors.Encoding, err)
+		}
+		rec.Account, err = w.Manager.AddrAccount(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		return w.TxStore.PutSplitTicket(txmgrNs, rec)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &ticketHash, nil
+}
+
+// PublishSplitTicketSession publishes the ticket of a split ticket session
+// once every participant has signed its inputs.
+func (w *Wallet) PublishSplitTicketSession(s *SplitTicketSession, n NetworkBackend) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.PublishSplitTicketSession"
+
+	tx, err := s.Ticket(w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	idx := 0
+	for i := range s.Contributions {
+		c := &s.Contributions[i]
+		for j := range c.Inputs {
+			pkScript, err := hex.DecodeString(c.Inputs[j].PkScript)
+			if err != nil {
+				return nil, errors.E(op, errors.Encoding, err)
+			}
+			vm, err := txscript.NewEngine(pkScript, tx, idx,
+				sanityVerifyFlags, txscript.DefaultScriptVersion, nil)
+			if err == nil {
+				err = vm.Execute()
+			}
+			if err != nil {
+				return nil, errors.E(op, errors.Invalid,
+					errors.Errorf("input %d is not signed: %v", idx, err))
+			}
+			idx++
+		}
+	}
+
+	serializedTx, err := tx.Bytes()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hash, err := w.PublishTransaction(tx, serializedTx, n)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	log.Infof("Published split ticket %v", hash)
+	return hash, nil
+}
+
+// SplitTicketSummary describes the status of a split ticket co-funded by the
+// wallet.  Returned is the amount paid to the wallet's commitments by the vote
+// or revocation of the ticket, and is zero while the ticket is unspent.
+type SplitTicketSummary struct {
+	*udb.SplitTicket
+	Status   string
+	Returned vhcutil.Amount
+}
+
+// SplitTickets returns the split tickets co-funded by the wallet.  The status
+// of a ticket is one of "unpublished", "unmined", "live", "voted", or
+// "revoked".  The vote or revocation of a ticket is only known to wallets that
+// record the spending transaction, such as the wallet owning the voting
+// address.
+func (w *Wallet) SplitTickets() ([]*SplitTicketSummary, error) {
+	const op errors.Op = "wallet.SplitTickets"
+	var summaries []*SplitTicketSummary
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachSplitTicket(txmgrNs, func(t *udb.SplitTicket) error {
+			summary := &SplitTicketSummary{SplitTicket: t, Status: "unpublished"}
+			summaries = append(summaries, summary)
+			details, err := w.TxStore.TxDetails(txmgrNs, &t.TicketHash)
+			if errors.Is(errors.NotExist, err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			ticket, err := w.TxStore.TicketDetails(txmgrNs, details)
+			if err != nil {
+				return err
+			}
+			switch {
+			case ticket == nil:
+				return nil
+			case ticket.Spender != nil && stake.IsSSGen(&ticket.Spender.MsgTx):
+				summary.Status = "voted"
+				summary.Returned = splitTicketReturned(t, ticket.Spender.MsgTx.TxOut, 2)
+			case ticket.Spender != nil && stake.IsSSRtx(&ticket.Spender.MsgTx):
+				summary.Status = "revoked"
+				summary.Returned = splitTicketReturned(t, ticket.Spender.MsgTx.TxOut, 0)
+			case details.Block.Height == -1:
+				summary.Status = "unmined"
+			default:
+				summary.Status = "live"
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return summaries, nil
+}
+
+// splitTicketReturned sums the outputs of a vote or revocation paying the
+// commitments of a split ticket.  Outputs paying commitments begin at offset.
+func splitTicketReturned(t *udb.SplitTicket, outs []*wire.TxOut, offset int) vhcutil.Amount {
+	var sum vhcutil.Amount
+	for _, c := range t.Commitments {
+		if i := offset + int(c.Index); i < len(outs) {
+			sum += vhcutil.Amount(outs[i].Value)
+		}
+	}
+	return sum
+}
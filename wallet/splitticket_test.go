@@ -0,0 +1,113 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/txsizes"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+)
+
+func splitTicketTestAddr(t *testing.T, b byte) string {
+	t.Helper()
+	var pkh [20]byte
+	pkh[0] = b
+	addr, err := vhcutil.NewAddressPubKeyHash(pkh[:], &chaincfg.SimNetParams,
+		vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr.EncodeAddress()
+}
+
+func TestSplitTicketSession(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	input := func(b byte, amount int64) SplitTicketInput {
+		return SplitTicketInput{
+			Hash:     chainhash.Hash{b}.String(),
+			Amount:   amount,
+			PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+		}
+	}
+	s := &SplitTicketSession{
+		ID:            "test",
+		Network:       params.Name,
+		TicketPrice:   100e8,
+		FeeRate:       1e5,
+		Expiry:        1000,
+		VotingAddress: splitTicketTestAddr(t, 1),
+		Contributions: []SplitTicketContribution{{
+			Amount:            60e8,
+			CommitmentAddress: splitTicketTestAddr(t, 2),
+			ChangeAddress:     splitTicketTestAddr(t, 3),
+			Inputs:            []SplitTicketInput{input(1, 50e8), input(2, 50e8)},
+		}},
+	}
+
+	// Partially funded sessions do not describe a ticket.
+	_, err := s.Ticket(params)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("ticket of partially funded session: %v", err)
+	}
+
+	s.Contributions = append(s.Contributions, SplitTicketContribution{
+		Amount:            40e8,
+		CommitmentAddress: splitTicketTestAddr(t, 4),
+		ChangeAddress:     splitTicketTestAddr(t, 5),
+		Inputs:            []SplitTicketInput{input(3, 41e8)},
+	})
+	tx, err := s.Ticket(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stake.IsSStx(tx) {
+		t.Fatal("split ticket is not a ticket")
+	}
+	if len(tx.TxIn) != 3 || len(tx.TxOut) != 7 {
+		t.Fatalf("split ticket has %d inputs and %d outputs", len(tx.TxIn), len(tx.TxOut))
+	}
+	if tx.TxOut[0].Value != s.TicketPrice || tx.Expiry != 1000 {
+		t.Fatalf("split ticket pays %v with expiry %d", tx.TxOut[0].Value, tx.Expiry)
+	}
+
+	// Every commitment pays back the participant who funded the input, and
+	// contributions are committed in proportion to their share of the price.
+	_, _, committed, change, _, _ := stake.TxSStxStakeOutputInfo(tx)
+	if committed[0] != 50e8 || change[0] != 0 {
+		t.Errorf("first input commits %v with change %v", committed[0], change[0])
+	}
+	first := committed[0] + committed[1]
+	second := committed[2]
+	if first <= 60e8 || second <= 40e8 || first+second-s.TicketPrice < 0 {
+		t.Errorf("contributions commit %v and %v", first, second)
+	}
+	if change[1] != 100e8-first || change[2] != 41e8-second {
+		t.Errorf("change is %v", change)
+	}
+
+	// The fee paid covers the size of the signed ticket.
+	size := tx.SerializeSize() + len(tx.TxIn)*txsizes.RedeemP2PKHSigScriptSize
+	fee := first + second - s.TicketPrice
+	if min := txrules.FeeForSerializeSize(vhcutil.Amount(s.FeeRate), size); vhcutil.Amount(fee) < min {
+		t.Errorf("ticket pays fee %v, requires %v", vhcutil.Amount(fee), min)
+	}
+
+	// Contributions whose inputs do not cover the contribution and fee are
+	// rejected.
+	s.Contributions[1].Inputs[0].Amount = 40e8
+	_, err = s.Ticket(params)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("ticket of underfunded contribution: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+)
+
+func TestStakeInfoCache(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	info, err := w.StakeInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.OwnMempoolTix != 0 || info.Live != 0 {
+		t.Fatalf("stake info of new wallet: %+v", info)
+	}
+	if w.stakeInfo == nil {
+		t.Fatal("stake info was not cached")
+	}
+
+	// Accepting a ticket with voting rights assigned to the wallet
+	// invalidates the cached statistics.
+	votingAddr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &SplitTicketSession{
+		Network:       cfg.Params.Name,
+		TicketPrice:   1e8,
+		Expiry:        1000,
+		VotingAddress: votingAddr.String(),
+		Contributions: []SplitTicketContribution{{
+			Amount:            1e8,
+			CommitmentAddress: splitTicketTestAddr(t, 2),
+			ChangeAddress:     splitTicketTestAddr(t, 3),
+			Inputs: []SplitTicketInput{{
+				Hash:     chainhash.Hash{1}.String(),
+				Amount:   2e8,
+				PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+			}},
+		}},
+	}
+	ticket, err := s.Ticket(cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.AcceptMempoolTx(ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.stakeInfo != nil {
+		t.Fatal("stake info remains cached after accepting ticket")
+	}
+	info, err = w.StakeInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.OwnMempoolTix != 1 {
+		t.Fatalf("stake info counts %d mempool tickets", info.OwnMempoolTix)
+	}
+
+	// Cached statistics are returned until invalidated.
+	w.stakeInfo.OwnMempoolTix = 2
+	info, err = w.StakeInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.OwnMempoolTix != 2 {
+		t.Fatal("cached stake info was not used")
+	}
+}
@@ -5,6 +5,7 @@
 package wallet
 
 import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcwallet/errors"
 	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
@@ -35,3 +36,31 @@ func (w *Wallet) StakePoolUserInfo(userAddress vhcutil.Address) (*udb.StakePoolU
 	}
 	return user, nil
 }
+
+// ForEachStakePoolUserTicket calls f with each ticket of a stake pool user
+// identified by their P2SH voting address, ordered by ticket hash.  When after
+// is non-nil, iteration begins with the first ticket with a greater hash, and
+// when status is non-nil, only tickets with the status are included.
+// Iteration stops early when f returns false.
+func (w *Wallet) ForEachStakePoolUserTicket(userAddress vhcutil.Address, status *udb.TicketStatus,
+	after *chainhash.Hash, f func(*udb.PoolTicket) bool) error {
+
+	const op errors.Op = "wallet.ForEachStakePoolUserTicket"
+
+	switch userAddress.(type) {
+	case *vhcutil.AddressPubKeyHash: // ok
+	case *vhcutil.AddressScriptHash: // ok
+	default:
+		return errors.E(op, errors.Invalid, "address must be P2PKH or P2SH")
+	}
+
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		stakemgrNs := tx.ReadBucket(wstakemgrNamespaceKey)
+		return w.StakeMgr.ForEachStakePoolUserTicket(stakemgrNs, userAddress,
+			status, after, f)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
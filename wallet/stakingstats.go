@@ -0,0 +1,114 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// StakingStats describes the staking activity of the wallet over a time range.
+type StakingStats struct {
+	// Start and End bound the time range of the statistics.
+	Start, End time.Time
+
+	TicketsBought int
+	TicketCost    vhcutil.Amount
+	Votes         int
+	VoteRewards   vhcutil.Amount
+	Revocations   int
+	Fees          vhcutil.Amount
+}
+
+// AnnualizedReturn returns the vote rewards earned over the time range, less
+// the fees paid, as a fraction of the cost of the tickets bought, scaled to a
+// year.  Zero is returned when no tickets were bought or the range is empty.
+func (s *StakingStats) AnnualizedReturn() float64 {
+	d := s.End.Sub(s.Start)
+	if s.TicketCost <= 0 || d <= 0 {
+		return 0
+	}
+	const year = 365.25 * 24 * time.Hour
+	r := float64(s.VoteRewards-s.Fees) / float64(s.TicketCost)
+	return r * float64(year) / float64(d)
+}
+
+// StakingStats aggregates the tickets bought, votes, and revocations of the
+// wallet mined in blocks with timestamps in the range [start,end).  A zero
+// start begins the range at the wallet's first mined stake transaction, and a
+// zero end ends the range at the current time.
+//
+// Ticket costs and fees are only counted for tickets funded by the wallet.
+// Vote rewards are the stakebase subsidy of each vote.
+func (w *Wallet) StakingStats(start, end time.Time) (*StakingStats, error) {
+	const op errors.Op = "wallet.StakingStats"
+	if end.IsZero() {
+		end = time.Now()
+	}
+	res := &StakingStats{Start: start, End: end}
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			for i := range details {
+				d := &details[i]
+				if d.Block.Time.Before(start) || !d.Block.Time.Before(end) {
+					continue
+				}
+				switch d.TxType {
+				case stake.TxTypeSStx:
+					if len(d.Debits) == 0 {
+						continue
+					}
+					res.TicketsBought++
+					res.TicketCost += vhcutil.Amount(d.MsgTx.TxOut[0].Value)
+					res.Fees += txFee(&d.MsgTx)
+				case stake.TxTypeSSGen:
+					res.Votes++
+					res.VoteRewards += vhcutil.Amount(d.MsgTx.TxIn[0].ValueIn)
+					res.Fees += txFee(&d.MsgTx)
+				case stake.TxTypeSSRtx:
+					res.Revocations++
+					res.Fees += txFee(&d.MsgTx)
+				default:
+					continue
+				}
+				if res.Start.IsZero() {
+					res.Start = d.Block.Time
+				}
+			}
+			return false, nil
+		}
+		return w.TxStore.RangeTransactions(txmgrNs, 0, tipHeight, rangeFn)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if res.Start.IsZero() {
+		res.Start = end
+	}
+	return res, nil
+}
+
+// txFee returns the fee paid by a transaction with known input amounts.
+func txFee(tx *wire.MsgTx) vhcutil.Amount {
+	var fee int64
+	for _, in := range tx.TxIn {
+		fee += in.ValueIn
+	}
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+	if fee < 0 {
+		return 0
+	}
+	return vhcutil.Amount(fee)
+}
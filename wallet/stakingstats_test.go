@@ -0,0 +1,83 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+func TestAnnualizedReturn(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	year := time.Duration(365.25 * 24 * float64(time.Hour))
+	tests := []struct {
+		name  string
+		stats StakingStats
+		want  float64
+	}{
+		{
+			name:  "no tickets",
+			stats: StakingStats{Start: start, End: start.Add(year)},
+		},
+		{
+			name:  "empty range",
+			stats: StakingStats{Start: start, End: start, TicketCost: 100e8},
+		},
+		{
+			name: "one year",
+			stats: StakingStats{Start: start, End: start.Add(year),
+				TicketCost: 100e8, VoteRewards: 11e8, Fees: 1e8},
+			want: 0.1,
+		},
+		{
+			name: "half year",
+			stats: StakingStats{Start: start, End: start.Add(year / 2),
+				TicketCost: 100e8, VoteRewards: 5e8},
+			want: 0.1,
+		},
+	}
+	for _, test := range tests {
+		got := test.stats.AnnualizedReturn()
+		if math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s: annualized return %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestStakingStats(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	end := time.Now()
+	stats, err := w.StakingStats(time.Time{}, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TicketsBought != 0 || stats.Votes != 0 || stats.Revocations != 0 {
+		t.Fatalf("staking stats of new wallet: %+v", stats)
+	}
+	if !stats.Start.Equal(end) || !stats.End.Equal(end) {
+		t.Fatalf("range of new wallet is [%v,%v), want empty range at %v",
+			stats.Start, stats.End, end)
+	}
+}
+
+func TestTxFee(t *testing.T) {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(&wire.TxIn{ValueIn: 5e8})
+	tx.AddTxIn(&wire.TxIn{ValueIn: 1e8})
+	tx.AddTxOut(&wire.TxOut{Value: 5.9e8})
+	if fee := txFee(tx); fee != 0.1e8 {
+		t.Fatalf("fee %v, want 0.1 VHC", fee)
+	}
+	tx.AddTxOut(&wire.TxOut{Value: 1e8})
+	if fee := txFee(tx); fee != 0 {
+		t.Fatalf("fee of transaction with unknown inputs %v, want 0", fee)
+	}
+}
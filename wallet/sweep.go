@@ -0,0 +1,398 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/txsizes"
+	"github.com/valhallacoin/vhcwallet/wallet/txauthor"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// sweepDestination is the change source of a sweep transaction.  The change
+// output of a sweep receives all swept value and pays a fixed script.
+type sweepDestination struct {
+	script []byte
+}
+
+func (d *sweepDestination) Script() ([]byte, uint16, error) {
+	return d.script, txscript.DefaultScriptVersion, nil
+}
+
+func (d *sweepDestination) ScriptSize() int {
+	return len(d.script)
+}
+
+// SweepFilter selects the unspent outputs of an account which are swept.
+// Ticket outputs, immature coinbase, vote, revocation, and ticket change
+// outputs, and outputs with the do-not-spend or reserve-for-tickets UTXO
+// policies are never swept.  The zero value sweeps every other output which is
+// not locked, including outputs with the dust UTXO policy.
+type SweepFilter struct {
+	// IncludeLocked sweeps outputs locked in any lock namespace, which are
+	// otherwise excluded.
+	IncludeLocked bool
+
+	// DustThreshold excludes outputs of lower value.
+	DustThreshold vhcutil.Amount
+
+	// MaxValue excludes outputs of equal or higher value, other than
+	// outputs with the dust UTXO policy.  Zero sweeps outputs of any value.
+	MaxValue vhcutil.Amount
+}
+
+// NewUnsignedSweeps creates unsigned transactions moving as much value as
+// possible from the unspent outputs of an account with at least minConf
+// confirmations to the script of changeSource, paying a fee of feePerKb.  The
+// swept outputs are selected by filter, which may be nil to use the zero
+// filter.  The outputs are split over as many transactions as required for
+// each to be within the maximum transaction size.  The transactions spend
+// distinct outputs and do not depend on each other.  Outputs left over in a
+// final transaction that would not pay its own fee are not swept.
+func (w *Wallet) NewUnsignedSweeps(account uint32, minConf int32, feePerKb vhcutil.Amount,
+	changeSource txauthor.ChangeSource, filter *SweepFilter) ([]*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedSweeps"
+	script, version, err := changeSource.Script()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(script) > txscript.MaxScriptElementSize {
+		return nil, errors.E(op, errors.Invalid, "script size exceed maximum bytes "+
+			"pushable to the stack")
+	}
+
+	if filter == nil {
+		filter = new(SweepFilter)
+	}
+	var inputs *txauthor.InputDetail
+	dustPolicy := make(map[wire.OutPoint]bool)
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		if w.TxStore.SendApprovalRequired(txmgrNs, account) {
+			return errApprovalRequired(account)
+		}
+		if account != udb.ImportedAddrAccount {
+			lastAcct, err := w.Manager.LastAccount(addrmgrNs)
+			if err != nil {
+				return err
+			}
+			if account > lastAcct {
+				return errors.E(errors.NotExist, "missing account")
+			}
+		}
+
+		source := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
+			minConf, tipHeight, udb.UTXOPolicyReserveForTickets)
+		var err error
+		inputs, err = source.SelectInputs(vhcutil.MaxAmount)
+		if errors.Is(errors.InsufficientBalance, err) {
+			err = nil
+		}
+		if err != nil || inputs == nil || filter.MaxValue == 0 {
+			return err
+		}
+		for _, in := range inputs.Inputs {
+			op := &in.PreviousOutPoint
+			if w.TxStore.UTXOPolicy(txmgrNs, op)&udb.UTXOPolicyDust != 0 {
+				dustPolicy[*op] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	filtered := &txauthor.InputDetail{}
+	if inputs == nil {
+		inputs = filtered
+	}
+	for i, in := range inputs.Inputs {
+		if !filter.IncludeLocked && w.LockedOutpoint(in.PreviousOutPoint) {
+			continue
+		}
+		if vhcutil.Amount(in.ValueIn) < filter.DustThreshold {
+			continue
+		}
+		if filter.MaxValue != 0 && vhcutil.Amount(in.ValueIn) >= filter.MaxValue &&
+			!dustPolicy[in.PreviousOutPoint] {
+			continue
+		}
+		filtered.Amount += vhcutil.Amount(in.ValueIn)
+		filtered.Inputs = append(filtered.Inputs, in)
+		filtered.Scripts = append(filtered.Scripts, inputs.Scripts[i])
+		filtered.RedeemScriptSizes = append(filtered.RedeemScriptSizes,
+			inputs.RedeemScriptSizes[i])
+	}
+	inputs = filtered
+
+	maxSize := maxTxSize
+	if w.chainParams.Net == wire.MainNet {
+		maxSize = maxStandardTxSize
+	}
+	sweep := func(start, end int) *txauthor.AuthoredTx {
+		scriptSizes := inputs.RedeemScriptSizes[start:end]
+		size := txsizes.EstimateSerializeSize(scriptSizes, nil, len(script))
+		var total vhcutil.Amount
+		for _, in := range inputs.Inputs[start:end] {
+			total += vhcutil.Amount(in.ValueIn)
+		}
+		value := total - txrules.FeeForSerializeSize(feePerKb, size)
+		if value <= 0 || txrules.IsDustAmount(value, len(script), feePerKb) {
+			return nil
+		}
+		tx := wire.NewMsgTx()
+		tx.TxIn = inputs.Inputs[start:end:end]
+		tx.AddTxOut(&wire.TxOut{
+			Value:    int64(value),
+			Version:  version,
+			PkScript: script,
+		})
+		return &txauthor.AuthoredTx{
+			Tx:                           tx,
+			PrevScripts:                  inputs.Scripts[start:end:end],
+			TotalInput:                   total,
+			ChangeIndex:                  0,
+			EstimatedSignedSerializeSize: size,
+		}
+	}
+	var sweeps []*txauthor.AuthoredTx
+	start := 0
+	for end := 1; end <= len(inputs.Inputs); end++ {
+		// Close the current transaction before the input which would
+		// exceed the maximum size.
+		size := txsizes.EstimateSerializeSize(
+			inputs.RedeemScriptSizes[start:end], nil, len(script))
+		if size > maxSize && end-1 > start {
+			if atx := sweep(start, end-1); atx != nil {
+				sweeps = append(sweeps, atx)
+			}
+			start = end - 1
+		}
+	}
+	if start < len(inputs.Inputs) {
+		if atx := sweep(start, len(inputs.Inputs)); atx != nil {
+			sweeps = append(sweeps, atx)
+		}
+	}
+	if len(sweeps) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			"swept value does not exceed the fee")
+	}
+	return sweeps, nil
+}
+
+// SweepAccount signs and publishes transactions moving as much value as
+// possible from the unspent outputs of an account with at least minConf
+// confirmations and selected by filter to a destination address, paying a fee
+// of feePerKb.  Accounts with more outputs than fit in a single transaction are
+// swept over several transactions, as described by NewUnsignedSweeps.  The
+// wallet must be unlocked.  The hashes of the published transactions are
+// returned.
+func (w *Wallet) SweepAccount(account uint32, minConf int32, feePerKb vhcutil.Amount,
+	dest vhcutil.Address, filter *SweepFilter) ([]*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.SweepAccount"
+	req := sweepRequest{
+		account:  account,
+		minconf:  minConf,
+		feePerKb: feePerKb,
+		dest:     dest,
+		filter:   filter,
+		resp:     make(chan sweepResponse),
+	}
+	w.sweepRequests <- req
+	resp := <-req.resp
+	if resp.err != nil {
+		return nil, errors.E(op, resp.err)
+	}
+	log.Infof("Swept account %d to %v in %d transaction(s)", account,
+		dest.EncodeAddress(), len(resp.txHashes))
+	return resp.txHashes, nil
+}
+
+// sweepAccount creates, signs, records, and publishes the transactions of a
+// sweep request.  It must only be called by txCreator while holding the
+// unlocked wallet.
+func (w *Wallet) sweepAccount(op errors.Op, req *sweepRequest) ([]*chainhash.Hash, error) {
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	pkScript, err := txscript.PayToAddrScript(req.dest)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	sweeps, err := w.NewUnsignedSweeps(req.account, req.minconf, req.feePerKb,
+		&sweepDestination{pkScript}, req.filter)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	remote := w.remote()
+	for _, atx := range sweeps {
+		if remote != nil {
+			err = remote.SignTransaction(context.Background(), atx.Tx,
+				atx.PrevScripts, txscript.SigHashAll)
+		} else {
+			err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+				addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+				if w.AccountKeyStorage(req.account) != KeyStorageLocal {
+					return w.signKeyStoreAll(addrmgrNs, atx.Tx, atx.PrevScripts,
+						txscript.SigHashAll)
+				}
+				secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+				err := atx.AddAllInputScripts(secrets)
+				for _, done := range secrets.doneFuncs {
+					done()
+				}
+				return err
+			})
+		}
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		err = validateMsgTx(op, atx.Tx, atx.PrevScripts)
+		if err != nil {
+			return nil, err
+		}
+		err = w.checkHighFees(atx.TotalInput, atx.Tx)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	recs := make([]*udb.TxRecord, 0, len(sweeps))
+	txs := make([]*wire.MsgTx, 0, len(sweeps))
+	var swept vhcutil.Amount
+	for _, atx := range sweeps {
+		rec, err := udb.NewTxRecordFromMsgTx(atx.Tx, time.Now())
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		recs = append(recs, rec)
+		txs = append(txs, atx.Tx)
+		swept += vhcutil.Amount(atx.Tx.TxOut[atx.ChangeIndex].Value)
+	}
+	err = w.checkSpendGuard(swept)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var watch []wire.OutPoint
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		err := w.recordPolicySpend(dbtx, req.account, swept)
+		if err != nil {
+			return err
+		}
+		for _, rec := range recs {
+			recWatch, err := w.processTransactionRecord(dbtx, rec, nil, nil)
+			if err != nil {
+				return err
+			}
+			watch = append(watch, recWatch...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = n.PublishTransactions(context.TODO(), txs...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(watch) > 0 {
+		err := n.LoadTxFilter(context.TODO(), false, nil, watch)
+		if err != nil {
+			log.Errorf("Failed to watch outpoints: %v", err)
+		}
+	}
+
+	hashes := make([]*chainhash.Hash, len(recs))
+	for i, rec := range recs {
+		hashes[i] = &rec.Hash
+	}
+	return hashes, nil
+}
+
+// DustSweep describes the transactions sweeping the dust outputs of an account.
+// Inputs is the number of swept outputs and DustValue is their total value.
+// Reclaimed is the value of the sweep outputs after paying fees.
+type DustSweep struct {
+	Inputs    int
+	DustValue vhcutil.Amount
+	Reclaimed vhcutil.Amount
+	TxHashes  []*chainhash.Hash
+}
+
+// DustThreshold returns the value below which P2PKH outputs are considered
+// dust at a fee rate.  This is three times the fee of creating and spending
+// the output, and matches the dust rule of mempool policy.
+func DustThreshold(feePerKb vhcutil.Amount) vhcutil.Amount {
+	return 3 * txrules.FeeForSerializeSize(feePerKb,
+		txsizes.P2PKHOutputSize+txsizes.RedeemP2PKHInputSize)
+}
+
+// SweepDust consolidates the dust outputs of an account into a new internal
+// address of the account, paying a fee of feePerKb.  Outputs with the dust UTXO
+// policy and outputs with a value below threshold are dust, and a zero
+// threshold uses DustThreshold of the fee rate.  Dust outputs which are worth
+// less than the fee of spending them are not swept, and no transaction is
+// created when the swept value would not exceed the fee.  The wallet must be
+// unlocked.
+func (w *Wallet) SweepDust(account uint32, threshold, feePerKb vhcutil.Amount) (*DustSweep, error) {
+	const op errors.Op = "wallet.SweepDust"
+	if threshold == 0 {
+		threshold = DustThreshold(feePerKb)
+	}
+	filter := &SweepFilter{
+		DustThreshold: txrules.FeeForSerializeSize(feePerKb, txsizes.RedeemP2PKHInputSize),
+		MaxValue:      threshold,
+	}
+
+	// Only derive an address for the sweep when it is profitable.
+	_, err := w.NewUnsignedSweeps(account, 1, feePerKb,
+		&sweepDestination{make([]byte, txsizes.P2PKHPkScriptSize)}, filter)
+	if errors.Is(errors.InsufficientBalance, err) {
+		return &DustSweep{}, nil
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	dest, err := w.NewInternalAddress(account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hashes, err := w.SweepAccount(account, 1, feePerKb, dest, filter)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	txs, _, err := w.GetTransactionsByHashes(hashes)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sweep := &DustSweep{TxHashes: hashes}
+	for _, tx := range txs {
+		sweep.Inputs += len(tx.TxIn)
+		for _, in := range tx.TxIn {
+			sweep.DustValue += vhcutil.Amount(in.ValueIn)
+		}
+		for _, out := range tx.TxOut {
+			sweep.Reclaimed += vhcutil.Amount(out.Value)
+		}
+	}
+	return sweep, nil
+}
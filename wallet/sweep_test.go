@@ -0,0 +1,241 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/txsizes"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// fundAccount records a transaction mined in the genesis block paying each of
+// amounts to new external addresses of an account.
+func fundAccount(t *testing.T, w *Wallet, account uint32, amounts ...vhcutil.Amount) {
+	t.Helper()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0xff}, 0, wire.TxTreeRegular), 0, nil))
+	for _, amount := range amounts {
+		addr, err := w.NewExternalAddress(account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(amount), pkScript))
+	}
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := &w.chainParams.GenesisBlock.Header
+	blockMeta := &udb.BlockMeta{
+		Block: udb.Block{Hash: genesis.BlockHash()},
+		Time:  genesis.Timestamp,
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := w.processTransactionRecord(dbtx, rec, genesis, blockMeta)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSweepAccount(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	dest, err := vhcutil.NewAddressPubKeyHash(bytes.Repeat([]byte{1}, 20),
+		w.chainParams, vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 2e8)
+
+	// Sweeps require an unlocked wallet.
+	w.Lock()
+	_, err = w.SweepAccount(0, 1, w.RelayFee(), dest, nil)
+	if !errors.Is(errors.Locked, err) {
+		t.Fatalf("expected Locked error, got %v", err)
+	}
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	hashes, err := w.SweepAccount(0, 1, w.RelayFee(), dest, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs, _, err := w.GetTransactionsByHashes(hashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 1 {
+		t.Fatalf("account swept in %d transactions", len(txs))
+	}
+	tx := txs[0]
+	if len(tx.TxIn) != 2 || len(tx.TxOut) != 1 {
+		t.Fatalf("sweep spends %d inputs to %d outputs", len(tx.TxIn), len(tx.TxOut))
+	}
+	if v := tx.TxOut[0].Value; v >= 3e8 || v < 3e8-1e6 {
+		t.Fatalf("unexpected swept value %v", vhcutil.Amount(v))
+	}
+
+	// Nothing remains to be swept.
+	_, err = w.SweepAccount(0, 0, w.RelayFee(), dest, nil)
+	if !errors.Is(errors.InsufficientBalance, err) {
+		t.Fatalf("expected InsufficientBalance error, got %v", err)
+	}
+}
+
+func TestNewUnsignedSweepsSplit(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 1e8, 1e8, 1e8, 1e8)
+	w.Lock()
+
+	// Limit transactions to two inputs.
+	defer func(size int) { maxTxSize = size }(maxTxSize)
+	maxTxSize = txsizes.EstimateSerializeSize([]int{
+		txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize,
+	}, nil, txsizes.P2PKHPkScriptSize)
+
+	dest := &sweepDestination{script: make([]byte, txsizes.P2PKHPkScriptSize)}
+	sweeps, err := w.NewUnsignedSweeps(0, 1, w.RelayFee(), dest, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sweeps) != 3 {
+		t.Fatalf("account swept in %d transactions", len(sweeps))
+	}
+	spent := make(map[wire.OutPoint]bool)
+	for i, atx := range sweeps {
+		if atx.EstimatedSignedSerializeSize > maxTxSize {
+			t.Errorf("sweep %d exceeds the maximum size", i)
+		}
+		for _, in := range atx.Tx.TxIn {
+			if spent[in.PreviousOutPoint] {
+				t.Errorf("sweep %d double spends %v", i, &in.PreviousOutPoint)
+			}
+			spent[in.PreviousOutPoint] = true
+		}
+	}
+	if len(spent) != 5 {
+		t.Fatalf("sweeps spend %d outputs", len(spent))
+	}
+}
+
+func TestNewUnsignedSweepsFilter(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 1e8, 1e5)
+	w.Lock()
+
+	sweeps, err := w.NewUnsignedSweeps(0, 1, w.RelayFee(),
+		&sweepDestination{script: make([]byte, txsizes.P2PKHPkScriptSize)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.LockOutpoint(sweeps[0].Tx.TxIn[0].PreviousOutPoint)
+
+	tests := []struct {
+		filter *SweepFilter
+		inputs int
+	}{
+		{nil, 2},
+		{&SweepFilter{IncludeLocked: true}, 3},
+		{&SweepFilter{DustThreshold: 1e6}, 1},
+		{&SweepFilter{IncludeLocked: true, DustThreshold: 1e6}, 2},
+	}
+	for i, test := range tests {
+		sweeps, err := w.NewUnsignedSweeps(0, 1, w.RelayFee(),
+			&sweepDestination{script: make([]byte, txsizes.P2PKHPkScriptSize)},
+			test.filter)
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		if n := len(sweeps[0].Tx.TxIn); len(sweeps) != 1 || n != test.inputs {
+			t.Errorf("test %d: swept %d inputs, expected %d", i, n, test.inputs)
+		}
+	}
+}
+
+func TestSweepDust(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e4, 3e4, 4e4, 5e4, 1e8, 2e6)
+	if DustThreshold(w.RelayFee()) <= 5e4 {
+		t.Fatalf("unexpected dust threshold %v", DustThreshold(w.RelayFee()))
+	}
+
+	// Mark the 2e6 output as dust.
+	sweeps, err := w.NewUnsignedSweeps(0, 1, w.RelayFee(),
+		&sweepDestination{script: make([]byte, txsizes.P2PKHPkScriptSize)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range sweeps[0].Tx.TxIn {
+		if in.ValueIn == 2e6 {
+			err := w.SetUTXOPolicy(&in.PreviousOutPoint, udb.UTXOPolicyDust)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	sweep, err := w.SweepDust(0, 0, w.RelayFee())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep.Inputs != 4 || sweep.DustValue != 3e4+4e4+5e4+2e6 || len(sweep.TxHashes) != 1 {
+		t.Fatalf("unexpected dust sweep %+v", sweep)
+	}
+	if sweep.Reclaimed <= 0 || sweep.Reclaimed >= sweep.DustValue {
+		t.Fatalf("reclaimed %v of %v dust", sweep.Reclaimed, sweep.DustValue)
+	}
+
+	// The remaining dust output is worth less than the fee to spend it.
+	sweep, err = w.SweepDust(0, 0, w.RelayFee())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep.Inputs != 0 || len(sweep.TxHashes) != 0 {
+		t.Fatalf("unprofitable dust swept %+v", sweep)
+	}
+}
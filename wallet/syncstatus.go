@@ -0,0 +1,173 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// SyncStatus describes the synchronization of the wallet with its network
+// backend.
+type SyncStatus struct {
+	// Synced is true once the network backend has completed the initial
+	// synchronization of the wallet.
+	Synced bool
+
+	TipHash   chainhash.Hash
+	TipHeight int32
+
+	// BackendHeight is the best block height known by the network
+	// backend, or zero when it is not yet known.  It is never less than
+	// the height of the wallet's main chain tip.
+	BackendHeight int32
+
+	// HeadersFetched and CFiltersFetched count the block headers and
+	// compact filters fetched from the network since the network backend
+	// was set.
+	HeadersFetched  int32
+	CFiltersFetched int32
+
+	// RescanPoint is the first main chain block which has not been scanned
+	// for wallet transactions, or nil if every block has been scanned.
+	RescanPoint       *chainhash.Hash
+	RescanPointHeight int32
+
+	// Rescanning reports whether a rescan is in progress and
+	// RescannedThrough is the last block height it has scanned.
+	Rescanning       bool
+	RescannedThrough int32
+
+	// EstimatedRemaining is the estimated duration until the wallet is
+	// synchronized, based on the rate of the current headers fetch or
+	// rescan.  It is zero when synchronized or when no estimate is
+	// available.
+	EstimatedRemaining time.Duration
+}
+
+type syncProgress struct {
+	synced        bool
+	backendHeight int32
+
+	headersFetched  int32
+	cfiltersFetched int32
+	headersStart    time.Time
+	headersStartTip int32
+}
+
+// SetSynced records whether the network backend has completed synchronizing
+// the wallet.  It is called by network syncers.
+func (w *Wallet) SetSynced(synced bool) {
+	w.syncProgressMu.Lock()
+	w.syncProgress.synced = synced
+	w.syncProgressMu.Unlock()
+}
+
+// SetBackendHeight records the best block height known by the network
+// backend.  It is called by network syncers.
+func (w *Wallet) SetBackendHeight(height int32) {
+	w.syncProgressMu.Lock()
+	if height > w.syncProgress.backendHeight {
+		w.syncProgress.backendHeight = height
+	}
+	w.syncProgressMu.Unlock()
+}
+
+// RecordFetchedHeaders records the number of new block headers, and the
+// compact filters of these blocks, fetched from the network.  It is called by
+// network syncers.
+func (w *Wallet) RecordFetchedHeaders(headers, cfilters int32) {
+	_, tipHeight := w.MainChainTip()
+	w.syncProgressMu.Lock()
+	p := &w.syncProgress
+	if p.headersStart.IsZero() {
+		p.headersStart = time.Now()
+		p.headersStartTip = tipHeight
+	}
+	p.headersFetched += headers
+	p.cfiltersFetched += cfilters
+	w.syncProgressMu.Unlock()
+}
+
+func (w *Wallet) recordFetchedCFilters(cfilters int32) {
+	w.syncProgressMu.Lock()
+	w.syncProgress.cfiltersFetched += cfilters
+	w.syncProgressMu.Unlock()
+}
+
+// resetSyncProgress clears the recorded synchronization progress after the
+// network backend changes.
+func (w *Wallet) resetSyncProgress() {
+	w.syncProgressMu.Lock()
+	w.syncProgress = syncProgress{}
+	w.syncProgressMu.Unlock()
+}
+
+// remaining estimates the time to process the blocks through target when
+// the blocks from start through done were processed since began.
+func remaining(began time.Time, start, done, target int32) time.Duration {
+	elapsed := time.Since(began)
+	processed := done - start + 1
+	if processed <= 0 || elapsed <= 0 || target <= done {
+		return 0
+	}
+	perBlock := elapsed / time.Duration(processed)
+	return perBlock * time.Duration(target-done)
+}
+
+// SyncStatus returns the current synchronization status of the wallet.
+func (w *Wallet) SyncStatus() (*SyncStatus, error) {
+	const op errors.Op = "wallet.SyncStatus"
+
+	tipHash, tipHeight := w.MainChainTip()
+	rescanPoint, err := w.RescanPoint()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var rescanPointHeight int32
+	if rescanPoint != nil {
+		h, err := w.BlockHeader(rescanPoint)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		rescanPointHeight = int32(h.Height)
+	}
+
+	w.syncProgressMu.Lock()
+	p := w.syncProgress
+	w.syncProgressMu.Unlock()
+	rescan := w.RescanStatus()
+	rescanning := rescan != nil && !rescan.Done
+
+	s := &SyncStatus{
+		Synced:            p.synced,
+		TipHash:           tipHash,
+		TipHeight:         tipHeight,
+		BackendHeight:     p.backendHeight,
+		HeadersFetched:    p.headersFetched,
+		CFiltersFetched:   p.cfiltersFetched,
+		RescanPoint:       rescanPoint,
+		RescanPointHeight: rescanPointHeight,
+		Rescanning:        rescanning,
+	}
+	if rescan != nil {
+		s.RescannedThrough = rescan.ScannedThrough
+	}
+	if s.BackendHeight != 0 && s.BackendHeight < tipHeight {
+		s.BackendHeight = tipHeight
+	}
+	switch {
+	case s.Synced:
+	case rescanning:
+		s.EstimatedRemaining = remaining(rescan.Started, rescan.StartHeight,
+			rescan.ScannedThrough, rescan.TargetHeight)
+	case !p.headersStart.IsZero() && s.BackendHeight > tipHeight:
+		s.EstimatedRemaining = remaining(p.headersStart, p.headersStartTip+1,
+			tipHeight, s.BackendHeight)
+	}
+	return s, nil
+}
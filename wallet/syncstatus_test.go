@@ -0,0 +1,81 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncStatus(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	s, err := w.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Synced || s.BackendHeight != 0 || s.HeadersFetched != 0 || s.EstimatedRemaining != 0 {
+		t.Fatalf("unexpected initial status %+v", s)
+	}
+
+	w.SetBackendHeight(100)
+	w.SetBackendHeight(50)
+	w.RecordFetchedHeaders(10, 10)
+	w.recordFetchedCFilters(5)
+	s, err = w.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BackendHeight != 100 || s.HeadersFetched != 10 || s.CFiltersFetched != 15 {
+		t.Fatalf("unexpected status %+v", s)
+	}
+
+	rt := w.startRescanTracker(1, nil)
+	rt.scanned(5)
+	s, err = w.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Rescanning || s.RescannedThrough != 5 {
+		t.Fatalf("unexpected rescan status %+v", s)
+	}
+	rt.done(nil)
+
+	w.SetSynced(true)
+	s, err = w.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Synced || s.Rescanning || s.EstimatedRemaining != 0 {
+		t.Fatalf("unexpected synced status %+v", s)
+	}
+
+	// Changing the network backend clears the progress.
+	w.SetNetworkBackend(nil)
+	s, err = w.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Synced || s.BackendHeight != 0 || s.HeadersFetched != 0 {
+		t.Fatalf("progress not cleared %+v", s)
+	}
+}
+
+func TestSyncRemaining(t *testing.T) {
+	began := time.Now().Add(-10 * time.Second)
+	d := remaining(began, 1, 100, 300)
+	if d < 19*time.Second || d > 21*time.Second {
+		t.Errorf("estimated %v remaining, expected about 20s", d)
+	}
+	if d := remaining(began, 1, 100, 100); d != 0 {
+		t.Errorf("estimated %v remaining after completion", d)
+	}
+	if d := remaining(began, 1, 0, 100); d != 0 {
+		t.Errorf("estimated %v remaining without progress", d)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// TicketBudgetRemaining returns how much of a ticket purchasing budget of max
+// per window blocks remains for an account, counting the tickets recorded with
+// RecordTicketSpend in the window ending at the main chain tip.
+func (w *Wallet) TicketBudgetRemaining(account uint32, max vhcutil.Amount, window int32) (vhcutil.Amount, error) {
+	const op errors.Op = "wallet.TicketBudgetRemaining"
+	if window < 1 {
+		return 0, errors.E(op, errors.Invalid, "budget window must be at least one block")
+	}
+	_, tipHeight := w.MainChainTip()
+	var spent vhcutil.Amount
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		spent, err = w.Manager.TicketSpend(ns, account, tipHeight-window+1)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if spent >= max {
+		return 0, nil
+	}
+	return max - spent, nil
+}
+
+// RecordTicketSpend records amount spent on tickets purchased from an account
+// at the main chain tip.  Records older than window blocks are pruned.
+func (w *Wallet) RecordTicketSpend(account uint32, amount vhcutil.Amount, window int32) error {
+	const op errors.Op = "wallet.RecordTicketSpend"
+	_, tipHeight := w.MainChainTip()
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.PutTicketSpend(ns, account, tipHeight, amount,
+			tipHeight-window+1)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
@@ -24,32 +24,81 @@ import (
 
 // GenerateVoteTx creates a vote transaction for a chosen ticket purchase hash
 // using the provided votebits.  The ticket purchase transaction must be stored
-// by the wallet.
+// by the wallet.  The vote bits and agenda choices of the vote are recorded.
 func (w *Wallet) GenerateVoteTx(blockHash *chainhash.Hash, height int32, ticketHash *chainhash.Hash, voteBits stake.VoteBits) (*wire.MsgTx, error) {
 	const op errors.Op = "wallet.GenerateVoteTx"
 
 	var vote *wire.MsgTx
-	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
-		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
-		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
-		ticketPurchase, err := w.TxStore.Tx(txmgrNs, ticketHash)
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		vote, err = w.generateVoteTx(dbtx, blockHash, height, ticketHash, voteBits)
 		if err != nil {
 			return err
 		}
-		vote, err = createUnsignedVote(ticketHash, ticketPurchase,
-			height, blockHash, voteBits, w.subsidyCache, w.chainParams)
-		if err != nil {
-			return errors.E(op, err)
-		}
-		err = w.signVote(addrmgrNs, ticketPurchase, vote)
-		if err != nil {
-			return errors.E(op, err)
+		return w.recordVote(dbtx, vote, time.Now())
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return vote, nil
+}
+
+// GeneratedVote describes the vote created for a ticket by GenerateVoteTxs, or
+// the error which prevented its creation.
+type GeneratedVote struct {
+	Ticket chainhash.Hash
+	Vote   *wire.MsgTx
+	Err    error
+}
+
+// GenerateVoteTxs creates vote transactions for each of the chosen ticket
+// purchase hashes on the same block using the provided votebits.  Votes are
+// created in a single database transaction, and failing to create the vote of
+// one ticket does not prevent votes from being created for the others.  The
+// vote bits and agenda choices of each created vote are recorded.
+func (w *Wallet) GenerateVoteTxs(blockHash *chainhash.Hash, height int32, ticketHashes []*chainhash.Hash, voteBits stake.VoteBits) ([]*GeneratedVote, error) {
+	const op errors.Op = "wallet.GenerateVoteTxs"
+
+	votes := make([]*GeneratedVote, len(ticketHashes))
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		now := time.Now()
+		for i, ticketHash := range ticketHashes {
+			vote, err := w.generateVoteTx(dbtx, blockHash, height, ticketHash, voteBits)
+			votes[i] = &GeneratedVote{Ticket: *ticketHash, Vote: vote, Err: err}
+			if err != nil {
+				continue
+			}
+			err = w.recordVote(dbtx, vote, now)
+			if err != nil {
+				return err
+			}
 		}
-		return err
+		return nil
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	return votes, nil
+}
+
+// generateVoteTx creates and signs a vote transaction for a ticket purchase
+// stored by the wallet.
+func (w *Wallet) generateVoteTx(dbtx walletdb.ReadTx, blockHash *chainhash.Hash, height int32, ticketHash *chainhash.Hash, voteBits stake.VoteBits) (*wire.MsgTx, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	ticketPurchase, err := w.TxStore.Tx(txmgrNs, ticketHash)
+	if err != nil {
+		return nil, err
+	}
+	vote, err := createUnsignedVote(ticketHash, ticketPurchase,
+		height, blockHash, voteBits, w.subsidyCache, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	err = w.signVote(addrmgrNs, ticketPurchase, vote)
+	if err != nil {
+		return nil, err
+	}
 	return vote, nil
 }
 
@@ -427,15 +476,22 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 		return err
 	}
 
-	if len(expired) == 0 {
+	return w.revokeTicketsWithPeer(ctx, p, expired)
+}
+
+// revokeTicketsWithPeer creates, records, and publishes to p the revocations
+// of the tickets which the wallet has voting authority for.
+func (w *Wallet) revokeTicketsWithPeer(ctx context.Context, p Peer, tickets []chainhash.Hash) error {
+	if len(tickets) == 0 {
 		return nil
 	}
 
 	feePerKb := w.RelayFee()
-	revocations := make([]*wire.MsgTx, 0, len(expired))
-	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
-		for i := range expired {
-			ticketHash := &expired[i]
+	revoked := make([]*chainhash.Hash, 0, len(tickets))
+	revocations := make([]*wire.MsgTx, 0, len(tickets))
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		for i := range tickets {
+			ticketHash := &tickets[i]
 			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 			ticketPurchase, err := w.TxStore.Tx(txmgrNs, ticketHash)
@@ -462,6 +518,7 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 			if err != nil {
 				return err
 			}
+			revoked = append(revoked, ticketHash)
 			revocations = append(revocations, revocation)
 		}
 		return nil
@@ -478,7 +535,7 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 				return err
 			}
 
-			log.Infof("Revoking ticket %v with revocation %v", &expired[i],
+			log.Infof("Revoking ticket %v with revocation %v", revoked[i],
 				&rec.Hash)
 
 			watch, err := w.processTransactionRecord(dbtx, rec, nil, nil)
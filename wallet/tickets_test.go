@@ -0,0 +1,91 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestGenerateVoteTxsUnknownTickets(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	// Failing to vote an unknown ticket does not fail the batch.
+	tickets := []*chainhash.Hash{{1}, {2}}
+	votes, err := w.GenerateVoteTxs(&chainhash.Hash{3}, 100, tickets,
+		stake.VoteBits{Bits: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(votes) != len(tickets) {
+		t.Fatalf("expected %d results, got %d", len(tickets), len(votes))
+	}
+	for i, v := range votes {
+		if v.Ticket != *tickets[i] {
+			t.Errorf("result %d is for ticket %v, expected %v", i, &v.Ticket, tickets[i])
+		}
+		if v.Vote != nil || !errors.Is(errors.NotExist, v.Err) {
+			t.Errorf("vote of unknown ticket %v: vote %v, error %v", tickets[i], v.Vote, v.Err)
+		}
+	}
+}
+
+func TestPurchaseTicketsUnsigned(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	// Unsigned tickets are created by locked wallets.
+	fundAccount(t, w, 0, 10e8)
+	ticketPrice, err := w.NextStakeDifficulty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := w.PurchaseTicketsUnsigned(-1, 0, nil, 0, 2, nil, 0, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.TicketPrice != ticketPrice || len(p.Tickets) != 2 {
+		t.Fatalf("created %d tickets at price %v", len(p.Tickets), p.TicketPrice)
+	}
+
+	// Each ticket spends the split output at its own index, and no
+	// transaction is signed.
+	splitHash := p.SplitTx.TxHash()
+	for _, in := range p.SplitTx.TxIn {
+		if len(in.SignatureScript) != 0 {
+			t.Fatal("split transaction is signed")
+		}
+	}
+	for i, ticket := range p.Tickets {
+		if !stake.IsSStx(ticket) || ticket.TxOut[0].Value != int64(ticketPrice) {
+			t.Fatalf("ticket %d is not a ticket at the ticket price", i)
+		}
+		in := ticket.TxIn[0]
+		if in.PreviousOutPoint.Hash != splitHash || in.PreviousOutPoint.Index != uint32(i) ||
+			in.ValueIn != p.SplitTx.TxOut[i].Value || len(in.SignatureScript) != 0 {
+			t.Fatalf("ticket %d input %+v", i, in)
+		}
+	}
+
+	// Nothing is recorded or published, so the funds remain spendable.
+	_, _, _, err = w.TransactionSummary(&splitHash)
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("split transaction was recorded: %v", err)
+	}
+	bal, err := w.CalculateAccountBalance(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal.Spendable != 10e8 {
+		t.Fatalf("spendable balance %v after unsigned purchase", bal.Spendable)
+	}
+}
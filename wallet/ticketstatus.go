@@ -0,0 +1,146 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// TicketStatusChange describes a ticket of the wallet becoming live, voting,
+// being missed, expiring, or being revoked in a block attached to the main
+// chain.
+type TicketStatusChange struct {
+	Ticket      chainhash.Hash
+	Status      TicketStatus
+	BlockHash   chainhash.Hash
+	BlockHeight int32
+}
+
+// checkTicketStatusChanges notifies clients of the status changes of the
+// wallet's tickets caused by attaching a chain of blocks to the main chain.
+// Missed votes detected when the blocks were attached are reported as missed
+// tickets.
+func (w *Wallet) checkTicketStatusChanges(chain []*BlockNode, missed []*MissedVote) error {
+	const op errors.Op = "wallet.checkTicketStatusChanges"
+	maturity := int32(w.chainParams.TicketMaturity)
+	expiry := int32(w.chainParams.TicketExpiry)
+
+	missedTickets := make(map[chainhash.Hash]struct{})
+	for _, m := range w.MissedVotes() {
+		missedTickets[m.Ticket] = struct{}{}
+	}
+
+	var changes []*TicketStatusChange
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		// rangeBlock calls f with the wallet transactions mined in the main
+		// chain block at a height.
+		rangeBlock := func(height int32, f func(*udb.TxDetails) error) error {
+			if height < 0 {
+				return nil
+			}
+			return w.TxStore.RangeTransactions(txmgrNs, height, height, func(details []udb.TxDetails) (bool, error) {
+				for i := range details {
+					err := f(&details[i])
+					if err != nil {
+						return false, err
+					}
+				}
+				return false, nil
+			})
+		}
+
+		for _, n := range chain {
+			height := int32(n.Header.Height)
+			add := func(ticket *chainhash.Hash, status TicketStatus) {
+				changes = append(changes, &TicketStatusChange{
+					Ticket:      *ticket,
+					Status:      status,
+					BlockHash:   *n.Hash,
+					BlockHeight: height,
+				})
+			}
+
+			// Tickets purchased in the block TicketMaturity+1 blocks
+			// earlier become live.
+			err := rangeBlock(height-maturity-1, func(details *udb.TxDetails) error {
+				if details.TxType == stake.TxTypeSStx {
+					add(&details.Hash, TicketStatusLive)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			// Unspent tickets which were not missed expire TicketExpiry
+			// blocks after becoming live.
+			err = rangeBlock(height-maturity-expiry-1, func(details *udb.TxDetails) error {
+				if details.TxType != stake.TxTypeSStx {
+					return nil
+				}
+				if _, ok := missedTickets[details.Hash]; ok {
+					return nil
+				}
+				ticket, err := w.TxStore.TicketDetails(txmgrNs, details)
+				if err != nil {
+					return err
+				}
+				if ticket.Spender == nil || ticket.Spender.Block.Height == -1 {
+					add(&details.Hash, TicketStatusExpired)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			// Votes and revocations mined in the block spend the wallet's
+			// tickets.
+			err = rangeBlock(height, func(details *udb.TxDetails) error {
+				var status TicketStatus
+				var ticketHash *chainhash.Hash
+				switch details.TxType {
+				case stake.TxTypeSSGen:
+					status = TicketStatusVoted
+					ticketHash = &details.MsgTx.TxIn[1].PreviousOutPoint.Hash
+				case stake.TxTypeSSRtx:
+					status = TicketStatusRevoked
+					ticketHash = &details.MsgTx.TxIn[0].PreviousOutPoint.Hash
+				default:
+					return nil
+				}
+				if w.TxStore.ExistsTx(txmgrNs, ticketHash) {
+					add(ticketHash, status)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			// Tickets selected to vote on the previous block whose votes
+			// were not included in this block were missed.
+			for _, m := range missed {
+				if m.BlockHash == n.Header.PrevBlock {
+					add(&m.Ticket, TicketStatusMissed)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if len(changes) != 0 {
+		w.NtfnServer.notifyTickets(changes)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+func TestTicketStatusChanges(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+
+	n := w.NtfnServer.TicketNotifications()
+	defer n.Done()
+	changes := make(chan *TicketStatusChange, 10)
+	go func() {
+		for v := range n.C {
+			for _, c := range v.Changes {
+				changes <- c
+			}
+		}
+	}()
+	expectChange := func(ticket *chainhash.Hash, status TicketStatus, block *gblock) {
+		t.Helper()
+		select {
+		case c := <-changes:
+			if c.Ticket != *ticket || c.Status != status ||
+				c.BlockHash != *block.Hash || c.BlockHeight != int32(block.MsgBlock.Header.Height) {
+				t.Fatalf("unexpected ticket status change %+v", c)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no ticket status change to %v", status)
+		}
+	}
+	expectStatus := func(ticket *chainhash.Hash, status TicketStatus) {
+		t.Helper()
+		summary, _, err := w.GetTicketInfo(ticket)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.Status != status {
+			t.Fatalf("ticket status %v, expected %v", summary.Status, status)
+		}
+	}
+	attach := func(b *gblock, relevantTxs map[chainhash.Hash][]*wire.MsgTx) {
+		t.Helper()
+		mustAddBlockNode(t, forest, b.BlockNode)
+		bestChain := tw.evaluateBestChain(forest, 1, b.Hash)
+		_, err := w.ChainSwitch(forest, bestChain, relevantTxs)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &SplitTicketSession{
+		Network:       cfg.Params.Name,
+		TicketPrice:   1e8,
+		Expiry:        1000,
+		VotingAddress: splitTicketTestAddr(t, 1),
+		Contributions: []SplitTicketContribution{{
+			Amount:            1e8,
+			CommitmentAddress: splitTicketTestAddr(t, 2),
+			ChangeAddress:     splitTicketTestAddr(t, 3),
+			Inputs: []SplitTicketInput{{
+				Hash:     chainhash.Hash{1}.String(),
+				Amount:   2e8,
+				PkScript: hex.EncodeToString([]byte{txscript.OP_TRUE}),
+			}},
+		}},
+	}
+	ticket, err := s.Ticket(cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticketHash := ticket.TxHash()
+
+	attach(tg.createPremineBlock("premine"), nil)
+	b := tg.nextBlock("ticket", nil, nil, func(b *wire.MsgBlock) {
+		b.STransactions = append(b.STransactions, ticket)
+	})
+	attach(b, map[chainhash.Hash][]*wire.MsgTx{*b.Hash: {ticket}})
+
+	// The ticket becomes live once it matures.
+	for i := 0; i <= int(cfg.Params.TicketMaturity); i++ {
+		b = tg.nextBlock(fmt.Sprintf("b%d", i), nil, nil)
+		attach(b, nil)
+	}
+	expectChange(&ticketHash, TicketStatusLive, b)
+	expectStatus(&ticketHash, TicketStatusLive)
+
+	// The ticket is missed when it is selected to vote on a block and the
+	// next block does not include its vote.
+	w.selectedTickets[*b.Hash] = &selectedTickets{
+		height:  int32(b.MsgBlock.Header.Height),
+		tickets: []chainhash.Hash{ticketHash},
+	}
+	b = tg.nextBlock("missed", nil, nil)
+	attach(b, nil)
+	expectChange(&ticketHash, TicketStatusMissed, b)
+
+	// Missed tickets are reported without querying the consensus server.
+	expectStatus(&ticketHash, TicketStatusMissed)
+
+	select {
+	case c := <-changes:
+		t.Fatalf("unexpected ticket status change %+v", c)
+	default:
+	}
+}
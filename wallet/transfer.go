@@ -0,0 +1,76 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// MoveFunds creates and publishes a transaction transferring amount from one
+// account of the wallet to a new internal address of another account.  The
+// transaction is recorded as an internal transfer so that it is listed as a
+// transfer between the accounts rather than as a send and receive.
+func (w *Wallet) MoveFunds(fromAccount, toAccount uint32, amount vhcutil.Amount, minconf int32) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.MoveFunds"
+	if fromAccount == toAccount {
+		return nil, errors.E(op, errors.Invalid, "source and destination accounts must differ")
+	}
+	if toAccount == udb.ImportedAddrAccount {
+		return nil, errors.E(op, errors.Invalid, "funds may not be moved to the imported account")
+	}
+
+	required, err := w.SendApprovalRequired(fromAccount)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if required {
+		return nil, errors.E(op, errApprovalRequired(fromAccount))
+	}
+
+	addr, err := w.NewInternalAddress(toAccount, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, errors.E(op, errors.Bug, err)
+	}
+	output := wire.NewTxOut(int64(amount), pkScript)
+	err = txrules.CheckOutput(output, w.RelayFee())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	transfer := &udb.InternalTransfer{
+		FromAccount: fromAccount,
+		ToAccount:   toAccount,
+	}
+	req := createTxRequest{
+		account: fromAccount,
+		outputs: []*wire.TxOut{output},
+		minconf: minconf,
+		record: func(txmgrNs walletdb.ReadWriteBucket, txHash *chainhash.Hash) error {
+			return w.TxStore.PutInternalTransfer(txmgrNs, txHash, transfer)
+		},
+		resp: make(chan createTxResponse),
+	}
+	w.createTxRequests <- req
+	resp := <-req.resp
+	if resp.err != nil {
+		return nil, errors.E(op, resp.err)
+	}
+
+	hash := resp.tx.Tx.TxHash()
+	log.Infof("Moved %v from account %d to account %d in transaction %v",
+		amount, fromAccount, toAccount, &hash)
+	return &hash, nil
+}
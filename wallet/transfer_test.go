@@ -0,0 +1,90 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// transferCheckNetwork checks that published transactions are already recorded
+// as internal transfers.
+type transferCheckNetwork struct {
+	mockNetwork
+	w         *Wallet
+	published []*udb.InternalTransfer
+}
+
+func (n *transferCheckNetwork) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	return walletdb.View(n.w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, tx := range txs {
+			hash := tx.TxHash()
+			t, err := n.w.TxStore.InternalTransfer(txmgrNs, &hash)
+			if err != nil {
+				return err
+			}
+			n.published = append(n.published, t)
+		}
+		return nil
+	})
+}
+
+func TestMoveFunds(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	n := &transferCheckNetwork{w: w}
+	w.SetNetworkBackend(n)
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 5e8)
+	account, err := w.NextAccount("savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = w.MoveFunds(0, 0, 1e8, 1)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("moved funds to the source account: %v", err)
+	}
+
+	hash, err := w.MoveFunds(0, account, 1e8, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.published) != 1 || n.published[0].FromAccount != 0 ||
+		n.published[0].ToAccount != account {
+		t.Fatalf("published transfers %+v", n.published)
+	}
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		if !w.TxStore.ExistsTx(txmgrNs, hash) {
+			return errors.Errorf("transfer %v was not recorded", hash)
+		}
+		_, err := w.TxStore.InternalTransfer(txmgrNs, hash)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bal, err := w.CalculateAccountBalance(account, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal.Total != vhcutil.Amount(1e8) {
+		t.Fatalf("destination balance %v", bal.Total)
+	}
+}
@@ -173,7 +173,8 @@ func (a *managedAddress) ExportPubKey() string {
 
 // newManagedAddressWithoutPrivKey returns a new managed address based on the
 // passed account, public key, and whether or not the public key should be
-// compressed.
+// compressed.  The signature algorithm of the address is the one of the public
+// key.
 func newManagedAddressWithoutPrivKey(m *Manager, account uint32, pubKey chainec.PublicKey, compressed bool) (*managedAddress, error) {
 	// Create a pay-to-pubkey-hash address from the public key.
 	var pubKeyHash []byte
@@ -183,7 +184,7 @@ func newManagedAddressWithoutPrivKey(m *Manager, account uint32, pubKey chainec.
 		pubKeyHash = vhcutil.Hash160(pubKey.SerializeUncompressed())
 	}
 	address, err := vhcutil.NewAddressPubKeyHash(pubKeyHash, m.chainParams,
-		vhcec.STEcdsaSecp256k1)
+		vhcec.SignatureType(pubKey.GetType()))
 	if err != nil {
 		return nil, err
 	}
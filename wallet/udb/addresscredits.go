@@ -0,0 +1,232 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// The address credits bucket indexes every credit recorded by the store by
+// each address paid by the credited output script.  Keys use the format:
+//
+//   [0]      Length of the encoded address (1 byte)
+//   [1:n+1]  Encoded address (n bytes)
+//   [n+1:]   Canonical outpoint of the credit (36 bytes)
+//
+// Values record the 8-byte amount of the credit.
+//
+// Index entries are never removed.  Entries for credits that are no longer
+// recorded, for example after an unmined transaction is removed as a double
+// spend, are skipped when the index is read.
+
+// AddressCredit describes an output credited to a wallet address.  Height is
+// the height of the block mining the credit, or -1 for unmined credits.
+type AddressCredit struct {
+	OutPoint wire.OutPoint
+	Amount   vhcutil.Amount
+	Height   int32
+}
+
+func keyAddressCreditsPrefix(addr string) []byte {
+	k := make([]byte, 1+len(addr))
+	k[0] = byte(len(addr))
+	copy(k[1:], addr)
+	return k
+}
+
+func keyAddressCredit(addr string, op *wire.OutPoint) []byte {
+	k := make([]byte, 1+len(addr)+36)
+	k[0] = byte(len(addr))
+	copy(k[1:], addr)
+	copy(k[1+len(addr):], canonicalOutPoint(&op.Hash, op.Index))
+	return k
+}
+
+func valueAddressCredit(amount vhcutil.Amount) []byte {
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(amount))
+	return v
+}
+
+// putAddressCredits indexes a credit by every address paid by its output
+// script.
+func putAddressCredits(ns walletdb.ReadWriteBucket, op *wire.OutPoint, pkScript []byte,
+	amount vhcutil.Amount, params *chaincfg.Params) error {
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(txscript.DefaultScriptVersion,
+		pkScript, params)
+	if err != nil || len(addrs) == 0 {
+		// Nonstandard scripts do not pay addresses and are not indexed.
+		return nil
+	}
+	b := ns.NestedReadWriteBucket(bucketAddressCredits)
+	v := valueAddressCredit(amount)
+	for _, a := range addrs {
+		err := b.Put(keyAddressCredit(a.EncodeAddress(), op), v)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
+
+// addressCreditHeight returns the block height of a recorded credit, or -1 if
+// the credit is unmined.  ok is false if the credit is not recorded.
+func addressCreditHeight(ns walletdb.ReadBucket, op *wire.OutPoint) (height int32, ok bool) {
+	if existsRawUnminedCredit(ns, canonicalOutPoint(&op.Hash, op.Index)) != nil {
+		return -1, true
+	}
+	recKey, _ := latestTxRecord(ns, op.Hash[:])
+	if recKey == nil {
+		return 0, false
+	}
+	var block Block
+	if err := readRawTxRecordBlock(recKey, &block); err != nil {
+		return 0, false
+	}
+	if _, v := existsCredit(ns, &op.Hash, op.Index, &block); v == nil {
+		return 0, false
+	}
+	return block.Height, true
+}
+
+// forEachAddressCredit calls f with each recorded credit indexed by keys
+// beginning with prefix.
+func forEachAddressCredit(ns walletdb.ReadBucket, prefix []byte, f func(addr string, c *AddressCredit) error) error {
+	c := ns.NestedReadBucket(bucketAddressCredits).ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		addrLen := int(k[0])
+		if len(k) != 1+addrLen+36 || len(v) != 8 {
+			return errors.E(errors.IO, errors.Errorf("address credit key len %d value len %d",
+				len(k), len(v)))
+		}
+		cred := &AddressCredit{Amount: vhcutil.Amount(byteOrder.Uint64(v))}
+		err := readCanonicalOutPoint(k[1+addrLen:], &cred.OutPoint)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		cred.Height, ok = addressCreditHeight(ns, &cred.OutPoint)
+		if !ok {
+			continue
+		}
+		err = f(string(k[1:1+addrLen]), cred)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddressCredits returns every recorded credit paying an address.
+func (s *Store) AddressCredits(ns walletdb.ReadBucket, addr vhcutil.Address) ([]AddressCredit, error) {
+	var credits []AddressCredit
+	prefix := keyAddressCreditsPrefix(addr.EncodeAddress())
+	err := forEachAddressCredit(ns, prefix, func(_ string, c *AddressCredit) error {
+		credits = append(credits, *c)
+		return nil
+	})
+	return credits, err
+}
+
+// ForEachAddressCredit calls f with every recorded credit paying an address,
+// ordered by the encoded address.  Credits paying multiple addresses are
+// reported once for each address.
+func (s *Store) ForEachAddressCredit(ns walletdb.ReadBucket, f func(addr string, c *AddressCredit) error) error {
+	return forEachAddressCredit(ns, nil, f)
+}
+
+// indexAddressCredits indexes every credit recorded by the store.  It is used
+// to build the index of databases which were created before credits were
+// indexed by address.
+func indexAddressCredits(ns walletdb.ReadWriteBucket, params *chaincfg.Params) error {
+	type indexed struct {
+		op       wire.OutPoint
+		pkScript []byte
+		amount   vhcutil.Amount
+	}
+	var credits []indexed
+
+	// Entries are collected first as buckets may not be modified while
+	// iterated.
+	err := ns.NestedReadBucket(bucketCredits).ForEach(func(k, v []byte) error {
+		if len(k) < 72 {
+			return errors.E(errors.IO, errors.Errorf("credit key len %d", len(k)))
+		}
+		recKey := extractRawCreditTxRecordKey(k)
+		recVal := existsRawTxRecord(ns, recKey)
+		if recVal == nil {
+			return errors.E(errors.IO, errors.Errorf("missing tx record for credit %x", k))
+		}
+		index := extractRawCreditIndex(k)
+		pkScript, err := fetchRawTxRecordPkScript(recKey, recVal, index,
+			fetchRawCreditScriptOffset(v), fetchRawCreditScriptLength(v))
+		if err != nil {
+			return err
+		}
+		amount, err := fetchRawCreditAmount(v)
+		if err != nil {
+			return err
+		}
+		credits = append(credits, indexed{
+			op:       wire.OutPoint{Hash: extractRawCreditTxHash(k), Index: index},
+			pkScript: pkScript,
+			amount:   amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) error {
+		var op wire.OutPoint
+		err := readCanonicalOutPoint(k, &op)
+		if err != nil {
+			return err
+		}
+		unmined := existsRawUnmined(ns, op.Hash[:])
+		if unmined == nil {
+			return errors.E(errors.IO, errors.Errorf("missing unmined tx for credit %v", &op))
+		}
+		var tx wire.MsgTx
+		err = tx.Deserialize(bytes.NewReader(extractRawUnminedTx(unmined)))
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		if int(op.Index) >= len(tx.TxOut) {
+			return errors.E(errors.IO, errors.Errorf("missing output for credit %v", &op))
+		}
+		amount, err := fetchRawUnminedCreditAmount(v)
+		if err != nil {
+			return err
+		}
+		credits = append(credits, indexed{
+			op:       op,
+			pkScript: tx.TxOut[op.Index].PkScript,
+			amount:   amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range credits {
+		c := &credits[i]
+		err := putAddressCredits(ns, &c.op, c.pkScript, c.amount, params)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
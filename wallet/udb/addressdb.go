@@ -151,6 +151,36 @@ var (
 	// e.g. last account number
 	metaBucketName = []byte("meta")
 
+	// gapPolicyBucketName is used to store the default address gap limit
+	// policy of accounts which do not use the wallet default, keyed by
+	// account number.
+	gapPolicyBucketName = []byte("gappolicy")
+
+	// depositPoolBucketName is used to store the external addresses reserved
+	// for deposits to accounts and whether they have been assigned, keyed by
+	// account number and child index.
+	depositPoolBucketName = []byte("depositpool")
+
+	// keyStorageBucketName is used to store where the private keys of
+	// accounts which are not stored by the address manager are kept, keyed
+	// by account number.
+	keyStorageBucketName = []byte("keystorage")
+
+	// rotatedAccountBucketName is used to store the successor accounts of
+	// accounts which have been rotated and no longer derive new addresses,
+	// keyed by account number.
+	rotatedAccountBucketName = []byte("rotated")
+
+	// ticketSpendBucketName is used to store the amounts spent by ticket
+	// buyers on tickets purchased from each account, keyed by account
+	// number and block height.
+	ticketSpendBucketName = []byte("ticketspend")
+
+	// multisigAccountBucketName is used to store the cosigner extended
+	// public keys and next child indexes of HD multisig accounts, keyed by
+	// multisig account name.
+	multisigAccountBucketName = []byte("multisigaccts")
+
 	// addrPoolMetaKeyLen is the byte length of the address pool
 	// prefixes. It is 11 bytes for the prefix and 4 bytes for
 	// the account number.
@@ -1132,6 +1162,31 @@ func existsAddress(ns walletdb.ReadBucket, addressID []byte) bool {
 	return bucket.Get(addrHash[:]) != nil
 }
 
+// deleteAddress removes the address with the provided address id from the
+// database and from the address account index.
+func deleteAddress(ns walletdb.ReadWriteBucket, addressID []byte, account uint32) error {
+	addrHash := sha256.Sum256(addressID)
+	err := ns.NestedReadWriteBucket(addrBucketName).Delete(addrHash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	bucket := ns.NestedReadWriteBucket(addrAcctIdxBucketName)
+	err = bucket.Delete(addrHash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	bucket = bucket.NestedReadWriteBucket(uint32ToBytes(account))
+	if bucket == nil {
+		return nil
+	}
+	err = bucket.Delete(addrHash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
 // fetchAddrAccount returns the account to which the given address belongs to.
 // It looks up the account using the addracctidx index which maps the address
 // hash to its corresponding account id.
@@ -10,9 +10,11 @@ import (
 	"crypto/sha512"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
 	"github.com/valhallacoin/vhcd/chaincfg/chainec"
+	"github.com/valhallacoin/vhcd/vhcec"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcd/hdkeychain"
 	"github.com/valhallacoin/vhcwallet/errors"
@@ -132,7 +134,9 @@ type accountInfo struct {
 
 	// The account key is used to derive the branches which in turn derive
 	// the internal and external addresses.
-	// The accountKeyPriv will be nil when the address manager is locked.
+	// The accountKeyPriv will be nil when the address manager is locked, and
+	// for accounts imported from an xpub, which have no encrypted private
+	// key.
 	acctKeyEncrypted []byte
 	acctKeyPriv      *hdkeychain.ExtendedKey
 	acctKeyPub       *hdkeychain.ExtendedKey
@@ -289,6 +293,12 @@ type Manager struct {
 	// manager is already unlocked.  The hash is zeroed each lock.
 	privPassphraseSalt   [saltSize]byte
 	hashedPrivPassphrase [sha512.Size]byte
+
+	// unlockSession caches the derived master private key for unlocks
+	// which occur within unlockSessionTimeout of the last key derivation.
+	// It is not cleared when the manager is locked.
+	unlockSession        *unlockSession
+	unlockSessionTimeout time.Duration
 }
 
 // lock performs a best try effort to remove and zero all secret keys associated
@@ -364,6 +374,8 @@ func (m *Manager) Close() error {
 		m.lock()
 	}
 
+	m.clearUnlockSession()
+
 	// Attempt to clear sensitive public key material from memory too.
 	m.zeroSensitivePublicData()
 
@@ -403,6 +415,9 @@ func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdke
 	// private child derivation.
 	acctKey := acctInfo.acctKeyPub
 	if private {
+		if acctInfo.acctKeyPriv == nil {
+			return nil, errors.E(errors.WatchingOnly, "account has no private keys")
+		}
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -477,7 +492,7 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		acctKeyPub:       acctKeyPub,
 	}
 
-	if !m.locked {
+	if !m.locked && len(acctInfo.acctKeyEncrypted) != 0 {
 		// Use the crypto private key to decrypt the account private
 		// extended keys.
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
@@ -808,12 +823,20 @@ func (m *Manager) importedAddressRowToManaged(row *dbImportedAddressRow) (Manage
 		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt imported pubkey: %v", err))
 	}
 
-	pubKey, err := chainec.Secp256k1.ParsePubKey(pubBytes)
+	// Ed25519 public keys are the only imported keys serialized with 32
+	// bytes, and are always compressed.
+	var pubKey chainec.PublicKey
+	compressed := true
+	if len(pubBytes) == chainec.Edwards.PubKeyBytesLen() {
+		pubKey, err = chainec.Edwards.ParsePubKey(pubBytes)
+	} else {
+		pubKey, err = chainec.Secp256k1.ParsePubKey(pubBytes)
+		compressed = len(pubBytes) == chainec.Secp256k1.PubKeyBytesLenCompressed()
+	}
 	if err != nil {
 		return nil, errors.E(errors.IO, err)
 	}
 
-	compressed := len(pubBytes) == chainec.Secp256k1.PubKeyBytesLenCompressed()
 	ma, err := newManagedAddressWithoutPrivKey(m, row.account, pubKey,
 		compressed)
 	if err != nil {
@@ -901,45 +924,121 @@ func (m *Manager) AddrAccount(ns walletdb.ReadBucket, address vhcutil.Address) (
 	return acct, nil
 }
 
-// ChangePassphrase changes either the public or private passphrase to the
-// provided value depending on the private flag.  In order to change the private
-// password, the address manager must not be watching-only.  The new passphrase
-// keys are derived using the scrypt parameters in the options, so changing the
-// passphrase may be used to bump the computational difficulty needed to brute
-// force the passphrase.
-func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, newPassphrase []byte, private bool) error {
-	defer m.mtx.Unlock()
-	m.mtx.Lock()
+// PassphraseChange is a passphrase change derived by PreparePassphraseChange
+// which has not yet been committed to the database.  The secret keys of a
+// prepared change must be cleared with Zero if the change is abandoned.
+type PassphraseChange struct {
+	private bool
+
+	// oldParams are the master key parameters the change was derived from,
+	// used to detect passphrase changes committed since preparation.
+	oldParams snacl.Parameters
+
+	oldKey           snacl.SecretKey
+	newKey           *snacl.SecretKey
+	passphraseSalt   [saltSize]byte
+	hashedPassphrase [sha512.Size]byte
+}
+
+// Zero clears the secret keys of a prepared passphrase change.
+func (c *PassphraseChange) Zero() {
+	c.oldKey.Zero()
+	if c.newKey != nil {
+		c.newKey.Zero()
+	}
+	zero.Bytea64(&c.hashedPassphrase)
+}
+
+// PreparePassphraseChange verifies the old passphrase and derives the master
+// key of the new passphrase for a change of either the public or private
+// passphrase depending on the private flag.  The key derivations are the
+// expensive part of changing a passphrase, and are performed without holding
+// the manager mutex or any database transaction so that the manager remains
+// usable (in its current lock state) while they run.  The change is applied by
+// CommitPassphraseChange.
+//
+// Individual private keys and scripts are encrypted by the crypto keys rather
+// than the master keys, so the work of a passphrase change is independent of
+// the number of imported keys.
+func (m *Manager) PreparePassphraseChange(oldPassphrase, newPassphrase []byte, private bool) (*PassphraseChange, error) {
+	c := &PassphraseChange{private: private}
 
+	m.mtx.RLock()
 	// No private passphrase to change for a watching-only address manager.
 	if private && m.watchingOnly {
-		return errors.E(errors.WatchingOnly)
+		m.mtx.RUnlock()
+		return nil, errors.E(errors.WatchingOnly)
 	}
-
-	// Ensure the provided old passphrase is correct.  This check is done
-	// using a copy of the appropriate master key depending on the private
-	// flag to ensure the current state is not altered.  The temp key is
-	// cleared when done to avoid leaving a copy in memory.
-	secretKey := snacl.SecretKey{Key: &snacl.CryptoKey{}}
 	if private {
-		secretKey.Parameters = m.masterKeyPriv.Parameters
+		c.oldParams = m.masterKeyPriv.Parameters
 	} else {
-		secretKey.Parameters = m.masterKeyPub.Parameters
+		c.oldParams = m.masterKeyPub.Parameters
 	}
-	if err := secretKey.DeriveKey(&oldPassphrase); err != nil {
-		return err
+	m.mtx.RUnlock()
+
+	// Ensure the provided old passphrase is correct.  This check is done
+	// using a copy of the appropriate master key depending on the private
+	// flag to ensure the current state is not altered.
+	c.oldKey = snacl.SecretKey{Key: &snacl.CryptoKey{}, Parameters: c.oldParams}
+	if err := c.oldKey.DeriveKey(&oldPassphrase); err != nil {
+		return nil, err
 	}
-	defer secretKey.Zero()
 
 	// Generate a new master key from the passphrase which is used to secure
 	// the actual secret keys.
-	newMasterKey, err := newSecretKey(&newPassphrase, &defaultScryptOptions)
+	newKey, err := newSecretKey(&newPassphrase, &defaultScryptOptions)
 	if err != nil {
-		return errors.Errorf("create new master privkey: %v", err)
+		c.oldKey.Zero()
+		return nil, errors.Errorf("create new master privkey: %v", err)
 	}
-	newKeyParams := newMasterKey.Marshal()
+	c.newKey = newKey
 
 	if private {
+		// Create a new salt that will be used for hashing the new
+		// passphrase each unlock, and the hash of the new passphrase
+		// to be used if the manager is unlocked when committing.
+		_, err := rand.Read(c.passphraseSalt[:])
+		if err != nil {
+			c.Zero()
+			return nil, errors.E(errors.IO, err)
+		}
+		saltedPassphrase := append(c.passphraseSalt[:],
+			newPassphrase...)
+		c.hashedPassphrase = sha512.Sum512(saltedPassphrase)
+		zero.Bytes(saltedPassphrase)
+	}
+
+	return c, nil
+}
+
+// CommitPassphraseChange re-encrypts the crypto keys with the new master key of
+// a prepared passphrase change and saves the new keys and master key
+// parameters to the database.  Only fast symmetric encryption is performed.
+// An error with code errors.Invalid is returned if the passphrase was changed
+// after the change was prepared.  The secret keys of the change are consumed
+// or cleared by the commit, and it must not be committed again.
+func (m *Manager) CommitPassphraseChange(ns walletdb.ReadWriteBucket, c *PassphraseChange) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	defer c.oldKey.Zero()
+	secretKey := &c.oldKey
+	newMasterKey := c.newKey
+	c.newKey = nil
+
+	var currentParams snacl.Parameters
+	if c.private {
+		currentParams = m.masterKeyPriv.Parameters
+	} else {
+		currentParams = m.masterKeyPub.Parameters
+	}
+	if currentParams != c.oldParams {
+		newMasterKey.Zero()
+		return errors.E(errors.Invalid, "passphrase changed during preparation of passphrase change")
+	}
+	newKeyParams := newMasterKey.Marshal()
+
+	if c.private {
 		// Technically, the locked state could be checked here to only
 		// do the decrypts when the address manager is locked as the
 		// clear text keys are already available in memory when it is
@@ -947,23 +1046,17 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 		// fast, and it's less cyclomatic complexity to simply decrypt
 		// in either case.
 
-		// Create a new salt that will be used for hashing the new
-		// passphrase each unlock.
-		var passphraseSalt [saltSize]byte
-		_, err := rand.Read(passphraseSalt[:])
-		if err != nil {
-			return errors.E(errors.IO, err)
-		}
-
 		// Re-encrypt the crypto private key using the new master
 		// private key.
 		decPriv, err := secretKey.Decrypt(m.cryptoKeyPrivEncrypted)
 		if err != nil {
+			newMasterKey.Zero()
 			return errors.E(errors.Crypto, errors.Errorf("decrypt crypto privkey: %v", err))
 		}
 		encPriv, err := newMasterKey.Encrypt(decPriv)
 		zero.Bytes(decPriv)
 		if err != nil {
+			newMasterKey.Zero()
 			return errors.E(errors.Crypto, errors.Errorf("encrypt crypto privkey: %v", err))
 		}
 
@@ -971,53 +1064,55 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 		// key.
 		decScript, err := secretKey.Decrypt(m.cryptoKeyScriptEncrypted)
 		if err != nil {
+			newMasterKey.Zero()
 			return errors.E(errors.Crypto, errors.Errorf("decrypt crypto script key: %v", err))
 		}
 		encScript, err := newMasterKey.Encrypt(decScript)
 		zero.Bytes(decScript)
 		if err != nil {
-			return errors.E(errors.Crypto, errors.Errorf("encrypt crypto script key: %v", err))
-		}
-
-		// When the manager is locked, ensure the new clear text master
-		// key is cleared from memory now that it is no longer needed.
-		// If unlocked, create the new passphrase hash with the new
-		// passphrase and salt.
-		var hashedPassphrase [sha512.Size]byte
-		if m.locked {
 			newMasterKey.Zero()
-		} else {
-			saltedPassphrase := append(passphraseSalt[:],
-				newPassphrase...)
-			hashedPassphrase = sha512.Sum512(saltedPassphrase)
-			zero.Bytes(saltedPassphrase)
+			return errors.E(errors.Crypto, errors.Errorf("encrypt crypto script key: %v", err))
 		}
 
 		// Save the new keys and params to the the db in a single
 		// transaction.
 		err = putCryptoKeys(ns, nil, encPriv, encScript)
 		if err != nil {
+			newMasterKey.Zero()
 			return err
 		}
 
 		err = putMasterKeyParams(ns, nil, newKeyParams)
 		if err != nil {
+			newMasterKey.Zero()
 			return err
 		}
 
+		// When the manager is locked, ensure the new clear text master
+		// key and passphrase hash are cleared from memory now that they
+		// are no longer needed.
+		hashedPassphrase := c.hashedPassphrase
+		zero.Bytea64(&c.hashedPassphrase)
+		if m.locked {
+			newMasterKey.Zero()
+			zero.Bytea64(&hashedPassphrase)
+		}
+
 		// Now that the db has been successfully updated, clear the old
 		// key and set the new one.
 		copy(m.cryptoKeyPrivEncrypted[:], encPriv)
 		copy(m.cryptoKeyScriptEncrypted[:], encScript)
 		m.masterKeyPriv.Zero() // Clear the old key.
 		m.masterKeyPriv = newMasterKey
-		m.privPassphraseSalt = passphraseSalt
+		m.clearUnlockSession()
+		m.privPassphraseSalt = c.passphraseSalt
 		m.hashedPrivPassphrase = hashedPassphrase
 	} else {
 		// Re-encrypt the crypto public key using the new master public
 		// key.
 		encryptedPub, err := newMasterKey.Encrypt(m.cryptoKeyPub.Bytes())
 		if err != nil {
+			newMasterKey.Zero()
 			return errors.E(errors.Crypto, errors.Errorf("encrypt crypto pubkey: %v", err))
 		}
 
@@ -1025,11 +1120,13 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 		// transaction.
 		err = putCryptoKeys(ns, encryptedPub, nil, nil)
 		if err != nil {
+			newMasterKey.Zero()
 			return err
 		}
 
 		err = putMasterKeyParams(ns, newKeyParams, nil)
 		if err != nil {
+			newMasterKey.Zero()
 			return err
 		}
 
@@ -1042,6 +1139,24 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 	return nil
 }
 
+// ChangePassphrase changes either the public or private passphrase to the
+// provided value depending on the private flag.  In order to change the private
+// password, the address manager must not be watching-only.  The new passphrase
+// keys are derived using the scrypt parameters in the options, so changing the
+// passphrase may be used to bump the computational difficulty needed to brute
+// force the passphrase.
+//
+// The key derivations are performed while the caller's database transaction is
+// open.  Callers which must not hold the transaction for the duration of the
+// derivations should use PreparePassphraseChange and CommitPassphraseChange.
+func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, newPassphrase []byte, private bool) error {
+	c, err := m.PreparePassphraseChange(oldPassphrase, newPassphrase, private)
+	if err != nil {
+		return err
+	}
+	return m.CommitPassphraseChange(ns, c)
+}
+
 // ConvertToWatchingOnly converts the current address manager to a locked
 // watching-only address manager.
 //
@@ -1128,8 +1243,9 @@ func (m *Manager) ExistsHash160(ns walletdb.ReadBucket, hash160 []byte) bool {
 }
 
 // ImportPrivateKey imports a WIF private key into the address manager.  The
-// imported address is created using either a compressed or uncompressed
-// serialized public key, depending on the CompressPubKey bool of the WIF.
+// imported address is created using the compressed serialized public key.
+// Ed25519 keys are imported as Ed25519 P2PKH addresses, while secp256k1 ECDSA
+// and Schnorr keys are both imported as secp256k1 ECDSA P2PKH addresses.
 //
 // All imported addresses will be part of the account defined by the
 // ImportedAddrAccount constant.
@@ -1192,8 +1308,61 @@ func (m *Manager) ImportPrivateKey(ns walletdb.ReadWriteBucket, wif *vhcutil.WIF
 	}
 
 	// Create a new managed address based on the imported address.
+	var pubKey chainec.PublicKey
+	if wif.DSA() == vhcec.STEd25519 {
+		pubKey = chainec.Edwards.NewPublicKey(wif.PrivKey.Public())
+	} else {
+		pubKey = chainec.Secp256k1.NewPublicKey(wif.PrivKey.Public())
+	}
+	managedAddr, err := newManagedAddressWithoutPrivKey(m, ImportedAddrAccount,
+		pubKey, true)
+	if err != nil {
+		return nil, err
+	}
+	managedAddr.imported = true
+	return managedAddr, nil
+}
+
+// ImportPublicKey imports a serialized secp256k1 public key into the address
+// manager as a watching-only P2PKH address.  The private key is never
+// available, so outputs paid to the address are tracked but can not be spent
+// by the wallet.
+//
+// All imported addresses will be part of the account defined by the
+// ImportedAddrAccount constant.
+//
+// This function will return an error if the public key is invalid or the
+// address already exists.  Any other errors returned are generally unexpected.
+func (m *Manager) ImportPublicKey(ns walletdb.ReadWriteBucket, serializedPubKey []byte) (ManagedPubKeyAddress, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	pubKey, err := chainec.Secp256k1.ParsePubKey(serializedPubKey)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+
+	// Prevent duplicates.
+	pubKeyHash := vhcutil.Hash160(serializedPubKey)
+	if existsAddress(ns, pubKeyHash) {
+		return nil, errors.E(errors.Exist, "address for public key already exists")
+	}
+
+	// Encrypt public key.
+	encryptedPubKey, err := m.cryptoKeyPub.Encrypt(serializedPubKey)
+	if err != nil {
+		return nil, errors.E(errors.Crypto, errors.Errorf("encrypt imported pubkey: %v", err))
+	}
+
+	err = putImportedAddress(ns, pubKeyHash, ImportedAddrAccount, ssNone,
+		encryptedPubKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	compressed := len(serializedPubKey) == chainec.Secp256k1.PubKeyBytesLenCompressed()
 	managedAddr, err := newManagedAddressWithoutPrivKey(m, ImportedAddrAccount,
-		chainec.Secp256k1.NewPublicKey(wif.PrivKey.Public()), true)
+		pubKey, compressed)
 	if err != nil {
 		return nil, err
 	}
@@ -1257,6 +1426,32 @@ func (m *Manager) ImportScript(ns walletdb.ReadWriteBucket, script []byte) (Mana
 	return newScriptAddress(m, ImportedAddrAccount, scriptHash)
 }
 
+// RemoveImportedAddress removes an address added by ImportPrivateKey,
+// ImportPublicKey, or ImportScript from the address manager, along with the
+// encrypted key or script saved for it.  Unknown addresses return an
+// errors.NotExist error and addresses derived from an account branch return
+// an errors.Invalid error.
+func (m *Manager) RemoveImportedAddress(ns walletdb.ReadWriteBucket, address vhcutil.Address) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	addressID := normalizeAddress(address).ScriptAddress()
+	row, err := fetchAddress(ns, addressID)
+	if err != nil {
+		return err
+	}
+	var account uint32
+	switch row := row.(type) {
+	case *dbImportedAddressRow:
+		account = row.account
+	case *dbScriptAddressRow:
+		account = row.account
+	default:
+		return errors.E(errors.Invalid, errors.Errorf("address %v was not imported", address))
+	}
+	return deleteAddress(ns, addressID, account)
+}
+
 // IsLocked returns whether or not the address managed is locked.  When it is
 // unlocked, the decryption key needed to decrypt private keys used for signing
 // is in memory.
@@ -1329,8 +1524,9 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 		return nil
 	}
 
-	// Derive the master private key using the provided passphrase.
-	if err := m.masterKeyPriv.DeriveKey(&passphrase); err != nil {
+	// Derive the master private key using the provided passphrase, or
+	// recover it from the unlock session.
+	if err := m.deriveMasterKeyPriv(passphrase); err != nil {
 		m.lock()
 		return err
 	}
@@ -1345,8 +1541,11 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 	zero.Bytes(decryptedKey)
 
 	// Use the crypto private key to decrypt all of the account private
-	// extended keys.
+	// extended keys.  Accounts imported from an xpub have none.
 	for account, acctInfo := range m.acctInfo {
+		if len(acctInfo.acctKeyEncrypted) == 0 {
+			continue
+		}
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
 		if err != nil {
 			m.lock()
@@ -1540,7 +1739,7 @@ func (m *Manager) syncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account ui
 		if err != nil {
 			return err
 		}
-		if m.locked {
+		if m.locked || acctInfo.acctKeyPriv == nil {
 			break
 		}
 		xprivBranch, err = acctInfo.acctKeyPriv.Child(branch)
@@ -1713,6 +1912,53 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 	return account, nil
 }
 
+// ImportXpubAccount creates a new account from an account extended public key
+// whose private keys are kept outside of the manager, such as inside a hardware
+// security module.  No private key material of the account is recorded, and
+// the account is not restored from the seed.  If an account with the same name
+// already exists, an errors.Exist error is returned.
+func (m *Manager) ImportXpubAccount(ns walletdb.ReadWriteBucket, name string, xpub *hdkeychain.ExtendedKey) (uint32, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if err := ValidateAccountName(name); err != nil {
+		return 0, err
+	}
+	if xpub.IsPrivate() {
+		return 0, errors.E(errors.Invalid, "extended key is private")
+	}
+	if !xpub.IsForNet(m.chainParams) {
+		return 0, errors.E(errors.Invalid, "extended key is for another network")
+	}
+	_, err := fetchAccountByName(ns, name)
+	if err == nil {
+		return 0, errors.E(errors.Exist, errors.Errorf("account named %q already exists", name))
+	}
+
+	account, err := fetchLastAccount(ns)
+	if err != nil {
+		return 0, err
+	}
+	account++
+	if account > MaxAccountNum {
+		return 0, errors.E(errors.Invalid, "maximum number of accounts reached")
+	}
+	acctPubEnc, err := m.cryptoKeyPub.Encrypt([]byte(xpub.String()))
+	if err != nil {
+		return 0, errors.E(errors.Crypto, errors.Errorf("encrypt account pubkey: %v", err))
+	}
+	row := bip0044AccountInfo(acctPubEnc, nil, 0, 0,
+		^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0), name, DBVersion)
+	err = putAccountInfo(ns, account, row)
+	if err != nil {
+		return 0, err
+	}
+	if err := putLastAccount(ns, account); err != nil {
+		return 0, err
+	}
+	return account, nil
+}
+
 // RenameAccount renames an account stored in the manager based on the
 // given account number with the given name.  If an account with the same name
 // already exists, ErrDuplicateAccount will be returned.
@@ -1877,11 +2123,25 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr vhcutil.Address) (key
 		}
 
 	case *dbImportedAddressRow:
+		if len(a.encryptedPrivKey) == 0 {
+			return nil, nil, errors.E(errors.WatchingOnly, "imported address is watching-only")
+		}
 		privKeyBytes, err := m.cryptoKeyPriv.Decrypt(a.encryptedPrivKey)
 		if err != nil {
 			return nil, nil, errors.E(errors.Crypto, errors.Errorf("decrypt imported privkey: %v", err))
 		}
-		key, _ = chainec.Secp256k1.PrivKeyFromBytes(privKeyBytes)
+		// The private keys of all imported keys are serialized as 32 byte
+		// scalars, so the public key determines the curve of the key.
+		pubKeyBytes, err := m.cryptoKeyPub.Decrypt(a.encryptedPubKey)
+		if err != nil {
+			zero.Bytes(privKeyBytes)
+			return nil, nil, errors.E(errors.Crypto, errors.Errorf("decrypt imported pubkey: %v", err))
+		}
+		if len(pubKeyBytes) == chainec.Edwards.PubKeyBytesLen() {
+			key, _ = chainec.Edwards.PrivKeyFromScalar(privKeyBytes)
+		} else {
+			key, _ = chainec.Secp256k1.PrivKeyFromBytes(privKeyBytes)
+		}
 		// PrivKeyFromBytes creates a copy of the private key, and therefore
 		// the decrypted private key bytes must be zeroed now.
 		zero.Bytes(privKeyBytes)
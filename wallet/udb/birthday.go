@@ -0,0 +1,42 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+var birthdayKey = []byte("birthday")
+
+// The birthday is saved in the metadata bucket as the 8 byte unix seconds of
+// the time.
+
+// FetchBirthday returns the time the wallet seed was created, before which no
+// transactions of the wallet were mined.  The zero time is returned when the
+// birthday is unknown.
+func FetchBirthday(dbtx walletdb.ReadTx) (time.Time, error) {
+	v := dbtx.ReadBucket(metadataRootBucketKey).Get(birthdayKey)
+	if v == nil {
+		return time.Time{}, nil
+	}
+	if len(v) != 8 {
+		return time.Time{}, errors.E(errors.IO, errors.Errorf("birthday len %d", len(v)))
+	}
+	return time.Unix(int64(byteOrder.Uint64(v)), 0), nil
+}
+
+// PutBirthday records the birthday of the wallet seed.
+func PutBirthday(dbtx walletdb.ReadWriteTx, birthday time.Time) error {
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(birthday.Unix()))
+	err := dbtx.ReadWriteBucket(metadataRootBucketKey).Put(birthdayKey, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// CheckStats describes the records verified by a database consistency check.
+type CheckStats struct {
+	Full         bool
+	TipHash      chainhash.Hash
+	TipHeight    int32
+	Blocks       int
+	Transactions int
+	Credits      int
+	Accounts     int
+}
+
+// requiredBuckets lists the buckets of each namespace which must exist in a
+// database of the current version.
+var requiredBuckets = []struct {
+	ns      []byte
+	buckets [][]byte
+}{
+	{waddrmgrBucketKey, [][]byte{
+		mainBucketName, metaBucketName, acctBucketName, addrBucketName,
+		addrAcctIdxBucketName, acctNameIdxBucketName, acctIDIdxBucketName,
+		gapPolicyBucketName, depositPoolBucketName, keyStorageBucketName,
+		rotatedAccountBucketName, ticketSpendBucketName,
+		multisigAccountBucketName,
+	}},
+	{wstakemgrBucketKey, [][]byte{
+		sstxRecordsBucketName, ssgenRecordsBucketName, ssrtxRecordsBucketName,
+		stakePoolUserTicketsBucketName, stakePoolUserStatusBucketName,
+	}},
+	{wtxmgrBucketKey, [][]byte{
+		bucketBlocks, bucketHeaders, bucketTxRecords, bucketCredits,
+		bucketUnspent, bucketDebits, bucketUnmined, bucketUnminedCredits,
+		bucketUnminedInputs, bucketTickets, bucketScripts, bucketMultisig,
+		bucketMultisigUsp, bucketStakeInvalidatedCredits,
+		bucketStakeInvalidatedDebits, bucketCFilters, bucketSpendingPolicies,
+		bucketSendApprovals, bucketPendingSends, bucketInternalTransfers,
+		bucketVoteRecords, bucketVSPTickets, bucketSplitTickets,
+		bucketColdVotingTickets, bucketColdVotingIndexes, bucketScriptImports,
+		bucketWatchedScripts, bucketWatchedOutPoints, bucketOutpointLocks,
+		bucketUTXOPolicies, bucketAddressCredits, bucketPendingRevocations,
+	}},
+}
+
+// corrupt returns an IO error describing an inconsistency of the database.
+func corrupt(format string, args ...interface{}) error {
+	return errors.E(errors.IO, errors.Errorf("wallet database is corrupt: "+format, args...))
+}
+
+// Check validates the structure of an upgraded database.  The quick check
+// verifies the version, the presence of all buckets, and that the main chain
+// tip links to the block records and headers of its parent.  When full is
+// true, every main chain block is verified to link to its parent, and every
+// block transaction, credit, unspent output, and account record is verified to
+// reference existing records.
+//
+// Inconsistencies are reported with an errors.IO error describing the
+// corrupt record.
+func Check(db walletdb.DB, params *chaincfg.Params, full bool) (*CheckStats, error) {
+	const op errors.Op = "udb.Check"
+	stats := &CheckStats{Full: full}
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		metadataBucket := tx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		if metadataBucket == nil {
+			return corrupt("missing metadata bucket")
+		}
+		version, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if version != DBVersion {
+			return corrupt("database version %d, expected %d", version, DBVersion)
+		}
+		for _, r := range requiredBuckets {
+			ns := tx.ReadBucket(r.ns)
+			if ns == nil {
+				return corrupt("missing %s namespace", r.ns)
+			}
+			for _, b := range r.buckets {
+				if ns.NestedReadBucket(b) == nil {
+					return corrupt("missing %s bucket %q", r.ns, b)
+				}
+			}
+		}
+
+		txmgrNs := tx.ReadBucket(wtxmgrBucketKey)
+		err = checkTip(txmgrNs, stats)
+		if err != nil {
+			return err
+		}
+		if !full {
+			return nil
+		}
+		err = checkMainChain(txmgrNs, params, stats)
+		if err != nil {
+			return err
+		}
+		err = checkCredits(txmgrNs, stats)
+		if err != nil {
+			return err
+		}
+		return checkAccounts(tx.ReadBucket(waddrmgrBucketKey), stats)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return stats, nil
+}
+
+// checkBlock verifies the block record at a height references a header of
+// the same height, returning the block hash and header.
+func checkBlock(ns walletdb.ReadBucket, height int32) (*chainhash.Hash, []byte, error) {
+	_, v := existsBlockRecord(ns, height)
+	if len(v) < 47 {
+		return nil, nil, corrupt("missing main chain block record at height %d", height)
+	}
+	var hash chainhash.Hash
+	copy(hash[:], extractRawBlockRecordHash(v))
+	header := existsBlockHeader(ns, keyBlockHeader(&hash))
+	if len(header) < 180 {
+		return nil, nil, corrupt("missing header of main chain block %v", &hash)
+	}
+	if h := extractBlockHeaderHeight(header); h != height {
+		return nil, nil, corrupt("header of main chain block %v has height %d, "+
+			"recorded at height %d", &hash, h, height)
+	}
+	return &hash, header, nil
+}
+
+func checkTip(ns walletdb.ReadBucket, stats *CheckStats) error {
+	tip := ns.Get(rootTipBlock)
+	if len(tip) != chainhash.HashSize {
+		return corrupt("main chain tip hash has length %d", len(tip))
+	}
+	copy(stats.TipHash[:], tip)
+	header := existsBlockHeader(ns, tip)
+	if len(header) < 180 {
+		return corrupt("missing header of main chain tip %v", &stats.TipHash)
+	}
+	stats.TipHeight = extractBlockHeaderHeight(header)
+
+	hash, header, err := checkBlock(ns, stats.TipHeight)
+	if err != nil {
+		return err
+	}
+	if *hash != stats.TipHash {
+		return corrupt("main chain tip %v does not match block %v recorded "+
+			"at height %d", &stats.TipHash, hash, stats.TipHeight)
+	}
+	if _, v := existsBlockRecord(ns, stats.TipHeight+1); v != nil {
+		return corrupt("main chain block recorded above tip height %d", stats.TipHeight)
+	}
+	if stats.TipHeight == 0 {
+		return nil
+	}
+	parent, _, err := checkBlock(ns, stats.TipHeight-1)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(extractBlockHeaderParentHash(header), parent[:]) {
+		return corrupt("main chain tip %v does not link to block %v", hash, parent)
+	}
+	return nil
+}
+
+func checkMainChain(ns walletdb.ReadBucket, params *chaincfg.Params, stats *CheckStats) error {
+	var prev *chainhash.Hash
+	for height := int32(0); height <= stats.TipHeight; height++ {
+		hash, header, err := checkBlock(ns, height)
+		if err != nil {
+			return err
+		}
+		switch {
+		case height == 0 && *hash != *params.GenesisHash:
+			return corrupt("main chain genesis block %v is not the %s genesis block",
+				hash, params.Name)
+		case height != 0 && !bytes.Equal(extractBlockHeaderParentHash(header), prev[:]):
+			return corrupt("main chain block %v does not link to block %v", hash, prev)
+		}
+		prev = hash
+
+		var br blockRecord
+		k, v := existsBlockRecord(ns, height)
+		err = readRawBlockRecord(k, v, &br)
+		if err != nil {
+			return err
+		}
+		block := &Block{Hash: *hash, Height: height}
+		for i := range br.transactions {
+			txHash := &br.transactions[i]
+			_, v := existsTxRecord(ns, txHash, block)
+			if v == nil {
+				return corrupt("missing record of transaction %v mined in block %v",
+					txHash, hash)
+			}
+			var rec TxRecord
+			err := readRawTxRecord(txHash, v, &rec)
+			if err != nil {
+				return corrupt("transaction %v: %v", txHash, err)
+			}
+			stats.Transactions++
+		}
+		stats.Blocks++
+	}
+	return nil
+}
+
+func checkCredits(ns walletdb.ReadBucket, stats *CheckStats) error {
+	err := ns.NestedReadBucket(bucketCredits).ForEach(func(k, v []byte) error {
+		if len(k) < 72 {
+			return corrupt("credit key has length %d", len(k))
+		}
+		if existsRawTxRecord(ns, extractRawCreditTxRecordKey(k)) == nil {
+			var txHash chainhash.Hash
+			copy(txHash[:], k)
+			return corrupt("missing record of transaction %v with credit %d",
+				&txHash, byteOrder.Uint32(k[68:72]))
+		}
+		stats.Credits++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) error {
+		if len(k) != 36 {
+			return corrupt("unspent output key has length %d", len(k))
+		}
+		var block Block
+		err := readUnspentBlock(v, &block)
+		if err != nil {
+			return err
+		}
+		var txHash chainhash.Hash
+		copy(txHash[:], k)
+		index := byteOrder.Uint32(k[32:36])
+		if existsRawCredit(ns, keyCredit(&txHash, index, &block)) == nil {
+			return corrupt("missing credit of unspent output %v:%d", &txHash, index)
+		}
+		return nil
+	})
+}
+
+func checkAccounts(ns walletdb.ReadBucket, stats *CheckStats) error {
+	lastAccount, err := fetchLastAccount(ns)
+	if err != nil {
+		return err
+	}
+	for account := uint32(0); account <= lastAccount; account++ {
+		_, err := fetchAccountInfo(ns, account, DBVersion)
+		if err != nil {
+			return corrupt("account %d: %v", account, err)
+		}
+		if _, err := fetchAccountName(ns, account); err != nil {
+			return corrupt("account %d: %v", account, err)
+		}
+		stats.Accounts++
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestCheck(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := &chaincfg.TestNetParams
+
+	g := makeBlockGenerator()
+	headers := []*wire.BlockHeader{
+		g.generate(vhcutil.BlockValid),
+		g.generate(vhcutil.BlockValid),
+		g.generate(vhcutil.BlockValid),
+	}
+	tx := &wire.MsgTx{TxOut: []*wire.TxOut{{Value: 1e8}}}
+	rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	headerData := makeHeaderDataSlice(headers...)
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData, emptyFilters(len(headers)))
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, rec, &headerData[1].BlockHash)
+		if err != nil {
+			return err
+		}
+		return s.AddCredit(ns, rec, makeBlockMeta(headers[1]), 0, false, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := Check(db, params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TipHash != headerData[2].BlockHash || stats.TipHeight != 3 || stats.Blocks != 0 {
+		t.Fatalf("unexpected quick check stats %+v", stats)
+	}
+	stats, err = Check(db, params, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Blocks != 4 || stats.Transactions != 1 || stats.Credits != 1 || stats.Accounts != 1 {
+		t.Fatalf("unexpected full check stats %+v", stats)
+	}
+
+	update := func(f func(ns walletdb.ReadWriteBucket) error) {
+		err := walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+			return f(dbtx.ReadWriteBucket(wtxmgrBucketKey))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Missing transaction records are only found by the full check.
+	update(func(ns walletdb.ReadWriteBucket) error {
+		k := keyTxRecord(&rec.Hash, &Block{Hash: headerData[1].BlockHash, Height: 2})
+		return ns.NestedReadWriteBucket(bucketTxRecords).Delete(k)
+	})
+	if _, err := Check(db, params, false); err != nil {
+		t.Fatalf("quick check: %v", err)
+	}
+	if _, err := Check(db, params, true); !errors.Is(errors.IO, err) {
+		t.Fatalf("full check of missing transaction record: %v", err)
+	}
+
+	// A tip which does not link to its parent fails the quick check.
+	update(func(ns walletdb.ReadWriteBucket) error {
+		return deleteBlockRecord(ns, 2)
+	})
+	if _, err := Check(db, params, false); !errors.Is(errors.IO, err) {
+		t.Fatalf("quick check of missing parent block: %v", err)
+	}
+
+	// Missing buckets fail the quick check.
+	update(func(ns walletdb.ReadWriteBucket) error {
+		return ns.DeleteNestedBucket(bucketVoteRecords)
+	})
+	if _, err := Check(db, params, false); !errors.Is(errors.IO, err) {
+		t.Fatalf("quick check of missing bucket: %v", err)
+	}
+}
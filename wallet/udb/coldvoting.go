@@ -0,0 +1,89 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// ColdVotingTicket records the child index of the external voting extended
+// public key which derived the voting address of a ticket purchased by this
+// wallet.  XpubID identifies the extended public key.
+type ColdVotingTicket struct {
+	TicketHash chainhash.Hash
+	XpubID     chainhash.Hash
+	Index      uint32
+}
+
+// The cold voting tickets bucket is keyed by ticket hash and records values
+// with the following format:
+//
+//   [0:32]  Extended public key ID (32 bytes)
+//   [32:36] Child index (4 bytes)
+//
+// The cold voting indexes bucket is keyed by extended public key ID and
+// records the next child index (4 bytes) to derive voting addresses from.
+const coldVotingTicketSize = chainhash.HashSize + 4
+
+func readColdVotingTicket(k, v []byte) (*ColdVotingTicket, error) {
+	if len(k) != chainhash.HashSize || len(v) != coldVotingTicketSize {
+		return nil, errors.E(errors.IO, errors.Errorf("cold voting ticket key len %d value len %d", len(k), len(v)))
+	}
+	t := new(ColdVotingTicket)
+	copy(t.TicketHash[:], k)
+	copy(t.XpubID[:], v[:chainhash.HashSize])
+	t.Index = byteOrder.Uint32(v[chainhash.HashSize:])
+	return t, nil
+}
+
+// NextColdVotingIndex returns the next child index of an external voting
+// extended public key to derive a voting address from, and records that the
+// index was used.
+func (s *Store) NextColdVotingIndex(ns walletdb.ReadWriteBucket, xpubID *chainhash.Hash) (uint32, error) {
+	b := ns.NestedReadWriteBucket(bucketColdVotingIndexes)
+	var index uint32
+	if v := b.Get(xpubID[:]); v != nil {
+		if len(v) != 4 {
+			return 0, errors.E(errors.IO, errors.Errorf("cold voting index len %d", len(v)))
+		}
+		index = byteOrder.Uint32(v)
+	}
+	if index >= 1<<31 {
+		return 0, errors.E(errors.Invalid, "voting extended public key has no unused non-hardened children")
+	}
+	v := make([]byte, 4)
+	byteOrder.PutUint32(v, index+1)
+	err := b.Put(xpubID[:], v)
+	if err != nil {
+		return 0, errors.E(errors.IO, err)
+	}
+	return index, nil
+}
+
+// PutColdVotingTicket records the child index which derived the voting
+// address of a ticket.
+func (s *Store) PutColdVotingTicket(ns walletdb.ReadWriteBucket, t *ColdVotingTicket) error {
+	v := make([]byte, coldVotingTicketSize)
+	copy(v, t.XpubID[:])
+	byteOrder.PutUint32(v[chainhash.HashSize:], t.Index)
+	err := ns.NestedReadWriteBucket(bucketColdVotingTickets).Put(t.TicketHash[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ColdVotingTicket returns the record of the child index which derived the
+// voting address of a ticket.  Tickets whose voting addresses were not derived
+// from an external voting extended public key return an errors.NotExist error.
+func (s *Store) ColdVotingTicket(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) (*ColdVotingTicket, error) {
+	v := ns.NestedReadBucket(bucketColdVotingTickets).Get(ticketHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("ticket %v has no cold voting address", ticketHash))
+	}
+	return readColdVotingTicket(ticketHash[:], v)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestColdVotingTickets(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xpubs := []chainhash.Hash{{1}, {2}}
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+		// Indexes are allocated sequentially for each extended public key.
+		for i, want := range []uint32{0, 1, 2} {
+			index, err := s.NextColdVotingIndex(ns, &xpubs[0])
+			if err != nil {
+				return err
+			}
+			if index != want {
+				t.Errorf("allocation %d: index %d, want %d", i, index, want)
+			}
+		}
+		index, err := s.NextColdVotingIndex(ns, &xpubs[1])
+		if err != nil {
+			return err
+		}
+		if index != 0 {
+			t.Errorf("first index of second key is %d", index)
+		}
+
+		ticket := &ColdVotingTicket{
+			TicketHash: chainhash.Hash{3},
+			XpubID:     xpubs[0],
+			Index:      2,
+		}
+		_, err = s.ColdVotingTicket(ns, &ticket.TicketHash)
+		if !errors.Is(errors.NotExist, err) {
+			t.Errorf("unrecorded ticket: expected NotExist, got %v", err)
+		}
+		err = s.PutColdVotingTicket(ns, ticket)
+		if err != nil {
+			return err
+		}
+		got, err := s.ColdVotingTicket(ns, &ticket.TicketHash)
+		if err != nil {
+			return err
+		}
+		if *got != *ticket {
+			t.Errorf("read %+v, want %+v", got, ticket)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// DepositAddressStatus describes whether a reserved deposit address has been
+// handed out.
+type DepositAddressStatus uint8
+
+// Deposit address statuses.
+const (
+	// DepositAddressAvailable is the status of reserved addresses which
+	// have not yet been assigned.
+	DepositAddressAvailable DepositAddressStatus = iota
+
+	// DepositAddressAssigned is the status of reserved addresses which have
+	// been assigned to a reference.
+	DepositAddressAssigned
+)
+
+// String returns the name of the status.
+func (s DepositAddressStatus) String() string {
+	switch s {
+	case DepositAddressAvailable:
+		return "available"
+	case DepositAddressAssigned:
+		return "assigned"
+	default:
+		return "unknown"
+	}
+}
+
+// DepositAddress describes an external address reserved for deposits to an
+// account.
+type DepositAddress struct {
+	Account   uint32
+	Child     uint32
+	Address   string
+	Status    DepositAddressStatus
+	Created   time.Time
+	Assigned  time.Time // Zero until assigned
+	Reference string
+}
+
+// The deposit pool bucket is keyed by account number and external branch
+// child index (4 bytes each), which orders the addresses of each account by
+// their creation.  Values have the following format:
+//
+//   [0]     Status (1 byte)
+//   [1:9]   Created time (8 bytes, unix seconds)
+//   [9:17]  Assigned time (8 bytes, unix seconds, zero when unassigned)
+//   [17]    Address length (1 byte)
+//   [18:]   Encoded address followed by the assignment reference
+const depositAddressMinSize = 18
+
+func depositAddressKey(account, child uint32) []byte {
+	k := make([]byte, 8)
+	byteOrder.PutUint32(k[0:4], account)
+	byteOrder.PutUint32(k[4:8], child)
+	return k
+}
+
+func valueDepositAddress(a *DepositAddress) ([]byte, error) {
+	if len(a.Address) > 255 {
+		return nil, errors.E(errors.Encoding, errors.Errorf("address len %d", len(a.Address)))
+	}
+	v := make([]byte, depositAddressMinSize+len(a.Address)+len(a.Reference))
+	v[0] = byte(a.Status)
+	byteOrder.PutUint64(v[1:9], uint64(a.Created.Unix()))
+	if !a.Assigned.IsZero() {
+		byteOrder.PutUint64(v[9:17], uint64(a.Assigned.Unix()))
+	}
+	v[17] = byte(len(a.Address))
+	copy(v[18:], a.Address)
+	copy(v[18+len(a.Address):], a.Reference)
+	return v, nil
+}
+
+func readDepositAddress(k, v []byte) (*DepositAddress, error) {
+	if len(k) != 8 || len(v) < depositAddressMinSize || len(v) < depositAddressMinSize+int(v[17]) {
+		return nil, errors.E(errors.IO, errors.Errorf("deposit address key len %d value len %d",
+			len(k), len(v)))
+	}
+	addrEnd := depositAddressMinSize + int(v[17])
+	a := &DepositAddress{
+		Account:   byteOrder.Uint32(k[0:4]),
+		Child:     byteOrder.Uint32(k[4:8]),
+		Address:   string(v[depositAddressMinSize:addrEnd]),
+		Status:    DepositAddressStatus(v[0]),
+		Created:   time.Unix(int64(byteOrder.Uint64(v[1:9])), 0),
+		Reference: string(v[addrEnd:]),
+	}
+	if assigned := byteOrder.Uint64(v[9:17]); assigned != 0 {
+		a.Assigned = time.Unix(int64(assigned), 0)
+	}
+	return a, nil
+}
+
+// PutDepositAddress records a reserved deposit address, replacing any previous
+// record of the same account and child index.
+func (m *Manager) PutDepositAddress(ns walletdb.ReadWriteBucket, a *DepositAddress) error {
+	v, err := valueDepositAddress(a)
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadWriteBucket(depositPoolBucketName).Put(depositAddressKey(a.Account, a.Child), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DepositAddresses returns the reserved deposit addresses of an account in the
+// order they were created.
+func (m *Manager) DepositAddresses(ns walletdb.ReadBucket, account uint32) ([]*DepositAddress, error) {
+	var addrs []*DepositAddress
+	prefix := depositAddressKey(account, 0)[:4]
+	c := ns.NestedReadBucket(depositPoolBucketName).ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(prefix); k != nil && len(k) >= 4 && byteOrder.Uint32(k) == account; k, v = c.Next() {
+		a, err := readDepositAddress(k, v)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+// AllDepositAddresses returns the reserved deposit addresses of every account,
+// ordered by account and then by creation.
+func (m *Manager) AllDepositAddresses(ns walletdb.ReadBucket) ([]*DepositAddress, error) {
+	var addrs []*DepositAddress
+	err := ns.NestedReadBucket(depositPoolBucketName).ForEach(func(k, v []byte) error {
+		a, err := readDepositAddress(k, v)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, a)
+		return nil
+	})
+	return addrs, err
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDepositAddressSerialization(t *testing.T) {
+	tests := []*DepositAddress{{
+		Account: 1,
+		Child:   42,
+		Address: "VsfVHsNHBjQ6ivUr1ZpfmTW4yy9FpmUWMAa",
+		Status:  DepositAddressAvailable,
+		Created: time.Unix(1559390400, 0),
+	}, {
+		Account:   3,
+		Child:     7,
+		Address:   "VsfVHsNHBjQ6ivUr1ZpfmTW4yy9FpmUWMAa",
+		Status:    DepositAddressAssigned,
+		Created:   time.Unix(1559390400, 0),
+		Assigned:  time.Unix(1559394000, 0),
+		Reference: "customer-1234",
+	}}
+	for _, a := range tests {
+		v, err := valueDepositAddress(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := readDepositAddress(depositAddressKey(a.Account, a.Child), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, a) {
+			t.Errorf("deposit address round trip: got %+v, want %+v", got, a)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// The gap policy bucket is keyed by account number and records a single byte
+// value describing the default gap limit policy of the account.  The meaning of
+// the value is defined by the wallet package.
+
+func gapPolicyKey(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+// AccountGapPolicies returns the default gap limit policies of every account
+// with a recorded policy.
+func (m *Manager) AccountGapPolicies(ns walletdb.ReadBucket) (map[uint32]uint8, error) {
+	policies := make(map[uint32]uint8)
+	err := ns.NestedReadBucket(gapPolicyBucketName).ForEach(func(k, v []byte) error {
+		if len(k) != 4 || len(v) != 1 {
+			return errors.E(errors.IO, errors.Errorf("gap policy key len %d value len %d",
+				len(k), len(v)))
+		}
+		policies[byteOrder.Uint32(k)] = v[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// PutAccountGapPolicy records the default gap limit policy of an account.
+func (m *Manager) PutAccountGapPolicy(ns walletdb.ReadWriteBucket, account uint32, policy uint8) error {
+	err := ns.NestedReadWriteBucket(gapPolicyBucketName).Put(gapPolicyKey(account), []byte{policy})
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteAccountGapPolicy removes the recorded default gap limit policy of an
+// account.
+func (m *Manager) DeleteAccountGapPolicy(ns walletdb.ReadWriteBucket, account uint32) error {
+	err := ns.NestedReadWriteBucket(gapPolicyBucketName).Delete(gapPolicyKey(account))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
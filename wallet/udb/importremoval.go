@@ -0,0 +1,180 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// DeleteTxScript removes the redeem script with a hash160 saved with
+// InsertTxScript, along with any record of its import.  Unknown scripts return
+// an errors.NotExist error.
+func (s *Store) DeleteTxScript(ns walletdb.ReadWriteBucket, hash []byte) error {
+	b := ns.NestedReadWriteBucket(bucketScripts)
+	if b.Get(hash) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no script %x", hash))
+	}
+	err := b.Delete(hash)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = ns.NestedReadWriteBucket(bucketScriptImports).Delete(hash)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// pkScriptPaysHash160 returns whether an output script pays the P2PKH or P2SH
+// address with a hash160, including through a public key.
+func (s *Store) pkScriptPaysHash160(pkScript, hash160 []byte) bool {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(txscript.DefaultScriptVersion,
+		pkScript, s.chainParams)
+	if err != nil {
+		return false
+	}
+	for _, addr := range addrs {
+		if bytes.Equal(normalizeAddress(addr).ScriptAddress(), hash160) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAddressCredits removes the unspent outputs paying the P2PKH or P2SH
+// address with a hash160 from the store, reducing the mined balance by the
+// value of any mined outputs, and returns the number of distinct outpoints
+// removed.  Spent outputs and outputs spent by unmined transactions remain
+// recorded as the debits of the spending transactions refer to them.
+func (s *Store) RemoveAddressCredits(ns walletdb.ReadWriteBucket, hash160 []byte) (int, error) {
+	type minedCredit struct {
+		k, credKey []byte
+		amount     vhcutil.Amount
+	}
+	var mined []minedCredit
+	var unmined [][]byte
+
+	var op wire.OutPoint
+	var block Block
+	c := ns.NestedReadBucket(bucketUnspent).ReadCursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if existsRawUnminedInput(ns, k) != nil {
+			continue
+		}
+		err := readCanonicalOutPoint(k, &op)
+		if err == nil {
+			err = readUnspentBlock(v, &block)
+		}
+		if err != nil {
+			c.Close()
+			return 0, err
+		}
+		cred, err := s.outputCreditInfo(ns, op, &block)
+		if err != nil {
+			c.Close()
+			return 0, err
+		}
+		if s.pkScriptPaysHash160(cred.PkScript, hash160) {
+			mined = append(mined, minedCredit{
+				k:       append([]byte(nil), k...),
+				credKey: keyCredit(&op.Hash, op.Index, &block),
+				amount:  cred.Amount,
+			})
+		}
+	}
+	c.Close()
+
+	c = ns.NestedReadBucket(bucketUnminedCredits).ReadCursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if existsRawUnminedInput(ns, k) != nil {
+			continue
+		}
+		err := readCanonicalOutPoint(k, &op)
+		if err != nil {
+			c.Close()
+			return 0, err
+		}
+		cred, err := s.outputCreditInfo(ns, op, nil)
+		if err != nil {
+			c.Close()
+			return 0, err
+		}
+		if s.pkScriptPaysHash160(cred.PkScript, hash160) {
+			unmined = append(unmined, append([]byte(nil), k...))
+		}
+	}
+	c.Close()
+
+	if len(mined) != 0 {
+		minedBalance, err := fetchMinedBalance(ns)
+		if err != nil {
+			return 0, err
+		}
+		for _, cred := range mined {
+			err := deleteRawCredit(ns, cred.credKey)
+			if err != nil {
+				return 0, err
+			}
+			err = deleteRawUnspent(ns, cred.k)
+			if err != nil {
+				return 0, err
+			}
+			minedBalance -= cred.amount
+		}
+		err = putMinedBalance(ns, minedBalance)
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, k := range unmined {
+		err := deleteRawUnminedCredit(ns, k)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	removed := make(map[string]struct{}, len(mined)+len(unmined))
+	for _, cred := range mined {
+		removed[string(cred.k)] = struct{}{}
+	}
+	for _, k := range unmined {
+		removed[string(k)] = struct{}{}
+	}
+
+	// Unspent multisig outputs paying a removed P2SH script are forgotten as
+	// well.
+	var multisig [][]byte
+	c = ns.NestedReadBucket(bucketMultisigUsp).ReadCursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		v := existsMultisigOut(ns, k)
+		if v == nil {
+			continue
+		}
+		scriptHash := fetchMultisigOutScrHash(v)
+		if bytes.Equal(scriptHash[:], hash160) {
+			multisig = append(multisig, append([]byte(nil), k...))
+		}
+	}
+	c.Close()
+	for _, k := range multisig {
+		err := deleteMultisigOutUS(ns, k)
+		if err != nil {
+			return 0, err
+		}
+		err = ns.NestedReadWriteBucket(bucketMultisig).Delete(k)
+		if err != nil {
+			return 0, errors.E(errors.IO, err)
+		}
+		removed[string(k)] = struct{}{}
+	}
+
+	return len(removed), nil
+}
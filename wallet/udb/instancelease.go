@@ -0,0 +1,78 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// InstanceLease records the wallet process which most recently had the
+// database open.  The lease is periodically renewed by the running process and
+// is used to detect when multiple processes are operating on copies of the
+// same wallet.
+type InstanceLease struct {
+	ID        [16]byte
+	PID       uint32
+	Host      string
+	Heartbeat time.Time
+}
+
+var instanceLeaseKey = []byte("instancelease")
+
+// The instance lease is saved in the metadata bucket with the following
+// format:
+//
+//   [0:16]  Instance ID (16 bytes)
+//   [16:24] Heartbeat time (8 bytes, unix seconds)
+//   [24:28] Process ID (4 bytes)
+//   [28:]   Host name (variable length)
+const instanceLeaseMinSize = 28
+
+// FetchInstanceLease returns the instance lease recorded in the database, or
+// nil if no running instance holds the lease.
+func FetchInstanceLease(dbtx walletdb.ReadTx) (*InstanceLease, error) {
+	v := dbtx.ReadBucket(metadataRootBucketKey).Get(instanceLeaseKey)
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) < instanceLeaseMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("instance lease len %d", len(v)))
+	}
+	lease := &InstanceLease{
+		Heartbeat: time.Unix(int64(byteOrder.Uint64(v[16:24])), 0),
+		PID:       byteOrder.Uint32(v[24:28]),
+		Host:      string(v[28:]),
+	}
+	copy(lease.ID[:], v[:16])
+	return lease, nil
+}
+
+// PutInstanceLease records the instance lease in the database.
+func PutInstanceLease(dbtx walletdb.ReadWriteTx, lease *InstanceLease) error {
+	v := make([]byte, instanceLeaseMinSize+len(lease.Host))
+	copy(v[:16], lease.ID[:])
+	byteOrder.PutUint64(v[16:24], uint64(lease.Heartbeat.Unix()))
+	byteOrder.PutUint32(v[24:28], lease.PID)
+	copy(v[28:], lease.Host)
+	err := dbtx.ReadWriteBucket(metadataRootBucketKey).Put(instanceLeaseKey, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteInstanceLease removes any instance lease from the database.  This
+// should be called by a process releasing the database during a clean
+// shutdown.
+func DeleteInstanceLease(dbtx walletdb.ReadWriteTx) error {
+	err := dbtx.ReadWriteBucket(metadataRootBucketKey).Delete(instanceLeaseKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// InternalTransfer describes a transaction which moves funds from one account
+// of the wallet to another.
+type InternalTransfer struct {
+	FromAccount uint32
+	ToAccount   uint32
+}
+
+// The internal transfers bucket is keyed by transaction hash and records
+// values with the following format:
+//
+//   [0:4] Source account (4 bytes)
+//   [4:8] Destination account (4 bytes)
+const internalTransferSize = 8
+
+// PutInternalTransfer records a transaction as a transfer between two accounts
+// of the wallet.
+func (s *Store) PutInternalTransfer(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash, t *InternalTransfer) error {
+	v := make([]byte, internalTransferSize)
+	byteOrder.PutUint32(v[0:4], t.FromAccount)
+	byteOrder.PutUint32(v[4:8], t.ToAccount)
+	err := ns.NestedReadWriteBucket(bucketInternalTransfers).Put(txHash[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// InternalTransfer returns the accounts of a transaction recorded as a transfer
+// between two accounts of the wallet.  An error with kind errors.NotExist is
+// returned if the transaction is not an internal transfer.
+func (s *Store) InternalTransfer(ns walletdb.ReadBucket, txHash *chainhash.Hash) (*InternalTransfer, error) {
+	v := ns.NestedReadBucket(bucketInternalTransfers).Get(txHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no internal transfer %v", txHash))
+	}
+	if len(v) != internalTransferSize {
+		return nil, errors.E(errors.IO, errors.Errorf("internal transfer len %d", len(v)))
+	}
+	return &InternalTransfer{
+		FromAccount: byteOrder.Uint32(v[0:4]),
+		ToAccount:   byteOrder.Uint32(v[4:8]),
+	}, nil
+}
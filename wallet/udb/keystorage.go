@@ -0,0 +1,48 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// The account key storage bucket is keyed by account number and records a
+// single byte value describing where the private keys of the account are
+// stored.  Accounts without a record use the address manager.  The meaning of
+// the value is defined by the wallet package.
+
+func keyStorageKey(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+// AccountKeyStorages returns the key storage of every account with a recorded
+// key storage.
+func (m *Manager) AccountKeyStorages(ns walletdb.ReadBucket) (map[uint32]uint8, error) {
+	storages := make(map[uint32]uint8)
+	err := ns.NestedReadBucket(keyStorageBucketName).ForEach(func(k, v []byte) error {
+		if len(k) != 4 || len(v) != 1 {
+			return errors.E(errors.IO, errors.Errorf("key storage key len %d value len %d",
+				len(k), len(v)))
+		}
+		storages[byteOrder.Uint32(k)] = v[0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return storages, nil
+}
+
+// PutAccountKeyStorage records the key storage of an account.
+func (m *Manager) PutAccountKeyStorage(ns walletdb.ReadWriteBucket, account uint32, storage uint8) error {
+	err := ns.NestedReadWriteBucket(keyStorageBucketName).Put(keyStorageKey(account), []byte{storage})
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestForEachUnspentMultisigOut(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pubKeys []*vhcutil.AddressSecpPubKey
+	for i := byte(1); i <= 3; i++ {
+		_, pub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{i}, 32))
+		pk, err := vhcutil.NewAddressSecpPubKey(pub.SerializeCompressed(), s.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, pk)
+	}
+	script1, err := txscript.MultiSigScript(pubKeys, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script2, err := txscript.MultiSigScript(pubKeys[:2], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The transaction pays both scripts twice.  One output of the first
+	// script is spent.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, 0), 0, nil))
+	for i, script := range [][]byte{script1, script1, script2, script2} {
+		pkScript, err := txscript.PayToScriptHashScript(vhcutil.Hash160(script))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(i+1)*1e8, pkScript))
+	}
+	rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &BlockMeta{Block: Block{Hash: chainhash.Hash{2}, Height: 10}}
+
+	type output struct {
+		index  uint32
+		script []byte
+		m      uint8
+		height uint32
+	}
+	var got []output
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		for _, script := range [][]byte{script1, script2} {
+			err := s.InsertTxScript(ns, script)
+			if err != nil {
+				return err
+			}
+		}
+		for i := range tx.TxOut {
+			b := block
+			if i == 3 {
+				b = nil
+			}
+			err := s.AddMultisigOut(ns, rec, b, uint32(i))
+			if err != nil {
+				return err
+			}
+		}
+		err := s.SpendMultisigOut(ns, wire.NewOutPoint(&rec.Hash, 0, 0), chainhash.Hash{3}, 0)
+		if err != nil {
+			return err
+		}
+		return s.ForEachUnspentMultisigOut(ns, func(mso *MultisigOut, redeemScript []byte) error {
+			if mso.OutPoint.Hash != rec.Hash || mso.Spent {
+				t.Errorf("unexpected output %v (spent %v)", mso.OutPoint, mso.Spent)
+			}
+			got = append(got, output{mso.OutPoint.Index, redeemScript, mso.M, mso.BlockHeight})
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []output{
+		{1, script1, 2, 10},
+		{2, script2, 1, 10},
+		{3, script2, 1, 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("found %d unspent outputs, want %d", len(got), len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.index != w.index || !bytes.Equal(g.script, w.script) || g.m != w.m || g.height != w.height {
+			t.Errorf("output %d: got index %d m %d height %d, want index %d m %d height %d",
+				i, g.index, g.m, g.height, w.index, w.m, w.height)
+		}
+	}
+}
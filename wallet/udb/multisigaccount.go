@@ -0,0 +1,164 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// MultisigAccount describes an M-of-N multisig account.  The redeem script of
+// each branch child is a multisig script requiring M signatures of the child
+// public keys derived from the N cosigner account extended public keys.
+// Account is the wallet account whose extended public key is one of the
+// cosigner keys.
+type MultisigAccount struct {
+	Name         string
+	Account      uint32
+	M            uint8
+	Xpubs        []string
+	NextExternal uint32
+	NextInternal uint32
+}
+
+// The multisig account bucket is keyed by multisig account name and records
+// values with the following format:
+//
+//   [0:4]   Wallet account (4 bytes)
+//   [4]     Required signatures (1 byte)
+//   [5]     Cosigner count (1 byte)
+//   [6:10]  Next external branch child index (4 bytes)
+//   [10:14] Next internal branch child index (4 bytes)
+//   [14:]   Cosigner extended public keys, each prefixed by a 1 byte length
+const multisigAccountMinSize = 14
+
+func valueMultisigAccount(a *MultisigAccount) ([]byte, error) {
+	if len(a.Xpubs) > 255 {
+		return nil, errors.E(errors.Encoding, errors.Errorf("%d cosigners", len(a.Xpubs)))
+	}
+	size := multisigAccountMinSize
+	for _, xpub := range a.Xpubs {
+		if len(xpub) > 255 {
+			return nil, errors.E(errors.Encoding, errors.Errorf("xpub len %d", len(xpub)))
+		}
+		size += 1 + len(xpub)
+	}
+	v := make([]byte, size)
+	byteOrder.PutUint32(v[0:4], a.Account)
+	v[4] = a.M
+	v[5] = byte(len(a.Xpubs))
+	byteOrder.PutUint32(v[6:10], a.NextExternal)
+	byteOrder.PutUint32(v[10:14], a.NextInternal)
+	off := multisigAccountMinSize
+	for _, xpub := range a.Xpubs {
+		v[off] = byte(len(xpub))
+		copy(v[off+1:], xpub)
+		off += 1 + len(xpub)
+	}
+	return v, nil
+}
+
+func readMultisigAccount(k, v []byte) (*MultisigAccount, error) {
+	if len(v) < multisigAccountMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("multisig account value len %d", len(v)))
+	}
+	a := &MultisigAccount{
+		Name:         string(k),
+		Account:      byteOrder.Uint32(v[0:4]),
+		M:            v[4],
+		Xpubs:        make([]string, 0, v[5]),
+		NextExternal: byteOrder.Uint32(v[6:10]),
+		NextInternal: byteOrder.Uint32(v[10:14]),
+	}
+	off := multisigAccountMinSize
+	for i := 0; i < int(v[5]); i++ {
+		if off >= len(v) || off+1+int(v[off]) > len(v) {
+			return nil, errors.E(errors.IO, errors.Errorf("multisig account %q value len %d", k, len(v)))
+		}
+		a.Xpubs = append(a.Xpubs, string(v[off+1:off+1+int(v[off])]))
+		off += 1 + int(v[off])
+	}
+	return a, nil
+}
+
+// PutMultisigAccount records a new multisig account.  Names which are already
+// used by another multisig account return an errors.Exist error.
+func (m *Manager) PutMultisigAccount(ns walletdb.ReadWriteBucket, a *MultisigAccount) error {
+	if a.Name == "" {
+		return errors.E(errors.Invalid, "multisig accounts may not be named the empty string")
+	}
+	b := ns.NestedReadWriteBucket(multisigAccountBucketName)
+	if b.Get([]byte(a.Name)) != nil {
+		return errors.E(errors.Exist, errors.Errorf("multisig account %q already exists", a.Name))
+	}
+	v, err := valueMultisigAccount(a)
+	if err != nil {
+		return err
+	}
+	err = b.Put([]byte(a.Name), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// MultisigAccount returns the multisig account with a name.  Unknown names
+// return an errors.NotExist error.
+func (m *Manager) MultisigAccount(ns walletdb.ReadBucket, name string) (*MultisigAccount, error) {
+	v := ns.NestedReadBucket(multisigAccountBucketName).Get([]byte(name))
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no multisig account %q", name))
+	}
+	return readMultisigAccount([]byte(name), v)
+}
+
+// MultisigAccounts returns every multisig account ordered by name.
+func (m *Manager) MultisigAccounts(ns walletdb.ReadBucket) ([]*MultisigAccount, error) {
+	var accts []*MultisigAccount
+	err := ns.NestedReadBucket(multisigAccountBucketName).ForEach(func(k, v []byte) error {
+		a, err := readMultisigAccount(k, v)
+		if err != nil {
+			return err
+		}
+		accts = append(accts, a)
+		return nil
+	})
+	return accts, err
+}
+
+// NextMultisigAccountIndex returns the next child index of a branch of a
+// multisig account to derive an address from, and records that the index was
+// used.
+func (m *Manager) NextMultisigAccountIndex(ns walletdb.ReadWriteBucket, name string, branch uint32) (uint32, error) {
+	b := ns.NestedReadWriteBucket(multisigAccountBucketName)
+	v := b.Get([]byte(name))
+	if v == nil {
+		return 0, errors.E(errors.NotExist, errors.Errorf("no multisig account %q", name))
+	}
+	if len(v) < multisigAccountMinSize {
+		return 0, errors.E(errors.IO, errors.Errorf("multisig account value len %d", len(v)))
+	}
+	var off int
+	switch branch {
+	case ExternalBranch:
+		off = 6
+	case InternalBranch:
+		off = 10
+	default:
+		return 0, errors.E(errors.Invalid, errors.Errorf("branch %d", branch))
+	}
+	index := byteOrder.Uint32(v[off : off+4])
+	if index >= MaxAddressesPerAccount {
+		return 0, errors.E(errors.Invalid, errors.Errorf("multisig account %q has no unused "+
+			"non-hardened children", name))
+	}
+	v = append(v[:0:0], v...)
+	byteOrder.PutUint32(v[off:off+4], index+1)
+	err := b.Put([]byte(name), v)
+	if err != nil {
+		return 0, errors.E(errors.IO, err)
+	}
+	return index, nil
+}
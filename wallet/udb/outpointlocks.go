@@ -0,0 +1,102 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// OutpointLock is a persistent lock of an outpoint held by a lock namespace.
+// Expiry is the zero time for locks which do not expire at a time, and
+// ExpiryHeight is zero for locks which do not expire at a main chain height.
+type OutpointLock struct {
+	OutPoint     wire.OutPoint
+	Namespace    string
+	Expiry       time.Time
+	ExpiryHeight int32
+}
+
+// The outpoint locks bucket is keyed by canonical outpoint and records values
+// with the following format:
+//
+//   [0:8]   Expiry (8 bytes, unix seconds, zero for locks which do not expire)
+//   [8:12]  Expiry height (4 bytes, zero for locks which do not expire)
+//   [12]    Transaction tree (1 byte)
+//   [13:]   Lock namespace
+//
+// Prior to database version 29, the expiry height was not recorded and the
+// transaction tree began at offset 8.
+const outpointLockMinSize = 13
+
+func valueOutpointLock(l *OutpointLock) []byte {
+	v := make([]byte, outpointLockMinSize+len(l.Namespace))
+	if !l.Expiry.IsZero() {
+		byteOrder.PutUint64(v[0:8], uint64(l.Expiry.Unix()))
+	}
+	byteOrder.PutUint32(v[8:12], uint32(l.ExpiryHeight))
+	v[12] = byte(l.OutPoint.Tree)
+	copy(v[13:], l.Namespace)
+	return v
+}
+
+func readOutpointLock(k, v []byte) (*OutpointLock, error) {
+	if len(v) < outpointLockMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("outpoint lock len %d", len(v)))
+	}
+	l := &OutpointLock{
+		Namespace:    string(v[13:]),
+		ExpiryHeight: int32(byteOrder.Uint32(v[8:12])),
+	}
+	if expiry := int64(byteOrder.Uint64(v[0:8])); expiry != 0 {
+		l.Expiry = time.Unix(expiry, 0)
+	}
+	err := readCanonicalOutPoint(k, &l.OutPoint)
+	if err != nil {
+		return nil, err
+	}
+	l.OutPoint.Tree = int8(v[12])
+	return l, nil
+}
+
+// PutOutpointLock records a persistent outpoint lock, replacing any lock of
+// the outpoint already recorded.
+func (s *Store) PutOutpointLock(ns walletdb.ReadWriteBucket, l *OutpointLock) error {
+	k := canonicalOutPoint(&l.OutPoint.Hash, l.OutPoint.Index)
+	err := ns.NestedReadWriteBucket(bucketOutpointLocks).Put(k, valueOutpointLock(l))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteOutpointLock removes the persistent lock of an outpoint.  Removing the
+// lock of an outpoint which is not recorded is not an error.
+func (s *Store) DeleteOutpointLock(ns walletdb.ReadWriteBucket, op *wire.OutPoint) error {
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	err := ns.NestedReadWriteBucket(bucketOutpointLocks).Delete(k)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// OutpointLocks returns every recorded persistent outpoint lock, including
+// expired locks.
+func (s *Store) OutpointLocks(ns walletdb.ReadBucket) ([]OutpointLock, error) {
+	var locks []OutpointLock
+	err := ns.NestedReadBucket(bucketOutpointLocks).ForEach(func(k, v []byte) error {
+		l, err := readOutpointLock(k, v)
+		if err != nil {
+			return err
+		}
+		locks = append(locks, *l)
+		return nil
+	})
+	return locks, err
+}
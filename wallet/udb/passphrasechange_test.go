@@ -0,0 +1,87 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestPassphraseChange(t *testing.T) {
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := &chaincfg.TestNetParams
+	err := Initialize(db, params, seed, pubPass, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _, _, err := Open(db, params, pubPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit := func(c *PassphraseChange) error {
+		return walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+			return m.CommitPassphraseChange(ns, c)
+		})
+	}
+	unlock := func(passphrase []byte) error {
+		return walletdb.View(db, func(tx walletdb.ReadTx) error {
+			ns := tx.ReadBucket(waddrmgrBucketKey)
+			return m.Unlock(ns, passphrase)
+		})
+	}
+
+	_, err = m.PreparePassphraseChange([]byte("bogus"), privPassphrase2, true)
+	if !errors.Is(errors.Passphrase, err) {
+		t.Fatalf("prepared change with incorrect passphrase: %v", err)
+	}
+
+	// Prepare two changes from the same passphrase.  Once the first is
+	// committed, the second was derived from a stale master key and must
+	// not be committed.
+	c, err := m.PreparePassphraseChange(privPassphrase, privPassphrase2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale, err := m.PreparePassphraseChange(privPassphrase, privPassphrase, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := commit(c); err != nil {
+		t.Fatal(err)
+	}
+	if err := commit(stale); !errors.Is(errors.Invalid, err) {
+		t.Fatalf("committed stale passphrase change: %v", err)
+	}
+
+	if err := unlock(privPassphrase); !errors.Is(errors.Passphrase, err) {
+		t.Fatalf("unlocked with old passphrase: %v", err)
+	}
+	if err := unlock(privPassphrase2); err != nil {
+		t.Fatalf("unlock with new passphrase: %v", err)
+	}
+
+	// Changing the passphrase of the unlocked manager must keep it
+	// unlocked and usable with the new passphrase.
+	c, err = m.PreparePassphraseChange(privPassphrase2, privPassphrase, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := commit(c); err != nil {
+		t.Fatal(err)
+	}
+	if m.IsLocked() {
+		t.Fatal("manager locked by passphrase change")
+	}
+	if err := unlock(privPassphrase); err != nil {
+		t.Fatalf("unlock with new passphrase: %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/sha256"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/snacl"
+)
+
+// passphraseHashSize is the size of the marshalled scrypt parameters and key
+// digest recorded for passphrases which are only ever verified, such as
+// approval and override passphrases.
+const passphraseHashSize = snacl.KeySize + sha256.Size + 24
+
+// hashPassphrase returns the marshalled scrypt parameters and key digest of a
+// passphrase, derived with the same scrypt options as the master keys.
+func hashPassphrase(passphrase []byte) ([]byte, error) {
+	sk, err := newSecretKey(&passphrase, &defaultScryptOptions)
+	if err != nil {
+		return nil, err
+	}
+	sk.Zero()
+	return sk.Marshal(), nil
+}
+
+// checkPassphraseHash returns whether passphrase derives the key of a hash
+// returned by hashPassphrase.
+func checkPassphraseHash(hash, passphrase []byte) (bool, error) {
+	var sk snacl.SecretKey
+	err := sk.Unmarshal(hash)
+	if err != nil {
+		return false, errors.E(errors.IO, err)
+	}
+	err = sk.DeriveKey(&passphrase)
+	sk.Zero()
+	if errors.Is(errors.Passphrase, err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
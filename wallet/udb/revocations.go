@@ -0,0 +1,99 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// PendingRevocation describes a delayed automatic revocation of a missed
+// ticket.  Canceled revocations are recorded so the ticket is not scheduled
+// for automatic revocation again.
+type PendingRevocation struct {
+	Ticket    chainhash.Hash
+	Reported  time.Time
+	Scheduled time.Time
+	Canceled  bool
+}
+
+// The pending revocations bucket is keyed by ticket hash and records values
+// with the following format:
+//
+//   [0:8]   Reported time (8 bytes, unix seconds)
+//   [8:16]  Scheduled revocation time (8 bytes, unix seconds)
+//   [16]    Canceled flag (1 byte)
+const pendingRevocationSize = 17
+
+func valuePendingRevocation(r *PendingRevocation) []byte {
+	v := make([]byte, pendingRevocationSize)
+	byteOrder.PutUint64(v[0:8], uint64(r.Reported.Unix()))
+	byteOrder.PutUint64(v[8:16], uint64(r.Scheduled.Unix()))
+	if r.Canceled {
+		v[16] = 1
+	}
+	return v
+}
+
+func readPendingRevocation(k, v []byte) (*PendingRevocation, error) {
+	if len(k) != chainhash.HashSize || len(v) != pendingRevocationSize {
+		return nil, errors.E(errors.IO, errors.Errorf("pending revocation key len %d value len %d",
+			len(k), len(v)))
+	}
+	r := &PendingRevocation{
+		Reported:  time.Unix(int64(byteOrder.Uint64(v[0:8])), 0),
+		Scheduled: time.Unix(int64(byteOrder.Uint64(v[8:16])), 0),
+		Canceled:  v[16] != 0,
+	}
+	copy(r.Ticket[:], k)
+	return r, nil
+}
+
+// PutPendingRevocation records a pending or canceled revocation, replacing
+// any previous record of the ticket.
+func (s *Store) PutPendingRevocation(ns walletdb.ReadWriteBucket, r *PendingRevocation) error {
+	err := ns.NestedReadWriteBucket(bucketPendingRevocations).Put(r.Ticket[:],
+		valuePendingRevocation(r))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// PendingRevocation returns the recorded revocation of a ticket.  An error
+// with kind errors.NotExist is returned when the ticket is not recorded.
+func (s *Store) PendingRevocation(ns walletdb.ReadBucket, ticket *chainhash.Hash) (*PendingRevocation, error) {
+	v := ns.NestedReadBucket(bucketPendingRevocations).Get(ticket[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no pending revocation of ticket %v", ticket))
+	}
+	return readPendingRevocation(ticket[:], v)
+}
+
+// PendingRevocations returns all recorded pending and canceled revocations.
+func (s *Store) PendingRevocations(ns walletdb.ReadBucket) ([]*PendingRevocation, error) {
+	var revocations []*PendingRevocation
+	err := ns.NestedReadBucket(bucketPendingRevocations).ForEach(func(k, v []byte) error {
+		r, err := readPendingRevocation(k, v)
+		if err != nil {
+			return err
+		}
+		revocations = append(revocations, r)
+		return nil
+	})
+	return revocations, err
+}
+
+// DeletePendingRevocation removes the recorded revocation of a ticket.
+func (s *Store) DeletePendingRevocation(ns walletdb.ReadWriteBucket, ticket *chainhash.Hash) error {
+	err := ns.NestedReadWriteBucket(bucketPendingRevocations).Delete(ticket[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
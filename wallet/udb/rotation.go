@@ -0,0 +1,65 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// AccountRotation records the rotation of an account to a successor account.
+// Rotated accounts continue to receive funds to previously derived addresses
+// but no longer derive new addresses.
+type AccountRotation struct {
+	Successor uint32
+	Time      time.Time
+}
+
+// The rotated account bucket is keyed by the account number of the rotated
+// account and records values with the following format:
+//
+//   [0:4]   Successor account (4 bytes)
+//   [4:12]  Unix time of the rotation (8 bytes)
+const rotatedAccountValueSize = 12
+
+func rotatedAccountKey(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+// AccountRotations returns the rotations of every rotated account.
+func (m *Manager) AccountRotations(ns walletdb.ReadBucket) (map[uint32]*AccountRotation, error) {
+	rotations := make(map[uint32]*AccountRotation)
+	err := ns.NestedReadBucket(rotatedAccountBucketName).ForEach(func(k, v []byte) error {
+		if len(k) != 4 || len(v) != rotatedAccountValueSize {
+			return errors.E(errors.IO, errors.Errorf("rotated account key len %d value len %d",
+				len(k), len(v)))
+		}
+		rotations[byteOrder.Uint32(k)] = &AccountRotation{
+			Successor: byteOrder.Uint32(v[0:4]),
+			Time:      time.Unix(int64(byteOrder.Uint64(v[4:12])), 0),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rotations, nil
+}
+
+// PutAccountRotation records the rotation of an account to its successor.
+func (m *Manager) PutAccountRotation(ns walletdb.ReadWriteBucket, account uint32, r *AccountRotation) error {
+	v := make([]byte, rotatedAccountValueSize)
+	byteOrder.PutUint32(v[0:4], r.Successor)
+	byteOrder.PutUint64(v[4:12], uint64(r.Time.Unix()))
+	err := ns.NestedReadWriteBucket(rotatedAccountBucketName).Put(rotatedAccountKey(account), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// ScriptImport describes the import of a redeem script.  Origin names the
+// request which imported the script, such as the RPC method, and User is the
+// authenticated user of the request when known.  RescanFrom is the height the
+// import rescanned the chain from, or -1 when no rescan was performed.
+type ScriptImport struct {
+	Time       time.Time
+	Origin     string
+	User       string
+	RescanFrom int32
+}
+
+// The script imports bucket is keyed by the hash160 of the redeem script and
+// records values with the following format:
+//
+//   [0:8]   Import time (8 bytes, unix seconds)
+//   [8:12]  Rescan height (4 bytes, -1 when not rescanned)
+//   [12]    Origin length (1 byte)
+//   [13:]   Origin followed by the user
+const scriptImportMinSize = 13
+
+func valueScriptImport(imp *ScriptImport) ([]byte, error) {
+	if len(imp.Origin) > 255 {
+		return nil, errors.E(errors.Encoding, errors.Errorf("origin len %d", len(imp.Origin)))
+	}
+	v := make([]byte, scriptImportMinSize+len(imp.Origin)+len(imp.User))
+	byteOrder.PutUint64(v[0:8], uint64(imp.Time.Unix()))
+	byteOrder.PutUint32(v[8:12], uint32(imp.RescanFrom))
+	v[12] = byte(len(imp.Origin))
+	copy(v[13:], imp.Origin)
+	copy(v[13+len(imp.Origin):], imp.User)
+	return v, nil
+}
+
+func readScriptImport(v []byte) (*ScriptImport, error) {
+	if len(v) < scriptImportMinSize || len(v) < scriptImportMinSize+int(v[12]) {
+		return nil, errors.E(errors.IO, errors.Errorf("script import len %d", len(v)))
+	}
+	originEnd := scriptImportMinSize + int(v[12])
+	return &ScriptImport{
+		Time:       time.Unix(int64(byteOrder.Uint64(v[0:8])), 0),
+		Origin:     string(v[scriptImportMinSize:originEnd]),
+		User:       string(v[originEnd:]),
+		RescanFrom: int32(byteOrder.Uint32(v[8:12])),
+	}, nil
+}
+
+// PutScriptImport records the import of a redeem script saved with
+// InsertTxScript, replacing any previous record of the script's import.
+func (s *Store) PutScriptImport(ns walletdb.ReadWriteBucket, script []byte, imp *ScriptImport) error {
+	v, err := valueScriptImport(imp)
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadWriteBucket(bucketScriptImports).Put(keyTxScript(script), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ScriptImport returns the record of the import of the redeem script with a
+// hash160.  Scripts which were not imported, or were imported before imports
+// were recorded, return an errors.NotExist error.
+func (s *Store) ScriptImport(ns walletdb.ReadBucket, hash []byte) (*ScriptImport, error) {
+	v := ns.NestedReadBucket(bucketScriptImports).Get(hash)
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no import of script %x", hash))
+	}
+	return readScriptImport(v)
+}
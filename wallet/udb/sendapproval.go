@@ -0,0 +1,186 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// The send approval bucket is keyed by account number and records the
+// passphrase required to approve sends from accounts which require approval:
+//
+//   [0:88]  Scrypt parameters and key digest of the approval passphrase
+const sendApprovalSize = passphraseHashSize
+
+// PendingSend describes a send requiring approval which has not yet been
+// approved.  No transaction is created until the send is approved by a
+// principal other than the requester.
+type PendingSend struct {
+	ID        [16]byte
+	Account   uint32
+	MinConf   int32
+	Time      time.Time
+	Requester string
+	Outputs   []*wire.TxOut
+}
+
+// The pending sends bucket is keyed by the pending send ID and records values
+// with the following format:
+//
+//   [0:4]   Account (4 bytes)
+//   [4:8]   Minimum confirmations (4 bytes)
+//   [8:16]  Queued time (8 bytes, unix seconds)
+//   [16:18] Requester length (2 bytes)
+//   [18:]   Requester, followed by a serialized transaction containing only
+//           the outputs
+const pendingSendMinSize = 18
+
+func sendApprovalKey(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+// SendApprovalRequired returns whether sends from an account must be queued
+// and approved before they are created.
+func (s *Store) SendApprovalRequired(ns walletdb.ReadBucket, account uint32) bool {
+	v := ns.NestedReadBucket(bucketSendApprovals).Get(sendApprovalKey(account))
+	return v != nil
+}
+
+// CheckSendApprovalPassphrase returns whether passphrase is the approval
+// passphrase of an account.  It always returns false for accounts which do not
+// require approval.
+func (s *Store) CheckSendApprovalPassphrase(ns walletdb.ReadBucket, account uint32, passphrase []byte) (bool, error) {
+	v := ns.NestedReadBucket(bucketSendApprovals).Get(sendApprovalKey(account))
+	if v == nil {
+		return false, nil
+	}
+	if len(v) != sendApprovalSize {
+		return false, errors.E(errors.IO, errors.Errorf("send approval len %d", len(v)))
+	}
+	return checkPassphraseHash(v, passphrase)
+}
+
+// PutSendApprovalPassphrase sets the passphrase required to approve sends from
+// an account.  An empty passphrase removes the approval requirement.
+func (s *Store) PutSendApprovalPassphrase(ns walletdb.ReadWriteBucket, account uint32, passphrase []byte) error {
+	b := ns.NestedReadWriteBucket(bucketSendApprovals)
+	k := sendApprovalKey(account)
+	if len(passphrase) == 0 {
+		err := b.Delete(k)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		return nil
+	}
+
+	v, err := hashPassphrase(passphrase)
+	if err != nil {
+		return err
+	}
+	err = b.Put(k, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func valuePendingSend(p *PendingSend) ([]byte, error) {
+	if len(p.Requester) > 0xffff {
+		return nil, errors.E(errors.Invalid, "pending send requester is too long")
+	}
+	tx := wire.NewMsgTx()
+	tx.TxOut = p.Outputs
+	size := pendingSendMinSize + len(p.Requester)
+	buf := bytes.NewBuffer(make([]byte, size, size+tx.SerializeSize()))
+	v := buf.Bytes()
+	byteOrder.PutUint32(v[0:4], p.Account)
+	byteOrder.PutUint32(v[4:8], uint32(p.MinConf))
+	byteOrder.PutUint64(v[8:16], uint64(p.Time.Unix()))
+	byteOrder.PutUint16(v[16:18], uint16(len(p.Requester)))
+	copy(v[18:], p.Requester)
+	err := tx.Serialize(buf)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func readPendingSend(k, v []byte) (*PendingSend, error) {
+	if len(k) != 16 || len(v) < pendingSendMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("pending send key len %d value len %d",
+			len(k), len(v)))
+	}
+	end := pendingSendMinSize + int(byteOrder.Uint16(v[16:18]))
+	if len(v) < end {
+		return nil, errors.E(errors.IO, errors.Errorf("pending send value len %d", len(v)))
+	}
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(v[end:]))
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	p := &PendingSend{
+		Account:   byteOrder.Uint32(v[0:4]),
+		MinConf:   int32(byteOrder.Uint32(v[4:8])),
+		Time:      time.Unix(int64(byteOrder.Uint64(v[8:16])), 0),
+		Requester: string(v[pendingSendMinSize:end]),
+		Outputs:   tx.TxOut,
+	}
+	copy(p.ID[:], k)
+	return p, nil
+}
+
+// PutPendingSend records a send awaiting approval.
+func (s *Store) PutPendingSend(ns walletdb.ReadWriteBucket, p *PendingSend) error {
+	v, err := valuePendingSend(p)
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadWriteBucket(bucketPendingSends).Put(p.ID[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// PendingSend returns the send awaiting approval with the ID.  An error with
+// kind errors.NotExist is returned if no such send is queued.
+func (s *Store) PendingSend(ns walletdb.ReadBucket, id *[16]byte) (*PendingSend, error) {
+	v := ns.NestedReadBucket(bucketPendingSends).Get(id[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no pending send %x", id[:]))
+	}
+	return readPendingSend(id[:], v)
+}
+
+// PendingSends returns all sends awaiting approval.
+func (s *Store) PendingSends(ns walletdb.ReadBucket) ([]*PendingSend, error) {
+	var sends []*PendingSend
+	err := ns.NestedReadBucket(bucketPendingSends).ForEach(func(k, v []byte) error {
+		p, err := readPendingSend(k, v)
+		if err != nil {
+			return err
+		}
+		sends = append(sends, p)
+		return nil
+	})
+	return sends, err
+}
+
+// DeletePendingSend removes a send awaiting approval.
+func (s *Store) DeletePendingSend(ns walletdb.ReadWriteBucket, id *[16]byte) error {
+	err := ns.NestedReadWriteBucket(bucketPendingSends).Delete(id[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
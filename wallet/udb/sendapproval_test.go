@@ -0,0 +1,38 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/wire"
+)
+
+func TestPendingSendSerialization(t *testing.T) {
+	p := &PendingSend{
+		ID:        [16]byte{1, 2, 3},
+		Account:   2,
+		MinConf:   6,
+		Time:      time.Unix(1559390400, 0),
+		Requester: "CN=clerk serial=1f",
+		Outputs: []*wire.TxOut{
+			wire.NewTxOut(1e8, []byte{0x76, 0xa9}),
+			wire.NewTxOut(2e8, []byte{0xa9, 0x87}),
+		},
+	}
+	v, err := valuePendingSend(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := readPendingSend(p.ID[:], v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, p) {
+		t.Fatalf("pending send round trip: got %+v, want %+v", got, p)
+	}
+}
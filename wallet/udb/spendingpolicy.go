@@ -0,0 +1,193 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// SpendingPolicy describes the limits placed on sending funds from an account
+// and the running counters used to enforce them.  A zero limit disables that
+// particular check.
+type SpendingPolicy struct {
+	// TxLimit is the maximum amount which may be sent by a single
+	// transaction.
+	TxLimit vhcutil.Amount
+
+	// DailyLimit is the maximum total amount which may be sent during a
+	// single UTC day.
+	DailyLimit vhcutil.Amount
+
+	// Day is the UTC day (measured in days since the unix epoch) that
+	// DailySpent was accumulated during.
+	Day int64
+
+	// DailySpent is the total amount sent during Day.
+	DailySpent vhcutil.Amount
+
+	// overrideHash is the scrypt hash of the override passphrase, or nil
+	// when the policy may not be exceeded by providing one.
+	overrideHash []byte
+}
+
+// The spending policy bucket is keyed by account number and records values
+// with the following format:
+//
+//   [0:8]     Per-transaction limit (8 bytes)
+//   [8:16]    Daily limit (8 bytes)
+//   [16:24]   Day of the daily spent counter (8 bytes)
+//   [24:32]   Daily spent counter (8 bytes)
+//   [32:120]  Scrypt parameters and key digest of the override passphrase
+//             (88 bytes, optional)
+const (
+	spendingPolicySize         = 32
+	spendingPolicyOverrideSize = spendingPolicySize + passphraseHashSize
+)
+
+// UTCDay returns the number of days since the unix epoch for the UTC day of t.
+func UTCDay(t time.Time) int64 {
+	return t.Unix() / (24 * 60 * 60)
+}
+
+// HasOverride returns whether the policy may be exceeded by providing an
+// override passphrase.
+func (p *SpendingPolicy) HasOverride() bool {
+	return p.overrideHash != nil
+}
+
+// SetOverridePassphrase sets the passphrase which allows the policy limits to
+// be exceeded.  An empty passphrase removes the ability to override the
+// policy.
+func (p *SpendingPolicy) SetOverridePassphrase(passphrase []byte) error {
+	if len(passphrase) == 0 {
+		p.overrideHash = nil
+		return nil
+	}
+	hash, err := hashPassphrase(passphrase)
+	if err != nil {
+		return err
+	}
+	p.overrideHash = hash
+	return nil
+}
+
+// CheckOverridePassphrase returns whether passphrase matches the override
+// passphrase of the policy.  It always returns false when the policy may not
+// be overridden.
+func (p *SpendingPolicy) CheckOverridePassphrase(passphrase []byte) (bool, error) {
+	if p.overrideHash == nil {
+		return false, nil
+	}
+	return checkPassphraseHash(p.overrideHash, passphrase)
+}
+
+// Remaining returns the amount which may still be sent during the UTC day of
+// now without exceeding the daily limit, and whether the daily limit is set.
+func (p *SpendingPolicy) Remaining(now time.Time) (vhcutil.Amount, bool) {
+	if p.DailyLimit == 0 {
+		return 0, false
+	}
+	spent := p.DailySpent
+	if p.Day != UTCDay(now) {
+		spent = 0
+	}
+	if spent >= p.DailyLimit {
+		return 0, true
+	}
+	return p.DailyLimit - spent, true
+}
+
+// Check returns an error with kind errors.Policy if sending amount during the
+// UTC day of now would exceed either limit of the policy.
+func (p *SpendingPolicy) Check(amount vhcutil.Amount, now time.Time) error {
+	if p.TxLimit != 0 && amount > p.TxLimit {
+		return errors.E(errors.Policy, errors.Errorf("amount %v exceeds "+
+			"per-transaction spending limit %v", amount, p.TxLimit))
+	}
+	if remaining, ok := p.Remaining(now); ok && amount > remaining {
+		return errors.E(errors.Policy, errors.Errorf("amount %v exceeds "+
+			"remaining daily spending limit %v", amount, remaining))
+	}
+	return nil
+}
+
+// Record adds amount to the daily spent counter, resetting the counter first
+// if the UTC day of now differs from the day it was last updated.
+func (p *SpendingPolicy) Record(amount vhcutil.Amount, now time.Time) {
+	day := UTCDay(now)
+	if p.Day != day {
+		p.Day = day
+		p.DailySpent = 0
+	}
+	p.DailySpent += amount
+}
+
+func valueSpendingPolicy(p *SpendingPolicy) []byte {
+	size := spendingPolicySize
+	if p.overrideHash != nil {
+		size = spendingPolicyOverrideSize
+	}
+	v := make([]byte, size)
+	byteOrder.PutUint64(v[0:8], uint64(p.TxLimit))
+	byteOrder.PutUint64(v[8:16], uint64(p.DailyLimit))
+	byteOrder.PutUint64(v[16:24], uint64(p.Day))
+	byteOrder.PutUint64(v[24:32], uint64(p.DailySpent))
+	copy(v[32:], p.overrideHash)
+	return v
+}
+
+func readSpendingPolicy(v []byte, p *SpendingPolicy) error {
+	if len(v) != spendingPolicySize && len(v) != spendingPolicyOverrideSize {
+		return errors.E(errors.IO, errors.Errorf("spending policy len %d", len(v)))
+	}
+	p.TxLimit = vhcutil.Amount(byteOrder.Uint64(v[0:8]))
+	p.DailyLimit = vhcutil.Amount(byteOrder.Uint64(v[8:16]))
+	p.Day = int64(byteOrder.Uint64(v[16:24]))
+	p.DailySpent = vhcutil.Amount(byteOrder.Uint64(v[24:32]))
+	p.overrideHash = nil
+	if len(v) == spendingPolicyOverrideSize {
+		p.overrideHash = append([]byte(nil), v[32:]...)
+	}
+	return nil
+}
+
+// SpendingPolicy returns the spending policy of an account.  A policy without
+// any limits is returned for accounts which have never had a policy set.
+func (s *Store) SpendingPolicy(ns walletdb.ReadBucket, account uint32) (*SpendingPolicy, error) {
+	p := new(SpendingPolicy)
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	v := ns.NestedReadBucket(bucketSpendingPolicies).Get(k)
+	if v == nil {
+		return p, nil
+	}
+	err := readSpendingPolicy(v, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PutSpendingPolicy records the spending policy of an account.  Policies
+// without any limits or override passphrase are removed.
+func (s *Store) PutSpendingPolicy(ns walletdb.ReadWriteBucket, account uint32, p *SpendingPolicy) error {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	b := ns.NestedReadWriteBucket(bucketSpendingPolicies)
+	var err error
+	if p.TxLimit == 0 && p.DailyLimit == 0 && p.overrideHash == nil {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, valueSpendingPolicy(p))
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
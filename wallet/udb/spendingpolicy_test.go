@@ -0,0 +1,69 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestSpendingPolicy(t *testing.T) {
+	day := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	nextDay := day.Add(24 * time.Hour)
+
+	p := &SpendingPolicy{TxLimit: 5e8, DailyLimit: 8e8}
+	tests := []struct {
+		amount vhcutil.Amount
+		now    time.Time
+		policy bool // whether a policy error is expected
+	}{
+		{6e8, day, true},      // exceeds tx limit
+		{5e8, day, false},     // 5 spent
+		{3e8, day, false},     // 8 spent
+		{1, day, true},        // exceeds daily limit
+		{5e8, nextDay, false}, // counter reset
+	}
+	for i, test := range tests {
+		err := p.Check(test.amount, test.now)
+		if test.policy != errors.Is(errors.Policy, err) {
+			t.Fatalf("test %d: unexpected error %v", i, err)
+		}
+		if err == nil {
+			p.Record(test.amount, test.now)
+		}
+	}
+	if p.DailySpent != 5e8 || p.Day != UTCDay(nextDay) {
+		t.Fatalf("unexpected daily counter %v on day %d", p.DailySpent, p.Day)
+	}
+
+	ok, err := p.CheckOverridePassphrase([]byte("override"))
+	if err != nil || ok {
+		t.Fatalf("policy without override passphrase was overridden: %v", err)
+	}
+	err = p.SetOverridePassphrase([]byte("override"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded SpendingPolicy
+	err = readSpendingPolicy(valueSpendingPolicy(p), &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = decoded.CheckOverridePassphrase([]byte("override"))
+	if err != nil || !ok {
+		t.Errorf("override passphrase did not match after serialization: %v", err)
+	}
+	ok, err = decoded.CheckOverridePassphrase([]byte("wrong"))
+	if err != nil || ok {
+		t.Errorf("incorrect override passphrase matched: %v", err)
+	}
+	if decoded.TxLimit != p.TxLimit || decoded.DailyLimit != p.DailyLimit ||
+		decoded.DailySpent != p.DailySpent || decoded.Day != p.Day {
+		t.Errorf("serialized policy mismatch: %+v != %+v", decoded, *p)
+	}
+}
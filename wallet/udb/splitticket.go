@@ -0,0 +1,130 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// SplitTicketCommitment describes a commitment output of a split ticket which
+// returns funds to this wallet.  Index is the index of the ticket input, and
+// therefore of the commitment, among all inputs of the ticket.
+type SplitTicketCommitment struct {
+	Index  uint32
+	Amount int64
+}
+
+// SplitTicket records this wallet's contribution to a ticket co-funded with
+// other wallets.
+type SplitTicket struct {
+	TicketHash     chainhash.Hash
+	Account        uint32
+	TicketPrice    int64
+	TotalCommitted int64
+	Commitments    []SplitTicketCommitment
+}
+
+// Contribution returns the total amount committed to the ticket by this
+// wallet.
+func (t *SplitTicket) Contribution() int64 {
+	var sum int64
+	for _, c := range t.Commitments {
+		sum += c.Amount
+	}
+	return sum
+}
+
+// The split tickets bucket is keyed by ticket hash and records values with the
+// following format:
+//
+//   [0:4]   Account (4 bytes)
+//   [4:12]  Ticket price (8 bytes)
+//   [12:20] Total amount committed by all participants (8 bytes)
+//   [20:22] Number of commitments of this wallet (2 bytes)
+//   [22:]   Commitments, each an input index (4 bytes) and amount (8 bytes)
+const (
+	splitTicketMinSize    = 22
+	splitTicketCommitSize = 12
+)
+
+func valueSplitTicket(t *SplitTicket) ([]byte, error) {
+	if len(t.Commitments) > 0xffff {
+		return nil, errors.E(errors.Invalid, "split ticket record is too large")
+	}
+	v := make([]byte, splitTicketMinSize+splitTicketCommitSize*len(t.Commitments))
+	byteOrder.PutUint32(v[0:4], t.Account)
+	byteOrder.PutUint64(v[4:12], uint64(t.TicketPrice))
+	byteOrder.PutUint64(v[12:20], uint64(t.TotalCommitted))
+	byteOrder.PutUint16(v[20:22], uint16(len(t.Commitments)))
+	off := splitTicketMinSize
+	for _, c := range t.Commitments {
+		byteOrder.PutUint32(v[off:off+4], c.Index)
+		byteOrder.PutUint64(v[off+4:off+12], uint64(c.Amount))
+		off += splitTicketCommitSize
+	}
+	return v, nil
+}
+
+func readSplitTicket(k, v []byte) (*SplitTicket, error) {
+	if len(k) != chainhash.HashSize || len(v) < splitTicketMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("split ticket key len %d value len %d", len(k), len(v)))
+	}
+	n := int(byteOrder.Uint16(v[20:22]))
+	if len(v) != splitTicketMinSize+splitTicketCommitSize*n {
+		return nil, errors.E(errors.IO, "short split ticket record")
+	}
+	t := new(SplitTicket)
+	copy(t.TicketHash[:], k)
+	t.Account = byteOrder.Uint32(v[0:4])
+	t.TicketPrice = int64(byteOrder.Uint64(v[4:12]))
+	t.TotalCommitted = int64(byteOrder.Uint64(v[12:20]))
+	t.Commitments = make([]SplitTicketCommitment, n)
+	off := splitTicketMinSize
+	for i := range t.Commitments {
+		t.Commitments[i].Index = byteOrder.Uint32(v[off : off+4])
+		t.Commitments[i].Amount = int64(byteOrder.Uint64(v[off+4 : off+12]))
+		off += splitTicketCommitSize
+	}
+	return t, nil
+}
+
+// PutSplitTicket records this wallet's contribution to a split ticket,
+// replacing any previous record of the ticket.
+func (s *Store) PutSplitTicket(ns walletdb.ReadWriteBucket, t *SplitTicket) error {
+	v, err := valueSplitTicket(t)
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadWriteBucket(bucketSplitTickets).Put(t.TicketHash[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// SplitTicket returns the record of this wallet's contribution to a split
+// ticket.  Tickets which were not co-funded by the wallet return an
+// errors.NotExist error.
+func (s *Store) SplitTicket(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) (*SplitTicket, error) {
+	v := ns.NestedReadBucket(bucketSplitTickets).Get(ticketHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("ticket %v is not a split ticket", ticketHash))
+	}
+	return readSplitTicket(ticketHash[:], v)
+}
+
+// ForEachSplitTicket calls f with the record of each split ticket co-funded by
+// the wallet, breaking early on error.
+func (s *Store) ForEachSplitTicket(ns walletdb.ReadBucket, f func(*SplitTicket) error) error {
+	return ns.NestedReadBucket(bucketSplitTickets).ForEach(func(k, v []byte) error {
+		t, err := readSplitTicket(k, v)
+		if err != nil {
+			return err
+		}
+		return f(t)
+	})
+}
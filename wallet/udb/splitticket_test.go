@@ -0,0 +1,81 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestSplitTickets(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &SplitTicket{
+		TicketHash:     chainhash.Hash{1},
+		Account:        2,
+		TicketPrice:    100e8,
+		TotalCommitted: 100e8 + 1e5,
+		Commitments: []SplitTicketCommitment{
+			{Index: 1, Amount: 20e8 + 2e4},
+			{Index: 3, Amount: 5e8 + 5e3},
+		},
+	}
+	if got := r.Contribution(); got != 25e8+25e3 {
+		t.Errorf("contribution is %v", got)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		_, err := s.SplitTicket(ns, &r.TicketHash)
+		if !errors.Is(errors.NotExist, err) {
+			t.Errorf("split ticket of new database: %v", err)
+		}
+		err = s.PutSplitTicket(ns, r)
+		if err != nil {
+			return err
+		}
+		got, err := s.SplitTicket(ns, &r.TicketHash)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(got, r) {
+			t.Errorf("split ticket round trip: got %+v, want %+v", got, r)
+		}
+		var n int
+		err = s.ForEachSplitTicket(ns, func(*SplitTicket) error {
+			n++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if n != 1 {
+			t.Errorf("iterated %d split tickets", n)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Truncated records are rejected.
+	v, err := valueSplitTicket(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(v); i++ {
+		if _, err := readSplitTicket(r.TicketHash[:], v[:i]); err == nil {
+			t.Fatalf("read split ticket truncated to %d bytes", i)
+		}
+	}
+}
@@ -346,6 +346,26 @@ func (s *StakeStore) StakePoolUserInfo(ns walletdb.ReadBucket, user vhcutil.Addr
 	return stakePoolUserInfo(ns, user)
 }
 
+// ForEachStakePoolUserTicket calls f with each ticket of a stake pool user,
+// keyed to their P2SH or P2PKH voting address, ordered by ticket hash.  When
+// after is non-nil, iteration begins with the first ticket with a greater
+// hash.  When status is non-nil, only tickets with the status are included.
+// Iteration stops early when f returns false.  Tickets are read from indexes
+// so the cost does not depend on the number of tickets skipped.
+func (s *StakeStore) ForEachStakePoolUserTicket(ns walletdb.ReadBucket, user vhcutil.Address,
+	status *TicketStatus, after *chainhash.Hash, f func(*PoolTicket) bool) error {
+
+	_, isScriptHash := user.(*vhcutil.AddressScriptHash)
+	_, isP2PKH := user.(*vhcutil.AddressPubKeyHash)
+	if !(isScriptHash || isP2PKH) {
+		return errors.E(errors.Invalid, errors.Errorf("voting address type %T", user))
+	}
+	var scriptHash [20]byte
+	copy(scriptHash[:], user.ScriptAddress())
+
+	return forEachStakePoolUserTicket(ns, scriptHash, status, after, f)
+}
+
 // loadManager returns a new stake manager that results from loading it from
 // the passed opened database.  The public passphrase is required to decrypt the
 // public keys.
@@ -78,6 +78,13 @@ var (
 	ssgenRecordsBucketName = []byte("ssgenrecords")
 	ssrtxRecordsBucketName = []byte("ssrtxrecords")
 
+	// Stake pool user ticket indexes.  Both record serialized stake pool
+	// user tickets.  The tickets index is keyed by user script hash and
+	// ticket hash, and the status index is keyed by user script hash,
+	// ticket status, and ticket hash.
+	stakePoolUserTicketsBucketName = []byte("spusertickets")
+	stakePoolUserStatusBucketName  = []byte("spuserstatus")
+
 	// Db related key names (main bucket).
 	stakeStoreCreateDateName = []byte("stakestorecreated")
 )
@@ -515,8 +522,10 @@ func updateStakePoolUserTickets(ns walletdb.ReadWriteBucket, scriptHash [20]byte
 	// itself and push. Otherwise, we need to insert a new
 	// record.
 	var records []*PoolTicket
+	var oldRecord *PoolTicket
 	preExists, loc := recordExistsInUserTickets(record, oldRecords)
 	if preExists {
+		oldRecord = oldRecords[loc]
 		records = oldRecords
 		records[loc] = record
 	} else {
@@ -543,6 +552,89 @@ func updateStakePoolUserTickets(ns walletdb.ReadWriteBucket, scriptHash [20]byte
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
+	return putStakePoolUserTicketIndex(ns, scriptHash, oldRecord, record)
+}
+
+func keyStakePoolUserTicket(scriptHash [20]byte, ticket *chainhash.Hash) []byte {
+	k := make([]byte, scriptHashSize+hashSize)
+	copy(k, scriptHash[:])
+	copy(k[scriptHashSize:], ticket[:])
+	return k
+}
+
+func keyStakePoolUserStatus(scriptHash [20]byte, status TicketStatus, ticket *chainhash.Hash) []byte {
+	k := make([]byte, scriptHashSize+int8Size+hashSize)
+	copy(k, scriptHash[:])
+	k[scriptHashSize] = byte(status)
+	copy(k[scriptHashSize+int8Size:], ticket[:])
+	return k
+}
+
+// putStakePoolUserTicketIndex records a stake pool user ticket in the user
+// ticket indexes, replacing the status index entry of the previous record of
+// the ticket when its status changed.  old is nil for new tickets.
+func putStakePoolUserTicketIndex(ns walletdb.ReadWriteBucket, scriptHash [20]byte, old, record *PoolTicket) error {
+	statusBucket := ns.NestedReadWriteBucket(stakePoolUserStatusBucketName)
+	if old != nil && old.Status != record.Status {
+		err := statusBucket.Delete(keyStakePoolUserStatus(scriptHash, old.Status, &old.Ticket))
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	v := serializeUserTicket(record)
+	err := ns.NestedReadWriteBucket(stakePoolUserTicketsBucketName).Put(
+		keyStakePoolUserTicket(scriptHash, &record.Ticket), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = statusBucket.Put(keyStakePoolUserStatus(scriptHash, record.Status, &record.Ticket), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// forEachStakePoolUserTicket calls f with each ticket of a stake pool user
+// ordered by ticket hash, beginning with the first ticket with a hash greater
+// than after when it is non-nil.  Only tickets with a matching status are
+// included when status is non-nil.  Iteration stops early when f returns
+// false.
+func forEachStakePoolUserTicket(ns walletdb.ReadBucket, scriptHash [20]byte, status *TicketStatus,
+	after *chainhash.Hash, f func(*PoolTicket) bool) error {
+
+	var c walletdb.ReadCursor
+	var prefix []byte
+	if status != nil {
+		prefix = append(scriptHash[:], byte(*status))
+		c = ns.NestedReadBucket(stakePoolUserStatusBucketName).ReadPrefixCursor(prefix)
+	} else {
+		prefix = scriptHash[:]
+		c = ns.NestedReadBucket(stakePoolUserTicketsBucketName).ReadPrefixCursor(prefix)
+	}
+	defer c.Close()
+
+	var k, v []byte
+	if after == nil {
+		k, v = c.First()
+	} else {
+		seek := append(prefix[:len(prefix):len(prefix)], after[:]...)
+		k, v = c.Seek(seek)
+		if bytes.Equal(k, seek) {
+			k, v = c.Next()
+		}
+	}
+	for ; k != nil; k, v = c.Next() {
+		if len(v) != stakePoolUserTicketSize {
+			return errors.E(errors.IO, errors.Errorf("stake pool user ticket len %d", len(v)))
+		}
+		ticket, err := deserializeUserTicket(v)
+		if err != nil {
+			return err
+		}
+		if !f(ticket) {
+			return nil
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestStakePoolUserTicketIndex(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := &chaincfg.TestNetParams
+	s := newStakeStore(params, nil)
+	user, err := vhcutil.NewAddressScriptHashFromHash(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := vhcutil.NewAddressScriptHashFromHash(append(make([]byte, 19), 1), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tickets := func(status *TicketStatus, after *chainhash.Hash) []chainhash.Hash {
+		var hashes []chainhash.Hash
+		err := walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(wstakemgrBucketKey)
+			return s.ForEachStakePoolUserTicket(ns, user, status, after, func(t *PoolTicket) bool {
+				hashes = append(hashes, t.Ticket)
+				return true
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hashes
+	}
+	equal := func(a, b []chainhash.Hash) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wstakemgrBucketKey)
+		for _, h := range []byte{3, 1, 4, 2} {
+			err := s.UpdateStakePoolUserTickets(ns, user, &PoolTicket{
+				Ticket: chainhash.Hash{h},
+				Status: TSImmatureOrLive,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		err := s.UpdateStakePoolUserTickets(ns, other, &PoolTicket{
+			Ticket: chainhash.Hash{5},
+			Status: TSImmatureOrLive,
+		})
+		if err != nil {
+			return err
+		}
+		// Changing the status of a ticket moves it between status
+		// indexes.
+		return s.UpdateStakePoolUserTickets(ns, user, &PoolTicket{
+			Ticket:  chainhash.Hash{2},
+			Status:  TSVoted,
+			SpentBy: chainhash.Hash{6},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	live, voted, missed := TicketStatus(TSImmatureOrLive), TicketStatus(TSVoted), TicketStatus(TSMissed)
+	tests := []struct {
+		name   string
+		status *TicketStatus
+		after  *chainhash.Hash
+		want   []chainhash.Hash
+	}{
+		{"all", nil, nil, []chainhash.Hash{{1}, {2}, {3}, {4}}},
+		{"after", nil, &chainhash.Hash{2}, []chainhash.Hash{{3}, {4}}},
+		{"after unknown", nil, &chainhash.Hash{2, 1}, []chainhash.Hash{{3}, {4}}},
+		{"live", &live, nil, []chainhash.Hash{{1}, {3}, {4}}},
+		{"live after", &live, &chainhash.Hash{3}, []chainhash.Hash{{4}}},
+		{"voted", &voted, nil, []chainhash.Hash{{2}}},
+		{"missed", &missed, nil, nil},
+	}
+	for _, test := range tests {
+		got := tickets(test.status, test.after)
+		if !equal(got, test.want) {
+			t.Errorf("%s: tickets %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// The ticket spend bucket is keyed by the account number and the height of the
+// block tickets were purchased at, and records the total ticket price spent as
+// an 8 byte amount:
+//
+//   Key:   [0:4] Account (4 bytes)  [4:8] Block height (4 bytes)
+//   Value: [0:8] Amount (8 bytes)
+const ticketSpendKeySize = 8
+
+func ticketSpendKey(account uint32, height int32) []byte {
+	k := make([]byte, ticketSpendKeySize)
+	byteOrder.PutUint32(k[0:4], account)
+	byteOrder.PutUint32(k[4:8], uint32(height))
+	return k
+}
+
+// TicketSpend returns the total amount spent on tickets purchased from an
+// account in blocks at or after the height since.
+func (m *Manager) TicketSpend(ns walletdb.ReadBucket, account uint32, since int32) (vhcutil.Amount, error) {
+	var total vhcutil.Amount
+	err := ns.NestedReadBucket(ticketSpendBucketName).ForEach(func(k, v []byte) error {
+		if len(k) != ticketSpendKeySize || len(v) != 8 {
+			return errors.E(errors.IO, errors.Errorf("ticket spend key len %d value len %d",
+				len(k), len(v)))
+		}
+		if byteOrder.Uint32(k[0:4]) != account || int32(byteOrder.Uint32(k[4:8])) < since {
+			return nil
+		}
+		total += vhcutil.Amount(byteOrder.Uint64(v))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// PutTicketSpend adds amount to the total spent on tickets purchased from an
+// account in the block at height.  Records of the account for blocks before
+// the height prune are removed.
+func (m *Manager) PutTicketSpend(ns walletdb.ReadWriteBucket, account uint32, height int32,
+	amount vhcutil.Amount, prune int32) error {
+
+	b := ns.NestedReadWriteBucket(ticketSpendBucketName)
+	var stale [][]byte
+	err := b.ForEach(func(k, v []byte) error {
+		if len(k) == ticketSpendKeySize && byteOrder.Uint32(k[0:4]) == account &&
+			int32(byteOrder.Uint32(k[4:8])) < prune {
+			stale = append(stale, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	for _, k := range stale {
+		err := b.Delete(k)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	k := ticketSpendKey(account, height)
+	if v := b.Get(k); len(v) == 8 {
+		amount += vhcutil.Amount(byteOrder.Uint64(v))
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(amount))
+	err = b.Put(k, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestTicketSpend(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m *Manager
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+		puts := []struct {
+			account uint32
+			height  int32
+			amount  vhcutil.Amount
+		}{
+			{0, 100, 10e8},
+			{0, 100, 5e8},
+			{0, 110, 20e8},
+			{1, 110, 7e8},
+		}
+		for _, p := range puts {
+			err := m.PutTicketSpend(ns, p.account, p.height, p.amount, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		tests := []struct {
+			account uint32
+			since   int32
+			want    vhcutil.Amount
+		}{
+			{0, 0, 35e8},
+			{0, 101, 20e8},
+			{0, 111, 0},
+			{1, 0, 7e8},
+			{2, 0, 0},
+		}
+		for _, test := range tests {
+			spent, err := m.TicketSpend(ns, test.account, test.since)
+			if err != nil {
+				return err
+			}
+			if spent != test.want {
+				t.Errorf("account %d since %d: spent %v, want %v",
+					test.account, test.since, spent, test.want)
+			}
+		}
+
+		// Records of the account before the pruning height are removed
+		// while other accounts are unaffected.
+		err := m.PutTicketSpend(ns, 0, 120, 1e8, 105)
+		if err != nil {
+			return err
+		}
+		if spent, err := m.TicketSpend(ns, 0, 0); err != nil || spent != 21e8 {
+			t.Errorf("account 0 after pruning: spent %v (%v), want %v",
+				spent, err, vhcutil.Amount(21e8))
+		}
+		if spent, err := m.TicketSpend(ns, 1, 0); err != nil || spent != 7e8 {
+			t.Errorf("account 1 after pruning: spent %v (%v), want %v",
+				spent, err, vhcutil.Amount(7e8))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
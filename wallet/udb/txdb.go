@@ -145,6 +145,22 @@ var (
 	bucketStakeInvalidatedCredits = []byte("ic")
 	bucketStakeInvalidatedDebits  = []byte("id")
 	bucketCFilters                = []byte("cf")
+	bucketSpendingPolicies        = []byte("spp")
+	bucketSendApprovals           = []byte("sap")
+	bucketPendingSends            = []byte("psd")
+	bucketInternalTransfers       = []byte("itr")
+	bucketVoteRecords             = []byte("vrc")
+	bucketVSPTickets              = []byte("vspt")
+	bucketSplitTickets            = []byte("splt")
+	bucketColdVotingTickets       = []byte("cvt")
+	bucketColdVotingIndexes       = []byte("cvi")
+	bucketScriptImports           = []byte("sci")
+	bucketWatchedScripts          = []byte("wscr")
+	bucketWatchedOutPoints        = []byte("wop")
+	bucketOutpointLocks           = []byte("olk")
+	bucketUTXOPolicies            = []byte("utxop")
+	bucketAddressCredits          = []byte("adcr")
+	bucketPendingRevocations      = []byte("prev")
 )
 
 // Root (namespace) bucket keys
@@ -155,6 +171,7 @@ var (
 	rootTipBlock     = []byte("tip")
 	rootHaveCFilters = []byte("havecfilters")
 	rootLastTxsBlock = []byte("lasttxsblock")
+	rootVSP          = []byte("vsp")
 )
 
 // The root bucket's mined balance k/v pair records the total balance for all
@@ -1393,7 +1393,14 @@ func (s *Store) addCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 		v := valueUnminedCredit(vhcutil.Amount(rec.MsgTx.TxOut[index].Value),
 			change, opCode, isCoinbase, hasExpiry, scrType, uint32(scrLoc),
 			uint32(scrLen), account, DBVersion)
-		return true, putRawUnminedCredit(ns, k, v)
+		err := putRawUnminedCredit(ns, k, v)
+		if err != nil {
+			return false, err
+		}
+		op := wire.OutPoint{Hash: rec.Hash, Index: index}
+		err = putAddressCredits(ns, &op, rec.MsgTx.TxOut[index].PkScript,
+			vhcutil.Amount(rec.MsgTx.TxOut[index].Value), s.chainParams)
+		return true, err
 	}
 
 	k, v := existsCredit(ns, &rec.Hash, index, &block.Block)
@@ -1429,6 +1436,11 @@ func (s *Store) addCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 	if err != nil {
 		return false, err
 	}
+	err = putAddressCredits(ns, &cred.outPoint, rec.MsgTx.TxOut[index].PkScript,
+		txOutAmt, s.chainParams)
+	if err != nil {
+		return false, err
+	}
 
 	minedBalance, err := fetchMinedBalance(ns)
 	if err != nil {
@@ -2112,6 +2124,78 @@ func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]*Credit, error) {
 	return unspent, nil
 }
 
+// RangeUnspentOutputs runs the function f on each unspent received
+// transaction output, ordered by transaction hash and output index, beginning
+// with the first output following after, or the first output if after is nil,
+// until f returns true.  The tree of after is ignored.  Only the outputs which
+// are passed to f are read.
+func (s *Store) RangeUnspentOutputs(ns walletdb.ReadBucket, after *wire.OutPoint,
+	f func(*Credit) (bool, error)) error {
+
+	// Mined and unmined credits are recorded by separate buckets, which are
+	// both keyed by the canonical outpoint.  Their cursors are merged to
+	// iterate every output in key order.
+	mined := ns.NestedReadBucket(bucketUnspent).ReadCursor()
+	defer mined.Close()
+	unmined := ns.NestedReadBucket(bucketUnminedCredits).ReadCursor()
+	defer unmined.Close()
+	first := func(c walletdb.ReadCursor) (k, v []byte) {
+		if after == nil {
+			return c.First()
+		}
+		start := canonicalOutPoint(&after.Hash, after.Index)
+		k, v = c.Seek(start)
+		if bytes.Equal(k, start) {
+			k, v = c.Next()
+		}
+		return k, v
+	}
+	mk, mv := first(mined)
+	uk, _ := first(unmined)
+
+	var op wire.OutPoint
+	var block Block
+	for mk != nil || uk != nil {
+		isMined := uk == nil || (mk != nil && bytes.Compare(mk, uk) < 0)
+		k := uk
+		if isMined {
+			k = mk
+		}
+
+		// Outputs spent by an unmined transaction are skipped.
+		if existsRawUnminedInput(ns, k) == nil {
+			err := readCanonicalOutPoint(k, &op)
+			if err != nil {
+				return err
+			}
+			var cred *Credit
+			if isMined {
+				err = readUnspentBlock(mv, &block)
+				if err != nil {
+					return err
+				}
+				cred, err = s.outputCreditInfo(ns, op, &block)
+			} else {
+				cred, err = s.outputCreditInfo(ns, op, nil)
+			}
+			if err != nil {
+				return err
+			}
+			brk, err := f(cred)
+			if err != nil || brk {
+				return err
+			}
+		}
+
+		if isMined {
+			mk, mv = mined.Next()
+		} else {
+			uk, _ = unmined.Next()
+		}
+	}
+	return nil
+}
+
 // UnspentOutpoints returns all unspent received transaction outpoints.
 // The order is undefined.
 func (s *Store) UnspentOutpoints(ns walletdb.ReadBucket) ([]wire.OutPoint, error) {
@@ -2470,6 +2554,28 @@ func (s *Store) UnspentMultisigCreditsForAddress(ns walletdb.ReadBucket, addr vh
 	return mscs, nil
 }
 
+// ForEachUnspentMultisigOut calls f with each unspent multisignature P2SH
+// output recorded by the wallet and its redeem script, breaking early on
+// error.
+func (s *Store) ForEachUnspentMultisigOut(ns walletdb.ReadBucket, f func(mso *MultisigOut, redeemScript []byte) error) error {
+	return ns.NestedReadBucket(bucketMultisigUsp).ForEach(func(k, _ []byte) error {
+		val := existsMultisigOutCopy(ns, k)
+		if val == nil {
+			return errors.E(errors.IO, "missing multisig credit")
+		}
+		mso, err := fetchMultisigOut(k, val)
+		if err != nil {
+			return err
+		}
+		mso.OutPoint.Tree = mso.Tree
+		redeemScript := existsTxScript(ns, mso.ScriptHash[:])
+		if redeemScript == nil {
+			return errors.E(errors.IO, "missing multisig redeem script")
+		}
+		return f(mso, redeemScript)
+	})
+}
+
 type minimalCredit struct {
 	txRecordKey []byte
 	index       uint32
@@ -2865,8 +2971,13 @@ func (s *InputSource) SelectInputs(target vhcutil.Amount) (*txauthor.InputDetail
 
 // MakeInputSource creates an InputSource to redeem unspent outputs from an
 // account.  The minConf and syncHeight parameters are used to filter outputs
-// based on some spendable policy.
-func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint32, minConf, syncHeight int32) InputSource {
+// based on some spendable policy.  Outputs with the do-not-spend UTXO policy,
+// or with any of the policy flags of exclude, are never selected.
+func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint32, minConf, syncHeight int32,
+	exclude UTXOPolicy) InputSource {
+
+	exclude |= UTXOPolicyDoNotSpend
+
 	// Cursors to iterate over the (mined) unspent and unmined credit
 	// buckets.  These are closed over by the returned input source and
 	// reused across multiple calls.
@@ -2918,6 +3029,10 @@ func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint3
 				// Skip to next unmined credit.
 				continue
 			}
+			// Skip outputs excluded by their UTXO policy.
+			if fetchUTXOPolicy(ns, k)&exclude != 0 {
+				continue
+			}
 
 			cKey := make([]byte, 72)
 			copy(cKey[0:32], k[0:32])   // Tx hash
@@ -3077,6 +3192,10 @@ func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint3
 			if existsRawUnminedInput(ns, k) != nil {
 				continue
 			}
+			// Skip outputs excluded by their UTXO policy.
+			if fetchUTXOPolicy(ns, k)&exclude != 0 {
+				continue
+			}
 
 			// Check the account first.
 			pkScript, err := s.fastCreditPkScriptLookup(ns, nil, k)
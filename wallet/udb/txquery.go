@@ -6,6 +6,8 @@
 package udb
 
 import (
+	"bytes"
+
 	"github.com/valhallacoin/vhcd/blockchain/stake"
 	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
 	"github.com/valhallacoin/vhcd/vhcutil"
@@ -523,6 +525,58 @@ func (s *Store) RangeTransactions(ns walletdb.ReadBucket, begin, end int32,
 	return err
 }
 
+// TxCursor identifies a transaction in the newest first order of
+// RangeTransactionsFrom: unmined transactions by decreasing hash, followed by
+// mined transactions by decreasing block height and decreasing position in
+// the block.  The height of unmined transactions is -1.
+type TxCursor struct {
+	Height int32
+	Hash   chainhash.Hash
+}
+
+// RangeTransactionsFrom runs the function f on the details of each
+// transaction, newest first, beginning with the transaction identified by the
+// cursor, or the newest transaction if the cursor is nil, until f returns
+// true.  Only the blocks from the cursor height are read.  If the cursor's
+// transaction is no longer recorded at the cursor height, iteration begins
+// with the transaction following its position, or with the next block if the
+// position is unknown.
+//
+// The details passed to f may be reused and are not safe to use after f
+// returns.
+func (s *Store) RangeTransactionsFrom(ns walletdb.ReadBucket, cursor *TxCursor,
+	f func(*TxDetails) (bool, error)) error {
+
+	begin := int32(-1)
+	started := cursor == nil
+	if cursor != nil {
+		begin = cursor.Height
+	}
+	return s.RangeTransactions(ns, begin, 0, func(details []TxDetails) (bool, error) {
+		for i := len(details) - 1; i >= 0; i-- {
+			d := &details[i]
+			if !started {
+				switch {
+				case d.Block.Height != cursor.Height:
+					// The cursor's block was passed.
+				case d.Block.Height == -1:
+					if bytes.Compare(d.Hash[:], cursor.Hash[:]) > 0 {
+						continue
+					}
+				case d.Hash != cursor.Hash:
+					continue
+				}
+				started = true
+			}
+			brk, err := f(d)
+			if err != nil || brk {
+				return brk, err
+			}
+		}
+		return false, nil
+	})
+}
+
 // PreviousPkScripts returns a slice of previous output scripts for each credit
 // output this transaction record debits from.
 func (s *Store) PreviousPkScripts(ns walletdb.ReadBucket, rec *TxRecord, block *Block) ([][]byte, error) {
@@ -619,3 +673,79 @@ func (s *Store) PreviousPkScripts(ns walletdb.ReadBucket, rec *TxRecord, block *
 
 	return pkScripts, nil
 }
+
+// AccountBalancesAtHeight returns the total balance of every account as of the
+// main chain block at height.  Balances are calculated by replaying each mined
+// credit and debit recorded at or below this height, so later and unmined
+// transactions are not included.  Accounts that never received any credits by
+// this height are omitted from the result.
+func (s *Store) AccountBalancesAtHeight(ns, addrmgrNs walletdb.ReadBucket, height int32) (map[uint32]vhcutil.Amount, error) {
+	if height < 0 {
+		return nil, errors.E(errors.Invalid, errors.Errorf("negative block height %d", height))
+	}
+
+	balances := make(map[uint32]vhcutil.Amount)
+	f := func(details []TxDetails) (bool, error) {
+		for i := range details {
+			d := &details[i]
+			block := &d.Block.Block
+
+			for _, cred := range d.Credits {
+				credVal := existsRawCredit(ns, keyCredit(&d.Hash, cred.Index, block))
+				pkScript := d.MsgTx.TxOut[cred.Index].PkScript
+				acct, err := s.fetchAccountForPkScript(addrmgrNs, credVal, nil, pkScript)
+				if err != nil {
+					return false, err
+				}
+				balances[acct] += cred.Amount
+			}
+
+			if len(d.Debits) == 0 {
+				continue
+			}
+			it := makeReadDebitIterator(ns, keyTxRecord(&d.Hash, block))
+			for it.next() {
+				acct, err := s.debitCreditAccount(ns, addrmgrNs, extractRawDebitCreditKey(it.cv))
+				if err != nil {
+					return false, err
+				}
+				balances[acct] -= it.elem.Amount
+			}
+			if it.err != nil {
+				return false, it.err
+			}
+		}
+		return false, nil
+	}
+	_, err := s.rangeBlockTransactions(ns, 0, height, f)
+	if err != nil {
+		return nil, err
+	}
+	return balances, nil
+}
+
+// debitCreditAccount returns the account of the mined credit with key credKey
+// that was spent by a debit.
+func (s *Store) debitCreditAccount(ns, addrmgrNs walletdb.ReadBucket, credKey []byte) (uint32, error) {
+	credVal := existsRawCredit(ns, credKey)
+	if credVal == nil {
+		return 0, errors.E(errors.IO, errors.Errorf("missing credit value for key %x", credKey))
+	}
+	acct, err := fetchRawCreditAccount(credVal)
+	if err == nil {
+		return acct, nil
+	}
+
+	// Older credits may not have recorded the account.  Look it up from
+	// the credited output script instead.
+	scrPos := fetchRawCreditScriptOffset(credVal)
+	scrLen := fetchRawCreditScriptLength(credVal)
+	k := extractRawCreditTxRecordKey(credKey)
+	v := existsRawTxRecord(ns, k)
+	pkScript, err := fetchRawTxRecordPkScript(k, v, extractRawCreditIndex(credKey),
+		scrPos, scrLen)
+	if err != nil {
+		return 0, err
+	}
+	return s.fetchAccountForPkScript(addrmgrNs, nil, nil, pkScript)
+}
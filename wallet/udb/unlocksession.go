@@ -0,0 +1,166 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/internal/zero"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/snacl"
+)
+
+// unlockSession caches the master private key derived from the private
+// passphrase so that unlocks with the same passphrase may skip the expensive
+// key derivation function.  The cached key is never held in clear text: it is
+// encrypted with an ephemeral key that exists only in process memory.
+type unlockSession struct {
+	wrapKey            *snacl.CryptoKey
+	masterKeyEncrypted []byte
+	salt               [saltSize]byte
+	hashedPassphrase   [sha512.Size]byte
+	expiry             time.Time
+}
+
+func newUnlockSession(masterKey *snacl.CryptoKey, passphrase []byte,
+	expiry time.Time) (*unlockSession, error) {
+
+	wrapKey, err := snacl.GenerateCryptoKey()
+	if err != nil {
+		return nil, err
+	}
+	enc, err := wrapKey.Encrypt(masterKey[:])
+	if err != nil {
+		wrapKey.Zero()
+		return nil, err
+	}
+	s := &unlockSession{
+		wrapKey:            wrapKey,
+		masterKeyEncrypted: enc,
+		expiry:             expiry,
+	}
+	_, err = rand.Read(s.salt[:])
+	if err != nil {
+		s.zero()
+		return nil, errors.E(errors.IO, err)
+	}
+	s.hashedPassphrase = hashSessionPassphrase(&s.salt, passphrase)
+	return s, nil
+}
+
+func hashSessionPassphrase(salt *[saltSize]byte, passphrase []byte) [sha512.Size]byte {
+	salted := append(salt[:len(salt):len(salt)], passphrase...)
+	hashed := sha512.Sum512(salted)
+	zero.Bytes(salted)
+	return hashed
+}
+
+// masterKey copies the cached master private key into key if the session has
+// not expired and passphrase matches the passphrase the session was created
+// with.  It returns whether the key was copied.
+func (s *unlockSession) masterKey(passphrase []byte, key *snacl.CryptoKey) bool {
+	if time.Now().After(s.expiry) {
+		return false
+	}
+	hashed := hashSessionPassphrase(&s.salt, passphrase)
+	if subtle.ConstantTimeCompare(hashed[:], s.hashedPassphrase[:]) != 1 {
+		return false
+	}
+	dec, err := s.wrapKey.Decrypt(s.masterKeyEncrypted)
+	if err != nil || len(dec) != len(key) {
+		zero.Bytes(dec)
+		return false
+	}
+	copy(key[:], dec)
+	zero.Bytes(dec)
+	return true
+}
+
+func (s *unlockSession) zero() {
+	s.wrapKey.Zero()
+	zero.Bytes(s.masterKeyEncrypted)
+	zero.Bytea64(&s.hashedPassphrase)
+}
+
+// SetUnlockSessionTimeout sets the duration that the master private key
+// derived during an unlock remains cached.  While cached, unlocking with the
+// same passphrase skips the key derivation function.  A zero timeout disables
+// caching and clears any cached key.
+func (m *Manager) SetUnlockSessionTimeout(timeout time.Duration) {
+	m.mtx.Lock()
+	m.unlockSessionTimeout = timeout
+	if timeout <= 0 {
+		m.clearUnlockSession()
+	}
+	m.mtx.Unlock()
+}
+
+// UnlockSession returns the expiry time of the cached unlock session key, and
+// whether a session key is cached.
+func (m *Manager) UnlockSession() (time.Time, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.unlockSession == nil {
+		return time.Time{}, false
+	}
+	if time.Now().After(m.unlockSession.expiry) {
+		m.clearUnlockSession()
+		return time.Time{}, false
+	}
+	return m.unlockSession.expiry, true
+}
+
+// ClearUnlockSession removes any cached unlock session key, requiring the
+// next unlock to derive the master private key from the passphrase.
+func (m *Manager) ClearUnlockSession() {
+	m.mtx.Lock()
+	m.clearUnlockSession()
+	m.mtx.Unlock()
+}
+
+// clearUnlockSession zeros and removes the unlock session.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) clearUnlockSession() {
+	if m.unlockSession != nil {
+		m.unlockSession.zero()
+		m.unlockSession = nil
+	}
+}
+
+// deriveMasterKeyPriv derives the master private key from passphrase, using
+// the cached unlock session key when possible and caching the derived key when
+// sessions are enabled.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) deriveMasterKeyPriv(passphrase []byte) error {
+	if s := m.unlockSession; s != nil {
+		if s.masterKey(passphrase, m.masterKeyPriv.Key) {
+			return nil
+		}
+		if time.Now().After(s.expiry) {
+			m.clearUnlockSession()
+		}
+	}
+
+	if err := m.masterKeyPriv.DeriveKey(&passphrase); err != nil {
+		return err
+	}
+
+	if m.unlockSessionTimeout > 0 {
+		m.clearUnlockSession()
+		expiry := time.Now().Add(m.unlockSessionTimeout)
+		s, err := newUnlockSession(m.masterKeyPriv.Key, passphrase, expiry)
+		if err != nil {
+			log.Warnf("Unable to cache unlock session key: %v", err)
+			return nil
+		}
+		m.unlockSession = s
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcwallet/wallet/internal/snacl"
+)
+
+func TestUnlockSession(t *testing.T) {
+	masterKey, err := snacl.GenerateCryptoKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	passphrase := []byte("passphrase")
+
+	s, err := newUnlockSession(masterKey, passphrase, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var key snacl.CryptoKey
+	if s.masterKey([]byte("wrong"), &key) {
+		t.Fatal("session key returned for incorrect passphrase")
+	}
+	if !s.masterKey(passphrase, &key) {
+		t.Fatal("session key not returned for correct passphrase")
+	}
+	if key != *masterKey {
+		t.Fatal("session key does not match master key")
+	}
+
+	s.expiry = time.Now().Add(-time.Second)
+	if s.masterKey(passphrase, &key) {
+		t.Fatal("session key returned after expiry")
+	}
+}
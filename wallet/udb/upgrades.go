@@ -99,26 +99,156 @@ const (
 	// from properly-synced wallets.
 	lastProcessedTxsBlockVersion = 11
 
+	// spendingPolicyVersion is the twelfth version of the database.  It adds a
+	// txmgr namespace bucket recording the per-account spending limit policies
+	// and the running daily spend counters used to enforce them.
+	spendingPolicyVersion = 12
+
+	// sendApprovalVersion is the thirteenth version of the database.  It adds
+	// txmgr namespace buckets recording the approval passphrases of accounts
+	// which require sends to be approved, and the sends awaiting approval.
+	sendApprovalVersion = 13
+
+	// internalTransferVersion is the fourteenth version of the database.  It
+	// adds a txmgr namespace bucket recording the source and destination
+	// accounts of transactions which move funds between accounts of the
+	// wallet.
+	internalTransferVersion = 14
+
+	// accountGapPolicyVersion is the fifteenth version of the database.  It
+	// adds an address manager namespace bucket recording the default address
+	// gap limit policy of accounts.
+	accountGapPolicyVersion = 15
+
+	// depositPoolVersion is the sixteenth version of the database.  It adds
+	// an address manager namespace bucket recording the external addresses
+	// reserved for deposits to accounts and their assignment status.
+	depositPoolVersion = 16
+
+	// voteRecordVersion is the seventeenth version of the database.  It adds
+	// a txmgr namespace bucket recording the vote bits and agenda choices of
+	// each vote created by the wallet.
+	voteRecordVersion = 17
+
+	// accountKeyStorageVersion is the eighteenth version of the database.
+	// It adds an address manager namespace bucket recording the accounts
+	// whose private keys are kept outside of the address manager, such as
+	// in a hardware security module.
+	accountKeyStorageVersion = 18
+
+	// vspTicketsVersion is the nineteenth version of the database.  It adds a
+	// txmgr namespace bucket recording the tickets registered with voting
+	// service providers and the progress of paying their fees.
+	vspTicketsVersion = 19
+
+	// accountRotationVersion is the twentieth version of the database.  It
+	// adds an address manager namespace bucket recording the successors of
+	// accounts which were rotated after their extended public keys leaked.
+	accountRotationVersion = 20
+
+	// ticketSpendVersion is the twenty-first version of the database.  It
+	// adds an address manager namespace bucket recording the amounts spent
+	// on tickets by ticket buyers so spending budgets survive restarts.
+	ticketSpendVersion = 21
+
+	// splitTicketsVersion is the twenty-second version of the database.  It
+	// adds a transaction manager namespace bucket recording this wallet's
+	// contributions to tickets co-funded with other wallets.
+	splitTicketsVersion = 22
+
+	// coldVotingVersion is the twenty-third version of the database.  It adds
+	// transaction manager namespace buckets recording the child indexes of
+	// external voting extended public keys which derived ticket voting
+	// addresses.
+	coldVotingVersion = 23
+
+	// stakePoolUserTicketIndexVersion is the twenty-fourth version of the
+	// database.  It adds stake manager namespace buckets indexing the
+	// tickets of stake pool users by ticket hash and by ticket status.
+	stakePoolUserTicketIndexVersion = 24
+
+	// multisigAccountVersion is the twenty-fifth version of the database.
+	// It adds an address manager namespace bucket recording the cosigner
+	// extended public keys of HD multisig accounts and the next child
+	// indexes of their branches.
+	multisigAccountVersion = 25
+
+	// scriptImportVersion is the twenty-sixth version of the database.  It
+	// adds a transaction manager namespace bucket recording when and by whom
+	// redeem scripts were imported and the height rescanned from.
+	scriptImportVersion = 26
+
+	// watchedVersion is the twenty-seventh version of the database.  It adds
+	// transaction manager namespace buckets recording output scripts and
+	// outpoints watched by the wallet without being owned by it.
+	watchedVersion = 27
+
+	// outpointLocksVersion is the twenty-eighth version of the database.  It
+	// adds a transaction manager namespace bucket recording persistent
+	// outpoint locks.
+	outpointLocksVersion = 28
+
+	// outpointLockHeightsVersion is the twenty-ninth version of the database.
+	// It adds the main chain height a persistent outpoint lock expires at to
+	// the recorded locks.
+	outpointLockHeightsVersion = 29
+
+	// utxoPoliciesVersion is the thirtieth version of the database.  It adds a
+	// transaction manager namespace bucket recording the spend policies of
+	// unspent outputs.
+	utxoPoliciesVersion = 30
+
+	// addressCreditsVersion is the thirty-first version of the database.  It
+	// adds a transaction manager namespace bucket indexing credits by the
+	// addresses paid by their output scripts.
+	addressCreditsVersion = 31
+
+	// pendingRevocationsVersion is the thirty-second version of the
+	// database.  It adds a transaction manager namespace bucket recording
+	// delayed and canceled automatic revocations of missed tickets.
+	pendingRevocationsVersion = 32
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = lastProcessedTxsBlockVersion
+	DBVersion = pendingRevocationsVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
 // upgrade the database to the next version.  Note that there was never a
 // version zero so upgrades[0] is nil.
 var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
-	lastUsedAddressIndexVersion - 1:  lastUsedAddressIndexUpgrade,
-	votingPreferencesVersion - 1:     votingPreferencesUpgrade,
-	noEncryptedSeedVersion - 1:       noEncryptedSeedUpgrade,
-	lastReturnedAddressVersion - 1:   lastReturnedAddressUpgrade,
-	ticketBucketVersion - 1:          ticketBucketUpgrade,
-	slip0044CoinTypeVersion - 1:      slip0044CoinTypeUpgrade,
-	hasExpiryVersion - 1:             hasExpiryUpgrade,
-	hasExpiryFixedVersion - 1:        hasExpiryFixedUpgrade,
-	cfVersion - 1:                    cfUpgrade,
-	lastProcessedTxsBlockVersion - 1: lastProcessedTxsBlockUpgrade,
+	lastUsedAddressIndexVersion - 1:     lastUsedAddressIndexUpgrade,
+	votingPreferencesVersion - 1:        votingPreferencesUpgrade,
+	noEncryptedSeedVersion - 1:          noEncryptedSeedUpgrade,
+	lastReturnedAddressVersion - 1:      lastReturnedAddressUpgrade,
+	ticketBucketVersion - 1:             ticketBucketUpgrade,
+	slip0044CoinTypeVersion - 1:         slip0044CoinTypeUpgrade,
+	hasExpiryVersion - 1:                hasExpiryUpgrade,
+	hasExpiryFixedVersion - 1:           hasExpiryFixedUpgrade,
+	cfVersion - 1:                       cfUpgrade,
+	lastProcessedTxsBlockVersion - 1:    lastProcessedTxsBlockUpgrade,
+	spendingPolicyVersion - 1:           spendingPolicyUpgrade,
+	sendApprovalVersion - 1:             sendApprovalUpgrade,
+	internalTransferVersion - 1:         internalTransferUpgrade,
+	accountGapPolicyVersion - 1:         accountGapPolicyUpgrade,
+	depositPoolVersion - 1:              depositPoolUpgrade,
+	voteRecordVersion - 1:               voteRecordUpgrade,
+	accountKeyStorageVersion - 1:        accountKeyStorageUpgrade,
+	vspTicketsVersion - 1:               vspTicketsUpgrade,
+	accountRotationVersion - 1:          accountRotationUpgrade,
+	ticketSpendVersion - 1:              ticketSpendUpgrade,
+	splitTicketsVersion - 1:             splitTicketsUpgrade,
+	coldVotingVersion - 1:               coldVotingUpgrade,
+	stakePoolUserTicketIndexVersion - 1: stakePoolUserTicketIndexUpgrade,
+	multisigAccountVersion - 1:          multisigAccountUpgrade,
+	scriptImportVersion - 1:             scriptImportUpgrade,
+	watchedVersion - 1:                  watchedUpgrade,
+	outpointLocksVersion - 1:            outpointLocksUpgrade,
+	outpointLockHeightsVersion - 1:      outpointLockHeightsUpgrade,
+	utxoPoliciesVersion - 1:             utxoPoliciesUpgrade,
+	addressCreditsVersion - 1:           addressCreditsUpgrade,
+	pendingRevocationsVersion - 1:       pendingRevocationsUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -803,6 +933,602 @@ func lastProcessedTxsBlockUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []by
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
+func spendingPolicyUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 11
+	const newVersion = 12
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 11 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "spendingPolicyUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketSpendingPolicies)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func sendApprovalUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 12
+	const newVersion = 13
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 12 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "sendApprovalUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketSendApprovals)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = txmgrBucket.CreateBucket(bucketPendingSends)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func internalTransferUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 13
+	const newVersion = 14
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 13 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "internalTransferUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketInternalTransfers)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountGapPolicyUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 14
+	const newVersion = 15
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 14 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountGapPolicyUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(gapPolicyBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func depositPoolUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 15
+	const newVersion = 16
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 15 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "depositPoolUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(depositPoolBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func voteRecordUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 16
+	const newVersion = 17
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 16 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "voteRecordUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketVoteRecords)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountKeyStorageUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 17
+	const newVersion = 18
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 17 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountKeyStorageUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(keyStorageBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func vspTicketsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 18
+	const newVersion = 19
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 18 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "vspTicketsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketVSPTickets)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountRotationUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 19
+	const newVersion = 20
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 19 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountRotationUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(rotatedAccountBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func ticketSpendUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 20
+	const newVersion = 21
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 20 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "ticketSpendUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(ticketSpendBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func splitTicketsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 21
+	const newVersion = 22
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 21 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "splitTicketsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketSplitTickets)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func coldVotingUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 22
+	const newVersion = 23
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 22 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "coldVotingUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketColdVotingTickets)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = txmgrBucket.CreateBucket(bucketColdVotingIndexes)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func stakePoolUserTicketIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 23
+	const newVersion = 24
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	stakemgrBucket := tx.ReadWriteBucket(wstakemgrBucketKey)
+
+	// Assert that this function is only called on version 23 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "stakePoolUserTicketIndexUpgrade inappropriately called")
+	}
+
+	_, err = stakemgrBucket.CreateBucket(stakePoolUserTicketsBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = stakemgrBucket.CreateBucket(stakePoolUserStatusBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Index the tickets of every stake pool user.
+	type userTickets struct {
+		scriptHash [20]byte
+		tickets    []*PoolTicket
+	}
+	var users []userTickets
+	c := stakemgrBucket.NestedReadBucket(metaBucketName).ReadPrefixCursor(stakePoolTicketsPrefix)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != stakePoolTicketsPrefixSize+scriptHashSize {
+			continue
+		}
+		tickets, err := deserializeUserTickets(v)
+		if err != nil {
+			c.Close()
+			return errors.E(errors.IO, err)
+		}
+		u := userTickets{tickets: tickets}
+		copy(u.scriptHash[:], k[stakePoolTicketsPrefixSize:])
+		users = append(users, u)
+	}
+	c.Close()
+	for _, u := range users {
+		for _, t := range u.tickets {
+			err := putStakePoolUserTicketIndex(stakemgrBucket, u.scriptHash, nil, t)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func multisigAccountUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 24
+	const newVersion = 25
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 24 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "multisigAccountUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(multisigAccountBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func scriptImportUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 25
+	const newVersion = 26
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 25 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "scriptImportUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketScriptImports)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func watchedUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 26
+	const newVersion = 27
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 26 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "watchedUpgrade inappropriately called")
+	}
+
+	for _, b := range [][]byte{bucketWatchedScripts, bucketWatchedOutPoints} {
+		_, err = txmgrBucket.CreateBucket(b)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func outpointLocksUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 27
+	const newVersion = 28
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 27 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "outpointLocksUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketOutpointLocks)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func outpointLockHeightsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 28
+	const newVersion = 29
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+	locksBucket := txmgrBucket.NestedReadWriteBucket(bucketOutpointLocks)
+
+	// Assert that this function is only called on version 28 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "outpointLockHeightsUpgrade inappropriately called")
+	}
+
+	// Insert a zero expiry height after the expiry time of every recorded
+	// lock.  The values are collected before they are rewritten, as the
+	// bucket may not be modified during iteration.
+	type kv struct{ k, v []byte }
+	var locks []kv
+	err = locksBucket.ForEach(func(k, v []byte) error {
+		if len(v) < 9 {
+			return errors.E(errors.IO, errors.Errorf("outpoint lock len %d", len(v)))
+		}
+		newValue := make([]byte, len(v)+4)
+		copy(newValue, v[:8])
+		copy(newValue[12:], v[8:])
+		locks = append(locks, kv{append([]byte(nil), k...), newValue})
+		return nil
+	})
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	for _, l := range locks {
+		err = locksBucket.Put(l.k, l.v)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func utxoPoliciesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 29
+	const newVersion = 30
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 29 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "utxoPoliciesUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketUTXOPolicies)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func addressCreditsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 30
+	const newVersion = 31
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 30 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "addressCreditsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketAddressCredits)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Index every credit already recorded by the transaction manager.
+	err = indexAddressCredits(txmgrBucket, params)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func pendingRevocationsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 31
+	const newVersion = 32
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 31 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "pendingRevocationsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketPendingRevocations)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
 // Upgrade checks whether the any upgrades are necessary before the database is
 // ready for application usage.  If any are, they are performed.
 func Upgrade(db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
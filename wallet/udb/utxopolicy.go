@@ -0,0 +1,91 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"strings"
+
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// UTXOPolicy is a set of flags restricting the transactions an unspent output
+// is selected to be spent by.  The zero value places no restrictions on the
+// output.
+type UTXOPolicy uint8
+
+// UTXO spend policy flags.
+const (
+	// UTXOPolicyDoNotSpend excludes the output from all input selection.
+	UTXOPolicyDoNotSpend UTXOPolicy = 1 << iota
+
+	// UTXOPolicyReserveForTickets excludes the output from input selection
+	// for all transactions except ticket purchases.
+	UTXOPolicyReserveForTickets
+
+	// UTXOPolicyDust marks the output as dust, excluding it from input
+	// selection for sends and ticket purchases.  Dust outputs remain
+	// spendable by account sweeps.
+	UTXOPolicyDust
+)
+
+var utxoPolicyNames = [...]string{"donotspend", "reservefortickets", "dust"}
+
+// String returns the names of the flags of the policy separated by commas.
+func (p UTXOPolicy) String() string {
+	var names []string
+	for i, name := range utxoPolicyNames {
+		if p&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseUTXOPolicy returns the policy flag with a name returned by String.
+func ParseUTXOPolicy(name string) (UTXOPolicy, error) {
+	for i := range utxoPolicyNames {
+		if name == utxoPolicyNames[i] {
+			return 1 << uint(i), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown UTXO policy %q", name))
+}
+
+// The UTXO policies bucket is keyed by canonical outpoint and records a single
+// byte value holding the policy flags.  Outpoints without a policy are not
+// recorded.
+
+func fetchUTXOPolicy(ns walletdb.ReadBucket, k []byte) UTXOPolicy {
+	v := ns.NestedReadBucket(bucketUTXOPolicies).Get(k)
+	if len(v) == 0 {
+		return 0
+	}
+	return UTXOPolicy(v[0])
+}
+
+// SetUTXOPolicy records the spend policy of an outpoint, replacing any previous
+// policy.  Setting the zero policy removes the record of the outpoint.
+func (s *Store) SetUTXOPolicy(ns walletdb.ReadWriteBucket, op *wire.OutPoint, p UTXOPolicy) error {
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	b := ns.NestedReadWriteBucket(bucketUTXOPolicies)
+	var err error
+	if p == 0 {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, []byte{byte(p)})
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// UTXOPolicy returns the spend policy of an outpoint.  The zero policy is
+// returned for outpoints without a recorded policy.
+func (s *Store) UTXOPolicy(ns walletdb.ReadBucket, op *wire.OutPoint) UTXOPolicy {
+	return fetchUTXOPolicy(ns, canonicalOutPoint(&op.Hash, op.Index))
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// VoteChoice describes the choice of an agenda that was cast by a vote.
+type VoteChoice struct {
+	AgendaID string
+	ChoiceID string
+}
+
+// VoteRecord describes a vote created by the wallet, recording the vote bits
+// and agenda choices that were cast.
+type VoteRecord struct {
+	TicketHash   chainhash.Hash
+	VoteHash     chainhash.Hash
+	BlockHash    chainhash.Hash
+	BlockHeight  int32
+	Created      time.Time
+	VoteBits     uint16
+	ExtendedBits []byte
+	Choices      []VoteChoice
+}
+
+// The vote records bucket is keyed by the ticket hash followed by the vote
+// hash, and records values with the following format:
+//
+//   [0:32]  Hash of the block voted on (32 bytes)
+//   [32:36] Height of the block voted on (4 bytes)
+//   [36:44] Creation time (8 bytes)
+//   [44:46] Vote bits (2 bytes)
+//   [46]    Extended vote bits length (1 byte)
+//   [47:N]  Extended vote bits
+//   [N]     Number of agenda choices (1 byte)
+//
+// Each agenda choice is serialized as the length (1 byte) and string of the
+// agenda ID, followed by the length (1 byte) and string of the choice ID.
+const voteRecordMinSize = 48
+
+func valueVoteRecord(r *VoteRecord) ([]byte, error) {
+	if len(r.ExtendedBits) > 0xff || len(r.Choices) > 0xff {
+		return nil, errors.E(errors.Invalid, "vote record is too large")
+	}
+	size := voteRecordMinSize + len(r.ExtendedBits)
+	for _, c := range r.Choices {
+		if len(c.AgendaID) > 0xff || len(c.ChoiceID) > 0xff {
+			return nil, errors.E(errors.Invalid, "agenda choice is too large")
+		}
+		size += 2 + len(c.AgendaID) + len(c.ChoiceID)
+	}
+	v := make([]byte, 47, size)
+	copy(v[0:32], r.BlockHash[:])
+	byteOrder.PutUint32(v[32:36], uint32(r.BlockHeight))
+	byteOrder.PutUint64(v[36:44], uint64(r.Created.Unix()))
+	byteOrder.PutUint16(v[44:46], r.VoteBits)
+	v[46] = byte(len(r.ExtendedBits))
+	v = append(v, r.ExtendedBits...)
+	v = append(v, byte(len(r.Choices)))
+	for _, c := range r.Choices {
+		v = append(v, byte(len(c.AgendaID)))
+		v = append(v, c.AgendaID...)
+		v = append(v, byte(len(c.ChoiceID)))
+		v = append(v, c.ChoiceID...)
+	}
+	return v, nil
+}
+
+func readVoteRecord(k, v []byte) (*VoteRecord, error) {
+	if len(k) != 2*chainhash.HashSize || len(v) < voteRecordMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("vote record key len %d value len %d", len(k), len(v)))
+	}
+	r := new(VoteRecord)
+	copy(r.TicketHash[:], k[:chainhash.HashSize])
+	copy(r.VoteHash[:], k[chainhash.HashSize:])
+	copy(r.BlockHash[:], v[0:32])
+	r.BlockHeight = int32(byteOrder.Uint32(v[32:36]))
+	r.Created = time.Unix(int64(byteOrder.Uint64(v[36:44])), 0)
+	r.VoteBits = byteOrder.Uint16(v[44:46])
+	extLen := int(v[46])
+	v = v[47:]
+	if len(v) < extLen+1 {
+		return nil, errors.E(errors.IO, "short vote record")
+	}
+	r.ExtendedBits = append([]byte(nil), v[:extLen]...)
+	n := int(v[extLen])
+	v = v[extLen+1:]
+	readString := func() (string, bool) {
+		if len(v) < 1 || len(v) < 1+int(v[0]) {
+			return "", false
+		}
+		s := string(v[1 : 1+int(v[0])])
+		v = v[1+int(v[0]):]
+		return s, true
+	}
+	r.Choices = make([]VoteChoice, 0, n)
+	for i := 0; i < n; i++ {
+		agendaID, ok := readString()
+		if !ok {
+			return nil, errors.E(errors.IO, "short vote record")
+		}
+		choiceID, ok := readString()
+		if !ok {
+			return nil, errors.E(errors.IO, "short vote record")
+		}
+		r.Choices = append(r.Choices, VoteChoice{AgendaID: agendaID, ChoiceID: choiceID})
+	}
+	return r, nil
+}
+
+// PutVoteRecord records the vote bits and agenda choices of a vote created by
+// the wallet.
+func (s *Store) PutVoteRecord(ns walletdb.ReadWriteBucket, r *VoteRecord) error {
+	v, err := valueVoteRecord(r)
+	if err != nil {
+		return err
+	}
+	k := make([]byte, 2*chainhash.HashSize)
+	copy(k, r.TicketHash[:])
+	copy(k[chainhash.HashSize:], r.VoteHash[:])
+	err = ns.NestedReadWriteBucket(bucketVoteRecords).Put(k, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// VoteRecords returns the records of all votes created by the wallet for a
+// ticket.  Multiple votes are recorded when the ticket was selected to vote on
+// blocks of different chains.
+func (s *Store) VoteRecords(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) ([]*VoteRecord, error) {
+	var records []*VoteRecord
+	c := ns.NestedReadBucket(bucketVoteRecords).ReadPrefixCursor(ticketHash[:])
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		r, err := readVoteRecord(k, v)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// ForEachVoteRecord calls f with the record of each vote created by the
+// wallet, breaking early on error.
+func (s *Store) ForEachVoteRecord(ns walletdb.ReadBucket, f func(*VoteRecord) error) error {
+	return ns.NestedReadBucket(bucketVoteRecords).ForEach(func(k, v []byte) error {
+		r, err := readVoteRecord(k, v)
+		if err != nil {
+			return err
+		}
+		return f(r)
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+)
+
+func TestVoteRecordSerialization(t *testing.T) {
+	r := &VoteRecord{
+		TicketHash:   chainhash.Hash{1},
+		VoteHash:     chainhash.Hash{2},
+		BlockHash:    chainhash.Hash{3},
+		BlockHeight:  4096,
+		Created:      time.Unix(1559390400, 0),
+		VoteBits:     0x0005,
+		ExtendedBits: []byte{6, 0, 0, 0},
+		Choices: []VoteChoice{
+			{AgendaID: "sdiffalgorithm", ChoiceID: "yes"},
+			{AgendaID: "lnsupport", ChoiceID: "abstain"},
+		},
+	}
+	v, err := valueVoteRecord(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := append(r.TicketHash[:], r.VoteHash[:]...)
+	got, err := readVoteRecord(k, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, r) {
+		t.Fatalf("vote record round trip: got %+v, want %+v", got, r)
+	}
+
+	// Truncated records are rejected.
+	for i := voteRecordMinSize; i < len(v); i++ {
+		if _, err := readVoteRecord(k, v[:i]); err == nil {
+			t.Fatalf("read vote record truncated to %d bytes", i)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// VSPTicketStatus describes the progress of registering a ticket with a voting
+// service provider.
+type VSPTicketStatus byte
+
+// Statuses of tickets managed by a voting service provider.
+const (
+	// VSPFeeUnpaid describes tickets purchased for a VSP whose fee has not
+	// been paid.
+	VSPFeeUnpaid VSPTicketStatus = iota
+
+	// VSPFeePublished describes tickets whose fee transaction has been
+	// published but has not been accepted by the VSP.
+	VSPFeePublished
+
+	// VSPRegistered describes tickets whose fee was accepted by the VSP,
+	// which votes with the ticket.
+	VSPRegistered
+
+	// VSPFeeSigned describes tickets whose fee transaction has been
+	// signed and recorded but may not have been published.  It follows
+	// the other statuses to preserve their recorded values.
+	VSPFeeSigned
+)
+
+// String returns the status name.
+func (s VSPTicketStatus) String() string {
+	switch s {
+	case VSPFeeUnpaid:
+		return "unpaid"
+	case VSPFeePublished:
+		return "published"
+	case VSPRegistered:
+		return "registered"
+	case VSPFeeSigned:
+		return "signed"
+	default:
+		return "unknown"
+	}
+}
+
+// VSPTicket records the registration of a ticket with a voting service
+// provider.
+type VSPTicket struct {
+	TicketHash      chainhash.Hash
+	Host            string
+	Status          VSPTicketStatus
+	FeeAccount      uint32
+	FeeAddress      string
+	FeeAddressIndex uint32
+	FeeAmount       int64
+	FeeTxHash       chainhash.Hash
+}
+
+// The VSP tickets bucket is keyed by ticket hash and records values with the
+// following format:
+//
+//   [0]     Status (1 byte)
+//   [1:5]   Account paying the fee (4 bytes)
+//   [5:9]   Fee address index (4 bytes)
+//   [9:17]  Fee amount (8 bytes)
+//   [17:49] Fee transaction hash (32 bytes)
+//   [49]    Host length (1 byte)
+//   [50:N]  Host
+//   [N]     Fee address length (1 byte)
+//   [N+1:]  Fee address
+const vspTicketMinSize = 51
+
+func valueVSPTicket(t *VSPTicket) ([]byte, error) {
+	if len(t.Host) > 0xff || len(t.FeeAddress) > 0xff {
+		return nil, errors.E(errors.Invalid, "VSP ticket record is too large")
+	}
+	v := make([]byte, 50, vspTicketMinSize+len(t.Host)+len(t.FeeAddress))
+	v[0] = byte(t.Status)
+	byteOrder.PutUint32(v[1:5], t.FeeAccount)
+	byteOrder.PutUint32(v[5:9], t.FeeAddressIndex)
+	byteOrder.PutUint64(v[9:17], uint64(t.FeeAmount))
+	copy(v[17:49], t.FeeTxHash[:])
+	v[49] = byte(len(t.Host))
+	v = append(v, t.Host...)
+	v = append(v, byte(len(t.FeeAddress)))
+	v = append(v, t.FeeAddress...)
+	return v, nil
+}
+
+func readVSPTicket(k, v []byte) (*VSPTicket, error) {
+	if len(k) != chainhash.HashSize || len(v) < vspTicketMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("VSP ticket key len %d value len %d", len(k), len(v)))
+	}
+	t := new(VSPTicket)
+	copy(t.TicketHash[:], k)
+	t.Status = VSPTicketStatus(v[0])
+	t.FeeAccount = byteOrder.Uint32(v[1:5])
+	t.FeeAddressIndex = byteOrder.Uint32(v[5:9])
+	t.FeeAmount = int64(byteOrder.Uint64(v[9:17]))
+	copy(t.FeeTxHash[:], v[17:49])
+	hostLen := int(v[49])
+	v = v[50:]
+	if len(v) < hostLen+1 || len(v) != hostLen+1+int(v[hostLen]) {
+		return nil, errors.E(errors.IO, "short VSP ticket record")
+	}
+	t.Host = string(v[:hostLen])
+	t.FeeAddress = string(v[hostLen+1:])
+	return t, nil
+}
+
+// PutVSPTicket records the registration of a ticket with a voting service
+// provider, replacing any previous record of the ticket.
+func (s *Store) PutVSPTicket(ns walletdb.ReadWriteBucket, t *VSPTicket) error {
+	v, err := valueVSPTicket(t)
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadWriteBucket(bucketVSPTickets).Put(t.TicketHash[:], v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// VSPTicket returns the VSP registration record of a ticket.  Tickets which
+// are not managed by a VSP return an errors.NotExist error.
+func (s *Store) VSPTicket(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) (*VSPTicket, error) {
+	v := ns.NestedReadBucket(bucketVSPTickets).Get(ticketHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("ticket %v is not managed by a VSP", ticketHash))
+	}
+	return readVSPTicket(ticketHash[:], v)
+}
+
+// ForEachVSPTicket calls f with the record of each ticket managed by a voting
+// service provider, breaking early on error.
+func (s *Store) ForEachVSPTicket(ns walletdb.ReadBucket, f func(*VSPTicket) error) error {
+	return ns.NestedReadBucket(bucketVSPTickets).ForEach(func(k, v []byte) error {
+		t, err := readVSPTicket(k, v)
+		if err != nil {
+			return err
+		}
+		return f(t)
+	})
+}
+
+// The selected VSP is recorded in the root bucket with the following format:
+//
+//   [0]     Host length (1 byte)
+//   [1:N]   Host
+//   [N:]    Ed25519 public key of the VSP (32 bytes)
+
+// SetVSP records the host and public key of the voting service provider which
+// tickets are purchased for.  An empty host clears the selected VSP.
+func (s *Store) SetVSP(ns walletdb.ReadWriteBucket, host string, pubKey []byte) error {
+	if host == "" {
+		err := ns.Delete(rootVSP)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		return nil
+	}
+	if len(host) > 0xff {
+		return errors.E(errors.Invalid, "VSP host is too long")
+	}
+	v := make([]byte, 0, 1+len(host)+len(pubKey))
+	v = append(v, byte(len(host)))
+	v = append(v, host...)
+	v = append(v, pubKey...)
+	err := ns.Put(rootVSP, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// VSP returns the host and public key of the selected voting service provider.
+// An errors.NotExist error is returned when no VSP is selected.
+func (s *Store) VSP(ns walletdb.ReadBucket) (host string, pubKey []byte, err error) {
+	v := ns.Get(rootVSP)
+	if v == nil {
+		return "", nil, errors.E(errors.NotExist, "no VSP is selected")
+	}
+	if len(v) < 1 || len(v) < 1+int(v[0]) {
+		return "", nil, errors.E(errors.IO, "short VSP record")
+	}
+	host = string(v[1 : 1+int(v[0])])
+	pubKey = append([]byte(nil), v[1+int(v[0]):]...)
+	return host, pubKey, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestVSPTicketSerialization(t *testing.T) {
+	r := &VSPTicket{
+		TicketHash:      chainhash.Hash{1},
+		Host:            "https://vsp.example.org",
+		Status:          VSPFeePublished,
+		FeeAccount:      1,
+		FeeAddress:      "SsWKp7wtdTZYabYFYSc9cnxhwFEjA5g4pFc",
+		FeeAddressIndex: 42,
+		FeeAmount:       1e6,
+		FeeTxHash:       chainhash.Hash{2},
+	}
+	v, err := valueVSPTicket(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := readVSPTicket(r.TicketHash[:], v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, r) {
+		t.Fatalf("VSP ticket round trip: got %+v, want %+v", got, r)
+	}
+
+	// Truncated records are rejected.
+	for i := vspTicketMinSize; i < len(v); i++ {
+		if _, err := readVSPTicket(r.TicketHash[:], v[:i]); err == nil {
+			t.Fatalf("read VSP ticket truncated to %d bytes", i)
+		}
+	}
+}
+
+func TestSelectedVSP(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubKey := bytes.Repeat([]byte{7}, 32)
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		if _, _, err := s.VSP(ns); !errors.Is(errors.NotExist, err) {
+			t.Errorf("VSP of new database: %v", err)
+		}
+		err := s.SetVSP(ns, "https://vsp.example.org", pubKey)
+		if err != nil {
+			return err
+		}
+		host, pk, err := s.VSP(ns)
+		if err != nil {
+			return err
+		}
+		if host != "https://vsp.example.org" || !bytes.Equal(pk, pubKey) {
+			t.Errorf("selected VSP is %q %x", host, pk)
+		}
+		err = s.SetVSP(ns, "", nil)
+		if err != nil {
+			return err
+		}
+		if _, _, err := s.VSP(ns); !errors.Is(errors.NotExist, err) {
+			t.Errorf("VSP after clearing: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// WatchedOutPoint describes an outpoint watched by the wallet without being
+// owned by it.  Amount and PkScript are only known for outpoints of outputs
+// paying watched scripts and are otherwise zero.
+type WatchedOutPoint struct {
+	OutPoint wire.OutPoint
+	Amount   vhcutil.Amount
+	PkScript []byte
+	Added    time.Time
+}
+
+// The watched scripts bucket is keyed by output script and records the unix
+// time (8 bytes) the script was watched.
+//
+// The watched outpoints bucket is keyed by canonical outpoint and records
+// values with the following format:
+//
+//   [0:8]   Watch time (8 bytes, unix seconds)
+//   [8:16]  Output amount (8 bytes, zero when unknown)
+//   [16]    Transaction tree (1 byte)
+//   [17:]   Output script (empty when unknown)
+const watchedOutPointMinSize = 17
+
+func valueWatchedOutPoint(w *WatchedOutPoint) []byte {
+	v := make([]byte, watchedOutPointMinSize+len(w.PkScript))
+	byteOrder.PutUint64(v[0:8], uint64(w.Added.Unix()))
+	byteOrder.PutUint64(v[8:16], uint64(w.Amount))
+	v[16] = byte(w.OutPoint.Tree)
+	copy(v[17:], w.PkScript)
+	return v
+}
+
+func readWatchedOutPoint(k, v []byte) (*WatchedOutPoint, error) {
+	if len(v) < watchedOutPointMinSize {
+		return nil, errors.E(errors.IO, errors.Errorf("watched outpoint len %d", len(v)))
+	}
+	w := &WatchedOutPoint{
+		Amount:   vhcutil.Amount(byteOrder.Uint64(v[8:16])),
+		PkScript: append([]byte(nil), v[17:]...),
+		Added:    time.Unix(int64(byteOrder.Uint64(v[0:8])), 0),
+	}
+	err := readCanonicalOutPoint(k, &w.OutPoint)
+	if err != nil {
+		return nil, err
+	}
+	w.OutPoint.Tree = int8(v[16])
+	return w, nil
+}
+
+// WatchScript records an output script whose activity is recorded by the
+// wallet.  Scripts which are already watched return an errors.Exist error.
+func (s *Store) WatchScript(ns walletdb.ReadWriteBucket, pkScript []byte) error {
+	if len(pkScript) == 0 {
+		return errors.E(errors.Invalid, "empty script")
+	}
+	b := ns.NestedReadWriteBucket(bucketWatchedScripts)
+	if b.Get(pkScript) != nil {
+		return errors.E(errors.Exist, errors.Errorf("script %x is already watched", pkScript))
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(time.Now().Unix()))
+	err := b.Put(pkScript, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// IsWatchedScript returns whether an output script is watched.
+func (s *Store) IsWatchedScript(ns walletdb.ReadBucket, pkScript []byte) bool {
+	return len(pkScript) != 0 && ns.NestedReadBucket(bucketWatchedScripts).Get(pkScript) != nil
+}
+
+// WatchedScripts returns every watched output script.
+func (s *Store) WatchedScripts(ns walletdb.ReadBucket) ([][]byte, error) {
+	var scripts [][]byte
+	err := ns.NestedReadBucket(bucketWatchedScripts).ForEach(func(k, v []byte) error {
+		scripts = append(scripts, append([]byte(nil), k...))
+		return nil
+	})
+	return scripts, err
+}
+
+// WatchOutPoint records an outpoint whose spending transaction is recorded by
+// the wallet.  Outpoints which are already watched return an errors.Exist
+// error.
+func (s *Store) WatchOutPoint(ns walletdb.ReadWriteBucket, w *WatchedOutPoint) error {
+	k := canonicalOutPoint(&w.OutPoint.Hash, w.OutPoint.Index)
+	b := ns.NestedReadWriteBucket(bucketWatchedOutPoints)
+	if b.Get(k) != nil {
+		return errors.E(errors.Exist, errors.Errorf("outpoint %v is already watched", &w.OutPoint))
+	}
+	if w.Added.IsZero() {
+		w2 := *w
+		w2.Added = time.Now()
+		w = &w2
+	}
+	err := b.Put(k, valueWatchedOutPoint(w))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// WatchedOutPoint returns the watched outpoint op.  Outpoints which are not
+// watched return an errors.NotExist error.
+func (s *Store) WatchedOutPoint(ns walletdb.ReadBucket, op *wire.OutPoint) (*WatchedOutPoint, error) {
+	k := canonicalOutPoint(&op.Hash, op.Index)
+	v := ns.NestedReadBucket(bucketWatchedOutPoints).Get(k)
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("outpoint %v is not watched", op))
+	}
+	return readWatchedOutPoint(k, v)
+}
+
+// WatchedOutPoints returns every watched outpoint.
+func (s *Store) WatchedOutPoints(ns walletdb.ReadBucket) ([]*WatchedOutPoint, error) {
+	var ops []*WatchedOutPoint
+	err := ns.NestedReadBucket(bucketWatchedOutPoints).ForEach(func(k, v []byte) error {
+		w, err := readWatchedOutPoint(k, v)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, w)
+		return nil
+	})
+	return ops, err
+}
+
+// WatchTxOutputs watches the outpoints of the outputs of a transaction which
+// pay watched scripts so that their spends are recorded as well.  The newly
+// watched outpoints are returned.
+func (s *Store) WatchTxOutputs(ns walletdb.ReadWriteBucket, rec *TxRecord) ([]wire.OutPoint, error) {
+	var watched []wire.OutPoint
+	tree := wire.TxTreeRegular
+	if rec.TxType != stake.TxTypeRegular {
+		tree = wire.TxTreeStake
+	}
+	for i, output := range rec.MsgTx.TxOut {
+		if !s.IsWatchedScript(ns, output.PkScript) {
+			continue
+		}
+		w := &WatchedOutPoint{
+			OutPoint: wire.OutPoint{Hash: rec.Hash, Index: uint32(i), Tree: tree},
+			Amount:   vhcutil.Amount(output.Value),
+			PkScript: output.PkScript,
+			Added:    rec.Received,
+		}
+		err := s.WatchOutPoint(ns, w)
+		if errors.Is(errors.Exist, err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		watched = append(watched, w.OutPoint)
+	}
+	return watched, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// UTXO policies of outputs which are not selected as inputs of sends and
+// ticket purchases.  Outputs with the do-not-spend policy are never selected.
+const (
+	sendExcludedUTXOPolicies   = udb.UTXOPolicyReserveForTickets | udb.UTXOPolicyDust
+	ticketExcludedUTXOPolicies = udb.UTXOPolicyDust
+)
+
+// SetUTXOPolicy sets the spend policy of an unspent output of the wallet,
+// replacing any previous policy.  The policy is saved across wallet restarts
+// and is respected by input selection for sends, ticket purchases, and account
+// sweeps.  Setting the zero policy removes the restrictions on the output and
+// is allowed for outputs which are no longer unspent.
+func (w *Wallet) SetUTXOPolicy(outPoint *wire.OutPoint, policy udb.UTXOPolicy) error {
+	const op errors.Op = "wallet.SetUTXOPolicy"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if policy != 0 && !w.TxStore.ExistsUTXO(dbtx, outPoint) {
+			return errors.E(errors.NotExist,
+				errors.Errorf("no unspent output %v", outPoint))
+		}
+		return w.TxStore.SetUTXOPolicy(txmgrNs, outPoint, policy)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// UTXOPolicy returns the spend policy of an output.  The zero policy is
+// returned for outputs without a policy.
+func (w *Wallet) UTXOPolicy(outPoint *wire.OutPoint) (udb.UTXOPolicy, error) {
+	const op errors.Op = "wallet.UTXOPolicy"
+	var policy udb.UTXOPolicy
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		policy = w.TxStore.UTXOPolicy(txmgrNs, outPoint)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return policy, nil
+}
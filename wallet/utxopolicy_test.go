@@ -0,0 +1,103 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/internal/txsizes"
+	"github.com/valhallacoin/vhcwallet/wallet/txauthor"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+func TestUTXOPolicy(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 2e8, 3e8, 4e8)
+	w.Lock()
+
+	dest := &sweepDestination{script: make([]byte, txsizes.P2PKHPkScriptSize)}
+	sweeps, err := w.NewUnsignedSweeps(0, 1, w.RelayFee(), dest, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outPoints := make(map[vhcutil.Amount]wire.OutPoint)
+	for _, in := range sweeps[0].Tx.TxIn {
+		outPoints[vhcutil.Amount(in.ValueIn)] = in.PreviousOutPoint
+	}
+	policies := map[vhcutil.Amount]udb.UTXOPolicy{
+		1e8: udb.UTXOPolicyDoNotSpend,
+		2e8: udb.UTXOPolicyReserveForTickets,
+		3e8: udb.UTXOPolicyDust,
+	}
+	for amount, policy := range policies {
+		op := outPoints[amount]
+		if err := w.SetUTXOPolicy(&op, policy); err != nil {
+			t.Fatal(err)
+		}
+		if p, err := w.UTXOPolicy(&op); p != policy || err != nil {
+			t.Fatalf("policy %v recorded as %v: %v", policy, p, err)
+		}
+	}
+	err = w.SetUTXOPolicy(&wire.OutPoint{Hash: chainhash.Hash{1}}, udb.UTXOPolicyDust)
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("expected NotExist error, got %v", err)
+	}
+
+	selected := func(atx *txauthor.AuthoredTx) vhcutil.Amount {
+		var total vhcutil.Amount
+		for _, in := range atx.Tx.TxIn {
+			total += vhcutil.Amount(in.ValueIn)
+		}
+		return total
+	}
+	send, err := w.NewUnsignedTransaction(nil, w.RelayFee(), 0, 1,
+		OutputSelectionAlgorithmAll, dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := selected(send); v != 4e8 {
+		t.Errorf("send selected %v", v)
+	}
+	const op errors.Op = "test"
+	split, err := w.newUnsignedTransaction(op, nil, w.RelayFee(), 0, 1,
+		OutputSelectionAlgorithmAll, dest, ticketExcludedUTXOPolicies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := selected(split); v != 6e8 {
+		t.Errorf("ticket purchase selected %v", v)
+	}
+	sweeps, err = w.NewUnsignedSweeps(0, 1, w.RelayFee(), dest, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := selected(sweeps[0]); len(sweeps) != 1 || v != 7e8 {
+		t.Errorf("sweep selected %v", v)
+	}
+
+	// Removing the policy allows the output to be spent by sends.
+	op1 := outPoints[1e8]
+	if err := w.SetUTXOPolicy(&op1, 0); err != nil {
+		t.Fatal(err)
+	}
+	send, err = w.NewUnsignedTransaction(nil, w.RelayFee(), 0, 1,
+		OutputSelectionAlgorithmAll, dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := selected(send); v != 5e8 {
+		t.Errorf("send selected %v after removing policy", v)
+	}
+}
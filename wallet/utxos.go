@@ -119,7 +119,7 @@ func (w *Wallet) SelectInputs(targetAmount vhcutil.Amount, policy OutputSelectio
 		}
 
 		sourceImpl := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, policy.Account,
-			policy.RequiredConfirmations, tipHeight)
+			policy.RequiredConfirmations, tipHeight, sendExcludedUTXOPolicies)
 		var err error
 		inputDetail, err = sourceImpl.SelectInputs(targetAmount)
 		return err
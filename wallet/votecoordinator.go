@@ -0,0 +1,284 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+// VoteCoordinator coordinates redundant wallets holding the same voting keys
+// so that exactly one of them creates and publishes each vote.
+type VoteCoordinator interface {
+	// ClaimVote returns whether the vote of a ticket on a block was leased
+	// to this wallet, in which case it must create and publish the vote,
+	// and then confirm or release it.  When the vote is leased to another
+	// wallet, the time that its lease expires is returned, after which the
+	// vote may be claimed again.  The zero time is returned when the vote
+	// was published by another wallet.
+	ClaimVote(blockHash, ticketHash *chainhash.Hash) (claimed bool, leaseExpiry time.Time, err error)
+
+	// ConfirmVote records that a claimed vote was published so that it is
+	// never taken over by another wallet.
+	ConfirmVote(blockHash, ticketHash *chainhash.Hash) error
+
+	// ReleaseVote releases a claimed vote which could not be created or
+	// published.
+	ReleaseVote(blockHash, ticketHash *chainhash.Hash) error
+}
+
+const (
+	// voteClaimPruneAge is the age of vote claims removed by a
+	// FileVoteCoordinator.  Votes are only valid for the block after the
+	// block voted on, so older claims are never checked again.
+	voteClaimPruneAge = 24 * time.Hour
+
+	// voteClaimPruneInterval is the minimum duration between removals of
+	// old vote claims.
+	voteClaimPruneInterval = time.Hour
+
+	// voteClaimPublished is written to claim files of published votes.
+	voteClaimPublished = "published"
+)
+
+// FileVoteCoordinator is a VoteCoordinator which claims votes by exclusively
+// creating files in a directory shared by every coordinated wallet, e.g. over
+// NFS.  The file system must support atomic exclusive file creation and
+// rename.  A claim is leased to the wallet which created its file until the
+// file is older than the lease duration, unless the vote was confirmed.
+type FileVoteCoordinator struct {
+	dir      string
+	instance string
+	lease    time.Duration
+
+	mu         sync.Mutex
+	lastPruned time.Time
+}
+
+// NewFileVoteCoordinator creates the directory of vote claims if necessary and
+// returns a FileVoteCoordinator claiming votes in it for the lease duration.
+// The instance name is written to each claim to identify the wallet which
+// created the vote.
+func NewFileVoteCoordinator(dir, instance string, lease time.Duration) (*FileVoteCoordinator, error) {
+	const op errors.Op = "wallet.NewFileVoteCoordinator"
+	if lease <= 0 {
+		return nil, errors.E(op, errors.Invalid, "vote claim lease must be positive")
+	}
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	return &FileVoteCoordinator{dir: dir, instance: instance, lease: lease}, nil
+}
+
+func (c *FileVoteCoordinator) claimPath(blockHash, ticketHash *chainhash.Hash) string {
+	return filepath.Join(c.dir, blockHash.String()+"-"+ticketHash.String())
+}
+
+// ClaimVote implements the VoteCoordinator interface by exclusively creating
+// the claim file of the vote.  Claim files of unconfirmed votes with expired
+// leases are renamed away before the vote is claimed again, so that only one
+// wallet takes over the vote.
+func (c *FileVoteCoordinator) ClaimVote(blockHash, ticketHash *chainhash.Hash) (bool, time.Time, error) {
+	const op errors.Op = "wallet.FileVoteCoordinator.ClaimVote"
+	c.prune()
+	path := c.claimPath(blockHash, ticketHash)
+	// A few attempts are made in case the claim file is concurrently
+	// removed or taken over by other wallets.
+	for i := 0; i < 3; i++ {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			_, err = fmt.Fprintf(f, "%s %s\n", c.instance,
+				time.Now().UTC().Format(time.RFC3339))
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				// The claim file was created, so the vote is claimed
+				// even if the instance could not be recorded.
+				log.Warnf("Failed to record vote claim of ticket %v: %v",
+					ticketHash, err)
+			}
+			return true, time.Time{}, nil
+		}
+		if !os.IsExist(err) {
+			return false, time.Time{}, errors.E(op, errors.IO, err)
+		}
+
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return false, time.Time{}, errors.E(op, errors.IO, err)
+		}
+		claim, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return false, time.Time{}, errors.E(op, errors.IO, err)
+		}
+		fields := strings.Fields(string(claim))
+		if len(fields) == 2 && fields[1] == voteClaimPublished {
+			return false, time.Time{}, nil
+		}
+		expiry := info.ModTime().Add(c.lease)
+		if time.Now().Before(expiry) {
+			return false, expiry, nil
+		}
+
+		// Take over the expired lease.  Renaming fails for every wallet
+		// but one when several take over the lease at once.
+		expired := path + ".expired-" + c.instance
+		err = os.Rename(path, expired)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return false, time.Time{}, errors.E(op, errors.IO, err)
+		}
+		log.Infof("Taking over expired vote claim of ticket %v on block %v",
+			ticketHash, blockHash)
+		err = os.Remove(expired)
+		if err != nil {
+			log.Warnf("Failed to remove expired vote claim: %v", err)
+		}
+	}
+	return false, time.Now().Add(c.lease), nil
+}
+
+// ConfirmVote implements the VoteCoordinator interface by replacing the claim
+// file of the vote with a claim that never expires.
+func (c *FileVoteCoordinator) ConfirmVote(blockHash, ticketHash *chainhash.Hash) error {
+	const op errors.Op = "wallet.FileVoteCoordinator.ConfirmVote"
+	path := c.claimPath(blockHash, ticketHash)
+	tmp := path + ".confirm-" + c.instance
+	claim := fmt.Sprintf("%s %s\n", c.instance, voteClaimPublished)
+	err := ioutil.WriteFile(tmp, []byte(claim), 0600)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		os.Remove(tmp)
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// ReleaseVote implements the VoteCoordinator interface by removing the claim
+// file of the vote.
+func (c *FileVoteCoordinator) ReleaseVote(blockHash, ticketHash *chainhash.Hash) error {
+	const op errors.Op = "wallet.FileVoteCoordinator.ReleaseVote"
+	err := os.Remove(c.claimPath(blockHash, ticketHash))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// prune removes old vote claims if they were not recently removed.
+func (c *FileVoteCoordinator) prune() {
+	c.mu.Lock()
+	if time.Since(c.lastPruned) < voteClaimPruneInterval {
+		c.mu.Unlock()
+		return
+	}
+	c.lastPruned = time.Now()
+	c.mu.Unlock()
+
+	infos, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		log.Warnf("Failed to read vote claims: %v", err)
+		return
+	}
+	for _, info := range infos {
+		if info.IsDir() || time.Since(info.ModTime()) < voteClaimPruneAge {
+			continue
+		}
+		err := os.Remove(filepath.Join(c.dir, info.Name()))
+		if err != nil && !os.IsNotExist(err) {
+			log.Warnf("Failed to remove vote claim %v: %v", info.Name(), err)
+		}
+	}
+}
+
+// claimVote returns whether the wallet should create the vote of a ticket on a
+// block.  Every vote is created when the wallet is not coordinated with
+// redundant voting wallets.  Votes are also created when the coordinator can
+// not be reached, as a conflicting vote of a redundant wallet is only rejected
+// by the network, while a vote which no wallet creates is missed.  When the
+// vote is leased to another wallet, the vote is claimed again after the lease
+// expires in case the other wallet did not publish it.
+func (w *Wallet) claimVote(blockHash *chainhash.Hash, blockHeight int32, ticketHash *chainhash.Hash) bool {
+	if w.voteCoordinator == nil {
+		return true
+	}
+	claimed, expiry, err := w.voteCoordinator.ClaimVote(blockHash, ticketHash)
+	if err != nil {
+		log.Errorf("Failed to claim vote of ticket %v on block %v, voting "+
+			"without coordination: %v", ticketHash, blockHash, err)
+		return true
+	}
+	if claimed {
+		return true
+	}
+	log.Debugf("Vote of ticket %v on block %v was claimed by another "+
+		"wallet", ticketHash, blockHash)
+	if !expiry.IsZero() {
+		time.AfterFunc(time.Until(expiry), func() {
+			w.retryVote(blockHash, blockHeight, ticketHash)
+		})
+	}
+	return false
+}
+
+// retryVote votes with a ticket whose vote was leased to another wallet when
+// the block voted on is still the main chain tip.
+func (w *Wallet) retryVote(blockHash *chainhash.Hash, blockHeight int32, ticketHash *chainhash.Hash) {
+	tipHash, _ := w.MainChainTip()
+	if tipHash != *blockHash {
+		return
+	}
+	err := w.voteOnOwnedTickets([]*chainhash.Hash{ticketHash}, blockHash, blockHeight)
+	if err != nil {
+		log.Errorf("Failed to vote with ticket %v on block %v: %v",
+			ticketHash, blockHash, err)
+	}
+}
+
+// confirmVote records that a vote claimed by claimVote was published.
+func (w *Wallet) confirmVote(blockHash, ticketHash *chainhash.Hash) {
+	if w.voteCoordinator == nil {
+		return
+	}
+	err := w.voteCoordinator.ConfirmVote(blockHash, ticketHash)
+	if err != nil {
+		log.Errorf("Failed to confirm vote of ticket %v on block %v: %v",
+			ticketHash, blockHash, err)
+	}
+}
+
+// releaseVote releases a vote claimed by claimVote which could not be created
+// or published so that it may be created by a redundant voting wallet.
+func (w *Wallet) releaseVote(blockHash, ticketHash *chainhash.Hash) {
+	if w.voteCoordinator == nil {
+		return
+	}
+	err := w.voteCoordinator.ReleaseVote(blockHash, ticketHash)
+	if err != nil {
+		log.Errorf("Failed to release vote of ticket %v on block %v: %v",
+			ticketHash, blockHash, err)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcwallet/errors"
+)
+
+func TestFileVoteCoordinator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vhcwallet.votes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dir = filepath.Join(dir, "claims")
+
+	a, err := NewFileVoteCoordinator(dir, "a", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewFileVoteCoordinator(dir, "b", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := &chainhash.Hash{1}
+	tickets := []*chainhash.Hash{{2}, {3}}
+	claim := func(c *FileVoteCoordinator, ticket *chainhash.Hash, want bool) {
+		t.Helper()
+		claimed, _, err := c.ClaimVote(block, ticket)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if claimed != want {
+			t.Fatalf("%s claimed vote of ticket %v: %v, want %v", c.instance,
+				ticket, claimed, want)
+		}
+	}
+
+	// Each vote is claimed by exactly one wallet.
+	claim(a, tickets[0], true)
+	claim(b, tickets[0], false)
+	claim(a, tickets[0], false)
+	claim(b, tickets[1], true)
+	claim(a, tickets[1], false)
+
+	// Released votes may be claimed by another wallet.
+	err = a.ReleaseVote(block, tickets[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	claim(b, tickets[0], true)
+
+	// Releasing an unclaimed vote is not an error.
+	err = a.ReleaseVote(&chainhash.Hash{4}, tickets[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Votes leased to another wallet report the expiry of the lease.
+	_, expiry, err := a.ClaimVote(block, tickets[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(expiry); d <= 0 || d > time.Hour {
+		t.Fatalf("lease expires in %v", d)
+	}
+
+	// Expired leases of unpublished votes are taken over by exactly one
+	// wallet, and confirmed votes are never taken over.
+	err = a.ConfirmVote(block, tickets[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-2 * time.Hour)
+	for _, ticket := range tickets {
+		err := os.Chtimes(a.claimPath(block, ticket), past, past)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	claim(a, tickets[1], true)
+	claim(b, tickets[1], false)
+	_, expiry, err = b.ClaimVote(block, tickets[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !expiry.IsZero() {
+		t.Fatalf("confirmed vote lease expires at %v", expiry)
+	}
+	claim(b, tickets[0], false)
+}
+
+// failVoteCoordinator fails to claim votes.
+type failVoteCoordinator struct{}
+
+func (failVoteCoordinator) ClaimVote(blockHash, ticketHash *chainhash.Hash) (bool, time.Time, error) {
+	return false, time.Time{}, errors.E(errors.IO, "coordinator unreachable")
+}
+
+func (failVoteCoordinator) ConfirmVote(blockHash, ticketHash *chainhash.Hash) error {
+	return errors.E(errors.IO, "coordinator unreachable")
+}
+
+func (failVoteCoordinator) ReleaseVote(blockHash, ticketHash *chainhash.Hash) error {
+	return errors.E(errors.IO, "coordinator unreachable")
+}
+
+func TestClaimVoteUnreachable(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.VoteCoordinator = failVoteCoordinator{}
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	// Votes are created without coordination when the coordinator can not
+	// be reached.
+	if !w.claimVote(&chainhash.Hash{1}, 1, &chainhash.Hash{2}) {
+		t.Fatal("vote was not created with an unreachable coordinator")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/valhallacoin/vhcd/blockchain/stake"
+	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// voteChoices decodes the agenda choices cast by vote bits using the agendas
+// of the vote version.  Agendas with vote bits not matching any choice are
+// omitted.
+func voteChoices(params *chaincfg.Params, version uint32, voteBits uint16) []udb.VoteChoice {
+	if params.Deployments == nil {
+		return nil
+	}
+	deployments := params.Deployments[version]
+	choices := make([]udb.VoteChoice, 0, len(deployments))
+	for i := range deployments {
+		agenda := &deployments[i].Vote
+		bits := voteBits & agenda.Mask
+		for j := range agenda.Choices {
+			if agenda.Choices[j].Bits == bits {
+				choices = append(choices, udb.VoteChoice{
+					AgendaID: agenda.Id,
+					ChoiceID: agenda.Choices[j].Id,
+				})
+				break
+			}
+		}
+	}
+	return choices
+}
+
+// newVoteRecord creates the record of the vote bits and agenda choices cast by
+// a vote transaction.
+func newVoteRecord(params *chaincfg.Params, vote *wire.MsgTx, created time.Time) *udb.VoteRecord {
+	blockHash, blockHeight := stake.SSGenBlockVotedOn(vote)
+	voteBits := stake.SSGenVoteBits(vote)
+	return &udb.VoteRecord{
+		TicketHash:   vote.TxIn[1].PreviousOutPoint.Hash,
+		VoteHash:     vote.TxHash(),
+		BlockHash:    blockHash,
+		BlockHeight:  int32(blockHeight),
+		Created:      created,
+		VoteBits:     voteBits,
+		ExtendedBits: append([]byte(nil), vote.TxOut[1].PkScript[4:]...),
+		Choices:      voteChoices(params, stake.SSGenVersion(vote), voteBits),
+	}
+}
+
+// recordVote records the vote bits and agenda choices of a vote created by
+// the wallet.
+func (w *Wallet) recordVote(dbtx walletdb.ReadWriteTx, vote *wire.MsgTx, created time.Time) error {
+	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+	return w.TxStore.PutVoteRecord(txmgrNs, newVoteRecord(w.chainParams, vote, created))
+}
+
+// VoteRecords returns the records of the votes created by the wallet for a
+// ticket, ordered by the height of the block voted on.
+func (w *Wallet) VoteRecords(ticketHash *chainhash.Hash) ([]*udb.VoteRecord, error) {
+	const op errors.Op = "wallet.VoteRecords"
+	var records []*udb.VoteRecord
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		records, err = w.TxStore.VoteRecords(txmgrNs, ticketHash)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].BlockHeight < records[j].BlockHeight
+	})
+	return records, nil
+}
+
+// StakeHistory returns the records of all votes created by the wallet, ordered
+// by the height of the block voted on.
+func (w *Wallet) StakeHistory() ([]*udb.VoteRecord, error) {
+	const op errors.Op = "wallet.StakeHistory"
+	var records []*udb.VoteRecord
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachVoteRecord(txmgrNs, func(r *udb.VoteRecord) error {
+			records = append(records, r)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].BlockHeight < records[j].BlockHeight
+	})
+	return records, nil
+}
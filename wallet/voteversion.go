@@ -0,0 +1,142 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// voteVersionCheckBlocks is the number of recent main chain block headers
+// whose stake versions are compared with the vote version of the wallet.
+// Blocks are rejected once their stake version is older than the enforced
+// stake version, so the lowest version of the recent headers is a lower bound
+// of the version enforced by the network.
+const voteVersionCheckBlocks = 16
+
+// VoteVersionStatus describes whether the votes cast by the wallet are
+// compatible with the stake version observed in recent main chain headers.
+type VoteVersionStatus struct {
+	// VoteVersion is the vote version of votes cast by the wallet.
+	VoteVersion uint32
+
+	// ChainStakeVersion is the lowest stake version of the recent main
+	// chain block headers, or zero if no blocks have been synced.
+	ChainStakeVersion uint32
+
+	// Outdated is true when the network has upgraded to a stake version
+	// newer than the vote version of the wallet.  Votes cast by the wallet
+	// do not vote on the agendas of the newer version.
+	Outdated bool
+
+	// Choices are the non-abstaining agenda choices of the wallet's vote
+	// version, which are cast with the outdated version when Outdated is
+	// true.
+	Choices []AgendaChoice
+}
+
+// Warning describes the outdated votes cast by the wallet, or is empty when
+// the votes are compatible with the network.
+func (s *VoteVersionStatus) Warning() string {
+	if !s.Outdated {
+		return ""
+	}
+	msg := fmt.Sprintf("Wallet votes with outdated vote version %d while "+
+		"the network stake version is %d; upgrade the wallet to vote on "+
+		"the current agendas", s.VoteVersion, s.ChainStakeVersion)
+	if len(s.Choices) != 0 {
+		choices := make([]string, len(s.Choices))
+		for i := range s.Choices {
+			choices[i] = s.Choices[i].AgendaID + "=" + s.Choices[i].ChoiceID
+		}
+		msg += fmt.Sprintf(" (outdated agenda choices: %s)",
+			strings.Join(choices, ", "))
+	}
+	return msg
+}
+
+// VoteVersionStatus returns the result of the most recent comparison of the
+// wallet's vote version and agenda choices against the stake version of the
+// network.  The comparison is performed when the wallet is opened and each
+// time the main chain is extended.
+func (w *Wallet) VoteVersionStatus() VoteVersionStatus {
+	w.voteVersionMu.Lock()
+	s := w.voteVersionStatus
+	w.voteVersionMu.Unlock()
+	return s
+}
+
+// readVoteVersionStatus compares the vote version and agenda choices of the
+// wallet with the stake versions of the most recent main chain headers.
+func (w *Wallet) readVoteVersionStatus(dbtx walletdb.ReadTx) (VoteVersionStatus, error) {
+	version, deployments := CurrentAgendas(w.chainParams)
+	s := VoteVersionStatus{VoteVersion: version}
+
+	ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.TxStore.MainChainTip(ns)
+	for height := tipHeight; height > 0 && height > tipHeight-voteVersionCheckBlocks; height-- {
+		hash, err := w.TxStore.GetMainChainBlockHashForHeight(ns, height)
+		if err != nil {
+			return s, err
+		}
+		header, err := w.TxStore.GetBlockHeader(dbtx, &hash)
+		if err != nil {
+			return s, err
+		}
+		if s.ChainStakeVersion == 0 || header.StakeVersion < s.ChainStakeVersion {
+			s.ChainStakeVersion = header.StakeVersion
+		}
+	}
+	s.Outdated = version < s.ChainStakeVersion
+
+	for i := range deployments {
+		agendaID := deployments[i].Vote.Id
+		choiceID := udb.AgendaPreference(dbtx, version, agendaID)
+		if choiceID == "" || choiceID == "abstain" {
+			continue
+		}
+		s.Choices = append(s.Choices, AgendaChoice{
+			AgendaID: agendaID,
+			ChoiceID: choiceID,
+		})
+	}
+
+	return s, nil
+}
+
+// checkVoteVersion updates the vote version status of the wallet, logging a
+// warning and notifying clients when the wallet begins casting outdated votes.
+func (w *Wallet) checkVoteVersion() error {
+	const op errors.Op = "wallet.checkVoteVersion"
+	var s VoteVersionStatus
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		s, err = w.readVoteVersionStatus(dbtx)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	w.voteVersionMu.Lock()
+	wasOutdated := w.voteVersionStatus.Outdated
+	w.voteVersionStatus = s
+	w.voteVersionMu.Unlock()
+
+	switch {
+	case s.Outdated && !wasOutdated:
+		log.Warn(s.Warning())
+		w.NtfnServer.notifyVoteVersion(&s)
+	case !s.Outdated && wasOutdated:
+		log.Infof("Vote version %d is compatible with network stake "+
+			"version %d", s.VoteVersion, s.ChainStakeVersion)
+		w.NtfnServer.notifyVoteVersion(&s)
+	}
+	return nil
+}
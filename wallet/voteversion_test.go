@@ -0,0 +1,55 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/blockchain/chaingen"
+)
+
+func TestVoteVersionStatus(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+
+	premine := tg.createPremineBlock("premine")
+	mustAddBlockNode(t, forest, premine.BlockNode)
+	tw.chainSwitch(forest, tw.evaluateBestChain(forest, 1, premine.Hash))
+
+	s := w.VoteVersionStatus()
+	if s.Outdated || s.Warning() != "" {
+		t.Fatalf("votes outdated before network upgrade: %+v", s)
+	}
+
+	// A single block of a newer stake version does not upgrade the network.
+	upgrade := chaingen.ReplaceStakeVersion(s.VoteVersion + 1)
+	b := tg.nextBlock("upgrade0", nil, nil, upgrade)
+	mustAddBlockNode(t, forest, b.BlockNode)
+	tw.chainSwitch(forest, tw.evaluateBestChain(forest, 1, b.Hash))
+	if s := w.VoteVersionStatus(); s.Outdated {
+		t.Fatalf("votes outdated by a single upgraded block: %+v", s)
+	}
+
+	// Votes are outdated once every recent block has the newer version.
+	for i := 1; i < voteVersionCheckBlocks; i++ {
+		b = tg.nextBlock(fmt.Sprintf("upgrade%d", i), nil, nil, upgrade)
+		mustAddBlockNode(t, forest, b.BlockNode)
+	}
+	tw.chainSwitch(forest, tw.evaluateBestChain(forest, voteVersionCheckBlocks-1, b.Hash))
+	s = w.VoteVersionStatus()
+	if !s.Outdated || s.ChainStakeVersion != s.VoteVersion+1 {
+		t.Fatalf("votes not outdated after network upgrade: %+v", s)
+	}
+	if !strings.Contains(s.Warning(), "outdated vote version") {
+		t.Fatalf("unexpected warning %q", s.Warning())
+	}
+}
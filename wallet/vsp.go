@@ -0,0 +1,164 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/txrules"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// SetVSP selects the voting service provider at host, identified by its
+// Ed25519 public key, as the provider which tickets are purchased for.  An
+// empty host clears the selected VSP.
+func (w *Wallet) SetVSP(host string, pubKey []byte) error {
+	const op errors.Op = "wallet.SetVSP"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.SetVSP(txmgrNs, host, pubKey)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// VSP returns the host and public key of the selected voting service provider.
+// An errors.NotExist error is returned when no VSP is selected.
+func (w *Wallet) VSP() (host string, pubKey []byte, err error) {
+	const op errors.Op = "wallet.VSP"
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		host, pubKey, err = w.TxStore.VSP(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return "", nil, errors.E(op, err)
+	}
+	return host, pubKey, nil
+}
+
+// PutVSPTicket records the registration of a ticket with a voting service
+// provider.
+func (w *Wallet) PutVSPTicket(t *udb.VSPTicket) error {
+	const op errors.Op = "wallet.PutVSPTicket"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.PutVSPTicket(txmgrNs, t)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// VSPTicket returns the VSP registration record of a ticket.  Tickets which
+// are not managed by a VSP return an errors.NotExist error.
+func (w *Wallet) VSPTicket(ticketHash *chainhash.Hash) (*udb.VSPTicket, error) {
+	const op errors.Op = "wallet.VSPTicket"
+	var t *udb.VSPTicket
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		t, err = w.TxStore.VSPTicket(txmgrNs, ticketHash)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return t, nil
+}
+
+// VSPTickets returns the registration records of all tickets managed by a
+// voting service provider.
+func (w *Wallet) VSPTickets() ([]*udb.VSPTicket, error) {
+	const op errors.Op = "wallet.VSPTickets"
+	var tickets []*udb.VSPTicket
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachVSPTicket(txmgrNs, func(t *udb.VSPTicket) error {
+			tickets = append(tickets, t)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tickets, nil
+}
+
+// VSPMaxFee returns the largest fee paid to a voting service provider for a
+// ticket.
+func (w *Wallet) VSPMaxFee() vhcutil.Amount {
+	return w.vspMaxFee
+}
+
+// PayVSPFee creates and publishes the transaction paying the fee of a ticket
+// registered with a voting service provider from the fee account of the ticket.
+// The ticket is recorded with the VSPFeeSigned status and the hash of the fee
+// transaction together with the transaction itself, before it is published, so
+// that an interrupted payment is published again rather than paid twice.  The
+// recorded ticket must be read again after an error, as the fee transaction may
+// have been recorded but not published.  Fees exceeding the VSPMaxFee of the
+// wallet are refused.
+func (w *Wallet) PayVSPFee(t *udb.VSPTicket, minconf int32) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.PayVSPFee"
+	if t.Status != udb.VSPFeeUnpaid {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("fee of ticket %v is already paid", &t.TicketHash))
+	}
+	if fee := vhcutil.Amount(t.FeeAmount); fee > w.VSPMaxFee() {
+		return nil, errors.E(op, errors.Policy, errors.Errorf("VSP fee %v "+
+			"exceeds the maximum of %v", fee, w.VSPMaxFee()))
+	}
+	feeAddr, err := vhcutil.DecodeAddress(t.FeeAddress)
+	if err != nil {
+		return nil, errors.E(op, errors.Protocol, err)
+	}
+	pkScript, err := txscript.PayToAddrScript(feeAddr)
+	if err != nil {
+		return nil, errors.E(op, errors.Protocol, err)
+	}
+	output := &wire.TxOut{Value: t.FeeAmount, Version: txscript.DefaultScriptVersion, PkScript: pkScript}
+	err = txrules.CheckOutput(output, w.RelayFee())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	required, err := w.SendApprovalRequired(t.FeeAccount)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if required {
+		return nil, errors.E(op, errApprovalRequired(t.FeeAccount))
+	}
+
+	signed := *t
+	signed.Status = udb.VSPFeeSigned
+	req := createTxRequest{
+		account: t.FeeAccount,
+		outputs: []*wire.TxOut{output},
+		minconf: minconf,
+		record: func(txmgrNs walletdb.ReadWriteBucket, txHash *chainhash.Hash) error {
+			signed.FeeTxHash = *txHash
+			return w.TxStore.PutVSPTicket(txmgrNs, &signed)
+		},
+		resp: make(chan createTxResponse),
+	}
+	w.createTxRequests <- req
+	resp := <-req.resp
+	if resp.err != nil {
+		return nil, errors.E(op, resp.err)
+	}
+	*t = signed
+	hash := t.FeeTxHash
+	return &hash, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+)
+
+// failPublishNetwork fails to publish transactions.
+type failPublishNetwork struct {
+	mockNetwork
+}
+
+func (failPublishNetwork) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	return errors.E(errors.IO, "publish failed")
+}
+
+func TestPayVSPFee(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.VSPMaxFee = 1e7
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	w.SetNetworkBackend(failPublishNetwork{})
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 5e8)
+	feeAddr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket := &udb.VSPTicket{
+		TicketHash: chainhash.Hash{1},
+		Host:       "https://vsp.example.org",
+		Status:     udb.VSPFeeUnpaid,
+		FeeAddress: feeAddr.EncodeAddress(),
+		FeeAmount:  2e7,
+	}
+	if err := w.PutVSPTicket(ticket); err != nil {
+		t.Fatal(err)
+	}
+
+	// Fees above the maximum are refused.
+	_, err = w.PayVSPFee(ticket, 1)
+	if !errors.Is(errors.Policy, err) {
+		t.Fatalf("fee above maximum: got error %v", err)
+	}
+
+	// The fee transaction is recorded with the ticket before it is
+	// published, even when publishing fails.
+	ticket.FeeAmount = 1e7
+	if _, err := w.PayVSPFee(ticket, 1); err == nil {
+		t.Fatal("fee paid without publishing")
+	}
+	got, err := w.VSPTicket(&ticket.TicketHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != udb.VSPFeeSigned {
+		t.Fatalf("ticket status %v after failed publish", got.Status)
+	}
+	txs, _, err := w.GetTransactionsByHashes([]*chainhash.Hash{&got.FeeTxHash})
+	if err != nil {
+		t.Fatalf("fee transaction was not recorded: %v", err)
+	}
+	if txs[0].TxOut[0].Value != 1e7 && txs[0].TxOut[1].Value != 1e7 {
+		t.Fatalf("fee transaction does not pay the fee")
+	}
+
+	// Fees of tickets which are already signed are not paid again.
+	_, err = w.PayVSPFee(got, 1)
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("second payment: got error %v", err)
+	}
+}
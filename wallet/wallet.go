@@ -27,7 +27,6 @@ import (
 	vhcrpcclient "github.com/valhallacoin/vhcd/rpcclient"
 	"github.com/valhallacoin/vhcd/txscript"
 	"github.com/valhallacoin/vhcd/vhcec"
-	"github.com/valhallacoin/vhcd/vhcec/secp256k1"
 	"github.com/valhallacoin/vhcd/vhcjson"
 	"github.com/valhallacoin/vhcd/vhcutil"
 	"github.com/valhallacoin/vhcd/wire"
@@ -100,7 +99,70 @@ type Wallet struct {
 	networkBackend   NetworkBackend
 	networkBackendMu sync.Mutex
 
-	lockedOutpoints map[wire.OutPoint]struct{}
+	// Progress of synchronization with the network backend.
+	syncProgress   syncProgress
+	syncProgressMu sync.Mutex
+
+	// Status of the most recently started rescan, and the cancel functions
+	// of rescans which may be aborted by CancelRescan.
+	rescanStatus   *RescanStatus
+	rescanCancels  map[uint64]context.CancelFunc
+	rescanCancelID uint64
+	rescanStatusMu sync.Mutex
+
+	lockedOutpoints   map[wire.OutPoint]outpointLock
+	lockedOutpointsMu sync.Mutex
+
+	policyOverrides   map[uint32]time.Time
+	policyOverridesMu sync.Mutex
+
+	// Automatic consolidation of account outputs.
+	autoConsolidations   map[uint32]*autoConsolidation
+	autoConsolidationsMu sync.Mutex
+
+	// Delayed automatic revocations of missed tickets.
+	revocationDelay     time.Duration
+	pendingRevocations  map[chainhash.Hash]*pendingRevocation
+	revocationsMu       sync.Mutex
+
+	// Largest fee paid to a voting service provider for a ticket.
+	vspMaxFee vhcutil.Amount
+
+	// Coordination of votes with redundant voting wallets.
+	voteCoordinator VoteCoordinator
+
+	// Tickets selected to vote and the votes detected as missed.
+	selectedTickets map[chainhash.Hash]*selectedTickets
+	missedVotes     []MissedVote
+	missedVotesMu   sync.Mutex
+
+	// Staking statistics cached by StakeInfo.  The generation is
+	// incremented each time the cache is invalidated.
+	stakeInfo    *cachedStakeInfo
+	stakeInfoGen uint64
+	stakeInfoMu  sync.Mutex
+
+	// Result of the database consistency check performed by Open.
+	consistencyCheck ConsistencyCheck
+
+	// Duplicate instance detection.
+	instanceID      [16]byte
+	allowDuplicate  bool
+	heartbeatAddr   string
+	duplicateReason string
+	duplicateMu     sync.Mutex
+
+	// Compatibility of cast votes with the network stake version.
+	voteVersionStatus VoteVersionStatus
+	voteVersionMu     sync.Mutex
+
+	spendGuard   SpendGuard
+	spendGuardMu sync.Mutex
+
+	remoteSigner Signer
+	keyStore     KeyStore
+	keyStorages  map[uint32]KeyStorage // accounts with external keys
+	signerMu     sync.Mutex
 
 	relayFee               vhcutil.Amount
 	relayFeeMu             sync.Mutex
@@ -113,6 +175,7 @@ type Wallet struct {
 	consolidateRequests      chan consolidateRequest
 	createTxRequests         chan createTxRequest
 	createMultisigTxRequests chan createMultisigTxRequest
+	sweepRequests            chan sweepRequest
 
 	// Channels for stake tx creation requests.
 	purchaseTicketRequests chan purchaseTicketRequest
@@ -120,7 +183,10 @@ type Wallet struct {
 	// Internal address handling.
 	addressReuse     bool
 	ticketAddress    vhcutil.Address
+	coldVoting       *coldVotingKey
 	addressBuffers   map[uint32]*bip0044AccountData
+	gapPolicies      map[uint32]GapPolicy            // protected by addressBuffersMu
+	rotations        map[uint32]*udb.AccountRotation // protected by addressBuffersMu
 	addressBuffersMu sync.Mutex
 
 	// Channels for the manager locker.
@@ -154,6 +220,12 @@ type Config struct {
 	PoolFees      float64
 	TicketFee     float64
 
+	// VotingXpub is an optional account extended public key of a cold
+	// voting wallet.  When set and VotingAddress is unset, the voting
+	// address of each purchased ticket is derived from the next unused
+	// child of its external branch.
+	VotingXpub *hdkeychain.ExtendedKey
+
 	GapLimit        int
 	AccountGapLimit int
 
@@ -161,6 +233,38 @@ type Config struct {
 	AllowHighFees       bool
 	RelayFee            float64
 	Params              *chaincfg.Params
+
+	// AllowDuplicate permits transactions to be created and published
+	// even after another running instance of the wallet is detected.
+	// InstanceHeartbeat is an optional UDP address used to exchange
+	// heartbeats with other instances of the same wallet.
+	AllowDuplicate    bool
+	InstanceHeartbeat string
+
+	// UnlockSessionTimeout is the duration that the key derived from the
+	// private passphrase is cached to speed up later unlocks.  Zero
+	// disables caching.
+	UnlockSessionTimeout time.Duration
+
+	// RevocationDelay is the duration that automatic revocations of missed
+	// tickets are delayed, during which they may be canceled.  Zero revokes
+	// missed tickets immediately.
+	RevocationDelay time.Duration
+
+	// VSPMaxFee is the largest fee paid to a voting service provider for
+	// a ticket.  Fees are not paid to VSPs when it is zero.
+	VSPMaxFee vhcutil.Amount
+
+	// VoteCoordinator, if non-nil, coordinates the votes of this wallet with
+	// redundant wallets holding the same voting keys.  Votes are only
+	// created for tickets claimed by this wallet, and voting continues
+	// after another running instance of the wallet is detected.
+	VoteCoordinator VoteCoordinator
+
+	// FullCheck performs a full consistency check of the database when it
+	// is opened, verifying every main chain block, transaction, credit,
+	// and account record, rather than only its structure and tip.
+	FullCheck bool
 }
 
 // FetchOutput fetches the associated transaction output given an outpoint.
@@ -210,6 +314,27 @@ func (w *Wallet) VotingEnabled() bool {
 	return enabled
 }
 
+// SetVotingEnabled enables or disables voting and the automatic revocation of
+// missed tickets.  This allows voting to fail over between wallets without
+// restarting them.  The setting is not saved and the configured value is used
+// when the wallet is next opened.
+func (w *Wallet) SetVotingEnabled(enabled bool) {
+	w.stakeSettingsLock.Lock()
+	changed := w.votingEnabled != enabled
+	w.votingEnabled = enabled
+	w.stakeSettingsLock.Unlock()
+	if !changed {
+		return
+	}
+	if enabled {
+		vb := w.VoteBits()
+		log.Infof("Wallet voting enabled: vote bits = %#04x, "+
+			"extended vote bits = %x", vb.Bits, vb.ExtendedBits)
+	} else {
+		log.Infof("Wallet voting disabled")
+	}
+}
+
 func voteVersion(params *chaincfg.Params) uint32 {
 	switch params.Net {
 	case wire.MainNet:
@@ -383,6 +508,12 @@ func (w *Wallet) SetAgendaChoices(choices ...AgendaChoice) (voteBits uint16, err
 	voteBits = w.voteBits.Bits
 	w.stakeSettingsLock.Unlock()
 
+	// Update the outdated agenda choices reported by the vote version status.
+	err = w.checkVoteVersion()
+	if err != nil {
+		log.Errorf("Failed to check vote version: %v", err)
+	}
+
 	return voteBits, nil
 }
 
@@ -420,9 +551,10 @@ func (w *Wallet) Start() {
 	}
 	w.quitMu.Unlock()
 
-	w.wg.Add(2)
+	w.wg.Add(3)
 	go w.txCreator()
 	go w.walletLocker()
+	go w.instanceMonitor()
 }
 
 // RelayFee returns the current minimum relay fee (per kB of serialized
@@ -571,8 +703,8 @@ func (w *Wallet) loadActiveAddrs(ctx context.Context, dbtx walletdb.ReadTx, nb N
 	if err != nil {
 		return 0, err
 	}
-	errs := make(chan error, int(lastAcct+1)*2+1)
-	var bip0044AddrCount, importedAddrCount uint64
+	errs := make(chan error, int(lastAcct+1)*2+2)
+	var bip0044AddrCount, importedAddrCount, multisigAddrCount uint64
 	for acct := uint32(0); acct <= lastAcct; acct++ {
 		props, err := w.Manager.AccountProperties(addrmgrNs, acct)
 		if err != nil {
@@ -615,6 +747,28 @@ func (w *Wallet) loadActiveAddrs(ctx context.Context, dbtx walletdb.ReadTx, nb N
 		importedAddrCount = uint64(len(addrs))
 		errs <- nb.LoadTxFilter(ctx, false, addrs, nil)
 	}()
+	go func() {
+		// Multisig account addresses are derived for every returned child
+		// of both branches.
+		accts, err := w.Manager.MultisigAccounts(addrmgrNs)
+		if err != nil {
+			errs <- err
+			return
+		}
+		var addrs []vhcutil.Address
+		for _, a := range accts {
+			p2shAddrs, err := multisigAccountAddrs(a, w.chainParams)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for _, addr := range p2shAddrs {
+				addrs = append(addrs, addr)
+			}
+		}
+		multisigAddrCount = uint64(len(addrs))
+		errs <- nb.LoadTxFilter(ctx, false, addrs, nil)
+	}()
 	for i := 0; i < cap(errs); i++ {
 		err := <-errs
 		if err != nil {
@@ -622,7 +776,7 @@ func (w *Wallet) loadActiveAddrs(ctx context.Context, dbtx walletdb.ReadTx, nb N
 		}
 	}
 
-	return bip0044AddrCount + importedAddrCount, nil
+	return bip0044AddrCount + importedAddrCount + multisigAddrCount, nil
 }
 
 // LoadActiveDataFilters loads filters for all active addresses and unspent
@@ -649,7 +803,18 @@ func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, re
 			return err
 		}
 		utxoCount = uint64(len(unspent))
-		return n.LoadTxFilter(ctx, false, nil, unspent)
+		err = n.LoadTxFilter(ctx, false, nil, unspent)
+		if err != nil {
+			return err
+		}
+
+		watchedAddrs, watchedOutPoints, err := w.watchedFilterData(txmgrNs)
+		if err != nil {
+			return err
+		}
+		addrCount += uint64(len(watchedAddrs))
+		utxoCount += uint64(len(watchedOutPoints))
+		return n.LoadTxFilter(ctx, false, watchedAddrs, watchedOutPoints)
 	})
 	if err != nil {
 		return errors.E(op, err)
@@ -838,6 +1003,7 @@ func (w *Wallet) fetchMissingCFilters(ctx context.Context, p Peer, progress chan
 			continue
 		}
 
+		w.recordFetchedCFilters(int32(len(get)))
 		if progress != nil {
 			progress <- MissingCFilterProgress{BlockHeightStart: height, BlockHeightEnd: height + span - 1}
 		}
@@ -1102,15 +1268,17 @@ func (w *Wallet) FetchHeaders(ctx context.Context, p Peer) (count int, rescanFro
 
 type (
 	consolidateRequest struct {
-		inputs  int
-		account uint32
-		address vhcutil.Address
-		resp    chan consolidateResponse
+		minInputs int
+		opts      ConsolidateOptions
+		account   uint32
+		address   vhcutil.Address
+		resp      chan consolidateResponse
 	}
 	createTxRequest struct {
 		account uint32
 		outputs []*wire.TxOut
 		minconf int32
+		record  txRecorder
 		resp    chan createTxResponse
 	}
 	createMultisigTxRequest struct {
@@ -1121,6 +1289,14 @@ type (
 		minconf   int32
 		resp      chan createMultisigTxResponse
 	}
+	sweepRequest struct {
+		account  uint32
+		minconf  int32
+		feePerKb vhcutil.Amount
+		dest     vhcutil.Address
+		filter   *SweepFilter
+		resp     chan sweepResponse
+	}
 	purchaseTicketRequest struct {
 		minBalance  vhcutil.Amount
 		spendLimit  vhcutil.Amount
@@ -1134,6 +1310,10 @@ type (
 		txFee       vhcutil.Amount
 		ticketFee   vhcutil.Amount
 		resp        chan purchaseTicketResponse
+
+		// unsigned is set to create the split transaction and tickets
+		// without signing or publishing them, and records the result.
+		unsigned *UnsignedTicketPurchase
 	}
 
 	consolidateResponse struct {
@@ -1154,6 +1334,10 @@ type (
 		data []*chainhash.Hash
 		err  error
 	}
+	sweepResponse struct {
+		txHashes []*chainhash.Hash
+		err      error
+	}
 )
 
 // txCreator is responsible for the input selection and creation of
@@ -1172,29 +1356,29 @@ out:
 	for {
 		select {
 		case txr := <-w.consolidateRequests:
-			heldUnlock, err := w.holdUnlock()
+			heldUnlock, err := w.holdMutatingUnlock()
 			if err != nil {
 				txr.resp <- consolidateResponse{nil, err}
 				continue
 			}
-			txh, err := w.compressWallet("wallet.Consolidate", txr.inputs,
-				txr.account, txr.address)
+			txh, err := w.compressWallet("wallet.Consolidate", txr.minInputs,
+				&txr.opts, txr.account, txr.address)
 			heldUnlock.release()
 			txr.resp <- consolidateResponse{txh, err}
 
 		case txr := <-w.createTxRequests:
-			heldUnlock, err := w.holdUnlock()
+			heldUnlock, err := w.holdMutatingUnlock()
 			if err != nil {
 				txr.resp <- createTxResponse{nil, err}
 				continue
 			}
 			tx, err := w.txToOutputs("wallet.SendOutputs", txr.outputs,
-				txr.account, txr.minconf, true)
+				txr.account, txr.minconf, true, txr.record)
 			heldUnlock.release()
 			txr.resp <- createTxResponse{tx, err}
 
 		case txr := <-w.createMultisigTxRequests:
-			heldUnlock, err := w.holdUnlock()
+			heldUnlock, err := w.holdMutatingUnlock()
 			if err != nil {
 				txr.resp <- createMultisigTxResponse{nil, nil, nil, err}
 				continue
@@ -1204,8 +1388,24 @@ out:
 			heldUnlock.release()
 			txr.resp <- createMultisigTxResponse{tx, address, redeemScript, err}
 
+		case txr := <-w.sweepRequests:
+			heldUnlock, err := w.holdMutatingUnlock()
+			if err != nil {
+				txr.resp <- sweepResponse{nil, err}
+				continue
+			}
+			txh, err := w.sweepAccount("wallet.SweepAccount", &txr)
+			heldUnlock.release()
+			txr.resp <- sweepResponse{txh, err}
+
 		case txr := <-w.purchaseTicketRequests:
-			heldUnlock, err := w.holdUnlock()
+			if txr.unsigned != nil {
+				// Unsigned purchases require no private keys.
+				_, err := w.purchaseTickets("wallet.PurchaseTicketsUnsigned", txr)
+				txr.resp <- purchaseTicketResponse{nil, err}
+				continue
+			}
+			heldUnlock, err := w.holdMutatingUnlock()
 			if err != nil {
 				txr.resp <- purchaseTicketResponse{nil, err}
 				continue
@@ -1221,16 +1421,44 @@ out:
 	w.wg.Done()
 }
 
-// Consolidate consolidates as many UTXOs as are passed in the inputs argument.
-// If that many UTXOs can not be found, it will use the maximum it finds. This
-// will only compress UTXOs in the default account
+// ConsolidateOptions controls the inputs and fee of a consolidation
+// transaction.
+type ConsolidateOptions struct {
+	// MaxInputs limits the number of consolidated outputs.  Zero only
+	// limits the inputs by the maximum transaction size.
+	MaxInputs int
+
+	// TargetSize is the value of the output created by the consolidation.
+	// Only outputs of lower value are consolidated, smallest first, and no
+	// more inputs are added once the consolidated value after fees reaches
+	// the target.  Zero consolidates outputs of any value.
+	TargetSize vhcutil.Amount
+
+	// FeeRate is the fee per kB paid by the transaction.  Zero uses the
+	// relay fee of the wallet.
+	FeeRate vhcutil.Amount
+}
+
+// Consolidate consolidates as many UTXOs of an account as are passed in the
+// inputs argument.  If that many UTXOs can not be found, it will use the
+// maximum it finds.
 func (w *Wallet) Consolidate(inputs int, account uint32,
 	address vhcutil.Address) (*chainhash.Hash, error) {
+	return w.ConsolidateAccount(account, address, &ConsolidateOptions{MaxInputs: inputs})
+}
+
+// ConsolidateAccount consolidates unspent outputs of an account, selected as
+// described by opts, into a single output paying address, or a new internal
+// address of the account if address is nil.  At least two outputs must be
+// consolidated.
+func (w *Wallet) ConsolidateAccount(account uint32, address vhcutil.Address,
+	opts *ConsolidateOptions) (*chainhash.Hash, error) {
 	req := consolidateRequest{
-		inputs:  inputs,
-		account: account,
-		address: address,
-		resp:    make(chan consolidateResponse),
+		minInputs: 2,
+		opts:      *opts,
+		account:   account,
+		address:   address,
+		resp:      make(chan consolidateResponse),
 	}
 	w.consolidateRequests <- req
 	resp := <-req.resp
@@ -1278,6 +1506,49 @@ func (w *Wallet) PurchaseTickets(minBalance, spendLimit vhcutil.Amount, minConf
 	return resp.data, resp.err
 }
 
+// UnsignedTicketPurchase describes a ticket purchase created without signing or
+// publishing any transactions.  The split transaction pays the exact amounts
+// spent by each ticket, and the tickets spend its outputs.  Signing does not
+// modify transaction hashes, so the tickets remain valid once the split
+// transaction is signed.
+type UnsignedTicketPurchase struct {
+	SplitTx     *wire.MsgTx
+	Tickets     []*wire.MsgTx
+	TicketPrice vhcutil.Amount
+}
+
+// PurchaseTicketsUnsigned performs the input selection and split output
+// construction of PurchaseTickets, but returns the unsigned split transaction
+// and tickets rather than signing and publishing them.  This allows tickets to
+// be inspected or signed externally before any funds are committed.  Addresses
+// derived for change, voting, and ticket commitments are recorded so they are
+// not reused, but the selected outputs are not locked.
+func (w *Wallet) PurchaseTicketsUnsigned(spendLimit vhcutil.Amount, minConf int32, ticketAddr vhcutil.Address,
+	account uint32, numTickets int, poolAddress vhcutil.Address, poolFees float64, expiry int32,
+	txFee vhcutil.Amount, ticketFee vhcutil.Amount) (*UnsignedTicketPurchase, error) {
+
+	req := purchaseTicketRequest{
+		spendLimit:  spendLimit,
+		minConf:     minConf,
+		ticketAddr:  ticketAddr,
+		account:     account,
+		numTickets:  numTickets,
+		poolAddress: poolAddress,
+		poolFees:    poolFees,
+		expiry:      expiry,
+		txFee:       txFee,
+		ticketFee:   ticketFee,
+		resp:        make(chan purchaseTicketResponse),
+		unsigned:    new(UnsignedTicketPurchase),
+	}
+	w.purchaseTicketRequests <- req
+	resp := <-req.resp
+	if resp.err != nil {
+		return nil, resp.err
+	}
+	return req.unsigned, nil
+}
+
 type (
 	unlockRequest struct {
 		passphrase []byte
@@ -1286,8 +1557,8 @@ type (
 	}
 
 	changePassphraseRequest struct {
-		old, new []byte
-		err      chan error
+		change *udb.PassphraseChange
+		err    chan error
 	}
 
 	// heldUnlock is a tool to prevent the wallet from automatically
@@ -1342,8 +1613,8 @@ out:
 		case req := <-w.changePassphrase:
 			err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 				addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-				return w.Manager.ChangePassphrase(addrmgrNs, req.old,
-					req.new, true)
+				return w.Manager.CommitPassphraseChange(addrmgrNs,
+					req.change)
 			})
 			req.err <- err
 			continue
@@ -1399,6 +1670,11 @@ out:
 // unlock.
 func (w *Wallet) Unlock(passphrase []byte, lock <-chan time.Time) error {
 	const op errors.Op = "wallet.Unlock"
+	if g := w.SpendGuard(); g != nil {
+		if err := g.CheckUnlock(); err != nil {
+			return errors.E(op, err)
+		}
+	}
 	err := make(chan error, 1)
 	w.unlockRequests <- unlockRequest{
 		passphrase: passphrase,
@@ -1412,6 +1688,26 @@ func (w *Wallet) Unlock(passphrase []byte, lock <-chan time.Time) error {
 	return nil
 }
 
+// SetUnlockSessionTimeout sets the duration that the key derived from the
+// private passphrase during an unlock is cached in memory.  Later unlocks with
+// the same passphrase within this duration skip the expensive key derivation.
+// A zero timeout disables caching and clears any cached key.
+func (w *Wallet) SetUnlockSessionTimeout(timeout time.Duration) {
+	w.Manager.SetUnlockSessionTimeout(timeout)
+}
+
+// UnlockSession returns the expiry time of the cached unlock session key, and
+// whether a session key is currently cached.
+func (w *Wallet) UnlockSession() (time.Time, bool) {
+	return w.Manager.UnlockSession()
+}
+
+// ClearUnlockSession removes any cached unlock session key.  The wallet lock
+// state is not modified.
+func (w *Wallet) ClearUnlockSession() {
+	w.Manager.ClearUnlockSession()
+}
+
 // Lock locks the wallet's address manager.
 func (w *Wallet) Lock() {
 	w.lockRequests <- struct{}{}
@@ -1449,15 +1745,22 @@ func (c heldUnlock) release() {
 // old to new.  Changing the passphrase is synchronized with all other address
 // manager locking and unlocking.  The lock state will be the same as it was
 // before the password change.
+//
+// The keys of the old and new passphrases are derived before the change is
+// synchronized with the address manager, so the wallet may continue to be
+// locked, unlocked, and written to during the derivations.
 func (w *Wallet) ChangePrivatePassphrase(old, new []byte) error {
 	const op errors.Op = "wallet.ChangePrivatePassphrase"
+	change, e := w.Manager.PreparePassphraseChange(old, new, true)
+	if e != nil {
+		return errors.E(op, e)
+	}
 	err := make(chan error, 1)
 	w.changePassphrase <- changePassphraseRequest{
-		old: old,
-		new: new,
-		err: err,
+		change: change,
+		err:    err,
 	}
-	e := <-err
+	e = <-err
 	if e != nil {
 		return errors.E(op, e)
 	}
@@ -1467,9 +1770,13 @@ func (w *Wallet) ChangePrivatePassphrase(old, new []byte) error {
 // ChangePublicPassphrase modifies the public passphrase of the wallet.
 func (w *Wallet) ChangePublicPassphrase(old, new []byte) error {
 	const op errors.Op = "wallet.ChangePublicPassphrase"
-	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+	change, err := w.Manager.PreparePassphraseChange(old, new, false)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		return w.Manager.ChangePassphrase(addrmgrNs, old, new, false)
+		return w.Manager.CommitPassphraseChange(addrmgrNs, change)
 	})
 	if err != nil {
 		return errors.E(op, err)
@@ -1522,6 +1829,38 @@ func (w *Wallet) CalculateAccountBalances(confirms int32) (map[uint32]*udb.Balan
 	return balances, nil
 }
 
+// AccountBalancesAtBlock calculates the total balance of every account as of
+// the main chain block identified by blockHash, returning the block height and
+// the balances keyed by account number.  Only transactions mined at or below
+// this block contribute to the balances, allowing point-in-time statements to
+// be produced without restoring older copies of the wallet.
+func (w *Wallet) AccountBalancesAtBlock(blockHash *chainhash.Hash) (int32, map[uint32]vhcutil.Amount, error) {
+	const op errors.Op = "wallet.AccountBalancesAtBlock"
+	var height int32
+	var balances map[uint32]vhcutil.Amount
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		inMainChain, _ := w.TxStore.BlockInMainChain(tx, blockHash)
+		if !inMainChain {
+			return errors.E(errors.NotExist, errors.Errorf("block %v is not in the main chain", blockHash))
+		}
+		header, err := w.TxStore.GetBlockHeader(tx, blockHash)
+		if err != nil {
+			return err
+		}
+		height = int32(header.Height)
+
+		balances, err = w.TxStore.AccountBalancesAtHeight(txmgrNs, addrmgrNs, height)
+		return err
+	})
+	if err != nil {
+		return 0, nil, errors.E(op, err)
+	}
+	return height, balances, nil
+}
+
 // CurrentAddress gets the most recently requested payment address from a wallet.
 // If the address has already been used (there is at least one transaction
 // spending to it in the blockchain or vhcd mempool), the next chained address
@@ -1573,52 +1912,58 @@ func (w *Wallet) PubKeyForAddress(a vhcutil.Address) (chainec.PublicKey, error)
 }
 
 // SignMessage returns the signature of a signed message using an address'
-// associated private key.
+// associated private key.  Messages are signed with the Ed25519 or secp256k1
+// Schnorr key of addresses of these signature algorithms, and with the
+// secp256k1 ECDSA key of all other addresses.  Secp256k1 ECDSA signatures are
+// signed by the remote signer of the wallet, if set.
 func (w *Wallet) SignMessage(msg string, addr vhcutil.Address) (sig []byte, err error) {
 	const op errors.Op = "wallet.SignMessage"
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Valhalla Signed Message:\n")
-	wire.WriteVarString(&buf, 0, msg)
-	messageHash := chainhash.HashB(buf.Bytes())
-	var privKey chainec.PrivateKey
-	var done func()
-	defer func() {
-		if done != nil {
-			done()
-		}
-	}()
-	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
-		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
-		var err error
-		privKey, done, err = w.Manager.PrivateKey(addrmgrNs, addr)
-		return err
-	})
-	if err != nil {
-		return nil, errors.E(op, err)
+	if addr.DSA(w.chainParams) != vhcec.STEcdsaSecp256k1 {
+		return w.signMessage(msg, addr)
 	}
-	pkCast, ok := privKey.(*secp256k1.PrivateKey)
-	if !ok {
-		return nil, errors.E(op, "unable to create secp256k1.PrivateKey from chainec.PrivateKey")
+	if r := w.remote(); r != nil {
+		sig, err = r.SignMessage(context.Background(), msg, addr)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		ok, err := VerifyMessage(msg, addr, sig)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if !ok {
+			return nil, errors.E(op, "remote signer returned an invalid signature")
+		}
+		return sig, nil
 	}
-	sig, err = secp256k1.SignCompact(pkCast, messageHash, true)
+	sig, err = w.signMessageKeyStore(msg, addr)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
-	return sig, nil
+	if sig != nil {
+		return sig, nil
+	}
+	return w.signMessage(msg, addr)
 }
 
 // VerifyMessage verifies that sig is a valid signature of msg and was created
-// using the secp256k1 private key for addr.
+// using the private key for addr.  Signatures of Ed25519 and secp256k1 Schnorr
+// addresses are verified with the public key included in the signature, while
+// the public key of secp256k1 ECDSA signatures is recovered from the compact
+// signature.
 func VerifyMessage(msg string, addr vhcutil.Address, sig []byte) (bool, error) {
 	const op errors.Op = "wallet.VerifyMessage"
+	if dsa, ok := messageSigDSA(addr.DSA(addr.Net())); ok {
+		valid, err := verifyMessageAlt(msg, addr, dsa, sig)
+		if err != nil {
+			return false, errors.E(op, err)
+		}
+		return valid, nil
+	}
+
 	// Validate the signature - this just shows that it was valid for any pubkey
 	// at all. Whether the pubkey matches is checked below.
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Valhalla Signed Message:\n")
-	wire.WriteVarString(&buf, 0, msg)
-	expectedMessageHash := chainhash.HashB(buf.Bytes())
 	pk, wasCompressed, err := chainec.Secp256k1.RecoverCompact(sig,
-		expectedMessageHash)
+		messageHash(msg))
 	if err != nil {
 		return false, errors.E(op, err)
 	}
@@ -1765,74 +2110,105 @@ func (w *Wallet) RenameAccount(account uint32, newName string) error {
 // spec, which allows no unused account gaps).
 func (w *Wallet) NextAccount(name string) (uint32, error) {
 	const op errors.Op = "wallet.NextAccount"
-	maxEmptyAccounts := uint32(w.accountGapLimit)
-	var account uint32
-	var props *udb.AccountProperties
-	var xpub *hdkeychain.ExtendedKey
+	var a *newAccount
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
-		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-
-		// Ensure that there is transaction history in the last 100 accounts.
 		var err error
-		lastAcct, err := w.Manager.LastAccount(addrmgrNs)
-		if err != nil {
-			return err
+		a, err = w.nextAccount(tx, name)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	err = w.loadNewAccount(a)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return a.account, nil
+}
+
+// newAccount describes an account created by nextAccount which must be loaded
+// by loadNewAccount after the creating transaction is committed.
+type newAccount struct {
+	account uint32
+	props   *udb.AccountProperties
+	xpub    *hdkeychain.ExtendedKey
+}
+
+// nextAccount creates the next account in the database transaction tx.
+func (w *Wallet) nextAccount(tx walletdb.ReadWriteTx, name string) (*newAccount, error) {
+	maxEmptyAccounts := uint32(w.accountGapLimit)
+	addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+	// Ensure that there is transaction history in the last 100 accounts.
+	lastAcct, err := w.Manager.LastAccount(addrmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	canCreate := false
+	for i := uint32(0); i < maxEmptyAccounts; i++ {
+		a := lastAcct - i
+		if a == 0 && i < maxEmptyAccounts-1 {
+			// Less than 100 accounts total.
+			canCreate = true
+			break
 		}
-		canCreate := false
-		for i := uint32(0); i < maxEmptyAccounts; i++ {
-			a := lastAcct - i
-			if a == 0 && i < maxEmptyAccounts-1 {
-				// Less than 100 accounts total.
-				canCreate = true
-				break
-			}
-			props, err := w.Manager.AccountProperties(addrmgrNs, a)
-			if err != nil {
-				return err
-			}
-			if props.LastUsedExternalIndex != ^uint32(0) || props.LastUsedInternalIndex != ^uint32(0) {
-				canCreate = true
-				break
-			}
+		props, err := w.Manager.AccountProperties(addrmgrNs, a)
+		if err != nil {
+			return nil, err
 		}
-		if !canCreate {
-			return errors.New("last 100 accounts have no transaction history")
+		if props.LastUsedExternalIndex != ^uint32(0) || props.LastUsedInternalIndex != ^uint32(0) {
+			canCreate = true
+			break
 		}
+	}
+	if !canCreate {
+		return nil, errors.New("last 100 accounts have no transaction history")
+	}
 
-		account, err = w.Manager.NewAccount(addrmgrNs, name)
-		if err != nil {
-			return err
-		}
+	account, err := w.Manager.NewAccount(addrmgrNs, name)
+	if err != nil {
+		return nil, err
+	}
+	return w.prepareNewAccount(tx, account)
+}
 
-		props, err = w.Manager.AccountProperties(addrmgrNs, account)
-		if err != nil {
-			return err
-		}
+// prepareNewAccount derives the initial addresses of an account created in the
+// database transaction tx, describing the account to load by loadNewAccount.
+func (w *Wallet) prepareNewAccount(tx walletdb.ReadWriteTx, account uint32) (*newAccount, error) {
+	addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+	props, err := w.Manager.AccountProperties(addrmgrNs, account)
+	if err != nil {
+		return nil, err
+	}
 
-		xpub, err = w.Manager.AccountExtendedPubKey(tx, account)
-		if err != nil {
-			return err
-		}
+	xpub, err := w.Manager.AccountExtendedPubKey(tx, account)
+	if err != nil {
+		return nil, err
+	}
 
-		gapLimit := uint32(w.gapLimit)
-		err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
-			gapLimit, udb.ExternalBranch)
-		if err != nil {
-			return err
-		}
-		return w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
-			gapLimit, udb.InternalBranch)
-	})
+	gapLimit := uint32(w.gapLimit)
+	err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+		gapLimit, udb.ExternalBranch)
 	if err != nil {
-		return 0, errors.E(op, err)
+		return nil, err
+	}
+	err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+		gapLimit, udb.InternalBranch)
+	if err != nil {
+		return nil, err
 	}
+	return &newAccount{account: account, props: props, xpub: xpub}, nil
+}
 
-	extKey, intKey, err := deriveBranches(xpub)
+// loadNewAccount adds the address buffers of a committed new account, watches
+// its initial addresses, and notifies clients of the account.
+func (w *Wallet) loadNewAccount(a *newAccount) error {
+	extKey, intKey, err := deriveBranches(a.xpub)
 	if err != nil {
-		return 0, errors.E(op, err)
+		return err
 	}
 	w.addressBuffersMu.Lock()
-	w.addressBuffers[account] = &bip0044AccountData{
+	w.addressBuffers[a.account] = &bip0044AccountData{
 		albExternal: addressBuffer{branchXpub: extKey, lastUsed: ^uint32(0)},
 		albInternal: addressBuffer{branchXpub: intKey, lastUsed: ^uint32(0)},
 	}
@@ -1855,14 +2231,13 @@ func (w *Wallet) NextAccount(name string) (uint32, error) {
 		for i := 0; i < cap(errs); i++ {
 			err := <-errs
 			if err != nil {
-				return 0, errors.E(op, err)
+				return err
 			}
 		}
 	}
 
-	w.NtfnServer.notifyAccountProperties(props)
-
-	return account, nil
+	w.NtfnServer.notifyAccountProperties(a.props)
+	return nil
 }
 
 // MasterPubKey returns the BIP0044 master public key for the passed account.
@@ -1965,8 +2340,9 @@ func RecvCategory(details *udb.TxDetails, syncHeight int32, chainParams *chaincf
 // for a listtransactions RPC.
 //
 // TODO: This should be moved to the legacyrpc package.
-func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.Manager, syncHeight int32, net *chaincfg.Params) (sends, receives []vhcjson.ListTransactionsResult) {
+func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.Manager, txStore *udb.Store, syncHeight int32, net *chaincfg.Params) (sends, receives []vhcjson.ListTransactionsResult) {
 	addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
 
 	var (
 		blockHashStr  string
@@ -1986,6 +2362,16 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 
 	send := len(details.Debits) != 0
 
+	// Outputs of internal transfers paying the destination account are
+	// listed under the transfer category for both accounts rather than as a
+	// send and a receive.  Missing records indicate the transaction is not an
+	// internal transfer.
+	transfer, _ := txStore.InternalTransfer(txmgrNs, &details.Hash)
+	var transferFromName string
+	if transfer != nil {
+		transferFromName, _ = addrMgr.AccountName(addrmgrNs, transfer.FromAccount)
+	}
+
 	txTypeStr := vhcjson.LTTTRegular
 	switch details.TxType {
 	case stake.TxTypeSStx:
@@ -2015,11 +2401,12 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 
 outputs:
 	for i, output := range details.MsgTx.TxOut {
-		// Determine if this output is a credit.  Change outputs are skipped.
+		// Determine if this output is a credit.  Change outputs are skipped,
+		// except for internal transfers to another account.
 		var isCredit bool
 		for _, cred := range details.Credits {
 			if cred.Index == uint32(i) {
-				if cred.Change {
+				if cred.Change && transfer == nil {
 					continue outputs
 				}
 				isCredit = true
@@ -2029,6 +2416,7 @@ outputs:
 
 		var address string
 		var accountName string
+		isTransfer := false
 		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(output.Version,
 			output.PkScript, net)
 		if len(addrs) == 1 {
@@ -2036,17 +2424,26 @@ outputs:
 			address = addr.EncodeAddress()
 			account, err := addrMgr.AddrAccount(addrmgrNs, addrs[0])
 			if err == nil {
+				isTransfer = isCredit && transfer != nil &&
+					account == transfer.ToAccount
 				accountName, err = addrMgr.AccountName(addrmgrNs, account)
 				if err != nil {
 					accountName = ""
 				}
 			}
 		}
+		if transfer != nil && isCredit && !isTransfer {
+			// Change returned to the source account of the transfer.
+			continue outputs
+		}
+
+		// Outputs paying watched scripts are reported as receives
+		// involving watch-only outputs.
+		watched := !isCredit && txStore.IsWatchedScript(txmgrNs, output.PkScript)
 
 		amountF64 := vhcutil.Amount(output.Value).ToCoin()
 		result := vhcjson.ListTransactionsResult{
 			// Fields left zeroed:
-			//   InvolvesWatchOnly
 			//   BlockIndex
 			//
 			// Fields set below:
@@ -2054,6 +2451,7 @@ outputs:
 			//   Category
 			//   Amount
 			//   Fee
+			//   InvolvesWatchOnly
 			Address:         address,
 			Vout:            uint32(i),
 			Confirmations:   confirmations,
@@ -2077,25 +2475,69 @@ outputs:
 		// controlled by this wallet, all non-credits from transactions
 		// with debits are grouped under the send category.
 
+		if isTransfer {
+			result.Category = "transfer"
+			result.Account = transferFromName
+			result.Amount = -amountF64
+			result.Fee = &feeF64
+			sends = append(sends, result)
+			result.Account = accountName
+			result.Amount = amountF64
+			result.Fee = nil
+			receives = append(receives, result)
+			continue
+		}
 		if send {
 			result.Category = "send"
 			result.Amount = -amountF64
 			result.Fee = &feeF64
 			sends = append(sends, result)
 		}
-		if isCredit {
+		if isCredit || watched {
 			result.Account = accountName
 			result.Category = recvCat
 			result.Amount = amountF64
 			result.Fee = nil
+			result.InvolvesWatchOnly = watched
 			receives = append(receives, result)
 		}
 	}
-	return sends, receives
-}
 
-// ListSinceBlock returns a slice of objects with details about transactions
-// since the given block. If the block is -1 then all transactions are included.
+	// Inputs spending watched outpoints are reported as sends involving
+	// watch-only outputs.  The amount is only known for outpoints of
+	// outputs paying watched scripts.
+	for _, input := range details.MsgTx.TxIn {
+		wop, err := txStore.WatchedOutPoint(txmgrNs, &input.PreviousOutPoint)
+		if err != nil {
+			continue
+		}
+		var address string
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(txscript.DefaultScriptVersion,
+			wop.PkScript, net)
+		if len(addrs) == 1 {
+			address = addrs[0].EncodeAddress()
+		}
+		sends = append(sends, vhcjson.ListTransactionsResult{
+			Address:           address,
+			Amount:            -wop.Amount.ToCoin(),
+			Category:          "send",
+			Confirmations:     confirmations,
+			Generated:         generated,
+			BlockHash:         blockHashStr,
+			BlockTime:         blockTime,
+			InvolvesWatchOnly: true,
+			TxID:              txHashStr,
+			WalletConflicts:   []string{},
+			Time:              received,
+			TimeReceived:      received,
+			TxType:            &txTypeStr,
+		})
+	}
+	return sends, receives
+}
+
+// ListSinceBlock returns a slice of objects with details about transactions
+// since the given block. If the block is -1 then all transactions are included.
 // This is intended to be used for listsinceblock RPC replies.
 func (w *Wallet) ListSinceBlock(start, end, syncHeight int32) ([]vhcjson.ListTransactionsResult, error) {
 	const op errors.Op = "wallet.ListSinceBlock"
@@ -2106,7 +2548,7 @@ func (w *Wallet) ListSinceBlock(start, end, syncHeight int32) ([]vhcjson.ListTra
 		rangeFn := func(details []udb.TxDetails) (bool, error) {
 			for _, detail := range details {
 				sends, receives := listTransactions(tx, &detail,
-					w.Manager, syncHeight, w.chainParams)
+					w.Manager, w.TxStore, syncHeight, w.chainParams)
 				txList = append(txList, receives...)
 				txList = append(txList, sends...)
 			}
@@ -2155,7 +2597,7 @@ func (w *Wallet) ListTransactions(from, count int) ([]vhcjson.ListTransactionsRe
 				}
 
 				sends, receives := listTransactions(tx, &details[i],
-					w.Manager, tipHeight, w.chainParams)
+					w.Manager, w.TxStore, tipHeight, w.chainParams)
 				txList = append(txList, sends...)
 				txList = append(txList, receives...)
 
@@ -2216,7 +2658,7 @@ func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]vhcjso
 					}
 
 					sends, receives := listTransactions(tx, detail,
-						w.Manager, tipHeight, w.chainParams)
+						w.Manager, w.TxStore, tipHeight, w.chainParams)
 					if err != nil {
 						return false, err
 					}
@@ -2257,7 +2699,7 @@ func (w *Wallet) ListAllTransactions() ([]vhcjson.ListTransactionsResult, error)
 			// mined.
 			for i := len(details) - 1; i >= 0; i-- {
 				sends, receives := listTransactions(tx, &details[i],
-					w.Manager, tipHeight, w.chainParams)
+					w.Manager, w.TxStore, tipHeight, w.chainParams)
 				txList = append(txList, sends...)
 				txList = append(txList, receives...)
 			}
@@ -2279,6 +2721,34 @@ func (w *Wallet) ListAllTransactions() ([]vhcjson.ListTransactionsResult, error)
 	return txList, nil
 }
 
+// RangeListTransactions calls f with the listtransactions results of each
+// wallet transaction, newest first, beginning with the transaction identified
+// by the cursor, or the newest transaction if the cursor is nil, until f
+// returns true.  The cursor of each transaction is passed to f so that
+// clients may resume iteration with a later call.
+func (w *Wallet) RangeListTransactions(cursor *udb.TxCursor,
+	f func(*udb.TxCursor, []vhcjson.ListTransactionsResult) (bool, error)) error {
+
+	const op errors.Op = "wallet.RangeListTransactions"
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		return w.TxStore.RangeTransactionsFrom(txmgrNs, cursor, func(details *udb.TxDetails) (bool, error) {
+			sends, receives := listTransactions(tx, details,
+				w.Manager, w.TxStore, tipHeight, w.chainParams)
+			if len(sends) == 0 && len(receives) == 0 {
+				return false, nil
+			}
+			c := &udb.TxCursor{Height: details.Block.Height, Hash: details.Hash}
+			return f(c, append(sends, receives...))
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // ListTransactionDetails returns the listtransaction results for a single
 // transaction.
 func (w *Wallet) ListTransactionDetails(txHash *chainhash.Hash) ([]vhcjson.ListTransactionsResult, error) {
@@ -2295,7 +2765,7 @@ func (w *Wallet) ListTransactionDetails(txHash *chainhash.Hash) ([]vhcjson.ListT
 		if err != nil {
 			return err
 		}
-		sends, receives := listTransactions(dbtx, txd, w.Manager, tipHeight, w.chainParams)
+		sends, receives := listTransactions(dbtx, txd, w.Manager, w.TxStore, tipHeight, w.chainParams)
 		txList = make([]vhcjson.ListTransactionsResult, 0, len(sends)+len(receives))
 		txList = append(txList, receives...)
 		txList = append(txList, sends...)
@@ -2755,6 +3225,52 @@ func (w *Wallet) GetTickets(f func([]*TicketSummary, *wire.BlockHeader) (bool, e
 	return nil
 }
 
+// RangeTickets calls f with the summary of each ticket of the wallet, newest
+// first, beginning with the ticket identified by the cursor, or the newest
+// ticket if the cursor is nil, until f returns true.  The cursor of each
+// ticket and the header of the block mining it, or nil for unmined tickets,
+// are passed to f so that clients may resume iteration with a later call.
+//
+// Like GetTickets, missed tickets are only reported when the wallet detected
+// the missed vote.
+func (w *Wallet) RangeTickets(cursor *udb.TxCursor,
+	f func(*udb.TxCursor, *TicketSummary, *wire.BlockHeader) (bool, error)) error {
+
+	const op errors.Op = "wallet.RangeTickets"
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var header *wire.BlockHeader
+		return w.TxStore.RangeTransactionsFrom(txmgrNs, cursor, func(details *udb.TxDetails) (bool, error) {
+			ticketInfo, err := w.TxStore.TicketDetails(txmgrNs, details)
+			if err != nil {
+				return false, err
+			}
+			if ticketInfo == nil {
+				return false, nil
+			}
+			if details.Block.Height == -1 {
+				header = nil
+			} else if header == nil || header.BlockHash() != details.Block.Hash {
+				headerBytes, err := w.TxStore.GetSerializedBlockHeader(txmgrNs, &details.Block.Hash)
+				if err != nil {
+					return false, err
+				}
+				header = new(wire.BlockHeader)
+				err = header.FromBytes(headerBytes)
+				if err != nil {
+					return false, err
+				}
+			}
+			c := &udb.TxCursor{Height: details.Block.Height, Hash: details.Hash}
+			return f(c, makeTicketSummary(nil, dbtx, w, ticketInfo), header)
+		})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // GetTransactionsResult is the result of the wallet's GetTransactions method.
 // See GetTransactions for more details.
 type GetTransactionsResult struct {
@@ -2996,14 +3512,100 @@ func (s creditSlice) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
 
+// ListUnspentOptions filters and pages the results of ListUnspent.
+type ListUnspentOptions struct {
+	// Account limits the results to outputs controlled by an account when
+	// non-nil.
+	Account *uint32
+
+	// After and Limit page the results.  When either is set, outputs are
+	// read from the store in order of transaction hash and output index,
+	// beginning with the first output following After, or the first
+	// output if After is nil, and reading stops once Limit results, when
+	// positive, are found.  The tree of After is ignored.  Otherwise,
+	// every output is read and results are ordered from newest to oldest.
+	After *wire.OutPoint
+	Limit int
+}
+
+// StakeOutputKind classifies the unspent outputs of stake transactions.
+type StakeOutputKind int8
+
+// Stake output kinds.
+const (
+	// StakeOutputNone is the kind of outputs of regular transactions.
+	StakeOutputNone StakeOutputKind = iota
+
+	// StakeOutputTicket is the kind of the ticket submission output of a
+	// ticket purchase, which may only be spent by a vote or revocation of
+	// the ticket.
+	StakeOutputTicket
+
+	// StakeOutputTicketChange is the kind of change outputs of ticket
+	// purchases.
+	StakeOutputTicketChange
+
+	// StakeOutputVote is the kind of outputs of votes.
+	StakeOutputVote
+
+	// StakeOutputRevocation is the kind of outputs of revocations.
+	StakeOutputRevocation
+)
+
+// String returns the name of the stake output kind, or the empty string for
+// outputs of regular transactions.
+func (k StakeOutputKind) String() string {
+	switch k {
+	case StakeOutputTicket:
+		return "ticket"
+	case StakeOutputTicketChange:
+		return "ticketchange"
+	case StakeOutputVote:
+		return "vote"
+	case StakeOutputRevocation:
+		return "revocation"
+	default:
+		return ""
+	}
+}
+
+// ListUnspentResult describes an unspent output returned by ListUnspent.
+type ListUnspentResult struct {
+	vhcjson.ListUnspentResult
+
+	// StakeOutput classifies outputs of stake transactions.
+	StakeOutput StakeOutputKind
+
+	// TicketStatus is the status of the ticket of StakeOutputTicket
+	// outputs, which is one of TicketStatusLive, TicketStatusMissed, or
+	// TicketStatusExpired, and TicketStatusUnknown for other outputs.
+	TicketStatus TicketStatus
+
+	// StakeLocked is true for outputs locked by an unspent ticket, which
+	// may not be spent by regular transactions.
+	StakeLocked bool
+}
+
 // ListUnspent returns a slice of objects representing the unspent wallet
 // transactions fitting the given criteria. The confirmations will be more than
 // minconf, less than maxconf and if addresses is populated only the addresses
-// contained within it will be considered.  If we know nothing about a
-// transaction an empty array will be returned.
-func (w *Wallet) ListUnspent(minconf, maxconf int32, addresses map[string]struct{}) ([]*vhcjson.ListUnspentResult, error) {
+// contained within it will be considered.  Results are further filtered and
+// paged by opts, which may be nil.  Paged results are read from the store
+// one output at a time, so only the outputs up to the end of the page are
+// read.  If we know nothing about a transaction an empty array will be
+// returned.
+func (w *Wallet) ListUnspent(minconf, maxconf int32, addresses map[string]struct{},
+	opts *ListUnspentOptions) ([]*ListUnspentResult, error) {
+
 	const op errors.Op = "wallet.ListUnspent"
-	var results []*vhcjson.ListUnspentResult
+	if opts == nil {
+		opts = new(ListUnspentOptions)
+	}
+	missed := make(map[chainhash.Hash]bool)
+	for _, m := range w.MissedVotes() {
+		missed[m.Ticket] = true
+	}
+	var results []*ListUnspentResult
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
@@ -3011,108 +3613,133 @@ func (w *Wallet) ListUnspent(minconf, maxconf int32, addresses map[string]struct
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
 
 		filter := len(addresses) != 0
-		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
-		if err != nil {
-			return err
-		}
-		sort.Sort(sort.Reverse(creditSlice(unspent)))
 
-		defaultAccountName, err := w.Manager.AccountName(
-			addrmgrNs, udb.DefaultAccountNum)
+		accountNames := make(map[uint32]string)
+		accountName := func(account uint32) (string, error) {
+			name, ok := accountNames[account]
+			if ok {
+				return name, nil
+			}
+			name, err := w.Manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return "", err
+			}
+			accountNames[account] = name
+			return name, nil
+		}
+		defaultAccountName, err := accountName(udb.DefaultAccountNum)
 		if err != nil {
 			return err
 		}
 
-		for i := range unspent {
-			output := unspent[i]
-
-			details, err := w.TxStore.TxDetails(txmgrNs, &output.Hash)
-			if err != nil {
-				return err
-			}
-
+		// list returns the result of an output, or nil when the output
+		// is excluded.
+		list := func(output *udb.Credit) (*ListUnspentResult, error) {
 			// Outputs with fewer confirmations than the minimum or more
 			// confs than the maximum are excluded.
 			confs := confirms(output.Height, tipHeight)
 			if confs < minconf || confs > maxconf {
-				continue
+				return nil, nil
 			}
 
 			// Only mature coinbase outputs are included.
 			if output.FromCoinBase {
 				if !coinbaseMatured(w.chainParams, output.Height, tipHeight) {
-					continue
-				}
-			}
-
-			switch details.TxRecord.TxType {
-			case stake.TxTypeSStx:
-				// Ticket commitment, only spendable after ticket maturity.
-				if output.Index == 0 {
-					if !ticketMatured(w.chainParams, details.Height(), tipHeight) {
-						continue
-					}
-				}
-				// Change outputs.
-				if (output.Index > 0) && (output.Index%2 == 0) {
-					if !ticketChangeMatured(w.chainParams, details.Height(), tipHeight) {
-						continue
-					}
-				}
-			case stake.TxTypeSSGen:
-				// All non-OP_RETURN outputs for SSGen tx are only spendable
-				// after coinbase maturity many blocks.
-				if !coinbaseMatured(w.chainParams, details.Height(), tipHeight) {
-					continue
-				}
-			case stake.TxTypeSSRtx:
-				// All outputs for SSRtx tx are only spendable
-				// after coinbase maturity many blocks.
-				if !coinbaseMatured(w.chainParams, details.Height(), tipHeight) {
-					continue
+					return nil, nil
 				}
 			}
 
 			// Exclude locked outputs from the result set.
 			if w.LockedOutpoint(output.OutPoint) {
-				continue
+				return nil, nil
 			}
 
 			// Lookup the associated account for the output.  Use the
-			// default account name in case there is no associated account
+			// default account in case there is no associated account
 			// for some reason, although this should never happen.
 			//
 			// This will be unnecessary once transactions and outputs are
 			// grouped under the associated account in the db.
+			acct := uint32(udb.DefaultAccountNum)
 			acctName := defaultAccountName
 			sc, addrs, _, err := txscript.ExtractPkScriptAddrs(
 				txscript.DefaultScriptVersion, output.PkScript, w.chainParams)
 			if err != nil {
-				continue
+				return nil, nil
 			}
 			if len(addrs) > 0 {
-				acct, err := w.Manager.AddrAccount(
+				a, err := w.Manager.AddrAccount(
 					addrmgrNs, addrs[0])
 				if err == nil {
-					s, err := w.Manager.AccountName(
-						addrmgrNs, acct)
+					s, err := accountName(a)
 					if err == nil {
-						acctName = s
+						acct, acctName = a, s
 					}
 				}
 			}
+			if opts.Account != nil && acct != *opts.Account {
+				return nil, nil
+			}
 
 			if filter {
+				matched := false
 				for _, addr := range addrs {
-					_, ok := addresses[addr.EncodeAddress()]
-					if ok {
-						goto include
+					if _, ok := addresses[addr.EncodeAddress()]; ok {
+						matched = true
+						break
 					}
 				}
-				continue
+				if !matched {
+					return nil, nil
+				}
+			}
+
+			details, err := w.TxStore.TxDetails(txmgrNs, &output.Hash)
+			if err != nil {
+				return nil, err
+			}
+
+			var stakeOutput StakeOutputKind
+			var ticketStatus TicketStatus
+			switch details.TxRecord.TxType {
+			case stake.TxTypeSStx:
+				// Ticket commitment, only spendable after ticket maturity.
+				if output.Index == 0 {
+					if !ticketMatured(w.chainParams, details.Height(), tipHeight) {
+						return nil, nil
+					}
+					stakeOutput = StakeOutputTicket
+					ticketStatus = TicketStatusLive
+					switch {
+					case ticketExpired(w.chainParams, details.Height(), tipHeight):
+						ticketStatus = TicketStatusExpired
+					case missed[output.Hash]:
+						ticketStatus = TicketStatusMissed
+					}
+				}
+				// Change outputs.
+				if (output.Index > 0) && (output.Index%2 == 0) {
+					if !ticketChangeMatured(w.chainParams, details.Height(), tipHeight) {
+						return nil, nil
+					}
+					stakeOutput = StakeOutputTicketChange
+				}
+			case stake.TxTypeSSGen:
+				// All non-OP_RETURN outputs for SSGen tx are only spendable
+				// after coinbase maturity many blocks.
+				if !coinbaseMatured(w.chainParams, details.Height(), tipHeight) {
+					return nil, nil
+				}
+				stakeOutput = StakeOutputVote
+			case stake.TxTypeSSRtx:
+				// All outputs for SSRtx tx are only spendable
+				// after coinbase maturity many blocks.
+				if !coinbaseMatured(w.chainParams, details.Height(), tipHeight) {
+					return nil, nil
+				}
+				stakeOutput = StakeOutputRevocation
 			}
 
-		include:
 			// At the moment watch-only addresses are not supported, so all
 			// recorded outputs that are not multisig are "spendable".
 			// Multisig outputs are only "spendable" if all keys are
@@ -3143,26 +3770,31 @@ func (w *Wallet) ListUnspent(minconf, maxconf int32, addresses map[string]struct
 				for _, a := range addrs {
 					_, err := w.Manager.Address(addrmgrNs, a)
 					if err == nil {
-						continue
+						return nil, nil
 					}
 					if errors.Is(errors.NotExist, err) {
 						break scSwitch
 					}
-					return err
+					return nil, err
 				}
 				spendable = true
 			}
 
-			result := &vhcjson.ListUnspentResult{
-				TxID:          output.OutPoint.Hash.String(),
-				Vout:          output.OutPoint.Index,
-				Tree:          output.OutPoint.Tree,
-				Account:       acctName,
-				ScriptPubKey:  hex.EncodeToString(output.PkScript),
-				TxType:        int(details.TxType),
-				Amount:        output.Amount.ToCoin(),
-				Confirmations: int64(confs),
-				Spendable:     spendable,
+			result := &ListUnspentResult{
+				ListUnspentResult: vhcjson.ListUnspentResult{
+					TxID:          output.OutPoint.Hash.String(),
+					Vout:          output.OutPoint.Index,
+					Tree:          output.OutPoint.Tree,
+					Account:       acctName,
+					ScriptPubKey:  hex.EncodeToString(output.PkScript),
+					TxType:        int(details.TxType),
+					Amount:        output.Amount.ToCoin(),
+					Confirmations: int64(confs),
+					Spendable:     spendable,
+				},
+				StakeOutput:  stakeOutput,
+				TicketStatus: ticketStatus,
+				StakeLocked:  stakeOutput == StakeOutputTicket,
 			}
 
 			// BUG: this should be a JSON array so that all
@@ -3171,8 +3803,33 @@ func (w *Wallet) ListUnspent(minconf, maxconf int32, addresses map[string]struct
 			if len(addrs) > 0 {
 				result.Address = addrs[0].EncodeAddress()
 			}
+			return result, nil
+		}
+
+		if opts.After != nil || opts.Limit > 0 {
+			return w.TxStore.RangeUnspentOutputs(txmgrNs, opts.After, func(output *udb.Credit) (bool, error) {
+				result, err := list(output)
+				if err != nil || result == nil {
+					return false, err
+				}
+				results = append(results, result)
+				return opts.Limit > 0 && len(results) == opts.Limit, nil
+			})
+		}
 
-			results = append(results, result)
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		sort.Sort(sort.Reverse(creditSlice(unspent)))
+		for _, output := range unspent {
+			result, err := list(output)
+			if err != nil {
+				return err
+			}
+			if result != nil {
+				results = append(results, result)
+			}
 		}
 		return nil
 	})
@@ -3248,14 +3905,15 @@ func (w *Wallet) ImportPrivateKey(wif *vhcutil.WIF) (string, error) {
 
 // ImportScript imports a redeemscript to the wallet. If it also allows the
 // user to specify whether or not they want the redeemscript to be rescanned,
-// and how far back they wish to rescan.
-func (w *Wallet) ImportScript(rs []byte) error {
+// and how far back they wish to rescan.  When imp is not nil, it is recorded
+// as the import of the script, using the current time if imp.Time is zero.
+func (w *Wallet) ImportScript(rs []byte, imp *udb.ScriptImport) error {
 	const op errors.Op = "wallet.ImportScript"
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
 
-		err := w.TxStore.InsertTxScript(txmgrNs, rs)
+		err := w.insertImportedScript(txmgrNs, rs, imp)
 		if err != nil {
 			return err
 		}
@@ -3334,6 +3992,12 @@ type StakeInfoData struct {
 	Expired       uint32
 }
 
+// cachedStakeInfo is the result of StakeInfo for a main chain tip.
+type cachedStakeInfo struct {
+	StakeInfoData
+	tip chainhash.Hash
+}
+
 func isTicketPurchase(tx *wire.MsgTx) bool {
 	return stake.IsSStx(tx)
 }
@@ -3364,21 +4028,42 @@ func (w *Wallet) hasVotingAuthority(addrmgrNs walletdb.ReadBucket, ticketPurchas
 }
 
 // StakeInfo collects and returns staking statistics for this wallet.
+//
+// Without querying a consensus RPC server, the states of unspent and revoked
+// tickets are determined from the wallet's view of the main chain: tickets
+// revoked before reaching expiry were missed, tickets reported by
+// MissedVotes are missed until revoked, and other mature unspent tickets are
+// live until they expire.  The pool size is read from the main chain tip
+// header.  Statistics are cached until the main chain tip or the transactions
+// of the wallet change.
 func (w *Wallet) StakeInfo() (*StakeInfoData, error) {
 	const op errors.Op = "wallet.StakeInfo"
 
-	var res StakeInfoData
+	w.stakeInfoMu.Lock()
+	cached, gen := w.stakeInfo, w.stakeInfoGen
+	w.stakeInfoMu.Unlock()
 
+	missed := make(map[chainhash.Hash]struct{})
+	for _, m := range w.MissedVotes() {
+		missed[m.Ticket] = struct{}{}
+	}
+
+	var res StakeInfoData
 	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 		tipHash, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		if cached != nil && cached.tip == tipHash {
+			res = cached.StakeInfoData
+			return nil
+		}
 		res.BlockHeight = int64(tipHeight)
+		tipHeader, err := w.TxStore.GetBlockHeader(dbtx, &tipHash)
+		if err != nil {
+			return err
+		}
+		res.PoolSize = tipHeader.PoolSize
 		if deployments.DCP0001.Active(tipHeight, w.chainParams) {
-			tipHeader, err := w.TxStore.GetBlockHeader(dbtx, &tipHash)
-			if err != nil {
-				return err
-			}
 			sdiff, err := w.nextRequiredDCP0001PoSDifficulty(dbtx, tipHeader, nil)
 			if err != nil {
 				return err
@@ -3435,6 +4120,22 @@ func (w *Wallet) StakeInfo() (*StakeInfoData, error) {
 				case isRevocation(spender):
 					res.Revoked++
 
+					// The ticket was revoked because it was either expired or
+					// missed.  Revocations of expired tickets can not be mined
+					// before the block in which the ticket expires.
+					revokedHeight, err := w.TxStore.TxBlockHeight(dbtx, &it.SpenderHash)
+					if err != nil {
+						return err
+					}
+					if revokedHeight == -1 {
+						revokedHeight = tipHeight + 1
+					}
+					if ticketExpired(w.chainParams, it.Block.Height, revokedHeight-1) {
+						res.Expired++
+					} else {
+						res.Missed++
+					}
+
 				default:
 					return errors.E(errors.IO, errors.Errorf("ticket spender %v is neither vote nor revocation", &it.SpenderHash))
 				}
@@ -3444,11 +4145,27 @@ func (w *Wallet) StakeInfo() (*StakeInfoData, error) {
 			// Ticket is matured but unspent.  Possible states are that the
 			// ticket is live, expired, or missed.
 			res.Unspent++
-			if ticketExpired(w.chainParams, it.Block.Height, tipHeight) {
+			_, isMissed := missed[it.Hash]
+			switch {
+			case ticketExpired(w.chainParams, it.Block.Height, tipHeight):
 				res.UnspentExpired++
+				res.Expired++
+			case isMissed:
+				res.Missed++
+			default:
+				res.Live++
 			}
 		}
-		return it.Err()
+		if err := it.Err(); err != nil {
+			return err
+		}
+
+		w.stakeInfoMu.Lock()
+		if w.stakeInfoGen == gen {
+			w.stakeInfo = &cachedStakeInfo{StakeInfoData: res, tip: tipHash}
+		}
+		w.stakeInfoMu.Unlock()
+		return nil
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -3456,6 +4173,16 @@ func (w *Wallet) StakeInfo() (*StakeInfoData, error) {
 	return &res, nil
 }
 
+// invalidateStakeInfo discards the statistics cached by StakeInfo.  It must be
+// called whenever transactions are added to or removed from the wallet
+// without changing the main chain tip.
+func (w *Wallet) invalidateStakeInfo() {
+	w.stakeInfoMu.Lock()
+	w.stakeInfo = nil
+	w.stakeInfoGen++
+	w.stakeInfoMu.Unlock()
+}
+
 // StakeInfoPrecise collects and returns staking statistics for this wallet.  It
 // uses RPC to query futher information than StakeInfo.
 func (w *Wallet) StakeInfoPrecise(chainClient *vhcrpcclient.Client) (*StakeInfoData, error) {
@@ -3627,47 +4354,6 @@ func (w *Wallet) StakeInfoPrecise(chainClient *vhcrpcclient.Client) (*StakeInfoD
 	return res, nil
 }
 
-// LockedOutpoint returns whether an outpoint has been marked as locked and
-// should not be used as an input for created transactions.
-func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
-	_, locked := w.lockedOutpoints[op]
-	return locked
-}
-
-// LockOutpoint marks an outpoint as locked, that is, it should not be used as
-// an input for newly created transactions.
-func (w *Wallet) LockOutpoint(op wire.OutPoint) {
-	w.lockedOutpoints[op] = struct{}{}
-}
-
-// UnlockOutpoint marks an outpoint as unlocked, that is, it may be used as an
-// input for newly created transactions.
-func (w *Wallet) UnlockOutpoint(op wire.OutPoint) {
-	delete(w.lockedOutpoints, op)
-}
-
-// ResetLockedOutpoints resets the set of locked outpoints so all may be used
-// as inputs for new transactions.
-func (w *Wallet) ResetLockedOutpoints() {
-	w.lockedOutpoints = map[wire.OutPoint]struct{}{}
-}
-
-// LockedOutpoints returns a slice of currently locked outpoints.  This is
-// intended to be used by marshaling the result as a JSON array for
-// listlockunspent RPC results.
-func (w *Wallet) LockedOutpoints() []vhcjson.TransactionInput {
-	locked := make([]vhcjson.TransactionInput, len(w.lockedOutpoints))
-	i := 0
-	for op := range w.lockedOutpoints {
-		locked[i] = vhcjson.TransactionInput{
-			Txid: op.Hash.String(),
-			Vout: op.Index,
-		}
-		i++
-	}
-	return locked
-}
-
 // UnminedTransactions returns all unmined transactions from the wallet.
 // Transactions are sorted in dependency order making it suitable to range them
 // in order to broadcast at wallet startup.
@@ -3831,9 +4517,8 @@ func (w *Wallet) TotalReceivedForAccounts(minConf int32) ([]AccountTotalReceived
 	return results, nil
 }
 
-// TotalReceivedForAddr iterates through a wallet's transaction history,
-// returning the total amount of valhallacoin received for a single wallet
-// address.
+// TotalReceivedForAddr returns the total amount of valhallacoin received for a
+// single wallet address by credits with at least minConf confirmations.
 func (w *Wallet) TotalReceivedForAddr(addr vhcutil.Address, minConf int32) (vhcutil.Amount, error) {
 	const op errors.Op = "wallet.TotalReceivedForAddr"
 	var amount vhcutil.Amount
@@ -3842,19 +4527,125 @@ func (w *Wallet) TotalReceivedForAddr(addr vhcutil.Address, minConf int32) (vhcu
 
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
 
-		var (
-			addrStr    = addr.EncodeAddress()
-			stopHeight int32
-		)
-
-		if minConf > 0 {
-			stopHeight = tipHeight - minConf + 1
-		} else {
-			stopHeight = -1
+		credits, err := w.TxStore.AddressCredits(txmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		for i := range credits {
+			if confirmed(minConf, credits[i].Height, tipHeight) {
+				amount += credits[i].Amount
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return amount, nil
+}
+
+// AddressReceived describes the credits received by a single address.
+type AddressReceived struct {
+	Address string
+	Amount  vhcutil.Amount
+
+	// Confirmations is the number of confirmations of the most recent
+	// credit.
+	Confirmations int32
+
+	// TxHashes are the hashes of the transactions of each credit, ordered
+	// by block height with unmined transactions last.
+	TxHashes []chainhash.Hash
+}
+
+// ReceivedByAddresses returns the amounts received by every address paid by a
+// wallet credit with at least minConf confirmations.  Addresses without any
+// such credits are not included.  Results are ordered by address.
+func (w *Wallet) ReceivedByAddresses(minConf int32) ([]AddressReceived, error) {
+	const op errors.Op = "wallet.ReceivedByAddresses"
+	var received []AddressReceived
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		var credits []udb.AddressCredit
+		flush := func(addr string) {
+			if len(credits) == 0 {
+				return
+			}
+			sort.SliceStable(credits, func(i, j int) bool {
+				hi, hj := credits[i].Height, credits[j].Height
+				if hi == -1 || hj == -1 {
+					return hi != -1
+				}
+				return hi < hj
+			})
+			r := AddressReceived{
+				Address:       addr,
+				Confirmations: confirms(credits[len(credits)-1].Height, tipHeight),
+				TxHashes:      make([]chainhash.Hash, len(credits)),
+			}
+			for i := range credits {
+				r.Amount += credits[i].Amount
+				r.TxHashes[i] = credits[i].OutPoint.Hash
+			}
+			received = append(received, r)
+			credits = credits[:0]
+		}
+		var lastAddr string
+		err := w.TxStore.ForEachAddressCredit(txmgrNs, func(addr string, c *udb.AddressCredit) error {
+			if addr != lastAddr {
+				flush(lastAddr)
+				lastAddr = addr
+			}
+			if confirmed(minConf, c.Height, tipHeight) {
+				credits = append(credits, *c)
+			}
+			return nil
+		})
+		flush(lastAddr)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return received, nil
+}
+
+// ReceivedTotals describes the amounts received by an address or account,
+// including spent outputs.
+type ReceivedTotals struct {
+	// Confirmed is the value of credits mined with at least the required
+	// number of confirmations.
+	Confirmed vhcutil.Amount
+
+	// Pending is the value of unmined credits and credits mined with fewer
+	// than the required number of confirmations.
+	Pending vhcutil.Amount
+}
+
+// receivedTotals sums the credits of every transaction recorded by the wallet,
+// including unmined transactions, that pay to addresses matched by match.  A
+// minConf less than one is treated as one so that unmined credits are always
+// counted as pending.
+func (w *Wallet) receivedTotals(minConf int32,
+	match func(addrmgrNs walletdb.ReadBucket, addrs []vhcutil.Address) bool) (*ReceivedTotals, error) {
+
+	if minConf < 1 {
+		minConf = 1
+	}
+	totals := new(ReceivedTotals)
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
 		rangeFn := func(details []udb.TxDetails) (bool, error) {
 			for i := range details {
 				detail := &details[i]
+				txConfirmed := confirmed(minConf, detail.Block.Height, tipHeight)
 				for _, cred := range detail.Credits {
 					pkVersion := detail.MsgTx.TxOut[cred.Index].Version
 					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
@@ -3862,29 +4653,77 @@ func (w *Wallet) TotalReceivedForAddr(addr vhcutil.Address, minConf int32) (vhcu
 						pkScript, w.chainParams)
 					// An error creating addresses from the output script only
 					// indicates a non-standard script, so ignore this credit.
-					if err != nil {
+					if err != nil || !match(addrmgrNs, addrs) {
 						continue
 					}
-					for _, a := range addrs {
-						if addrStr == a.EncodeAddress() {
-							amount += cred.Amount
-							break
-						}
+					if txConfirmed {
+						totals.Confirmed += cred.Amount
+					} else {
+						totals.Pending += cred.Amount
 					}
 				}
 			}
 			return false, nil
 		}
-		return w.TxStore.RangeTransactions(txmgrNs, 0, stopHeight, rangeFn)
+		return w.TxStore.RangeTransactions(txmgrNs, 0, -1, rangeFn)
+	})
+	return totals, err
+}
+
+// ReceivedByAddress returns the confirmed and pending amounts received by a
+// single wallet address.  Credits with fewer than minConf confirmations, and
+// all unmined credits, are counted as pending.
+func (w *Wallet) ReceivedByAddress(addr vhcutil.Address, minConf int32) (*ReceivedTotals, error) {
+	const op errors.Op = "wallet.ReceivedByAddress"
+	if minConf < 1 {
+		minConf = 1
+	}
+	totals := new(ReceivedTotals)
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		credits, err := w.TxStore.AddressCredits(txmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		for i := range credits {
+			if confirmed(minConf, credits[i].Height, tipHeight) {
+				totals.Confirmed += credits[i].Amount
+			} else {
+				totals.Pending += credits[i].Amount
+			}
+		}
+		return nil
 	})
 	if err != nil {
-		return 0, errors.E(op, err)
+		return nil, errors.E(op, err)
 	}
-	return amount, nil
+	return totals, nil
+}
+
+// ReceivedByAccount returns the confirmed and pending amounts received by the
+// addresses of an account.  Credits with fewer than minConf confirmations, and
+// all unmined credits, are counted as pending.
+func (w *Wallet) ReceivedByAccount(account uint32, minConf int32) (*ReceivedTotals, error) {
+	const op errors.Op = "wallet.ReceivedByAccount"
+	totals, err := w.receivedTotals(minConf, func(addrmgrNs walletdb.ReadBucket, addrs []vhcutil.Address) bool {
+		if len(addrs) == 0 {
+			return false
+		}
+		a, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
+		return err == nil && a == account
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return totals, nil
 }
 
 // SendOutputs creates and sends payment transactions. It returns the
-// transaction hash upon success
+// transaction hash upon success.  Sends from accounts requiring approval are
+// refused and must instead be queued with QueueSend.
 func (w *Wallet) SendOutputs(outputs []*wire.TxOut, account uint32, minconf int32) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputs"
 	relayFee := w.RelayFee()
@@ -3895,6 +4734,14 @@ func (w *Wallet) SendOutputs(outputs []*wire.TxOut, account uint32, minconf int3
 		}
 	}
 
+	required, err := w.SendApprovalRequired(account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if required {
+		return nil, errors.E(op, errApprovalRequired(account))
+	}
+
 	req := createTxRequest{
 		account: account,
 		outputs: outputs,
@@ -3939,11 +4786,47 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 		}
 	}()
 
+	// Inputs are signed by the remote signer, if set, unless the keys were
+	// provided by the caller.  The signatures are validated below.
+	remote := w.remote()
+	if len(additionalKeysByAddress) != 0 {
+		remote = nil
+	}
+	if remote != nil {
+		err := w.signRemote(remote, tx, hashType, additionalPrevScripts)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
 	var signErrors []SignatureError
 	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 
+		// Inputs of accounts whose keys are kept by the key store are
+		// signed by it rather than with the keys of the address manager.
+		keyStoreSigned := make([]bool, len(tx.TxIn))
+		if remote == nil && len(additionalKeysByAddress) == 0 {
+			prevScripts := make([][]byte, len(tx.TxIn))
+			for i, txIn := range tx.TxIn {
+				if i == 0 && stake.IsSSGen(tx) {
+					continue
+				}
+				script, err := w.prevOutScript(txmgrNs, &txIn.PreviousOutPoint,
+					additionalPrevScripts)
+				if err != nil {
+					return err
+				}
+				prevScripts[i] = script
+			}
+			var err error
+			keyStoreSigned, err = w.signKeyStore(addrmgrNs, tx, prevScripts, hashType)
+			if err != nil {
+				return err
+			}
+		}
+
 		for i, txIn := range tx.TxIn {
 			// For an SSGen tx, skip the first input as it is a stake base
 			// and doesn't need to be signed.
@@ -3955,17 +4838,10 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 				}
 			}
 
-			prevOutScript, ok := additionalPrevScripts[txIn.PreviousOutPoint]
-			if !ok {
-				prevHash := &txIn.PreviousOutPoint.Hash
-				prevIndex := txIn.PreviousOutPoint.Index
-				txDetails, err := w.TxStore.TxDetails(txmgrNs, prevHash)
-				if errors.Is(errors.NotExist, err) {
-					return errors.Errorf("%v not found", &txIn.PreviousOutPoint)
-				} else if err != nil {
-					return err
-				}
-				prevOutScript = txDetails.MsgTx.TxOut[prevIndex].PkScript
+			prevOutScript, err := w.prevOutScript(txmgrNs, &txIn.PreviousOutPoint,
+				additionalPrevScripts)
+			if err != nil {
+				return err
 			}
 
 			// Set up our callbacks that we pass to txscript so it can
@@ -4033,8 +4909,8 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 			// SigHashSingle inputs can only be signed if there's a
 			// corresponding output. However this could be already signed,
 			// so we always verify the output.
-			if (hashType&txscript.SigHashSingle) !=
-				txscript.SigHashSingle || i < len(tx.TxOut) {
+			if remote == nil && !keyStoreSigned[i] && ((hashType&txscript.SigHashSingle) !=
+				txscript.SigHashSingle || i < len(tx.TxOut)) {
 				// Check for alternative checksig scripts and
 				// set the signature suite accordingly.
 				ecType := vhcec.STEcdsaSecp256k1
@@ -4107,6 +4983,87 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, hashType txscript.SigHashType,
 	return signErrors, nil
 }
 
+// prevOutScript returns the output script spent by an input, either from the
+// additional scripts provided by the caller or from the transaction store.
+func (w *Wallet) prevOutScript(txmgrNs walletdb.ReadBucket, prevOut *wire.OutPoint,
+	additionalPrevScripts map[wire.OutPoint][]byte) ([]byte, error) {
+
+	if script, ok := additionalPrevScripts[*prevOut]; ok {
+		return script, nil
+	}
+	txDetails, err := w.TxStore.TxDetails(txmgrNs, &prevOut.Hash)
+	if errors.Is(errors.NotExist, err) {
+		return nil, errors.Errorf("%v not found", prevOut)
+	} else if err != nil {
+		return nil, err
+	}
+	return txDetails.MsgTx.TxOut[prevOut.Index].PkScript, nil
+}
+
+// PrevOutScripts returns the output scripts of the outpoints which are known
+// by the transaction store, either as outputs of recorded transactions or as
+// watched outpoints of outputs paying watched scripts.  Outpoints unknown to
+// the wallet are omitted from the returned map.
+func (w *Wallet) PrevOutScripts(outpoints []wire.OutPoint) (map[wire.OutPoint][]byte, error) {
+	const op errors.Op = "wallet.PrevOutScripts"
+	scripts := make(map[wire.OutPoint][]byte)
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for i := range outpoints {
+			prevOut := &outpoints[i]
+			tx, err := w.TxStore.Tx(txmgrNs, &prevOut.Hash)
+			if err == nil && int(prevOut.Index) < len(tx.TxOut) {
+				scripts[*prevOut] = tx.TxOut[prevOut.Index].PkScript
+				continue
+			}
+			if err != nil && !errors.Is(errors.NotExist, err) {
+				return err
+			}
+			wop, err := w.TxStore.WatchedOutPoint(txmgrNs, prevOut)
+			if err == nil && len(wop.PkScript) != 0 {
+				scripts[*prevOut] = wop.PkScript
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return scripts, nil
+}
+
+// signRemote signs the inputs of a transaction with a remote signer.  The
+// stakebase input of votes is not signed.
+func (w *Wallet) signRemote(remote Signer, tx *wire.MsgTx, hashType txscript.SigHashType,
+	additionalPrevScripts map[wire.OutPoint][]byte) error {
+
+	prevScripts := make([][]byte, len(tx.TxIn))
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		isVote := stake.IsSSGen(tx)
+		for i, txIn := range tx.TxIn {
+			if i == 0 && isVote {
+				continue
+			}
+			if (hashType&txscript.SigHashSingle) == txscript.SigHashSingle &&
+				i >= len(tx.TxOut) {
+				continue
+			}
+			script, err := w.prevOutScript(txmgrNs, &txIn.PreviousOutPoint,
+				additionalPrevScripts)
+			if err != nil {
+				return err
+			}
+			prevScripts[i] = script
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return remote.SignTransaction(context.Background(), tx, prevScripts, hashType)
+}
+
 // CreateSignature returns the raw signature created by the private key of addr
 // for tx's idx'th input script and the serialized compressed pubkey for the
 // address.
@@ -4199,6 +5156,7 @@ func (w *Wallet) PurgeUnminedTransaction(hash *chainhash.Hash) error {
 		if details.Block.Height != -1 {
 			return errors.E(errors.Invalid, errors.Errorf("transaction %v is mined in main chain", hash))
 		}
+		w.invalidateStakeInfo()
 		return w.TxStore.RemoveUnconfirmed(ns, &details.MsgTx, hash)
 	})
 	if err != nil {
@@ -4216,17 +5174,28 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, n Netwo
 
 	txHash := tx.TxHash()
 
+	err := w.checkDuplicateInstance()
+	if err != nil {
+		op := errors.Opf(opf, &txHash)
+		return nil, errors.E(op, err)
+	}
+
 	var relevant bool
-	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+	var totalInput vhcutil.Amount
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
 		relevant = w.isRelevantTx(dbtx, tx)
+		if !relevant {
+			return nil
+		}
+		var err error
+		totalInput, err = w.TxStore.TotalInput(dbtx, tx)
+		if err != nil {
+			return err
+		}
 
 		// Prevent high fee transactions from being published, if disabled and
 		// the fee can be calculated.
-		if relevant && !w.AllowHighFees {
-			totalInput, err := w.TxStore.TotalInput(dbtx, tx)
-			if err != nil {
-				return err
-			}
+		if !w.AllowHighFees {
 			err = w.checkHighFees(totalInput, tx)
 			if err != nil {
 				return err
@@ -4240,6 +5209,16 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, n Netwo
 		return nil, errors.E(op, err)
 	}
 
+	// The value of transactions created outside of the wallet is bounded
+	// by the inputs they spend.
+	if relevant {
+		err = w.checkSpendGuard(totalInput)
+		if err != nil {
+			op := errors.Opf(opf, &txHash)
+			return nil, errors.E(op, err)
+		}
+	}
+
 	var watchOutPoints []wire.OutPoint
 	if relevant {
 		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
@@ -4340,8 +5319,11 @@ func (w *Wallet) NeedsAccountsSync() (bool, error) {
 
 // Create creates an new wallet, writing it to an empty database.  If the passed
 // seed is non-nil, it is used.  Otherwise, a secure random seed of the
-// recommended length is generated.
-func Create(db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) error {
+// recommended length is generated.  The birthday records when the seed was
+// created, and blocks mined before it are not rescanned for wallet
+// transactions.  The birthday of generated seeds defaults to the current time,
+// and a zero birthday of a provided seed records that the birthday is unknown.
+func Create(db DB, pubPass, privPass, seed []byte, birthday time.Time, params *chaincfg.Params) error {
 	const op errors.Op = "wallet.Create"
 	// If a seed was provided, ensure that it is of valid length. Otherwise,
 	// we generate a random seed for the wallet with the recommended seed
@@ -4352,6 +5334,9 @@ func Create(db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) erro
 			return errors.E(op, err)
 		}
 		seed = hdSeed
+		if birthday.IsZero() {
+			birthday = time.Now()
+		}
 	}
 	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
 		return errors.E(op, hdkeychain.ErrInvalidSeedLen)
@@ -4361,6 +5346,14 @@ func Create(db DB, pubPass, privPass, seed []byte, params *chaincfg.Params) erro
 	if err != nil {
 		return errors.E(op, err)
 	}
+	if !birthday.IsZero() {
+		err = walletdb.Update(db.internal(), func(dbtx walletdb.ReadWriteTx) error {
+			return udb.PutBirthday(dbtx, birthday)
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
 	return nil
 }
 
@@ -4459,6 +5452,27 @@ func Open(cfg *Config) (*Wallet, error) {
 		return nil, errors.E(op, err)
 	}
 
+	// Validate the database structure so that corrupt databases fail to
+	// open with a description of the inconsistency.
+	checkStart := time.Now()
+	checkStats, err := udb.Check(db, cfg.Params, cfg.FullCheck)
+	if err != nil {
+		log.Errorf("Database consistency check failed: %v", err)
+		log.Errorf("Restore the wallet database from a backup, or " +
+			"recreate the wallet from its seed")
+		return nil, errors.E(op, err)
+	}
+	check := ConsistencyCheck{CheckStats: *checkStats, Duration: time.Since(checkStart)}
+	check.log()
+
+	var coldVoting *coldVotingKey
+	if cfg.VotingXpub != nil {
+		coldVoting, err = newColdVotingKey(cfg.VotingXpub)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
 	w := &Wallet{
 		db: db,
 
@@ -4466,6 +5480,7 @@ func Open(cfg *Config) (*Wallet, error) {
 		votingEnabled: cfg.VotingEnabled,
 		addressReuse:  cfg.AddressReuse,
 		ticketAddress: cfg.VotingAddress,
+		coldVoting:    coldVoting,
 		poolAddress:   cfg.PoolAddress,
 		poolFees:      cfg.PoolFees,
 
@@ -4473,18 +5488,38 @@ func Open(cfg *Config) (*Wallet, error) {
 		gapLimit:        cfg.GapLimit,
 		AllowHighFees:   cfg.AllowHighFees,
 		accountGapLimit: cfg.AccountGapLimit,
+		allowDuplicate:  cfg.AllowDuplicate,
+		heartbeatAddr:   cfg.InstanceHeartbeat,
 
 		// Chain params
 		subsidyCache: blockchain.NewSubsidyCache(0, cfg.Params),
 		chainParams:  cfg.Params,
 
-		lockedOutpoints: map[wire.OutPoint]struct{}{},
+		lockedOutpoints: map[wire.OutPoint]outpointLock{},
+		policyOverrides: make(map[uint32]time.Time),
+
+		autoConsolidations: make(map[uint32]*autoConsolidation),
+
+		revocationDelay:     cfg.RevocationDelay,
+		pendingRevocations:  make(map[chainhash.Hash]*pendingRevocation),
+
+		vspMaxFee: cfg.VSPMaxFee,
+
+		voteCoordinator: cfg.VoteCoordinator,
+
+		selectedTickets: make(map[chainhash.Hash]*selectedTickets),
+
+		consistencyCheck: check,
 
 		consolidateRequests:      make(chan consolidateRequest),
 		createTxRequests:         make(chan createTxRequest),
 		createMultisigTxRequests: make(chan createMultisigTxRequest),
+		sweepRequests:            make(chan sweepRequest),
 		purchaseTicketRequests:   make(chan purchaseTicketRequest),
 		addressBuffers:           make(map[uint32]*bip0044AccountData),
+		gapPolicies:              make(map[uint32]GapPolicy),
+		rotations:                make(map[uint32]*udb.AccountRotation),
+		keyStorages:              make(map[uint32]KeyStorage),
 		unlockRequests:           make(chan unlockRequest),
 		lockRequests:             make(chan struct{}),
 		holdUnlockRequests:       make(chan chan heldUnlock),
@@ -4534,6 +5569,27 @@ func Open(cfg *Config) (*Wallet, error) {
 			}
 		}
 
+		policies, err := w.Manager.AccountGapPolicies(ns)
+		if err != nil {
+			return err
+		}
+		for acct, policy := range policies {
+			w.gapPolicies[acct] = GapPolicy(policy)
+		}
+
+		w.rotations, err = w.Manager.AccountRotations(ns)
+		if err != nil {
+			return err
+		}
+
+		storages, err := w.Manager.AccountKeyStorages(ns)
+		if err != nil {
+			return err
+		}
+		for acct, storage := range storages {
+			w.keyStorages[acct] = KeyStorage(storage)
+		}
+
 		vb = w.readDBVoteBits(tx)
 
 		return nil
@@ -4542,8 +5598,24 @@ func Open(cfg *Config) (*Wallet, error) {
 		return nil, errors.E(op, err)
 	}
 
+	err = w.loadOutpointLocks()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	w.NtfnServer = newNotificationServer(w)
 	w.voteBits = vb
+	w.Manager.SetUnlockSessionTimeout(cfg.UnlockSessionTimeout)
+
+	err = w.checkVoteVersion()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	err = w.acquireInstanceLease()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
 
 	w.stakePoolColdAddrs, err = decodeStakePoolColdExtKey(cfg.StakePoolColdExtKey,
 		cfg.Params)
@@ -4562,5 +5634,12 @@ func Open(cfg *Config) (*Wallet, error) {
 		return nil, errors.E(op, err)
 	}
 
+	// Pending revocations are loaded last, as their timers may fire
+	// immediately.
+	err = w.loadPendingRevocations()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	return w, nil
 }
@@ -6,9 +6,19 @@ package wallet
 
 import (
 	"math"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcjson"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
 )
 
 func TestCoinbaseMatured(t *testing.T) {
@@ -91,3 +101,331 @@ func TestTicketExpired(t *testing.T) {
 		}
 	}
 }
+
+func TestReceivedTotals(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8)
+
+	// Record an unmined transaction paying a new address.
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), 0, nil))
+	tx.AddTxOut(wire.NewTxOut(2e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := w.processTransactionRecord(dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		minConf            int32
+		confirmed, pending vhcutil.Amount
+	}{
+		{0, 1e8, 2e8},
+		{1, 1e8, 2e8},
+		{2, 0, 3e8},
+	}
+	for i, test := range tests {
+		totals, err := w.ReceivedByAccount(0, test.minConf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if totals.Confirmed != test.confirmed || totals.Pending != test.pending {
+			t.Errorf("test %d: account received %+v", i, totals)
+		}
+	}
+
+	totals, err := w.ReceivedByAddress(addr, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totals.Confirmed != 0 || totals.Pending != 2e8 {
+		t.Errorf("address received %+v", totals)
+	}
+	totals, err = w.ReceivedByAccount(1<<20, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totals.Confirmed != 0 || totals.Pending != 0 {
+		t.Errorf("unknown account received %+v", totals)
+	}
+}
+
+func TestReceivedByAddresses(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	fundAccount(t, w, 0, 1e8, 2e8)
+
+	// Record an unmined transaction paying a new address twice.
+	addr, err := w.NewExternalAddress(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), 0, nil))
+	tx.AddTxOut(wire.NewTxOut(3e8, pkScript))
+	tx.AddTxOut(wire.NewTxOut(4e8, pkScript))
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := w.processTransactionRecord(dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := w.ReceivedByAddresses(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 2 || received[0].Amount+received[1].Amount != 3e8 {
+		t.Fatalf("unexpected confirmed receives %+v", received)
+	}
+	received, err = w.ReceivedByAddresses(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 3 {
+		t.Fatalf("received by %d addresses", len(received))
+	}
+	var r *AddressReceived
+	for i := range received {
+		if received[i].Address == addr.EncodeAddress() {
+			r = &received[i]
+		}
+	}
+	if r == nil || r.Amount != 7e8 || r.Confirmations != 0 || len(r.TxHashes) != 2 ||
+		r.TxHashes[0] != rec.Hash {
+		t.Fatalf("unexpected unmined receives %+v", r)
+	}
+
+	tests := []struct {
+		minConf int32
+		amount  vhcutil.Amount
+	}{
+		{0, 7e8},
+		{1, 0},
+	}
+	for i, test := range tests {
+		amount, err := w.TotalReceivedForAddr(addr, test.minConf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if amount != test.amount {
+			t.Errorf("test %d: address received %v", i, amount)
+		}
+	}
+}
+
+func TestRangeListTransactions(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	// Record mined transactions and unmined transactions, including an
+	// unmined ticket.
+	for i := 0; i < 3; i++ {
+		fundAccount(t, w, 0, vhcutil.Amount(i+1)*1e8, 1e8)
+	}
+	for i := 0; i < 3; i++ {
+		addr, err := w.NewExternalAddress(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := wire.NewMsgTx()
+		prev := wire.NewOutPoint(&chainhash.Hash{0xfe, byte(i)}, 0, wire.TxTreeRegular)
+		tx.AddTxIn(wire.NewTxIn(prev, 0, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+		if err := w.AcceptMempoolTx(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ticket := testOwnedTicket(t, w)
+	if err := w.AcceptMempoolTx(ticket); err != nil {
+		t.Fatal(err)
+	}
+
+	// Iterating from each cursor must resume with the transaction of the
+	// cursor and continue in the same order as a complete iteration.
+	var cursors []udb.TxCursor
+	err := w.RangeListTransactions(nil, func(c *udb.TxCursor, _ []vhcjson.ListTransactionsResult) (bool, error) {
+		cursors = append(cursors, *c)
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cursors) != 7 {
+		t.Fatalf("iterated %d transactions, expected 7", len(cursors))
+	}
+	for i := range cursors {
+		var resumed []udb.TxCursor
+		err := w.RangeListTransactions(&cursors[i], func(c *udb.TxCursor, _ []vhcjson.ListTransactionsResult) (bool, error) {
+			resumed = append(resumed, *c)
+			return false, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(resumed, cursors[i:]) {
+			t.Fatalf("resuming from cursor %d iterated %v, expected %v",
+				i, resumed, cursors[i:])
+		}
+	}
+
+	// Unmined transactions are iterated first.
+	for i, c := range cursors {
+		if (c.Height == -1) != (i < 4) {
+			t.Fatalf("transaction %d at height %d", i, c.Height)
+		}
+	}
+
+	// Tickets are ranged with the same cursors.
+	var tickets []udb.TxCursor
+	err = w.RangeTickets(&cursors[0], func(c *udb.TxCursor, s *TicketSummary, header *wire.BlockHeader) (bool, error) {
+		if *s.Ticket.Hash != c.Hash || header != nil {
+			t.Errorf("ticket %v summary for cursor %v", s.Ticket.Hash, c)
+		}
+		tickets = append(tickets, *c)
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != 1 || tickets[0].Hash != ticket.TxHash() {
+		t.Fatalf("ranged tickets %v", tickets)
+	}
+}
+
+func TestAccountBalancesAtBlock(t *testing.T) {
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	if err := w.Unlock([]byte("private"), nil); err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.NextAccount("second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	payTo := func(account uint32) []byte {
+		t.Helper()
+		addr, err := w.NewExternalAddress(account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pkScript
+	}
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+	attach := func(b *gblock, txs ...*wire.MsgTx) {
+		t.Helper()
+		mustAddBlockNode(t, forest, b.BlockNode)
+		bestChain := tw.evaluateBestChain(forest, 1, b.Hash)
+		relevantTxs := map[chainhash.Hash][]*wire.MsgTx{*b.Hash: txs}
+		_, err := w.ChainSwitch(forest, bestChain, relevantTxs)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Block 1 credits both accounts, and block 2 spends the credit of the
+	// default account to the second account.
+	premine := tg.createPremineBlock("premine")
+	attach(premine)
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0xff}, 0, wire.TxTreeRegular), 0, nil))
+	fund.AddTxOut(wire.NewTxOut(3e8, payTo(0)))
+	fund.AddTxOut(wire.NewTxOut(2e8, payTo(account)))
+	b1 := tg.nextBlock("b1", nil, nil)
+	attach(b1, fund)
+	fundHash := fund.TxHash()
+	transfer := wire.NewMsgTx()
+	transfer.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundHash, 0, wire.TxTreeRegular), 3e8, nil))
+	transfer.AddTxOut(wire.NewTxOut(1e8, payTo(account)))
+	b2 := tg.nextBlock("b2", nil, nil)
+	attach(b2, transfer)
+
+	// Unmined transactions do not contribute to any balance.
+	unmined := wire.NewMsgTx()
+	unmined.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0xfe}, 0, wire.TxTreeRegular), 0, nil))
+	unmined.AddTxOut(wire.NewTxOut(5e8, payTo(0)))
+	if err := w.AcceptMempoolTx(unmined); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		block    *gblock
+		balances map[uint32]vhcutil.Amount
+	}{
+		{premine, map[uint32]vhcutil.Amount{}},
+		{b1, map[uint32]vhcutil.Amount{0: 3e8, account: 2e8}},
+		{b2, map[uint32]vhcutil.Amount{0: 0, account: 3e8}},
+	}
+	for _, test := range tests {
+		height, balances, err := w.AccountBalancesAtBlock(test.block.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if height != int32(test.block.MsgBlock.Header.Height) {
+			t.Errorf("block %v: height %d, expected %d", test.block.Hash,
+				height, test.block.MsgBlock.Header.Height)
+		}
+		if !reflect.DeepEqual(balances, test.balances) {
+			t.Errorf("block %v: balances %v, expected %v", test.block.Hash,
+				balances, test.balances)
+		}
+	}
+
+	// Blocks which are not in the main chain have no balances.
+	tg.SetTip("premine")
+	side := tg.nextBlock("side", nil, nil)
+	mustAddBlockNode(t, forest, side.BlockNode)
+	_, _, err = w.AccountBalancesAtBlock(side.Hash)
+	if !errors.Is(errors.NotExist, err) {
+		t.Fatalf("sidechain block: got error %v", err)
+	}
+}
@@ -21,6 +21,10 @@ type ReadTx interface {
 	// described by the key does not exist, nil is returned.
 	ReadBucket(key []byte) ReadBucket
 
+	// ForEachBucket invokes the passed function with the key of every root
+	// bucket.
+	ForEachBucket(func(key []byte) error) error
+
 	// Rollback closes the transaction, discarding changes (if any) if the
 	// database was modified by a write transaction.
 	Rollback() error
@@ -79,6 +83,15 @@ type ReadBucket interface {
 	Get(key []byte) []byte
 
 	ReadCursor() ReadCursor
+
+	// ReadPrefixCursor returns a new cursor over the bucket's key/value
+	// pairs and nested buckets with keys beginning with prefix.  Moving the
+	// cursor outside of the prefixed keys returns a nil pair.
+	ReadPrefixCursor(prefix []byte) ReadCursor
+
+	// Stats returns statistics describing the storage of the bucket and all
+	// of its nested buckets.
+	Stats() BucketStats
 }
 
 // ReadWriteBucket represents a bucket (a hierarchical structure within the
@@ -120,6 +133,12 @@ type ReadWriteBucket interface {
 	// Only one cursor can be opened at a time and should be closed before
 	// commiting or rolling back the transaction.
 	ReadWriteCursor() ReadWriteCursor
+
+	// ReadWritePrefixCursor returns a new cursor over the bucket's key/value
+	// pairs and nested buckets with keys beginning with prefix.  Moving the
+	// cursor outside of the prefixed keys returns a nil pair.  The same
+	// restrictions as ReadWriteCursor apply.
+	ReadWritePrefixCursor(prefix []byte) ReadWriteCursor
 }
 
 // ReadCursor represents a bucket cursor that can be positioned at the start or
@@ -191,6 +210,47 @@ type DB interface {
 	Close() error
 }
 
+// Stats describes the storage of a database.
+type Stats struct {
+	// Path is the file path of the database.
+	Path string
+
+	// WriteErrors is the number of read-write transactions which failed
+	// to begin or commit since the database was opened.
+	WriteErrors uint64
+}
+
+// BucketStats describes the storage of a bucket and all of its nested
+// buckets.
+type BucketStats struct {
+	// KeyN is the number of keys of the bucket and all nested buckets,
+	// including the keys of the nested buckets themselves.
+	KeyN int
+
+	// BucketN is the number of buckets, including the bucket itself.
+	BucketN int
+
+	// Depth is the number of levels of the storage tree.
+	Depth int
+
+	// InuseBytes is the number of bytes used to store keys, values, and
+	// the structure of the buckets.
+	InuseBytes int
+
+	// AllocBytes is the number of bytes allocated for the storage of the
+	// buckets, which may exceed InuseBytes.
+	AllocBytes int
+}
+
+// StatsDB is implemented by databases which report statistics about their
+// storage.
+type StatsDB interface {
+	DB
+
+	// Stats returns the current statistics of the database.
+	Stats() Stats
+}
+
 // View opens a database read transaction and executes the function f with the
 // transaction passed as a parameter.  After f exits or panics, the transaction
 // is rolled back.  If f errors, its error is returned, not a rollback error (if
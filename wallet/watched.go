@@ -0,0 +1,141 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+// watchedScriptAddrs returns the addresses an output script pays, which are
+// loaded in the transaction filter of the network backend to watch the
+// script.
+func (w *Wallet) watchedScriptAddrs(pkScript []byte) ([]vhcutil.Address, error) {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(txscript.DefaultScriptVersion,
+		pkScript, w.chainParams)
+	if err != nil {
+		return nil, errors.E(errors.Op("txscript.ExtractPkScriptAddrs"), errors.Invalid, err)
+	}
+	if len(addrs) == 0 {
+		return nil, errors.E(errors.Invalid, "script does not pay any address")
+	}
+	return addrs, nil
+}
+
+// WatchScript watches an output script which is not owned by the wallet.
+// Transactions paying the script are recorded in the transaction store and
+// reported as involving watch-only outputs, and the outputs paying the script
+// are watched so that their spends are recorded as well.  Past activity is
+// only recorded after a rescan.
+func (w *Wallet) WatchScript(pkScript []byte) error {
+	const op errors.Op = "wallet.WatchScript"
+	addrs, err := w.watchedScriptAddrs(pkScript)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.WatchScript(txmgrNs, pkScript)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		err := n.LoadTxFilter(context.TODO(), false, addrs, nil)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+// WatchOutPoint watches an outpoint which is not owned by the wallet.  The
+// transaction spending the outpoint is recorded in the transaction store and
+// reported as involving a watch-only input.
+func (w *Wallet) WatchOutPoint(outpoint *wire.OutPoint) error {
+	const op errors.Op = "wallet.WatchOutPoint"
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.WatchOutPoint(txmgrNs, &udb.WatchedOutPoint{OutPoint: *outpoint})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		err := n.LoadTxFilter(context.TODO(), false, nil, []wire.OutPoint{*outpoint})
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
+// WatchedScripts returns every output script watched by the wallet.
+func (w *Wallet) WatchedScripts() ([][]byte, error) {
+	const op errors.Op = "wallet.WatchedScripts"
+	var scripts [][]byte
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		scripts, err = w.TxStore.WatchedScripts(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return scripts, nil
+}
+
+// WatchedOutPoints returns every outpoint watched by the wallet, including the
+// outpoints of outputs paying watched scripts.
+func (w *Wallet) WatchedOutPoints() ([]*udb.WatchedOutPoint, error) {
+	const op errors.Op = "wallet.WatchedOutPoints"
+	var ops []*udb.WatchedOutPoint
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		ops, err = w.TxStore.WatchedOutPoints(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return ops, nil
+}
+
+// watchedFilterData returns the addresses of watched scripts and the watched
+// outpoints to load in the transaction filter of the network backend.
+func (w *Wallet) watchedFilterData(txmgrNs walletdb.ReadBucket) ([]vhcutil.Address, []wire.OutPoint, error) {
+	scripts, err := w.TxStore.WatchedScripts(txmgrNs)
+	if err != nil {
+		return nil, nil, err
+	}
+	var addrs []vhcutil.Address
+	for _, script := range scripts {
+		scriptAddrs, err := w.watchedScriptAddrs(script)
+		if err != nil {
+			log.Warnf("Unable to watch script %x: %v", script, err)
+			continue
+		}
+		addrs = append(addrs, scriptAddrs...)
+	}
+	watched, err := w.TxStore.WatchedOutPoints(txmgrNs)
+	if err != nil {
+		return nil, nil, err
+	}
+	ops := make([]wire.OutPoint, 0, len(watched))
+	for _, wop := range watched {
+		ops = append(ops, wop.OutPoint)
+	}
+	return addrs, ops, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/valhallacoin/vhcd/chaincfg/chainhash"
+	"github.com/valhallacoin/vhcd/txscript"
+	"github.com/valhallacoin/vhcd/vhcec"
+	"github.com/valhallacoin/vhcd/vhcutil"
+	"github.com/valhallacoin/vhcd/wire"
+	"github.com/valhallacoin/vhcwallet/errors"
+	"github.com/valhallacoin/vhcwallet/wallet/udb"
+	"github.com/valhallacoin/vhcwallet/wallet/walletdb"
+)
+
+func TestWatchScript(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	addr, err := vhcutil.NewAddressPubKeyHash(bytes.Repeat([]byte{1}, 20),
+		w.chainParams, vhcec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.WatchScript([]byte{txscript.OP_RETURN})
+	if !errors.Is(errors.Invalid, err) {
+		t.Fatalf("null data script: expected Invalid error, got %v", err)
+	}
+	err = w.WatchScript(pkScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.WatchScript(pkScript)
+	if !errors.Is(errors.Exist, err) {
+		t.Fatalf("watched twice: expected Exist error, got %v", err)
+	}
+
+	process := func(tx *wire.MsgTx) *udb.TxRecord {
+		rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			_, err := w.processTransactionRecord(dbtx, rec, nil, nil)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+
+	// A transaction paying the watched script watches the paid outpoint.
+	pay := wire.NewMsgTx()
+	pay.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), 2e8, nil))
+	pay.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	payRec := process(pay)
+
+	ops, err := w.WatchedOutPoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 1 || ops[0].OutPoint.Hash != payRec.Hash || ops[0].Amount != 1e8 {
+		t.Fatalf("unexpected watched outpoints %v", ops)
+	}
+
+	// A transaction spending the watched outpoint is reported as a send.
+	spend := wire.NewMsgTx()
+	spend.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&payRec.Hash, 0, wire.TxTreeRegular), 1e8, nil))
+	spend.AddTxOut(wire.NewTxOut(1e8-1e4, []byte{txscript.OP_TRUE}))
+	spendRec := process(spend)
+
+	txs, err := w.ListTransactions(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var receive, send bool
+	for _, tx := range txs {
+		if !tx.InvolvesWatchOnly {
+			t.Errorf("transaction %v does not involve watch-only outputs", tx.TxID)
+			continue
+		}
+		switch {
+		case tx.TxID == payRec.Hash.String() && tx.Category == "receive":
+			receive = tx.Amount == 1 && tx.Address == addr.EncodeAddress()
+		case tx.TxID == spendRec.Hash.String() && tx.Category == "send":
+			send = tx.Amount == -1 && tx.Address == addr.EncodeAddress()
+		}
+	}
+	if !receive || !send {
+		t.Fatalf("missing watch-only receive or send in %+v", txs)
+	}
+
+	// Output scripts of recorded transactions are known for signing.
+	unknown := wire.OutPoint{Hash: chainhash.Hash{2}}
+	scripts, err := w.PrevOutScripts([]wire.OutPoint{spend.TxIn[0].PreviousOutPoint, unknown})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scripts) != 1 || !bytes.Equal(scripts[spend.TxIn[0].PreviousOutPoint], pkScript) {
+		t.Fatalf("unexpected previous output scripts %x", scripts)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/valhallacoin/vhcd/chaincfg"
 	"github.com/valhallacoin/vhcd/hdkeychain"
@@ -47,20 +48,31 @@ func createWallet(ctx context.Context, cfg *config) error {
 		VotingEnabled: cfg.EnableVoting,
 		AddressReuse:  cfg.ReuseAddresses,
 		VotingAddress: cfg.TBOpts.VotingAddress.Address,
+		VotingXpub:    cfg.votingXpub,
 		TicketFee:     cfg.TicketFee.ToCoin(),
 	}
 	loader := loader.NewLoader(activeNet.Params, dbDir, stakeOptions,
 		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(), cfg.AccountGapLimit)
+	loader.SetDuplicateInstanceOptions(cfg.AllowDuplicate, cfg.InstanceHeartbeat)
 
 	var privPass, pubPass, seed []byte
 	var imported bool
+	var birthday time.Time
 	var err error
 	c := make(chan struct{}, 1)
 	go func() {
+		defer func() { c <- struct{}{} }()
 		reader := bufio.NewReader(os.Stdin)
 		privPass, pubPass, seed, imported, err = prompt.Setup(reader,
 			[]byte(wallet.InsecurePubPassphrase), []byte(cfg.WalletPass))
-		c <- struct{}{}
+		if err != nil {
+			return
+		}
+		if imported {
+			birthday, err = prompt.Birthday(reader)
+		} else {
+			birthday = time.Now()
+		}
 	}()
 	select {
 	case <-ctx.Done():
@@ -72,7 +84,7 @@ func createWallet(ctx context.Context, cfg *config) error {
 	}
 
 	fmt.Println("Creating the wallet...")
-	w, err := loader.CreateNewWallet(pubPass, privPass, seed)
+	w, err := loader.CreateNewWallet(pubPass, privPass, seed, birthday)
 	if err != nil {
 		return err
 	}
@@ -151,7 +163,7 @@ func createSimulationWallet(cfg *config) error {
 	defer db.Close()
 
 	// Create the wallet.
-	err = wallet.Create(db, pubPass, privPass, seed, activeNet.Params)
+	err = wallet.Create(db, pubPass, privPass, seed, time.Now(), activeNet.Params)
 	if err != nil {
 		return err
 	}